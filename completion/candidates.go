@@ -0,0 +1,145 @@
+package completion
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Options describes how a grammar's candidates are turned into suggestions.
+type Options struct {
+	// PreferredRules are reported as rules instead of being expanded into
+	// their tokens, e.g. the rules for table and column names.
+	PreferredRules []int
+	// IgnoredTokens are never reported.
+	IgnoredTokens []int
+	// IsKeyword reports whether the literal text of a token, such as "SELECT",
+	// is a keyword. Tokens without literal text are never keywords.
+	IsKeyword func(text string) bool
+	// FunctionRules are the preferred rules that name a function. If one of
+	// them is a candidate, Functions are suggested.
+	FunctionRules []int
+	// Functions are the built-in function names of the grammar.
+	Functions []string
+}
+
+// Candidates are the suggestions valid at a caret position.
+type Candidates struct {
+	// Keywords are the keywords valid at the caret in upper case, sorted. A
+	// keyword that is always followed by other keywords is listed together
+	// with them, e.g. "GROUP BY".
+	Keywords []string
+	// Functions are the built-in functions valid at the caret, sorted.
+	Functions []string
+	// Rules are the preferred rules valid at the caret, sorted by name.
+	Rules []*Rule
+}
+
+// Rule is a preferred rule valid at the caret.
+type Rule struct {
+	// Name is the rule name, e.g. "qualified_name".
+	Name string
+	// RuleStack is the names of the rules that lead to the rule, outermost
+	// first. Callers use it to tell a column in a SELECT list from a column in
+	// an INSERT column list, for example.
+	RuleStack []string
+	// StartTokenIndex is the index of the token where the rule starts.
+	StartTokenIndex int
+}
+
+// Collect returns the candidates at caretOffset, a rune offset into the text
+// of stream. The parser must read from stream; it does not need to have parsed it.
+func Collect(parser antlr.Parser, stream *antlr.CommonTokenStream, caretOffset int, opts Options) *Candidates {
+	core := NewCodeCompletionCore(parser)
+	for _, rule := range opts.PreferredRules {
+		core.PreferredRules[rule] = true
+	}
+	for _, token := range opts.IgnoredTokens {
+		core.IgnoredTokens[token] = true
+	}
+	collection := core.CollectCandidates(CaretTokenIndex(stream, caretOffset), nil)
+
+	result := &Candidates{}
+	literalNames := parser.GetLiteralNames()
+	keyword := func(tokenType int) string {
+		if tokenType <= 0 || tokenType >= len(literalNames) || opts.IsKeyword == nil {
+			return ""
+		}
+		text := strings.Trim(literalNames[tokenType], "'")
+		if text == "" || !opts.IsKeyword(text) {
+			return ""
+		}
+		return strings.ToUpper(text)
+	}
+	seen := make(map[string]bool)
+	for tokenType, following := range collection.Tokens {
+		text := keyword(tokenType)
+		if text == "" {
+			continue
+		}
+		for _, next := range following {
+			nextText := keyword(next)
+			if nextText == "" {
+				break
+			}
+			text += " " + nextText
+		}
+		if !seen[text] {
+			seen[text] = true
+			result.Keywords = append(result.Keywords, text)
+		}
+	}
+	sort.Strings(result.Keywords)
+
+	ruleNames := parser.GetRuleNames()
+	for ruleIndex, candidate := range collection.Rules {
+		rule := &Rule{
+			Name:            ruleNames[ruleIndex],
+			StartTokenIndex: candidate.StartTokenIndex,
+		}
+		for _, r := range candidate.RuleList {
+			rule.RuleStack = append(rule.RuleStack, ruleNames[r])
+		}
+		result.Rules = append(result.Rules, rule)
+	}
+	sort.Slice(result.Rules, func(i, j int) bool {
+		return result.Rules[i].Name < result.Rules[j].Name
+	})
+
+	for _, rule := range opts.FunctionRules {
+		if _, ok := collection.Rules[rule]; ok {
+			result.Functions = append(result.Functions, opts.Functions...)
+			sort.Strings(result.Functions)
+			break
+		}
+	}
+	return result
+}
+
+// CaretTokenIndex returns the index of the token at caretOffset, a rune offset
+// into the text of stream. A caret directly after a word belongs to that word,
+// so that "SEL|" completes to SELECT; otherwise it belongs to the next token.
+func CaretTokenIndex(stream *antlr.CommonTokenStream, caretOffset int) int {
+	stream.Fill()
+	for _, token := range stream.GetAllTokens() {
+		if token.GetTokenType() == antlr.TokenEOF {
+			return token.GetTokenIndex()
+		}
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		if token.GetStop() >= caretOffset || (token.GetStop()+1 == caretOffset && isWord(token.GetText())) {
+			return token.GetTokenIndex()
+		}
+	}
+	return stream.Size() - 1
+}
+
+func isWord(text string) bool {
+	for _, r := range text {
+		return r == '_' || r == '"' || unicode.IsLetter(r)
+	}
+	return false
+}
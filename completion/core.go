@@ -0,0 +1,396 @@
+// Package completion computes code-completion candidates from a generated
+// ANTLR parser's ATN. It is a port of antlr4-c3
+// (https://github.com/mike-lischke/antlr4-c3): instead of parsing, it walks the
+// ATN along the tokens before the caret and collects every token and every
+// preferred rule that could come next.
+package completion
+
+import (
+	"slices"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// CandidateRule is a preferred rule that may start at the caret.
+type CandidateRule struct {
+	// StartTokenIndex is the index of the token where the rule starts.
+	StartTokenIndex int
+	// RuleList is the stack of rule indexes that lead to the rule, outermost first.
+	RuleList []int
+}
+
+// CandidatesCollection is the result of CollectCandidates.
+type CandidatesCollection struct {
+	// Tokens maps each token type valid at the caret to the token types that
+	// must follow it, e.g. GROUP to [BY]. The list is empty if the following
+	// tokens are not fixed.
+	Tokens map[int][]int
+	// Rules maps each preferred rule valid at the caret to its call stack.
+	Rules map[int]*CandidateRule
+}
+
+// CodeCompletionCore collects completion candidates for one parser.
+type CodeCompletionCore struct {
+	// IgnoredTokens are token types never reported as candidates, e.g.
+	// punctuation or tokens the caller handles itself.
+	IgnoredTokens map[int]bool
+	// PreferredRules are rules reported as a whole instead of being expanded
+	// into their tokens. Typical preferred rules are table or column names,
+	// where the caller offers names from a catalog.
+	PreferredRules map[int]bool
+
+	parser antlr.Parser
+	atn    *antlr.ATN
+
+	tokens          []antlr.Token
+	candidates      *CandidatesCollection
+	shortcutMap     map[int]map[int]map[int]bool
+	followSetsCache map[int]*followSetsHolder
+}
+
+type followSet struct {
+	intervals *antlr.IntervalSet
+	// path is the rule stack from the rule start to the token.
+	path      []int
+	following []int
+}
+
+type followSetsHolder struct {
+	sets     []*followSet
+	combined *antlr.IntervalSet
+	// isExhaustive is false if the rule can be passed without consuming a
+	// token, in which case combined does not cover all tokens that may follow.
+	isExhaustive bool
+}
+
+type pipelineEntry struct {
+	state          antlr.ATNState
+	tokenListIndex int
+}
+
+type ruleWithStartToken struct {
+	startTokenIndex int
+	ruleIndex       int
+}
+
+// NewCodeCompletionCore creates a CodeCompletionCore for parser. The parser's
+// token stream supplies the tokens before the caret.
+func NewCodeCompletionCore(parser antlr.Parser) *CodeCompletionCore {
+	return &CodeCompletionCore{
+		IgnoredTokens:   make(map[int]bool),
+		PreferredRules:  make(map[int]bool),
+		parser:          parser,
+		atn:             parser.GetATN(),
+		followSetsCache: make(map[int]*followSetsHolder),
+	}
+}
+
+// CollectCandidates returns the tokens and preferred rules that may appear at
+// the token with index caretTokenIndex. If context is not nil the walk starts
+// at that rule and its first token instead of the start rule, which is faster
+// for long inputs.
+//
+// Precedence predicates of left-recursive rules are not evaluated, so inside
+// such rules the result may include candidates that a stricter check would
+// reject.
+func (c *CodeCompletionCore) CollectCandidates(caretTokenIndex int, context antlr.ParserRuleContext) *CandidatesCollection {
+	c.shortcutMap = make(map[int]map[int]map[int]bool)
+	c.candidates = &CandidatesCollection{
+		Tokens: make(map[int][]int),
+		Rules:  make(map[int]*CandidateRule),
+	}
+
+	startTokenIndex := 0
+	startRule := 0
+	if context != nil {
+		startTokenIndex = context.GetStart().GetTokenIndex()
+		startRule = context.GetRuleIndex()
+	}
+
+	stream := c.parser.GetTokenStream()
+	c.tokens = nil
+	for offset := startTokenIndex; ; offset++ {
+		token := stream.Get(offset)
+		if token.GetChannel() == antlr.TokenDefaultChannel {
+			c.tokens = append(c.tokens, token)
+			if token.GetTokenIndex() >= caretTokenIndex {
+				break
+			}
+		}
+		if token.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+	}
+
+	c.processRule(c.atn.GetRuleToStartState(startRule), 0, nil)
+	return c.candidates
+}
+
+// translateStackToRuleIndex records the first preferred rule on ruleStack as a
+// candidate. It reports whether there was one.
+func (c *CodeCompletionCore) translateStackToRuleIndex(ruleStack []ruleWithStartToken) bool {
+	if len(c.PreferredRules) == 0 {
+		return false
+	}
+	for i, rule := range ruleStack {
+		if !c.PreferredRules[rule.ruleIndex] {
+			continue
+		}
+		// Only the first path found to a rule is kept.
+		if _, ok := c.candidates.Rules[rule.ruleIndex]; !ok {
+			path := make([]int, 0, i)
+			for _, r := range ruleStack[:i] {
+				path = append(path, r.ruleIndex)
+			}
+			c.candidates.Rules[rule.ruleIndex] = &CandidateRule{
+				StartTokenIndex: rule.startTokenIndex,
+				RuleList:        path,
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// followingTokens returns the fixed sequence of tokens after transition, e.g.
+// BY after GROUP.
+func (c *CodeCompletionCore) followingTokens(transition antlr.Transition) []int {
+	var result []int
+	pipeline := []antlr.ATNState{transition.GetTarget()}
+	for len(pipeline) > 0 {
+		state := pipeline[len(pipeline)-1]
+		pipeline = pipeline[:len(pipeline)-1]
+		for _, t := range state.GetTransitions() {
+			if t.GetSerializationType() != antlr.TransitionATOM || t.GetIsEpsilon() {
+				continue
+			}
+			list := t.GetLabel().ToList()
+			if len(list) == 1 && !c.IgnoredTokens[list[0]] {
+				result = append(result, list[0])
+				pipeline = append(pipeline, t.GetTarget())
+			}
+		}
+	}
+	return result
+}
+
+// determineFollowSets returns the token sets that can begin the rule starting at start.
+func (c *CodeCompletionCore) determineFollowSets(start, stop antlr.ATNState) *followSetsHolder {
+	holder := &followSetsHolder{combined: antlr.NewIntervalSet()}
+	holder.isExhaustive = c.collectFollowSets(start, stop, &holder.sets, nil, nil)
+	for _, set := range holder.sets {
+		holder.combined.AddAll(set.intervals)
+	}
+	return holder
+}
+
+// collectFollowSets collects the token sets reachable from s without
+// consuming a token. It reports false if stop can be reached as well.
+func (c *CodeCompletionCore) collectFollowSets(s, stop antlr.ATNState, sets *[]*followSet, stateStack []antlr.ATNState, ruleStack []int) bool {
+	if slices.Contains(stateStack, s) {
+		return true
+	}
+	if s == stop || s.GetStateType() == antlr.ATNStateRuleStop {
+		return false
+	}
+	stateStack = append(stateStack, s)
+
+	isExhaustive := true
+	for _, transition := range s.GetTransitions() {
+		switch t := transition.(type) {
+		case *antlr.RuleTransition:
+			ruleIndex := t.GetTarget().GetRuleIndex()
+			if slices.Contains(ruleStack, ruleIndex) {
+				continue
+			}
+			if !c.collectFollowSets(t.GetTarget(), stop, sets, stateStack, append(ruleStack, ruleIndex)) {
+				// The rule can be passed without consuming a token, so the
+				// tokens after it can begin this rule as well.
+				isExhaustive = c.collectFollowSets(t.GetFollowState(), stop, sets, stateStack, ruleStack) && isExhaustive
+			}
+		case *antlr.PredicateTransition:
+			if c.checkPredicate(t) {
+				isExhaustive = c.collectFollowSets(t.GetTarget(), stop, sets, stateStack, ruleStack) && isExhaustive
+			}
+		case *antlr.WildcardTransition:
+			*sets = append(*sets, &followSet{
+				intervals: c.allTokens(),
+				path:      slices.Clone(ruleStack),
+			})
+		default:
+			if transition.GetIsEpsilon() {
+				isExhaustive = c.collectFollowSets(transition.GetTarget(), stop, sets, stateStack, ruleStack) && isExhaustive
+				continue
+			}
+			if label := c.label(transition); label != nil {
+				*sets = append(*sets, &followSet{
+					intervals: label,
+					path:      slices.Clone(ruleStack),
+					following: c.followingTokens(transition),
+				})
+			}
+		}
+	}
+	return isExhaustive
+}
+
+// processRule walks the rule that starts at startState from the token at
+// tokenListIndex and returns the token indexes at which the rule can end.
+func (c *CodeCompletionCore) processRule(startState *antlr.RuleStartState, tokenListIndex int, callStack []ruleWithStartToken) map[int]bool {
+	ruleIndex := startState.GetRuleIndex()
+	positionMap, ok := c.shortcutMap[ruleIndex]
+	if !ok {
+		positionMap = make(map[int]map[int]bool)
+		c.shortcutMap[ruleIndex] = positionMap
+	} else if result, ok := positionMap[tokenListIndex]; ok {
+		return result
+	}
+
+	result := make(map[int]bool)
+
+	// The follow sets of a rule start tell cheaply whether the rule can match
+	// the next token at all, and are the candidates when the rule starts at the caret.
+	followSets, ok := c.followSetsCache[startState.GetStateNumber()]
+	if !ok {
+		followSets = c.determineFollowSets(startState, c.atn.GetRuleToStopState(ruleIndex))
+		c.followSetsCache[startState.GetStateNumber()] = followSets
+	}
+
+	callStack = append(callStack, ruleWithStartToken{startTokenIndex: tokenListIndex, ruleIndex: ruleIndex})
+
+	if tokenListIndex >= len(c.tokens)-1 {
+		// At the caret.
+		if c.PreferredRules[ruleIndex] {
+			c.translateStackToRuleIndex(callStack)
+		} else {
+			for _, set := range followSets.sets {
+				fullPath := slices.Clone(callStack)
+				for _, r := range set.path {
+					fullPath = append(fullPath, ruleWithStartToken{startTokenIndex: tokenListIndex, ruleIndex: r})
+				}
+				if !c.translateStackToRuleIndex(fullPath) {
+					for _, symbol := range set.intervals.ToList() {
+						c.addToken(symbol, set.following)
+					}
+				}
+			}
+		}
+		if !followSets.isExhaustive {
+			// The rule can end here, so whatever follows it is a candidate as well.
+			result[tokenListIndex] = true
+		}
+		positionMap[tokenListIndex] = result
+		return result
+	}
+
+	currentSymbol := c.tokens[tokenListIndex].GetTokenType()
+	if followSets.isExhaustive && !followSets.combined.Contains(currentSymbol) {
+		positionMap[tokenListIndex] = result
+		return result
+	}
+
+	pipeline := []pipelineEntry{{state: startState, tokenListIndex: tokenListIndex}}
+	for len(pipeline) > 0 {
+		entry := pipeline[len(pipeline)-1]
+		pipeline = pipeline[:len(pipeline)-1]
+
+		if entry.state.GetStateType() == antlr.ATNStateRuleStop {
+			result[entry.tokenListIndex] = true
+			continue
+		}
+
+		atCaret := entry.tokenListIndex >= len(c.tokens)-1
+		currentSymbol := c.tokens[entry.tokenListIndex].GetTokenType()
+		for _, transition := range entry.state.GetTransitions() {
+			switch t := transition.(type) {
+			case *antlr.RuleTransition:
+				endStatus := c.processRule(t.GetTarget().(*antlr.RuleStartState), entry.tokenListIndex, callStack)
+				for position := range endStatus {
+					pipeline = append(pipeline, pipelineEntry{state: t.GetFollowState(), tokenListIndex: position})
+				}
+			case *antlr.PredicateTransition:
+				if c.checkPredicate(t) {
+					pipeline = append(pipeline, pipelineEntry{state: t.GetTarget(), tokenListIndex: entry.tokenListIndex})
+				}
+			case *antlr.WildcardTransition:
+				if atCaret {
+					if !c.translateStackToRuleIndex(callStack) {
+						for _, symbol := range c.allTokens().ToList() {
+							c.addToken(symbol, nil)
+						}
+					}
+				} else {
+					pipeline = append(pipeline, pipelineEntry{state: t.GetTarget(), tokenListIndex: entry.tokenListIndex + 1})
+				}
+			default:
+				if transition.GetIsEpsilon() {
+					pipeline = append(pipeline, pipelineEntry{state: transition.GetTarget(), tokenListIndex: entry.tokenListIndex})
+					continue
+				}
+				label := c.label(transition)
+				if label == nil {
+					continue
+				}
+				if !atCaret {
+					if label.Contains(currentSymbol) {
+						pipeline = append(pipeline, pipelineEntry{state: transition.GetTarget(), tokenListIndex: entry.tokenListIndex + 1})
+					}
+					continue
+				}
+				if c.translateStackToRuleIndex(callStack) {
+					continue
+				}
+				list := label.ToList()
+				var following []int
+				if len(list) == 1 {
+					following = c.followingTokens(transition)
+				}
+				for _, symbol := range list {
+					c.addToken(symbol, following)
+				}
+			}
+		}
+	}
+
+	positionMap[tokenListIndex] = result
+	return result
+}
+
+func (c *CodeCompletionCore) addToken(symbol int, following []int) {
+	if c.IgnoredTokens[symbol] || symbol == antlr.TokenEOF {
+		return
+	}
+	existing, ok := c.candidates.Tokens[symbol]
+	switch {
+	case !ok:
+		c.candidates.Tokens[symbol] = following
+	case !slices.Equal(existing, following):
+		// Different paths disagree on what follows the token.
+		c.candidates.Tokens[symbol] = nil
+	}
+}
+
+// label returns the token types a non-epsilon transition matches.
+func (c *CodeCompletionCore) label(transition antlr.Transition) *antlr.IntervalSet {
+	label := transition.GetLabel()
+	if label == nil || label.Length() == 0 {
+		return nil
+	}
+	if transition.GetSerializationType() == antlr.TransitionNOTSET {
+		return label.Complement(antlr.TokenMinUserTokenType, c.atn.GetMaxTokenType())
+	}
+	return label
+}
+
+func (c *CodeCompletionCore) allTokens() *antlr.IntervalSet {
+	set := antlr.NewIntervalSet()
+	set.AddRange(antlr.TokenMinUserTokenType, c.atn.GetMaxTokenType())
+	return set
+}
+
+// checkPredicate evaluates a semantic predicate without a rule context. The
+// grammars in this module only use predicates that depend on parser state,
+// such as the engine.
+func (c *CodeCompletionCore) checkPredicate(transition *antlr.PredicateTransition) bool {
+	return transition.GetPredicate().Evaluate(c.parser, nil)
+}
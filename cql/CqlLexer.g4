@@ -38,10 +38,12 @@ OPERATOR_GTE : '>=';
 
 K_ADD            : 'ADD';
 K_AGGREGATE      : 'AGGREGATE';
+K_AGGREGATES     : 'AGGREGATES';
 K_ALL            : 'ALL';
 K_ALLOW          : 'ALLOW';
 K_ALTER          : 'ALTER';
 K_AND            : 'AND';
+K_ANN            : 'ANN';
 K_ANY            : 'ANY';
 K_APPLY          : 'APPLY';
 K_AS             : 'AS';
@@ -51,7 +53,9 @@ K_BATCH          : 'BATCH';
 K_BEGIN          : 'BEGIN';
 K_BY             : 'BY';
 K_CALLED         : 'CALLED';
+K_CAST           : 'CAST';
 K_CLUSTERING     : 'CLUSTERING';
+K_CLUSTER        : 'CLUSTER';
 K_COLUMNFAMILY   : 'COLUMNFAMILY';
 K_COMPACT        : 'COMPACT';
 K_CONSISTENCY    : 'CONSISTENCY';
@@ -76,6 +80,7 @@ K_FULL           : 'FULL';
 K_FUNCTION       : 'FUNCTION';
 K_FUNCTIONS      : 'FUNCTIONS';
 K_GRANT          : 'GRANT';
+K_GROUP          : 'GROUP';
 K_IF             : 'IF';
 K_IN             : 'IN';
 K_INDEX          : 'INDEX';
@@ -84,6 +89,7 @@ K_INITCOND       : 'INITCOND';
 K_INPUT          : 'INPUT';
 K_INSERT         : 'INSERT';
 K_INTO           : 'INTO';
+K_INTERNALS      : 'INTERNALS';
 K_IS             : 'IS';
 K_JSON           : 'JSON';
 K_KEY            : 'KEY';
@@ -107,6 +113,7 @@ K_NULL           : 'NULL';
 K_OF             : 'OF';
 K_ON             : 'ON';
 K_ONE            : 'ONE';
+K_ONLY           : 'ONLY';
 K_OPTIONS        : 'OPTIONS';
 K_OR             : 'OR';
 K_ORDER          : 'ORDER';
@@ -133,6 +140,7 @@ K_STORAGE        : 'STORAGE';
 K_STYPE          : 'STYPE';
 K_SUPERUSER      : 'SUPERUSER';
 K_TABLE          : 'TABLE';
+K_TABLES         : 'TABLES';
 K_THREE          : 'THREE';
 K_TIMESTAMP      : 'TIMESTAMP';
 K_TO             : 'TO';
@@ -143,6 +151,7 @@ K_TRUNCATE       : 'TRUNCATE';
 K_TTL            : 'TTL';
 K_TWO            : 'TWO';
 K_TYPE           : 'TYPE';
+K_TYPES          : 'TYPES';
 K_UNLOGGED       : 'UNLOGGED';
 K_UPDATE         : 'UPDATE';
 K_USE            : 'USE';
@@ -162,6 +171,7 @@ K_COUNTER        : 'COUNTER';
 K_DATE           : 'DATE';
 K_DECIMAL        : 'DECIMAL';
 K_DOUBLE         : 'DOUBLE';
+K_DURATION       : 'DURATION';
 K_FLOAT          : 'FLOAT';
 K_FROZEN         : 'FROZEN';
 K_INET           : 'INET';
@@ -176,6 +186,7 @@ K_TINYINT        : 'TINYINT';
 K_TUPLE          : 'TUPLE';
 K_VARCHAR        : 'VARCHAR';
 K_VARINT         : 'VARINT';
+K_VECTOR         : 'VECTOR';
 
 // Literals
 
@@ -183,9 +194,11 @@ CODE_BLOCK: '$$' (~ '$' | '$' ~'$')* '$$';
 
 STRING_LITERAL: '\'' ('\\' . | '\'\'' | ~('\'' | '\\'))* '\'';
 
+DURATION_LITERAL: (DEC_DIGIT+ DURATION_UNIT)+;
+
 DECIMAL_LITERAL: DEC_DIGIT+;
 
-FLOAT_LITERAL: MINUS? [0-9]+ (DOT [0-9]+)?;
+FLOAT_LITERAL: [0-9]+ (DOT [0-9]+)?;
 
 HEXADECIMAL_LITERAL: 'X' '\'' (HEX_DIGIT HEX_DIGIT)+ '\'' | '0X' HEX_DIGIT+;
 
@@ -214,4 +227,6 @@ fragment HEX_DIGIT: [0-9A-F];
 
 fragment DEC_DIGIT: [0-9];
 
+fragment DURATION_UNIT: 'Y' | 'MO' | 'W' | 'D' | 'H' | 'M' | 'S' | 'MS' | 'US' | '\u00B5S' | 'NS';
+
 fragment EXPONENT_NUM_PART: 'E' '-'? DEC_DIGIT+;
\ No newline at end of file
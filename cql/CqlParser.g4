@@ -69,6 +69,7 @@ cql
     | createType
     | createUser
     | delete_
+    | describe
     | dropAggregate
     | dropFunction
     | dropIndex
@@ -106,6 +107,29 @@ grant
     : kwGrant priviledge kwOn resource kwTo role
     ;
 
+// DESCRIBE is executed server-side since Cassandra 4.0.
+describe
+    : (kwDescribe | kwDesc) describeTarget
+    ;
+
+describeTarget
+    : kwCluster
+    | kwFull? kwSchema (kwWith kwInternals)?
+    | kwKeyspaces
+    | kwOnly? kwKeyspace keyspace? (kwWith kwInternals)?
+    | kwTables
+    | kwTable (keyspace DOT)? table (kwWith kwInternals)?
+    | kwIndex (keyspace DOT)? indexName
+    | kwMaterialized kwView (keyspace DOT)? materializedView
+    | kwTypes
+    | kwType (keyspace DOT)? type_
+    | kwFunctions
+    | kwFunction (keyspace DOT)? function_
+    | kwAggregates
+    | kwAggregate (keyspace DOT)? aggregate
+    | (keyspace DOT)? OBJECT_NAME (kwWith kwInternals)?
+    ;
+
 priviledge
     : (kwAll | kwAllPermissions)
     | kwAlter
@@ -245,7 +269,7 @@ orReplace
     ;
 
 alterUser
-    : kwAlter kwUser user kwWith userPassword userSuperUser?
+    : kwAlter kwUser ifExist? user kwWith userPassword userSuperUser?
     ;
 
 userPassword
@@ -258,7 +282,7 @@ userSuperUser
     ;
 
 alterType
-    : kwAlter kwType (keyspace DOT)? type_ alterTypeOperation
+    : kwAlter kwType ifExist? (keyspace DOT)? type_ alterTypeOperation
     ;
 
 alterTypeOperation
@@ -268,7 +292,7 @@ alterTypeOperation
     ;
 
 alterTypeRename
-    : kwRename alterTypeRenameList
+    : kwRename ifExist? alterTypeRenameList
     ;
 
 alterTypeRenameList
@@ -280,7 +304,7 @@ alterTypeRenameItem
     ;
 
 alterTypeAdd
-    : kwAdd column dataType (syntaxComma column dataType)*
+    : kwAdd ifNotExist? column dataType (syntaxComma column dataType)*
     ;
 
 alterTypeAlterType
@@ -288,7 +312,7 @@ alterTypeAlterType
     ;
 
 alterTable
-    : kwAlter kwTable (keyspace DOT)? table alterTableOperation
+    : kwAlter kwTable ifExist? (keyspace DOT)? table alterTableOperation
     ;
 
 alterTableOperation
@@ -304,7 +328,7 @@ alterTableWith
     ;
 
 alterTableRename
-    : kwRename column kwTo column
+    : kwRename ifExist? column kwTo column (kwAnd column kwTo column)*
     ;
 
 alterTableDropCompactStorage
@@ -312,7 +336,7 @@ alterTableDropCompactStorage
     ;
 
 alterTableDropColumns
-    : kwDrop alterTableDropColumnList
+    : kwDrop ifExist? alterTableDropColumnList
     ;
 
 alterTableDropColumnList
@@ -320,7 +344,7 @@ alterTableDropColumnList
     ;
 
 alterTableAdd
-    : kwAdd alterTableColumnDefinition
+    : kwAdd ifNotExist? alterTableColumnDefinition
     ;
 
 alterTableColumnDefinition
@@ -328,7 +352,7 @@ alterTableColumnDefinition
     ;
 
 alterRole
-    : kwAlter kwRole role roleWith?
+    : kwAlter kwRole ifExist? role roleWith?
     ;
 
 roleWith
@@ -343,7 +367,7 @@ roleWithOptions
     ;
 
 alterMaterializedView
-    : kwAlter kwMaterialized kwView (keyspace DOT)? materializedView (kwWith tableOptions)?
+    : kwAlter kwMaterialized kwView ifExist? (keyspace DOT)? materializedView (kwWith tableOptions)?
     ;
 
 dropUser
@@ -501,7 +525,7 @@ batchType
     ;
 
 alterKeyspace
-    : kwAlter kwKeyspace keyspace kwWith kwReplication OPERATOR_EQ syntaxBracketLc replicationList syntaxBracketRc (
+    : kwAlter kwKeyspace ifExist? keyspace kwWith kwReplication OPERATOR_EQ syntaxBracketLc replicationList syntaxBracketRc (
         kwAnd durableWrites
     )?
     ;
@@ -528,7 +552,13 @@ truncate
     ;
 
 createIndex
-    : kwCreate kwIndex ifNotExist? indexName? kwOn (keyspace DOT)? table syntaxBracketLr indexColumnSpec syntaxBracketRr
+    : kwCreate kwCustom? kwIndex ifNotExist? indexName? kwOn (keyspace DOT)? table syntaxBracketLr indexColumnSpec syntaxBracketRr indexUsing?
+    ;
+
+// CREATE CUSTOM INDEX ... USING 'org.apache.cassandra.index.sai.StorageAttachedIndex'
+// CREATE INDEX ... USING 'sai' WITH OPTIONS = {'case_sensitive': 'false'}
+indexUsing
+    : kwUsing stringLiteral (kwWith kwOptions OPERATOR_EQ optionHash)?
     ;
 
 indexName
@@ -680,7 +710,7 @@ expression
     ;
 
 select_
-    : kwSelect distinctSpec? kwJson? selectElements fromSpec whereSpec? orderSpec? limitSpec? allowFilteringSpec?
+    : kwSelect distinctSpec? kwJson? selectElements fromSpec whereSpec? groupSpec? orderSpec? perPartitionLimitSpec? limitSpec? allowFilteringSpec?
     ;
 
 allowFilteringSpec
@@ -691,6 +721,19 @@ limitSpec
     : kwLimit decimalLiteral
     ;
 
+perPartitionLimitSpec
+    : kwPer kwPartition kwLimit decimalLiteral
+    ;
+
+groupSpec
+    : kwGroup kwBy groupSpecElement (syntaxComma groupSpecElement)*
+    ;
+
+groupSpecElement
+    : column
+    | functionCall
+    ;
+
 fromSpec
     : kwFrom fromSpecElement
     ;
@@ -701,11 +744,12 @@ fromSpecElement
     ;
 
 orderSpec
-    : kwOrder kwBy orderSpecElement
+    : kwOrder kwBy orderSpecElement (syntaxComma orderSpecElement)*
     ;
 
 orderSpecElement
-    : OBJECT_NAME (kwAsc | kwDesc)?
+    : column (kwAsc | kwDesc)?
+    | column kwAnn kwOf (assignmentList | constant)
     ;
 
 whereSpec
@@ -722,8 +766,34 @@ selectElements
 
 selectElement
     : OBJECT_NAME '.' '*'
-    | OBJECT_NAME (kwAs OBJECT_NAME)?
-    | functionCall (kwAs OBJECT_NAME)?
+    | column (kwAs column)?
+    | functionCall (kwAs column)?
+    | castFunction (kwAs column)?
+    | arithmeticExpression (kwAs column)?
+    ;
+
+castFunction
+    : kwCast syntaxBracketLr (OBJECT_NAME | functionCall | constant) kwAs dataType syntaxBracketRr
+    ;
+
+arithmeticExpression
+    : arithmeticOperand (arithmeticOperator arithmeticOperand)+
+    ;
+
+arithmeticOperand
+    : OBJECT_NAME
+    | functionCall
+    | castFunction
+    | constant
+    | syntaxBracketLr arithmeticExpression syntaxBracketRr
+    ;
+
+arithmeticOperator
+    : PLUS
+    | MINUS
+    | STAR
+    | DIVIDE
+    | MODULE
     ;
 
 relationElements
@@ -731,8 +801,8 @@ relationElements
     ;
 
 relationElement
-    : OBJECT_NAME (OPERATOR_EQ | OPERATOR_LT | OPERATOR_GT | OPERATOR_LTE | OPERATOR_GTE) constant
-    | OBJECT_NAME '.' OBJECT_NAME (
+    : column (OPERATOR_EQ | OPERATOR_LT | OPERATOR_GT | OPERATOR_LTE | OPERATOR_GTE) constant
+    | table '.' column (
         OPERATOR_EQ
         | OPERATOR_LT
         | OPERATOR_GT
@@ -741,11 +811,11 @@ relationElement
     ) constant
     | functionCall (OPERATOR_EQ | OPERATOR_LT | OPERATOR_GT | OPERATOR_LTE | OPERATOR_GTE) constant
     | functionCall (OPERATOR_EQ | OPERATOR_LT | OPERATOR_GT | OPERATOR_LTE | OPERATOR_GTE) functionCall
-    | OBJECT_NAME kwIn '(' functionArgs? ')'
-    | '(' OBJECT_NAME (syntaxComma OBJECT_NAME)* ')' kwIn '(' assignmentTuple (
+    | column kwIn '(' functionArgs? ')'
+    | '(' column (syntaxComma column)* ')' kwIn '(' assignmentTuple (
         syntaxComma assignmentTuple
     )* ')'
-    | '(' OBJECT_NAME (syntaxComma OBJECT_NAME)* ')' (
+    | '(' column (syntaxComma column)* ')' (
         OPERATOR_EQ
         | OPERATOR_LT
         | OPERATOR_GT
@@ -757,11 +827,11 @@ relationElement
     ;
 
 relalationContains
-    : OBJECT_NAME kwContains constant
+    : column kwContains constant
     ;
 
 relalationContainsKey
-    : OBJECT_NAME (kwContains kwKey) constant
+    : column (kwContains kwKey) constant
     ;
 
 functionCall
@@ -781,6 +851,7 @@ constant
     | floatLiteral
     | hexadecimalLiteral
     | booleanLiteral
+    | durationLiteral
     | codeBlock
     | kwNull
     ;
@@ -790,14 +861,18 @@ decimalLiteral
     ;
 
 floatLiteral
-    : DECIMAL_LITERAL
-    | FLOAT_LITERAL
+    : MINUS? DECIMAL_LITERAL
+    | MINUS? FLOAT_LITERAL
     ;
 
 stringLiteral
     : STRING_LITERAL
     ;
 
+durationLiteral
+    : DURATION_LITERAL
+    ;
+
 booleanLiteral
     : K_TRUE
     | K_FALSE
@@ -810,20 +885,28 @@ hexadecimalLiteral
 keyspace
     : OBJECT_NAME
     | DQUOTE OBJECT_NAME DQUOTE
+    | unreservedKeyword
     ;
 
 table
     : OBJECT_NAME
     | DQUOTE OBJECT_NAME DQUOTE
+    | unreservedKeyword
     ;
 
 column
     : OBJECT_NAME
     | DQUOTE OBJECT_NAME DQUOTE
+    | unreservedKeyword
     ;
 
 dataType
     : dataTypeName dataTypeDefinition?
+    | vectorType
+    ;
+
+vectorType
+    : kwVector syntaxBracketLa dataType syntaxComma decimalLiteral syntaxBracketRa
     ;
 
 dataTypeName
@@ -838,6 +921,7 @@ dataTypeName
     | K_DATE
     | K_DECIMAL
     | K_DOUBLE
+    | K_DURATION
     | K_FLOAT
     | K_FROZEN
     | K_INET
@@ -860,6 +944,21 @@ dataTypeDefinition
     : syntaxBracketLa dataTypeName (syntaxComma dataTypeName)* syntaxBracketRa
     ;
 
+// Keywords added after CQL 3.0 that Cassandra still accepts as plain identifiers.
+unreservedKeyword
+    : K_AGGREGATES
+    | K_ANN
+    | K_CAST
+    | K_CLUSTER
+    | K_DURATION
+    | K_GROUP
+    | K_INTERNALS
+    | K_ONLY
+    | K_TABLES
+    | K_TYPES
+    | K_VECTOR
+    ;
+
 orderDirection
     : kwAsc
     | kwDesc
@@ -926,6 +1025,10 @@ kwAggregate
     : K_AGGREGATE
     ;
 
+kwAggregates
+    : K_AGGREGATES
+    ;
+
 kwAll
     : K_ALL
     ;
@@ -946,6 +1049,10 @@ kwAnd
     : K_AND
     ;
 
+kwAnn
+    : K_ANN
+    ;
+
 kwApply
     : K_APPLY
     ;
@@ -978,6 +1085,14 @@ kwCalled
     : K_CALLED
     ;
 
+kwCast
+    : K_CAST
+    ;
+
+kwCluster
+    : K_CLUSTER
+    ;
+
 kwClustering
     : K_CLUSTERING
     ;
@@ -994,6 +1109,10 @@ kwCreate
     : K_CREATE
     ;
 
+kwCustom
+    : K_CUSTOM
+    ;
+
 kwDelete
     : K_DELETE
     ;
@@ -1006,6 +1125,10 @@ kwDescibe
     : K_DESCRIBE
     ;
 
+kwDescribe
+    : K_DESCRIBE
+    ;
+
 kwDistinct
     : K_DISTINCT
     ;
@@ -1058,6 +1181,10 @@ kwGrant
     : K_GRANT
     ;
 
+kwGroup
+    : K_GROUP
+    ;
+
 kwIf
     : K_IF
     ;
@@ -1082,6 +1209,10 @@ kwInsert
     : K_INSERT
     ;
 
+kwInternals
+    : K_INTERNALS
+    ;
+
 kwInto
     : K_INTO
     ;
@@ -1162,6 +1293,10 @@ kwOn
     : K_ON
     ;
 
+kwOnly
+    : K_ONLY
+    ;
+
 kwOptions
     : K_OPTIONS
     ;
@@ -1174,10 +1309,18 @@ kwOrder
     : K_ORDER
     ;
 
+kwPartition
+    : K_PARTITION
+    ;
+
 kwPassword
     : K_PASSWORD
     ;
 
+kwPer
+    : K_PER
+    ;
+
 kwPrimary
     : K_PRIMARY
     ;
@@ -1206,6 +1349,10 @@ kwRoles
     : K_ROLES
     ;
 
+kwSchema
+    : K_SCHEMA
+    ;
+
 kwSelect
     : K_SELECT
     ;
@@ -1234,6 +1381,10 @@ kwTable
     : K_TABLE
     ;
 
+kwTables
+    : K_TABLES
+    ;
+
 kwTimestamp
     : K_TIMESTAMP
     ;
@@ -1258,6 +1409,10 @@ kwType
     : K_TYPE
     ;
 
+kwTypes
+    : K_TYPES
+    ;
+
 kwUnlogged
     : K_UNLOGGED
     ;
@@ -1282,6 +1437,10 @@ kwValues
     : K_VALUES
     ;
 
+kwVector
+    : K_VECTOR
+    ;
+
 kwView
     : K_VIEW
     ;
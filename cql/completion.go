@@ -0,0 +1,47 @@
+package cql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/completion"
+)
+
+// completionRules are the name rules reported instead of their tokens, so
+// that callers can offer keyspace, table and column names from a catalog.
+var completionRules = []int{
+	CqlParserRULE_keyspace,
+	CqlParserRULE_table,
+	CqlParserRULE_column,
+	CqlParserRULE_fromSpecElement,
+	CqlParserRULE_indexName,
+	CqlParserRULE_materializedView,
+	CqlParserRULE_type_,
+	CqlParserRULE_role,
+	CqlParserRULE_function_,
+	CqlParserRULE_aggregate,
+}
+
+// GetCompletionCandidates returns the keywords and name rules valid at
+// caretOffset, a rune offset into statement. The statement does not need to
+// be complete or valid after the caret.
+func GetCompletionCandidates(statement string, caretOffset int) *completion.Candidates {
+	lexer := NewCqlLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := NewCqlParser(stream)
+
+	return completion.Collect(p, stream, caretOffset, completion.Options{
+		PreferredRules: completionRules,
+		IsKeyword:      isKeywordText,
+	})
+}
+
+// isKeywordText reports whether a literal token text is a keyword. All
+// literal tokens made of letters are K_ keywords in the CQL lexer.
+func isKeywordText(text string) bool {
+	for _, r := range text {
+		if (r < 'A' || r > 'Z') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
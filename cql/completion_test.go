@@ -0,0 +1,70 @@
+package cql_test
+
+import (
+	"testing"
+
+	cqlparser "github.com/bytebase/parser/cql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCompletionCandidates(t *testing.T) {
+	tests := []struct {
+		statement    string
+		wantKeywords []string
+		wantRules    []string
+	}{
+		{
+			statement:    "SEL",
+			wantKeywords: []string{"SELECT", "INSERT", "CREATE"},
+		},
+		{
+			statement:    "SELECT * ",
+			wantKeywords: []string{"FROM"},
+		},
+		{
+			statement: "SELECT * FROM ",
+			wantRules: []string{"fromSpecElement"},
+		},
+		{
+			statement:    "SELECT a FROM t ORDER ",
+			wantKeywords: []string{"BY"},
+		},
+		{
+			statement:    "CREATE ",
+			wantKeywords: []string{"TABLE", "KEYSPACE", "INDEX"},
+		},
+		{
+			statement: "INSERT INTO t (",
+			wantRules: []string{"column"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			candidates := cqlparser.GetCompletionCandidates(tt.statement, len(tt.statement))
+			for _, keyword := range tt.wantKeywords {
+				require.Contains(t, candidates.Keywords, keyword)
+			}
+			var rules []string
+			for _, rule := range candidates.Rules {
+				rules = append(rules, rule.Name)
+			}
+			for _, rule := range tt.wantRules {
+				require.Contains(t, rules, rule)
+			}
+		})
+	}
+}
+
+func TestGetCompletionCandidatesRuleStack(t *testing.T) {
+	statement := "INSERT INTO t (a, "
+	candidates := cqlparser.GetCompletionCandidates(statement, len(statement))
+	require.Len(t, candidates.Rules, 1)
+	require.Equal(t, "column", candidates.Rules[0].Name)
+	require.Equal(t, []string{"root", "cqls", "cql", "insert", "insertColumnSpec", "columnList"}, candidates.Rules[0].RuleStack)
+
+	// A caret inside the first word completes that word.
+	candidates = cqlparser.GetCompletionCandidates("SELECT * FROM t", 3)
+	require.Contains(t, candidates.Keywords, "SELECT")
+	require.NotContains(t, candidates.Keywords, "FROM")
+}
@@ -45,96 +45,102 @@ func cqllexerLexerInit() {
 	staticData.LiteralNames = []string{
 		"", "'('", "')'", "'{'", "'}'", "'['", "']'", "','", "';'", "':'", "'.'",
 		"'*'", "'/'", "'%'", "'+'", "'--'", "'-'", "'\"'", "'''", "'='", "'<'",
-		"'>'", "'<='", "'>='", "'ADD'", "'AGGREGATE'", "'ALL'", "'ALLOW'", "'ALTER'",
-		"'AND'", "'ANY'", "'APPLY'", "'AS'", "'ASC'", "'AUTHORIZE'", "'BATCH'",
-		"'BEGIN'", "'BY'", "'CALLED'", "'CLUSTERING'", "'COLUMNFAMILY'", "'COMPACT'",
-		"'CONSISTENCY'", "'CONTAINS'", "'CREATE'", "'CUSTOM'", "'DELETE'", "'DESC'",
-		"'DESCRIBE'", "'DISTINCT'", "'DROP'", "'DURABLE_WRITES'", "'EACH_QUORUM'",
-		"'ENTRIES'", "'EXECUTE'", "'EXISTS'", "'FALSE'", "'FILTERING'", "'FINALFUNC'",
-		"'FROM'", "'FULL'", "'FUNCTION'", "'FUNCTIONS'", "'GRANT'", "'IF'",
+		"'>'", "'<='", "'>='", "'ADD'", "'AGGREGATE'", "'AGGREGATES'", "'ALL'",
+		"'ALLOW'", "'ALTER'", "'AND'", "'ANN'", "'ANY'", "'APPLY'", "'AS'",
+		"'ASC'", "'AUTHORIZE'", "'BATCH'", "'BEGIN'", "'BY'", "'CALLED'", "'CAST'",
+		"'CLUSTERING'", "'CLUSTER'", "'COLUMNFAMILY'", "'COMPACT'", "'CONSISTENCY'",
+		"'CONTAINS'", "'CREATE'", "'CUSTOM'", "'DELETE'", "'DESC'", "'DESCRIBE'",
+		"'DISTINCT'", "'DROP'", "'DURABLE_WRITES'", "'EACH_QUORUM'", "'ENTRIES'",
+		"'EXECUTE'", "'EXISTS'", "'FALSE'", "'FILTERING'", "'FINALFUNC'", "'FROM'",
+		"'FULL'", "'FUNCTION'", "'FUNCTIONS'", "'GRANT'", "'GROUP'", "'IF'",
 		"'IN'", "'INDEX'", "'INFINITY'", "'INITCOND'", "'INPUT'", "'INSERT'",
-		"'INTO'", "'IS'", "'JSON'", "'KEY'", "'KEYS'", "'KEYSPACE'", "'KEYSPACES'",
-		"'LANGUAGE'", "'LEVEL'", "'LIMIT'", "'LOCAL_ONE'", "'LOCAL_QUORUM'",
+		"'INTO'", "'INTERNALS'", "'IS'", "'JSON'", "'KEY'", "'KEYS'", "'KEYSPACE'",
+		"'KEYSPACES'", "'LANGUAGE'", "'LEVEL'", "'LIMIT'", "'LOCAL_ONE'", "'LOCAL_QUORUM'",
 		"'LOGGED'", "'LOGIN'", "'MATERIALIZED'", "'MODIFY'", "'NAN'", "'NORECURSIVE'",
-		"'NOSUPERUSER'", "'NOT'", "'NULL'", "'OF'", "'ON'", "'ONE'", "'OPTIONS'",
-		"'OR'", "'ORDER'", "'PARTITION'", "'PASSWORD'", "'PER'", "'PERMISSION'",
-		"'PERMISSIONS'", "'PRIMARY'", "'QUORUM'", "'RENAME'", "'REPLACE'", "'REPLICATION'",
-		"'RETURNS'", "'REVOKE'", "'ROLE'", "'ROLES'", "'SCHEMA'", "'SELECT'",
-		"'SET'", "'SFUNC'", "'STATIC'", "'STORAGE'", "'STYPE'", "'SUPERUSER'",
-		"'TABLE'", "'THREE'", "'TIMESTAMP'", "'TO'", "'TOKEN'", "'TRIGGER'",
-		"'TRUE'", "'TRUNCATE'", "'TTL'", "'TWO'", "'TYPE'", "'UNLOGGED'", "'UPDATE'",
-		"'USE'", "'USER'", "'USING'", "'UUID'", "'VALUES'", "'VIEW'", "'WHERE'",
-		"'WITH'", "'WRITETIME'", "'ASCII'", "'BIGINT'", "'BLOB'", "'BOOLEAN'",
-		"'COUNTER'", "'DATE'", "'DECIMAL'", "'DOUBLE'", "'FLOAT'", "'FROZEN'",
-		"'INET'", "'INT'", "'LIST'", "'MAP'", "'SMALLINT'", "'TEXT'", "'TIMEUUID'",
-		"'TIME'", "'TINYINT'", "'TUPLE'", "'VARCHAR'", "'VARINT'",
+		"'NOSUPERUSER'", "'NOT'", "'NULL'", "'OF'", "'ON'", "'ONE'", "'ONLY'",
+		"'OPTIONS'", "'OR'", "'ORDER'", "'PARTITION'", "'PASSWORD'", "'PER'",
+		"'PERMISSION'", "'PERMISSIONS'", "'PRIMARY'", "'QUORUM'", "'RENAME'",
+		"'REPLACE'", "'REPLICATION'", "'RETURNS'", "'REVOKE'", "'ROLE'", "'ROLES'",
+		"'SCHEMA'", "'SELECT'", "'SET'", "'SFUNC'", "'STATIC'", "'STORAGE'",
+		"'STYPE'", "'SUPERUSER'", "'TABLE'", "'TABLES'", "'THREE'", "'TIMESTAMP'",
+		"'TO'", "'TOKEN'", "'TRIGGER'", "'TRUE'", "'TRUNCATE'", "'TTL'", "'TWO'",
+		"'TYPE'", "'TYPES'", "'UNLOGGED'", "'UPDATE'", "'USE'", "'USER'", "'USING'",
+		"'UUID'", "'VALUES'", "'VIEW'", "'WHERE'", "'WITH'", "'WRITETIME'",
+		"'ASCII'", "'BIGINT'", "'BLOB'", "'BOOLEAN'", "'COUNTER'", "'DATE'",
+		"'DECIMAL'", "'DOUBLE'", "'DURATION'", "'FLOAT'", "'FROZEN'", "'INET'",
+		"'INT'", "'LIST'", "'MAP'", "'SMALLINT'", "'TEXT'", "'TIMEUUID'", "'TIME'",
+		"'TINYINT'", "'TUPLE'", "'VARCHAR'", "'VARINT'", "'VECTOR'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "LR_BRACKET", "RR_BRACKET", "LC_BRACKET", "RC_BRACKET", "LS_BRACKET",
 		"RS_BRACKET", "COMMA", "SEMI", "COLON", "DOT", "STAR", "DIVIDE", "MODULE",
 		"PLUS", "MINUSMINUS", "MINUS", "DQUOTE", "SQUOTE", "OPERATOR_EQ", "OPERATOR_LT",
 		"OPERATOR_GT", "OPERATOR_LTE", "OPERATOR_GTE", "K_ADD", "K_AGGREGATE",
-		"K_ALL", "K_ALLOW", "K_ALTER", "K_AND", "K_ANY", "K_APPLY", "K_AS",
-		"K_ASC", "K_AUTHORIZE", "K_BATCH", "K_BEGIN", "K_BY", "K_CALLED", "K_CLUSTERING",
-		"K_COLUMNFAMILY", "K_COMPACT", "K_CONSISTENCY", "K_CONTAINS", "K_CREATE",
-		"K_CUSTOM", "K_DELETE", "K_DESC", "K_DESCRIBE", "K_DISTINCT", "K_DROP",
-		"K_DURABLE_WRITES", "K_EACH_QUORUM", "K_ENTRIES", "K_EXECUTE", "K_EXISTS",
-		"K_FALSE", "K_FILTERING", "K_FINALFUNC", "K_FROM", "K_FULL", "K_FUNCTION",
-		"K_FUNCTIONS", "K_GRANT", "K_IF", "K_IN", "K_INDEX", "K_INFINITY", "K_INITCOND",
-		"K_INPUT", "K_INSERT", "K_INTO", "K_IS", "K_JSON", "K_KEY", "K_KEYS",
+		"K_AGGREGATES", "K_ALL", "K_ALLOW", "K_ALTER", "K_AND", "K_ANN", "K_ANY",
+		"K_APPLY", "K_AS", "K_ASC", "K_AUTHORIZE", "K_BATCH", "K_BEGIN", "K_BY",
+		"K_CALLED", "K_CAST", "K_CLUSTERING", "K_CLUSTER", "K_COLUMNFAMILY",
+		"K_COMPACT", "K_CONSISTENCY", "K_CONTAINS", "K_CREATE", "K_CUSTOM",
+		"K_DELETE", "K_DESC", "K_DESCRIBE", "K_DISTINCT", "K_DROP", "K_DURABLE_WRITES",
+		"K_EACH_QUORUM", "K_ENTRIES", "K_EXECUTE", "K_EXISTS", "K_FALSE", "K_FILTERING",
+		"K_FINALFUNC", "K_FROM", "K_FULL", "K_FUNCTION", "K_FUNCTIONS", "K_GRANT",
+		"K_GROUP", "K_IF", "K_IN", "K_INDEX", "K_INFINITY", "K_INITCOND", "K_INPUT",
+		"K_INSERT", "K_INTO", "K_INTERNALS", "K_IS", "K_JSON", "K_KEY", "K_KEYS",
 		"K_KEYSPACE", "K_KEYSPACES", "K_LANGUAGE", "K_LEVEL", "K_LIMIT", "K_LOCAL_ONE",
 		"K_LOCAL_QUORUM", "K_LOGGED", "K_LOGIN", "K_MATERIALIZED", "K_MODIFY",
 		"K_NAN", "K_NORECURSIVE", "K_NOSUPERUSER", "K_NOT", "K_NULL", "K_OF",
-		"K_ON", "K_ONE", "K_OPTIONS", "K_OR", "K_ORDER", "K_PARTITION", "K_PASSWORD",
-		"K_PER", "K_PERMISSION", "K_PERMISSIONS", "K_PRIMARY", "K_QUORUM", "K_RENAME",
-		"K_REPLACE", "K_REPLICATION", "K_RETURNS", "K_REVOKE", "K_ROLE", "K_ROLES",
-		"K_SCHEMA", "K_SELECT", "K_SET", "K_SFUNC", "K_STATIC", "K_STORAGE",
-		"K_STYPE", "K_SUPERUSER", "K_TABLE", "K_THREE", "K_TIMESTAMP", "K_TO",
-		"K_TOKEN", "K_TRIGGER", "K_TRUE", "K_TRUNCATE", "K_TTL", "K_TWO", "K_TYPE",
-		"K_UNLOGGED", "K_UPDATE", "K_USE", "K_USER", "K_USING", "K_UUID", "K_VALUES",
-		"K_VIEW", "K_WHERE", "K_WITH", "K_WRITETIME", "K_ASCII", "K_BIGINT",
-		"K_BLOB", "K_BOOLEAN", "K_COUNTER", "K_DATE", "K_DECIMAL", "K_DOUBLE",
-		"K_FLOAT", "K_FROZEN", "K_INET", "K_INT", "K_LIST", "K_MAP", "K_SMALLINT",
-		"K_TEXT", "K_TIMEUUID", "K_TIME", "K_TINYINT", "K_TUPLE", "K_VARCHAR",
-		"K_VARINT", "CODE_BLOCK", "STRING_LITERAL", "DECIMAL_LITERAL", "FLOAT_LITERAL",
-		"HEXADECIMAL_LITERAL", "REAL_LITERAL", "OBJECT_NAME", "UUID", "SPACE",
-		"SPEC_MYSQL_COMMENT", "COMMENT_INPUT", "LINE_COMMENT",
+		"K_ON", "K_ONE", "K_ONLY", "K_OPTIONS", "K_OR", "K_ORDER", "K_PARTITION",
+		"K_PASSWORD", "K_PER", "K_PERMISSION", "K_PERMISSIONS", "K_PRIMARY",
+		"K_QUORUM", "K_RENAME", "K_REPLACE", "K_REPLICATION", "K_RETURNS", "K_REVOKE",
+		"K_ROLE", "K_ROLES", "K_SCHEMA", "K_SELECT", "K_SET", "K_SFUNC", "K_STATIC",
+		"K_STORAGE", "K_STYPE", "K_SUPERUSER", "K_TABLE", "K_TABLES", "K_THREE",
+		"K_TIMESTAMP", "K_TO", "K_TOKEN", "K_TRIGGER", "K_TRUE", "K_TRUNCATE",
+		"K_TTL", "K_TWO", "K_TYPE", "K_TYPES", "K_UNLOGGED", "K_UPDATE", "K_USE",
+		"K_USER", "K_USING", "K_UUID", "K_VALUES", "K_VIEW", "K_WHERE", "K_WITH",
+		"K_WRITETIME", "K_ASCII", "K_BIGINT", "K_BLOB", "K_BOOLEAN", "K_COUNTER",
+		"K_DATE", "K_DECIMAL", "K_DOUBLE", "K_DURATION", "K_FLOAT", "K_FROZEN",
+		"K_INET", "K_INT", "K_LIST", "K_MAP", "K_SMALLINT", "K_TEXT", "K_TIMEUUID",
+		"K_TIME", "K_TINYINT", "K_TUPLE", "K_VARCHAR", "K_VARINT", "K_VECTOR",
+		"CODE_BLOCK", "STRING_LITERAL", "DURATION_LITERAL", "DECIMAL_LITERAL",
+		"FLOAT_LITERAL", "HEXADECIMAL_LITERAL", "REAL_LITERAL", "OBJECT_NAME",
+		"UUID", "SPACE", "SPEC_MYSQL_COMMENT", "COMMENT_INPUT", "LINE_COMMENT",
 	}
 	staticData.RuleNames = []string{
 		"LR_BRACKET", "RR_BRACKET", "LC_BRACKET", "RC_BRACKET", "LS_BRACKET",
 		"RS_BRACKET", "COMMA", "SEMI", "COLON", "DOT", "STAR", "DIVIDE", "MODULE",
 		"PLUS", "MINUSMINUS", "MINUS", "DQUOTE", "SQUOTE", "OPERATOR_EQ", "OPERATOR_LT",
 		"OPERATOR_GT", "OPERATOR_LTE", "OPERATOR_GTE", "K_ADD", "K_AGGREGATE",
-		"K_ALL", "K_ALLOW", "K_ALTER", "K_AND", "K_ANY", "K_APPLY", "K_AS",
-		"K_ASC", "K_AUTHORIZE", "K_BATCH", "K_BEGIN", "K_BY", "K_CALLED", "K_CLUSTERING",
-		"K_COLUMNFAMILY", "K_COMPACT", "K_CONSISTENCY", "K_CONTAINS", "K_CREATE",
-		"K_CUSTOM", "K_DELETE", "K_DESC", "K_DESCRIBE", "K_DISTINCT", "K_DROP",
-		"K_DURABLE_WRITES", "K_EACH_QUORUM", "K_ENTRIES", "K_EXECUTE", "K_EXISTS",
-		"K_FALSE", "K_FILTERING", "K_FINALFUNC", "K_FROM", "K_FULL", "K_FUNCTION",
-		"K_FUNCTIONS", "K_GRANT", "K_IF", "K_IN", "K_INDEX", "K_INFINITY", "K_INITCOND",
-		"K_INPUT", "K_INSERT", "K_INTO", "K_IS", "K_JSON", "K_KEY", "K_KEYS",
+		"K_AGGREGATES", "K_ALL", "K_ALLOW", "K_ALTER", "K_AND", "K_ANN", "K_ANY",
+		"K_APPLY", "K_AS", "K_ASC", "K_AUTHORIZE", "K_BATCH", "K_BEGIN", "K_BY",
+		"K_CALLED", "K_CAST", "K_CLUSTERING", "K_CLUSTER", "K_COLUMNFAMILY",
+		"K_COMPACT", "K_CONSISTENCY", "K_CONTAINS", "K_CREATE", "K_CUSTOM",
+		"K_DELETE", "K_DESC", "K_DESCRIBE", "K_DISTINCT", "K_DROP", "K_DURABLE_WRITES",
+		"K_EACH_QUORUM", "K_ENTRIES", "K_EXECUTE", "K_EXISTS", "K_FALSE", "K_FILTERING",
+		"K_FINALFUNC", "K_FROM", "K_FULL", "K_FUNCTION", "K_FUNCTIONS", "K_GRANT",
+		"K_GROUP", "K_IF", "K_IN", "K_INDEX", "K_INFINITY", "K_INITCOND", "K_INPUT",
+		"K_INSERT", "K_INTO", "K_INTERNALS", "K_IS", "K_JSON", "K_KEY", "K_KEYS",
 		"K_KEYSPACE", "K_KEYSPACES", "K_LANGUAGE", "K_LEVEL", "K_LIMIT", "K_LOCAL_ONE",
 		"K_LOCAL_QUORUM", "K_LOGGED", "K_LOGIN", "K_MATERIALIZED", "K_MODIFY",
 		"K_NAN", "K_NORECURSIVE", "K_NOSUPERUSER", "K_NOT", "K_NULL", "K_OF",
-		"K_ON", "K_ONE", "K_OPTIONS", "K_OR", "K_ORDER", "K_PARTITION", "K_PASSWORD",
-		"K_PER", "K_PERMISSION", "K_PERMISSIONS", "K_PRIMARY", "K_QUORUM", "K_RENAME",
-		"K_REPLACE", "K_REPLICATION", "K_RETURNS", "K_REVOKE", "K_ROLE", "K_ROLES",
-		"K_SCHEMA", "K_SELECT", "K_SET", "K_SFUNC", "K_STATIC", "K_STORAGE",
-		"K_STYPE", "K_SUPERUSER", "K_TABLE", "K_THREE", "K_TIMESTAMP", "K_TO",
-		"K_TOKEN", "K_TRIGGER", "K_TRUE", "K_TRUNCATE", "K_TTL", "K_TWO", "K_TYPE",
-		"K_UNLOGGED", "K_UPDATE", "K_USE", "K_USER", "K_USING", "K_UUID", "K_VALUES",
-		"K_VIEW", "K_WHERE", "K_WITH", "K_WRITETIME", "K_ASCII", "K_BIGINT",
-		"K_BLOB", "K_BOOLEAN", "K_COUNTER", "K_DATE", "K_DECIMAL", "K_DOUBLE",
-		"K_FLOAT", "K_FROZEN", "K_INET", "K_INT", "K_LIST", "K_MAP", "K_SMALLINT",
-		"K_TEXT", "K_TIMEUUID", "K_TIME", "K_TINYINT", "K_TUPLE", "K_VARCHAR",
-		"K_VARINT", "CODE_BLOCK", "STRING_LITERAL", "DECIMAL_LITERAL", "FLOAT_LITERAL",
-		"HEXADECIMAL_LITERAL", "REAL_LITERAL", "OBJECT_NAME", "UUID", "SPACE",
-		"SPEC_MYSQL_COMMENT", "COMMENT_INPUT", "LINE_COMMENT", "HEX_4DIGIT",
-		"HEX_DIGIT", "DEC_DIGIT", "EXPONENT_NUM_PART",
+		"K_ON", "K_ONE", "K_ONLY", "K_OPTIONS", "K_OR", "K_ORDER", "K_PARTITION",
+		"K_PASSWORD", "K_PER", "K_PERMISSION", "K_PERMISSIONS", "K_PRIMARY",
+		"K_QUORUM", "K_RENAME", "K_REPLACE", "K_REPLICATION", "K_RETURNS", "K_REVOKE",
+		"K_ROLE", "K_ROLES", "K_SCHEMA", "K_SELECT", "K_SET", "K_SFUNC", "K_STATIC",
+		"K_STORAGE", "K_STYPE", "K_SUPERUSER", "K_TABLE", "K_TABLES", "K_THREE",
+		"K_TIMESTAMP", "K_TO", "K_TOKEN", "K_TRIGGER", "K_TRUE", "K_TRUNCATE",
+		"K_TTL", "K_TWO", "K_TYPE", "K_TYPES", "K_UNLOGGED", "K_UPDATE", "K_USE",
+		"K_USER", "K_USING", "K_UUID", "K_VALUES", "K_VIEW", "K_WHERE", "K_WITH",
+		"K_WRITETIME", "K_ASCII", "K_BIGINT", "K_BLOB", "K_BOOLEAN", "K_COUNTER",
+		"K_DATE", "K_DECIMAL", "K_DOUBLE", "K_DURATION", "K_FLOAT", "K_FROZEN",
+		"K_INET", "K_INT", "K_LIST", "K_MAP", "K_SMALLINT", "K_TEXT", "K_TIMEUUID",
+		"K_TIME", "K_TINYINT", "K_TUPLE", "K_VARCHAR", "K_VARINT", "K_VECTOR",
+		"CODE_BLOCK", "STRING_LITERAL", "DURATION_LITERAL", "DECIMAL_LITERAL",
+		"FLOAT_LITERAL", "HEXADECIMAL_LITERAL", "REAL_LITERAL", "OBJECT_NAME",
+		"UUID", "SPACE", "SPEC_MYSQL_COMMENT", "COMMENT_INPUT", "LINE_COMMENT",
+		"HEX_4DIGIT", "HEX_DIGIT", "DEC_DIGIT", "DURATION_UNIT", "EXPONENT_NUM_PART",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 175, 1588, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
+		4, 0, 187, 1714, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
 		2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9,
 		2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2,
 		15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20,
@@ -170,684 +176,745 @@ func cqllexerLexerInit() {
 		7, 162, 2, 163, 7, 163, 2, 164, 7, 164, 2, 165, 7, 165, 2, 166, 7, 166,
 		2, 167, 7, 167, 2, 168, 7, 168, 2, 169, 7, 169, 2, 170, 7, 170, 2, 171,
 		7, 171, 2, 172, 7, 172, 2, 173, 7, 173, 2, 174, 7, 174, 2, 175, 7, 175,
-		2, 176, 7, 176, 2, 177, 7, 177, 2, 178, 7, 178, 1, 0, 1, 0, 1, 1, 1, 1,
-		1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7,
-		1, 8, 1, 8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13,
-		1, 13, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1,
-		18, 1, 18, 1, 19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22,
-		1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1,
-		24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26,
-		1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1,
-		28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30,
-		1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34,
-		1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1,
-		35, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37,
-		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
-		38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39,
-		1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
-		40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41,
-		1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1,
-		42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44,
-		1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1,
-		45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47,
-		1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
-		48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50,
-		1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1,
-		50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51,
-		1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54,
-		1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1,
-		55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56,
-		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1,
-		58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60,
-		1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1,
-		61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62,
-		1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 65, 1,
-		65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66,
-		1, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1,
-		67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69,
-		1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1,
-		71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73,
-		1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1,
-		75, 1, 75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76,
-		1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1,
-		77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79,
-		1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1,
-		80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81,
-		1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1,
-		82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84,
-		1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1,
-		85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 86,
-		1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1,
-		87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88,
-		1, 88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1,
-		90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93, 1, 93,
-		1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 95, 1,
-		95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97,
-		1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1,
-		98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99, 1, 99, 1, 100,
-		1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100,
-		1, 100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101,
-		1, 101, 1, 101, 1, 101, 1, 101, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102,
-		1, 102, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103,
-		1, 103, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105,
-		1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 106, 1, 106,
-		1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106,
-		1, 106, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107,
-		1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 109, 1, 109,
-		1, 109, 1, 109, 1, 109, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110,
-		1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 112, 1, 112,
-		1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 113,
-		1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 115, 1, 115, 1, 115,
-		1, 115, 1, 115, 1, 115, 1, 115, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116,
-		1, 116, 1, 116, 1, 116, 1, 117, 1, 117, 1, 117, 1, 117, 1, 117, 1, 117,
-		1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118,
-		1, 118, 1, 119, 1, 119, 1, 119, 1, 119, 1, 119, 1, 119, 1, 120, 1, 120,
-		1, 120, 1, 120, 1, 120, 1, 120, 1, 121, 1, 121, 1, 121, 1, 121, 1, 121,
-		1, 121, 1, 121, 1, 121, 1, 121, 1, 121, 1, 122, 1, 122, 1, 122, 1, 123,
-		1, 123, 1, 123, 1, 123, 1, 123, 1, 123, 1, 124, 1, 124, 1, 124, 1, 124,
-		1, 124, 1, 124, 1, 124, 1, 124, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125,
-		1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126,
-		1, 127, 1, 127, 1, 127, 1, 127, 1, 128, 1, 128, 1, 128, 1, 128, 1, 129,
-		1, 129, 1, 129, 1, 129, 1, 129, 1, 130, 1, 130, 1, 130, 1, 130, 1, 130,
-		1, 130, 1, 130, 1, 130, 1, 130, 1, 131, 1, 131, 1, 131, 1, 131, 1, 131,
-		1, 131, 1, 131, 1, 132, 1, 132, 1, 132, 1, 132, 1, 133, 1, 133, 1, 133,
-		1, 133, 1, 133, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 135,
-		1, 135, 1, 135, 1, 135, 1, 135, 1, 136, 1, 136, 1, 136, 1, 136, 1, 136,
-		1, 136, 1, 136, 1, 137, 1, 137, 1, 137, 1, 137, 1, 137, 1, 138, 1, 138,
-		1, 138, 1, 138, 1, 138, 1, 138, 1, 139, 1, 139, 1, 139, 1, 139, 1, 139,
-		1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140,
-		1, 140, 1, 141, 1, 141, 1, 141, 1, 141, 1, 141, 1, 141, 1, 142, 1, 142,
-		1, 142, 1, 142, 1, 142, 1, 142, 1, 142, 1, 143, 1, 143, 1, 143, 1, 143,
-		1, 143, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144,
-		1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 146,
-		1, 146, 1, 146, 1, 146, 1, 146, 1, 147, 1, 147, 1, 147, 1, 147, 1, 147,
-		1, 147, 1, 147, 1, 147, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148,
-		1, 148, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 150, 1, 150,
-		1, 150, 1, 150, 1, 150, 1, 150, 1, 150, 1, 151, 1, 151, 1, 151, 1, 151,
-		1, 151, 1, 152, 1, 152, 1, 152, 1, 152, 1, 153, 1, 153, 1, 153, 1, 153,
-		1, 153, 1, 154, 1, 154, 1, 154, 1, 154, 1, 155, 1, 155, 1, 155, 1, 155,
-		1, 155, 1, 155, 1, 155, 1, 155, 1, 155, 1, 156, 1, 156, 1, 156, 1, 156,
-		1, 156, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157,
-		1, 157, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 159, 1, 159, 1, 159,
-		1, 159, 1, 159, 1, 159, 1, 159, 1, 159, 1, 160, 1, 160, 1, 160, 1, 160,
-		1, 160, 1, 160, 1, 161, 1, 161, 1, 161, 1, 161, 1, 161, 1, 161, 1, 161,
-		1, 161, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 163,
-		1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 5, 163, 1382, 8, 163, 10, 163,
-		12, 163, 1385, 9, 163, 1, 163, 1, 163, 1, 163, 1, 164, 1, 164, 1, 164,
-		1, 164, 1, 164, 1, 164, 5, 164, 1396, 8, 164, 10, 164, 12, 164, 1399, 9,
-		164, 1, 164, 1, 164, 1, 165, 4, 165, 1404, 8, 165, 11, 165, 12, 165, 1405,
-		1, 166, 3, 166, 1409, 8, 166, 1, 166, 4, 166, 1412, 8, 166, 11, 166, 12,
-		166, 1413, 1, 166, 1, 166, 4, 166, 1418, 8, 166, 11, 166, 12, 166, 1419,
-		3, 166, 1422, 8, 166, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 4, 167, 1429,
-		8, 167, 11, 167, 12, 167, 1430, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167,
-		1, 167, 4, 167, 1439, 8, 167, 11, 167, 12, 167, 1440, 3, 167, 1443, 8,
-		167, 1, 168, 4, 168, 1446, 8, 168, 11, 168, 12, 168, 1447, 1, 168, 3, 168,
-		1451, 8, 168, 1, 168, 1, 168, 1, 168, 5, 168, 1456, 8, 168, 10, 168, 12,
-		168, 1459, 9, 168, 1, 168, 1, 168, 4, 168, 1463, 8, 168, 11, 168, 12, 168,
-		1464, 1, 168, 3, 168, 1468, 8, 168, 3, 168, 1470, 8, 168, 1, 169, 1, 169,
-		5, 169, 1474, 8, 169, 10, 169, 12, 169, 1477, 9, 169, 1, 169, 1, 169, 4,
-		169, 1481, 8, 169, 11, 169, 12, 169, 1482, 1, 169, 3, 169, 1486, 8, 169,
-		1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170,
-		1, 170, 1, 170, 1, 170, 1, 170, 1, 171, 4, 171, 1502, 8, 171, 11, 171,
-		12, 171, 1503, 1, 171, 1, 171, 1, 172, 1, 172, 1, 172, 1, 172, 1, 172,
-		4, 172, 1513, 8, 172, 11, 172, 12, 172, 1514, 1, 172, 1, 172, 1, 172, 1,
-		172, 1, 172, 1, 173, 1, 173, 1, 173, 1, 173, 5, 173, 1526, 8, 173, 10,
-		173, 12, 173, 1529, 9, 173, 1, 173, 1, 173, 1, 173, 1, 173, 1, 173, 1,
-		174, 1, 174, 1, 174, 1, 174, 1, 174, 1, 174, 3, 174, 1542, 8, 174, 1, 174,
-		5, 174, 1545, 8, 174, 10, 174, 12, 174, 1548, 9, 174, 1, 174, 3, 174, 1551,
-		8, 174, 1, 174, 1, 174, 3, 174, 1555, 8, 174, 1, 174, 1, 174, 1, 174, 1,
-		174, 3, 174, 1561, 8, 174, 1, 174, 1, 174, 3, 174, 1565, 8, 174, 3, 174,
-		1567, 8, 174, 1, 174, 1, 174, 1, 175, 1, 175, 1, 175, 1, 175, 1, 175, 1,
-		176, 1, 176, 1, 177, 1, 177, 1, 178, 1, 178, 3, 178, 1582, 8, 178, 1, 178,
-		4, 178, 1585, 8, 178, 11, 178, 12, 178, 1586, 2, 1514, 1527, 0, 179, 1,
-		1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11,
-		23, 12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20,
-		41, 21, 43, 22, 45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29,
-		59, 30, 61, 31, 63, 32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38,
-		77, 39, 79, 40, 81, 41, 83, 42, 85, 43, 87, 44, 89, 45, 91, 46, 93, 47,
-		95, 48, 97, 49, 99, 50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 55, 111,
-		56, 113, 57, 115, 58, 117, 59, 119, 60, 121, 61, 123, 62, 125, 63, 127,
-		64, 129, 65, 131, 66, 133, 67, 135, 68, 137, 69, 139, 70, 141, 71, 143,
-		72, 145, 73, 147, 74, 149, 75, 151, 76, 153, 77, 155, 78, 157, 79, 159,
-		80, 161, 81, 163, 82, 165, 83, 167, 84, 169, 85, 171, 86, 173, 87, 175,
-		88, 177, 89, 179, 90, 181, 91, 183, 92, 185, 93, 187, 94, 189, 95, 191,
-		96, 193, 97, 195, 98, 197, 99, 199, 100, 201, 101, 203, 102, 205, 103,
-		207, 104, 209, 105, 211, 106, 213, 107, 215, 108, 217, 109, 219, 110, 221,
-		111, 223, 112, 225, 113, 227, 114, 229, 115, 231, 116, 233, 117, 235, 118,
-		237, 119, 239, 120, 241, 121, 243, 122, 245, 123, 247, 124, 249, 125, 251,
-		126, 253, 127, 255, 128, 257, 129, 259, 130, 261, 131, 263, 132, 265, 133,
-		267, 134, 269, 135, 271, 136, 273, 137, 275, 138, 277, 139, 279, 140, 281,
-		141, 283, 142, 285, 143, 287, 144, 289, 145, 291, 146, 293, 147, 295, 148,
-		297, 149, 299, 150, 301, 151, 303, 152, 305, 153, 307, 154, 309, 155, 311,
-		156, 313, 157, 315, 158, 317, 159, 319, 160, 321, 161, 323, 162, 325, 163,
-		327, 164, 329, 165, 331, 166, 333, 167, 335, 168, 337, 169, 339, 170, 341,
-		171, 343, 172, 345, 173, 347, 174, 349, 175, 351, 0, 353, 0, 355, 0, 357,
-		0, 1, 0, 35, 2, 0, 65, 65, 97, 97, 2, 0, 68, 68, 100, 100, 2, 0, 71, 71,
-		103, 103, 2, 0, 82, 82, 114, 114, 2, 0, 69, 69, 101, 101, 2, 0, 84, 84,
-		116, 116, 2, 0, 76, 76, 108, 108, 2, 0, 79, 79, 111, 111, 2, 0, 87, 87,
-		119, 119, 2, 0, 78, 78, 110, 110, 2, 0, 89, 89, 121, 121, 2, 0, 80, 80,
-		112, 112, 2, 0, 83, 83, 115, 115, 2, 0, 67, 67, 99, 99, 2, 0, 85, 85, 117,
-		117, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 90, 90, 122,
-		122, 2, 0, 66, 66, 98, 98, 2, 0, 77, 77, 109, 109, 2, 0, 70, 70, 102, 102,
-		2, 0, 81, 81, 113, 113, 2, 0, 88, 88, 120, 120, 2, 0, 74, 74, 106, 106,
-		2, 0, 75, 75, 107, 107, 2, 0, 86, 86, 118, 118, 1, 0, 36, 36, 2, 0, 39,
-		39, 92, 92, 1, 0, 48, 57, 2, 0, 65, 90, 97, 122, 5, 0, 36, 36, 48, 57,
-		65, 90, 95, 95, 97, 122, 1, 0, 34, 34, 3, 0, 9, 10, 13, 13, 32, 32, 2,
-		0, 10, 10, 13, 13, 3, 0, 48, 57, 65, 70, 97, 102, 1618, 0, 1, 1, 0, 0,
-		0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0,
-		0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0,
-		0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1,
-		0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33,
-		1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0,
-		41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0,
-		0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0,
-		0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0,
-		0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1,
-		0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79,
-		1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0,
-		87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0,
-		0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0,
-		0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109,
-		1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0,
-		0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1,
-		0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0,
-		131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0,
-		0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145,
-		1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0,
-		0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1,
-		0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0,
-		167, 1, 0, 0, 0, 0, 169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0,
-		0, 0, 0, 175, 1, 0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 181,
-		1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 0, 187, 1, 0, 0, 0,
-		0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 195, 1,
-		0, 0, 0, 0, 197, 1, 0, 0, 0, 0, 199, 1, 0, 0, 0, 0, 201, 1, 0, 0, 0, 0,
-		203, 1, 0, 0, 0, 0, 205, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0, 0, 209, 1, 0,
-		0, 0, 0, 211, 1, 0, 0, 0, 0, 213, 1, 0, 0, 0, 0, 215, 1, 0, 0, 0, 0, 217,
-		1, 0, 0, 0, 0, 219, 1, 0, 0, 0, 0, 221, 1, 0, 0, 0, 0, 223, 1, 0, 0, 0,
-		0, 225, 1, 0, 0, 0, 0, 227, 1, 0, 0, 0, 0, 229, 1, 0, 0, 0, 0, 231, 1,
-		0, 0, 0, 0, 233, 1, 0, 0, 0, 0, 235, 1, 0, 0, 0, 0, 237, 1, 0, 0, 0, 0,
-		239, 1, 0, 0, 0, 0, 241, 1, 0, 0, 0, 0, 243, 1, 0, 0, 0, 0, 245, 1, 0,
-		0, 0, 0, 247, 1, 0, 0, 0, 0, 249, 1, 0, 0, 0, 0, 251, 1, 0, 0, 0, 0, 253,
-		1, 0, 0, 0, 0, 255, 1, 0, 0, 0, 0, 257, 1, 0, 0, 0, 0, 259, 1, 0, 0, 0,
-		0, 261, 1, 0, 0, 0, 0, 263, 1, 0, 0, 0, 0, 265, 1, 0, 0, 0, 0, 267, 1,
-		0, 0, 0, 0, 269, 1, 0, 0, 0, 0, 271, 1, 0, 0, 0, 0, 273, 1, 0, 0, 0, 0,
-		275, 1, 0, 0, 0, 0, 277, 1, 0, 0, 0, 0, 279, 1, 0, 0, 0, 0, 281, 1, 0,
-		0, 0, 0, 283, 1, 0, 0, 0, 0, 285, 1, 0, 0, 0, 0, 287, 1, 0, 0, 0, 0, 289,
-		1, 0, 0, 0, 0, 291, 1, 0, 0, 0, 0, 293, 1, 0, 0, 0, 0, 295, 1, 0, 0, 0,
-		0, 297, 1, 0, 0, 0, 0, 299, 1, 0, 0, 0, 0, 301, 1, 0, 0, 0, 0, 303, 1,
-		0, 0, 0, 0, 305, 1, 0, 0, 0, 0, 307, 1, 0, 0, 0, 0, 309, 1, 0, 0, 0, 0,
-		311, 1, 0, 0, 0, 0, 313, 1, 0, 0, 0, 0, 315, 1, 0, 0, 0, 0, 317, 1, 0,
-		0, 0, 0, 319, 1, 0, 0, 0, 0, 321, 1, 0, 0, 0, 0, 323, 1, 0, 0, 0, 0, 325,
-		1, 0, 0, 0, 0, 327, 1, 0, 0, 0, 0, 329, 1, 0, 0, 0, 0, 331, 1, 0, 0, 0,
-		0, 333, 1, 0, 0, 0, 0, 335, 1, 0, 0, 0, 0, 337, 1, 0, 0, 0, 0, 339, 1,
-		0, 0, 0, 0, 341, 1, 0, 0, 0, 0, 343, 1, 0, 0, 0, 0, 345, 1, 0, 0, 0, 0,
-		347, 1, 0, 0, 0, 0, 349, 1, 0, 0, 0, 1, 359, 1, 0, 0, 0, 3, 361, 1, 0,
-		0, 0, 5, 363, 1, 0, 0, 0, 7, 365, 1, 0, 0, 0, 9, 367, 1, 0, 0, 0, 11, 369,
-		1, 0, 0, 0, 13, 371, 1, 0, 0, 0, 15, 373, 1, 0, 0, 0, 17, 375, 1, 0, 0,
-		0, 19, 377, 1, 0, 0, 0, 21, 379, 1, 0, 0, 0, 23, 381, 1, 0, 0, 0, 25, 383,
-		1, 0, 0, 0, 27, 385, 1, 0, 0, 0, 29, 387, 1, 0, 0, 0, 31, 390, 1, 0, 0,
-		0, 33, 392, 1, 0, 0, 0, 35, 394, 1, 0, 0, 0, 37, 396, 1, 0, 0, 0, 39, 398,
-		1, 0, 0, 0, 41, 400, 1, 0, 0, 0, 43, 402, 1, 0, 0, 0, 45, 405, 1, 0, 0,
-		0, 47, 408, 1, 0, 0, 0, 49, 412, 1, 0, 0, 0, 51, 422, 1, 0, 0, 0, 53, 426,
-		1, 0, 0, 0, 55, 432, 1, 0, 0, 0, 57, 438, 1, 0, 0, 0, 59, 442, 1, 0, 0,
-		0, 61, 446, 1, 0, 0, 0, 63, 452, 1, 0, 0, 0, 65, 455, 1, 0, 0, 0, 67, 459,
-		1, 0, 0, 0, 69, 469, 1, 0, 0, 0, 71, 475, 1, 0, 0, 0, 73, 481, 1, 0, 0,
-		0, 75, 484, 1, 0, 0, 0, 77, 491, 1, 0, 0, 0, 79, 502, 1, 0, 0, 0, 81, 515,
-		1, 0, 0, 0, 83, 523, 1, 0, 0, 0, 85, 535, 1, 0, 0, 0, 87, 544, 1, 0, 0,
-		0, 89, 551, 1, 0, 0, 0, 91, 558, 1, 0, 0, 0, 93, 565, 1, 0, 0, 0, 95, 570,
-		1, 0, 0, 0, 97, 579, 1, 0, 0, 0, 99, 588, 1, 0, 0, 0, 101, 593, 1, 0, 0,
-		0, 103, 608, 1, 0, 0, 0, 105, 620, 1, 0, 0, 0, 107, 628, 1, 0, 0, 0, 109,
-		636, 1, 0, 0, 0, 111, 643, 1, 0, 0, 0, 113, 649, 1, 0, 0, 0, 115, 659,
-		1, 0, 0, 0, 117, 669, 1, 0, 0, 0, 119, 674, 1, 0, 0, 0, 121, 679, 1, 0,
-		0, 0, 123, 688, 1, 0, 0, 0, 125, 698, 1, 0, 0, 0, 127, 704, 1, 0, 0, 0,
-		129, 707, 1, 0, 0, 0, 131, 710, 1, 0, 0, 0, 133, 716, 1, 0, 0, 0, 135,
-		725, 1, 0, 0, 0, 137, 734, 1, 0, 0, 0, 139, 740, 1, 0, 0, 0, 141, 747,
-		1, 0, 0, 0, 143, 752, 1, 0, 0, 0, 145, 755, 1, 0, 0, 0, 147, 760, 1, 0,
-		0, 0, 149, 764, 1, 0, 0, 0, 151, 769, 1, 0, 0, 0, 153, 778, 1, 0, 0, 0,
-		155, 788, 1, 0, 0, 0, 157, 797, 1, 0, 0, 0, 159, 803, 1, 0, 0, 0, 161,
-		809, 1, 0, 0, 0, 163, 819, 1, 0, 0, 0, 165, 832, 1, 0, 0, 0, 167, 839,
-		1, 0, 0, 0, 169, 845, 1, 0, 0, 0, 171, 858, 1, 0, 0, 0, 173, 865, 1, 0,
-		0, 0, 175, 869, 1, 0, 0, 0, 177, 881, 1, 0, 0, 0, 179, 893, 1, 0, 0, 0,
-		181, 897, 1, 0, 0, 0, 183, 902, 1, 0, 0, 0, 185, 905, 1, 0, 0, 0, 187,
-		908, 1, 0, 0, 0, 189, 912, 1, 0, 0, 0, 191, 920, 1, 0, 0, 0, 193, 923,
-		1, 0, 0, 0, 195, 929, 1, 0, 0, 0, 197, 939, 1, 0, 0, 0, 199, 948, 1, 0,
-		0, 0, 201, 952, 1, 0, 0, 0, 203, 963, 1, 0, 0, 0, 205, 975, 1, 0, 0, 0,
-		207, 983, 1, 0, 0, 0, 209, 990, 1, 0, 0, 0, 211, 997, 1, 0, 0, 0, 213,
-		1005, 1, 0, 0, 0, 215, 1017, 1, 0, 0, 0, 217, 1025, 1, 0, 0, 0, 219, 1032,
-		1, 0, 0, 0, 221, 1037, 1, 0, 0, 0, 223, 1043, 1, 0, 0, 0, 225, 1050, 1,
-		0, 0, 0, 227, 1057, 1, 0, 0, 0, 229, 1061, 1, 0, 0, 0, 231, 1067, 1, 0,
-		0, 0, 233, 1074, 1, 0, 0, 0, 235, 1082, 1, 0, 0, 0, 237, 1088, 1, 0, 0,
-		0, 239, 1098, 1, 0, 0, 0, 241, 1104, 1, 0, 0, 0, 243, 1110, 1, 0, 0, 0,
-		245, 1120, 1, 0, 0, 0, 247, 1123, 1, 0, 0, 0, 249, 1129, 1, 0, 0, 0, 251,
-		1137, 1, 0, 0, 0, 253, 1142, 1, 0, 0, 0, 255, 1151, 1, 0, 0, 0, 257, 1155,
-		1, 0, 0, 0, 259, 1159, 1, 0, 0, 0, 261, 1164, 1, 0, 0, 0, 263, 1173, 1,
-		0, 0, 0, 265, 1180, 1, 0, 0, 0, 267, 1184, 1, 0, 0, 0, 269, 1189, 1, 0,
-		0, 0, 271, 1195, 1, 0, 0, 0, 273, 1200, 1, 0, 0, 0, 275, 1207, 1, 0, 0,
-		0, 277, 1212, 1, 0, 0, 0, 279, 1218, 1, 0, 0, 0, 281, 1223, 1, 0, 0, 0,
-		283, 1233, 1, 0, 0, 0, 285, 1239, 1, 0, 0, 0, 287, 1246, 1, 0, 0, 0, 289,
-		1251, 1, 0, 0, 0, 291, 1259, 1, 0, 0, 0, 293, 1267, 1, 0, 0, 0, 295, 1272,
-		1, 0, 0, 0, 297, 1280, 1, 0, 0, 0, 299, 1287, 1, 0, 0, 0, 301, 1293, 1,
-		0, 0, 0, 303, 1300, 1, 0, 0, 0, 305, 1305, 1, 0, 0, 0, 307, 1309, 1, 0,
-		0, 0, 309, 1314, 1, 0, 0, 0, 311, 1318, 1, 0, 0, 0, 313, 1327, 1, 0, 0,
-		0, 315, 1332, 1, 0, 0, 0, 317, 1341, 1, 0, 0, 0, 319, 1346, 1, 0, 0, 0,
-		321, 1354, 1, 0, 0, 0, 323, 1360, 1, 0, 0, 0, 325, 1368, 1, 0, 0, 0, 327,
-		1375, 1, 0, 0, 0, 329, 1389, 1, 0, 0, 0, 331, 1403, 1, 0, 0, 0, 333, 1408,
-		1, 0, 0, 0, 335, 1442, 1, 0, 0, 0, 337, 1469, 1, 0, 0, 0, 339, 1485, 1,
-		0, 0, 0, 341, 1487, 1, 0, 0, 0, 343, 1501, 1, 0, 0, 0, 345, 1507, 1, 0,
-		0, 0, 347, 1521, 1, 0, 0, 0, 349, 1566, 1, 0, 0, 0, 351, 1570, 1, 0, 0,
-		0, 353, 1575, 1, 0, 0, 0, 355, 1577, 1, 0, 0, 0, 357, 1579, 1, 0, 0, 0,
-		359, 360, 5, 40, 0, 0, 360, 2, 1, 0, 0, 0, 361, 362, 5, 41, 0, 0, 362,
-		4, 1, 0, 0, 0, 363, 364, 5, 123, 0, 0, 364, 6, 1, 0, 0, 0, 365, 366, 5,
-		125, 0, 0, 366, 8, 1, 0, 0, 0, 367, 368, 5, 91, 0, 0, 368, 10, 1, 0, 0,
-		0, 369, 370, 5, 93, 0, 0, 370, 12, 1, 0, 0, 0, 371, 372, 5, 44, 0, 0, 372,
-		14, 1, 0, 0, 0, 373, 374, 5, 59, 0, 0, 374, 16, 1, 0, 0, 0, 375, 376, 5,
-		58, 0, 0, 376, 18, 1, 0, 0, 0, 377, 378, 5, 46, 0, 0, 378, 20, 1, 0, 0,
-		0, 379, 380, 5, 42, 0, 0, 380, 22, 1, 0, 0, 0, 381, 382, 5, 47, 0, 0, 382,
-		24, 1, 0, 0, 0, 383, 384, 5, 37, 0, 0, 384, 26, 1, 0, 0, 0, 385, 386, 5,
-		43, 0, 0, 386, 28, 1, 0, 0, 0, 387, 388, 5, 45, 0, 0, 388, 389, 5, 45,
-		0, 0, 389, 30, 1, 0, 0, 0, 390, 391, 5, 45, 0, 0, 391, 32, 1, 0, 0, 0,
-		392, 393, 5, 34, 0, 0, 393, 34, 1, 0, 0, 0, 394, 395, 5, 39, 0, 0, 395,
-		36, 1, 0, 0, 0, 396, 397, 5, 61, 0, 0, 397, 38, 1, 0, 0, 0, 398, 399, 5,
-		60, 0, 0, 399, 40, 1, 0, 0, 0, 400, 401, 5, 62, 0, 0, 401, 42, 1, 0, 0,
-		0, 402, 403, 5, 60, 0, 0, 403, 404, 5, 61, 0, 0, 404, 44, 1, 0, 0, 0, 405,
-		406, 5, 62, 0, 0, 406, 407, 5, 61, 0, 0, 407, 46, 1, 0, 0, 0, 408, 409,
-		7, 0, 0, 0, 409, 410, 7, 1, 0, 0, 410, 411, 7, 1, 0, 0, 411, 48, 1, 0,
-		0, 0, 412, 413, 7, 0, 0, 0, 413, 414, 7, 2, 0, 0, 414, 415, 7, 2, 0, 0,
-		415, 416, 7, 3, 0, 0, 416, 417, 7, 4, 0, 0, 417, 418, 7, 2, 0, 0, 418,
-		419, 7, 0, 0, 0, 419, 420, 7, 5, 0, 0, 420, 421, 7, 4, 0, 0, 421, 50, 1,
-		0, 0, 0, 422, 423, 7, 0, 0, 0, 423, 424, 7, 6, 0, 0, 424, 425, 7, 6, 0,
-		0, 425, 52, 1, 0, 0, 0, 426, 427, 7, 0, 0, 0, 427, 428, 7, 6, 0, 0, 428,
-		429, 7, 6, 0, 0, 429, 430, 7, 7, 0, 0, 430, 431, 7, 8, 0, 0, 431, 54, 1,
-		0, 0, 0, 432, 433, 7, 0, 0, 0, 433, 434, 7, 6, 0, 0, 434, 435, 7, 5, 0,
-		0, 435, 436, 7, 4, 0, 0, 436, 437, 7, 3, 0, 0, 437, 56, 1, 0, 0, 0, 438,
-		439, 7, 0, 0, 0, 439, 440, 7, 9, 0, 0, 440, 441, 7, 1, 0, 0, 441, 58, 1,
-		0, 0, 0, 442, 443, 7, 0, 0, 0, 443, 444, 7, 9, 0, 0, 444, 445, 7, 10, 0,
-		0, 445, 60, 1, 0, 0, 0, 446, 447, 7, 0, 0, 0, 447, 448, 7, 11, 0, 0, 448,
-		449, 7, 11, 0, 0, 449, 450, 7, 6, 0, 0, 450, 451, 7, 10, 0, 0, 451, 62,
-		1, 0, 0, 0, 452, 453, 7, 0, 0, 0, 453, 454, 7, 12, 0, 0, 454, 64, 1, 0,
-		0, 0, 455, 456, 7, 0, 0, 0, 456, 457, 7, 12, 0, 0, 457, 458, 7, 13, 0,
-		0, 458, 66, 1, 0, 0, 0, 459, 460, 7, 0, 0, 0, 460, 461, 7, 14, 0, 0, 461,
-		462, 7, 5, 0, 0, 462, 463, 7, 15, 0, 0, 463, 464, 7, 7, 0, 0, 464, 465,
-		7, 3, 0, 0, 465, 466, 7, 16, 0, 0, 466, 467, 7, 17, 0, 0, 467, 468, 7,
-		4, 0, 0, 468, 68, 1, 0, 0, 0, 469, 470, 7, 18, 0, 0, 470, 471, 7, 0, 0,
-		0, 471, 472, 7, 5, 0, 0, 472, 473, 7, 13, 0, 0, 473, 474, 7, 15, 0, 0,
-		474, 70, 1, 0, 0, 0, 475, 476, 7, 18, 0, 0, 476, 477, 7, 4, 0, 0, 477,
-		478, 7, 2, 0, 0, 478, 479, 7, 16, 0, 0, 479, 480, 7, 9, 0, 0, 480, 72,
-		1, 0, 0, 0, 481, 482, 7, 18, 0, 0, 482, 483, 7, 10, 0, 0, 483, 74, 1, 0,
-		0, 0, 484, 485, 7, 13, 0, 0, 485, 486, 7, 0, 0, 0, 486, 487, 7, 6, 0, 0,
-		487, 488, 7, 6, 0, 0, 488, 489, 7, 4, 0, 0, 489, 490, 7, 1, 0, 0, 490,
-		76, 1, 0, 0, 0, 491, 492, 7, 13, 0, 0, 492, 493, 7, 6, 0, 0, 493, 494,
-		7, 14, 0, 0, 494, 495, 7, 12, 0, 0, 495, 496, 7, 5, 0, 0, 496, 497, 7,
-		4, 0, 0, 497, 498, 7, 3, 0, 0, 498, 499, 7, 16, 0, 0, 499, 500, 7, 9, 0,
-		0, 500, 501, 7, 2, 0, 0, 501, 78, 1, 0, 0, 0, 502, 503, 7, 13, 0, 0, 503,
-		504, 7, 7, 0, 0, 504, 505, 7, 6, 0, 0, 505, 506, 7, 14, 0, 0, 506, 507,
-		7, 19, 0, 0, 507, 508, 7, 9, 0, 0, 508, 509, 7, 20, 0, 0, 509, 510, 7,
-		0, 0, 0, 510, 511, 7, 19, 0, 0, 511, 512, 7, 16, 0, 0, 512, 513, 7, 6,
-		0, 0, 513, 514, 7, 10, 0, 0, 514, 80, 1, 0, 0, 0, 515, 516, 7, 13, 0, 0,
-		516, 517, 7, 7, 0, 0, 517, 518, 7, 19, 0, 0, 518, 519, 7, 11, 0, 0, 519,
-		520, 7, 0, 0, 0, 520, 521, 7, 13, 0, 0, 521, 522, 7, 5, 0, 0, 522, 82,
-		1, 0, 0, 0, 523, 524, 7, 13, 0, 0, 524, 525, 7, 7, 0, 0, 525, 526, 7, 9,
-		0, 0, 526, 527, 7, 12, 0, 0, 527, 528, 7, 16, 0, 0, 528, 529, 7, 12, 0,
-		0, 529, 530, 7, 5, 0, 0, 530, 531, 7, 4, 0, 0, 531, 532, 7, 9, 0, 0, 532,
-		533, 7, 13, 0, 0, 533, 534, 7, 10, 0, 0, 534, 84, 1, 0, 0, 0, 535, 536,
-		7, 13, 0, 0, 536, 537, 7, 7, 0, 0, 537, 538, 7, 9, 0, 0, 538, 539, 7, 5,
-		0, 0, 539, 540, 7, 0, 0, 0, 540, 541, 7, 16, 0, 0, 541, 542, 7, 9, 0, 0,
-		542, 543, 7, 12, 0, 0, 543, 86, 1, 0, 0, 0, 544, 545, 7, 13, 0, 0, 545,
-		546, 7, 3, 0, 0, 546, 547, 7, 4, 0, 0, 547, 548, 7, 0, 0, 0, 548, 549,
-		7, 5, 0, 0, 549, 550, 7, 4, 0, 0, 550, 88, 1, 0, 0, 0, 551, 552, 7, 13,
-		0, 0, 552, 553, 7, 14, 0, 0, 553, 554, 7, 12, 0, 0, 554, 555, 7, 5, 0,
-		0, 555, 556, 7, 7, 0, 0, 556, 557, 7, 19, 0, 0, 557, 90, 1, 0, 0, 0, 558,
-		559, 7, 1, 0, 0, 559, 560, 7, 4, 0, 0, 560, 561, 7, 6, 0, 0, 561, 562,
-		7, 4, 0, 0, 562, 563, 7, 5, 0, 0, 563, 564, 7, 4, 0, 0, 564, 92, 1, 0,
-		0, 0, 565, 566, 7, 1, 0, 0, 566, 567, 7, 4, 0, 0, 567, 568, 7, 12, 0, 0,
-		568, 569, 7, 13, 0, 0, 569, 94, 1, 0, 0, 0, 570, 571, 7, 1, 0, 0, 571,
-		572, 7, 4, 0, 0, 572, 573, 7, 12, 0, 0, 573, 574, 7, 13, 0, 0, 574, 575,
-		7, 3, 0, 0, 575, 576, 7, 16, 0, 0, 576, 577, 7, 18, 0, 0, 577, 578, 7,
-		4, 0, 0, 578, 96, 1, 0, 0, 0, 579, 580, 7, 1, 0, 0, 580, 581, 7, 16, 0,
-		0, 581, 582, 7, 12, 0, 0, 582, 583, 7, 5, 0, 0, 583, 584, 7, 16, 0, 0,
-		584, 585, 7, 9, 0, 0, 585, 586, 7, 13, 0, 0, 586, 587, 7, 5, 0, 0, 587,
-		98, 1, 0, 0, 0, 588, 589, 7, 1, 0, 0, 589, 590, 7, 3, 0, 0, 590, 591, 7,
-		7, 0, 0, 591, 592, 7, 11, 0, 0, 592, 100, 1, 0, 0, 0, 593, 594, 7, 1, 0,
-		0, 594, 595, 7, 14, 0, 0, 595, 596, 7, 3, 0, 0, 596, 597, 7, 0, 0, 0, 597,
-		598, 7, 18, 0, 0, 598, 599, 7, 6, 0, 0, 599, 600, 7, 4, 0, 0, 600, 601,
-		5, 95, 0, 0, 601, 602, 7, 8, 0, 0, 602, 603, 7, 3, 0, 0, 603, 604, 7, 16,
-		0, 0, 604, 605, 7, 5, 0, 0, 605, 606, 7, 4, 0, 0, 606, 607, 7, 12, 0, 0,
-		607, 102, 1, 0, 0, 0, 608, 609, 7, 4, 0, 0, 609, 610, 7, 0, 0, 0, 610,
-		611, 7, 13, 0, 0, 611, 612, 7, 15, 0, 0, 612, 613, 5, 95, 0, 0, 613, 614,
-		7, 21, 0, 0, 614, 615, 7, 14, 0, 0, 615, 616, 7, 7, 0, 0, 616, 617, 7,
-		3, 0, 0, 617, 618, 7, 14, 0, 0, 618, 619, 7, 19, 0, 0, 619, 104, 1, 0,
-		0, 0, 620, 621, 7, 4, 0, 0, 621, 622, 7, 9, 0, 0, 622, 623, 7, 5, 0, 0,
-		623, 624, 7, 3, 0, 0, 624, 625, 7, 16, 0, 0, 625, 626, 7, 4, 0, 0, 626,
-		627, 7, 12, 0, 0, 627, 106, 1, 0, 0, 0, 628, 629, 7, 4, 0, 0, 629, 630,
-		7, 22, 0, 0, 630, 631, 7, 4, 0, 0, 631, 632, 7, 13, 0, 0, 632, 633, 7,
-		14, 0, 0, 633, 634, 7, 5, 0, 0, 634, 635, 7, 4, 0, 0, 635, 108, 1, 0, 0,
-		0, 636, 637, 7, 4, 0, 0, 637, 638, 7, 22, 0, 0, 638, 639, 7, 16, 0, 0,
-		639, 640, 7, 12, 0, 0, 640, 641, 7, 5, 0, 0, 641, 642, 7, 12, 0, 0, 642,
-		110, 1, 0, 0, 0, 643, 644, 7, 20, 0, 0, 644, 645, 7, 0, 0, 0, 645, 646,
-		7, 6, 0, 0, 646, 647, 7, 12, 0, 0, 647, 648, 7, 4, 0, 0, 648, 112, 1, 0,
-		0, 0, 649, 650, 7, 20, 0, 0, 650, 651, 7, 16, 0, 0, 651, 652, 7, 6, 0,
-		0, 652, 653, 7, 5, 0, 0, 653, 654, 7, 4, 0, 0, 654, 655, 7, 3, 0, 0, 655,
-		656, 7, 16, 0, 0, 656, 657, 7, 9, 0, 0, 657, 658, 7, 2, 0, 0, 658, 114,
-		1, 0, 0, 0, 659, 660, 7, 20, 0, 0, 660, 661, 7, 16, 0, 0, 661, 662, 7,
-		9, 0, 0, 662, 663, 7, 0, 0, 0, 663, 664, 7, 6, 0, 0, 664, 665, 7, 20, 0,
-		0, 665, 666, 7, 14, 0, 0, 666, 667, 7, 9, 0, 0, 667, 668, 7, 13, 0, 0,
-		668, 116, 1, 0, 0, 0, 669, 670, 7, 20, 0, 0, 670, 671, 7, 3, 0, 0, 671,
-		672, 7, 7, 0, 0, 672, 673, 7, 19, 0, 0, 673, 118, 1, 0, 0, 0, 674, 675,
-		7, 20, 0, 0, 675, 676, 7, 14, 0, 0, 676, 677, 7, 6, 0, 0, 677, 678, 7,
-		6, 0, 0, 678, 120, 1, 0, 0, 0, 679, 680, 7, 20, 0, 0, 680, 681, 7, 14,
-		0, 0, 681, 682, 7, 9, 0, 0, 682, 683, 7, 13, 0, 0, 683, 684, 7, 5, 0, 0,
-		684, 685, 7, 16, 0, 0, 685, 686, 7, 7, 0, 0, 686, 687, 7, 9, 0, 0, 687,
-		122, 1, 0, 0, 0, 688, 689, 7, 20, 0, 0, 689, 690, 7, 14, 0, 0, 690, 691,
-		7, 9, 0, 0, 691, 692, 7, 13, 0, 0, 692, 693, 7, 5, 0, 0, 693, 694, 7, 16,
-		0, 0, 694, 695, 7, 7, 0, 0, 695, 696, 7, 9, 0, 0, 696, 697, 7, 12, 0, 0,
-		697, 124, 1, 0, 0, 0, 698, 699, 7, 2, 0, 0, 699, 700, 7, 3, 0, 0, 700,
-		701, 7, 0, 0, 0, 701, 702, 7, 9, 0, 0, 702, 703, 7, 5, 0, 0, 703, 126,
-		1, 0, 0, 0, 704, 705, 7, 16, 0, 0, 705, 706, 7, 20, 0, 0, 706, 128, 1,
-		0, 0, 0, 707, 708, 7, 16, 0, 0, 708, 709, 7, 9, 0, 0, 709, 130, 1, 0, 0,
-		0, 710, 711, 7, 16, 0, 0, 711, 712, 7, 9, 0, 0, 712, 713, 7, 1, 0, 0, 713,
-		714, 7, 4, 0, 0, 714, 715, 7, 22, 0, 0, 715, 132, 1, 0, 0, 0, 716, 717,
-		7, 16, 0, 0, 717, 718, 7, 9, 0, 0, 718, 719, 7, 20, 0, 0, 719, 720, 7,
-		16, 0, 0, 720, 721, 7, 9, 0, 0, 721, 722, 7, 16, 0, 0, 722, 723, 7, 5,
-		0, 0, 723, 724, 7, 10, 0, 0, 724, 134, 1, 0, 0, 0, 725, 726, 7, 16, 0,
-		0, 726, 727, 7, 9, 0, 0, 727, 728, 7, 16, 0, 0, 728, 729, 7, 5, 0, 0, 729,
-		730, 7, 13, 0, 0, 730, 731, 7, 7, 0, 0, 731, 732, 7, 9, 0, 0, 732, 733,
-		7, 1, 0, 0, 733, 136, 1, 0, 0, 0, 734, 735, 7, 16, 0, 0, 735, 736, 7, 9,
-		0, 0, 736, 737, 7, 11, 0, 0, 737, 738, 7, 14, 0, 0, 738, 739, 7, 5, 0,
-		0, 739, 138, 1, 0, 0, 0, 740, 741, 7, 16, 0, 0, 741, 742, 7, 9, 0, 0, 742,
-		743, 7, 12, 0, 0, 743, 744, 7, 4, 0, 0, 744, 745, 7, 3, 0, 0, 745, 746,
-		7, 5, 0, 0, 746, 140, 1, 0, 0, 0, 747, 748, 7, 16, 0, 0, 748, 749, 7, 9,
-		0, 0, 749, 750, 7, 5, 0, 0, 750, 751, 7, 7, 0, 0, 751, 142, 1, 0, 0, 0,
-		752, 753, 7, 16, 0, 0, 753, 754, 7, 12, 0, 0, 754, 144, 1, 0, 0, 0, 755,
-		756, 7, 23, 0, 0, 756, 757, 7, 12, 0, 0, 757, 758, 7, 7, 0, 0, 758, 759,
-		7, 9, 0, 0, 759, 146, 1, 0, 0, 0, 760, 761, 7, 24, 0, 0, 761, 762, 7, 4,
-		0, 0, 762, 763, 7, 10, 0, 0, 763, 148, 1, 0, 0, 0, 764, 765, 7, 24, 0,
-		0, 765, 766, 7, 4, 0, 0, 766, 767, 7, 10, 0, 0, 767, 768, 7, 12, 0, 0,
-		768, 150, 1, 0, 0, 0, 769, 770, 7, 24, 0, 0, 770, 771, 7, 4, 0, 0, 771,
-		772, 7, 10, 0, 0, 772, 773, 7, 12, 0, 0, 773, 774, 7, 11, 0, 0, 774, 775,
-		7, 0, 0, 0, 775, 776, 7, 13, 0, 0, 776, 777, 7, 4, 0, 0, 777, 152, 1, 0,
-		0, 0, 778, 779, 7, 24, 0, 0, 779, 780, 7, 4, 0, 0, 780, 781, 7, 10, 0,
-		0, 781, 782, 7, 12, 0, 0, 782, 783, 7, 11, 0, 0, 783, 784, 7, 0, 0, 0,
-		784, 785, 7, 13, 0, 0, 785, 786, 7, 4, 0, 0, 786, 787, 7, 12, 0, 0, 787,
-		154, 1, 0, 0, 0, 788, 789, 7, 6, 0, 0, 789, 790, 7, 0, 0, 0, 790, 791,
-		7, 9, 0, 0, 791, 792, 7, 2, 0, 0, 792, 793, 7, 14, 0, 0, 793, 794, 7, 0,
-		0, 0, 794, 795, 7, 2, 0, 0, 795, 796, 7, 4, 0, 0, 796, 156, 1, 0, 0, 0,
-		797, 798, 7, 6, 0, 0, 798, 799, 7, 4, 0, 0, 799, 800, 7, 25, 0, 0, 800,
-		801, 7, 4, 0, 0, 801, 802, 7, 6, 0, 0, 802, 158, 1, 0, 0, 0, 803, 804,
-		7, 6, 0, 0, 804, 805, 7, 16, 0, 0, 805, 806, 7, 19, 0, 0, 806, 807, 7,
-		16, 0, 0, 807, 808, 7, 5, 0, 0, 808, 160, 1, 0, 0, 0, 809, 810, 7, 6, 0,
-		0, 810, 811, 7, 7, 0, 0, 811, 812, 7, 13, 0, 0, 812, 813, 7, 0, 0, 0, 813,
-		814, 7, 6, 0, 0, 814, 815, 5, 95, 0, 0, 815, 816, 7, 7, 0, 0, 816, 817,
-		7, 9, 0, 0, 817, 818, 7, 4, 0, 0, 818, 162, 1, 0, 0, 0, 819, 820, 7, 6,
-		0, 0, 820, 821, 7, 7, 0, 0, 821, 822, 7, 13, 0, 0, 822, 823, 7, 0, 0, 0,
-		823, 824, 7, 6, 0, 0, 824, 825, 5, 95, 0, 0, 825, 826, 7, 21, 0, 0, 826,
-		827, 7, 14, 0, 0, 827, 828, 7, 7, 0, 0, 828, 829, 7, 3, 0, 0, 829, 830,
-		7, 14, 0, 0, 830, 831, 7, 19, 0, 0, 831, 164, 1, 0, 0, 0, 832, 833, 7,
-		6, 0, 0, 833, 834, 7, 7, 0, 0, 834, 835, 7, 2, 0, 0, 835, 836, 7, 2, 0,
-		0, 836, 837, 7, 4, 0, 0, 837, 838, 7, 1, 0, 0, 838, 166, 1, 0, 0, 0, 839,
-		840, 7, 6, 0, 0, 840, 841, 7, 7, 0, 0, 841, 842, 7, 2, 0, 0, 842, 843,
-		7, 16, 0, 0, 843, 844, 7, 9, 0, 0, 844, 168, 1, 0, 0, 0, 845, 846, 7, 19,
-		0, 0, 846, 847, 7, 0, 0, 0, 847, 848, 7, 5, 0, 0, 848, 849, 7, 4, 0, 0,
-		849, 850, 7, 3, 0, 0, 850, 851, 7, 16, 0, 0, 851, 852, 7, 0, 0, 0, 852,
-		853, 7, 6, 0, 0, 853, 854, 7, 16, 0, 0, 854, 855, 7, 17, 0, 0, 855, 856,
-		7, 4, 0, 0, 856, 857, 7, 1, 0, 0, 857, 170, 1, 0, 0, 0, 858, 859, 7, 19,
-		0, 0, 859, 860, 7, 7, 0, 0, 860, 861, 7, 1, 0, 0, 861, 862, 7, 16, 0, 0,
-		862, 863, 7, 20, 0, 0, 863, 864, 7, 10, 0, 0, 864, 172, 1, 0, 0, 0, 865,
-		866, 7, 9, 0, 0, 866, 867, 7, 0, 0, 0, 867, 868, 7, 9, 0, 0, 868, 174,
-		1, 0, 0, 0, 869, 870, 7, 9, 0, 0, 870, 871, 7, 7, 0, 0, 871, 872, 7, 3,
-		0, 0, 872, 873, 7, 4, 0, 0, 873, 874, 7, 13, 0, 0, 874, 875, 7, 14, 0,
-		0, 875, 876, 7, 3, 0, 0, 876, 877, 7, 12, 0, 0, 877, 878, 7, 16, 0, 0,
-		878, 879, 7, 25, 0, 0, 879, 880, 7, 4, 0, 0, 880, 176, 1, 0, 0, 0, 881,
-		882, 7, 9, 0, 0, 882, 883, 7, 7, 0, 0, 883, 884, 7, 12, 0, 0, 884, 885,
-		7, 14, 0, 0, 885, 886, 7, 11, 0, 0, 886, 887, 7, 4, 0, 0, 887, 888, 7,
-		3, 0, 0, 888, 889, 7, 14, 0, 0, 889, 890, 7, 12, 0, 0, 890, 891, 7, 4,
-		0, 0, 891, 892, 7, 3, 0, 0, 892, 178, 1, 0, 0, 0, 893, 894, 7, 9, 0, 0,
-		894, 895, 7, 7, 0, 0, 895, 896, 7, 5, 0, 0, 896, 180, 1, 0, 0, 0, 897,
-		898, 7, 9, 0, 0, 898, 899, 7, 14, 0, 0, 899, 900, 7, 6, 0, 0, 900, 901,
-		7, 6, 0, 0, 901, 182, 1, 0, 0, 0, 902, 903, 7, 7, 0, 0, 903, 904, 7, 20,
-		0, 0, 904, 184, 1, 0, 0, 0, 905, 906, 7, 7, 0, 0, 906, 907, 7, 9, 0, 0,
-		907, 186, 1, 0, 0, 0, 908, 909, 7, 7, 0, 0, 909, 910, 7, 9, 0, 0, 910,
-		911, 7, 4, 0, 0, 911, 188, 1, 0, 0, 0, 912, 913, 7, 7, 0, 0, 913, 914,
-		7, 11, 0, 0, 914, 915, 7, 5, 0, 0, 915, 916, 7, 16, 0, 0, 916, 917, 7,
-		7, 0, 0, 917, 918, 7, 9, 0, 0, 918, 919, 7, 12, 0, 0, 919, 190, 1, 0, 0,
-		0, 920, 921, 7, 7, 0, 0, 921, 922, 7, 3, 0, 0, 922, 192, 1, 0, 0, 0, 923,
-		924, 7, 7, 0, 0, 924, 925, 7, 3, 0, 0, 925, 926, 7, 1, 0, 0, 926, 927,
-		7, 4, 0, 0, 927, 928, 7, 3, 0, 0, 928, 194, 1, 0, 0, 0, 929, 930, 7, 11,
-		0, 0, 930, 931, 7, 0, 0, 0, 931, 932, 7, 3, 0, 0, 932, 933, 7, 5, 0, 0,
-		933, 934, 7, 16, 0, 0, 934, 935, 7, 5, 0, 0, 935, 936, 7, 16, 0, 0, 936,
-		937, 7, 7, 0, 0, 937, 938, 7, 9, 0, 0, 938, 196, 1, 0, 0, 0, 939, 940,
-		7, 11, 0, 0, 940, 941, 7, 0, 0, 0, 941, 942, 7, 12, 0, 0, 942, 943, 7,
-		12, 0, 0, 943, 944, 7, 8, 0, 0, 944, 945, 7, 7, 0, 0, 945, 946, 7, 3, 0,
-		0, 946, 947, 7, 1, 0, 0, 947, 198, 1, 0, 0, 0, 948, 949, 7, 11, 0, 0, 949,
-		950, 7, 4, 0, 0, 950, 951, 7, 3, 0, 0, 951, 200, 1, 0, 0, 0, 952, 953,
-		7, 11, 0, 0, 953, 954, 7, 4, 0, 0, 954, 955, 7, 3, 0, 0, 955, 956, 7, 19,
-		0, 0, 956, 957, 7, 16, 0, 0, 957, 958, 7, 12, 0, 0, 958, 959, 7, 12, 0,
-		0, 959, 960, 7, 16, 0, 0, 960, 961, 7, 7, 0, 0, 961, 962, 7, 9, 0, 0, 962,
-		202, 1, 0, 0, 0, 963, 964, 7, 11, 0, 0, 964, 965, 7, 4, 0, 0, 965, 966,
-		7, 3, 0, 0, 966, 967, 7, 19, 0, 0, 967, 968, 7, 16, 0, 0, 968, 969, 7,
-		12, 0, 0, 969, 970, 7, 12, 0, 0, 970, 971, 7, 16, 0, 0, 971, 972, 7, 7,
-		0, 0, 972, 973, 7, 9, 0, 0, 973, 974, 7, 12, 0, 0, 974, 204, 1, 0, 0, 0,
-		975, 976, 7, 11, 0, 0, 976, 977, 7, 3, 0, 0, 977, 978, 7, 16, 0, 0, 978,
-		979, 7, 19, 0, 0, 979, 980, 7, 0, 0, 0, 980, 981, 7, 3, 0, 0, 981, 982,
-		7, 10, 0, 0, 982, 206, 1, 0, 0, 0, 983, 984, 7, 21, 0, 0, 984, 985, 7,
-		14, 0, 0, 985, 986, 7, 7, 0, 0, 986, 987, 7, 3, 0, 0, 987, 988, 7, 14,
-		0, 0, 988, 989, 7, 19, 0, 0, 989, 208, 1, 0, 0, 0, 990, 991, 7, 3, 0, 0,
-		991, 992, 7, 4, 0, 0, 992, 993, 7, 9, 0, 0, 993, 994, 7, 0, 0, 0, 994,
-		995, 7, 19, 0, 0, 995, 996, 7, 4, 0, 0, 996, 210, 1, 0, 0, 0, 997, 998,
-		7, 3, 0, 0, 998, 999, 7, 4, 0, 0, 999, 1000, 7, 11, 0, 0, 1000, 1001, 7,
-		6, 0, 0, 1001, 1002, 7, 0, 0, 0, 1002, 1003, 7, 13, 0, 0, 1003, 1004, 7,
-		4, 0, 0, 1004, 212, 1, 0, 0, 0, 1005, 1006, 7, 3, 0, 0, 1006, 1007, 7,
-		4, 0, 0, 1007, 1008, 7, 11, 0, 0, 1008, 1009, 7, 6, 0, 0, 1009, 1010, 7,
-		16, 0, 0, 1010, 1011, 7, 13, 0, 0, 1011, 1012, 7, 0, 0, 0, 1012, 1013,
-		7, 5, 0, 0, 1013, 1014, 7, 16, 0, 0, 1014, 1015, 7, 7, 0, 0, 1015, 1016,
-		7, 9, 0, 0, 1016, 214, 1, 0, 0, 0, 1017, 1018, 7, 3, 0, 0, 1018, 1019,
-		7, 4, 0, 0, 1019, 1020, 7, 5, 0, 0, 1020, 1021, 7, 14, 0, 0, 1021, 1022,
-		7, 3, 0, 0, 1022, 1023, 7, 9, 0, 0, 1023, 1024, 7, 12, 0, 0, 1024, 216,
-		1, 0, 0, 0, 1025, 1026, 7, 3, 0, 0, 1026, 1027, 7, 4, 0, 0, 1027, 1028,
-		7, 25, 0, 0, 1028, 1029, 7, 7, 0, 0, 1029, 1030, 7, 24, 0, 0, 1030, 1031,
-		7, 4, 0, 0, 1031, 218, 1, 0, 0, 0, 1032, 1033, 7, 3, 0, 0, 1033, 1034,
-		7, 7, 0, 0, 1034, 1035, 7, 6, 0, 0, 1035, 1036, 7, 4, 0, 0, 1036, 220,
-		1, 0, 0, 0, 1037, 1038, 7, 3, 0, 0, 1038, 1039, 7, 7, 0, 0, 1039, 1040,
-		7, 6, 0, 0, 1040, 1041, 7, 4, 0, 0, 1041, 1042, 7, 12, 0, 0, 1042, 222,
-		1, 0, 0, 0, 1043, 1044, 7, 12, 0, 0, 1044, 1045, 7, 13, 0, 0, 1045, 1046,
-		7, 15, 0, 0, 1046, 1047, 7, 4, 0, 0, 1047, 1048, 7, 19, 0, 0, 1048, 1049,
-		7, 0, 0, 0, 1049, 224, 1, 0, 0, 0, 1050, 1051, 7, 12, 0, 0, 1051, 1052,
-		7, 4, 0, 0, 1052, 1053, 7, 6, 0, 0, 1053, 1054, 7, 4, 0, 0, 1054, 1055,
-		7, 13, 0, 0, 1055, 1056, 7, 5, 0, 0, 1056, 226, 1, 0, 0, 0, 1057, 1058,
-		7, 12, 0, 0, 1058, 1059, 7, 4, 0, 0, 1059, 1060, 7, 5, 0, 0, 1060, 228,
-		1, 0, 0, 0, 1061, 1062, 7, 12, 0, 0, 1062, 1063, 7, 20, 0, 0, 1063, 1064,
-		7, 14, 0, 0, 1064, 1065, 7, 9, 0, 0, 1065, 1066, 7, 13, 0, 0, 1066, 230,
-		1, 0, 0, 0, 1067, 1068, 7, 12, 0, 0, 1068, 1069, 7, 5, 0, 0, 1069, 1070,
-		7, 0, 0, 0, 1070, 1071, 7, 5, 0, 0, 1071, 1072, 7, 16, 0, 0, 1072, 1073,
-		7, 13, 0, 0, 1073, 232, 1, 0, 0, 0, 1074, 1075, 7, 12, 0, 0, 1075, 1076,
-		7, 5, 0, 0, 1076, 1077, 7, 7, 0, 0, 1077, 1078, 7, 3, 0, 0, 1078, 1079,
-		7, 0, 0, 0, 1079, 1080, 7, 2, 0, 0, 1080, 1081, 7, 4, 0, 0, 1081, 234,
-		1, 0, 0, 0, 1082, 1083, 7, 12, 0, 0, 1083, 1084, 7, 5, 0, 0, 1084, 1085,
-		7, 10, 0, 0, 1085, 1086, 7, 11, 0, 0, 1086, 1087, 7, 4, 0, 0, 1087, 236,
-		1, 0, 0, 0, 1088, 1089, 7, 12, 0, 0, 1089, 1090, 7, 14, 0, 0, 1090, 1091,
-		7, 11, 0, 0, 1091, 1092, 7, 4, 0, 0, 1092, 1093, 7, 3, 0, 0, 1093, 1094,
-		7, 14, 0, 0, 1094, 1095, 7, 12, 0, 0, 1095, 1096, 7, 4, 0, 0, 1096, 1097,
-		7, 3, 0, 0, 1097, 238, 1, 0, 0, 0, 1098, 1099, 7, 5, 0, 0, 1099, 1100,
-		7, 0, 0, 0, 1100, 1101, 7, 18, 0, 0, 1101, 1102, 7, 6, 0, 0, 1102, 1103,
-		7, 4, 0, 0, 1103, 240, 1, 0, 0, 0, 1104, 1105, 7, 5, 0, 0, 1105, 1106,
-		7, 15, 0, 0, 1106, 1107, 7, 3, 0, 0, 1107, 1108, 7, 4, 0, 0, 1108, 1109,
-		7, 4, 0, 0, 1109, 242, 1, 0, 0, 0, 1110, 1111, 7, 5, 0, 0, 1111, 1112,
-		7, 16, 0, 0, 1112, 1113, 7, 19, 0, 0, 1113, 1114, 7, 4, 0, 0, 1114, 1115,
-		7, 12, 0, 0, 1115, 1116, 7, 5, 0, 0, 1116, 1117, 7, 0, 0, 0, 1117, 1118,
-		7, 19, 0, 0, 1118, 1119, 7, 11, 0, 0, 1119, 244, 1, 0, 0, 0, 1120, 1121,
-		7, 5, 0, 0, 1121, 1122, 7, 7, 0, 0, 1122, 246, 1, 0, 0, 0, 1123, 1124,
-		7, 5, 0, 0, 1124, 1125, 7, 7, 0, 0, 1125, 1126, 7, 24, 0, 0, 1126, 1127,
-		7, 4, 0, 0, 1127, 1128, 7, 9, 0, 0, 1128, 248, 1, 0, 0, 0, 1129, 1130,
-		7, 5, 0, 0, 1130, 1131, 7, 3, 0, 0, 1131, 1132, 7, 16, 0, 0, 1132, 1133,
-		7, 2, 0, 0, 1133, 1134, 7, 2, 0, 0, 1134, 1135, 7, 4, 0, 0, 1135, 1136,
-		7, 3, 0, 0, 1136, 250, 1, 0, 0, 0, 1137, 1138, 7, 5, 0, 0, 1138, 1139,
-		7, 3, 0, 0, 1139, 1140, 7, 14, 0, 0, 1140, 1141, 7, 4, 0, 0, 1141, 252,
-		1, 0, 0, 0, 1142, 1143, 7, 5, 0, 0, 1143, 1144, 7, 3, 0, 0, 1144, 1145,
-		7, 14, 0, 0, 1145, 1146, 7, 9, 0, 0, 1146, 1147, 7, 13, 0, 0, 1147, 1148,
-		7, 0, 0, 0, 1148, 1149, 7, 5, 0, 0, 1149, 1150, 7, 4, 0, 0, 1150, 254,
-		1, 0, 0, 0, 1151, 1152, 7, 5, 0, 0, 1152, 1153, 7, 5, 0, 0, 1153, 1154,
-		7, 6, 0, 0, 1154, 256, 1, 0, 0, 0, 1155, 1156, 7, 5, 0, 0, 1156, 1157,
-		7, 8, 0, 0, 1157, 1158, 7, 7, 0, 0, 1158, 258, 1, 0, 0, 0, 1159, 1160,
-		7, 5, 0, 0, 1160, 1161, 7, 10, 0, 0, 1161, 1162, 7, 11, 0, 0, 1162, 1163,
-		7, 4, 0, 0, 1163, 260, 1, 0, 0, 0, 1164, 1165, 7, 14, 0, 0, 1165, 1166,
-		7, 9, 0, 0, 1166, 1167, 7, 6, 0, 0, 1167, 1168, 7, 7, 0, 0, 1168, 1169,
-		7, 2, 0, 0, 1169, 1170, 7, 2, 0, 0, 1170, 1171, 7, 4, 0, 0, 1171, 1172,
-		7, 1, 0, 0, 1172, 262, 1, 0, 0, 0, 1173, 1174, 7, 14, 0, 0, 1174, 1175,
-		7, 11, 0, 0, 1175, 1176, 7, 1, 0, 0, 1176, 1177, 7, 0, 0, 0, 1177, 1178,
-		7, 5, 0, 0, 1178, 1179, 7, 4, 0, 0, 1179, 264, 1, 0, 0, 0, 1180, 1181,
-		7, 14, 0, 0, 1181, 1182, 7, 12, 0, 0, 1182, 1183, 7, 4, 0, 0, 1183, 266,
-		1, 0, 0, 0, 1184, 1185, 7, 14, 0, 0, 1185, 1186, 7, 12, 0, 0, 1186, 1187,
-		7, 4, 0, 0, 1187, 1188, 7, 3, 0, 0, 1188, 268, 1, 0, 0, 0, 1189, 1190,
-		7, 14, 0, 0, 1190, 1191, 7, 12, 0, 0, 1191, 1192, 7, 16, 0, 0, 1192, 1193,
-		7, 9, 0, 0, 1193, 1194, 7, 2, 0, 0, 1194, 270, 1, 0, 0, 0, 1195, 1196,
-		7, 14, 0, 0, 1196, 1197, 7, 14, 0, 0, 1197, 1198, 7, 16, 0, 0, 1198, 1199,
-		7, 1, 0, 0, 1199, 272, 1, 0, 0, 0, 1200, 1201, 7, 25, 0, 0, 1201, 1202,
-		7, 0, 0, 0, 1202, 1203, 7, 6, 0, 0, 1203, 1204, 7, 14, 0, 0, 1204, 1205,
-		7, 4, 0, 0, 1205, 1206, 7, 12, 0, 0, 1206, 274, 1, 0, 0, 0, 1207, 1208,
-		7, 25, 0, 0, 1208, 1209, 7, 16, 0, 0, 1209, 1210, 7, 4, 0, 0, 1210, 1211,
-		7, 8, 0, 0, 1211, 276, 1, 0, 0, 0, 1212, 1213, 7, 8, 0, 0, 1213, 1214,
-		7, 15, 0, 0, 1214, 1215, 7, 4, 0, 0, 1215, 1216, 7, 3, 0, 0, 1216, 1217,
-		7, 4, 0, 0, 1217, 278, 1, 0, 0, 0, 1218, 1219, 7, 8, 0, 0, 1219, 1220,
-		7, 16, 0, 0, 1220, 1221, 7, 5, 0, 0, 1221, 1222, 7, 15, 0, 0, 1222, 280,
-		1, 0, 0, 0, 1223, 1224, 7, 8, 0, 0, 1224, 1225, 7, 3, 0, 0, 1225, 1226,
-		7, 16, 0, 0, 1226, 1227, 7, 5, 0, 0, 1227, 1228, 7, 4, 0, 0, 1228, 1229,
-		7, 5, 0, 0, 1229, 1230, 7, 16, 0, 0, 1230, 1231, 7, 19, 0, 0, 1231, 1232,
-		7, 4, 0, 0, 1232, 282, 1, 0, 0, 0, 1233, 1234, 7, 0, 0, 0, 1234, 1235,
-		7, 12, 0, 0, 1235, 1236, 7, 13, 0, 0, 1236, 1237, 7, 16, 0, 0, 1237, 1238,
-		7, 16, 0, 0, 1238, 284, 1, 0, 0, 0, 1239, 1240, 7, 18, 0, 0, 1240, 1241,
-		7, 16, 0, 0, 1241, 1242, 7, 2, 0, 0, 1242, 1243, 7, 16, 0, 0, 1243, 1244,
-		7, 9, 0, 0, 1244, 1245, 7, 5, 0, 0, 1245, 286, 1, 0, 0, 0, 1246, 1247,
-		7, 18, 0, 0, 1247, 1248, 7, 6, 0, 0, 1248, 1249, 7, 7, 0, 0, 1249, 1250,
-		7, 18, 0, 0, 1250, 288, 1, 0, 0, 0, 1251, 1252, 7, 18, 0, 0, 1252, 1253,
-		7, 7, 0, 0, 1253, 1254, 7, 7, 0, 0, 1254, 1255, 7, 6, 0, 0, 1255, 1256,
-		7, 4, 0, 0, 1256, 1257, 7, 0, 0, 0, 1257, 1258, 7, 9, 0, 0, 1258, 290,
-		1, 0, 0, 0, 1259, 1260, 7, 13, 0, 0, 1260, 1261, 7, 7, 0, 0, 1261, 1262,
-		7, 14, 0, 0, 1262, 1263, 7, 9, 0, 0, 1263, 1264, 7, 5, 0, 0, 1264, 1265,
-		7, 4, 0, 0, 1265, 1266, 7, 3, 0, 0, 1266, 292, 1, 0, 0, 0, 1267, 1268,
-		7, 1, 0, 0, 1268, 1269, 7, 0, 0, 0, 1269, 1270, 7, 5, 0, 0, 1270, 1271,
-		7, 4, 0, 0, 1271, 294, 1, 0, 0, 0, 1272, 1273, 7, 1, 0, 0, 1273, 1274,
-		7, 4, 0, 0, 1274, 1275, 7, 13, 0, 0, 1275, 1276, 7, 16, 0, 0, 1276, 1277,
-		7, 19, 0, 0, 1277, 1278, 7, 0, 0, 0, 1278, 1279, 7, 6, 0, 0, 1279, 296,
-		1, 0, 0, 0, 1280, 1281, 7, 1, 0, 0, 1281, 1282, 7, 7, 0, 0, 1282, 1283,
-		7, 14, 0, 0, 1283, 1284, 7, 18, 0, 0, 1284, 1285, 7, 6, 0, 0, 1285, 1286,
-		7, 4, 0, 0, 1286, 298, 1, 0, 0, 0, 1287, 1288, 7, 20, 0, 0, 1288, 1289,
-		7, 6, 0, 0, 1289, 1290, 7, 7, 0, 0, 1290, 1291, 7, 0, 0, 0, 1291, 1292,
-		7, 5, 0, 0, 1292, 300, 1, 0, 0, 0, 1293, 1294, 7, 20, 0, 0, 1294, 1295,
-		7, 3, 0, 0, 1295, 1296, 7, 7, 0, 0, 1296, 1297, 7, 17, 0, 0, 1297, 1298,
-		7, 4, 0, 0, 1298, 1299, 7, 9, 0, 0, 1299, 302, 1, 0, 0, 0, 1300, 1301,
-		7, 16, 0, 0, 1301, 1302, 7, 9, 0, 0, 1302, 1303, 7, 4, 0, 0, 1303, 1304,
-		7, 5, 0, 0, 1304, 304, 1, 0, 0, 0, 1305, 1306, 7, 16, 0, 0, 1306, 1307,
-		7, 9, 0, 0, 1307, 1308, 7, 5, 0, 0, 1308, 306, 1, 0, 0, 0, 1309, 1310,
-		7, 6, 0, 0, 1310, 1311, 7, 16, 0, 0, 1311, 1312, 7, 12, 0, 0, 1312, 1313,
-		7, 5, 0, 0, 1313, 308, 1, 0, 0, 0, 1314, 1315, 7, 19, 0, 0, 1315, 1316,
-		7, 0, 0, 0, 1316, 1317, 7, 11, 0, 0, 1317, 310, 1, 0, 0, 0, 1318, 1319,
-		7, 12, 0, 0, 1319, 1320, 7, 19, 0, 0, 1320, 1321, 7, 0, 0, 0, 1321, 1322,
-		7, 6, 0, 0, 1322, 1323, 7, 6, 0, 0, 1323, 1324, 7, 16, 0, 0, 1324, 1325,
-		7, 9, 0, 0, 1325, 1326, 7, 5, 0, 0, 1326, 312, 1, 0, 0, 0, 1327, 1328,
-		7, 5, 0, 0, 1328, 1329, 7, 4, 0, 0, 1329, 1330, 7, 22, 0, 0, 1330, 1331,
-		7, 5, 0, 0, 1331, 314, 1, 0, 0, 0, 1332, 1333, 7, 5, 0, 0, 1333, 1334,
-		7, 16, 0, 0, 1334, 1335, 7, 19, 0, 0, 1335, 1336, 7, 4, 0, 0, 1336, 1337,
-		7, 14, 0, 0, 1337, 1338, 7, 14, 0, 0, 1338, 1339, 7, 16, 0, 0, 1339, 1340,
-		7, 1, 0, 0, 1340, 316, 1, 0, 0, 0, 1341, 1342, 7, 5, 0, 0, 1342, 1343,
-		7, 16, 0, 0, 1343, 1344, 7, 19, 0, 0, 1344, 1345, 7, 4, 0, 0, 1345, 318,
-		1, 0, 0, 0, 1346, 1347, 7, 5, 0, 0, 1347, 1348, 7, 16, 0, 0, 1348, 1349,
-		7, 9, 0, 0, 1349, 1350, 7, 10, 0, 0, 1350, 1351, 7, 16, 0, 0, 1351, 1352,
-		7, 9, 0, 0, 1352, 1353, 7, 5, 0, 0, 1353, 320, 1, 0, 0, 0, 1354, 1355,
-		7, 5, 0, 0, 1355, 1356, 7, 14, 0, 0, 1356, 1357, 7, 11, 0, 0, 1357, 1358,
-		7, 6, 0, 0, 1358, 1359, 7, 4, 0, 0, 1359, 322, 1, 0, 0, 0, 1360, 1361,
-		7, 25, 0, 0, 1361, 1362, 7, 0, 0, 0, 1362, 1363, 7, 3, 0, 0, 1363, 1364,
-		7, 13, 0, 0, 1364, 1365, 7, 15, 0, 0, 1365, 1366, 7, 0, 0, 0, 1366, 1367,
-		7, 3, 0, 0, 1367, 324, 1, 0, 0, 0, 1368, 1369, 7, 25, 0, 0, 1369, 1370,
-		7, 0, 0, 0, 1370, 1371, 7, 3, 0, 0, 1371, 1372, 7, 16, 0, 0, 1372, 1373,
-		7, 9, 0, 0, 1373, 1374, 7, 5, 0, 0, 1374, 326, 1, 0, 0, 0, 1375, 1376,
-		5, 36, 0, 0, 1376, 1377, 5, 36, 0, 0, 1377, 1383, 1, 0, 0, 0, 1378, 1382,
-		8, 26, 0, 0, 1379, 1380, 5, 36, 0, 0, 1380, 1382, 8, 26, 0, 0, 1381, 1378,
-		1, 0, 0, 0, 1381, 1379, 1, 0, 0, 0, 1382, 1385, 1, 0, 0, 0, 1383, 1381,
-		1, 0, 0, 0, 1383, 1384, 1, 0, 0, 0, 1384, 1386, 1, 0, 0, 0, 1385, 1383,
-		1, 0, 0, 0, 1386, 1387, 5, 36, 0, 0, 1387, 1388, 5, 36, 0, 0, 1388, 328,
-		1, 0, 0, 0, 1389, 1397, 5, 39, 0, 0, 1390, 1391, 5, 92, 0, 0, 1391, 1396,
-		9, 0, 0, 0, 1392, 1393, 5, 39, 0, 0, 1393, 1396, 5, 39, 0, 0, 1394, 1396,
-		8, 27, 0, 0, 1395, 1390, 1, 0, 0, 0, 1395, 1392, 1, 0, 0, 0, 1395, 1394,
-		1, 0, 0, 0, 1396, 1399, 1, 0, 0, 0, 1397, 1395, 1, 0, 0, 0, 1397, 1398,
-		1, 0, 0, 0, 1398, 1400, 1, 0, 0, 0, 1399, 1397, 1, 0, 0, 0, 1400, 1401,
-		5, 39, 0, 0, 1401, 330, 1, 0, 0, 0, 1402, 1404, 3, 355, 177, 0, 1403, 1402,
-		1, 0, 0, 0, 1404, 1405, 1, 0, 0, 0, 1405, 1403, 1, 0, 0, 0, 1405, 1406,
-		1, 0, 0, 0, 1406, 332, 1, 0, 0, 0, 1407, 1409, 3, 31, 15, 0, 1408, 1407,
-		1, 0, 0, 0, 1408, 1409, 1, 0, 0, 0, 1409, 1411, 1, 0, 0, 0, 1410, 1412,
-		7, 28, 0, 0, 1411, 1410, 1, 0, 0, 0, 1412, 1413, 1, 0, 0, 0, 1413, 1411,
-		1, 0, 0, 0, 1413, 1414, 1, 0, 0, 0, 1414, 1421, 1, 0, 0, 0, 1415, 1417,
-		3, 19, 9, 0, 1416, 1418, 7, 28, 0, 0, 1417, 1416, 1, 0, 0, 0, 1418, 1419,
-		1, 0, 0, 0, 1419, 1417, 1, 0, 0, 0, 1419, 1420, 1, 0, 0, 0, 1420, 1422,
-		1, 0, 0, 0, 1421, 1415, 1, 0, 0, 0, 1421, 1422, 1, 0, 0, 0, 1422, 334,
-		1, 0, 0, 0, 1423, 1424, 7, 22, 0, 0, 1424, 1428, 5, 39, 0, 0, 1425, 1426,
-		3, 353, 176, 0, 1426, 1427, 3, 353, 176, 0, 1427, 1429, 1, 0, 0, 0, 1428,
-		1425, 1, 0, 0, 0, 1429, 1430, 1, 0, 0, 0, 1430, 1428, 1, 0, 0, 0, 1430,
-		1431, 1, 0, 0, 0, 1431, 1432, 1, 0, 0, 0, 1432, 1433, 5, 39, 0, 0, 1433,
-		1443, 1, 0, 0, 0, 1434, 1435, 5, 48, 0, 0, 1435, 1436, 7, 22, 0, 0, 1436,
-		1438, 1, 0, 0, 0, 1437, 1439, 3, 353, 176, 0, 1438, 1437, 1, 0, 0, 0, 1439,
-		1440, 1, 0, 0, 0, 1440, 1438, 1, 0, 0, 0, 1440, 1441, 1, 0, 0, 0, 1441,
-		1443, 1, 0, 0, 0, 1442, 1423, 1, 0, 0, 0, 1442, 1434, 1, 0, 0, 0, 1443,
-		336, 1, 0, 0, 0, 1444, 1446, 3, 355, 177, 0, 1445, 1444, 1, 0, 0, 0, 1446,
-		1447, 1, 0, 0, 0, 1447, 1445, 1, 0, 0, 0, 1447, 1448, 1, 0, 0, 0, 1448,
-		1450, 1, 0, 0, 0, 1449, 1451, 5, 46, 0, 0, 1450, 1449, 1, 0, 0, 0, 1450,
-		1451, 1, 0, 0, 0, 1451, 1452, 1, 0, 0, 0, 1452, 1453, 3, 357, 178, 0, 1453,
-		1470, 1, 0, 0, 0, 1454, 1456, 3, 355, 177, 0, 1455, 1454, 1, 0, 0, 0, 1456,
-		1459, 1, 0, 0, 0, 1457, 1455, 1, 0, 0, 0, 1457, 1458, 1, 0, 0, 0, 1458,
-		1460, 1, 0, 0, 0, 1459, 1457, 1, 0, 0, 0, 1460, 1462, 5, 46, 0, 0, 1461,
-		1463, 3, 355, 177, 0, 1462, 1461, 1, 0, 0, 0, 1463, 1464, 1, 0, 0, 0, 1464,
-		1462, 1, 0, 0, 0, 1464, 1465, 1, 0, 0, 0, 1465, 1467, 1, 0, 0, 0, 1466,
-		1468, 3, 357, 178, 0, 1467, 1466, 1, 0, 0, 0, 1467, 1468, 1, 0, 0, 0, 1468,
-		1470, 1, 0, 0, 0, 1469, 1445, 1, 0, 0, 0, 1469, 1457, 1, 0, 0, 0, 1470,
-		338, 1, 0, 0, 0, 1471, 1475, 7, 29, 0, 0, 1472, 1474, 7, 30, 0, 0, 1473,
-		1472, 1, 0, 0, 0, 1474, 1477, 1, 0, 0, 0, 1475, 1473, 1, 0, 0, 0, 1475,
-		1476, 1, 0, 0, 0, 1476, 1486, 1, 0, 0, 0, 1477, 1475, 1, 0, 0, 0, 1478,
-		1480, 5, 34, 0, 0, 1479, 1481, 8, 31, 0, 0, 1480, 1479, 1, 0, 0, 0, 1481,
-		1482, 1, 0, 0, 0, 1482, 1480, 1, 0, 0, 0, 1482, 1483, 1, 0, 0, 0, 1483,
-		1484, 1, 0, 0, 0, 1484, 1486, 5, 34, 0, 0, 1485, 1471, 1, 0, 0, 0, 1485,
-		1478, 1, 0, 0, 0, 1486, 340, 1, 0, 0, 0, 1487, 1488, 3, 351, 175, 0, 1488,
-		1489, 3, 351, 175, 0, 1489, 1490, 5, 45, 0, 0, 1490, 1491, 3, 351, 175,
-		0, 1491, 1492, 5, 45, 0, 0, 1492, 1493, 3, 351, 175, 0, 1493, 1494, 5,
-		45, 0, 0, 1494, 1495, 3, 351, 175, 0, 1495, 1496, 5, 45, 0, 0, 1496, 1497,
-		3, 351, 175, 0, 1497, 1498, 3, 351, 175, 0, 1498, 1499, 3, 351, 175, 0,
-		1499, 342, 1, 0, 0, 0, 1500, 1502, 7, 32, 0, 0, 1501, 1500, 1, 0, 0, 0,
-		1502, 1503, 1, 0, 0, 0, 1503, 1501, 1, 0, 0, 0, 1503, 1504, 1, 0, 0, 0,
-		1504, 1505, 1, 0, 0, 0, 1505, 1506, 6, 171, 0, 0, 1506, 344, 1, 0, 0, 0,
-		1507, 1508, 5, 47, 0, 0, 1508, 1509, 5, 42, 0, 0, 1509, 1510, 5, 33, 0,
-		0, 1510, 1512, 1, 0, 0, 0, 1511, 1513, 9, 0, 0, 0, 1512, 1511, 1, 0, 0,
-		0, 1513, 1514, 1, 0, 0, 0, 1514, 1515, 1, 0, 0, 0, 1514, 1512, 1, 0, 0,
-		0, 1515, 1516, 1, 0, 0, 0, 1516, 1517, 5, 42, 0, 0, 1517, 1518, 5, 47,
-		0, 0, 1518, 1519, 1, 0, 0, 0, 1519, 1520, 6, 172, 0, 0, 1520, 346, 1, 0,
-		0, 0, 1521, 1522, 5, 47, 0, 0, 1522, 1523, 5, 42, 0, 0, 1523, 1527, 1,
-		0, 0, 0, 1524, 1526, 9, 0, 0, 0, 1525, 1524, 1, 0, 0, 0, 1526, 1529, 1,
-		0, 0, 0, 1527, 1528, 1, 0, 0, 0, 1527, 1525, 1, 0, 0, 0, 1528, 1530, 1,
-		0, 0, 0, 1529, 1527, 1, 0, 0, 0, 1530, 1531, 5, 42, 0, 0, 1531, 1532, 5,
-		47, 0, 0, 1532, 1533, 1, 0, 0, 0, 1533, 1534, 6, 173, 0, 0, 1534, 348,
-		1, 0, 0, 0, 1535, 1536, 5, 45, 0, 0, 1536, 1537, 5, 45, 0, 0, 1537, 1542,
-		5, 32, 0, 0, 1538, 1542, 5, 35, 0, 0, 1539, 1540, 5, 47, 0, 0, 1540, 1542,
-		5, 47, 0, 0, 1541, 1535, 1, 0, 0, 0, 1541, 1538, 1, 0, 0, 0, 1541, 1539,
-		1, 0, 0, 0, 1542, 1546, 1, 0, 0, 0, 1543, 1545, 8, 33, 0, 0, 1544, 1543,
-		1, 0, 0, 0, 1545, 1548, 1, 0, 0, 0, 1546, 1544, 1, 0, 0, 0, 1546, 1547,
-		1, 0, 0, 0, 1547, 1554, 1, 0, 0, 0, 1548, 1546, 1, 0, 0, 0, 1549, 1551,
-		5, 13, 0, 0, 1550, 1549, 1, 0, 0, 0, 1550, 1551, 1, 0, 0, 0, 1551, 1552,
-		1, 0, 0, 0, 1552, 1555, 5, 10, 0, 0, 1553, 1555, 5, 0, 0, 1, 1554, 1550,
-		1, 0, 0, 0, 1554, 1553, 1, 0, 0, 0, 1555, 1567, 1, 0, 0, 0, 1556, 1557,
-		5, 45, 0, 0, 1557, 1558, 5, 45, 0, 0, 1558, 1564, 1, 0, 0, 0, 1559, 1561,
-		5, 13, 0, 0, 1560, 1559, 1, 0, 0, 0, 1560, 1561, 1, 0, 0, 0, 1561, 1562,
-		1, 0, 0, 0, 1562, 1565, 5, 10, 0, 0, 1563, 1565, 5, 0, 0, 1, 1564, 1560,
-		1, 0, 0, 0, 1564, 1563, 1, 0, 0, 0, 1565, 1567, 1, 0, 0, 0, 1566, 1541,
-		1, 0, 0, 0, 1566, 1556, 1, 0, 0, 0, 1567, 1568, 1, 0, 0, 0, 1568, 1569,
-		6, 174, 0, 0, 1569, 350, 1, 0, 0, 0, 1570, 1571, 7, 34, 0, 0, 1571, 1572,
-		7, 34, 0, 0, 1572, 1573, 7, 34, 0, 0, 1573, 1574, 7, 34, 0, 0, 1574, 352,
-		1, 0, 0, 0, 1575, 1576, 7, 34, 0, 0, 1576, 354, 1, 0, 0, 0, 1577, 1578,
-		7, 28, 0, 0, 1578, 356, 1, 0, 0, 0, 1579, 1581, 7, 4, 0, 0, 1580, 1582,
-		5, 45, 0, 0, 1581, 1580, 1, 0, 0, 0, 1581, 1582, 1, 0, 0, 0, 1582, 1584,
-		1, 0, 0, 0, 1583, 1585, 3, 355, 177, 0, 1584, 1583, 1, 0, 0, 0, 1585, 1586,
-		1, 0, 0, 0, 1586, 1584, 1, 0, 0, 0, 1586, 1587, 1, 0, 0, 0, 1587, 358,
-		1, 0, 0, 0, 34, 0, 1381, 1383, 1395, 1397, 1405, 1408, 1413, 1419, 1421,
-		1430, 1440, 1442, 1447, 1450, 1457, 1464, 1467, 1469, 1475, 1482, 1485,
-		1503, 1514, 1527, 1541, 1546, 1550, 1554, 1560, 1564, 1566, 1581, 1586,
-		1, 0, 1, 0,
+		2, 176, 7, 176, 2, 177, 7, 177, 2, 178, 7, 178, 2, 179, 7, 179, 2, 180,
+		7, 180, 2, 181, 7, 181, 2, 182, 7, 182, 2, 183, 7, 183, 2, 184, 7, 184,
+		2, 185, 7, 185, 2, 186, 7, 186, 2, 187, 7, 187, 2, 188, 7, 188, 2, 189,
+		7, 189, 2, 190, 7, 190, 2, 191, 7, 191, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1,
+		2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1,
+		8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13,
+		1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1,
+		18, 1, 19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22,
+		1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1,
+		24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29,
+		1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1,
+		31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 34,
+		1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1,
+		35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37,
+		1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1,
+		39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41,
+		1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1,
+		42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43,
+		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1,
+		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1,
+		46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47,
+		1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
+		49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1,
+		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53,
+		1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1,
+		54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55,
+		1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 1,
+		56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57,
+		1, 57, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1,
+		58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60,
+		1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1,
+		61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1,
+		64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65,
+		1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 67, 1,
+		67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69,
+		1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1, 71, 1,
+		71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72,
+		1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1,
+		74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75,
+		1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1,
+		77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79,
+		1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1,
+		81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82,
+		1, 82, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1,
+		83, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 85, 1, 85,
+		1, 85, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87,
+		1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1,
+		88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 90, 1, 90,
+		1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1,
+		90, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92,
+		1, 92, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1,
+		93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94,
+		1, 94, 1, 94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 96, 1, 96, 1,
+		96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99,
+		1, 99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 101, 1, 101, 1,
+		101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 102, 1, 102, 1, 102, 1,
+		103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 104, 1, 104, 1, 104, 1,
+		104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105, 1,
+		105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 106, 1, 106, 1,
+		106, 1, 106, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1,
+		107, 1, 107, 1, 107, 1, 107, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1,
+		108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 109, 1, 109, 1,
+		109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 110, 1, 110, 1, 110, 1,
+		110, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1,
+		111, 1, 111, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1,
+		112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1,
+		113, 1, 113, 1, 113, 1, 113, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1,
+		114, 1, 114, 1, 114, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1,
+		115, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 117, 1, 117, 1, 117, 1,
+		117, 1, 117, 1, 117, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1,
+		118, 1, 119, 1, 119, 1, 119, 1, 119, 1, 119, 1, 119, 1, 119, 1, 120, 1,
+		120, 1, 120, 1, 120, 1, 121, 1, 121, 1, 121, 1, 121, 1, 121, 1, 121, 1,
+		122, 1, 122, 1, 122, 1, 122, 1, 122, 1, 122, 1, 122, 1, 123, 1, 123, 1,
+		123, 1, 123, 1, 123, 1, 123, 1, 123, 1, 123, 1, 124, 1, 124, 1, 124, 1,
+		124, 1, 124, 1, 124, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1,
+		125, 1, 125, 1, 125, 1, 125, 1, 126, 1, 126, 1, 126, 1, 126, 1, 126, 1,
+		126, 1, 127, 1, 127, 1, 127, 1, 127, 1, 127, 1, 127, 1, 127, 1, 128, 1,
+		128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 129, 1, 129, 1, 129, 1, 129, 1,
+		129, 1, 129, 1, 129, 1, 129, 1, 129, 1, 129, 1, 130, 1, 130, 1, 130, 1,
+		131, 1, 131, 1, 131, 1, 131, 1, 131, 1, 131, 1, 132, 1, 132, 1, 132, 1,
+		132, 1, 132, 1, 132, 1, 132, 1, 132, 1, 133, 1, 133, 1, 133, 1, 133, 1,
+		133, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1,
+		134, 1, 135, 1, 135, 1, 135, 1, 135, 1, 136, 1, 136, 1, 136, 1, 136, 1,
+		137, 1, 137, 1, 137, 1, 137, 1, 137, 1, 138, 1, 138, 1, 138, 1, 138, 1,
+		138, 1, 138, 1, 139, 1, 139, 1, 139, 1, 139, 1, 139, 1, 139, 1, 139, 1,
+		139, 1, 139, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1,
+		141, 1, 141, 1, 141, 1, 141, 1, 142, 1, 142, 1, 142, 1, 142, 1, 142, 1,
+		143, 1, 143, 1, 143, 1, 143, 1, 143, 1, 143, 1, 144, 1, 144, 1, 144, 1,
+		144, 1, 144, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1,
+		146, 1, 146, 1, 146, 1, 146, 1, 146, 1, 147, 1, 147, 1, 147, 1, 147, 1,
+		147, 1, 147, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148, 1, 149, 1, 149, 1,
+		149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 150, 1,
+		150, 1, 150, 1, 150, 1, 150, 1, 150, 1, 151, 1, 151, 1, 151, 1, 151, 1,
+		151, 1, 151, 1, 151, 1, 152, 1, 152, 1, 152, 1, 152, 1, 152, 1, 153, 1,
+		153, 1, 153, 1, 153, 1, 153, 1, 153, 1, 153, 1, 153, 1, 154, 1, 154, 1,
+		154, 1, 154, 1, 154, 1, 154, 1, 154, 1, 154, 1, 155, 1, 155, 1, 155, 1,
+		155, 1, 155, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156, 1,
+		156, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 158, 1,
+		158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 159, 1,
+		159, 1, 159, 1, 159, 1, 159, 1, 159, 1, 160, 1, 160, 1, 160, 1, 160, 1,
+		160, 1, 160, 1, 160, 1, 161, 1, 161, 1, 161, 1, 161, 1, 161, 1, 162, 1,
+		162, 1, 162, 1, 162, 1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 1, 164, 1,
+		164, 1, 164, 1, 164, 1, 165, 1, 165, 1, 165, 1, 165, 1, 165, 1, 165, 1,
+		165, 1, 165, 1, 165, 1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 167, 1,
+		167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 168, 1,
+		168, 1, 168, 1, 168, 1, 168, 1, 169, 1, 169, 1, 169, 1, 169, 1, 169, 1,
+		169, 1, 169, 1, 169, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1,
+		171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 172, 1,
+		172, 1, 172, 1, 172, 1, 172, 1, 172, 1, 172, 1, 173, 1, 173, 1, 173, 1,
+		173, 1, 173, 1, 173, 1, 173, 1, 174, 1, 174, 1, 174, 1, 174, 1, 174, 1,
+		174, 5, 174, 1486, 8, 174, 10, 174, 12, 174, 1489, 9, 174, 1, 174, 1, 174,
+		1, 174, 1, 175, 1, 175, 1, 175, 1, 175, 1, 175, 1, 175, 5, 175, 1500, 8,
+		175, 10, 175, 12, 175, 1503, 9, 175, 1, 175, 1, 175, 1, 176, 4, 176, 1508,
+		8, 176, 11, 176, 12, 176, 1509, 1, 176, 1, 176, 4, 176, 1514, 8, 176, 11,
+		176, 12, 176, 1515, 1, 177, 4, 177, 1519, 8, 177, 11, 177, 12, 177, 1520,
+		1, 178, 4, 178, 1524, 8, 178, 11, 178, 12, 178, 1525, 1, 178, 1, 178, 4,
+		178, 1530, 8, 178, 11, 178, 12, 178, 1531, 3, 178, 1534, 8, 178, 1, 179,
+		1, 179, 1, 179, 1, 179, 1, 179, 4, 179, 1541, 8, 179, 11, 179, 12, 179,
+		1542, 1, 179, 1, 179, 1, 179, 1, 179, 1, 179, 1, 179, 4, 179, 1551, 8,
+		179, 11, 179, 12, 179, 1552, 3, 179, 1555, 8, 179, 1, 180, 4, 180, 1558,
+		8, 180, 11, 180, 12, 180, 1559, 1, 180, 3, 180, 1563, 8, 180, 1, 180, 1,
+		180, 1, 180, 5, 180, 1568, 8, 180, 10, 180, 12, 180, 1571, 9, 180, 1, 180,
+		1, 180, 4, 180, 1575, 8, 180, 11, 180, 12, 180, 1576, 1, 180, 3, 180, 1580,
+		8, 180, 3, 180, 1582, 8, 180, 1, 181, 1, 181, 5, 181, 1586, 8, 181, 10,
+		181, 12, 181, 1589, 9, 181, 1, 181, 1, 181, 4, 181, 1593, 8, 181, 11, 181,
+		12, 181, 1594, 1, 181, 3, 181, 1598, 8, 181, 1, 182, 1, 182, 1, 182, 1,
+		182, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182, 1,
+		182, 1, 183, 4, 183, 1614, 8, 183, 11, 183, 12, 183, 1615, 1, 183, 1, 183,
+		1, 184, 1, 184, 1, 184, 1, 184, 1, 184, 4, 184, 1625, 8, 184, 11, 184,
+		12, 184, 1626, 1, 184, 1, 184, 1, 184, 1, 184, 1, 184, 1, 185, 1, 185,
+		1, 185, 1, 185, 5, 185, 1638, 8, 185, 10, 185, 12, 185, 1641, 9, 185, 1,
+		185, 1, 185, 1, 185, 1, 185, 1, 185, 1, 186, 1, 186, 1, 186, 1, 186, 1,
+		186, 1, 186, 3, 186, 1654, 8, 186, 1, 186, 5, 186, 1657, 8, 186, 10, 186,
+		12, 186, 1660, 9, 186, 1, 186, 3, 186, 1663, 8, 186, 1, 186, 1, 186, 3,
+		186, 1667, 8, 186, 1, 186, 1, 186, 1, 186, 1, 186, 3, 186, 1673, 8, 186,
+		1, 186, 1, 186, 3, 186, 1677, 8, 186, 3, 186, 1679, 8, 186, 1, 186, 1,
+		186, 1, 187, 1, 187, 1, 187, 1, 187, 1, 187, 1, 188, 1, 188, 1, 189, 1,
+		189, 1, 190, 1, 190, 1, 190, 1, 190, 1, 190, 1, 190, 1, 190, 1, 190, 1,
+		190, 1, 190, 1, 190, 1, 190, 3, 190, 1704, 8, 190, 1, 191, 1, 191, 3, 191,
+		1708, 8, 191, 1, 191, 4, 191, 1711, 8, 191, 11, 191, 12, 191, 1712, 2,
+		1626, 1639, 0, 192, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8,
+		17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17,
+		35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24, 49, 25, 51, 26,
+		53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 32, 65, 33, 67, 34, 69, 35,
+		71, 36, 73, 37, 75, 38, 77, 39, 79, 40, 81, 41, 83, 42, 85, 43, 87, 44,
+		89, 45, 91, 46, 93, 47, 95, 48, 97, 49, 99, 50, 101, 51, 103, 52, 105,
+		53, 107, 54, 109, 55, 111, 56, 113, 57, 115, 58, 117, 59, 119, 60, 121,
+		61, 123, 62, 125, 63, 127, 64, 129, 65, 131, 66, 133, 67, 135, 68, 137,
+		69, 139, 70, 141, 71, 143, 72, 145, 73, 147, 74, 149, 75, 151, 76, 153,
+		77, 155, 78, 157, 79, 159, 80, 161, 81, 163, 82, 165, 83, 167, 84, 169,
+		85, 171, 86, 173, 87, 175, 88, 177, 89, 179, 90, 181, 91, 183, 92, 185,
+		93, 187, 94, 189, 95, 191, 96, 193, 97, 195, 98, 197, 99, 199, 100, 201,
+		101, 203, 102, 205, 103, 207, 104, 209, 105, 211, 106, 213, 107, 215, 108,
+		217, 109, 219, 110, 221, 111, 223, 112, 225, 113, 227, 114, 229, 115, 231,
+		116, 233, 117, 235, 118, 237, 119, 239, 120, 241, 121, 243, 122, 245, 123,
+		247, 124, 249, 125, 251, 126, 253, 127, 255, 128, 257, 129, 259, 130, 261,
+		131, 263, 132, 265, 133, 267, 134, 269, 135, 271, 136, 273, 137, 275, 138,
+		277, 139, 279, 140, 281, 141, 283, 142, 285, 143, 287, 144, 289, 145, 291,
+		146, 293, 147, 295, 148, 297, 149, 299, 150, 301, 151, 303, 152, 305, 153,
+		307, 154, 309, 155, 311, 156, 313, 157, 315, 158, 317, 159, 319, 160, 321,
+		161, 323, 162, 325, 163, 327, 164, 329, 165, 331, 166, 333, 167, 335, 168,
+		337, 169, 339, 170, 341, 171, 343, 172, 345, 173, 347, 174, 349, 175, 351,
+		176, 353, 177, 355, 178, 357, 179, 359, 180, 361, 181, 363, 182, 365, 183,
+		367, 184, 369, 185, 371, 186, 373, 187, 375, 0, 377, 0, 379, 0, 381, 0,
+		383, 0, 1, 0, 37, 2, 0, 65, 65, 97, 97, 2, 0, 68, 68, 100, 100, 2, 0, 71,
+		71, 103, 103, 2, 0, 82, 82, 114, 114, 2, 0, 69, 69, 101, 101, 2, 0, 84,
+		84, 116, 116, 2, 0, 83, 83, 115, 115, 2, 0, 76, 76, 108, 108, 2, 0, 79,
+		79, 111, 111, 2, 0, 87, 87, 119, 119, 2, 0, 78, 78, 110, 110, 2, 0, 89,
+		89, 121, 121, 2, 0, 80, 80, 112, 112, 2, 0, 67, 67, 99, 99, 2, 0, 85, 85,
+		117, 117, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 90, 90,
+		122, 122, 2, 0, 66, 66, 98, 98, 2, 0, 77, 77, 109, 109, 2, 0, 70, 70, 102,
+		102, 2, 0, 81, 81, 113, 113, 2, 0, 88, 88, 120, 120, 2, 0, 74, 74, 106,
+		106, 2, 0, 75, 75, 107, 107, 2, 0, 86, 86, 118, 118, 1, 0, 36, 36, 2, 0,
+		39, 39, 92, 92, 1, 0, 48, 57, 2, 0, 65, 90, 97, 122, 5, 0, 36, 36, 48,
+		57, 65, 90, 95, 95, 97, 122, 1, 0, 34, 34, 3, 0, 9, 10, 13, 13, 32, 32,
+		2, 0, 10, 10, 13, 13, 3, 0, 48, 57, 65, 70, 97, 102, 10, 0, 68, 68, 72,
+		72, 77, 77, 83, 83, 87, 87, 100, 100, 104, 104, 109, 109, 115, 115, 119,
+		119, 2, 0, 181, 181, 924, 924, 1750, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0,
+		0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0,
+		0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0,
+		0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0,
+		0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1,
+		0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43,
+		1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0,
+		51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0,
+		0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0,
+		0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0,
+		0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1,
+		0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89,
+		1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0,
+		97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0,
+		0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111,
+		1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0,
+		0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1,
+		0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0,
+		133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0,
+		0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147,
+		1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0,
+		0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1,
+		0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0,
+		169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1, 0,
+		0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183,
+		1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0,
+		0, 191, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1,
+		0, 0, 0, 0, 199, 1, 0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0,
+		205, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0, 0, 209, 1, 0, 0, 0, 0, 211, 1, 0,
+		0, 0, 0, 213, 1, 0, 0, 0, 0, 215, 1, 0, 0, 0, 0, 217, 1, 0, 0, 0, 0, 219,
+		1, 0, 0, 0, 0, 221, 1, 0, 0, 0, 0, 223, 1, 0, 0, 0, 0, 225, 1, 0, 0, 0,
+		0, 227, 1, 0, 0, 0, 0, 229, 1, 0, 0, 0, 0, 231, 1, 0, 0, 0, 0, 233, 1,
+		0, 0, 0, 0, 235, 1, 0, 0, 0, 0, 237, 1, 0, 0, 0, 0, 239, 1, 0, 0, 0, 0,
+		241, 1, 0, 0, 0, 0, 243, 1, 0, 0, 0, 0, 245, 1, 0, 0, 0, 0, 247, 1, 0,
+		0, 0, 0, 249, 1, 0, 0, 0, 0, 251, 1, 0, 0, 0, 0, 253, 1, 0, 0, 0, 0, 255,
+		1, 0, 0, 0, 0, 257, 1, 0, 0, 0, 0, 259, 1, 0, 0, 0, 0, 261, 1, 0, 0, 0,
+		0, 263, 1, 0, 0, 0, 0, 265, 1, 0, 0, 0, 0, 267, 1, 0, 0, 0, 0, 269, 1,
+		0, 0, 0, 0, 271, 1, 0, 0, 0, 0, 273, 1, 0, 0, 0, 0, 275, 1, 0, 0, 0, 0,
+		277, 1, 0, 0, 0, 0, 279, 1, 0, 0, 0, 0, 281, 1, 0, 0, 0, 0, 283, 1, 0,
+		0, 0, 0, 285, 1, 0, 0, 0, 0, 287, 1, 0, 0, 0, 0, 289, 1, 0, 0, 0, 0, 291,
+		1, 0, 0, 0, 0, 293, 1, 0, 0, 0, 0, 295, 1, 0, 0, 0, 0, 297, 1, 0, 0, 0,
+		0, 299, 1, 0, 0, 0, 0, 301, 1, 0, 0, 0, 0, 303, 1, 0, 0, 0, 0, 305, 1,
+		0, 0, 0, 0, 307, 1, 0, 0, 0, 0, 309, 1, 0, 0, 0, 0, 311, 1, 0, 0, 0, 0,
+		313, 1, 0, 0, 0, 0, 315, 1, 0, 0, 0, 0, 317, 1, 0, 0, 0, 0, 319, 1, 0,
+		0, 0, 0, 321, 1, 0, 0, 0, 0, 323, 1, 0, 0, 0, 0, 325, 1, 0, 0, 0, 0, 327,
+		1, 0, 0, 0, 0, 329, 1, 0, 0, 0, 0, 331, 1, 0, 0, 0, 0, 333, 1, 0, 0, 0,
+		0, 335, 1, 0, 0, 0, 0, 337, 1, 0, 0, 0, 0, 339, 1, 0, 0, 0, 0, 341, 1,
+		0, 0, 0, 0, 343, 1, 0, 0, 0, 0, 345, 1, 0, 0, 0, 0, 347, 1, 0, 0, 0, 0,
+		349, 1, 0, 0, 0, 0, 351, 1, 0, 0, 0, 0, 353, 1, 0, 0, 0, 0, 355, 1, 0,
+		0, 0, 0, 357, 1, 0, 0, 0, 0, 359, 1, 0, 0, 0, 0, 361, 1, 0, 0, 0, 0, 363,
+		1, 0, 0, 0, 0, 365, 1, 0, 0, 0, 0, 367, 1, 0, 0, 0, 0, 369, 1, 0, 0, 0,
+		0, 371, 1, 0, 0, 0, 0, 373, 1, 0, 0, 0, 1, 385, 1, 0, 0, 0, 3, 387, 1,
+		0, 0, 0, 5, 389, 1, 0, 0, 0, 7, 391, 1, 0, 0, 0, 9, 393, 1, 0, 0, 0, 11,
+		395, 1, 0, 0, 0, 13, 397, 1, 0, 0, 0, 15, 399, 1, 0, 0, 0, 17, 401, 1,
+		0, 0, 0, 19, 403, 1, 0, 0, 0, 21, 405, 1, 0, 0, 0, 23, 407, 1, 0, 0, 0,
+		25, 409, 1, 0, 0, 0, 27, 411, 1, 0, 0, 0, 29, 413, 1, 0, 0, 0, 31, 416,
+		1, 0, 0, 0, 33, 418, 1, 0, 0, 0, 35, 420, 1, 0, 0, 0, 37, 422, 1, 0, 0,
+		0, 39, 424, 1, 0, 0, 0, 41, 426, 1, 0, 0, 0, 43, 428, 1, 0, 0, 0, 45, 431,
+		1, 0, 0, 0, 47, 434, 1, 0, 0, 0, 49, 438, 1, 0, 0, 0, 51, 448, 1, 0, 0,
+		0, 53, 459, 1, 0, 0, 0, 55, 463, 1, 0, 0, 0, 57, 469, 1, 0, 0, 0, 59, 475,
+		1, 0, 0, 0, 61, 479, 1, 0, 0, 0, 63, 483, 1, 0, 0, 0, 65, 487, 1, 0, 0,
+		0, 67, 493, 1, 0, 0, 0, 69, 496, 1, 0, 0, 0, 71, 500, 1, 0, 0, 0, 73, 510,
+		1, 0, 0, 0, 75, 516, 1, 0, 0, 0, 77, 522, 1, 0, 0, 0, 79, 525, 1, 0, 0,
+		0, 81, 532, 1, 0, 0, 0, 83, 537, 1, 0, 0, 0, 85, 548, 1, 0, 0, 0, 87, 556,
+		1, 0, 0, 0, 89, 569, 1, 0, 0, 0, 91, 577, 1, 0, 0, 0, 93, 589, 1, 0, 0,
+		0, 95, 598, 1, 0, 0, 0, 97, 605, 1, 0, 0, 0, 99, 612, 1, 0, 0, 0, 101,
+		619, 1, 0, 0, 0, 103, 624, 1, 0, 0, 0, 105, 633, 1, 0, 0, 0, 107, 642,
+		1, 0, 0, 0, 109, 647, 1, 0, 0, 0, 111, 662, 1, 0, 0, 0, 113, 674, 1, 0,
+		0, 0, 115, 682, 1, 0, 0, 0, 117, 690, 1, 0, 0, 0, 119, 697, 1, 0, 0, 0,
+		121, 703, 1, 0, 0, 0, 123, 713, 1, 0, 0, 0, 125, 723, 1, 0, 0, 0, 127,
+		728, 1, 0, 0, 0, 129, 733, 1, 0, 0, 0, 131, 742, 1, 0, 0, 0, 133, 752,
+		1, 0, 0, 0, 135, 758, 1, 0, 0, 0, 137, 764, 1, 0, 0, 0, 139, 767, 1, 0,
+		0, 0, 141, 770, 1, 0, 0, 0, 143, 776, 1, 0, 0, 0, 145, 785, 1, 0, 0, 0,
+		147, 794, 1, 0, 0, 0, 149, 800, 1, 0, 0, 0, 151, 807, 1, 0, 0, 0, 153,
+		812, 1, 0, 0, 0, 155, 822, 1, 0, 0, 0, 157, 825, 1, 0, 0, 0, 159, 830,
+		1, 0, 0, 0, 161, 834, 1, 0, 0, 0, 163, 839, 1, 0, 0, 0, 165, 848, 1, 0,
+		0, 0, 167, 858, 1, 0, 0, 0, 169, 867, 1, 0, 0, 0, 171, 873, 1, 0, 0, 0,
+		173, 879, 1, 0, 0, 0, 175, 889, 1, 0, 0, 0, 177, 902, 1, 0, 0, 0, 179,
+		909, 1, 0, 0, 0, 181, 915, 1, 0, 0, 0, 183, 928, 1, 0, 0, 0, 185, 935,
+		1, 0, 0, 0, 187, 939, 1, 0, 0, 0, 189, 951, 1, 0, 0, 0, 191, 963, 1, 0,
+		0, 0, 193, 967, 1, 0, 0, 0, 195, 972, 1, 0, 0, 0, 197, 975, 1, 0, 0, 0,
+		199, 978, 1, 0, 0, 0, 201, 982, 1, 0, 0, 0, 203, 987, 1, 0, 0, 0, 205,
+		995, 1, 0, 0, 0, 207, 998, 1, 0, 0, 0, 209, 1004, 1, 0, 0, 0, 211, 1014,
+		1, 0, 0, 0, 213, 1023, 1, 0, 0, 0, 215, 1027, 1, 0, 0, 0, 217, 1038, 1,
+		0, 0, 0, 219, 1050, 1, 0, 0, 0, 221, 1058, 1, 0, 0, 0, 223, 1065, 1, 0,
+		0, 0, 225, 1072, 1, 0, 0, 0, 227, 1080, 1, 0, 0, 0, 229, 1092, 1, 0, 0,
+		0, 231, 1100, 1, 0, 0, 0, 233, 1107, 1, 0, 0, 0, 235, 1112, 1, 0, 0, 0,
+		237, 1118, 1, 0, 0, 0, 239, 1125, 1, 0, 0, 0, 241, 1132, 1, 0, 0, 0, 243,
+		1136, 1, 0, 0, 0, 245, 1142, 1, 0, 0, 0, 247, 1149, 1, 0, 0, 0, 249, 1157,
+		1, 0, 0, 0, 251, 1163, 1, 0, 0, 0, 253, 1173, 1, 0, 0, 0, 255, 1179, 1,
+		0, 0, 0, 257, 1186, 1, 0, 0, 0, 259, 1192, 1, 0, 0, 0, 261, 1202, 1, 0,
+		0, 0, 263, 1205, 1, 0, 0, 0, 265, 1211, 1, 0, 0, 0, 267, 1219, 1, 0, 0,
+		0, 269, 1224, 1, 0, 0, 0, 271, 1233, 1, 0, 0, 0, 273, 1237, 1, 0, 0, 0,
+		275, 1241, 1, 0, 0, 0, 277, 1246, 1, 0, 0, 0, 279, 1252, 1, 0, 0, 0, 281,
+		1261, 1, 0, 0, 0, 283, 1268, 1, 0, 0, 0, 285, 1272, 1, 0, 0, 0, 287, 1277,
+		1, 0, 0, 0, 289, 1283, 1, 0, 0, 0, 291, 1288, 1, 0, 0, 0, 293, 1295, 1,
+		0, 0, 0, 295, 1300, 1, 0, 0, 0, 297, 1306, 1, 0, 0, 0, 299, 1311, 1, 0,
+		0, 0, 301, 1321, 1, 0, 0, 0, 303, 1327, 1, 0, 0, 0, 305, 1334, 1, 0, 0,
+		0, 307, 1339, 1, 0, 0, 0, 309, 1347, 1, 0, 0, 0, 311, 1355, 1, 0, 0, 0,
+		313, 1360, 1, 0, 0, 0, 315, 1368, 1, 0, 0, 0, 317, 1375, 1, 0, 0, 0, 319,
+		1384, 1, 0, 0, 0, 321, 1390, 1, 0, 0, 0, 323, 1397, 1, 0, 0, 0, 325, 1402,
+		1, 0, 0, 0, 327, 1406, 1, 0, 0, 0, 329, 1411, 1, 0, 0, 0, 331, 1415, 1,
+		0, 0, 0, 333, 1424, 1, 0, 0, 0, 335, 1429, 1, 0, 0, 0, 337, 1438, 1, 0,
+		0, 0, 339, 1443, 1, 0, 0, 0, 341, 1451, 1, 0, 0, 0, 343, 1457, 1, 0, 0,
+		0, 345, 1465, 1, 0, 0, 0, 347, 1472, 1, 0, 0, 0, 349, 1479, 1, 0, 0, 0,
+		351, 1493, 1, 0, 0, 0, 353, 1513, 1, 0, 0, 0, 355, 1518, 1, 0, 0, 0, 357,
+		1523, 1, 0, 0, 0, 359, 1554, 1, 0, 0, 0, 361, 1581, 1, 0, 0, 0, 363, 1597,
+		1, 0, 0, 0, 365, 1599, 1, 0, 0, 0, 367, 1613, 1, 0, 0, 0, 369, 1619, 1,
+		0, 0, 0, 371, 1633, 1, 0, 0, 0, 373, 1678, 1, 0, 0, 0, 375, 1682, 1, 0,
+		0, 0, 377, 1687, 1, 0, 0, 0, 379, 1689, 1, 0, 0, 0, 381, 1703, 1, 0, 0,
+		0, 383, 1705, 1, 0, 0, 0, 385, 386, 5, 40, 0, 0, 386, 2, 1, 0, 0, 0, 387,
+		388, 5, 41, 0, 0, 388, 4, 1, 0, 0, 0, 389, 390, 5, 123, 0, 0, 390, 6, 1,
+		0, 0, 0, 391, 392, 5, 125, 0, 0, 392, 8, 1, 0, 0, 0, 393, 394, 5, 91, 0,
+		0, 394, 10, 1, 0, 0, 0, 395, 396, 5, 93, 0, 0, 396, 12, 1, 0, 0, 0, 397,
+		398, 5, 44, 0, 0, 398, 14, 1, 0, 0, 0, 399, 400, 5, 59, 0, 0, 400, 16,
+		1, 0, 0, 0, 401, 402, 5, 58, 0, 0, 402, 18, 1, 0, 0, 0, 403, 404, 5, 46,
+		0, 0, 404, 20, 1, 0, 0, 0, 405, 406, 5, 42, 0, 0, 406, 22, 1, 0, 0, 0,
+		407, 408, 5, 47, 0, 0, 408, 24, 1, 0, 0, 0, 409, 410, 5, 37, 0, 0, 410,
+		26, 1, 0, 0, 0, 411, 412, 5, 43, 0, 0, 412, 28, 1, 0, 0, 0, 413, 414, 5,
+		45, 0, 0, 414, 415, 5, 45, 0, 0, 415, 30, 1, 0, 0, 0, 416, 417, 5, 45,
+		0, 0, 417, 32, 1, 0, 0, 0, 418, 419, 5, 34, 0, 0, 419, 34, 1, 0, 0, 0,
+		420, 421, 5, 39, 0, 0, 421, 36, 1, 0, 0, 0, 422, 423, 5, 61, 0, 0, 423,
+		38, 1, 0, 0, 0, 424, 425, 5, 60, 0, 0, 425, 40, 1, 0, 0, 0, 426, 427, 5,
+		62, 0, 0, 427, 42, 1, 0, 0, 0, 428, 429, 5, 60, 0, 0, 429, 430, 5, 61,
+		0, 0, 430, 44, 1, 0, 0, 0, 431, 432, 5, 62, 0, 0, 432, 433, 5, 61, 0, 0,
+		433, 46, 1, 0, 0, 0, 434, 435, 7, 0, 0, 0, 435, 436, 7, 1, 0, 0, 436, 437,
+		7, 1, 0, 0, 437, 48, 1, 0, 0, 0, 438, 439, 7, 0, 0, 0, 439, 440, 7, 2,
+		0, 0, 440, 441, 7, 2, 0, 0, 441, 442, 7, 3, 0, 0, 442, 443, 7, 4, 0, 0,
+		443, 444, 7, 2, 0, 0, 444, 445, 7, 0, 0, 0, 445, 446, 7, 5, 0, 0, 446,
+		447, 7, 4, 0, 0, 447, 50, 1, 0, 0, 0, 448, 449, 7, 0, 0, 0, 449, 450, 7,
+		2, 0, 0, 450, 451, 7, 2, 0, 0, 451, 452, 7, 3, 0, 0, 452, 453, 7, 4, 0,
+		0, 453, 454, 7, 2, 0, 0, 454, 455, 7, 0, 0, 0, 455, 456, 7, 5, 0, 0, 456,
+		457, 7, 4, 0, 0, 457, 458, 7, 6, 0, 0, 458, 52, 1, 0, 0, 0, 459, 460, 7,
+		0, 0, 0, 460, 461, 7, 7, 0, 0, 461, 462, 7, 7, 0, 0, 462, 54, 1, 0, 0,
+		0, 463, 464, 7, 0, 0, 0, 464, 465, 7, 7, 0, 0, 465, 466, 7, 7, 0, 0, 466,
+		467, 7, 8, 0, 0, 467, 468, 7, 9, 0, 0, 468, 56, 1, 0, 0, 0, 469, 470, 7,
+		0, 0, 0, 470, 471, 7, 7, 0, 0, 471, 472, 7, 5, 0, 0, 472, 473, 7, 4, 0,
+		0, 473, 474, 7, 3, 0, 0, 474, 58, 1, 0, 0, 0, 475, 476, 7, 0, 0, 0, 476,
+		477, 7, 10, 0, 0, 477, 478, 7, 1, 0, 0, 478, 60, 1, 0, 0, 0, 479, 480,
+		7, 0, 0, 0, 480, 481, 7, 10, 0, 0, 481, 482, 7, 10, 0, 0, 482, 62, 1, 0,
+		0, 0, 483, 484, 7, 0, 0, 0, 484, 485, 7, 10, 0, 0, 485, 486, 7, 11, 0,
+		0, 486, 64, 1, 0, 0, 0, 487, 488, 7, 0, 0, 0, 488, 489, 7, 12, 0, 0, 489,
+		490, 7, 12, 0, 0, 490, 491, 7, 7, 0, 0, 491, 492, 7, 11, 0, 0, 492, 66,
+		1, 0, 0, 0, 493, 494, 7, 0, 0, 0, 494, 495, 7, 6, 0, 0, 495, 68, 1, 0,
+		0, 0, 496, 497, 7, 0, 0, 0, 497, 498, 7, 6, 0, 0, 498, 499, 7, 13, 0, 0,
+		499, 70, 1, 0, 0, 0, 500, 501, 7, 0, 0, 0, 501, 502, 7, 14, 0, 0, 502,
+		503, 7, 5, 0, 0, 503, 504, 7, 15, 0, 0, 504, 505, 7, 8, 0, 0, 505, 506,
+		7, 3, 0, 0, 506, 507, 7, 16, 0, 0, 507, 508, 7, 17, 0, 0, 508, 509, 7,
+		4, 0, 0, 509, 72, 1, 0, 0, 0, 510, 511, 7, 18, 0, 0, 511, 512, 7, 0, 0,
+		0, 512, 513, 7, 5, 0, 0, 513, 514, 7, 13, 0, 0, 514, 515, 7, 15, 0, 0,
+		515, 74, 1, 0, 0, 0, 516, 517, 7, 18, 0, 0, 517, 518, 7, 4, 0, 0, 518,
+		519, 7, 2, 0, 0, 519, 520, 7, 16, 0, 0, 520, 521, 7, 10, 0, 0, 521, 76,
+		1, 0, 0, 0, 522, 523, 7, 18, 0, 0, 523, 524, 7, 11, 0, 0, 524, 78, 1, 0,
+		0, 0, 525, 526, 7, 13, 0, 0, 526, 527, 7, 0, 0, 0, 527, 528, 7, 7, 0, 0,
+		528, 529, 7, 7, 0, 0, 529, 530, 7, 4, 0, 0, 530, 531, 7, 1, 0, 0, 531,
+		80, 1, 0, 0, 0, 532, 533, 7, 13, 0, 0, 533, 534, 7, 0, 0, 0, 534, 535,
+		7, 6, 0, 0, 535, 536, 7, 5, 0, 0, 536, 82, 1, 0, 0, 0, 537, 538, 7, 13,
+		0, 0, 538, 539, 7, 7, 0, 0, 539, 540, 7, 14, 0, 0, 540, 541, 7, 6, 0, 0,
+		541, 542, 7, 5, 0, 0, 542, 543, 7, 4, 0, 0, 543, 544, 7, 3, 0, 0, 544,
+		545, 7, 16, 0, 0, 545, 546, 7, 10, 0, 0, 546, 547, 7, 2, 0, 0, 547, 84,
+		1, 0, 0, 0, 548, 549, 7, 13, 0, 0, 549, 550, 7, 7, 0, 0, 550, 551, 7, 14,
+		0, 0, 551, 552, 7, 6, 0, 0, 552, 553, 7, 5, 0, 0, 553, 554, 7, 4, 0, 0,
+		554, 555, 7, 3, 0, 0, 555, 86, 1, 0, 0, 0, 556, 557, 7, 13, 0, 0, 557,
+		558, 7, 8, 0, 0, 558, 559, 7, 7, 0, 0, 559, 560, 7, 14, 0, 0, 560, 561,
+		7, 19, 0, 0, 561, 562, 7, 10, 0, 0, 562, 563, 7, 20, 0, 0, 563, 564, 7,
+		0, 0, 0, 564, 565, 7, 19, 0, 0, 565, 566, 7, 16, 0, 0, 566, 567, 7, 7,
+		0, 0, 567, 568, 7, 11, 0, 0, 568, 88, 1, 0, 0, 0, 569, 570, 7, 13, 0, 0,
+		570, 571, 7, 8, 0, 0, 571, 572, 7, 19, 0, 0, 572, 573, 7, 12, 0, 0, 573,
+		574, 7, 0, 0, 0, 574, 575, 7, 13, 0, 0, 575, 576, 7, 5, 0, 0, 576, 90,
+		1, 0, 0, 0, 577, 578, 7, 13, 0, 0, 578, 579, 7, 8, 0, 0, 579, 580, 7, 10,
+		0, 0, 580, 581, 7, 6, 0, 0, 581, 582, 7, 16, 0, 0, 582, 583, 7, 6, 0, 0,
+		583, 584, 7, 5, 0, 0, 584, 585, 7, 4, 0, 0, 585, 586, 7, 10, 0, 0, 586,
+		587, 7, 13, 0, 0, 587, 588, 7, 11, 0, 0, 588, 92, 1, 0, 0, 0, 589, 590,
+		7, 13, 0, 0, 590, 591, 7, 8, 0, 0, 591, 592, 7, 10, 0, 0, 592, 593, 7,
+		5, 0, 0, 593, 594, 7, 0, 0, 0, 594, 595, 7, 16, 0, 0, 595, 596, 7, 10,
+		0, 0, 596, 597, 7, 6, 0, 0, 597, 94, 1, 0, 0, 0, 598, 599, 7, 13, 0, 0,
+		599, 600, 7, 3, 0, 0, 600, 601, 7, 4, 0, 0, 601, 602, 7, 0, 0, 0, 602,
+		603, 7, 5, 0, 0, 603, 604, 7, 4, 0, 0, 604, 96, 1, 0, 0, 0, 605, 606, 7,
+		13, 0, 0, 606, 607, 7, 14, 0, 0, 607, 608, 7, 6, 0, 0, 608, 609, 7, 5,
+		0, 0, 609, 610, 7, 8, 0, 0, 610, 611, 7, 19, 0, 0, 611, 98, 1, 0, 0, 0,
+		612, 613, 7, 1, 0, 0, 613, 614, 7, 4, 0, 0, 614, 615, 7, 7, 0, 0, 615,
+		616, 7, 4, 0, 0, 616, 617, 7, 5, 0, 0, 617, 618, 7, 4, 0, 0, 618, 100,
+		1, 0, 0, 0, 619, 620, 7, 1, 0, 0, 620, 621, 7, 4, 0, 0, 621, 622, 7, 6,
+		0, 0, 622, 623, 7, 13, 0, 0, 623, 102, 1, 0, 0, 0, 624, 625, 7, 1, 0, 0,
+		625, 626, 7, 4, 0, 0, 626, 627, 7, 6, 0, 0, 627, 628, 7, 13, 0, 0, 628,
+		629, 7, 3, 0, 0, 629, 630, 7, 16, 0, 0, 630, 631, 7, 18, 0, 0, 631, 632,
+		7, 4, 0, 0, 632, 104, 1, 0, 0, 0, 633, 634, 7, 1, 0, 0, 634, 635, 7, 16,
+		0, 0, 635, 636, 7, 6, 0, 0, 636, 637, 7, 5, 0, 0, 637, 638, 7, 16, 0, 0,
+		638, 639, 7, 10, 0, 0, 639, 640, 7, 13, 0, 0, 640, 641, 7, 5, 0, 0, 641,
+		106, 1, 0, 0, 0, 642, 643, 7, 1, 0, 0, 643, 644, 7, 3, 0, 0, 644, 645,
+		7, 8, 0, 0, 645, 646, 7, 12, 0, 0, 646, 108, 1, 0, 0, 0, 647, 648, 7, 1,
+		0, 0, 648, 649, 7, 14, 0, 0, 649, 650, 7, 3, 0, 0, 650, 651, 7, 0, 0, 0,
+		651, 652, 7, 18, 0, 0, 652, 653, 7, 7, 0, 0, 653, 654, 7, 4, 0, 0, 654,
+		655, 5, 95, 0, 0, 655, 656, 7, 9, 0, 0, 656, 657, 7, 3, 0, 0, 657, 658,
+		7, 16, 0, 0, 658, 659, 7, 5, 0, 0, 659, 660, 7, 4, 0, 0, 660, 661, 7, 6,
+		0, 0, 661, 110, 1, 0, 0, 0, 662, 663, 7, 4, 0, 0, 663, 664, 7, 0, 0, 0,
+		664, 665, 7, 13, 0, 0, 665, 666, 7, 15, 0, 0, 666, 667, 5, 95, 0, 0, 667,
+		668, 7, 21, 0, 0, 668, 669, 7, 14, 0, 0, 669, 670, 7, 8, 0, 0, 670, 671,
+		7, 3, 0, 0, 671, 672, 7, 14, 0, 0, 672, 673, 7, 19, 0, 0, 673, 112, 1,
+		0, 0, 0, 674, 675, 7, 4, 0, 0, 675, 676, 7, 10, 0, 0, 676, 677, 7, 5, 0,
+		0, 677, 678, 7, 3, 0, 0, 678, 679, 7, 16, 0, 0, 679, 680, 7, 4, 0, 0, 680,
+		681, 7, 6, 0, 0, 681, 114, 1, 0, 0, 0, 682, 683, 7, 4, 0, 0, 683, 684,
+		7, 22, 0, 0, 684, 685, 7, 4, 0, 0, 685, 686, 7, 13, 0, 0, 686, 687, 7,
+		14, 0, 0, 687, 688, 7, 5, 0, 0, 688, 689, 7, 4, 0, 0, 689, 116, 1, 0, 0,
+		0, 690, 691, 7, 4, 0, 0, 691, 692, 7, 22, 0, 0, 692, 693, 7, 16, 0, 0,
+		693, 694, 7, 6, 0, 0, 694, 695, 7, 5, 0, 0, 695, 696, 7, 6, 0, 0, 696,
+		118, 1, 0, 0, 0, 697, 698, 7, 20, 0, 0, 698, 699, 7, 0, 0, 0, 699, 700,
+		7, 7, 0, 0, 700, 701, 7, 6, 0, 0, 701, 702, 7, 4, 0, 0, 702, 120, 1, 0,
+		0, 0, 703, 704, 7, 20, 0, 0, 704, 705, 7, 16, 0, 0, 705, 706, 7, 7, 0,
+		0, 706, 707, 7, 5, 0, 0, 707, 708, 7, 4, 0, 0, 708, 709, 7, 3, 0, 0, 709,
+		710, 7, 16, 0, 0, 710, 711, 7, 10, 0, 0, 711, 712, 7, 2, 0, 0, 712, 122,
+		1, 0, 0, 0, 713, 714, 7, 20, 0, 0, 714, 715, 7, 16, 0, 0, 715, 716, 7,
+		10, 0, 0, 716, 717, 7, 0, 0, 0, 717, 718, 7, 7, 0, 0, 718, 719, 7, 20,
+		0, 0, 719, 720, 7, 14, 0, 0, 720, 721, 7, 10, 0, 0, 721, 722, 7, 13, 0,
+		0, 722, 124, 1, 0, 0, 0, 723, 724, 7, 20, 0, 0, 724, 725, 7, 3, 0, 0, 725,
+		726, 7, 8, 0, 0, 726, 727, 7, 19, 0, 0, 727, 126, 1, 0, 0, 0, 728, 729,
+		7, 20, 0, 0, 729, 730, 7, 14, 0, 0, 730, 731, 7, 7, 0, 0, 731, 732, 7,
+		7, 0, 0, 732, 128, 1, 0, 0, 0, 733, 734, 7, 20, 0, 0, 734, 735, 7, 14,
+		0, 0, 735, 736, 7, 10, 0, 0, 736, 737, 7, 13, 0, 0, 737, 738, 7, 5, 0,
+		0, 738, 739, 7, 16, 0, 0, 739, 740, 7, 8, 0, 0, 740, 741, 7, 10, 0, 0,
+		741, 130, 1, 0, 0, 0, 742, 743, 7, 20, 0, 0, 743, 744, 7, 14, 0, 0, 744,
+		745, 7, 10, 0, 0, 745, 746, 7, 13, 0, 0, 746, 747, 7, 5, 0, 0, 747, 748,
+		7, 16, 0, 0, 748, 749, 7, 8, 0, 0, 749, 750, 7, 10, 0, 0, 750, 751, 7,
+		6, 0, 0, 751, 132, 1, 0, 0, 0, 752, 753, 7, 2, 0, 0, 753, 754, 7, 3, 0,
+		0, 754, 755, 7, 0, 0, 0, 755, 756, 7, 10, 0, 0, 756, 757, 7, 5, 0, 0, 757,
+		134, 1, 0, 0, 0, 758, 759, 7, 2, 0, 0, 759, 760, 7, 3, 0, 0, 760, 761,
+		7, 8, 0, 0, 761, 762, 7, 14, 0, 0, 762, 763, 7, 12, 0, 0, 763, 136, 1,
+		0, 0, 0, 764, 765, 7, 16, 0, 0, 765, 766, 7, 20, 0, 0, 766, 138, 1, 0,
+		0, 0, 767, 768, 7, 16, 0, 0, 768, 769, 7, 10, 0, 0, 769, 140, 1, 0, 0,
+		0, 770, 771, 7, 16, 0, 0, 771, 772, 7, 10, 0, 0, 772, 773, 7, 1, 0, 0,
+		773, 774, 7, 4, 0, 0, 774, 775, 7, 22, 0, 0, 775, 142, 1, 0, 0, 0, 776,
+		777, 7, 16, 0, 0, 777, 778, 7, 10, 0, 0, 778, 779, 7, 20, 0, 0, 779, 780,
+		7, 16, 0, 0, 780, 781, 7, 10, 0, 0, 781, 782, 7, 16, 0, 0, 782, 783, 7,
+		5, 0, 0, 783, 784, 7, 11, 0, 0, 784, 144, 1, 0, 0, 0, 785, 786, 7, 16,
+		0, 0, 786, 787, 7, 10, 0, 0, 787, 788, 7, 16, 0, 0, 788, 789, 7, 5, 0,
+		0, 789, 790, 7, 13, 0, 0, 790, 791, 7, 8, 0, 0, 791, 792, 7, 10, 0, 0,
+		792, 793, 7, 1, 0, 0, 793, 146, 1, 0, 0, 0, 794, 795, 7, 16, 0, 0, 795,
+		796, 7, 10, 0, 0, 796, 797, 7, 12, 0, 0, 797, 798, 7, 14, 0, 0, 798, 799,
+		7, 5, 0, 0, 799, 148, 1, 0, 0, 0, 800, 801, 7, 16, 0, 0, 801, 802, 7, 10,
+		0, 0, 802, 803, 7, 6, 0, 0, 803, 804, 7, 4, 0, 0, 804, 805, 7, 3, 0, 0,
+		805, 806, 7, 5, 0, 0, 806, 150, 1, 0, 0, 0, 807, 808, 7, 16, 0, 0, 808,
+		809, 7, 10, 0, 0, 809, 810, 7, 5, 0, 0, 810, 811, 7, 8, 0, 0, 811, 152,
+		1, 0, 0, 0, 812, 813, 7, 16, 0, 0, 813, 814, 7, 10, 0, 0, 814, 815, 7,
+		5, 0, 0, 815, 816, 7, 4, 0, 0, 816, 817, 7, 3, 0, 0, 817, 818, 7, 10, 0,
+		0, 818, 819, 7, 0, 0, 0, 819, 820, 7, 7, 0, 0, 820, 821, 7, 6, 0, 0, 821,
+		154, 1, 0, 0, 0, 822, 823, 7, 16, 0, 0, 823, 824, 7, 6, 0, 0, 824, 156,
+		1, 0, 0, 0, 825, 826, 7, 23, 0, 0, 826, 827, 7, 6, 0, 0, 827, 828, 7, 8,
+		0, 0, 828, 829, 7, 10, 0, 0, 829, 158, 1, 0, 0, 0, 830, 831, 7, 24, 0,
+		0, 831, 832, 7, 4, 0, 0, 832, 833, 7, 11, 0, 0, 833, 160, 1, 0, 0, 0, 834,
+		835, 7, 24, 0, 0, 835, 836, 7, 4, 0, 0, 836, 837, 7, 11, 0, 0, 837, 838,
+		7, 6, 0, 0, 838, 162, 1, 0, 0, 0, 839, 840, 7, 24, 0, 0, 840, 841, 7, 4,
+		0, 0, 841, 842, 7, 11, 0, 0, 842, 843, 7, 6, 0, 0, 843, 844, 7, 12, 0,
+		0, 844, 845, 7, 0, 0, 0, 845, 846, 7, 13, 0, 0, 846, 847, 7, 4, 0, 0, 847,
+		164, 1, 0, 0, 0, 848, 849, 7, 24, 0, 0, 849, 850, 7, 4, 0, 0, 850, 851,
+		7, 11, 0, 0, 851, 852, 7, 6, 0, 0, 852, 853, 7, 12, 0, 0, 853, 854, 7,
+		0, 0, 0, 854, 855, 7, 13, 0, 0, 855, 856, 7, 4, 0, 0, 856, 857, 7, 6, 0,
+		0, 857, 166, 1, 0, 0, 0, 858, 859, 7, 7, 0, 0, 859, 860, 7, 0, 0, 0, 860,
+		861, 7, 10, 0, 0, 861, 862, 7, 2, 0, 0, 862, 863, 7, 14, 0, 0, 863, 864,
+		7, 0, 0, 0, 864, 865, 7, 2, 0, 0, 865, 866, 7, 4, 0, 0, 866, 168, 1, 0,
+		0, 0, 867, 868, 7, 7, 0, 0, 868, 869, 7, 4, 0, 0, 869, 870, 7, 25, 0, 0,
+		870, 871, 7, 4, 0, 0, 871, 872, 7, 7, 0, 0, 872, 170, 1, 0, 0, 0, 873,
+		874, 7, 7, 0, 0, 874, 875, 7, 16, 0, 0, 875, 876, 7, 19, 0, 0, 876, 877,
+		7, 16, 0, 0, 877, 878, 7, 5, 0, 0, 878, 172, 1, 0, 0, 0, 879, 880, 7, 7,
+		0, 0, 880, 881, 7, 8, 0, 0, 881, 882, 7, 13, 0, 0, 882, 883, 7, 0, 0, 0,
+		883, 884, 7, 7, 0, 0, 884, 885, 5, 95, 0, 0, 885, 886, 7, 8, 0, 0, 886,
+		887, 7, 10, 0, 0, 887, 888, 7, 4, 0, 0, 888, 174, 1, 0, 0, 0, 889, 890,
+		7, 7, 0, 0, 890, 891, 7, 8, 0, 0, 891, 892, 7, 13, 0, 0, 892, 893, 7, 0,
+		0, 0, 893, 894, 7, 7, 0, 0, 894, 895, 5, 95, 0, 0, 895, 896, 7, 21, 0,
+		0, 896, 897, 7, 14, 0, 0, 897, 898, 7, 8, 0, 0, 898, 899, 7, 3, 0, 0, 899,
+		900, 7, 14, 0, 0, 900, 901, 7, 19, 0, 0, 901, 176, 1, 0, 0, 0, 902, 903,
+		7, 7, 0, 0, 903, 904, 7, 8, 0, 0, 904, 905, 7, 2, 0, 0, 905, 906, 7, 2,
+		0, 0, 906, 907, 7, 4, 0, 0, 907, 908, 7, 1, 0, 0, 908, 178, 1, 0, 0, 0,
+		909, 910, 7, 7, 0, 0, 910, 911, 7, 8, 0, 0, 911, 912, 7, 2, 0, 0, 912,
+		913, 7, 16, 0, 0, 913, 914, 7, 10, 0, 0, 914, 180, 1, 0, 0, 0, 915, 916,
+		7, 19, 0, 0, 916, 917, 7, 0, 0, 0, 917, 918, 7, 5, 0, 0, 918, 919, 7, 4,
+		0, 0, 919, 920, 7, 3, 0, 0, 920, 921, 7, 16, 0, 0, 921, 922, 7, 0, 0, 0,
+		922, 923, 7, 7, 0, 0, 923, 924, 7, 16, 0, 0, 924, 925, 7, 17, 0, 0, 925,
+		926, 7, 4, 0, 0, 926, 927, 7, 1, 0, 0, 927, 182, 1, 0, 0, 0, 928, 929,
+		7, 19, 0, 0, 929, 930, 7, 8, 0, 0, 930, 931, 7, 1, 0, 0, 931, 932, 7, 16,
+		0, 0, 932, 933, 7, 20, 0, 0, 933, 934, 7, 11, 0, 0, 934, 184, 1, 0, 0,
+		0, 935, 936, 7, 10, 0, 0, 936, 937, 7, 0, 0, 0, 937, 938, 7, 10, 0, 0,
+		938, 186, 1, 0, 0, 0, 939, 940, 7, 10, 0, 0, 940, 941, 7, 8, 0, 0, 941,
+		942, 7, 3, 0, 0, 942, 943, 7, 4, 0, 0, 943, 944, 7, 13, 0, 0, 944, 945,
+		7, 14, 0, 0, 945, 946, 7, 3, 0, 0, 946, 947, 7, 6, 0, 0, 947, 948, 7, 16,
+		0, 0, 948, 949, 7, 25, 0, 0, 949, 950, 7, 4, 0, 0, 950, 188, 1, 0, 0, 0,
+		951, 952, 7, 10, 0, 0, 952, 953, 7, 8, 0, 0, 953, 954, 7, 6, 0, 0, 954,
+		955, 7, 14, 0, 0, 955, 956, 7, 12, 0, 0, 956, 957, 7, 4, 0, 0, 957, 958,
+		7, 3, 0, 0, 958, 959, 7, 14, 0, 0, 959, 960, 7, 6, 0, 0, 960, 961, 7, 4,
+		0, 0, 961, 962, 7, 3, 0, 0, 962, 190, 1, 0, 0, 0, 963, 964, 7, 10, 0, 0,
+		964, 965, 7, 8, 0, 0, 965, 966, 7, 5, 0, 0, 966, 192, 1, 0, 0, 0, 967,
+		968, 7, 10, 0, 0, 968, 969, 7, 14, 0, 0, 969, 970, 7, 7, 0, 0, 970, 971,
+		7, 7, 0, 0, 971, 194, 1, 0, 0, 0, 972, 973, 7, 8, 0, 0, 973, 974, 7, 20,
+		0, 0, 974, 196, 1, 0, 0, 0, 975, 976, 7, 8, 0, 0, 976, 977, 7, 10, 0, 0,
+		977, 198, 1, 0, 0, 0, 978, 979, 7, 8, 0, 0, 979, 980, 7, 10, 0, 0, 980,
+		981, 7, 4, 0, 0, 981, 200, 1, 0, 0, 0, 982, 983, 7, 8, 0, 0, 983, 984,
+		7, 10, 0, 0, 984, 985, 7, 7, 0, 0, 985, 986, 7, 11, 0, 0, 986, 202, 1,
+		0, 0, 0, 987, 988, 7, 8, 0, 0, 988, 989, 7, 12, 0, 0, 989, 990, 7, 5, 0,
+		0, 990, 991, 7, 16, 0, 0, 991, 992, 7, 8, 0, 0, 992, 993, 7, 10, 0, 0,
+		993, 994, 7, 6, 0, 0, 994, 204, 1, 0, 0, 0, 995, 996, 7, 8, 0, 0, 996,
+		997, 7, 3, 0, 0, 997, 206, 1, 0, 0, 0, 998, 999, 7, 8, 0, 0, 999, 1000,
+		7, 3, 0, 0, 1000, 1001, 7, 1, 0, 0, 1001, 1002, 7, 4, 0, 0, 1002, 1003,
+		7, 3, 0, 0, 1003, 208, 1, 0, 0, 0, 1004, 1005, 7, 12, 0, 0, 1005, 1006,
+		7, 0, 0, 0, 1006, 1007, 7, 3, 0, 0, 1007, 1008, 7, 5, 0, 0, 1008, 1009,
+		7, 16, 0, 0, 1009, 1010, 7, 5, 0, 0, 1010, 1011, 7, 16, 0, 0, 1011, 1012,
+		7, 8, 0, 0, 1012, 1013, 7, 10, 0, 0, 1013, 210, 1, 0, 0, 0, 1014, 1015,
+		7, 12, 0, 0, 1015, 1016, 7, 0, 0, 0, 1016, 1017, 7, 6, 0, 0, 1017, 1018,
+		7, 6, 0, 0, 1018, 1019, 7, 9, 0, 0, 1019, 1020, 7, 8, 0, 0, 1020, 1021,
+		7, 3, 0, 0, 1021, 1022, 7, 1, 0, 0, 1022, 212, 1, 0, 0, 0, 1023, 1024,
+		7, 12, 0, 0, 1024, 1025, 7, 4, 0, 0, 1025, 1026, 7, 3, 0, 0, 1026, 214,
+		1, 0, 0, 0, 1027, 1028, 7, 12, 0, 0, 1028, 1029, 7, 4, 0, 0, 1029, 1030,
+		7, 3, 0, 0, 1030, 1031, 7, 19, 0, 0, 1031, 1032, 7, 16, 0, 0, 1032, 1033,
+		7, 6, 0, 0, 1033, 1034, 7, 6, 0, 0, 1034, 1035, 7, 16, 0, 0, 1035, 1036,
+		7, 8, 0, 0, 1036, 1037, 7, 10, 0, 0, 1037, 216, 1, 0, 0, 0, 1038, 1039,
+		7, 12, 0, 0, 1039, 1040, 7, 4, 0, 0, 1040, 1041, 7, 3, 0, 0, 1041, 1042,
+		7, 19, 0, 0, 1042, 1043, 7, 16, 0, 0, 1043, 1044, 7, 6, 0, 0, 1044, 1045,
+		7, 6, 0, 0, 1045, 1046, 7, 16, 0, 0, 1046, 1047, 7, 8, 0, 0, 1047, 1048,
+		7, 10, 0, 0, 1048, 1049, 7, 6, 0, 0, 1049, 218, 1, 0, 0, 0, 1050, 1051,
+		7, 12, 0, 0, 1051, 1052, 7, 3, 0, 0, 1052, 1053, 7, 16, 0, 0, 1053, 1054,
+		7, 19, 0, 0, 1054, 1055, 7, 0, 0, 0, 1055, 1056, 7, 3, 0, 0, 1056, 1057,
+		7, 11, 0, 0, 1057, 220, 1, 0, 0, 0, 1058, 1059, 7, 21, 0, 0, 1059, 1060,
+		7, 14, 0, 0, 1060, 1061, 7, 8, 0, 0, 1061, 1062, 7, 3, 0, 0, 1062, 1063,
+		7, 14, 0, 0, 1063, 1064, 7, 19, 0, 0, 1064, 222, 1, 0, 0, 0, 1065, 1066,
+		7, 3, 0, 0, 1066, 1067, 7, 4, 0, 0, 1067, 1068, 7, 10, 0, 0, 1068, 1069,
+		7, 0, 0, 0, 1069, 1070, 7, 19, 0, 0, 1070, 1071, 7, 4, 0, 0, 1071, 224,
+		1, 0, 0, 0, 1072, 1073, 7, 3, 0, 0, 1073, 1074, 7, 4, 0, 0, 1074, 1075,
+		7, 12, 0, 0, 1075, 1076, 7, 7, 0, 0, 1076, 1077, 7, 0, 0, 0, 1077, 1078,
+		7, 13, 0, 0, 1078, 1079, 7, 4, 0, 0, 1079, 226, 1, 0, 0, 0, 1080, 1081,
+		7, 3, 0, 0, 1081, 1082, 7, 4, 0, 0, 1082, 1083, 7, 12, 0, 0, 1083, 1084,
+		7, 7, 0, 0, 1084, 1085, 7, 16, 0, 0, 1085, 1086, 7, 13, 0, 0, 1086, 1087,
+		7, 0, 0, 0, 1087, 1088, 7, 5, 0, 0, 1088, 1089, 7, 16, 0, 0, 1089, 1090,
+		7, 8, 0, 0, 1090, 1091, 7, 10, 0, 0, 1091, 228, 1, 0, 0, 0, 1092, 1093,
+		7, 3, 0, 0, 1093, 1094, 7, 4, 0, 0, 1094, 1095, 7, 5, 0, 0, 1095, 1096,
+		7, 14, 0, 0, 1096, 1097, 7, 3, 0, 0, 1097, 1098, 7, 10, 0, 0, 1098, 1099,
+		7, 6, 0, 0, 1099, 230, 1, 0, 0, 0, 1100, 1101, 7, 3, 0, 0, 1101, 1102,
+		7, 4, 0, 0, 1102, 1103, 7, 25, 0, 0, 1103, 1104, 7, 8, 0, 0, 1104, 1105,
+		7, 24, 0, 0, 1105, 1106, 7, 4, 0, 0, 1106, 232, 1, 0, 0, 0, 1107, 1108,
+		7, 3, 0, 0, 1108, 1109, 7, 8, 0, 0, 1109, 1110, 7, 7, 0, 0, 1110, 1111,
+		7, 4, 0, 0, 1111, 234, 1, 0, 0, 0, 1112, 1113, 7, 3, 0, 0, 1113, 1114,
+		7, 8, 0, 0, 1114, 1115, 7, 7, 0, 0, 1115, 1116, 7, 4, 0, 0, 1116, 1117,
+		7, 6, 0, 0, 1117, 236, 1, 0, 0, 0, 1118, 1119, 7, 6, 0, 0, 1119, 1120,
+		7, 13, 0, 0, 1120, 1121, 7, 15, 0, 0, 1121, 1122, 7, 4, 0, 0, 1122, 1123,
+		7, 19, 0, 0, 1123, 1124, 7, 0, 0, 0, 1124, 238, 1, 0, 0, 0, 1125, 1126,
+		7, 6, 0, 0, 1126, 1127, 7, 4, 0, 0, 1127, 1128, 7, 7, 0, 0, 1128, 1129,
+		7, 4, 0, 0, 1129, 1130, 7, 13, 0, 0, 1130, 1131, 7, 5, 0, 0, 1131, 240,
+		1, 0, 0, 0, 1132, 1133, 7, 6, 0, 0, 1133, 1134, 7, 4, 0, 0, 1134, 1135,
+		7, 5, 0, 0, 1135, 242, 1, 0, 0, 0, 1136, 1137, 7, 6, 0, 0, 1137, 1138,
+		7, 20, 0, 0, 1138, 1139, 7, 14, 0, 0, 1139, 1140, 7, 10, 0, 0, 1140, 1141,
+		7, 13, 0, 0, 1141, 244, 1, 0, 0, 0, 1142, 1143, 7, 6, 0, 0, 1143, 1144,
+		7, 5, 0, 0, 1144, 1145, 7, 0, 0, 0, 1145, 1146, 7, 5, 0, 0, 1146, 1147,
+		7, 16, 0, 0, 1147, 1148, 7, 13, 0, 0, 1148, 246, 1, 0, 0, 0, 1149, 1150,
+		7, 6, 0, 0, 1150, 1151, 7, 5, 0, 0, 1151, 1152, 7, 8, 0, 0, 1152, 1153,
+		7, 3, 0, 0, 1153, 1154, 7, 0, 0, 0, 1154, 1155, 7, 2, 0, 0, 1155, 1156,
+		7, 4, 0, 0, 1156, 248, 1, 0, 0, 0, 1157, 1158, 7, 6, 0, 0, 1158, 1159,
+		7, 5, 0, 0, 1159, 1160, 7, 11, 0, 0, 1160, 1161, 7, 12, 0, 0, 1161, 1162,
+		7, 4, 0, 0, 1162, 250, 1, 0, 0, 0, 1163, 1164, 7, 6, 0, 0, 1164, 1165,
+		7, 14, 0, 0, 1165, 1166, 7, 12, 0, 0, 1166, 1167, 7, 4, 0, 0, 1167, 1168,
+		7, 3, 0, 0, 1168, 1169, 7, 14, 0, 0, 1169, 1170, 7, 6, 0, 0, 1170, 1171,
+		7, 4, 0, 0, 1171, 1172, 7, 3, 0, 0, 1172, 252, 1, 0, 0, 0, 1173, 1174,
+		7, 5, 0, 0, 1174, 1175, 7, 0, 0, 0, 1175, 1176, 7, 18, 0, 0, 1176, 1177,
+		7, 7, 0, 0, 1177, 1178, 7, 4, 0, 0, 1178, 254, 1, 0, 0, 0, 1179, 1180,
+		7, 5, 0, 0, 1180, 1181, 7, 0, 0, 0, 1181, 1182, 7, 18, 0, 0, 1182, 1183,
+		7, 7, 0, 0, 1183, 1184, 7, 4, 0, 0, 1184, 1185, 7, 6, 0, 0, 1185, 256,
+		1, 0, 0, 0, 1186, 1187, 7, 5, 0, 0, 1187, 1188, 7, 15, 0, 0, 1188, 1189,
+		7, 3, 0, 0, 1189, 1190, 7, 4, 0, 0, 1190, 1191, 7, 4, 0, 0, 1191, 258,
+		1, 0, 0, 0, 1192, 1193, 7, 5, 0, 0, 1193, 1194, 7, 16, 0, 0, 1194, 1195,
+		7, 19, 0, 0, 1195, 1196, 7, 4, 0, 0, 1196, 1197, 7, 6, 0, 0, 1197, 1198,
+		7, 5, 0, 0, 1198, 1199, 7, 0, 0, 0, 1199, 1200, 7, 19, 0, 0, 1200, 1201,
+		7, 12, 0, 0, 1201, 260, 1, 0, 0, 0, 1202, 1203, 7, 5, 0, 0, 1203, 1204,
+		7, 8, 0, 0, 1204, 262, 1, 0, 0, 0, 1205, 1206, 7, 5, 0, 0, 1206, 1207,
+		7, 8, 0, 0, 1207, 1208, 7, 24, 0, 0, 1208, 1209, 7, 4, 0, 0, 1209, 1210,
+		7, 10, 0, 0, 1210, 264, 1, 0, 0, 0, 1211, 1212, 7, 5, 0, 0, 1212, 1213,
+		7, 3, 0, 0, 1213, 1214, 7, 16, 0, 0, 1214, 1215, 7, 2, 0, 0, 1215, 1216,
+		7, 2, 0, 0, 1216, 1217, 7, 4, 0, 0, 1217, 1218, 7, 3, 0, 0, 1218, 266,
+		1, 0, 0, 0, 1219, 1220, 7, 5, 0, 0, 1220, 1221, 7, 3, 0, 0, 1221, 1222,
+		7, 14, 0, 0, 1222, 1223, 7, 4, 0, 0, 1223, 268, 1, 0, 0, 0, 1224, 1225,
+		7, 5, 0, 0, 1225, 1226, 7, 3, 0, 0, 1226, 1227, 7, 14, 0, 0, 1227, 1228,
+		7, 10, 0, 0, 1228, 1229, 7, 13, 0, 0, 1229, 1230, 7, 0, 0, 0, 1230, 1231,
+		7, 5, 0, 0, 1231, 1232, 7, 4, 0, 0, 1232, 270, 1, 0, 0, 0, 1233, 1234,
+		7, 5, 0, 0, 1234, 1235, 7, 5, 0, 0, 1235, 1236, 7, 7, 0, 0, 1236, 272,
+		1, 0, 0, 0, 1237, 1238, 7, 5, 0, 0, 1238, 1239, 7, 9, 0, 0, 1239, 1240,
+		7, 8, 0, 0, 1240, 274, 1, 0, 0, 0, 1241, 1242, 7, 5, 0, 0, 1242, 1243,
+		7, 11, 0, 0, 1243, 1244, 7, 12, 0, 0, 1244, 1245, 7, 4, 0, 0, 1245, 276,
+		1, 0, 0, 0, 1246, 1247, 7, 5, 0, 0, 1247, 1248, 7, 11, 0, 0, 1248, 1249,
+		7, 12, 0, 0, 1249, 1250, 7, 4, 0, 0, 1250, 1251, 7, 6, 0, 0, 1251, 278,
+		1, 0, 0, 0, 1252, 1253, 7, 14, 0, 0, 1253, 1254, 7, 10, 0, 0, 1254, 1255,
+		7, 7, 0, 0, 1255, 1256, 7, 8, 0, 0, 1256, 1257, 7, 2, 0, 0, 1257, 1258,
+		7, 2, 0, 0, 1258, 1259, 7, 4, 0, 0, 1259, 1260, 7, 1, 0, 0, 1260, 280,
+		1, 0, 0, 0, 1261, 1262, 7, 14, 0, 0, 1262, 1263, 7, 12, 0, 0, 1263, 1264,
+		7, 1, 0, 0, 1264, 1265, 7, 0, 0, 0, 1265, 1266, 7, 5, 0, 0, 1266, 1267,
+		7, 4, 0, 0, 1267, 282, 1, 0, 0, 0, 1268, 1269, 7, 14, 0, 0, 1269, 1270,
+		7, 6, 0, 0, 1270, 1271, 7, 4, 0, 0, 1271, 284, 1, 0, 0, 0, 1272, 1273,
+		7, 14, 0, 0, 1273, 1274, 7, 6, 0, 0, 1274, 1275, 7, 4, 0, 0, 1275, 1276,
+		7, 3, 0, 0, 1276, 286, 1, 0, 0, 0, 1277, 1278, 7, 14, 0, 0, 1278, 1279,
+		7, 6, 0, 0, 1279, 1280, 7, 16, 0, 0, 1280, 1281, 7, 10, 0, 0, 1281, 1282,
+		7, 2, 0, 0, 1282, 288, 1, 0, 0, 0, 1283, 1284, 7, 14, 0, 0, 1284, 1285,
+		7, 14, 0, 0, 1285, 1286, 7, 16, 0, 0, 1286, 1287, 7, 1, 0, 0, 1287, 290,
+		1, 0, 0, 0, 1288, 1289, 7, 25, 0, 0, 1289, 1290, 7, 0, 0, 0, 1290, 1291,
+		7, 7, 0, 0, 1291, 1292, 7, 14, 0, 0, 1292, 1293, 7, 4, 0, 0, 1293, 1294,
+		7, 6, 0, 0, 1294, 292, 1, 0, 0, 0, 1295, 1296, 7, 25, 0, 0, 1296, 1297,
+		7, 16, 0, 0, 1297, 1298, 7, 4, 0, 0, 1298, 1299, 7, 9, 0, 0, 1299, 294,
+		1, 0, 0, 0, 1300, 1301, 7, 9, 0, 0, 1301, 1302, 7, 15, 0, 0, 1302, 1303,
+		7, 4, 0, 0, 1303, 1304, 7, 3, 0, 0, 1304, 1305, 7, 4, 0, 0, 1305, 296,
+		1, 0, 0, 0, 1306, 1307, 7, 9, 0, 0, 1307, 1308, 7, 16, 0, 0, 1308, 1309,
+		7, 5, 0, 0, 1309, 1310, 7, 15, 0, 0, 1310, 298, 1, 0, 0, 0, 1311, 1312,
+		7, 9, 0, 0, 1312, 1313, 7, 3, 0, 0, 1313, 1314, 7, 16, 0, 0, 1314, 1315,
+		7, 5, 0, 0, 1315, 1316, 7, 4, 0, 0, 1316, 1317, 7, 5, 0, 0, 1317, 1318,
+		7, 16, 0, 0, 1318, 1319, 7, 19, 0, 0, 1319, 1320, 7, 4, 0, 0, 1320, 300,
+		1, 0, 0, 0, 1321, 1322, 7, 0, 0, 0, 1322, 1323, 7, 6, 0, 0, 1323, 1324,
+		7, 13, 0, 0, 1324, 1325, 7, 16, 0, 0, 1325, 1326, 7, 16, 0, 0, 1326, 302,
+		1, 0, 0, 0, 1327, 1328, 7, 18, 0, 0, 1328, 1329, 7, 16, 0, 0, 1329, 1330,
+		7, 2, 0, 0, 1330, 1331, 7, 16, 0, 0, 1331, 1332, 7, 10, 0, 0, 1332, 1333,
+		7, 5, 0, 0, 1333, 304, 1, 0, 0, 0, 1334, 1335, 7, 18, 0, 0, 1335, 1336,
+		7, 7, 0, 0, 1336, 1337, 7, 8, 0, 0, 1337, 1338, 7, 18, 0, 0, 1338, 306,
+		1, 0, 0, 0, 1339, 1340, 7, 18, 0, 0, 1340, 1341, 7, 8, 0, 0, 1341, 1342,
+		7, 8, 0, 0, 1342, 1343, 7, 7, 0, 0, 1343, 1344, 7, 4, 0, 0, 1344, 1345,
+		7, 0, 0, 0, 1345, 1346, 7, 10, 0, 0, 1346, 308, 1, 0, 0, 0, 1347, 1348,
+		7, 13, 0, 0, 1348, 1349, 7, 8, 0, 0, 1349, 1350, 7, 14, 0, 0, 1350, 1351,
+		7, 10, 0, 0, 1351, 1352, 7, 5, 0, 0, 1352, 1353, 7, 4, 0, 0, 1353, 1354,
+		7, 3, 0, 0, 1354, 310, 1, 0, 0, 0, 1355, 1356, 7, 1, 0, 0, 1356, 1357,
+		7, 0, 0, 0, 1357, 1358, 7, 5, 0, 0, 1358, 1359, 7, 4, 0, 0, 1359, 312,
+		1, 0, 0, 0, 1360, 1361, 7, 1, 0, 0, 1361, 1362, 7, 4, 0, 0, 1362, 1363,
+		7, 13, 0, 0, 1363, 1364, 7, 16, 0, 0, 1364, 1365, 7, 19, 0, 0, 1365, 1366,
+		7, 0, 0, 0, 1366, 1367, 7, 7, 0, 0, 1367, 314, 1, 0, 0, 0, 1368, 1369,
+		7, 1, 0, 0, 1369, 1370, 7, 8, 0, 0, 1370, 1371, 7, 14, 0, 0, 1371, 1372,
+		7, 18, 0, 0, 1372, 1373, 7, 7, 0, 0, 1373, 1374, 7, 4, 0, 0, 1374, 316,
+		1, 0, 0, 0, 1375, 1376, 7, 1, 0, 0, 1376, 1377, 7, 14, 0, 0, 1377, 1378,
+		7, 3, 0, 0, 1378, 1379, 7, 0, 0, 0, 1379, 1380, 7, 5, 0, 0, 1380, 1381,
+		7, 16, 0, 0, 1381, 1382, 7, 8, 0, 0, 1382, 1383, 7, 10, 0, 0, 1383, 318,
+		1, 0, 0, 0, 1384, 1385, 7, 20, 0, 0, 1385, 1386, 7, 7, 0, 0, 1386, 1387,
+		7, 8, 0, 0, 1387, 1388, 7, 0, 0, 0, 1388, 1389, 7, 5, 0, 0, 1389, 320,
+		1, 0, 0, 0, 1390, 1391, 7, 20, 0, 0, 1391, 1392, 7, 3, 0, 0, 1392, 1393,
+		7, 8, 0, 0, 1393, 1394, 7, 17, 0, 0, 1394, 1395, 7, 4, 0, 0, 1395, 1396,
+		7, 10, 0, 0, 1396, 322, 1, 0, 0, 0, 1397, 1398, 7, 16, 0, 0, 1398, 1399,
+		7, 10, 0, 0, 1399, 1400, 7, 4, 0, 0, 1400, 1401, 7, 5, 0, 0, 1401, 324,
+		1, 0, 0, 0, 1402, 1403, 7, 16, 0, 0, 1403, 1404, 7, 10, 0, 0, 1404, 1405,
+		7, 5, 0, 0, 1405, 326, 1, 0, 0, 0, 1406, 1407, 7, 7, 0, 0, 1407, 1408,
+		7, 16, 0, 0, 1408, 1409, 7, 6, 0, 0, 1409, 1410, 7, 5, 0, 0, 1410, 328,
+		1, 0, 0, 0, 1411, 1412, 7, 19, 0, 0, 1412, 1413, 7, 0, 0, 0, 1413, 1414,
+		7, 12, 0, 0, 1414, 330, 1, 0, 0, 0, 1415, 1416, 7, 6, 0, 0, 1416, 1417,
+		7, 19, 0, 0, 1417, 1418, 7, 0, 0, 0, 1418, 1419, 7, 7, 0, 0, 1419, 1420,
+		7, 7, 0, 0, 1420, 1421, 7, 16, 0, 0, 1421, 1422, 7, 10, 0, 0, 1422, 1423,
+		7, 5, 0, 0, 1423, 332, 1, 0, 0, 0, 1424, 1425, 7, 5, 0, 0, 1425, 1426,
+		7, 4, 0, 0, 1426, 1427, 7, 22, 0, 0, 1427, 1428, 7, 5, 0, 0, 1428, 334,
+		1, 0, 0, 0, 1429, 1430, 7, 5, 0, 0, 1430, 1431, 7, 16, 0, 0, 1431, 1432,
+		7, 19, 0, 0, 1432, 1433, 7, 4, 0, 0, 1433, 1434, 7, 14, 0, 0, 1434, 1435,
+		7, 14, 0, 0, 1435, 1436, 7, 16, 0, 0, 1436, 1437, 7, 1, 0, 0, 1437, 336,
+		1, 0, 0, 0, 1438, 1439, 7, 5, 0, 0, 1439, 1440, 7, 16, 0, 0, 1440, 1441,
+		7, 19, 0, 0, 1441, 1442, 7, 4, 0, 0, 1442, 338, 1, 0, 0, 0, 1443, 1444,
+		7, 5, 0, 0, 1444, 1445, 7, 16, 0, 0, 1445, 1446, 7, 10, 0, 0, 1446, 1447,
+		7, 11, 0, 0, 1447, 1448, 7, 16, 0, 0, 1448, 1449, 7, 10, 0, 0, 1449, 1450,
+		7, 5, 0, 0, 1450, 340, 1, 0, 0, 0, 1451, 1452, 7, 5, 0, 0, 1452, 1453,
+		7, 14, 0, 0, 1453, 1454, 7, 12, 0, 0, 1454, 1455, 7, 7, 0, 0, 1455, 1456,
+		7, 4, 0, 0, 1456, 342, 1, 0, 0, 0, 1457, 1458, 7, 25, 0, 0, 1458, 1459,
+		7, 0, 0, 0, 1459, 1460, 7, 3, 0, 0, 1460, 1461, 7, 13, 0, 0, 1461, 1462,
+		7, 15, 0, 0, 1462, 1463, 7, 0, 0, 0, 1463, 1464, 7, 3, 0, 0, 1464, 344,
+		1, 0, 0, 0, 1465, 1466, 7, 25, 0, 0, 1466, 1467, 7, 0, 0, 0, 1467, 1468,
+		7, 3, 0, 0, 1468, 1469, 7, 16, 0, 0, 1469, 1470, 7, 10, 0, 0, 1470, 1471,
+		7, 5, 0, 0, 1471, 346, 1, 0, 0, 0, 1472, 1473, 7, 25, 0, 0, 1473, 1474,
+		7, 4, 0, 0, 1474, 1475, 7, 13, 0, 0, 1475, 1476, 7, 5, 0, 0, 1476, 1477,
+		7, 8, 0, 0, 1477, 1478, 7, 3, 0, 0, 1478, 348, 1, 0, 0, 0, 1479, 1480,
+		5, 36, 0, 0, 1480, 1481, 5, 36, 0, 0, 1481, 1487, 1, 0, 0, 0, 1482, 1486,
+		8, 26, 0, 0, 1483, 1484, 5, 36, 0, 0, 1484, 1486, 8, 26, 0, 0, 1485, 1482,
+		1, 0, 0, 0, 1485, 1483, 1, 0, 0, 0, 1486, 1489, 1, 0, 0, 0, 1487, 1485,
+		1, 0, 0, 0, 1487, 1488, 1, 0, 0, 0, 1488, 1490, 1, 0, 0, 0, 1489, 1487,
+		1, 0, 0, 0, 1490, 1491, 5, 36, 0, 0, 1491, 1492, 5, 36, 0, 0, 1492, 350,
+		1, 0, 0, 0, 1493, 1501, 5, 39, 0, 0, 1494, 1495, 5, 92, 0, 0, 1495, 1500,
+		9, 0, 0, 0, 1496, 1497, 5, 39, 0, 0, 1497, 1500, 5, 39, 0, 0, 1498, 1500,
+		8, 27, 0, 0, 1499, 1494, 1, 0, 0, 0, 1499, 1496, 1, 0, 0, 0, 1499, 1498,
+		1, 0, 0, 0, 1500, 1503, 1, 0, 0, 0, 1501, 1499, 1, 0, 0, 0, 1501, 1502,
+		1, 0, 0, 0, 1502, 1504, 1, 0, 0, 0, 1503, 1501, 1, 0, 0, 0, 1504, 1505,
+		5, 39, 0, 0, 1505, 352, 1, 0, 0, 0, 1506, 1508, 3, 379, 189, 0, 1507, 1506,
+		1, 0, 0, 0, 1508, 1509, 1, 0, 0, 0, 1509, 1507, 1, 0, 0, 0, 1509, 1510,
+		1, 0, 0, 0, 1510, 1511, 1, 0, 0, 0, 1511, 1512, 3, 381, 190, 0, 1512, 1514,
+		1, 0, 0, 0, 1513, 1507, 1, 0, 0, 0, 1514, 1515, 1, 0, 0, 0, 1515, 1513,
+		1, 0, 0, 0, 1515, 1516, 1, 0, 0, 0, 1516, 354, 1, 0, 0, 0, 1517, 1519,
+		3, 379, 189, 0, 1518, 1517, 1, 0, 0, 0, 1519, 1520, 1, 0, 0, 0, 1520, 1518,
+		1, 0, 0, 0, 1520, 1521, 1, 0, 0, 0, 1521, 356, 1, 0, 0, 0, 1522, 1524,
+		7, 28, 0, 0, 1523, 1522, 1, 0, 0, 0, 1524, 1525, 1, 0, 0, 0, 1525, 1523,
+		1, 0, 0, 0, 1525, 1526, 1, 0, 0, 0, 1526, 1533, 1, 0, 0, 0, 1527, 1529,
+		3, 19, 9, 0, 1528, 1530, 7, 28, 0, 0, 1529, 1528, 1, 0, 0, 0, 1530, 1531,
+		1, 0, 0, 0, 1531, 1529, 1, 0, 0, 0, 1531, 1532, 1, 0, 0, 0, 1532, 1534,
+		1, 0, 0, 0, 1533, 1527, 1, 0, 0, 0, 1533, 1534, 1, 0, 0, 0, 1534, 358,
+		1, 0, 0, 0, 1535, 1536, 7, 22, 0, 0, 1536, 1540, 5, 39, 0, 0, 1537, 1538,
+		3, 377, 188, 0, 1538, 1539, 3, 377, 188, 0, 1539, 1541, 1, 0, 0, 0, 1540,
+		1537, 1, 0, 0, 0, 1541, 1542, 1, 0, 0, 0, 1542, 1540, 1, 0, 0, 0, 1542,
+		1543, 1, 0, 0, 0, 1543, 1544, 1, 0, 0, 0, 1544, 1545, 5, 39, 0, 0, 1545,
+		1555, 1, 0, 0, 0, 1546, 1547, 5, 48, 0, 0, 1547, 1548, 7, 22, 0, 0, 1548,
+		1550, 1, 0, 0, 0, 1549, 1551, 3, 377, 188, 0, 1550, 1549, 1, 0, 0, 0, 1551,
+		1552, 1, 0, 0, 0, 1552, 1550, 1, 0, 0, 0, 1552, 1553, 1, 0, 0, 0, 1553,
+		1555, 1, 0, 0, 0, 1554, 1535, 1, 0, 0, 0, 1554, 1546, 1, 0, 0, 0, 1555,
+		360, 1, 0, 0, 0, 1556, 1558, 3, 379, 189, 0, 1557, 1556, 1, 0, 0, 0, 1558,
+		1559, 1, 0, 0, 0, 1559, 1557, 1, 0, 0, 0, 1559, 1560, 1, 0, 0, 0, 1560,
+		1562, 1, 0, 0, 0, 1561, 1563, 5, 46, 0, 0, 1562, 1561, 1, 0, 0, 0, 1562,
+		1563, 1, 0, 0, 0, 1563, 1564, 1, 0, 0, 0, 1564, 1565, 3, 383, 191, 0, 1565,
+		1582, 1, 0, 0, 0, 1566, 1568, 3, 379, 189, 0, 1567, 1566, 1, 0, 0, 0, 1568,
+		1571, 1, 0, 0, 0, 1569, 1567, 1, 0, 0, 0, 1569, 1570, 1, 0, 0, 0, 1570,
+		1572, 1, 0, 0, 0, 1571, 1569, 1, 0, 0, 0, 1572, 1574, 5, 46, 0, 0, 1573,
+		1575, 3, 379, 189, 0, 1574, 1573, 1, 0, 0, 0, 1575, 1576, 1, 0, 0, 0, 1576,
+		1574, 1, 0, 0, 0, 1576, 1577, 1, 0, 0, 0, 1577, 1579, 1, 0, 0, 0, 1578,
+		1580, 3, 383, 191, 0, 1579, 1578, 1, 0, 0, 0, 1579, 1580, 1, 0, 0, 0, 1580,
+		1582, 1, 0, 0, 0, 1581, 1557, 1, 0, 0, 0, 1581, 1569, 1, 0, 0, 0, 1582,
+		362, 1, 0, 0, 0, 1583, 1587, 7, 29, 0, 0, 1584, 1586, 7, 30, 0, 0, 1585,
+		1584, 1, 0, 0, 0, 1586, 1589, 1, 0, 0, 0, 1587, 1585, 1, 0, 0, 0, 1587,
+		1588, 1, 0, 0, 0, 1588, 1598, 1, 0, 0, 0, 1589, 1587, 1, 0, 0, 0, 1590,
+		1592, 5, 34, 0, 0, 1591, 1593, 8, 31, 0, 0, 1592, 1591, 1, 0, 0, 0, 1593,
+		1594, 1, 0, 0, 0, 1594, 1592, 1, 0, 0, 0, 1594, 1595, 1, 0, 0, 0, 1595,
+		1596, 1, 0, 0, 0, 1596, 1598, 5, 34, 0, 0, 1597, 1583, 1, 0, 0, 0, 1597,
+		1590, 1, 0, 0, 0, 1598, 364, 1, 0, 0, 0, 1599, 1600, 3, 375, 187, 0, 1600,
+		1601, 3, 375, 187, 0, 1601, 1602, 5, 45, 0, 0, 1602, 1603, 3, 375, 187,
+		0, 1603, 1604, 5, 45, 0, 0, 1604, 1605, 3, 375, 187, 0, 1605, 1606, 5,
+		45, 0, 0, 1606, 1607, 3, 375, 187, 0, 1607, 1608, 5, 45, 0, 0, 1608, 1609,
+		3, 375, 187, 0, 1609, 1610, 3, 375, 187, 0, 1610, 1611, 3, 375, 187, 0,
+		1611, 366, 1, 0, 0, 0, 1612, 1614, 7, 32, 0, 0, 1613, 1612, 1, 0, 0, 0,
+		1614, 1615, 1, 0, 0, 0, 1615, 1613, 1, 0, 0, 0, 1615, 1616, 1, 0, 0, 0,
+		1616, 1617, 1, 0, 0, 0, 1617, 1618, 6, 183, 0, 0, 1618, 368, 1, 0, 0, 0,
+		1619, 1620, 5, 47, 0, 0, 1620, 1621, 5, 42, 0, 0, 1621, 1622, 5, 33, 0,
+		0, 1622, 1624, 1, 0, 0, 0, 1623, 1625, 9, 0, 0, 0, 1624, 1623, 1, 0, 0,
+		0, 1625, 1626, 1, 0, 0, 0, 1626, 1627, 1, 0, 0, 0, 1626, 1624, 1, 0, 0,
+		0, 1627, 1628, 1, 0, 0, 0, 1628, 1629, 5, 42, 0, 0, 1629, 1630, 5, 47,
+		0, 0, 1630, 1631, 1, 0, 0, 0, 1631, 1632, 6, 184, 0, 0, 1632, 370, 1, 0,
+		0, 0, 1633, 1634, 5, 47, 0, 0, 1634, 1635, 5, 42, 0, 0, 1635, 1639, 1,
+		0, 0, 0, 1636, 1638, 9, 0, 0, 0, 1637, 1636, 1, 0, 0, 0, 1638, 1641, 1,
+		0, 0, 0, 1639, 1640, 1, 0, 0, 0, 1639, 1637, 1, 0, 0, 0, 1640, 1642, 1,
+		0, 0, 0, 1641, 1639, 1, 0, 0, 0, 1642, 1643, 5, 42, 0, 0, 1643, 1644, 5,
+		47, 0, 0, 1644, 1645, 1, 0, 0, 0, 1645, 1646, 6, 185, 0, 0, 1646, 372,
+		1, 0, 0, 0, 1647, 1648, 5, 45, 0, 0, 1648, 1649, 5, 45, 0, 0, 1649, 1654,
+		5, 32, 0, 0, 1650, 1654, 5, 35, 0, 0, 1651, 1652, 5, 47, 0, 0, 1652, 1654,
+		5, 47, 0, 0, 1653, 1647, 1, 0, 0, 0, 1653, 1650, 1, 0, 0, 0, 1653, 1651,
+		1, 0, 0, 0, 1654, 1658, 1, 0, 0, 0, 1655, 1657, 8, 33, 0, 0, 1656, 1655,
+		1, 0, 0, 0, 1657, 1660, 1, 0, 0, 0, 1658, 1656, 1, 0, 0, 0, 1658, 1659,
+		1, 0, 0, 0, 1659, 1666, 1, 0, 0, 0, 1660, 1658, 1, 0, 0, 0, 1661, 1663,
+		5, 13, 0, 0, 1662, 1661, 1, 0, 0, 0, 1662, 1663, 1, 0, 0, 0, 1663, 1664,
+		1, 0, 0, 0, 1664, 1667, 5, 10, 0, 0, 1665, 1667, 5, 0, 0, 1, 1666, 1662,
+		1, 0, 0, 0, 1666, 1665, 1, 0, 0, 0, 1667, 1679, 1, 0, 0, 0, 1668, 1669,
+		5, 45, 0, 0, 1669, 1670, 5, 45, 0, 0, 1670, 1676, 1, 0, 0, 0, 1671, 1673,
+		5, 13, 0, 0, 1672, 1671, 1, 0, 0, 0, 1672, 1673, 1, 0, 0, 0, 1673, 1674,
+		1, 0, 0, 0, 1674, 1677, 5, 10, 0, 0, 1675, 1677, 5, 0, 0, 1, 1676, 1672,
+		1, 0, 0, 0, 1676, 1675, 1, 0, 0, 0, 1677, 1679, 1, 0, 0, 0, 1678, 1653,
+		1, 0, 0, 0, 1678, 1668, 1, 0, 0, 0, 1679, 1680, 1, 0, 0, 0, 1680, 1681,
+		6, 186, 0, 0, 1681, 374, 1, 0, 0, 0, 1682, 1683, 7, 34, 0, 0, 1683, 1684,
+		7, 34, 0, 0, 1684, 1685, 7, 34, 0, 0, 1685, 1686, 7, 34, 0, 0, 1686, 376,
+		1, 0, 0, 0, 1687, 1688, 7, 34, 0, 0, 1688, 378, 1, 0, 0, 0, 1689, 1690,
+		7, 28, 0, 0, 1690, 380, 1, 0, 0, 0, 1691, 1704, 7, 11, 0, 0, 1692, 1693,
+		7, 19, 0, 0, 1693, 1704, 7, 8, 0, 0, 1694, 1704, 7, 35, 0, 0, 1695, 1696,
+		7, 19, 0, 0, 1696, 1704, 7, 6, 0, 0, 1697, 1698, 7, 14, 0, 0, 1698, 1704,
+		7, 6, 0, 0, 1699, 1700, 7, 36, 0, 0, 1700, 1704, 7, 6, 0, 0, 1701, 1702,
+		7, 10, 0, 0, 1702, 1704, 7, 6, 0, 0, 1703, 1691, 1, 0, 0, 0, 1703, 1692,
+		1, 0, 0, 0, 1703, 1694, 1, 0, 0, 0, 1703, 1695, 1, 0, 0, 0, 1703, 1697,
+		1, 0, 0, 0, 1703, 1699, 1, 0, 0, 0, 1703, 1701, 1, 0, 0, 0, 1704, 382,
+		1, 0, 0, 0, 1705, 1707, 7, 4, 0, 0, 1706, 1708, 5, 45, 0, 0, 1707, 1706,
+		1, 0, 0, 0, 1707, 1708, 1, 0, 0, 0, 1708, 1710, 1, 0, 0, 0, 1709, 1711,
+		3, 379, 189, 0, 1710, 1709, 1, 0, 0, 0, 1711, 1712, 1, 0, 0, 0, 1712, 1710,
+		1, 0, 0, 0, 1712, 1713, 1, 0, 0, 0, 1713, 384, 1, 0, 0, 0, 36, 0, 1485,
+		1487, 1499, 1501, 1509, 1515, 1520, 1525, 1531, 1533, 1542, 1552, 1554,
+		1559, 1562, 1569, 1576, 1579, 1581, 1587, 1594, 1597, 1615, 1626, 1639,
+		1653, 1658, 1662, 1666, 1672, 1676, 1678, 1703, 1707, 1712, 1, 0, 1, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -913,154 +980,166 @@ const (
 	CqlLexerOPERATOR_GTE        = 23
 	CqlLexerK_ADD               = 24
 	CqlLexerK_AGGREGATE         = 25
-	CqlLexerK_ALL               = 26
-	CqlLexerK_ALLOW             = 27
-	CqlLexerK_ALTER             = 28
-	CqlLexerK_AND               = 29
-	CqlLexerK_ANY               = 30
-	CqlLexerK_APPLY             = 31
-	CqlLexerK_AS                = 32
-	CqlLexerK_ASC               = 33
-	CqlLexerK_AUTHORIZE         = 34
-	CqlLexerK_BATCH             = 35
-	CqlLexerK_BEGIN             = 36
-	CqlLexerK_BY                = 37
-	CqlLexerK_CALLED            = 38
-	CqlLexerK_CLUSTERING        = 39
-	CqlLexerK_COLUMNFAMILY      = 40
-	CqlLexerK_COMPACT           = 41
-	CqlLexerK_CONSISTENCY       = 42
-	CqlLexerK_CONTAINS          = 43
-	CqlLexerK_CREATE            = 44
-	CqlLexerK_CUSTOM            = 45
-	CqlLexerK_DELETE            = 46
-	CqlLexerK_DESC              = 47
-	CqlLexerK_DESCRIBE          = 48
-	CqlLexerK_DISTINCT          = 49
-	CqlLexerK_DROP              = 50
-	CqlLexerK_DURABLE_WRITES    = 51
-	CqlLexerK_EACH_QUORUM       = 52
-	CqlLexerK_ENTRIES           = 53
-	CqlLexerK_EXECUTE           = 54
-	CqlLexerK_EXISTS            = 55
-	CqlLexerK_FALSE             = 56
-	CqlLexerK_FILTERING         = 57
-	CqlLexerK_FINALFUNC         = 58
-	CqlLexerK_FROM              = 59
-	CqlLexerK_FULL              = 60
-	CqlLexerK_FUNCTION          = 61
-	CqlLexerK_FUNCTIONS         = 62
-	CqlLexerK_GRANT             = 63
-	CqlLexerK_IF                = 64
-	CqlLexerK_IN                = 65
-	CqlLexerK_INDEX             = 66
-	CqlLexerK_INFINITY          = 67
-	CqlLexerK_INITCOND          = 68
-	CqlLexerK_INPUT             = 69
-	CqlLexerK_INSERT            = 70
-	CqlLexerK_INTO              = 71
-	CqlLexerK_IS                = 72
-	CqlLexerK_JSON              = 73
-	CqlLexerK_KEY               = 74
-	CqlLexerK_KEYS              = 75
-	CqlLexerK_KEYSPACE          = 76
-	CqlLexerK_KEYSPACES         = 77
-	CqlLexerK_LANGUAGE          = 78
-	CqlLexerK_LEVEL             = 79
-	CqlLexerK_LIMIT             = 80
-	CqlLexerK_LOCAL_ONE         = 81
-	CqlLexerK_LOCAL_QUORUM      = 82
-	CqlLexerK_LOGGED            = 83
-	CqlLexerK_LOGIN             = 84
-	CqlLexerK_MATERIALIZED      = 85
-	CqlLexerK_MODIFY            = 86
-	CqlLexerK_NAN               = 87
-	CqlLexerK_NORECURSIVE       = 88
-	CqlLexerK_NOSUPERUSER       = 89
-	CqlLexerK_NOT               = 90
-	CqlLexerK_NULL              = 91
-	CqlLexerK_OF                = 92
-	CqlLexerK_ON                = 93
-	CqlLexerK_ONE               = 94
-	CqlLexerK_OPTIONS           = 95
-	CqlLexerK_OR                = 96
-	CqlLexerK_ORDER             = 97
-	CqlLexerK_PARTITION         = 98
-	CqlLexerK_PASSWORD          = 99
-	CqlLexerK_PER               = 100
-	CqlLexerK_PERMISSION        = 101
-	CqlLexerK_PERMISSIONS       = 102
-	CqlLexerK_PRIMARY           = 103
-	CqlLexerK_QUORUM            = 104
-	CqlLexerK_RENAME            = 105
-	CqlLexerK_REPLACE           = 106
-	CqlLexerK_REPLICATION       = 107
-	CqlLexerK_RETURNS           = 108
-	CqlLexerK_REVOKE            = 109
-	CqlLexerK_ROLE              = 110
-	CqlLexerK_ROLES             = 111
-	CqlLexerK_SCHEMA            = 112
-	CqlLexerK_SELECT            = 113
-	CqlLexerK_SET               = 114
-	CqlLexerK_SFUNC             = 115
-	CqlLexerK_STATIC            = 116
-	CqlLexerK_STORAGE           = 117
-	CqlLexerK_STYPE             = 118
-	CqlLexerK_SUPERUSER         = 119
-	CqlLexerK_TABLE             = 120
-	CqlLexerK_THREE             = 121
-	CqlLexerK_TIMESTAMP         = 122
-	CqlLexerK_TO                = 123
-	CqlLexerK_TOKEN             = 124
-	CqlLexerK_TRIGGER           = 125
-	CqlLexerK_TRUE              = 126
-	CqlLexerK_TRUNCATE          = 127
-	CqlLexerK_TTL               = 128
-	CqlLexerK_TWO               = 129
-	CqlLexerK_TYPE              = 130
-	CqlLexerK_UNLOGGED          = 131
-	CqlLexerK_UPDATE            = 132
-	CqlLexerK_USE               = 133
-	CqlLexerK_USER              = 134
-	CqlLexerK_USING             = 135
-	CqlLexerK_UUID              = 136
-	CqlLexerK_VALUES            = 137
-	CqlLexerK_VIEW              = 138
-	CqlLexerK_WHERE             = 139
-	CqlLexerK_WITH              = 140
-	CqlLexerK_WRITETIME         = 141
-	CqlLexerK_ASCII             = 142
-	CqlLexerK_BIGINT            = 143
-	CqlLexerK_BLOB              = 144
-	CqlLexerK_BOOLEAN           = 145
-	CqlLexerK_COUNTER           = 146
-	CqlLexerK_DATE              = 147
-	CqlLexerK_DECIMAL           = 148
-	CqlLexerK_DOUBLE            = 149
-	CqlLexerK_FLOAT             = 150
-	CqlLexerK_FROZEN            = 151
-	CqlLexerK_INET              = 152
-	CqlLexerK_INT               = 153
-	CqlLexerK_LIST              = 154
-	CqlLexerK_MAP               = 155
-	CqlLexerK_SMALLINT          = 156
-	CqlLexerK_TEXT              = 157
-	CqlLexerK_TIMEUUID          = 158
-	CqlLexerK_TIME              = 159
-	CqlLexerK_TINYINT           = 160
-	CqlLexerK_TUPLE             = 161
-	CqlLexerK_VARCHAR           = 162
-	CqlLexerK_VARINT            = 163
-	CqlLexerCODE_BLOCK          = 164
-	CqlLexerSTRING_LITERAL      = 165
-	CqlLexerDECIMAL_LITERAL     = 166
-	CqlLexerFLOAT_LITERAL       = 167
-	CqlLexerHEXADECIMAL_LITERAL = 168
-	CqlLexerREAL_LITERAL        = 169
-	CqlLexerOBJECT_NAME         = 170
-	CqlLexerUUID                = 171
-	CqlLexerSPACE               = 172
-	CqlLexerSPEC_MYSQL_COMMENT  = 173
-	CqlLexerCOMMENT_INPUT       = 174
-	CqlLexerLINE_COMMENT        = 175
+	CqlLexerK_AGGREGATES        = 26
+	CqlLexerK_ALL               = 27
+	CqlLexerK_ALLOW             = 28
+	CqlLexerK_ALTER             = 29
+	CqlLexerK_AND               = 30
+	CqlLexerK_ANN               = 31
+	CqlLexerK_ANY               = 32
+	CqlLexerK_APPLY             = 33
+	CqlLexerK_AS                = 34
+	CqlLexerK_ASC               = 35
+	CqlLexerK_AUTHORIZE         = 36
+	CqlLexerK_BATCH             = 37
+	CqlLexerK_BEGIN             = 38
+	CqlLexerK_BY                = 39
+	CqlLexerK_CALLED            = 40
+	CqlLexerK_CAST              = 41
+	CqlLexerK_CLUSTERING        = 42
+	CqlLexerK_CLUSTER           = 43
+	CqlLexerK_COLUMNFAMILY      = 44
+	CqlLexerK_COMPACT           = 45
+	CqlLexerK_CONSISTENCY       = 46
+	CqlLexerK_CONTAINS          = 47
+	CqlLexerK_CREATE            = 48
+	CqlLexerK_CUSTOM            = 49
+	CqlLexerK_DELETE            = 50
+	CqlLexerK_DESC              = 51
+	CqlLexerK_DESCRIBE          = 52
+	CqlLexerK_DISTINCT          = 53
+	CqlLexerK_DROP              = 54
+	CqlLexerK_DURABLE_WRITES    = 55
+	CqlLexerK_EACH_QUORUM       = 56
+	CqlLexerK_ENTRIES           = 57
+	CqlLexerK_EXECUTE           = 58
+	CqlLexerK_EXISTS            = 59
+	CqlLexerK_FALSE             = 60
+	CqlLexerK_FILTERING         = 61
+	CqlLexerK_FINALFUNC         = 62
+	CqlLexerK_FROM              = 63
+	CqlLexerK_FULL              = 64
+	CqlLexerK_FUNCTION          = 65
+	CqlLexerK_FUNCTIONS         = 66
+	CqlLexerK_GRANT             = 67
+	CqlLexerK_GROUP             = 68
+	CqlLexerK_IF                = 69
+	CqlLexerK_IN                = 70
+	CqlLexerK_INDEX             = 71
+	CqlLexerK_INFINITY          = 72
+	CqlLexerK_INITCOND          = 73
+	CqlLexerK_INPUT             = 74
+	CqlLexerK_INSERT            = 75
+	CqlLexerK_INTO              = 76
+	CqlLexerK_INTERNALS         = 77
+	CqlLexerK_IS                = 78
+	CqlLexerK_JSON              = 79
+	CqlLexerK_KEY               = 80
+	CqlLexerK_KEYS              = 81
+	CqlLexerK_KEYSPACE          = 82
+	CqlLexerK_KEYSPACES         = 83
+	CqlLexerK_LANGUAGE          = 84
+	CqlLexerK_LEVEL             = 85
+	CqlLexerK_LIMIT             = 86
+	CqlLexerK_LOCAL_ONE         = 87
+	CqlLexerK_LOCAL_QUORUM      = 88
+	CqlLexerK_LOGGED            = 89
+	CqlLexerK_LOGIN             = 90
+	CqlLexerK_MATERIALIZED      = 91
+	CqlLexerK_MODIFY            = 92
+	CqlLexerK_NAN               = 93
+	CqlLexerK_NORECURSIVE       = 94
+	CqlLexerK_NOSUPERUSER       = 95
+	CqlLexerK_NOT               = 96
+	CqlLexerK_NULL              = 97
+	CqlLexerK_OF                = 98
+	CqlLexerK_ON                = 99
+	CqlLexerK_ONE               = 100
+	CqlLexerK_ONLY              = 101
+	CqlLexerK_OPTIONS           = 102
+	CqlLexerK_OR                = 103
+	CqlLexerK_ORDER             = 104
+	CqlLexerK_PARTITION         = 105
+	CqlLexerK_PASSWORD          = 106
+	CqlLexerK_PER               = 107
+	CqlLexerK_PERMISSION        = 108
+	CqlLexerK_PERMISSIONS       = 109
+	CqlLexerK_PRIMARY           = 110
+	CqlLexerK_QUORUM            = 111
+	CqlLexerK_RENAME            = 112
+	CqlLexerK_REPLACE           = 113
+	CqlLexerK_REPLICATION       = 114
+	CqlLexerK_RETURNS           = 115
+	CqlLexerK_REVOKE            = 116
+	CqlLexerK_ROLE              = 117
+	CqlLexerK_ROLES             = 118
+	CqlLexerK_SCHEMA            = 119
+	CqlLexerK_SELECT            = 120
+	CqlLexerK_SET               = 121
+	CqlLexerK_SFUNC             = 122
+	CqlLexerK_STATIC            = 123
+	CqlLexerK_STORAGE           = 124
+	CqlLexerK_STYPE             = 125
+	CqlLexerK_SUPERUSER         = 126
+	CqlLexerK_TABLE             = 127
+	CqlLexerK_TABLES            = 128
+	CqlLexerK_THREE             = 129
+	CqlLexerK_TIMESTAMP         = 130
+	CqlLexerK_TO                = 131
+	CqlLexerK_TOKEN             = 132
+	CqlLexerK_TRIGGER           = 133
+	CqlLexerK_TRUE              = 134
+	CqlLexerK_TRUNCATE          = 135
+	CqlLexerK_TTL               = 136
+	CqlLexerK_TWO               = 137
+	CqlLexerK_TYPE              = 138
+	CqlLexerK_TYPES             = 139
+	CqlLexerK_UNLOGGED          = 140
+	CqlLexerK_UPDATE            = 141
+	CqlLexerK_USE               = 142
+	CqlLexerK_USER              = 143
+	CqlLexerK_USING             = 144
+	CqlLexerK_UUID              = 145
+	CqlLexerK_VALUES            = 146
+	CqlLexerK_VIEW              = 147
+	CqlLexerK_WHERE             = 148
+	CqlLexerK_WITH              = 149
+	CqlLexerK_WRITETIME         = 150
+	CqlLexerK_ASCII             = 151
+	CqlLexerK_BIGINT            = 152
+	CqlLexerK_BLOB              = 153
+	CqlLexerK_BOOLEAN           = 154
+	CqlLexerK_COUNTER           = 155
+	CqlLexerK_DATE              = 156
+	CqlLexerK_DECIMAL           = 157
+	CqlLexerK_DOUBLE            = 158
+	CqlLexerK_DURATION          = 159
+	CqlLexerK_FLOAT             = 160
+	CqlLexerK_FROZEN            = 161
+	CqlLexerK_INET              = 162
+	CqlLexerK_INT               = 163
+	CqlLexerK_LIST              = 164
+	CqlLexerK_MAP               = 165
+	CqlLexerK_SMALLINT          = 166
+	CqlLexerK_TEXT              = 167
+	CqlLexerK_TIMEUUID          = 168
+	CqlLexerK_TIME              = 169
+	CqlLexerK_TINYINT           = 170
+	CqlLexerK_TUPLE             = 171
+	CqlLexerK_VARCHAR           = 172
+	CqlLexerK_VARINT            = 173
+	CqlLexerK_VECTOR            = 174
+	CqlLexerCODE_BLOCK          = 175
+	CqlLexerSTRING_LITERAL      = 176
+	CqlLexerDURATION_LITERAL    = 177
+	CqlLexerDECIMAL_LITERAL     = 178
+	CqlLexerFLOAT_LITERAL       = 179
+	CqlLexerHEXADECIMAL_LITERAL = 180
+	CqlLexerREAL_LITERAL        = 181
+	CqlLexerOBJECT_NAME         = 182
+	CqlLexerUUID                = 183
+	CqlLexerSPACE               = 184
+	CqlLexerSPEC_MYSQL_COMMENT  = 185
+	CqlLexerCOMMENT_INPUT       = 186
+	CqlLexerLINE_COMMENT        = 187
 )
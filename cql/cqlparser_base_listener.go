@@ -74,6 +74,18 @@ func (s *BaseCqlParserListener) EnterGrant(ctx *GrantContext) {}
 // ExitGrant is called when production grant is exited.
 func (s *BaseCqlParserListener) ExitGrant(ctx *GrantContext) {}
 
+// EnterDescribe is called when production describe is entered.
+func (s *BaseCqlParserListener) EnterDescribe(ctx *DescribeContext) {}
+
+// ExitDescribe is called when production describe is exited.
+func (s *BaseCqlParserListener) ExitDescribe(ctx *DescribeContext) {}
+
+// EnterDescribeTarget is called when production describeTarget is entered.
+func (s *BaseCqlParserListener) EnterDescribeTarget(ctx *DescribeTargetContext) {}
+
+// ExitDescribeTarget is called when production describeTarget is exited.
+func (s *BaseCqlParserListener) ExitDescribeTarget(ctx *DescribeTargetContext) {}
+
 // EnterPriviledge is called when production priviledge is entered.
 func (s *BaseCqlParserListener) EnterPriviledge(ctx *PriviledgeContext) {}
 
@@ -618,6 +630,12 @@ func (s *BaseCqlParserListener) EnterCreateIndex(ctx *CreateIndexContext) {}
 // ExitCreateIndex is called when production createIndex is exited.
 func (s *BaseCqlParserListener) ExitCreateIndex(ctx *CreateIndexContext) {}
 
+// EnterIndexUsing is called when production indexUsing is entered.
+func (s *BaseCqlParserListener) EnterIndexUsing(ctx *IndexUsingContext) {}
+
+// ExitIndexUsing is called when production indexUsing is exited.
+func (s *BaseCqlParserListener) ExitIndexUsing(ctx *IndexUsingContext) {}
+
 // EnterIndexName is called when production indexName is entered.
 func (s *BaseCqlParserListener) EnterIndexName(ctx *IndexNameContext) {}
 
@@ -816,6 +834,24 @@ func (s *BaseCqlParserListener) EnterLimitSpec(ctx *LimitSpecContext) {}
 // ExitLimitSpec is called when production limitSpec is exited.
 func (s *BaseCqlParserListener) ExitLimitSpec(ctx *LimitSpecContext) {}
 
+// EnterPerPartitionLimitSpec is called when production perPartitionLimitSpec is entered.
+func (s *BaseCqlParserListener) EnterPerPartitionLimitSpec(ctx *PerPartitionLimitSpecContext) {}
+
+// ExitPerPartitionLimitSpec is called when production perPartitionLimitSpec is exited.
+func (s *BaseCqlParserListener) ExitPerPartitionLimitSpec(ctx *PerPartitionLimitSpecContext) {}
+
+// EnterGroupSpec is called when production groupSpec is entered.
+func (s *BaseCqlParserListener) EnterGroupSpec(ctx *GroupSpecContext) {}
+
+// ExitGroupSpec is called when production groupSpec is exited.
+func (s *BaseCqlParserListener) ExitGroupSpec(ctx *GroupSpecContext) {}
+
+// EnterGroupSpecElement is called when production groupSpecElement is entered.
+func (s *BaseCqlParserListener) EnterGroupSpecElement(ctx *GroupSpecElementContext) {}
+
+// ExitGroupSpecElement is called when production groupSpecElement is exited.
+func (s *BaseCqlParserListener) ExitGroupSpecElement(ctx *GroupSpecElementContext) {}
+
 // EnterFromSpec is called when production fromSpec is entered.
 func (s *BaseCqlParserListener) EnterFromSpec(ctx *FromSpecContext) {}
 
@@ -864,6 +900,30 @@ func (s *BaseCqlParserListener) EnterSelectElement(ctx *SelectElementContext) {}
 // ExitSelectElement is called when production selectElement is exited.
 func (s *BaseCqlParserListener) ExitSelectElement(ctx *SelectElementContext) {}
 
+// EnterCastFunction is called when production castFunction is entered.
+func (s *BaseCqlParserListener) EnterCastFunction(ctx *CastFunctionContext) {}
+
+// ExitCastFunction is called when production castFunction is exited.
+func (s *BaseCqlParserListener) ExitCastFunction(ctx *CastFunctionContext) {}
+
+// EnterArithmeticExpression is called when production arithmeticExpression is entered.
+func (s *BaseCqlParserListener) EnterArithmeticExpression(ctx *ArithmeticExpressionContext) {}
+
+// ExitArithmeticExpression is called when production arithmeticExpression is exited.
+func (s *BaseCqlParserListener) ExitArithmeticExpression(ctx *ArithmeticExpressionContext) {}
+
+// EnterArithmeticOperand is called when production arithmeticOperand is entered.
+func (s *BaseCqlParserListener) EnterArithmeticOperand(ctx *ArithmeticOperandContext) {}
+
+// ExitArithmeticOperand is called when production arithmeticOperand is exited.
+func (s *BaseCqlParserListener) ExitArithmeticOperand(ctx *ArithmeticOperandContext) {}
+
+// EnterArithmeticOperator is called when production arithmeticOperator is entered.
+func (s *BaseCqlParserListener) EnterArithmeticOperator(ctx *ArithmeticOperatorContext) {}
+
+// ExitArithmeticOperator is called when production arithmeticOperator is exited.
+func (s *BaseCqlParserListener) ExitArithmeticOperator(ctx *ArithmeticOperatorContext) {}
+
 // EnterRelationElements is called when production relationElements is entered.
 func (s *BaseCqlParserListener) EnterRelationElements(ctx *RelationElementsContext) {}
 
@@ -924,6 +984,12 @@ func (s *BaseCqlParserListener) EnterStringLiteral(ctx *StringLiteralContext) {}
 // ExitStringLiteral is called when production stringLiteral is exited.
 func (s *BaseCqlParserListener) ExitStringLiteral(ctx *StringLiteralContext) {}
 
+// EnterDurationLiteral is called when production durationLiteral is entered.
+func (s *BaseCqlParserListener) EnterDurationLiteral(ctx *DurationLiteralContext) {}
+
+// ExitDurationLiteral is called when production durationLiteral is exited.
+func (s *BaseCqlParserListener) ExitDurationLiteral(ctx *DurationLiteralContext) {}
+
 // EnterBooleanLiteral is called when production booleanLiteral is entered.
 func (s *BaseCqlParserListener) EnterBooleanLiteral(ctx *BooleanLiteralContext) {}
 
@@ -960,6 +1026,12 @@ func (s *BaseCqlParserListener) EnterDataType(ctx *DataTypeContext) {}
 // ExitDataType is called when production dataType is exited.
 func (s *BaseCqlParserListener) ExitDataType(ctx *DataTypeContext) {}
 
+// EnterVectorType is called when production vectorType is entered.
+func (s *BaseCqlParserListener) EnterVectorType(ctx *VectorTypeContext) {}
+
+// ExitVectorType is called when production vectorType is exited.
+func (s *BaseCqlParserListener) ExitVectorType(ctx *VectorTypeContext) {}
+
 // EnterDataTypeName is called when production dataTypeName is entered.
 func (s *BaseCqlParserListener) EnterDataTypeName(ctx *DataTypeNameContext) {}
 
@@ -972,6 +1044,12 @@ func (s *BaseCqlParserListener) EnterDataTypeDefinition(ctx *DataTypeDefinitionC
 // ExitDataTypeDefinition is called when production dataTypeDefinition is exited.
 func (s *BaseCqlParserListener) ExitDataTypeDefinition(ctx *DataTypeDefinitionContext) {}
 
+// EnterUnreservedKeyword is called when production unreservedKeyword is entered.
+func (s *BaseCqlParserListener) EnterUnreservedKeyword(ctx *UnreservedKeywordContext) {}
+
+// ExitUnreservedKeyword is called when production unreservedKeyword is exited.
+func (s *BaseCqlParserListener) ExitUnreservedKeyword(ctx *UnreservedKeywordContext) {}
+
 // EnterOrderDirection is called when production orderDirection is entered.
 func (s *BaseCqlParserListener) EnterOrderDirection(ctx *OrderDirectionContext) {}
 
@@ -1068,6 +1146,12 @@ func (s *BaseCqlParserListener) EnterKwAggregate(ctx *KwAggregateContext) {}
 // ExitKwAggregate is called when production kwAggregate is exited.
 func (s *BaseCqlParserListener) ExitKwAggregate(ctx *KwAggregateContext) {}
 
+// EnterKwAggregates is called when production kwAggregates is entered.
+func (s *BaseCqlParserListener) EnterKwAggregates(ctx *KwAggregatesContext) {}
+
+// ExitKwAggregates is called when production kwAggregates is exited.
+func (s *BaseCqlParserListener) ExitKwAggregates(ctx *KwAggregatesContext) {}
+
 // EnterKwAll is called when production kwAll is entered.
 func (s *BaseCqlParserListener) EnterKwAll(ctx *KwAllContext) {}
 
@@ -1098,6 +1182,12 @@ func (s *BaseCqlParserListener) EnterKwAnd(ctx *KwAndContext) {}
 // ExitKwAnd is called when production kwAnd is exited.
 func (s *BaseCqlParserListener) ExitKwAnd(ctx *KwAndContext) {}
 
+// EnterKwAnn is called when production kwAnn is entered.
+func (s *BaseCqlParserListener) EnterKwAnn(ctx *KwAnnContext) {}
+
+// ExitKwAnn is called when production kwAnn is exited.
+func (s *BaseCqlParserListener) ExitKwAnn(ctx *KwAnnContext) {}
+
 // EnterKwApply is called when production kwApply is entered.
 func (s *BaseCqlParserListener) EnterKwApply(ctx *KwApplyContext) {}
 
@@ -1146,6 +1236,18 @@ func (s *BaseCqlParserListener) EnterKwCalled(ctx *KwCalledContext) {}
 // ExitKwCalled is called when production kwCalled is exited.
 func (s *BaseCqlParserListener) ExitKwCalled(ctx *KwCalledContext) {}
 
+// EnterKwCast is called when production kwCast is entered.
+func (s *BaseCqlParserListener) EnterKwCast(ctx *KwCastContext) {}
+
+// ExitKwCast is called when production kwCast is exited.
+func (s *BaseCqlParserListener) ExitKwCast(ctx *KwCastContext) {}
+
+// EnterKwCluster is called when production kwCluster is entered.
+func (s *BaseCqlParserListener) EnterKwCluster(ctx *KwClusterContext) {}
+
+// ExitKwCluster is called when production kwCluster is exited.
+func (s *BaseCqlParserListener) ExitKwCluster(ctx *KwClusterContext) {}
+
 // EnterKwClustering is called when production kwClustering is entered.
 func (s *BaseCqlParserListener) EnterKwClustering(ctx *KwClusteringContext) {}
 
@@ -1170,6 +1272,12 @@ func (s *BaseCqlParserListener) EnterKwCreate(ctx *KwCreateContext) {}
 // ExitKwCreate is called when production kwCreate is exited.
 func (s *BaseCqlParserListener) ExitKwCreate(ctx *KwCreateContext) {}
 
+// EnterKwCustom is called when production kwCustom is entered.
+func (s *BaseCqlParserListener) EnterKwCustom(ctx *KwCustomContext) {}
+
+// ExitKwCustom is called when production kwCustom is exited.
+func (s *BaseCqlParserListener) ExitKwCustom(ctx *KwCustomContext) {}
+
 // EnterKwDelete is called when production kwDelete is entered.
 func (s *BaseCqlParserListener) EnterKwDelete(ctx *KwDeleteContext) {}
 
@@ -1188,6 +1296,12 @@ func (s *BaseCqlParserListener) EnterKwDescibe(ctx *KwDescibeContext) {}
 // ExitKwDescibe is called when production kwDescibe is exited.
 func (s *BaseCqlParserListener) ExitKwDescibe(ctx *KwDescibeContext) {}
 
+// EnterKwDescribe is called when production kwDescribe is entered.
+func (s *BaseCqlParserListener) EnterKwDescribe(ctx *KwDescribeContext) {}
+
+// ExitKwDescribe is called when production kwDescribe is exited.
+func (s *BaseCqlParserListener) ExitKwDescribe(ctx *KwDescribeContext) {}
+
 // EnterKwDistinct is called when production kwDistinct is entered.
 func (s *BaseCqlParserListener) EnterKwDistinct(ctx *KwDistinctContext) {}
 
@@ -1266,6 +1380,12 @@ func (s *BaseCqlParserListener) EnterKwGrant(ctx *KwGrantContext) {}
 // ExitKwGrant is called when production kwGrant is exited.
 func (s *BaseCqlParserListener) ExitKwGrant(ctx *KwGrantContext) {}
 
+// EnterKwGroup is called when production kwGroup is entered.
+func (s *BaseCqlParserListener) EnterKwGroup(ctx *KwGroupContext) {}
+
+// ExitKwGroup is called when production kwGroup is exited.
+func (s *BaseCqlParserListener) ExitKwGroup(ctx *KwGroupContext) {}
+
 // EnterKwIf is called when production kwIf is entered.
 func (s *BaseCqlParserListener) EnterKwIf(ctx *KwIfContext) {}
 
@@ -1302,6 +1422,12 @@ func (s *BaseCqlParserListener) EnterKwInsert(ctx *KwInsertContext) {}
 // ExitKwInsert is called when production kwInsert is exited.
 func (s *BaseCqlParserListener) ExitKwInsert(ctx *KwInsertContext) {}
 
+// EnterKwInternals is called when production kwInternals is entered.
+func (s *BaseCqlParserListener) EnterKwInternals(ctx *KwInternalsContext) {}
+
+// ExitKwInternals is called when production kwInternals is exited.
+func (s *BaseCqlParserListener) ExitKwInternals(ctx *KwInternalsContext) {}
+
 // EnterKwInto is called when production kwInto is entered.
 func (s *BaseCqlParserListener) EnterKwInto(ctx *KwIntoContext) {}
 
@@ -1422,6 +1548,12 @@ func (s *BaseCqlParserListener) EnterKwOn(ctx *KwOnContext) {}
 // ExitKwOn is called when production kwOn is exited.
 func (s *BaseCqlParserListener) ExitKwOn(ctx *KwOnContext) {}
 
+// EnterKwOnly is called when production kwOnly is entered.
+func (s *BaseCqlParserListener) EnterKwOnly(ctx *KwOnlyContext) {}
+
+// ExitKwOnly is called when production kwOnly is exited.
+func (s *BaseCqlParserListener) ExitKwOnly(ctx *KwOnlyContext) {}
+
 // EnterKwOptions is called when production kwOptions is entered.
 func (s *BaseCqlParserListener) EnterKwOptions(ctx *KwOptionsContext) {}
 
@@ -1440,12 +1572,24 @@ func (s *BaseCqlParserListener) EnterKwOrder(ctx *KwOrderContext) {}
 // ExitKwOrder is called when production kwOrder is exited.
 func (s *BaseCqlParserListener) ExitKwOrder(ctx *KwOrderContext) {}
 
+// EnterKwPartition is called when production kwPartition is entered.
+func (s *BaseCqlParserListener) EnterKwPartition(ctx *KwPartitionContext) {}
+
+// ExitKwPartition is called when production kwPartition is exited.
+func (s *BaseCqlParserListener) ExitKwPartition(ctx *KwPartitionContext) {}
+
 // EnterKwPassword is called when production kwPassword is entered.
 func (s *BaseCqlParserListener) EnterKwPassword(ctx *KwPasswordContext) {}
 
 // ExitKwPassword is called when production kwPassword is exited.
 func (s *BaseCqlParserListener) ExitKwPassword(ctx *KwPasswordContext) {}
 
+// EnterKwPer is called when production kwPer is entered.
+func (s *BaseCqlParserListener) EnterKwPer(ctx *KwPerContext) {}
+
+// ExitKwPer is called when production kwPer is exited.
+func (s *BaseCqlParserListener) ExitKwPer(ctx *KwPerContext) {}
+
 // EnterKwPrimary is called when production kwPrimary is entered.
 func (s *BaseCqlParserListener) EnterKwPrimary(ctx *KwPrimaryContext) {}
 
@@ -1488,6 +1632,12 @@ func (s *BaseCqlParserListener) EnterKwRoles(ctx *KwRolesContext) {}
 // ExitKwRoles is called when production kwRoles is exited.
 func (s *BaseCqlParserListener) ExitKwRoles(ctx *KwRolesContext) {}
 
+// EnterKwSchema is called when production kwSchema is entered.
+func (s *BaseCqlParserListener) EnterKwSchema(ctx *KwSchemaContext) {}
+
+// ExitKwSchema is called when production kwSchema is exited.
+func (s *BaseCqlParserListener) ExitKwSchema(ctx *KwSchemaContext) {}
+
 // EnterKwSelect is called when production kwSelect is entered.
 func (s *BaseCqlParserListener) EnterKwSelect(ctx *KwSelectContext) {}
 
@@ -1530,6 +1680,12 @@ func (s *BaseCqlParserListener) EnterKwTable(ctx *KwTableContext) {}
 // ExitKwTable is called when production kwTable is exited.
 func (s *BaseCqlParserListener) ExitKwTable(ctx *KwTableContext) {}
 
+// EnterKwTables is called when production kwTables is entered.
+func (s *BaseCqlParserListener) EnterKwTables(ctx *KwTablesContext) {}
+
+// ExitKwTables is called when production kwTables is exited.
+func (s *BaseCqlParserListener) ExitKwTables(ctx *KwTablesContext) {}
+
 // EnterKwTimestamp is called when production kwTimestamp is entered.
 func (s *BaseCqlParserListener) EnterKwTimestamp(ctx *KwTimestampContext) {}
 
@@ -1566,6 +1722,12 @@ func (s *BaseCqlParserListener) EnterKwType(ctx *KwTypeContext) {}
 // ExitKwType is called when production kwType is exited.
 func (s *BaseCqlParserListener) ExitKwType(ctx *KwTypeContext) {}
 
+// EnterKwTypes is called when production kwTypes is entered.
+func (s *BaseCqlParserListener) EnterKwTypes(ctx *KwTypesContext) {}
+
+// ExitKwTypes is called when production kwTypes is exited.
+func (s *BaseCqlParserListener) ExitKwTypes(ctx *KwTypesContext) {}
+
 // EnterKwUnlogged is called when production kwUnlogged is entered.
 func (s *BaseCqlParserListener) EnterKwUnlogged(ctx *KwUnloggedContext) {}
 
@@ -1602,6 +1764,12 @@ func (s *BaseCqlParserListener) EnterKwValues(ctx *KwValuesContext) {}
 // ExitKwValues is called when production kwValues is exited.
 func (s *BaseCqlParserListener) ExitKwValues(ctx *KwValuesContext) {}
 
+// EnterKwVector is called when production kwVector is entered.
+func (s *BaseCqlParserListener) EnterKwVector(ctx *KwVectorContext) {}
+
+// ExitKwVector is called when production kwVector is exited.
+func (s *BaseCqlParserListener) ExitKwVector(ctx *KwVectorContext) {}
+
 // EnterKwView is called when production kwView is entered.
 func (s *BaseCqlParserListener) EnterKwView(ctx *KwViewContext) {}
 
@@ -43,6 +43,14 @@ func (v *BaseCqlParserVisitor) VisitGrant(ctx *GrantContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitDescribe(ctx *DescribeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCqlParserVisitor) VisitDescribeTarget(ctx *DescribeTargetContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitPriviledge(ctx *PriviledgeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -403,6 +411,10 @@ func (v *BaseCqlParserVisitor) VisitCreateIndex(ctx *CreateIndexContext) interfa
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitIndexUsing(ctx *IndexUsingContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitIndexName(ctx *IndexNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -535,6 +547,18 @@ func (v *BaseCqlParserVisitor) VisitLimitSpec(ctx *LimitSpecContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitPerPartitionLimitSpec(ctx *PerPartitionLimitSpecContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCqlParserVisitor) VisitGroupSpec(ctx *GroupSpecContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCqlParserVisitor) VisitGroupSpecElement(ctx *GroupSpecElementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitFromSpec(ctx *FromSpecContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -567,6 +591,22 @@ func (v *BaseCqlParserVisitor) VisitSelectElement(ctx *SelectElementContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitCastFunction(ctx *CastFunctionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCqlParserVisitor) VisitArithmeticExpression(ctx *ArithmeticExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCqlParserVisitor) VisitArithmeticOperand(ctx *ArithmeticOperandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCqlParserVisitor) VisitArithmeticOperator(ctx *ArithmeticOperatorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitRelationElements(ctx *RelationElementsContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -607,6 +647,10 @@ func (v *BaseCqlParserVisitor) VisitStringLiteral(ctx *StringLiteralContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitDurationLiteral(ctx *DurationLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitBooleanLiteral(ctx *BooleanLiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -631,6 +675,10 @@ func (v *BaseCqlParserVisitor) VisitDataType(ctx *DataTypeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitVectorType(ctx *VectorTypeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitDataTypeName(ctx *DataTypeNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -639,6 +687,10 @@ func (v *BaseCqlParserVisitor) VisitDataTypeDefinition(ctx *DataTypeDefinitionCo
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitUnreservedKeyword(ctx *UnreservedKeywordContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitOrderDirection(ctx *OrderDirectionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -703,6 +755,10 @@ func (v *BaseCqlParserVisitor) VisitKwAggregate(ctx *KwAggregateContext) interfa
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwAggregates(ctx *KwAggregatesContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwAll(ctx *KwAllContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -723,6 +779,10 @@ func (v *BaseCqlParserVisitor) VisitKwAnd(ctx *KwAndContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwAnn(ctx *KwAnnContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwApply(ctx *KwApplyContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -755,6 +815,14 @@ func (v *BaseCqlParserVisitor) VisitKwCalled(ctx *KwCalledContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwCast(ctx *KwCastContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCqlParserVisitor) VisitKwCluster(ctx *KwClusterContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwClustering(ctx *KwClusteringContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -771,6 +839,10 @@ func (v *BaseCqlParserVisitor) VisitKwCreate(ctx *KwCreateContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwCustom(ctx *KwCustomContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwDelete(ctx *KwDeleteContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -783,6 +855,10 @@ func (v *BaseCqlParserVisitor) VisitKwDescibe(ctx *KwDescibeContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwDescribe(ctx *KwDescribeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwDistinct(ctx *KwDistinctContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -835,6 +911,10 @@ func (v *BaseCqlParserVisitor) VisitKwGrant(ctx *KwGrantContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwGroup(ctx *KwGroupContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwIf(ctx *KwIfContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -859,6 +939,10 @@ func (v *BaseCqlParserVisitor) VisitKwInsert(ctx *KwInsertContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwInternals(ctx *KwInternalsContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwInto(ctx *KwIntoContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -939,6 +1023,10 @@ func (v *BaseCqlParserVisitor) VisitKwOn(ctx *KwOnContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwOnly(ctx *KwOnlyContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwOptions(ctx *KwOptionsContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -951,10 +1039,18 @@ func (v *BaseCqlParserVisitor) VisitKwOrder(ctx *KwOrderContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwPartition(ctx *KwPartitionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwPassword(ctx *KwPasswordContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwPer(ctx *KwPerContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwPrimary(ctx *KwPrimaryContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -983,6 +1079,10 @@ func (v *BaseCqlParserVisitor) VisitKwRoles(ctx *KwRolesContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwSchema(ctx *KwSchemaContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwSelect(ctx *KwSelectContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -1011,6 +1111,10 @@ func (v *BaseCqlParserVisitor) VisitKwTable(ctx *KwTableContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwTables(ctx *KwTablesContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwTimestamp(ctx *KwTimestampContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -1035,6 +1139,10 @@ func (v *BaseCqlParserVisitor) VisitKwType(ctx *KwTypeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwTypes(ctx *KwTypesContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwUnlogged(ctx *KwUnloggedContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -1059,6 +1167,10 @@ func (v *BaseCqlParserVisitor) VisitKwValues(ctx *KwValuesContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCqlParserVisitor) VisitKwVector(ctx *KwVectorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCqlParserVisitor) VisitKwView(ctx *KwViewContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -34,6 +34,12 @@ type CqlParserListener interface {
 	// EnterGrant is called when entering the grant production.
 	EnterGrant(c *GrantContext)
 
+	// EnterDescribe is called when entering the describe production.
+	EnterDescribe(c *DescribeContext)
+
+	// EnterDescribeTarget is called when entering the describeTarget production.
+	EnterDescribeTarget(c *DescribeTargetContext)
+
 	// EnterPriviledge is called when entering the priviledge production.
 	EnterPriviledge(c *PriviledgeContext)
 
@@ -304,6 +310,9 @@ type CqlParserListener interface {
 	// EnterCreateIndex is called when entering the createIndex production.
 	EnterCreateIndex(c *CreateIndexContext)
 
+	// EnterIndexUsing is called when entering the indexUsing production.
+	EnterIndexUsing(c *IndexUsingContext)
+
 	// EnterIndexName is called when entering the indexName production.
 	EnterIndexName(c *IndexNameContext)
 
@@ -403,6 +412,15 @@ type CqlParserListener interface {
 	// EnterLimitSpec is called when entering the limitSpec production.
 	EnterLimitSpec(c *LimitSpecContext)
 
+	// EnterPerPartitionLimitSpec is called when entering the perPartitionLimitSpec production.
+	EnterPerPartitionLimitSpec(c *PerPartitionLimitSpecContext)
+
+	// EnterGroupSpec is called when entering the groupSpec production.
+	EnterGroupSpec(c *GroupSpecContext)
+
+	// EnterGroupSpecElement is called when entering the groupSpecElement production.
+	EnterGroupSpecElement(c *GroupSpecElementContext)
+
 	// EnterFromSpec is called when entering the fromSpec production.
 	EnterFromSpec(c *FromSpecContext)
 
@@ -427,6 +445,18 @@ type CqlParserListener interface {
 	// EnterSelectElement is called when entering the selectElement production.
 	EnterSelectElement(c *SelectElementContext)
 
+	// EnterCastFunction is called when entering the castFunction production.
+	EnterCastFunction(c *CastFunctionContext)
+
+	// EnterArithmeticExpression is called when entering the arithmeticExpression production.
+	EnterArithmeticExpression(c *ArithmeticExpressionContext)
+
+	// EnterArithmeticOperand is called when entering the arithmeticOperand production.
+	EnterArithmeticOperand(c *ArithmeticOperandContext)
+
+	// EnterArithmeticOperator is called when entering the arithmeticOperator production.
+	EnterArithmeticOperator(c *ArithmeticOperatorContext)
+
 	// EnterRelationElements is called when entering the relationElements production.
 	EnterRelationElements(c *RelationElementsContext)
 
@@ -457,6 +487,9 @@ type CqlParserListener interface {
 	// EnterStringLiteral is called when entering the stringLiteral production.
 	EnterStringLiteral(c *StringLiteralContext)
 
+	// EnterDurationLiteral is called when entering the durationLiteral production.
+	EnterDurationLiteral(c *DurationLiteralContext)
+
 	// EnterBooleanLiteral is called when entering the booleanLiteral production.
 	EnterBooleanLiteral(c *BooleanLiteralContext)
 
@@ -475,12 +508,18 @@ type CqlParserListener interface {
 	// EnterDataType is called when entering the dataType production.
 	EnterDataType(c *DataTypeContext)
 
+	// EnterVectorType is called when entering the vectorType production.
+	EnterVectorType(c *VectorTypeContext)
+
 	// EnterDataTypeName is called when entering the dataTypeName production.
 	EnterDataTypeName(c *DataTypeNameContext)
 
 	// EnterDataTypeDefinition is called when entering the dataTypeDefinition production.
 	EnterDataTypeDefinition(c *DataTypeDefinitionContext)
 
+	// EnterUnreservedKeyword is called when entering the unreservedKeyword production.
+	EnterUnreservedKeyword(c *UnreservedKeywordContext)
+
 	// EnterOrderDirection is called when entering the orderDirection production.
 	EnterOrderDirection(c *OrderDirectionContext)
 
@@ -529,6 +568,9 @@ type CqlParserListener interface {
 	// EnterKwAggregate is called when entering the kwAggregate production.
 	EnterKwAggregate(c *KwAggregateContext)
 
+	// EnterKwAggregates is called when entering the kwAggregates production.
+	EnterKwAggregates(c *KwAggregatesContext)
+
 	// EnterKwAll is called when entering the kwAll production.
 	EnterKwAll(c *KwAllContext)
 
@@ -544,6 +586,9 @@ type CqlParserListener interface {
 	// EnterKwAnd is called when entering the kwAnd production.
 	EnterKwAnd(c *KwAndContext)
 
+	// EnterKwAnn is called when entering the kwAnn production.
+	EnterKwAnn(c *KwAnnContext)
+
 	// EnterKwApply is called when entering the kwApply production.
 	EnterKwApply(c *KwApplyContext)
 
@@ -568,6 +613,12 @@ type CqlParserListener interface {
 	// EnterKwCalled is called when entering the kwCalled production.
 	EnterKwCalled(c *KwCalledContext)
 
+	// EnterKwCast is called when entering the kwCast production.
+	EnterKwCast(c *KwCastContext)
+
+	// EnterKwCluster is called when entering the kwCluster production.
+	EnterKwCluster(c *KwClusterContext)
+
 	// EnterKwClustering is called when entering the kwClustering production.
 	EnterKwClustering(c *KwClusteringContext)
 
@@ -580,6 +631,9 @@ type CqlParserListener interface {
 	// EnterKwCreate is called when entering the kwCreate production.
 	EnterKwCreate(c *KwCreateContext)
 
+	// EnterKwCustom is called when entering the kwCustom production.
+	EnterKwCustom(c *KwCustomContext)
+
 	// EnterKwDelete is called when entering the kwDelete production.
 	EnterKwDelete(c *KwDeleteContext)
 
@@ -589,6 +643,9 @@ type CqlParserListener interface {
 	// EnterKwDescibe is called when entering the kwDescibe production.
 	EnterKwDescibe(c *KwDescibeContext)
 
+	// EnterKwDescribe is called when entering the kwDescribe production.
+	EnterKwDescribe(c *KwDescribeContext)
+
 	// EnterKwDistinct is called when entering the kwDistinct production.
 	EnterKwDistinct(c *KwDistinctContext)
 
@@ -628,6 +685,9 @@ type CqlParserListener interface {
 	// EnterKwGrant is called when entering the kwGrant production.
 	EnterKwGrant(c *KwGrantContext)
 
+	// EnterKwGroup is called when entering the kwGroup production.
+	EnterKwGroup(c *KwGroupContext)
+
 	// EnterKwIf is called when entering the kwIf production.
 	EnterKwIf(c *KwIfContext)
 
@@ -646,6 +706,9 @@ type CqlParserListener interface {
 	// EnterKwInsert is called when entering the kwInsert production.
 	EnterKwInsert(c *KwInsertContext)
 
+	// EnterKwInternals is called when entering the kwInternals production.
+	EnterKwInternals(c *KwInternalsContext)
+
 	// EnterKwInto is called when entering the kwInto production.
 	EnterKwInto(c *KwIntoContext)
 
@@ -706,6 +769,9 @@ type CqlParserListener interface {
 	// EnterKwOn is called when entering the kwOn production.
 	EnterKwOn(c *KwOnContext)
 
+	// EnterKwOnly is called when entering the kwOnly production.
+	EnterKwOnly(c *KwOnlyContext)
+
 	// EnterKwOptions is called when entering the kwOptions production.
 	EnterKwOptions(c *KwOptionsContext)
 
@@ -715,9 +781,15 @@ type CqlParserListener interface {
 	// EnterKwOrder is called when entering the kwOrder production.
 	EnterKwOrder(c *KwOrderContext)
 
+	// EnterKwPartition is called when entering the kwPartition production.
+	EnterKwPartition(c *KwPartitionContext)
+
 	// EnterKwPassword is called when entering the kwPassword production.
 	EnterKwPassword(c *KwPasswordContext)
 
+	// EnterKwPer is called when entering the kwPer production.
+	EnterKwPer(c *KwPerContext)
+
 	// EnterKwPrimary is called when entering the kwPrimary production.
 	EnterKwPrimary(c *KwPrimaryContext)
 
@@ -739,6 +811,9 @@ type CqlParserListener interface {
 	// EnterKwRoles is called when entering the kwRoles production.
 	EnterKwRoles(c *KwRolesContext)
 
+	// EnterKwSchema is called when entering the kwSchema production.
+	EnterKwSchema(c *KwSchemaContext)
+
 	// EnterKwSelect is called when entering the kwSelect production.
 	EnterKwSelect(c *KwSelectContext)
 
@@ -760,6 +835,9 @@ type CqlParserListener interface {
 	// EnterKwTable is called when entering the kwTable production.
 	EnterKwTable(c *KwTableContext)
 
+	// EnterKwTables is called when entering the kwTables production.
+	EnterKwTables(c *KwTablesContext)
+
 	// EnterKwTimestamp is called when entering the kwTimestamp production.
 	EnterKwTimestamp(c *KwTimestampContext)
 
@@ -778,6 +856,9 @@ type CqlParserListener interface {
 	// EnterKwType is called when entering the kwType production.
 	EnterKwType(c *KwTypeContext)
 
+	// EnterKwTypes is called when entering the kwTypes production.
+	EnterKwTypes(c *KwTypesContext)
+
 	// EnterKwUnlogged is called when entering the kwUnlogged production.
 	EnterKwUnlogged(c *KwUnloggedContext)
 
@@ -796,6 +877,9 @@ type CqlParserListener interface {
 	// EnterKwValues is called when entering the kwValues production.
 	EnterKwValues(c *KwValuesContext)
 
+	// EnterKwVector is called when entering the kwVector production.
+	EnterKwVector(c *KwVectorContext)
+
 	// EnterKwView is called when entering the kwView production.
 	EnterKwView(c *KwViewContext)
 
@@ -865,6 +949,12 @@ type CqlParserListener interface {
 	// ExitGrant is called when exiting the grant production.
 	ExitGrant(c *GrantContext)
 
+	// ExitDescribe is called when exiting the describe production.
+	ExitDescribe(c *DescribeContext)
+
+	// ExitDescribeTarget is called when exiting the describeTarget production.
+	ExitDescribeTarget(c *DescribeTargetContext)
+
 	// ExitPriviledge is called when exiting the priviledge production.
 	ExitPriviledge(c *PriviledgeContext)
 
@@ -1135,6 +1225,9 @@ type CqlParserListener interface {
 	// ExitCreateIndex is called when exiting the createIndex production.
 	ExitCreateIndex(c *CreateIndexContext)
 
+	// ExitIndexUsing is called when exiting the indexUsing production.
+	ExitIndexUsing(c *IndexUsingContext)
+
 	// ExitIndexName is called when exiting the indexName production.
 	ExitIndexName(c *IndexNameContext)
 
@@ -1234,6 +1327,15 @@ type CqlParserListener interface {
 	// ExitLimitSpec is called when exiting the limitSpec production.
 	ExitLimitSpec(c *LimitSpecContext)
 
+	// ExitPerPartitionLimitSpec is called when exiting the perPartitionLimitSpec production.
+	ExitPerPartitionLimitSpec(c *PerPartitionLimitSpecContext)
+
+	// ExitGroupSpec is called when exiting the groupSpec production.
+	ExitGroupSpec(c *GroupSpecContext)
+
+	// ExitGroupSpecElement is called when exiting the groupSpecElement production.
+	ExitGroupSpecElement(c *GroupSpecElementContext)
+
 	// ExitFromSpec is called when exiting the fromSpec production.
 	ExitFromSpec(c *FromSpecContext)
 
@@ -1258,6 +1360,18 @@ type CqlParserListener interface {
 	// ExitSelectElement is called when exiting the selectElement production.
 	ExitSelectElement(c *SelectElementContext)
 
+	// ExitCastFunction is called when exiting the castFunction production.
+	ExitCastFunction(c *CastFunctionContext)
+
+	// ExitArithmeticExpression is called when exiting the arithmeticExpression production.
+	ExitArithmeticExpression(c *ArithmeticExpressionContext)
+
+	// ExitArithmeticOperand is called when exiting the arithmeticOperand production.
+	ExitArithmeticOperand(c *ArithmeticOperandContext)
+
+	// ExitArithmeticOperator is called when exiting the arithmeticOperator production.
+	ExitArithmeticOperator(c *ArithmeticOperatorContext)
+
 	// ExitRelationElements is called when exiting the relationElements production.
 	ExitRelationElements(c *RelationElementsContext)
 
@@ -1288,6 +1402,9 @@ type CqlParserListener interface {
 	// ExitStringLiteral is called when exiting the stringLiteral production.
 	ExitStringLiteral(c *StringLiteralContext)
 
+	// ExitDurationLiteral is called when exiting the durationLiteral production.
+	ExitDurationLiteral(c *DurationLiteralContext)
+
 	// ExitBooleanLiteral is called when exiting the booleanLiteral production.
 	ExitBooleanLiteral(c *BooleanLiteralContext)
 
@@ -1306,12 +1423,18 @@ type CqlParserListener interface {
 	// ExitDataType is called when exiting the dataType production.
 	ExitDataType(c *DataTypeContext)
 
+	// ExitVectorType is called when exiting the vectorType production.
+	ExitVectorType(c *VectorTypeContext)
+
 	// ExitDataTypeName is called when exiting the dataTypeName production.
 	ExitDataTypeName(c *DataTypeNameContext)
 
 	// ExitDataTypeDefinition is called when exiting the dataTypeDefinition production.
 	ExitDataTypeDefinition(c *DataTypeDefinitionContext)
 
+	// ExitUnreservedKeyword is called when exiting the unreservedKeyword production.
+	ExitUnreservedKeyword(c *UnreservedKeywordContext)
+
 	// ExitOrderDirection is called when exiting the orderDirection production.
 	ExitOrderDirection(c *OrderDirectionContext)
 
@@ -1360,6 +1483,9 @@ type CqlParserListener interface {
 	// ExitKwAggregate is called when exiting the kwAggregate production.
 	ExitKwAggregate(c *KwAggregateContext)
 
+	// ExitKwAggregates is called when exiting the kwAggregates production.
+	ExitKwAggregates(c *KwAggregatesContext)
+
 	// ExitKwAll is called when exiting the kwAll production.
 	ExitKwAll(c *KwAllContext)
 
@@ -1375,6 +1501,9 @@ type CqlParserListener interface {
 	// ExitKwAnd is called when exiting the kwAnd production.
 	ExitKwAnd(c *KwAndContext)
 
+	// ExitKwAnn is called when exiting the kwAnn production.
+	ExitKwAnn(c *KwAnnContext)
+
 	// ExitKwApply is called when exiting the kwApply production.
 	ExitKwApply(c *KwApplyContext)
 
@@ -1399,6 +1528,12 @@ type CqlParserListener interface {
 	// ExitKwCalled is called when exiting the kwCalled production.
 	ExitKwCalled(c *KwCalledContext)
 
+	// ExitKwCast is called when exiting the kwCast production.
+	ExitKwCast(c *KwCastContext)
+
+	// ExitKwCluster is called when exiting the kwCluster production.
+	ExitKwCluster(c *KwClusterContext)
+
 	// ExitKwClustering is called when exiting the kwClustering production.
 	ExitKwClustering(c *KwClusteringContext)
 
@@ -1411,6 +1546,9 @@ type CqlParserListener interface {
 	// ExitKwCreate is called when exiting the kwCreate production.
 	ExitKwCreate(c *KwCreateContext)
 
+	// ExitKwCustom is called when exiting the kwCustom production.
+	ExitKwCustom(c *KwCustomContext)
+
 	// ExitKwDelete is called when exiting the kwDelete production.
 	ExitKwDelete(c *KwDeleteContext)
 
@@ -1420,6 +1558,9 @@ type CqlParserListener interface {
 	// ExitKwDescibe is called when exiting the kwDescibe production.
 	ExitKwDescibe(c *KwDescibeContext)
 
+	// ExitKwDescribe is called when exiting the kwDescribe production.
+	ExitKwDescribe(c *KwDescribeContext)
+
 	// ExitKwDistinct is called when exiting the kwDistinct production.
 	ExitKwDistinct(c *KwDistinctContext)
 
@@ -1459,6 +1600,9 @@ type CqlParserListener interface {
 	// ExitKwGrant is called when exiting the kwGrant production.
 	ExitKwGrant(c *KwGrantContext)
 
+	// ExitKwGroup is called when exiting the kwGroup production.
+	ExitKwGroup(c *KwGroupContext)
+
 	// ExitKwIf is called when exiting the kwIf production.
 	ExitKwIf(c *KwIfContext)
 
@@ -1477,6 +1621,9 @@ type CqlParserListener interface {
 	// ExitKwInsert is called when exiting the kwInsert production.
 	ExitKwInsert(c *KwInsertContext)
 
+	// ExitKwInternals is called when exiting the kwInternals production.
+	ExitKwInternals(c *KwInternalsContext)
+
 	// ExitKwInto is called when exiting the kwInto production.
 	ExitKwInto(c *KwIntoContext)
 
@@ -1537,6 +1684,9 @@ type CqlParserListener interface {
 	// ExitKwOn is called when exiting the kwOn production.
 	ExitKwOn(c *KwOnContext)
 
+	// ExitKwOnly is called when exiting the kwOnly production.
+	ExitKwOnly(c *KwOnlyContext)
+
 	// ExitKwOptions is called when exiting the kwOptions production.
 	ExitKwOptions(c *KwOptionsContext)
 
@@ -1546,9 +1696,15 @@ type CqlParserListener interface {
 	// ExitKwOrder is called when exiting the kwOrder production.
 	ExitKwOrder(c *KwOrderContext)
 
+	// ExitKwPartition is called when exiting the kwPartition production.
+	ExitKwPartition(c *KwPartitionContext)
+
 	// ExitKwPassword is called when exiting the kwPassword production.
 	ExitKwPassword(c *KwPasswordContext)
 
+	// ExitKwPer is called when exiting the kwPer production.
+	ExitKwPer(c *KwPerContext)
+
 	// ExitKwPrimary is called when exiting the kwPrimary production.
 	ExitKwPrimary(c *KwPrimaryContext)
 
@@ -1570,6 +1726,9 @@ type CqlParserListener interface {
 	// ExitKwRoles is called when exiting the kwRoles production.
 	ExitKwRoles(c *KwRolesContext)
 
+	// ExitKwSchema is called when exiting the kwSchema production.
+	ExitKwSchema(c *KwSchemaContext)
+
 	// ExitKwSelect is called when exiting the kwSelect production.
 	ExitKwSelect(c *KwSelectContext)
 
@@ -1591,6 +1750,9 @@ type CqlParserListener interface {
 	// ExitKwTable is called when exiting the kwTable production.
 	ExitKwTable(c *KwTableContext)
 
+	// ExitKwTables is called when exiting the kwTables production.
+	ExitKwTables(c *KwTablesContext)
+
 	// ExitKwTimestamp is called when exiting the kwTimestamp production.
 	ExitKwTimestamp(c *KwTimestampContext)
 
@@ -1609,6 +1771,9 @@ type CqlParserListener interface {
 	// ExitKwType is called when exiting the kwType production.
 	ExitKwType(c *KwTypeContext)
 
+	// ExitKwTypes is called when exiting the kwTypes production.
+	ExitKwTypes(c *KwTypesContext)
+
 	// ExitKwUnlogged is called when exiting the kwUnlogged production.
 	ExitKwUnlogged(c *KwUnloggedContext)
 
@@ -1627,6 +1792,9 @@ type CqlParserListener interface {
 	// ExitKwValues is called when exiting the kwValues production.
 	ExitKwValues(c *KwValuesContext)
 
+	// ExitKwVector is called when exiting the kwVector production.
+	ExitKwVector(c *KwVectorContext)
+
 	// ExitKwView is called when exiting the kwView production.
 	ExitKwView(c *KwViewContext)
 
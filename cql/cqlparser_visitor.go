@@ -34,6 +34,12 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#grant.
 	VisitGrant(ctx *GrantContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#describe.
+	VisitDescribe(ctx *DescribeContext) interface{}
+
+	// Visit a parse tree produced by CqlParser#describeTarget.
+	VisitDescribeTarget(ctx *DescribeTargetContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#priviledge.
 	VisitPriviledge(ctx *PriviledgeContext) interface{}
 
@@ -304,6 +310,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#createIndex.
 	VisitCreateIndex(ctx *CreateIndexContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#indexUsing.
+	VisitIndexUsing(ctx *IndexUsingContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#indexName.
 	VisitIndexName(ctx *IndexNameContext) interface{}
 
@@ -403,6 +412,15 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#limitSpec.
 	VisitLimitSpec(ctx *LimitSpecContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#perPartitionLimitSpec.
+	VisitPerPartitionLimitSpec(ctx *PerPartitionLimitSpecContext) interface{}
+
+	// Visit a parse tree produced by CqlParser#groupSpec.
+	VisitGroupSpec(ctx *GroupSpecContext) interface{}
+
+	// Visit a parse tree produced by CqlParser#groupSpecElement.
+	VisitGroupSpecElement(ctx *GroupSpecElementContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#fromSpec.
 	VisitFromSpec(ctx *FromSpecContext) interface{}
 
@@ -427,6 +445,18 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#selectElement.
 	VisitSelectElement(ctx *SelectElementContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#castFunction.
+	VisitCastFunction(ctx *CastFunctionContext) interface{}
+
+	// Visit a parse tree produced by CqlParser#arithmeticExpression.
+	VisitArithmeticExpression(ctx *ArithmeticExpressionContext) interface{}
+
+	// Visit a parse tree produced by CqlParser#arithmeticOperand.
+	VisitArithmeticOperand(ctx *ArithmeticOperandContext) interface{}
+
+	// Visit a parse tree produced by CqlParser#arithmeticOperator.
+	VisitArithmeticOperator(ctx *ArithmeticOperatorContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#relationElements.
 	VisitRelationElements(ctx *RelationElementsContext) interface{}
 
@@ -457,6 +487,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#stringLiteral.
 	VisitStringLiteral(ctx *StringLiteralContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#durationLiteral.
+	VisitDurationLiteral(ctx *DurationLiteralContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#booleanLiteral.
 	VisitBooleanLiteral(ctx *BooleanLiteralContext) interface{}
 
@@ -475,12 +508,18 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#dataType.
 	VisitDataType(ctx *DataTypeContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#vectorType.
+	VisitVectorType(ctx *VectorTypeContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#dataTypeName.
 	VisitDataTypeName(ctx *DataTypeNameContext) interface{}
 
 	// Visit a parse tree produced by CqlParser#dataTypeDefinition.
 	VisitDataTypeDefinition(ctx *DataTypeDefinitionContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#unreservedKeyword.
+	VisitUnreservedKeyword(ctx *UnreservedKeywordContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#orderDirection.
 	VisitOrderDirection(ctx *OrderDirectionContext) interface{}
 
@@ -529,6 +568,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwAggregate.
 	VisitKwAggregate(ctx *KwAggregateContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwAggregates.
+	VisitKwAggregates(ctx *KwAggregatesContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwAll.
 	VisitKwAll(ctx *KwAllContext) interface{}
 
@@ -544,6 +586,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwAnd.
 	VisitKwAnd(ctx *KwAndContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwAnn.
+	VisitKwAnn(ctx *KwAnnContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwApply.
 	VisitKwApply(ctx *KwApplyContext) interface{}
 
@@ -568,6 +613,12 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwCalled.
 	VisitKwCalled(ctx *KwCalledContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwCast.
+	VisitKwCast(ctx *KwCastContext) interface{}
+
+	// Visit a parse tree produced by CqlParser#kwCluster.
+	VisitKwCluster(ctx *KwClusterContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwClustering.
 	VisitKwClustering(ctx *KwClusteringContext) interface{}
 
@@ -580,6 +631,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwCreate.
 	VisitKwCreate(ctx *KwCreateContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwCustom.
+	VisitKwCustom(ctx *KwCustomContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwDelete.
 	VisitKwDelete(ctx *KwDeleteContext) interface{}
 
@@ -589,6 +643,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwDescibe.
 	VisitKwDescibe(ctx *KwDescibeContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwDescribe.
+	VisitKwDescribe(ctx *KwDescribeContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwDistinct.
 	VisitKwDistinct(ctx *KwDistinctContext) interface{}
 
@@ -628,6 +685,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwGrant.
 	VisitKwGrant(ctx *KwGrantContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwGroup.
+	VisitKwGroup(ctx *KwGroupContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwIf.
 	VisitKwIf(ctx *KwIfContext) interface{}
 
@@ -646,6 +706,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwInsert.
 	VisitKwInsert(ctx *KwInsertContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwInternals.
+	VisitKwInternals(ctx *KwInternalsContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwInto.
 	VisitKwInto(ctx *KwIntoContext) interface{}
 
@@ -706,6 +769,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwOn.
 	VisitKwOn(ctx *KwOnContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwOnly.
+	VisitKwOnly(ctx *KwOnlyContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwOptions.
 	VisitKwOptions(ctx *KwOptionsContext) interface{}
 
@@ -715,9 +781,15 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwOrder.
 	VisitKwOrder(ctx *KwOrderContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwPartition.
+	VisitKwPartition(ctx *KwPartitionContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwPassword.
 	VisitKwPassword(ctx *KwPasswordContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwPer.
+	VisitKwPer(ctx *KwPerContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwPrimary.
 	VisitKwPrimary(ctx *KwPrimaryContext) interface{}
 
@@ -739,6 +811,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwRoles.
 	VisitKwRoles(ctx *KwRolesContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwSchema.
+	VisitKwSchema(ctx *KwSchemaContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwSelect.
 	VisitKwSelect(ctx *KwSelectContext) interface{}
 
@@ -760,6 +835,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwTable.
 	VisitKwTable(ctx *KwTableContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwTables.
+	VisitKwTables(ctx *KwTablesContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwTimestamp.
 	VisitKwTimestamp(ctx *KwTimestampContext) interface{}
 
@@ -778,6 +856,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwType.
 	VisitKwType(ctx *KwTypeContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwTypes.
+	VisitKwTypes(ctx *KwTypesContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwUnlogged.
 	VisitKwUnlogged(ctx *KwUnloggedContext) interface{}
 
@@ -796,6 +877,9 @@ type CqlParserVisitor interface {
 	// Visit a parse tree produced by CqlParser#kwValues.
 	VisitKwValues(ctx *KwValuesContext) interface{}
 
+	// Visit a parse tree produced by CqlParser#kwVector.
+	VisitKwVector(ctx *KwVectorContext) interface{}
+
 	// Visit a parse tree produced by CqlParser#kwView.
 	VisitKwView(ctx *KwViewContext) interface{}
 
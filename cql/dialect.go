@@ -0,0 +1,353 @@
+package cql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Dialect identifies a database that speaks CQL.
+type Dialect int
+
+const (
+	// DialectAny accepts everything the grammar accepts.
+	DialectAny Dialect = iota
+	DialectCassandra3
+	// DialectCassandra4 is Cassandra 4.0.
+	DialectCassandra4
+	// DialectCassandra41 is Cassandra 4.1, which added IF EXISTS and IF NOT
+	// EXISTS to ALTER TABLE and ALTER TYPE.
+	DialectCassandra41
+	DialectCassandra5
+	DialectScyllaDB
+	DialectKeyspaces
+	DialectAstra
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case DialectCassandra3:
+		return "Cassandra 3"
+	case DialectCassandra4:
+		return "Cassandra 4.0"
+	case DialectCassandra41:
+		return "Cassandra 4.1"
+	case DialectCassandra5:
+		return "Cassandra 5"
+	case DialectScyllaDB:
+		return "ScyllaDB"
+	case DialectKeyspaces:
+		return "Amazon Keyspaces"
+	case DialectAstra:
+		return "DataStax Astra"
+	default:
+		return "CQL"
+	}
+}
+
+// Feature is a piece of CQL that is not available on every dialect.
+type Feature int
+
+const (
+	FeatureTriggers Feature = iota
+	FeatureMaterializedViews
+	FeatureUserDefinedFunctions
+	FeatureSecondaryIndexes
+	FeatureCustomIndexes
+	FeatureStorageAttachedIndexes
+	FeaturePerPartitionLimit
+	FeatureVectorType
+	FeatureCompactStorage
+	FeatureRoles
+	FeatureAlterIfExists
+	// FeatureSASIIndexes is custom indexes of the SASIIndex class, which
+	// Cassandra 4.0 and later ship disabled through enable_sasi_indexes.
+	FeatureSASIIndexes
+)
+
+func (f Feature) String() string {
+	switch f {
+	case FeatureTriggers:
+		return "triggers"
+	case FeatureMaterializedViews:
+		return "materialized views"
+	case FeatureUserDefinedFunctions:
+		return "user-defined functions and aggregates"
+	case FeatureSecondaryIndexes:
+		return "secondary indexes"
+	case FeatureCustomIndexes:
+		return "custom indexes"
+	case FeatureStorageAttachedIndexes:
+		return "storage-attached indexes (SAI)"
+	case FeaturePerPartitionLimit:
+		return "PER PARTITION LIMIT"
+	case FeatureVectorType:
+		return "vector types"
+	case FeatureCompactStorage:
+		return "COMPACT STORAGE"
+	case FeatureRoles:
+		return "role and permission management"
+	case FeatureAlterIfExists:
+		return "IF EXISTS / IF NOT EXISTS in ALTER statements"
+	case FeatureSASIIndexes:
+		return "SASI indexes"
+	default:
+		return fmt.Sprintf("feature %d", int(f))
+	}
+}
+
+type support int
+
+const (
+	supported support = iota
+	unsupported
+	// disabledByDefault means the server supports the feature only after it is
+	// switched on in its configuration, e.g. materialized_views_enabled.
+	disabledByDefault
+)
+
+// dialectSupport lists the features each dialect restricts. Features not
+// listed for a dialect are supported.
+var dialectSupport = map[Dialect]map[Feature]support{
+	DialectCassandra3: {
+		FeatureUserDefinedFunctions:   disabledByDefault,
+		FeatureStorageAttachedIndexes: unsupported,
+		FeatureVectorType:             unsupported,
+		FeatureAlterIfExists:          unsupported,
+	},
+	DialectCassandra4: {
+		FeatureMaterializedViews:      disabledByDefault,
+		FeatureUserDefinedFunctions:   disabledByDefault,
+		FeatureSASIIndexes:            disabledByDefault,
+		FeatureStorageAttachedIndexes: unsupported,
+		FeatureVectorType:             unsupported,
+		FeatureCompactStorage:         unsupported,
+		FeatureAlterIfExists:          unsupported,
+	},
+	DialectCassandra41: {
+		FeatureMaterializedViews:      disabledByDefault,
+		FeatureUserDefinedFunctions:   disabledByDefault,
+		FeatureSASIIndexes:            disabledByDefault,
+		FeatureStorageAttachedIndexes: unsupported,
+		FeatureVectorType:             unsupported,
+		FeatureCompactStorage:         unsupported,
+	},
+	DialectCassandra5: {
+		FeatureMaterializedViews:    disabledByDefault,
+		FeatureUserDefinedFunctions: disabledByDefault,
+		FeatureSASIIndexes:          disabledByDefault,
+		FeatureCompactStorage:       unsupported,
+	},
+	DialectScyllaDB: {
+		FeatureTriggers:               unsupported,
+		FeatureUserDefinedFunctions:   disabledByDefault,
+		FeatureCustomIndexes:          unsupported,
+		FeatureSASIIndexes:            unsupported,
+		FeatureStorageAttachedIndexes: unsupported,
+		FeatureVectorType:             unsupported,
+		FeatureAlterIfExists:          unsupported,
+	},
+	DialectKeyspaces: {
+		FeatureTriggers:               unsupported,
+		FeatureMaterializedViews:      unsupported,
+		FeatureUserDefinedFunctions:   unsupported,
+		FeatureSecondaryIndexes:       unsupported,
+		FeatureCustomIndexes:          unsupported,
+		FeatureSASIIndexes:            unsupported,
+		FeatureStorageAttachedIndexes: unsupported,
+		FeaturePerPartitionLimit:      unsupported,
+		FeatureVectorType:             unsupported,
+		FeatureCompactStorage:         unsupported,
+		FeatureRoles:                  unsupported,
+		FeatureAlterIfExists:          unsupported,
+	},
+	DialectAstra: {
+		FeatureTriggers:             unsupported,
+		FeatureMaterializedViews:    unsupported,
+		FeatureUserDefinedFunctions: unsupported,
+		FeatureSecondaryIndexes:     unsupported,
+		FeatureCustomIndexes:        unsupported,
+		FeatureSASIIndexes:          unsupported,
+		FeatureCompactStorage:       unsupported,
+		FeatureRoles:                unsupported,
+	},
+}
+
+// Supports reports whether the dialect accepts the feature. Features that the
+// server ships disabled count as supported only when listed in enabled.
+func (d Dialect) Supports(f Feature, enabled ...Feature) bool {
+	switch dialectSupport[d][f] {
+	case unsupported:
+		return false
+	case disabledByDefault:
+		for _, e := range enabled {
+			if e == f {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// DialectError reports a statement that uses a feature the target dialect does not support.
+type DialectError struct {
+	Line    int
+	Column  int
+	Dialect Dialect
+	Feature Feature
+}
+
+func (e *DialectError) Error() string {
+	if dialectSupport[e.Dialect][e.Feature] == disabledByDefault {
+		return fmt.Sprintf("line %d:%d %s disabled by default on %s", e.Line, e.Column, e.Feature, e.Dialect)
+	}
+	return fmt.Sprintf("line %d:%d %s not supported by %s", e.Line, e.Column, e.Feature, e.Dialect)
+}
+
+// ParseCQLWithDialect parses a CQL statement and rejects it if it uses features
+// the dialect does not support. Features passed in enabled are treated as
+// switched on in the server configuration. The returned error wraps one
+// *DialectError per offending use.
+func ParseCQLWithDialect(statement string, dialect Dialect, enabled ...Feature) (antlr.Tree, error) {
+	tree, err := ParseCQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if errs := CheckDialect(tree, dialect, enabled...); len(errs) > 0 {
+		joined := make([]error, 0, len(errs))
+		for _, e := range errs {
+			joined = append(joined, e)
+		}
+		return nil, fmt.Errorf("dialect error: %w", errors.Join(joined...))
+	}
+	return tree, nil
+}
+
+// CheckDialect walks a tree returned by ParseCQL and reports every use of a
+// feature the dialect does not support.
+func CheckDialect(tree antlr.Tree, dialect Dialect, enabled ...Feature) []*DialectError {
+	if dialect == DialectAny {
+		return nil
+	}
+	parseTree, ok := tree.(antlr.ParseTree)
+	if !ok {
+		return nil
+	}
+	checker := &dialectChecker{
+		BaseCqlParserListener: &BaseCqlParserListener{},
+		dialect:               dialect,
+		enabled:               enabled,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(checker, parseTree)
+	return checker.errors
+}
+
+type dialectChecker struct {
+	*BaseCqlParserListener
+
+	dialect Dialect
+	enabled []Feature
+	errors  []*DialectError
+}
+
+func (c *dialectChecker) ExitCql(ctx *CqlContext) {
+	start := ctx.GetStart()
+	switch {
+	case ctx.CreateTrigger() != nil, ctx.DropTrigger() != nil:
+		c.report(start, FeatureTriggers)
+	case ctx.CreateMaterializedView() != nil, ctx.AlterMaterializedView() != nil, ctx.DropMaterializedView() != nil:
+		c.report(start, FeatureMaterializedViews)
+	case ctx.CreateFunction() != nil, ctx.DropFunction() != nil, ctx.CreateAggregate() != nil, ctx.DropAggregate() != nil:
+		c.report(start, FeatureUserDefinedFunctions)
+	case ctx.CreateRole() != nil, ctx.AlterRole() != nil, ctx.DropRole() != nil,
+		ctx.CreateUser() != nil, ctx.AlterUser() != nil, ctx.DropUser() != nil,
+		ctx.Grant() != nil, ctx.Revoke() != nil, ctx.ListRoles() != nil, ctx.ListPermissions() != nil:
+		c.report(start, FeatureRoles)
+	case ctx.CreateIndex() != nil:
+		c.checkCreateIndex(ctx.CreateIndex().(*CreateIndexContext))
+	}
+}
+
+// EnterCreateTable reports WITH COMPACT STORAGE. ALTER TABLE ... DROP COMPACT
+// STORAGE, the way off the feature, is not reported.
+func (c *dialectChecker) EnterCreateTable(ctx *CreateTableContext) {
+	if ctx.WithElement() == nil {
+		return
+	}
+	for options := ctx.WithElement().TableOptions(); options != nil; options = options.TableOptions() {
+		if options.KwCompact() != nil {
+			c.report(options.GetStart(), FeatureCompactStorage)
+		}
+	}
+}
+
+// EnterIfExist reports IF EXISTS in ALTER TABLE and ALTER TYPE.
+func (c *dialectChecker) EnterIfExist(ctx *IfExistContext) {
+	if inAlterTableOrType(ctx) {
+		c.report(ctx.GetStart(), FeatureAlterIfExists)
+	}
+}
+
+// EnterIfNotExist reports IF NOT EXISTS in ALTER TABLE and ALTER TYPE.
+func (c *dialectChecker) EnterIfNotExist(ctx *IfNotExistContext) {
+	if inAlterTableOrType(ctx) {
+		c.report(ctx.GetStart(), FeatureAlterIfExists)
+	}
+}
+
+func inAlterTableOrType(ctx antlr.Tree) bool {
+	for node := ctx.GetParent(); node != nil; node = node.GetParent() {
+		switch node.(type) {
+		case *AlterTableContext, *AlterTypeContext:
+			return true
+		case *CqlContext:
+			return false
+		}
+	}
+	return false
+}
+
+// EnterPerPartitionLimitSpec reports PER PARTITION LIMIT in SELECT statements.
+func (c *dialectChecker) EnterPerPartitionLimitSpec(ctx *PerPartitionLimitSpecContext) {
+	c.report(ctx.GetStart(), FeaturePerPartitionLimit)
+}
+
+// EnterVectorType reports vector<type, n> wherever a data type is expected, so
+// that a column merely named vector is not mistaken for one.
+func (c *dialectChecker) EnterVectorType(ctx *VectorTypeContext) {
+	c.report(ctx.GetStart(), FeatureVectorType)
+}
+
+// checkCreateIndex classifies CREATE [CUSTOM] INDEX ... [USING 'class'] by its index class.
+func (c *dialectChecker) checkCreateIndex(ctx *CreateIndexContext) {
+	class := ""
+	if using := ctx.IndexUsing(); using != nil {
+		class = strings.ToLower(strings.Trim(using.StringLiteral().GetText(), "'"))
+	}
+	switch {
+	case class == "sai" || strings.HasSuffix(class, "storageattachedindex"):
+		c.report(ctx.GetStart(), FeatureStorageAttachedIndexes)
+	case strings.HasSuffix(class, "sasiindex"):
+		c.report(ctx.GetStart(), FeatureSASIIndexes)
+	case ctx.KwCustom() != nil || class != "":
+		c.report(ctx.GetStart(), FeatureCustomIndexes)
+	default:
+		c.report(ctx.GetStart(), FeatureSecondaryIndexes)
+	}
+}
+
+func (c *dialectChecker) report(token antlr.Token, feature Feature) {
+	if c.dialect.Supports(feature, c.enabled...) {
+		return
+	}
+	c.errors = append(c.errors, &DialectError{
+		Line:    token.GetLine(),
+		Column:  token.GetColumn(),
+		Dialect: c.dialect,
+		Feature: feature,
+	})
+}
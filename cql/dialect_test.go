@@ -0,0 +1,175 @@
+package cql_test
+
+import (
+	"testing"
+
+	cqlparser "github.com/bytebase/parser/cql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDialect(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		dialect   cqlparser.Dialect
+		enabled   []cqlparser.Feature
+		want      []cqlparser.Feature
+	}{
+		{
+			name:      "trigger on Keyspaces",
+			statement: `DROP TRIGGER trigger_name ON table_name;`,
+			dialect:   cqlparser.DialectKeyspaces,
+			want:      []cqlparser.Feature{cqlparser.FeatureTriggers},
+		},
+		{
+			name:      "trigger on Cassandra 4",
+			statement: `DROP TRIGGER trigger_name ON table_name;`,
+			dialect:   cqlparser.DialectCassandra4,
+		},
+		{
+			name:      "materialized view disabled on Cassandra 4",
+			statement: `DROP MATERIALIZED VIEW cycling.cyclist_by_age;`,
+			dialect:   cqlparser.DialectCassandra4,
+			want:      []cqlparser.Feature{cqlparser.FeatureMaterializedViews},
+		},
+		{
+			name:      "materialized view enabled on Cassandra 4",
+			statement: `DROP MATERIALIZED VIEW cycling.cyclist_by_age;`,
+			dialect:   cqlparser.DialectCassandra4,
+			enabled:   []cqlparser.Feature{cqlparser.FeatureMaterializedViews},
+		},
+		{
+			name:      "secondary index on Astra",
+			statement: `CREATE INDEX user_state ON myschema.users (state);`,
+			dialect:   cqlparser.DialectAstra,
+			want:      []cqlparser.Feature{cqlparser.FeatureSecondaryIndexes},
+		},
+		{
+			name:      "compact storage on Cassandra 4",
+			statement: `CREATE TABLE users (id int PRIMARY KEY, name text) WITH COMPACT STORAGE;`,
+			dialect:   cqlparser.DialectCassandra4,
+			want:      []cqlparser.Feature{cqlparser.FeatureCompactStorage},
+		},
+		{
+			name:      "compact storage after clustering order on Keyspaces",
+			statement: `CREATE TABLE t (k int, c int, v int, PRIMARY KEY (k, c)) WITH CLUSTERING ORDER BY (c DESC) AND COMPACT STORAGE;`,
+			dialect:   cqlparser.DialectKeyspaces,
+			want:      []cqlparser.Feature{cqlparser.FeatureCompactStorage},
+		},
+		{
+			name:      "drop compact storage on Cassandra 4",
+			statement: `ALTER TABLE users DROP COMPACT STORAGE;`,
+			dialect:   cqlparser.DialectCassandra4,
+		},
+		{
+			name:      "alter if exists on Cassandra 4.0",
+			statement: `ALTER TABLE IF EXISTS users ADD IF NOT EXISTS email text;`,
+			dialect:   cqlparser.DialectCassandra4,
+			want:      []cqlparser.Feature{cqlparser.FeatureAlterIfExists, cqlparser.FeatureAlterIfExists},
+		},
+		{
+			name:      "alter if exists on Cassandra 4.1",
+			statement: `ALTER TABLE IF EXISTS users ADD IF NOT EXISTS email text;`,
+			dialect:   cqlparser.DialectCassandra41,
+		},
+		{
+			name:      "drop if exists on Cassandra 4.0",
+			statement: `DROP TABLE IF EXISTS users;`,
+			dialect:   cqlparser.DialectCassandra4,
+		},
+		{
+			name:      "roles on Keyspaces",
+			statement: `CREATE ROLE coach WITH PASSWORD = 'All4One2day!' AND LOGIN = true; GRANT SELECT ON KEYSPACE cycling TO coach;`,
+			dialect:   cqlparser.DialectKeyspaces,
+			want:      []cqlparser.Feature{cqlparser.FeatureRoles, cqlparser.FeatureRoles},
+		},
+		{
+			name:      "per partition limit on Keyspaces",
+			statement: `SELECT * FROM events PER PARTITION LIMIT 2;`,
+			dialect:   cqlparser.DialectKeyspaces,
+			want:      []cqlparser.Feature{cqlparser.FeaturePerPartitionLimit},
+		},
+		{
+			name:      "per partition limit on ScyllaDB",
+			statement: `SELECT * FROM events PER PARTITION LIMIT 2;`,
+			dialect:   cqlparser.DialectScyllaDB,
+		},
+		{
+			name:      "SAI by class name on Cassandra 4",
+			statement: `CREATE CUSTOM INDEX ON users (email) USING 'StorageAttachedIndex';`,
+			dialect:   cqlparser.DialectCassandra4,
+			want:      []cqlparser.Feature{cqlparser.FeatureStorageAttachedIndexes},
+		},
+		{
+			name:      "SAI by alias on Cassandra 5",
+			statement: `CREATE INDEX ON users (email) USING 'sai';`,
+			dialect:   cqlparser.DialectCassandra5,
+		},
+		{
+			name:      "custom index on Cassandra 4",
+			statement: `CREATE CUSTOM INDEX ON users (email) USING 'org.example.MyIndex';`,
+			dialect:   cqlparser.DialectCassandra4,
+		},
+		{
+			name:      "SASI index on Cassandra 4",
+			statement: `CREATE CUSTOM INDEX ON users (email) USING 'org.apache.cassandra.index.sasi.SASIIndex';`,
+			dialect:   cqlparser.DialectCassandra4,
+			want:      []cqlparser.Feature{cqlparser.FeatureSASIIndexes},
+		},
+		{
+			name:      "SASI index enabled on Cassandra 5",
+			statement: `CREATE CUSTOM INDEX ON users (email) USING 'org.apache.cassandra.index.sasi.SASIIndex';`,
+			dialect:   cqlparser.DialectCassandra5,
+			enabled:   []cqlparser.Feature{cqlparser.FeatureSASIIndexes},
+		},
+		{
+			name:      "custom index on ScyllaDB",
+			statement: `CREATE CUSTOM INDEX ON users (email) USING 'org.example.MyIndex';`,
+			dialect:   cqlparser.DialectScyllaDB,
+			want:      []cqlparser.Feature{cqlparser.FeatureCustomIndexes},
+		},
+		{
+			name:      "vector type on Cassandra 4",
+			statement: `CREATE TABLE items (id int PRIMARY KEY, embedding vector<float, 3>);`,
+			dialect:   cqlparser.DialectCassandra4,
+			want:      []cqlparser.Feature{cqlparser.FeatureVectorType},
+		},
+		{
+			name:      "column named vector on Cassandra 4",
+			statement: `SELECT * FROM items WHERE vector < 5;`,
+			dialect:   cqlparser.DialectCassandra4,
+		},
+		{
+			name:      "plain DML everywhere",
+			statement: `SELECT * FROM users WHERE id = 1; UPDATE users SET email = 'a@b.c' WHERE id = 1;`,
+			dialect:   cqlparser.DialectKeyspaces,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := cqlparser.ParseCQL(tt.statement)
+			require.NoError(t, err)
+
+			var got []cqlparser.Feature
+			for _, e := range cqlparser.CheckDialect(tree, tt.dialect, tt.enabled...) {
+				got = append(got, e.Feature)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCQLWithDialect(t *testing.T) {
+	_, err := cqlparser.ParseCQLWithDialect(`DROP TRIGGER trigger_name ON table_name;`, cqlparser.DialectScyllaDB)
+	require.EqualError(t, err, "dialect error: line 1:0 triggers not supported by ScyllaDB")
+
+	_, err = cqlparser.ParseCQLWithDialect(`DROP TRIGGER t1 ON a; DROP TRIGGER t2 ON b;`, cqlparser.DialectScyllaDB)
+	require.EqualError(t, err, "dialect error: line 1:0 triggers not supported by ScyllaDB\nline 1:22 triggers not supported by ScyllaDB")
+	var dialectErr *cqlparser.DialectError
+	require.ErrorAs(t, err, &dialectErr)
+
+	tree, err := cqlparser.ParseCQLWithDialect(`DROP TRIGGER trigger_name ON table_name;`, cqlparser.DialectCassandra5)
+	require.NoError(t, err)
+	require.NotNil(t, tree)
+}
@@ -0,0 +1,403 @@
+package cql
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ChangeAction is what a SchemaChange does to a schema object.
+type ChangeAction int
+
+const (
+	ChangeCreate ChangeAction = iota
+	ChangeAlter
+	ChangeDrop
+)
+
+func (a ChangeAction) String() string {
+	switch a {
+	case ChangeCreate:
+		return "create"
+	case ChangeAlter:
+		return "alter"
+	default:
+		return "drop"
+	}
+}
+
+// SchemaChange is one step of a migration.
+type SchemaChange struct {
+	Action ChangeAction
+	// Object describes what changes, e.g. "table cycling.cyclist_name" or
+	// "column cycling.cyclist_name.email".
+	Object string
+	// Statement is the CQL that performs the change.
+	Statement string
+}
+
+// UnsupportedChangeError reports a difference that Cassandra cannot apply in place.
+type UnsupportedChangeError struct {
+	Object string
+	Reason string
+}
+
+func (e *UnsupportedChangeError) Error() string {
+	return fmt.Sprintf("cannot migrate %s: %s", e.Object, e.Reason)
+}
+
+// Diff loads two sets of CQL DDL and returns the changes that turn the old
+// schema into the new one. See DiffSchemas.
+func Diff(oldDDL, newDDL string) ([]*SchemaChange, error) {
+	oldSchema, err := LoadSchema(oldDDL)
+	if err != nil {
+		return nil, fmt.Errorf("old schema: %w", err)
+	}
+	newSchema, err := LoadSchema(newDDL)
+	if err != nil {
+		return nil, fmt.Errorf("new schema: %w", err)
+	}
+	return DiffSchemas(oldSchema, newSchema)
+}
+
+// DiffSchemas returns the CREATE, ALTER and DROP statements that migrate old to
+// new, ordered so that dependent objects are dropped first and created last.
+// If any difference cannot be applied in place, such as a primary key or
+// column type change, it returns no changes and an error joining one
+// *UnsupportedChangeError per difference.
+func DiffSchemas(old, new *Schema) ([]*SchemaChange, error) {
+	d := &differ{}
+
+	for _, name := range sortedKeys(old.MaterializedViews) {
+		if new.MaterializedViews[name] == nil {
+			view := old.MaterializedViews[name]
+			d.add(&d.drops, ChangeDrop, "materialized view "+name, "DROP MATERIALIZED VIEW %s;", quoteQualifiedName(view.Keyspace, view.Name))
+		}
+	}
+	for _, name := range sortedKeys(old.Indexes) {
+		oldIndex, newIndex := old.Indexes[name], new.Indexes[name]
+		if newIndex == nil || indexChanged(oldIndex, newIndex) {
+			d.add(&d.drops, ChangeDrop, "index "+name, "DROP INDEX %s;", quoteQualifiedName(oldIndex.Keyspace, oldIndex.Name))
+		}
+	}
+	for _, name := range sortedKeys(old.Tables) {
+		if new.Tables[name] == nil {
+			table := old.Tables[name]
+			d.add(&d.drops, ChangeDrop, "table "+name, "DROP TABLE %s;", quoteQualifiedName(table.Keyspace, table.Name))
+		}
+	}
+	oldTypes := typeOrder(old.Types)
+	slices.Reverse(oldTypes)
+	for _, name := range oldTypes {
+		if new.Types[name] == nil {
+			udt := old.Types[name]
+			d.add(&d.typeDrops, ChangeDrop, "type "+name, "DROP TYPE %s;", quoteQualifiedName(udt.Keyspace, udt.Name))
+		}
+	}
+
+	for _, name := range typeOrder(new.Types) {
+		if oldType := old.Types[name]; oldType != nil {
+			d.diffType(name, oldType, new.Types[name])
+		} else {
+			d.createType(name, new.Types[name])
+		}
+	}
+	for _, name := range sortedKeys(new.Tables) {
+		if oldTable := old.Tables[name]; oldTable != nil {
+			d.diffTable(name, oldTable, new.Tables[name])
+		} else {
+			d.createTable(name, new.Tables[name])
+		}
+	}
+	for _, name := range sortedKeys(new.Indexes) {
+		oldIndex, newIndex := old.Indexes[name], new.Indexes[name]
+		if oldIndex == nil || indexChanged(oldIndex, newIndex) {
+			d.creates = append(d.creates, &SchemaChange{Action: ChangeCreate, Object: "index " + name, Statement: createIndexText(newIndex)})
+		}
+	}
+	for _, name := range sortedKeys(new.MaterializedViews) {
+		oldView, newView := old.MaterializedViews[name], new.MaterializedViews[name]
+		switch {
+		case oldView == nil:
+			d.creates = append(d.creates, &SchemaChange{
+				Action:    ChangeCreate,
+				Object:    "materialized view " + name,
+				Statement: strings.TrimSpace(newView.Statement) + ";",
+			})
+		case oldView.Definition != newView.Definition:
+			d.refuse("materialized view "+name, "the view's query or primary key changed; Cassandra cannot alter a view definition, drop and recreate the view instead")
+		default:
+			if options := d.diffOptions("materialized view "+name, oldView.Options, newView.Options); options != "" {
+				d.add(&d.creates, ChangeAlter, "materialized view "+name, "ALTER MATERIALIZED VIEW %s WITH %s;", quoteQualifiedName(newView.Keyspace, newView.Name), options)
+			}
+		}
+	}
+
+	if len(d.unsupported) > 0 {
+		return nil, errors.Join(d.unsupported...)
+	}
+	changes := append(d.drops, d.typeDrops...)
+	return append(changes, d.creates...), nil
+}
+
+type differ struct {
+	// drops run before creates so that objects are never recreated while an
+	// old version still depends on them.
+	drops []*SchemaChange
+	// typeDrops run after the other drops, since dropped tables and columns
+	// may still use the type.
+	typeDrops   []*SchemaChange
+	creates     []*SchemaChange
+	unsupported []error
+}
+
+func (d *differ) add(changes *[]*SchemaChange, action ChangeAction, object string, format string, args ...any) {
+	*changes = append(*changes, &SchemaChange{
+		Action:    action,
+		Object:    object,
+		Statement: fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *differ) refuse(object, reason string) {
+	d.unsupported = append(d.unsupported, &UnsupportedChangeError{Object: object, Reason: reason})
+}
+
+func (d *differ) createType(name string, udt *TypeSchema) {
+	d.add(&d.creates, ChangeCreate, "type "+name, "CREATE TYPE %s (%s);", quoteQualifiedName(udt.Keyspace, udt.Name), columnList(udt.Fields))
+}
+
+func (d *differ) diffType(name string, oldType, newType *TypeSchema) {
+	qualified := quoteQualifiedName(newType.Keyspace, newType.Name)
+	for _, field := range oldType.Fields {
+		newField := newType.Field(field.Name)
+		switch {
+		case newField == nil:
+			d.refuse("field "+name+"."+field.Name, "Cassandra cannot drop a field from a user-defined type")
+		case newField.Type != field.Type:
+			d.refuse("field "+name+"."+field.Name, fmt.Sprintf("type changed from %s to %s; Cassandra cannot change the type of a user-defined type field", field.Type, newField.Type))
+		}
+	}
+	for _, field := range newType.Fields {
+		if oldType.Field(field.Name) == nil {
+			d.add(&d.creates, ChangeAlter, "field "+name+"."+field.Name, "ALTER TYPE %s ADD %s %s;", qualified, quoteIdentifier(field.Name), field.Type)
+		}
+	}
+}
+
+// typeOrder returns the names of types ordered so that every type follows the
+// types its fields use, with ties broken by name.
+func typeOrder(types map[string]*TypeSchema) []string {
+	var order []string
+	visited := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		udt := types[name]
+		for _, field := range udt.Fields {
+			for _, ref := range typeReferences(udt.Keyspace, field.Type) {
+				if types[ref] != nil {
+					visit(ref)
+				}
+			}
+		}
+		order = append(order, name)
+	}
+	for _, name := range sortedKeys(types) {
+		visit(name)
+	}
+	return order
+}
+
+// typeReferences returns the qualified names of the words in a data type such
+// as "map<text, frozen<ks.address>>", resolving unqualified names in keyspace.
+// Built-in type names are returned too; callers look the names up.
+func typeReferences(keyspace, dataType string) []string {
+	var refs, parts []string
+	var word strings.Builder
+	endWord := func() {
+		if word.Len() > 0 {
+			parts = append(parts, normalizeIdentifier(word.String()))
+			word.Reset()
+		}
+	}
+	endName := func() {
+		endWord()
+		switch len(parts) {
+		case 1:
+			refs = append(refs, qualifiedName(keyspace, parts[0]))
+		case 2:
+			refs = append(refs, qualifiedName(parts[0], parts[1]))
+		}
+		parts = nil
+	}
+	for i := 0; i < len(dataType); i++ {
+		switch c := dataType[i]; {
+		case c == '"':
+			// Copy the quoted identifier, including doubled quotes, as one word.
+			word.WriteByte(c)
+			for i++; i < len(dataType); i++ {
+				word.WriteByte(dataType[i])
+				if dataType[i] == '"' {
+					if i+1 < len(dataType) && dataType[i+1] == '"' {
+						i++
+						word.WriteByte('"')
+						continue
+					}
+					break
+				}
+			}
+		case c == '.':
+			endWord()
+		case c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9':
+			word.WriteByte(c)
+		default:
+			endName()
+		}
+	}
+	endName()
+	return refs
+}
+
+// indexChanged reports whether new differs from old in a way that needs the
+// index rebuilt; Cassandra cannot alter an index in place.
+func indexChanged(old, new *IndexSchema) bool {
+	return old.Table != new.Table || old.Target != new.Target || old.Custom != new.Custom ||
+		old.Class != new.Class || old.Options != new.Options
+}
+
+func createIndexText(index *IndexSchema) string {
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if index.Custom {
+		b.WriteString("CUSTOM ")
+	}
+	fmt.Fprintf(&b, "INDEX %s ON %s (%s)", quoteIdentifier(index.Name), quoteQualifiedName(index.Keyspace, index.Table), index.Target)
+	if index.Class != "" {
+		fmt.Fprintf(&b, " USING '%s'", strings.ReplaceAll(index.Class, "'", "''"))
+		if index.Options != "" {
+			b.WriteString(" WITH OPTIONS = " + index.Options)
+		}
+	}
+	b.WriteString(";")
+	return b.String()
+}
+
+func (d *differ) createTable(name string, table *TableSchema) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE %s (%s, PRIMARY KEY (", quoteQualifiedName(table.Keyspace, table.Name), columnList(table.Columns))
+	partition := identifierList(table.PartitionKey)
+	if len(table.PartitionKey) > 1 {
+		partition = "(" + partition + ")"
+	}
+	b.WriteString(partition)
+	if len(table.ClusteringKey) > 0 {
+		b.WriteString(", " + identifierList(table.ClusteringKey))
+	}
+	b.WriteString("))")
+
+	var with []string
+	if table.CompactStorage {
+		with = append(with, "COMPACT STORAGE")
+	}
+	if order := clusteringOrderText(table); order != "" {
+		with = append(with, order)
+	}
+	for _, option := range sortedKeys(table.Options) {
+		with = append(with, option+" = "+table.Options[option])
+	}
+	if len(with) > 0 {
+		b.WriteString(" WITH " + strings.Join(with, " AND "))
+	}
+	b.WriteString(";")
+	d.creates = append(d.creates, &SchemaChange{Action: ChangeCreate, Object: "table " + name, Statement: b.String()})
+}
+
+func (d *differ) diffTable(name string, oldTable, newTable *TableSchema) {
+	qualified := quoteQualifiedName(newTable.Keyspace, newTable.Name)
+	if !slices.Equal(oldTable.PartitionKey, newTable.PartitionKey) || !slices.Equal(oldTable.ClusteringKey, newTable.ClusteringKey) {
+		d.refuse("table "+name, "the primary key changed; Cassandra cannot alter a primary key, create a new table and copy the data instead")
+		return
+	}
+	if clusteringOrderText(oldTable) != clusteringOrderText(newTable) {
+		d.refuse("table "+name, "the clustering order changed; Cassandra fixes the clustering order when a table is created")
+		return
+	}
+
+	for _, column := range oldTable.Columns {
+		newColumn := newTable.Column(column.Name)
+		switch {
+		case newColumn == nil:
+			d.add(&d.drops, ChangeAlter, "column "+name+"."+column.Name, "ALTER TABLE %s DROP %s;", qualified, quoteIdentifier(column.Name))
+		case newColumn.Type != column.Type:
+			d.refuse("column "+name+"."+column.Name, fmt.Sprintf("type changed from %s to %s; Cassandra cannot change the type of an existing column", column.Type, newColumn.Type))
+		}
+	}
+	for _, column := range newTable.Columns {
+		if oldTable.Column(column.Name) == nil {
+			d.add(&d.creates, ChangeAlter, "column "+name+"."+column.Name, "ALTER TABLE %s ADD %s %s;", qualified, quoteIdentifier(column.Name), column.Type)
+		}
+	}
+
+	switch {
+	case oldTable.CompactStorage && !newTable.CompactStorage:
+		d.add(&d.creates, ChangeAlter, "table "+name, "ALTER TABLE %s DROP COMPACT STORAGE;", qualified)
+	case !oldTable.CompactStorage && newTable.CompactStorage:
+		d.refuse("table "+name, "COMPACT STORAGE can only be set when a table is created")
+	}
+	if options := d.diffOptions("table "+name, oldTable.Options, newTable.Options); options != "" {
+		d.add(&d.creates, ChangeAlter, "table "+name, "ALTER TABLE %s WITH %s;", qualified, options)
+	}
+}
+
+// diffOptions returns the "name = value AND ..." list that turns oldOptions into newOptions.
+func (d *differ) diffOptions(object string, oldOptions, newOptions map[string]string) string {
+	var changed []string
+	for _, option := range sortedKeys(oldOptions) {
+		if _, ok := newOptions[option]; !ok {
+			d.refuse(object, fmt.Sprintf("option %s was removed; Cassandra keeps its current value unless it is set explicitly", option))
+		}
+	}
+	for _, option := range sortedKeys(newOptions) {
+		if oldOptions[option] != newOptions[option] {
+			changed = append(changed, option+" = "+newOptions[option])
+		}
+	}
+	return strings.Join(changed, " AND ")
+}
+
+func clusteringOrderText(table *TableSchema) string {
+	var order []string
+	for _, column := range table.ClusteringKey {
+		direction := table.ClusteringOrder[column]
+		if direction == "" {
+			direction = "ASC"
+		}
+		order = append(order, quoteIdentifier(column)+" "+direction)
+	}
+	// An all-ascending order is the default and need not be spelled out.
+	if !slices.ContainsFunc(order, func(s string) bool { return strings.HasSuffix(s, " DESC") }) {
+		return ""
+	}
+	return "CLUSTERING ORDER BY (" + strings.Join(order, ", ") + ")"
+}
+
+func columnList(columns []*ColumnSchema) string {
+	var list []string
+	for _, column := range columns {
+		list = append(list, quoteIdentifier(column.Name)+" "+column.Type)
+	}
+	return strings.Join(list, ", ")
+}
+
+func identifierList(names []string) string {
+	var list []string
+	for _, name := range names {
+		list = append(list, quoteIdentifier(name))
+	}
+	return strings.Join(list, ", ")
+}
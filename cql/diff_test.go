@@ -0,0 +1,227 @@
+package cql_test
+
+import (
+	"testing"
+
+	cqlparser "github.com/bytebase/parser/cql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSchema(t *testing.T) {
+	schema, err := cqlparser.LoadSchema(`
+USE cycling;
+CREATE TYPE basic_info (birthday timestamp, nationality text);
+CREATE TABLE race_times (race_name text, race_time time, "Cyclist" frozen<basic_info>, PRIMARY KEY ((race_name), race_time)) WITH CLUSTERING ORDER BY (race_time DESC) AND comment = 'times';
+ALTER TABLE race_times ADD lap int;
+ALTER TYPE basic_info ADD height int;
+CREATE INDEX ON race_times (lap);
+`)
+	require.NoError(t, err)
+
+	table := schema.Tables["cycling.race_times"]
+	require.NotNil(t, table)
+	require.Equal(t, []string{"race_name"}, table.PartitionKey)
+	require.Equal(t, []string{"race_time"}, table.ClusteringKey)
+	require.Equal(t, "DESC", table.ClusteringOrder["race_time"])
+	require.Equal(t, "'times'", table.Options["comment"])
+	require.NotNil(t, table.Column("Cyclist"))
+	require.NotNil(t, table.Column("lap"))
+	require.NotNil(t, schema.Types["cycling.basic_info"].Field("height"))
+	require.Equal(t, "lap", schema.Indexes["cycling.race_times_lap_idx"].Target)
+
+	schema, err = cqlparser.LoadSchema(`
+CREATE INDEX ON ks.users ("Email");
+CREATE CUSTOM INDEX users_name_sai ON ks.users (name) USING 'StorageAttachedIndex' WITH OPTIONS = {'case_sensitive': 'false'};
+`)
+	require.NoError(t, err)
+	require.Equal(t, `"Email"`, schema.Indexes["ks.users_Email_idx"].Target)
+	sai := schema.Indexes["ks.users_name_sai"]
+	require.True(t, sai.Custom)
+	require.Equal(t, "StorageAttachedIndex", sai.Class)
+	require.Equal(t, "{'case_sensitive': 'false'}", sai.Options)
+
+	_, err = cqlparser.LoadSchema(`ALTER TABLE missing ADD lap int;`)
+	require.Error(t, err)
+
+	schema, err = cqlparser.LoadSchema(`
+CREATE TABLE ks.t (id int, c int, v text, PRIMARY KEY (id, c));
+CREATE TYPE ks.addr (street text);
+ALTER TABLE ks.t RENAME id TO pk AND c TO ck;
+ALTER TABLE ks.t ADD IF NOT EXISTS v text;
+ALTER TYPE ks.addr ADD IF NOT EXISTS street text;
+ALTER TABLE IF EXISTS ks.missing ADD lap int;
+ALTER TYPE IF EXISTS ks.missing ADD lap int;
+`)
+	require.NoError(t, err)
+	table = schema.Tables["ks.t"]
+	require.Equal(t, []string{"pk"}, table.PartitionKey)
+	require.Equal(t, []string{"ck"}, table.ClusteringKey)
+	require.Len(t, table.Columns, 3)
+	require.Len(t, schema.Types["ks.addr"].Fields, 1)
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "add and drop columns",
+			old:  `CREATE TABLE ks.users (id uuid PRIMARY KEY, name text, age int);`,
+			new:  `CREATE TABLE ks.users (id uuid PRIMARY KEY, name text, email text);`,
+			want: []string{
+				"ALTER TABLE ks.users DROP age;",
+				"ALTER TABLE ks.users ADD email text;",
+			},
+		},
+		{
+			name: "new table, type, index and view",
+			old:  `CREATE TABLE ks.users (id uuid PRIMARY KEY, name text);`,
+			new: `CREATE TABLE ks.users (id uuid PRIMARY KEY, name text);
+CREATE TYPE ks.address (street text, city text);
+CREATE TABLE ks.events (user_id uuid, at timestamp, "Home" frozen<address>, PRIMARY KEY ((user_id), at)) WITH CLUSTERING ORDER BY (at DESC) AND comment = 'events';
+CREATE INDEX users_name ON ks.users (name);
+CREATE MATERIALIZED VIEW ks.users_by_name AS SELECT id, name FROM ks.users WHERE name IS NOT NULL AND id IS NOT NULL PRIMARY KEY (name, id);`,
+			want: []string{
+				"CREATE TYPE ks.address (street text, city text);",
+				`CREATE TABLE ks.events (user_id uuid, at timestamp, "Home" frozen<address>, PRIMARY KEY (user_id, at)) WITH CLUSTERING ORDER BY (at DESC) AND comment = 'events';`,
+				"CREATE INDEX users_name ON ks.users (name);",
+				"CREATE MATERIALIZED VIEW ks.users_by_name AS SELECT id, name FROM ks.users WHERE name IS NOT NULL AND id IS NOT NULL PRIMARY KEY (name, id);",
+			},
+		},
+		{
+			name: "drop objects",
+			old: `CREATE TYPE ks.address (street text);
+CREATE TABLE ks.users (id uuid PRIMARY KEY, name text);
+CREATE INDEX users_name ON ks.users (name);`,
+			new: ``,
+			want: []string{
+				"DROP INDEX ks.users_name;",
+				"DROP TABLE ks.users;",
+				"DROP TYPE ks.address;",
+			},
+		},
+		{
+			name: "type fields and table options",
+			old: `CREATE TYPE ks.address (street text);
+CREATE TABLE ks.users (id uuid PRIMARY KEY) WITH COMPACT STORAGE AND comment = 'old';`,
+			new: `CREATE TYPE ks.address (street text, zip int);
+CREATE TABLE ks.users (id uuid PRIMARY KEY) WITH comment = 'new' AND gc_grace_seconds = 3600;`,
+			want: []string{
+				"ALTER TYPE ks.address ADD zip int;",
+				"ALTER TABLE ks.users DROP COMPACT STORAGE;",
+				"ALTER TABLE ks.users WITH comment = 'new' AND gc_grace_seconds = 3600;",
+			},
+		},
+		{
+			name: "types in dependency order",
+			old: `CREATE TYPE ks.a_contact (home frozen<z_address>, phone frozen<phone>);
+CREATE TYPE ks.z_address (street text);
+CREATE TYPE ks.phone (number text);`,
+			new: `CREATE TYPE ks.a_contact (home frozen<z_address>, phone frozen<phone>, "Work" frozen<"Office">);
+CREATE TYPE ks.z_address (street text, city frozen<"Office">);
+CREATE TYPE ks.phone (number text);
+CREATE TYPE ks."Office" (floor int);`,
+			want: []string{
+				`CREATE TYPE ks."Office" (floor int);`,
+				`ALTER TYPE ks.z_address ADD city frozen<"Office">;`,
+				`ALTER TYPE ks.a_contact ADD "Work" frozen<"Office">;`,
+			},
+		},
+		{
+			name: "drop a column before its type",
+			old: `CREATE TYPE ks.addr (street text);
+CREATE TABLE ks.t (id int PRIMARY KEY, a frozen<addr>);`,
+			new: `CREATE TABLE ks.t (id int PRIMARY KEY);`,
+			want: []string{
+				"ALTER TABLE ks.t DROP a;",
+				"DROP TYPE ks.addr;",
+			},
+		},
+		{
+			name: "drop nested types",
+			old: `CREATE TYPE ks.a_contact (home frozen<z_address>);
+CREATE TYPE ks.z_address (street text);`,
+			new: ``,
+			want: []string{
+				"DROP TYPE ks.a_contact;",
+				"DROP TYPE ks.z_address;",
+			},
+		},
+		{
+			name: "create nested types",
+			old:  ``,
+			new: `CREATE TYPE ks.a_contact (home frozen<z_address>);
+CREATE TYPE ks.z_address (street text);`,
+			want: []string{
+				"CREATE TYPE ks.z_address (street text);",
+				"CREATE TYPE ks.a_contact (home frozen<z_address>);",
+			},
+		},
+		{
+			name: "quoted and storage-attached indexes",
+			old:  `CREATE TABLE ks.users (id uuid PRIMARY KEY, "Email" text, name text);`,
+			new: `CREATE TABLE ks.users (id uuid PRIMARY KEY, "Email" text, name text);
+CREATE INDEX users_email ON ks.users ("Email");
+CREATE CUSTOM INDEX users_name ON ks.users (name) USING 'StorageAttachedIndex' WITH OPTIONS = {'case_sensitive': 'false'};`,
+			want: []string{
+				`CREATE INDEX users_email ON ks.users ("Email");`,
+				"CREATE CUSTOM INDEX users_name ON ks.users (name) USING 'StorageAttachedIndex' WITH OPTIONS = {'case_sensitive': 'false'};",
+			},
+		},
+		{
+			name: "index class change",
+			old: `CREATE TABLE ks.users (id uuid PRIMARY KEY, name text);
+CREATE INDEX users_name ON ks.users (name);`,
+			new: `CREATE TABLE ks.users (id uuid PRIMARY KEY, name text);
+CREATE INDEX users_name ON ks.users (name) USING 'sai';`,
+			want: []string{
+				"DROP INDEX ks.users_name;",
+				"CREATE INDEX users_name ON ks.users (name) USING 'sai';",
+			},
+		},
+		{
+			name:    "primary key change",
+			old:     `CREATE TABLE ks.users (id uuid, name text, PRIMARY KEY (id));`,
+			new:     `CREATE TABLE ks.users (id uuid, name text, PRIMARY KEY (id, name));`,
+			wantErr: "cannot migrate table ks.users: the primary key changed; Cassandra cannot alter a primary key, create a new table and copy the data instead",
+		},
+		{
+			name:    "column type change",
+			old:     `CREATE TABLE ks.users (id uuid PRIMARY KEY, age int);`,
+			new:     `CREATE TABLE ks.users (id uuid PRIMARY KEY, age bigint);`,
+			wantErr: "cannot migrate column ks.users.age: type changed from int to bigint; Cassandra cannot change the type of an existing column",
+		},
+		{
+			name:    "type field removal",
+			old:     `CREATE TYPE ks.address (street text, zip int);`,
+			new:     `CREATE TYPE ks.address (street text);`,
+			wantErr: "cannot migrate field ks.address.zip: Cassandra cannot drop a field from a user-defined type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := cqlparser.Diff(tt.old, tt.new)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				var unsupported *cqlparser.UnsupportedChangeError
+				require.ErrorAs(t, err, &unsupported)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []string
+			for _, change := range changes {
+				got = append(got, change.Statement)
+				// Every generated statement must be valid CQL.
+				_, err := cqlparser.ParseCQL(change.Statement)
+				require.NoError(t, err, change.Statement)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,396 @@
+package cql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// KeywordCase controls how Format prints CQL keywords.
+type KeywordCase int
+
+const (
+	KeywordCaseUpper KeywordCase = iota
+	KeywordCaseLower
+)
+
+// FormatOptions configures Format. The zero value prints upper-case keywords
+// and indents by four spaces.
+type FormatOptions struct {
+	KeywordCase KeywordCase
+	// Indent is the number of spaces per indentation level.
+	Indent int
+}
+
+// Format re-emits CQL statements with consistent keyword case and layout:
+// one column definition per line, one table option per line and one
+// clustering key per line. Comments are preserved.
+func Format(statement string, opts FormatOptions) (string, error) {
+	lexer := NewCqlLexer(antlr.NewInputStream(statement))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := NewCqlParser(stream)
+
+	lexer.RemoveErrorListeners()
+	p.RemoveErrorListeners()
+	lexerErrors := NewErrorListener()
+	parserErrors := NewErrorListener()
+	lexer.AddErrorListener(lexerErrors)
+	p.AddErrorListener(parserErrors)
+
+	p.BuildParseTrees = true
+	tree := p.Root()
+
+	if len(lexerErrors.Errors) > 0 {
+		return "", fmt.Errorf("lexer error: %v", lexerErrors.Errors[0].Error())
+	}
+	if len(parserErrors.Errors) > 0 {
+		return "", fmt.Errorf("parser error: %v", parserErrors.Errors[0].Error())
+	}
+
+	if opts.Indent <= 0 {
+		opts.Indent = 4
+	}
+	f := &formatter{
+		BaseCqlParserVisitor: &BaseCqlParserVisitor{},
+		opts:                 opts,
+		stream:               stream,
+		symbolicNames:        p.GetSymbolicNames(),
+	}
+	f.Visit(tree)
+	return f.String(), nil
+}
+
+// formatter prints a parse tree. Rules without a Visit method of their own
+// are walked by VisitChildren.
+type formatter struct {
+	*BaseCqlParserVisitor
+
+	opts          FormatOptions
+	stream        *antlr.CommonTokenStream
+	symbolicNames []string
+
+	buf strings.Builder
+	// line holds the line being built; it is flushed to buf on newline.
+	line   strings.Builder
+	indent int
+	// pad is extra spaces added after the indentation of each new line.
+	pad int
+	// pendingNewlines is the number of line breaks to emit before the next token.
+	pendingNewlines int
+	// prev is the last token written, nil at the start of a line.
+	prev antlr.Token
+	// prevParent is the rule that contains prev.
+	prevParent antlr.Tree
+	// rule is the rule being visited. Terminal nodes point at the embedded
+	// BaseParserRuleContext rather than the generated context, so their
+	// parent is tracked here instead.
+	rule antlr.RuleNode
+}
+
+var _ CqlParserVisitor = &formatter{}
+
+// visited is returned by the formatter's Visit methods. The generated ones
+// return nil without printing anything, which tells Visit to walk the
+// children itself.
+const visited = true
+
+func (f *formatter) Visit(tree antlr.ParseTree) interface{} {
+	rule, ok := tree.(antlr.RuleNode)
+	if !ok {
+		return tree.Accept(f)
+	}
+	parent := f.rule
+	f.rule = rule
+	defer func() { f.rule = parent }()
+
+	if tree.Accept(f) == nil {
+		f.VisitChildren(rule)
+	}
+	return visited
+}
+
+func (f *formatter) VisitTerminal(node antlr.TerminalNode) interface{} {
+	f.token(node)
+	return visited
+}
+
+func (f *formatter) VisitErrorNode(node antlr.ErrorNode) interface{} {
+	f.token(node)
+	return visited
+}
+
+func (f *formatter) VisitChildren(node antlr.RuleNode) interface{} {
+	f.visitAll(node)
+	return visited
+}
+
+func (f *formatter) VisitCql(ctx *CqlContext) interface{} {
+	if f.buf.Len() > 0 || f.line.Len() > 0 {
+		f.newline(2)
+	}
+	return f.VisitChildren(ctx)
+}
+
+func (f *formatter) VisitColumnDefinitionList(ctx *ColumnDefinitionListContext) interface{} {
+	f.visitBlock(ctx)
+	return visited
+}
+
+func (f *formatter) VisitTypeMemberColumnList(ctx *TypeMemberColumnListContext) interface{} {
+	f.indent++
+	for _, child := range ctx.GetChildren() {
+		if _, ok := child.(*ColumnContext); ok {
+			f.newline(1)
+		}
+		f.Visit(child.(antlr.ParseTree))
+	}
+	f.indent--
+	f.newline(1)
+	return visited
+}
+
+func (f *formatter) VisitClusteringOrder(ctx *ClusteringOrderContext) interface{} {
+	f.visitClusteringOrder(ctx)
+	return visited
+}
+
+func (f *formatter) VisitTableOptions(ctx *TableOptionsContext) interface{} {
+	// Nested tableOptions continue the enclosing WITH list.
+	if _, nested := ctx.GetParent().(*TableOptionsContext); nested {
+		f.visitOptions(ctx)
+		return visited
+	}
+	f.indent++
+	f.visitOptions(ctx)
+	f.indent--
+	return visited
+}
+
+func (f *formatter) VisitMaterializedViewOptions(ctx *MaterializedViewOptionsContext) interface{} {
+	f.indent++
+	f.visitOptions(ctx)
+	f.indent--
+	return visited
+}
+
+func (f *formatter) VisitCreateKeyspace(ctx *CreateKeyspaceContext) interface{} {
+	f.indent++
+	f.visitOptions(ctx)
+	f.indent--
+	return visited
+}
+
+func (f *formatter) VisitAlterKeyspace(ctx *AlterKeyspaceContext) interface{} {
+	f.indent++
+	f.visitOptions(ctx)
+	f.indent--
+	return visited
+}
+
+func (f *formatter) VisitCreateMaterializedView(ctx *CreateMaterializedViewContext) interface{} {
+	for _, child := range ctx.GetChildren() {
+		switch child.(type) {
+		case *KwAsContext, *KwFromContext, *MaterializedViewWhereContext, *KwPrimaryContext, *KwWithContext:
+			f.newline(1)
+		}
+		f.Visit(child.(antlr.ParseTree))
+	}
+	return visited
+}
+
+func (f *formatter) visitAll(node antlr.Tree) {
+	for _, child := range node.GetChildren() {
+		f.Visit(child.(antlr.ParseTree))
+	}
+}
+
+// visitOptions starts a new line before each AND of an option list.
+func (f *formatter) visitOptions(node antlr.Tree) {
+	for _, child := range node.GetChildren() {
+		if _, ok := child.(*KwAndContext); ok {
+			f.newline(1)
+		}
+		f.Visit(child.(antlr.ParseTree))
+	}
+}
+
+// visitBlock prints each comma-separated child of node on its own indented line.
+func (f *formatter) visitBlock(node antlr.Tree) {
+	f.indent++
+	for _, child := range node.GetChildren() {
+		if _, ok := child.(*SyntaxCommaContext); !ok {
+			f.newline(1)
+		}
+		f.Visit(child.(antlr.ParseTree))
+	}
+	f.indent--
+	f.newline(1)
+}
+
+// clusteringOrderIndent is the number of spaces clustering keys are indented
+// by, relative to the start of the statement.
+const clusteringOrderIndent = 2
+
+// visitClusteringOrder prints one clustering key per line. The keys hang off
+// the WITH line rather than the table options that follow it, so they are
+// indented from the statement's margin instead of the option level.
+func (f *formatter) visitClusteringOrder(ctx *ClusteringOrderContext) {
+	indent := f.indent
+	for _, child := range ctx.GetChildren() {
+		switch child.(type) {
+		case *SyntaxBracketLrContext:
+			f.Visit(child.(antlr.ParseTree))
+			f.indent, f.pad = 0, clusteringOrderIndent
+		case *SyntaxBracketRrContext:
+			f.pad = 0
+			f.newline(1)
+			f.Visit(child.(antlr.ParseTree))
+			f.indent = indent
+		case *ColumnContext:
+			f.newline(1)
+			f.Visit(child.(antlr.ParseTree))
+		default:
+			f.Visit(child.(antlr.ParseTree))
+		}
+	}
+}
+
+func (f *formatter) newline(n int) {
+	if n > f.pendingNewlines {
+		f.pendingNewlines = n
+	}
+}
+
+func (f *formatter) token(node antlr.TerminalNode) {
+	token := node.GetSymbol()
+	f.comments(token)
+	if token.GetTokenType() == antlr.TokenEOF {
+		return
+	}
+
+	parent := antlr.Tree(f.rule)
+	if f.pendingNewlines > 0 {
+		f.flush()
+	} else if f.prev != nil && f.needSpace(token, parent) {
+		f.line.WriteByte(' ')
+	}
+	f.line.WriteString(f.text(token, parent))
+	f.prev = token
+	f.prevParent = parent
+}
+
+// comments prints the comments between the previous token and token.
+func (f *formatter) comments(token antlr.Token) {
+	hidden := f.stream.GetHiddenTokensToLeft(token.GetTokenIndex(), antlr.TokenHiddenChannel)
+	for i, h := range hidden {
+		if h.GetTokenType() == CqlLexerSPACE {
+			continue
+		}
+		text := strings.TrimRight(h.GetText(), "\r\n")
+		ownLine := h.GetTokenIndex() == 0
+		if i > 0 && hidden[i-1].GetTokenType() == CqlLexerSPACE && strings.Contains(hidden[i-1].GetText(), "\n") {
+			ownLine = true
+		}
+		if ownLine {
+			f.newline(1)
+		}
+		if f.pendingNewlines > 0 {
+			f.flush()
+		} else if f.line.Len() > 0 {
+			f.line.WriteByte(' ')
+		}
+		f.line.WriteString(text)
+		f.prev = h
+		f.prevParent = nil
+		if h.GetTokenType() == CqlLexerLINE_COMMENT {
+			f.newline(1)
+		}
+	}
+}
+
+// flush ends the current line and starts the next one at the current indentation.
+func (f *formatter) flush() {
+	if f.line.Len() > 0 || f.buf.Len() > 0 {
+		f.buf.WriteString(strings.TrimRight(f.line.String(), " "))
+		for i := 0; i < f.pendingNewlines; i++ {
+			f.buf.WriteByte('\n')
+		}
+	}
+	f.line.Reset()
+	f.line.WriteString(strings.Repeat(" ", f.indent*f.opts.Indent+f.pad))
+	f.pendingNewlines = 0
+	f.prev = nil
+	f.prevParent = nil
+}
+
+func (f *formatter) needSpace(token antlr.Token, parent antlr.Tree) bool {
+	switch token.GetTokenType() {
+	case CqlLexerCOMMA, CqlLexerSEMI, CqlLexerCOLON, CqlLexerDOT, CqlLexerRR_BRACKET, CqlLexerRS_BRACKET, CqlLexerRC_BRACKET:
+		return false
+	case CqlLexerLR_BRACKET:
+		// Function calls keep their argument list attached: now(), count(*),
+		// CAST(b AS text).
+		if _, ok := parent.(*FunctionCallContext); ok {
+			return false
+		}
+		if _, ok := parent.GetParent().(*CastFunctionContext); ok {
+			return false
+		}
+	case CqlLexerOPERATOR_GT:
+		if isTypeBracket(parent) {
+			return false
+		}
+	case CqlLexerOPERATOR_LT:
+		if isTypeBracket(parent) {
+			return false
+		}
+	}
+	switch f.prev.GetTokenType() {
+	case CqlLexerLR_BRACKET, CqlLexerLS_BRACKET, CqlLexerLC_BRACKET, CqlLexerDOT:
+		return false
+	case CqlLexerMINUS:
+		// A negative number keeps its sign attached: -1.
+		if _, ok := f.prevParent.(*FloatLiteralContext); ok {
+			return false
+		}
+	case CqlLexerOPERATOR_LT:
+		if isTypeBracket(f.prevParent) {
+			return false
+		}
+	}
+	return true
+}
+
+// isTypeBracket reports whether the angle bracket belongs to a type such as map<text, int>.
+func isTypeBracket(parent antlr.Tree) bool {
+	switch parent.(type) {
+	case *SyntaxBracketLaContext, *SyntaxBracketRaContext:
+		return true
+	}
+	return false
+}
+
+func (f *formatter) text(token antlr.Token, parent antlr.Tree) string {
+	text := token.GetText()
+	tokenType := token.GetTokenType()
+	if tokenType < 0 || tokenType >= len(f.symbolicNames) || !strings.HasPrefix(f.symbolicNames[tokenType], "K_") {
+		return text
+	}
+	// Type names, boolean literals and keyword-named functions such as uuid()
+	// read as values rather than syntax, so they are always lower case.
+	switch parent.(type) {
+	case *DataTypeNameContext, *BooleanLiteralContext, *FunctionCallContext:
+		return strings.ToLower(text)
+	}
+	if f.opts.KeywordCase == KeywordCaseLower {
+		return strings.ToLower(text)
+	}
+	return strings.ToUpper(text)
+}
+
+func (f *formatter) String() string {
+	f.pendingNewlines = max(f.pendingNewlines, 1)
+	f.flush()
+	return strings.TrimLeft(f.buf.String(), "\n")
+}
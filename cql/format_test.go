@@ -0,0 +1,117 @@
+package cql_test
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	cqlparser "github.com/bytebase/parser/cql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		opts      cqlparser.FormatOptions
+		want      string
+	}{
+		{
+			name:      "create table",
+			statement: `create table cycling.race_winners (race_name text, race_position int, cyclist_name FROZEN<fullname>, PRIMARY KEY (race_name, race_position)) with clustering order by (race_position asc, cyclist_name desc) and comment = 'winners' and compaction = {'class' : 'LeveledCompactionStrategy'};`,
+			want: `CREATE TABLE cycling.race_winners (
+    race_name text,
+    race_position int,
+    cyclist_name frozen<fullname>,
+    PRIMARY KEY (race_name, race_position)
+) WITH CLUSTERING ORDER BY (
+  race_position ASC,
+  cyclist_name DESC
+)
+    AND comment = 'winners'
+    AND compaction = {'class': 'LeveledCompactionStrategy'};
+`,
+		},
+		{
+			name: "lower case keywords and comments",
+			statement: `-- users by id
+SELECT id, toTimestamp(now()) FROM users /* all */ WHERE id = 1;
+insert into users (id) values (uuid());`,
+			opts: cqlparser.FormatOptions{KeywordCase: cqlparser.KeywordCaseLower, Indent: 2},
+			want: `-- users by id
+select id, toTimestamp(now()) from users /* all */ where id = 1;
+
+insert into users (id) values (uuid());
+`,
+		},
+		{
+			name:      "arithmetic and negative numbers",
+			statement: `SELECT lap_time-1 FROM t WHERE lap = -1;`,
+			want: `SELECT lap_time - 1 FROM t WHERE lap = -1;
+`,
+		},
+		{
+			name:      "cast",
+			statement: `select cast(b as text), count(*) from t;`,
+			want: `SELECT CAST(b AS text), count(*) FROM t;
+`,
+		},
+		{
+			name:      "create type",
+			statement: `CREATE TYPE cycling.basic_info (birthday timestamp, nationality text, weight text);`,
+			want: `CREATE TYPE cycling.basic_info (
+    birthday timestamp,
+    nationality text,
+    weight text
+);
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cqlparser.Format(tt.statement, tt.opts)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatExamples(t *testing.T) {
+	examples, err := os.ReadDir("examples")
+	require.NoError(t, err)
+
+	for _, file := range examples {
+		filePath := path.Join("examples", file.Name())
+		data, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		if _, err := cqlparser.ParseCQL(string(data)); err != nil {
+			// Formatting needs a tree; files the parser rejects are covered by TestCQLParser.
+			continue
+		}
+		t.Run(filePath, func(t *testing.T) {
+			formatted, err := cqlparser.Format(string(data), cqlparser.FormatOptions{})
+			require.NoError(t, err)
+
+			// Formatting only changes layout and keyword case.
+			require.Equal(t, defaultChannelTokens(string(data)), defaultChannelTokens(formatted))
+
+			again, err := cqlparser.Format(formatted, cqlparser.FormatOptions{})
+			require.NoError(t, err)
+			require.Equal(t, formatted, again)
+		})
+	}
+}
+
+func defaultChannelTokens(statement string) []string {
+	lexer := cqlparser.NewCqlLexer(antlr.NewInputStream(statement))
+	var tokens []string
+	for token := lexer.NextToken(); token.GetTokenType() != antlr.TokenEOF; token = lexer.NextToken() {
+		if token.GetChannel() == antlr.TokenDefaultChannel {
+			tokens = append(tokens, strings.ToLower(token.GetText()))
+		}
+	}
+	return tokens
+}
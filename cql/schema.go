@@ -0,0 +1,578 @@
+package cql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Schema is the keyspace model described by a set of CQL DDL statements.
+// Objects are keyed by their qualified name, e.g. "cycling.cyclist_name", or
+// by their bare name when neither the statement nor a preceding USE names a
+// keyspace.
+type Schema struct {
+	Tables            map[string]*TableSchema
+	Types             map[string]*TypeSchema
+	Indexes           map[string]*IndexSchema
+	MaterializedViews map[string]*MaterializedViewSchema
+}
+
+// ColumnSchema is a table column or a user-defined type field.
+type ColumnSchema struct {
+	Name string
+	Type string
+}
+
+type TableSchema struct {
+	Keyspace      string
+	Name          string
+	Columns       []*ColumnSchema
+	PartitionKey  []string
+	ClusteringKey []string
+	// ClusteringOrder maps clustering columns to ASC or DESC.
+	ClusteringOrder map[string]string
+	CompactStorage  bool
+	// Options maps table option names to their values as written in CQL.
+	Options map[string]string
+}
+
+type TypeSchema struct {
+	Keyspace string
+	Name     string
+	Fields   []*ColumnSchema
+}
+
+type IndexSchema struct {
+	Keyspace string
+	Name     string
+	Table    string
+	// Target is the indexed column spec, e.g. "email" or "keys(tags)".
+	Target string
+	// Custom is set for CREATE CUSTOM INDEX.
+	Custom bool
+	// Class is the index implementation named by USING, e.g. "sai" or
+	// "StorageAttachedIndex", and empty for a built-in secondary index.
+	Class string
+	// Options is the WITH OPTIONS map as written in CQL.
+	Options string
+}
+
+type MaterializedViewSchema struct {
+	Keyspace  string
+	Name      string
+	BaseTable string
+	// Definition is the normalized text of the view's AS SELECT ... PRIMARY KEY (...) part.
+	Definition string
+	Options    map[string]string
+	// Statement is the CREATE MATERIALIZED VIEW statement the view was loaded from.
+	Statement string
+}
+
+// Column returns the column with the given name, or nil.
+func (t *TableSchema) Column(name string) *ColumnSchema {
+	return findColumn(t.Columns, name)
+}
+
+// Field returns the field with the given name, or nil.
+func (t *TypeSchema) Field(name string) *ColumnSchema {
+	return findColumn(t.Fields, name)
+}
+
+func findColumn(columns []*ColumnSchema, name string) *ColumnSchema {
+	for _, c := range columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// LoadSchema parses CQL DDL and builds the schema it describes. CREATE, ALTER
+// and DROP statements are applied in order; other statements are ignored.
+func LoadSchema(ddl string) (*Schema, error) {
+	tree, err := ParseCQL(ddl)
+	if err != nil {
+		return nil, err
+	}
+	loader := &schemaLoader{
+		BaseCqlParserListener: &BaseCqlParserListener{},
+		schema: &Schema{
+			Tables:            make(map[string]*TableSchema),
+			Types:             make(map[string]*TypeSchema),
+			Indexes:           make(map[string]*IndexSchema),
+			MaterializedViews: make(map[string]*MaterializedViewSchema),
+		},
+	}
+	antlr.ParseTreeWalkerDefault.Walk(loader, tree.(antlr.ParseTree))
+	if loader.err != nil {
+		return nil, loader.err
+	}
+	return loader.schema, nil
+}
+
+type schemaLoader struct {
+	*BaseCqlParserListener
+
+	schema *Schema
+	// keyspace is the keyspace selected by the last USE statement.
+	keyspace string
+	err      error
+}
+
+func (l *schemaLoader) fail(token antlr.Token, format string, args ...any) {
+	if l.err == nil {
+		l.err = fmt.Errorf("line %d:%d %s", token.GetLine(), token.GetColumn(), fmt.Sprintf(format, args...))
+	}
+}
+
+func (l *schemaLoader) qualify(keyspace IKeyspaceContext) string {
+	if keyspace != nil {
+		return normalizeIdentifier(keyspace.GetText())
+	}
+	return l.keyspace
+}
+
+func (l *schemaLoader) EnterUse_(ctx *Use_Context) {
+	l.keyspace = normalizeIdentifier(ctx.Keyspace().GetText())
+}
+
+func (l *schemaLoader) EnterCreateTable(ctx *CreateTableContext) {
+	table := &TableSchema{
+		Keyspace:        l.qualify(ctx.Keyspace()),
+		Name:            normalizeIdentifier(ctx.Table().GetText()),
+		ClusteringOrder: make(map[string]string),
+		Options:         make(map[string]string),
+	}
+	if ctx.IfNotExist() != nil && l.schema.Tables[qualifiedName(table.Keyspace, table.Name)] != nil {
+		return
+	}
+	list := ctx.ColumnDefinitionList()
+	for _, def := range list.AllColumnDefinition() {
+		name := normalizeIdentifier(def.Column().GetText())
+		table.Columns = append(table.Columns, &ColumnSchema{Name: name, Type: dataTypeText(def.DataType())})
+		if def.PrimaryKeyColumn() != nil {
+			table.PartitionKey = []string{name}
+		}
+	}
+	if pk := list.PrimaryKeyElement(); pk != nil {
+		table.PartitionKey, table.ClusteringKey = primaryKeyColumns(pk.PrimaryKeyDefinition())
+	}
+	if with := ctx.WithElement(); with != nil {
+		l.applyTableOptions(table, with.TableOptions())
+	}
+	l.schema.Tables[qualifiedName(table.Keyspace, table.Name)] = table
+}
+
+func primaryKeyColumns(def IPrimaryKeyDefinitionContext) (partition []string, clustering []string) {
+	switch {
+	case def.SinglePrimaryKey() != nil:
+		partition = []string{normalizeIdentifier(def.SinglePrimaryKey().GetText())}
+	case def.CompoundKey() != nil:
+		key := def.CompoundKey()
+		partition = []string{normalizeIdentifier(key.PartitionKey().GetText())}
+		clustering = clusteringKeyNames(key.ClusteringKeyList())
+	case def.CompositeKey() != nil:
+		key := def.CompositeKey()
+		for _, p := range key.PartitionKeyList().AllPartitionKey() {
+			partition = append(partition, normalizeIdentifier(p.GetText()))
+		}
+		clustering = clusteringKeyNames(key.ClusteringKeyList())
+	}
+	return partition, clustering
+}
+
+func clusteringKeyNames(list IClusteringKeyListContext) []string {
+	var names []string
+	if list == nil {
+		return names
+	}
+	for _, c := range list.AllClusteringKey() {
+		names = append(names, normalizeIdentifier(c.GetText()))
+	}
+	return names
+}
+
+func (l *schemaLoader) applyTableOptions(table *TableSchema, options ITableOptionsContext) {
+	for options != nil {
+		if options.KwCompact() != nil {
+			table.CompactStorage = true
+		}
+		if order := options.ClusteringOrder(); order != nil {
+			for column, direction := range clusteringOrder(order) {
+				table.ClusteringOrder[column] = direction
+			}
+		}
+		for name, value := range tableOptionItems(options.AllTableOptionItem()) {
+			table.Options[name] = value
+		}
+		options = options.TableOptions()
+	}
+}
+
+func clusteringOrder(ctx IClusteringOrderContext) map[string]string {
+	order := make(map[string]string)
+	column := ""
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case *ColumnContext:
+			column = normalizeIdentifier(c.GetText())
+			order[column] = "ASC"
+		case *OrderDirectionContext:
+			order[column] = strings.ToUpper(c.GetText())
+		}
+	}
+	return order
+}
+
+func tableOptionItems(items []ITableOptionItemContext) map[string]string {
+	options := make(map[string]string)
+	for _, item := range items {
+		name := strings.ToLower(item.TableOptionName().GetText())
+		if item.TableOptionValue() != nil {
+			options[name] = joinTokens(item.TableOptionValue())
+		} else {
+			options[name] = joinTokens(item.OptionHash())
+		}
+	}
+	return options
+}
+
+func (l *schemaLoader) EnterAlterTable(ctx *AlterTableContext) {
+	name := qualifiedName(l.qualify(ctx.Keyspace()), normalizeIdentifier(ctx.Table().GetText()))
+	table := l.schema.Tables[name]
+	if table == nil {
+		if ctx.IfExist() == nil {
+			l.fail(ctx.GetStart(), "table %s does not exist", name)
+		}
+		return
+	}
+	op := ctx.AlterTableOperation()
+	switch {
+	case op.AlterTableAdd() != nil:
+		add := op.AlterTableAdd()
+		def := add.AlterTableColumnDefinition()
+		types := def.AllDataType()
+		for i, column := range def.AllColumn() {
+			name := normalizeIdentifier(column.GetText())
+			if add.IfNotExist() != nil && table.Column(name) != nil {
+				continue
+			}
+			table.Columns = append(table.Columns, &ColumnSchema{Name: name, Type: dataTypeText(types[i])})
+		}
+	case op.AlterTableDropColumns() != nil:
+		for _, column := range op.AlterTableDropColumns().AlterTableDropColumnList().AllColumn() {
+			table.Columns = removeColumn(table.Columns, normalizeIdentifier(column.GetText()))
+		}
+	case op.AlterTableDropCompactStorage() != nil:
+		table.CompactStorage = false
+	case op.AlterTableRename() != nil:
+		// The columns come in FROM TO pairs: RENAME a TO b AND c TO d.
+		columns := op.AlterTableRename().AllColumn()
+		for i := 0; i+1 < len(columns); i += 2 {
+			from, to := normalizeIdentifier(columns[i].GetText()), normalizeIdentifier(columns[i+1].GetText())
+			if column := table.Column(from); column != nil {
+				column.Name = to
+			}
+			renameKey(table.PartitionKey, from, to)
+			renameKey(table.ClusteringKey, from, to)
+			if direction, ok := table.ClusteringOrder[from]; ok {
+				delete(table.ClusteringOrder, from)
+				table.ClusteringOrder[to] = direction
+			}
+		}
+	case op.AlterTableWith() != nil:
+		l.applyTableOptions(table, op.AlterTableWith().TableOptions())
+	}
+}
+
+func removeColumn(columns []*ColumnSchema, name string) []*ColumnSchema {
+	var result []*ColumnSchema
+	for _, c := range columns {
+		if c.Name != name {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func renameKey(keys []string, from, to string) {
+	for i, key := range keys {
+		if key == from {
+			keys[i] = to
+		}
+	}
+}
+
+func (l *schemaLoader) EnterDropTable(ctx *DropTableContext) {
+	delete(l.schema.Tables, qualifiedName(l.qualify(ctx.Keyspace()), normalizeIdentifier(ctx.Table().GetText())))
+}
+
+func (l *schemaLoader) EnterCreateType(ctx *CreateTypeContext) {
+	udt := &TypeSchema{
+		Keyspace: l.qualify(ctx.Keyspace()),
+		Name:     normalizeIdentifier(ctx.Type_().GetText()),
+	}
+	if ctx.IfNotExist() != nil && l.schema.Types[qualifiedName(udt.Keyspace, udt.Name)] != nil {
+		return
+	}
+	members := ctx.TypeMemberColumnList()
+	types := members.AllDataType()
+	for i, column := range members.AllColumn() {
+		udt.Fields = append(udt.Fields, &ColumnSchema{Name: normalizeIdentifier(column.GetText()), Type: dataTypeText(types[i])})
+	}
+	l.schema.Types[qualifiedName(udt.Keyspace, udt.Name)] = udt
+}
+
+func (l *schemaLoader) EnterAlterType(ctx *AlterTypeContext) {
+	name := qualifiedName(l.qualify(ctx.Keyspace()), normalizeIdentifier(ctx.Type_().GetText()))
+	udt := l.schema.Types[name]
+	if udt == nil {
+		if ctx.IfExist() == nil {
+			l.fail(ctx.GetStart(), "type %s does not exist", name)
+		}
+		return
+	}
+	op := ctx.AlterTypeOperation()
+	switch {
+	case op.AlterTypeAdd() != nil:
+		add := op.AlterTypeAdd()
+		types := add.AllDataType()
+		for i, column := range add.AllColumn() {
+			name := normalizeIdentifier(column.GetText())
+			if add.IfNotExist() != nil && udt.Field(name) != nil {
+				continue
+			}
+			udt.Fields = append(udt.Fields, &ColumnSchema{Name: name, Type: dataTypeText(types[i])})
+		}
+	case op.AlterTypeAlterType() != nil:
+		alter := op.AlterTypeAlterType()
+		if field := udt.Field(normalizeIdentifier(alter.Column().GetText())); field != nil {
+			field.Type = dataTypeText(alter.DataType())
+		}
+	case op.AlterTypeRename() != nil:
+		for _, item := range op.AlterTypeRename().AlterTypeRenameList().AllAlterTypeRenameItem() {
+			if field := udt.Field(normalizeIdentifier(item.Column(0).GetText())); field != nil {
+				field.Name = normalizeIdentifier(item.Column(1).GetText())
+			}
+		}
+	}
+}
+
+func (l *schemaLoader) EnterDropType(ctx *DropTypeContext) {
+	delete(l.schema.Types, qualifiedName(l.qualify(ctx.Keyspace()), normalizeIdentifier(ctx.Type_().GetText())))
+}
+
+func (l *schemaLoader) EnterCreateIndex(ctx *CreateIndexContext) {
+	index := &IndexSchema{
+		Keyspace: l.qualify(ctx.Keyspace()),
+		Table:    normalizeIdentifier(ctx.Table().GetText()),
+		Target:   foldTokens(ctx.IndexColumnSpec()),
+		Custom:   ctx.KwCustom() != nil,
+	}
+	if using := ctx.IndexUsing(); using != nil {
+		class := using.StringLiteral().GetText()
+		index.Class = strings.ReplaceAll(class[1:len(class)-1], "''", "'")
+		if using.OptionHash() != nil {
+			index.Options = joinTokens(using.OptionHash())
+		}
+	}
+	if ctx.IndexName() != nil {
+		index.Name = normalizeIdentifier(ctx.IndexName().GetText())
+	} else {
+		// Cassandra names anonymous indexes <table>_<column>_idx.
+		column := ctx.IndexColumnSpec().GetText()
+		if i := strings.Index(column, "("); i >= 0 {
+			column = strings.TrimSuffix(column[i+1:], ")")
+		}
+		index.Name = fmt.Sprintf("%s_%s_idx", index.Table, normalizeIdentifier(column))
+	}
+	if ctx.IfNotExist() != nil && l.schema.Indexes[qualifiedName(index.Keyspace, index.Name)] != nil {
+		return
+	}
+	l.schema.Indexes[qualifiedName(index.Keyspace, index.Name)] = index
+}
+
+func (l *schemaLoader) EnterDropIndex(ctx *DropIndexContext) {
+	delete(l.schema.Indexes, qualifiedName(l.qualify(ctx.Keyspace()), normalizeIdentifier(ctx.IndexName().GetText())))
+}
+
+func (l *schemaLoader) EnterCreateMaterializedView(ctx *CreateMaterializedViewContext) {
+	view := &MaterializedViewSchema{
+		Keyspace:  l.keyspace,
+		Name:      normalizeIdentifier(ctx.MaterializedView().GetText()),
+		BaseTable: normalizeIdentifier(ctx.Table().GetText()),
+		Options:   make(map[string]string),
+		Statement: statementText(ctx),
+	}
+	// The view and its base table may each be qualified; tell them apart by position.
+	from := ctx.KwFrom().GetStart().GetTokenIndex()
+	for _, keyspace := range ctx.AllKeyspace() {
+		if keyspace.GetStart().GetTokenIndex() < from {
+			view.Keyspace = normalizeIdentifier(keyspace.GetText())
+		}
+	}
+	if ctx.IfNotExist() != nil && l.schema.MaterializedViews[qualifiedName(view.Keyspace, view.Name)] != nil {
+		return
+	}
+
+	var definition []string
+	started := false
+	for _, child := range ctx.GetChildren() {
+		switch child.(type) {
+		case *KwAsContext:
+			started = true
+		case *KwWithContext:
+			started = false
+		}
+		if started {
+			definition = append(definition, joinTokens(child))
+		}
+	}
+	view.Definition = strings.ToLower(strings.Join(definition, " "))
+	if options := ctx.MaterializedViewOptions(); options != nil {
+		if options.TableOptions() != nil {
+			l.applyViewOptions(view, options.TableOptions())
+		}
+		if order := options.ClusteringOrder(); order != nil {
+			view.Definition += " " + strings.ToLower(joinTokens(order))
+		}
+	}
+	l.schema.MaterializedViews[qualifiedName(view.Keyspace, view.Name)] = view
+}
+
+func (l *schemaLoader) applyViewOptions(view *MaterializedViewSchema, options ITableOptionsContext) {
+	for options != nil {
+		if order := options.ClusteringOrder(); order != nil {
+			view.Definition += " " + strings.ToLower(joinTokens(order))
+		}
+		for name, value := range tableOptionItems(options.AllTableOptionItem()) {
+			view.Options[name] = value
+		}
+		options = options.TableOptions()
+	}
+}
+
+func (l *schemaLoader) EnterAlterMaterializedView(ctx *AlterMaterializedViewContext) {
+	name := qualifiedName(l.qualify(ctx.Keyspace()), normalizeIdentifier(ctx.MaterializedView().GetText()))
+	view := l.schema.MaterializedViews[name]
+	if view == nil {
+		l.fail(ctx.GetStart(), "materialized view %s does not exist", name)
+		return
+	}
+	if ctx.TableOptions() != nil {
+		l.applyViewOptions(view, ctx.TableOptions())
+	}
+}
+
+func (l *schemaLoader) EnterDropMaterializedView(ctx *DropMaterializedViewContext) {
+	delete(l.schema.MaterializedViews, qualifiedName(l.qualify(ctx.Keyspace()), normalizeIdentifier(ctx.MaterializedView().GetText())))
+}
+
+func qualifiedName(keyspace, name string) string {
+	if keyspace == "" {
+		return name
+	}
+	return keyspace + "." + name
+}
+
+// normalizeIdentifier folds unquoted identifiers to lower case, as Cassandra
+// does, and strips the quotes from quoted ones.
+func normalizeIdentifier(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return strings.ReplaceAll(s[1:len(s)-1], `""`, `"`)
+	}
+	return strings.ToLower(s)
+}
+
+// quoteIdentifier quotes name when it would not survive case folding.
+func quoteIdentifier(name string) string {
+	for i, r := range name {
+		if !(r >= 'a' && r <= 'z' || r == '_' || i > 0 && r >= '0' && r <= '9') {
+			return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+		}
+	}
+	return name
+}
+
+func quoteQualifiedName(keyspace, name string) string {
+	if keyspace == "" {
+		return quoteIdentifier(name)
+	}
+	return quoteIdentifier(keyspace) + "." + quoteIdentifier(name)
+}
+
+func dataTypeText(ctx IDataTypeContext) string {
+	return foldTokens(ctx)
+}
+
+// statementText returns the source text of ctx.
+func statementText(ctx antlr.ParserRuleContext) string {
+	start, stop := ctx.GetStart(), ctx.GetStop()
+	return start.GetInputStream().GetTextFromInterval(antlr.NewInterval(start.GetStart(), stop.GetStop()))
+}
+
+// joinTokens prints the tokens under tree on one line, with the spacing Format uses.
+func joinTokens(tree antlr.Tree) string {
+	return joinTokensWith(tree, antlr.Token.GetText)
+}
+
+// foldTokens is joinTokens with unquoted words folded to lower case. Quoted
+// identifiers are case-sensitive and are kept verbatim.
+func foldTokens(tree antlr.Tree) string {
+	return joinTokensWith(tree, func(token antlr.Token) string {
+		text := token.GetText()
+		if strings.HasPrefix(text, `"`) {
+			return text
+		}
+		return strings.ToLower(text)
+	})
+}
+
+func joinTokensWith(tree antlr.Tree, text func(antlr.Token) string) string {
+	var tokens []antlr.Token
+	var collect func(antlr.Tree)
+	collect = func(t antlr.Tree) {
+		if node, ok := t.(antlr.TerminalNode); ok {
+			tokens = append(tokens, node.GetSymbol())
+			return
+		}
+		for _, child := range t.GetChildren() {
+			collect(child)
+		}
+	}
+	collect(tree)
+
+	var b strings.Builder
+	for i, token := range tokens {
+		if i > 0 {
+			switch prev := tokens[i-1].GetTokenType(); {
+			case prev == CqlLexerLR_BRACKET, prev == CqlLexerLS_BRACKET, prev == CqlLexerLC_BRACKET,
+				prev == CqlLexerDOT, prev == CqlLexerOPERATOR_LT:
+			default:
+				switch token.GetTokenType() {
+				case CqlLexerCOMMA, CqlLexerCOLON, CqlLexerDOT, CqlLexerRR_BRACKET, CqlLexerRS_BRACKET, CqlLexerRC_BRACKET,
+					CqlLexerOPERATOR_GT, CqlLexerOPERATOR_LT:
+				case CqlLexerLR_BRACKET:
+					if prev != CqlLexerOBJECT_NAME && prev != CqlLexerK_KEYS && prev != CqlLexerK_ENTRIES && prev != CqlLexerK_FULL {
+						b.WriteByte(' ')
+					}
+				default:
+					b.WriteByte(' ')
+				}
+			}
+		}
+		b.WriteString(text(token))
+	}
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -646,6 +646,10 @@ ABORT_P
    : 'ABORT'
    ;
 
+ABSENT
+   : 'ABSENT'
+   ;
+
 ABSOLUTE_P
    : 'ABSOLUTE'
    ;
@@ -778,6 +782,10 @@ COMMITTED
    : 'COMMITTED'
    ;
 
+CONDITIONAL
+   : 'CONDITIONAL'
+   ;
+
 CONFIGURATION
    : 'CONFIGURATION'
    ;
@@ -898,6 +906,10 @@ EACH
    : 'EACH'
    ;
 
+EMPTY_P
+   : 'EMPTY'
+   ;
+
 ENABLE_P
    : 'ENABLE'
    ;
@@ -1070,10 +1082,22 @@ ISOLATION
    : 'ISOLATION'
    ;
 
+JSON
+   : 'JSON'
+   ;
+
+KEEP
+   : 'KEEP'
+   ;
+
 KEY
    : 'KEY'
    ;
 
+KEYS
+   : 'KEYS'
+   ;
+
 LABEL
    : 'LABEL'
    ;
@@ -1173,6 +1197,10 @@ NAMES
    : 'NAMES'
    ;
 
+NESTED
+   : 'NESTED'
+   ;
+
 NEXT
    : 'NEXT'
    ;
@@ -1213,6 +1241,10 @@ OIDS
    : 'OIDS'
    ;
 
+OMIT
+   : 'OMIT'
+   ;
+
 OPERATOR
    : 'OPERATOR'
    ;
@@ -1253,6 +1285,10 @@ PASSWORD
    : 'PASSWORD'
    ;
 
+PATH
+   : 'PATH'
+   ;
+
 PLANS
    : 'PLANS'
    ;
@@ -1297,6 +1333,10 @@ QUOTE
    : 'QUOTE'
    ;
 
+QUOTES
+   : 'QUOTES'
+   ;
+
 RANGE
    : 'RANGE'
    ;
@@ -1393,6 +1433,10 @@ SAVEPOINT
    : 'SAVEPOINT'
    ;
 
+SCALAR
+   : 'SCALAR'
+   ;
+
 SCHEMA
    : 'SCHEMA'
    ;
@@ -1453,6 +1497,10 @@ SNAPSHOT
    : 'SNAPSHOT'
    ;
 
+SOURCE
+   : 'SOURCE'
+   ;
+
 STABLE
    : 'STABLE'
    ;
@@ -1489,6 +1537,10 @@ STRICT_P
    : 'STRICT'
    ;
 
+STRING_P
+   : 'STRING'
+   ;
+
 STRIP_P
    : 'STRIP'
    ;
@@ -1509,6 +1561,10 @@ TABLESPACE
    : 'TABLESPACE'
    ;
 
+TARGET
+   : 'TARGET'
+   ;
+
 TEMP
    : 'TEMP'
    ;
@@ -1557,6 +1613,10 @@ UNCOMMITTED
    : 'UNCOMMITTED'
    ;
 
+UNCONDITIONAL
+   : 'UNCONDITIONAL'
+   ;
+
 UNENCRYPTED
    : 'UNENCRYPTED'
    ;
@@ -1727,6 +1787,46 @@ INTERVAL
    : 'INTERVAL'
    ;
 
+JSON_ARRAY
+   : 'JSON_ARRAY'
+   ;
+
+JSON_ARRAYAGG
+   : 'JSON_ARRAYAGG'
+   ;
+
+JSON_EXISTS
+   : 'JSON_EXISTS'
+   ;
+
+JSON_OBJECT
+   : 'JSON_OBJECT'
+   ;
+
+JSON_OBJECTAGG
+   : 'JSON_OBJECTAGG'
+   ;
+
+JSON_QUERY
+   : 'JSON_QUERY'
+   ;
+
+JSON_SCALAR
+   : 'JSON_SCALAR'
+   ;
+
+JSON_SERIALIZE
+   : 'JSON_SERIALIZE'
+   ;
+
+JSON_TABLE
+   : 'JSON_TABLE'
+   ;
+
+JSON_VALUE
+   : 'JSON_VALUE'
+   ;
+
 LEAST
    : 'LEAST'
    ;
@@ -3063,3 +3163,13 @@ EndDollarStringConstant
    {p.isTag()}?
    {l.popTag();} -> popMode
    ;
+
+// COPY ... FROM STDIN DATA
+
+// The rows that follow COPY ... FROM STDIN in a psql script or a pg_dump file, up to the \. line. The lexer base enters
+// this mode at the end of the line of the COPY statement.
+
+mode CopyDataMode;
+CopyData
+   : (~ [\r\n]* ('\r' '\n'? | '\n'))*? '\\.' -> channel (HIDDEN) , popMode
+   ;
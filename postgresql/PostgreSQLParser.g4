@@ -14,10 +14,18 @@ superClass = PostgreSQLParserBase;
 }
 root
    : stmtblock EOF
+   {
+                p.ValidateTargetVersion(localctx);
+                p.ValidateEngine(localctx);
+            }
    ;
 
 plsqlroot
    : pl_function
+   {
+                p.ValidateTargetVersion(localctx);
+                p.ValidateEngine(localctx);
+            }
    ;
 
 stmtblock
@@ -1712,16 +1720,26 @@ opt_grant_grant_option
    ;
 
 grantrolestmt
-   : GRANT privilege_list TO role_list opt_grant_admin_option? opt_granted_by?
+   : GRANT privilege_list TO role_list grant_role_opt_list? opt_granted_by?
    ;
 
 revokerolestmt
    : REVOKE privilege_list FROM role_list opt_granted_by? opt_drop_behavior?
-   | REVOKE ADMIN OPTION FOR privilege_list FROM role_list opt_granted_by? opt_drop_behavior?
+   | REVOKE colid OPTION FOR privilege_list FROM role_list opt_granted_by? opt_drop_behavior?
+   ;
+
+grant_role_opt_list
+   : WITH grant_role_opt (COMMA grant_role_opt)*
+   ;
+
+grant_role_opt
+   : collabel grant_role_opt_value
    ;
 
-opt_grant_admin_option
-   : WITH ADMIN OPTION
+grant_role_opt_value
+   : OPTION
+   | TRUE_P
+   | FALSE_P
    ;
 
 opt_granted_by
@@ -2034,9 +2052,12 @@ dostmt
 
 dostmt_opt_list
    : dostmt_opt_item+
+   {
+                p.ParseDoBody(localctx);
+            }
    ;
 
-dostmt_opt_item
+dostmt_opt_item locals[antlr.ParserRuleContext Definition]
    : sconst
    | LANGUAGE nonreservedword_or_sconst
    ;
@@ -2726,20 +2747,46 @@ returning_clause
 
 // https://www.postgresql.org/docs/current/sql-merge.html
 mergestmt
-   : with_clause? MERGE INTO ONLY? qualified_name alias_clause? USING (select_with_parens|qualified_name) alias_clause? ON a_expr
-        (merge_insert_clause merge_update_clause? | merge_update_clause merge_insert_clause?) merge_delete_clause?
+   : opt_with_clause? MERGE INTO relation_expr_opt_alias USING table_ref ON a_expr merge_when_list returning_clause?
+   ;
+
+merge_when_list
+   : merge_when_clause+
+   ;
+
+merge_when_clause
+   : merge_when_tgt_matched opt_merge_when_condition? THEN (merge_update | merge_delete | DO NOTHING)
+   | merge_when_tgt_not_matched opt_merge_when_condition? THEN (merge_insert | DO NOTHING)
+   ;
+
+merge_when_tgt_matched
+   : WHEN MATCHED
+   | WHEN NOT MATCHED BY SOURCE
+   ;
+
+merge_when_tgt_not_matched
+   : WHEN NOT MATCHED (BY TARGET)?
+   ;
+
+opt_merge_when_condition
+   : AND a_expr
+   ;
+
+merge_update
+   : UPDATE SET set_clause_list
    ;
 
-merge_insert_clause
-   : WHEN NOT MATCHED (AND a_expr)? THEN? INSERT (OPEN_PAREN insert_column_list CLOSE_PAREN)? values_clause
+merge_delete
+   : DELETE_P
    ;
 
-merge_update_clause
-   : WHEN MATCHED (AND a_expr)? THEN? UPDATE SET set_clause_list
+merge_insert
+   : INSERT (OPEN_PAREN insert_column_list CLOSE_PAREN)? (OVERRIDING override_kind VALUE_P)? merge_values_clause
+   | INSERT DEFAULT VALUES
    ;
 
-merge_delete_clause
-   : WHEN MATCHED THEN? DELETE_P
+merge_values_clause
+   : VALUES OPEN_PAREN expr_list CLOSE_PAREN
    ;
 
 deletestmt
@@ -3052,9 +3099,11 @@ table_ref
    : (relation_expr opt_alias_clause? tablesample_clause?
       | func_table func_alias_clause?
       | xmltable opt_alias_clause?
+      | json_table opt_alias_clause?
       | select_with_parens opt_alias_clause?
       | LATERAL_P (
                     xmltable opt_alias_clause?
+                    | json_table opt_alias_clause?
                     | func_table func_alias_clause?
                     | select_with_parens opt_alias_clause?
                   )
@@ -3181,10 +3230,31 @@ xmltable_column_option_list
 xmltable_column_option_el
    : DEFAULT a_expr
    | identifier a_expr
+   | PATH a_expr
    | NOT NULL_P
    | NULL_P
    ;
 
+// https://www.postgresql.org/docs/current/functions-json.html#FUNCTIONS-SQLJSON-TABLE
+json_table
+   : JSON_TABLE OPEN_PAREN json_value_expr COMMA a_expr (AS name)? json_passing_clause? COLUMNS OPEN_PAREN json_table_column_definition_list CLOSE_PAREN json_on_error_clause? CLOSE_PAREN
+   ;
+
+json_table_column_definition_list
+   : json_table_column_definition (COMMA json_table_column_definition)*
+   ;
+
+json_table_column_definition
+   : colid FOR ORDINALITY
+   | colid typename json_format_clause? json_table_column_path_clause? json_wrapper_behavior? json_quotes_clause? json_behavior_clause?
+   | colid typename EXISTS json_table_column_path_clause? json_on_error_clause?
+   | NESTED PATH? sconst (AS name)? COLUMNS OPEN_PAREN json_table_column_definition_list CLOSE_PAREN
+   ;
+
+json_table_column_path_clause
+   : PATH sconst
+   ;
+
 xml_namespace_list
    : xml_namespace_el (COMMA xml_namespace_el)*
    ;
@@ -3443,7 +3513,7 @@ a_expr_isnull
 
 
 a_expr_is_not
-   : a_expr_compare (IS NOT? (NULL_P | TRUE_P | FALSE_P | UNKNOWN | DISTINCT FROM a_expr | OF OPEN_PAREN type_list CLOSE_PAREN | DOCUMENT_P | unicode_normal_form? NORMALIZED))?
+   : a_expr_compare (IS NOT? (NULL_P | TRUE_P | FALSE_P | UNKNOWN | DISTINCT FROM a_expr | OF OPEN_PAREN type_list CLOSE_PAREN | DOCUMENT_P | unicode_normal_form? NORMALIZED | json_predicate_type_constraint json_key_uniqueness_constraint?))?
    ;
 /*11*/
 
@@ -3569,12 +3639,14 @@ func_application
 
 func_expr
    : func_application within_group_clause? filter_clause? over_clause?
+   | json_aggregate_func filter_clause? over_clause?
    | func_expr_common_subexpr
    ;
 
 func_expr_windowless
    : func_application
    | func_expr_common_subexpr
+   | json_aggregate_func
    ;
 
 func_expr_common_subexpr
@@ -3610,6 +3682,18 @@ func_expr_common_subexpr
    | XMLPI OPEN_PAREN NAME_P collabel (COMMA a_expr)? CLOSE_PAREN
    | XMLROOT OPEN_PAREN XML_P a_expr COMMA xml_root_version opt_xml_root_standalone? CLOSE_PAREN
    | XMLSERIALIZE OPEN_PAREN document_or_content a_expr AS simpletypename CLOSE_PAREN
+   | JSON_OBJECT OPEN_PAREN func_arg_list CLOSE_PAREN
+   | JSON_OBJECT OPEN_PAREN json_name_and_value_list json_constructor_null_clause? json_key_uniqueness_constraint? json_returning_clause? CLOSE_PAREN
+   | JSON_OBJECT OPEN_PAREN json_returning_clause? CLOSE_PAREN
+   | JSON_ARRAY OPEN_PAREN json_value_expr_list json_constructor_null_clause? json_returning_clause? CLOSE_PAREN
+   | JSON_ARRAY OPEN_PAREN select_no_parens json_format_clause? json_returning_clause? CLOSE_PAREN
+   | JSON_ARRAY OPEN_PAREN json_returning_clause? CLOSE_PAREN
+   | JSON OPEN_PAREN json_value_expr json_key_uniqueness_constraint? CLOSE_PAREN
+   | JSON_SCALAR OPEN_PAREN a_expr CLOSE_PAREN
+   | JSON_SERIALIZE OPEN_PAREN json_value_expr json_returning_clause? CLOSE_PAREN
+   | JSON_QUERY OPEN_PAREN json_value_expr COMMA a_expr json_passing_clause? json_returning_clause? json_wrapper_behavior? json_quotes_clause? json_behavior_clause? CLOSE_PAREN
+   | JSON_EXISTS OPEN_PAREN json_value_expr COMMA a_expr json_passing_clause? json_on_error_clause? CLOSE_PAREN
+   | JSON_VALUE OPEN_PAREN json_value_expr COMMA a_expr json_passing_clause? json_returning_clause? json_behavior_clause? CLOSE_PAREN
    ;
 
 xml_root_version
@@ -3623,6 +3707,85 @@ opt_xml_root_standalone
    | COMMA STANDALONE_P NO VALUE_P
    ;
 
+// https://www.postgresql.org/docs/current/functions-json.html
+json_value_expr
+   : a_expr json_format_clause?
+   ;
+
+json_value_expr_list
+   : json_value_expr (COMMA json_value_expr)*
+   ;
+
+json_format_clause
+   : FORMAT JSON (ENCODING name)?
+   ;
+
+json_returning_clause
+   : RETURNING typename json_format_clause?
+   ;
+
+json_name_and_value_list
+   : json_name_and_value (COMMA json_name_and_value)*
+   ;
+
+json_name_and_value
+   : c_expr VALUE_P json_value_expr
+   | a_expr COLON json_value_expr
+   ;
+
+json_constructor_null_clause
+   : (NULL_P | ABSENT) ON NULL_P
+   ;
+
+json_key_uniqueness_constraint
+   : (WITH | WITHOUT) UNIQUE KEYS?
+   ;
+
+json_predicate_type_constraint
+   : JSON (VALUE_P | ARRAY | OBJECT_P | SCALAR)?
+   ;
+
+json_passing_clause
+   : PASSING json_argument (COMMA json_argument)*
+   ;
+
+json_argument
+   : json_value_expr AS collabel
+   ;
+
+json_wrapper_behavior
+   : WITHOUT ARRAY? WRAPPER
+   | WITH (CONDITIONAL | UNCONDITIONAL)? ARRAY? WRAPPER
+   ;
+
+json_quotes_clause
+   : (KEEP | OMIT) QUOTES (ON SCALAR STRING_P)?
+   ;
+
+json_behavior
+   : DEFAULT a_expr
+   | ERROR
+   | NULL_P
+   | TRUE_P
+   | FALSE_P
+   | UNKNOWN
+   | EMPTY_P (ARRAY | OBJECT_P)?
+   ;
+
+json_behavior_clause
+   : json_behavior ON EMPTY_P (json_behavior ON ERROR)?
+   | json_behavior ON ERROR
+   ;
+
+json_on_error_clause
+   : json_behavior ON ERROR
+   ;
+
+json_aggregate_func
+   : JSON_OBJECTAGG OPEN_PAREN json_name_and_value json_constructor_null_clause? json_key_uniqueness_constraint? json_returning_clause? CLOSE_PAREN
+   | JSON_ARRAYAGG OPEN_PAREN json_value_expr sort_clause? json_constructor_null_clause? json_returning_clause? CLOSE_PAREN
+   ;
+
 xml_attributes
    : XMLATTRIBUTES OPEN_PAREN xml_attribute_list CLOSE_PAREN
    ;
@@ -4067,6 +4230,7 @@ plsqlidentifier
 
 unreserved_keyword
    : ABORT_P
+   | ABSENT
    | ABSOLUTE_P
    | ACCESS
    | ACTION
@@ -4104,6 +4268,7 @@ unreserved_keyword
    | COMMENTS
    | COMMIT
    | COMMITTED
+   | CONDITIONAL
    | CONFIGURATION
    | CONFLICT
    | CONNECTION
@@ -4139,6 +4304,7 @@ unreserved_keyword
    | DOUBLE_P
    | DROP
    | EACH
+   | EMPTY_P
    | ENABLE_P
    | ENCODING
    | ENCRYPTED
@@ -4189,7 +4355,10 @@ unreserved_keyword
    | INSTEAD
    | INVOKER
    | ISOLATION
+   | JSON
+   | KEEP
    | KEY
+   | KEYS
    | LABEL
    | LANGUAGE
    | LARGE_P
@@ -4205,8 +4374,10 @@ unreserved_keyword
    | LOGGED
    | MAPPING
    | MATCH
+   | MATCHED
    | MATERIALIZED
    | MAXVALUE
+   | MERGE
    | METHOD
    | MINUTE_P
    | MINVALUE
@@ -4215,6 +4386,7 @@ unreserved_keyword
    | MOVE
    | NAME_P
    | NAMES
+   | NESTED
    | NEW
    | NEXT
    | NFC
@@ -4232,6 +4404,7 @@ unreserved_keyword
    | OFF
    | OIDS
    | OLD
+   | OMIT
    | OPERATOR
    | OPTION
    | OPTIONS
@@ -4248,6 +4421,7 @@ unreserved_keyword
    | PARTITION
    | PASSING
    | PASSWORD
+   | PATH
    | PLANS
    | POLICY
    | PRECEDING
@@ -4262,6 +4436,7 @@ unreserved_keyword
    | PROGRAM
    | PUBLICATION
    | QUOTE
+   | QUOTES
    | RANGE
    | READ
    | REASSIGN
@@ -4289,6 +4464,7 @@ unreserved_keyword
    | ROWS
    | RULE
    | SAVEPOINT
+   | SCALAR
    | SCHEMA
    | SCHEMAS
    | SCROLL
@@ -4307,6 +4483,7 @@ unreserved_keyword
    | SIMPLE
    | SKIP_P
    | SNAPSHOT
+   | SOURCE
    | SQL_P
    | STABLE
    | STANDALONE_P
@@ -4318,6 +4495,7 @@ unreserved_keyword
    | STORAGE
    | STORED
    | STRICT_P
+   | STRING_P
    | STRIP_P
    | SUBSCRIPTION
    | SUPPORT
@@ -4325,6 +4503,7 @@ unreserved_keyword
    | SYSTEM_P
    | TABLES
    | TABLESPACE
+   | TARGET
    | TEMP
    | TEMPLATE
    | TEMPORARY
@@ -4340,6 +4519,7 @@ unreserved_keyword
    | UESCAPE
    | UNBOUNDED
    | UNCOMMITTED
+   | UNCONDITIONAL
    | UNENCRYPTED
    | UNKNOWN
    | UNLISTEN
@@ -4387,6 +4567,16 @@ col_name_keyword
    | INT_P
    | INTEGER
    | INTERVAL
+   | JSON_ARRAY
+   | JSON_ARRAYAGG
+   | JSON_EXISTS
+   | JSON_OBJECT
+   | JSON_OBJECTAGG
+   | JSON_QUERY
+   | JSON_SCALAR
+   | JSON_SERIALIZE
+   | JSON_TABLE
+   | JSON_VALUE
    | LEAST
    | NATIONAL
    | NCHAR
@@ -4820,8 +5010,8 @@ proc_stmt
    | stmt_foreach_a
    | stmt_exit
    | stmt_assert
-   | stmt_execsql
    | stmt_dynexecute
+   | stmt_execsql
    | stmt_perform
    | stmt_call
    | stmt_getdiag
@@ -5057,11 +5247,14 @@ stmt_execsql
 
    //EXECUTE command-string [ INTO [STRICT] target ] [ USING expression [, ... ] ];
 
-stmt_dynexecute
+stmt_dynexecute locals[antlr.ParserRuleContext Definition, []*PostgreSQLParseError DefinitionErrors, []*VersionError DefinitionVersionErrors]
    : EXECUTE a_expr (
 /*this is silly, but i have to time to find nice way to code */
 
    opt_execute_into? opt_execute_using? | opt_execute_using? opt_execute_into? |) SEMI
+   {
+                p.ParseDynamicSQL(localctx);
+            }
    ;
 
 opt_execute_using
@@ -1,835 +1,1403 @@
 package postgresql
 
-import "sort"
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
 
-var (
-	comparisonFunctions = []string{
-		"num_nonnulls",
-		"num_nulls",
-	}
+// FunctionCategory is the section of the PostgreSQL documentation that
+// describes a built-in function.
+type FunctionCategory int
 
-	mathematicalFunctions = []string{
-		"abs",
-		"cbrt",
-		"ceil",
-		"ceiling",
-		"degrees",
-		"div",
-		"erf",
-		"erfc",
-		"exp",
-		"factorial",
-		"floor",
-		"gcd",
-		"lcm",
-		"ln",
-		"log",
-		"log10",
-		"min_scale",
-		"mod",
-		"pi",
-		"power",
-		"radians",
-		"round",
-		"scale",
-		"sign",
-		"sqrt",
-		"trim_scale",
-		"trunc",
-		"width_bucket",
-		"random",
-		"random_normal",
-		"setseed",
-		"acos",
-		"acosd",
-		"asin",
-		"asind",
-		"atan",
-		"atand",
-		"atan2",
-		"atan2d",
-		"cos",
-		"cosd",
-		"cot",
-		"cotd",
-		"sin",
-		"sind",
-		"tan",
-		"tand",
-		"sinh",
-		"cosh",
-		"tanh",
-		"asinh",
-		"acosh",
-		"atanh",
-	}
+const (
+	ComparisonFunction FunctionCategory = iota
+	MathematicalFunction
+	StringFunction
+	BinaryStringFunction
+	BitStringFunction
+	DataTypeFormattingFunction
+	DateTimeFunction
+	EnumSupportFunction
+	GeometricFunction
+	NetworkAddressFunction
+	TextSearchFunction
+	UUIDFunction
+	XMLFunction
+	JSONFunction
+	SequenceManipulationFunction
+	ConditionalFunction
+	ArrayFunction
+	RangeFunction
+	AggregateFunction
+	WindowFunction
+	MergeSupportFunction
+	SetReturningFunction
+	SystemInformationFunction
+	SystemAdministrationFunction
+	TriggerFunction
+	EventTriggerFunction
+	StatisticsFunction
+)
 
-	stringFunctions = []string{
-		"btrim",
-		"bit_length",
-		"char_length",
-		"character_length",
-		"lower",
-		"lpad",
-		"ltrim",
-		"normalize",
-		"octet_length",
-		"overlay",
-		"position",
-		"rpad",
-		"rtrim",
-		"substring",
-		"trim",
-		"upper",
-		"ascii",
-		"chr",
-		"concat",
-		"concat_ws",
-		"format",
-		"initcap",
-		"left",
-		"length",
-		"md5",
-		"parse_ident",
-		"pg_client_encoding",
-		"quote_ident",
-		"quote_literal",
-		"quote_nullable",
-		"regexp_count",
-		"regexp_instr",
-		"regexp_like",
-		"regexp_match",
-		"regexp_matches",
-		"regexp_replace",
-		"regexp_split_to_array",
-		"regexp_split_to_table",
-		"regexp_substr",
-		"repeat",
-		"replace",
-		"reverse",
-		"right",
-		"split_part",
-		"starts_with",
-		"string_to_array",
-		"string_to_table",
-		"strpos",
-		"substr",
-		"to_ascii",
-		"to_hex",
-		"translate",
-		"unistr",
-	}
+var functionCategoryNames = []string{
+	"comparison",
+	"mathematical",
+	"string",
+	"binary string",
+	"bit string",
+	"data type formatting",
+	"date/time",
+	"enum support",
+	"geometric",
+	"network address",
+	"text search",
+	"uuid",
+	"xml",
+	"json",
+	"sequence manipulation",
+	"conditional",
+	"array",
+	"range",
+	"aggregate",
+	"window",
+	"merge support",
+	"set returning",
+	"system information",
+	"system administration",
+	"trigger",
+	"event trigger",
+	"statistics",
+}
 
-	binaryStringFunctions = []string{
-		"bit_length",
-		"btrim",
-		"ltrim",
-		"octet_length",
-		"overlay",
-		"position",
-		"rtrim",
-		"substring",
-		"trim",
-		"bit_count",
-		"get_bit",
-		"get_byte",
-		"length",
-		"md5",
-		"set_bit",
-		"set_byte",
-		"sha224",
-		"sha256",
-		"sha384",
-		"sha512",
-		"substr",
-		"convert",
-		"convert_from",
-		"convert_to",
-		"encode",
-		"decode",
+func (c FunctionCategory) String() string {
+	if c < 0 || int(c) >= len(functionCategoryNames) {
+		return fmt.Sprintf("FunctionCategory(%d)", int(c))
 	}
+	return functionCategoryNames[c]
+}
 
-	bitStringFunctions = []string{
-		"bit_count",
-		"bit_length",
-		"length",
-		"octet_length",
-		"overlay",
-		"position",
-		"substring",
-		"get_bit",
-		"set_bit",
-	}
+// Volatility is the volatility class of a function, as in CREATE FUNCTION.
+type Volatility int
 
-	dataTypeFormattingFunctions = []string{
-		"to_char",
-		"to_date",
-		"to_number",
-		"to_timestamp",
-	}
+const (
+	// VolatilityImmutable functions always return the same result for the same arguments.
+	VolatilityImmutable Volatility = iota
+	// VolatilityStable functions return the same result for the same arguments
+	// within a single statement, e.g. functions that depend on TimeZone.
+	VolatilityStable
+	// VolatilityVolatile functions can return a different result on every call.
+	VolatilityVolatile
+)
 
-	dateTimeFunctions = []string{
-		"age",
-		"clock_timestamp",
-		"current_time",
-		"current_timestamp",
-		"date_add",
-		"date_bin",
-		"date_part",
-		"date_subtract",
-		"date_trunc",
-		"extract",
-		"isfinite",
-		"justify_days",
-		"justify_hours",
-		"justify_interval",
-		"localtime",
-		"localtimestamp",
-		"make_date",
-		"make_interval",
-		"make_time",
-		"make_timestamp",
-		"make_timestamptz",
-		"now",
-		"statement_timestamp",
-		"timeofday",
-		"transaction_timestamp",
-		"to_timestamp",
+func (v Volatility) String() string {
+	switch v {
+	case VolatilityImmutable:
+		return "immutable"
+	case VolatilityStable:
+		return "stable"
+	default:
+		return "volatile"
 	}
+}
 
-	enumSupportFunctions = []string{
-		"enum_first",
-		"enum_last",
-		"enum_range",
-	}
+// FunctionKind tells plain functions from aggregate and window functions.
+type FunctionKind int
 
-	geometricFunctions = []string{
-		"area",
-		"center",
-		"diagonal",
-		"diameter",
-		"height",
-		"isclosed",
-		"isopen",
-		"length",
-		"npoints",
-		"pclose",
-		"popen",
-		"radius",
-		"slope",
-		"width",
-		"box",
-		"bound_box",
-		"circle",
-		"line",
-		"lseg",
-		"path",
-		"point",
-		"polygon",
-	}
+const (
+	FunctionKindNormal FunctionKind = iota
+	FunctionKindAggregate
+	FunctionKindWindow
+)
 
-	networkAddressFunctions = []string{
-		"abbrev",
-		"broadcast",
-		"family",
-		"host",
-		"hostmask",
-		"inet_merge",
-		"inet_same_family",
-		"masklen",
-		"netmask",
-		"network",
-		"set_masklen",
-		"text",
-		"trunc",
-		"macaddr8_set7bit",
+func (k FunctionKind) String() string {
+	switch k {
+	case FunctionKindAggregate:
+		return "aggregate"
+	case FunctionKindWindow:
+		return "window"
+	default:
+		return "normal"
 	}
+}
 
-	textSearchFunctions = []string{
-		"array_to_tsvector",
-		"get_current_ts_config",
-		"length",
-		"numnode",
-		"plainto_tsquery",
-		"phraseto_tsquery",
-		"websearch_to_tsquery",
-		"querytree",
-		"setweight",
-		"strip",
-		"to_tsquery",
-		"to_tsvector",
-		"json_to_tsvector",
-		"jsonb_to_tsvector",
-		"ts_delete",
-		"ts_filter",
-		"ts_headline",
-		"ts_rank",
-		"ts_rank_cd",
-		"ts_rewrite",
-		"tsquery_phrase",
-		"tsvector_to_array",
-		"unnest",
-		"ts_debug",
-		"ts_lexize",
-		"ts_parse",
-		"ts_token_type",
-		"ts_stat",
-	}
+// FunctionSignature is one overload of a built-in function.
+type FunctionSignature struct {
+	// Arguments are the argument types, e.g. "numeric" or "text[]". Polymorphic
+	// arguments use the pseudo-type names: "anyelement", "anyarray", "any", ...
+	// For ordered-set aggregates such as percentile_cont, these are the direct
+	// arguments; the aggregated ones are given in WITHIN GROUP.
+	Arguments []string
+	// Variadic is true if the last argument may be repeated, as in
+	// concat(VARIADIC "any").
+	Variadic bool
+	// Defaults is the number of trailing arguments that have a default value
+	// and may be left out.
+	Defaults int
+	// ReturnType is the type of the result, or of each row for a set-returning function.
+	ReturnType string
+	// ReturnsSet is true for set-returning functions.
+	ReturnsSet bool
+	Volatility Volatility
+	// Since is the major server version that introduced the overload, or 0 if
+	// it predates PostgreSQL 10.
+	Since int
+}
 
-	uuidFunctions = []string{
-		"gen_random_uuid",
+func (s *FunctionSignature) String() string {
+	args := make([]string, len(s.Arguments))
+	copy(args, s.Arguments)
+	if s.Variadic && len(args) > 0 {
+		args[len(args)-1] = "VARIADIC " + args[len(args)-1]
 	}
-
-	xmlFunctions = []string{
-		"xmlcomment",
-		"xmlconcat",
-		"xmlelement",
-		"xmlforest",
-		"xmlpi",
-		"xmlroot",
-		"xmlagg",
-		"XMLEXISTS",
-		"xml_is_well_formed",
-		"xml_is_well_formed_document",
-		"xml_is_well_formed_content",
-		"xpath",
-		"xpath_exists",
-		"table_to_xml",
-		"query_to_xml",
-		"cursor_to_xml",
-		"table_to_xmlschema",
-		"query_to_xmlschema",
-		"cursor_to_xmlschema",
-		"table_to_xml_and_xmlschema",
-		"query_to_xml_and_xmlschema",
-		"schema_to_xml",
-		"schema_to_xmlschema",
-		"schema_to_xml_and_xmlschema",
-		"database_to_xml",
-		"database_to_xmlschema",
-		"database_to_xml_and_xmlschema",
+	for i := len(args) - s.Defaults; i < len(args); i++ {
+		args[i] += " DEFAULT"
 	}
-
-	jsonFunctions = []string{
-		"to_json",
-		"to_jsonb",
-		"array_to_json",
-		"json_array",
-		"row_to_json",
-		"json_build_array",
-		"jsonb_build_array",
-		"json_build_object",
-		"jsonb_build_object",
-		"json_object",
-		"jsonb_object",
-		"json_array_elements",
-		"jsonb_array_elements",
-		"json_array_elements_text",
-		"jsonb_array_elements_text",
-		"json_array_length",
-		"jsonb_array_length",
-		"json_each",
-		"jsonb_each",
-		"json_each_text",
-		"jsonb_each_text",
-		"json_extract_path",
-		"jsonb_extract_path",
-		"json_extract_path_text",
-		"jsonb_extract_path_text",
-		"json_object_keys",
-		"jsonb_object_keys",
-		"json_populate_record",
-		"jsonb_populate_record",
-		"json_populate_recordset",
-		"jsonb_populate_recordset",
-		"json_to_record",
-		"jsonb_to_record",
-		"json_to_recordset",
-		"jsonb_to_recordset",
-		"jsonb_set",
-		"jsonb_set_lax",
-		"jsonb_insert",
-		"json_strip_nulls",
-		"jsonb_strip_nulls",
-		"jsonb_path_exists",
-		"jsonb_path_match",
-		"jsonb_path_query",
-		"jsonb_path_query_array",
-		"jsonb_path_query_first",
-		"jsonb_path_exists_tz",
-		"jsonb_path_match_tz",
-		"jsonb_path_query_tz",
-		"jsonb_path_query_array_tz",
-		"jsonb_path_query_first_tz",
-		"jsonb_pretty",
-		"json_typeof",
-		"jsonb_typeof",
-		"exists",
+	result := s.ReturnType
+	if s.ReturnsSet {
+		result = "SETOF " + result
 	}
+	return "(" + strings.Join(args, ", ") + ") RETURNS " + result
+}
 
-	sequenceManipulationFunctions = []string{
-		"nextval",
-		"setval",
-		"currval",
-		"lastval",
+// Accepts reports whether the signature can be called with n arguments. As in
+// PostgreSQL, a variadic argument without a default must be given at least once.
+func (s *FunctionSignature) Accepts(n int) bool {
+	if n < len(s.Arguments)-s.Defaults {
+		return false
 	}
+	return s.Variadic || n <= len(s.Arguments)
+}
 
-	conditionalFunctions = []string{
-		"COALESCE",
-		"NULLIF",
-		"GREATEST",
-		"LEAST",
-	}
+// BuiltinFunction describes a built-in function of one category. A name that
+// is documented in several categories, such as length, has one BuiltinFunction
+// per category.
+type BuiltinFunction struct {
+	// Name is the function name as it is written in SQL. Functions with special
+	// syntax keep their SQL spelling, e.g. "COALESCE" or "COLLATION FOR".
+	Name       string
+	Category   FunctionCategory
+	Kind       FunctionKind
+	Signatures []*FunctionSignature
+	// Since is the major server version that introduced the function, or 0 if
+	// it predates PostgreSQL 10.
+	Since int
+}
 
-	arrayFunctions = []string{
-		"array_append",
-		"array_cat",
-		"array_dims",
-		"array_fill",
-		"array_length",
-		"array_lower",
-		"array_ndims",
-		"array_position",
-		"array_positions",
-		"array_prepend",
-		"array_remove",
-		"array_replace",
-		"array_sample",
-		"array_shuffle",
-		"array_to_string",
-		"array_upper",
-		"cardinality",
-		"trim_array",
-		"unnest",
+// SignaturesForVersion returns the overloads available in the given major
+// server version. Version 0 means the latest version.
+func (f *BuiltinFunction) SignaturesForVersion(version int) []*FunctionSignature {
+	var result []*FunctionSignature
+	for _, s := range f.Signatures {
+		if version == 0 || s.Since <= version {
+			result = append(result, s)
+		}
 	}
+	return result
+}
 
-	rangeFunctions = []string{
-		"lower",
-		"upper",
-		"isempty",
-		"lower_inc",
-		"upper_inc",
-		"lower_inf",
-		"upper_inf",
-		"range_merge",
-		"multirange",
-		"unnest",
-	}
+var (
+	builtinFunctionCatalog []*BuiltinFunction
+	builtinFunctionsByName = make(map[string][]*BuiltinFunction)
+	builtinFunctions       []string
+)
 
-	aggregateFunctions = []string{
-		"any_value",
-		"array_agg",
-		"avg",
-		"bit_and",
-		"bit_or",
-		"bit_xor",
-		"bool_and",
-		"bool_or",
-		"count",
-		"every",
-		"json_agg",
-		"jsonb_agg",
-		"json_objectagg",
-		"json_object_agg",
-		"jsonb_object_agg",
-		"json_object_agg_strict",
-		"jsonb_object_agg_strict",
-		"json_object_agg_unique",
-		"jsonb_object_agg_unique",
-		"json_arrayagg",
-		"json_object_agg_unique_strict",
-		"jsonb_object_agg_unique_strict",
-		"max",
-		"min",
-		"range_agg",
-		"range_intersect_agg",
-		"json_agg_strict",
-		"jsonb_agg_strict",
-		"string_agg",
-		"sum",
-		"xmlagg",
-		"corr",
-		"covar_pop",
-		"covar_samp",
-		"regr_avgx",
-		"regr_avgy",
-		"regr_count",
-		"regr_intercept",
-		"regr_r2",
-		"regr_slope",
-		"regr_sxx",
-		"regr_sxy",
-		"regr_syy",
-		"stddev",
-		"stddev_pop",
-		"stddev_samp",
-		"variance",
-		"var_pop",
-		"var_samp",
-		"mode",
-		"percentile_cont",
-		"percentile_disc",
-		"rank",
-		"dense_rank",
-		"percent_rank",
-		"cume_dist",
-		"GROUPING",
+func init() {
+	for _, section := range builtinFunctionSections {
+		for _, f := range parseBuiltinFunctionSection(section) {
+			builtinFunctionCatalog = append(builtinFunctionCatalog, f)
+			key := strings.ToLower(f.Name)
+			if len(builtinFunctionsByName[key]) == 0 {
+				builtinFunctions = append(builtinFunctions, f.Name)
+			}
+			builtinFunctionsByName[key] = append(builtinFunctionsByName[key], f)
+		}
 	}
+	sort.Strings(builtinFunctions)
+}
 
-	windowFunctions = []string{
-		"row_number",
-		"rank",
-		"dense_rank",
-		"percent_rank",
-		"cume_dist",
-		"ntile",
-		"lag",
-		"lead",
-		"first_value",
-		"last_value",
-		"nth_value",
-	}
+// GetBuiltinFunctions returns the names of the built-in functions, sorted.
+func GetBuiltinFunctions() []string {
+	var result []string
+	result = append(result, builtinFunctions...)
+	return result
+}
 
-	setReturningFunctions = []string{
-		"generate_series",
-		"generate_subscripts",
-	}
+// GetBuiltinFunctionCatalog returns every built-in function, ordered by category.
+func GetBuiltinFunctionCatalog() []*BuiltinFunction {
+	var result []*BuiltinFunction
+	result = append(result, builtinFunctionCatalog...)
+	return result
+}
 
-	systemInformationFunctions = []string{
-		"current_database",
-		"current_query",
-		"current_schema",
-		"current_schemas",
-		"inet_client_addr",
-		"inet_client_port",
-		"inet_server_addr",
-		"inet_server_port",
-		"pg_backend_pid",
-		"pg_blocking_pids",
-		"pg_conf_load_time",
-		"pg_current_logfile",
-		"pg_my_temp_schema",
-		"pg_is_other_temp_schema",
-		"pg_jit_available",
-		"pg_listening_channels",
-		"pg_notification_queue_usage",
-		"pg_postmaster_start_time",
-		"pg_safe_snapshot_blocking_pids",
-		"pg_trigger_depth",
-		"version",
-		"has_any_column_privilege",
-		"has_column_privilege",
-		"has_database_privilege",
-		"has_foreign_data_wrapper_privilege",
-		"has_function_privilege",
-		"has_language_privilege",
-		"has_parameter_privilege",
-		"has_schema_privilege",
-		"has_sequence_privilege",
-		"has_server_privilege",
-		"has_table_privilege",
-		"has_tablespace_privilege",
-		"has_type_privilege",
-		"pg_has_role",
-		"row_security_active",
-		"acldefault",
-		"aclexplode",
-		"makeaclitem",
-		"pg_collation_is_visible",
-		"pg_conversion_is_visible",
-		"pg_function_is_visible",
-		"pg_opclass_is_visible",
-		"pg_operator_is_visible",
-		"pg_opfamily_is_visible",
-		"pg_statistics_obj_is_visible",
-		"pg_table_is_visible",
-		"pg_ts_config_is_visible",
-		"pg_ts_dict_is_visible",
-		"pg_ts_parser_is_visible",
-		"pg_ts_template_is_visible",
-		"pg_type_is_visible",
-		"format_type",
-		"pg_char_to_encoding",
-		"pg_encoding_to_char",
-		"pg_get_catalog_foreign_keys",
-		"pg_get_constraintdef",
-		"pg_get_expr",
-		"pg_get_functiondef",
-		"pg_get_function_arguments",
-		"pg_get_function_identity_arguments",
-		"pg_get_function_result",
-		"pg_get_indexdef",
-		"pg_get_keywords",
-		"pg_get_partkeydef",
-		"pg_get_ruledef",
-		"pg_get_serial_sequence",
-		"pg_get_statisticsobjdef",
-		"pg_get_triggerdef",
-		"pg_get_userbyid",
-		"pg_get_viewdef",
-		"pg_index_column_has_property",
-		"pg_index_has_property",
-		"pg_indexam_has_property",
-		"pg_options_to_table",
-		"pg_settings_get_flags",
-		"pg_tablespace_databases",
-		"pg_tablespace_location",
-		"pg_typeof",
-		"COLLATION FOR",
-		"to_regclass",
-		"to_regcollation",
-		"to_regnamespace",
-		"to_regoper",
-		"to_regoperator",
-		"to_regproc",
-		"to_regprocedure",
-		"to_regrole",
-		"to_regtype",
-		"pg_describe_object",
-		"pg_identify_object",
-		"pg_identify_object_as_address",
-		"pg_get_object_address",
-		"col_description",
-		"obj_description",
-		"shobj_description",
-		"pg_input_is_valid",
-		"pg_input_error_info",
-		"pg_current_xact_id",
-		"pg_current_xact_id_if_assigned",
-		"pg_xact_status",
-		"pg_current_snapshot",
-		"pg_snapshot_xip",
-		"pg_snapshot_xmax",
-		"pg_snapshot_xmin",
-		"pg_visible_in_snapshot",
-		"txid_current",
-		"txid_current_if_assigned",
-		"txid_current_snapshot",
-		"txid_snapshot_xip",
-		"txid_snapshot_xmax",
-		"txid_snapshot_xmin",
-		"txid_visible_in_snapshot",
-		"txid_status",
-		"pg_xact_commit_timestamp",
-		"pg_xact_commit_timestamp_origin",
-		"pg_last_committed_xact",
-		"pg_control_checkpoint",
-		"pg_control_system",
-		"pg_control_init",
-		"pg_control_recovery",
-	}
+// LookupBuiltinFunction returns the built-in functions called name, one per
+// category that documents it. Names are matched case-insensitively.
+func LookupBuiltinFunction(name string) []*BuiltinFunction {
+	var result []*BuiltinFunction
+	result = append(result, builtinFunctionsByName[strings.ToLower(name)]...)
+	return result
+}
 
-	systemAdministrationFunctions = []string{
-		"current_setting",
-		"set_config",
-		"pg_cancel_backend",
-		"pg_log_backend_memory_contexts",
-		"pg_reload_conf",
-		"pg_rotate_logfile",
-		"pg_terminate_backend",
-		"pg_create_restore_point",
-		"pg_current_wal_flush_lsn",
-		"pg_current_wal_insert_lsn",
-		"pg_current_wal_lsn",
-		"pg_backup_start",
-		"pg_backup_stop",
-		"pg_switch_wal",
-		"pg_walfile_name",
-		"pg_walfile_name_offset",
-		"pg_split_walfile_name",
-		"pg_wal_lsn_diff",
-		"pg_is_in_recovery",
-		"pg_last_wal_receive_lsn",
-		"pg_last_wal_replay_lsn",
-		"pg_last_xact_replay_timestamp",
-		"pg_get_wal_resource_managers",
-		"pg_is_wal_replay_paused",
-		"pg_get_wal_replay_pause_state",
-		"pg_promote",
-		"pg_wal_replay_pause",
-		"pg_wal_replay_resume",
-		"pg_export_snapshot",
-		"pg_log_standby_snapshot",
-		"pg_create_physical_replication_slot",
-		"pg_drop_replication_slot",
-		"pg_create_logical_replication_slot",
-		"pg_copy_physical_replication_slot",
-		"pg_copy_logical_replication_slot",
-		"pg_logical_slot_get_changes",
-		"pg_logical_slot_peek_changes",
-		"pg_logical_slot_get_binary_changes",
-		"pg_logical_slot_peek_binary_changes",
-		"pg_replication_slot_advance",
-		"pg_replication_origin_create",
-		"pg_replication_origin_drop",
-		"pg_replication_origin_oid",
-		"pg_replication_origin_session_setup",
-		"pg_replication_origin_session_reset",
-		"pg_replication_origin_session_is_setup",
-		"pg_replication_origin_session_progress",
-		"pg_replication_origin_xact_setup",
-		"pg_replication_origin_xact_reset",
-		"pg_replication_origin_advance",
-		"pg_replication_origin_progress",
-		"pg_logical_emit_message",
-		"pg_column_size",
-		"pg_column_compression",
-		"pg_database_size",
-		"pg_indexes_size",
-		"pg_relation_size",
-		"pg_size_bytes",
-		"pg_size_pretty",
-		"pg_table_size",
-		"pg_tablespace_size",
-		"pg_total_relation_size",
-		"pg_relation_filenode",
-		"pg_relation_filepath",
-		"pg_filenode_relation",
-		"pg_collation_actual_version",
-		"pg_database_collation_actual_version",
-		"pg_import_system_collations",
-		"pg_partition_tree",
-		"pg_partition_ancestors",
-		"pg_partition_root",
-		"brin_summarize_new_values",
-		"brin_summarize_range",
-		"brin_desummarize_range",
-		"gin_clean_pending_list",
-		"pg_ls_dir",
-		"pg_ls_logdir",
-		"pg_ls_waldir",
-		"pg_ls_logicalmapdir",
-		"pg_ls_logicalsnapdir",
-		"pg_ls_replslotdir",
-		"pg_ls_archive_statusdir",
-		"pg_ls_tmpdir",
-		"pg_read_file",
-		"pg_read_binary_file",
-		"pg_stat_file",
-		"pg_advisory_lock",
-		"pg_advisory_lock_shared",
-		"pg_advisory_unlock",
-		"pg_advisory_unlock_all",
-		"pg_advisory_unlock_shared",
-		"pg_advisory_xact_lock",
-		"pg_advisory_xact_lock_shared",
-		"pg_try_advisory_lock",
-		"pg_try_advisory_lock_shared",
-		"pg_try_advisory_xact_lock",
-		"pg_try_advisory_xact_lock_shared",
-	}
+// builtinFunctionSection lists the signatures of one category, one per line:
+//
+//	name(argtype, ..., argtype DEFAULT, VARIADIC argtype) [SETOF] rettype [flags]
+//
+// where arguments marked DEFAULT may be left out, and the optional flags are a
+// volatility class overriding the section's default, "since N" for overloads
+// added in PostgreSQL N, and "aggregate" or "window" for aggregate and window
+// functions listed outside their category.
+type builtinFunctionSection struct {
+	category   FunctionCategory
+	volatility Volatility
+	signatures string
+}
 
-	triggerFunctions = []string{
-		"suppress_redundant_updates_trigger",
-		"tsvector_update_trigger",
-		"tsvector_update_trigger_column",
+func parseBuiltinFunctionSection(section builtinFunctionSection) []*BuiltinFunction {
+	var result []*BuiltinFunction
+	byName := make(map[string]*BuiltinFunction)
+	for _, line := range strings.Split(section.signatures, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, signature, kind := parseFunctionSignature(line, section.volatility)
+		f, ok := byName[name]
+		if !ok {
+			f = &BuiltinFunction{Name: name, Category: section.category, Since: signature.Since}
+			switch section.category {
+			case AggregateFunction:
+				f.Kind = FunctionKindAggregate
+			case WindowFunction:
+				f.Kind = FunctionKindWindow
+			}
+			byName[name] = f
+			result = append(result, f)
+		}
+		if kind != FunctionKindNormal {
+			f.Kind = kind
+		}
+		f.Since = min(f.Since, signature.Since)
+		f.Signatures = append(f.Signatures, signature)
 	}
+	return result
+}
 
-	eventTriggerFunctions = []string{
-		"pg_event_trigger_ddl_commands",
-		"pg_event_trigger_dropped_objects",
-		"pg_event_trigger_table_rewrite_oid",
-		"pg_event_trigger_table_rewrite_reason",
+func parseFunctionSignature(line string, volatility Volatility) (string, *FunctionSignature, FunctionKind) {
+	open, end := strings.Index(line, "("), strings.Index(line, ")")
+	if open <= 0 || end < open {
+		panic(fmt.Sprintf("malformed built-in function signature %q", line))
 	}
-
-	statisticsFunctions = []string{
-		"pg_event_trigger_table_rewrite_reason",
+	name := strings.TrimSpace(line[:open])
+	signature := &FunctionSignature{Volatility: volatility}
+	if args := strings.TrimSpace(line[open+1 : end]); args != "" {
+		for _, arg := range strings.Split(args, ",") {
+			arg = strings.TrimSpace(arg)
+			if rest, ok := strings.CutPrefix(arg, "VARIADIC "); ok {
+				signature.Variadic = true
+				arg = rest
+			}
+			if rest, ok := strings.CutSuffix(arg, " DEFAULT"); ok {
+				signature.Defaults++
+				arg = rest
+			} else if signature.Defaults > 0 {
+				panic(fmt.Sprintf("built-in function signature %q has an argument without a default after one with a default", line))
+			}
+			signature.Arguments = append(signature.Arguments, arg)
+		}
 	}
 
-	builtinFunctions = []string{}
-)
-
-func init() {
-	funcMap := make(map[string]bool)
-
-	for _, f := range comparisonFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range mathematicalFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range stringFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range binaryStringFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range bitStringFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range dataTypeFormattingFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range dateTimeFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range enumSupportFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range geometricFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range networkAddressFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range textSearchFunctions {
-		funcMap[f] = true
+	kind := FunctionKindNormal
+	words := strings.Fields(line[end+1:])
+flags:
+	for len(words) > 1 {
+		switch last := words[len(words)-1]; last {
+		case "immutable":
+			signature.Volatility = VolatilityImmutable
+		case "stable":
+			signature.Volatility = VolatilityStable
+		case "volatile":
+			signature.Volatility = VolatilityVolatile
+		case "aggregate":
+			kind = FunctionKindAggregate
+		case "window":
+			kind = FunctionKindWindow
+		default:
+			version, err := strconv.Atoi(last)
+			if err != nil || words[len(words)-2] != "since" {
+				break flags
+			}
+			signature.Since = version
+			words = words[:len(words)-1]
+		}
+		words = words[:len(words)-1]
 	}
-	for _, f := range uuidFunctions {
-		funcMap[f] = true
+	if len(words) > 1 && words[0] == "SETOF" {
+		signature.ReturnsSet = true
+		words = words[1:]
 	}
-	for _, f := range xmlFunctions {
-		funcMap[f] = true
+	if len(words) == 0 {
+		panic(fmt.Sprintf("built-in function signature %q has no return type", line))
 	}
-	for _, f := range jsonFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range sequenceManipulationFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range conditionalFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range arrayFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range rangeFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range aggregateFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range windowFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range setReturningFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range systemInformationFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range systemAdministrationFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range triggerFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range eventTriggerFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range statisticsFunctions {
-		funcMap[f] = true
-	}
-
-	for f := range funcMap {
-		builtinFunctions = append(builtinFunctions, f)
-	}
-
-	sort.Strings(builtinFunctions)
+	signature.ReturnType = strings.Join(words, " ")
+	return name, signature, kind
 }
 
-func GetBuiltinFunctions() []string {
-	var result []string
-	result = append(result, builtinFunctions...)
-	return result
+var builtinFunctionSections = []builtinFunctionSection{
+	{ComparisonFunction, VolatilityImmutable, `
+		num_nonnulls(VARIADIC any) integer
+		num_nulls(VARIADIC any) integer
+	`},
+	{MathematicalFunction, VolatilityImmutable, `
+		abs(numeric) numeric
+		abs(double precision) double precision
+		abs(real) real
+		abs(bigint) bigint
+		abs(integer) integer
+		abs(smallint) smallint
+		cbrt(double precision) double precision
+		ceil(numeric) numeric
+		ceil(double precision) double precision
+		ceiling(numeric) numeric
+		ceiling(double precision) double precision
+		degrees(double precision) double precision
+		div(numeric, numeric) numeric
+		erf(double precision) double precision since 16
+		erfc(double precision) double precision since 16
+		exp(numeric) numeric
+		exp(double precision) double precision
+		factorial(bigint) numeric
+		floor(numeric) numeric
+		floor(double precision) double precision
+		gcd(integer, integer) integer since 13
+		gcd(bigint, bigint) bigint since 13
+		gcd(numeric, numeric) numeric since 13
+		lcm(integer, integer) integer since 13
+		lcm(bigint, bigint) bigint since 13
+		lcm(numeric, numeric) numeric since 13
+		ln(numeric) numeric
+		ln(double precision) double precision
+		log(numeric) numeric
+		log(double precision) double precision
+		log(numeric, numeric) numeric
+		log10(numeric) numeric since 12
+		log10(double precision) double precision since 12
+		min_scale(numeric) integer since 13
+		mod(smallint, smallint) smallint
+		mod(integer, integer) integer
+		mod(bigint, bigint) bigint
+		mod(numeric, numeric) numeric
+		pi() double precision
+		power(numeric, numeric) numeric
+		power(double precision, double precision) double precision
+		radians(double precision) double precision
+		round(numeric) numeric
+		round(double precision) double precision
+		round(numeric, integer) numeric
+		scale(numeric) integer
+		sign(numeric) numeric
+		sign(double precision) double precision
+		sqrt(numeric) numeric
+		sqrt(double precision) double precision
+		trim_scale(numeric) numeric since 13
+		trunc(numeric) numeric
+		trunc(double precision) double precision
+		trunc(numeric, integer) numeric
+		width_bucket(numeric, numeric, numeric, integer) integer
+		width_bucket(double precision, double precision, double precision, integer) integer
+		width_bucket(anycompatible, anycompatiblearray) integer
+		random() double precision volatile
+		random(integer, integer) integer volatile since 17
+		random(bigint, bigint) bigint volatile since 17
+		random(numeric, numeric) numeric volatile since 17
+		random_normal(double precision DEFAULT, double precision DEFAULT) double precision volatile since 16
+		setseed(double precision) void volatile
+		acos(double precision) double precision
+		acosd(double precision) double precision
+		asin(double precision) double precision
+		asind(double precision) double precision
+		atan(double precision) double precision
+		atand(double precision) double precision
+		atan2(double precision, double precision) double precision
+		atan2d(double precision, double precision) double precision
+		cos(double precision) double precision
+		cosd(double precision) double precision
+		cot(double precision) double precision
+		cotd(double precision) double precision
+		sin(double precision) double precision
+		sind(double precision) double precision
+		tan(double precision) double precision
+		tand(double precision) double precision
+		sinh(double precision) double precision since 12
+		cosh(double precision) double precision since 12
+		tanh(double precision) double precision since 12
+		asinh(double precision) double precision since 12
+		acosh(double precision) double precision since 12
+		atanh(double precision) double precision since 12
+	`},
+	{StringFunction, VolatilityImmutable, `
+		btrim(text) text
+		btrim(text, text) text
+		bit_length(text) integer
+		char_length(text) integer
+		character_length(text) integer
+		lower(text) text
+		lpad(text, integer) text
+		lpad(text, integer, text) text
+		ltrim(text) text
+		ltrim(text, text) text
+		normalize(text) text since 13
+		normalize(text, text) text since 13
+		octet_length(text) integer
+		octet_length(character) integer
+		overlay(text, text, integer) text
+		overlay(text, text, integer, integer) text
+		position(text, text) integer
+		rpad(text, integer) text
+		rpad(text, integer, text) text
+		rtrim(text) text
+		rtrim(text, text) text
+		substring(text, integer) text
+		substring(text, integer, integer) text
+		substring(text, text) text
+		substring(text, text, text) text
+		trim(text) text
+		trim(text, text) text
+		upper(text) text
+		ascii(text) integer
+		chr(integer) text
+		concat(VARIADIC any) text stable
+		concat_ws(text, VARIADIC any) text stable
+		format(text) text stable
+		format(text, VARIADIC any) text stable
+		initcap(text) text
+		left(text, integer) text
+		length(text) integer
+		md5(text) text
+		parse_ident(text) text[]
+		parse_ident(text, boolean) text[]
+		pg_client_encoding() name stable
+		quote_ident(text) text
+		quote_literal(text) text
+		quote_literal(anyelement) text stable
+		quote_nullable(text) text
+		quote_nullable(anyelement) text stable
+		regexp_count(text, text) integer since 15
+		regexp_count(text, text, integer) integer since 15
+		regexp_count(text, text, integer, text) integer since 15
+		regexp_instr(text, text) integer since 15
+		regexp_instr(text, text, integer) integer since 15
+		regexp_instr(text, text, integer, integer) integer since 15
+		regexp_instr(text, text, integer, integer, integer) integer since 15
+		regexp_instr(text, text, integer, integer, integer, text) integer since 15
+		regexp_instr(text, text, integer, integer, integer, text, integer) integer since 15
+		regexp_like(text, text) boolean since 15
+		regexp_like(text, text, text) boolean since 15
+		regexp_match(text, text) text[]
+		regexp_match(text, text, text) text[]
+		regexp_matches(text, text) SETOF text[]
+		regexp_matches(text, text, text) SETOF text[]
+		regexp_replace(text, text, text) text
+		regexp_replace(text, text, text, text) text
+		regexp_replace(text, text, text, integer) text since 16
+		regexp_replace(text, text, text, integer, integer) text since 16
+		regexp_replace(text, text, text, integer, integer, text) text since 16
+		regexp_split_to_array(text, text) text[]
+		regexp_split_to_array(text, text, text) text[]
+		regexp_split_to_table(text, text) SETOF text
+		regexp_split_to_table(text, text, text) SETOF text
+		regexp_substr(text, text) text since 15
+		regexp_substr(text, text, integer) text since 15
+		regexp_substr(text, text, integer, integer) text since 15
+		regexp_substr(text, text, integer, integer, text) text since 15
+		regexp_substr(text, text, integer, integer, text, integer) text since 15
+		repeat(text, integer) text
+		replace(text, text, text) text
+		reverse(text) text
+		right(text, integer) text
+		split_part(text, text, integer) text
+		starts_with(text, text) boolean since 11
+		string_to_array(text, text) text[]
+		string_to_array(text, text, text) text[]
+		string_to_table(text, text) SETOF text since 14
+		string_to_table(text, text, text) SETOF text since 14
+		strpos(text, text) integer
+		substr(text, integer) text
+		substr(text, integer, integer) text
+		to_ascii(text) text
+		to_ascii(text, name) text
+		to_ascii(text, integer) text
+		to_hex(integer) text
+		to_hex(bigint) text
+		translate(text, text, text) text
+		unistr(text) text since 14
+	`},
+	{BinaryStringFunction, VolatilityImmutable, `
+		bit_length(bytea) integer
+		btrim(bytea, bytea) bytea
+		ltrim(bytea, bytea) bytea since 14
+		octet_length(bytea) integer
+		overlay(bytea, bytea, integer) bytea
+		overlay(bytea, bytea, integer, integer) bytea
+		position(bytea, bytea) integer
+		rtrim(bytea, bytea) bytea since 14
+		substring(bytea, integer) bytea
+		substring(bytea, integer, integer) bytea
+		trim(bytea, bytea) bytea
+		bit_count(bytea) bigint since 14
+		get_bit(bytea, bigint) integer
+		get_byte(bytea, integer) integer
+		length(bytea) integer
+		length(bytea, name) integer stable
+		md5(bytea) text
+		set_bit(bytea, bigint, integer) bytea
+		set_byte(bytea, integer, integer) bytea
+		sha224(bytea) bytea since 11
+		sha256(bytea) bytea since 11
+		sha384(bytea) bytea since 11
+		sha512(bytea) bytea since 11
+		substr(bytea, integer) bytea
+		substr(bytea, integer, integer) bytea
+		convert(bytea, name, name) bytea stable
+		convert_from(bytea, name) text stable
+		convert_to(text, name) bytea stable
+		encode(bytea, text) text
+		decode(text, text) bytea
+	`},
+	{BitStringFunction, VolatilityImmutable, `
+		bit_count(bit) bigint since 14
+		bit_length(bit) integer
+		length(bit) integer
+		octet_length(bit) integer
+		overlay(bit, bit, integer) bit
+		overlay(bit, bit, integer, integer) bit
+		position(bit, bit) integer
+		substring(bit, integer) bit
+		substring(bit, integer, integer) bit
+		get_bit(bit, integer) integer
+		set_bit(bit, integer, integer) bit
+	`},
+	{DataTypeFormattingFunction, VolatilityStable, `
+		to_char(timestamp, text) text
+		to_char(timestamptz, text) text
+		to_char(interval, text) text
+		to_char(numeric, text) text
+		to_char(integer, text) text
+		to_char(bigint, text) text
+		to_char(real, text) text
+		to_char(double precision, text) text
+		to_date(text, text) date
+		to_number(text, text) numeric
+		to_timestamp(text, text) timestamptz
+	`},
+	{DateTimeFunction, VolatilityImmutable, `
+		age(timestamp, timestamp) interval
+		age(timestamptz, timestamptz) interval stable
+		age(timestamp) interval stable
+		age(timestamptz) interval stable
+		clock_timestamp() timestamptz volatile
+		current_time() timetz stable
+		current_time(integer) timetz stable
+		current_timestamp() timestamptz stable
+		current_timestamp(integer) timestamptz stable
+		date_add(timestamptz, interval) timestamptz stable since 16
+		date_add(timestamptz, interval, text) timestamptz since 16
+		date_bin(interval, timestamp, timestamp) timestamp since 14
+		date_bin(interval, timestamptz, timestamptz) timestamptz since 14
+		date_part(text, timestamp) double precision
+		date_part(text, timestamptz) double precision stable
+		date_part(text, interval) double precision
+		date_part(text, date) double precision
+		date_subtract(timestamptz, interval) timestamptz stable since 16
+		date_subtract(timestamptz, interval, text) timestamptz since 16
+		date_trunc(text, timestamp) timestamp
+		date_trunc(text, timestamptz) timestamptz stable
+		date_trunc(text, timestamptz, text) timestamptz since 12
+		date_trunc(text, interval) interval
+		extract(text, timestamp) numeric
+		extract(text, timestamptz) numeric stable
+		extract(text, interval) numeric
+		extract(text, date) numeric
+		extract(text, time) numeric
+		extract(text, timetz) numeric
+		isfinite(date) boolean
+		isfinite(timestamp) boolean
+		isfinite(timestamptz) boolean
+		isfinite(interval) boolean
+		justify_days(interval) interval
+		justify_hours(interval) interval
+		justify_interval(interval) interval
+		localtime() time stable
+		localtime(integer) time stable
+		localtimestamp() timestamp stable
+		localtimestamp(integer) timestamp stable
+		make_date(integer, integer, integer) date
+		make_interval(integer DEFAULT, integer DEFAULT, integer DEFAULT, integer DEFAULT, integer DEFAULT, integer DEFAULT, double precision DEFAULT) interval
+		make_time(integer, integer, double precision) time
+		make_timestamp(integer, integer, integer, integer, integer, double precision) timestamp
+		make_timestamptz(integer, integer, integer, integer, integer, double precision) timestamptz stable
+		make_timestamptz(integer, integer, integer, integer, integer, double precision, text) timestamptz stable
+		now() timestamptz stable
+		statement_timestamp() timestamptz stable
+		timeofday() text volatile
+		transaction_timestamp() timestamptz stable
+		to_timestamp(double precision) timestamptz
+	`},
+	{EnumSupportFunction, VolatilityStable, `
+		enum_first(anyenum) anyenum
+		enum_last(anyenum) anyenum
+		enum_range(anyenum) anyarray
+		enum_range(anyenum, anyenum) anyarray
+	`},
+	{GeometricFunction, VolatilityImmutable, `
+		area(box) double precision
+		area(path) double precision
+		area(circle) double precision
+		center(box) point
+		center(circle) point
+		diagonal(box) lseg
+		diameter(circle) double precision
+		height(box) double precision
+		isclosed(path) boolean
+		isopen(path) boolean
+		length(lseg) double precision
+		length(path) double precision
+		npoints(path) integer
+		npoints(polygon) integer
+		pclose(path) path
+		popen(path) path
+		radius(circle) double precision
+		slope(point, point) double precision
+		width(box) double precision
+		box(circle) box
+		box(point) box
+		box(point, point) box
+		box(polygon) box
+		bound_box(box, box) box
+		circle(box) circle
+		circle(point, double precision) circle
+		circle(polygon) circle
+		line(point, point) line
+		lseg(box) lseg
+		lseg(point, point) lseg
+		path(polygon) path
+		point(double precision, double precision) point
+		point(box) point
+		point(circle) point
+		point(lseg) point
+		point(polygon) point
+		polygon(box) polygon
+		polygon(circle) polygon
+		polygon(integer, circle) polygon
+		polygon(path) polygon
+	`},
+	{NetworkAddressFunction, VolatilityImmutable, `
+		abbrev(inet) text
+		abbrev(cidr) text
+		broadcast(inet) inet
+		family(inet) integer
+		host(inet) text
+		hostmask(inet) inet
+		inet_merge(inet, inet) cidr
+		inet_same_family(inet, inet) boolean
+		masklen(inet) integer
+		netmask(inet) inet
+		network(inet) cidr
+		set_masklen(inet, integer) inet
+		set_masklen(cidr, integer) cidr
+		text(inet) text
+		trunc(macaddr) macaddr
+		trunc(macaddr8) macaddr8
+		macaddr8_set7bit(macaddr8) macaddr8
+	`},
+	{TextSearchFunction, VolatilityImmutable, `
+		array_to_tsvector(text[]) tsvector
+		get_current_ts_config() regconfig stable
+		length(tsvector) integer
+		numnode(tsquery) integer
+		plainto_tsquery(regconfig, text) tsquery
+		plainto_tsquery(text) tsquery stable
+		phraseto_tsquery(regconfig, text) tsquery
+		phraseto_tsquery(text) tsquery stable
+		websearch_to_tsquery(regconfig, text) tsquery since 11
+		websearch_to_tsquery(text) tsquery stable since 11
+		querytree(tsquery) text
+		setweight(tsvector, "char") tsvector
+		setweight(tsvector, "char", text[]) tsvector
+		strip(tsvector) tsvector
+		to_tsquery(regconfig, text) tsquery
+		to_tsquery(text) tsquery stable
+		to_tsvector(regconfig, text) tsvector
+		to_tsvector(text) tsvector stable
+		to_tsvector(regconfig, json) tsvector
+		to_tsvector(regconfig, jsonb) tsvector
+		to_tsvector(json) tsvector stable
+		to_tsvector(jsonb) tsvector stable
+		json_to_tsvector(regconfig, json, jsonb) tsvector since 11
+		json_to_tsvector(json, jsonb) tsvector stable since 11
+		jsonb_to_tsvector(regconfig, jsonb, jsonb) tsvector since 11
+		jsonb_to_tsvector(jsonb, jsonb) tsvector stable since 11
+		ts_delete(tsvector, text) tsvector
+		ts_delete(tsvector, text[]) tsvector
+		ts_filter(tsvector, "char"[]) tsvector
+		ts_headline(regconfig, text, tsquery) text
+		ts_headline(regconfig, text, tsquery, text) text
+		ts_headline(text, tsquery) text stable
+		ts_headline(text, tsquery, text) text stable
+		ts_headline(regconfig, json, tsquery) json
+		ts_headline(regconfig, jsonb, tsquery) jsonb
+		ts_rank(tsvector, tsquery) real
+		ts_rank(tsvector, tsquery, integer) real
+		ts_rank(real[], tsvector, tsquery) real
+		ts_rank(real[], tsvector, tsquery, integer) real
+		ts_rank_cd(tsvector, tsquery) real
+		ts_rank_cd(tsvector, tsquery, integer) real
+		ts_rank_cd(real[], tsvector, tsquery) real
+		ts_rank_cd(real[], tsvector, tsquery, integer) real
+		ts_rewrite(tsquery, tsquery, tsquery) tsquery
+		ts_rewrite(tsquery, text) tsquery volatile
+		tsquery_phrase(tsquery, tsquery) tsquery
+		tsquery_phrase(tsquery, tsquery, integer) tsquery
+		tsvector_to_array(tsvector) text[]
+		unnest(tsvector) SETOF record
+		ts_debug(regconfig, text) SETOF record stable
+		ts_debug(text) SETOF record stable
+		ts_lexize(regdictionary, text) text[] stable
+		ts_parse(text, text) SETOF record stable
+		ts_parse(oid, text) SETOF record stable
+		ts_token_type(text) SETOF record stable
+		ts_token_type(oid) SETOF record stable
+		ts_stat(text) SETOF record volatile
+		ts_stat(text, text) SETOF record volatile
+	`},
+	{UUIDFunction, VolatilityVolatile, `
+		gen_random_uuid() uuid since 13
+	`},
+	{XMLFunction, VolatilityImmutable, `
+		xmlcomment(text) xml
+		xmlconcat(VARIADIC xml) xml
+		xmlelement(VARIADIC any) xml stable
+		xmlforest(VARIADIC any) xml stable
+		xmlpi(name) xml
+		xmlpi(name, text) xml
+		xmlroot(xml, text, text) xml
+		xmlagg(xml) xml aggregate
+		XMLEXISTS(text, xml) boolean
+		xml_is_well_formed(text) boolean stable
+		xml_is_well_formed_document(text) boolean
+		xml_is_well_formed_content(text) boolean
+		xpath(text, xml) xml[]
+		xpath(text, xml, text[]) xml[]
+		xpath_exists(text, xml) boolean
+		xpath_exists(text, xml, text[]) boolean
+		table_to_xml(regclass, boolean, boolean, text) xml stable
+		query_to_xml(text, boolean, boolean, text) xml volatile
+		cursor_to_xml(refcursor, integer, boolean, boolean, text) xml volatile
+		table_to_xmlschema(regclass, boolean, boolean, text) xml stable
+		query_to_xmlschema(text, boolean, boolean, text) xml volatile
+		cursor_to_xmlschema(refcursor, boolean, boolean, text) xml volatile
+		table_to_xml_and_xmlschema(regclass, boolean, boolean, text) xml stable
+		query_to_xml_and_xmlschema(text, boolean, boolean, text) xml volatile
+		schema_to_xml(name, boolean, boolean, text) xml stable
+		schema_to_xmlschema(name, boolean, boolean, text) xml stable
+		schema_to_xml_and_xmlschema(name, boolean, boolean, text) xml stable
+		database_to_xml(boolean, boolean, text) xml stable
+		database_to_xmlschema(boolean, boolean, text) xml stable
+		database_to_xml_and_xmlschema(boolean, boolean, text) xml stable
+	`},
+	{JSONFunction, VolatilityImmutable, `
+		to_json(anyelement) json stable
+		to_jsonb(anyelement) jsonb stable
+		array_to_json(anyarray) json stable
+		array_to_json(anyarray, boolean) json stable
+		json_array() json stable since 16
+		json_array(VARIADIC any) json stable since 16
+		row_to_json(record) json stable
+		row_to_json(record, boolean) json stable
+		json_build_array() json stable
+		json_build_array(VARIADIC any) json stable
+		jsonb_build_array() jsonb stable
+		jsonb_build_array(VARIADIC any) jsonb stable
+		json_build_object() json stable
+		json_build_object(VARIADIC any) json stable
+		jsonb_build_object() jsonb stable
+		jsonb_build_object(VARIADIC any) jsonb stable
+		json_object() json stable since 16
+		json_object(VARIADIC any) json stable since 16
+		json_object(text[]) json
+		json_object(text[], text[]) json
+		jsonb_object(text[]) jsonb
+		jsonb_object(text[], text[]) jsonb
+		JSON(any) json since 17
+		JSON_SCALAR(any) json stable since 17
+		JSON_SERIALIZE(any) text since 17
+		json_array_elements(json) SETOF json
+		jsonb_array_elements(jsonb) SETOF jsonb
+		json_array_elements_text(json) SETOF text
+		jsonb_array_elements_text(jsonb) SETOF text
+		json_array_length(json) integer
+		jsonb_array_length(jsonb) integer
+		json_each(json) SETOF record
+		jsonb_each(jsonb) SETOF record
+		json_each_text(json) SETOF record
+		jsonb_each_text(jsonb) SETOF record
+		json_extract_path(json, VARIADIC text[]) json
+		jsonb_extract_path(jsonb, VARIADIC text[]) jsonb
+		json_extract_path_text(json, VARIADIC text[]) text
+		jsonb_extract_path_text(jsonb, VARIADIC text[]) text
+		json_object_keys(json) SETOF text
+		jsonb_object_keys(jsonb) SETOF text
+		json_populate_record(anyelement, json) anyelement stable
+		jsonb_populate_record(anyelement, jsonb) anyelement stable
+		json_populate_recordset(anyelement, json) SETOF anyelement stable
+		jsonb_populate_recordset(anyelement, jsonb) SETOF anyelement stable
+		json_to_record(json) record stable
+		jsonb_to_record(jsonb) record stable
+		json_to_recordset(json) SETOF record stable
+		jsonb_to_recordset(jsonb) SETOF record stable
+		jsonb_set(jsonb, text[], jsonb) jsonb
+		jsonb_set(jsonb, text[], jsonb, boolean) jsonb
+		jsonb_set_lax(jsonb, text[], jsonb, boolean, text) jsonb since 13
+		jsonb_insert(jsonb, text[], jsonb) jsonb
+		jsonb_insert(jsonb, text[], jsonb, boolean) jsonb
+		json_strip_nulls(json) json
+		jsonb_strip_nulls(jsonb) jsonb
+		jsonb_path_exists(jsonb, jsonpath) boolean since 12
+		jsonb_path_exists(jsonb, jsonpath, jsonb) boolean since 12
+		jsonb_path_exists(jsonb, jsonpath, jsonb, boolean) boolean since 12
+		jsonb_path_match(jsonb, jsonpath) boolean since 12
+		jsonb_path_match(jsonb, jsonpath, jsonb) boolean since 12
+		jsonb_path_match(jsonb, jsonpath, jsonb, boolean) boolean since 12
+		jsonb_path_query(jsonb, jsonpath) SETOF jsonb since 12
+		jsonb_path_query(jsonb, jsonpath, jsonb) SETOF jsonb since 12
+		jsonb_path_query(jsonb, jsonpath, jsonb, boolean) SETOF jsonb since 12
+		jsonb_path_query_array(jsonb, jsonpath) jsonb since 12
+		jsonb_path_query_array(jsonb, jsonpath, jsonb) jsonb since 12
+		jsonb_path_query_array(jsonb, jsonpath, jsonb, boolean) jsonb since 12
+		jsonb_path_query_first(jsonb, jsonpath) jsonb since 12
+		jsonb_path_query_first(jsonb, jsonpath, jsonb) jsonb since 12
+		jsonb_path_query_first(jsonb, jsonpath, jsonb, boolean) jsonb since 12
+		jsonb_path_exists_tz(jsonb, jsonpath, jsonb DEFAULT, boolean DEFAULT) boolean stable since 13
+		jsonb_path_match_tz(jsonb, jsonpath, jsonb DEFAULT, boolean DEFAULT) boolean stable since 13
+		jsonb_path_query_tz(jsonb, jsonpath, jsonb DEFAULT, boolean DEFAULT) SETOF jsonb stable since 13
+		jsonb_path_query_array_tz(jsonb, jsonpath, jsonb DEFAULT, boolean DEFAULT) jsonb stable since 13
+		jsonb_path_query_first_tz(jsonb, jsonpath, jsonb DEFAULT, boolean DEFAULT) jsonb stable since 13
+		JSON_EXISTS(jsonb, jsonpath) boolean since 17
+		JSON_QUERY(jsonb, jsonpath) jsonb since 17
+		JSON_VALUE(jsonb, jsonpath) text since 17
+		jsonb_pretty(jsonb) text
+		json_typeof(json) text
+		jsonb_typeof(jsonb) text
+	`},
+	{SequenceManipulationFunction, VolatilityVolatile, `
+		nextval(regclass) bigint
+		setval(regclass, bigint) bigint
+		setval(regclass, bigint, boolean) bigint
+		currval(regclass) bigint
+		lastval() bigint
+	`},
+	{ConditionalFunction, VolatilityImmutable, `
+		COALESCE(VARIADIC anycompatible) anycompatible
+		NULLIF(anycompatible, anycompatible) anycompatible
+		GREATEST(VARIADIC anycompatible) anycompatible
+		LEAST(VARIADIC anycompatible) anycompatible
+	`},
+	{ArrayFunction, VolatilityImmutable, `
+		array_append(anycompatiblearray, anycompatible) anycompatiblearray
+		array_cat(anycompatiblearray, anycompatiblearray) anycompatiblearray
+		array_dims(anyarray) text
+		array_fill(anyelement, integer[]) anyarray
+		array_fill(anyelement, integer[], integer[]) anyarray
+		array_length(anyarray, integer) integer
+		array_lower(anyarray, integer) integer
+		array_ndims(anyarray) integer
+		array_position(anycompatiblearray, anycompatible) integer
+		array_position(anycompatiblearray, anycompatible, integer) integer
+		array_positions(anycompatiblearray, anycompatible) integer[]
+		array_prepend(anycompatible, anycompatiblearray) anycompatiblearray
+		array_remove(anycompatiblearray, anycompatible) anycompatiblearray
+		array_replace(anycompatiblearray, anycompatible, anycompatible) anycompatiblearray
+		array_sample(anyarray, integer) anyarray volatile since 16
+		array_shuffle(anyarray) anyarray volatile since 16
+		array_to_string(anyarray, text) text stable
+		array_to_string(anyarray, text, text) text stable
+		array_upper(anyarray, integer) integer
+		cardinality(anyarray) integer
+		trim_array(anyarray, integer) anyarray since 14
+		unnest(anyarray) SETOF anyelement
+	`},
+	{RangeFunction, VolatilityImmutable, `
+		lower(anyrange) anyelement
+		lower(anymultirange) anyelement since 14
+		upper(anyrange) anyelement
+		upper(anymultirange) anyelement since 14
+		isempty(anyrange) boolean
+		isempty(anymultirange) boolean since 14
+		lower_inc(anyrange) boolean
+		lower_inc(anymultirange) boolean since 14
+		upper_inc(anyrange) boolean
+		upper_inc(anymultirange) boolean since 14
+		lower_inf(anyrange) boolean
+		lower_inf(anymultirange) boolean since 14
+		upper_inf(anyrange) boolean
+		upper_inf(anymultirange) boolean since 14
+		range_merge(anyrange, anyrange) anyrange
+		range_merge(anymultirange) anyrange since 14
+		multirange(anyrange) anymultirange since 14
+		unnest(anymultirange) SETOF anyrange since 14
+	`},
+	{AggregateFunction, VolatilityImmutable, `
+		any_value(anyelement) anyelement since 16
+		array_agg(anynonarray) anyarray
+		array_agg(anyarray) anyarray
+		avg(smallint) numeric
+		avg(integer) numeric
+		avg(bigint) numeric
+		avg(numeric) numeric
+		avg(real) double precision
+		avg(double precision) double precision
+		avg(interval) interval
+		bit_and(smallint) smallint
+		bit_and(integer) integer
+		bit_and(bigint) bigint
+		bit_and(bit) bit
+		bit_or(smallint) smallint
+		bit_or(integer) integer
+		bit_or(bigint) bigint
+		bit_or(bit) bit
+		bit_xor(smallint) smallint since 14
+		bit_xor(integer) integer since 14
+		bit_xor(bigint) bigint since 14
+		bit_xor(bit) bit since 14
+		bool_and(boolean) boolean
+		bool_or(boolean) boolean
+		count() bigint
+		count(any) bigint
+		every(boolean) boolean
+		json_agg(anyelement) json stable
+		jsonb_agg(anyelement) jsonb stable
+		json_objectagg(text, any) json stable since 16
+		json_object_agg(any, any) json stable
+		jsonb_object_agg(any, any) jsonb stable
+		json_object_agg_strict(any, any) json stable since 16
+		jsonb_object_agg_strict(any, any) jsonb stable since 16
+		json_object_agg_unique(any, any) json stable since 16
+		jsonb_object_agg_unique(any, any) jsonb stable since 16
+		json_arrayagg(any) json stable since 16
+		json_object_agg_unique_strict(any, any) json stable since 16
+		jsonb_object_agg_unique_strict(any, any) jsonb stable since 16
+		max(anyelement) anyelement
+		min(anyelement) anyelement
+		range_agg(anyrange) anymultirange since 14
+		range_agg(anymultirange) anymultirange since 14
+		range_intersect_agg(anyrange) anyrange
+		range_intersect_agg(anymultirange) anymultirange since 14
+		json_agg_strict(anyelement) json stable since 16
+		jsonb_agg_strict(anyelement) jsonb stable since 16
+		string_agg(text, text) text
+		string_agg(bytea, bytea) bytea
+		sum(smallint) bigint
+		sum(integer) bigint
+		sum(bigint) numeric
+		sum(numeric) numeric
+		sum(real) real
+		sum(double precision) double precision
+		sum(interval) interval
+		sum(money) money
+		xmlagg(xml) xml
+		corr(double precision, double precision) double precision
+		covar_pop(double precision, double precision) double precision
+		covar_samp(double precision, double precision) double precision
+		regr_avgx(double precision, double precision) double precision
+		regr_avgy(double precision, double precision) double precision
+		regr_count(double precision, double precision) bigint
+		regr_intercept(double precision, double precision) double precision
+		regr_r2(double precision, double precision) double precision
+		regr_slope(double precision, double precision) double precision
+		regr_sxx(double precision, double precision) double precision
+		regr_sxy(double precision, double precision) double precision
+		regr_syy(double precision, double precision) double precision
+		stddev(numeric) numeric
+		stddev(double precision) double precision
+		stddev_pop(numeric) numeric
+		stddev_pop(double precision) double precision
+		stddev_samp(numeric) numeric
+		stddev_samp(double precision) double precision
+		variance(numeric) numeric
+		variance(double precision) double precision
+		var_pop(numeric) numeric
+		var_pop(double precision) double precision
+		var_samp(numeric) numeric
+		var_samp(double precision) double precision
+		mode() anyelement
+		percentile_cont(double precision) double precision
+		percentile_cont(double precision[]) double precision[]
+		percentile_disc(double precision) anyelement
+		percentile_disc(double precision[]) anyarray
+		rank(VARIADIC any) bigint
+		dense_rank(VARIADIC any) bigint
+		percent_rank(VARIADIC any) double precision
+		cume_dist(VARIADIC any) double precision
+		GROUPING(VARIADIC any) integer
+	`},
+	{WindowFunction, VolatilityImmutable, `
+		row_number() bigint
+		rank() bigint
+		dense_rank() bigint
+		percent_rank() double precision
+		cume_dist() double precision
+		ntile(integer) integer
+		lag(anyelement) anyelement
+		lag(anyelement, integer) anyelement
+		lag(anycompatible, integer, anycompatible) anycompatible
+		lead(anyelement) anyelement
+		lead(anyelement, integer) anyelement
+		lead(anycompatible, integer, anycompatible) anycompatible
+		first_value(anyelement) anyelement
+		last_value(anyelement) anyelement
+		nth_value(anyelement, integer) anyelement
+	`},
+	{MergeSupportFunction, VolatilityStable, `
+		merge_action() text since 17
+	`},
+	{SetReturningFunction, VolatilityImmutable, `
+		generate_series(integer, integer) SETOF integer
+		generate_series(integer, integer, integer) SETOF integer
+		generate_series(bigint, bigint) SETOF bigint
+		generate_series(bigint, bigint, bigint) SETOF bigint
+		generate_series(numeric, numeric) SETOF numeric
+		generate_series(numeric, numeric, numeric) SETOF numeric
+		generate_series(timestamp, timestamp, interval) SETOF timestamp
+		generate_series(timestamptz, timestamptz, interval) SETOF timestamptz stable
+		generate_series(timestamptz, timestamptz, interval, text) SETOF timestamptz since 16
+		generate_subscripts(anyarray, integer) SETOF integer
+		generate_subscripts(anyarray, integer, boolean) SETOF integer
+	`},
+	{SystemInformationFunction, VolatilityStable, `
+		current_database() name
+		current_query() text volatile
+		current_schema() name
+		current_schemas(boolean) name[]
+		inet_client_addr() inet
+		inet_client_port() integer
+		inet_server_addr() inet
+		inet_server_port() integer
+		pg_backend_pid() integer
+		pg_blocking_pids(integer) integer[] volatile
+		pg_conf_load_time() timestamptz
+		pg_current_logfile() text volatile
+		pg_current_logfile(text) text volatile
+		pg_my_temp_schema() oid
+		pg_is_other_temp_schema(oid) boolean
+		pg_jit_available() boolean volatile since 11
+		pg_listening_channels() SETOF text
+		pg_notification_queue_usage() double precision volatile
+		pg_postmaster_start_time() timestamptz
+		pg_safe_snapshot_blocking_pids(integer) integer[] volatile
+		pg_trigger_depth() integer
+		version() text
+		has_any_column_privilege(text, text) boolean
+		has_any_column_privilege(oid, text) boolean
+		has_any_column_privilege(name, text, text) boolean
+		has_any_column_privilege(name, oid, text) boolean
+		has_column_privilege(text, text, text) boolean
+		has_column_privilege(oid, text, text) boolean
+		has_column_privilege(name, text, text, text) boolean
+		has_column_privilege(name, oid, text, text) boolean
+		has_database_privilege(text, text) boolean
+		has_database_privilege(oid, text) boolean
+		has_database_privilege(name, text, text) boolean
+		has_database_privilege(name, oid, text) boolean
+		has_foreign_data_wrapper_privilege(text, text) boolean
+		has_foreign_data_wrapper_privilege(oid, text) boolean
+		has_foreign_data_wrapper_privilege(name, text, text) boolean
+		has_foreign_data_wrapper_privilege(name, oid, text) boolean
+		has_function_privilege(text, text) boolean
+		has_function_privilege(oid, text) boolean
+		has_function_privilege(name, text, text) boolean
+		has_function_privilege(name, oid, text) boolean
+		has_language_privilege(text, text) boolean
+		has_language_privilege(oid, text) boolean
+		has_language_privilege(name, text, text) boolean
+		has_language_privilege(name, oid, text) boolean
+		has_parameter_privilege(text, text) boolean since 15
+		has_parameter_privilege(name, text, text) boolean since 15
+		has_parameter_privilege(oid, text, text) boolean since 15
+		has_schema_privilege(text, text) boolean
+		has_schema_privilege(oid, text) boolean
+		has_schema_privilege(name, text, text) boolean
+		has_schema_privilege(name, oid, text) boolean
+		has_sequence_privilege(text, text) boolean
+		has_sequence_privilege(oid, text) boolean
+		has_sequence_privilege(name, text, text) boolean
+		has_sequence_privilege(name, oid, text) boolean
+		has_server_privilege(text, text) boolean
+		has_server_privilege(oid, text) boolean
+		has_server_privilege(name, text, text) boolean
+		has_server_privilege(name, oid, text) boolean
+		has_table_privilege(text, text) boolean
+		has_table_privilege(oid, text) boolean
+		has_table_privilege(name, text, text) boolean
+		has_table_privilege(name, oid, text) boolean
+		has_tablespace_privilege(text, text) boolean
+		has_tablespace_privilege(oid, text) boolean
+		has_tablespace_privilege(name, text, text) boolean
+		has_tablespace_privilege(name, oid, text) boolean
+		has_type_privilege(text, text) boolean
+		has_type_privilege(oid, text) boolean
+		has_type_privilege(name, text, text) boolean
+		has_type_privilege(name, oid, text) boolean
+		pg_has_role(name, text) boolean
+		pg_has_role(oid, text) boolean
+		pg_has_role(name, name, text) boolean
+		pg_has_role(name, oid, text) boolean
+		row_security_active(text) boolean
+		row_security_active(oid) boolean
+		acldefault("char", oid) aclitem[] immutable
+		aclexplode(aclitem[]) SETOF record
+		makeaclitem(oid, oid, text, boolean) aclitem immutable
+		pg_collation_is_visible(oid) boolean
+		pg_conversion_is_visible(oid) boolean
+		pg_function_is_visible(oid) boolean
+		pg_opclass_is_visible(oid) boolean
+		pg_operator_is_visible(oid) boolean
+		pg_opfamily_is_visible(oid) boolean
+		pg_statistics_obj_is_visible(oid) boolean
+		pg_table_is_visible(oid) boolean
+		pg_ts_config_is_visible(oid) boolean
+		pg_ts_dict_is_visible(oid) boolean
+		pg_ts_parser_is_visible(oid) boolean
+		pg_ts_template_is_visible(oid) boolean
+		pg_type_is_visible(oid) boolean
+		format_type(oid, integer) text
+		pg_char_to_encoding(name) integer
+		pg_encoding_to_char(integer) name
+		pg_get_catalog_foreign_keys() SETOF record since 14
+		pg_get_constraintdef(oid) text
+		pg_get_constraintdef(oid, boolean) text
+		pg_get_expr(pg_node_tree, oid) text
+		pg_get_expr(pg_node_tree, oid, boolean) text
+		pg_get_functiondef(oid) text
+		pg_get_function_arguments(oid) text
+		pg_get_function_identity_arguments(oid) text
+		pg_get_function_result(oid) text
+		pg_get_indexdef(oid) text
+		pg_get_indexdef(oid, integer, boolean) text
+		pg_get_keywords() SETOF record
+		pg_get_partkeydef(oid) text
+		pg_get_ruledef(oid) text
+		pg_get_ruledef(oid, boolean) text
+		pg_get_serial_sequence(text, text) text
+		pg_get_statisticsobjdef(oid) text
+		pg_get_triggerdef(oid) text
+		pg_get_triggerdef(oid, boolean) text
+		pg_get_userbyid(oid) name
+		pg_get_viewdef(oid) text
+		pg_get_viewdef(oid, boolean) text
+		pg_get_viewdef(oid, integer) text
+		pg_get_viewdef(text) text
+		pg_get_viewdef(text, boolean) text
+		pg_index_column_has_property(regclass, integer, text) boolean
+		pg_index_has_property(regclass, text) boolean
+		pg_indexam_has_property(oid, text) boolean
+		pg_options_to_table(text[]) SETOF record
+		pg_settings_get_flags(text) text[] since 15
+		pg_tablespace_databases(oid) SETOF oid
+		pg_tablespace_location(oid) text
+		pg_typeof(any) regtype
+		COLLATION FOR(any) text
+		to_regclass(text) regclass
+		to_regcollation(text) regcollation since 13
+		to_regnamespace(text) regnamespace
+		to_regoper(text) regoper
+		to_regoperator(text) regoperator
+		to_regproc(text) regproc
+		to_regprocedure(text) regprocedure
+		to_regrole(text) regrole
+		to_regtype(text) regtype
+		pg_describe_object(oid, oid, integer) text
+		pg_identify_object(oid, oid, integer) record
+		pg_identify_object_as_address(oid, oid, integer) record
+		pg_get_object_address(text, text[], text[]) record
+		col_description(oid, integer) text
+		obj_description(oid) text
+		obj_description(oid, name) text
+		shobj_description(oid, name) text
+		pg_input_is_valid(text, text) boolean since 16
+		pg_input_error_info(text, text) record since 16
+		pg_current_xact_id() xid8 volatile since 13
+		pg_current_xact_id_if_assigned() xid8 volatile since 13
+		pg_xact_status(xid8) text volatile since 13
+		pg_current_snapshot() pg_snapshot since 13
+		pg_snapshot_xip(pg_snapshot) SETOF xid8 immutable since 13
+		pg_snapshot_xmax(pg_snapshot) xid8 immutable since 13
+		pg_snapshot_xmin(pg_snapshot) xid8 immutable since 13
+		pg_visible_in_snapshot(xid8, pg_snapshot) boolean immutable since 13
+		txid_current() bigint volatile
+		txid_current_if_assigned() bigint volatile
+		txid_current_snapshot() txid_snapshot
+		txid_snapshot_xip(txid_snapshot) SETOF bigint immutable
+		txid_snapshot_xmax(txid_snapshot) bigint immutable
+		txid_snapshot_xmin(txid_snapshot) bigint immutable
+		txid_visible_in_snapshot(bigint, txid_snapshot) boolean immutable
+		txid_status(bigint) text volatile
+		pg_xact_commit_timestamp(xid) timestamptz volatile
+		pg_xact_commit_timestamp_origin(xid) record volatile since 14
+		pg_last_committed_xact() record volatile
+		pg_control_checkpoint() record volatile
+		pg_control_system() record volatile
+		pg_control_init() record volatile
+		pg_control_recovery() record volatile
+	`},
+	{SystemAdministrationFunction, VolatilityVolatile, `
+		current_setting(text) text stable
+		current_setting(text, boolean) text stable
+		set_config(text, text, boolean) text
+		pg_cancel_backend(integer) boolean
+		pg_log_backend_memory_contexts(integer) boolean since 14
+		pg_reload_conf() boolean
+		pg_rotate_logfile() boolean
+		pg_terminate_backend(integer) boolean
+		pg_terminate_backend(integer, bigint) boolean since 14
+		pg_create_restore_point(text) pg_lsn
+		pg_current_wal_flush_lsn() pg_lsn since 10
+		pg_current_wal_insert_lsn() pg_lsn since 10
+		pg_current_wal_lsn() pg_lsn since 10
+		pg_backup_start(text) pg_lsn since 15
+		pg_backup_start(text, boolean) pg_lsn since 15
+		pg_backup_stop() record since 15
+		pg_backup_stop(boolean) record since 15
+		pg_switch_wal() pg_lsn since 10
+		pg_walfile_name(pg_lsn) text immutable since 10
+		pg_walfile_name_offset(pg_lsn) record immutable since 10
+		pg_split_walfile_name(text) record immutable since 16
+		pg_wal_lsn_diff(pg_lsn, pg_lsn) numeric immutable since 10
+		pg_is_in_recovery() boolean
+		pg_last_wal_receive_lsn() pg_lsn since 10
+		pg_last_wal_replay_lsn() pg_lsn since 10
+		pg_last_xact_replay_timestamp() timestamptz
+		pg_get_wal_resource_managers() SETOF record since 15
+		pg_is_wal_replay_paused() boolean since 10
+		pg_get_wal_replay_pause_state() text since 14
+		pg_promote(boolean DEFAULT, integer DEFAULT) boolean since 12
+		pg_wal_replay_pause() void since 10
+		pg_wal_replay_resume() void since 10
+		pg_export_snapshot() text
+		pg_log_standby_snapshot() pg_lsn since 16
+		pg_create_physical_replication_slot(name) record
+		pg_create_physical_replication_slot(name, boolean) record
+		pg_create_physical_replication_slot(name, boolean, boolean) record
+		pg_drop_replication_slot(name) void
+		pg_create_logical_replication_slot(name, name) record
+		pg_create_logical_replication_slot(name, name, boolean) record
+		pg_create_logical_replication_slot(name, name, boolean, boolean) record since 14
+		pg_create_logical_replication_slot(name, name, boolean, boolean, boolean) record since 17
+		pg_copy_physical_replication_slot(name, name) record since 12
+		pg_copy_physical_replication_slot(name, name, boolean) record since 12
+		pg_copy_logical_replication_slot(name, name) record since 12
+		pg_copy_logical_replication_slot(name, name, boolean) record since 12
+		pg_copy_logical_replication_slot(name, name, boolean, name) record since 12
+		pg_logical_slot_get_changes(name, pg_lsn, integer, VARIADIC text[] DEFAULT) SETOF record
+		pg_logical_slot_peek_changes(name, pg_lsn, integer, VARIADIC text[] DEFAULT) SETOF record
+		pg_logical_slot_get_binary_changes(name, pg_lsn, integer, VARIADIC text[] DEFAULT) SETOF record
+		pg_logical_slot_peek_binary_changes(name, pg_lsn, integer, VARIADIC text[] DEFAULT) SETOF record
+		pg_replication_slot_advance(name, pg_lsn) record since 11
+		pg_replication_origin_create(text) oid
+		pg_replication_origin_drop(text) void
+		pg_replication_origin_oid(text) oid stable
+		pg_replication_origin_session_setup(text) void
+		pg_replication_origin_session_reset() void
+		pg_replication_origin_session_is_setup() boolean
+		pg_replication_origin_session_progress(boolean) pg_lsn
+		pg_replication_origin_xact_setup(pg_lsn, timestamptz) void
+		pg_replication_origin_xact_reset() void
+		pg_replication_origin_advance(text, pg_lsn) void
+		pg_replication_origin_progress(text, boolean) pg_lsn
+		pg_logical_emit_message(boolean, text, text) pg_lsn
+		pg_logical_emit_message(boolean, text, bytea) pg_lsn
+		pg_logical_emit_message(boolean, text, text, boolean) pg_lsn since 17
+		pg_logical_emit_message(boolean, text, bytea, boolean) pg_lsn since 17
+		pg_column_size(any) integer stable
+		pg_column_compression(any) text stable since 14
+		pg_database_size(name) bigint
+		pg_database_size(oid) bigint
+		pg_indexes_size(regclass) bigint
+		pg_relation_size(regclass) bigint
+		pg_relation_size(regclass, text) bigint
+		pg_size_bytes(text) bigint immutable
+		pg_size_pretty(bigint) text immutable
+		pg_size_pretty(numeric) text immutable
+		pg_table_size(regclass) bigint
+		pg_tablespace_size(name) bigint
+		pg_tablespace_size(oid) bigint
+		pg_total_relation_size(regclass) bigint
+		pg_relation_filenode(regclass) oid stable
+		pg_relation_filepath(regclass) text stable
+		pg_filenode_relation(oid, oid) regclass stable
+		pg_collation_actual_version(oid) text
+		pg_database_collation_actual_version(oid) text since 15
+		pg_import_system_collations(regnamespace) integer
+		pg_partition_tree(regclass) SETOF record stable since 12
+		pg_partition_ancestors(regclass) SETOF regclass stable since 12
+		pg_partition_root(regclass) regclass stable since 12
+		brin_summarize_new_values(regclass) integer
+		brin_summarize_range(regclass, bigint) integer
+		brin_desummarize_range(regclass, bigint) void
+		gin_clean_pending_list(regclass) bigint
+		pg_ls_dir(text) SETOF text
+		pg_ls_dir(text, boolean, boolean) SETOF text
+		pg_ls_logdir() SETOF record since 10
+		pg_ls_waldir() SETOF record since 10
+		pg_ls_logicalmapdir() SETOF record since 15
+		pg_ls_logicalsnapdir() SETOF record since 15
+		pg_ls_replslotdir(text) SETOF record since 15
+		pg_ls_archive_statusdir() SETOF record since 12
+		pg_ls_tmpdir() SETOF record since 12
+		pg_ls_tmpdir(oid) SETOF record since 12
+		pg_read_file(text) text
+		pg_read_file(text, boolean) text since 13
+		pg_read_file(text, bigint, bigint) text
+		pg_read_file(text, bigint, bigint, boolean) text
+		pg_read_binary_file(text) bytea
+		pg_read_binary_file(text, boolean) bytea since 13
+		pg_read_binary_file(text, bigint, bigint) bytea
+		pg_read_binary_file(text, bigint, bigint, boolean) bytea
+		pg_stat_file(text) record
+		pg_stat_file(text, boolean) record
+		pg_advisory_lock(bigint) void
+		pg_advisory_lock(integer, integer) void
+		pg_advisory_lock_shared(bigint) void
+		pg_advisory_lock_shared(integer, integer) void
+		pg_advisory_unlock(bigint) boolean
+		pg_advisory_unlock(integer, integer) boolean
+		pg_advisory_unlock_all() void
+		pg_advisory_unlock_shared(bigint) boolean
+		pg_advisory_unlock_shared(integer, integer) boolean
+		pg_advisory_xact_lock(bigint) void
+		pg_advisory_xact_lock(integer, integer) void
+		pg_advisory_xact_lock_shared(bigint) void
+		pg_advisory_xact_lock_shared(integer, integer) void
+		pg_try_advisory_lock(bigint) boolean
+		pg_try_advisory_lock(integer, integer) boolean
+		pg_try_advisory_lock_shared(bigint) boolean
+		pg_try_advisory_lock_shared(integer, integer) boolean
+		pg_try_advisory_xact_lock(bigint) boolean
+		pg_try_advisory_xact_lock(integer, integer) boolean
+		pg_try_advisory_xact_lock_shared(bigint) boolean
+		pg_try_advisory_xact_lock_shared(integer, integer) boolean
+	`},
+	{TriggerFunction, VolatilityVolatile, `
+		suppress_redundant_updates_trigger() trigger
+		tsvector_update_trigger() trigger
+		tsvector_update_trigger_column() trigger
+	`},
+	{EventTriggerFunction, VolatilityStable, `
+		pg_event_trigger_ddl_commands() SETOF record
+		pg_event_trigger_dropped_objects() SETOF record
+		pg_event_trigger_table_rewrite_oid() oid
+		pg_event_trigger_table_rewrite_reason() integer
+	`},
+	{StatisticsFunction, VolatilityStable, `
+		pg_mcv_list_items(pg_mcv_list) SETOF record since 12
+	`},
 }
@@ -0,0 +1,110 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupBuiltinFunction(t *testing.T) {
+	functions := pgparser.LookupBuiltinFunction("Round")
+	require.Len(t, functions, 1)
+	round := functions[0]
+	require.Equal(t, pgparser.MathematicalFunction, round.Category)
+	require.Equal(t, pgparser.FunctionKindNormal, round.Kind)
+	require.Len(t, round.Signatures, 3)
+	require.Equal(t, "(numeric, integer) RETURNS numeric", round.Signatures[2].String())
+	require.Equal(t, pgparser.VolatilityImmutable, round.Signatures[2].Volatility)
+
+	// length is documented for strings, binary strings, bit strings, geometric
+	// types and text search.
+	require.Len(t, pgparser.LookupBuiltinFunction("length"), 5)
+
+	rank := pgparser.LookupBuiltinFunction("rank")
+	require.Len(t, rank, 2)
+	require.Equal(t, pgparser.FunctionKindAggregate, rank[0].Kind)
+	require.Equal(t, pgparser.FunctionKindWindow, rank[1].Kind)
+
+	xmlagg := pgparser.LookupBuiltinFunction("xmlagg")
+	require.Len(t, xmlagg, 2)
+	for _, f := range xmlagg {
+		require.Equal(t, pgparser.FunctionKindAggregate, f.Kind, f.Category.String())
+	}
+
+	now := pgparser.LookupBuiltinFunction("now")[0]
+	require.Equal(t, pgparser.VolatilityStable, now.Signatures[0].Volatility)
+	require.Equal(t, "timestamptz", now.Signatures[0].ReturnType)
+
+	series := pgparser.LookupBuiltinFunction("generate_series")[0]
+	require.True(t, series.Signatures[0].ReturnsSet)
+	require.Equal(t, "integer", series.Signatures[0].ReturnType)
+
+	require.Empty(t, pgparser.LookupBuiltinFunction("no_such_function"))
+}
+
+func TestBuiltinFunctionVersions(t *testing.T) {
+	random := pgparser.LookupBuiltinFunction("random")[0]
+	require.Equal(t, 0, random.Since)
+	require.Len(t, random.SignaturesForVersion(16), 1)
+	require.Len(t, random.SignaturesForVersion(17), 4)
+	require.Len(t, random.SignaturesForVersion(0), 4)
+
+	anyValue := pgparser.LookupBuiltinFunction("any_value")[0]
+	require.Equal(t, 16, anyValue.Since)
+	require.Empty(t, anyValue.SignaturesForVersion(15))
+
+	jsonValue := pgparser.LookupBuiltinFunction("json_value")[0]
+	require.Equal(t, pgparser.JSONFunction, jsonValue.Category)
+	require.Equal(t, 17, jsonValue.Since)
+	require.Empty(t, jsonValue.SignaturesForVersion(16))
+	require.Equal(t, "JSON_VALUE", jsonValue.Name)
+	require.Empty(t, pgparser.LookupBuiltinFunction("json_table"))
+
+	for _, name := range []string{"json_array", "json_arrayagg", "json_objectagg"} {
+		functions := pgparser.LookupBuiltinFunction(name)
+		require.Len(t, functions, 1, name)
+		require.Equal(t, 16, functions[0].Since, name)
+	}
+}
+
+func TestFunctionSignatureAccepts(t *testing.T) {
+	concat := pgparser.LookupBuiltinFunction("concat_ws")[0].Signatures[0]
+	require.True(t, concat.Variadic)
+	require.Equal(t, "(text, VARIADIC any) RETURNS text", concat.String())
+	require.False(t, concat.Accepts(1))
+	require.True(t, concat.Accepts(2))
+	require.True(t, concat.Accepts(5))
+
+	makeInterval := pgparser.LookupBuiltinFunction("make_interval")[0].Signatures[0]
+	require.Equal(t, 7, makeInterval.Defaults)
+	require.True(t, makeInterval.Accepts(0))
+	require.True(t, makeInterval.Accepts(7))
+	require.False(t, makeInterval.Accepts(8))
+
+	randomNormal := pgparser.LookupBuiltinFunction("random_normal")[0].Signatures[0]
+	require.Equal(t, "(double precision DEFAULT, double precision DEFAULT) RETURNS double precision", randomNormal.String())
+	require.True(t, randomNormal.Accepts(0))
+	require.True(t, randomNormal.Accepts(2))
+	require.False(t, randomNormal.Accepts(3))
+
+	left := pgparser.LookupBuiltinFunction("left")[0].Signatures[0]
+	require.True(t, left.Accepts(2))
+	require.False(t, left.Accepts(3))
+}
+
+func TestBuiltinFunctionCatalog(t *testing.T) {
+	names := make(map[string]bool)
+	for _, f := range pgparser.GetBuiltinFunctionCatalog() {
+		require.NotEmpty(t, f.Signatures, f.Name)
+		for _, s := range f.Signatures {
+			require.NotEmpty(t, s.ReturnType, f.Name)
+			require.GreaterOrEqual(t, s.Since, f.Since, f.Name)
+		}
+		names[f.Name] = true
+	}
+	for _, name := range pgparser.GetBuiltinFunctions() {
+		require.True(t, names[name], name)
+	}
+	require.Len(t, pgparser.GetBuiltinFunctions(), len(names))
+}
@@ -0,0 +1,41 @@
+package postgresql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/completion"
+)
+
+// completionRules are reported instead of their tokens, so that callers can
+// offer table, column and function names from a catalog. The identifier
+// rules are included because they accept most unreserved keywords, which
+// would otherwise be listed wherever a name may appear.
+var completionRules = []int{
+	PostgreSQLParserRULE_qualified_name,
+	PostgreSQLParserRULE_columnref,
+	PostgreSQLParserRULE_func_name,
+	PostgreSQLParserRULE_type_function_name,
+	PostgreSQLParserRULE_colid,
+	PostgreSQLParserRULE_collabel,
+	PostgreSQLParserRULE_nonreservedword,
+	PostgreSQLParserRULE_identifier,
+}
+
+// GetCompletionCandidates returns the keywords, built-in functions and name
+// rules valid at caretOffset, a rune offset into statement. The statement
+// does not need to be complete or valid after the caret.
+func GetCompletionCandidates(statement string, caretOffset int) *completion.Candidates {
+	lexer := NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := NewPostgreSQLParser(stream)
+
+	return completion.Collect(parser, stream, caretOffset, completion.Options{
+		PreferredRules: completionRules,
+		IsKeyword: func(text string) bool {
+			_, ok := Keywords[text]
+			return ok
+		},
+		FunctionRules: []int{PostgreSQLParserRULE_func_name},
+		Functions:     GetBuiltinFunctions(),
+	})
+}
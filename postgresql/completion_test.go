@@ -0,0 +1,27 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCompletionCandidates(t *testing.T) {
+	statement := "SELECT * FROM "
+	candidates := pgparser.GetCompletionCandidates(statement, len(statement))
+	var rules []string
+	for _, rule := range candidates.Rules {
+		rules = append(rules, rule.Name)
+	}
+	require.Contains(t, rules, "qualified_name")
+
+	statement = "SELECT "
+	candidates = pgparser.GetCompletionCandidates(statement, len(statement))
+	require.Contains(t, candidates.Keywords, "DISTINCT")
+	require.Contains(t, candidates.Functions, "abs")
+
+	statement = "SELECT a FROM t GROUP "
+	candidates = pgparser.GetCompletionCandidates(statement, len(statement))
+	require.Contains(t, candidates.Keywords, "BY")
+}
@@ -0,0 +1,197 @@
+package postgresql
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// copyStatement tracks the statement the lexer is in to find the end of the
+// line of a COPY ... FROM STDIN statement, where its data starts.
+type copyStatement struct {
+	// inStatement is whether a statement has started since the last ;.
+	inStatement bool
+	// copy is the COPY token that starts the statement, or nil.
+	copy              antlr.Token
+	fromStdin         bool
+	previousTokenType int
+	// dataPending is whether the data starts on the next line.
+	dataPending bool
+}
+
+// NextToken returns the next token. The data of a COPY ... FROM STDIN
+// statement, from the line after the statement to the \. line, is a single
+// CopyData token on the hidden channel, or goes to CopyRowHandler. If there
+// is no \. line, or a line that reads as SQL comes first, the statement has
+// no data in the script.
+func (receiver *PostgreSQLLexerBase) NextToken() antlr.Token {
+	for {
+		token := receiver.BaseLexer.NextToken()
+		state := &receiver.copyStatement
+		switch {
+		case token.GetTokenType() == PostgreSQLLexerCopyData:
+			if receiver.CopyRowHandler == nil {
+				return token
+			}
+			for _, row := range CopyDataRows(token.GetText()) {
+				receiver.CopyRowHandler(state.copy, row)
+			}
+			continue
+		case token.GetTokenType() == PostgreSQLLexerNewline && state.dataPending:
+			state.dataPending = false
+			// Without a \. line the data is not in the script, e.g. because
+			// it is sent separately, and the rest is lexed as SQL.
+			if copyDataFollows(receiver.GetInputStream(), 1) {
+				receiver.PushMode(PostgreSQLLexerCopyDataMode)
+			}
+		case token.GetChannel() == antlr.TokenDefaultChannel:
+			state.track(token)
+		}
+		return token
+	}
+}
+
+func (s *copyStatement) track(token antlr.Token) {
+	tokenType := token.GetTokenType()
+	switch {
+	case tokenType == PostgreSQLLexerMetaCommand || tokenType == PostgreSQLLexerEndMetaCommand:
+		// psql commands are not part of the statement.
+		return
+	case tokenType == PostgreSQLLexerSEMI:
+		s.dataPending = s.fromStdin
+		s.fromStdin = false
+		s.inStatement = false
+	case !s.inStatement:
+		s.inStatement = true
+		s.copy = nil
+		if tokenType == PostgreSQLLexerCOPY {
+			s.copy = token
+		}
+	case s.copy != nil && tokenType == PostgreSQLLexerSTDIN && s.previousTokenType == PostgreSQLLexerFROM:
+		s.fromStdin = true
+	}
+	s.previousTokenType = tokenType
+}
+
+// copyDataFollows reports whether the lines of input from offset, the
+// lookahead offset of the line after a COPY ... FROM STDIN statement, are COPY
+// data ending in a \. line. It stops at the first line that reads as SQL, so
+// it only looks as far ahead as the data, or the statement, that follows.
+func copyDataFollows(input antlr.CharStream, offset int) bool {
+	var line []rune
+	for ; ; offset++ {
+		c := input.LA(offset)
+		if c != antlr.TokenEOF && c != '\n' {
+			line = append(line, rune(c))
+			continue
+		}
+		text := strings.TrimSuffix(string(line), "\r")
+		switch {
+		case text == `\.`:
+			return true
+		case c == antlr.TokenEOF || isSQLLine(text):
+			return false
+		}
+		line = line[:0]
+	}
+}
+
+// isSQLLine reports whether line reads as the end of an SQL statement rather
+// than a row of COPY data: it ends with a semicolon and has no tab, which
+// separates the columns of a row.
+func isSQLLine(line string) bool {
+	return strings.HasSuffix(strings.TrimSpace(line), ";") && !strings.Contains(line, "\t")
+}
+
+// GetCopyData returns the CopyData token that follows ctx in tokens, or nil if
+// ctx is not COPY ... FROM STDIN or its data went to a CopyRowHandler.
+func GetCopyData(tokens antlr.TokenStream, ctx ICopystmtContext) antlr.Token {
+	for i := ctx.GetStop().GetTokenIndex() + 1; i < tokens.Size(); i++ {
+		token := tokens.Get(i)
+		switch {
+		case token.GetTokenType() == PostgreSQLLexerCopyData:
+			return token
+		case token.GetChannel() == antlr.TokenDefaultChannel && token.GetTokenType() != PostgreSQLLexerSEMI:
+			return nil
+		}
+	}
+	return nil
+}
+
+// CopyDataRows splits the text of a CopyData token into its rows, without
+// their line terminators and the \. line.
+func CopyDataRows(data string) []string {
+	data = strings.TrimSuffix(data, `\.`)
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	if data == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(data, "\n"), "\n")
+}
+
+// SplitCopyRow splits a row of COPY text format data into its column values,
+// with their backslash escapes decoded. A NULL, \N, is nil.
+func SplitCopyRow(row string) []*string {
+	var result []*string
+	for _, field := range strings.Split(row, "\t") {
+		if field == `\N` {
+			result = append(result, nil)
+			continue
+		}
+		value := unescapeCopyField(field)
+		result = append(result, &value)
+	}
+	return result
+}
+
+func unescapeCopyField(field string) string {
+	if !strings.Contains(field, `\`) {
+		return field
+	}
+	var b strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] != '\\' || i+1 == len(field) {
+			b.WriteByte(field[i])
+			continue
+		}
+		i++
+		switch c := field[i]; {
+		case c == 'b':
+			b.WriteByte('\b')
+		case c == 'f':
+			b.WriteByte('\f')
+		case c == 'n':
+			b.WriteByte('\n')
+		case c == 'r':
+			b.WriteByte('\r')
+		case c == 't':
+			b.WriteByte('\t')
+		case c == 'v':
+			b.WriteByte('\v')
+		case c >= '0' && c <= '7':
+			end := i + 1
+			for end < len(field) && end < i+3 && field[end] >= '0' && field[end] <= '7' {
+				end++
+			}
+			value, _ := strconv.ParseUint(field[i:end], 8, 8)
+			b.WriteByte(byte(value))
+			i = end - 1
+		case c == 'x' && i+1 < len(field) && isHexDigit(field[i+1]):
+			end := i + 2
+			if end < len(field) && isHexDigit(field[end]) {
+				end++
+			}
+			value, _ := strconv.ParseUint(field[i+1:end], 16, 8)
+			b.WriteByte(byte(value))
+			i = end - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
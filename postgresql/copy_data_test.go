@@ -0,0 +1,91 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+const pgDump = `--
+-- Data for Name: users; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.users (id, name, note) FROM stdin;
+1	Alice	it's "fine"; really
+2	Bob	\N
+3	Carol	tab\there\\
+\.
+
+
+SELECT pg_catalog.setval('public.users_id_seq', 3, true);
+`
+
+func TestCopyData(t *testing.T) {
+	lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream(pgDump))
+	tokens := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := pgparser.NewPostgreSQLParser(tokens)
+	collector := &syntaxErrorCollector{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(collector)
+	tree := parser.Root()
+	require.Empty(t, collector.messages)
+
+	stmts := tree.Stmtblock().Stmtmulti().AllStmt()
+	require.Len(t, stmts, 2)
+	require.NotNil(t, stmts[1].Selectstmt())
+	data := pgparser.GetCopyData(tokens, stmts[0].Copystmt())
+	require.NotNil(t, data)
+	require.Equal(t, 6, data.GetLine())
+
+	rows := pgparser.CopyDataRows(data.GetText())
+	require.Len(t, rows, 3)
+	values := pgparser.SplitCopyRow(rows[0])
+	require.Equal(t, `it's "fine"; really`, *values[2])
+	values = pgparser.SplitCopyRow(rows[1])
+	require.Nil(t, values[2])
+	values = pgparser.SplitCopyRow(rows[2])
+	require.Equal(t, "tab\there\\", *values[2])
+
+	// COPY TO STDOUT has no data.
+	_, tree = parseStatement("COPY users TO stdout;\nSELECT 1;")
+	require.Len(t, tree.Stmtblock().Stmtmulti().AllStmt(), 2)
+
+	// Without a \. line before the next statement, the data of COPY FROM
+	// STDIN is not in the script.
+	lexer = pgparser.NewPostgreSQLLexer(antlr.NewInputStream("COPY a FROM stdin;\nSELECT 1;\nCOPY b FROM stdin;\n1\n\\.\nSELECT 2;\nCOPY c FROM stdin;\n"))
+	tokens = antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser = pgparser.NewPostgreSQLParser(tokens)
+	collector = &syntaxErrorCollector{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(collector)
+	tree = parser.Root()
+	require.Empty(t, collector.messages)
+	stmts = tree.Stmtblock().Stmtmulti().AllStmt()
+	require.Len(t, stmts, 5)
+	require.NotNil(t, stmts[1].Selectstmt())
+	require.Nil(t, pgparser.GetCopyData(tokens, stmts[0].Copystmt()))
+	data = pgparser.GetCopyData(tokens, stmts[2].Copystmt())
+	require.NotNil(t, data)
+	require.Equal(t, []string{"1"}, pgparser.CopyDataRows(data.GetText()))
+	require.NotNil(t, stmts[3].Selectstmt())
+	require.Nil(t, pgparser.GetCopyData(tokens, stmts[4].Copystmt()))
+}
+
+func TestCopyRowHandler(t *testing.T) {
+	lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream(pgDump))
+	var rows []string
+	lexer.CopyRowHandler = func(copy antlr.Token, row string) {
+		require.Equal(t, 5, copy.GetLine())
+		rows = append(rows, row)
+	}
+	tokens := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := pgparser.NewPostgreSQLParser(tokens)
+	parser.RemoveErrorListeners()
+	tree := parser.Root()
+
+	require.Len(t, rows, 3)
+	require.Equal(t, "2\tBob\t\\N", rows[1])
+	require.Nil(t, pgparser.GetCopyData(tokens, tree.Stmtblock().Stmtmulti().Stmt(0).Copystmt()))
+}
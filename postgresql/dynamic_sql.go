@@ -0,0 +1,163 @@
+package postgresql
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// DynamicSQLPlaceholder stands in for the parts of a dynamic SQL command that
+// are only known at run time, such as a concatenated variable or a format()
+// argument. It is an identifier, so it parses wherever a name or a value can
+// appear.
+const DynamicSQLPlaceholder = "__dynamic__"
+
+// dynamicSQLSource rebuilds the command string of an EXECUTE from the string
+// constants and format() templates in command, and maps it to the script.
+// parent maps the text command was parsed from to the script; it is nil if
+// command was parsed from the script itself. It returns false if the command
+// has no constant part.
+func dynamicSQLSource(command antlr.ParserRuleContext, parent *sourceMap) (string, *sourceMap, bool) {
+	b := &dynamicSQLBuilder{parent: parent}
+	b.expression(command)
+	if !b.constant {
+		return "", nil, false
+	}
+	positions := append(b.positions, sourcePositionOf(command.GetStop(), parent))
+	return string(b.text), &sourceMap{positions: positions}, true
+}
+
+type dynamicSQLBuilder struct {
+	parent    *sourceMap
+	text      []rune
+	positions []sourcePosition
+	// constant is whether any part of the command is known.
+	constant bool
+}
+
+// expression appends the command string node evaluates to.
+func (b *dynamicSQLBuilder) expression(node antlr.ParserRuleContext) {
+	for current := node; ; {
+		switch n := current.(type) {
+		case *A_expr_qual_opContext:
+			if isConcatenation(n) {
+				for _, operand := range n.AllA_expr_unary_qualop() {
+					b.expression(operand)
+				}
+				return
+			}
+		case *AexprconstContext:
+			if sConstContext, ok := n.Sconst().(*SconstContext); ok && n.GetChildCount() == 1 {
+				b.add(routineBodySource(sConstContext, b.parent))
+				b.constant = true
+				return
+			}
+		case *Func_applicationContext:
+			if template := formatTemplate(n); template != nil {
+				b.format(routineBodySource(template, b.parent))
+				b.constant = true
+				return
+			}
+		}
+		if current.GetChildCount() != 1 {
+			break
+		}
+		child, ok := current.GetChild(0).(antlr.ParserRuleContext)
+		if !ok {
+			break
+		}
+		current = child
+	}
+	b.placeholder(sourcePositionOf(node.GetStart(), b.parent))
+}
+
+func (b *dynamicSQLBuilder) add(text string, m *sourceMap) {
+	for i, r := range []rune(text) {
+		b.text = append(b.text, r)
+		b.positions = append(b.positions, m.position(i))
+	}
+}
+
+func (b *dynamicSQLBuilder) placeholder(position sourcePosition) {
+	for _, r := range DynamicSQLPlaceholder {
+		b.text = append(b.text, r)
+		b.positions = append(b.positions, position)
+	}
+}
+
+// format appends a format() template with its format specifiers replaced by
+// placeholders.
+func (b *dynamicSQLBuilder) format(template string, m *sourceMap) {
+	runes := []rune(template)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '%' {
+			if i+1 < len(runes) && runes[i+1] == '%' {
+				b.text = append(b.text, '%')
+				b.positions = append(b.positions, m.position(i))
+				i++
+				continue
+			}
+			if end := formatSpecifierEnd(runes, i+1); end >= 0 {
+				b.placeholder(m.position(i))
+				i = end
+				continue
+			}
+		}
+		b.text = append(b.text, runes[i])
+		b.positions = append(b.positions, m.position(i))
+	}
+}
+
+// formatSpecifierEnd returns the index of the type of the format specifier
+// that starts after the % at i-1, or -1 if there is none. A specifier is
+// %[position][flags][width]type, with type s, I or L.
+func formatSpecifierEnd(runes []rune, i int) int {
+	for ; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r >= '0' && r <= '9', r == '$', r == '-', r == '*':
+			continue
+		case r == 's', r == 'I', r == 'L':
+			return i
+		}
+		return -1
+	}
+	return -1
+}
+
+// isConcatenation reports whether every operator of n is ||.
+func isConcatenation(n *A_expr_qual_opContext) bool {
+	ops := n.AllQual_op()
+	for _, op := range ops {
+		if op.GetText() != "||" {
+			return false
+		}
+	}
+	return len(ops) > 0
+}
+
+// formatTemplate returns the template of a format() call if it is a string
+// constant.
+func formatTemplate(n *Func_applicationContext) *SconstContext {
+	parts := splitQualifiedName(n.Func_name().GetText())
+	if parts[len(parts)-1] != "format" || len(parts) > 1 && strings.Join(parts[:len(parts)-1], ".") != "pg_catalog" {
+		return nil
+	}
+	list := n.Func_arg_list()
+	if list == nil || list.Func_arg_expr(0).Param_name() != nil {
+		return nil
+	}
+	var node antlr.Tree = list.Func_arg_expr(0).A_expr()
+	for {
+		if constant, ok := node.(*AexprconstContext); ok {
+			if constant.GetChildCount() != 1 {
+				return nil
+			}
+			template, _ := constant.Sconst().(*SconstContext)
+			return template
+		}
+		if node.GetChildCount() != 1 {
+			return nil
+		}
+		node = node.GetChild(0)
+	}
+}
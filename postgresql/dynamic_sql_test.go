@@ -0,0 +1,97 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+type dynexecuteCollector struct {
+	*pgparser.BasePostgreSQLParserListener
+
+	executes []*pgparser.Stmt_dynexecuteContext
+}
+
+func (c *dynexecuteCollector) EnterStmt_dynexecute(ctx *pgparser.Stmt_dynexecuteContext) {
+	c.executes = append(c.executes, ctx)
+}
+
+func collectDynexecutes(tree antlr.Tree) []*pgparser.Stmt_dynexecuteContext {
+	collector := &dynexecuteCollector{BasePostgreSQLParserListener: &pgparser.BasePostgreSQLParserListener{}}
+	antlr.ParseTreeWalkerDefault.Walk(collector, tree)
+	return collector.executes
+}
+
+func TestParseDynamicSQL(t *testing.T) {
+	parser, tree := parseStatement(`CREATE FUNCTION f(t text) RETURNS void AS $$
+BEGIN
+  EXECUTE 'ALTER TABLE ' || quote_ident(t) || ' ADD COLUMN c int';
+  EXECUTE format('DROP TABLE IF EXISTS %I.%I', 'public', t);
+  EXECUTE t;
+  EXECUTE 'SELEC ' || t;
+END;
+$$ LANGUAGE plpgsql;`)
+	require.Empty(t, parser.ParseErrors())
+	executes := collectDynexecutes(pgparser.GetRoutineBody(firstStmt(tree).Createfunctionstmt()))
+	require.Len(t, executes, 4)
+
+	definition, errs := pgparser.GetDynamicSQL(executes[0])
+	require.Empty(t, errs)
+	root, ok := definition.(*pgparser.RootContext)
+	require.True(t, ok)
+	require.NotNil(t, firstStmt(root).Altertablestmt())
+	require.Equal(t, 3, definition.GetStart().GetLine())
+	require.Equal(t, 11, definition.GetStart().GetColumn())
+
+	definition, errs = pgparser.GetDynamicSQL(executes[1])
+	require.Empty(t, errs)
+	root, ok = definition.(*pgparser.RootContext)
+	require.True(t, ok)
+	require.NotNil(t, firstStmt(root).Dropstmt())
+	require.Equal(t, 4, definition.GetStart().GetLine())
+	require.Equal(t, 18, definition.GetStart().GetColumn())
+
+	// A command without a constant part is not parsed.
+	definition, errs = pgparser.GetDynamicSQL(executes[2])
+	require.Nil(t, definition)
+	require.Empty(t, errs)
+
+	// Syntax errors are attached to the EXECUTE, not reported for the script.
+	definition, errs = pgparser.GetDynamicSQL(executes[3])
+	require.Nil(t, definition)
+	require.NotEmpty(t, errs)
+	require.Equal(t, 6, errs[0].Line)
+
+	// EXECUTE in a DO block is parsed as well.
+	parser, tree = parseStatement("DO $$BEGIN EXECUTE format('TRUNCATE %s', 'audit_' || 1); END$$;")
+	require.Empty(t, parser.ParseErrors())
+	executes = collectDynexecutes(pgparser.GetDoBody(firstStmt(tree).Dostmt()))
+	require.Len(t, executes, 1)
+	definition, _ = pgparser.GetDynamicSQL(executes[0])
+	root, ok = definition.(*pgparser.RootContext)
+	require.True(t, ok)
+	require.NotNil(t, firstStmt(root).Truncatestmt())
+}
+
+func TestParseDynamicSQLVersionErrors(t *testing.T) {
+	lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream("DO $$BEGIN EXECUTE 'MERGE INTO t USING s ON true WHEN MATCHED THEN DELETE'; END$$;"))
+	parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.TargetVersion = 14
+	parser.RemoveErrorListeners()
+	tree := parser.Root()
+	require.Empty(t, parser.ParseErrors())
+
+	// Version errors are reported apart from syntax errors and keep the tree.
+	executes := collectDynexecutes(pgparser.GetDoBody(firstStmt(tree).Dostmt()))
+	require.Len(t, executes, 1)
+	definition, errs := pgparser.GetDynamicSQL(executes[0])
+	require.Empty(t, errs)
+	root, ok := definition.(*pgparser.RootContext)
+	require.True(t, ok)
+	require.NotNil(t, firstStmt(root).Mergestmt())
+	versionErrors := pgparser.GetDynamicSQLVersionErrors(executes[0])
+	require.Len(t, versionErrors, 1)
+	require.Equal(t, "line 1:20 MERGE requires PostgreSQL 15 or later", versionErrors[0].Error())
+}
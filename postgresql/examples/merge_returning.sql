@@ -0,0 +1,43 @@
+MERGE INTO products p
+USING new_products n
+ON p.product_no = n.product_no
+WHEN NOT MATCHED THEN
+  INSERT VALUES (n.product_no, n.name, n.price)
+WHEN MATCHED THEN
+  UPDATE SET name = n.name, price = n.price
+RETURNING merge_action(), p.*;
+
+WITH src AS (
+  SELECT id, value FROM staging WHERE batch = 42
+)
+MERGE INTO target t
+USING src s
+ON t.id = s.id
+WHEN MATCHED THEN
+  UPDATE SET value = s.value
+WHEN NOT MATCHED BY SOURCE THEN
+  DELETE
+WHEN NOT MATCHED THEN
+  INSERT (id, value) VALUES (s.id, s.value)
+RETURNING merge_action() AS action, t.id, s.value AS new_value;
+
+WITH changed AS (
+  MERGE INTO inventory i
+  USING deliveries d
+  ON i.item_id = d.item_id
+  WHEN MATCHED THEN
+    UPDATE SET quantity = i.quantity + d.quantity
+  WHEN NOT MATCHED THEN
+    INSERT (item_id, quantity) VALUES (d.item_id, d.quantity)
+  RETURNING merge_action() AS action, i.item_id
+)
+SELECT action, count(*) FROM changed GROUP BY action;
+
+PREPARE upsert_price (int, numeric) AS
+MERGE INTO prices p
+USING (VALUES ($1, $2)) AS v (product_no, price)
+ON p.product_no = v.product_no
+WHEN MATCHED THEN
+  UPDATE SET price = v.price
+WHEN NOT MATCHED THEN
+  INSERT VALUES (v.product_no, v.price);
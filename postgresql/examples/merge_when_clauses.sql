@@ -0,0 +1,57 @@
+MERGE INTO customer_account ca
+USING recent_transactions t
+ON t.customer_id = ca.customer_id
+WHEN MATCHED AND t.transaction_value = 0 THEN
+  DO NOTHING
+WHEN MATCHED AND ca.balance + t.transaction_value < 0 THEN
+  DELETE
+WHEN MATCHED THEN
+  UPDATE SET balance = balance + transaction_value
+WHEN NOT MATCHED AND t.transaction_value > 0 THEN
+  INSERT (customer_id, balance)
+  VALUES (t.customer_id, t.transaction_value)
+WHEN NOT MATCHED THEN
+  DO NOTHING;
+
+MERGE INTO ONLY wines AS w
+USING wine_stock_changes s
+ON s.winename = w.winename
+WHEN NOT MATCHED BY TARGET AND s.stock_delta > 0 THEN
+  INSERT VALUES (s.winename, s.stock_delta)
+WHEN MATCHED THEN
+  UPDATE SET stock = w.stock + s.stock_delta
+WHEN NOT MATCHED BY SOURCE AND w.stock = 0 THEN
+  DELETE
+WHEN NOT MATCHED BY SOURCE THEN
+  UPDATE SET stock = 0;
+
+MERGE INTO station_data_actual sda
+USING station_data_new sdn
+ON sda.station_id = sdn.station_id
+WHEN NOT MATCHED THEN
+  INSERT DEFAULT VALUES;
+
+MERGE INTO accounts a
+USING (SELECT id, owner FROM staged_accounts) AS s (id, owner)
+ON a.id = s.id
+WHEN NOT MATCHED THEN
+  INSERT (id, owner) OVERRIDING SYSTEM VALUE VALUES (s.id, s.owner)
+WHEN NOT MATCHED BY SOURCE THEN
+  DO NOTHING;
+
+MERGE INTO accounts a
+USING staged_accounts s
+ON a.id = s.id
+WHEN NOT MATCHED THEN
+  INSERT OVERRIDING USER VALUE VALUES (DEFAULT, s.owner);
+
+MERGE INTO measurement m
+USING new_measurement nm
+ON m.city_id = nm.city_id AND m.logdate = nm.logdate
+WHEN MATCHED AND nm.peaktemp IS NULL THEN
+  DELETE
+WHEN MATCHED THEN
+  UPDATE SET (peaktemp, unitsales) = (greatest(m.peaktemp, nm.peaktemp), m.unitsales + coalesce(nm.unitsales, 0))
+WHEN NOT MATCHED THEN
+  INSERT (city_id, logdate, peaktemp, unitsales)
+  VALUES (nm.city_id, nm.logdate, nm.peaktemp, nm.unitsales);
@@ -0,0 +1,40 @@
+-- JSON constructors
+SELECT JSON_OBJECT();
+SELECT JSON_OBJECT(RETURNING jsonb);
+SELECT JSON_OBJECT('a': 1, 'b': 'two');
+SELECT JSON_OBJECT('a' VALUE 1, 'b' VALUE NULL ABSENT ON NULL);
+SELECT JSON_OBJECT('a' VALUE 1, 'a' VALUE 2 NULL ON NULL WITHOUT UNIQUE KEYS);
+SELECT JSON_OBJECT('a': '{"x": 1}' FORMAT JSON WITH UNIQUE KEYS RETURNING jsonb);
+SELECT JSON_OBJECT('a': 1 RETURNING text FORMAT JSON ENCODING UTF8);
+SELECT json_object('{a,1,b,2}');
+SELECT json_object('{a,b}', '{1,2}');
+SELECT JSON_ARRAY();
+SELECT JSON_ARRAY(RETURNING jsonb);
+SELECT JSON_ARRAY(1, 'two', NULL, '[3]' FORMAT JSON);
+SELECT JSON_ARRAY(1, NULL, 3 NULL ON NULL RETURNING jsonb);
+SELECT JSON_ARRAY(SELECT i FROM generate_series(1, 3) i ORDER BY i DESC);
+SELECT JSON('{"a": 1}');
+SELECT JSON('{"a": 1, "a": 2}' WITH UNIQUE KEYS);
+SELECT JSON('{"a": 1}' FORMAT JSON WITHOUT UNIQUE);
+SELECT JSON_SCALAR(123.45), JSON_SCALAR(now()), JSON_SCALAR(NULL);
+SELECT JSON_SERIALIZE('{"a": 1}' RETURNING bytea);
+SELECT JSON_SERIALIZE(JSON('[1, 2]') RETURNING text FORMAT JSON);
+SELECT '{}'::json, CAST('[]' AS jsonb);
+
+-- JSON aggregates
+SELECT JSON_OBJECTAGG(k: v) FROM (VALUES ('a', 1), ('b', 2)) t(k, v);
+SELECT JSON_OBJECTAGG(k VALUE v ABSENT ON NULL WITH UNIQUE KEYS RETURNING jsonb) FROM t;
+SELECT JSON_ARRAYAGG(v) FROM t;
+SELECT JSON_ARRAYAGG(v ORDER BY v DESC NULL ON NULL RETURNING jsonb) FROM t;
+SELECT JSON_ARRAYAGG(v) FILTER (WHERE v > 1) OVER (PARTITION BY k) FROM t;
+SELECT k, JSON_OBJECTAGG(k: v) FILTER (WHERE v IS NOT NULL) FROM t GROUP BY k;
+
+-- IS JSON predicate
+SELECT js IS JSON, js IS NOT JSON FROM t;
+SELECT js IS JSON VALUE, js IS JSON SCALAR, js IS JSON ARRAY, js IS JSON OBJECT FROM t;
+SELECT js IS JSON OBJECT WITH UNIQUE KEYS, js IS NOT JSON ARRAY WITHOUT UNIQUE KEYS FROM t;
+SELECT * FROM t WHERE js IS JSON OBJECT AND js->>'kind' = 'event';
+
+-- keywords remain usable as names
+CREATE TABLE json_keywords (path text, keys text[], string text, scalar int, nested bool, empty bool, format text, json json);
+SELECT path, keys, string, scalar, nested, empty, format FROM json_keywords;
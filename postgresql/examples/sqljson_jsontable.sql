@@ -0,0 +1,41 @@
+SELECT *
+FROM JSON_TABLE(
+  jsonb '[{"a": 1, "b": "x"}, {"a": 2}]', '$[*]'
+  COLUMNS (
+    id FOR ORDINALITY,
+    a int PATH '$.a',
+    b text PATH '$.b' DEFAULT 'none' ON EMPTY,
+    raw jsonb FORMAT JSON PATH '$' WITH WRAPPER,
+    has_b boolean EXISTS PATH '$.b',
+    quoted text PATH '$.b' KEEP QUOTES,
+    b2 text
+  )
+) AS jt;
+
+SELECT jt.*
+FROM orders o,
+  JSON_TABLE(o.details, '$' AS root
+    PASSING 10 AS min_qty
+    COLUMNS (
+      customer text PATH '$.customer',
+      NESTED PATH '$.items[*]' AS items COLUMNS (
+        sku text PATH '$.sku',
+        qty int PATH '$.qty' NULL ON EMPTY ERROR ON ERROR,
+        NESTED '$.tags[*]' COLUMNS (
+          tag text PATH '$'
+        )
+      )
+    )
+    ERROR ON ERROR
+  ) jt;
+
+SELECT o.id, items.*
+FROM orders o
+CROSS JOIN LATERAL JSON_TABLE(o.details, '$.items[*]'
+  COLUMNS (sku text PATH '$.sku', qty int PATH '$.qty')
+) AS items (sku, qty)
+WHERE items.qty > 1;
+
+SELECT *
+FROM XMLTABLE('/rows/row' PASSING xmlparse(document '<rows><row><a>1</a></row></rows>')
+  COLUMNS a int PATH 'a', b text PATH 'b' DEFAULT 'x');
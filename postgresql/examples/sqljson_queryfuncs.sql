@@ -0,0 +1,28 @@
+SELECT JSON_EXISTS(jsonb '{"a": 1}', '$.a');
+SELECT JSON_EXISTS(jsonb '{"a": [1, 2]}', '$.a[*] ? (@ > $x)' PASSING 1 AS x);
+SELECT JSON_EXISTS(jsonb '{"a": 1}', 'strict $.b' ERROR ON ERROR);
+SELECT JSON_EXISTS(jsonb '{"a": 1}', 'strict $.b' UNKNOWN ON ERROR);
+
+SELECT JSON_VALUE(jsonb '{"a": 1}', '$.a');
+SELECT JSON_VALUE(jsonb '{"a": 1}', '$.a' RETURNING int);
+SELECT JSON_VALUE(jsonb '{"a": "2024-01-01"}', '$.a' RETURNING date DEFAULT '1970-01-01' ON EMPTY);
+SELECT JSON_VALUE(jsonb '[1, 2]', 'strict $[*]' DEFAULT 9 ON ERROR);
+SELECT JSON_VALUE(jsonb '{}', '$.a' NULL ON EMPTY ERROR ON ERROR);
+SELECT JSON_VALUE(js, '$.price' PASSING 'usd' AS currency, 2 AS digits RETURNING numeric) FROM products;
+
+SELECT JSON_QUERY(jsonb '{"a": [1, 2]}', '$.a');
+SELECT JSON_QUERY(jsonb '{"a": [1, 2]}', '$.a[*]' WITH WRAPPER);
+SELECT JSON_QUERY(jsonb '{"a": [1, 2]}', '$.a[*]' WITH CONDITIONAL ARRAY WRAPPER);
+SELECT JSON_QUERY(jsonb '{"a": [1, 2]}', '$.a[*]' WITH UNCONDITIONAL WRAPPER);
+SELECT JSON_QUERY(jsonb '{"a": [1, 2]}', '$.a' WITHOUT ARRAY WRAPPER);
+SELECT JSON_QUERY(jsonb '{"a": "x"}', '$.a' RETURNING text KEEP QUOTES);
+SELECT JSON_QUERY(jsonb '{"a": "x"}', '$.a' RETURNING text OMIT QUOTES ON SCALAR STRING);
+SELECT JSON_QUERY(jsonb '{"a": "x"}', '$.b' EMPTY ARRAY ON EMPTY);
+SELECT JSON_QUERY(jsonb '{"a": "x"}', '$.b' EMPTY OBJECT ON EMPTY EMPTY ON ERROR);
+SELECT JSON_QUERY(jsonb '{"a": "x"}', 'strict $.b' DEFAULT '"none"' ON ERROR);
+SELECT JSON_QUERY('{"a": 1}' FORMAT JSON, '$' RETURNING jsonb);
+
+SELECT id
+FROM orders
+WHERE JSON_EXISTS(details, '$.items[*] ? (@.sku == $sku)' PASSING 'A-1' AS sku)
+  AND JSON_VALUE(details, '$.total' RETURNING numeric) > 100;
@@ -234,14 +234,12 @@ CREATE TABLE log_table (tstamp timestamp default timeofday()::timestamp);
 CREATE TABLE main_table (a int unique, b int);
 
 COPY main_table (a,b) FROM stdin;
-/*
 5	10
 20	20
 30	10
 50	35
 80	15
 \.
-*/
 
 CREATE FUNCTION trigger_func() RETURNS trigger LANGUAGE plpgsql AS '
 BEGIN
@@ -282,10 +280,9 @@ ALTER TABLE main_table DROP CONSTRAINT main_table_a_key;
 
 -- COPY should fire per-row and per-statement INSERT triggers
 COPY main_table (a, b) FROM stdin;
-/*
 30	40
 50	60
-\.*/
+\.
 
 SELECT * FROM main_table ORDER BY a, b;
 
@@ -313,10 +310,9 @@ SELECT trigger_name, event_manipulation, event_object_schema, event_object_table
   ORDER BY trigger_name COLLATE "C", 2;
 INSERT INTO main_table (a) VALUES (123), (456);
 COPY main_table FROM stdin;
-/*123	999
+123	999
 456	999
 \.
-*/
 
 DELETE FROM main_table WHERE a IN (123, 456);
 UPDATE main_table SET a = 50, b = 60;
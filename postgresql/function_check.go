@@ -0,0 +1,276 @@
+package postgresql
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// FunctionCallProblem is what is wrong with a function call.
+type FunctionCallProblem int
+
+const (
+	// UnknownFunction is a call to a function that is neither built in nor
+	// created in the script.
+	UnknownFunction FunctionCallProblem = iota
+	// WrongArgumentCount is a call whose argument count matches no overload.
+	WrongArgumentCount
+)
+
+// FunctionCallError reports a function call that would fail when the script runs.
+type FunctionCallError struct {
+	Line   int
+	Column int
+	// Function is the function name as called, with its schema if given.
+	Function string
+	Problem  FunctionCallProblem
+	// Arguments is the number of arguments of the call.
+	Arguments int
+	// Expected describes the argument counts the function accepts, e.g.
+	// "1 or 2" or "at least 1". It is empty for unknown functions.
+	Expected string
+}
+
+func (e *FunctionCallError) Error() string {
+	if e.Problem == UnknownFunction {
+		return fmt.Sprintf("line %d:%d function %s does not exist", e.Line, e.Column, e.Function)
+	}
+	noun := "arguments"
+	if e.Expected == "1" {
+		noun = "argument"
+	}
+	return fmt.Sprintf("line %d:%d function %s takes %s %s, got %d", e.Line, e.Column, e.Function, e.Expected, noun, e.Arguments)
+}
+
+// CheckFunctionCalls walks a tree returned by the PostgreSQL parser and
+// reports every call to an unknown function and every call whose argument
+// count matches none of the function's overloads. Built-in functions are
+// known, as are the functions, procedures and aggregates created anywhere in
+// the tree. extra names further functions that exist in the target database,
+// such as those of installed extensions; calls to them are not checked.
+func CheckFunctionCalls(tree antlr.Tree, extra ...string) []*FunctionCallError {
+	parseTree, ok := tree.(antlr.ParseTree)
+	if !ok {
+		return nil
+	}
+	checker := &functionCallChecker{
+		BasePostgreSQLParserListener: &BasePostgreSQLParserListener{},
+		userFunctions:                make(map[string][]*FunctionSignature),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(checker, parseTree)
+
+	extraFunctions := make(map[string]bool)
+	for _, name := range extra {
+		parts := splitQualifiedName(name)
+		extraFunctions[parts[len(parts)-1]] = true
+	}
+	var result []*FunctionCallError
+	for _, call := range checker.calls {
+		if extraFunctions[call.name] {
+			continue
+		}
+		if err := checker.check(call); err != nil {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// functionStyleCasts are type names that can be called like a function to
+// cast their single argument, as in int4(x) or text(x).
+var functionStyleCasts = map[string]bool{
+	"bool": true, "bpchar": true, "bytea": true, "char": true, "cidr": true, "date": true,
+	"float4": true, "float8": true, "inet": true, "int2": true, "int4": true, "int8": true,
+	"interval": true, "json": true, "jsonb": true, "name": true, "numeric": true, "oid": true,
+	"regclass": true, "text": true, "time": true, "timestamp": true, "timestamptz": true,
+	"timetz": true, "uuid": true, "varchar": true,
+}
+
+type functionCall struct {
+	schema    string
+	name      string
+	arguments int
+	token     antlr.Token
+}
+
+type functionCallChecker struct {
+	*BasePostgreSQLParserListener
+
+	// userFunctions are the functions created in the tree, by unqualified name.
+	userFunctions map[string][]*FunctionSignature
+	calls         []*functionCall
+}
+
+func (c *functionCallChecker) EnterCreatefunctionstmt(ctx *CreatefunctionstmtContext) {
+	signature := &FunctionSignature{}
+	if list := ctx.Func_args_with_defaults().Func_args_with_defaults_list(); list != nil {
+		for _, arg := range list.AllFunc_arg_with_default() {
+			if addInputArgument(signature, arg.Func_arg()) && arg.A_expr() != nil {
+				signature.Defaults++
+			}
+		}
+	}
+	c.define(ctx.Func_name().GetText(), signature)
+}
+
+func (c *functionCallChecker) EnterDefinestmt(ctx *DefinestmtContext) {
+	if ctx.AGGREGATE() == nil {
+		return
+	}
+	args := ctx.Aggr_args()
+	if args == nil {
+		// The old syntax names the argument type in the definition; accept any call.
+		c.define(ctx.Func_name().GetText(), &FunctionSignature{Variadic: true})
+		return
+	}
+	signature := &FunctionSignature{}
+	// Only the direct arguments of an ordered-set aggregate, the ones before
+	// ORDER BY, are passed in the call's parentheses.
+	if lists := args.AllAggr_args_list(); len(lists) > 0 && (args.ORDER() == nil || len(lists) == 2) {
+		for _, arg := range lists[0].AllAggr_arg() {
+			addInputArgument(signature, arg.Func_arg())
+		}
+	}
+	c.define(ctx.Func_name().GetText(), signature)
+}
+
+func (c *functionCallChecker) EnterFunc_application(ctx *Func_applicationContext) {
+	parts := splitQualifiedName(ctx.Func_name().GetText())
+	call := &functionCall{
+		name:  parts[len(parts)-1],
+		token: ctx.GetStart(),
+	}
+	if len(parts) > 1 {
+		call.schema = strings.Join(parts[:len(parts)-1], ".")
+	}
+	if list := ctx.Func_arg_list(); list != nil {
+		call.arguments = len(list.AllFunc_arg_expr())
+	}
+	if ctx.Func_arg_expr() != nil {
+		call.arguments++
+	}
+	c.calls = append(c.calls, call)
+}
+
+func (c *functionCallChecker) define(name string, signature *FunctionSignature) {
+	parts := splitQualifiedName(name)
+	key := parts[len(parts)-1]
+	c.userFunctions[key] = append(c.userFunctions[key], signature)
+}
+
+func (c *functionCallChecker) check(call *functionCall) *FunctionCallError {
+	signatures := c.userFunctions[call.name]
+	if call.schema == "" || call.schema == "pg_catalog" {
+		for _, f := range LookupBuiltinFunction(call.name) {
+			signatures = append(signatures, f.Signatures...)
+		}
+		if functionStyleCasts[call.name] {
+			signatures = append(signatures, &FunctionSignature{Arguments: []string{call.name}, ReturnType: call.name})
+		}
+	}
+
+	function := call.name
+	if call.schema != "" {
+		function = call.schema + "." + call.name
+	}
+	err := &FunctionCallError{
+		Line:      call.token.GetLine(),
+		Column:    call.token.GetColumn(),
+		Function:  function,
+		Arguments: call.arguments,
+	}
+	if len(signatures) == 0 {
+		err.Problem = UnknownFunction
+		return err
+	}
+	for _, signature := range signatures {
+		if signature.Accepts(call.arguments) {
+			return nil
+		}
+	}
+	err.Problem = WrongArgumentCount
+	err.Expected = expectedArguments(signatures)
+	return err
+}
+
+// addInputArgument adds arg to signature unless it is an OUT argument, and
+// reports whether it was added.
+func addInputArgument(signature *FunctionSignature, arg IFunc_argContext) bool {
+	if class := arg.Arg_class(); class != nil {
+		if class.OUT_P() != nil && class.IN_P() == nil {
+			return false
+		}
+		if class.VARIADIC() != nil {
+			signature.Variadic = true
+		}
+	}
+	start, stop := arg.Func_type().GetStart(), arg.Func_type().GetStop()
+	signature.Arguments = append(signature.Arguments, start.GetInputStream().GetTextFromInterval(antlr.NewInterval(start.GetStart(), stop.GetStop())))
+	return true
+}
+
+// expectedArguments describes the argument counts signatures accept, e.g. "1 or 2".
+func expectedArguments(signatures []*FunctionSignature) string {
+	atLeast := -1
+	counts := make(map[int]bool)
+	for _, signature := range signatures {
+		required := len(signature.Arguments) - signature.Defaults
+		if signature.Variadic {
+			if atLeast < 0 || required < atLeast {
+				atLeast = required
+			}
+			continue
+		}
+		for n := required; n <= len(signature.Arguments); n++ {
+			counts[n] = true
+		}
+	}
+
+	var sorted []int
+	for n := range counts {
+		if atLeast < 0 || n < atLeast {
+			sorted = append(sorted, n)
+		}
+	}
+	sort.Ints(sorted)
+	var parts []string
+	for _, n := range sorted {
+		parts = append(parts, strconv.Itoa(n))
+	}
+	if atLeast >= 0 {
+		parts = append(parts, fmt.Sprintf("at least %d", atLeast))
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " or " + parts[len(parts)-1]
+}
+
+// splitQualifiedName splits a possibly qualified name into its parts. Unquoted
+// ASCII letters are folded to lower case, as PostgreSQL does.
+func splitQualifiedName(name string) []string {
+	var parts []string
+	var part strings.Builder
+	quoted := false
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		switch {
+		case ch == '"' && quoted && i+1 < len(name) && name[i+1] == '"':
+			part.WriteByte('"')
+			i++
+		case ch == '"':
+			quoted = !quoted
+		case ch == '.' && !quoted:
+			parts = append(parts, part.String())
+			part.Reset()
+		case !quoted && ch >= 'A' && ch <= 'Z':
+			part.WriteByte(ch + 'a' - 'A')
+		default:
+			part.WriteByte(ch)
+		}
+	}
+	return append(parts, part.String())
+}
@@ -0,0 +1,59 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFunctionCalls(t *testing.T) {
+	tests := []struct {
+		statement string
+		extra     []string
+		want      []string
+	}{
+		{
+			statement: "SELECT lenght(name) FROM t;",
+			want:      []string{"line 1:7 function lenght does not exist"},
+		},
+		{
+			statement: "SELECT left(a, b, c), left(a, 1) FROM t;",
+			want:      []string{"line 1:7 function left takes 2 arguments, got 3"},
+		},
+		{
+			statement: "SELECT count(*), make_interval(days => 1), concat_ws(',', a, b), int4(a) FROM t;",
+		},
+		{
+			statement: "SELECT concat_ws(',') FROM t;",
+			want:      []string{"line 1:7 function concat_ws takes at least 2 arguments, got 1"},
+		},
+		{
+			statement: "SELECT pg_catalog.upper(a), myschema.upper(a) FROM t;",
+			want:      []string{"line 1:28 function myschema.upper does not exist"},
+		},
+		{
+			statement: `SELECT add_tax(price) FROM t;
+CREATE FUNCTION public.add_tax(amount numeric, rate numeric DEFAULT 0.2, OUT total numeric) AS $$ SELECT amount * (1 + rate) $$ LANGUAGE sql;
+SELECT add_tax(price, 0.1), public.add_tax(price, 0.1, 1) FROM t;`,
+			want: []string{"line 3:28 function public.add_tax takes 1 or 2 arguments, got 3"},
+		},
+		{
+			statement: "SELECT gen_random_bytes(16), uuid_generate_v4();",
+			extra:     []string{"pgcrypto.gen_random_bytes", "uuid_generate_v4"},
+		},
+	}
+
+	for _, test := range tests {
+		lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream(test.statement))
+		parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		tree := parser.Root()
+
+		var got []string
+		for _, err := range pgparser.CheckFunctionCalls(tree, test.extra...) {
+			got = append(got, err.Error())
+		}
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
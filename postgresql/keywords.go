@@ -1,855 +1,912 @@
 package postgresql
 
+import "strings"
+
+// KeywordCategory is the category PostgreSQL assigns a keyword in the
+// grammar, which decides where the keyword may be used as a name.
+type KeywordCategory int
+
+const (
+	// NotKeyword marks words the SQL standard reserves but PostgreSQL does
+	// not treat as keywords. They are listed in the appendix for reference.
+	NotKeyword KeywordCategory = iota
+	// UnreservedKeyword may be used as any kind of name ("non-reserved").
+	UnreservedKeyword
+	// ColNameKeyword may be used as a column or table name but not as a
+	// function or type name ("non-reserved (cannot be function or type)").
+	ColNameKeyword
+	// TypeFuncNameKeyword may be used as a function or type name but not as
+	// a column or table name ("reserved (can be function or type)").
+	TypeFuncNameKeyword
+	// ReservedKeyword may only be used as a column label ("reserved").
+	ReservedKeyword
+)
+
 // Keyword represents a SQL keyword with its reserved status.
 type Keyword struct {
 	Keyword  string
+	Category KeywordCategory
+	// Reserved is true if it's label is in ["reserved", "reserved, requires as", "reserved (can be function or type)"]
 	Reserved bool
+	// RequiresAS is true if the keyword can only be used as a column label
+	// after AS, e.g. "SELECT 1 AS year" but not "SELECT 1 year".
+	RequiresAS bool
+	// Since is the PostgreSQL major version that introduced the keyword, or
+	// 0 if it predates PostgreSQL 10.
+	Since int
 }
 
 // Retrieved from https://www.postgresql.org/docs/current/sql-keywords-appendix.html#KEYWORDS-TABLE
-// Reserved is true if it's label is in ["reserved", "reserved, requires as", "reserved (can be function or type)"]
 var Keywords = map[string]Keyword{
-	"A":                                {Keyword: "A", Reserved: false},
-	"ABORT":                            {Keyword: "ABORT", Reserved: false},
-	"ABS":                              {Keyword: "ABS", Reserved: false},
-	"ABSENT":                           {Keyword: "ABSENT", Reserved: false},
-	"ABSOLUTE":                         {Keyword: "ABSOLUTE", Reserved: false},
-	"ACCESS":                           {Keyword: "ACCESS", Reserved: false},
-	"ACCORDING":                        {Keyword: "ACCORDING", Reserved: false},
-	"ACOS":                             {Keyword: "ACOS", Reserved: false},
-	"ACTION":                           {Keyword: "ACTION", Reserved: false},
-	"ADA":                              {Keyword: "ADA", Reserved: false},
-	"ADD":                              {Keyword: "ADD", Reserved: false},
-	"ADMIN":                            {Keyword: "ADMIN", Reserved: false},
-	"AFTER":                            {Keyword: "AFTER", Reserved: false},
-	"AGGREGATE":                        {Keyword: "AGGREGATE", Reserved: false},
-	"ALL":                              {Keyword: "ALL", Reserved: true},
-	"ALLOCATE":                         {Keyword: "ALLOCATE", Reserved: false},
-	"ALSO":                             {Keyword: "ALSO", Reserved: false},
-	"ALTER":                            {Keyword: "ALTER", Reserved: false},
-	"ALWAYS":                           {Keyword: "ALWAYS", Reserved: false},
-	"ANALYSE":                          {Keyword: "ANALYSE", Reserved: true},
-	"ANALYZE":                          {Keyword: "ANALYZE", Reserved: true},
-	"AND":                              {Keyword: "AND", Reserved: true},
-	"ANY":                              {Keyword: "ANY", Reserved: true},
-	"ANY_VALUE":                        {Keyword: "ANY_VALUE", Reserved: false},
-	"ARE":                              {Keyword: "ARE", Reserved: false},
-	"ARRAY":                            {Keyword: "ARRAY", Reserved: true},
-	"ARRAY_AGG":                        {Keyword: "ARRAY_AGG", Reserved: false},
-	"ARRAY_MAX_CARDINALITY":            {Keyword: "ARRAY_MAX_CARDINALITY", Reserved: false},
-	"AS":                               {Keyword: "AS", Reserved: true},
-	"ASC":                              {Keyword: "ASC", Reserved: true},
-	"ASENSITIVE":                       {Keyword: "ASENSITIVE", Reserved: false},
-	"ASIN":                             {Keyword: "ASIN", Reserved: false},
-	"ASSERTION":                        {Keyword: "ASSERTION", Reserved: false},
-	"ASSIGNMENT":                       {Keyword: "ASSIGNMENT", Reserved: false},
-	"ASYMMETRIC":                       {Keyword: "ASYMMETRIC", Reserved: true},
-	"AT":                               {Keyword: "AT", Reserved: false},
-	"ATAN":                             {Keyword: "ATAN", Reserved: false},
-	"ATOMIC":                           {Keyword: "ATOMIC", Reserved: false},
-	"ATTACH":                           {Keyword: "ATTACH", Reserved: false},
-	"ATTRIBUTE":                        {Keyword: "ATTRIBUTE", Reserved: false},
-	"ATTRIBUTES":                       {Keyword: "ATTRIBUTES", Reserved: false},
-	"AUTHORIZATION":                    {Keyword: "AUTHORIZATION", Reserved: true},
-	"AVG":                              {Keyword: "AVG", Reserved: false},
-	"BACKWARD":                         {Keyword: "BACKWARD", Reserved: false},
-	"BASE64":                           {Keyword: "BASE64", Reserved: false},
-	"BEFORE":                           {Keyword: "BEFORE", Reserved: false},
-	"BEGIN":                            {Keyword: "BEGIN", Reserved: false},
-	"BEGIN_FRAME":                      {Keyword: "BEGIN_FRAME", Reserved: false},
-	"BEGIN_PARTITION":                  {Keyword: "BEGIN_PARTITION", Reserved: false},
-	"BERNOULLI":                        {Keyword: "BERNOULLI", Reserved: false},
-	"BETWEEN":                          {Keyword: "BETWEEN", Reserved: false},
-	"BIGINT":                           {Keyword: "BIGINT", Reserved: false},
-	"BINARY":                           {Keyword: "BINARY", Reserved: true},
-	"BIT":                              {Keyword: "BIT", Reserved: false},
-	"BIT_LENGTH":                       {Keyword: "BIT_LENGTH", Reserved: false},
-	"BLOB":                             {Keyword: "BLOB", Reserved: false},
-	"BLOCKED":                          {Keyword: "BLOCKED", Reserved: false},
-	"BOM":                              {Keyword: "BOM", Reserved: false},
-	"BOOLEAN":                          {Keyword: "BOOLEAN", Reserved: false},
-	"BOTH":                             {Keyword: "BOTH", Reserved: true},
-	"BREADTH":                          {Keyword: "BREADTH", Reserved: false},
-	"BTRIM":                            {Keyword: "BTRIM", Reserved: false},
-	"BY":                               {Keyword: "BY", Reserved: false},
-	"C":                                {Keyword: "C", Reserved: false},
-	"CACHE":                            {Keyword: "CACHE", Reserved: false},
-	"CALL":                             {Keyword: "CALL", Reserved: false},
-	"CALLED":                           {Keyword: "CALLED", Reserved: false},
-	"CARDINALITY":                      {Keyword: "CARDINALITY", Reserved: false},
-	"CASCADE":                          {Keyword: "CASCADE", Reserved: false},
-	"CASCADED":                         {Keyword: "CASCADED", Reserved: false},
-	"CASE":                             {Keyword: "CASE", Reserved: true},
-	"CAST":                             {Keyword: "CAST", Reserved: true},
-	"CATALOG":                          {Keyword: "CATALOG", Reserved: false},
-	"CATALOG_NAME":                     {Keyword: "CATALOG_NAME", Reserved: false},
-	"CEIL":                             {Keyword: "CEIL", Reserved: false},
-	"CEILING":                          {Keyword: "CEILING", Reserved: false},
-	"CHAIN":                            {Keyword: "CHAIN", Reserved: false},
-	"CHAINING":                         {Keyword: "CHAINING", Reserved: false},
-	"CHAR":                             {Keyword: "CHAR", Reserved: false},
-	"CHARACTER":                        {Keyword: "CHARACTER", Reserved: false},
-	"CHARACTERISTICS":                  {Keyword: "CHARACTERISTICS", Reserved: false},
-	"CHARACTERS":                       {Keyword: "CHARACTERS", Reserved: false},
-	"CHARACTER_LENGTH":                 {Keyword: "CHARACTER_LENGTH", Reserved: false},
-	"CHARACTER_SET_CATALOG":            {Keyword: "CHARACTER_SET_CATALOG", Reserved: false},
-	"CHARACTER_SET_NAME":               {Keyword: "CHARACTER_SET_NAME", Reserved: false},
-	"CHARACTER_SET_SCHEMA":             {Keyword: "CHARACTER_SET_SCHEMA", Reserved: false},
-	"CHAR_LENGTH":                      {Keyword: "CHAR_LENGTH", Reserved: false},
-	"CHECK":                            {Keyword: "CHECK", Reserved: true},
-	"CHECKPOINT":                       {Keyword: "CHECKPOINT", Reserved: false},
-	"CLASS":                            {Keyword: "CLASS", Reserved: false},
-	"CLASSIFIER":                       {Keyword: "CLASSIFIER", Reserved: false},
-	"CLASS_ORIGIN":                     {Keyword: "CLASS_ORIGIN", Reserved: false},
-	"CLOB":                             {Keyword: "CLOB", Reserved: false},
-	"CLOSE":                            {Keyword: "CLOSE", Reserved: false},
-	"CLUSTER":                          {Keyword: "CLUSTER", Reserved: false},
-	"COALESCE":                         {Keyword: "COALESCE", Reserved: false},
-	"COBOL":                            {Keyword: "COBOL", Reserved: false},
-	"COLLATE":                          {Keyword: "COLLATE", Reserved: true},
-	"COLLATION":                        {Keyword: "COLLATION", Reserved: true},
-	"COLLATION_CATALOG":                {Keyword: "COLLATION_CATALOG", Reserved: false},
-	"COLLATION_NAME":                   {Keyword: "COLLATION_NAME", Reserved: false},
-	"COLLATION_SCHEMA":                 {Keyword: "COLLATION_SCHEMA", Reserved: false},
-	"COLLECT":                          {Keyword: "COLLECT", Reserved: false},
-	"COLUMN":                           {Keyword: "COLUMN", Reserved: true},
-	"COLUMNS":                          {Keyword: "COLUMNS", Reserved: false},
-	"COLUMN_NAME":                      {Keyword: "COLUMN_NAME", Reserved: false},
-	"COMMAND_FUNCTION":                 {Keyword: "COMMAND_FUNCTION", Reserved: false},
-	"COMMAND_FUNCTION_CODE":            {Keyword: "COMMAND_FUNCTION_CODE", Reserved: false},
-	"COMMENT":                          {Keyword: "COMMENT", Reserved: false},
-	"COMMENTS":                         {Keyword: "COMMENTS", Reserved: false},
-	"COMMIT":                           {Keyword: "COMMIT", Reserved: false},
-	"COMMITTED":                        {Keyword: "COMMITTED", Reserved: false},
-	"COMPRESSION":                      {Keyword: "COMPRESSION", Reserved: false},
-	"CONCURRENTLY":                     {Keyword: "CONCURRENTLY", Reserved: true},
-	"CONDITION":                        {Keyword: "CONDITION", Reserved: false},
-	"CONDITIONAL":                      {Keyword: "CONDITIONAL", Reserved: false},
-	"CONDITION_NUMBER":                 {Keyword: "CONDITION_NUMBER", Reserved: false},
-	"CONFIGURATION":                    {Keyword: "CONFIGURATION", Reserved: false},
-	"CONFLICT":                         {Keyword: "CONFLICT", Reserved: false},
-	"CONNECT":                          {Keyword: "CONNECT", Reserved: false},
-	"CONNECTION":                       {Keyword: "CONNECTION", Reserved: false},
-	"CONNECTION_NAME":                  {Keyword: "CONNECTION_NAME", Reserved: false},
-	"CONSTRAINT":                       {Keyword: "CONSTRAINT", Reserved: true},
-	"CONSTRAINTS":                      {Keyword: "CONSTRAINTS", Reserved: false},
-	"CONSTRAINT_CATALOG":               {Keyword: "CONSTRAINT_CATALOG", Reserved: false},
-	"CONSTRAINT_NAME":                  {Keyword: "CONSTRAINT_NAME", Reserved: false},
-	"CONSTRAINT_SCHEMA":                {Keyword: "CONSTRAINT_SCHEMA", Reserved: false},
-	"CONSTRUCTOR":                      {Keyword: "CONSTRUCTOR", Reserved: false},
-	"CONTAINS":                         {Keyword: "CONTAINS", Reserved: false},
-	"CONTENT":                          {Keyword: "CONTENT", Reserved: false},
-	"CONTINUE":                         {Keyword: "CONTINUE", Reserved: false},
-	"CONTROL":                          {Keyword: "CONTROL", Reserved: false},
-	"CONVERSION":                       {Keyword: "CONVERSION", Reserved: false},
-	"CONVERT":                          {Keyword: "CONVERT", Reserved: false},
-	"COPARTITION":                      {Keyword: "COPARTITION", Reserved: false},
-	"COPY":                             {Keyword: "COPY", Reserved: false},
-	"CORR":                             {Keyword: "CORR", Reserved: false},
-	"CORRESPONDING":                    {Keyword: "CORRESPONDING", Reserved: false},
-	"COS":                              {Keyword: "COS", Reserved: false},
-	"COSH":                             {Keyword: "COSH", Reserved: false},
-	"COST":                             {Keyword: "COST", Reserved: false},
-	"COUNT":                            {Keyword: "COUNT", Reserved: false},
-	"COVAR_POP":                        {Keyword: "COVAR_POP", Reserved: false},
-	"COVAR_SAMP":                       {Keyword: "COVAR_SAMP", Reserved: false},
-	"CREATE":                           {Keyword: "CREATE", Reserved: true},
-	"CROSS":                            {Keyword: "CROSS", Reserved: true},
-	"CSV":                              {Keyword: "CSV", Reserved: false},
-	"CUBE":                             {Keyword: "CUBE", Reserved: false},
-	"CUME_DIST":                        {Keyword: "CUME_DIST", Reserved: false},
-	"CURRENT":                          {Keyword: "CURRENT", Reserved: false},
-	"CURRENT_CATALOG":                  {Keyword: "CURRENT_CATALOG", Reserved: true},
-	"CURRENT_DATE":                     {Keyword: "CURRENT_DATE", Reserved: true},
-	"CURRENT_DEFAULT_TRANSFORM_GROUP":  {Keyword: "CURRENT_DEFAULT_TRANSFORM_GROUP", Reserved: false},
-	"CURRENT_PATH":                     {Keyword: "CURRENT_PATH", Reserved: false},
-	"CURRENT_ROLE":                     {Keyword: "CURRENT_ROLE", Reserved: true},
-	"CURRENT_ROW":                      {Keyword: "CURRENT_ROW", Reserved: false},
-	"CURRENT_SCHEMA":                   {Keyword: "CURRENT_SCHEMA", Reserved: true},
-	"CURRENT_TIME":                     {Keyword: "CURRENT_TIME", Reserved: true},
-	"CURRENT_TIMESTAMP":                {Keyword: "CURRENT_TIMESTAMP", Reserved: true},
-	"CURRENT_TRANSFORM_GROUP_FOR_TYPE": {Keyword: "CURRENT_TRANSFORM_GROUP_FOR_TYPE", Reserved: false},
-	"CURRENT_USER":                     {Keyword: "CURRENT_USER", Reserved: true},
-	"CURSOR":                           {Keyword: "CURSOR", Reserved: false},
-	"CURSOR_NAME":                      {Keyword: "CURSOR_NAME", Reserved: false},
-	"CYCLE":                            {Keyword: "CYCLE", Reserved: false},
-	"DATA":                             {Keyword: "DATA", Reserved: false},
-	"DATABASE":                         {Keyword: "DATABASE", Reserved: false},
-	"DATALINK":                         {Keyword: "DATALINK", Reserved: false},
-	"DATE":                             {Keyword: "DATE", Reserved: false},
-	"DATETIME_INTERVAL_CODE":           {Keyword: "DATETIME_INTERVAL_CODE", Reserved: false},
-	"DATETIME_INTERVAL_PRECISION":      {Keyword: "DATETIME_INTERVAL_PRECISION", Reserved: false},
-	"DAY":                              {Keyword: "DAY", Reserved: false},
-	"DB":                               {Keyword: "DB", Reserved: false},
-	"DEALLOCATE":                       {Keyword: "DEALLOCATE", Reserved: false},
-	"DEC":                              {Keyword: "DEC", Reserved: false},
-	"DECFLOAT":                         {Keyword: "DECFLOAT", Reserved: false},
-	"DECIMAL":                          {Keyword: "DECIMAL", Reserved: false},
-	"DECLARE":                          {Keyword: "DECLARE", Reserved: false},
-	"DEFAULT":                          {Keyword: "DEFAULT", Reserved: true},
-	"DEFAULTS":                         {Keyword: "DEFAULTS", Reserved: false},
-	"DEFERRABLE":                       {Keyword: "DEFERRABLE", Reserved: true},
-	"DEFERRED":                         {Keyword: "DEFERRED", Reserved: false},
-	"DEFINE":                           {Keyword: "DEFINE", Reserved: false},
-	"DEFINED":                          {Keyword: "DEFINED", Reserved: false},
-	"DEFINER":                          {Keyword: "DEFINER", Reserved: false},
-	"DEGREE":                           {Keyword: "DEGREE", Reserved: false},
-	"DELETE":                           {Keyword: "DELETE", Reserved: false},
-	"DELIMITER":                        {Keyword: "DELIMITER", Reserved: false},
-	"DELIMITERS":                       {Keyword: "DELIMITERS", Reserved: false},
-	"DENSE_RANK":                       {Keyword: "DENSE_RANK", Reserved: false},
-	"DEPENDS":                          {Keyword: "DEPENDS", Reserved: false},
-	"DEPTH":                            {Keyword: "DEPTH", Reserved: false},
-	"DEREF":                            {Keyword: "DEREF", Reserved: false},
-	"DERIVED":                          {Keyword: "DERIVED", Reserved: false},
-	"DESC":                             {Keyword: "DESC", Reserved: true},
-	"DESCRIBE":                         {Keyword: "DESCRIBE", Reserved: false},
-	"DESCRIPTOR":                       {Keyword: "DESCRIPTOR", Reserved: false},
-	"DETACH":                           {Keyword: "DETACH", Reserved: false},
-	"DETERMINISTIC":                    {Keyword: "DETERMINISTIC", Reserved: false},
-	"DIAGNOSTICS":                      {Keyword: "DIAGNOSTICS", Reserved: false},
-	"DICTIONARY":                       {Keyword: "DICTIONARY", Reserved: false},
-	"DISABLE":                          {Keyword: "DISABLE", Reserved: false},
-	"DISCARD":                          {Keyword: "DISCARD", Reserved: false},
-	"DISCONNECT":                       {Keyword: "DISCONNECT", Reserved: false},
-	"DISPATCH":                         {Keyword: "DISPATCH", Reserved: false},
-	"DISTINCT":                         {Keyword: "DISTINCT", Reserved: true},
-	"DLNEWCOPY":                        {Keyword: "DLNEWCOPY", Reserved: false},
-	"DLPREVIOUSCOPY":                   {Keyword: "DLPREVIOUSCOPY", Reserved: false},
-	"DLURLCOMPLETE":                    {Keyword: "DLURLCOMPLETE", Reserved: false},
-	"DLURLCOMPLETEONLY":                {Keyword: "DLURLCOMPLETEONLY", Reserved: false},
-	"DLURLCOMPLETEWRITE":               {Keyword: "DLURLCOMPLETEWRITE", Reserved: false},
-	"DLURLPATH":                        {Keyword: "DLURLPATH", Reserved: false},
-	"DLURLPATHONLY":                    {Keyword: "DLURLPATHONLY", Reserved: false},
-	"DLURLPATHWRITE":                   {Keyword: "DLURLPATHWRITE", Reserved: false},
-	"DLURLSCHEME":                      {Keyword: "DLURLSCHEME", Reserved: false},
-	"DLURLSERVER":                      {Keyword: "DLURLSERVER", Reserved: false},
-	"DLVALUE":                          {Keyword: "DLVALUE", Reserved: false},
-	"DO":                               {Keyword: "DO", Reserved: true},
-	"DOCUMENT":                         {Keyword: "DOCUMENT", Reserved: false},
-	"DOMAIN":                           {Keyword: "DOMAIN", Reserved: false},
-	"DOUBLE":                           {Keyword: "DOUBLE", Reserved: false},
-	"DROP":                             {Keyword: "DROP", Reserved: false},
-	"DYNAMIC":                          {Keyword: "DYNAMIC", Reserved: false},
-	"DYNAMIC_FUNCTION":                 {Keyword: "DYNAMIC_FUNCTION", Reserved: false},
-	"DYNAMIC_FUNCTION_CODE":            {Keyword: "DYNAMIC_FUNCTION_CODE", Reserved: false},
-	"EACH":                             {Keyword: "EACH", Reserved: false},
-	"ELEMENT":                          {Keyword: "ELEMENT", Reserved: false},
-	"ELSE":                             {Keyword: "ELSE", Reserved: true},
-	"EMPTY":                            {Keyword: "EMPTY", Reserved: false},
-	"ENABLE":                           {Keyword: "ENABLE", Reserved: false},
-	"ENCODING":                         {Keyword: "ENCODING", Reserved: false},
-	"ENCRYPTED":                        {Keyword: "ENCRYPTED", Reserved: false},
-	"END":                              {Keyword: "END", Reserved: true},
-	"END-EXEC":                         {Keyword: "END-EXEC", Reserved: false},
-	"END_FRAME":                        {Keyword: "END_FRAME", Reserved: false},
-	"END_PARTITION":                    {Keyword: "END_PARTITION", Reserved: false},
-	"ENFORCED":                         {Keyword: "ENFORCED", Reserved: false},
-	"ENUM":                             {Keyword: "ENUM", Reserved: false},
-	"EQUALS":                           {Keyword: "EQUALS", Reserved: false},
-	"ERROR":                            {Keyword: "ERROR", Reserved: false},
-	"ESCAPE":                           {Keyword: "ESCAPE", Reserved: false},
-	"EVENT":                            {Keyword: "EVENT", Reserved: false},
-	"EVERY":                            {Keyword: "EVERY", Reserved: false},
-	"EXCEPT":                           {Keyword: "EXCEPT", Reserved: true},
-	"EXCEPTION":                        {Keyword: "EXCEPTION", Reserved: false},
-	"EXCLUDE":                          {Keyword: "EXCLUDE", Reserved: false},
-	"EXCLUDING":                        {Keyword: "EXCLUDING", Reserved: false},
-	"EXCLUSIVE":                        {Keyword: "EXCLUSIVE", Reserved: false},
-	"EXEC":                             {Keyword: "EXEC", Reserved: false},
-	"EXECUTE":                          {Keyword: "EXECUTE", Reserved: false},
-	"EXISTS":                           {Keyword: "EXISTS", Reserved: false},
-	"EXP":                              {Keyword: "EXP", Reserved: false},
-	"EXPLAIN":                          {Keyword: "EXPLAIN", Reserved: false},
-	"EXPRESSION":                       {Keyword: "EXPRESSION", Reserved: false},
-	"EXTENSION":                        {Keyword: "EXTENSION", Reserved: false},
-	"EXTERNAL":                         {Keyword: "EXTERNAL", Reserved: false},
-	"EXTRACT":                          {Keyword: "EXTRACT", Reserved: false},
-	"FALSE":                            {Keyword: "FALSE", Reserved: true},
-	"FAMILY":                           {Keyword: "FAMILY", Reserved: false},
-	"FETCH":                            {Keyword: "FETCH", Reserved: true},
-	"FILE":                             {Keyword: "FILE", Reserved: false},
-	"FILTER":                           {Keyword: "FILTER", Reserved: false},
-	"FINAL":                            {Keyword: "FINAL", Reserved: false},
-	"FINALIZE":                         {Keyword: "FINALIZE", Reserved: false},
-	"FINISH":                           {Keyword: "FINISH", Reserved: false},
-	"FIRST":                            {Keyword: "FIRST", Reserved: false},
-	"FIRST_VALUE":                      {Keyword: "FIRST_VALUE", Reserved: false},
-	"FLAG":                             {Keyword: "FLAG", Reserved: false},
-	"FLOAT":                            {Keyword: "FLOAT", Reserved: false},
-	"FLOOR":                            {Keyword: "FLOOR", Reserved: false},
-	"FOLLOWING":                        {Keyword: "FOLLOWING", Reserved: false},
-	"FOR":                              {Keyword: "FOR", Reserved: true},
-	"FORCE":                            {Keyword: "FORCE", Reserved: false},
-	"FOREIGN":                          {Keyword: "FOREIGN", Reserved: true},
-	"FORMAT":                           {Keyword: "FORMAT", Reserved: false},
-	"FORTRAN":                          {Keyword: "FORTRAN", Reserved: false},
-	"FORWARD":                          {Keyword: "FORWARD", Reserved: false},
-	"FOUND":                            {Keyword: "FOUND", Reserved: false},
-	"FRAME_ROW":                        {Keyword: "FRAME_ROW", Reserved: false},
-	"FREE":                             {Keyword: "FREE", Reserved: false},
-	"FREEZE":                           {Keyword: "FREEZE", Reserved: true},
-	"FROM":                             {Keyword: "FROM", Reserved: true},
-	"FS":                               {Keyword: "FS", Reserved: false},
-	"FULFILL":                          {Keyword: "FULFILL", Reserved: false},
-	"FULL":                             {Keyword: "FULL", Reserved: true},
-	"FUNCTION":                         {Keyword: "FUNCTION", Reserved: false},
-	"FUNCTIONS":                        {Keyword: "FUNCTIONS", Reserved: false},
-	"FUSION":                           {Keyword: "FUSION", Reserved: false},
-	"G":                                {Keyword: "G", Reserved: false},
-	"GENERAL":                          {Keyword: "GENERAL", Reserved: false},
-	"GENERATED":                        {Keyword: "GENERATED", Reserved: false},
-	"GET":                              {Keyword: "GET", Reserved: false},
-	"GLOBAL":                           {Keyword: "GLOBAL", Reserved: false},
-	"GO":                               {Keyword: "GO", Reserved: false},
-	"GOTO":                             {Keyword: "GOTO", Reserved: false},
-	"GRANT":                            {Keyword: "GRANT", Reserved: true},
-	"GRANTED":                          {Keyword: "GRANTED", Reserved: false},
-	"GREATEST":                         {Keyword: "GREATEST", Reserved: false},
-	"GROUP":                            {Keyword: "GROUP", Reserved: true},
-	"GROUPING":                         {Keyword: "GROUPING", Reserved: false},
-	"GROUPS":                           {Keyword: "GROUPS", Reserved: false},
-	"HANDLER":                          {Keyword: "HANDLER", Reserved: false},
-	"HAVING":                           {Keyword: "HAVING", Reserved: true},
-	"HEADER":                           {Keyword: "HEADER", Reserved: false},
-	"HEX":                              {Keyword: "HEX", Reserved: false},
-	"HIERARCHY":                        {Keyword: "HIERARCHY", Reserved: false},
-	"HOLD":                             {Keyword: "HOLD", Reserved: false},
-	"HOUR":                             {Keyword: "HOUR", Reserved: false},
-	"ID":                               {Keyword: "ID", Reserved: false},
-	"IDENTITY":                         {Keyword: "IDENTITY", Reserved: false},
-	"IF":                               {Keyword: "IF", Reserved: false},
-	"IGNORE":                           {Keyword: "IGNORE", Reserved: false},
-	"ILIKE":                            {Keyword: "ILIKE", Reserved: true},
-	"IMMEDIATE":                        {Keyword: "IMMEDIATE", Reserved: false},
-	"IMMEDIATELY":                      {Keyword: "IMMEDIATELY", Reserved: false},
-	"IMMUTABLE":                        {Keyword: "IMMUTABLE", Reserved: false},
-	"IMPLEMENTATION":                   {Keyword: "IMPLEMENTATION", Reserved: false},
-	"IMPLICIT":                         {Keyword: "IMPLICIT", Reserved: false},
-	"IMPORT":                           {Keyword: "IMPORT", Reserved: false},
-	"IN":                               {Keyword: "IN", Reserved: true},
-	"INCLUDE":                          {Keyword: "INCLUDE", Reserved: false},
-	"INCLUDING":                        {Keyword: "INCLUDING", Reserved: false},
-	"INCREMENT":                        {Keyword: "INCREMENT", Reserved: false},
-	"INDENT":                           {Keyword: "INDENT", Reserved: false},
-	"INDEX":                            {Keyword: "INDEX", Reserved: false},
-	"INDEXES":                          {Keyword: "INDEXES", Reserved: false},
-	"INDICATOR":                        {Keyword: "INDICATOR", Reserved: false},
-	"INHERIT":                          {Keyword: "INHERIT", Reserved: false},
-	"INHERITS":                         {Keyword: "INHERITS", Reserved: false},
-	"INITIAL":                          {Keyword: "INITIAL", Reserved: false},
-	"INITIALLY":                        {Keyword: "INITIALLY", Reserved: true},
-	"INLINE":                           {Keyword: "INLINE", Reserved: false},
-	"INNER":                            {Keyword: "INNER", Reserved: true},
-	"INOUT":                            {Keyword: "INOUT", Reserved: false},
-	"INPUT":                            {Keyword: "INPUT", Reserved: false},
-	"INSENSITIVE":                      {Keyword: "INSENSITIVE", Reserved: false},
-	"INSERT":                           {Keyword: "INSERT", Reserved: false},
-	"INSTANCE":                         {Keyword: "INSTANCE", Reserved: false},
-	"INSTANTIABLE":                     {Keyword: "INSTANTIABLE", Reserved: false},
-	"INSTEAD":                          {Keyword: "INSTEAD", Reserved: false},
-	"INT":                              {Keyword: "INT", Reserved: false},
-	"INTEGER":                          {Keyword: "INTEGER", Reserved: false},
-	"INTEGRITY":                        {Keyword: "INTEGRITY", Reserved: false},
-	"INTERSECT":                        {Keyword: "INTERSECT", Reserved: true},
-	"INTERSECTION":                     {Keyword: "INTERSECTION", Reserved: false},
-	"INTERVAL":                         {Keyword: "INTERVAL", Reserved: false},
-	"INTO":                             {Keyword: "INTO", Reserved: true},
-	"INVOKER":                          {Keyword: "INVOKER", Reserved: false},
-	"IS":                               {Keyword: "IS", Reserved: true},
-	"ISNULL":                           {Keyword: "ISNULL", Reserved: false},
-	"ISOLATION":                        {Keyword: "ISOLATION", Reserved: false},
-	"JOIN":                             {Keyword: "JOIN", Reserved: true},
-	"JSON":                             {Keyword: "JSON", Reserved: false},
-	"JSON_ARRAY":                       {Keyword: "JSON_ARRAY", Reserved: false},
-	"JSON_ARRAYAGG":                    {Keyword: "JSON_ARRAYAGG", Reserved: false},
-	"JSON_EXISTS":                      {Keyword: "JSON_EXISTS", Reserved: false},
-	"JSON_OBJECT":                      {Keyword: "JSON_OBJECT", Reserved: false},
-	"JSON_OBJECTAGG":                   {Keyword: "JSON_OBJECTAGG", Reserved: false},
-	"JSON_QUERY":                       {Keyword: "JSON_QUERY", Reserved: false},
-	"JSON_SCALAR":                      {Keyword: "JSON_SCALAR", Reserved: false},
-	"JSON_SERIALIZE":                   {Keyword: "JSON_SERIALIZE", Reserved: false},
-	"JSON_TABLE":                       {Keyword: "JSON_TABLE", Reserved: false},
-	"JSON_TABLE_PRIMITIVE":             {Keyword: "JSON_TABLE_PRIMITIVE", Reserved: false},
-	"JSON_VALUE":                       {Keyword: "JSON_VALUE", Reserved: false},
-	"K":                                {Keyword: "K", Reserved: false},
-	"KEEP":                             {Keyword: "KEEP", Reserved: false},
-	"KEY":                              {Keyword: "KEY", Reserved: false},
-	"KEYS":                             {Keyword: "KEYS", Reserved: false},
-	"KEY_MEMBER":                       {Keyword: "KEY_MEMBER", Reserved: false},
-	"KEY_TYPE":                         {Keyword: "KEY_TYPE", Reserved: false},
-	"LABEL":                            {Keyword: "LABEL", Reserved: false},
-	"LAG":                              {Keyword: "LAG", Reserved: false},
-	"LANGUAGE":                         {Keyword: "LANGUAGE", Reserved: false},
-	"LARGE":                            {Keyword: "LARGE", Reserved: false},
-	"LAST":                             {Keyword: "LAST", Reserved: false},
-	"LAST_VALUE":                       {Keyword: "LAST_VALUE", Reserved: false},
-	"LATERAL":                          {Keyword: "LATERAL", Reserved: true},
-	"LEAD":                             {Keyword: "LEAD", Reserved: false},
-	"LEADING":                          {Keyword: "LEADING", Reserved: true},
-	"LEAKPROOF":                        {Keyword: "LEAKPROOF", Reserved: false},
-	"LEAST":                            {Keyword: "LEAST", Reserved: false},
-	"LEFT":                             {Keyword: "LEFT", Reserved: true},
-	"LENGTH":                           {Keyword: "LENGTH", Reserved: false},
-	"LEVEL":                            {Keyword: "LEVEL", Reserved: false},
-	"LIBRARY":                          {Keyword: "LIBRARY", Reserved: false},
-	"LIKE":                             {Keyword: "LIKE", Reserved: true},
-	"LIKE_REGEX":                       {Keyword: "LIKE_REGEX", Reserved: false},
-	"LIMIT":                            {Keyword: "LIMIT", Reserved: true},
-	"LINK":                             {Keyword: "LINK", Reserved: false},
-	"LISTAGG":                          {Keyword: "LISTAGG", Reserved: false},
-	"LISTEN":                           {Keyword: "LISTEN", Reserved: false},
-	"LN":                               {Keyword: "LN", Reserved: false},
-	"LOAD":                             {Keyword: "LOAD", Reserved: false},
-	"LOCAL":                            {Keyword: "LOCAL", Reserved: false},
-	"LOCALTIME":                        {Keyword: "LOCALTIME", Reserved: true},
-	"LOCALTIMESTAMP":                   {Keyword: "LOCALTIMESTAMP", Reserved: true},
-	"LOCATION":                         {Keyword: "LOCATION", Reserved: false},
-	"LOCATOR":                          {Keyword: "LOCATOR", Reserved: false},
-	"LOCK":                             {Keyword: "LOCK", Reserved: false},
-	"LOCKED":                           {Keyword: "LOCKED", Reserved: false},
-	"LOG":                              {Keyword: "LOG", Reserved: false},
-	"LOG10":                            {Keyword: "LOG10", Reserved: false},
-	"LOGGED":                           {Keyword: "LOGGED", Reserved: false},
-	"LOWER":                            {Keyword: "LOWER", Reserved: false},
-	"LPAD":                             {Keyword: "LPAD", Reserved: false},
-	"LTRIM":                            {Keyword: "LTRIM", Reserved: false},
-	"M":                                {Keyword: "M", Reserved: false},
-	"MAP":                              {Keyword: "MAP", Reserved: false},
-	"MAPPING":                          {Keyword: "MAPPING", Reserved: false},
-	"MATCH":                            {Keyword: "MATCH", Reserved: false},
-	"MATCHED":                          {Keyword: "MATCHED", Reserved: false},
-	"MATCHES":                          {Keyword: "MATCHES", Reserved: false},
-	"MATCH_NUMBER":                     {Keyword: "MATCH_NUMBER", Reserved: false},
-	"MATCH_RECOGNIZE":                  {Keyword: "MATCH_RECOGNIZE", Reserved: false},
-	"MATERIALIZED":                     {Keyword: "MATERIALIZED", Reserved: false},
-	"MAX":                              {Keyword: "MAX", Reserved: false},
-	"MAXVALUE":                         {Keyword: "MAXVALUE", Reserved: false},
-	"MEASURES":                         {Keyword: "MEASURES", Reserved: false},
-	"MEMBER":                           {Keyword: "MEMBER", Reserved: false},
-	"MERGE":                            {Keyword: "MERGE", Reserved: false},
-	"MERGE_ACTION":                     {Keyword: "MERGE_ACTION", Reserved: false},
-	"MESSAGE_LENGTH":                   {Keyword: "MESSAGE_LENGTH", Reserved: false},
-	"MESSAGE_OCTET_LENGTH":             {Keyword: "MESSAGE_OCTET_LENGTH", Reserved: false},
-	"MESSAGE_TEXT":                     {Keyword: "MESSAGE_TEXT", Reserved: false},
-	"METHOD":                           {Keyword: "METHOD", Reserved: false},
-	"MIN":                              {Keyword: "MIN", Reserved: false},
-	"MINUTE":                           {Keyword: "MINUTE", Reserved: false},
-	"MINVALUE":                         {Keyword: "MINVALUE", Reserved: false},
-	"MOD":                              {Keyword: "MOD", Reserved: false},
-	"MODE":                             {Keyword: "MODE", Reserved: false},
-	"MODIFIES":                         {Keyword: "MODIFIES", Reserved: false},
-	"MODULE":                           {Keyword: "MODULE", Reserved: false},
-	"MONTH":                            {Keyword: "MONTH", Reserved: false},
-	"MORE":                             {Keyword: "MORE", Reserved: false},
-	"MOVE":                             {Keyword: "MOVE", Reserved: false},
-	"MULTISET":                         {Keyword: "MULTISET", Reserved: false},
-	"MUMPS":                            {Keyword: "MUMPS", Reserved: false},
-	"NAME":                             {Keyword: "NAME", Reserved: false},
-	"NAMES":                            {Keyword: "NAMES", Reserved: false},
-	"NAMESPACE":                        {Keyword: "NAMESPACE", Reserved: false},
-	"NATIONAL":                         {Keyword: "NATIONAL", Reserved: false},
-	"NATURAL":                          {Keyword: "NATURAL", Reserved: true},
-	"NCHAR":                            {Keyword: "NCHAR", Reserved: false},
-	"NCLOB":                            {Keyword: "NCLOB", Reserved: false},
-	"NESTED":                           {Keyword: "NESTED", Reserved: false},
-	"NESTING":                          {Keyword: "NESTING", Reserved: false},
-	"NEW":                              {Keyword: "NEW", Reserved: false},
-	"NEXT":                             {Keyword: "NEXT", Reserved: false},
-	"NFC":                              {Keyword: "NFC", Reserved: false},
-	"NFD":                              {Keyword: "NFD", Reserved: false},
-	"NFKC":                             {Keyword: "NFKC", Reserved: false},
-	"NFKD":                             {Keyword: "NFKD", Reserved: false},
-	"NIL":                              {Keyword: "NIL", Reserved: false},
-	"NO":                               {Keyword: "NO", Reserved: false},
-	"NONE":                             {Keyword: "NONE", Reserved: false},
-	"NORMALIZE":                        {Keyword: "NORMALIZE", Reserved: false},
-	"NORMALIZED":                       {Keyword: "NORMALIZED", Reserved: false},
-	"NOT":                              {Keyword: "NOT", Reserved: true},
-	"NOTHING":                          {Keyword: "NOTHING", Reserved: false},
-	"NOTIFY":                           {Keyword: "NOTIFY", Reserved: false},
-	"NOTNULL":                          {Keyword: "NOTNULL", Reserved: false},
-	"NOWAIT":                           {Keyword: "NOWAIT", Reserved: false},
-	"NTH_VALUE":                        {Keyword: "NTH_VALUE", Reserved: false},
-	"NTILE":                            {Keyword: "NTILE", Reserved: false},
-	"NULL":                             {Keyword: "NULL", Reserved: true},
-	"NULLABLE":                         {Keyword: "NULLABLE", Reserved: false},
-	"NULLIF":                           {Keyword: "NULLIF", Reserved: false},
-	"NULLS":                            {Keyword: "NULLS", Reserved: false},
-	"NULL_ORDERING":                    {Keyword: "NULL_ORDERING", Reserved: false},
-	"NUMBER":                           {Keyword: "NUMBER", Reserved: false},
-	"NUMERIC":                          {Keyword: "NUMERIC", Reserved: false},
-	"OBJECT":                           {Keyword: "OBJECT", Reserved: false},
-	"OCCURRENCE":                       {Keyword: "OCCURRENCE", Reserved: false},
-	"OCCURRENCES_REGEX":                {Keyword: "OCCURRENCES_REGEX", Reserved: false},
-	"OCTETS":                           {Keyword: "OCTETS", Reserved: false},
-	"OCTET_LENGTH":                     {Keyword: "OCTET_LENGTH", Reserved: false},
-	"OF":                               {Keyword: "OF", Reserved: false},
-	"OFF":                              {Keyword: "OFF", Reserved: false},
-	"OFFSET":                           {Keyword: "OFFSET", Reserved: true},
-	"OIDS":                             {Keyword: "OIDS", Reserved: false},
-	"OLD":                              {Keyword: "OLD", Reserved: false},
-	"OMIT":                             {Keyword: "OMIT", Reserved: false},
-	"ON":                               {Keyword: "ON", Reserved: true},
-	"ONE":                              {Keyword: "ONE", Reserved: false},
-	"ONLY":                             {Keyword: "ONLY", Reserved: true},
-	"OPEN":                             {Keyword: "OPEN", Reserved: false},
-	"OPERATOR":                         {Keyword: "OPERATOR", Reserved: false},
-	"OPTION":                           {Keyword: "OPTION", Reserved: false},
-	"OPTIONS":                          {Keyword: "OPTIONS", Reserved: false},
-	"OR":                               {Keyword: "OR", Reserved: true},
-	"ORDER":                            {Keyword: "ORDER", Reserved: true},
-	"ORDERING":                         {Keyword: "ORDERING", Reserved: false},
-	"ORDINALITY":                       {Keyword: "ORDINALITY", Reserved: false},
-	"OTHERS":                           {Keyword: "OTHERS", Reserved: false},
-	"OUT":                              {Keyword: "OUT", Reserved: false},
-	"OUTER":                            {Keyword: "OUTER", Reserved: true},
-	"OUTPUT":                           {Keyword: "OUTPUT", Reserved: false},
-	"OVER":                             {Keyword: "OVER", Reserved: false},
-	"OVERFLOW":                         {Keyword: "OVERFLOW", Reserved: false},
-	"OVERLAPS":                         {Keyword: "OVERLAPS", Reserved: false},
-	"OVERLAY":                          {Keyword: "OVERLAY", Reserved: false},
-	"OVERRIDING":                       {Keyword: "OVERRIDING", Reserved: false},
-	"OWNED":                            {Keyword: "OWNED", Reserved: false},
-	"OWNER":                            {Keyword: "OWNER", Reserved: false},
-	"P":                                {Keyword: "P", Reserved: false},
-	"PAD":                              {Keyword: "PAD", Reserved: false},
-	"PARALLEL":                         {Keyword: "PARALLEL", Reserved: false},
-	"PARAMETER":                        {Keyword: "PARAMETER", Reserved: false},
-	"PARAMETER_MODE":                   {Keyword: "PARAMETER_MODE", Reserved: false},
-	"PARAMETER_NAME":                   {Keyword: "PARAMETER_NAME", Reserved: false},
-	"PARAMETER_ORDINAL_POSITION":       {Keyword: "PARAMETER_ORDINAL_POSITION", Reserved: false},
-	"PARAMETER_SPECIFIC_CATALOG":       {Keyword: "PARAMETER_SPECIFIC_CATALOG", Reserved: false},
-	"PARAMETER_SPECIFIC_NAME":          {Keyword: "PARAMETER_SPECIFIC_NAME", Reserved: false},
-	"PARAMETER_SPECIFIC_SCHEMA":        {Keyword: "PARAMETER_SPECIFIC_SCHEMA", Reserved: false},
-	"PARSER":                           {Keyword: "PARSER", Reserved: false},
-	"PARTIAL":                          {Keyword: "PARTIAL", Reserved: false},
-	"PARTITION":                        {Keyword: "PARTITION", Reserved: false},
-	"PASCAL":                           {Keyword: "PASCAL", Reserved: false},
-	"PASS":                             {Keyword: "PASS", Reserved: false},
-	"PASSING":                          {Keyword: "PASSING", Reserved: false},
-	"PASSTHROUGH":                      {Keyword: "PASSTHROUGH", Reserved: false},
-	"PASSWORD":                         {Keyword: "PASSWORD", Reserved: false},
-	"PAST":                             {Keyword: "PAST", Reserved: false},
-	"PATH":                             {Keyword: "PATH", Reserved: false},
-	"PATTERN":                          {Keyword: "PATTERN", Reserved: false},
-	"PER":                              {Keyword: "PER", Reserved: false},
-	"PERCENT":                          {Keyword: "PERCENT", Reserved: false},
-	"PERCENTILE_CONT":                  {Keyword: "PERCENTILE_CONT", Reserved: false},
-	"PERCENTILE_DISC":                  {Keyword: "PERCENTILE_DISC", Reserved: false},
-	"PERCENT_RANK":                     {Keyword: "PERCENT_RANK", Reserved: false},
-	"PERIOD":                           {Keyword: "PERIOD", Reserved: false},
-	"PERMISSION":                       {Keyword: "PERMISSION", Reserved: false},
-	"PERMUTE":                          {Keyword: "PERMUTE", Reserved: false},
-	"PIPE":                             {Keyword: "PIPE", Reserved: false},
-	"PLACING":                          {Keyword: "PLACING", Reserved: true},
-	"PLAN":                             {Keyword: "PLAN", Reserved: false},
-	"PLANS":                            {Keyword: "PLANS", Reserved: false},
-	"PLI":                              {Keyword: "PLI", Reserved: false},
-	"POLICY":                           {Keyword: "POLICY", Reserved: false},
-	"PORTION":                          {Keyword: "PORTION", Reserved: false},
-	"POSITION":                         {Keyword: "POSITION", Reserved: false},
-	"POSITION_REGEX":                   {Keyword: "POSITION_REGEX", Reserved: false},
-	"POWER":                            {Keyword: "POWER", Reserved: false},
-	"PRECEDES":                         {Keyword: "PRECEDES", Reserved: false},
-	"PRECEDING":                        {Keyword: "PRECEDING", Reserved: false},
-	"PRECISION":                        {Keyword: "PRECISION", Reserved: false},
-	"PREPARE":                          {Keyword: "PREPARE", Reserved: false},
-	"PREPARED":                         {Keyword: "PREPARED", Reserved: false},
-	"PRESERVE":                         {Keyword: "PRESERVE", Reserved: false},
-	"PREV":                             {Keyword: "PREV", Reserved: false},
-	"PRIMARY":                          {Keyword: "PRIMARY", Reserved: true},
-	"PRIOR":                            {Keyword: "PRIOR", Reserved: false},
-	"PRIVATE":                          {Keyword: "PRIVATE", Reserved: false},
-	"PRIVILEGES":                       {Keyword: "PRIVILEGES", Reserved: false},
-	"PROCEDURAL":                       {Keyword: "PROCEDURAL", Reserved: false},
-	"PROCEDURE":                        {Keyword: "PROCEDURE", Reserved: false},
-	"PROCEDURES":                       {Keyword: "PROCEDURES", Reserved: false},
-	"PROGRAM":                          {Keyword: "PROGRAM", Reserved: false},
-	"PRUNE":                            {Keyword: "PRUNE", Reserved: false},
-	"PTF":                              {Keyword: "PTF", Reserved: false},
-	"PUBLIC":                           {Keyword: "PUBLIC", Reserved: false},
-	"PUBLICATION":                      {Keyword: "PUBLICATION", Reserved: false},
-	"QUOTE":                            {Keyword: "QUOTE", Reserved: false},
-	"QUOTES":                           {Keyword: "QUOTES", Reserved: false},
-	"RANGE":                            {Keyword: "RANGE", Reserved: false},
-	"RANK":                             {Keyword: "RANK", Reserved: false},
-	"READ":                             {Keyword: "READ", Reserved: false},
-	"READS":                            {Keyword: "READS", Reserved: false},
-	"REAL":                             {Keyword: "REAL", Reserved: false},
-	"REASSIGN":                         {Keyword: "REASSIGN", Reserved: false},
-	"RECHECK":                          {Keyword: "RECHECK", Reserved: false},
-	"RECOVERY":                         {Keyword: "RECOVERY", Reserved: false},
-	"RECURSIVE":                        {Keyword: "RECURSIVE", Reserved: false},
-	"REF":                              {Keyword: "REF", Reserved: false},
-	"REFERENCES":                       {Keyword: "REFERENCES", Reserved: true},
-	"REFERENCING":                      {Keyword: "REFERENCING", Reserved: false},
-	"REFRESH":                          {Keyword: "REFRESH", Reserved: false},
-	"REGR_AVGX":                        {Keyword: "REGR_AVGX", Reserved: false},
-	"REGR_AVGY":                        {Keyword: "REGR_AVGY", Reserved: false},
-	"REGR_COUNT":                       {Keyword: "REGR_COUNT", Reserved: false},
-	"REGR_INTERCEPT":                   {Keyword: "REGR_INTERCEPT", Reserved: false},
-	"REGR_R2":                          {Keyword: "REGR_R2", Reserved: false},
-	"REGR_SLOPE":                       {Keyword: "REGR_SLOPE", Reserved: false},
-	"REGR_SXX":                         {Keyword: "REGR_SXX", Reserved: false},
-	"REGR_SXY":                         {Keyword: "REGR_SXY", Reserved: false},
-	"REGR_SYY":                         {Keyword: "REGR_SYY", Reserved: false},
-	"REINDEX":                          {Keyword: "REINDEX", Reserved: false},
-	"RELATIVE":                         {Keyword: "RELATIVE", Reserved: false},
-	"RELEASE":                          {Keyword: "RELEASE", Reserved: false},
-	"RENAME":                           {Keyword: "RENAME", Reserved: false},
-	"REPEATABLE":                       {Keyword: "REPEATABLE", Reserved: false},
-	"REPLACE":                          {Keyword: "REPLACE", Reserved: false},
-	"REPLICA":                          {Keyword: "REPLICA", Reserved: false},
-	"REQUIRING":                        {Keyword: "REQUIRING", Reserved: false},
-	"RESET":                            {Keyword: "RESET", Reserved: false},
-	"RESPECT":                          {Keyword: "RESPECT", Reserved: false},
-	"RESTART":                          {Keyword: "RESTART", Reserved: false},
-	"RESTORE":                          {Keyword: "RESTORE", Reserved: false},
-	"RESTRICT":                         {Keyword: "RESTRICT", Reserved: false},
-	"RESULT":                           {Keyword: "RESULT", Reserved: false},
-	"RETURN":                           {Keyword: "RETURN", Reserved: false},
-	"RETURNED_CARDINALITY":             {Keyword: "RETURNED_CARDINALITY", Reserved: false},
-	"RETURNED_LENGTH":                  {Keyword: "RETURNED_LENGTH", Reserved: false},
-	"RETURNED_OCTET_LENGTH":            {Keyword: "RETURNED_OCTET_LENGTH", Reserved: false},
-	"RETURNED_SQLSTATE":                {Keyword: "RETURNED_SQLSTATE", Reserved: false},
-	"RETURNING":                        {Keyword: "RETURNING", Reserved: true},
-	"RETURNS":                          {Keyword: "RETURNS", Reserved: false},
-	"REVOKE":                           {Keyword: "REVOKE", Reserved: false},
-	"RIGHT":                            {Keyword: "RIGHT", Reserved: true},
-	"ROLE":                             {Keyword: "ROLE", Reserved: false},
-	"ROLLBACK":                         {Keyword: "ROLLBACK", Reserved: false},
-	"ROLLUP":                           {Keyword: "ROLLUP", Reserved: false},
-	"ROUTINE":                          {Keyword: "ROUTINE", Reserved: false},
-	"ROUTINES":                         {Keyword: "ROUTINES", Reserved: false},
-	"ROUTINE_CATALOG":                  {Keyword: "ROUTINE_CATALOG", Reserved: false},
-	"ROUTINE_NAME":                     {Keyword: "ROUTINE_NAME", Reserved: false},
-	"ROUTINE_SCHEMA":                   {Keyword: "ROUTINE_SCHEMA", Reserved: false},
-	"ROW":                              {Keyword: "ROW", Reserved: false},
-	"ROWS":                             {Keyword: "ROWS", Reserved: false},
-	"ROW_COUNT":                        {Keyword: "ROW_COUNT", Reserved: false},
-	"ROW_NUMBER":                       {Keyword: "ROW_NUMBER", Reserved: false},
-	"RPAD":                             {Keyword: "RPAD", Reserved: false},
-	"RTRIM":                            {Keyword: "RTRIM", Reserved: false},
-	"RULE":                             {Keyword: "RULE", Reserved: false},
-	"RUNNING":                          {Keyword: "RUNNING", Reserved: false},
-	"SAVEPOINT":                        {Keyword: "SAVEPOINT", Reserved: false},
-	"SCALAR":                           {Keyword: "SCALAR", Reserved: false},
-	"SCALE":                            {Keyword: "SCALE", Reserved: false},
-	"SCHEMA":                           {Keyword: "SCHEMA", Reserved: false},
-	"SCHEMAS":                          {Keyword: "SCHEMAS", Reserved: false},
-	"SCHEMA_NAME":                      {Keyword: "SCHEMA_NAME", Reserved: false},
-	"SCOPE":                            {Keyword: "SCOPE", Reserved: false},
-	"SCOPE_CATALOG":                    {Keyword: "SCOPE_CATALOG", Reserved: false},
-	"SCOPE_NAME":                       {Keyword: "SCOPE_NAME", Reserved: false},
-	"SCOPE_SCHEMA":                     {Keyword: "SCOPE_SCHEMA", Reserved: false},
-	"SCROLL":                           {Keyword: "SCROLL", Reserved: false},
-	"SEARCH":                           {Keyword: "SEARCH", Reserved: false},
-	"SECOND":                           {Keyword: "SECOND", Reserved: false},
-	"SECTION":                          {Keyword: "SECTION", Reserved: false},
-	"SECURITY":                         {Keyword: "SECURITY", Reserved: false},
-	"SEEK":                             {Keyword: "SEEK", Reserved: false},
-	"SELECT":                           {Keyword: "SELECT", Reserved: true},
-	"SELECTIVE":                        {Keyword: "SELECTIVE", Reserved: false},
-	"SELF":                             {Keyword: "SELF", Reserved: false},
-	"SEMANTICS":                        {Keyword: "SEMANTICS", Reserved: false},
-	"SENSITIVE":                        {Keyword: "SENSITIVE", Reserved: false},
-	"SEQUENCE":                         {Keyword: "SEQUENCE", Reserved: false},
-	"SEQUENCES":                        {Keyword: "SEQUENCES", Reserved: false},
-	"SERIALIZABLE":                     {Keyword: "SERIALIZABLE", Reserved: false},
-	"SERVER":                           {Keyword: "SERVER", Reserved: false},
-	"SERVER_NAME":                      {Keyword: "SERVER_NAME", Reserved: false},
-	"SESSION":                          {Keyword: "SESSION", Reserved: false},
-	"SESSION_USER":                     {Keyword: "SESSION_USER", Reserved: true},
-	"SET":                              {Keyword: "SET", Reserved: false},
-	"SETOF":                            {Keyword: "SETOF", Reserved: false},
-	"SETS":                             {Keyword: "SETS", Reserved: false},
-	"SHARE":                            {Keyword: "SHARE", Reserved: false},
-	"SHOW":                             {Keyword: "SHOW", Reserved: false},
-	"SIMILAR":                          {Keyword: "SIMILAR", Reserved: true},
-	"SIMPLE":                           {Keyword: "SIMPLE", Reserved: false},
-	"SIN":                              {Keyword: "SIN", Reserved: false},
-	"SINH":                             {Keyword: "SINH", Reserved: false},
-	"SIZE":                             {Keyword: "SIZE", Reserved: false},
-	"SKIP":                             {Keyword: "SKIP", Reserved: false},
-	"SMALLINT":                         {Keyword: "SMALLINT", Reserved: false},
-	"SNAPSHOT":                         {Keyword: "SNAPSHOT", Reserved: false},
-	"SOME":                             {Keyword: "SOME", Reserved: true},
-	"SORT_DIRECTION":                   {Keyword: "SORT_DIRECTION", Reserved: false},
-	"SOURCE":                           {Keyword: "SOURCE", Reserved: false},
-	"SPACE":                            {Keyword: "SPACE", Reserved: false},
-	"SPECIFIC":                         {Keyword: "SPECIFIC", Reserved: false},
-	"SPECIFICTYPE":                     {Keyword: "SPECIFICTYPE", Reserved: false},
-	"SPECIFIC_NAME":                    {Keyword: "SPECIFIC_NAME", Reserved: false},
-	"SQL":                              {Keyword: "SQL", Reserved: false},
-	"SQLCODE":                          {Keyword: "SQLCODE", Reserved: false},
-	"SQLERROR":                         {Keyword: "SQLERROR", Reserved: false},
-	"SQLEXCEPTION":                     {Keyword: "SQLEXCEPTION", Reserved: false},
-	"SQLSTATE":                         {Keyword: "SQLSTATE", Reserved: false},
-	"SQLWARNING":                       {Keyword: "SQLWARNING", Reserved: false},
-	"SQRT":                             {Keyword: "SQRT", Reserved: false},
-	"STABLE":                           {Keyword: "STABLE", Reserved: false},
-	"STANDALONE":                       {Keyword: "STANDALONE", Reserved: false},
-	"START":                            {Keyword: "START", Reserved: false},
-	"STATE":                            {Keyword: "STATE", Reserved: false},
-	"STATEMENT":                        {Keyword: "STATEMENT", Reserved: false},
-	"STATIC":                           {Keyword: "STATIC", Reserved: false},
-	"STATISTICS":                       {Keyword: "STATISTICS", Reserved: false},
-	"STDDEV_POP":                       {Keyword: "STDDEV_POP", Reserved: false},
-	"STDDEV_SAMP":                      {Keyword: "STDDEV_SAMP", Reserved: false},
-	"STDIN":                            {Keyword: "STDIN", Reserved: false},
-	"STDOUT":                           {Keyword: "STDOUT", Reserved: false},
-	"STORAGE":                          {Keyword: "STORAGE", Reserved: false},
-	"STORED":                           {Keyword: "STORED", Reserved: false},
-	"STRICT":                           {Keyword: "STRICT", Reserved: false},
-	"STRING":                           {Keyword: "STRING", Reserved: false},
-	"STRIP":                            {Keyword: "STRIP", Reserved: false},
-	"STRUCTURE":                        {Keyword: "STRUCTURE", Reserved: false},
-	"STYLE":                            {Keyword: "STYLE", Reserved: false},
-	"SUBCLASS_ORIGIN":                  {Keyword: "SUBCLASS_ORIGIN", Reserved: false},
-	"SUBMULTISET":                      {Keyword: "SUBMULTISET", Reserved: false},
-	"SUBSCRIPTION":                     {Keyword: "SUBSCRIPTION", Reserved: false},
-	"SUBSET":                           {Keyword: "SUBSET", Reserved: false},
-	"SUBSTRING":                        {Keyword: "SUBSTRING", Reserved: false},
-	"SUBSTRING_REGEX":                  {Keyword: "SUBSTRING_REGEX", Reserved: false},
-	"SUCCEEDS":                         {Keyword: "SUCCEEDS", Reserved: false},
-	"SUM":                              {Keyword: "SUM", Reserved: false},
-	"SUPPORT":                          {Keyword: "SUPPORT", Reserved: false},
-	"SYMMETRIC":                        {Keyword: "SYMMETRIC", Reserved: true},
-	"SYSID":                            {Keyword: "SYSID", Reserved: false},
-	"SYSTEM":                           {Keyword: "SYSTEM", Reserved: false},
-	"SYSTEM_TIME":                      {Keyword: "SYSTEM_TIME", Reserved: false},
-	"SYSTEM_USER":                      {Keyword: "SYSTEM_USER", Reserved: true},
-	"T":                                {Keyword: "T", Reserved: false},
-	"TABLE":                            {Keyword: "TABLE", Reserved: true},
-	"TABLES":                           {Keyword: "TABLES", Reserved: false},
-	"TABLESAMPLE":                      {Keyword: "TABLESAMPLE", Reserved: true},
-	"TABLESPACE":                       {Keyword: "TABLESPACE", Reserved: false},
-	"TABLE_NAME":                       {Keyword: "TABLE_NAME", Reserved: false},
-	"TAN":                              {Keyword: "TAN", Reserved: false},
-	"TANH":                             {Keyword: "TANH", Reserved: false},
-	"TARGET":                           {Keyword: "TARGET", Reserved: false},
-	"TEMP":                             {Keyword: "TEMP", Reserved: false},
-	"TEMPLATE":                         {Keyword: "TEMPLATE", Reserved: false},
-	"TEMPORARY":                        {Keyword: "TEMPORARY", Reserved: false},
-	"TEXT":                             {Keyword: "TEXT", Reserved: false},
-	"THEN":                             {Keyword: "THEN", Reserved: true},
-	"THROUGH":                          {Keyword: "THROUGH", Reserved: false},
-	"TIES":                             {Keyword: "TIES", Reserved: false},
-	"TIME":                             {Keyword: "TIME", Reserved: false},
-	"TIMESTAMP":                        {Keyword: "TIMESTAMP", Reserved: false},
-	"TIMEZONE_HOUR":                    {Keyword: "TIMEZONE_HOUR", Reserved: false},
-	"TIMEZONE_MINUTE":                  {Keyword: "TIMEZONE_MINUTE", Reserved: false},
-	"TO":                               {Keyword: "TO", Reserved: true},
-	"TOKEN":                            {Keyword: "TOKEN", Reserved: false},
-	"TOP_LEVEL_COUNT":                  {Keyword: "TOP_LEVEL_COUNT", Reserved: false},
-	"TRAILING":                         {Keyword: "TRAILING", Reserved: true},
-	"TRANSACTION":                      {Keyword: "TRANSACTION", Reserved: false},
-	"TRANSACTIONS_COMMITTED":           {Keyword: "TRANSACTIONS_COMMITTED", Reserved: false},
-	"TRANSACTIONS_ROLLED_BACK":         {Keyword: "TRANSACTIONS_ROLLED_BACK", Reserved: false},
-	"TRANSACTION_ACTIVE":               {Keyword: "TRANSACTION_ACTIVE", Reserved: false},
-	"TRANSFORM":                        {Keyword: "TRANSFORM", Reserved: false},
-	"TRANSFORMS":                       {Keyword: "TRANSFORMS", Reserved: false},
-	"TRANSLATE":                        {Keyword: "TRANSLATE", Reserved: false},
-	"TRANSLATE_REGEX":                  {Keyword: "TRANSLATE_REGEX", Reserved: false},
-	"TRANSLATION":                      {Keyword: "TRANSLATION", Reserved: false},
-	"TREAT":                            {Keyword: "TREAT", Reserved: false},
-	"TRIGGER":                          {Keyword: "TRIGGER", Reserved: false},
-	"TRIGGER_CATALOG":                  {Keyword: "TRIGGER_CATALOG", Reserved: false},
-	"TRIGGER_NAME":                     {Keyword: "TRIGGER_NAME", Reserved: false},
-	"TRIGGER_SCHEMA":                   {Keyword: "TRIGGER_SCHEMA", Reserved: false},
-	"TRIM":                             {Keyword: "TRIM", Reserved: false},
-	"TRIM_ARRAY":                       {Keyword: "TRIM_ARRAY", Reserved: false},
-	"TRUE":                             {Keyword: "TRUE", Reserved: true},
-	"TRUNCATE":                         {Keyword: "TRUNCATE", Reserved: false},
-	"TRUSTED":                          {Keyword: "TRUSTED", Reserved: false},
-	"TYPE":                             {Keyword: "TYPE", Reserved: false},
-	"TYPES":                            {Keyword: "TYPES", Reserved: false},
-	"UESCAPE":                          {Keyword: "UESCAPE", Reserved: false},
-	"UNBOUNDED":                        {Keyword: "UNBOUNDED", Reserved: false},
-	"UNCOMMITTED":                      {Keyword: "UNCOMMITTED", Reserved: false},
-	"UNCONDITIONAL":                    {Keyword: "UNCONDITIONAL", Reserved: false},
-	"UNDER":                            {Keyword: "UNDER", Reserved: false},
-	"UNENCRYPTED":                      {Keyword: "UNENCRYPTED", Reserved: false},
-	"UNION":                            {Keyword: "UNION", Reserved: true},
-	"UNIQUE":                           {Keyword: "UNIQUE", Reserved: true},
-	"UNKNOWN":                          {Keyword: "UNKNOWN", Reserved: false},
-	"UNLINK":                           {Keyword: "UNLINK", Reserved: false},
-	"UNLISTEN":                         {Keyword: "UNLISTEN", Reserved: false},
-	"UNLOGGED":                         {Keyword: "UNLOGGED", Reserved: false},
-	"UNMATCHED":                        {Keyword: "UNMATCHED", Reserved: false},
-	"UNNAMED":                          {Keyword: "UNNAMED", Reserved: false},
-	"UNNEST":                           {Keyword: "UNNEST", Reserved: false},
-	"UNTIL":                            {Keyword: "UNTIL", Reserved: false},
-	"UNTYPED":                          {Keyword: "UNTYPED", Reserved: false},
-	"UPDATE":                           {Keyword: "UPDATE", Reserved: false},
-	"UPPER":                            {Keyword: "UPPER", Reserved: false},
-	"URI":                              {Keyword: "URI", Reserved: false},
-	"USAGE":                            {Keyword: "USAGE", Reserved: false},
-	"USER":                             {Keyword: "USER", Reserved: true},
-	"USER_DEFINED_TYPE_CATALOG":        {Keyword: "USER_DEFINED_TYPE_CATALOG", Reserved: false},
-	"USER_DEFINED_TYPE_CODE":           {Keyword: "USER_DEFINED_TYPE_CODE", Reserved: false},
-	"USER_DEFINED_TYPE_NAME":           {Keyword: "USER_DEFINED_TYPE_NAME", Reserved: false},
-	"USER_DEFINED_TYPE_SCHEMA":         {Keyword: "USER_DEFINED_TYPE_SCHEMA", Reserved: false},
-	"USING":                            {Keyword: "USING", Reserved: true},
-	"UTF16":                            {Keyword: "UTF16", Reserved: false},
-	"UTF32":                            {Keyword: "UTF32", Reserved: false},
-	"UTF8":                             {Keyword: "UTF8", Reserved: false},
-	"VACUUM":                           {Keyword: "VACUUM", Reserved: false},
-	"VALID":                            {Keyword: "VALID", Reserved: false},
-	"VALIDATE":                         {Keyword: "VALIDATE", Reserved: false},
-	"VALIDATOR":                        {Keyword: "VALIDATOR", Reserved: false},
-	"VALUE":                            {Keyword: "VALUE", Reserved: false},
-	"VALUES":                           {Keyword: "VALUES", Reserved: false},
-	"VALUE_OF":                         {Keyword: "VALUE_OF", Reserved: false},
-	"VARBINARY":                        {Keyword: "VARBINARY", Reserved: false},
-	"VARCHAR":                          {Keyword: "VARCHAR", Reserved: false},
-	"VARIADIC":                         {Keyword: "VARIADIC", Reserved: true},
-	"VARYING":                          {Keyword: "VARYING", Reserved: false},
-	"VAR_POP":                          {Keyword: "VAR_POP", Reserved: false},
-	"VAR_SAMP":                         {Keyword: "VAR_SAMP", Reserved: false},
-	"VERBOSE":                          {Keyword: "VERBOSE", Reserved: true},
-	"VERSION":                          {Keyword: "VERSION", Reserved: false},
-	"VERSIONING":                       {Keyword: "VERSIONING", Reserved: false},
-	"VIEW":                             {Keyword: "VIEW", Reserved: false},
-	"VIEWS":                            {Keyword: "VIEWS", Reserved: false},
-	"VOLATILE":                         {Keyword: "VOLATILE", Reserved: false},
-	"WHEN":                             {Keyword: "WHEN", Reserved: true},
-	"WHENEVER":                         {Keyword: "WHENEVER", Reserved: false},
-	"WHERE":                            {Keyword: "WHERE", Reserved: true},
-	"WHITESPACE":                       {Keyword: "WHITESPACE", Reserved: false},
-	"WIDTH_BUCKET":                     {Keyword: "WIDTH_BUCKET", Reserved: false},
-	"WINDOW":                           {Keyword: "WINDOW", Reserved: true},
-	"WITH":                             {Keyword: "WITH", Reserved: true},
-	"WITHIN":                           {Keyword: "WITHIN", Reserved: false},
-	"WITHOUT":                          {Keyword: "WITHOUT", Reserved: false},
-	"WORK":                             {Keyword: "WORK", Reserved: false},
-	"WRAPPER":                          {Keyword: "WRAPPER", Reserved: false},
-	"WRITE":                            {Keyword: "WRITE", Reserved: false},
-	"XML":                              {Keyword: "XML", Reserved: false},
-	"XMLAGG":                           {Keyword: "XMLAGG", Reserved: false},
-	"XMLATTRIBUTES":                    {Keyword: "XMLATTRIBUTES", Reserved: false},
-	"XMLBINARY":                        {Keyword: "XMLBINARY", Reserved: false},
-	"XMLCAST":                          {Keyword: "XMLCAST", Reserved: false},
-	"XMLCOMMENT":                       {Keyword: "XMLCOMMENT", Reserved: false},
-	"XMLCONCAT":                        {Keyword: "XMLCONCAT", Reserved: false},
-	"XMLDECLARATION":                   {Keyword: "XMLDECLARATION", Reserved: false},
-	"XMLDOCUMENT":                      {Keyword: "XMLDOCUMENT", Reserved: false},
-	"XMLELEMENT":                       {Keyword: "XMLELEMENT", Reserved: false},
-	"XMLEXISTS":                        {Keyword: "XMLEXISTS", Reserved: false},
-	"XMLFOREST":                        {Keyword: "XMLFOREST", Reserved: false},
-	"XMLITERATE":                       {Keyword: "XMLITERATE", Reserved: false},
-	"XMLNAMESPACES":                    {Keyword: "XMLNAMESPACES", Reserved: false},
-	"XMLPARSE":                         {Keyword: "XMLPARSE", Reserved: false},
-	"XMLPI":                            {Keyword: "XMLPI", Reserved: false},
-	"XMLQUERY":                         {Keyword: "XMLQUERY", Reserved: false},
-	"XMLROOT":                          {Keyword: "XMLROOT", Reserved: false},
-	"XMLSCHEMA":                        {Keyword: "XMLSCHEMA", Reserved: false},
-	"XMLSERIALIZE":                     {Keyword: "XMLSERIALIZE", Reserved: false},
-	"XMLTABLE":                         {Keyword: "XMLTABLE", Reserved: false},
-	"XMLTEXT":                          {Keyword: "XMLTEXT", Reserved: false},
-	"XMLVALIDATE":                      {Keyword: "XMLVALIDATE", Reserved: false},
-	"YEAR":                             {Keyword: "YEAR", Reserved: false},
-	"YES":                              {Keyword: "YES", Reserved: false},
-	"ZONE":                             {Keyword: "ZONE", Reserved: false},
+	"A":                                {Keyword: "A", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ABORT":                            {Keyword: "ABORT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ABS":                              {Keyword: "ABS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ABSENT":                           {Keyword: "ABSENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"ABSOLUTE":                         {Keyword: "ABSOLUTE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ACCESS":                           {Keyword: "ACCESS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ACCORDING":                        {Keyword: "ACCORDING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ACOS":                             {Keyword: "ACOS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ACTION":                           {Keyword: "ACTION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ADA":                              {Keyword: "ADA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ADD":                              {Keyword: "ADD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ADMIN":                            {Keyword: "ADMIN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"AFTER":                            {Keyword: "AFTER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"AGGREGATE":                        {Keyword: "AGGREGATE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ALL":                              {Keyword: "ALL", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ALLOCATE":                         {Keyword: "ALLOCATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ALSO":                             {Keyword: "ALSO", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ALTER":                            {Keyword: "ALTER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ALWAYS":                           {Keyword: "ALWAYS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ANALYSE":                          {Keyword: "ANALYSE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ANALYZE":                          {Keyword: "ANALYZE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"AND":                              {Keyword: "AND", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ANY":                              {Keyword: "ANY", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ANY_VALUE":                        {Keyword: "ANY_VALUE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ARE":                              {Keyword: "ARE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ARRAY":                            {Keyword: "ARRAY", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"ARRAY_AGG":                        {Keyword: "ARRAY_AGG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ARRAY_MAX_CARDINALITY":            {Keyword: "ARRAY_MAX_CARDINALITY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"AS":                               {Keyword: "AS", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ASC":                              {Keyword: "ASC", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ASENSITIVE":                       {Keyword: "ASENSITIVE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ASIN":                             {Keyword: "ASIN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ASSERTION":                        {Keyword: "ASSERTION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ASSIGNMENT":                       {Keyword: "ASSIGNMENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ASYMMETRIC":                       {Keyword: "ASYMMETRIC", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"AT":                               {Keyword: "AT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ATAN":                             {Keyword: "ATAN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ATOMIC":                           {Keyword: "ATOMIC", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 14},
+	"ATTACH":                           {Keyword: "ATTACH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"ATTRIBUTE":                        {Keyword: "ATTRIBUTE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ATTRIBUTES":                       {Keyword: "ATTRIBUTES", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"AUTHORIZATION":                    {Keyword: "AUTHORIZATION", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"AVG":                              {Keyword: "AVG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BACKWARD":                         {Keyword: "BACKWARD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BASE64":                           {Keyword: "BASE64", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BEFORE":                           {Keyword: "BEFORE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BEGIN":                            {Keyword: "BEGIN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BEGIN_FRAME":                      {Keyword: "BEGIN_FRAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BEGIN_PARTITION":                  {Keyword: "BEGIN_PARTITION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BERNOULLI":                        {Keyword: "BERNOULLI", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BETWEEN":                          {Keyword: "BETWEEN", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BIGINT":                           {Keyword: "BIGINT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BINARY":                           {Keyword: "BINARY", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"BIT":                              {Keyword: "BIT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BIT_LENGTH":                       {Keyword: "BIT_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BLOB":                             {Keyword: "BLOB", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BLOCKED":                          {Keyword: "BLOCKED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BOM":                              {Keyword: "BOM", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BOOLEAN":                          {Keyword: "BOOLEAN", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BOTH":                             {Keyword: "BOTH", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"BREADTH":                          {Keyword: "BREADTH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 14},
+	"BTRIM":                            {Keyword: "BTRIM", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"BY":                               {Keyword: "BY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"C":                                {Keyword: "C", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CACHE":                            {Keyword: "CACHE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CALL":                             {Keyword: "CALL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 11},
+	"CALLED":                           {Keyword: "CALLED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CARDINALITY":                      {Keyword: "CARDINALITY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CASCADE":                          {Keyword: "CASCADE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CASCADED":                         {Keyword: "CASCADED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CASE":                             {Keyword: "CASE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CAST":                             {Keyword: "CAST", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CATALOG":                          {Keyword: "CATALOG", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CATALOG_NAME":                     {Keyword: "CATALOG_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CEIL":                             {Keyword: "CEIL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CEILING":                          {Keyword: "CEILING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHAIN":                            {Keyword: "CHAIN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHAINING":                         {Keyword: "CHAINING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHAR":                             {Keyword: "CHAR", Category: ColNameKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"CHARACTER":                        {Keyword: "CHARACTER", Category: ColNameKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"CHARACTERISTICS":                  {Keyword: "CHARACTERISTICS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHARACTERS":                       {Keyword: "CHARACTERS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHARACTER_LENGTH":                 {Keyword: "CHARACTER_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHARACTER_SET_CATALOG":            {Keyword: "CHARACTER_SET_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHARACTER_SET_NAME":               {Keyword: "CHARACTER_SET_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHARACTER_SET_SCHEMA":             {Keyword: "CHARACTER_SET_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHAR_LENGTH":                      {Keyword: "CHAR_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CHECK":                            {Keyword: "CHECK", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CHECKPOINT":                       {Keyword: "CHECKPOINT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CLASS":                            {Keyword: "CLASS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CLASSIFIER":                       {Keyword: "CLASSIFIER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CLASS_ORIGIN":                     {Keyword: "CLASS_ORIGIN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CLOB":                             {Keyword: "CLOB", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CLOSE":                            {Keyword: "CLOSE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CLUSTER":                          {Keyword: "CLUSTER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COALESCE":                         {Keyword: "COALESCE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COBOL":                            {Keyword: "COBOL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COLLATE":                          {Keyword: "COLLATE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"COLLATION":                        {Keyword: "COLLATION", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"COLLATION_CATALOG":                {Keyword: "COLLATION_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COLLATION_NAME":                   {Keyword: "COLLATION_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COLLATION_SCHEMA":                 {Keyword: "COLLATION_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COLLECT":                          {Keyword: "COLLECT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COLUMN":                           {Keyword: "COLUMN", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"COLUMNS":                          {Keyword: "COLUMNS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"COLUMN_NAME":                      {Keyword: "COLUMN_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COMMAND_FUNCTION":                 {Keyword: "COMMAND_FUNCTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COMMAND_FUNCTION_CODE":            {Keyword: "COMMAND_FUNCTION_CODE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COMMENT":                          {Keyword: "COMMENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COMMENTS":                         {Keyword: "COMMENTS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COMMIT":                           {Keyword: "COMMIT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COMMITTED":                        {Keyword: "COMMITTED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COMPRESSION":                      {Keyword: "COMPRESSION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 14},
+	"CONCURRENTLY":                     {Keyword: "CONCURRENTLY", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CONDITION":                        {Keyword: "CONDITION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONDITIONAL":                      {Keyword: "CONDITIONAL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"CONDITION_NUMBER":                 {Keyword: "CONDITION_NUMBER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONFIGURATION":                    {Keyword: "CONFIGURATION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONFLICT":                         {Keyword: "CONFLICT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONNECT":                          {Keyword: "CONNECT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONNECTION":                       {Keyword: "CONNECTION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONNECTION_NAME":                  {Keyword: "CONNECTION_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONSTRAINT":                       {Keyword: "CONSTRAINT", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CONSTRAINTS":                      {Keyword: "CONSTRAINTS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONSTRAINT_CATALOG":               {Keyword: "CONSTRAINT_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONSTRAINT_NAME":                  {Keyword: "CONSTRAINT_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONSTRAINT_SCHEMA":                {Keyword: "CONSTRAINT_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONSTRUCTOR":                      {Keyword: "CONSTRUCTOR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONTAINS":                         {Keyword: "CONTAINS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONTENT":                          {Keyword: "CONTENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONTINUE":                         {Keyword: "CONTINUE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONTROL":                          {Keyword: "CONTROL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONVERSION":                       {Keyword: "CONVERSION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CONVERT":                          {Keyword: "CONVERT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COPARTITION":                      {Keyword: "COPARTITION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COPY":                             {Keyword: "COPY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CORR":                             {Keyword: "CORR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CORRESPONDING":                    {Keyword: "CORRESPONDING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COS":                              {Keyword: "COS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COSH":                             {Keyword: "COSH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COST":                             {Keyword: "COST", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COUNT":                            {Keyword: "COUNT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COVAR_POP":                        {Keyword: "COVAR_POP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"COVAR_SAMP":                       {Keyword: "COVAR_SAMP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CREATE":                           {Keyword: "CREATE", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"CROSS":                            {Keyword: "CROSS", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CSV":                              {Keyword: "CSV", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CUBE":                             {Keyword: "CUBE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CUME_DIST":                        {Keyword: "CUME_DIST", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CURRENT":                          {Keyword: "CURRENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CURRENT_CATALOG":                  {Keyword: "CURRENT_CATALOG", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CURRENT_DATE":                     {Keyword: "CURRENT_DATE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CURRENT_DEFAULT_TRANSFORM_GROUP":  {Keyword: "CURRENT_DEFAULT_TRANSFORM_GROUP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CURRENT_PATH":                     {Keyword: "CURRENT_PATH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CURRENT_ROLE":                     {Keyword: "CURRENT_ROLE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CURRENT_ROW":                      {Keyword: "CURRENT_ROW", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CURRENT_SCHEMA":                   {Keyword: "CURRENT_SCHEMA", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CURRENT_TIME":                     {Keyword: "CURRENT_TIME", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CURRENT_TIMESTAMP":                {Keyword: "CURRENT_TIMESTAMP", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CURRENT_TRANSFORM_GROUP_FOR_TYPE": {Keyword: "CURRENT_TRANSFORM_GROUP_FOR_TYPE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CURRENT_USER":                     {Keyword: "CURRENT_USER", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"CURSOR":                           {Keyword: "CURSOR", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CURSOR_NAME":                      {Keyword: "CURSOR_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"CYCLE":                            {Keyword: "CYCLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DATA":                             {Keyword: "DATA", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DATABASE":                         {Keyword: "DATABASE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DATALINK":                         {Keyword: "DATALINK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DATE":                             {Keyword: "DATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DATETIME_INTERVAL_CODE":           {Keyword: "DATETIME_INTERVAL_CODE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DATETIME_INTERVAL_PRECISION":      {Keyword: "DATETIME_INTERVAL_PRECISION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DAY":                              {Keyword: "DAY", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"DB":                               {Keyword: "DB", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEALLOCATE":                       {Keyword: "DEALLOCATE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEC":                              {Keyword: "DEC", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DECFLOAT":                         {Keyword: "DECFLOAT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DECIMAL":                          {Keyword: "DECIMAL", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DECLARE":                          {Keyword: "DECLARE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEFAULT":                          {Keyword: "DEFAULT", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"DEFAULTS":                         {Keyword: "DEFAULTS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEFERRABLE":                       {Keyword: "DEFERRABLE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"DEFERRED":                         {Keyword: "DEFERRED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEFINE":                           {Keyword: "DEFINE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEFINED":                          {Keyword: "DEFINED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEFINER":                          {Keyword: "DEFINER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEGREE":                           {Keyword: "DEGREE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DELETE":                           {Keyword: "DELETE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DELIMITER":                        {Keyword: "DELIMITER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DELIMITERS":                       {Keyword: "DELIMITERS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DENSE_RANK":                       {Keyword: "DENSE_RANK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEPENDS":                          {Keyword: "DEPENDS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DEPTH":                            {Keyword: "DEPTH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 14},
+	"DEREF":                            {Keyword: "DEREF", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DERIVED":                          {Keyword: "DERIVED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DESC":                             {Keyword: "DESC", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"DESCRIBE":                         {Keyword: "DESCRIBE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DESCRIPTOR":                       {Keyword: "DESCRIPTOR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DETACH":                           {Keyword: "DETACH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"DETERMINISTIC":                    {Keyword: "DETERMINISTIC", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DIAGNOSTICS":                      {Keyword: "DIAGNOSTICS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DICTIONARY":                       {Keyword: "DICTIONARY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DISABLE":                          {Keyword: "DISABLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DISCARD":                          {Keyword: "DISCARD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DISCONNECT":                       {Keyword: "DISCONNECT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DISPATCH":                         {Keyword: "DISPATCH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DISTINCT":                         {Keyword: "DISTINCT", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"DLNEWCOPY":                        {Keyword: "DLNEWCOPY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLPREVIOUSCOPY":                   {Keyword: "DLPREVIOUSCOPY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLCOMPLETE":                    {Keyword: "DLURLCOMPLETE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLCOMPLETEONLY":                {Keyword: "DLURLCOMPLETEONLY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLCOMPLETEWRITE":               {Keyword: "DLURLCOMPLETEWRITE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLPATH":                        {Keyword: "DLURLPATH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLPATHONLY":                    {Keyword: "DLURLPATHONLY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLPATHWRITE":                   {Keyword: "DLURLPATHWRITE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLSCHEME":                      {Keyword: "DLURLSCHEME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLURLSERVER":                      {Keyword: "DLURLSERVER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DLVALUE":                          {Keyword: "DLVALUE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DO":                               {Keyword: "DO", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"DOCUMENT":                         {Keyword: "DOCUMENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DOMAIN":                           {Keyword: "DOMAIN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DOUBLE":                           {Keyword: "DOUBLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DROP":                             {Keyword: "DROP", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DYNAMIC":                          {Keyword: "DYNAMIC", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DYNAMIC_FUNCTION":                 {Keyword: "DYNAMIC_FUNCTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"DYNAMIC_FUNCTION_CODE":            {Keyword: "DYNAMIC_FUNCTION_CODE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EACH":                             {Keyword: "EACH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ELEMENT":                          {Keyword: "ELEMENT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ELSE":                             {Keyword: "ELSE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"EMPTY":                            {Keyword: "EMPTY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"ENABLE":                           {Keyword: "ENABLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ENCODING":                         {Keyword: "ENCODING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ENCRYPTED":                        {Keyword: "ENCRYPTED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"END":                              {Keyword: "END", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"END_FRAME":                        {Keyword: "END_FRAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"END_PARTITION":                    {Keyword: "END_PARTITION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ENFORCED":                         {Keyword: "ENFORCED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ENUM":                             {Keyword: "ENUM", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EQUALS":                           {Keyword: "EQUALS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ERROR":                            {Keyword: "ERROR", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"ESCAPE":                           {Keyword: "ESCAPE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EVENT":                            {Keyword: "EVENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EVERY":                            {Keyword: "EVERY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXCEPT":                           {Keyword: "EXCEPT", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"EXCEPTION":                        {Keyword: "EXCEPTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXCLUDE":                          {Keyword: "EXCLUDE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXCLUDING":                        {Keyword: "EXCLUDING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXCLUSIVE":                        {Keyword: "EXCLUSIVE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXEC":                             {Keyword: "EXEC", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXECUTE":                          {Keyword: "EXECUTE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXISTS":                           {Keyword: "EXISTS", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXP":                              {Keyword: "EXP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXPLAIN":                          {Keyword: "EXPLAIN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXPRESSION":                       {Keyword: "EXPRESSION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"EXTENSION":                        {Keyword: "EXTENSION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXTERNAL":                         {Keyword: "EXTERNAL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"EXTRACT":                          {Keyword: "EXTRACT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FALSE":                            {Keyword: "FALSE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"FAMILY":                           {Keyword: "FAMILY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FETCH":                            {Keyword: "FETCH", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"FILE":                             {Keyword: "FILE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FILTER":                           {Keyword: "FILTER", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"FINAL":                            {Keyword: "FINAL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FINALIZE":                         {Keyword: "FINALIZE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 14},
+	"FINISH":                           {Keyword: "FINISH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FIRST":                            {Keyword: "FIRST", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FIRST_VALUE":                      {Keyword: "FIRST_VALUE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FLAG":                             {Keyword: "FLAG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FLOAT":                            {Keyword: "FLOAT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FLOOR":                            {Keyword: "FLOOR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FOLLOWING":                        {Keyword: "FOLLOWING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FOR":                              {Keyword: "FOR", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"FORCE":                            {Keyword: "FORCE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FOREIGN":                          {Keyword: "FOREIGN", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"FORMAT":                           {Keyword: "FORMAT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"FORTRAN":                          {Keyword: "FORTRAN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FORWARD":                          {Keyword: "FORWARD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FOUND":                            {Keyword: "FOUND", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FRAME_ROW":                        {Keyword: "FRAME_ROW", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FREE":                             {Keyword: "FREE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FREEZE":                           {Keyword: "FREEZE", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"FROM":                             {Keyword: "FROM", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"FS":                               {Keyword: "FS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FULFILL":                          {Keyword: "FULFILL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FULL":                             {Keyword: "FULL", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"FUNCTION":                         {Keyword: "FUNCTION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FUNCTIONS":                        {Keyword: "FUNCTIONS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"FUSION":                           {Keyword: "FUSION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"G":                                {Keyword: "G", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GENERAL":                          {Keyword: "GENERAL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GENERATED":                        {Keyword: "GENERATED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"GET":                              {Keyword: "GET", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GLOBAL":                           {Keyword: "GLOBAL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GO":                               {Keyword: "GO", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GOTO":                             {Keyword: "GOTO", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GRANT":                            {Keyword: "GRANT", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"GRANTED":                          {Keyword: "GRANTED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GREATEST":                         {Keyword: "GREATEST", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GROUP":                            {Keyword: "GROUP", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"GROUPING":                         {Keyword: "GROUPING", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"GROUPS":                           {Keyword: "GROUPS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 11},
+	"HANDLER":                          {Keyword: "HANDLER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"HAVING":                           {Keyword: "HAVING", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"HEADER":                           {Keyword: "HEADER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"HEX":                              {Keyword: "HEX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"HIERARCHY":                        {Keyword: "HIERARCHY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"HOLD":                             {Keyword: "HOLD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"HOUR":                             {Keyword: "HOUR", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"ID":                               {Keyword: "ID", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IDENTITY":                         {Keyword: "IDENTITY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IF":                               {Keyword: "IF", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IGNORE":                           {Keyword: "IGNORE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ILIKE":                            {Keyword: "ILIKE", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"IMMEDIATE":                        {Keyword: "IMMEDIATE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IMMEDIATELY":                      {Keyword: "IMMEDIATELY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IMMUTABLE":                        {Keyword: "IMMUTABLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IMPLEMENTATION":                   {Keyword: "IMPLEMENTATION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IMPLICIT":                         {Keyword: "IMPLICIT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IMPORT":                           {Keyword: "IMPORT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IN":                               {Keyword: "IN", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"INCLUDE":                          {Keyword: "INCLUDE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 11},
+	"INCLUDING":                        {Keyword: "INCLUDING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INCREMENT":                        {Keyword: "INCREMENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INDENT":                           {Keyword: "INDENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"INDEX":                            {Keyword: "INDEX", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INDEXES":                          {Keyword: "INDEXES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INDICATOR":                        {Keyword: "INDICATOR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INHERIT":                          {Keyword: "INHERIT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INHERITS":                         {Keyword: "INHERITS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INITIAL":                          {Keyword: "INITIAL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INITIALLY":                        {Keyword: "INITIALLY", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"INLINE":                           {Keyword: "INLINE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INNER":                            {Keyword: "INNER", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"INOUT":                            {Keyword: "INOUT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INPUT":                            {Keyword: "INPUT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INSENSITIVE":                      {Keyword: "INSENSITIVE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INSERT":                           {Keyword: "INSERT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INSTANCE":                         {Keyword: "INSTANCE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INSTANTIABLE":                     {Keyword: "INSTANTIABLE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INSTEAD":                          {Keyword: "INSTEAD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INT":                              {Keyword: "INT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INTEGER":                          {Keyword: "INTEGER", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INTEGRITY":                        {Keyword: "INTEGRITY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INTERSECT":                        {Keyword: "INTERSECT", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"INTERSECTION":                     {Keyword: "INTERSECTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INTERVAL":                         {Keyword: "INTERVAL", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"INTO":                             {Keyword: "INTO", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"INVOKER":                          {Keyword: "INVOKER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"IS":                               {Keyword: "IS", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ISNULL":                           {Keyword: "ISNULL", Category: TypeFuncNameKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"ISOLATION":                        {Keyword: "ISOLATION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"JOIN":                             {Keyword: "JOIN", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"JSON":                             {Keyword: "JSON", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"JSON_ARRAY":                       {Keyword: "JSON_ARRAY", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"JSON_ARRAYAGG":                    {Keyword: "JSON_ARRAYAGG", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"JSON_EXISTS":                      {Keyword: "JSON_EXISTS", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"JSON_OBJECT":                      {Keyword: "JSON_OBJECT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"JSON_OBJECTAGG":                   {Keyword: "JSON_OBJECTAGG", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"JSON_QUERY":                       {Keyword: "JSON_QUERY", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"JSON_SCALAR":                      {Keyword: "JSON_SCALAR", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"JSON_SERIALIZE":                   {Keyword: "JSON_SERIALIZE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"JSON_TABLE":                       {Keyword: "JSON_TABLE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"JSON_TABLE_PRIMITIVE":             {Keyword: "JSON_TABLE_PRIMITIVE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"JSON_VALUE":                       {Keyword: "JSON_VALUE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"K":                                {Keyword: "K", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"KEEP":                             {Keyword: "KEEP", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"KEY":                              {Keyword: "KEY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"KEYS":                             {Keyword: "KEYS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"KEY_MEMBER":                       {Keyword: "KEY_MEMBER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"KEY_TYPE":                         {Keyword: "KEY_TYPE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LABEL":                            {Keyword: "LABEL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LAG":                              {Keyword: "LAG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LANGUAGE":                         {Keyword: "LANGUAGE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LARGE":                            {Keyword: "LARGE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LAST":                             {Keyword: "LAST", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LAST_VALUE":                       {Keyword: "LAST_VALUE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LATERAL":                          {Keyword: "LATERAL", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"LEAD":                             {Keyword: "LEAD", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LEADING":                          {Keyword: "LEADING", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"LEAKPROOF":                        {Keyword: "LEAKPROOF", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LEAST":                            {Keyword: "LEAST", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LEFT":                             {Keyword: "LEFT", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"LENGTH":                           {Keyword: "LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LEVEL":                            {Keyword: "LEVEL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LIBRARY":                          {Keyword: "LIBRARY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LIKE":                             {Keyword: "LIKE", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"LIKE_REGEX":                       {Keyword: "LIKE_REGEX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LIMIT":                            {Keyword: "LIMIT", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"LINK":                             {Keyword: "LINK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LISTAGG":                          {Keyword: "LISTAGG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LISTEN":                           {Keyword: "LISTEN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LN":                               {Keyword: "LN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOAD":                             {Keyword: "LOAD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOCAL":                            {Keyword: "LOCAL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOCALTIME":                        {Keyword: "LOCALTIME", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"LOCALTIMESTAMP":                   {Keyword: "LOCALTIMESTAMP", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"LOCATION":                         {Keyword: "LOCATION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOCATOR":                          {Keyword: "LOCATOR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOCK":                             {Keyword: "LOCK", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOCKED":                           {Keyword: "LOCKED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOG":                              {Keyword: "LOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOG10":                            {Keyword: "LOG10", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOGGED":                           {Keyword: "LOGGED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LOWER":                            {Keyword: "LOWER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LPAD":                             {Keyword: "LPAD", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"LTRIM":                            {Keyword: "LTRIM", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"M":                                {Keyword: "M", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MAP":                              {Keyword: "MAP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MAPPING":                          {Keyword: "MAPPING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MATCH":                            {Keyword: "MATCH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MATCHED":                          {Keyword: "MATCHED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 15},
+	"MATCHES":                          {Keyword: "MATCHES", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MATCH_NUMBER":                     {Keyword: "MATCH_NUMBER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MATCH_RECOGNIZE":                  {Keyword: "MATCH_RECOGNIZE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MATERIALIZED":                     {Keyword: "MATERIALIZED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MAX":                              {Keyword: "MAX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MAXVALUE":                         {Keyword: "MAXVALUE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MEASURES":                         {Keyword: "MEASURES", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MEMBER":                           {Keyword: "MEMBER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MERGE":                            {Keyword: "MERGE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 15},
+	"MERGE_ACTION":                     {Keyword: "MERGE_ACTION", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"MESSAGE_LENGTH":                   {Keyword: "MESSAGE_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MESSAGE_OCTET_LENGTH":             {Keyword: "MESSAGE_OCTET_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MESSAGE_TEXT":                     {Keyword: "MESSAGE_TEXT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"METHOD":                           {Keyword: "METHOD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MIN":                              {Keyword: "MIN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MINUTE":                           {Keyword: "MINUTE", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"MINVALUE":                         {Keyword: "MINVALUE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MOD":                              {Keyword: "MOD", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MODE":                             {Keyword: "MODE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MODIFIES":                         {Keyword: "MODIFIES", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MODULE":                           {Keyword: "MODULE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MONTH":                            {Keyword: "MONTH", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"MORE":                             {Keyword: "MORE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MOVE":                             {Keyword: "MOVE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MULTISET":                         {Keyword: "MULTISET", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"MUMPS":                            {Keyword: "MUMPS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NAME":                             {Keyword: "NAME", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NAMES":                            {Keyword: "NAMES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NAMESPACE":                        {Keyword: "NAMESPACE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NATIONAL":                         {Keyword: "NATIONAL", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NATURAL":                          {Keyword: "NATURAL", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"NCHAR":                            {Keyword: "NCHAR", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NCLOB":                            {Keyword: "NCLOB", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NESTED":                           {Keyword: "NESTED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"NESTING":                          {Keyword: "NESTING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NEW":                              {Keyword: "NEW", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"NEXT":                             {Keyword: "NEXT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NFC":                              {Keyword: "NFC", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"NFD":                              {Keyword: "NFD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"NFKC":                             {Keyword: "NFKC", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"NFKD":                             {Keyword: "NFKD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"NIL":                              {Keyword: "NIL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NO":                               {Keyword: "NO", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NONE":                             {Keyword: "NONE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NORMALIZE":                        {Keyword: "NORMALIZE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"NORMALIZED":                       {Keyword: "NORMALIZED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"NOT":                              {Keyword: "NOT", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"NOTHING":                          {Keyword: "NOTHING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NOTIFY":                           {Keyword: "NOTIFY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NOTNULL":                          {Keyword: "NOTNULL", Category: TypeFuncNameKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"NOWAIT":                           {Keyword: "NOWAIT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NTH_VALUE":                        {Keyword: "NTH_VALUE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NTILE":                            {Keyword: "NTILE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NULL":                             {Keyword: "NULL", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"NULLABLE":                         {Keyword: "NULLABLE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NULLIF":                           {Keyword: "NULLIF", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NULLS":                            {Keyword: "NULLS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NULL_ORDERING":                    {Keyword: "NULL_ORDERING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NUMBER":                           {Keyword: "NUMBER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"NUMERIC":                          {Keyword: "NUMERIC", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OBJECT":                           {Keyword: "OBJECT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OCCURRENCE":                       {Keyword: "OCCURRENCE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OCCURRENCES_REGEX":                {Keyword: "OCCURRENCES_REGEX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OCTETS":                           {Keyword: "OCTETS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OCTET_LENGTH":                     {Keyword: "OCTET_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OF":                               {Keyword: "OF", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OFF":                              {Keyword: "OFF", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OFFSET":                           {Keyword: "OFFSET", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"OIDS":                             {Keyword: "OIDS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OLD":                              {Keyword: "OLD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"OMIT":                             {Keyword: "OMIT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"ON":                               {Keyword: "ON", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"ONE":                              {Keyword: "ONE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ONLY":                             {Keyword: "ONLY", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"OPEN":                             {Keyword: "OPEN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OPERATOR":                         {Keyword: "OPERATOR", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OPTION":                           {Keyword: "OPTION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OPTIONS":                          {Keyword: "OPTIONS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OR":                               {Keyword: "OR", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ORDER":                            {Keyword: "ORDER", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"ORDERING":                         {Keyword: "ORDERING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ORDINALITY":                       {Keyword: "ORDINALITY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OTHERS":                           {Keyword: "OTHERS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 11},
+	"OUT":                              {Keyword: "OUT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OUTER":                            {Keyword: "OUTER", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"OUTPUT":                           {Keyword: "OUTPUT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OVER":                             {Keyword: "OVER", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"OVERFLOW":                         {Keyword: "OVERFLOW", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OVERLAPS":                         {Keyword: "OVERLAPS", Category: TypeFuncNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OVERLAY":                          {Keyword: "OVERLAY", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OVERRIDING":                       {Keyword: "OVERRIDING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"OWNED":                            {Keyword: "OWNED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"OWNER":                            {Keyword: "OWNER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"P":                                {Keyword: "P", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PAD":                              {Keyword: "PAD", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARALLEL":                         {Keyword: "PARALLEL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARAMETER":                        {Keyword: "PARAMETER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARAMETER_MODE":                   {Keyword: "PARAMETER_MODE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARAMETER_NAME":                   {Keyword: "PARAMETER_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARAMETER_ORDINAL_POSITION":       {Keyword: "PARAMETER_ORDINAL_POSITION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARAMETER_SPECIFIC_CATALOG":       {Keyword: "PARAMETER_SPECIFIC_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARAMETER_SPECIFIC_NAME":          {Keyword: "PARAMETER_SPECIFIC_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARAMETER_SPECIFIC_SCHEMA":        {Keyword: "PARAMETER_SPECIFIC_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARSER":                           {Keyword: "PARSER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARTIAL":                          {Keyword: "PARTIAL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PARTITION":                        {Keyword: "PARTITION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PASCAL":                           {Keyword: "PASCAL", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PASS":                             {Keyword: "PASS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PASSING":                          {Keyword: "PASSING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PASSTHROUGH":                      {Keyword: "PASSTHROUGH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PASSWORD":                         {Keyword: "PASSWORD", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PAST":                             {Keyword: "PAST", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PATH":                             {Keyword: "PATH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"PATTERN":                          {Keyword: "PATTERN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PER":                              {Keyword: "PER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PERCENT":                          {Keyword: "PERCENT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PERCENTILE_CONT":                  {Keyword: "PERCENTILE_CONT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PERCENTILE_DISC":                  {Keyword: "PERCENTILE_DISC", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PERCENT_RANK":                     {Keyword: "PERCENT_RANK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PERIOD":                           {Keyword: "PERIOD", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PERMISSION":                       {Keyword: "PERMISSION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PERMUTE":                          {Keyword: "PERMUTE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PIPE":                             {Keyword: "PIPE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PLACING":                          {Keyword: "PLACING", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"PLAN":                             {Keyword: "PLAN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PLANS":                            {Keyword: "PLANS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PLI":                              {Keyword: "PLI", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"POLICY":                           {Keyword: "POLICY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PORTION":                          {Keyword: "PORTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"POSITION":                         {Keyword: "POSITION", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"POSITION_REGEX":                   {Keyword: "POSITION_REGEX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"POWER":                            {Keyword: "POWER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRECEDES":                         {Keyword: "PRECEDES", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRECEDING":                        {Keyword: "PRECEDING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRECISION":                        {Keyword: "PRECISION", Category: ColNameKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"PREPARE":                          {Keyword: "PREPARE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PREPARED":                         {Keyword: "PREPARED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRESERVE":                         {Keyword: "PRESERVE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PREV":                             {Keyword: "PREV", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRIMARY":                          {Keyword: "PRIMARY", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"PRIOR":                            {Keyword: "PRIOR", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRIVATE":                          {Keyword: "PRIVATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRIVILEGES":                       {Keyword: "PRIVILEGES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PROCEDURAL":                       {Keyword: "PROCEDURAL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PROCEDURE":                        {Keyword: "PROCEDURE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PROCEDURES":                       {Keyword: "PROCEDURES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 11},
+	"PROGRAM":                          {Keyword: "PROGRAM", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PRUNE":                            {Keyword: "PRUNE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PTF":                              {Keyword: "PTF", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PUBLIC":                           {Keyword: "PUBLIC", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"PUBLICATION":                      {Keyword: "PUBLICATION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"QUOTE":                            {Keyword: "QUOTE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"QUOTES":                           {Keyword: "QUOTES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"RANGE":                            {Keyword: "RANGE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RANK":                             {Keyword: "RANK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"READ":                             {Keyword: "READ", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"READS":                            {Keyword: "READS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REAL":                             {Keyword: "REAL", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REASSIGN":                         {Keyword: "REASSIGN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RECHECK":                          {Keyword: "RECHECK", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RECOVERY":                         {Keyword: "RECOVERY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RECURSIVE":                        {Keyword: "RECURSIVE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REF":                              {Keyword: "REF", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REFERENCES":                       {Keyword: "REFERENCES", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"REFERENCING":                      {Keyword: "REFERENCING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"REFRESH":                          {Keyword: "REFRESH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_AVGX":                        {Keyword: "REGR_AVGX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_AVGY":                        {Keyword: "REGR_AVGY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_COUNT":                       {Keyword: "REGR_COUNT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_INTERCEPT":                   {Keyword: "REGR_INTERCEPT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_R2":                          {Keyword: "REGR_R2", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_SLOPE":                       {Keyword: "REGR_SLOPE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_SXX":                         {Keyword: "REGR_SXX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_SXY":                         {Keyword: "REGR_SXY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REGR_SYY":                         {Keyword: "REGR_SYY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REINDEX":                          {Keyword: "REINDEX", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RELATIVE":                         {Keyword: "RELATIVE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RELEASE":                          {Keyword: "RELEASE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RENAME":                           {Keyword: "RENAME", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REPEATABLE":                       {Keyword: "REPEATABLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REPLACE":                          {Keyword: "REPLACE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REPLICA":                          {Keyword: "REPLICA", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REQUIRING":                        {Keyword: "REQUIRING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RESET":                            {Keyword: "RESET", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RESPECT":                          {Keyword: "RESPECT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RESTART":                          {Keyword: "RESTART", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RESTORE":                          {Keyword: "RESTORE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RESTRICT":                         {Keyword: "RESTRICT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RESULT":                           {Keyword: "RESULT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RETURN":                           {Keyword: "RETURN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 14},
+	"RETURNED_CARDINALITY":             {Keyword: "RETURNED_CARDINALITY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RETURNED_LENGTH":                  {Keyword: "RETURNED_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RETURNED_OCTET_LENGTH":            {Keyword: "RETURNED_OCTET_LENGTH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RETURNED_SQLSTATE":                {Keyword: "RETURNED_SQLSTATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RETURNING":                        {Keyword: "RETURNING", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"RETURNS":                          {Keyword: "RETURNS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"REVOKE":                           {Keyword: "REVOKE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RIGHT":                            {Keyword: "RIGHT", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"ROLE":                             {Keyword: "ROLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROLLBACK":                         {Keyword: "ROLLBACK", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROLLUP":                           {Keyword: "ROLLUP", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROUTINE":                          {Keyword: "ROUTINE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 11},
+	"ROUTINES":                         {Keyword: "ROUTINES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 11},
+	"ROUTINE_CATALOG":                  {Keyword: "ROUTINE_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROUTINE_NAME":                     {Keyword: "ROUTINE_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROUTINE_SCHEMA":                   {Keyword: "ROUTINE_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROW":                              {Keyword: "ROW", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROWS":                             {Keyword: "ROWS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROW_COUNT":                        {Keyword: "ROW_COUNT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ROW_NUMBER":                       {Keyword: "ROW_NUMBER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RPAD":                             {Keyword: "RPAD", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RTRIM":                            {Keyword: "RTRIM", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RULE":                             {Keyword: "RULE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"RUNNING":                          {Keyword: "RUNNING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SAVEPOINT":                        {Keyword: "SAVEPOINT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCALAR":                           {Keyword: "SCALAR", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 16},
+	"SCALE":                            {Keyword: "SCALE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCHEMA":                           {Keyword: "SCHEMA", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCHEMAS":                          {Keyword: "SCHEMAS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCHEMA_NAME":                      {Keyword: "SCHEMA_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCOPE":                            {Keyword: "SCOPE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCOPE_CATALOG":                    {Keyword: "SCOPE_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCOPE_NAME":                       {Keyword: "SCOPE_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCOPE_SCHEMA":                     {Keyword: "SCOPE_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SCROLL":                           {Keyword: "SCROLL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SEARCH":                           {Keyword: "SEARCH", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SECOND":                           {Keyword: "SECOND", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"SECTION":                          {Keyword: "SECTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SECURITY":                         {Keyword: "SECURITY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SEEK":                             {Keyword: "SEEK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SELECT":                           {Keyword: "SELECT", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"SELECTIVE":                        {Keyword: "SELECTIVE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SELF":                             {Keyword: "SELF", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SEMANTICS":                        {Keyword: "SEMANTICS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SENSITIVE":                        {Keyword: "SENSITIVE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SEQUENCE":                         {Keyword: "SEQUENCE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SEQUENCES":                        {Keyword: "SEQUENCES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SERIALIZABLE":                     {Keyword: "SERIALIZABLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SERVER":                           {Keyword: "SERVER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SERVER_NAME":                      {Keyword: "SERVER_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SESSION":                          {Keyword: "SESSION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SESSION_USER":                     {Keyword: "SESSION_USER", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"SET":                              {Keyword: "SET", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SETOF":                            {Keyword: "SETOF", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SETS":                             {Keyword: "SETS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SHARE":                            {Keyword: "SHARE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SHOW":                             {Keyword: "SHOW", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SIMILAR":                          {Keyword: "SIMILAR", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"SIMPLE":                           {Keyword: "SIMPLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SIN":                              {Keyword: "SIN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SINH":                             {Keyword: "SINH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SIZE":                             {Keyword: "SIZE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SKIP":                             {Keyword: "SKIP", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SMALLINT":                         {Keyword: "SMALLINT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SNAPSHOT":                         {Keyword: "SNAPSHOT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SOME":                             {Keyword: "SOME", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"SORT_DIRECTION":                   {Keyword: "SORT_DIRECTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SOURCE":                           {Keyword: "SOURCE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"SPACE":                            {Keyword: "SPACE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SPECIFIC":                         {Keyword: "SPECIFIC", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SPECIFICTYPE":                     {Keyword: "SPECIFICTYPE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SPECIFIC_NAME":                    {Keyword: "SPECIFIC_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SQL":                              {Keyword: "SQL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SQLCODE":                          {Keyword: "SQLCODE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SQLERROR":                         {Keyword: "SQLERROR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SQLEXCEPTION":                     {Keyword: "SQLEXCEPTION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SQLSTATE":                         {Keyword: "SQLSTATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SQLWARNING":                       {Keyword: "SQLWARNING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SQRT":                             {Keyword: "SQRT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STABLE":                           {Keyword: "STABLE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STANDALONE":                       {Keyword: "STANDALONE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"START":                            {Keyword: "START", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STATE":                            {Keyword: "STATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STATEMENT":                        {Keyword: "STATEMENT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STATIC":                           {Keyword: "STATIC", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STATISTICS":                       {Keyword: "STATISTICS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STDDEV_POP":                       {Keyword: "STDDEV_POP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STDDEV_SAMP":                      {Keyword: "STDDEV_SAMP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STDIN":                            {Keyword: "STDIN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STDOUT":                           {Keyword: "STDOUT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STORAGE":                          {Keyword: "STORAGE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STORED":                           {Keyword: "STORED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 12},
+	"STRICT":                           {Keyword: "STRICT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STRING":                           {Keyword: "STRING", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"STRIP":                            {Keyword: "STRIP", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STRUCTURE":                        {Keyword: "STRUCTURE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"STYLE":                            {Keyword: "STYLE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUBCLASS_ORIGIN":                  {Keyword: "SUBCLASS_ORIGIN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUBMULTISET":                      {Keyword: "SUBMULTISET", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUBSCRIPTION":                     {Keyword: "SUBSCRIPTION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"SUBSET":                           {Keyword: "SUBSET", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUBSTRING":                        {Keyword: "SUBSTRING", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUBSTRING_REGEX":                  {Keyword: "SUBSTRING_REGEX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUCCEEDS":                         {Keyword: "SUCCEEDS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUM":                              {Keyword: "SUM", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SUPPORT":                          {Keyword: "SUPPORT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SYMMETRIC":                        {Keyword: "SYMMETRIC", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"SYSID":                            {Keyword: "SYSID", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SYSTEM":                           {Keyword: "SYSTEM", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SYSTEM_TIME":                      {Keyword: "SYSTEM_TIME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"SYSTEM_USER":                      {Keyword: "SYSTEM_USER", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 16},
+	"T":                                {Keyword: "T", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TABLE":                            {Keyword: "TABLE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"TABLES":                           {Keyword: "TABLES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TABLESAMPLE":                      {Keyword: "TABLESAMPLE", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"TABLESPACE":                       {Keyword: "TABLESPACE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TABLE_NAME":                       {Keyword: "TABLE_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TAN":                              {Keyword: "TAN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TANH":                             {Keyword: "TANH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TARGET":                           {Keyword: "TARGET", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"TEMP":                             {Keyword: "TEMP", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TEMPLATE":                         {Keyword: "TEMPLATE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TEMPORARY":                        {Keyword: "TEMPORARY", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TEXT":                             {Keyword: "TEXT", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"THEN":                             {Keyword: "THEN", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"THROUGH":                          {Keyword: "THROUGH", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TIES":                             {Keyword: "TIES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 13},
+	"TIME":                             {Keyword: "TIME", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TIMESTAMP":                        {Keyword: "TIMESTAMP", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TIMEZONE_HOUR":                    {Keyword: "TIMEZONE_HOUR", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TIMEZONE_MINUTE":                  {Keyword: "TIMEZONE_MINUTE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TO":                               {Keyword: "TO", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"TOKEN":                            {Keyword: "TOKEN", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TOP_LEVEL_COUNT":                  {Keyword: "TOP_LEVEL_COUNT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRAILING":                         {Keyword: "TRAILING", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"TRANSACTION":                      {Keyword: "TRANSACTION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSACTIONS_COMMITTED":           {Keyword: "TRANSACTIONS_COMMITTED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSACTIONS_ROLLED_BACK":         {Keyword: "TRANSACTIONS_ROLLED_BACK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSACTION_ACTIVE":               {Keyword: "TRANSACTION_ACTIVE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSFORM":                        {Keyword: "TRANSFORM", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSFORMS":                       {Keyword: "TRANSFORMS", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSLATE":                        {Keyword: "TRANSLATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSLATE_REGEX":                  {Keyword: "TRANSLATE_REGEX", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRANSLATION":                      {Keyword: "TRANSLATION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TREAT":                            {Keyword: "TREAT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRIGGER":                          {Keyword: "TRIGGER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRIGGER_CATALOG":                  {Keyword: "TRIGGER_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRIGGER_NAME":                     {Keyword: "TRIGGER_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRIGGER_SCHEMA":                   {Keyword: "TRIGGER_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRIM":                             {Keyword: "TRIM", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRIM_ARRAY":                       {Keyword: "TRIM_ARRAY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRUE":                             {Keyword: "TRUE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"TRUNCATE":                         {Keyword: "TRUNCATE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TRUSTED":                          {Keyword: "TRUSTED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TYPE":                             {Keyword: "TYPE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"TYPES":                            {Keyword: "TYPES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UESCAPE":                          {Keyword: "UESCAPE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNBOUNDED":                        {Keyword: "UNBOUNDED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNCOMMITTED":                      {Keyword: "UNCOMMITTED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNCONDITIONAL":                    {Keyword: "UNCONDITIONAL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 17},
+	"UNDER":                            {Keyword: "UNDER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNENCRYPTED":                      {Keyword: "UNENCRYPTED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNION":                            {Keyword: "UNION", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"UNIQUE":                           {Keyword: "UNIQUE", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"UNKNOWN":                          {Keyword: "UNKNOWN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNLINK":                           {Keyword: "UNLINK", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNLISTEN":                         {Keyword: "UNLISTEN", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNLOGGED":                         {Keyword: "UNLOGGED", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNMATCHED":                        {Keyword: "UNMATCHED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNNAMED":                          {Keyword: "UNNAMED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNNEST":                           {Keyword: "UNNEST", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNTIL":                            {Keyword: "UNTIL", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UNTYPED":                          {Keyword: "UNTYPED", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UPDATE":                           {Keyword: "UPDATE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UPPER":                            {Keyword: "UPPER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"URI":                              {Keyword: "URI", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"USAGE":                            {Keyword: "USAGE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"USER":                             {Keyword: "USER", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"USER_DEFINED_TYPE_CATALOG":        {Keyword: "USER_DEFINED_TYPE_CATALOG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"USER_DEFINED_TYPE_CODE":           {Keyword: "USER_DEFINED_TYPE_CODE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"USER_DEFINED_TYPE_NAME":           {Keyword: "USER_DEFINED_TYPE_NAME", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"USER_DEFINED_TYPE_SCHEMA":         {Keyword: "USER_DEFINED_TYPE_SCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"USING":                            {Keyword: "USING", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"UTF16":                            {Keyword: "UTF16", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UTF32":                            {Keyword: "UTF32", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"UTF8":                             {Keyword: "UTF8", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VACUUM":                           {Keyword: "VACUUM", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VALID":                            {Keyword: "VALID", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VALIDATE":                         {Keyword: "VALIDATE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VALIDATOR":                        {Keyword: "VALIDATOR", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VALUE":                            {Keyword: "VALUE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VALUES":                           {Keyword: "VALUES", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VALUE_OF":                         {Keyword: "VALUE_OF", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VARBINARY":                        {Keyword: "VARBINARY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VARCHAR":                          {Keyword: "VARCHAR", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VARIADIC":                         {Keyword: "VARIADIC", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"VARYING":                          {Keyword: "VARYING", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"VAR_POP":                          {Keyword: "VAR_POP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VAR_SAMP":                         {Keyword: "VAR_SAMP", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VERBOSE":                          {Keyword: "VERBOSE", Category: TypeFuncNameKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"VERSION":                          {Keyword: "VERSION", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VERSIONING":                       {Keyword: "VERSIONING", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VIEW":                             {Keyword: "VIEW", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VIEWS":                            {Keyword: "VIEWS", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"VOLATILE":                         {Keyword: "VOLATILE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"WHEN":                             {Keyword: "WHEN", Category: ReservedKeyword, Reserved: true, RequiresAS: false, Since: 0},
+	"WHENEVER":                         {Keyword: "WHENEVER", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"WHERE":                            {Keyword: "WHERE", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"WHITESPACE":                       {Keyword: "WHITESPACE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"WIDTH_BUCKET":                     {Keyword: "WIDTH_BUCKET", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"WINDOW":                           {Keyword: "WINDOW", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"WITH":                             {Keyword: "WITH", Category: ReservedKeyword, Reserved: true, RequiresAS: true, Since: 0},
+	"WITHIN":                           {Keyword: "WITHIN", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"WITHOUT":                          {Keyword: "WITHOUT", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"WORK":                             {Keyword: "WORK", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"WRAPPER":                          {Keyword: "WRAPPER", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"WRITE":                            {Keyword: "WRITE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XML":                              {Keyword: "XML", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLAGG":                           {Keyword: "XMLAGG", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLATTRIBUTES":                    {Keyword: "XMLATTRIBUTES", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLBINARY":                        {Keyword: "XMLBINARY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLCAST":                          {Keyword: "XMLCAST", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLCOMMENT":                       {Keyword: "XMLCOMMENT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLCONCAT":                        {Keyword: "XMLCONCAT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLDECLARATION":                   {Keyword: "XMLDECLARATION", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLDOCUMENT":                      {Keyword: "XMLDOCUMENT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLELEMENT":                       {Keyword: "XMLELEMENT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLEXISTS":                        {Keyword: "XMLEXISTS", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLFOREST":                        {Keyword: "XMLFOREST", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLITERATE":                       {Keyword: "XMLITERATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLNAMESPACES":                    {Keyword: "XMLNAMESPACES", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"XMLPARSE":                         {Keyword: "XMLPARSE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLPI":                            {Keyword: "XMLPI", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLQUERY":                         {Keyword: "XMLQUERY", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLROOT":                          {Keyword: "XMLROOT", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLSCHEMA":                        {Keyword: "XMLSCHEMA", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLSERIALIZE":                     {Keyword: "XMLSERIALIZE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLTABLE":                         {Keyword: "XMLTABLE", Category: ColNameKeyword, Reserved: false, RequiresAS: false, Since: 10},
+	"XMLTEXT":                          {Keyword: "XMLTEXT", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"XMLVALIDATE":                      {Keyword: "XMLVALIDATE", Category: NotKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"YEAR":                             {Keyword: "YEAR", Category: UnreservedKeyword, Reserved: false, RequiresAS: true, Since: 0},
+	"YES":                              {Keyword: "YES", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+	"ZONE":                             {Keyword: "ZONE", Category: UnreservedKeyword, Reserved: false, RequiresAS: false, Since: 0},
+}
+
+// LookupKeyword returns the keyword spelled by name, in any case, on the
+// given PostgreSQL major version. A version of 0 means the latest version.
+// Words the SQL standard reserves but PostgreSQL does not are not reported.
+func LookupKeyword(name string, version int) (Keyword, bool) {
+	keyword, ok := Keywords[strings.ToUpper(name)]
+	if !ok || keyword.Category == NotKeyword {
+		return Keyword{}, false
+	}
+	if version > 0 && keyword.Since > version {
+		return Keyword{}, false
+	}
+	return keyword, true
+}
+
+// AliasRequiresAS reports whether name must be preceded by AS when used as a
+// column alias on the given PostgreSQL major version, 0 meaning the latest.
+// Before PostgreSQL 14 every keyword did, so "SELECT 1 value" only works
+// from 14 on; since then only the keywords marked RequiresAS do.
+func AliasRequiresAS(name string, version int) bool {
+	keyword, ok := LookupKeyword(name, version)
+	if !ok {
+		return false
+	}
+	if version > 0 && version < 14 {
+		return true
+	}
+	return keyword.RequiresAS
 }
@@ -0,0 +1,54 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupKeyword(t *testing.T) {
+	keyword, ok := pgparser.LookupKeyword("select", 0)
+	require.True(t, ok)
+	require.Equal(t, pgparser.ReservedKeyword, keyword.Category)
+
+	keyword, ok = pgparser.LookupKeyword("Between", 12)
+	require.True(t, ok)
+	require.Equal(t, pgparser.ColNameKeyword, keyword.Category)
+
+	// MERGE arrived in PostgreSQL 15.
+	_, ok = pgparser.LookupKeyword("merge", 14)
+	require.False(t, ok)
+	_, ok = pgparser.LookupKeyword("merge", 15)
+	require.True(t, ok)
+
+	// Reserved by the SQL standard only.
+	_, ok = pgparser.LookupKeyword("abs", 0)
+	require.False(t, ok)
+}
+
+func TestAliasRequiresAS(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		want    bool
+	}{
+		{name: "value", version: 12, want: true},
+		{name: "value", version: 17, want: false},
+		{name: "value", version: 0, want: false},
+		{name: "year", version: 17, want: true},
+		{name: "from", version: 17, want: true},
+		{name: "select", version: 17, want: false},
+		{name: "total", version: 12, want: false},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, pgparser.AliasRequiresAS(tt.name, tt.version), "%s on %d", tt.name, tt.version)
+	}
+}
+
+func TestNeedsQuotingForVersion(t *testing.T) {
+	require.False(t, pgparser.NeedsQuotingForVersion("json_table", 16))
+	require.True(t, pgparser.NeedsQuotingForVersion("json_table", 17))
+	require.True(t, pgparser.NeedsQuoting("json_table"))
+}
@@ -0,0 +1,673 @@
+package postgresql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// PlpgsqlProblem is what is wrong in a PL/pgSQL function body.
+type PlpgsqlProblem int
+
+const (
+	// UndeclaredVariable is an assignment to, or a read outside any query of,
+	// a name that is not a variable, parameter or label.
+	UndeclaredVariable PlpgsqlProblem = iota
+	// UnusedVariable is a declared variable that is assigned but never read.
+	UnusedVariable
+	// UnreachableCode is a statement that follows a RETURN, RAISE EXCEPTION,
+	// EXIT or CONTINUE in the same statement list.
+	UnreachableCode
+	// MissingReturn is a function that can reach the end of its body without
+	// returning a value.
+	MissingReturn
+	// ConcatenatedDynamicSQL is an EXECUTE whose command string concatenates
+	// values that are not quoted with quote_ident, quote_literal or
+	// quote_nullable, which risks SQL injection.
+	ConcatenatedDynamicSQL
+	// UnknownLabel is an EXIT naming no enclosing block or loop, or a CONTINUE
+	// naming no enclosing loop.
+	UnknownLabel
+)
+
+// PlpgsqlError reports a problem in a PL/pgSQL function body. Line and Column
+// are positions in the script the function was created in.
+type PlpgsqlError struct {
+	Line    int
+	Column  int
+	Problem PlpgsqlProblem
+	// Name is the variable or label the problem is about, if any.
+	Name string
+}
+
+func (e *PlpgsqlError) Error() string {
+	switch e.Problem {
+	case UndeclaredVariable:
+		return fmt.Sprintf("line %d:%d variable %s is not declared", e.Line, e.Column, e.Name)
+	case UnusedVariable:
+		return fmt.Sprintf("line %d:%d variable %s is assigned but never read", e.Line, e.Column, e.Name)
+	case UnreachableCode:
+		return fmt.Sprintf("line %d:%d unreachable code", e.Line, e.Column)
+	case MissingReturn:
+		return fmt.Sprintf("line %d:%d control reaches end of function without RETURN", e.Line, e.Column)
+	case ConcatenatedDynamicSQL:
+		return fmt.Sprintf("line %d:%d EXECUTE of a concatenated string, use format() or USING to pass values", e.Line, e.Column)
+	default:
+		return fmt.Sprintf("line %d:%d label %s does not exist", e.Line, e.Column, e.Name)
+	}
+}
+
+// CheckPlpgsql analyzes the body of every LANGUAGE plpgsql function and
+// procedure created, and of every DO block, in a tree returned by the
+// PostgreSQL parser. Names read in a query with a FROM clause, or anywhere in
+// an embedded SQL statement, may be columns and are never reported as
+// undeclared.
+func CheckPlpgsql(tree antlr.Tree) []*PlpgsqlError {
+	parseTree, ok := tree.(antlr.ParseTree)
+	if !ok {
+		return nil
+	}
+	finder := &plpgsqlFunctionFinder{BasePostgreSQLParserListener: &BasePostgreSQLParserListener{}}
+	antlr.ParseTreeWalkerDefault.Walk(finder, parseTree)
+	return finder.errors
+}
+
+type plpgsqlFunctionFinder struct {
+	*BasePostgreSQLParserListener
+
+	errors []*PlpgsqlError
+}
+
+func (f *plpgsqlFunctionFinder) EnterCreatefunctionstmt(ctx *CreatefunctionstmtContext) {
+	var funcAs *Func_asContext
+	for _, item := range ctx.Createfunc_opt_list().AllCreatefunc_opt_item() {
+		if as, ok := item.Func_as().(*Func_asContext); ok {
+			funcAs = as
+			break
+		}
+	}
+	if funcAs == nil {
+		return
+	}
+	root, ok := funcAs.Definition.(*PlsqlrootContext)
+	if !ok {
+		return
+	}
+
+	parts := splitQualifiedName(ctx.Func_name().GetText())
+	c := &plpgsqlChecker{function: parts[len(parts)-1]}
+	c.push("", false)
+	hasOut := false
+	if list := ctx.Func_args_with_defaults().Func_args_with_defaults_list(); list != nil {
+		for _, arg := range list.AllFunc_arg_with_default() {
+			if name := arg.Func_arg().Param_name(); name != nil {
+				c.declare(name.GetText(), name.GetStart(), false)
+			}
+			if class := arg.Func_arg().Arg_class(); class != nil && (class.OUT_P() != nil || class.INOUT() != nil) {
+				hasOut = true
+			}
+		}
+	}
+	if columns := ctx.Table_func_column_list(); columns != nil {
+		for _, column := range columns.AllTable_func_column() {
+			c.declare(column.Param_name().GetText(), column.GetStart(), false)
+		}
+		hasOut = true
+	}
+
+	returnType, setOf := "", false
+	if ret := ctx.Func_return(); ret != nil {
+		setOf = strings.HasPrefix(strings.ToLower(ret.GetText()), "setof")
+		parts := splitQualifiedName(ret.GetText())
+		returnType = parts[len(parts)-1]
+	}
+	c.declare("found", nil, false)
+	for _, name := range plpgsqlSpecialVariables[returnType] {
+		c.declare(name, nil, false)
+	}
+
+	needsReturn := ctx.PROCEDURE() == nil && returnType != "" && returnType != "void" && !setOf && !hasOut
+	f.check(c, root, needsReturn)
+}
+
+func (f *plpgsqlFunctionFinder) EnterDostmt(ctx *DostmtContext) {
+	for _, itemInterface := range ctx.Dostmt_opt_list().AllDostmt_opt_item() {
+		item, ok := itemInterface.(*Dostmt_opt_itemContext)
+		if !ok {
+			continue
+		}
+		if root, ok := item.Definition.(*PlsqlrootContext); ok {
+			c := &plpgsqlChecker{}
+			c.push("", false)
+			c.declare("found", nil, false)
+			f.check(c, root, false)
+		}
+	}
+}
+
+// check analyzes a body with c, whose outermost scope holds the parameters.
+func (f *plpgsqlFunctionFinder) check(c *plpgsqlChecker, root *PlsqlrootContext, needsReturn bool) {
+	block := root.Pl_function().Pl_block()
+	c.block(block)
+	if needsReturn && c.blockFallsThrough(block) {
+		c.report(block.END_P().GetSymbol(), MissingReturn, "")
+	}
+	c.pop()
+
+	sort.SliceStable(c.errors, func(i, j int) bool {
+		if c.errors[i].Line != c.errors[j].Line {
+			return c.errors[i].Line < c.errors[j].Line
+		}
+		return c.errors[i].Column < c.errors[j].Column
+	})
+	f.errors = append(f.errors, c.errors...)
+}
+
+// plpgsqlSpecialVariables are the variables PL/pgSQL declares in trigger and
+// event trigger functions, by return type.
+var plpgsqlSpecialVariables = map[string][]string{
+	"trigger": {
+		"new", "old", "tg_name", "tg_when", "tg_level", "tg_op", "tg_relid", "tg_relname",
+		"tg_table_name", "tg_table_schema", "tg_nargs", "tg_argv",
+	},
+	"event_trigger": {"tg_event", "tg_tag"},
+}
+
+type plpgsqlVariable struct {
+	name  string
+	token antlr.Token
+	// local is set for the variables of DECLARE sections, which are reported
+	// when they are assigned but never read.
+	local    bool
+	assigned bool
+	read     bool
+}
+
+type plpgsqlScope struct {
+	label     string
+	loop      bool
+	variables map[string]*plpgsqlVariable
+	declared  []*plpgsqlVariable
+}
+
+type plpgsqlChecker struct {
+	// function is the function name, which qualifies its parameters.
+	function string
+	scopes   []*plpgsqlScope
+	errors   []*PlpgsqlError
+}
+
+func (c *plpgsqlChecker) report(token antlr.Token, problem PlpgsqlProblem, name string) {
+	c.errors = append(c.errors, &PlpgsqlError{Line: token.GetLine(), Column: token.GetColumn(), Problem: problem, Name: name})
+}
+
+func (c *plpgsqlChecker) push(label string, loop bool) {
+	c.scopes = append(c.scopes, &plpgsqlScope{
+		label:     label,
+		loop:      loop,
+		variables: make(map[string]*plpgsqlVariable),
+	})
+}
+
+func (c *plpgsqlChecker) pop() {
+	scope := c.scopes[len(c.scopes)-1]
+	c.scopes = c.scopes[:len(c.scopes)-1]
+	for _, v := range scope.declared {
+		if v.local && v.assigned && !v.read {
+			c.report(v.token, UnusedVariable, v.name)
+		}
+	}
+}
+
+func (c *plpgsqlChecker) declare(name string, token antlr.Token, local bool) *plpgsqlVariable {
+	scope := c.scopes[len(c.scopes)-1]
+	v := &plpgsqlVariable{name: identifierName(name), token: token, local: local}
+	scope.variables[v.name] = v
+	scope.declared = append(scope.declared, v)
+	return v
+}
+
+// labelScope returns the innermost block or loop with the label, or nil.
+func (c *plpgsqlChecker) labelScope(label string) *plpgsqlScope {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if c.scopes[i].label == label {
+			return c.scopes[i]
+		}
+	}
+	return nil
+}
+
+// qualifierScope returns the scope whose variables name qualifies: a block or
+// loop label, or the function name for the parameters.
+func (c *plpgsqlChecker) qualifierScope(name string) *plpgsqlScope {
+	if scope := c.labelScope(name); scope != nil {
+		return scope
+	}
+	if name == c.function {
+		return c.scopes[0]
+	}
+	return nil
+}
+
+// resolve returns the variable a possibly qualified reference names, or nil.
+func (c *plpgsqlChecker) resolve(parts []string) *plpgsqlVariable {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if v, ok := c.scopes[i].variables[parts[0]]; ok {
+			return v
+		}
+	}
+	if len(parts) > 1 {
+		if scope := c.qualifierScope(parts[0]); scope != nil {
+			return scope.variables[parts[1]]
+		}
+	}
+	return nil
+}
+
+// reference records a read or a write of a variable. Unresolved names are
+// reported unless ambiguous is set, i.e. the name may be a column.
+func (c *plpgsqlChecker) reference(parts []string, token antlr.Token, write, ambiguous bool) {
+	v := c.resolve(parts)
+	switch {
+	case v == nil && !ambiguous:
+		name := parts[0]
+		if len(parts) > 1 && c.qualifierScope(parts[0]) != nil {
+			name += "." + parts[1]
+		}
+		c.report(token, UndeclaredVariable, name)
+	case v == nil:
+	case write:
+		v.assigned = true
+	default:
+		v.read = true
+	}
+}
+
+func (c *plpgsqlChecker) block(b IPl_blockContext) {
+	label := ""
+	if l := b.Decl_sect().Opt_block_label(); l != nil {
+		label = identifierName(l.Label_decl().Any_identifier().GetText())
+	}
+	c.push(label, false)
+	if stmts := b.Decl_sect().Decl_stmts(); stmts != nil {
+		for _, stmt := range stmts.AllDecl_stmt() {
+			if d := stmt.Decl_statement(); d != nil {
+				c.declaration(d)
+			}
+		}
+	}
+	c.statements(b.Proc_sect())
+	if e := b.Exception_sect(); e != nil {
+		for _, handler := range e.Proc_exceptions().AllProc_exception() {
+			c.push("", false)
+			c.declare("sqlstate", nil, false)
+			c.declare("sqlerrm", nil, false)
+			c.statements(handler.Proc_sect())
+			c.pop()
+		}
+	}
+	c.pop()
+}
+
+func (c *plpgsqlChecker) declaration(d IDecl_statementContext) {
+	name := d.Decl_varname()
+	switch {
+	case d.ALIAS() != nil:
+		if item := d.Decl_aliasitem().Colid(); item != nil {
+			c.reference([]string{identifierName(item.GetText())}, item.GetStart(), false, false)
+		}
+		c.declare(name.GetText(), name.GetStart(), false)
+	case d.CURSOR() != nil:
+		c.push("", false)
+		if args := d.Decl_cursor_args(); args != nil {
+			for _, arg := range args.Decl_cursor_arglist().AllDecl_cursor_arg() {
+				c.declare(arg.Decl_varname().GetText(), arg.GetStart(), false)
+			}
+		}
+		c.walk(d.Decl_cursor_query(), true)
+		c.pop()
+		c.declare(name.GetText(), name.GetStart(), false)
+	default:
+		// The default value is evaluated before the variable comes into scope.
+		if def := d.Decl_defval(); def != nil {
+			c.walk(def.Sql_expression(), false)
+		}
+		v := c.declare(name.GetText(), name.GetStart(), true)
+		v.assigned = d.Decl_defval() != nil
+	}
+}
+
+// statements checks a statement list and reports the first statement that
+// follows one control cannot pass.
+func (c *plpgsqlChecker) statements(sect IProc_sectContext) {
+	unreachable, reported := false, false
+	for _, stmt := range sect.AllProc_stmt() {
+		if unreachable && !reported {
+			c.report(stmt.GetStart(), UnreachableCode, "")
+			reported = true
+		}
+		c.statement(stmt)
+		if !c.fallsThrough(stmt) {
+			unreachable = true
+		}
+	}
+}
+
+func (c *plpgsqlChecker) statement(stmt IProc_stmtContext) {
+	switch n := stmt.GetChild(0).(type) {
+	case *Pl_blockContext:
+		c.block(n)
+	case *Stmt_loopContext:
+		c.loop(n.Opt_loop_label(), n.Loop_body(), nil)
+	case *Stmt_whileContext:
+		c.walk(n.Expr_until_loop(), false)
+		c.loop(n.Opt_loop_label(), n.Loop_body(), nil)
+	case *Stmt_forContext:
+		c.forLoop(n)
+	case *Stmt_foreach_aContext:
+		c.walk(n.A_expr(), false)
+		for _, name := range n.For_variable().Any_name_list().AllAny_name() {
+			c.reference(splitQualifiedName(name.GetText()), name.GetStart(), true, false)
+		}
+		c.loop(n.Opt_loop_label(), n.Loop_body(), nil)
+	case *Stmt_exitContext:
+		if l := n.Opt_label(); l != nil {
+			label := identifierName(l.GetText())
+			// CONTINUE can only name a loop.
+			if scope := c.labelScope(label); scope == nil || n.Exit_type().CONTINUE_P() != nil && !scope.loop {
+				c.report(l.GetStart(), UnknownLabel, label)
+			}
+		}
+		if cond := n.Opt_exitcond(); cond != nil {
+			c.walk(cond, false)
+		}
+	case *Stmt_assignContext:
+		c.assignVar(n.Assign_var())
+		c.walk(n.Sql_expression(), false)
+	case *Stmt_getdiagContext:
+		for _, item := range n.Getdiag_list().AllGetdiag_list_item() {
+			c.assignVar(item.Getdiag_target().Assign_var())
+		}
+	case *Stmt_execsqlContext:
+		c.walk(n, true)
+	case *Stmt_dynexecuteContext:
+		c.checkDynamicSQL(n.A_expr())
+		c.walk(n, false)
+	case *Stmt_returnContext:
+		if n.EXECUTE() != nil {
+			c.checkDynamicSQL(n.A_expr())
+		}
+		c.walk(n, false)
+	case *Stmt_openContext:
+		if n.EXECUTE() != nil {
+			c.checkDynamicSQL(n.Sql_expression())
+		}
+		if cursor := n.Colid(); cursor != nil {
+			c.reference([]string{identifierName(cursor.GetText())}, cursor.GetStart(), false, false)
+		}
+		c.walk(n, false)
+	default:
+		c.walk(n, false)
+	}
+}
+
+func (c *plpgsqlChecker) loop(label IOpt_loop_labelContext, body ILoop_bodyContext, variables IFor_variableContext) {
+	name := ""
+	if label != nil {
+		name = identifierName(label.Label_decl().Any_identifier().GetText())
+	}
+	c.push(name, true)
+	if variables != nil {
+		for _, v := range variables.Any_name_list().AllAny_name() {
+			c.declare(v.GetText(), v.GetStart(), false)
+		}
+	}
+	c.statements(body.Proc_sect())
+	c.pop()
+}
+
+// forLoop checks a FOR loop. Integer and cursor loops declare their loop
+// variable; query loops assign variables declared beforehand.
+func (c *plpgsqlChecker) forLoop(n *Stmt_forContext) {
+	control := n.For_control()
+	for _, child := range control.GetChildren() {
+		if _, ok := child.(*For_variableContext); !ok {
+			c.walk(child, false)
+		}
+	}
+	switch {
+	case control.DOT_DOT() != nil:
+		c.loop(n.Opt_loop_label(), n.Loop_body(), control.For_variable())
+	case control.Cursor_name() != nil:
+		cursor := control.Cursor_name()
+		c.reference([]string{identifierName(cursor.GetText())}, cursor.GetStart(), false, false)
+		c.loop(n.Opt_loop_label(), n.Loop_body(), control.For_variable())
+	default:
+		if control.EXECUTE() != nil {
+			c.checkDynamicSQL(control.A_expr(0))
+		}
+		for _, name := range control.For_variable().Any_name_list().AllAny_name() {
+			c.reference(splitQualifiedName(name.GetText()), name.GetStart(), true, false)
+		}
+		c.loop(n.Opt_loop_label(), n.Loop_body(), nil)
+	}
+}
+
+func (c *plpgsqlChecker) assignVar(v IAssign_varContext) {
+	if name := v.Any_name(); name != nil {
+		c.reference(splitQualifiedName(name.GetText()), name.GetStart(), true, false)
+	}
+	for _, subscript := range v.AllExpr_until_rightbracket() {
+		c.walk(subscript, false)
+	}
+}
+
+// walk records the variable references in a subtree. Names are ambiguous in
+// queries with a FROM clause, where they may be columns.
+func (c *plpgsqlChecker) walk(node antlr.Tree, ambiguous bool) {
+	if node == nil {
+		// A statement the parser could not recover has no children.
+		return
+	}
+	switch n := node.(type) {
+	case *Proc_sectContext:
+		c.statements(n)
+		return
+	case *Sql_expressionContext:
+		ambiguous = ambiguous || n.From_clause() != nil
+	case *Simple_select_pramaryContext:
+		ambiguous = ambiguous || n.From_clause() != nil
+	case *ColumnrefContext:
+		parts := []string{identifierName(n.Colid().GetText())}
+		if indirection := n.Indirection(); indirection != nil {
+			if attr := indirection.Indirection_el(0).Attr_name(); attr != nil {
+				parts = append(parts, identifierName(attr.GetText()))
+			}
+		}
+		c.reference(parts, n.GetStart(), false, ambiguous)
+	case *Into_targetContext:
+		for _, expr := range n.Expr_list().AllA_expr() {
+			if ref := soleColumnref(expr); ref != nil {
+				c.reference(splitQualifiedName(ref.Colid().GetText()), ref.GetStart(), true, false)
+				if indirection := ref.Indirection(); indirection != nil {
+					c.walk(indirection, false)
+				}
+				continue
+			}
+			c.walk(expr, false)
+		}
+		return
+	case *OpttempTableNameContext:
+		name := n.Qualified_name()
+		c.reference([]string{identifierName(name.Colid().GetText())}, name.GetStart(), true, false)
+		return
+	case *Cursor_variableContext:
+		if cursor := n.Colid(); cursor != nil {
+			c.reference([]string{identifierName(cursor.GetText())}, cursor.GetStart(), false, false)
+		}
+		return
+	}
+	for i := 0; i < node.GetChildCount(); i++ {
+		c.walk(node.GetChild(i), ambiguous)
+	}
+}
+
+func (c *plpgsqlChecker) checkDynamicSQL(command antlr.ParserRuleContext) {
+	if concatenatesUnquoted(command) {
+		c.report(command.GetStart(), ConcatenatedDynamicSQL, "")
+	}
+}
+
+// fallsThrough reports whether control can pass from stmt to the statement
+// after it.
+func (c *plpgsqlChecker) fallsThrough(stmt IProc_stmtContext) bool {
+	switch n := stmt.GetChild(0).(type) {
+	case *Pl_blockContext:
+		return c.blockFallsThrough(n)
+	case *Stmt_returnContext:
+		return n.NEXT() != nil || n.QUERY() != nil
+	case *Stmt_raiseContext:
+		// RAISE defaults to the EXCEPTION level.
+		level := n.Opt_stmt_raise_level()
+		return level != nil && level.EXCEPTION() == nil
+	case *Stmt_exitContext:
+		return n.Opt_exitcond() != nil
+	case *Stmt_ifContext:
+		if n.Stmt_else() == nil || c.sectFallsThrough(n.Proc_sect()) || c.sectFallsThrough(n.Stmt_else().Proc_sect()) {
+			return true
+		}
+		for _, sect := range n.Stmt_elsifs().AllProc_sect() {
+			if c.sectFallsThrough(sect) {
+				return true
+			}
+		}
+		return false
+	case *Stmt_caseContext:
+		// Without ELSE, a CASE that matches no branch raises CASE_NOT_FOUND.
+		if e := n.Opt_case_else(); e != nil && c.sectFallsThrough(e.Proc_sect()) {
+			return true
+		}
+		for _, when := range n.Case_when_list().AllCase_when() {
+			if c.sectFallsThrough(when.Proc_sect()) {
+				return true
+			}
+		}
+		return false
+	case *Stmt_loopContext:
+		label := ""
+		if l := n.Opt_loop_label(); l != nil {
+			label = identifierName(l.Label_decl().Any_identifier().GetText())
+		}
+		return exits(n.Loop_body(), label, true)
+	default:
+		return true
+	}
+}
+
+func (c *plpgsqlChecker) blockFallsThrough(b IPl_blockContext) bool {
+	if c.sectFallsThrough(b.Proc_sect()) {
+		return true
+	}
+	if e := b.Exception_sect(); e != nil {
+		for _, handler := range e.Proc_exceptions().AllProc_exception() {
+			if c.sectFallsThrough(handler.Proc_sect()) {
+				return true
+			}
+		}
+	}
+	if l := b.Decl_sect().Opt_block_label(); l != nil {
+		return exits(b, identifierName(l.Label_decl().Any_identifier().GetText()), false)
+	}
+	return false
+}
+
+func (c *plpgsqlChecker) sectFallsThrough(sect IProc_sectContext) bool {
+	for _, stmt := range sect.AllProc_stmt() {
+		if !c.fallsThrough(stmt) {
+			return false
+		}
+	}
+	return true
+}
+
+// exits reports whether node contains an EXIT naming label, or, if unlabeled
+// is set, an EXIT without a label outside any nested loop.
+func exits(node antlr.Tree, label string, unlabeled bool) bool {
+	switch n := node.(type) {
+	case *Stmt_exitContext:
+		if n.Exit_type().EXIT() == nil {
+			return false
+		}
+		if l := n.Opt_label(); l != nil {
+			return label != "" && identifierName(l.GetText()) == label
+		}
+		return unlabeled
+	case *Stmt_loopContext, *Stmt_whileContext, *Stmt_forContext, *Stmt_foreach_aContext:
+		unlabeled = false
+	}
+	for i := 0; i < node.GetChildCount(); i++ {
+		if exits(node.GetChild(i), label, unlabeled) {
+			return true
+		}
+	}
+	return false
+}
+
+// concatenatesUnquoted reports whether node contains a || operator joining a
+// value other than a constant or a quote_ident, quote_literal or
+// quote_nullable call.
+func concatenatesUnquoted(node antlr.Tree) bool {
+	if n, ok := node.(*A_expr_qual_opContext); ok {
+		for i, op := range n.AllQual_op() {
+			if op.GetText() == "||" && (!isQuoted(n.A_expr_unary_qualop(i)) || !isQuoted(n.A_expr_unary_qualop(i+1))) {
+				return true
+			}
+		}
+	}
+	for i := 0; i < node.GetChildCount(); i++ {
+		if concatenatesUnquoted(node.GetChild(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isQuoted(node antlr.Tree) bool {
+	for {
+		switch n := node.(type) {
+		case *AexprconstContext:
+			return true
+		case *Func_applicationContext:
+			parts := splitQualifiedName(n.Func_name().GetText())
+			switch parts[len(parts)-1] {
+			case "quote_ident", "quote_literal", "quote_nullable":
+				return true
+			}
+			return false
+		}
+		if node.GetChildCount() != 1 {
+			return false
+		}
+		node = node.GetChild(0)
+	}
+}
+
+// soleColumnref returns the column reference an expression consists of, or nil.
+func soleColumnref(node antlr.Tree) *ColumnrefContext {
+	for {
+		if ref, ok := node.(*ColumnrefContext); ok {
+			return ref
+		}
+		if node.GetChildCount() != 1 {
+			return nil
+		}
+		node = node.GetChild(0)
+	}
+}
+
+// identifierName unquotes an identifier, folding it to lower case unless it is quoted.
+func identifierName(text string) string {
+	return splitQualifiedName(text)[0]
+}
@@ -0,0 +1,109 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPlpgsql(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{
+			statement: `CREATE FUNCTION f(a int) RETURNS int AS $$
+DECLARE
+  unused int := 0;
+  total int;
+BEGIN
+  total := a + b;
+  IF total > 0 THEN
+    RETURN total;
+  END IF;
+END;
+$$ LANGUAGE plpgsql;`,
+			want: []string{
+				"line 3:2 variable unused is assigned but never read",
+				"line 6:15 variable b is not declared",
+				"line 10:0 control reaches end of function without RETURN",
+			},
+		},
+		{
+			statement: `CREATE PROCEDURE p(t text) AS $$
+BEGIN
+  <<outer>>
+  LOOP
+    EXIT outer WHEN t IS NULL;
+    CONTINUE missing;
+  END LOOP;
+  EXECUTE 'DELETE FROM ' || t;
+  EXECUTE 'DELETE FROM ' || quote_ident(t);
+  EXECUTE format('DELETE FROM %I', t);
+  RAISE EXCEPTION 'done';
+  RAISE NOTICE 'never';
+END;
+$$ LANGUAGE plpgsql;`,
+			want: []string{
+				"line 6:13 label missing does not exist",
+				"line 8:10 EXECUTE of a concatenated string, use format() or USING to pass values",
+				"line 12:2 unreachable code",
+			},
+		},
+		{
+			statement: `CREATE FUNCTION audit() RETURNS trigger AS $$
+DECLARE
+  n int;
+  r record;
+BEGIN
+  SELECT count(*) INTO n FROM audit_log WHERE id = NEW.id;
+  FOR r IN SELECT * FROM t LOOP
+    n := n + r.x;
+  END LOOP;
+  FOR i IN 1..n LOOP
+    PERFORM pg_notify('audit', i::text);
+  END LOOP;
+  IF n > 0 THEN
+    RETURN NEW;
+  ELSE
+    RETURN OLD;
+  END IF;
+EXCEPTION WHEN others THEN
+  RAISE WARNING '%', SQLERRM;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`,
+		},
+		{
+			statement: "DO $$DECLARE n int := 1; BEGIN EXIT foo; END$$;",
+			want: []string{
+				"line 1:13 variable n is assigned but never read",
+				"line 1:36 label foo does not exist",
+			},
+		},
+		{
+			// The checker skips statements the parser could not recover.
+			statement: `CREATE FUNCTION f() RETURNS void AS $$
+BEGIN
+  LOOP
+    CONTINUE inner;
+  END LOOP;
+END;
+$$ LANGUAGE plpgsql;`,
+		},
+	}
+
+	for _, test := range tests {
+		lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream(test.statement))
+		parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		tree := parser.Root()
+
+		var got []string
+		for _, err := range pgparser.CheckPlpgsql(tree) {
+			got = append(got, err.Error())
+		}
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
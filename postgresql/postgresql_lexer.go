@@ -42,6 +42,7 @@ func postgresqllexerLexerInit() {
 	staticData.ModeNames = []string{
 		"DEFAULT_MODE", "EscapeStringConstantMode", "AfterEscapeStringConstantMode",
 		"AfterEscapeStringConstantWithNewlineMode", "DollarQuotedStringMode",
+		"CopyDataMode",
 	}
 	staticData.LiteralNames = []string{
 		"", "'$'", "'('", "')'", "'['", "']'", "','", "';'", "':'", "'*'", "'='",
@@ -63,74 +64,79 @@ func postgresqllexerLexerInit() {
 		"'BINARY'", "'COLLATION'", "'CONCURRENTLY'", "'CROSS'", "'CURRENT_SCHEMA'",
 		"'FREEZE'", "'FULL'", "'ILIKE'", "'INNER'", "'IS'", "'ISNULL'", "'JOIN'",
 		"'LEFT'", "'LIKE'", "'NATURAL'", "'NOTNULL'", "'OUTER'", "'OVER'", "'OVERLAPS'",
-		"'RIGHT'", "'SIMILAR'", "'VERBOSE'", "'ABORT'", "'ABSOLUTE'", "'ACCESS'",
-		"'ACTION'", "'ADD'", "'ADMIN'", "'AFTER'", "'AGGREGATE'", "'ALSO'",
-		"'ALTER'", "'ALWAYS'", "'ASSERTION'", "'ASSIGNMENT'", "'AT'", "'ATTRIBUTE'",
-		"'BACKWARD'", "'BEFORE'", "'BEGIN'", "'BY'", "'CACHE'", "'CALLED'",
-		"'CASCADE'", "'CASCADED'", "'CATALOG'", "'CHAIN'", "'CHARACTERISTICS'",
+		"'RIGHT'", "'SIMILAR'", "'VERBOSE'", "'ABORT'", "'ABSENT'", "'ABSOLUTE'",
+		"'ACCESS'", "'ACTION'", "'ADD'", "'ADMIN'", "'AFTER'", "'AGGREGATE'",
+		"'ALSO'", "'ALTER'", "'ALWAYS'", "'ASSERTION'", "'ASSIGNMENT'", "'AT'",
+		"'ATTRIBUTE'", "'BACKWARD'", "'BEFORE'", "'BEGIN'", "'BY'", "'CACHE'",
+		"'CALLED'", "'CASCADE'", "'CASCADED'", "'CATALOG'", "'CHAIN'", "'CHARACTERISTICS'",
 		"'CHECKPOINT'", "'CLASS'", "'CLOSE'", "'CLUSTER'", "'COMMENT'", "'COMMENTS'",
-		"'COMMIT'", "'COMMITTED'", "'CONFIGURATION'", "'CONNECTION'", "'CONSTRAINTS'",
-		"'CONTENT'", "'CONTINUE'", "'CONVERSION'", "'COPY'", "'COST'", "'CSV'",
-		"'CURSOR'", "'CYCLE'", "'DATA'", "'DATABASE'", "'DAY'", "'DEALLOCATE'",
-		"'DECLARE'", "'DEFAULTS'", "'DEFERRED'", "'DEFINER'", "'DELETE'", "'DELIMITER'",
-		"'DELIMITERS'", "'DICTIONARY'", "'DISABLE'", "'DISCARD'", "'DOCUMENT'",
-		"'DOMAIN'", "'DOUBLE'", "'DROP'", "'EACH'", "'ENABLE'", "'ENCODING'",
-		"'ENCRYPTED'", "'ENUM'", "'ESCAPE'", "'EVENT'", "'EXCLUDE'", "'EXCLUDING'",
-		"'EXCLUSIVE'", "'EXECUTE'", "'EXPLAIN'", "'EXTENSION'", "'EXTERNAL'",
-		"'FAMILY'", "'FIRST'", "'FOLLOWING'", "'FORCE'", "'FORWARD'", "'FUNCTION'",
-		"'FUNCTIONS'", "'GLOBAL'", "'GRANTED'", "'HANDLER'", "'HEADER'", "'HOLD'",
-		"'HOUR'", "'IDENTITY'", "'IF'", "'IMMEDIATE'", "'IMMUTABLE'", "'IMPLICIT'",
-		"'INCLUDING'", "'INCREMENT'", "'INDEX'", "'INDEXES'", "'INHERIT'", "'INHERITS'",
-		"'INLINE'", "'INSENSITIVE'", "'INSERT'", "'INSTEAD'", "'INVOKER'", "'ISOLATION'",
-		"'KEY'", "'LABEL'", "'LANGUAGE'", "'LARGE'", "'LAST'", "'LEAKPROOF'",
+		"'COMMIT'", "'COMMITTED'", "'CONDITIONAL'", "'CONFIGURATION'", "'CONNECTION'",
+		"'CONSTRAINTS'", "'CONTENT'", "'CONTINUE'", "'CONVERSION'", "'COPY'",
+		"'COST'", "'CSV'", "'CURSOR'", "'CYCLE'", "'DATA'", "'DATABASE'", "'DAY'",
+		"'DEALLOCATE'", "'DECLARE'", "'DEFAULTS'", "'DEFERRED'", "'DEFINER'",
+		"'DELETE'", "'DELIMITER'", "'DELIMITERS'", "'DICTIONARY'", "'DISABLE'",
+		"'DISCARD'", "'DOCUMENT'", "'DOMAIN'", "'DOUBLE'", "'DROP'", "'EACH'",
+		"'EMPTY'", "'ENABLE'", "'ENCODING'", "'ENCRYPTED'", "'ENUM'", "'ESCAPE'",
+		"'EVENT'", "'EXCLUDE'", "'EXCLUDING'", "'EXCLUSIVE'", "'EXECUTE'", "'EXPLAIN'",
+		"'EXTENSION'", "'EXTERNAL'", "'FAMILY'", "'FIRST'", "'FOLLOWING'", "'FORCE'",
+		"'FORWARD'", "'FUNCTION'", "'FUNCTIONS'", "'GLOBAL'", "'GRANTED'", "'HANDLER'",
+		"'HEADER'", "'HOLD'", "'HOUR'", "'IDENTITY'", "'IF'", "'IMMEDIATE'",
+		"'IMMUTABLE'", "'IMPLICIT'", "'INCLUDING'", "'INCREMENT'", "'INDEX'",
+		"'INDEXES'", "'INHERIT'", "'INHERITS'", "'INLINE'", "'INSENSITIVE'",
+		"'INSERT'", "'INSTEAD'", "'INVOKER'", "'ISOLATION'", "'JSON'", "'KEEP'",
+		"'KEY'", "'KEYS'", "'LABEL'", "'LANGUAGE'", "'LARGE'", "'LAST'", "'LEAKPROOF'",
 		"'LEVEL'", "'LISTEN'", "'LOAD'", "'LOCAL'", "'LOCATION'", "'LOCK'",
 		"'MAPPING'", "'MATCH'", "'MATCHED'", "'MATERIALIZED'", "'MAXVALUE'",
 		"'MERGE'", "'MINUTE'", "'MINVALUE'", "'MODE'", "'MONTH'", "'MOVE'",
-		"'NAME'", "'NAMES'", "'NEXT'", "'NO'", "'NOTHING'", "'NOTIFY'", "'NOWAIT'",
-		"'NULLS'", "'OBJECT'", "'OF'", "'OFF'", "'OIDS'", "'OPERATOR'", "'OPTION'",
-		"'OPTIONS'", "'OWNED'", "'OWNER'", "'PARSER'", "'PARTIAL'", "'PARTITION'",
-		"'PASSING'", "'PASSWORD'", "'PLANS'", "'PRECEDING'", "'PREPARE'", "'PREPARED'",
-		"'PRESERVE'", "'PRIOR'", "'PRIVILEGES'", "'PROCEDURAL'", "'PROCEDURE'",
-		"'PROGRAM'", "'QUOTE'", "'RANGE'", "'READ'", "'REASSIGN'", "'RECHECK'",
-		"'RECURSIVE'", "'REF'", "'REFRESH'", "'REINDEX'", "'RELATIVE'", "'RELEASE'",
-		"'RENAME'", "'REPEATABLE'", "'REPLACE'", "'REPLICA'", "'RESET'", "'RESTART'",
-		"'RESTRICT'", "'RETURNS'", "'REVOKE'", "'ROLE'", "'ROLLBACK'", "'ROWS'",
-		"'RULE'", "'SAVEPOINT'", "'SCHEMA'", "'SCROLL'", "'SEARCH'", "'SECOND'",
-		"'SECURITY'", "'SEQUENCE'", "'SEQUENCES'", "'SERIALIZABLE'", "'SERVER'",
-		"'SESSION'", "'SET'", "'SHARE'", "'SHOW'", "'SIMPLE'", "'SNAPSHOT'",
+		"'NAME'", "'NAMES'", "'NESTED'", "'NEXT'", "'NO'", "'NOTHING'", "'NOTIFY'",
+		"'NOWAIT'", "'NULLS'", "'OBJECT'", "'OF'", "'OFF'", "'OIDS'", "'OMIT'",
+		"'OPERATOR'", "'OPTION'", "'OPTIONS'", "'OWNED'", "'OWNER'", "'PARSER'",
+		"'PARTIAL'", "'PARTITION'", "'PASSING'", "'PASSWORD'", "'PATH'", "'PLANS'",
+		"'PRECEDING'", "'PREPARE'", "'PREPARED'", "'PRESERVE'", "'PRIOR'", "'PRIVILEGES'",
+		"'PROCEDURAL'", "'PROCEDURE'", "'PROGRAM'", "'QUOTE'", "'QUOTES'", "'RANGE'",
+		"'READ'", "'REASSIGN'", "'RECHECK'", "'RECURSIVE'", "'REF'", "'REFRESH'",
+		"'REINDEX'", "'RELATIVE'", "'RELEASE'", "'RENAME'", "'REPEATABLE'",
+		"'REPLACE'", "'REPLICA'", "'RESET'", "'RESTART'", "'RESTRICT'", "'RETURNS'",
+		"'REVOKE'", "'ROLE'", "'ROLLBACK'", "'ROWS'", "'RULE'", "'SAVEPOINT'",
+		"'SCALAR'", "'SCHEMA'", "'SCROLL'", "'SEARCH'", "'SECOND'", "'SECURITY'",
+		"'SEQUENCE'", "'SEQUENCES'", "'SERIALIZABLE'", "'SERVER'", "'SESSION'",
+		"'SET'", "'SHARE'", "'SHOW'", "'SIMPLE'", "'SNAPSHOT'", "'SOURCE'",
 		"'STABLE'", "'STANDALONE'", "'START'", "'STATEMENT'", "'STATISTICS'",
-		"'STDIN'", "'STDOUT'", "'STORAGE'", "'STRICT'", "'STRIP'", "'SYSID'",
-		"'SYSTEM'", "'TABLES'", "'TABLESPACE'", "'TEMP'", "'TEMPLATE'", "'TEMPORARY'",
-		"'TEXT'", "'TRANSACTION'", "'TRIGGER'", "'TRUNCATE'", "'TRUSTED'", "'TYPE'",
-		"'TYPES'", "'UNBOUNDED'", "'UNCOMMITTED'", "'UNENCRYPTED'", "'UNKNOWN'",
-		"'UNLISTEN'", "'UNLOGGED'", "'UNTIL'", "'UPDATE'", "'VACUUM'", "'VALID'",
-		"'VALIDATE'", "'VALIDATOR'", "'VARYING'", "'VERSION'", "'VIEW'", "'VOLATILE'",
-		"'WHITESPACE'", "'WITHOUT'", "'WORK'", "'WRAPPER'", "'WRITE'", "'XML'",
-		"'YEAR'", "'YES'", "'ZONE'", "'ATOMIC'", "'BETWEEN'", "'BIGINT'", "'BIT'",
-		"'BOOLEAN'", "'CHAR'", "'CHARACTER'", "'COALESCE'", "'DEC'", "'DECIMAL'",
-		"'EXISTS'", "'EXTRACT'", "'FLOAT'", "'GREATEST'", "'INOUT'", "'INT'",
-		"'INTEGER'", "'INTERVAL'", "'LEAST'", "'NATIONAL'", "'NCHAR'", "'NONE'",
-		"'NULLIF'", "'NUMERIC'", "'OVERLAY'", "'PARAMETER'", "'POSITION'", "'PRECISION'",
-		"'REAL'", "'ROW'", "'SETOF'", "'SMALLINT'", "'SUBSTRING'", "'TIME'",
-		"'TIMESTAMP'", "'TREAT'", "'TRIM'", "'VALUES'", "'VARCHAR'", "'XMLATTRIBUTES'",
-		"'XMLCOMMENT'", "'XMLAGG'", "'XML_IS_WELL_FORMED'", "'XML_IS_WELL_FORMED_DOCUMENT'",
-		"'XML_IS_WELL_FORMED_CONTENT'", "'XPATH'", "'XPATH_EXISTS'", "'XMLCONCAT'",
-		"'XMLELEMENT'", "'XMLEXISTS'", "'XMLFOREST'", "'XMLPARSE'", "'XMLPI'",
-		"'XMLROOT'", "'XMLSERIALIZE'", "'CALL'", "'CURRENT'", "'ATTACH'", "'DETACH'",
-		"'EXPRESSION'", "'GENERATED'", "'LOGGED'", "'STORED'", "'INCLUDE'",
-		"'ROUTINE'", "'TRANSFORM'", "'IMPORT'", "'POLICY'", "'METHOD'", "'REFERENCING'",
-		"'NEW'", "'OLD'", "'VALUE'", "'SUBSCRIPTION'", "'PUBLICATION'", "'OUT'",
-		"'END'", "'ROUTINES'", "'SCHEMAS'", "'PROCEDURES'", "'INPUT'", "'SUPPORT'",
-		"'PARALLEL'", "'SQL'", "'DEPENDS'", "'OVERRIDING'", "'CONFLICT'", "'SKIP'",
-		"'LOCKED'", "'TIES'", "'ROLLUP'", "'CUBE'", "'GROUPING'", "'SETS'",
-		"'TABLESAMPLE'", "'ORDINALITY'", "'XMLTABLE'", "'COLUMNS'", "'XMLNAMESPACES'",
-		"'ROWTYPE'", "'NORMALIZED'", "'WITHIN'", "'FILTER'", "'GROUPS'", "'OTHERS'",
-		"'NFC'", "'NFD'", "'NFKC'", "'NFKD'", "'UESCAPE'", "'VIEWS'", "'NORMALIZE'",
-		"'DUMP'", "'PRINT_STRICT_PARAMS'", "'VARIABLE_CONFLICT'", "'ERROR'",
-		"'USE_VARIABLE'", "'USE_COLUMN'", "'ALIAS'", "'CONSTANT'", "'PERFORM'",
-		"'GET'", "'DIAGNOSTICS'", "'STACKED'", "'ELSIF'", "'WHILE'", "'REVERSE'",
-		"'FOREACH'", "'SLICE'", "'EXIT'", "'RETURN'", "'QUERY'", "'RAISE'",
-		"'SQLSTATE'", "'DEBUG'", "'LOG'", "'INFO'", "'NOTICE'", "'WARNING'",
+		"'STDIN'", "'STDOUT'", "'STORAGE'", "'STRICT'", "'STRING'", "'STRIP'",
+		"'SYSID'", "'SYSTEM'", "'TABLES'", "'TABLESPACE'", "'TARGET'", "'TEMP'",
+		"'TEMPLATE'", "'TEMPORARY'", "'TEXT'", "'TRANSACTION'", "'TRIGGER'",
+		"'TRUNCATE'", "'TRUSTED'", "'TYPE'", "'TYPES'", "'UNBOUNDED'", "'UNCOMMITTED'",
+		"'UNCONDITIONAL'", "'UNENCRYPTED'", "'UNKNOWN'", "'UNLISTEN'", "'UNLOGGED'",
+		"'UNTIL'", "'UPDATE'", "'VACUUM'", "'VALID'", "'VALIDATE'", "'VALIDATOR'",
+		"'VARYING'", "'VERSION'", "'VIEW'", "'VOLATILE'", "'WHITESPACE'", "'WITHOUT'",
+		"'WORK'", "'WRAPPER'", "'WRITE'", "'XML'", "'YEAR'", "'YES'", "'ZONE'",
+		"'ATOMIC'", "'BETWEEN'", "'BIGINT'", "'BIT'", "'BOOLEAN'", "'CHAR'",
+		"'CHARACTER'", "'COALESCE'", "'DEC'", "'DECIMAL'", "'EXISTS'", "'EXTRACT'",
+		"'FLOAT'", "'GREATEST'", "'INOUT'", "'INT'", "'INTEGER'", "'INTERVAL'",
+		"'JSON_ARRAY'", "'JSON_ARRAYAGG'", "'JSON_EXISTS'", "'JSON_OBJECT'",
+		"'JSON_OBJECTAGG'", "'JSON_QUERY'", "'JSON_SCALAR'", "'JSON_SERIALIZE'",
+		"'JSON_TABLE'", "'JSON_VALUE'", "'LEAST'", "'NATIONAL'", "'NCHAR'",
+		"'NONE'", "'NULLIF'", "'NUMERIC'", "'OVERLAY'", "'PARAMETER'", "'POSITION'",
+		"'PRECISION'", "'REAL'", "'ROW'", "'SETOF'", "'SMALLINT'", "'SUBSTRING'",
+		"'TIME'", "'TIMESTAMP'", "'TREAT'", "'TRIM'", "'VALUES'", "'VARCHAR'",
+		"'XMLATTRIBUTES'", "'XMLCOMMENT'", "'XMLAGG'", "'XML_IS_WELL_FORMED'",
+		"'XML_IS_WELL_FORMED_DOCUMENT'", "'XML_IS_WELL_FORMED_CONTENT'", "'XPATH'",
+		"'XPATH_EXISTS'", "'XMLCONCAT'", "'XMLELEMENT'", "'XMLEXISTS'", "'XMLFOREST'",
+		"'XMLPARSE'", "'XMLPI'", "'XMLROOT'", "'XMLSERIALIZE'", "'CALL'", "'CURRENT'",
+		"'ATTACH'", "'DETACH'", "'EXPRESSION'", "'GENERATED'", "'LOGGED'", "'STORED'",
+		"'INCLUDE'", "'ROUTINE'", "'TRANSFORM'", "'IMPORT'", "'POLICY'", "'METHOD'",
+		"'REFERENCING'", "'NEW'", "'OLD'", "'VALUE'", "'SUBSCRIPTION'", "'PUBLICATION'",
+		"'OUT'", "'END'", "'ROUTINES'", "'SCHEMAS'", "'PROCEDURES'", "'INPUT'",
+		"'SUPPORT'", "'PARALLEL'", "'SQL'", "'DEPENDS'", "'OVERRIDING'", "'CONFLICT'",
+		"'SKIP'", "'LOCKED'", "'TIES'", "'ROLLUP'", "'CUBE'", "'GROUPING'",
+		"'SETS'", "'TABLESAMPLE'", "'ORDINALITY'", "'XMLTABLE'", "'COLUMNS'",
+		"'XMLNAMESPACES'", "'ROWTYPE'", "'NORMALIZED'", "'WITHIN'", "'FILTER'",
+		"'GROUPS'", "'OTHERS'", "'NFC'", "'NFD'", "'NFKC'", "'NFKD'", "'UESCAPE'",
+		"'VIEWS'", "'NORMALIZE'", "'DUMP'", "'PRINT_STRICT_PARAMS'", "'VARIABLE_CONFLICT'",
+		"'ERROR'", "'USE_VARIABLE'", "'USE_COLUMN'", "'ALIAS'", "'CONSTANT'",
+		"'PERFORM'", "'GET'", "'DIAGNOSTICS'", "'STACKED'", "'ELSIF'", "'WHILE'",
+		"'REVERSE'", "'FOREACH'", "'SLICE'", "'EXIT'", "'RETURN'", "'QUERY'",
+		"'RAISE'", "'SQLSTATE'", "'DEBUG'", "'LOG'", "'INFO'", "'NOTICE'", "'WARNING'",
 		"'EXCEPTION'", "'ASSERT'", "'LOOP'", "'OPEN'", "'ABS'", "'CBRT'", "'CEIL'",
 		"'CEILING'", "'DEGREES'", "'DIV'", "'EXP'", "'FACTORIAL'", "'FLOOR'",
 		"'GCD'", "'LCM'", "'LN'", "'LOG10'", "'MIN_SCALE'", "'MOD'", "'PI'",
@@ -156,7 +162,7 @@ func postgresqllexerLexerInit() {
 		"'DISTKEY'", "'SORTKEY'", "'CASE_SENSITIVE'", "'CASE_INSENSITIVE'",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'\\\\'",
-		"", "", "", "", "", "", "", "", "", "'''",
+		"", "", "", "", "", "", "", "", "", "", "'''",
 	}
 	staticData.SymbolicNames = []string{
 		"", "Dollar", "OPEN_PAREN", "CLOSE_PAREN", "OPEN_BRACKET", "CLOSE_BRACKET",
@@ -178,52 +184,56 @@ func postgresqllexerLexerInit() {
 		"CONCURRENTLY", "CROSS", "CURRENT_SCHEMA", "FREEZE", "FULL", "ILIKE",
 		"INNER_P", "IS", "ISNULL", "JOIN", "LEFT", "LIKE", "NATURAL", "NOTNULL",
 		"OUTER_P", "OVER", "OVERLAPS", "RIGHT", "SIMILAR", "VERBOSE", "ABORT_P",
-		"ABSOLUTE_P", "ACCESS", "ACTION", "ADD_P", "ADMIN", "AFTER", "AGGREGATE",
-		"ALSO", "ALTER", "ALWAYS", "ASSERTION", "ASSIGNMENT", "AT", "ATTRIBUTE",
-		"BACKWARD", "BEFORE", "BEGIN_P", "BY", "CACHE", "CALLED", "CASCADE",
-		"CASCADED", "CATALOG", "CHAIN", "CHARACTERISTICS", "CHECKPOINT", "CLASS",
-		"CLOSE", "CLUSTER", "COMMENT", "COMMENTS", "COMMIT", "COMMITTED", "CONFIGURATION",
-		"CONNECTION", "CONSTRAINTS", "CONTENT_P", "CONTINUE_P", "CONVERSION_P",
-		"COPY", "COST", "CSV", "CURSOR", "CYCLE", "DATA_P", "DATABASE", "DAY_P",
-		"DEALLOCATE", "DECLARE", "DEFAULTS", "DEFERRED", "DEFINER", "DELETE_P",
-		"DELIMITER", "DELIMITERS", "DICTIONARY", "DISABLE_P", "DISCARD", "DOCUMENT_P",
-		"DOMAIN_P", "DOUBLE_P", "DROP", "EACH", "ENABLE_P", "ENCODING", "ENCRYPTED",
-		"ENUM_P", "ESCAPE", "EVENT", "EXCLUDE", "EXCLUDING", "EXCLUSIVE", "EXECUTE",
-		"EXPLAIN", "EXTENSION", "EXTERNAL", "FAMILY", "FIRST_P", "FOLLOWING",
-		"FORCE", "FORWARD", "FUNCTION", "FUNCTIONS", "GLOBAL", "GRANTED", "HANDLER",
+		"ABSENT", "ABSOLUTE_P", "ACCESS", "ACTION", "ADD_P", "ADMIN", "AFTER",
+		"AGGREGATE", "ALSO", "ALTER", "ALWAYS", "ASSERTION", "ASSIGNMENT", "AT",
+		"ATTRIBUTE", "BACKWARD", "BEFORE", "BEGIN_P", "BY", "CACHE", "CALLED",
+		"CASCADE", "CASCADED", "CATALOG", "CHAIN", "CHARACTERISTICS", "CHECKPOINT",
+		"CLASS", "CLOSE", "CLUSTER", "COMMENT", "COMMENTS", "COMMIT", "COMMITTED",
+		"CONDITIONAL", "CONFIGURATION", "CONNECTION", "CONSTRAINTS", "CONTENT_P",
+		"CONTINUE_P", "CONVERSION_P", "COPY", "COST", "CSV", "CURSOR", "CYCLE",
+		"DATA_P", "DATABASE", "DAY_P", "DEALLOCATE", "DECLARE", "DEFAULTS",
+		"DEFERRED", "DEFINER", "DELETE_P", "DELIMITER", "DELIMITERS", "DICTIONARY",
+		"DISABLE_P", "DISCARD", "DOCUMENT_P", "DOMAIN_P", "DOUBLE_P", "DROP",
+		"EACH", "EMPTY_P", "ENABLE_P", "ENCODING", "ENCRYPTED", "ENUM_P", "ESCAPE",
+		"EVENT", "EXCLUDE", "EXCLUDING", "EXCLUSIVE", "EXECUTE", "EXPLAIN",
+		"EXTENSION", "EXTERNAL", "FAMILY", "FIRST_P", "FOLLOWING", "FORCE",
+		"FORWARD", "FUNCTION", "FUNCTIONS", "GLOBAL", "GRANTED", "HANDLER",
 		"HEADER_P", "HOLD", "HOUR_P", "IDENTITY_P", "IF_P", "IMMEDIATE", "IMMUTABLE",
 		"IMPLICIT_P", "INCLUDING", "INCREMENT", "INDEX", "INDEXES", "INHERIT",
 		"INHERITS", "INLINE_P", "INSENSITIVE", "INSERT", "INSTEAD", "INVOKER",
-		"ISOLATION", "KEY", "LABEL", "LANGUAGE", "LARGE_P", "LAST_P", "LEAKPROOF",
-		"LEVEL", "LISTEN", "LOAD", "LOCAL", "LOCATION", "LOCK_P", "MAPPING",
-		"MATCH", "MATCHED", "MATERIALIZED", "MAXVALUE", "MERGE", "MINUTE_P",
-		"MINVALUE", "MODE", "MONTH_P", "MOVE", "NAME_P", "NAMES", "NEXT", "NO",
-		"NOTHING", "NOTIFY", "NOWAIT", "NULLS_P", "OBJECT_P", "OF", "OFF", "OIDS",
-		"OPERATOR", "OPTION", "OPTIONS", "OWNED", "OWNER", "PARSER", "PARTIAL",
-		"PARTITION", "PASSING", "PASSWORD", "PLANS", "PRECEDING", "PREPARE",
-		"PREPARED", "PRESERVE", "PRIOR", "PRIVILEGES", "PROCEDURAL", "PROCEDURE",
-		"PROGRAM", "QUOTE", "RANGE", "READ", "REASSIGN", "RECHECK", "RECURSIVE",
-		"REF", "REFRESH", "REINDEX", "RELATIVE_P", "RELEASE", "RENAME", "REPEATABLE",
-		"REPLACE", "REPLICA", "RESET", "RESTART", "RESTRICT", "RETURNS", "REVOKE",
-		"ROLE", "ROLLBACK", "ROWS", "RULE", "SAVEPOINT", "SCHEMA", "SCROLL",
+		"ISOLATION", "JSON", "KEEP", "KEY", "KEYS", "LABEL", "LANGUAGE", "LARGE_P",
+		"LAST_P", "LEAKPROOF", "LEVEL", "LISTEN", "LOAD", "LOCAL", "LOCATION",
+		"LOCK_P", "MAPPING", "MATCH", "MATCHED", "MATERIALIZED", "MAXVALUE",
+		"MERGE", "MINUTE_P", "MINVALUE", "MODE", "MONTH_P", "MOVE", "NAME_P",
+		"NAMES", "NESTED", "NEXT", "NO", "NOTHING", "NOTIFY", "NOWAIT", "NULLS_P",
+		"OBJECT_P", "OF", "OFF", "OIDS", "OMIT", "OPERATOR", "OPTION", "OPTIONS",
+		"OWNED", "OWNER", "PARSER", "PARTIAL", "PARTITION", "PASSING", "PASSWORD",
+		"PATH", "PLANS", "PRECEDING", "PREPARE", "PREPARED", "PRESERVE", "PRIOR",
+		"PRIVILEGES", "PROCEDURAL", "PROCEDURE", "PROGRAM", "QUOTE", "QUOTES",
+		"RANGE", "READ", "REASSIGN", "RECHECK", "RECURSIVE", "REF", "REFRESH",
+		"REINDEX", "RELATIVE_P", "RELEASE", "RENAME", "REPEATABLE", "REPLACE",
+		"REPLICA", "RESET", "RESTART", "RESTRICT", "RETURNS", "REVOKE", "ROLE",
+		"ROLLBACK", "ROWS", "RULE", "SAVEPOINT", "SCALAR", "SCHEMA", "SCROLL",
 		"SEARCH", "SECOND_P", "SECURITY", "SEQUENCE", "SEQUENCES", "SERIALIZABLE",
-		"SERVER", "SESSION", "SET", "SHARE", "SHOW", "SIMPLE", "SNAPSHOT", "STABLE",
-		"STANDALONE_P", "START", "STATEMENT", "STATISTICS", "STDIN", "STDOUT",
-		"STORAGE", "STRICT_P", "STRIP_P", "SYSID", "SYSTEM_P", "TABLES", "TABLESPACE",
-		"TEMP", "TEMPLATE", "TEMPORARY", "TEXT_P", "TRANSACTION", "TRIGGER",
-		"TRUNCATE", "TRUSTED", "TYPE_P", "TYPES_P", "UNBOUNDED", "UNCOMMITTED",
-		"UNENCRYPTED", "UNKNOWN", "UNLISTEN", "UNLOGGED", "UNTIL", "UPDATE",
-		"VACUUM", "VALID", "VALIDATE", "VALIDATOR", "VARYING", "VERSION_P",
-		"VIEW", "VOLATILE", "WHITESPACE_P", "WITHOUT", "WORK", "WRAPPER", "WRITE",
-		"XML_P", "YEAR_P", "YES_P", "ZONE", "ATOMIC_P", "BETWEEN", "BIGINT",
-		"BIT", "BOOLEAN_P", "CHAR_P", "CHARACTER", "COALESCE", "DEC", "DECIMAL_P",
-		"EXISTS", "EXTRACT", "FLOAT_P", "GREATEST", "INOUT", "INT_P", "INTEGER",
-		"INTERVAL", "LEAST", "NATIONAL", "NCHAR", "NONE", "NULLIF", "NUMERIC",
-		"OVERLAY", "PARAMETER", "POSITION", "PRECISION", "REAL", "ROW", "SETOF",
-		"SMALLINT", "SUBSTRING", "TIME", "TIMESTAMP", "TREAT", "TRIM", "VALUES",
-		"VARCHAR", "XMLATTRIBUTES", "XMLCOMMENT", "XMLAGG", "XML_IS_WELL_FORMED",
-		"XML_IS_WELL_FORMED_DOCUMENT", "XML_IS_WELL_FORMED_CONTENT", "XPATH",
-		"XPATH_EXISTS", "XMLCONCAT", "XMLELEMENT", "XMLEXISTS", "XMLFOREST",
+		"SERVER", "SESSION", "SET", "SHARE", "SHOW", "SIMPLE", "SNAPSHOT", "SOURCE",
+		"STABLE", "STANDALONE_P", "START", "STATEMENT", "STATISTICS", "STDIN",
+		"STDOUT", "STORAGE", "STRICT_P", "STRING_P", "STRIP_P", "SYSID", "SYSTEM_P",
+		"TABLES", "TABLESPACE", "TARGET", "TEMP", "TEMPLATE", "TEMPORARY", "TEXT_P",
+		"TRANSACTION", "TRIGGER", "TRUNCATE", "TRUSTED", "TYPE_P", "TYPES_P",
+		"UNBOUNDED", "UNCOMMITTED", "UNCONDITIONAL", "UNENCRYPTED", "UNKNOWN",
+		"UNLISTEN", "UNLOGGED", "UNTIL", "UPDATE", "VACUUM", "VALID", "VALIDATE",
+		"VALIDATOR", "VARYING", "VERSION_P", "VIEW", "VOLATILE", "WHITESPACE_P",
+		"WITHOUT", "WORK", "WRAPPER", "WRITE", "XML_P", "YEAR_P", "YES_P", "ZONE",
+		"ATOMIC_P", "BETWEEN", "BIGINT", "BIT", "BOOLEAN_P", "CHAR_P", "CHARACTER",
+		"COALESCE", "DEC", "DECIMAL_P", "EXISTS", "EXTRACT", "FLOAT_P", "GREATEST",
+		"INOUT", "INT_P", "INTEGER", "INTERVAL", "JSON_ARRAY", "JSON_ARRAYAGG",
+		"JSON_EXISTS", "JSON_OBJECT", "JSON_OBJECTAGG", "JSON_QUERY", "JSON_SCALAR",
+		"JSON_SERIALIZE", "JSON_TABLE", "JSON_VALUE", "LEAST", "NATIONAL", "NCHAR",
+		"NONE", "NULLIF", "NUMERIC", "OVERLAY", "PARAMETER", "POSITION", "PRECISION",
+		"REAL", "ROW", "SETOF", "SMALLINT", "SUBSTRING", "TIME", "TIMESTAMP",
+		"TREAT", "TRIM", "VALUES", "VARCHAR", "XMLATTRIBUTES", "XMLCOMMENT",
+		"XMLAGG", "XML_IS_WELL_FORMED", "XML_IS_WELL_FORMED_DOCUMENT", "XML_IS_WELL_FORMED_CONTENT",
+		"XPATH", "XPATH_EXISTS", "XMLCONCAT", "XMLELEMENT", "XMLEXISTS", "XMLFOREST",
 		"XMLPARSE", "XMLPI", "XMLROOT", "XMLSERIALIZE", "CALL", "CURRENT_P",
 		"ATTACH", "DETACH", "EXPRESSION", "GENERATED", "LOGGED", "STORED", "INCLUDE",
 		"ROUTINE", "TRANSFORM", "IMPORT_P", "POLICY", "METHOD", "REFERENCING",
@@ -270,7 +280,7 @@ func postgresqllexerLexerInit() {
 		"MetaCommand", "EndMetaCommand", "ErrorCharacter", "EscapeStringConstant",
 		"UnterminatedEscapeStringConstant", "InvalidEscapeStringConstant", "InvalidUnterminatedEscapeStringConstant",
 		"AfterEscapeStringConstantMode_NotContinued", "AfterEscapeStringConstantWithNewlineMode_NotContinued",
-		"DollarText", "EndDollarStringConstant", "AfterEscapeStringConstantWithNewlineMode_Continued",
+		"DollarText", "EndDollarStringConstant", "CopyData", "AfterEscapeStringConstantWithNewlineMode_Continued",
 	}
 	staticData.RuleNames = []string{
 		"Dollar", "OPEN_PAREN", "CLOSE_PAREN", "OPEN_BRACKET", "CLOSE_BRACKET",
@@ -293,52 +303,56 @@ func postgresqllexerLexerInit() {
 		"BINARY", "COLLATION", "CONCURRENTLY", "CROSS", "CURRENT_SCHEMA", "FREEZE",
 		"FULL", "ILIKE", "INNER_P", "IS", "ISNULL", "JOIN", "LEFT", "LIKE",
 		"NATURAL", "NOTNULL", "OUTER_P", "OVER", "OVERLAPS", "RIGHT", "SIMILAR",
-		"VERBOSE", "ABORT_P", "ABSOLUTE_P", "ACCESS", "ACTION", "ADD_P", "ADMIN",
-		"AFTER", "AGGREGATE", "ALSO", "ALTER", "ALWAYS", "ASSERTION", "ASSIGNMENT",
-		"AT", "ATTRIBUTE", "BACKWARD", "BEFORE", "BEGIN_P", "BY", "CACHE", "CALLED",
-		"CASCADE", "CASCADED", "CATALOG", "CHAIN", "CHARACTERISTICS", "CHECKPOINT",
-		"CLASS", "CLOSE", "CLUSTER", "COMMENT", "COMMENTS", "COMMIT", "COMMITTED",
-		"CONFIGURATION", "CONNECTION", "CONSTRAINTS", "CONTENT_P", "CONTINUE_P",
-		"CONVERSION_P", "COPY", "COST", "CSV", "CURSOR", "CYCLE", "DATA_P",
-		"DATABASE", "DAY_P", "DEALLOCATE", "DECLARE", "DEFAULTS", "DEFERRED",
-		"DEFINER", "DELETE_P", "DELIMITER", "DELIMITERS", "DICTIONARY", "DISABLE_P",
-		"DISCARD", "DOCUMENT_P", "DOMAIN_P", "DOUBLE_P", "DROP", "EACH", "ENABLE_P",
-		"ENCODING", "ENCRYPTED", "ENUM_P", "ESCAPE", "EVENT", "EXCLUDE", "EXCLUDING",
-		"EXCLUSIVE", "EXECUTE", "EXPLAIN", "EXTENSION", "EXTERNAL", "FAMILY",
-		"FIRST_P", "FOLLOWING", "FORCE", "FORWARD", "FUNCTION", "FUNCTIONS",
-		"GLOBAL", "GRANTED", "HANDLER", "HEADER_P", "HOLD", "HOUR_P", "IDENTITY_P",
-		"IF_P", "IMMEDIATE", "IMMUTABLE", "IMPLICIT_P", "INCLUDING", "INCREMENT",
-		"INDEX", "INDEXES", "INHERIT", "INHERITS", "INLINE_P", "INSENSITIVE",
-		"INSERT", "INSTEAD", "INVOKER", "ISOLATION", "KEY", "LABEL", "LANGUAGE",
-		"LARGE_P", "LAST_P", "LEAKPROOF", "LEVEL", "LISTEN", "LOAD", "LOCAL",
-		"LOCATION", "LOCK_P", "MAPPING", "MATCH", "MATCHED", "MATERIALIZED",
-		"MAXVALUE", "MERGE", "MINUTE_P", "MINVALUE", "MODE", "MONTH_P", "MOVE",
-		"NAME_P", "NAMES", "NEXT", "NO", "NOTHING", "NOTIFY", "NOWAIT", "NULLS_P",
-		"OBJECT_P", "OF", "OFF", "OIDS", "OPERATOR", "OPTION", "OPTIONS", "OWNED",
-		"OWNER", "PARSER", "PARTIAL", "PARTITION", "PASSING", "PASSWORD", "PLANS",
-		"PRECEDING", "PREPARE", "PREPARED", "PRESERVE", "PRIOR", "PRIVILEGES",
-		"PROCEDURAL", "PROCEDURE", "PROGRAM", "QUOTE", "RANGE", "READ", "REASSIGN",
-		"RECHECK", "RECURSIVE", "REF", "REFRESH", "REINDEX", "RELATIVE_P", "RELEASE",
-		"RENAME", "REPEATABLE", "REPLACE", "REPLICA", "RESET", "RESTART", "RESTRICT",
-		"RETURNS", "REVOKE", "ROLE", "ROLLBACK", "ROWS", "RULE", "SAVEPOINT",
-		"SCHEMA", "SCROLL", "SEARCH", "SECOND_P", "SECURITY", "SEQUENCE", "SEQUENCES",
-		"SERIALIZABLE", "SERVER", "SESSION", "SET", "SHARE", "SHOW", "SIMPLE",
-		"SNAPSHOT", "STABLE", "STANDALONE_P", "START", "STATEMENT", "STATISTICS",
-		"STDIN", "STDOUT", "STORAGE", "STRICT_P", "STRIP_P", "SYSID", "SYSTEM_P",
-		"TABLES", "TABLESPACE", "TEMP", "TEMPLATE", "TEMPORARY", "TEXT_P", "TRANSACTION",
-		"TRIGGER", "TRUNCATE", "TRUSTED", "TYPE_P", "TYPES_P", "UNBOUNDED",
-		"UNCOMMITTED", "UNENCRYPTED", "UNKNOWN", "UNLISTEN", "UNLOGGED", "UNTIL",
-		"UPDATE", "VACUUM", "VALID", "VALIDATE", "VALIDATOR", "VARYING", "VERSION_P",
-		"VIEW", "VOLATILE", "WHITESPACE_P", "WITHOUT", "WORK", "WRAPPER", "WRITE",
-		"XML_P", "YEAR_P", "YES_P", "ZONE", "ATOMIC_P", "BETWEEN", "BIGINT",
-		"BIT", "BOOLEAN_P", "CHAR_P", "CHARACTER", "COALESCE", "DEC", "DECIMAL_P",
-		"EXISTS", "EXTRACT", "FLOAT_P", "GREATEST", "INOUT", "INT_P", "INTEGER",
-		"INTERVAL", "LEAST", "NATIONAL", "NCHAR", "NONE", "NULLIF", "NUMERIC",
-		"OVERLAY", "PARAMETER", "POSITION", "PRECISION", "REAL", "ROW", "SETOF",
-		"SMALLINT", "SUBSTRING", "TIME", "TIMESTAMP", "TREAT", "TRIM", "VALUES",
-		"VARCHAR", "XMLATTRIBUTES", "XMLCOMMENT", "XMLAGG", "XML_IS_WELL_FORMED",
-		"XML_IS_WELL_FORMED_DOCUMENT", "XML_IS_WELL_FORMED_CONTENT", "XPATH",
-		"XPATH_EXISTS", "XMLCONCAT", "XMLELEMENT", "XMLEXISTS", "XMLFOREST",
+		"VERBOSE", "ABORT_P", "ABSENT", "ABSOLUTE_P", "ACCESS", "ACTION", "ADD_P",
+		"ADMIN", "AFTER", "AGGREGATE", "ALSO", "ALTER", "ALWAYS", "ASSERTION",
+		"ASSIGNMENT", "AT", "ATTRIBUTE", "BACKWARD", "BEFORE", "BEGIN_P", "BY",
+		"CACHE", "CALLED", "CASCADE", "CASCADED", "CATALOG", "CHAIN", "CHARACTERISTICS",
+		"CHECKPOINT", "CLASS", "CLOSE", "CLUSTER", "COMMENT", "COMMENTS", "COMMIT",
+		"COMMITTED", "CONDITIONAL", "CONFIGURATION", "CONNECTION", "CONSTRAINTS",
+		"CONTENT_P", "CONTINUE_P", "CONVERSION_P", "COPY", "COST", "CSV", "CURSOR",
+		"CYCLE", "DATA_P", "DATABASE", "DAY_P", "DEALLOCATE", "DECLARE", "DEFAULTS",
+		"DEFERRED", "DEFINER", "DELETE_P", "DELIMITER", "DELIMITERS", "DICTIONARY",
+		"DISABLE_P", "DISCARD", "DOCUMENT_P", "DOMAIN_P", "DOUBLE_P", "DROP",
+		"EACH", "EMPTY_P", "ENABLE_P", "ENCODING", "ENCRYPTED", "ENUM_P", "ESCAPE",
+		"EVENT", "EXCLUDE", "EXCLUDING", "EXCLUSIVE", "EXECUTE", "EXPLAIN",
+		"EXTENSION", "EXTERNAL", "FAMILY", "FIRST_P", "FOLLOWING", "FORCE",
+		"FORWARD", "FUNCTION", "FUNCTIONS", "GLOBAL", "GRANTED", "HANDLER",
+		"HEADER_P", "HOLD", "HOUR_P", "IDENTITY_P", "IF_P", "IMMEDIATE", "IMMUTABLE",
+		"IMPLICIT_P", "INCLUDING", "INCREMENT", "INDEX", "INDEXES", "INHERIT",
+		"INHERITS", "INLINE_P", "INSENSITIVE", "INSERT", "INSTEAD", "INVOKER",
+		"ISOLATION", "JSON", "KEEP", "KEY", "KEYS", "LABEL", "LANGUAGE", "LARGE_P",
+		"LAST_P", "LEAKPROOF", "LEVEL", "LISTEN", "LOAD", "LOCAL", "LOCATION",
+		"LOCK_P", "MAPPING", "MATCH", "MATCHED", "MATERIALIZED", "MAXVALUE",
+		"MERGE", "MINUTE_P", "MINVALUE", "MODE", "MONTH_P", "MOVE", "NAME_P",
+		"NAMES", "NESTED", "NEXT", "NO", "NOTHING", "NOTIFY", "NOWAIT", "NULLS_P",
+		"OBJECT_P", "OF", "OFF", "OIDS", "OMIT", "OPERATOR", "OPTION", "OPTIONS",
+		"OWNED", "OWNER", "PARSER", "PARTIAL", "PARTITION", "PASSING", "PASSWORD",
+		"PATH", "PLANS", "PRECEDING", "PREPARE", "PREPARED", "PRESERVE", "PRIOR",
+		"PRIVILEGES", "PROCEDURAL", "PROCEDURE", "PROGRAM", "QUOTE", "QUOTES",
+		"RANGE", "READ", "REASSIGN", "RECHECK", "RECURSIVE", "REF", "REFRESH",
+		"REINDEX", "RELATIVE_P", "RELEASE", "RENAME", "REPEATABLE", "REPLACE",
+		"REPLICA", "RESET", "RESTART", "RESTRICT", "RETURNS", "REVOKE", "ROLE",
+		"ROLLBACK", "ROWS", "RULE", "SAVEPOINT", "SCALAR", "SCHEMA", "SCROLL",
+		"SEARCH", "SECOND_P", "SECURITY", "SEQUENCE", "SEQUENCES", "SERIALIZABLE",
+		"SERVER", "SESSION", "SET", "SHARE", "SHOW", "SIMPLE", "SNAPSHOT", "SOURCE",
+		"STABLE", "STANDALONE_P", "START", "STATEMENT", "STATISTICS", "STDIN",
+		"STDOUT", "STORAGE", "STRICT_P", "STRING_P", "STRIP_P", "SYSID", "SYSTEM_P",
+		"TABLES", "TABLESPACE", "TARGET", "TEMP", "TEMPLATE", "TEMPORARY", "TEXT_P",
+		"TRANSACTION", "TRIGGER", "TRUNCATE", "TRUSTED", "TYPE_P", "TYPES_P",
+		"UNBOUNDED", "UNCOMMITTED", "UNCONDITIONAL", "UNENCRYPTED", "UNKNOWN",
+		"UNLISTEN", "UNLOGGED", "UNTIL", "UPDATE", "VACUUM", "VALID", "VALIDATE",
+		"VALIDATOR", "VARYING", "VERSION_P", "VIEW", "VOLATILE", "WHITESPACE_P",
+		"WITHOUT", "WORK", "WRAPPER", "WRITE", "XML_P", "YEAR_P", "YES_P", "ZONE",
+		"ATOMIC_P", "BETWEEN", "BIGINT", "BIT", "BOOLEAN_P", "CHAR_P", "CHARACTER",
+		"COALESCE", "DEC", "DECIMAL_P", "EXISTS", "EXTRACT", "FLOAT_P", "GREATEST",
+		"INOUT", "INT_P", "INTEGER", "INTERVAL", "JSON_ARRAY", "JSON_ARRAYAGG",
+		"JSON_EXISTS", "JSON_OBJECT", "JSON_OBJECTAGG", "JSON_QUERY", "JSON_SCALAR",
+		"JSON_SERIALIZE", "JSON_TABLE", "JSON_VALUE", "LEAST", "NATIONAL", "NCHAR",
+		"NONE", "NULLIF", "NUMERIC", "OVERLAY", "PARAMETER", "POSITION", "PRECISION",
+		"REAL", "ROW", "SETOF", "SMALLINT", "SUBSTRING", "TIME", "TIMESTAMP",
+		"TREAT", "TRIM", "VALUES", "VARCHAR", "XMLATTRIBUTES", "XMLCOMMENT",
+		"XMLAGG", "XML_IS_WELL_FORMED", "XML_IS_WELL_FORMED_DOCUMENT", "XML_IS_WELL_FORMED_CONTENT",
+		"XPATH", "XPATH_EXISTS", "XMLCONCAT", "XMLELEMENT", "XMLEXISTS", "XMLFOREST",
 		"XMLPARSE", "XMLPI", "XMLROOT", "XMLSERIALIZE", "CALL", "CURRENT_P",
 		"ATTACH", "DETACH", "EXPRESSION", "GENERATED", "LOGGED", "STORED", "INCLUDE",
 		"ROUTINE", "TRANSFORM", "IMPORT_P", "POLICY", "METHOD", "REFERENCING",
@@ -390,3165 +404,3306 @@ func postgresqllexerLexerInit() {
 		"AfterEscapeStringConstantMode_Newline", "AfterEscapeStringConstantMode_NotContinued",
 		"AfterEscapeStringConstantWithNewlineMode_Whitespace", "AfterEscapeStringConstantWithNewlineMode_Newline",
 		"AfterEscapeStringConstantWithNewlineMode_Continued", "AfterEscapeStringConstantWithNewlineMode_NotContinued",
-		"DollarText", "EndDollarStringConstant",
+		"DollarText", "EndDollarStringConstant", "CopyData",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 686, 6874, 6, -1, 6, -1, 6, -1, 6, -1, 6, -1, 2, 0, 7, 0, 2, 1, 7,
-		1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7,
-		7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2,
-		13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18,
-		7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7,
-		23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28,
-		2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2,
-		34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39,
-		7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7,
-		44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49,
-		2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2,
-		55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60,
-		7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7,
-		65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70,
-		2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2,
-		76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81,
-		7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7,
-		86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91,
-		2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2,
-		97, 7, 97, 2, 98, 7, 98, 2, 99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101,
-		2, 102, 7, 102, 2, 103, 7, 103, 2, 104, 7, 104, 2, 105, 7, 105, 2, 106,
-		7, 106, 2, 107, 7, 107, 2, 108, 7, 108, 2, 109, 7, 109, 2, 110, 7, 110,
-		2, 111, 7, 111, 2, 112, 7, 112, 2, 113, 7, 113, 2, 114, 7, 114, 2, 115,
-		7, 115, 2, 116, 7, 116, 2, 117, 7, 117, 2, 118, 7, 118, 2, 119, 7, 119,
-		2, 120, 7, 120, 2, 121, 7, 121, 2, 122, 7, 122, 2, 123, 7, 123, 2, 124,
-		7, 124, 2, 125, 7, 125, 2, 126, 7, 126, 2, 127, 7, 127, 2, 128, 7, 128,
-		2, 129, 7, 129, 2, 130, 7, 130, 2, 131, 7, 131, 2, 132, 7, 132, 2, 133,
-		7, 133, 2, 134, 7, 134, 2, 135, 7, 135, 2, 136, 7, 136, 2, 137, 7, 137,
-		2, 138, 7, 138, 2, 139, 7, 139, 2, 140, 7, 140, 2, 141, 7, 141, 2, 142,
-		7, 142, 2, 143, 7, 143, 2, 144, 7, 144, 2, 145, 7, 145, 2, 146, 7, 146,
-		2, 147, 7, 147, 2, 148, 7, 148, 2, 149, 7, 149, 2, 150, 7, 150, 2, 151,
-		7, 151, 2, 152, 7, 152, 2, 153, 7, 153, 2, 154, 7, 154, 2, 155, 7, 155,
-		2, 156, 7, 156, 2, 157, 7, 157, 2, 158, 7, 158, 2, 159, 7, 159, 2, 160,
-		7, 160, 2, 161, 7, 161, 2, 162, 7, 162, 2, 163, 7, 163, 2, 164, 7, 164,
-		2, 165, 7, 165, 2, 166, 7, 166, 2, 167, 7, 167, 2, 168, 7, 168, 2, 169,
-		7, 169, 2, 170, 7, 170, 2, 171, 7, 171, 2, 172, 7, 172, 2, 173, 7, 173,
-		2, 174, 7, 174, 2, 175, 7, 175, 2, 176, 7, 176, 2, 177, 7, 177, 2, 178,
-		7, 178, 2, 179, 7, 179, 2, 180, 7, 180, 2, 181, 7, 181, 2, 182, 7, 182,
-		2, 183, 7, 183, 2, 184, 7, 184, 2, 185, 7, 185, 2, 186, 7, 186, 2, 187,
-		7, 187, 2, 188, 7, 188, 2, 189, 7, 189, 2, 190, 7, 190, 2, 191, 7, 191,
-		2, 192, 7, 192, 2, 193, 7, 193, 2, 194, 7, 194, 2, 195, 7, 195, 2, 196,
-		7, 196, 2, 197, 7, 197, 2, 198, 7, 198, 2, 199, 7, 199, 2, 200, 7, 200,
-		2, 201, 7, 201, 2, 202, 7, 202, 2, 203, 7, 203, 2, 204, 7, 204, 2, 205,
-		7, 205, 2, 206, 7, 206, 2, 207, 7, 207, 2, 208, 7, 208, 2, 209, 7, 209,
-		2, 210, 7, 210, 2, 211, 7, 211, 2, 212, 7, 212, 2, 213, 7, 213, 2, 214,
-		7, 214, 2, 215, 7, 215, 2, 216, 7, 216, 2, 217, 7, 217, 2, 218, 7, 218,
-		2, 219, 7, 219, 2, 220, 7, 220, 2, 221, 7, 221, 2, 222, 7, 222, 2, 223,
-		7, 223, 2, 224, 7, 224, 2, 225, 7, 225, 2, 226, 7, 226, 2, 227, 7, 227,
-		2, 228, 7, 228, 2, 229, 7, 229, 2, 230, 7, 230, 2, 231, 7, 231, 2, 232,
-		7, 232, 2, 233, 7, 233, 2, 234, 7, 234, 2, 235, 7, 235, 2, 236, 7, 236,
-		2, 237, 7, 237, 2, 238, 7, 238, 2, 239, 7, 239, 2, 240, 7, 240, 2, 241,
-		7, 241, 2, 242, 7, 242, 2, 243, 7, 243, 2, 244, 7, 244, 2, 245, 7, 245,
-		2, 246, 7, 246, 2, 247, 7, 247, 2, 248, 7, 248, 2, 249, 7, 249, 2, 250,
-		7, 250, 2, 251, 7, 251, 2, 252, 7, 252, 2, 253, 7, 253, 2, 254, 7, 254,
-		2, 255, 7, 255, 2, 256, 7, 256, 2, 257, 7, 257, 2, 258, 7, 258, 2, 259,
-		7, 259, 2, 260, 7, 260, 2, 261, 7, 261, 2, 262, 7, 262, 2, 263, 7, 263,
-		2, 264, 7, 264, 2, 265, 7, 265, 2, 266, 7, 266, 2, 267, 7, 267, 2, 268,
-		7, 268, 2, 269, 7, 269, 2, 270, 7, 270, 2, 271, 7, 271, 2, 272, 7, 272,
-		2, 273, 7, 273, 2, 274, 7, 274, 2, 275, 7, 275, 2, 276, 7, 276, 2, 277,
-		7, 277, 2, 278, 7, 278, 2, 279, 7, 279, 2, 280, 7, 280, 2, 281, 7, 281,
-		2, 282, 7, 282, 2, 283, 7, 283, 2, 284, 7, 284, 2, 285, 7, 285, 2, 286,
-		7, 286, 2, 287, 7, 287, 2, 288, 7, 288, 2, 289, 7, 289, 2, 290, 7, 290,
-		2, 291, 7, 291, 2, 292, 7, 292, 2, 293, 7, 293, 2, 294, 7, 294, 2, 295,
-		7, 295, 2, 296, 7, 296, 2, 297, 7, 297, 2, 298, 7, 298, 2, 299, 7, 299,
-		2, 300, 7, 300, 2, 301, 7, 301, 2, 302, 7, 302, 2, 303, 7, 303, 2, 304,
-		7, 304, 2, 305, 7, 305, 2, 306, 7, 306, 2, 307, 7, 307, 2, 308, 7, 308,
-		2, 309, 7, 309, 2, 310, 7, 310, 2, 311, 7, 311, 2, 312, 7, 312, 2, 313,
-		7, 313, 2, 314, 7, 314, 2, 315, 7, 315, 2, 316, 7, 316, 2, 317, 7, 317,
-		2, 318, 7, 318, 2, 319, 7, 319, 2, 320, 7, 320, 2, 321, 7, 321, 2, 322,
-		7, 322, 2, 323, 7, 323, 2, 324, 7, 324, 2, 325, 7, 325, 2, 326, 7, 326,
-		2, 327, 7, 327, 2, 328, 7, 328, 2, 329, 7, 329, 2, 330, 7, 330, 2, 331,
-		7, 331, 2, 332, 7, 332, 2, 333, 7, 333, 2, 334, 7, 334, 2, 335, 7, 335,
-		2, 336, 7, 336, 2, 337, 7, 337, 2, 338, 7, 338, 2, 339, 7, 339, 2, 340,
-		7, 340, 2, 341, 7, 341, 2, 342, 7, 342, 2, 343, 7, 343, 2, 344, 7, 344,
-		2, 345, 7, 345, 2, 346, 7, 346, 2, 347, 7, 347, 2, 348, 7, 348, 2, 349,
-		7, 349, 2, 350, 7, 350, 2, 351, 7, 351, 2, 352, 7, 352, 2, 353, 7, 353,
-		2, 354, 7, 354, 2, 355, 7, 355, 2, 356, 7, 356, 2, 357, 7, 357, 2, 358,
-		7, 358, 2, 359, 7, 359, 2, 360, 7, 360, 2, 361, 7, 361, 2, 362, 7, 362,
-		2, 363, 7, 363, 2, 364, 7, 364, 2, 365, 7, 365, 2, 366, 7, 366, 2, 367,
-		7, 367, 2, 368, 7, 368, 2, 369, 7, 369, 2, 370, 7, 370, 2, 371, 7, 371,
-		2, 372, 7, 372, 2, 373, 7, 373, 2, 374, 7, 374, 2, 375, 7, 375, 2, 376,
-		7, 376, 2, 377, 7, 377, 2, 378, 7, 378, 2, 379, 7, 379, 2, 380, 7, 380,
-		2, 381, 7, 381, 2, 382, 7, 382, 2, 383, 7, 383, 2, 384, 7, 384, 2, 385,
-		7, 385, 2, 386, 7, 386, 2, 387, 7, 387, 2, 388, 7, 388, 2, 389, 7, 389,
-		2, 390, 7, 390, 2, 391, 7, 391, 2, 392, 7, 392, 2, 393, 7, 393, 2, 394,
-		7, 394, 2, 395, 7, 395, 2, 396, 7, 396, 2, 397, 7, 397, 2, 398, 7, 398,
-		2, 399, 7, 399, 2, 400, 7, 400, 2, 401, 7, 401, 2, 402, 7, 402, 2, 403,
-		7, 403, 2, 404, 7, 404, 2, 405, 7, 405, 2, 406, 7, 406, 2, 407, 7, 407,
-		2, 408, 7, 408, 2, 409, 7, 409, 2, 410, 7, 410, 2, 411, 7, 411, 2, 412,
-		7, 412, 2, 413, 7, 413, 2, 414, 7, 414, 2, 415, 7, 415, 2, 416, 7, 416,
-		2, 417, 7, 417, 2, 418, 7, 418, 2, 419, 7, 419, 2, 420, 7, 420, 2, 421,
-		7, 421, 2, 422, 7, 422, 2, 423, 7, 423, 2, 424, 7, 424, 2, 425, 7, 425,
-		2, 426, 7, 426, 2, 427, 7, 427, 2, 428, 7, 428, 2, 429, 7, 429, 2, 430,
-		7, 430, 2, 431, 7, 431, 2, 432, 7, 432, 2, 433, 7, 433, 2, 434, 7, 434,
-		2, 435, 7, 435, 2, 436, 7, 436, 2, 437, 7, 437, 2, 438, 7, 438, 2, 439,
-		7, 439, 2, 440, 7, 440, 2, 441, 7, 441, 2, 442, 7, 442, 2, 443, 7, 443,
-		2, 444, 7, 444, 2, 445, 7, 445, 2, 446, 7, 446, 2, 447, 7, 447, 2, 448,
-		7, 448, 2, 449, 7, 449, 2, 450, 7, 450, 2, 451, 7, 451, 2, 452, 7, 452,
-		2, 453, 7, 453, 2, 454, 7, 454, 2, 455, 7, 455, 2, 456, 7, 456, 2, 457,
-		7, 457, 2, 458, 7, 458, 2, 459, 7, 459, 2, 460, 7, 460, 2, 461, 7, 461,
-		2, 462, 7, 462, 2, 463, 7, 463, 2, 464, 7, 464, 2, 465, 7, 465, 2, 466,
-		7, 466, 2, 467, 7, 467, 2, 468, 7, 468, 2, 469, 7, 469, 2, 470, 7, 470,
-		2, 471, 7, 471, 2, 472, 7, 472, 2, 473, 7, 473, 2, 474, 7, 474, 2, 475,
-		7, 475, 2, 476, 7, 476, 2, 477, 7, 477, 2, 478, 7, 478, 2, 479, 7, 479,
-		2, 480, 7, 480, 2, 481, 7, 481, 2, 482, 7, 482, 2, 483, 7, 483, 2, 484,
-		7, 484, 2, 485, 7, 485, 2, 486, 7, 486, 2, 487, 7, 487, 2, 488, 7, 488,
-		2, 489, 7, 489, 2, 490, 7, 490, 2, 491, 7, 491, 2, 492, 7, 492, 2, 493,
-		7, 493, 2, 494, 7, 494, 2, 495, 7, 495, 2, 496, 7, 496, 2, 497, 7, 497,
-		2, 498, 7, 498, 2, 499, 7, 499, 2, 500, 7, 500, 2, 501, 7, 501, 2, 502,
-		7, 502, 2, 503, 7, 503, 2, 504, 7, 504, 2, 505, 7, 505, 2, 506, 7, 506,
-		2, 507, 7, 507, 2, 508, 7, 508, 2, 509, 7, 509, 2, 510, 7, 510, 2, 511,
-		7, 511, 2, 512, 7, 512, 2, 513, 7, 513, 2, 514, 7, 514, 2, 515, 7, 515,
-		2, 516, 7, 516, 2, 517, 7, 517, 2, 518, 7, 518, 2, 519, 7, 519, 2, 520,
-		7, 520, 2, 521, 7, 521, 2, 522, 7, 522, 2, 523, 7, 523, 2, 524, 7, 524,
-		2, 525, 7, 525, 2, 526, 7, 526, 2, 527, 7, 527, 2, 528, 7, 528, 2, 529,
-		7, 529, 2, 530, 7, 530, 2, 531, 7, 531, 2, 532, 7, 532, 2, 533, 7, 533,
-		2, 534, 7, 534, 2, 535, 7, 535, 2, 536, 7, 536, 2, 537, 7, 537, 2, 538,
-		7, 538, 2, 539, 7, 539, 2, 540, 7, 540, 2, 541, 7, 541, 2, 542, 7, 542,
-		2, 543, 7, 543, 2, 544, 7, 544, 2, 545, 7, 545, 2, 546, 7, 546, 2, 547,
-		7, 547, 2, 548, 7, 548, 2, 549, 7, 549, 2, 550, 7, 550, 2, 551, 7, 551,
-		2, 552, 7, 552, 2, 553, 7, 553, 2, 554, 7, 554, 2, 555, 7, 555, 2, 556,
-		7, 556, 2, 557, 7, 557, 2, 558, 7, 558, 2, 559, 7, 559, 2, 560, 7, 560,
-		2, 561, 7, 561, 2, 562, 7, 562, 2, 563, 7, 563, 2, 564, 7, 564, 2, 565,
-		7, 565, 2, 566, 7, 566, 2, 567, 7, 567, 2, 568, 7, 568, 2, 569, 7, 569,
-		2, 570, 7, 570, 2, 571, 7, 571, 2, 572, 7, 572, 2, 573, 7, 573, 2, 574,
-		7, 574, 2, 575, 7, 575, 2, 576, 7, 576, 2, 577, 7, 577, 2, 578, 7, 578,
-		2, 579, 7, 579, 2, 580, 7, 580, 2, 581, 7, 581, 2, 582, 7, 582, 2, 583,
-		7, 583, 2, 584, 7, 584, 2, 585, 7, 585, 2, 586, 7, 586, 2, 587, 7, 587,
-		2, 588, 7, 588, 2, 589, 7, 589, 2, 590, 7, 590, 2, 591, 7, 591, 2, 592,
-		7, 592, 2, 593, 7, 593, 2, 594, 7, 594, 2, 595, 7, 595, 2, 596, 7, 596,
-		2, 597, 7, 597, 2, 598, 7, 598, 2, 599, 7, 599, 2, 600, 7, 600, 2, 601,
-		7, 601, 2, 602, 7, 602, 2, 603, 7, 603, 2, 604, 7, 604, 2, 605, 7, 605,
-		2, 606, 7, 606, 2, 607, 7, 607, 2, 608, 7, 608, 2, 609, 7, 609, 2, 610,
-		7, 610, 2, 611, 7, 611, 2, 612, 7, 612, 2, 613, 7, 613, 2, 614, 7, 614,
-		2, 615, 7, 615, 2, 616, 7, 616, 2, 617, 7, 617, 2, 618, 7, 618, 2, 619,
-		7, 619, 2, 620, 7, 620, 2, 621, 7, 621, 2, 622, 7, 622, 2, 623, 7, 623,
-		2, 624, 7, 624, 2, 625, 7, 625, 2, 626, 7, 626, 2, 627, 7, 627, 2, 628,
-		7, 628, 2, 629, 7, 629, 2, 630, 7, 630, 2, 631, 7, 631, 2, 632, 7, 632,
-		2, 633, 7, 633, 2, 634, 7, 634, 2, 635, 7, 635, 2, 636, 7, 636, 2, 637,
-		7, 637, 2, 638, 7, 638, 2, 639, 7, 639, 2, 640, 7, 640, 2, 641, 7, 641,
-		2, 642, 7, 642, 2, 643, 7, 643, 2, 644, 7, 644, 2, 645, 7, 645, 2, 646,
-		7, 646, 2, 647, 7, 647, 2, 648, 7, 648, 2, 649, 7, 649, 2, 650, 7, 650,
-		2, 651, 7, 651, 2, 652, 7, 652, 2, 653, 7, 653, 2, 654, 7, 654, 2, 655,
-		7, 655, 2, 656, 7, 656, 2, 657, 7, 657, 2, 658, 7, 658, 2, 659, 7, 659,
-		2, 660, 7, 660, 2, 661, 7, 661, 2, 662, 7, 662, 2, 663, 7, 663, 2, 664,
-		7, 664, 2, 665, 7, 665, 2, 666, 7, 666, 2, 667, 7, 667, 2, 668, 7, 668,
-		2, 669, 7, 669, 2, 670, 7, 670, 2, 671, 7, 671, 2, 672, 7, 672, 2, 673,
-		7, 673, 2, 674, 7, 674, 2, 675, 7, 675, 2, 676, 7, 676, 2, 677, 7, 677,
-		2, 678, 7, 678, 2, 679, 7, 679, 2, 680, 7, 680, 2, 681, 7, 681, 2, 682,
-		7, 682, 2, 683, 7, 683, 2, 684, 7, 684, 2, 685, 7, 685, 2, 686, 7, 686,
-		2, 687, 7, 687, 2, 688, 7, 688, 2, 689, 7, 689, 2, 690, 7, 690, 2, 691,
-		7, 691, 2, 692, 7, 692, 2, 693, 7, 693, 2, 694, 7, 694, 2, 695, 7, 695,
-		2, 696, 7, 696, 2, 697, 7, 697, 2, 698, 7, 698, 2, 699, 7, 699, 2, 700,
-		7, 700, 2, 701, 7, 701, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1, 2, 1, 3, 1, 3,
-		1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 9, 1, 9,
-		1, 10, 1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 14, 1, 14, 1,
-		15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 19,
-		1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1,
-		22, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 26,
-		1, 26, 1, 27, 1, 27, 4, 27, 1475, 8, 27, 11, 27, 12, 27, 1476, 1, 28, 1,
-		28, 1, 28, 1, 28, 4, 28, 1483, 8, 28, 11, 28, 12, 28, 1484, 1, 28, 1, 28,
-		1, 28, 3, 28, 1490, 8, 28, 1, 28, 1, 28, 4, 28, 1494, 8, 28, 11, 28, 12,
-		28, 1495, 1, 28, 3, 28, 1499, 8, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29,
-		1, 29, 1, 29, 5, 29, 1508, 8, 29, 10, 29, 12, 29, 1511, 9, 29, 1, 29, 1,
-		29, 3, 29, 1515, 8, 29, 1, 29, 1, 29, 1, 29, 4, 29, 1520, 8, 29, 11, 29,
-		12, 29, 1521, 1, 29, 1, 29, 1, 30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34,
-		1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1,
-		36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1,
-		41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42,
-		1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46,
-		1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1,
-		47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48,
-		1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1,
-		49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50,
-		1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1,
-		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52,
-		1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53,
-		1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1,
-		54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54,
+		4, 0, 712, 7181, 6, -1, 6, -1, 6, -1, 6, -1, 6, -1, 6, -1, 2, 0, 7, 0,
+		2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6,
+		2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7, 10, 2, 11, 7, 11, 2, 12,
+		7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15, 2, 16, 7, 16, 2, 17, 7,
+		17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2, 21, 7, 21, 2, 22, 7, 22,
+		2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26, 7, 26, 2, 27, 7, 27, 2,
+		28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7, 31, 2, 32, 7, 32, 2, 33,
+		7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36, 2, 37, 7, 37, 2, 38, 7,
+		38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2, 42, 7, 42, 2, 43, 7, 43,
+		2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47, 7, 47, 2, 48, 7, 48, 2,
+		49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7, 52, 2, 53, 7, 53, 2, 54,
+		7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57, 2, 58, 7, 58, 2, 59, 7,
+		59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2, 63, 7, 63, 2, 64, 7, 64,
+		2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68, 7, 68, 2, 69, 7, 69, 2,
+		70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7, 73, 2, 74, 7, 74, 2, 75,
+		7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78, 2, 79, 7, 79, 2, 80, 7,
+		80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2, 84, 7, 84, 2, 85, 7, 85,
+		2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89, 7, 89, 2, 90, 7, 90, 2,
+		91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7, 94, 2, 95, 7, 95, 2, 96,
+		7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7, 99, 2, 100, 7, 100, 2, 101,
+		7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 2, 104, 7, 104, 2, 105, 7, 105,
+		2, 106, 7, 106, 2, 107, 7, 107, 2, 108, 7, 108, 2, 109, 7, 109, 2, 110,
+		7, 110, 2, 111, 7, 111, 2, 112, 7, 112, 2, 113, 7, 113, 2, 114, 7, 114,
+		2, 115, 7, 115, 2, 116, 7, 116, 2, 117, 7, 117, 2, 118, 7, 118, 2, 119,
+		7, 119, 2, 120, 7, 120, 2, 121, 7, 121, 2, 122, 7, 122, 2, 123, 7, 123,
+		2, 124, 7, 124, 2, 125, 7, 125, 2, 126, 7, 126, 2, 127, 7, 127, 2, 128,
+		7, 128, 2, 129, 7, 129, 2, 130, 7, 130, 2, 131, 7, 131, 2, 132, 7, 132,
+		2, 133, 7, 133, 2, 134, 7, 134, 2, 135, 7, 135, 2, 136, 7, 136, 2, 137,
+		7, 137, 2, 138, 7, 138, 2, 139, 7, 139, 2, 140, 7, 140, 2, 141, 7, 141,
+		2, 142, 7, 142, 2, 143, 7, 143, 2, 144, 7, 144, 2, 145, 7, 145, 2, 146,
+		7, 146, 2, 147, 7, 147, 2, 148, 7, 148, 2, 149, 7, 149, 2, 150, 7, 150,
+		2, 151, 7, 151, 2, 152, 7, 152, 2, 153, 7, 153, 2, 154, 7, 154, 2, 155,
+		7, 155, 2, 156, 7, 156, 2, 157, 7, 157, 2, 158, 7, 158, 2, 159, 7, 159,
+		2, 160, 7, 160, 2, 161, 7, 161, 2, 162, 7, 162, 2, 163, 7, 163, 2, 164,
+		7, 164, 2, 165, 7, 165, 2, 166, 7, 166, 2, 167, 7, 167, 2, 168, 7, 168,
+		2, 169, 7, 169, 2, 170, 7, 170, 2, 171, 7, 171, 2, 172, 7, 172, 2, 173,
+		7, 173, 2, 174, 7, 174, 2, 175, 7, 175, 2, 176, 7, 176, 2, 177, 7, 177,
+		2, 178, 7, 178, 2, 179, 7, 179, 2, 180, 7, 180, 2, 181, 7, 181, 2, 182,
+		7, 182, 2, 183, 7, 183, 2, 184, 7, 184, 2, 185, 7, 185, 2, 186, 7, 186,
+		2, 187, 7, 187, 2, 188, 7, 188, 2, 189, 7, 189, 2, 190, 7, 190, 2, 191,
+		7, 191, 2, 192, 7, 192, 2, 193, 7, 193, 2, 194, 7, 194, 2, 195, 7, 195,
+		2, 196, 7, 196, 2, 197, 7, 197, 2, 198, 7, 198, 2, 199, 7, 199, 2, 200,
+		7, 200, 2, 201, 7, 201, 2, 202, 7, 202, 2, 203, 7, 203, 2, 204, 7, 204,
+		2, 205, 7, 205, 2, 206, 7, 206, 2, 207, 7, 207, 2, 208, 7, 208, 2, 209,
+		7, 209, 2, 210, 7, 210, 2, 211, 7, 211, 2, 212, 7, 212, 2, 213, 7, 213,
+		2, 214, 7, 214, 2, 215, 7, 215, 2, 216, 7, 216, 2, 217, 7, 217, 2, 218,
+		7, 218, 2, 219, 7, 219, 2, 220, 7, 220, 2, 221, 7, 221, 2, 222, 7, 222,
+		2, 223, 7, 223, 2, 224, 7, 224, 2, 225, 7, 225, 2, 226, 7, 226, 2, 227,
+		7, 227, 2, 228, 7, 228, 2, 229, 7, 229, 2, 230, 7, 230, 2, 231, 7, 231,
+		2, 232, 7, 232, 2, 233, 7, 233, 2, 234, 7, 234, 2, 235, 7, 235, 2, 236,
+		7, 236, 2, 237, 7, 237, 2, 238, 7, 238, 2, 239, 7, 239, 2, 240, 7, 240,
+		2, 241, 7, 241, 2, 242, 7, 242, 2, 243, 7, 243, 2, 244, 7, 244, 2, 245,
+		7, 245, 2, 246, 7, 246, 2, 247, 7, 247, 2, 248, 7, 248, 2, 249, 7, 249,
+		2, 250, 7, 250, 2, 251, 7, 251, 2, 252, 7, 252, 2, 253, 7, 253, 2, 254,
+		7, 254, 2, 255, 7, 255, 2, 256, 7, 256, 2, 257, 7, 257, 2, 258, 7, 258,
+		2, 259, 7, 259, 2, 260, 7, 260, 2, 261, 7, 261, 2, 262, 7, 262, 2, 263,
+		7, 263, 2, 264, 7, 264, 2, 265, 7, 265, 2, 266, 7, 266, 2, 267, 7, 267,
+		2, 268, 7, 268, 2, 269, 7, 269, 2, 270, 7, 270, 2, 271, 7, 271, 2, 272,
+		7, 272, 2, 273, 7, 273, 2, 274, 7, 274, 2, 275, 7, 275, 2, 276, 7, 276,
+		2, 277, 7, 277, 2, 278, 7, 278, 2, 279, 7, 279, 2, 280, 7, 280, 2, 281,
+		7, 281, 2, 282, 7, 282, 2, 283, 7, 283, 2, 284, 7, 284, 2, 285, 7, 285,
+		2, 286, 7, 286, 2, 287, 7, 287, 2, 288, 7, 288, 2, 289, 7, 289, 2, 290,
+		7, 290, 2, 291, 7, 291, 2, 292, 7, 292, 2, 293, 7, 293, 2, 294, 7, 294,
+		2, 295, 7, 295, 2, 296, 7, 296, 2, 297, 7, 297, 2, 298, 7, 298, 2, 299,
+		7, 299, 2, 300, 7, 300, 2, 301, 7, 301, 2, 302, 7, 302, 2, 303, 7, 303,
+		2, 304, 7, 304, 2, 305, 7, 305, 2, 306, 7, 306, 2, 307, 7, 307, 2, 308,
+		7, 308, 2, 309, 7, 309, 2, 310, 7, 310, 2, 311, 7, 311, 2, 312, 7, 312,
+		2, 313, 7, 313, 2, 314, 7, 314, 2, 315, 7, 315, 2, 316, 7, 316, 2, 317,
+		7, 317, 2, 318, 7, 318, 2, 319, 7, 319, 2, 320, 7, 320, 2, 321, 7, 321,
+		2, 322, 7, 322, 2, 323, 7, 323, 2, 324, 7, 324, 2, 325, 7, 325, 2, 326,
+		7, 326, 2, 327, 7, 327, 2, 328, 7, 328, 2, 329, 7, 329, 2, 330, 7, 330,
+		2, 331, 7, 331, 2, 332, 7, 332, 2, 333, 7, 333, 2, 334, 7, 334, 2, 335,
+		7, 335, 2, 336, 7, 336, 2, 337, 7, 337, 2, 338, 7, 338, 2, 339, 7, 339,
+		2, 340, 7, 340, 2, 341, 7, 341, 2, 342, 7, 342, 2, 343, 7, 343, 2, 344,
+		7, 344, 2, 345, 7, 345, 2, 346, 7, 346, 2, 347, 7, 347, 2, 348, 7, 348,
+		2, 349, 7, 349, 2, 350, 7, 350, 2, 351, 7, 351, 2, 352, 7, 352, 2, 353,
+		7, 353, 2, 354, 7, 354, 2, 355, 7, 355, 2, 356, 7, 356, 2, 357, 7, 357,
+		2, 358, 7, 358, 2, 359, 7, 359, 2, 360, 7, 360, 2, 361, 7, 361, 2, 362,
+		7, 362, 2, 363, 7, 363, 2, 364, 7, 364, 2, 365, 7, 365, 2, 366, 7, 366,
+		2, 367, 7, 367, 2, 368, 7, 368, 2, 369, 7, 369, 2, 370, 7, 370, 2, 371,
+		7, 371, 2, 372, 7, 372, 2, 373, 7, 373, 2, 374, 7, 374, 2, 375, 7, 375,
+		2, 376, 7, 376, 2, 377, 7, 377, 2, 378, 7, 378, 2, 379, 7, 379, 2, 380,
+		7, 380, 2, 381, 7, 381, 2, 382, 7, 382, 2, 383, 7, 383, 2, 384, 7, 384,
+		2, 385, 7, 385, 2, 386, 7, 386, 2, 387, 7, 387, 2, 388, 7, 388, 2, 389,
+		7, 389, 2, 390, 7, 390, 2, 391, 7, 391, 2, 392, 7, 392, 2, 393, 7, 393,
+		2, 394, 7, 394, 2, 395, 7, 395, 2, 396, 7, 396, 2, 397, 7, 397, 2, 398,
+		7, 398, 2, 399, 7, 399, 2, 400, 7, 400, 2, 401, 7, 401, 2, 402, 7, 402,
+		2, 403, 7, 403, 2, 404, 7, 404, 2, 405, 7, 405, 2, 406, 7, 406, 2, 407,
+		7, 407, 2, 408, 7, 408, 2, 409, 7, 409, 2, 410, 7, 410, 2, 411, 7, 411,
+		2, 412, 7, 412, 2, 413, 7, 413, 2, 414, 7, 414, 2, 415, 7, 415, 2, 416,
+		7, 416, 2, 417, 7, 417, 2, 418, 7, 418, 2, 419, 7, 419, 2, 420, 7, 420,
+		2, 421, 7, 421, 2, 422, 7, 422, 2, 423, 7, 423, 2, 424, 7, 424, 2, 425,
+		7, 425, 2, 426, 7, 426, 2, 427, 7, 427, 2, 428, 7, 428, 2, 429, 7, 429,
+		2, 430, 7, 430, 2, 431, 7, 431, 2, 432, 7, 432, 2, 433, 7, 433, 2, 434,
+		7, 434, 2, 435, 7, 435, 2, 436, 7, 436, 2, 437, 7, 437, 2, 438, 7, 438,
+		2, 439, 7, 439, 2, 440, 7, 440, 2, 441, 7, 441, 2, 442, 7, 442, 2, 443,
+		7, 443, 2, 444, 7, 444, 2, 445, 7, 445, 2, 446, 7, 446, 2, 447, 7, 447,
+		2, 448, 7, 448, 2, 449, 7, 449, 2, 450, 7, 450, 2, 451, 7, 451, 2, 452,
+		7, 452, 2, 453, 7, 453, 2, 454, 7, 454, 2, 455, 7, 455, 2, 456, 7, 456,
+		2, 457, 7, 457, 2, 458, 7, 458, 2, 459, 7, 459, 2, 460, 7, 460, 2, 461,
+		7, 461, 2, 462, 7, 462, 2, 463, 7, 463, 2, 464, 7, 464, 2, 465, 7, 465,
+		2, 466, 7, 466, 2, 467, 7, 467, 2, 468, 7, 468, 2, 469, 7, 469, 2, 470,
+		7, 470, 2, 471, 7, 471, 2, 472, 7, 472, 2, 473, 7, 473, 2, 474, 7, 474,
+		2, 475, 7, 475, 2, 476, 7, 476, 2, 477, 7, 477, 2, 478, 7, 478, 2, 479,
+		7, 479, 2, 480, 7, 480, 2, 481, 7, 481, 2, 482, 7, 482, 2, 483, 7, 483,
+		2, 484, 7, 484, 2, 485, 7, 485, 2, 486, 7, 486, 2, 487, 7, 487, 2, 488,
+		7, 488, 2, 489, 7, 489, 2, 490, 7, 490, 2, 491, 7, 491, 2, 492, 7, 492,
+		2, 493, 7, 493, 2, 494, 7, 494, 2, 495, 7, 495, 2, 496, 7, 496, 2, 497,
+		7, 497, 2, 498, 7, 498, 2, 499, 7, 499, 2, 500, 7, 500, 2, 501, 7, 501,
+		2, 502, 7, 502, 2, 503, 7, 503, 2, 504, 7, 504, 2, 505, 7, 505, 2, 506,
+		7, 506, 2, 507, 7, 507, 2, 508, 7, 508, 2, 509, 7, 509, 2, 510, 7, 510,
+		2, 511, 7, 511, 2, 512, 7, 512, 2, 513, 7, 513, 2, 514, 7, 514, 2, 515,
+		7, 515, 2, 516, 7, 516, 2, 517, 7, 517, 2, 518, 7, 518, 2, 519, 7, 519,
+		2, 520, 7, 520, 2, 521, 7, 521, 2, 522, 7, 522, 2, 523, 7, 523, 2, 524,
+		7, 524, 2, 525, 7, 525, 2, 526, 7, 526, 2, 527, 7, 527, 2, 528, 7, 528,
+		2, 529, 7, 529, 2, 530, 7, 530, 2, 531, 7, 531, 2, 532, 7, 532, 2, 533,
+		7, 533, 2, 534, 7, 534, 2, 535, 7, 535, 2, 536, 7, 536, 2, 537, 7, 537,
+		2, 538, 7, 538, 2, 539, 7, 539, 2, 540, 7, 540, 2, 541, 7, 541, 2, 542,
+		7, 542, 2, 543, 7, 543, 2, 544, 7, 544, 2, 545, 7, 545, 2, 546, 7, 546,
+		2, 547, 7, 547, 2, 548, 7, 548, 2, 549, 7, 549, 2, 550, 7, 550, 2, 551,
+		7, 551, 2, 552, 7, 552, 2, 553, 7, 553, 2, 554, 7, 554, 2, 555, 7, 555,
+		2, 556, 7, 556, 2, 557, 7, 557, 2, 558, 7, 558, 2, 559, 7, 559, 2, 560,
+		7, 560, 2, 561, 7, 561, 2, 562, 7, 562, 2, 563, 7, 563, 2, 564, 7, 564,
+		2, 565, 7, 565, 2, 566, 7, 566, 2, 567, 7, 567, 2, 568, 7, 568, 2, 569,
+		7, 569, 2, 570, 7, 570, 2, 571, 7, 571, 2, 572, 7, 572, 2, 573, 7, 573,
+		2, 574, 7, 574, 2, 575, 7, 575, 2, 576, 7, 576, 2, 577, 7, 577, 2, 578,
+		7, 578, 2, 579, 7, 579, 2, 580, 7, 580, 2, 581, 7, 581, 2, 582, 7, 582,
+		2, 583, 7, 583, 2, 584, 7, 584, 2, 585, 7, 585, 2, 586, 7, 586, 2, 587,
+		7, 587, 2, 588, 7, 588, 2, 589, 7, 589, 2, 590, 7, 590, 2, 591, 7, 591,
+		2, 592, 7, 592, 2, 593, 7, 593, 2, 594, 7, 594, 2, 595, 7, 595, 2, 596,
+		7, 596, 2, 597, 7, 597, 2, 598, 7, 598, 2, 599, 7, 599, 2, 600, 7, 600,
+		2, 601, 7, 601, 2, 602, 7, 602, 2, 603, 7, 603, 2, 604, 7, 604, 2, 605,
+		7, 605, 2, 606, 7, 606, 2, 607, 7, 607, 2, 608, 7, 608, 2, 609, 7, 609,
+		2, 610, 7, 610, 2, 611, 7, 611, 2, 612, 7, 612, 2, 613, 7, 613, 2, 614,
+		7, 614, 2, 615, 7, 615, 2, 616, 7, 616, 2, 617, 7, 617, 2, 618, 7, 618,
+		2, 619, 7, 619, 2, 620, 7, 620, 2, 621, 7, 621, 2, 622, 7, 622, 2, 623,
+		7, 623, 2, 624, 7, 624, 2, 625, 7, 625, 2, 626, 7, 626, 2, 627, 7, 627,
+		2, 628, 7, 628, 2, 629, 7, 629, 2, 630, 7, 630, 2, 631, 7, 631, 2, 632,
+		7, 632, 2, 633, 7, 633, 2, 634, 7, 634, 2, 635, 7, 635, 2, 636, 7, 636,
+		2, 637, 7, 637, 2, 638, 7, 638, 2, 639, 7, 639, 2, 640, 7, 640, 2, 641,
+		7, 641, 2, 642, 7, 642, 2, 643, 7, 643, 2, 644, 7, 644, 2, 645, 7, 645,
+		2, 646, 7, 646, 2, 647, 7, 647, 2, 648, 7, 648, 2, 649, 7, 649, 2, 650,
+		7, 650, 2, 651, 7, 651, 2, 652, 7, 652, 2, 653, 7, 653, 2, 654, 7, 654,
+		2, 655, 7, 655, 2, 656, 7, 656, 2, 657, 7, 657, 2, 658, 7, 658, 2, 659,
+		7, 659, 2, 660, 7, 660, 2, 661, 7, 661, 2, 662, 7, 662, 2, 663, 7, 663,
+		2, 664, 7, 664, 2, 665, 7, 665, 2, 666, 7, 666, 2, 667, 7, 667, 2, 668,
+		7, 668, 2, 669, 7, 669, 2, 670, 7, 670, 2, 671, 7, 671, 2, 672, 7, 672,
+		2, 673, 7, 673, 2, 674, 7, 674, 2, 675, 7, 675, 2, 676, 7, 676, 2, 677,
+		7, 677, 2, 678, 7, 678, 2, 679, 7, 679, 2, 680, 7, 680, 2, 681, 7, 681,
+		2, 682, 7, 682, 2, 683, 7, 683, 2, 684, 7, 684, 2, 685, 7, 685, 2, 686,
+		7, 686, 2, 687, 7, 687, 2, 688, 7, 688, 2, 689, 7, 689, 2, 690, 7, 690,
+		2, 691, 7, 691, 2, 692, 7, 692, 2, 693, 7, 693, 2, 694, 7, 694, 2, 695,
+		7, 695, 2, 696, 7, 696, 2, 697, 7, 697, 2, 698, 7, 698, 2, 699, 7, 699,
+		2, 700, 7, 700, 2, 701, 7, 701, 2, 702, 7, 702, 2, 703, 7, 703, 2, 704,
+		7, 704, 2, 705, 7, 705, 2, 706, 7, 706, 2, 707, 7, 707, 2, 708, 7, 708,
+		2, 709, 7, 709, 2, 710, 7, 710, 2, 711, 7, 711, 2, 712, 7, 712, 2, 713,
+		7, 713, 2, 714, 7, 714, 2, 715, 7, 715, 2, 716, 7, 716, 2, 717, 7, 717,
+		2, 718, 7, 718, 2, 719, 7, 719, 2, 720, 7, 720, 2, 721, 7, 721, 2, 722,
+		7, 722, 2, 723, 7, 723, 2, 724, 7, 724, 2, 725, 7, 725, 2, 726, 7, 726,
+		2, 727, 7, 727, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1,
+		4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 9, 1, 9, 1, 10, 1,
+		10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 14, 1, 14, 1, 15, 1, 15,
+		1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1,
+		19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 23,
+		1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1,
+		27, 1, 27, 4, 27, 1528, 8, 27, 11, 27, 12, 27, 1529, 1, 28, 1, 28, 1, 28,
+		1, 28, 4, 28, 1536, 8, 28, 11, 28, 12, 28, 1537, 1, 28, 1, 28, 1, 28, 3,
+		28, 1543, 8, 28, 1, 28, 1, 28, 4, 28, 1547, 8, 28, 11, 28, 12, 28, 1548,
+		1, 28, 3, 28, 1552, 8, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1,
+		29, 5, 29, 1561, 8, 29, 10, 29, 12, 29, 1564, 9, 29, 1, 29, 1, 29, 3, 29,
+		1568, 8, 29, 1, 29, 1, 29, 1, 29, 4, 29, 1573, 8, 29, 11, 29, 12, 29, 1574,
+		1, 29, 1, 29, 1, 30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 33, 1, 33, 1,
+		33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35,
+		1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1,
+		36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
+		1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1,
+		41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42,
+		1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1,
+		44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46,
+		1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1,
+		47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48,
+		1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1,
+		50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1,
+		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52,
+		1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1,
+		53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53,
+		1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1,
+		54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55,
 		1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1,
-		55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56,
-		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1,
-		57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
-		1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1,
-		61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63,
-		1, 63, 1, 63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1,
-		65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66,
-		1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1,
-		68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70,
-		1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1,
-		72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73,
-		1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1,
-		74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 76, 1, 76,
-		1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1,
-		77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
-		1, 78, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1,
-		79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81,
-		1, 81, 1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1,
-		82, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 85, 1, 85,
-		1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1,
-		87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88,
-		1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1,
-		89, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90,
-		1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 92, 1,
-		92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92,
-		1, 92, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1,
-		94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95,
-		1, 95, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 98, 1,
-		98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99,
-		1, 99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 101, 1,
-		101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 102, 1, 102, 1, 102, 1,
-		102, 1, 102, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 104, 1,
-		104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1,
-		105, 1, 105, 1, 105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1,
-		106, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 108, 1,
-		108, 1, 108, 1, 108, 1, 108, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1,
-		109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1,
-		110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111, 1,
-		111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 112, 1,
-		112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1,
-		112, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1,
-		114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1,
-		114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 115, 1, 115, 1, 115, 1,
-		115, 1, 115, 1, 115, 1, 115, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1,
-		117, 1, 117, 1, 117, 1, 117, 1, 117, 1, 117, 1, 118, 1, 118, 1, 118, 1,
-		118, 1, 118, 1, 118, 1, 119, 1, 119, 1, 119, 1, 120, 1, 120, 1, 120, 1,
-		120, 1, 120, 1, 120, 1, 120, 1, 121, 1, 121, 1, 121, 1, 121, 1, 121, 1,
-		122, 1, 122, 1, 122, 1, 122, 1, 122, 1, 123, 1, 123, 1, 123, 1, 123, 1,
-		123, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1,
-		125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 126, 1,
-		126, 1, 126, 1, 126, 1, 126, 1, 126, 1, 127, 1, 127, 1, 127, 1, 127, 1,
-		127, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1,
-		128, 1, 129, 1, 129, 1, 129, 1, 129, 1, 129, 1, 129, 1, 130, 1, 130, 1,
-		130, 1, 130, 1, 130, 1, 130, 1, 130, 1, 130, 1, 131, 1, 131, 1, 131, 1,
-		131, 1, 131, 1, 131, 1, 131, 1, 131, 1, 132, 1, 132, 1, 132, 1, 132, 1,
-		132, 1, 132, 1, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1,
-		133, 1, 133, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1,
-		135, 1, 135, 1, 135, 1, 135, 1, 135, 1, 135, 1, 135, 1, 136, 1, 136, 1,
-		136, 1, 136, 1, 137, 1, 137, 1, 137, 1, 137, 1, 137, 1, 137, 1, 138, 1,
-		138, 1, 138, 1, 138, 1, 138, 1, 138, 1, 139, 1, 139, 1, 139, 1, 139, 1,
-		139, 1, 139, 1, 139, 1, 139, 1, 139, 1, 139, 1, 140, 1, 140, 1, 140, 1,
-		140, 1, 140, 1, 141, 1, 141, 1, 141, 1, 141, 1, 141, 1, 141, 1, 142, 1,
-		142, 1, 142, 1, 142, 1, 142, 1, 142, 1, 142, 1, 143, 1, 143, 1, 143, 1,
-		143, 1, 143, 1, 143, 1, 143, 1, 143, 1, 143, 1, 143, 1, 144, 1, 144, 1,
-		144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1,
-		145, 1, 145, 1, 145, 1, 146, 1, 146, 1, 146, 1, 146, 1, 146, 1, 146, 1,
-		146, 1, 146, 1, 146, 1, 146, 1, 147, 1, 147, 1, 147, 1, 147, 1, 147, 1,
-		147, 1, 147, 1, 147, 1, 147, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148, 1,
-		148, 1, 148, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 150, 1,
-		150, 1, 150, 1, 151, 1, 151, 1, 151, 1, 151, 1, 151, 1, 151, 1, 152, 1,
-		152, 1, 152, 1, 152, 1, 152, 1, 152, 1, 152, 1, 153, 1, 153, 1, 153, 1,
-		153, 1, 153, 1, 153, 1, 153, 1, 153, 1, 154, 1, 154, 1, 154, 1, 154, 1,
-		154, 1, 154, 1, 154, 1, 154, 1, 154, 1, 155, 1, 155, 1, 155, 1, 155, 1,
-		155, 1, 155, 1, 155, 1, 155, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156, 1,
-		156, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1,
-		157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 158, 1,
-		158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1,
-		158, 1, 159, 1, 159, 1, 159, 1, 159, 1, 159, 1, 159, 1, 160, 1, 160, 1,
-		160, 1, 160, 1, 160, 1, 160, 1, 161, 1, 161, 1, 161, 1, 161, 1, 161, 1,
-		161, 1, 161, 1, 161, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1,
-		162, 1, 162, 1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 1,
-		163, 1, 163, 1, 164, 1, 164, 1, 164, 1, 164, 1, 164, 1, 164, 1, 164, 1,
-		165, 1, 165, 1, 165, 1, 165, 1, 165, 1, 165, 1, 165, 1, 165, 1, 165, 1,
-		165, 1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1,
-		166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 167, 1, 167, 1, 167, 1,
-		167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 168, 1,
-		168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1,
-		168, 1, 168, 1, 169, 1, 169, 1, 169, 1, 169, 1, 169, 1, 169, 1, 169, 1,
-		169, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1,
-		170, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1,
-		171, 1, 171, 1, 171, 1, 172, 1, 172, 1, 172, 1, 172, 1, 172, 1, 173, 1,
-		173, 1, 173, 1, 173, 1, 173, 1, 174, 1, 174, 1, 174, 1, 174, 1, 175, 1,
-		175, 1, 175, 1, 175, 1, 175, 1, 175, 1, 175, 1, 176, 1, 176, 1, 176, 1,
-		176, 1, 176, 1, 176, 1, 177, 1, 177, 1, 177, 1, 177, 1, 177, 1, 178, 1,
-		178, 1, 178, 1, 178, 1, 178, 1, 178, 1, 178, 1, 178, 1, 178, 1, 179, 1,
-		179, 1, 179, 1, 179, 1, 180, 1, 180, 1, 180, 1, 180, 1, 180, 1, 180, 1,
-		180, 1, 180, 1, 180, 1, 180, 1, 180, 1, 181, 1, 181, 1, 181, 1, 181, 1,
-		181, 1, 181, 1, 181, 1, 181, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182, 1,
-		182, 1, 182, 1, 182, 1, 182, 1, 183, 1, 183, 1, 183, 1, 183, 1, 183, 1,
-		183, 1, 183, 1, 183, 1, 183, 1, 184, 1, 184, 1, 184, 1, 184, 1, 184, 1,
-		184, 1, 184, 1, 184, 1, 185, 1, 185, 1, 185, 1, 185, 1, 185, 1, 185, 1,
-		185, 1, 186, 1, 186, 1, 186, 1, 186, 1, 186, 1, 186, 1, 186, 1, 186, 1,
-		186, 1, 186, 1, 187, 1, 187, 1, 187, 1, 187, 1, 187, 1, 187, 1, 187, 1,
-		187, 1, 187, 1, 187, 1, 187, 1, 188, 1, 188, 1, 188, 1, 188, 1, 188, 1,
-		188, 1, 188, 1, 188, 1, 188, 1, 188, 1, 188, 1, 189, 1, 189, 1, 189, 1,
-		189, 1, 189, 1, 189, 1, 189, 1, 189, 1, 190, 1, 190, 1, 190, 1, 190, 1,
-		190, 1, 190, 1, 190, 1, 190, 1, 191, 1, 191, 1, 191, 1, 191, 1, 191, 1,
-		191, 1, 191, 1, 191, 1, 191, 1, 192, 1, 192, 1, 192, 1, 192, 1, 192, 1,
-		192, 1, 192, 1, 193, 1, 193, 1, 193, 1, 193, 1, 193, 1, 193, 1, 193, 1,
-		194, 1, 194, 1, 194, 1, 194, 1, 194, 1, 195, 1, 195, 1, 195, 1, 195, 1,
-		195, 1, 196, 1, 196, 1, 196, 1, 196, 1, 196, 1, 196, 1, 196, 1, 197, 1,
-		197, 1, 197, 1, 197, 1, 197, 1, 197, 1, 197, 1, 197, 1, 197, 1, 198, 1,
-		198, 1, 198, 1, 198, 1, 198, 1, 198, 1, 198, 1, 198, 1, 198, 1, 198, 1,
-		199, 1, 199, 1, 199, 1, 199, 1, 199, 1, 200, 1, 200, 1, 200, 1, 200, 1,
-		200, 1, 200, 1, 200, 1, 201, 1, 201, 1, 201, 1, 201, 1, 201, 1, 201, 1,
-		202, 1, 202, 1, 202, 1, 202, 1, 202, 1, 202, 1, 202, 1, 202, 1, 203, 1,
-		203, 1, 203, 1, 203, 1, 203, 1, 203, 1, 203, 1, 203, 1, 203, 1, 203, 1,
-		204, 1, 204, 1, 204, 1, 204, 1, 204, 1, 204, 1, 204, 1, 204, 1, 204, 1,
-		204, 1, 205, 1, 205, 1, 205, 1, 205, 1, 205, 1, 205, 1, 205, 1, 205, 1,
-		206, 1, 206, 1, 206, 1, 206, 1, 206, 1, 206, 1, 206, 1, 206, 1, 207, 1,
-		207, 1, 207, 1, 207, 1, 207, 1, 207, 1, 207, 1, 207, 1, 207, 1, 207, 1,
-		208, 1, 208, 1, 208, 1, 208, 1, 208, 1, 208, 1, 208, 1, 208, 1, 208, 1,
-		209, 1, 209, 1, 209, 1, 209, 1, 209, 1, 209, 1, 209, 1, 210, 1, 210, 1,
-		210, 1, 210, 1, 210, 1, 210, 1, 211, 1, 211, 1, 211, 1, 211, 1, 211, 1,
-		211, 1, 211, 1, 211, 1, 211, 1, 211, 1, 212, 1, 212, 1, 212, 1, 212, 1,
-		212, 1, 212, 1, 213, 1, 213, 1, 213, 1, 213, 1, 213, 1, 213, 1, 213, 1,
-		213, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1,
-		214, 1, 215, 1, 215, 1, 215, 1, 215, 1, 215, 1, 215, 1, 215, 1, 215, 1,
-		215, 1, 215, 1, 216, 1, 216, 1, 216, 1, 216, 1, 216, 1, 216, 1, 216, 1,
-		217, 1, 217, 1, 217, 1, 217, 1, 217, 1, 217, 1, 217, 1, 217, 1, 218, 1,
-		218, 1, 218, 1, 218, 1, 218, 1, 218, 1, 218, 1, 218, 1, 219, 1, 219, 1,
-		219, 1, 219, 1, 219, 1, 219, 1, 219, 1, 220, 1, 220, 1, 220, 1, 220, 1,
-		220, 1, 221, 1, 221, 1, 221, 1, 221, 1, 221, 1, 222, 1, 222, 1, 222, 1,
-		222, 1, 222, 1, 222, 1, 222, 1, 222, 1, 222, 1, 223, 1, 223, 1, 223, 1,
-		224, 1, 224, 1, 224, 1, 224, 1, 224, 1, 224, 1, 224, 1, 224, 1, 224, 1,
-		224, 1, 225, 1, 225, 1, 225, 1, 225, 1, 225, 1, 225, 1, 225, 1, 225, 1,
-		225, 1, 225, 1, 226, 1, 226, 1, 226, 1, 226, 1, 226, 1, 226, 1, 226, 1,
-		226, 1, 226, 1, 227, 1, 227, 1, 227, 1, 227, 1, 227, 1, 227, 1, 227, 1,
-		227, 1, 227, 1, 227, 1, 228, 1, 228, 1, 228, 1, 228, 1, 228, 1, 228, 1,
-		228, 1, 228, 1, 228, 1, 228, 1, 229, 1, 229, 1, 229, 1, 229, 1, 229, 1,
-		229, 1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1,
-		231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 232, 1,
-		232, 1, 232, 1, 232, 1, 232, 1, 232, 1, 232, 1, 232, 1, 232, 1, 233, 1,
-		233, 1, 233, 1, 233, 1, 233, 1, 233, 1, 233, 1, 234, 1, 234, 1, 234, 1,
-		234, 1, 234, 1, 234, 1, 234, 1, 234, 1, 234, 1, 234, 1, 234, 1, 234, 1,
-		235, 1, 235, 1, 235, 1, 235, 1, 235, 1, 235, 1, 235, 1, 236, 1, 236, 1,
-		236, 1, 236, 1, 236, 1, 236, 1, 236, 1, 236, 1, 237, 1, 237, 1, 237, 1,
-		237, 1, 237, 1, 237, 1, 237, 1, 237, 1, 238, 1, 238, 1, 238, 1, 238, 1,
-		238, 1, 238, 1, 238, 1, 238, 1, 238, 1, 238, 1, 239, 1, 239, 1, 239, 1,
-		239, 1, 240, 1, 240, 1, 240, 1, 240, 1, 240, 1, 240, 1, 241, 1, 241, 1,
-		241, 1, 241, 1, 241, 1, 241, 1, 241, 1, 241, 1, 241, 1, 242, 1, 242, 1,
-		242, 1, 242, 1, 242, 1, 242, 1, 243, 1, 243, 1, 243, 1, 243, 1, 243, 1,
-		244, 1, 244, 1, 244, 1, 244, 1, 244, 1, 244, 1, 244, 1, 244, 1, 244, 1,
-		244, 1, 245, 1, 245, 1, 245, 1, 245, 1, 245, 1, 245, 1, 246, 1, 246, 1,
-		246, 1, 246, 1, 246, 1, 246, 1, 246, 1, 247, 1, 247, 1, 247, 1, 247, 1,
-		247, 1, 248, 1, 248, 1, 248, 1, 248, 1, 248, 1, 248, 1, 249, 1, 249, 1,
-		249, 1, 249, 1, 249, 1, 249, 1, 249, 1, 249, 1, 249, 1, 250, 1, 250, 1,
-		250, 1, 250, 1, 250, 1, 251, 1, 251, 1, 251, 1, 251, 1, 251, 1, 251, 1,
-		251, 1, 251, 1, 252, 1, 252, 1, 252, 1, 252, 1, 252, 1, 252, 1, 253, 1,
-		253, 1, 253, 1, 253, 1, 253, 1, 253, 1, 253, 1, 253, 1, 254, 1, 254, 1,
-		254, 1, 254, 1, 254, 1, 254, 1, 254, 1, 254, 1, 254, 1, 254, 1, 254, 1,
-		254, 1, 254, 1, 255, 1, 255, 1, 255, 1, 255, 1, 255, 1, 255, 1, 255, 1,
-		255, 1, 255, 1, 256, 1, 256, 1, 256, 1, 256, 1, 256, 1, 256, 1, 257, 1,
-		257, 1, 257, 1, 257, 1, 257, 1, 257, 1, 257, 1, 258, 1, 258, 1, 258, 1,
-		258, 1, 258, 1, 258, 1, 258, 1, 258, 1, 258, 1, 259, 1, 259, 1, 259, 1,
-		259, 1, 259, 1, 260, 1, 260, 1, 260, 1, 260, 1, 260, 1, 260, 1, 261, 1,
-		261, 1, 261, 1, 261, 1, 261, 1, 262, 1, 262, 1, 262, 1, 262, 1, 262, 1,
-		263, 1, 263, 1, 263, 1, 263, 1, 263, 1, 263, 1, 264, 1, 264, 1, 264, 1,
-		264, 1, 264, 1, 265, 1, 265, 1, 265, 1, 266, 1, 266, 1, 266, 1, 266, 1,
-		266, 1, 266, 1, 266, 1, 266, 1, 267, 1, 267, 1, 267, 1, 267, 1, 267, 1,
-		267, 1, 267, 1, 268, 1, 268, 1, 268, 1, 268, 1, 268, 1, 268, 1, 268, 1,
-		269, 1, 269, 1, 269, 1, 269, 1, 269, 1, 269, 1, 270, 1, 270, 1, 270, 1,
-		270, 1, 270, 1, 270, 1, 270, 1, 271, 1, 271, 1, 271, 1, 272, 1, 272, 1,
-		272, 1, 272, 1, 273, 1, 273, 1, 273, 1, 273, 1, 273, 1, 274, 1, 274, 1,
-		274, 1, 274, 1, 274, 1, 274, 1, 274, 1, 274, 1, 274, 1, 275, 1, 275, 1,
-		275, 1, 275, 1, 275, 1, 275, 1, 275, 1, 276, 1, 276, 1, 276, 1, 276, 1,
-		276, 1, 276, 1, 276, 1, 276, 1, 277, 1, 277, 1, 277, 1, 277, 1, 277, 1,
-		277, 1, 278, 1, 278, 1, 278, 1, 278, 1, 278, 1, 278, 1, 279, 1, 279, 1,
-		279, 1, 279, 1, 279, 1, 279, 1, 279, 1, 280, 1, 280, 1, 280, 1, 280, 1,
-		280, 1, 280, 1, 280, 1, 280, 1, 281, 1, 281, 1, 281, 1, 281, 1, 281, 1,
-		281, 1, 281, 1, 281, 1, 281, 1, 281, 1, 282, 1, 282, 1, 282, 1, 282, 1,
-		282, 1, 282, 1, 282, 1, 282, 1, 283, 1, 283, 1, 283, 1, 283, 1, 283, 1,
-		283, 1, 283, 1, 283, 1, 283, 1, 284, 1, 284, 1, 284, 1, 284, 1, 284, 1,
-		284, 1, 285, 1, 285, 1, 285, 1, 285, 1, 285, 1, 285, 1, 285, 1, 285, 1,
-		285, 1, 285, 1, 286, 1, 286, 1, 286, 1, 286, 1, 286, 1, 286, 1, 286, 1,
-		286, 1, 287, 1, 287, 1, 287, 1, 287, 1, 287, 1, 287, 1, 287, 1, 287, 1,
-		287, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288, 1,
-		288, 1, 289, 1, 289, 1, 289, 1, 289, 1, 289, 1, 289, 1, 290, 1, 290, 1,
-		290, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290, 1,
-		291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1,
-		291, 1, 291, 1, 292, 1, 292, 1, 292, 1, 292, 1, 292, 1, 292, 1, 292, 1,
-		292, 1, 292, 1, 292, 1, 293, 1, 293, 1, 293, 1, 293, 1, 293, 1, 293, 1,
-		293, 1, 293, 1, 294, 1, 294, 1, 294, 1, 294, 1, 294, 1, 294, 1, 295, 1,
-		295, 1, 295, 1, 295, 1, 295, 1, 295, 1, 296, 1, 296, 1, 296, 1, 296, 1,
-		296, 1, 297, 1, 297, 1, 297, 1, 297, 1, 297, 1, 297, 1, 297, 1, 297, 1,
-		297, 1, 298, 1, 298, 1, 298, 1, 298, 1, 298, 1, 298, 1, 298, 1, 298, 1,
-		299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1,
-		299, 1, 300, 1, 300, 1, 300, 1, 300, 1, 301, 1, 301, 1, 301, 1, 301, 1,
-		301, 1, 301, 1, 301, 1, 301, 1, 302, 1, 302, 1, 302, 1, 302, 1, 302, 1,
-		302, 1, 302, 1, 302, 1, 303, 1, 303, 1, 303, 1, 303, 1, 303, 1, 303, 1,
-		303, 1, 303, 1, 303, 1, 304, 1, 304, 1, 304, 1, 304, 1, 304, 1, 304, 1,
-		304, 1, 304, 1, 305, 1, 305, 1, 305, 1, 305, 1, 305, 1, 305, 1, 305, 1,
-		306, 1, 306, 1, 306, 1, 306, 1, 306, 1, 306, 1, 306, 1, 306, 1, 306, 1,
-		306, 1, 306, 1, 307, 1, 307, 1, 307, 1, 307, 1, 307, 1, 307, 1, 307, 1,
-		307, 1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1,
-		309, 1, 309, 1, 309, 1, 309, 1, 309, 1, 309, 1, 310, 1, 310, 1, 310, 1,
-		310, 1, 310, 1, 310, 1, 310, 1, 310, 1, 311, 1, 311, 1, 311, 1, 311, 1,
-		311, 1, 311, 1, 311, 1, 311, 1, 311, 1, 312, 1, 312, 1, 312, 1, 312, 1,
-		312, 1, 312, 1, 312, 1, 312, 1, 313, 1, 313, 1, 313, 1, 313, 1, 313, 1,
-		313, 1, 313, 1, 314, 1, 314, 1, 314, 1, 314, 1, 314, 1, 315, 1, 315, 1,
-		315, 1, 315, 1, 315, 1, 315, 1, 315, 1, 315, 1, 315, 1, 316, 1, 316, 1,
-		316, 1, 316, 1, 316, 1, 317, 1, 317, 1, 317, 1, 317, 1, 317, 1, 318, 1,
-		318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1,
-		319, 1, 319, 1, 319, 1, 319, 1, 319, 1, 319, 1, 319, 1, 320, 1, 320, 1,
-		320, 1, 320, 1, 320, 1, 320, 1, 320, 1, 321, 1, 321, 1, 321, 1, 321, 1,
-		321, 1, 321, 1, 321, 1, 322, 1, 322, 1, 322, 1, 322, 1, 322, 1, 322, 1,
-		322, 1, 323, 1, 323, 1, 323, 1, 323, 1, 323, 1, 323, 1, 323, 1, 323, 1,
-		323, 1, 324, 1, 324, 1, 324, 1, 324, 1, 324, 1, 324, 1, 324, 1, 324, 1,
-		324, 1, 325, 1, 325, 1, 325, 1, 325, 1, 325, 1, 325, 1, 325, 1, 325, 1,
-		325, 1, 325, 1, 326, 1, 326, 1, 326, 1, 326, 1, 326, 1, 326, 1, 326, 1,
-		326, 1, 326, 1, 326, 1, 326, 1, 326, 1, 326, 1, 327, 1, 327, 1, 327, 1,
-		327, 1, 327, 1, 327, 1, 327, 1, 328, 1, 328, 1, 328, 1, 328, 1, 328, 1,
-		328, 1, 328, 1, 328, 1, 329, 1, 329, 1, 329, 1, 329, 1, 330, 1, 330, 1,
-		330, 1, 330, 1, 330, 1, 330, 1, 331, 1, 331, 1, 331, 1, 331, 1, 331, 1,
-		332, 1, 332, 1, 332, 1, 332, 1, 332, 1, 332, 1, 332, 1, 333, 1, 333, 1,
-		333, 1, 333, 1, 333, 1, 333, 1, 333, 1, 333, 1, 333, 1, 334, 1, 334, 1,
-		334, 1, 334, 1, 334, 1, 334, 1, 334, 1, 335, 1, 335, 1, 335, 1, 335, 1,
-		335, 1, 335, 1, 335, 1, 335, 1, 335, 1, 335, 1, 335, 1, 336, 1, 336, 1,
-		336, 1, 336, 1, 336, 1, 336, 1, 337, 1, 337, 1, 337, 1, 337, 1, 337, 1,
-		337, 1, 337, 1, 337, 1, 337, 1, 337, 1, 338, 1, 338, 1, 338, 1, 338, 1,
-		338, 1, 338, 1, 338, 1, 338, 1, 338, 1, 338, 1, 338, 1, 339, 1, 339, 1,
-		339, 1, 339, 1, 339, 1, 339, 1, 340, 1, 340, 1, 340, 1, 340, 1, 340, 1,
-		340, 1, 340, 1, 341, 1, 341, 1, 341, 1, 341, 1, 341, 1, 341, 1, 341, 1,
-		341, 1, 342, 1, 342, 1, 342, 1, 342, 1, 342, 1, 342, 1, 342, 1, 343, 1,
-		343, 1, 343, 1, 343, 1, 343, 1, 343, 1, 344, 1, 344, 1, 344, 1, 344, 1,
-		344, 1, 344, 1, 345, 1, 345, 1, 345, 1, 345, 1, 345, 1, 345, 1, 345, 1,
-		346, 1, 346, 1, 346, 1, 346, 1, 346, 1, 346, 1, 346, 1, 347, 1, 347, 1,
-		347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1,
-		348, 1, 348, 1, 348, 1, 348, 1, 348, 1, 349, 1, 349, 1, 349, 1, 349, 1,
-		349, 1, 349, 1, 349, 1, 349, 1, 349, 1, 350, 1, 350, 1, 350, 1, 350, 1,
-		350, 1, 350, 1, 350, 1, 350, 1, 350, 1, 350, 1, 351, 1, 351, 1, 351, 1,
-		351, 1, 351, 1, 352, 1, 352, 1, 352, 1, 352, 1, 352, 1, 352, 1, 352, 1,
-		352, 1, 352, 1, 352, 1, 352, 1, 352, 1, 353, 1, 353, 1, 353, 1, 353, 1,
-		353, 1, 353, 1, 353, 1, 353, 1, 354, 1, 354, 1, 354, 1, 354, 1, 354, 1,
-		354, 1, 354, 1, 354, 1, 354, 1, 355, 1, 355, 1, 355, 1, 355, 1, 355, 1,
-		355, 1, 355, 1, 355, 1, 356, 1, 356, 1, 356, 1, 356, 1, 356, 1, 357, 1,
-		357, 1, 357, 1, 357, 1, 357, 1, 357, 1, 358, 1, 358, 1, 358, 1, 358, 1,
-		358, 1, 358, 1, 358, 1, 358, 1, 358, 1, 358, 1, 359, 1, 359, 1, 359, 1,
-		359, 1, 359, 1, 359, 1, 359, 1, 359, 1, 359, 1, 359, 1, 359, 1, 359, 1,
-		360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1,
-		360, 1, 360, 1, 360, 1, 361, 1, 361, 1, 361, 1, 361, 1, 361, 1, 361, 1,
-		361, 1, 361, 1, 362, 1, 362, 1, 362, 1, 362, 1, 362, 1, 362, 1, 362, 1,
-		362, 1, 362, 1, 363, 1, 363, 1, 363, 1, 363, 1, 363, 1, 363, 1, 363, 1,
-		363, 1, 363, 1, 364, 1, 364, 1, 364, 1, 364, 1, 364, 1, 364, 1, 365, 1,
-		365, 1, 365, 1, 365, 1, 365, 1, 365, 1, 365, 1, 366, 1, 366, 1, 366, 1,
-		366, 1, 366, 1, 366, 1, 366, 1, 367, 1, 367, 1, 367, 1, 367, 1, 367, 1,
-		367, 1, 368, 1, 368, 1, 368, 1, 368, 1, 368, 1, 368, 1, 368, 1, 368, 1,
-		368, 1, 369, 1, 369, 1, 369, 1, 369, 1, 369, 1, 369, 1, 369, 1, 369, 1,
-		369, 1, 369, 1, 370, 1, 370, 1, 370, 1, 370, 1, 370, 1, 370, 1, 370, 1,
-		370, 1, 371, 1, 371, 1, 371, 1, 371, 1, 371, 1, 371, 1, 371, 1, 371, 1,
-		372, 1, 372, 1, 372, 1, 372, 1, 372, 1, 373, 1, 373, 1, 373, 1, 373, 1,
-		373, 1, 373, 1, 373, 1, 373, 1, 373, 1, 374, 1, 374, 1, 374, 1, 374, 1,
-		374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 375, 1, 375, 1,
-		375, 1, 375, 1, 375, 1, 375, 1, 375, 1, 375, 1, 376, 1, 376, 1, 376, 1,
-		376, 1, 376, 1, 377, 1, 377, 1, 377, 1, 377, 1, 377, 1, 377, 1, 377, 1,
-		377, 1, 378, 1, 378, 1, 378, 1, 378, 1, 378, 1, 378, 1, 379, 1, 379, 1,
-		379, 1, 379, 1, 380, 1, 380, 1, 380, 1, 380, 1, 380, 1, 381, 1, 381, 1,
-		381, 1, 381, 1, 382, 1, 382, 1, 382, 1, 382, 1, 382, 1, 383, 1, 383, 1,
-		383, 1, 383, 1, 383, 1, 383, 1, 383, 1, 384, 1, 384, 1, 384, 1, 384, 1,
-		384, 1, 384, 1, 384, 1, 384, 1, 385, 1, 385, 1, 385, 1, 385, 1, 385, 1,
-		385, 1, 385, 1, 386, 1, 386, 1, 386, 1, 386, 1, 387, 1, 387, 1, 387, 1,
-		387, 1, 387, 1, 387, 1, 387, 1, 387, 1, 388, 1, 388, 1, 388, 1, 388, 1,
-		388, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389, 1,
-		389, 1, 389, 1, 390, 1, 390, 1, 390, 1, 390, 1, 390, 1, 390, 1, 390, 1,
-		390, 1, 390, 1, 391, 1, 391, 1, 391, 1, 391, 1, 392, 1, 392, 1, 392, 1,
-		392, 1, 392, 1, 392, 1, 392, 1, 392, 1, 393, 1, 393, 1, 393, 1, 393, 1,
-		393, 1, 393, 1, 393, 1, 394, 1, 394, 1, 394, 1, 394, 1, 394, 1, 394, 1,
-		394, 1, 394, 1, 395, 1, 395, 1, 395, 1, 395, 1, 395, 1, 395, 1, 396, 1,
-		396, 1, 396, 1, 396, 1, 396, 1, 396, 1, 396, 1, 396, 1, 396, 1, 397, 1,
-		397, 1, 397, 1, 397, 1, 397, 1, 397, 1, 398, 1, 398, 1, 398, 1, 398, 1,
-		399, 1, 399, 1, 399, 1, 399, 1, 399, 1, 399, 1, 399, 1, 399, 1, 400, 1,
-		400, 1, 400, 1, 400, 1, 400, 1, 400, 1, 400, 1, 400, 1, 400, 1, 401, 1,
-		401, 1, 401, 1, 401, 1, 401, 1, 401, 1, 402, 1, 402, 1, 402, 1, 402, 1,
-		402, 1, 402, 1, 402, 1, 402, 1, 402, 1, 403, 1, 403, 1, 403, 1, 403, 1,
-		403, 1, 403, 1, 404, 1, 404, 1, 404, 1, 404, 1, 404, 1, 405, 1, 405, 1,
-		405, 1, 405, 1, 405, 1, 405, 1, 405, 1, 406, 1, 406, 1, 406, 1, 406, 1,
-		406, 1, 406, 1, 406, 1, 406, 1, 407, 1, 407, 1, 407, 1, 407, 1, 407, 1,
-		407, 1, 407, 1, 407, 1, 408, 1, 408, 1, 408, 1, 408, 1, 408, 1, 408, 1,
-		408, 1, 408, 1, 408, 1, 408, 1, 409, 1, 409, 1, 409, 1, 409, 1, 409, 1,
-		409, 1, 409, 1, 409, 1, 409, 1, 410, 1, 410, 1, 410, 1, 410, 1, 410, 1,
-		410, 1, 410, 1, 410, 1, 410, 1, 410, 1, 411, 1, 411, 1, 411, 1, 411, 1,
-		411, 1, 412, 1, 412, 1, 412, 1, 412, 1, 413, 1, 413, 1, 413, 1, 413, 1,
-		413, 1, 413, 1, 414, 1, 414, 1, 414, 1, 414, 1, 414, 1, 414, 1, 414, 1,
-		414, 1, 414, 1, 415, 1, 415, 1, 415, 1, 415, 1, 415, 1, 415, 1, 415, 1,
-		415, 1, 415, 1, 415, 1, 416, 1, 416, 1, 416, 1, 416, 1, 416, 1, 417, 1,
-		417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1,
-		418, 1, 418, 1, 418, 1, 418, 1, 418, 1, 418, 1, 419, 1, 419, 1, 419, 1,
-		419, 1, 419, 1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1,
-		421, 1, 421, 1, 421, 1, 421, 1, 421, 1, 421, 1, 421, 1, 421, 1, 422, 1,
-		422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1,
-		422, 1, 422, 1, 422, 1, 422, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1,
-		423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 424, 1, 424, 1, 424, 1,
-		424, 1, 424, 1, 424, 1, 424, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1,
-		425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1,
-		425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 426, 1, 426, 1, 426, 1, 426, 1,
-		426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1,
-		426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1,
-		426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 427, 1, 427, 1, 427, 1,
-		427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1,
-		427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1,
-		427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 428, 1, 428, 1, 428, 1,
-		428, 1, 428, 1, 428, 1, 429, 1, 429, 1, 429, 1, 429, 1, 429, 1, 429, 1,
-		429, 1, 429, 1, 429, 1, 429, 1, 429, 1, 429, 1, 429, 1, 430, 1, 430, 1,
-		430, 1, 430, 1, 430, 1, 430, 1, 430, 1, 430, 1, 430, 1, 430, 1, 431, 1,
-		431, 1, 431, 1, 431, 1, 431, 1, 431, 1, 431, 1, 431, 1, 431, 1, 431, 1,
-		431, 1, 432, 1, 432, 1, 432, 1, 432, 1, 432, 1, 432, 1, 432, 1, 432, 1,
-		432, 1, 432, 1, 433, 1, 433, 1, 433, 1, 433, 1, 433, 1, 433, 1, 433, 1,
-		433, 1, 433, 1, 433, 1, 434, 1, 434, 1, 434, 1, 434, 1, 434, 1, 434, 1,
-		434, 1, 434, 1, 434, 1, 435, 1, 435, 1, 435, 1, 435, 1, 435, 1, 435, 1,
-		436, 1, 436, 1, 436, 1, 436, 1, 436, 1, 436, 1, 436, 1, 436, 1, 437, 1,
-		437, 1, 437, 1, 437, 1, 437, 1, 437, 1, 437, 1, 437, 1, 437, 1, 437, 1,
-		437, 1, 437, 1, 437, 1, 438, 1, 438, 1, 438, 1, 438, 1, 438, 1, 439, 1,
-		439, 1, 439, 1, 439, 1, 439, 1, 439, 1, 439, 1, 439, 1, 440, 1, 440, 1,
-		440, 1, 440, 1, 440, 1, 440, 1, 440, 1, 441, 1, 441, 1, 441, 1, 441, 1,
-		441, 1, 441, 1, 441, 1, 442, 1, 442, 1, 442, 1, 442, 1, 442, 1, 442, 1,
-		442, 1, 442, 1, 442, 1, 442, 1, 442, 1, 443, 1, 443, 1, 443, 1, 443, 1,
-		443, 1, 443, 1, 443, 1, 443, 1, 443, 1, 443, 1, 444, 1, 444, 1, 444, 1,
-		444, 1, 444, 1, 444, 1, 444, 1, 445, 1, 445, 1, 445, 1, 445, 1, 445, 1,
-		445, 1, 445, 1, 446, 1, 446, 1, 446, 1, 446, 1, 446, 1, 446, 1, 446, 1,
-		446, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1,
-		448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1,
-		448, 1, 449, 1, 449, 1, 449, 1, 449, 1, 449, 1, 449, 1, 449, 1, 450, 1,
-		450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 451, 1, 451, 1, 451, 1,
-		451, 1, 451, 1, 451, 1, 451, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1,
-		452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 453, 1, 453, 1,
-		453, 1, 453, 1, 454, 1, 454, 1, 454, 1, 454, 1, 455, 1, 455, 1, 455, 1,
-		455, 1, 455, 1, 455, 1, 456, 1, 456, 1, 456, 1, 456, 1, 456, 1, 456, 1,
-		456, 1, 456, 1, 456, 1, 456, 1, 456, 1, 456, 1, 456, 1, 457, 1, 457, 1,
-		457, 1, 457, 1, 457, 1, 457, 1, 457, 1, 457, 1, 457, 1, 457, 1, 457, 1,
-		457, 1, 458, 1, 458, 1, 458, 1, 458, 1, 459, 1, 459, 1, 459, 1, 459, 1,
-		460, 1, 460, 1, 460, 1, 460, 1, 460, 1, 460, 1, 460, 1, 460, 1, 460, 1,
-		461, 1, 461, 1, 461, 1, 461, 1, 461, 1, 461, 1, 461, 1, 461, 1, 462, 1,
-		462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1,
-		462, 1, 463, 1, 463, 1, 463, 1, 463, 1, 463, 1, 463, 1, 464, 1, 464, 1,
-		464, 1, 464, 1, 464, 1, 464, 1, 464, 1, 464, 1, 465, 1, 465, 1, 465, 1,
-		465, 1, 465, 1, 465, 1, 465, 1, 465, 1, 465, 1, 466, 1, 466, 1, 466, 1,
-		466, 1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1,
-		468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1,
-		468, 1, 468, 1, 469, 1, 469, 1, 469, 1, 469, 1, 469, 1, 469, 1, 469, 1,
-		469, 1, 469, 1, 470, 1, 470, 1, 470, 1, 470, 1, 470, 1, 471, 1, 471, 1,
-		471, 1, 471, 1, 471, 1, 471, 1, 471, 1, 472, 1, 472, 1, 472, 1, 472, 1,
-		472, 1, 473, 1, 473, 1, 473, 1, 473, 1, 473, 1, 473, 1, 473, 1, 474, 1,
-		474, 1, 474, 1, 474, 1, 474, 1, 475, 1, 475, 1, 475, 1, 475, 1, 475, 1,
-		475, 1, 475, 1, 475, 1, 475, 1, 476, 1, 476, 1, 476, 1, 476, 1, 476, 1,
-		477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1,
-		477, 1, 477, 1, 477, 1, 478, 1, 478, 1, 478, 1, 478, 1, 478, 1, 478, 1,
-		478, 1, 478, 1, 478, 1, 478, 1, 478, 1, 479, 1, 479, 1, 479, 1, 479, 1,
-		479, 1, 479, 1, 479, 1, 479, 1, 479, 1, 480, 1, 480, 1, 480, 1, 480, 1,
-		480, 1, 480, 1, 480, 1, 480, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1,
-		481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1,
-		482, 1, 482, 1, 482, 1, 482, 1, 482, 1, 482, 1, 482, 1, 482, 1, 483, 1,
-		483, 1, 483, 1, 483, 1, 483, 1, 483, 1, 483, 1, 483, 1, 483, 1, 483, 1,
-		483, 1, 484, 1, 484, 1, 484, 1, 484, 1, 484, 1, 484, 1, 484, 1, 485, 1,
-		485, 1, 485, 1, 485, 1, 485, 1, 485, 1, 485, 1, 486, 1, 486, 1, 486, 1,
-		486, 1, 486, 1, 486, 1, 486, 1, 487, 1, 487, 1, 487, 1, 487, 1, 487, 1,
-		487, 1, 487, 1, 488, 1, 488, 1, 488, 1, 488, 1, 489, 1, 489, 1, 489, 1,
-		489, 1, 490, 1, 490, 1, 490, 1, 490, 1, 490, 1, 491, 1, 491, 1, 491, 1,
-		491, 1, 491, 1, 492, 1, 492, 1, 492, 1, 492, 1, 492, 1, 492, 1, 492, 1,
-		492, 1, 493, 1, 493, 1, 493, 1, 493, 1, 493, 1, 493, 1, 494, 1, 494, 1,
-		494, 1, 494, 1, 494, 1, 494, 1, 494, 1, 494, 1, 494, 1, 494, 1, 495, 1,
-		495, 1, 495, 1, 495, 1, 495, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1,
-		496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1,
-		496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 497, 1, 497, 1, 497, 1,
-		497, 1, 497, 1, 497, 1, 497, 1, 497, 1, 497, 1, 497, 1, 497, 1, 497, 1,
-		497, 1, 497, 1, 497, 1, 497, 1, 497, 1, 497, 1, 498, 1, 498, 1, 498, 1,
-		498, 1, 498, 1, 498, 1, 499, 1, 499, 1, 499, 1, 499, 1, 499, 1, 499, 1,
-		499, 1, 499, 1, 499, 1, 499, 1, 499, 1, 499, 1, 499, 1, 500, 1, 500, 1,
-		500, 1, 500, 1, 500, 1, 500, 1, 500, 1, 500, 1, 500, 1, 500, 1, 500, 1,
-		501, 1, 501, 1, 501, 1, 501, 1, 501, 1, 501, 1, 502, 1, 502, 1, 502, 1,
-		502, 1, 502, 1, 502, 1, 502, 1, 502, 1, 502, 1, 503, 1, 503, 1, 503, 1,
-		503, 1, 503, 1, 503, 1, 503, 1, 503, 1, 504, 1, 504, 1, 504, 1, 504, 1,
-		505, 1, 505, 1, 505, 1, 505, 1, 505, 1, 505, 1, 505, 1, 505, 1, 505, 1,
-		505, 1, 505, 1, 505, 1, 506, 1, 506, 1, 506, 1, 506, 1, 506, 1, 506, 1,
-		506, 1, 506, 1, 507, 1, 507, 1, 507, 1, 507, 1, 507, 1, 507, 1, 508, 1,
-		508, 1, 508, 1, 508, 1, 508, 1, 508, 1, 509, 1, 509, 1, 509, 1, 509, 1,
-		509, 1, 509, 1, 509, 1, 509, 1, 510, 1, 510, 1, 510, 1, 510, 1, 510, 1,
-		510, 1, 510, 1, 510, 1, 511, 1, 511, 1, 511, 1, 511, 1, 511, 1, 511, 1,
-		512, 1, 512, 1, 512, 1, 512, 1, 512, 1, 513, 1, 513, 1, 513, 1, 513, 1,
-		513, 1, 513, 1, 513, 1, 514, 1, 514, 1, 514, 1, 514, 1, 514, 1, 514, 1,
-		515, 1, 515, 1, 515, 1, 515, 1, 515, 1, 515, 1, 516, 1, 516, 1, 516, 1,
-		516, 1, 516, 1, 516, 1, 516, 1, 516, 1, 516, 1, 517, 1, 517, 1, 517, 1,
-		517, 1, 517, 1, 517, 1, 518, 1, 518, 1, 518, 1, 518, 1, 519, 1, 519, 1,
-		519, 1, 519, 1, 519, 1, 520, 1, 520, 1, 520, 1, 520, 1, 520, 1, 520, 1,
-		520, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1,
-		522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1,
-		522, 1, 523, 1, 523, 1, 523, 1, 523, 1, 523, 1, 523, 1, 523, 1, 524, 1,
-		524, 1, 524, 1, 524, 1, 524, 1, 525, 1, 525, 1, 525, 1, 525, 1, 525, 1,
-		526, 1, 526, 1, 526, 1, 526, 1, 527, 1, 527, 1, 527, 1, 527, 1, 527, 1,
-		528, 1, 528, 1, 528, 1, 528, 1, 528, 1, 529, 1, 529, 1, 529, 1, 529, 1,
-		529, 1, 529, 1, 529, 1, 529, 1, 530, 1, 530, 1, 530, 1, 530, 1, 530, 1,
-		530, 1, 530, 1, 530, 1, 531, 1, 531, 1, 531, 1, 531, 1, 532, 1, 532, 1,
-		532, 1, 532, 1, 533, 1, 533, 1, 533, 1, 533, 1, 533, 1, 533, 1, 533, 1,
-		533, 1, 533, 1, 533, 1, 534, 1, 534, 1, 534, 1, 534, 1, 534, 1, 534, 1,
-		535, 1, 535, 1, 535, 1, 535, 1, 536, 1, 536, 1, 536, 1, 536, 1, 537, 1,
-		537, 1, 537, 1, 538, 1, 538, 1, 538, 1, 538, 1, 538, 1, 538, 1, 539, 1,
-		539, 1, 539, 1, 539, 1, 539, 1, 539, 1, 539, 1, 539, 1, 539, 1, 539, 1,
-		540, 1, 540, 1, 540, 1, 540, 1, 541, 1, 541, 1, 541, 1, 542, 1, 542, 1,
-		542, 1, 542, 1, 542, 1, 542, 1, 543, 1, 543, 1, 543, 1, 543, 1, 543, 1,
-		543, 1, 543, 1, 543, 1, 544, 1, 544, 1, 544, 1, 544, 1, 544, 1, 544, 1,
-		545, 1, 545, 1, 545, 1, 545, 1, 545, 1, 545, 1, 546, 1, 546, 1, 546, 1,
-		546, 1, 546, 1, 547, 1, 547, 1, 547, 1, 547, 1, 547, 1, 548, 1, 548, 1,
-		548, 1, 548, 1, 548, 1, 548, 1, 548, 1, 548, 1, 548, 1, 548, 1, 548, 1,
-		549, 1, 549, 1, 549, 1, 549, 1, 549, 1, 549, 1, 550, 1, 550, 1, 550, 1,
-		550, 1, 550, 1, 550, 1, 550, 1, 550, 1, 550, 1, 550, 1, 550, 1, 550, 1,
-		550, 1, 551, 1, 551, 1, 551, 1, 551, 1, 551, 1, 551, 1, 551, 1, 552, 1,
-		552, 1, 552, 1, 552, 1, 552, 1, 552, 1, 552, 1, 552, 1, 553, 1, 553, 1,
-		553, 1, 553, 1, 553, 1, 554, 1, 554, 1, 554, 1, 554, 1, 554, 1, 554, 1,
-		555, 1, 555, 1, 555, 1, 555, 1, 555, 1, 556, 1, 556, 1, 556, 1, 556, 1,
-		556, 1, 556, 1, 557, 1, 557, 1, 557, 1, 557, 1, 557, 1, 558, 1, 558, 1,
-		558, 1, 558, 1, 558, 1, 558, 1, 559, 1, 559, 1, 559, 1, 559, 1, 559, 1,
-		559, 1, 560, 1, 560, 1, 560, 1, 560, 1, 560, 1, 560, 1, 560, 1, 561, 1,
-		561, 1, 561, 1, 561, 1, 562, 1, 562, 1, 562, 1, 562, 1, 562, 1, 563, 1,
-		563, 1, 563, 1, 563, 1, 564, 1, 564, 1, 564, 1, 564, 1, 564, 1, 565, 1,
-		565, 1, 565, 1, 565, 1, 566, 1, 566, 1, 566, 1, 566, 1, 566, 1, 567, 1,
-		567, 1, 567, 1, 567, 1, 568, 1, 568, 1, 568, 1, 568, 1, 568, 1, 569, 1,
-		569, 1, 569, 1, 569, 1, 569, 1, 570, 1, 570, 1, 570, 1, 570, 1, 570, 1,
-		571, 1, 571, 1, 571, 1, 571, 1, 571, 1, 572, 1, 572, 1, 572, 1, 572, 1,
-		572, 1, 572, 1, 573, 1, 573, 1, 573, 1, 573, 1, 573, 1, 573, 1, 574, 1,
-		574, 1, 574, 1, 574, 1, 574, 1, 574, 1, 575, 1, 575, 1, 575, 1, 575, 1,
-		575, 1, 575, 1, 575, 1, 575, 1, 575, 1, 575, 1, 575, 1, 576, 1, 576, 1,
-		576, 1, 576, 1, 576, 1, 576, 1, 576, 1, 576, 1, 576, 1, 576, 1, 576, 1,
-		576, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1,
-		577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1,
-		578, 1, 578, 1, 578, 1, 578, 1, 578, 1, 578, 1, 579, 1, 579, 1, 579, 1,
-		579, 1, 579, 1, 579, 1, 579, 1, 579, 1, 579, 1, 579, 1, 579, 1, 579, 1,
-		579, 1, 580, 1, 580, 1, 580, 1, 580, 1, 580, 1, 580, 1, 581, 1, 581, 1,
-		581, 1, 581, 1, 581, 1, 581, 1, 582, 1, 582, 1, 582, 1, 582, 1, 582, 1,
-		582, 1, 583, 1, 583, 1, 583, 1, 583, 1, 584, 1, 584, 1, 584, 1, 584, 1,
-		584, 1, 584, 1, 584, 1, 585, 1, 585, 1, 585, 1, 585, 1, 585, 1, 585, 1,
-		585, 1, 585, 1, 585, 1, 585, 1, 586, 1, 586, 1, 586, 1, 586, 1, 586, 1,
-		586, 1, 586, 1, 587, 1, 587, 1, 587, 1, 587, 1, 587, 1, 587, 1, 587, 1,
-		587, 1, 588, 1, 588, 1, 588, 1, 588, 1, 588, 1, 588, 1, 588, 1, 589, 1,
-		589, 1, 589, 1, 589, 1, 589, 1, 590, 1, 590, 1, 590, 1, 590, 1, 590, 1,
-		590, 1, 591, 1, 591, 1, 591, 1, 591, 1, 592, 1, 592, 1, 592, 1, 592, 1,
-		592, 1, 592, 1, 592, 1, 592, 1, 592, 1, 592, 1, 592, 1, 592, 1, 593, 1,
-		593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1,
-		593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1, 593, 1,
-		594, 1, 594, 1, 594, 1, 594, 1, 594, 1, 594, 1, 594, 1, 594, 1, 594, 1,
-		594, 1, 594, 1, 594, 1, 595, 1, 595, 1, 595, 1, 595, 1, 595, 1, 595, 1,
-		595, 1, 595, 1, 595, 1, 595, 1, 595, 1, 595, 1, 595, 1, 595, 1, 596, 1,
-		596, 1, 596, 1, 596, 1, 596, 1, 596, 1, 596, 1, 596, 1, 596, 1, 596, 1,
-		596, 1, 596, 1, 596, 1, 596, 1, 596, 1, 597, 1, 597, 1, 597, 1, 597, 1,
-		597, 1, 597, 1, 597, 1, 597, 1, 597, 1, 597, 1, 597, 1, 597, 1, 597, 1,
-		598, 1, 598, 1, 598, 1, 598, 1, 598, 1, 598, 1, 598, 1, 598, 1, 598, 1,
-		598, 1, 598, 1, 598, 1, 598, 1, 599, 1, 599, 1, 599, 1, 599, 1, 599, 1,
-		599, 1, 599, 1, 599, 1, 599, 1, 599, 1, 599, 1, 599, 1, 600, 1, 600, 1,
-		600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1,
-		600, 1, 600, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1,
-		601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 602, 1,
-		602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1,
-		602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 603, 1, 603, 1, 603, 1, 603, 1,
-		603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1,
-		603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1,
-		604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1,
-		604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1,
-		604, 1, 604, 1, 604, 1, 604, 1, 605, 1, 605, 1, 605, 1, 605, 1, 605, 1,
-		605, 1, 605, 1, 605, 1, 605, 1, 605, 1, 605, 1, 605, 1, 605, 1, 605, 1,
-		606, 1, 606, 1, 606, 1, 606, 1, 606, 1, 606, 1, 606, 1, 607, 1, 607, 1,
-		607, 1, 607, 1, 607, 1, 608, 1, 608, 1, 608, 1, 608, 1, 608, 1, 608, 1,
-		609, 1, 609, 1, 609, 1, 609, 1, 609, 1, 609, 1, 609, 1, 609, 1, 609, 1,
-		609, 1, 609, 1, 610, 1, 610, 1, 610, 1, 610, 1, 610, 1, 610, 1, 610, 1,
-		610, 1, 610, 1, 610, 1, 610, 1, 610, 1, 611, 1, 611, 1, 611, 1, 611, 1,
-		611, 1, 611, 1, 611, 1, 611, 1, 611, 1, 611, 1, 611, 1, 611, 1, 611, 1,
-		611, 1, 611, 1, 611, 1, 612, 1, 612, 1, 612, 1, 612, 1, 612, 1, 612, 1,
-		612, 1, 612, 1, 612, 1, 612, 1, 612, 1, 612, 1, 612, 1, 612, 1, 612, 1,
-		612, 1, 613, 1, 613, 1, 613, 1, 613, 1, 613, 1, 613, 1, 613, 1, 614, 1,
-		614, 1, 614, 1, 614, 1, 614, 1, 614, 1, 614, 1, 615, 1, 615, 1, 615, 1,
-		615, 1, 615, 1, 615, 1, 615, 1, 615, 1, 615, 1, 616, 1, 616, 1, 616, 1,
-		616, 1, 616, 1, 616, 1, 616, 1, 617, 1, 617, 1, 617, 1, 617, 1, 617, 1,
-		617, 1, 617, 1, 617, 1, 617, 1, 617, 1, 618, 1, 618, 1, 618, 1, 618, 1,
-		618, 1, 618, 1, 618, 1, 619, 1, 619, 1, 619, 1, 619, 1, 620, 1, 620, 1,
-		620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1,
-		620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 621, 1, 621, 1, 621, 1, 621, 1,
-		621, 1, 621, 1, 621, 1, 621, 1, 621, 1, 622, 1, 622, 1, 622, 1, 622, 1,
-		622, 1, 622, 1, 622, 1, 622, 1, 622, 1, 622, 1, 623, 1, 623, 1, 623, 1,
-		623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 624, 1,
-		624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 625, 1,
-		625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1,
-		625, 1, 625, 1, 625, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1,
-		626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 627, 1,
-		627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1,
-		627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 628, 1, 628, 1,
-		628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 629, 1,
-		629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1,
-		629, 1, 629, 1, 629, 1, 629, 1, 630, 1, 630, 1, 630, 1, 630, 1, 630, 1,
-		630, 1, 630, 1, 630, 1, 630, 1, 630, 1, 631, 1, 631, 1, 631, 1, 631, 1,
-		631, 1, 631, 1, 631, 1, 631, 1, 631, 1, 631, 1, 631, 1, 631, 1, 631, 1,
-		631, 1, 631, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1,
-		632, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1,
-		632, 1, 633, 1, 633, 1, 633, 1, 633, 1, 634, 1, 634, 1, 634, 1, 634, 1,
-		634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1,
-		634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 635, 1, 635, 1,
-		635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 636, 1,
-		636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1,
-		636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1,
-		636, 1, 636, 1, 636, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1,
-		637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 638, 1, 638, 1,
-		638, 1, 638, 1, 638, 1, 638, 1, 638, 1, 638, 1, 639, 1, 639, 1, 639, 1,
-		639, 1, 639, 1, 639, 1, 639, 1, 639, 1, 640, 1, 640, 1, 640, 1, 640, 1,
-		640, 1, 640, 1, 640, 1, 640, 1, 640, 1, 640, 1, 641, 1, 641, 1, 641, 1,
-		641, 1, 641, 1, 641, 1, 641, 1, 642, 1, 642, 1, 642, 1, 642, 1, 642, 1,
-		642, 1, 642, 1, 642, 1, 643, 1, 643, 1, 643, 1, 643, 1, 643, 1, 643, 1,
-		643, 1, 643, 1, 644, 1, 644, 1, 644, 1, 644, 1, 644, 1, 644, 1, 644, 1,
-		644, 1, 644, 1, 644, 1, 644, 1, 644, 1, 644, 1, 644, 1, 644, 1, 645, 1,
-		645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1,
-		645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 646, 1, 646, 5,
-		646, 6409, 8, 646, 10, 646, 12, 646, 6412, 9, 646, 1, 647, 1, 647, 1, 647,
-		1, 647, 1, 647, 1, 647, 3, 647, 6420, 8, 647, 1, 648, 1, 648, 3, 648, 6424,
-		8, 648, 1, 649, 1, 649, 3, 649, 6428, 8, 649, 1, 650, 1, 650, 1, 650, 1,
-		651, 1, 651, 1, 651, 1, 651, 5, 651, 6437, 8, 651, 10, 651, 12, 651, 6440,
-		9, 651, 1, 652, 1, 652, 1, 652, 1, 653, 1, 653, 1, 653, 1, 653, 5, 653,
-		6449, 8, 653, 10, 653, 12, 653, 6452, 9, 653, 1, 654, 1, 654, 1, 654, 1,
-		654, 1, 655, 1, 655, 1, 655, 1, 655, 1, 656, 1, 656, 1, 656, 1, 656, 1,
-		657, 1, 657, 1, 657, 1, 657, 1, 658, 1, 658, 1, 658, 1, 659, 1, 659, 1,
-		659, 1, 659, 5, 659, 6477, 8, 659, 10, 659, 12, 659, 6480, 9, 659, 1, 660,
-		1, 660, 1, 660, 1, 660, 1, 660, 1, 660, 1, 661, 1, 661, 1, 661, 1, 662,
-		1, 662, 1, 662, 1, 662, 1, 663, 1, 663, 3, 663, 6497, 8, 663, 1, 663, 1,
-		663, 1, 663, 1, 663, 1, 663, 1, 664, 1, 664, 5, 664, 6506, 8, 664, 10,
-		664, 12, 664, 6509, 9, 664, 1, 665, 1, 665, 1, 665, 1, 666, 1, 666, 1,
-		666, 5, 666, 6517, 8, 666, 10, 666, 12, 666, 6520, 9, 666, 1, 667, 1, 667,
-		1, 667, 1, 668, 1, 668, 1, 668, 1, 669, 1, 669, 1, 669, 1, 670, 1, 670,
-		1, 670, 5, 670, 6534, 8, 670, 10, 670, 12, 670, 6537, 9, 670, 1, 671, 1,
-		671, 1, 671, 1, 672, 1, 672, 1, 672, 1, 673, 1, 673, 1, 674, 1, 674, 1,
-		674, 1, 674, 1, 674, 1, 674, 1, 675, 1, 675, 1, 675, 3, 675, 6556, 8, 675,
-		1, 675, 1, 675, 3, 675, 6560, 8, 675, 1, 675, 3, 675, 6563, 8, 675, 1,
-		675, 1, 675, 1, 675, 1, 675, 3, 675, 6569, 8, 675, 1, 675, 3, 675, 6572,
-		8, 675, 1, 675, 1, 675, 1, 675, 3, 675, 6577, 8, 675, 1, 675, 1, 675, 3,
-		675, 6581, 8, 675, 1, 676, 4, 676, 6584, 8, 676, 11, 676, 12, 676, 6585,
-		1, 677, 1, 677, 1, 677, 5, 677, 6591, 8, 677, 10, 677, 12, 677, 6594, 9,
-		677, 1, 678, 1, 678, 1, 678, 1, 678, 1, 678, 1, 678, 1, 678, 1, 678, 5,
-		678, 6604, 8, 678, 10, 678, 12, 678, 6607, 9, 678, 1, 678, 1, 678, 1, 679,
-		1, 679, 1, 679, 1, 679, 1, 680, 1, 680, 3, 680, 6617, 8, 680, 1, 680, 3,
-		680, 6620, 8, 680, 1, 680, 1, 680, 1, 681, 1, 681, 1, 681, 1, 681, 5, 681,
-		6628, 8, 681, 10, 681, 12, 681, 6631, 9, 681, 1, 681, 1, 681, 1, 682, 1,
-		682, 1, 682, 1, 682, 5, 682, 6639, 8, 682, 10, 682, 12, 682, 6642, 9, 682,
-		1, 682, 1, 682, 1, 682, 4, 682, 6647, 8, 682, 11, 682, 12, 682, 6648, 1,
-		682, 1, 682, 4, 682, 6653, 8, 682, 11, 682, 12, 682, 6654, 1, 682, 5, 682,
-		6658, 8, 682, 10, 682, 12, 682, 6661, 9, 682, 1, 682, 5, 682, 6664, 8,
-		682, 10, 682, 12, 682, 6667, 9, 682, 1, 682, 1, 682, 1, 682, 1, 682, 1,
-		682, 1, 683, 1, 683, 1, 683, 1, 683, 5, 683, 6678, 8, 683, 10, 683, 12,
-		683, 6681, 9, 683, 1, 683, 1, 683, 1, 683, 4, 683, 6686, 8, 683, 11, 683,
-		12, 683, 6687, 1, 683, 1, 683, 4, 683, 6692, 8, 683, 11, 683, 12, 683,
-		6693, 1, 683, 3, 683, 6697, 8, 683, 5, 683, 6699, 8, 683, 10, 683, 12,
-		683, 6702, 9, 683, 1, 683, 4, 683, 6705, 8, 683, 11, 683, 12, 683, 6706,
-		1, 683, 4, 683, 6710, 8, 683, 11, 683, 12, 683, 6711, 1, 683, 5, 683, 6715,
-		8, 683, 10, 683, 12, 683, 6718, 9, 683, 1, 683, 3, 683, 6721, 8, 683, 1,
-		683, 1, 683, 1, 684, 1, 684, 1, 684, 1, 684, 5, 684, 6729, 8, 684, 10,
-		684, 12, 684, 6732, 9, 684, 1, 684, 5, 684, 6735, 8, 684, 10, 684, 12,
-		684, 6738, 9, 684, 1, 684, 1, 684, 5, 684, 6742, 8, 684, 10, 684, 12, 684,
-		6745, 9, 684, 3, 684, 6747, 8, 684, 1, 685, 1, 685, 1, 685, 1, 686, 1,
-		686, 1, 687, 1, 687, 1, 687, 1, 687, 1, 687, 1, 688, 1, 688, 3, 688, 6761,
-		8, 688, 1, 688, 1, 688, 1, 689, 1, 689, 1, 689, 1, 689, 1, 689, 1, 689,
-		1, 689, 1, 689, 1, 689, 1, 689, 1, 689, 1, 689, 1, 689, 1, 689, 1, 689,
-		1, 689, 1, 689, 1, 689, 1, 689, 1, 689, 3, 689, 6785, 8, 689, 1, 689, 5,
-		689, 6788, 8, 689, 10, 689, 12, 689, 6791, 9, 689, 1, 690, 1, 690, 1, 690,
-		1, 690, 1, 690, 1, 691, 1, 691, 3, 691, 6800, 8, 691, 1, 691, 1, 691, 1,
-		692, 1, 692, 1, 692, 1, 692, 1, 692, 5, 692, 6809, 8, 692, 10, 692, 12,
-		692, 6812, 9, 692, 1, 693, 1, 693, 1, 693, 1, 693, 1, 693, 1, 694, 1, 694,
-		1, 694, 1, 694, 1, 694, 1, 694, 1, 695, 1, 695, 1, 695, 1, 695, 1, 695,
-		1, 696, 1, 696, 1, 696, 1, 696, 1, 696, 1, 697, 1, 697, 1, 697, 1, 697,
-		1, 697, 1, 698, 1, 698, 1, 698, 1, 698, 1, 698, 1, 699, 1, 699, 1, 699,
-		1, 699, 1, 699, 1, 700, 4, 700, 6851, 8, 700, 11, 700, 12, 700, 6852, 1,
-		700, 1, 700, 5, 700, 6857, 8, 700, 10, 700, 12, 700, 6860, 9, 700, 3, 700,
-		6862, 8, 700, 1, 701, 1, 701, 3, 701, 6866, 8, 701, 1, 701, 1, 701, 1,
-		701, 1, 701, 1, 701, 1, 701, 1, 701, 0, 0, 702, 5, 1, 7, 2, 9, 3, 11, 4,
-		13, 5, 15, 6, 17, 7, 19, 8, 21, 9, 23, 10, 25, 11, 27, 12, 29, 13, 31,
-		14, 33, 15, 35, 16, 37, 17, 39, 18, 41, 19, 43, 20, 45, 21, 47, 22, 49,
-		23, 51, 24, 53, 25, 55, 26, 57, 27, 59, 28, 61, 29, 63, 0, 65, 0, 67, 0,
-		69, 0, 71, 30, 73, 31, 75, 32, 77, 33, 79, 34, 81, 35, 83, 36, 85, 37,
-		87, 38, 89, 39, 91, 40, 93, 41, 95, 42, 97, 43, 99, 44, 101, 45, 103, 46,
-		105, 47, 107, 48, 109, 49, 111, 50, 113, 51, 115, 52, 117, 53, 119, 54,
-		121, 55, 123, 56, 125, 57, 127, 58, 129, 59, 131, 60, 133, 61, 135, 62,
-		137, 63, 139, 64, 141, 65, 143, 66, 145, 67, 147, 68, 149, 69, 151, 70,
-		153, 71, 155, 72, 157, 73, 159, 74, 161, 75, 163, 76, 165, 77, 167, 78,
-		169, 79, 171, 80, 173, 81, 175, 82, 177, 83, 179, 84, 181, 85, 183, 86,
-		185, 87, 187, 88, 189, 89, 191, 90, 193, 91, 195, 92, 197, 93, 199, 94,
-		201, 95, 203, 96, 205, 97, 207, 98, 209, 99, 211, 100, 213, 101, 215, 102,
-		217, 103, 219, 104, 221, 105, 223, 106, 225, 107, 227, 108, 229, 109, 231,
-		110, 233, 111, 235, 112, 237, 113, 239, 114, 241, 115, 243, 116, 245, 117,
-		247, 118, 249, 119, 251, 120, 253, 121, 255, 122, 257, 123, 259, 124, 261,
-		125, 263, 126, 265, 127, 267, 128, 269, 129, 271, 130, 273, 131, 275, 132,
-		277, 133, 279, 134, 281, 135, 283, 136, 285, 137, 287, 138, 289, 139, 291,
-		140, 293, 141, 295, 142, 297, 143, 299, 144, 301, 145, 303, 146, 305, 147,
-		307, 148, 309, 149, 311, 150, 313, 151, 315, 152, 317, 153, 319, 154, 321,
-		155, 323, 156, 325, 157, 327, 158, 329, 159, 331, 160, 333, 161, 335, 162,
-		337, 163, 339, 164, 341, 165, 343, 166, 345, 167, 347, 168, 349, 169, 351,
-		170, 353, 171, 355, 172, 357, 173, 359, 174, 361, 175, 363, 176, 365, 177,
-		367, 178, 369, 179, 371, 180, 373, 181, 375, 182, 377, 183, 379, 184, 381,
-		185, 383, 186, 385, 187, 387, 188, 389, 189, 391, 190, 393, 191, 395, 192,
-		397, 193, 399, 194, 401, 195, 403, 196, 405, 197, 407, 198, 409, 199, 411,
-		200, 413, 201, 415, 202, 417, 203, 419, 204, 421, 205, 423, 206, 425, 207,
-		427, 208, 429, 209, 431, 210, 433, 211, 435, 212, 437, 213, 439, 214, 441,
-		215, 443, 216, 445, 217, 447, 218, 449, 219, 451, 220, 453, 221, 455, 222,
-		457, 223, 459, 224, 461, 225, 463, 226, 465, 227, 467, 228, 469, 229, 471,
-		230, 473, 231, 475, 232, 477, 233, 479, 234, 481, 235, 483, 236, 485, 237,
-		487, 238, 489, 239, 491, 240, 493, 241, 495, 242, 497, 243, 499, 244, 501,
-		245, 503, 246, 505, 247, 507, 248, 509, 249, 511, 250, 513, 251, 515, 252,
-		517, 253, 519, 254, 521, 255, 523, 256, 525, 257, 527, 258, 529, 259, 531,
-		260, 533, 261, 535, 262, 537, 263, 539, 264, 541, 265, 543, 266, 545, 267,
-		547, 268, 549, 269, 551, 270, 553, 271, 555, 272, 557, 273, 559, 274, 561,
-		275, 563, 276, 565, 277, 567, 278, 569, 279, 571, 280, 573, 281, 575, 282,
-		577, 283, 579, 284, 581, 285, 583, 286, 585, 287, 587, 288, 589, 289, 591,
-		290, 593, 291, 595, 292, 597, 293, 599, 294, 601, 295, 603, 296, 605, 297,
-		607, 298, 609, 299, 611, 300, 613, 301, 615, 302, 617, 303, 619, 304, 621,
-		305, 623, 306, 625, 307, 627, 308, 629, 309, 631, 310, 633, 311, 635, 312,
-		637, 313, 639, 314, 641, 315, 643, 316, 645, 317, 647, 318, 649, 319, 651,
-		320, 653, 321, 655, 322, 657, 323, 659, 324, 661, 325, 663, 326, 665, 327,
-		667, 328, 669, 329, 671, 330, 673, 331, 675, 332, 677, 333, 679, 334, 681,
-		335, 683, 336, 685, 337, 687, 338, 689, 339, 691, 340, 693, 341, 695, 342,
-		697, 343, 699, 344, 701, 345, 703, 346, 705, 347, 707, 348, 709, 349, 711,
-		350, 713, 351, 715, 352, 717, 353, 719, 354, 721, 355, 723, 356, 725, 357,
-		727, 358, 729, 359, 731, 360, 733, 361, 735, 362, 737, 363, 739, 364, 741,
-		365, 743, 366, 745, 367, 747, 368, 749, 369, 751, 370, 753, 371, 755, 372,
-		757, 373, 759, 374, 761, 375, 763, 376, 765, 377, 767, 378, 769, 379, 771,
-		380, 773, 381, 775, 382, 777, 383, 779, 384, 781, 385, 783, 386, 785, 387,
-		787, 388, 789, 389, 791, 390, 793, 391, 795, 392, 797, 393, 799, 394, 801,
-		395, 803, 396, 805, 397, 807, 398, 809, 399, 811, 400, 813, 401, 815, 402,
-		817, 403, 819, 404, 821, 405, 823, 406, 825, 407, 827, 408, 829, 409, 831,
-		410, 833, 411, 835, 412, 837, 413, 839, 414, 841, 415, 843, 416, 845, 417,
-		847, 418, 849, 419, 851, 420, 853, 421, 855, 422, 857, 423, 859, 424, 861,
-		425, 863, 426, 865, 427, 867, 428, 869, 429, 871, 430, 873, 431, 875, 432,
-		877, 433, 879, 434, 881, 435, 883, 436, 885, 437, 887, 438, 889, 439, 891,
-		440, 893, 441, 895, 442, 897, 443, 899, 444, 901, 445, 903, 446, 905, 447,
-		907, 448, 909, 449, 911, 450, 913, 451, 915, 452, 917, 453, 919, 454, 921,
-		455, 923, 456, 925, 457, 927, 458, 929, 459, 931, 460, 933, 461, 935, 462,
-		937, 463, 939, 464, 941, 465, 943, 466, 945, 467, 947, 468, 949, 469, 951,
-		470, 953, 471, 955, 472, 957, 473, 959, 474, 961, 475, 963, 476, 965, 477,
-		967, 478, 969, 479, 971, 480, 973, 481, 975, 482, 977, 483, 979, 484, 981,
-		485, 983, 486, 985, 487, 987, 488, 989, 489, 991, 490, 993, 491, 995, 492,
-		997, 493, 999, 494, 1001, 495, 1003, 496, 1005, 497, 1007, 498, 1009, 499,
-		1011, 500, 1013, 501, 1015, 502, 1017, 503, 1019, 504, 1021, 505, 1023,
-		506, 1025, 507, 1027, 508, 1029, 509, 1031, 510, 1033, 511, 1035, 512,
-		1037, 513, 1039, 514, 1041, 515, 1043, 516, 1045, 517, 1047, 518, 1049,
-		519, 1051, 520, 1053, 521, 1055, 522, 1057, 523, 1059, 524, 1061, 525,
-		1063, 526, 1065, 527, 1067, 528, 1069, 529, 1071, 530, 1073, 531, 1075,
-		532, 1077, 533, 1079, 534, 1081, 535, 1083, 536, 1085, 537, 1087, 538,
-		1089, 539, 1091, 540, 1093, 541, 1095, 542, 1097, 543, 1099, 544, 1101,
-		545, 1103, 546, 1105, 547, 1107, 548, 1109, 549, 1111, 550, 1113, 551,
-		1115, 552, 1117, 553, 1119, 554, 1121, 555, 1123, 556, 1125, 557, 1127,
-		558, 1129, 559, 1131, 560, 1133, 561, 1135, 562, 1137, 563, 1139, 564,
-		1141, 565, 1143, 566, 1145, 567, 1147, 568, 1149, 569, 1151, 570, 1153,
-		571, 1155, 572, 1157, 573, 1159, 574, 1161, 575, 1163, 576, 1165, 577,
-		1167, 578, 1169, 579, 1171, 580, 1173, 581, 1175, 582, 1177, 583, 1179,
-		584, 1181, 585, 1183, 586, 1185, 587, 1187, 588, 1189, 589, 1191, 590,
-		1193, 591, 1195, 592, 1197, 593, 1199, 594, 1201, 595, 1203, 596, 1205,
-		597, 1207, 598, 1209, 599, 1211, 600, 1213, 601, 1215, 602, 1217, 603,
-		1219, 604, 1221, 605, 1223, 606, 1225, 607, 1227, 608, 1229, 609, 1231,
-		610, 1233, 611, 1235, 612, 1237, 613, 1239, 614, 1241, 615, 1243, 616,
-		1245, 617, 1247, 618, 1249, 619, 1251, 620, 1253, 621, 1255, 622, 1257,
-		623, 1259, 624, 1261, 625, 1263, 626, 1265, 627, 1267, 628, 1269, 629,
-		1271, 630, 1273, 631, 1275, 632, 1277, 633, 1279, 634, 1281, 635, 1283,
-		636, 1285, 637, 1287, 638, 1289, 639, 1291, 640, 1293, 641, 1295, 642,
-		1297, 643, 1299, 0, 1301, 0, 1303, 0, 1305, 644, 1307, 645, 1309, 646,
-		1311, 647, 1313, 648, 1315, 649, 1317, 650, 1319, 651, 1321, 652, 1323,
-		653, 1325, 0, 1327, 654, 1329, 655, 1331, 656, 1333, 0, 1335, 657, 1337,
-		658, 1339, 659, 1341, 660, 1343, 661, 1345, 662, 1347, 663, 1349, 664,
-		1351, 665, 1353, 666, 1355, 667, 1357, 0, 1359, 668, 1361, 669, 1363, 670,
-		1365, 671, 1367, 672, 1369, 673, 1371, 674, 1373, 675, 1375, 676, 1377,
-		677, 1379, 678, 1381, 679, 1383, 0, 1385, 680, 1387, 681, 1389, 0, 1391,
-		0, 1393, 0, 1395, 682, 1397, 0, 1399, 0, 1401, 686, 1403, 683, 1405, 684,
-		1407, 685, 5, 0, 1, 2, 3, 4, 51, 1, 0, 48, 57, 2, 0, 43, 43, 45, 45, 9,
-		0, 33, 33, 35, 35, 37, 38, 42, 42, 60, 64, 94, 94, 96, 96, 124, 124, 126,
-		126, 2, 0, 42, 43, 60, 62, 8, 0, 33, 33, 35, 35, 37, 38, 63, 64, 94, 94,
-		96, 96, 124, 124, 126, 126, 2, 0, 65, 65, 97, 97, 2, 0, 76, 76, 108, 108,
-		2, 0, 78, 78, 110, 110, 2, 0, 89, 89, 121, 121, 2, 0, 83, 83, 115, 115,
-		2, 0, 69, 69, 101, 101, 2, 0, 90, 90, 122, 122, 2, 0, 68, 68, 100, 100,
-		2, 0, 82, 82, 114, 114, 2, 0, 67, 67, 99, 99, 2, 0, 77, 77, 109, 109, 2,
-		0, 84, 84, 116, 116, 2, 0, 73, 73, 105, 105, 2, 0, 66, 66, 98, 98, 2, 0,
-		79, 79, 111, 111, 2, 0, 72, 72, 104, 104, 2, 0, 75, 75, 107, 107, 2, 0,
-		85, 85, 117, 117, 2, 0, 71, 71, 103, 103, 2, 0, 80, 80, 112, 112, 2, 0,
-		70, 70, 102, 102, 2, 0, 88, 88, 120, 120, 2, 0, 86, 86, 118, 118, 2, 0,
-		81, 81, 113, 113, 2, 0, 87, 87, 119, 119, 2, 0, 74, 74, 106, 106, 9, 0,
-		65, 90, 95, 95, 97, 122, 170, 170, 181, 181, 186, 186, 192, 214, 216, 246,
-		248, 255, 2, 0, 256, 55295, 57344, 65535, 1, 0, 55296, 56319, 1, 0, 56320,
-		57343, 2, 0, 0, 0, 34, 34, 1, 0, 34, 34, 1, 0, 39, 39, 1, 0, 48, 49, 3,
-		0, 48, 57, 65, 70, 97, 102, 3, 0, 65, 90, 95, 95, 97, 122, 5, 0, 36, 36,
-		48, 57, 65, 90, 95, 95, 97, 122, 2, 0, 34, 34, 92, 92, 2, 0, 9, 9, 32,
-		32, 2, 0, 10, 10, 13, 13, 2, 0, 42, 42, 47, 47, 4, 0, 10, 10, 13, 13, 34,
-		34, 92, 92, 3, 0, 10, 10, 13, 13, 34, 34, 3, 0, 85, 85, 117, 117, 120,
-		120, 2, 0, 39, 39, 92, 92, 1, 0, 36, 36, 6945, 0, 5, 1, 0, 0, 0, 0, 7,
-		1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0,
-		15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0,
-		0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0,
-		0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0,
-		0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1,
-		0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53,
-		1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0,
-		61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0,
-		0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0,
-		0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0,
-		0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1,
-		0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0,
-		105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0,
-		0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119,
-		1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0,
-		0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1,
-		0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0,
-		141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0,
-		0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155,
-		1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0,
-		0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1,
-		0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0,
-		177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0,
-		0, 0, 0, 185, 1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191,
-		1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0,
-		0, 199, 1, 0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 205, 1,
-		0, 0, 0, 0, 207, 1, 0, 0, 0, 0, 209, 1, 0, 0, 0, 0, 211, 1, 0, 0, 0, 0,
-		213, 1, 0, 0, 0, 0, 215, 1, 0, 0, 0, 0, 217, 1, 0, 0, 0, 0, 219, 1, 0,
-		0, 0, 0, 221, 1, 0, 0, 0, 0, 223, 1, 0, 0, 0, 0, 225, 1, 0, 0, 0, 0, 227,
-		1, 0, 0, 0, 0, 229, 1, 0, 0, 0, 0, 231, 1, 0, 0, 0, 0, 233, 1, 0, 0, 0,
-		0, 235, 1, 0, 0, 0, 0, 237, 1, 0, 0, 0, 0, 239, 1, 0, 0, 0, 0, 241, 1,
-		0, 0, 0, 0, 243, 1, 0, 0, 0, 0, 245, 1, 0, 0, 0, 0, 247, 1, 0, 0, 0, 0,
-		249, 1, 0, 0, 0, 0, 251, 1, 0, 0, 0, 0, 253, 1, 0, 0, 0, 0, 255, 1, 0,
-		0, 0, 0, 257, 1, 0, 0, 0, 0, 259, 1, 0, 0, 0, 0, 261, 1, 0, 0, 0, 0, 263,
-		1, 0, 0, 0, 0, 265, 1, 0, 0, 0, 0, 267, 1, 0, 0, 0, 0, 269, 1, 0, 0, 0,
-		0, 271, 1, 0, 0, 0, 0, 273, 1, 0, 0, 0, 0, 275, 1, 0, 0, 0, 0, 277, 1,
-		0, 0, 0, 0, 279, 1, 0, 0, 0, 0, 281, 1, 0, 0, 0, 0, 283, 1, 0, 0, 0, 0,
-		285, 1, 0, 0, 0, 0, 287, 1, 0, 0, 0, 0, 289, 1, 0, 0, 0, 0, 291, 1, 0,
-		0, 0, 0, 293, 1, 0, 0, 0, 0, 295, 1, 0, 0, 0, 0, 297, 1, 0, 0, 0, 0, 299,
-		1, 0, 0, 0, 0, 301, 1, 0, 0, 0, 0, 303, 1, 0, 0, 0, 0, 305, 1, 0, 0, 0,
-		0, 307, 1, 0, 0, 0, 0, 309, 1, 0, 0, 0, 0, 311, 1, 0, 0, 0, 0, 313, 1,
-		0, 0, 0, 0, 315, 1, 0, 0, 0, 0, 317, 1, 0, 0, 0, 0, 319, 1, 0, 0, 0, 0,
-		321, 1, 0, 0, 0, 0, 323, 1, 0, 0, 0, 0, 325, 1, 0, 0, 0, 0, 327, 1, 0,
-		0, 0, 0, 329, 1, 0, 0, 0, 0, 331, 1, 0, 0, 0, 0, 333, 1, 0, 0, 0, 0, 335,
-		1, 0, 0, 0, 0, 337, 1, 0, 0, 0, 0, 339, 1, 0, 0, 0, 0, 341, 1, 0, 0, 0,
-		0, 343, 1, 0, 0, 0, 0, 345, 1, 0, 0, 0, 0, 347, 1, 0, 0, 0, 0, 349, 1,
-		0, 0, 0, 0, 351, 1, 0, 0, 0, 0, 353, 1, 0, 0, 0, 0, 355, 1, 0, 0, 0, 0,
-		357, 1, 0, 0, 0, 0, 359, 1, 0, 0, 0, 0, 361, 1, 0, 0, 0, 0, 363, 1, 0,
-		0, 0, 0, 365, 1, 0, 0, 0, 0, 367, 1, 0, 0, 0, 0, 369, 1, 0, 0, 0, 0, 371,
-		1, 0, 0, 0, 0, 373, 1, 0, 0, 0, 0, 375, 1, 0, 0, 0, 0, 377, 1, 0, 0, 0,
-		0, 379, 1, 0, 0, 0, 0, 381, 1, 0, 0, 0, 0, 383, 1, 0, 0, 0, 0, 385, 1,
-		0, 0, 0, 0, 387, 1, 0, 0, 0, 0, 389, 1, 0, 0, 0, 0, 391, 1, 0, 0, 0, 0,
-		393, 1, 0, 0, 0, 0, 395, 1, 0, 0, 0, 0, 397, 1, 0, 0, 0, 0, 399, 1, 0,
-		0, 0, 0, 401, 1, 0, 0, 0, 0, 403, 1, 0, 0, 0, 0, 405, 1, 0, 0, 0, 0, 407,
-		1, 0, 0, 0, 0, 409, 1, 0, 0, 0, 0, 411, 1, 0, 0, 0, 0, 413, 1, 0, 0, 0,
-		0, 415, 1, 0, 0, 0, 0, 417, 1, 0, 0, 0, 0, 419, 1, 0, 0, 0, 0, 421, 1,
-		0, 0, 0, 0, 423, 1, 0, 0, 0, 0, 425, 1, 0, 0, 0, 0, 427, 1, 0, 0, 0, 0,
-		429, 1, 0, 0, 0, 0, 431, 1, 0, 0, 0, 0, 433, 1, 0, 0, 0, 0, 435, 1, 0,
-		0, 0, 0, 437, 1, 0, 0, 0, 0, 439, 1, 0, 0, 0, 0, 441, 1, 0, 0, 0, 0, 443,
-		1, 0, 0, 0, 0, 445, 1, 0, 0, 0, 0, 447, 1, 0, 0, 0, 0, 449, 1, 0, 0, 0,
-		0, 451, 1, 0, 0, 0, 0, 453, 1, 0, 0, 0, 0, 455, 1, 0, 0, 0, 0, 457, 1,
-		0, 0, 0, 0, 459, 1, 0, 0, 0, 0, 461, 1, 0, 0, 0, 0, 463, 1, 0, 0, 0, 0,
-		465, 1, 0, 0, 0, 0, 467, 1, 0, 0, 0, 0, 469, 1, 0, 0, 0, 0, 471, 1, 0,
-		0, 0, 0, 473, 1, 0, 0, 0, 0, 475, 1, 0, 0, 0, 0, 477, 1, 0, 0, 0, 0, 479,
-		1, 0, 0, 0, 0, 481, 1, 0, 0, 0, 0, 483, 1, 0, 0, 0, 0, 485, 1, 0, 0, 0,
-		0, 487, 1, 0, 0, 0, 0, 489, 1, 0, 0, 0, 0, 491, 1, 0, 0, 0, 0, 493, 1,
-		0, 0, 0, 0, 495, 1, 0, 0, 0, 0, 497, 1, 0, 0, 0, 0, 499, 1, 0, 0, 0, 0,
-		501, 1, 0, 0, 0, 0, 503, 1, 0, 0, 0, 0, 505, 1, 0, 0, 0, 0, 507, 1, 0,
-		0, 0, 0, 509, 1, 0, 0, 0, 0, 511, 1, 0, 0, 0, 0, 513, 1, 0, 0, 0, 0, 515,
-		1, 0, 0, 0, 0, 517, 1, 0, 0, 0, 0, 519, 1, 0, 0, 0, 0, 521, 1, 0, 0, 0,
-		0, 523, 1, 0, 0, 0, 0, 525, 1, 0, 0, 0, 0, 527, 1, 0, 0, 0, 0, 529, 1,
-		0, 0, 0, 0, 531, 1, 0, 0, 0, 0, 533, 1, 0, 0, 0, 0, 535, 1, 0, 0, 0, 0,
-		537, 1, 0, 0, 0, 0, 539, 1, 0, 0, 0, 0, 541, 1, 0, 0, 0, 0, 543, 1, 0,
-		0, 0, 0, 545, 1, 0, 0, 0, 0, 547, 1, 0, 0, 0, 0, 549, 1, 0, 0, 0, 0, 551,
-		1, 0, 0, 0, 0, 553, 1, 0, 0, 0, 0, 555, 1, 0, 0, 0, 0, 557, 1, 0, 0, 0,
-		0, 559, 1, 0, 0, 0, 0, 561, 1, 0, 0, 0, 0, 563, 1, 0, 0, 0, 0, 565, 1,
-		0, 0, 0, 0, 567, 1, 0, 0, 0, 0, 569, 1, 0, 0, 0, 0, 571, 1, 0, 0, 0, 0,
-		573, 1, 0, 0, 0, 0, 575, 1, 0, 0, 0, 0, 577, 1, 0, 0, 0, 0, 579, 1, 0,
-		0, 0, 0, 581, 1, 0, 0, 0, 0, 583, 1, 0, 0, 0, 0, 585, 1, 0, 0, 0, 0, 587,
-		1, 0, 0, 0, 0, 589, 1, 0, 0, 0, 0, 591, 1, 0, 0, 0, 0, 593, 1, 0, 0, 0,
-		0, 595, 1, 0, 0, 0, 0, 597, 1, 0, 0, 0, 0, 599, 1, 0, 0, 0, 0, 601, 1,
-		0, 0, 0, 0, 603, 1, 0, 0, 0, 0, 605, 1, 0, 0, 0, 0, 607, 1, 0, 0, 0, 0,
-		609, 1, 0, 0, 0, 0, 611, 1, 0, 0, 0, 0, 613, 1, 0, 0, 0, 0, 615, 1, 0,
-		0, 0, 0, 617, 1, 0, 0, 0, 0, 619, 1, 0, 0, 0, 0, 621, 1, 0, 0, 0, 0, 623,
-		1, 0, 0, 0, 0, 625, 1, 0, 0, 0, 0, 627, 1, 0, 0, 0, 0, 629, 1, 0, 0, 0,
-		0, 631, 1, 0, 0, 0, 0, 633, 1, 0, 0, 0, 0, 635, 1, 0, 0, 0, 0, 637, 1,
-		0, 0, 0, 0, 639, 1, 0, 0, 0, 0, 641, 1, 0, 0, 0, 0, 643, 1, 0, 0, 0, 0,
-		645, 1, 0, 0, 0, 0, 647, 1, 0, 0, 0, 0, 649, 1, 0, 0, 0, 0, 651, 1, 0,
-		0, 0, 0, 653, 1, 0, 0, 0, 0, 655, 1, 0, 0, 0, 0, 657, 1, 0, 0, 0, 0, 659,
-		1, 0, 0, 0, 0, 661, 1, 0, 0, 0, 0, 663, 1, 0, 0, 0, 0, 665, 1, 0, 0, 0,
-		0, 667, 1, 0, 0, 0, 0, 669, 1, 0, 0, 0, 0, 671, 1, 0, 0, 0, 0, 673, 1,
-		0, 0, 0, 0, 675, 1, 0, 0, 0, 0, 677, 1, 0, 0, 0, 0, 679, 1, 0, 0, 0, 0,
-		681, 1, 0, 0, 0, 0, 683, 1, 0, 0, 0, 0, 685, 1, 0, 0, 0, 0, 687, 1, 0,
-		0, 0, 0, 689, 1, 0, 0, 0, 0, 691, 1, 0, 0, 0, 0, 693, 1, 0, 0, 0, 0, 695,
-		1, 0, 0, 0, 0, 697, 1, 0, 0, 0, 0, 699, 1, 0, 0, 0, 0, 701, 1, 0, 0, 0,
-		0, 703, 1, 0, 0, 0, 0, 705, 1, 0, 0, 0, 0, 707, 1, 0, 0, 0, 0, 709, 1,
-		0, 0, 0, 0, 711, 1, 0, 0, 0, 0, 713, 1, 0, 0, 0, 0, 715, 1, 0, 0, 0, 0,
-		717, 1, 0, 0, 0, 0, 719, 1, 0, 0, 0, 0, 721, 1, 0, 0, 0, 0, 723, 1, 0,
-		0, 0, 0, 725, 1, 0, 0, 0, 0, 727, 1, 0, 0, 0, 0, 729, 1, 0, 0, 0, 0, 731,
-		1, 0, 0, 0, 0, 733, 1, 0, 0, 0, 0, 735, 1, 0, 0, 0, 0, 737, 1, 0, 0, 0,
-		0, 739, 1, 0, 0, 0, 0, 741, 1, 0, 0, 0, 0, 743, 1, 0, 0, 0, 0, 745, 1,
-		0, 0, 0, 0, 747, 1, 0, 0, 0, 0, 749, 1, 0, 0, 0, 0, 751, 1, 0, 0, 0, 0,
-		753, 1, 0, 0, 0, 0, 755, 1, 0, 0, 0, 0, 757, 1, 0, 0, 0, 0, 759, 1, 0,
-		0, 0, 0, 761, 1, 0, 0, 0, 0, 763, 1, 0, 0, 0, 0, 765, 1, 0, 0, 0, 0, 767,
-		1, 0, 0, 0, 0, 769, 1, 0, 0, 0, 0, 771, 1, 0, 0, 0, 0, 773, 1, 0, 0, 0,
-		0, 775, 1, 0, 0, 0, 0, 777, 1, 0, 0, 0, 0, 779, 1, 0, 0, 0, 0, 781, 1,
-		0, 0, 0, 0, 783, 1, 0, 0, 0, 0, 785, 1, 0, 0, 0, 0, 787, 1, 0, 0, 0, 0,
-		789, 1, 0, 0, 0, 0, 791, 1, 0, 0, 0, 0, 793, 1, 0, 0, 0, 0, 795, 1, 0,
-		0, 0, 0, 797, 1, 0, 0, 0, 0, 799, 1, 0, 0, 0, 0, 801, 1, 0, 0, 0, 0, 803,
-		1, 0, 0, 0, 0, 805, 1, 0, 0, 0, 0, 807, 1, 0, 0, 0, 0, 809, 1, 0, 0, 0,
-		0, 811, 1, 0, 0, 0, 0, 813, 1, 0, 0, 0, 0, 815, 1, 0, 0, 0, 0, 817, 1,
-		0, 0, 0, 0, 819, 1, 0, 0, 0, 0, 821, 1, 0, 0, 0, 0, 823, 1, 0, 0, 0, 0,
-		825, 1, 0, 0, 0, 0, 827, 1, 0, 0, 0, 0, 829, 1, 0, 0, 0, 0, 831, 1, 0,
-		0, 0, 0, 833, 1, 0, 0, 0, 0, 835, 1, 0, 0, 0, 0, 837, 1, 0, 0, 0, 0, 839,
-		1, 0, 0, 0, 0, 841, 1, 0, 0, 0, 0, 843, 1, 0, 0, 0, 0, 845, 1, 0, 0, 0,
-		0, 847, 1, 0, 0, 0, 0, 849, 1, 0, 0, 0, 0, 851, 1, 0, 0, 0, 0, 853, 1,
-		0, 0, 0, 0, 855, 1, 0, 0, 0, 0, 857, 1, 0, 0, 0, 0, 859, 1, 0, 0, 0, 0,
-		861, 1, 0, 0, 0, 0, 863, 1, 0, 0, 0, 0, 865, 1, 0, 0, 0, 0, 867, 1, 0,
-		0, 0, 0, 869, 1, 0, 0, 0, 0, 871, 1, 0, 0, 0, 0, 873, 1, 0, 0, 0, 0, 875,
-		1, 0, 0, 0, 0, 877, 1, 0, 0, 0, 0, 879, 1, 0, 0, 0, 0, 881, 1, 0, 0, 0,
-		0, 883, 1, 0, 0, 0, 0, 885, 1, 0, 0, 0, 0, 887, 1, 0, 0, 0, 0, 889, 1,
-		0, 0, 0, 0, 891, 1, 0, 0, 0, 0, 893, 1, 0, 0, 0, 0, 895, 1, 0, 0, 0, 0,
-		897, 1, 0, 0, 0, 0, 899, 1, 0, 0, 0, 0, 901, 1, 0, 0, 0, 0, 903, 1, 0,
-		0, 0, 0, 905, 1, 0, 0, 0, 0, 907, 1, 0, 0, 0, 0, 909, 1, 0, 0, 0, 0, 911,
-		1, 0, 0, 0, 0, 913, 1, 0, 0, 0, 0, 915, 1, 0, 0, 0, 0, 917, 1, 0, 0, 0,
-		0, 919, 1, 0, 0, 0, 0, 921, 1, 0, 0, 0, 0, 923, 1, 0, 0, 0, 0, 925, 1,
-		0, 0, 0, 0, 927, 1, 0, 0, 0, 0, 929, 1, 0, 0, 0, 0, 931, 1, 0, 0, 0, 0,
-		933, 1, 0, 0, 0, 0, 935, 1, 0, 0, 0, 0, 937, 1, 0, 0, 0, 0, 939, 1, 0,
-		0, 0, 0, 941, 1, 0, 0, 0, 0, 943, 1, 0, 0, 0, 0, 945, 1, 0, 0, 0, 0, 947,
-		1, 0, 0, 0, 0, 949, 1, 0, 0, 0, 0, 951, 1, 0, 0, 0, 0, 953, 1, 0, 0, 0,
-		0, 955, 1, 0, 0, 0, 0, 957, 1, 0, 0, 0, 0, 959, 1, 0, 0, 0, 0, 961, 1,
-		0, 0, 0, 0, 963, 1, 0, 0, 0, 0, 965, 1, 0, 0, 0, 0, 967, 1, 0, 0, 0, 0,
-		969, 1, 0, 0, 0, 0, 971, 1, 0, 0, 0, 0, 973, 1, 0, 0, 0, 0, 975, 1, 0,
-		0, 0, 0, 977, 1, 0, 0, 0, 0, 979, 1, 0, 0, 0, 0, 981, 1, 0, 0, 0, 0, 983,
-		1, 0, 0, 0, 0, 985, 1, 0, 0, 0, 0, 987, 1, 0, 0, 0, 0, 989, 1, 0, 0, 0,
-		0, 991, 1, 0, 0, 0, 0, 993, 1, 0, 0, 0, 0, 995, 1, 0, 0, 0, 0, 997, 1,
-		0, 0, 0, 0, 999, 1, 0, 0, 0, 0, 1001, 1, 0, 0, 0, 0, 1003, 1, 0, 0, 0,
-		0, 1005, 1, 0, 0, 0, 0, 1007, 1, 0, 0, 0, 0, 1009, 1, 0, 0, 0, 0, 1011,
-		1, 0, 0, 0, 0, 1013, 1, 0, 0, 0, 0, 1015, 1, 0, 0, 0, 0, 1017, 1, 0, 0,
-		0, 0, 1019, 1, 0, 0, 0, 0, 1021, 1, 0, 0, 0, 0, 1023, 1, 0, 0, 0, 0, 1025,
-		1, 0, 0, 0, 0, 1027, 1, 0, 0, 0, 0, 1029, 1, 0, 0, 0, 0, 1031, 1, 0, 0,
-		0, 0, 1033, 1, 0, 0, 0, 0, 1035, 1, 0, 0, 0, 0, 1037, 1, 0, 0, 0, 0, 1039,
-		1, 0, 0, 0, 0, 1041, 1, 0, 0, 0, 0, 1043, 1, 0, 0, 0, 0, 1045, 1, 0, 0,
-		0, 0, 1047, 1, 0, 0, 0, 0, 1049, 1, 0, 0, 0, 0, 1051, 1, 0, 0, 0, 0, 1053,
-		1, 0, 0, 0, 0, 1055, 1, 0, 0, 0, 0, 1057, 1, 0, 0, 0, 0, 1059, 1, 0, 0,
-		0, 0, 1061, 1, 0, 0, 0, 0, 1063, 1, 0, 0, 0, 0, 1065, 1, 0, 0, 0, 0, 1067,
-		1, 0, 0, 0, 0, 1069, 1, 0, 0, 0, 0, 1071, 1, 0, 0, 0, 0, 1073, 1, 0, 0,
-		0, 0, 1075, 1, 0, 0, 0, 0, 1077, 1, 0, 0, 0, 0, 1079, 1, 0, 0, 0, 0, 1081,
-		1, 0, 0, 0, 0, 1083, 1, 0, 0, 0, 0, 1085, 1, 0, 0, 0, 0, 1087, 1, 0, 0,
-		0, 0, 1089, 1, 0, 0, 0, 0, 1091, 1, 0, 0, 0, 0, 1093, 1, 0, 0, 0, 0, 1095,
-		1, 0, 0, 0, 0, 1097, 1, 0, 0, 0, 0, 1099, 1, 0, 0, 0, 0, 1101, 1, 0, 0,
-		0, 0, 1103, 1, 0, 0, 0, 0, 1105, 1, 0, 0, 0, 0, 1107, 1, 0, 0, 0, 0, 1109,
-		1, 0, 0, 0, 0, 1111, 1, 0, 0, 0, 0, 1113, 1, 0, 0, 0, 0, 1115, 1, 0, 0,
-		0, 0, 1117, 1, 0, 0, 0, 0, 1119, 1, 0, 0, 0, 0, 1121, 1, 0, 0, 0, 0, 1123,
-		1, 0, 0, 0, 0, 1125, 1, 0, 0, 0, 0, 1127, 1, 0, 0, 0, 0, 1129, 1, 0, 0,
-		0, 0, 1131, 1, 0, 0, 0, 0, 1133, 1, 0, 0, 0, 0, 1135, 1, 0, 0, 0, 0, 1137,
-		1, 0, 0, 0, 0, 1139, 1, 0, 0, 0, 0, 1141, 1, 0, 0, 0, 0, 1143, 1, 0, 0,
-		0, 0, 1145, 1, 0, 0, 0, 0, 1147, 1, 0, 0, 0, 0, 1149, 1, 0, 0, 0, 0, 1151,
-		1, 0, 0, 0, 0, 1153, 1, 0, 0, 0, 0, 1155, 1, 0, 0, 0, 0, 1157, 1, 0, 0,
-		0, 0, 1159, 1, 0, 0, 0, 0, 1161, 1, 0, 0, 0, 0, 1163, 1, 0, 0, 0, 0, 1165,
-		1, 0, 0, 0, 0, 1167, 1, 0, 0, 0, 0, 1169, 1, 0, 0, 0, 0, 1171, 1, 0, 0,
-		0, 0, 1173, 1, 0, 0, 0, 0, 1175, 1, 0, 0, 0, 0, 1177, 1, 0, 0, 0, 0, 1179,
-		1, 0, 0, 0, 0, 1181, 1, 0, 0, 0, 0, 1183, 1, 0, 0, 0, 0, 1185, 1, 0, 0,
-		0, 0, 1187, 1, 0, 0, 0, 0, 1189, 1, 0, 0, 0, 0, 1191, 1, 0, 0, 0, 0, 1193,
-		1, 0, 0, 0, 0, 1195, 1, 0, 0, 0, 0, 1197, 1, 0, 0, 0, 0, 1199, 1, 0, 0,
-		0, 0, 1201, 1, 0, 0, 0, 0, 1203, 1, 0, 0, 0, 0, 1205, 1, 0, 0, 0, 0, 1207,
-		1, 0, 0, 0, 0, 1209, 1, 0, 0, 0, 0, 1211, 1, 0, 0, 0, 0, 1213, 1, 0, 0,
-		0, 0, 1215, 1, 0, 0, 0, 0, 1217, 1, 0, 0, 0, 0, 1219, 1, 0, 0, 0, 0, 1221,
-		1, 0, 0, 0, 0, 1223, 1, 0, 0, 0, 0, 1225, 1, 0, 0, 0, 0, 1227, 1, 0, 0,
-		0, 0, 1229, 1, 0, 0, 0, 0, 1231, 1, 0, 0, 0, 0, 1233, 1, 0, 0, 0, 0, 1235,
-		1, 0, 0, 0, 0, 1237, 1, 0, 0, 0, 0, 1239, 1, 0, 0, 0, 0, 1241, 1, 0, 0,
-		0, 0, 1243, 1, 0, 0, 0, 0, 1245, 1, 0, 0, 0, 0, 1247, 1, 0, 0, 0, 0, 1249,
-		1, 0, 0, 0, 0, 1251, 1, 0, 0, 0, 0, 1253, 1, 0, 0, 0, 0, 1255, 1, 0, 0,
-		0, 0, 1257, 1, 0, 0, 0, 0, 1259, 1, 0, 0, 0, 0, 1261, 1, 0, 0, 0, 0, 1263,
-		1, 0, 0, 0, 0, 1265, 1, 0, 0, 0, 0, 1267, 1, 0, 0, 0, 0, 1269, 1, 0, 0,
-		0, 0, 1271, 1, 0, 0, 0, 0, 1273, 1, 0, 0, 0, 0, 1275, 1, 0, 0, 0, 0, 1277,
-		1, 0, 0, 0, 0, 1279, 1, 0, 0, 0, 0, 1281, 1, 0, 0, 0, 0, 1283, 1, 0, 0,
-		0, 0, 1285, 1, 0, 0, 0, 0, 1287, 1, 0, 0, 0, 0, 1289, 1, 0, 0, 0, 0, 1291,
-		1, 0, 0, 0, 0, 1293, 1, 0, 0, 0, 0, 1295, 1, 0, 0, 0, 0, 1297, 1, 0, 0,
-		0, 0, 1305, 1, 0, 0, 0, 0, 1307, 1, 0, 0, 0, 0, 1309, 1, 0, 0, 0, 0, 1311,
-		1, 0, 0, 0, 0, 1313, 1, 0, 0, 0, 0, 1315, 1, 0, 0, 0, 0, 1317, 1, 0, 0,
-		0, 0, 1319, 1, 0, 0, 0, 0, 1321, 1, 0, 0, 0, 0, 1323, 1, 0, 0, 0, 0, 1325,
-		1, 0, 0, 0, 0, 1327, 1, 0, 0, 0, 0, 1329, 1, 0, 0, 0, 0, 1331, 1, 0, 0,
-		0, 0, 1335, 1, 0, 0, 0, 0, 1337, 1, 0, 0, 0, 0, 1339, 1, 0, 0, 0, 0, 1341,
-		1, 0, 0, 0, 0, 1343, 1, 0, 0, 0, 0, 1345, 1, 0, 0, 0, 0, 1347, 1, 0, 0,
-		0, 0, 1349, 1, 0, 0, 0, 0, 1351, 1, 0, 0, 0, 0, 1353, 1, 0, 0, 0, 0, 1355,
-		1, 0, 0, 0, 0, 1359, 1, 0, 0, 0, 0, 1361, 1, 0, 0, 0, 0, 1363, 1, 0, 0,
-		0, 0, 1365, 1, 0, 0, 0, 0, 1367, 1, 0, 0, 0, 0, 1369, 1, 0, 0, 0, 0, 1371,
-		1, 0, 0, 0, 0, 1373, 1, 0, 0, 0, 0, 1375, 1, 0, 0, 0, 0, 1377, 1, 0, 0,
-		0, 1, 1379, 1, 0, 0, 0, 1, 1381, 1, 0, 0, 0, 1, 1385, 1, 0, 0, 0, 1, 1387,
-		1, 0, 0, 0, 2, 1391, 1, 0, 0, 0, 2, 1393, 1, 0, 0, 0, 2, 1395, 1, 0, 0,
-		0, 3, 1397, 1, 0, 0, 0, 3, 1399, 1, 0, 0, 0, 3, 1401, 1, 0, 0, 0, 3, 1403,
-		1, 0, 0, 0, 4, 1405, 1, 0, 0, 0, 4, 1407, 1, 0, 0, 0, 5, 1409, 1, 0, 0,
-		0, 7, 1411, 1, 0, 0, 0, 9, 1413, 1, 0, 0, 0, 11, 1415, 1, 0, 0, 0, 13,
-		1417, 1, 0, 0, 0, 15, 1419, 1, 0, 0, 0, 17, 1421, 1, 0, 0, 0, 19, 1423,
-		1, 0, 0, 0, 21, 1425, 1, 0, 0, 0, 23, 1427, 1, 0, 0, 0, 25, 1429, 1, 0,
-		0, 0, 27, 1431, 1, 0, 0, 0, 29, 1433, 1, 0, 0, 0, 31, 1435, 1, 0, 0, 0,
-		33, 1437, 1, 0, 0, 0, 35, 1439, 1, 0, 0, 0, 37, 1441, 1, 0, 0, 0, 39, 1443,
-		1, 0, 0, 0, 41, 1446, 1, 0, 0, 0, 43, 1449, 1, 0, 0, 0, 45, 1452, 1, 0,
-		0, 0, 47, 1455, 1, 0, 0, 0, 49, 1458, 1, 0, 0, 0, 51, 1461, 1, 0, 0, 0,
-		53, 1464, 1, 0, 0, 0, 55, 1467, 1, 0, 0, 0, 57, 1470, 1, 0, 0, 0, 59, 1472,
-		1, 0, 0, 0, 61, 1498, 1, 0, 0, 0, 63, 1509, 1, 0, 0, 0, 65, 1525, 1, 0,
-		0, 0, 67, 1527, 1, 0, 0, 0, 69, 1529, 1, 0, 0, 0, 71, 1531, 1, 0, 0, 0,
-		73, 1535, 1, 0, 0, 0, 75, 1543, 1, 0, 0, 0, 77, 1551, 1, 0, 0, 0, 79, 1555,
-		1, 0, 0, 0, 81, 1559, 1, 0, 0, 0, 83, 1565, 1, 0, 0, 0, 85, 1568, 1, 0,
-		0, 0, 87, 1572, 1, 0, 0, 0, 89, 1583, 1, 0, 0, 0, 91, 1588, 1, 0, 0, 0,
-		93, 1593, 1, 0, 0, 0, 95, 1598, 1, 0, 0, 0, 97, 1604, 1, 0, 0, 0, 99, 1612,
-		1, 0, 0, 0, 101, 1619, 1, 0, 0, 0, 103, 1630, 1, 0, 0, 0, 105, 1637, 1,
-		0, 0, 0, 107, 1653, 1, 0, 0, 0, 109, 1666, 1, 0, 0, 0, 111, 1679, 1, 0,
-		0, 0, 113, 1692, 1, 0, 0, 0, 115, 1710, 1, 0, 0, 0, 117, 1723, 1, 0, 0,
-		0, 119, 1731, 1, 0, 0, 0, 121, 1742, 1, 0, 0, 0, 123, 1747, 1, 0, 0, 0,
-		125, 1756, 1, 0, 0, 0, 127, 1759, 1, 0, 0, 0, 129, 1764, 1, 0, 0, 0, 131,
-		1771, 1, 0, 0, 0, 133, 1777, 1, 0, 0, 0, 135, 1783, 1, 0, 0, 0, 137, 1787,
-		1, 0, 0, 0, 139, 1795, 1, 0, 0, 0, 141, 1800, 1, 0, 0, 0, 143, 1806, 1,
-		0, 0, 0, 145, 1812, 1, 0, 0, 0, 147, 1819, 1, 0, 0, 0, 149, 1822, 1, 0,
-		0, 0, 151, 1832, 1, 0, 0, 0, 153, 1842, 1, 0, 0, 0, 155, 1847, 1, 0, 0,
-		0, 157, 1855, 1, 0, 0, 0, 159, 1863, 1, 0, 0, 0, 161, 1869, 1, 0, 0, 0,
-		163, 1879, 1, 0, 0, 0, 165, 1894, 1, 0, 0, 0, 167, 1898, 1, 0, 0, 0, 169,
-		1903, 1, 0, 0, 0, 171, 1910, 1, 0, 0, 0, 173, 1913, 1, 0, 0, 0, 175, 1918,
-		1, 0, 0, 0, 177, 1921, 1, 0, 0, 0, 179, 1927, 1, 0, 0, 0, 181, 1935, 1,
-		0, 0, 0, 183, 1943, 1, 0, 0, 0, 185, 1954, 1, 0, 0, 0, 187, 1964, 1, 0,
-		0, 0, 189, 1971, 1, 0, 0, 0, 191, 1984, 1, 0, 0, 0, 193, 1989, 1, 0, 0,
-		0, 195, 1999, 1, 0, 0, 0, 197, 2005, 1, 0, 0, 0, 199, 2010, 1, 0, 0, 0,
-		201, 2013, 1, 0, 0, 0, 203, 2022, 1, 0, 0, 0, 205, 2027, 1, 0, 0, 0, 207,
-		2033, 1, 0, 0, 0, 209, 2040, 1, 0, 0, 0, 211, 2045, 1, 0, 0, 0, 213, 2051,
-		1, 0, 0, 0, 215, 2060, 1, 0, 0, 0, 217, 2065, 1, 0, 0, 0, 219, 2071, 1,
-		0, 0, 0, 221, 2078, 1, 0, 0, 0, 223, 2083, 1, 0, 0, 0, 225, 2097, 1, 0,
-		0, 0, 227, 2104, 1, 0, 0, 0, 229, 2114, 1, 0, 0, 0, 231, 2127, 1, 0, 0,
-		0, 233, 2133, 1, 0, 0, 0, 235, 2148, 1, 0, 0, 0, 237, 2155, 1, 0, 0, 0,
-		239, 2160, 1, 0, 0, 0, 241, 2166, 1, 0, 0, 0, 243, 2172, 1, 0, 0, 0, 245,
-		2175, 1, 0, 0, 0, 247, 2182, 1, 0, 0, 0, 249, 2187, 1, 0, 0, 0, 251, 2192,
-		1, 0, 0, 0, 253, 2197, 1, 0, 0, 0, 255, 2205, 1, 0, 0, 0, 257, 2213, 1,
-		0, 0, 0, 259, 2219, 1, 0, 0, 0, 261, 2224, 1, 0, 0, 0, 263, 2233, 1, 0,
-		0, 0, 265, 2239, 1, 0, 0, 0, 267, 2247, 1, 0, 0, 0, 269, 2255, 1, 0, 0,
-		0, 271, 2261, 1, 0, 0, 0, 273, 2270, 1, 0, 0, 0, 275, 2277, 1, 0, 0, 0,
-		277, 2284, 1, 0, 0, 0, 279, 2288, 1, 0, 0, 0, 281, 2294, 1, 0, 0, 0, 283,
-		2300, 1, 0, 0, 0, 285, 2310, 1, 0, 0, 0, 287, 2315, 1, 0, 0, 0, 289, 2321,
-		1, 0, 0, 0, 291, 2328, 1, 0, 0, 0, 293, 2338, 1, 0, 0, 0, 295, 2349, 1,
-		0, 0, 0, 297, 2352, 1, 0, 0, 0, 299, 2362, 1, 0, 0, 0, 301, 2371, 1, 0,
-		0, 0, 303, 2378, 1, 0, 0, 0, 305, 2384, 1, 0, 0, 0, 307, 2387, 1, 0, 0,
-		0, 309, 2393, 1, 0, 0, 0, 311, 2400, 1, 0, 0, 0, 313, 2408, 1, 0, 0, 0,
-		315, 2417, 1, 0, 0, 0, 317, 2425, 1, 0, 0, 0, 319, 2431, 1, 0, 0, 0, 321,
-		2447, 1, 0, 0, 0, 323, 2458, 1, 0, 0, 0, 325, 2464, 1, 0, 0, 0, 327, 2470,
-		1, 0, 0, 0, 329, 2478, 1, 0, 0, 0, 331, 2486, 1, 0, 0, 0, 333, 2495, 1,
-		0, 0, 0, 335, 2502, 1, 0, 0, 0, 337, 2512, 1, 0, 0, 0, 339, 2526, 1, 0,
-		0, 0, 341, 2537, 1, 0, 0, 0, 343, 2549, 1, 0, 0, 0, 345, 2557, 1, 0, 0,
-		0, 347, 2566, 1, 0, 0, 0, 349, 2577, 1, 0, 0, 0, 351, 2582, 1, 0, 0, 0,
-		353, 2587, 1, 0, 0, 0, 355, 2591, 1, 0, 0, 0, 357, 2598, 1, 0, 0, 0, 359,
-		2604, 1, 0, 0, 0, 361, 2609, 1, 0, 0, 0, 363, 2618, 1, 0, 0, 0, 365, 2622,
-		1, 0, 0, 0, 367, 2633, 1, 0, 0, 0, 369, 2641, 1, 0, 0, 0, 371, 2650, 1,
-		0, 0, 0, 373, 2659, 1, 0, 0, 0, 375, 2667, 1, 0, 0, 0, 377, 2674, 1, 0,
-		0, 0, 379, 2684, 1, 0, 0, 0, 381, 2695, 1, 0, 0, 0, 383, 2706, 1, 0, 0,
-		0, 385, 2714, 1, 0, 0, 0, 387, 2722, 1, 0, 0, 0, 389, 2731, 1, 0, 0, 0,
-		391, 2738, 1, 0, 0, 0, 393, 2745, 1, 0, 0, 0, 395, 2750, 1, 0, 0, 0, 397,
-		2755, 1, 0, 0, 0, 399, 2762, 1, 0, 0, 0, 401, 2771, 1, 0, 0, 0, 403, 2781,
-		1, 0, 0, 0, 405, 2786, 1, 0, 0, 0, 407, 2793, 1, 0, 0, 0, 409, 2799, 1,
-		0, 0, 0, 411, 2807, 1, 0, 0, 0, 413, 2817, 1, 0, 0, 0, 415, 2827, 1, 0,
-		0, 0, 417, 2835, 1, 0, 0, 0, 419, 2843, 1, 0, 0, 0, 421, 2853, 1, 0, 0,
-		0, 423, 2862, 1, 0, 0, 0, 425, 2869, 1, 0, 0, 0, 427, 2875, 1, 0, 0, 0,
-		429, 2885, 1, 0, 0, 0, 431, 2891, 1, 0, 0, 0, 433, 2899, 1, 0, 0, 0, 435,
-		2908, 1, 0, 0, 0, 437, 2918, 1, 0, 0, 0, 439, 2925, 1, 0, 0, 0, 441, 2933,
-		1, 0, 0, 0, 443, 2941, 1, 0, 0, 0, 445, 2948, 1, 0, 0, 0, 447, 2953, 1,
-		0, 0, 0, 449, 2958, 1, 0, 0, 0, 451, 2967, 1, 0, 0, 0, 453, 2970, 1, 0,
-		0, 0, 455, 2980, 1, 0, 0, 0, 457, 2990, 1, 0, 0, 0, 459, 2999, 1, 0, 0,
-		0, 461, 3009, 1, 0, 0, 0, 463, 3019, 1, 0, 0, 0, 465, 3025, 1, 0, 0, 0,
-		467, 3033, 1, 0, 0, 0, 469, 3041, 1, 0, 0, 0, 471, 3050, 1, 0, 0, 0, 473,
-		3057, 1, 0, 0, 0, 475, 3069, 1, 0, 0, 0, 477, 3076, 1, 0, 0, 0, 479, 3084,
-		1, 0, 0, 0, 481, 3092, 1, 0, 0, 0, 483, 3102, 1, 0, 0, 0, 485, 3106, 1,
-		0, 0, 0, 487, 3112, 1, 0, 0, 0, 489, 3121, 1, 0, 0, 0, 491, 3127, 1, 0,
-		0, 0, 493, 3132, 1, 0, 0, 0, 495, 3142, 1, 0, 0, 0, 497, 3148, 1, 0, 0,
-		0, 499, 3155, 1, 0, 0, 0, 501, 3160, 1, 0, 0, 0, 503, 3166, 1, 0, 0, 0,
-		505, 3175, 1, 0, 0, 0, 507, 3180, 1, 0, 0, 0, 509, 3188, 1, 0, 0, 0, 511,
-		3194, 1, 0, 0, 0, 513, 3202, 1, 0, 0, 0, 515, 3215, 1, 0, 0, 0, 517, 3224,
-		1, 0, 0, 0, 519, 3230, 1, 0, 0, 0, 521, 3237, 1, 0, 0, 0, 523, 3246, 1,
-		0, 0, 0, 525, 3251, 1, 0, 0, 0, 527, 3257, 1, 0, 0, 0, 529, 3262, 1, 0,
-		0, 0, 531, 3267, 1, 0, 0, 0, 533, 3273, 1, 0, 0, 0, 535, 3278, 1, 0, 0,
-		0, 537, 3281, 1, 0, 0, 0, 539, 3289, 1, 0, 0, 0, 541, 3296, 1, 0, 0, 0,
-		543, 3303, 1, 0, 0, 0, 545, 3309, 1, 0, 0, 0, 547, 3316, 1, 0, 0, 0, 549,
-		3319, 1, 0, 0, 0, 551, 3323, 1, 0, 0, 0, 553, 3328, 1, 0, 0, 0, 555, 3337,
-		1, 0, 0, 0, 557, 3344, 1, 0, 0, 0, 559, 3352, 1, 0, 0, 0, 561, 3358, 1,
-		0, 0, 0, 563, 3364, 1, 0, 0, 0, 565, 3371, 1, 0, 0, 0, 567, 3379, 1, 0,
-		0, 0, 569, 3389, 1, 0, 0, 0, 571, 3397, 1, 0, 0, 0, 573, 3406, 1, 0, 0,
-		0, 575, 3412, 1, 0, 0, 0, 577, 3422, 1, 0, 0, 0, 579, 3430, 1, 0, 0, 0,
-		581, 3439, 1, 0, 0, 0, 583, 3448, 1, 0, 0, 0, 585, 3454, 1, 0, 0, 0, 587,
-		3465, 1, 0, 0, 0, 589, 3476, 1, 0, 0, 0, 591, 3486, 1, 0, 0, 0, 593, 3494,
-		1, 0, 0, 0, 595, 3500, 1, 0, 0, 0, 597, 3506, 1, 0, 0, 0, 599, 3511, 1,
-		0, 0, 0, 601, 3520, 1, 0, 0, 0, 603, 3528, 1, 0, 0, 0, 605, 3538, 1, 0,
-		0, 0, 607, 3542, 1, 0, 0, 0, 609, 3550, 1, 0, 0, 0, 611, 3558, 1, 0, 0,
-		0, 613, 3567, 1, 0, 0, 0, 615, 3575, 1, 0, 0, 0, 617, 3582, 1, 0, 0, 0,
-		619, 3593, 1, 0, 0, 0, 621, 3601, 1, 0, 0, 0, 623, 3609, 1, 0, 0, 0, 625,
-		3615, 1, 0, 0, 0, 627, 3623, 1, 0, 0, 0, 629, 3632, 1, 0, 0, 0, 631, 3640,
-		1, 0, 0, 0, 633, 3647, 1, 0, 0, 0, 635, 3652, 1, 0, 0, 0, 637, 3661, 1,
-		0, 0, 0, 639, 3666, 1, 0, 0, 0, 641, 3671, 1, 0, 0, 0, 643, 3681, 1, 0,
-		0, 0, 645, 3688, 1, 0, 0, 0, 647, 3695, 1, 0, 0, 0, 649, 3702, 1, 0, 0,
-		0, 651, 3709, 1, 0, 0, 0, 653, 3718, 1, 0, 0, 0, 655, 3727, 1, 0, 0, 0,
-		657, 3737, 1, 0, 0, 0, 659, 3750, 1, 0, 0, 0, 661, 3757, 1, 0, 0, 0, 663,
-		3765, 1, 0, 0, 0, 665, 3769, 1, 0, 0, 0, 667, 3775, 1, 0, 0, 0, 669, 3780,
-		1, 0, 0, 0, 671, 3787, 1, 0, 0, 0, 673, 3796, 1, 0, 0, 0, 675, 3803, 1,
-		0, 0, 0, 677, 3814, 1, 0, 0, 0, 679, 3820, 1, 0, 0, 0, 681, 3830, 1, 0,
-		0, 0, 683, 3841, 1, 0, 0, 0, 685, 3847, 1, 0, 0, 0, 687, 3854, 1, 0, 0,
-		0, 689, 3862, 1, 0, 0, 0, 691, 3869, 1, 0, 0, 0, 693, 3875, 1, 0, 0, 0,
-		695, 3881, 1, 0, 0, 0, 697, 3888, 1, 0, 0, 0, 699, 3895, 1, 0, 0, 0, 701,
-		3906, 1, 0, 0, 0, 703, 3911, 1, 0, 0, 0, 705, 3920, 1, 0, 0, 0, 707, 3930,
-		1, 0, 0, 0, 709, 3935, 1, 0, 0, 0, 711, 3947, 1, 0, 0, 0, 713, 3955, 1,
-		0, 0, 0, 715, 3964, 1, 0, 0, 0, 717, 3972, 1, 0, 0, 0, 719, 3977, 1, 0,
-		0, 0, 721, 3983, 1, 0, 0, 0, 723, 3993, 1, 0, 0, 0, 725, 4005, 1, 0, 0,
-		0, 727, 4017, 1, 0, 0, 0, 729, 4025, 1, 0, 0, 0, 731, 4034, 1, 0, 0, 0,
-		733, 4043, 1, 0, 0, 0, 735, 4049, 1, 0, 0, 0, 737, 4056, 1, 0, 0, 0, 739,
-		4063, 1, 0, 0, 0, 741, 4069, 1, 0, 0, 0, 743, 4078, 1, 0, 0, 0, 745, 4088,
-		1, 0, 0, 0, 747, 4096, 1, 0, 0, 0, 749, 4104, 1, 0, 0, 0, 751, 4109, 1,
-		0, 0, 0, 753, 4118, 1, 0, 0, 0, 755, 4129, 1, 0, 0, 0, 757, 4137, 1, 0,
-		0, 0, 759, 4142, 1, 0, 0, 0, 761, 4150, 1, 0, 0, 0, 763, 4156, 1, 0, 0,
-		0, 765, 4160, 1, 0, 0, 0, 767, 4165, 1, 0, 0, 0, 769, 4169, 1, 0, 0, 0,
-		771, 4174, 1, 0, 0, 0, 773, 4181, 1, 0, 0, 0, 775, 4189, 1, 0, 0, 0, 777,
-		4196, 1, 0, 0, 0, 779, 4200, 1, 0, 0, 0, 781, 4208, 1, 0, 0, 0, 783, 4213,
-		1, 0, 0, 0, 785, 4223, 1, 0, 0, 0, 787, 4232, 1, 0, 0, 0, 789, 4236, 1,
-		0, 0, 0, 791, 4244, 1, 0, 0, 0, 793, 4251, 1, 0, 0, 0, 795, 4259, 1, 0,
-		0, 0, 797, 4265, 1, 0, 0, 0, 799, 4274, 1, 0, 0, 0, 801, 4280, 1, 0, 0,
-		0, 803, 4284, 1, 0, 0, 0, 805, 4292, 1, 0, 0, 0, 807, 4301, 1, 0, 0, 0,
-		809, 4307, 1, 0, 0, 0, 811, 4316, 1, 0, 0, 0, 813, 4322, 1, 0, 0, 0, 815,
-		4327, 1, 0, 0, 0, 817, 4334, 1, 0, 0, 0, 819, 4342, 1, 0, 0, 0, 821, 4350,
-		1, 0, 0, 0, 823, 4360, 1, 0, 0, 0, 825, 4369, 1, 0, 0, 0, 827, 4379, 1,
-		0, 0, 0, 829, 4384, 1, 0, 0, 0, 831, 4388, 1, 0, 0, 0, 833, 4394, 1, 0,
-		0, 0, 835, 4403, 1, 0, 0, 0, 837, 4413, 1, 0, 0, 0, 839, 4418, 1, 0, 0,
-		0, 841, 4428, 1, 0, 0, 0, 843, 4434, 1, 0, 0, 0, 845, 4439, 1, 0, 0, 0,
-		847, 4446, 1, 0, 0, 0, 849, 4454, 1, 0, 0, 0, 851, 4468, 1, 0, 0, 0, 853,
-		4479, 1, 0, 0, 0, 855, 4486, 1, 0, 0, 0, 857, 4505, 1, 0, 0, 0, 859, 4533,
-		1, 0, 0, 0, 861, 4560, 1, 0, 0, 0, 863, 4566, 1, 0, 0, 0, 865, 4579, 1,
-		0, 0, 0, 867, 4589, 1, 0, 0, 0, 869, 4600, 1, 0, 0, 0, 871, 4610, 1, 0,
-		0, 0, 873, 4620, 1, 0, 0, 0, 875, 4629, 1, 0, 0, 0, 877, 4635, 1, 0, 0,
-		0, 879, 4643, 1, 0, 0, 0, 881, 4656, 1, 0, 0, 0, 883, 4661, 1, 0, 0, 0,
-		885, 4669, 1, 0, 0, 0, 887, 4676, 1, 0, 0, 0, 889, 4683, 1, 0, 0, 0, 891,
-		4694, 1, 0, 0, 0, 893, 4704, 1, 0, 0, 0, 895, 4711, 1, 0, 0, 0, 897, 4718,
-		1, 0, 0, 0, 899, 4726, 1, 0, 0, 0, 901, 4734, 1, 0, 0, 0, 903, 4744, 1,
-		0, 0, 0, 905, 4751, 1, 0, 0, 0, 907, 4758, 1, 0, 0, 0, 909, 4765, 1, 0,
-		0, 0, 911, 4777, 1, 0, 0, 0, 913, 4781, 1, 0, 0, 0, 915, 4785, 1, 0, 0,
-		0, 917, 4791, 1, 0, 0, 0, 919, 4804, 1, 0, 0, 0, 921, 4816, 1, 0, 0, 0,
-		923, 4820, 1, 0, 0, 0, 925, 4824, 1, 0, 0, 0, 927, 4833, 1, 0, 0, 0, 929,
-		4841, 1, 0, 0, 0, 931, 4852, 1, 0, 0, 0, 933, 4858, 1, 0, 0, 0, 935, 4866,
-		1, 0, 0, 0, 937, 4875, 1, 0, 0, 0, 939, 4879, 1, 0, 0, 0, 941, 4887, 1,
-		0, 0, 0, 943, 4898, 1, 0, 0, 0, 945, 4907, 1, 0, 0, 0, 947, 4912, 1, 0,
-		0, 0, 949, 4919, 1, 0, 0, 0, 951, 4924, 1, 0, 0, 0, 953, 4931, 1, 0, 0,
-		0, 955, 4936, 1, 0, 0, 0, 957, 4945, 1, 0, 0, 0, 959, 4950, 1, 0, 0, 0,
-		961, 4962, 1, 0, 0, 0, 963, 4973, 1, 0, 0, 0, 965, 4982, 1, 0, 0, 0, 967,
-		4990, 1, 0, 0, 0, 969, 5004, 1, 0, 0, 0, 971, 5012, 1, 0, 0, 0, 973, 5023,
-		1, 0, 0, 0, 975, 5030, 1, 0, 0, 0, 977, 5037, 1, 0, 0, 0, 979, 5044, 1,
-		0, 0, 0, 981, 5051, 1, 0, 0, 0, 983, 5055, 1, 0, 0, 0, 985, 5059, 1, 0,
-		0, 0, 987, 5064, 1, 0, 0, 0, 989, 5069, 1, 0, 0, 0, 991, 5077, 1, 0, 0,
-		0, 993, 5083, 1, 0, 0, 0, 995, 5093, 1, 0, 0, 0, 997, 5098, 1, 0, 0, 0,
-		999, 5118, 1, 0, 0, 0, 1001, 5136, 1, 0, 0, 0, 1003, 5142, 1, 0, 0, 0,
-		1005, 5155, 1, 0, 0, 0, 1007, 5166, 1, 0, 0, 0, 1009, 5172, 1, 0, 0, 0,
-		1011, 5181, 1, 0, 0, 0, 1013, 5189, 1, 0, 0, 0, 1015, 5193, 1, 0, 0, 0,
-		1017, 5205, 1, 0, 0, 0, 1019, 5213, 1, 0, 0, 0, 1021, 5219, 1, 0, 0, 0,
-		1023, 5225, 1, 0, 0, 0, 1025, 5233, 1, 0, 0, 0, 1027, 5241, 1, 0, 0, 0,
-		1029, 5247, 1, 0, 0, 0, 1031, 5252, 1, 0, 0, 0, 1033, 5259, 1, 0, 0, 0,
-		1035, 5265, 1, 0, 0, 0, 1037, 5271, 1, 0, 0, 0, 1039, 5280, 1, 0, 0, 0,
-		1041, 5286, 1, 0, 0, 0, 1043, 5290, 1, 0, 0, 0, 1045, 5295, 1, 0, 0, 0,
-		1047, 5302, 1, 0, 0, 0, 1049, 5310, 1, 0, 0, 0, 1051, 5320, 1, 0, 0, 0,
-		1053, 5327, 1, 0, 0, 0, 1055, 5332, 1, 0, 0, 0, 1057, 5337, 1, 0, 0, 0,
-		1059, 5341, 1, 0, 0, 0, 1061, 5346, 1, 0, 0, 0, 1063, 5351, 1, 0, 0, 0,
-		1065, 5359, 1, 0, 0, 0, 1067, 5367, 1, 0, 0, 0, 1069, 5371, 1, 0, 0, 0,
-		1071, 5375, 1, 0, 0, 0, 1073, 5385, 1, 0, 0, 0, 1075, 5391, 1, 0, 0, 0,
-		1077, 5395, 1, 0, 0, 0, 1079, 5399, 1, 0, 0, 0, 1081, 5402, 1, 0, 0, 0,
-		1083, 5408, 1, 0, 0, 0, 1085, 5418, 1, 0, 0, 0, 1087, 5422, 1, 0, 0, 0,
-		1089, 5425, 1, 0, 0, 0, 1091, 5431, 1, 0, 0, 0, 1093, 5439, 1, 0, 0, 0,
-		1095, 5445, 1, 0, 0, 0, 1097, 5451, 1, 0, 0, 0, 1099, 5456, 1, 0, 0, 0,
-		1101, 5461, 1, 0, 0, 0, 1103, 5472, 1, 0, 0, 0, 1105, 5478, 1, 0, 0, 0,
-		1107, 5491, 1, 0, 0, 0, 1109, 5498, 1, 0, 0, 0, 1111, 5506, 1, 0, 0, 0,
-		1113, 5511, 1, 0, 0, 0, 1115, 5517, 1, 0, 0, 0, 1117, 5522, 1, 0, 0, 0,
-		1119, 5528, 1, 0, 0, 0, 1121, 5533, 1, 0, 0, 0, 1123, 5539, 1, 0, 0, 0,
-		1125, 5545, 1, 0, 0, 0, 1127, 5552, 1, 0, 0, 0, 1129, 5556, 1, 0, 0, 0,
-		1131, 5561, 1, 0, 0, 0, 1133, 5565, 1, 0, 0, 0, 1135, 5570, 1, 0, 0, 0,
-		1137, 5574, 1, 0, 0, 0, 1139, 5579, 1, 0, 0, 0, 1141, 5583, 1, 0, 0, 0,
-		1143, 5588, 1, 0, 0, 0, 1145, 5593, 1, 0, 0, 0, 1147, 5598, 1, 0, 0, 0,
-		1149, 5603, 1, 0, 0, 0, 1151, 5609, 1, 0, 0, 0, 1153, 5615, 1, 0, 0, 0,
-		1155, 5621, 1, 0, 0, 0, 1157, 5632, 1, 0, 0, 0, 1159, 5644, 1, 0, 0, 0,
-		1161, 5661, 1, 0, 0, 0, 1163, 5667, 1, 0, 0, 0, 1165, 5680, 1, 0, 0, 0,
-		1167, 5686, 1, 0, 0, 0, 1169, 5692, 1, 0, 0, 0, 1171, 5698, 1, 0, 0, 0,
-		1173, 5702, 1, 0, 0, 0, 1175, 5709, 1, 0, 0, 0, 1177, 5719, 1, 0, 0, 0,
-		1179, 5726, 1, 0, 0, 0, 1181, 5734, 1, 0, 0, 0, 1183, 5741, 1, 0, 0, 0,
-		1185, 5746, 1, 0, 0, 0, 1187, 5752, 1, 0, 0, 0, 1189, 5756, 1, 0, 0, 0,
-		1191, 5768, 1, 0, 0, 0, 1193, 5787, 1, 0, 0, 0, 1195, 5799, 1, 0, 0, 0,
-		1197, 5813, 1, 0, 0, 0, 1199, 5828, 1, 0, 0, 0, 1201, 5841, 1, 0, 0, 0,
-		1203, 5854, 1, 0, 0, 0, 1205, 5866, 1, 0, 0, 0, 1207, 5879, 1, 0, 0, 0,
-		1209, 5894, 1, 0, 0, 0, 1211, 5909, 1, 0, 0, 0, 1213, 5931, 1, 0, 0, 0,
-		1215, 5953, 1, 0, 0, 0, 1217, 5967, 1, 0, 0, 0, 1219, 5974, 1, 0, 0, 0,
-		1221, 5979, 1, 0, 0, 0, 1223, 5985, 1, 0, 0, 0, 1225, 5996, 1, 0, 0, 0,
-		1227, 6008, 1, 0, 0, 0, 1229, 6024, 1, 0, 0, 0, 1231, 6040, 1, 0, 0, 0,
-		1233, 6047, 1, 0, 0, 0, 1235, 6054, 1, 0, 0, 0, 1237, 6063, 1, 0, 0, 0,
-		1239, 6070, 1, 0, 0, 0, 1241, 6080, 1, 0, 0, 0, 1243, 6087, 1, 0, 0, 0,
-		1245, 6091, 1, 0, 0, 0, 1247, 6107, 1, 0, 0, 0, 1249, 6116, 1, 0, 0, 0,
-		1251, 6126, 1, 0, 0, 0, 1253, 6137, 1, 0, 0, 0, 1255, 6146, 1, 0, 0, 0,
-		1257, 6159, 1, 0, 0, 0, 1259, 6173, 1, 0, 0, 0, 1261, 6190, 1, 0, 0, 0,
-		1263, 6200, 1, 0, 0, 0, 1265, 6214, 1, 0, 0, 0, 1267, 6224, 1, 0, 0, 0,
-		1269, 6239, 1, 0, 0, 0, 1271, 6256, 1, 0, 0, 0, 1273, 6260, 1, 0, 0, 0,
-		1275, 6280, 1, 0, 0, 0, 1277, 6290, 1, 0, 0, 0, 1279, 6312, 1, 0, 0, 0,
-		1281, 6325, 1, 0, 0, 0, 1283, 6333, 1, 0, 0, 0, 1285, 6341, 1, 0, 0, 0,
-		1287, 6351, 1, 0, 0, 0, 1289, 6358, 1, 0, 0, 0, 1291, 6366, 1, 0, 0, 0,
-		1293, 6374, 1, 0, 0, 0, 1295, 6389, 1, 0, 0, 0, 1297, 6406, 1, 0, 0, 0,
-		1299, 6419, 1, 0, 0, 0, 1301, 6423, 1, 0, 0, 0, 1303, 6427, 1, 0, 0, 0,
-		1305, 6429, 1, 0, 0, 0, 1307, 6432, 1, 0, 0, 0, 1309, 6441, 1, 0, 0, 0,
-		1311, 6444, 1, 0, 0, 0, 1313, 6453, 1, 0, 0, 0, 1315, 6457, 1, 0, 0, 0,
-		1317, 6461, 1, 0, 0, 0, 1319, 6465, 1, 0, 0, 0, 1321, 6469, 1, 0, 0, 0,
-		1323, 6472, 1, 0, 0, 0, 1325, 6481, 1, 0, 0, 0, 1327, 6487, 1, 0, 0, 0,
-		1329, 6490, 1, 0, 0, 0, 1331, 6494, 1, 0, 0, 0, 1333, 6503, 1, 0, 0, 0,
-		1335, 6510, 1, 0, 0, 0, 1337, 6513, 1, 0, 0, 0, 1339, 6521, 1, 0, 0, 0,
-		1341, 6524, 1, 0, 0, 0, 1343, 6527, 1, 0, 0, 0, 1345, 6530, 1, 0, 0, 0,
-		1347, 6538, 1, 0, 0, 0, 1349, 6541, 1, 0, 0, 0, 1351, 6544, 1, 0, 0, 0,
-		1353, 6546, 1, 0, 0, 0, 1355, 6580, 1, 0, 0, 0, 1357, 6583, 1, 0, 0, 0,
-		1359, 6587, 1, 0, 0, 0, 1361, 6595, 1, 0, 0, 0, 1363, 6610, 1, 0, 0, 0,
-		1365, 6619, 1, 0, 0, 0, 1367, 6623, 1, 0, 0, 0, 1369, 6634, 1, 0, 0, 0,
-		1371, 6673, 1, 0, 0, 0, 1373, 6724, 1, 0, 0, 0, 1375, 6748, 1, 0, 0, 0,
-		1377, 6751, 1, 0, 0, 0, 1379, 6753, 1, 0, 0, 0, 1381, 6758, 1, 0, 0, 0,
-		1383, 6789, 1, 0, 0, 0, 1385, 6792, 1, 0, 0, 0, 1387, 6797, 1, 0, 0, 0,
-		1389, 6810, 1, 0, 0, 0, 1391, 6813, 1, 0, 0, 0, 1393, 6818, 1, 0, 0, 0,
-		1395, 6824, 1, 0, 0, 0, 1397, 6829, 1, 0, 0, 0, 1399, 6834, 1, 0, 0, 0,
-		1401, 6839, 1, 0, 0, 0, 1403, 6844, 1, 0, 0, 0, 1405, 6861, 1, 0, 0, 0,
-		1407, 6863, 1, 0, 0, 0, 1409, 1410, 5, 36, 0, 0, 1410, 6, 1, 0, 0, 0, 1411,
-		1412, 5, 40, 0, 0, 1412, 8, 1, 0, 0, 0, 1413, 1414, 5, 41, 0, 0, 1414,
-		10, 1, 0, 0, 0, 1415, 1416, 5, 91, 0, 0, 1416, 12, 1, 0, 0, 0, 1417, 1418,
-		5, 93, 0, 0, 1418, 14, 1, 0, 0, 0, 1419, 1420, 5, 44, 0, 0, 1420, 16, 1,
-		0, 0, 0, 1421, 1422, 5, 59, 0, 0, 1422, 18, 1, 0, 0, 0, 1423, 1424, 5,
-		58, 0, 0, 1424, 20, 1, 0, 0, 0, 1425, 1426, 5, 42, 0, 0, 1426, 22, 1, 0,
-		0, 0, 1427, 1428, 5, 61, 0, 0, 1428, 24, 1, 0, 0, 0, 1429, 1430, 5, 46,
-		0, 0, 1430, 26, 1, 0, 0, 0, 1431, 1432, 5, 43, 0, 0, 1432, 28, 1, 0, 0,
-		0, 1433, 1434, 5, 45, 0, 0, 1434, 30, 1, 0, 0, 0, 1435, 1436, 5, 47, 0,
-		0, 1436, 32, 1, 0, 0, 0, 1437, 1438, 5, 94, 0, 0, 1438, 34, 1, 0, 0, 0,
-		1439, 1440, 5, 60, 0, 0, 1440, 36, 1, 0, 0, 0, 1441, 1442, 5, 62, 0, 0,
-		1442, 38, 1, 0, 0, 0, 1443, 1444, 5, 60, 0, 0, 1444, 1445, 5, 60, 0, 0,
-		1445, 40, 1, 0, 0, 0, 1446, 1447, 5, 62, 0, 0, 1447, 1448, 5, 62, 0, 0,
-		1448, 42, 1, 0, 0, 0, 1449, 1450, 5, 58, 0, 0, 1450, 1451, 5, 61, 0, 0,
-		1451, 44, 1, 0, 0, 0, 1452, 1453, 5, 60, 0, 0, 1453, 1454, 5, 61, 0, 0,
-		1454, 46, 1, 0, 0, 0, 1455, 1456, 5, 61, 0, 0, 1456, 1457, 5, 62, 0, 0,
-		1457, 48, 1, 0, 0, 0, 1458, 1459, 5, 62, 0, 0, 1459, 1460, 5, 61, 0, 0,
-		1460, 50, 1, 0, 0, 0, 1461, 1462, 5, 46, 0, 0, 1462, 1463, 5, 46, 0, 0,
-		1463, 52, 1, 0, 0, 0, 1464, 1465, 5, 60, 0, 0, 1465, 1466, 5, 62, 0, 0,
-		1466, 54, 1, 0, 0, 0, 1467, 1468, 5, 58, 0, 0, 1468, 1469, 5, 58, 0, 0,
-		1469, 56, 1, 0, 0, 0, 1470, 1471, 5, 37, 0, 0, 1471, 58, 1, 0, 0, 0, 1472,
-		1474, 5, 36, 0, 0, 1473, 1475, 7, 0, 0, 0, 1474, 1473, 1, 0, 0, 0, 1475,
-		1476, 1, 0, 0, 0, 1476, 1474, 1, 0, 0, 0, 1476, 1477, 1, 0, 0, 0, 1477,
-		60, 1, 0, 0, 0, 1478, 1494, 3, 65, 30, 0, 1479, 1483, 5, 43, 0, 0, 1480,
-		1481, 5, 45, 0, 0, 1481, 1483, 4, 28, 0, 0, 1482, 1479, 1, 0, 0, 0, 1482,
-		1480, 1, 0, 0, 0, 1483, 1484, 1, 0, 0, 0, 1484, 1482, 1, 0, 0, 0, 1484,
-		1485, 1, 0, 0, 0, 1485, 1489, 1, 0, 0, 0, 1486, 1490, 3, 65, 30, 0, 1487,
-		1488, 5, 47, 0, 0, 1488, 1490, 4, 28, 1, 0, 1489, 1486, 1, 0, 0, 0, 1489,
-		1487, 1, 0, 0, 0, 1490, 1494, 1, 0, 0, 0, 1491, 1492, 5, 47, 0, 0, 1492,
-		1494, 4, 28, 2, 0, 1493, 1478, 1, 0, 0, 0, 1493, 1482, 1, 0, 0, 0, 1493,
-		1491, 1, 0, 0, 0, 1494, 1495, 1, 0, 0, 0, 1495, 1493, 1, 0, 0, 0, 1495,
-		1496, 1, 0, 0, 0, 1496, 1499, 1, 0, 0, 0, 1497, 1499, 7, 1, 0, 0, 1498,
-		1493, 1, 0, 0, 0, 1498, 1497, 1, 0, 0, 0, 1499, 1500, 1, 0, 0, 0, 1500,
-		1501, 6, 28, 0, 0, 1501, 62, 1, 0, 0, 0, 1502, 1508, 3, 67, 31, 0, 1503,
-		1504, 5, 45, 0, 0, 1504, 1508, 4, 29, 3, 0, 1505, 1506, 5, 47, 0, 0, 1506,
-		1508, 4, 29, 4, 0, 1507, 1502, 1, 0, 0, 0, 1507, 1503, 1, 0, 0, 0, 1507,
-		1505, 1, 0, 0, 0, 1508, 1511, 1, 0, 0, 0, 1509, 1507, 1, 0, 0, 0, 1509,
-		1510, 1, 0, 0, 0, 1510, 1512, 1, 0, 0, 0, 1511, 1509, 1, 0, 0, 0, 1512,
-		1514, 3, 69, 32, 0, 1513, 1515, 3, 61, 28, 0, 1514, 1513, 1, 0, 0, 0, 1514,
-		1515, 1, 0, 0, 0, 1515, 1519, 1, 0, 0, 0, 1516, 1520, 5, 43, 0, 0, 1517,
-		1518, 5, 45, 0, 0, 1518, 1520, 4, 29, 5, 0, 1519, 1516, 1, 0, 0, 0, 1519,
-		1517, 1, 0, 0, 0, 1520, 1521, 1, 0, 0, 0, 1521, 1519, 1, 0, 0, 0, 1521,
-		1522, 1, 0, 0, 0, 1522, 1523, 1, 0, 0, 0, 1523, 1524, 6, 29, 1, 0, 1524,
-		64, 1, 0, 0, 0, 1525, 1526, 7, 2, 0, 0, 1526, 66, 1, 0, 0, 0, 1527, 1528,
-		7, 3, 0, 0, 1528, 68, 1, 0, 0, 0, 1529, 1530, 7, 4, 0, 0, 1530, 70, 1,
-		0, 0, 0, 1531, 1532, 7, 5, 0, 0, 1532, 1533, 7, 6, 0, 0, 1533, 1534, 7,
-		6, 0, 0, 1534, 72, 1, 0, 0, 0, 1535, 1536, 7, 5, 0, 0, 1536, 1537, 7, 7,
-		0, 0, 1537, 1538, 7, 5, 0, 0, 1538, 1539, 7, 6, 0, 0, 1539, 1540, 7, 8,
-		0, 0, 1540, 1541, 7, 9, 0, 0, 1541, 1542, 7, 10, 0, 0, 1542, 74, 1, 0,
-		0, 0, 1543, 1544, 7, 5, 0, 0, 1544, 1545, 7, 7, 0, 0, 1545, 1546, 7, 5,
-		0, 0, 1546, 1547, 7, 6, 0, 0, 1547, 1548, 7, 8, 0, 0, 1548, 1549, 7, 11,
-		0, 0, 1549, 1550, 7, 10, 0, 0, 1550, 76, 1, 0, 0, 0, 1551, 1552, 7, 5,
-		0, 0, 1552, 1553, 7, 7, 0, 0, 1553, 1554, 7, 12, 0, 0, 1554, 78, 1, 0,
-		0, 0, 1555, 1556, 7, 5, 0, 0, 1556, 1557, 7, 7, 0, 0, 1557, 1558, 7, 8,
-		0, 0, 1558, 80, 1, 0, 0, 0, 1559, 1560, 7, 5, 0, 0, 1560, 1561, 7, 13,
-		0, 0, 1561, 1562, 7, 13, 0, 0, 1562, 1563, 7, 5, 0, 0, 1563, 1564, 7, 8,
-		0, 0, 1564, 82, 1, 0, 0, 0, 1565, 1566, 7, 5, 0, 0, 1566, 1567, 7, 9, 0,
-		0, 1567, 84, 1, 0, 0, 0, 1568, 1569, 7, 5, 0, 0, 1569, 1570, 7, 9, 0, 0,
-		1570, 1571, 7, 14, 0, 0, 1571, 86, 1, 0, 0, 0, 1572, 1573, 7, 5, 0, 0,
-		1573, 1574, 7, 9, 0, 0, 1574, 1575, 7, 8, 0, 0, 1575, 1576, 7, 15, 0, 0,
-		1576, 1577, 7, 15, 0, 0, 1577, 1578, 7, 10, 0, 0, 1578, 1579, 7, 16, 0,
-		0, 1579, 1580, 7, 13, 0, 0, 1580, 1581, 7, 17, 0, 0, 1581, 1582, 7, 14,
-		0, 0, 1582, 88, 1, 0, 0, 0, 1583, 1584, 7, 18, 0, 0, 1584, 1585, 7, 19,
-		0, 0, 1585, 1586, 7, 16, 0, 0, 1586, 1587, 7, 20, 0, 0, 1587, 90, 1, 0,
-		0, 0, 1588, 1589, 7, 14, 0, 0, 1589, 1590, 7, 5, 0, 0, 1590, 1591, 7, 9,
-		0, 0, 1591, 1592, 7, 10, 0, 0, 1592, 92, 1, 0, 0, 0, 1593, 1594, 7, 14,
-		0, 0, 1594, 1595, 7, 5, 0, 0, 1595, 1596, 7, 9, 0, 0, 1596, 1597, 7, 16,
-		0, 0, 1597, 94, 1, 0, 0, 0, 1598, 1599, 7, 14, 0, 0, 1599, 1600, 7, 20,
-		0, 0, 1600, 1601, 7, 10, 0, 0, 1601, 1602, 7, 14, 0, 0, 1602, 1603, 7,
-		21, 0, 0, 1603, 96, 1, 0, 0, 0, 1604, 1605, 7, 14, 0, 0, 1605, 1606, 7,
-		19, 0, 0, 1606, 1607, 7, 6, 0, 0, 1607, 1608, 7, 6, 0, 0, 1608, 1609, 7,
-		5, 0, 0, 1609, 1610, 7, 16, 0, 0, 1610, 1611, 7, 10, 0, 0, 1611, 98, 1,
-		0, 0, 0, 1612, 1613, 7, 14, 0, 0, 1613, 1614, 7, 19, 0, 0, 1614, 1615,
-		7, 6, 0, 0, 1615, 1616, 7, 22, 0, 0, 1616, 1617, 7, 15, 0, 0, 1617, 1618,
-		7, 7, 0, 0, 1618, 100, 1, 0, 0, 0, 1619, 1620, 7, 14, 0, 0, 1620, 1621,
-		7, 19, 0, 0, 1621, 1622, 7, 7, 0, 0, 1622, 1623, 7, 9, 0, 0, 1623, 1624,
-		7, 16, 0, 0, 1624, 1625, 7, 13, 0, 0, 1625, 1626, 7, 5, 0, 0, 1626, 1627,
-		7, 17, 0, 0, 1627, 1628, 7, 7, 0, 0, 1628, 1629, 7, 16, 0, 0, 1629, 102,
-		1, 0, 0, 0, 1630, 1631, 7, 14, 0, 0, 1631, 1632, 7, 13, 0, 0, 1632, 1633,
-		7, 10, 0, 0, 1633, 1634, 7, 5, 0, 0, 1634, 1635, 7, 16, 0, 0, 1635, 1636,
-		7, 10, 0, 0, 1636, 104, 1, 0, 0, 0, 1637, 1638, 7, 14, 0, 0, 1638, 1639,
-		7, 22, 0, 0, 1639, 1640, 7, 13, 0, 0, 1640, 1641, 7, 13, 0, 0, 1641, 1642,
-		7, 10, 0, 0, 1642, 1643, 7, 7, 0, 0, 1643, 1644, 7, 16, 0, 0, 1644, 1645,
-		5, 95, 0, 0, 1645, 1646, 7, 14, 0, 0, 1646, 1647, 7, 5, 0, 0, 1647, 1648,
-		7, 16, 0, 0, 1648, 1649, 7, 5, 0, 0, 1649, 1650, 7, 6, 0, 0, 1650, 1651,
-		7, 19, 0, 0, 1651, 1652, 7, 23, 0, 0, 1652, 106, 1, 0, 0, 0, 1653, 1654,
-		7, 14, 0, 0, 1654, 1655, 7, 22, 0, 0, 1655, 1656, 7, 13, 0, 0, 1656, 1657,
-		7, 13, 0, 0, 1657, 1658, 7, 10, 0, 0, 1658, 1659, 7, 7, 0, 0, 1659, 1660,
-		7, 16, 0, 0, 1660, 1661, 5, 95, 0, 0, 1661, 1662, 7, 12, 0, 0, 1662, 1663,
-		7, 5, 0, 0, 1663, 1664, 7, 16, 0, 0, 1664, 1665, 7, 10, 0, 0, 1665, 108,
-		1, 0, 0, 0, 1666, 1667, 7, 14, 0, 0, 1667, 1668, 7, 22, 0, 0, 1668, 1669,
-		7, 13, 0, 0, 1669, 1670, 7, 13, 0, 0, 1670, 1671, 7, 10, 0, 0, 1671, 1672,
-		7, 7, 0, 0, 1672, 1673, 7, 16, 0, 0, 1673, 1674, 5, 95, 0, 0, 1674, 1675,
-		7, 13, 0, 0, 1675, 1676, 7, 19, 0, 0, 1676, 1677, 7, 6, 0, 0, 1677, 1678,
-		7, 10, 0, 0, 1678, 110, 1, 0, 0, 0, 1679, 1680, 7, 14, 0, 0, 1680, 1681,
-		7, 22, 0, 0, 1681, 1682, 7, 13, 0, 0, 1682, 1683, 7, 13, 0, 0, 1683, 1684,
-		7, 10, 0, 0, 1684, 1685, 7, 7, 0, 0, 1685, 1686, 7, 16, 0, 0, 1686, 1687,
-		5, 95, 0, 0, 1687, 1688, 7, 16, 0, 0, 1688, 1689, 7, 17, 0, 0, 1689, 1690,
-		7, 15, 0, 0, 1690, 1691, 7, 10, 0, 0, 1691, 112, 1, 0, 0, 0, 1692, 1693,
-		7, 14, 0, 0, 1693, 1694, 7, 22, 0, 0, 1694, 1695, 7, 13, 0, 0, 1695, 1696,
-		7, 13, 0, 0, 1696, 1697, 7, 10, 0, 0, 1697, 1698, 7, 7, 0, 0, 1698, 1699,
-		7, 16, 0, 0, 1699, 1700, 5, 95, 0, 0, 1700, 1701, 7, 16, 0, 0, 1701, 1702,
-		7, 17, 0, 0, 1702, 1703, 7, 15, 0, 0, 1703, 1704, 7, 10, 0, 0, 1704, 1705,
-		7, 9, 0, 0, 1705, 1706, 7, 16, 0, 0, 1706, 1707, 7, 5, 0, 0, 1707, 1708,
-		7, 15, 0, 0, 1708, 1709, 7, 24, 0, 0, 1709, 114, 1, 0, 0, 0, 1710, 1711,
-		7, 14, 0, 0, 1711, 1712, 7, 22, 0, 0, 1712, 1713, 7, 13, 0, 0, 1713, 1714,
-		7, 13, 0, 0, 1714, 1715, 7, 10, 0, 0, 1715, 1716, 7, 7, 0, 0, 1716, 1717,
-		7, 16, 0, 0, 1717, 1718, 5, 95, 0, 0, 1718, 1719, 7, 22, 0, 0, 1719, 1720,
-		7, 9, 0, 0, 1720, 1721, 7, 10, 0, 0, 1721, 1722, 7, 13, 0, 0, 1722, 116,
-		1, 0, 0, 0, 1723, 1724, 7, 12, 0, 0, 1724, 1725, 7, 10, 0, 0, 1725, 1726,
-		7, 25, 0, 0, 1726, 1727, 7, 5, 0, 0, 1727, 1728, 7, 22, 0, 0, 1728, 1729,
-		7, 6, 0, 0, 1729, 1730, 7, 16, 0, 0, 1730, 118, 1, 0, 0, 0, 1731, 1732,
-		7, 12, 0, 0, 1732, 1733, 7, 10, 0, 0, 1733, 1734, 7, 25, 0, 0, 1734, 1735,
-		7, 10, 0, 0, 1735, 1736, 7, 13, 0, 0, 1736, 1737, 7, 13, 0, 0, 1737, 1738,
-		7, 5, 0, 0, 1738, 1739, 7, 18, 0, 0, 1739, 1740, 7, 6, 0, 0, 1740, 1741,
-		7, 10, 0, 0, 1741, 120, 1, 0, 0, 0, 1742, 1743, 7, 12, 0, 0, 1743, 1744,
-		7, 10, 0, 0, 1744, 1745, 7, 9, 0, 0, 1745, 1746, 7, 14, 0, 0, 1746, 122,
-		1, 0, 0, 0, 1747, 1748, 7, 12, 0, 0, 1748, 1749, 7, 17, 0, 0, 1749, 1750,
-		7, 9, 0, 0, 1750, 1751, 7, 16, 0, 0, 1751, 1752, 7, 17, 0, 0, 1752, 1753,
-		7, 7, 0, 0, 1753, 1754, 7, 14, 0, 0, 1754, 1755, 7, 16, 0, 0, 1755, 124,
-		1, 0, 0, 0, 1756, 1757, 7, 12, 0, 0, 1757, 1758, 7, 19, 0, 0, 1758, 126,
-		1, 0, 0, 0, 1759, 1760, 7, 10, 0, 0, 1760, 1761, 7, 6, 0, 0, 1761, 1762,
-		7, 9, 0, 0, 1762, 1763, 7, 10, 0, 0, 1763, 128, 1, 0, 0, 0, 1764, 1765,
-		7, 10, 0, 0, 1765, 1766, 7, 26, 0, 0, 1766, 1767, 7, 14, 0, 0, 1767, 1768,
-		7, 10, 0, 0, 1768, 1769, 7, 24, 0, 0, 1769, 1770, 7, 16, 0, 0, 1770, 130,
-		1, 0, 0, 0, 1771, 1772, 7, 25, 0, 0, 1772, 1773, 7, 5, 0, 0, 1773, 1774,
-		7, 6, 0, 0, 1774, 1775, 7, 9, 0, 0, 1775, 1776, 7, 10, 0, 0, 1776, 132,
-		1, 0, 0, 0, 1777, 1778, 7, 25, 0, 0, 1778, 1779, 7, 10, 0, 0, 1779, 1780,
-		7, 16, 0, 0, 1780, 1781, 7, 14, 0, 0, 1781, 1782, 7, 20, 0, 0, 1782, 134,
-		1, 0, 0, 0, 1783, 1784, 7, 25, 0, 0, 1784, 1785, 7, 19, 0, 0, 1785, 1786,
-		7, 13, 0, 0, 1786, 136, 1, 0, 0, 0, 1787, 1788, 7, 25, 0, 0, 1788, 1789,
-		7, 19, 0, 0, 1789, 1790, 7, 13, 0, 0, 1790, 1791, 7, 10, 0, 0, 1791, 1792,
-		7, 17, 0, 0, 1792, 1793, 7, 23, 0, 0, 1793, 1794, 7, 7, 0, 0, 1794, 138,
-		1, 0, 0, 0, 1795, 1796, 7, 25, 0, 0, 1796, 1797, 7, 13, 0, 0, 1797, 1798,
-		7, 19, 0, 0, 1798, 1799, 7, 15, 0, 0, 1799, 140, 1, 0, 0, 0, 1800, 1801,
-		7, 23, 0, 0, 1801, 1802, 7, 13, 0, 0, 1802, 1803, 7, 5, 0, 0, 1803, 1804,
-		7, 7, 0, 0, 1804, 1805, 7, 16, 0, 0, 1805, 142, 1, 0, 0, 0, 1806, 1807,
-		7, 23, 0, 0, 1807, 1808, 7, 13, 0, 0, 1808, 1809, 7, 19, 0, 0, 1809, 1810,
-		7, 22, 0, 0, 1810, 1811, 7, 24, 0, 0, 1811, 144, 1, 0, 0, 0, 1812, 1813,
-		7, 20, 0, 0, 1813, 1814, 7, 5, 0, 0, 1814, 1815, 7, 27, 0, 0, 1815, 1816,
-		7, 17, 0, 0, 1816, 1817, 7, 7, 0, 0, 1817, 1818, 7, 23, 0, 0, 1818, 146,
-		1, 0, 0, 0, 1819, 1820, 7, 17, 0, 0, 1820, 1821, 7, 7, 0, 0, 1821, 148,
-		1, 0, 0, 0, 1822, 1823, 7, 17, 0, 0, 1823, 1824, 7, 7, 0, 0, 1824, 1825,
-		7, 17, 0, 0, 1825, 1826, 7, 16, 0, 0, 1826, 1827, 7, 17, 0, 0, 1827, 1828,
-		7, 5, 0, 0, 1828, 1829, 7, 6, 0, 0, 1829, 1830, 7, 6, 0, 0, 1830, 1831,
-		7, 8, 0, 0, 1831, 150, 1, 0, 0, 0, 1832, 1833, 7, 17, 0, 0, 1833, 1834,
-		7, 7, 0, 0, 1834, 1835, 7, 16, 0, 0, 1835, 1836, 7, 10, 0, 0, 1836, 1837,
-		7, 13, 0, 0, 1837, 1838, 7, 9, 0, 0, 1838, 1839, 7, 10, 0, 0, 1839, 1840,
-		7, 14, 0, 0, 1840, 1841, 7, 16, 0, 0, 1841, 152, 1, 0, 0, 0, 1842, 1843,
-		7, 17, 0, 0, 1843, 1844, 7, 7, 0, 0, 1844, 1845, 7, 16, 0, 0, 1845, 1846,
-		7, 19, 0, 0, 1846, 154, 1, 0, 0, 0, 1847, 1848, 7, 6, 0, 0, 1848, 1849,
-		7, 5, 0, 0, 1849, 1850, 7, 16, 0, 0, 1850, 1851, 7, 10, 0, 0, 1851, 1852,
-		7, 13, 0, 0, 1852, 1853, 7, 5, 0, 0, 1853, 1854, 7, 6, 0, 0, 1854, 156,
-		1, 0, 0, 0, 1855, 1856, 7, 6, 0, 0, 1856, 1857, 7, 10, 0, 0, 1857, 1858,
-		7, 5, 0, 0, 1858, 1859, 7, 12, 0, 0, 1859, 1860, 7, 17, 0, 0, 1860, 1861,
-		7, 7, 0, 0, 1861, 1862, 7, 23, 0, 0, 1862, 158, 1, 0, 0, 0, 1863, 1864,
-		7, 6, 0, 0, 1864, 1865, 7, 17, 0, 0, 1865, 1866, 7, 15, 0, 0, 1866, 1867,
-		7, 17, 0, 0, 1867, 1868, 7, 16, 0, 0, 1868, 160, 1, 0, 0, 0, 1869, 1870,
-		7, 6, 0, 0, 1870, 1871, 7, 19, 0, 0, 1871, 1872, 7, 14, 0, 0, 1872, 1873,
-		7, 5, 0, 0, 1873, 1874, 7, 6, 0, 0, 1874, 1875, 7, 16, 0, 0, 1875, 1876,
-		7, 17, 0, 0, 1876, 1877, 7, 15, 0, 0, 1877, 1878, 7, 10, 0, 0, 1878, 162,
-		1, 0, 0, 0, 1879, 1880, 7, 6, 0, 0, 1880, 1881, 7, 19, 0, 0, 1881, 1882,
-		7, 14, 0, 0, 1882, 1883, 7, 5, 0, 0, 1883, 1884, 7, 6, 0, 0, 1884, 1885,
-		7, 16, 0, 0, 1885, 1886, 7, 17, 0, 0, 1886, 1887, 7, 15, 0, 0, 1887, 1888,
-		7, 10, 0, 0, 1888, 1889, 7, 9, 0, 0, 1889, 1890, 7, 16, 0, 0, 1890, 1891,
-		7, 5, 0, 0, 1891, 1892, 7, 15, 0, 0, 1892, 1893, 7, 24, 0, 0, 1893, 164,
-		1, 0, 0, 0, 1894, 1895, 7, 7, 0, 0, 1895, 1896, 7, 19, 0, 0, 1896, 1897,
-		7, 16, 0, 0, 1897, 166, 1, 0, 0, 0, 1898, 1899, 7, 7, 0, 0, 1899, 1900,
-		7, 22, 0, 0, 1900, 1901, 7, 6, 0, 0, 1901, 1902, 7, 6, 0, 0, 1902, 168,
-		1, 0, 0, 0, 1903, 1904, 7, 19, 0, 0, 1904, 1905, 7, 25, 0, 0, 1905, 1906,
-		7, 25, 0, 0, 1906, 1907, 7, 9, 0, 0, 1907, 1908, 7, 10, 0, 0, 1908, 1909,
-		7, 16, 0, 0, 1909, 170, 1, 0, 0, 0, 1910, 1911, 7, 19, 0, 0, 1911, 1912,
-		7, 7, 0, 0, 1912, 172, 1, 0, 0, 0, 1913, 1914, 7, 19, 0, 0, 1914, 1915,
-		7, 7, 0, 0, 1915, 1916, 7, 6, 0, 0, 1916, 1917, 7, 8, 0, 0, 1917, 174,
-		1, 0, 0, 0, 1918, 1919, 7, 19, 0, 0, 1919, 1920, 7, 13, 0, 0, 1920, 176,
-		1, 0, 0, 0, 1921, 1922, 7, 19, 0, 0, 1922, 1923, 7, 13, 0, 0, 1923, 1924,
-		7, 12, 0, 0, 1924, 1925, 7, 10, 0, 0, 1925, 1926, 7, 13, 0, 0, 1926, 178,
-		1, 0, 0, 0, 1927, 1928, 7, 24, 0, 0, 1928, 1929, 7, 6, 0, 0, 1929, 1930,
-		7, 5, 0, 0, 1930, 1931, 7, 14, 0, 0, 1931, 1932, 7, 17, 0, 0, 1932, 1933,
-		7, 7, 0, 0, 1933, 1934, 7, 23, 0, 0, 1934, 180, 1, 0, 0, 0, 1935, 1936,
-		7, 24, 0, 0, 1936, 1937, 7, 13, 0, 0, 1937, 1938, 7, 17, 0, 0, 1938, 1939,
-		7, 15, 0, 0, 1939, 1940, 7, 5, 0, 0, 1940, 1941, 7, 13, 0, 0, 1941, 1942,
-		7, 8, 0, 0, 1942, 182, 1, 0, 0, 0, 1943, 1944, 7, 13, 0, 0, 1944, 1945,
-		7, 10, 0, 0, 1945, 1946, 7, 25, 0, 0, 1946, 1947, 7, 10, 0, 0, 1947, 1948,
-		7, 13, 0, 0, 1948, 1949, 7, 10, 0, 0, 1949, 1950, 7, 7, 0, 0, 1950, 1951,
-		7, 14, 0, 0, 1951, 1952, 7, 10, 0, 0, 1952, 1953, 7, 9, 0, 0, 1953, 184,
-		1, 0, 0, 0, 1954, 1955, 7, 13, 0, 0, 1955, 1956, 7, 10, 0, 0, 1956, 1957,
-		7, 16, 0, 0, 1957, 1958, 7, 22, 0, 0, 1958, 1959, 7, 13, 0, 0, 1959, 1960,
-		7, 7, 0, 0, 1960, 1961, 7, 17, 0, 0, 1961, 1962, 7, 7, 0, 0, 1962, 1963,
-		7, 23, 0, 0, 1963, 186, 1, 0, 0, 0, 1964, 1965, 7, 9, 0, 0, 1965, 1966,
-		7, 10, 0, 0, 1966, 1967, 7, 6, 0, 0, 1967, 1968, 7, 10, 0, 0, 1968, 1969,
-		7, 14, 0, 0, 1969, 1970, 7, 16, 0, 0, 1970, 188, 1, 0, 0, 0, 1971, 1972,
-		7, 9, 0, 0, 1972, 1973, 7, 10, 0, 0, 1973, 1974, 7, 9, 0, 0, 1974, 1975,
-		7, 9, 0, 0, 1975, 1976, 7, 17, 0, 0, 1976, 1977, 7, 19, 0, 0, 1977, 1978,
-		7, 7, 0, 0, 1978, 1979, 5, 95, 0, 0, 1979, 1980, 7, 22, 0, 0, 1980, 1981,
-		7, 9, 0, 0, 1981, 1982, 7, 10, 0, 0, 1982, 1983, 7, 13, 0, 0, 1983, 190,
-		1, 0, 0, 0, 1984, 1985, 7, 9, 0, 0, 1985, 1986, 7, 19, 0, 0, 1986, 1987,
-		7, 15, 0, 0, 1987, 1988, 7, 10, 0, 0, 1988, 192, 1, 0, 0, 0, 1989, 1990,
-		7, 9, 0, 0, 1990, 1991, 7, 8, 0, 0, 1991, 1992, 7, 15, 0, 0, 1992, 1993,
-		7, 15, 0, 0, 1993, 1994, 7, 10, 0, 0, 1994, 1995, 7, 16, 0, 0, 1995, 1996,
-		7, 13, 0, 0, 1996, 1997, 7, 17, 0, 0, 1997, 1998, 7, 14, 0, 0, 1998, 194,
-		1, 0, 0, 0, 1999, 2000, 7, 16, 0, 0, 2000, 2001, 7, 5, 0, 0, 2001, 2002,
-		7, 18, 0, 0, 2002, 2003, 7, 6, 0, 0, 2003, 2004, 7, 10, 0, 0, 2004, 196,
-		1, 0, 0, 0, 2005, 2006, 7, 16, 0, 0, 2006, 2007, 7, 20, 0, 0, 2007, 2008,
-		7, 10, 0, 0, 2008, 2009, 7, 7, 0, 0, 2009, 198, 1, 0, 0, 0, 2010, 2011,
-		7, 16, 0, 0, 2011, 2012, 7, 19, 0, 0, 2012, 200, 1, 0, 0, 0, 2013, 2014,
-		7, 16, 0, 0, 2014, 2015, 7, 13, 0, 0, 2015, 2016, 7, 5, 0, 0, 2016, 2017,
-		7, 17, 0, 0, 2017, 2018, 7, 6, 0, 0, 2018, 2019, 7, 17, 0, 0, 2019, 2020,
-		7, 7, 0, 0, 2020, 2021, 7, 23, 0, 0, 2021, 202, 1, 0, 0, 0, 2022, 2023,
-		7, 16, 0, 0, 2023, 2024, 7, 13, 0, 0, 2024, 2025, 7, 22, 0, 0, 2025, 2026,
-		7, 10, 0, 0, 2026, 204, 1, 0, 0, 0, 2027, 2028, 7, 22, 0, 0, 2028, 2029,
-		7, 7, 0, 0, 2029, 2030, 7, 17, 0, 0, 2030, 2031, 7, 19, 0, 0, 2031, 2032,
-		7, 7, 0, 0, 2032, 206, 1, 0, 0, 0, 2033, 2034, 7, 22, 0, 0, 2034, 2035,
-		7, 7, 0, 0, 2035, 2036, 7, 17, 0, 0, 2036, 2037, 7, 28, 0, 0, 2037, 2038,
-		7, 22, 0, 0, 2038, 2039, 7, 10, 0, 0, 2039, 208, 1, 0, 0, 0, 2040, 2041,
-		7, 22, 0, 0, 2041, 2042, 7, 9, 0, 0, 2042, 2043, 7, 10, 0, 0, 2043, 2044,
-		7, 13, 0, 0, 2044, 210, 1, 0, 0, 0, 2045, 2046, 7, 22, 0, 0, 2046, 2047,
-		7, 9, 0, 0, 2047, 2048, 7, 17, 0, 0, 2048, 2049, 7, 7, 0, 0, 2049, 2050,
-		7, 23, 0, 0, 2050, 212, 1, 0, 0, 0, 2051, 2052, 7, 27, 0, 0, 2052, 2053,
-		7, 5, 0, 0, 2053, 2054, 7, 13, 0, 0, 2054, 2055, 7, 17, 0, 0, 2055, 2056,
-		7, 5, 0, 0, 2056, 2057, 7, 12, 0, 0, 2057, 2058, 7, 17, 0, 0, 2058, 2059,
-		7, 14, 0, 0, 2059, 214, 1, 0, 0, 0, 2060, 2061, 7, 29, 0, 0, 2061, 2062,
-		7, 20, 0, 0, 2062, 2063, 7, 10, 0, 0, 2063, 2064, 7, 7, 0, 0, 2064, 216,
-		1, 0, 0, 0, 2065, 2066, 7, 29, 0, 0, 2066, 2067, 7, 20, 0, 0, 2067, 2068,
-		7, 10, 0, 0, 2068, 2069, 7, 13, 0, 0, 2069, 2070, 7, 10, 0, 0, 2070, 218,
-		1, 0, 0, 0, 2071, 2072, 7, 29, 0, 0, 2072, 2073, 7, 17, 0, 0, 2073, 2074,
-		7, 7, 0, 0, 2074, 2075, 7, 12, 0, 0, 2075, 2076, 7, 19, 0, 0, 2076, 2077,
-		7, 29, 0, 0, 2077, 220, 1, 0, 0, 0, 2078, 2079, 7, 29, 0, 0, 2079, 2080,
-		7, 17, 0, 0, 2080, 2081, 7, 16, 0, 0, 2081, 2082, 7, 20, 0, 0, 2082, 222,
-		1, 0, 0, 0, 2083, 2084, 7, 5, 0, 0, 2084, 2085, 7, 22, 0, 0, 2085, 2086,
-		7, 16, 0, 0, 2086, 2087, 7, 20, 0, 0, 2087, 2088, 7, 19, 0, 0, 2088, 2089,
-		7, 13, 0, 0, 2089, 2090, 7, 17, 0, 0, 2090, 2091, 7, 11, 0, 0, 2091, 2092,
-		7, 5, 0, 0, 2092, 2093, 7, 16, 0, 0, 2093, 2094, 7, 17, 0, 0, 2094, 2095,
-		7, 19, 0, 0, 2095, 2096, 7, 7, 0, 0, 2096, 224, 1, 0, 0, 0, 2097, 2098,
-		7, 18, 0, 0, 2098, 2099, 7, 17, 0, 0, 2099, 2100, 7, 7, 0, 0, 2100, 2101,
-		7, 5, 0, 0, 2101, 2102, 7, 13, 0, 0, 2102, 2103, 7, 8, 0, 0, 2103, 226,
-		1, 0, 0, 0, 2104, 2105, 7, 14, 0, 0, 2105, 2106, 7, 19, 0, 0, 2106, 2107,
-		7, 6, 0, 0, 2107, 2108, 7, 6, 0, 0, 2108, 2109, 7, 5, 0, 0, 2109, 2110,
-		7, 16, 0, 0, 2110, 2111, 7, 17, 0, 0, 2111, 2112, 7, 19, 0, 0, 2112, 2113,
-		7, 7, 0, 0, 2113, 228, 1, 0, 0, 0, 2114, 2115, 7, 14, 0, 0, 2115, 2116,
-		7, 19, 0, 0, 2116, 2117, 7, 7, 0, 0, 2117, 2118, 7, 14, 0, 0, 2118, 2119,
-		7, 22, 0, 0, 2119, 2120, 7, 13, 0, 0, 2120, 2121, 7, 13, 0, 0, 2121, 2122,
-		7, 10, 0, 0, 2122, 2123, 7, 7, 0, 0, 2123, 2124, 7, 16, 0, 0, 2124, 2125,
-		7, 6, 0, 0, 2125, 2126, 7, 8, 0, 0, 2126, 230, 1, 0, 0, 0, 2127, 2128,
-		7, 14, 0, 0, 2128, 2129, 7, 13, 0, 0, 2129, 2130, 7, 19, 0, 0, 2130, 2131,
-		7, 9, 0, 0, 2131, 2132, 7, 9, 0, 0, 2132, 232, 1, 0, 0, 0, 2133, 2134,
-		7, 14, 0, 0, 2134, 2135, 7, 22, 0, 0, 2135, 2136, 7, 13, 0, 0, 2136, 2137,
-		7, 13, 0, 0, 2137, 2138, 7, 10, 0, 0, 2138, 2139, 7, 7, 0, 0, 2139, 2140,
-		7, 16, 0, 0, 2140, 2141, 5, 95, 0, 0, 2141, 2142, 7, 9, 0, 0, 2142, 2143,
-		7, 14, 0, 0, 2143, 2144, 7, 20, 0, 0, 2144, 2145, 7, 10, 0, 0, 2145, 2146,
-		7, 15, 0, 0, 2146, 2147, 7, 5, 0, 0, 2147, 234, 1, 0, 0, 0, 2148, 2149,
-		7, 25, 0, 0, 2149, 2150, 7, 13, 0, 0, 2150, 2151, 7, 10, 0, 0, 2151, 2152,
-		7, 10, 0, 0, 2152, 2153, 7, 11, 0, 0, 2153, 2154, 7, 10, 0, 0, 2154, 236,
-		1, 0, 0, 0, 2155, 2156, 7, 25, 0, 0, 2156, 2157, 7, 22, 0, 0, 2157, 2158,
-		7, 6, 0, 0, 2158, 2159, 7, 6, 0, 0, 2159, 238, 1, 0, 0, 0, 2160, 2161,
-		7, 17, 0, 0, 2161, 2162, 7, 6, 0, 0, 2162, 2163, 7, 17, 0, 0, 2163, 2164,
-		7, 21, 0, 0, 2164, 2165, 7, 10, 0, 0, 2165, 240, 1, 0, 0, 0, 2166, 2167,
-		7, 17, 0, 0, 2167, 2168, 7, 7, 0, 0, 2168, 2169, 7, 7, 0, 0, 2169, 2170,
-		7, 10, 0, 0, 2170, 2171, 7, 13, 0, 0, 2171, 242, 1, 0, 0, 0, 2172, 2173,
-		7, 17, 0, 0, 2173, 2174, 7, 9, 0, 0, 2174, 244, 1, 0, 0, 0, 2175, 2176,
-		7, 17, 0, 0, 2176, 2177, 7, 9, 0, 0, 2177, 2178, 7, 7, 0, 0, 2178, 2179,
-		7, 22, 0, 0, 2179, 2180, 7, 6, 0, 0, 2180, 2181, 7, 6, 0, 0, 2181, 246,
-		1, 0, 0, 0, 2182, 2183, 7, 30, 0, 0, 2183, 2184, 7, 19, 0, 0, 2184, 2185,
-		7, 17, 0, 0, 2185, 2186, 7, 7, 0, 0, 2186, 248, 1, 0, 0, 0, 2187, 2188,
-		7, 6, 0, 0, 2188, 2189, 7, 10, 0, 0, 2189, 2190, 7, 25, 0, 0, 2190, 2191,
-		7, 16, 0, 0, 2191, 250, 1, 0, 0, 0, 2192, 2193, 7, 6, 0, 0, 2193, 2194,
-		7, 17, 0, 0, 2194, 2195, 7, 21, 0, 0, 2195, 2196, 7, 10, 0, 0, 2196, 252,
-		1, 0, 0, 0, 2197, 2198, 7, 7, 0, 0, 2198, 2199, 7, 5, 0, 0, 2199, 2200,
-		7, 16, 0, 0, 2200, 2201, 7, 22, 0, 0, 2201, 2202, 7, 13, 0, 0, 2202, 2203,
-		7, 5, 0, 0, 2203, 2204, 7, 6, 0, 0, 2204, 254, 1, 0, 0, 0, 2205, 2206,
-		7, 7, 0, 0, 2206, 2207, 7, 19, 0, 0, 2207, 2208, 7, 16, 0, 0, 2208, 2209,
-		7, 7, 0, 0, 2209, 2210, 7, 22, 0, 0, 2210, 2211, 7, 6, 0, 0, 2211, 2212,
-		7, 6, 0, 0, 2212, 256, 1, 0, 0, 0, 2213, 2214, 7, 19, 0, 0, 2214, 2215,
-		7, 22, 0, 0, 2215, 2216, 7, 16, 0, 0, 2216, 2217, 7, 10, 0, 0, 2217, 2218,
-		7, 13, 0, 0, 2218, 258, 1, 0, 0, 0, 2219, 2220, 7, 19, 0, 0, 2220, 2221,
-		7, 27, 0, 0, 2221, 2222, 7, 10, 0, 0, 2222, 2223, 7, 13, 0, 0, 2223, 260,
-		1, 0, 0, 0, 2224, 2225, 7, 19, 0, 0, 2225, 2226, 7, 27, 0, 0, 2226, 2227,
-		7, 10, 0, 0, 2227, 2228, 7, 13, 0, 0, 2228, 2229, 7, 6, 0, 0, 2229, 2230,
-		7, 5, 0, 0, 2230, 2231, 7, 24, 0, 0, 2231, 2232, 7, 9, 0, 0, 2232, 262,
-		1, 0, 0, 0, 2233, 2234, 7, 13, 0, 0, 2234, 2235, 7, 17, 0, 0, 2235, 2236,
-		7, 23, 0, 0, 2236, 2237, 7, 20, 0, 0, 2237, 2238, 7, 16, 0, 0, 2238, 264,
-		1, 0, 0, 0, 2239, 2240, 7, 9, 0, 0, 2240, 2241, 7, 17, 0, 0, 2241, 2242,
-		7, 15, 0, 0, 2242, 2243, 7, 17, 0, 0, 2243, 2244, 7, 6, 0, 0, 2244, 2245,
-		7, 5, 0, 0, 2245, 2246, 7, 13, 0, 0, 2246, 266, 1, 0, 0, 0, 2247, 2248,
-		7, 27, 0, 0, 2248, 2249, 7, 10, 0, 0, 2249, 2250, 7, 13, 0, 0, 2250, 2251,
-		7, 18, 0, 0, 2251, 2252, 7, 19, 0, 0, 2252, 2253, 7, 9, 0, 0, 2253, 2254,
-		7, 10, 0, 0, 2254, 268, 1, 0, 0, 0, 2255, 2256, 7, 5, 0, 0, 2256, 2257,
-		7, 18, 0, 0, 2257, 2258, 7, 19, 0, 0, 2258, 2259, 7, 13, 0, 0, 2259, 2260,
-		7, 16, 0, 0, 2260, 270, 1, 0, 0, 0, 2261, 2262, 7, 5, 0, 0, 2262, 2263,
-		7, 18, 0, 0, 2263, 2264, 7, 9, 0, 0, 2264, 2265, 7, 19, 0, 0, 2265, 2266,
-		7, 6, 0, 0, 2266, 2267, 7, 22, 0, 0, 2267, 2268, 7, 16, 0, 0, 2268, 2269,
-		7, 10, 0, 0, 2269, 272, 1, 0, 0, 0, 2270, 2271, 7, 5, 0, 0, 2271, 2272,
-		7, 14, 0, 0, 2272, 2273, 7, 14, 0, 0, 2273, 2274, 7, 10, 0, 0, 2274, 2275,
-		7, 9, 0, 0, 2275, 2276, 7, 9, 0, 0, 2276, 274, 1, 0, 0, 0, 2277, 2278,
-		7, 5, 0, 0, 2278, 2279, 7, 14, 0, 0, 2279, 2280, 7, 16, 0, 0, 2280, 2281,
-		7, 17, 0, 0, 2281, 2282, 7, 19, 0, 0, 2282, 2283, 7, 7, 0, 0, 2283, 276,
-		1, 0, 0, 0, 2284, 2285, 7, 5, 0, 0, 2285, 2286, 7, 12, 0, 0, 2286, 2287,
-		7, 12, 0, 0, 2287, 278, 1, 0, 0, 0, 2288, 2289, 7, 5, 0, 0, 2289, 2290,
-		7, 12, 0, 0, 2290, 2291, 7, 15, 0, 0, 2291, 2292, 7, 17, 0, 0, 2292, 2293,
-		7, 7, 0, 0, 2293, 280, 1, 0, 0, 0, 2294, 2295, 7, 5, 0, 0, 2295, 2296,
-		7, 25, 0, 0, 2296, 2297, 7, 16, 0, 0, 2297, 2298, 7, 10, 0, 0, 2298, 2299,
-		7, 13, 0, 0, 2299, 282, 1, 0, 0, 0, 2300, 2301, 7, 5, 0, 0, 2301, 2302,
-		7, 23, 0, 0, 2302, 2303, 7, 23, 0, 0, 2303, 2304, 7, 13, 0, 0, 2304, 2305,
-		7, 10, 0, 0, 2305, 2306, 7, 23, 0, 0, 2306, 2307, 7, 5, 0, 0, 2307, 2308,
-		7, 16, 0, 0, 2308, 2309, 7, 10, 0, 0, 2309, 284, 1, 0, 0, 0, 2310, 2311,
-		7, 5, 0, 0, 2311, 2312, 7, 6, 0, 0, 2312, 2313, 7, 9, 0, 0, 2313, 2314,
-		7, 19, 0, 0, 2314, 286, 1, 0, 0, 0, 2315, 2316, 7, 5, 0, 0, 2316, 2317,
-		7, 6, 0, 0, 2317, 2318, 7, 16, 0, 0, 2318, 2319, 7, 10, 0, 0, 2319, 2320,
-		7, 13, 0, 0, 2320, 288, 1, 0, 0, 0, 2321, 2322, 7, 5, 0, 0, 2322, 2323,
-		7, 6, 0, 0, 2323, 2324, 7, 29, 0, 0, 2324, 2325, 7, 5, 0, 0, 2325, 2326,
-		7, 8, 0, 0, 2326, 2327, 7, 9, 0, 0, 2327, 290, 1, 0, 0, 0, 2328, 2329,
-		7, 5, 0, 0, 2329, 2330, 7, 9, 0, 0, 2330, 2331, 7, 9, 0, 0, 2331, 2332,
-		7, 10, 0, 0, 2332, 2333, 7, 13, 0, 0, 2333, 2334, 7, 16, 0, 0, 2334, 2335,
-		7, 17, 0, 0, 2335, 2336, 7, 19, 0, 0, 2336, 2337, 7, 7, 0, 0, 2337, 292,
-		1, 0, 0, 0, 2338, 2339, 7, 5, 0, 0, 2339, 2340, 7, 9, 0, 0, 2340, 2341,
-		7, 9, 0, 0, 2341, 2342, 7, 17, 0, 0, 2342, 2343, 7, 23, 0, 0, 2343, 2344,
-		7, 7, 0, 0, 2344, 2345, 7, 15, 0, 0, 2345, 2346, 7, 10, 0, 0, 2346, 2347,
-		7, 7, 0, 0, 2347, 2348, 7, 16, 0, 0, 2348, 294, 1, 0, 0, 0, 2349, 2350,
-		7, 5, 0, 0, 2350, 2351, 7, 16, 0, 0, 2351, 296, 1, 0, 0, 0, 2352, 2353,
-		7, 5, 0, 0, 2353, 2354, 7, 16, 0, 0, 2354, 2355, 7, 16, 0, 0, 2355, 2356,
-		7, 13, 0, 0, 2356, 2357, 7, 17, 0, 0, 2357, 2358, 7, 18, 0, 0, 2358, 2359,
-		7, 22, 0, 0, 2359, 2360, 7, 16, 0, 0, 2360, 2361, 7, 10, 0, 0, 2361, 298,
-		1, 0, 0, 0, 2362, 2363, 7, 18, 0, 0, 2363, 2364, 7, 5, 0, 0, 2364, 2365,
-		7, 14, 0, 0, 2365, 2366, 7, 21, 0, 0, 2366, 2367, 7, 29, 0, 0, 2367, 2368,
-		7, 5, 0, 0, 2368, 2369, 7, 13, 0, 0, 2369, 2370, 7, 12, 0, 0, 2370, 300,
-		1, 0, 0, 0, 2371, 2372, 7, 18, 0, 0, 2372, 2373, 7, 10, 0, 0, 2373, 2374,
-		7, 25, 0, 0, 2374, 2375, 7, 19, 0, 0, 2375, 2376, 7, 13, 0, 0, 2376, 2377,
-		7, 10, 0, 0, 2377, 302, 1, 0, 0, 0, 2378, 2379, 7, 18, 0, 0, 2379, 2380,
-		7, 10, 0, 0, 2380, 2381, 7, 23, 0, 0, 2381, 2382, 7, 17, 0, 0, 2382, 2383,
-		7, 7, 0, 0, 2383, 304, 1, 0, 0, 0, 2384, 2385, 7, 18, 0, 0, 2385, 2386,
-		7, 8, 0, 0, 2386, 306, 1, 0, 0, 0, 2387, 2388, 7, 14, 0, 0, 2388, 2389,
-		7, 5, 0, 0, 2389, 2390, 7, 14, 0, 0, 2390, 2391, 7, 20, 0, 0, 2391, 2392,
-		7, 10, 0, 0, 2392, 308, 1, 0, 0, 0, 2393, 2394, 7, 14, 0, 0, 2394, 2395,
-		7, 5, 0, 0, 2395, 2396, 7, 6, 0, 0, 2396, 2397, 7, 6, 0, 0, 2397, 2398,
-		7, 10, 0, 0, 2398, 2399, 7, 12, 0, 0, 2399, 310, 1, 0, 0, 0, 2400, 2401,
-		7, 14, 0, 0, 2401, 2402, 7, 5, 0, 0, 2402, 2403, 7, 9, 0, 0, 2403, 2404,
-		7, 14, 0, 0, 2404, 2405, 7, 5, 0, 0, 2405, 2406, 7, 12, 0, 0, 2406, 2407,
-		7, 10, 0, 0, 2407, 312, 1, 0, 0, 0, 2408, 2409, 7, 14, 0, 0, 2409, 2410,
-		7, 5, 0, 0, 2410, 2411, 7, 9, 0, 0, 2411, 2412, 7, 14, 0, 0, 2412, 2413,
-		7, 5, 0, 0, 2413, 2414, 7, 12, 0, 0, 2414, 2415, 7, 10, 0, 0, 2415, 2416,
-		7, 12, 0, 0, 2416, 314, 1, 0, 0, 0, 2417, 2418, 7, 14, 0, 0, 2418, 2419,
-		7, 5, 0, 0, 2419, 2420, 7, 16, 0, 0, 2420, 2421, 7, 5, 0, 0, 2421, 2422,
-		7, 6, 0, 0, 2422, 2423, 7, 19, 0, 0, 2423, 2424, 7, 23, 0, 0, 2424, 316,
-		1, 0, 0, 0, 2425, 2426, 7, 14, 0, 0, 2426, 2427, 7, 20, 0, 0, 2427, 2428,
-		7, 5, 0, 0, 2428, 2429, 7, 17, 0, 0, 2429, 2430, 7, 7, 0, 0, 2430, 318,
-		1, 0, 0, 0, 2431, 2432, 7, 14, 0, 0, 2432, 2433, 7, 20, 0, 0, 2433, 2434,
-		7, 5, 0, 0, 2434, 2435, 7, 13, 0, 0, 2435, 2436, 7, 5, 0, 0, 2436, 2437,
-		7, 14, 0, 0, 2437, 2438, 7, 16, 0, 0, 2438, 2439, 7, 10, 0, 0, 2439, 2440,
-		7, 13, 0, 0, 2440, 2441, 7, 17, 0, 0, 2441, 2442, 7, 9, 0, 0, 2442, 2443,
-		7, 16, 0, 0, 2443, 2444, 7, 17, 0, 0, 2444, 2445, 7, 14, 0, 0, 2445, 2446,
-		7, 9, 0, 0, 2446, 320, 1, 0, 0, 0, 2447, 2448, 7, 14, 0, 0, 2448, 2449,
-		7, 20, 0, 0, 2449, 2450, 7, 10, 0, 0, 2450, 2451, 7, 14, 0, 0, 2451, 2452,
-		7, 21, 0, 0, 2452, 2453, 7, 24, 0, 0, 2453, 2454, 7, 19, 0, 0, 2454, 2455,
-		7, 17, 0, 0, 2455, 2456, 7, 7, 0, 0, 2456, 2457, 7, 16, 0, 0, 2457, 322,
-		1, 0, 0, 0, 2458, 2459, 7, 14, 0, 0, 2459, 2460, 7, 6, 0, 0, 2460, 2461,
-		7, 5, 0, 0, 2461, 2462, 7, 9, 0, 0, 2462, 2463, 7, 9, 0, 0, 2463, 324,
-		1, 0, 0, 0, 2464, 2465, 7, 14, 0, 0, 2465, 2466, 7, 6, 0, 0, 2466, 2467,
-		7, 19, 0, 0, 2467, 2468, 7, 9, 0, 0, 2468, 2469, 7, 10, 0, 0, 2469, 326,
-		1, 0, 0, 0, 2470, 2471, 7, 14, 0, 0, 2471, 2472, 7, 6, 0, 0, 2472, 2473,
-		7, 22, 0, 0, 2473, 2474, 7, 9, 0, 0, 2474, 2475, 7, 16, 0, 0, 2475, 2476,
-		7, 10, 0, 0, 2476, 2477, 7, 13, 0, 0, 2477, 328, 1, 0, 0, 0, 2478, 2479,
-		7, 14, 0, 0, 2479, 2480, 7, 19, 0, 0, 2480, 2481, 7, 15, 0, 0, 2481, 2482,
-		7, 15, 0, 0, 2482, 2483, 7, 10, 0, 0, 2483, 2484, 7, 7, 0, 0, 2484, 2485,
-		7, 16, 0, 0, 2485, 330, 1, 0, 0, 0, 2486, 2487, 7, 14, 0, 0, 2487, 2488,
-		7, 19, 0, 0, 2488, 2489, 7, 15, 0, 0, 2489, 2490, 7, 15, 0, 0, 2490, 2491,
-		7, 10, 0, 0, 2491, 2492, 7, 7, 0, 0, 2492, 2493, 7, 16, 0, 0, 2493, 2494,
-		7, 9, 0, 0, 2494, 332, 1, 0, 0, 0, 2495, 2496, 7, 14, 0, 0, 2496, 2497,
-		7, 19, 0, 0, 2497, 2498, 7, 15, 0, 0, 2498, 2499, 7, 15, 0, 0, 2499, 2500,
-		7, 17, 0, 0, 2500, 2501, 7, 16, 0, 0, 2501, 334, 1, 0, 0, 0, 2502, 2503,
-		7, 14, 0, 0, 2503, 2504, 7, 19, 0, 0, 2504, 2505, 7, 15, 0, 0, 2505, 2506,
-		7, 15, 0, 0, 2506, 2507, 7, 17, 0, 0, 2507, 2508, 7, 16, 0, 0, 2508, 2509,
-		7, 16, 0, 0, 2509, 2510, 7, 10, 0, 0, 2510, 2511, 7, 12, 0, 0, 2511, 336,
-		1, 0, 0, 0, 2512, 2513, 7, 14, 0, 0, 2513, 2514, 7, 19, 0, 0, 2514, 2515,
-		7, 7, 0, 0, 2515, 2516, 7, 25, 0, 0, 2516, 2517, 7, 17, 0, 0, 2517, 2518,
-		7, 23, 0, 0, 2518, 2519, 7, 22, 0, 0, 2519, 2520, 7, 13, 0, 0, 2520, 2521,
-		7, 5, 0, 0, 2521, 2522, 7, 16, 0, 0, 2522, 2523, 7, 17, 0, 0, 2523, 2524,
-		7, 19, 0, 0, 2524, 2525, 7, 7, 0, 0, 2525, 338, 1, 0, 0, 0, 2526, 2527,
-		7, 14, 0, 0, 2527, 2528, 7, 19, 0, 0, 2528, 2529, 7, 7, 0, 0, 2529, 2530,
-		7, 7, 0, 0, 2530, 2531, 7, 10, 0, 0, 2531, 2532, 7, 14, 0, 0, 2532, 2533,
-		7, 16, 0, 0, 2533, 2534, 7, 17, 0, 0, 2534, 2535, 7, 19, 0, 0, 2535, 2536,
-		7, 7, 0, 0, 2536, 340, 1, 0, 0, 0, 2537, 2538, 7, 14, 0, 0, 2538, 2539,
-		7, 19, 0, 0, 2539, 2540, 7, 7, 0, 0, 2540, 2541, 7, 9, 0, 0, 2541, 2542,
-		7, 16, 0, 0, 2542, 2543, 7, 13, 0, 0, 2543, 2544, 7, 5, 0, 0, 2544, 2545,
-		7, 17, 0, 0, 2545, 2546, 7, 7, 0, 0, 2546, 2547, 7, 16, 0, 0, 2547, 2548,
-		7, 9, 0, 0, 2548, 342, 1, 0, 0, 0, 2549, 2550, 7, 14, 0, 0, 2550, 2551,
-		7, 19, 0, 0, 2551, 2552, 7, 7, 0, 0, 2552, 2553, 7, 16, 0, 0, 2553, 2554,
-		7, 10, 0, 0, 2554, 2555, 7, 7, 0, 0, 2555, 2556, 7, 16, 0, 0, 2556, 344,
-		1, 0, 0, 0, 2557, 2558, 7, 14, 0, 0, 2558, 2559, 7, 19, 0, 0, 2559, 2560,
-		7, 7, 0, 0, 2560, 2561, 7, 16, 0, 0, 2561, 2562, 7, 17, 0, 0, 2562, 2563,
-		7, 7, 0, 0, 2563, 2564, 7, 22, 0, 0, 2564, 2565, 7, 10, 0, 0, 2565, 346,
-		1, 0, 0, 0, 2566, 2567, 7, 14, 0, 0, 2567, 2568, 7, 19, 0, 0, 2568, 2569,
-		7, 7, 0, 0, 2569, 2570, 7, 27, 0, 0, 2570, 2571, 7, 10, 0, 0, 2571, 2572,
-		7, 13, 0, 0, 2572, 2573, 7, 9, 0, 0, 2573, 2574, 7, 17, 0, 0, 2574, 2575,
-		7, 19, 0, 0, 2575, 2576, 7, 7, 0, 0, 2576, 348, 1, 0, 0, 0, 2577, 2578,
-		7, 14, 0, 0, 2578, 2579, 7, 19, 0, 0, 2579, 2580, 7, 24, 0, 0, 2580, 2581,
-		7, 8, 0, 0, 2581, 350, 1, 0, 0, 0, 2582, 2583, 7, 14, 0, 0, 2583, 2584,
-		7, 19, 0, 0, 2584, 2585, 7, 9, 0, 0, 2585, 2586, 7, 16, 0, 0, 2586, 352,
-		1, 0, 0, 0, 2587, 2588, 7, 14, 0, 0, 2588, 2589, 7, 9, 0, 0, 2589, 2590,
-		7, 27, 0, 0, 2590, 354, 1, 0, 0, 0, 2591, 2592, 7, 14, 0, 0, 2592, 2593,
-		7, 22, 0, 0, 2593, 2594, 7, 13, 0, 0, 2594, 2595, 7, 9, 0, 0, 2595, 2596,
-		7, 19, 0, 0, 2596, 2597, 7, 13, 0, 0, 2597, 356, 1, 0, 0, 0, 2598, 2599,
-		7, 14, 0, 0, 2599, 2600, 7, 8, 0, 0, 2600, 2601, 7, 14, 0, 0, 2601, 2602,
-		7, 6, 0, 0, 2602, 2603, 7, 10, 0, 0, 2603, 358, 1, 0, 0, 0, 2604, 2605,
-		7, 12, 0, 0, 2605, 2606, 7, 5, 0, 0, 2606, 2607, 7, 16, 0, 0, 2607, 2608,
-		7, 5, 0, 0, 2608, 360, 1, 0, 0, 0, 2609, 2610, 7, 12, 0, 0, 2610, 2611,
-		7, 5, 0, 0, 2611, 2612, 7, 16, 0, 0, 2612, 2613, 7, 5, 0, 0, 2613, 2614,
-		7, 18, 0, 0, 2614, 2615, 7, 5, 0, 0, 2615, 2616, 7, 9, 0, 0, 2616, 2617,
-		7, 10, 0, 0, 2617, 362, 1, 0, 0, 0, 2618, 2619, 7, 12, 0, 0, 2619, 2620,
-		7, 5, 0, 0, 2620, 2621, 7, 8, 0, 0, 2621, 364, 1, 0, 0, 0, 2622, 2623,
-		7, 12, 0, 0, 2623, 2624, 7, 10, 0, 0, 2624, 2625, 7, 5, 0, 0, 2625, 2626,
-		7, 6, 0, 0, 2626, 2627, 7, 6, 0, 0, 2627, 2628, 7, 19, 0, 0, 2628, 2629,
-		7, 14, 0, 0, 2629, 2630, 7, 5, 0, 0, 2630, 2631, 7, 16, 0, 0, 2631, 2632,
-		7, 10, 0, 0, 2632, 366, 1, 0, 0, 0, 2633, 2634, 7, 12, 0, 0, 2634, 2635,
-		7, 10, 0, 0, 2635, 2636, 7, 14, 0, 0, 2636, 2637, 7, 6, 0, 0, 2637, 2638,
-		7, 5, 0, 0, 2638, 2639, 7, 13, 0, 0, 2639, 2640, 7, 10, 0, 0, 2640, 368,
-		1, 0, 0, 0, 2641, 2642, 7, 12, 0, 0, 2642, 2643, 7, 10, 0, 0, 2643, 2644,
-		7, 25, 0, 0, 2644, 2645, 7, 5, 0, 0, 2645, 2646, 7, 22, 0, 0, 2646, 2647,
-		7, 6, 0, 0, 2647, 2648, 7, 16, 0, 0, 2648, 2649, 7, 9, 0, 0, 2649, 370,
-		1, 0, 0, 0, 2650, 2651, 7, 12, 0, 0, 2651, 2652, 7, 10, 0, 0, 2652, 2653,
-		7, 25, 0, 0, 2653, 2654, 7, 10, 0, 0, 2654, 2655, 7, 13, 0, 0, 2655, 2656,
-		7, 13, 0, 0, 2656, 2657, 7, 10, 0, 0, 2657, 2658, 7, 12, 0, 0, 2658, 372,
-		1, 0, 0, 0, 2659, 2660, 7, 12, 0, 0, 2660, 2661, 7, 10, 0, 0, 2661, 2662,
-		7, 25, 0, 0, 2662, 2663, 7, 17, 0, 0, 2663, 2664, 7, 7, 0, 0, 2664, 2665,
-		7, 10, 0, 0, 2665, 2666, 7, 13, 0, 0, 2666, 374, 1, 0, 0, 0, 2667, 2668,
-		7, 12, 0, 0, 2668, 2669, 7, 10, 0, 0, 2669, 2670, 7, 6, 0, 0, 2670, 2671,
-		7, 10, 0, 0, 2671, 2672, 7, 16, 0, 0, 2672, 2673, 7, 10, 0, 0, 2673, 376,
-		1, 0, 0, 0, 2674, 2675, 7, 12, 0, 0, 2675, 2676, 7, 10, 0, 0, 2676, 2677,
-		7, 6, 0, 0, 2677, 2678, 7, 17, 0, 0, 2678, 2679, 7, 15, 0, 0, 2679, 2680,
-		7, 17, 0, 0, 2680, 2681, 7, 16, 0, 0, 2681, 2682, 7, 10, 0, 0, 2682, 2683,
-		7, 13, 0, 0, 2683, 378, 1, 0, 0, 0, 2684, 2685, 7, 12, 0, 0, 2685, 2686,
-		7, 10, 0, 0, 2686, 2687, 7, 6, 0, 0, 2687, 2688, 7, 17, 0, 0, 2688, 2689,
-		7, 15, 0, 0, 2689, 2690, 7, 17, 0, 0, 2690, 2691, 7, 16, 0, 0, 2691, 2692,
-		7, 10, 0, 0, 2692, 2693, 7, 13, 0, 0, 2693, 2694, 7, 9, 0, 0, 2694, 380,
-		1, 0, 0, 0, 2695, 2696, 7, 12, 0, 0, 2696, 2697, 7, 17, 0, 0, 2697, 2698,
-		7, 14, 0, 0, 2698, 2699, 7, 16, 0, 0, 2699, 2700, 7, 17, 0, 0, 2700, 2701,
-		7, 19, 0, 0, 2701, 2702, 7, 7, 0, 0, 2702, 2703, 7, 5, 0, 0, 2703, 2704,
-		7, 13, 0, 0, 2704, 2705, 7, 8, 0, 0, 2705, 382, 1, 0, 0, 0, 2706, 2707,
-		7, 12, 0, 0, 2707, 2708, 7, 17, 0, 0, 2708, 2709, 7, 9, 0, 0, 2709, 2710,
-		7, 5, 0, 0, 2710, 2711, 7, 18, 0, 0, 2711, 2712, 7, 6, 0, 0, 2712, 2713,
-		7, 10, 0, 0, 2713, 384, 1, 0, 0, 0, 2714, 2715, 7, 12, 0, 0, 2715, 2716,
-		7, 17, 0, 0, 2716, 2717, 7, 9, 0, 0, 2717, 2718, 7, 14, 0, 0, 2718, 2719,
-		7, 5, 0, 0, 2719, 2720, 7, 13, 0, 0, 2720, 2721, 7, 12, 0, 0, 2721, 386,
-		1, 0, 0, 0, 2722, 2723, 7, 12, 0, 0, 2723, 2724, 7, 19, 0, 0, 2724, 2725,
-		7, 14, 0, 0, 2725, 2726, 7, 22, 0, 0, 2726, 2727, 7, 15, 0, 0, 2727, 2728,
-		7, 10, 0, 0, 2728, 2729, 7, 7, 0, 0, 2729, 2730, 7, 16, 0, 0, 2730, 388,
-		1, 0, 0, 0, 2731, 2732, 7, 12, 0, 0, 2732, 2733, 7, 19, 0, 0, 2733, 2734,
-		7, 15, 0, 0, 2734, 2735, 7, 5, 0, 0, 2735, 2736, 7, 17, 0, 0, 2736, 2737,
-		7, 7, 0, 0, 2737, 390, 1, 0, 0, 0, 2738, 2739, 7, 12, 0, 0, 2739, 2740,
-		7, 19, 0, 0, 2740, 2741, 7, 22, 0, 0, 2741, 2742, 7, 18, 0, 0, 2742, 2743,
-		7, 6, 0, 0, 2743, 2744, 7, 10, 0, 0, 2744, 392, 1, 0, 0, 0, 2745, 2746,
-		7, 12, 0, 0, 2746, 2747, 7, 13, 0, 0, 2747, 2748, 7, 19, 0, 0, 2748, 2749,
-		7, 24, 0, 0, 2749, 394, 1, 0, 0, 0, 2750, 2751, 7, 10, 0, 0, 2751, 2752,
-		7, 5, 0, 0, 2752, 2753, 7, 14, 0, 0, 2753, 2754, 7, 20, 0, 0, 2754, 396,
-		1, 0, 0, 0, 2755, 2756, 7, 10, 0, 0, 2756, 2757, 7, 7, 0, 0, 2757, 2758,
-		7, 5, 0, 0, 2758, 2759, 7, 18, 0, 0, 2759, 2760, 7, 6, 0, 0, 2760, 2761,
-		7, 10, 0, 0, 2761, 398, 1, 0, 0, 0, 2762, 2763, 7, 10, 0, 0, 2763, 2764,
-		7, 7, 0, 0, 2764, 2765, 7, 14, 0, 0, 2765, 2766, 7, 19, 0, 0, 2766, 2767,
-		7, 12, 0, 0, 2767, 2768, 7, 17, 0, 0, 2768, 2769, 7, 7, 0, 0, 2769, 2770,
-		7, 23, 0, 0, 2770, 400, 1, 0, 0, 0, 2771, 2772, 7, 10, 0, 0, 2772, 2773,
-		7, 7, 0, 0, 2773, 2774, 7, 14, 0, 0, 2774, 2775, 7, 13, 0, 0, 2775, 2776,
-		7, 8, 0, 0, 2776, 2777, 7, 24, 0, 0, 2777, 2778, 7, 16, 0, 0, 2778, 2779,
-		7, 10, 0, 0, 2779, 2780, 7, 12, 0, 0, 2780, 402, 1, 0, 0, 0, 2781, 2782,
-		7, 10, 0, 0, 2782, 2783, 7, 7, 0, 0, 2783, 2784, 7, 22, 0, 0, 2784, 2785,
-		7, 15, 0, 0, 2785, 404, 1, 0, 0, 0, 2786, 2787, 7, 10, 0, 0, 2787, 2788,
-		7, 9, 0, 0, 2788, 2789, 7, 14, 0, 0, 2789, 2790, 7, 5, 0, 0, 2790, 2791,
-		7, 24, 0, 0, 2791, 2792, 7, 10, 0, 0, 2792, 406, 1, 0, 0, 0, 2793, 2794,
-		7, 10, 0, 0, 2794, 2795, 7, 27, 0, 0, 2795, 2796, 7, 10, 0, 0, 2796, 2797,
-		7, 7, 0, 0, 2797, 2798, 7, 16, 0, 0, 2798, 408, 1, 0, 0, 0, 2799, 2800,
-		7, 10, 0, 0, 2800, 2801, 7, 26, 0, 0, 2801, 2802, 7, 14, 0, 0, 2802, 2803,
-		7, 6, 0, 0, 2803, 2804, 7, 22, 0, 0, 2804, 2805, 7, 12, 0, 0, 2805, 2806,
-		7, 10, 0, 0, 2806, 410, 1, 0, 0, 0, 2807, 2808, 7, 10, 0, 0, 2808, 2809,
-		7, 26, 0, 0, 2809, 2810, 7, 14, 0, 0, 2810, 2811, 7, 6, 0, 0, 2811, 2812,
-		7, 22, 0, 0, 2812, 2813, 7, 12, 0, 0, 2813, 2814, 7, 17, 0, 0, 2814, 2815,
-		7, 7, 0, 0, 2815, 2816, 7, 23, 0, 0, 2816, 412, 1, 0, 0, 0, 2817, 2818,
-		7, 10, 0, 0, 2818, 2819, 7, 26, 0, 0, 2819, 2820, 7, 14, 0, 0, 2820, 2821,
-		7, 6, 0, 0, 2821, 2822, 7, 22, 0, 0, 2822, 2823, 7, 9, 0, 0, 2823, 2824,
-		7, 17, 0, 0, 2824, 2825, 7, 27, 0, 0, 2825, 2826, 7, 10, 0, 0, 2826, 414,
-		1, 0, 0, 0, 2827, 2828, 7, 10, 0, 0, 2828, 2829, 7, 26, 0, 0, 2829, 2830,
-		7, 10, 0, 0, 2830, 2831, 7, 14, 0, 0, 2831, 2832, 7, 22, 0, 0, 2832, 2833,
-		7, 16, 0, 0, 2833, 2834, 7, 10, 0, 0, 2834, 416, 1, 0, 0, 0, 2835, 2836,
-		7, 10, 0, 0, 2836, 2837, 7, 26, 0, 0, 2837, 2838, 7, 24, 0, 0, 2838, 2839,
-		7, 6, 0, 0, 2839, 2840, 7, 5, 0, 0, 2840, 2841, 7, 17, 0, 0, 2841, 2842,
-		7, 7, 0, 0, 2842, 418, 1, 0, 0, 0, 2843, 2844, 7, 10, 0, 0, 2844, 2845,
-		7, 26, 0, 0, 2845, 2846, 7, 16, 0, 0, 2846, 2847, 7, 10, 0, 0, 2847, 2848,
-		7, 7, 0, 0, 2848, 2849, 7, 9, 0, 0, 2849, 2850, 7, 17, 0, 0, 2850, 2851,
-		7, 19, 0, 0, 2851, 2852, 7, 7, 0, 0, 2852, 420, 1, 0, 0, 0, 2853, 2854,
-		7, 10, 0, 0, 2854, 2855, 7, 26, 0, 0, 2855, 2856, 7, 16, 0, 0, 2856, 2857,
-		7, 10, 0, 0, 2857, 2858, 7, 13, 0, 0, 2858, 2859, 7, 7, 0, 0, 2859, 2860,
-		7, 5, 0, 0, 2860, 2861, 7, 6, 0, 0, 2861, 422, 1, 0, 0, 0, 2862, 2863,
-		7, 25, 0, 0, 2863, 2864, 7, 5, 0, 0, 2864, 2865, 7, 15, 0, 0, 2865, 2866,
-		7, 17, 0, 0, 2866, 2867, 7, 6, 0, 0, 2867, 2868, 7, 8, 0, 0, 2868, 424,
-		1, 0, 0, 0, 2869, 2870, 7, 25, 0, 0, 2870, 2871, 7, 17, 0, 0, 2871, 2872,
-		7, 13, 0, 0, 2872, 2873, 7, 9, 0, 0, 2873, 2874, 7, 16, 0, 0, 2874, 426,
-		1, 0, 0, 0, 2875, 2876, 7, 25, 0, 0, 2876, 2877, 7, 19, 0, 0, 2877, 2878,
-		7, 6, 0, 0, 2878, 2879, 7, 6, 0, 0, 2879, 2880, 7, 19, 0, 0, 2880, 2881,
-		7, 29, 0, 0, 2881, 2882, 7, 17, 0, 0, 2882, 2883, 7, 7, 0, 0, 2883, 2884,
-		7, 23, 0, 0, 2884, 428, 1, 0, 0, 0, 2885, 2886, 7, 25, 0, 0, 2886, 2887,
-		7, 19, 0, 0, 2887, 2888, 7, 13, 0, 0, 2888, 2889, 7, 14, 0, 0, 2889, 2890,
-		7, 10, 0, 0, 2890, 430, 1, 0, 0, 0, 2891, 2892, 7, 25, 0, 0, 2892, 2893,
-		7, 19, 0, 0, 2893, 2894, 7, 13, 0, 0, 2894, 2895, 7, 29, 0, 0, 2895, 2896,
-		7, 5, 0, 0, 2896, 2897, 7, 13, 0, 0, 2897, 2898, 7, 12, 0, 0, 2898, 432,
-		1, 0, 0, 0, 2899, 2900, 7, 25, 0, 0, 2900, 2901, 7, 22, 0, 0, 2901, 2902,
-		7, 7, 0, 0, 2902, 2903, 7, 14, 0, 0, 2903, 2904, 7, 16, 0, 0, 2904, 2905,
-		7, 17, 0, 0, 2905, 2906, 7, 19, 0, 0, 2906, 2907, 7, 7, 0, 0, 2907, 434,
-		1, 0, 0, 0, 2908, 2909, 7, 25, 0, 0, 2909, 2910, 7, 22, 0, 0, 2910, 2911,
-		7, 7, 0, 0, 2911, 2912, 7, 14, 0, 0, 2912, 2913, 7, 16, 0, 0, 2913, 2914,
-		7, 17, 0, 0, 2914, 2915, 7, 19, 0, 0, 2915, 2916, 7, 7, 0, 0, 2916, 2917,
-		7, 9, 0, 0, 2917, 436, 1, 0, 0, 0, 2918, 2919, 7, 23, 0, 0, 2919, 2920,
-		7, 6, 0, 0, 2920, 2921, 7, 19, 0, 0, 2921, 2922, 7, 18, 0, 0, 2922, 2923,
-		7, 5, 0, 0, 2923, 2924, 7, 6, 0, 0, 2924, 438, 1, 0, 0, 0, 2925, 2926,
-		7, 23, 0, 0, 2926, 2927, 7, 13, 0, 0, 2927, 2928, 7, 5, 0, 0, 2928, 2929,
-		7, 7, 0, 0, 2929, 2930, 7, 16, 0, 0, 2930, 2931, 7, 10, 0, 0, 2931, 2932,
-		7, 12, 0, 0, 2932, 440, 1, 0, 0, 0, 2933, 2934, 7, 20, 0, 0, 2934, 2935,
-		7, 5, 0, 0, 2935, 2936, 7, 7, 0, 0, 2936, 2937, 7, 12, 0, 0, 2937, 2938,
-		7, 6, 0, 0, 2938, 2939, 7, 10, 0, 0, 2939, 2940, 7, 13, 0, 0, 2940, 442,
-		1, 0, 0, 0, 2941, 2942, 7, 20, 0, 0, 2942, 2943, 7, 10, 0, 0, 2943, 2944,
-		7, 5, 0, 0, 2944, 2945, 7, 12, 0, 0, 2945, 2946, 7, 10, 0, 0, 2946, 2947,
-		7, 13, 0, 0, 2947, 444, 1, 0, 0, 0, 2948, 2949, 7, 20, 0, 0, 2949, 2950,
-		7, 19, 0, 0, 2950, 2951, 7, 6, 0, 0, 2951, 2952, 7, 12, 0, 0, 2952, 446,
-		1, 0, 0, 0, 2953, 2954, 7, 20, 0, 0, 2954, 2955, 7, 19, 0, 0, 2955, 2956,
-		7, 22, 0, 0, 2956, 2957, 7, 13, 0, 0, 2957, 448, 1, 0, 0, 0, 2958, 2959,
-		7, 17, 0, 0, 2959, 2960, 7, 12, 0, 0, 2960, 2961, 7, 10, 0, 0, 2961, 2962,
-		7, 7, 0, 0, 2962, 2963, 7, 16, 0, 0, 2963, 2964, 7, 17, 0, 0, 2964, 2965,
-		7, 16, 0, 0, 2965, 2966, 7, 8, 0, 0, 2966, 450, 1, 0, 0, 0, 2967, 2968,
-		7, 17, 0, 0, 2968, 2969, 7, 25, 0, 0, 2969, 452, 1, 0, 0, 0, 2970, 2971,
-		7, 17, 0, 0, 2971, 2972, 7, 15, 0, 0, 2972, 2973, 7, 15, 0, 0, 2973, 2974,
-		7, 10, 0, 0, 2974, 2975, 7, 12, 0, 0, 2975, 2976, 7, 17, 0, 0, 2976, 2977,
-		7, 5, 0, 0, 2977, 2978, 7, 16, 0, 0, 2978, 2979, 7, 10, 0, 0, 2979, 454,
-		1, 0, 0, 0, 2980, 2981, 7, 17, 0, 0, 2981, 2982, 7, 15, 0, 0, 2982, 2983,
-		7, 15, 0, 0, 2983, 2984, 7, 22, 0, 0, 2984, 2985, 7, 16, 0, 0, 2985, 2986,
-		7, 5, 0, 0, 2986, 2987, 7, 18, 0, 0, 2987, 2988, 7, 6, 0, 0, 2988, 2989,
-		7, 10, 0, 0, 2989, 456, 1, 0, 0, 0, 2990, 2991, 7, 17, 0, 0, 2991, 2992,
-		7, 15, 0, 0, 2992, 2993, 7, 24, 0, 0, 2993, 2994, 7, 6, 0, 0, 2994, 2995,
-		7, 17, 0, 0, 2995, 2996, 7, 14, 0, 0, 2996, 2997, 7, 17, 0, 0, 2997, 2998,
-		7, 16, 0, 0, 2998, 458, 1, 0, 0, 0, 2999, 3000, 7, 17, 0, 0, 3000, 3001,
-		7, 7, 0, 0, 3001, 3002, 7, 14, 0, 0, 3002, 3003, 7, 6, 0, 0, 3003, 3004,
-		7, 22, 0, 0, 3004, 3005, 7, 12, 0, 0, 3005, 3006, 7, 17, 0, 0, 3006, 3007,
-		7, 7, 0, 0, 3007, 3008, 7, 23, 0, 0, 3008, 460, 1, 0, 0, 0, 3009, 3010,
-		7, 17, 0, 0, 3010, 3011, 7, 7, 0, 0, 3011, 3012, 7, 14, 0, 0, 3012, 3013,
-		7, 13, 0, 0, 3013, 3014, 7, 10, 0, 0, 3014, 3015, 7, 15, 0, 0, 3015, 3016,
-		7, 10, 0, 0, 3016, 3017, 7, 7, 0, 0, 3017, 3018, 7, 16, 0, 0, 3018, 462,
-		1, 0, 0, 0, 3019, 3020, 7, 17, 0, 0, 3020, 3021, 7, 7, 0, 0, 3021, 3022,
-		7, 12, 0, 0, 3022, 3023, 7, 10, 0, 0, 3023, 3024, 7, 26, 0, 0, 3024, 464,
-		1, 0, 0, 0, 3025, 3026, 7, 17, 0, 0, 3026, 3027, 7, 7, 0, 0, 3027, 3028,
-		7, 12, 0, 0, 3028, 3029, 7, 10, 0, 0, 3029, 3030, 7, 26, 0, 0, 3030, 3031,
-		7, 10, 0, 0, 3031, 3032, 7, 9, 0, 0, 3032, 466, 1, 0, 0, 0, 3033, 3034,
-		7, 17, 0, 0, 3034, 3035, 7, 7, 0, 0, 3035, 3036, 7, 20, 0, 0, 3036, 3037,
-		7, 10, 0, 0, 3037, 3038, 7, 13, 0, 0, 3038, 3039, 7, 17, 0, 0, 3039, 3040,
-		7, 16, 0, 0, 3040, 468, 1, 0, 0, 0, 3041, 3042, 7, 17, 0, 0, 3042, 3043,
-		7, 7, 0, 0, 3043, 3044, 7, 20, 0, 0, 3044, 3045, 7, 10, 0, 0, 3045, 3046,
-		7, 13, 0, 0, 3046, 3047, 7, 17, 0, 0, 3047, 3048, 7, 16, 0, 0, 3048, 3049,
-		7, 9, 0, 0, 3049, 470, 1, 0, 0, 0, 3050, 3051, 7, 17, 0, 0, 3051, 3052,
-		7, 7, 0, 0, 3052, 3053, 7, 6, 0, 0, 3053, 3054, 7, 17, 0, 0, 3054, 3055,
-		7, 7, 0, 0, 3055, 3056, 7, 10, 0, 0, 3056, 472, 1, 0, 0, 0, 3057, 3058,
-		7, 17, 0, 0, 3058, 3059, 7, 7, 0, 0, 3059, 3060, 7, 9, 0, 0, 3060, 3061,
-		7, 10, 0, 0, 3061, 3062, 7, 7, 0, 0, 3062, 3063, 7, 9, 0, 0, 3063, 3064,
-		7, 17, 0, 0, 3064, 3065, 7, 16, 0, 0, 3065, 3066, 7, 17, 0, 0, 3066, 3067,
-		7, 27, 0, 0, 3067, 3068, 7, 10, 0, 0, 3068, 474, 1, 0, 0, 0, 3069, 3070,
-		7, 17, 0, 0, 3070, 3071, 7, 7, 0, 0, 3071, 3072, 7, 9, 0, 0, 3072, 3073,
-		7, 10, 0, 0, 3073, 3074, 7, 13, 0, 0, 3074, 3075, 7, 16, 0, 0, 3075, 476,
-		1, 0, 0, 0, 3076, 3077, 7, 17, 0, 0, 3077, 3078, 7, 7, 0, 0, 3078, 3079,
-		7, 9, 0, 0, 3079, 3080, 7, 16, 0, 0, 3080, 3081, 7, 10, 0, 0, 3081, 3082,
-		7, 5, 0, 0, 3082, 3083, 7, 12, 0, 0, 3083, 478, 1, 0, 0, 0, 3084, 3085,
-		7, 17, 0, 0, 3085, 3086, 7, 7, 0, 0, 3086, 3087, 7, 27, 0, 0, 3087, 3088,
-		7, 19, 0, 0, 3088, 3089, 7, 21, 0, 0, 3089, 3090, 7, 10, 0, 0, 3090, 3091,
-		7, 13, 0, 0, 3091, 480, 1, 0, 0, 0, 3092, 3093, 7, 17, 0, 0, 3093, 3094,
-		7, 9, 0, 0, 3094, 3095, 7, 19, 0, 0, 3095, 3096, 7, 6, 0, 0, 3096, 3097,
-		7, 5, 0, 0, 3097, 3098, 7, 16, 0, 0, 3098, 3099, 7, 17, 0, 0, 3099, 3100,
-		7, 19, 0, 0, 3100, 3101, 7, 7, 0, 0, 3101, 482, 1, 0, 0, 0, 3102, 3103,
-		7, 21, 0, 0, 3103, 3104, 7, 10, 0, 0, 3104, 3105, 7, 8, 0, 0, 3105, 484,
-		1, 0, 0, 0, 3106, 3107, 7, 6, 0, 0, 3107, 3108, 7, 5, 0, 0, 3108, 3109,
-		7, 18, 0, 0, 3109, 3110, 7, 10, 0, 0, 3110, 3111, 7, 6, 0, 0, 3111, 486,
-		1, 0, 0, 0, 3112, 3113, 7, 6, 0, 0, 3113, 3114, 7, 5, 0, 0, 3114, 3115,
-		7, 7, 0, 0, 3115, 3116, 7, 23, 0, 0, 3116, 3117, 7, 22, 0, 0, 3117, 3118,
-		7, 5, 0, 0, 3118, 3119, 7, 23, 0, 0, 3119, 3120, 7, 10, 0, 0, 3120, 488,
-		1, 0, 0, 0, 3121, 3122, 7, 6, 0, 0, 3122, 3123, 7, 5, 0, 0, 3123, 3124,
-		7, 13, 0, 0, 3124, 3125, 7, 23, 0, 0, 3125, 3126, 7, 10, 0, 0, 3126, 490,
-		1, 0, 0, 0, 3127, 3128, 7, 6, 0, 0, 3128, 3129, 7, 5, 0, 0, 3129, 3130,
-		7, 9, 0, 0, 3130, 3131, 7, 16, 0, 0, 3131, 492, 1, 0, 0, 0, 3132, 3133,
-		7, 6, 0, 0, 3133, 3134, 7, 10, 0, 0, 3134, 3135, 7, 5, 0, 0, 3135, 3136,
-		7, 21, 0, 0, 3136, 3137, 7, 24, 0, 0, 3137, 3138, 7, 13, 0, 0, 3138, 3139,
-		7, 19, 0, 0, 3139, 3140, 7, 19, 0, 0, 3140, 3141, 7, 25, 0, 0, 3141, 494,
-		1, 0, 0, 0, 3142, 3143, 7, 6, 0, 0, 3143, 3144, 7, 10, 0, 0, 3144, 3145,
-		7, 27, 0, 0, 3145, 3146, 7, 10, 0, 0, 3146, 3147, 7, 6, 0, 0, 3147, 496,
-		1, 0, 0, 0, 3148, 3149, 7, 6, 0, 0, 3149, 3150, 7, 17, 0, 0, 3150, 3151,
-		7, 9, 0, 0, 3151, 3152, 7, 16, 0, 0, 3152, 3153, 7, 10, 0, 0, 3153, 3154,
-		7, 7, 0, 0, 3154, 498, 1, 0, 0, 0, 3155, 3156, 7, 6, 0, 0, 3156, 3157,
-		7, 19, 0, 0, 3157, 3158, 7, 5, 0, 0, 3158, 3159, 7, 12, 0, 0, 3159, 500,
-		1, 0, 0, 0, 3160, 3161, 7, 6, 0, 0, 3161, 3162, 7, 19, 0, 0, 3162, 3163,
-		7, 14, 0, 0, 3163, 3164, 7, 5, 0, 0, 3164, 3165, 7, 6, 0, 0, 3165, 502,
-		1, 0, 0, 0, 3166, 3167, 7, 6, 0, 0, 3167, 3168, 7, 19, 0, 0, 3168, 3169,
-		7, 14, 0, 0, 3169, 3170, 7, 5, 0, 0, 3170, 3171, 7, 16, 0, 0, 3171, 3172,
-		7, 17, 0, 0, 3172, 3173, 7, 19, 0, 0, 3173, 3174, 7, 7, 0, 0, 3174, 504,
-		1, 0, 0, 0, 3175, 3176, 7, 6, 0, 0, 3176, 3177, 7, 19, 0, 0, 3177, 3178,
-		7, 14, 0, 0, 3178, 3179, 7, 21, 0, 0, 3179, 506, 1, 0, 0, 0, 3180, 3181,
-		7, 15, 0, 0, 3181, 3182, 7, 5, 0, 0, 3182, 3183, 7, 24, 0, 0, 3183, 3184,
-		7, 24, 0, 0, 3184, 3185, 7, 17, 0, 0, 3185, 3186, 7, 7, 0, 0, 3186, 3187,
-		7, 23, 0, 0, 3187, 508, 1, 0, 0, 0, 3188, 3189, 7, 15, 0, 0, 3189, 3190,
-		7, 5, 0, 0, 3190, 3191, 7, 16, 0, 0, 3191, 3192, 7, 14, 0, 0, 3192, 3193,
-		7, 20, 0, 0, 3193, 510, 1, 0, 0, 0, 3194, 3195, 7, 15, 0, 0, 3195, 3196,
-		7, 5, 0, 0, 3196, 3197, 7, 16, 0, 0, 3197, 3198, 7, 14, 0, 0, 3198, 3199,
-		7, 20, 0, 0, 3199, 3200, 7, 10, 0, 0, 3200, 3201, 7, 12, 0, 0, 3201, 512,
-		1, 0, 0, 0, 3202, 3203, 7, 15, 0, 0, 3203, 3204, 7, 5, 0, 0, 3204, 3205,
-		7, 16, 0, 0, 3205, 3206, 7, 10, 0, 0, 3206, 3207, 7, 13, 0, 0, 3207, 3208,
-		7, 17, 0, 0, 3208, 3209, 7, 5, 0, 0, 3209, 3210, 7, 6, 0, 0, 3210, 3211,
-		7, 17, 0, 0, 3211, 3212, 7, 11, 0, 0, 3212, 3213, 7, 10, 0, 0, 3213, 3214,
-		7, 12, 0, 0, 3214, 514, 1, 0, 0, 0, 3215, 3216, 7, 15, 0, 0, 3216, 3217,
-		7, 5, 0, 0, 3217, 3218, 7, 26, 0, 0, 3218, 3219, 7, 27, 0, 0, 3219, 3220,
-		7, 5, 0, 0, 3220, 3221, 7, 6, 0, 0, 3221, 3222, 7, 22, 0, 0, 3222, 3223,
-		7, 10, 0, 0, 3223, 516, 1, 0, 0, 0, 3224, 3225, 7, 15, 0, 0, 3225, 3226,
-		7, 10, 0, 0, 3226, 3227, 7, 13, 0, 0, 3227, 3228, 7, 23, 0, 0, 3228, 3229,
-		7, 10, 0, 0, 3229, 518, 1, 0, 0, 0, 3230, 3231, 7, 15, 0, 0, 3231, 3232,
-		7, 17, 0, 0, 3232, 3233, 7, 7, 0, 0, 3233, 3234, 7, 22, 0, 0, 3234, 3235,
-		7, 16, 0, 0, 3235, 3236, 7, 10, 0, 0, 3236, 520, 1, 0, 0, 0, 3237, 3238,
-		7, 15, 0, 0, 3238, 3239, 7, 17, 0, 0, 3239, 3240, 7, 7, 0, 0, 3240, 3241,
-		7, 27, 0, 0, 3241, 3242, 7, 5, 0, 0, 3242, 3243, 7, 6, 0, 0, 3243, 3244,
-		7, 22, 0, 0, 3244, 3245, 7, 10, 0, 0, 3245, 522, 1, 0, 0, 0, 3246, 3247,
-		7, 15, 0, 0, 3247, 3248, 7, 19, 0, 0, 3248, 3249, 7, 12, 0, 0, 3249, 3250,
-		7, 10, 0, 0, 3250, 524, 1, 0, 0, 0, 3251, 3252, 7, 15, 0, 0, 3252, 3253,
-		7, 19, 0, 0, 3253, 3254, 7, 7, 0, 0, 3254, 3255, 7, 16, 0, 0, 3255, 3256,
-		7, 20, 0, 0, 3256, 526, 1, 0, 0, 0, 3257, 3258, 7, 15, 0, 0, 3258, 3259,
-		7, 19, 0, 0, 3259, 3260, 7, 27, 0, 0, 3260, 3261, 7, 10, 0, 0, 3261, 528,
-		1, 0, 0, 0, 3262, 3263, 7, 7, 0, 0, 3263, 3264, 7, 5, 0, 0, 3264, 3265,
-		7, 15, 0, 0, 3265, 3266, 7, 10, 0, 0, 3266, 530, 1, 0, 0, 0, 3267, 3268,
-		7, 7, 0, 0, 3268, 3269, 7, 5, 0, 0, 3269, 3270, 7, 15, 0, 0, 3270, 3271,
-		7, 10, 0, 0, 3271, 3272, 7, 9, 0, 0, 3272, 532, 1, 0, 0, 0, 3273, 3274,
-		7, 7, 0, 0, 3274, 3275, 7, 10, 0, 0, 3275, 3276, 7, 26, 0, 0, 3276, 3277,
-		7, 16, 0, 0, 3277, 534, 1, 0, 0, 0, 3278, 3279, 7, 7, 0, 0, 3279, 3280,
-		7, 19, 0, 0, 3280, 536, 1, 0, 0, 0, 3281, 3282, 7, 7, 0, 0, 3282, 3283,
-		7, 19, 0, 0, 3283, 3284, 7, 16, 0, 0, 3284, 3285, 7, 20, 0, 0, 3285, 3286,
-		7, 17, 0, 0, 3286, 3287, 7, 7, 0, 0, 3287, 3288, 7, 23, 0, 0, 3288, 538,
-		1, 0, 0, 0, 3289, 3290, 7, 7, 0, 0, 3290, 3291, 7, 19, 0, 0, 3291, 3292,
-		7, 16, 0, 0, 3292, 3293, 7, 17, 0, 0, 3293, 3294, 7, 25, 0, 0, 3294, 3295,
-		7, 8, 0, 0, 3295, 540, 1, 0, 0, 0, 3296, 3297, 7, 7, 0, 0, 3297, 3298,
-		7, 19, 0, 0, 3298, 3299, 7, 29, 0, 0, 3299, 3300, 7, 5, 0, 0, 3300, 3301,
-		7, 17, 0, 0, 3301, 3302, 7, 16, 0, 0, 3302, 542, 1, 0, 0, 0, 3303, 3304,
-		7, 7, 0, 0, 3304, 3305, 7, 22, 0, 0, 3305, 3306, 7, 6, 0, 0, 3306, 3307,
-		7, 6, 0, 0, 3307, 3308, 7, 9, 0, 0, 3308, 544, 1, 0, 0, 0, 3309, 3310,
-		7, 19, 0, 0, 3310, 3311, 7, 18, 0, 0, 3311, 3312, 7, 30, 0, 0, 3312, 3313,
-		7, 10, 0, 0, 3313, 3314, 7, 14, 0, 0, 3314, 3315, 7, 16, 0, 0, 3315, 546,
-		1, 0, 0, 0, 3316, 3317, 7, 19, 0, 0, 3317, 3318, 7, 25, 0, 0, 3318, 548,
-		1, 0, 0, 0, 3319, 3320, 7, 19, 0, 0, 3320, 3321, 7, 25, 0, 0, 3321, 3322,
-		7, 25, 0, 0, 3322, 550, 1, 0, 0, 0, 3323, 3324, 7, 19, 0, 0, 3324, 3325,
-		7, 17, 0, 0, 3325, 3326, 7, 12, 0, 0, 3326, 3327, 7, 9, 0, 0, 3327, 552,
-		1, 0, 0, 0, 3328, 3329, 7, 19, 0, 0, 3329, 3330, 7, 24, 0, 0, 3330, 3331,
-		7, 10, 0, 0, 3331, 3332, 7, 13, 0, 0, 3332, 3333, 7, 5, 0, 0, 3333, 3334,
-		7, 16, 0, 0, 3334, 3335, 7, 19, 0, 0, 3335, 3336, 7, 13, 0, 0, 3336, 554,
-		1, 0, 0, 0, 3337, 3338, 7, 19, 0, 0, 3338, 3339, 7, 24, 0, 0, 3339, 3340,
-		7, 16, 0, 0, 3340, 3341, 7, 17, 0, 0, 3341, 3342, 7, 19, 0, 0, 3342, 3343,
-		7, 7, 0, 0, 3343, 556, 1, 0, 0, 0, 3344, 3345, 7, 19, 0, 0, 3345, 3346,
-		7, 24, 0, 0, 3346, 3347, 7, 16, 0, 0, 3347, 3348, 7, 17, 0, 0, 3348, 3349,
-		7, 19, 0, 0, 3349, 3350, 7, 7, 0, 0, 3350, 3351, 7, 9, 0, 0, 3351, 558,
-		1, 0, 0, 0, 3352, 3353, 7, 19, 0, 0, 3353, 3354, 7, 29, 0, 0, 3354, 3355,
-		7, 7, 0, 0, 3355, 3356, 7, 10, 0, 0, 3356, 3357, 7, 12, 0, 0, 3357, 560,
-		1, 0, 0, 0, 3358, 3359, 7, 19, 0, 0, 3359, 3360, 7, 29, 0, 0, 3360, 3361,
-		7, 7, 0, 0, 3361, 3362, 7, 10, 0, 0, 3362, 3363, 7, 13, 0, 0, 3363, 562,
-		1, 0, 0, 0, 3364, 3365, 7, 24, 0, 0, 3365, 3366, 7, 5, 0, 0, 3366, 3367,
-		7, 13, 0, 0, 3367, 3368, 7, 9, 0, 0, 3368, 3369, 7, 10, 0, 0, 3369, 3370,
-		7, 13, 0, 0, 3370, 564, 1, 0, 0, 0, 3371, 3372, 7, 24, 0, 0, 3372, 3373,
-		7, 5, 0, 0, 3373, 3374, 7, 13, 0, 0, 3374, 3375, 7, 16, 0, 0, 3375, 3376,
-		7, 17, 0, 0, 3376, 3377, 7, 5, 0, 0, 3377, 3378, 7, 6, 0, 0, 3378, 566,
-		1, 0, 0, 0, 3379, 3380, 7, 24, 0, 0, 3380, 3381, 7, 5, 0, 0, 3381, 3382,
-		7, 13, 0, 0, 3382, 3383, 7, 16, 0, 0, 3383, 3384, 7, 17, 0, 0, 3384, 3385,
-		7, 16, 0, 0, 3385, 3386, 7, 17, 0, 0, 3386, 3387, 7, 19, 0, 0, 3387, 3388,
-		7, 7, 0, 0, 3388, 568, 1, 0, 0, 0, 3389, 3390, 7, 24, 0, 0, 3390, 3391,
-		7, 5, 0, 0, 3391, 3392, 7, 9, 0, 0, 3392, 3393, 7, 9, 0, 0, 3393, 3394,
-		7, 17, 0, 0, 3394, 3395, 7, 7, 0, 0, 3395, 3396, 7, 23, 0, 0, 3396, 570,
-		1, 0, 0, 0, 3397, 3398, 7, 24, 0, 0, 3398, 3399, 7, 5, 0, 0, 3399, 3400,
-		7, 9, 0, 0, 3400, 3401, 7, 9, 0, 0, 3401, 3402, 7, 29, 0, 0, 3402, 3403,
-		7, 19, 0, 0, 3403, 3404, 7, 13, 0, 0, 3404, 3405, 7, 12, 0, 0, 3405, 572,
-		1, 0, 0, 0, 3406, 3407, 7, 24, 0, 0, 3407, 3408, 7, 6, 0, 0, 3408, 3409,
-		7, 5, 0, 0, 3409, 3410, 7, 7, 0, 0, 3410, 3411, 7, 9, 0, 0, 3411, 574,
-		1, 0, 0, 0, 3412, 3413, 7, 24, 0, 0, 3413, 3414, 7, 13, 0, 0, 3414, 3415,
-		7, 10, 0, 0, 3415, 3416, 7, 14, 0, 0, 3416, 3417, 7, 10, 0, 0, 3417, 3418,
-		7, 12, 0, 0, 3418, 3419, 7, 17, 0, 0, 3419, 3420, 7, 7, 0, 0, 3420, 3421,
-		7, 23, 0, 0, 3421, 576, 1, 0, 0, 0, 3422, 3423, 7, 24, 0, 0, 3423, 3424,
-		7, 13, 0, 0, 3424, 3425, 7, 10, 0, 0, 3425, 3426, 7, 24, 0, 0, 3426, 3427,
-		7, 5, 0, 0, 3427, 3428, 7, 13, 0, 0, 3428, 3429, 7, 10, 0, 0, 3429, 578,
-		1, 0, 0, 0, 3430, 3431, 7, 24, 0, 0, 3431, 3432, 7, 13, 0, 0, 3432, 3433,
-		7, 10, 0, 0, 3433, 3434, 7, 24, 0, 0, 3434, 3435, 7, 5, 0, 0, 3435, 3436,
-		7, 13, 0, 0, 3436, 3437, 7, 10, 0, 0, 3437, 3438, 7, 12, 0, 0, 3438, 580,
-		1, 0, 0, 0, 3439, 3440, 7, 24, 0, 0, 3440, 3441, 7, 13, 0, 0, 3441, 3442,
-		7, 10, 0, 0, 3442, 3443, 7, 9, 0, 0, 3443, 3444, 7, 10, 0, 0, 3444, 3445,
-		7, 13, 0, 0, 3445, 3446, 7, 27, 0, 0, 3446, 3447, 7, 10, 0, 0, 3447, 582,
-		1, 0, 0, 0, 3448, 3449, 7, 24, 0, 0, 3449, 3450, 7, 13, 0, 0, 3450, 3451,
-		7, 17, 0, 0, 3451, 3452, 7, 19, 0, 0, 3452, 3453, 7, 13, 0, 0, 3453, 584,
-		1, 0, 0, 0, 3454, 3455, 7, 24, 0, 0, 3455, 3456, 7, 13, 0, 0, 3456, 3457,
-		7, 17, 0, 0, 3457, 3458, 7, 27, 0, 0, 3458, 3459, 7, 17, 0, 0, 3459, 3460,
-		7, 6, 0, 0, 3460, 3461, 7, 10, 0, 0, 3461, 3462, 7, 23, 0, 0, 3462, 3463,
-		7, 10, 0, 0, 3463, 3464, 7, 9, 0, 0, 3464, 586, 1, 0, 0, 0, 3465, 3466,
-		7, 24, 0, 0, 3466, 3467, 7, 13, 0, 0, 3467, 3468, 7, 19, 0, 0, 3468, 3469,
-		7, 14, 0, 0, 3469, 3470, 7, 10, 0, 0, 3470, 3471, 7, 12, 0, 0, 3471, 3472,
-		7, 22, 0, 0, 3472, 3473, 7, 13, 0, 0, 3473, 3474, 7, 5, 0, 0, 3474, 3475,
-		7, 6, 0, 0, 3475, 588, 1, 0, 0, 0, 3476, 3477, 7, 24, 0, 0, 3477, 3478,
-		7, 13, 0, 0, 3478, 3479, 7, 19, 0, 0, 3479, 3480, 7, 14, 0, 0, 3480, 3481,
-		7, 10, 0, 0, 3481, 3482, 7, 12, 0, 0, 3482, 3483, 7, 22, 0, 0, 3483, 3484,
-		7, 13, 0, 0, 3484, 3485, 7, 10, 0, 0, 3485, 590, 1, 0, 0, 0, 3486, 3487,
-		7, 24, 0, 0, 3487, 3488, 7, 13, 0, 0, 3488, 3489, 7, 19, 0, 0, 3489, 3490,
-		7, 23, 0, 0, 3490, 3491, 7, 13, 0, 0, 3491, 3492, 7, 5, 0, 0, 3492, 3493,
-		7, 15, 0, 0, 3493, 592, 1, 0, 0, 0, 3494, 3495, 7, 28, 0, 0, 3495, 3496,
-		7, 22, 0, 0, 3496, 3497, 7, 19, 0, 0, 3497, 3498, 7, 16, 0, 0, 3498, 3499,
-		7, 10, 0, 0, 3499, 594, 1, 0, 0, 0, 3500, 3501, 7, 13, 0, 0, 3501, 3502,
-		7, 5, 0, 0, 3502, 3503, 7, 7, 0, 0, 3503, 3504, 7, 23, 0, 0, 3504, 3505,
-		7, 10, 0, 0, 3505, 596, 1, 0, 0, 0, 3506, 3507, 7, 13, 0, 0, 3507, 3508,
-		7, 10, 0, 0, 3508, 3509, 7, 5, 0, 0, 3509, 3510, 7, 12, 0, 0, 3510, 598,
-		1, 0, 0, 0, 3511, 3512, 7, 13, 0, 0, 3512, 3513, 7, 10, 0, 0, 3513, 3514,
-		7, 5, 0, 0, 3514, 3515, 7, 9, 0, 0, 3515, 3516, 7, 9, 0, 0, 3516, 3517,
-		7, 17, 0, 0, 3517, 3518, 7, 23, 0, 0, 3518, 3519, 7, 7, 0, 0, 3519, 600,
-		1, 0, 0, 0, 3520, 3521, 7, 13, 0, 0, 3521, 3522, 7, 10, 0, 0, 3522, 3523,
-		7, 14, 0, 0, 3523, 3524, 7, 20, 0, 0, 3524, 3525, 7, 10, 0, 0, 3525, 3526,
-		7, 14, 0, 0, 3526, 3527, 7, 21, 0, 0, 3527, 602, 1, 0, 0, 0, 3528, 3529,
-		7, 13, 0, 0, 3529, 3530, 7, 10, 0, 0, 3530, 3531, 7, 14, 0, 0, 3531, 3532,
-		7, 22, 0, 0, 3532, 3533, 7, 13, 0, 0, 3533, 3534, 7, 9, 0, 0, 3534, 3535,
-		7, 17, 0, 0, 3535, 3536, 7, 27, 0, 0, 3536, 3537, 7, 10, 0, 0, 3537, 604,
-		1, 0, 0, 0, 3538, 3539, 7, 13, 0, 0, 3539, 3540, 7, 10, 0, 0, 3540, 3541,
-		7, 25, 0, 0, 3541, 606, 1, 0, 0, 0, 3542, 3543, 7, 13, 0, 0, 3543, 3544,
-		7, 10, 0, 0, 3544, 3545, 7, 25, 0, 0, 3545, 3546, 7, 13, 0, 0, 3546, 3547,
-		7, 10, 0, 0, 3547, 3548, 7, 9, 0, 0, 3548, 3549, 7, 20, 0, 0, 3549, 608,
-		1, 0, 0, 0, 3550, 3551, 7, 13, 0, 0, 3551, 3552, 7, 10, 0, 0, 3552, 3553,
-		7, 17, 0, 0, 3553, 3554, 7, 7, 0, 0, 3554, 3555, 7, 12, 0, 0, 3555, 3556,
-		7, 10, 0, 0, 3556, 3557, 7, 26, 0, 0, 3557, 610, 1, 0, 0, 0, 3558, 3559,
-		7, 13, 0, 0, 3559, 3560, 7, 10, 0, 0, 3560, 3561, 7, 6, 0, 0, 3561, 3562,
-		7, 5, 0, 0, 3562, 3563, 7, 16, 0, 0, 3563, 3564, 7, 17, 0, 0, 3564, 3565,
-		7, 27, 0, 0, 3565, 3566, 7, 10, 0, 0, 3566, 612, 1, 0, 0, 0, 3567, 3568,
-		7, 13, 0, 0, 3568, 3569, 7, 10, 0, 0, 3569, 3570, 7, 6, 0, 0, 3570, 3571,
-		7, 10, 0, 0, 3571, 3572, 7, 5, 0, 0, 3572, 3573, 7, 9, 0, 0, 3573, 3574,
-		7, 10, 0, 0, 3574, 614, 1, 0, 0, 0, 3575, 3576, 7, 13, 0, 0, 3576, 3577,
-		7, 10, 0, 0, 3577, 3578, 7, 7, 0, 0, 3578, 3579, 7, 5, 0, 0, 3579, 3580,
-		7, 15, 0, 0, 3580, 3581, 7, 10, 0, 0, 3581, 616, 1, 0, 0, 0, 3582, 3583,
-		7, 13, 0, 0, 3583, 3584, 7, 10, 0, 0, 3584, 3585, 7, 24, 0, 0, 3585, 3586,
-		7, 10, 0, 0, 3586, 3587, 7, 5, 0, 0, 3587, 3588, 7, 16, 0, 0, 3588, 3589,
-		7, 5, 0, 0, 3589, 3590, 7, 18, 0, 0, 3590, 3591, 7, 6, 0, 0, 3591, 3592,
-		7, 10, 0, 0, 3592, 618, 1, 0, 0, 0, 3593, 3594, 7, 13, 0, 0, 3594, 3595,
-		7, 10, 0, 0, 3595, 3596, 7, 24, 0, 0, 3596, 3597, 7, 6, 0, 0, 3597, 3598,
-		7, 5, 0, 0, 3598, 3599, 7, 14, 0, 0, 3599, 3600, 7, 10, 0, 0, 3600, 620,
-		1, 0, 0, 0, 3601, 3602, 7, 13, 0, 0, 3602, 3603, 7, 10, 0, 0, 3603, 3604,
-		7, 24, 0, 0, 3604, 3605, 7, 6, 0, 0, 3605, 3606, 7, 17, 0, 0, 3606, 3607,
-		7, 14, 0, 0, 3607, 3608, 7, 5, 0, 0, 3608, 622, 1, 0, 0, 0, 3609, 3610,
-		7, 13, 0, 0, 3610, 3611, 7, 10, 0, 0, 3611, 3612, 7, 9, 0, 0, 3612, 3613,
-		7, 10, 0, 0, 3613, 3614, 7, 16, 0, 0, 3614, 624, 1, 0, 0, 0, 3615, 3616,
-		7, 13, 0, 0, 3616, 3617, 7, 10, 0, 0, 3617, 3618, 7, 9, 0, 0, 3618, 3619,
-		7, 16, 0, 0, 3619, 3620, 7, 5, 0, 0, 3620, 3621, 7, 13, 0, 0, 3621, 3622,
-		7, 16, 0, 0, 3622, 626, 1, 0, 0, 0, 3623, 3624, 7, 13, 0, 0, 3624, 3625,
-		7, 10, 0, 0, 3625, 3626, 7, 9, 0, 0, 3626, 3627, 7, 16, 0, 0, 3627, 3628,
-		7, 13, 0, 0, 3628, 3629, 7, 17, 0, 0, 3629, 3630, 7, 14, 0, 0, 3630, 3631,
-		7, 16, 0, 0, 3631, 628, 1, 0, 0, 0, 3632, 3633, 7, 13, 0, 0, 3633, 3634,
-		7, 10, 0, 0, 3634, 3635, 7, 16, 0, 0, 3635, 3636, 7, 22, 0, 0, 3636, 3637,
-		7, 13, 0, 0, 3637, 3638, 7, 7, 0, 0, 3638, 3639, 7, 9, 0, 0, 3639, 630,
-		1, 0, 0, 0, 3640, 3641, 7, 13, 0, 0, 3641, 3642, 7, 10, 0, 0, 3642, 3643,
-		7, 27, 0, 0, 3643, 3644, 7, 19, 0, 0, 3644, 3645, 7, 21, 0, 0, 3645, 3646,
-		7, 10, 0, 0, 3646, 632, 1, 0, 0, 0, 3647, 3648, 7, 13, 0, 0, 3648, 3649,
-		7, 19, 0, 0, 3649, 3650, 7, 6, 0, 0, 3650, 3651, 7, 10, 0, 0, 3651, 634,
-		1, 0, 0, 0, 3652, 3653, 7, 13, 0, 0, 3653, 3654, 7, 19, 0, 0, 3654, 3655,
-		7, 6, 0, 0, 3655, 3656, 7, 6, 0, 0, 3656, 3657, 7, 18, 0, 0, 3657, 3658,
-		7, 5, 0, 0, 3658, 3659, 7, 14, 0, 0, 3659, 3660, 7, 21, 0, 0, 3660, 636,
-		1, 0, 0, 0, 3661, 3662, 7, 13, 0, 0, 3662, 3663, 7, 19, 0, 0, 3663, 3664,
-		7, 29, 0, 0, 3664, 3665, 7, 9, 0, 0, 3665, 638, 1, 0, 0, 0, 3666, 3667,
-		7, 13, 0, 0, 3667, 3668, 7, 22, 0, 0, 3668, 3669, 7, 6, 0, 0, 3669, 3670,
-		7, 10, 0, 0, 3670, 640, 1, 0, 0, 0, 3671, 3672, 7, 9, 0, 0, 3672, 3673,
-		7, 5, 0, 0, 3673, 3674, 7, 27, 0, 0, 3674, 3675, 7, 10, 0, 0, 3675, 3676,
-		7, 24, 0, 0, 3676, 3677, 7, 19, 0, 0, 3677, 3678, 7, 17, 0, 0, 3678, 3679,
-		7, 7, 0, 0, 3679, 3680, 7, 16, 0, 0, 3680, 642, 1, 0, 0, 0, 3681, 3682,
-		7, 9, 0, 0, 3682, 3683, 7, 14, 0, 0, 3683, 3684, 7, 20, 0, 0, 3684, 3685,
-		7, 10, 0, 0, 3685, 3686, 7, 15, 0, 0, 3686, 3687, 7, 5, 0, 0, 3687, 644,
-		1, 0, 0, 0, 3688, 3689, 7, 9, 0, 0, 3689, 3690, 7, 14, 0, 0, 3690, 3691,
-		7, 13, 0, 0, 3691, 3692, 7, 19, 0, 0, 3692, 3693, 7, 6, 0, 0, 3693, 3694,
-		7, 6, 0, 0, 3694, 646, 1, 0, 0, 0, 3695, 3696, 7, 9, 0, 0, 3696, 3697,
-		7, 10, 0, 0, 3697, 3698, 7, 5, 0, 0, 3698, 3699, 7, 13, 0, 0, 3699, 3700,
-		7, 14, 0, 0, 3700, 3701, 7, 20, 0, 0, 3701, 648, 1, 0, 0, 0, 3702, 3703,
-		7, 9, 0, 0, 3703, 3704, 7, 10, 0, 0, 3704, 3705, 7, 14, 0, 0, 3705, 3706,
-		7, 19, 0, 0, 3706, 3707, 7, 7, 0, 0, 3707, 3708, 7, 12, 0, 0, 3708, 650,
-		1, 0, 0, 0, 3709, 3710, 7, 9, 0, 0, 3710, 3711, 7, 10, 0, 0, 3711, 3712,
-		7, 14, 0, 0, 3712, 3713, 7, 22, 0, 0, 3713, 3714, 7, 13, 0, 0, 3714, 3715,
-		7, 17, 0, 0, 3715, 3716, 7, 16, 0, 0, 3716, 3717, 7, 8, 0, 0, 3717, 652,
-		1, 0, 0, 0, 3718, 3719, 7, 9, 0, 0, 3719, 3720, 7, 10, 0, 0, 3720, 3721,
-		7, 28, 0, 0, 3721, 3722, 7, 22, 0, 0, 3722, 3723, 7, 10, 0, 0, 3723, 3724,
-		7, 7, 0, 0, 3724, 3725, 7, 14, 0, 0, 3725, 3726, 7, 10, 0, 0, 3726, 654,
-		1, 0, 0, 0, 3727, 3728, 7, 9, 0, 0, 3728, 3729, 7, 10, 0, 0, 3729, 3730,
-		7, 28, 0, 0, 3730, 3731, 7, 22, 0, 0, 3731, 3732, 7, 10, 0, 0, 3732, 3733,
-		7, 7, 0, 0, 3733, 3734, 7, 14, 0, 0, 3734, 3735, 7, 10, 0, 0, 3735, 3736,
-		7, 9, 0, 0, 3736, 656, 1, 0, 0, 0, 3737, 3738, 7, 9, 0, 0, 3738, 3739,
-		7, 10, 0, 0, 3739, 3740, 7, 13, 0, 0, 3740, 3741, 7, 17, 0, 0, 3741, 3742,
-		7, 5, 0, 0, 3742, 3743, 7, 6, 0, 0, 3743, 3744, 7, 17, 0, 0, 3744, 3745,
-		7, 11, 0, 0, 3745, 3746, 7, 5, 0, 0, 3746, 3747, 7, 18, 0, 0, 3747, 3748,
-		7, 6, 0, 0, 3748, 3749, 7, 10, 0, 0, 3749, 658, 1, 0, 0, 0, 3750, 3751,
-		7, 9, 0, 0, 3751, 3752, 7, 10, 0, 0, 3752, 3753, 7, 13, 0, 0, 3753, 3754,
-		7, 27, 0, 0, 3754, 3755, 7, 10, 0, 0, 3755, 3756, 7, 13, 0, 0, 3756, 660,
-		1, 0, 0, 0, 3757, 3758, 7, 9, 0, 0, 3758, 3759, 7, 10, 0, 0, 3759, 3760,
-		7, 9, 0, 0, 3760, 3761, 7, 9, 0, 0, 3761, 3762, 7, 17, 0, 0, 3762, 3763,
-		7, 19, 0, 0, 3763, 3764, 7, 7, 0, 0, 3764, 662, 1, 0, 0, 0, 3765, 3766,
-		7, 9, 0, 0, 3766, 3767, 7, 10, 0, 0, 3767, 3768, 7, 16, 0, 0, 3768, 664,
-		1, 0, 0, 0, 3769, 3770, 7, 9, 0, 0, 3770, 3771, 7, 20, 0, 0, 3771, 3772,
-		7, 5, 0, 0, 3772, 3773, 7, 13, 0, 0, 3773, 3774, 7, 10, 0, 0, 3774, 666,
-		1, 0, 0, 0, 3775, 3776, 7, 9, 0, 0, 3776, 3777, 7, 20, 0, 0, 3777, 3778,
-		7, 19, 0, 0, 3778, 3779, 7, 29, 0, 0, 3779, 668, 1, 0, 0, 0, 3780, 3781,
-		7, 9, 0, 0, 3781, 3782, 7, 17, 0, 0, 3782, 3783, 7, 15, 0, 0, 3783, 3784,
-		7, 24, 0, 0, 3784, 3785, 7, 6, 0, 0, 3785, 3786, 7, 10, 0, 0, 3786, 670,
-		1, 0, 0, 0, 3787, 3788, 7, 9, 0, 0, 3788, 3789, 7, 7, 0, 0, 3789, 3790,
-		7, 5, 0, 0, 3790, 3791, 7, 24, 0, 0, 3791, 3792, 7, 9, 0, 0, 3792, 3793,
-		7, 20, 0, 0, 3793, 3794, 7, 19, 0, 0, 3794, 3795, 7, 16, 0, 0, 3795, 672,
-		1, 0, 0, 0, 3796, 3797, 7, 9, 0, 0, 3797, 3798, 7, 16, 0, 0, 3798, 3799,
-		7, 5, 0, 0, 3799, 3800, 7, 18, 0, 0, 3800, 3801, 7, 6, 0, 0, 3801, 3802,
-		7, 10, 0, 0, 3802, 674, 1, 0, 0, 0, 3803, 3804, 7, 9, 0, 0, 3804, 3805,
-		7, 16, 0, 0, 3805, 3806, 7, 5, 0, 0, 3806, 3807, 7, 7, 0, 0, 3807, 3808,
-		7, 12, 0, 0, 3808, 3809, 7, 5, 0, 0, 3809, 3810, 7, 6, 0, 0, 3810, 3811,
-		7, 19, 0, 0, 3811, 3812, 7, 7, 0, 0, 3812, 3813, 7, 10, 0, 0, 3813, 676,
-		1, 0, 0, 0, 3814, 3815, 7, 9, 0, 0, 3815, 3816, 7, 16, 0, 0, 3816, 3817,
-		7, 5, 0, 0, 3817, 3818, 7, 13, 0, 0, 3818, 3819, 7, 16, 0, 0, 3819, 678,
-		1, 0, 0, 0, 3820, 3821, 7, 9, 0, 0, 3821, 3822, 7, 16, 0, 0, 3822, 3823,
-		7, 5, 0, 0, 3823, 3824, 7, 16, 0, 0, 3824, 3825, 7, 10, 0, 0, 3825, 3826,
-		7, 15, 0, 0, 3826, 3827, 7, 10, 0, 0, 3827, 3828, 7, 7, 0, 0, 3828, 3829,
-		7, 16, 0, 0, 3829, 680, 1, 0, 0, 0, 3830, 3831, 7, 9, 0, 0, 3831, 3832,
-		7, 16, 0, 0, 3832, 3833, 7, 5, 0, 0, 3833, 3834, 7, 16, 0, 0, 3834, 3835,
-		7, 17, 0, 0, 3835, 3836, 7, 9, 0, 0, 3836, 3837, 7, 16, 0, 0, 3837, 3838,
-		7, 17, 0, 0, 3838, 3839, 7, 14, 0, 0, 3839, 3840, 7, 9, 0, 0, 3840, 682,
-		1, 0, 0, 0, 3841, 3842, 7, 9, 0, 0, 3842, 3843, 7, 16, 0, 0, 3843, 3844,
-		7, 12, 0, 0, 3844, 3845, 7, 17, 0, 0, 3845, 3846, 7, 7, 0, 0, 3846, 684,
-		1, 0, 0, 0, 3847, 3848, 7, 9, 0, 0, 3848, 3849, 7, 16, 0, 0, 3849, 3850,
-		7, 12, 0, 0, 3850, 3851, 7, 19, 0, 0, 3851, 3852, 7, 22, 0, 0, 3852, 3853,
-		7, 16, 0, 0, 3853, 686, 1, 0, 0, 0, 3854, 3855, 7, 9, 0, 0, 3855, 3856,
-		7, 16, 0, 0, 3856, 3857, 7, 19, 0, 0, 3857, 3858, 7, 13, 0, 0, 3858, 3859,
-		7, 5, 0, 0, 3859, 3860, 7, 23, 0, 0, 3860, 3861, 7, 10, 0, 0, 3861, 688,
-		1, 0, 0, 0, 3862, 3863, 7, 9, 0, 0, 3863, 3864, 7, 16, 0, 0, 3864, 3865,
-		7, 13, 0, 0, 3865, 3866, 7, 17, 0, 0, 3866, 3867, 7, 14, 0, 0, 3867, 3868,
-		7, 16, 0, 0, 3868, 690, 1, 0, 0, 0, 3869, 3870, 7, 9, 0, 0, 3870, 3871,
-		7, 16, 0, 0, 3871, 3872, 7, 13, 0, 0, 3872, 3873, 7, 17, 0, 0, 3873, 3874,
-		7, 24, 0, 0, 3874, 692, 1, 0, 0, 0, 3875, 3876, 7, 9, 0, 0, 3876, 3877,
-		7, 8, 0, 0, 3877, 3878, 7, 9, 0, 0, 3878, 3879, 7, 17, 0, 0, 3879, 3880,
-		7, 12, 0, 0, 3880, 694, 1, 0, 0, 0, 3881, 3882, 7, 9, 0, 0, 3882, 3883,
-		7, 8, 0, 0, 3883, 3884, 7, 9, 0, 0, 3884, 3885, 7, 16, 0, 0, 3885, 3886,
-		7, 10, 0, 0, 3886, 3887, 7, 15, 0, 0, 3887, 696, 1, 0, 0, 0, 3888, 3889,
-		7, 16, 0, 0, 3889, 3890, 7, 5, 0, 0, 3890, 3891, 7, 18, 0, 0, 3891, 3892,
-		7, 6, 0, 0, 3892, 3893, 7, 10, 0, 0, 3893, 3894, 7, 9, 0, 0, 3894, 698,
-		1, 0, 0, 0, 3895, 3896, 7, 16, 0, 0, 3896, 3897, 7, 5, 0, 0, 3897, 3898,
-		7, 18, 0, 0, 3898, 3899, 7, 6, 0, 0, 3899, 3900, 7, 10, 0, 0, 3900, 3901,
-		7, 9, 0, 0, 3901, 3902, 7, 24, 0, 0, 3902, 3903, 7, 5, 0, 0, 3903, 3904,
-		7, 14, 0, 0, 3904, 3905, 7, 10, 0, 0, 3905, 700, 1, 0, 0, 0, 3906, 3907,
-		7, 16, 0, 0, 3907, 3908, 7, 10, 0, 0, 3908, 3909, 7, 15, 0, 0, 3909, 3910,
-		7, 24, 0, 0, 3910, 702, 1, 0, 0, 0, 3911, 3912, 7, 16, 0, 0, 3912, 3913,
-		7, 10, 0, 0, 3913, 3914, 7, 15, 0, 0, 3914, 3915, 7, 24, 0, 0, 3915, 3916,
-		7, 6, 0, 0, 3916, 3917, 7, 5, 0, 0, 3917, 3918, 7, 16, 0, 0, 3918, 3919,
-		7, 10, 0, 0, 3919, 704, 1, 0, 0, 0, 3920, 3921, 7, 16, 0, 0, 3921, 3922,
-		7, 10, 0, 0, 3922, 3923, 7, 15, 0, 0, 3923, 3924, 7, 24, 0, 0, 3924, 3925,
-		7, 19, 0, 0, 3925, 3926, 7, 13, 0, 0, 3926, 3927, 7, 5, 0, 0, 3927, 3928,
-		7, 13, 0, 0, 3928, 3929, 7, 8, 0, 0, 3929, 706, 1, 0, 0, 0, 3930, 3931,
-		7, 16, 0, 0, 3931, 3932, 7, 10, 0, 0, 3932, 3933, 7, 26, 0, 0, 3933, 3934,
-		7, 16, 0, 0, 3934, 708, 1, 0, 0, 0, 3935, 3936, 7, 16, 0, 0, 3936, 3937,
-		7, 13, 0, 0, 3937, 3938, 7, 5, 0, 0, 3938, 3939, 7, 7, 0, 0, 3939, 3940,
-		7, 9, 0, 0, 3940, 3941, 7, 5, 0, 0, 3941, 3942, 7, 14, 0, 0, 3942, 3943,
-		7, 16, 0, 0, 3943, 3944, 7, 17, 0, 0, 3944, 3945, 7, 19, 0, 0, 3945, 3946,
-		7, 7, 0, 0, 3946, 710, 1, 0, 0, 0, 3947, 3948, 7, 16, 0, 0, 3948, 3949,
-		7, 13, 0, 0, 3949, 3950, 7, 17, 0, 0, 3950, 3951, 7, 23, 0, 0, 3951, 3952,
-		7, 23, 0, 0, 3952, 3953, 7, 10, 0, 0, 3953, 3954, 7, 13, 0, 0, 3954, 712,
-		1, 0, 0, 0, 3955, 3956, 7, 16, 0, 0, 3956, 3957, 7, 13, 0, 0, 3957, 3958,
-		7, 22, 0, 0, 3958, 3959, 7, 7, 0, 0, 3959, 3960, 7, 14, 0, 0, 3960, 3961,
-		7, 5, 0, 0, 3961, 3962, 7, 16, 0, 0, 3962, 3963, 7, 10, 0, 0, 3963, 714,
-		1, 0, 0, 0, 3964, 3965, 7, 16, 0, 0, 3965, 3966, 7, 13, 0, 0, 3966, 3967,
-		7, 22, 0, 0, 3967, 3968, 7, 9, 0, 0, 3968, 3969, 7, 16, 0, 0, 3969, 3970,
-		7, 10, 0, 0, 3970, 3971, 7, 12, 0, 0, 3971, 716, 1, 0, 0, 0, 3972, 3973,
-		7, 16, 0, 0, 3973, 3974, 7, 8, 0, 0, 3974, 3975, 7, 24, 0, 0, 3975, 3976,
-		7, 10, 0, 0, 3976, 718, 1, 0, 0, 0, 3977, 3978, 7, 16, 0, 0, 3978, 3979,
-		7, 8, 0, 0, 3979, 3980, 7, 24, 0, 0, 3980, 3981, 7, 10, 0, 0, 3981, 3982,
-		7, 9, 0, 0, 3982, 720, 1, 0, 0, 0, 3983, 3984, 7, 22, 0, 0, 3984, 3985,
-		7, 7, 0, 0, 3985, 3986, 7, 18, 0, 0, 3986, 3987, 7, 19, 0, 0, 3987, 3988,
-		7, 22, 0, 0, 3988, 3989, 7, 7, 0, 0, 3989, 3990, 7, 12, 0, 0, 3990, 3991,
-		7, 10, 0, 0, 3991, 3992, 7, 12, 0, 0, 3992, 722, 1, 0, 0, 0, 3993, 3994,
-		7, 22, 0, 0, 3994, 3995, 7, 7, 0, 0, 3995, 3996, 7, 14, 0, 0, 3996, 3997,
-		7, 19, 0, 0, 3997, 3998, 7, 15, 0, 0, 3998, 3999, 7, 15, 0, 0, 3999, 4000,
-		7, 17, 0, 0, 4000, 4001, 7, 16, 0, 0, 4001, 4002, 7, 16, 0, 0, 4002, 4003,
-		7, 10, 0, 0, 4003, 4004, 7, 12, 0, 0, 4004, 724, 1, 0, 0, 0, 4005, 4006,
-		7, 22, 0, 0, 4006, 4007, 7, 7, 0, 0, 4007, 4008, 7, 10, 0, 0, 4008, 4009,
-		7, 7, 0, 0, 4009, 4010, 7, 14, 0, 0, 4010, 4011, 7, 13, 0, 0, 4011, 4012,
-		7, 8, 0, 0, 4012, 4013, 7, 24, 0, 0, 4013, 4014, 7, 16, 0, 0, 4014, 4015,
-		7, 10, 0, 0, 4015, 4016, 7, 12, 0, 0, 4016, 726, 1, 0, 0, 0, 4017, 4018,
-		7, 22, 0, 0, 4018, 4019, 7, 7, 0, 0, 4019, 4020, 7, 21, 0, 0, 4020, 4021,
-		7, 7, 0, 0, 4021, 4022, 7, 19, 0, 0, 4022, 4023, 7, 29, 0, 0, 4023, 4024,
-		7, 7, 0, 0, 4024, 728, 1, 0, 0, 0, 4025, 4026, 7, 22, 0, 0, 4026, 4027,
-		7, 7, 0, 0, 4027, 4028, 7, 6, 0, 0, 4028, 4029, 7, 17, 0, 0, 4029, 4030,
-		7, 9, 0, 0, 4030, 4031, 7, 16, 0, 0, 4031, 4032, 7, 10, 0, 0, 4032, 4033,
-		7, 7, 0, 0, 4033, 730, 1, 0, 0, 0, 4034, 4035, 7, 22, 0, 0, 4035, 4036,
-		7, 7, 0, 0, 4036, 4037, 7, 6, 0, 0, 4037, 4038, 7, 19, 0, 0, 4038, 4039,
-		7, 23, 0, 0, 4039, 4040, 7, 23, 0, 0, 4040, 4041, 7, 10, 0, 0, 4041, 4042,
-		7, 12, 0, 0, 4042, 732, 1, 0, 0, 0, 4043, 4044, 7, 22, 0, 0, 4044, 4045,
-		7, 7, 0, 0, 4045, 4046, 7, 16, 0, 0, 4046, 4047, 7, 17, 0, 0, 4047, 4048,
-		7, 6, 0, 0, 4048, 734, 1, 0, 0, 0, 4049, 4050, 7, 22, 0, 0, 4050, 4051,
-		7, 24, 0, 0, 4051, 4052, 7, 12, 0, 0, 4052, 4053, 7, 5, 0, 0, 4053, 4054,
-		7, 16, 0, 0, 4054, 4055, 7, 10, 0, 0, 4055, 736, 1, 0, 0, 0, 4056, 4057,
-		7, 27, 0, 0, 4057, 4058, 7, 5, 0, 0, 4058, 4059, 7, 14, 0, 0, 4059, 4060,
-		7, 22, 0, 0, 4060, 4061, 7, 22, 0, 0, 4061, 4062, 7, 15, 0, 0, 4062, 738,
-		1, 0, 0, 0, 4063, 4064, 7, 27, 0, 0, 4064, 4065, 7, 5, 0, 0, 4065, 4066,
-		7, 6, 0, 0, 4066, 4067, 7, 17, 0, 0, 4067, 4068, 7, 12, 0, 0, 4068, 740,
-		1, 0, 0, 0, 4069, 4070, 7, 27, 0, 0, 4070, 4071, 7, 5, 0, 0, 4071, 4072,
-		7, 6, 0, 0, 4072, 4073, 7, 17, 0, 0, 4073, 4074, 7, 12, 0, 0, 4074, 4075,
-		7, 5, 0, 0, 4075, 4076, 7, 16, 0, 0, 4076, 4077, 7, 10, 0, 0, 4077, 742,
-		1, 0, 0, 0, 4078, 4079, 7, 27, 0, 0, 4079, 4080, 7, 5, 0, 0, 4080, 4081,
-		7, 6, 0, 0, 4081, 4082, 7, 17, 0, 0, 4082, 4083, 7, 12, 0, 0, 4083, 4084,
-		7, 5, 0, 0, 4084, 4085, 7, 16, 0, 0, 4085, 4086, 7, 19, 0, 0, 4086, 4087,
-		7, 13, 0, 0, 4087, 744, 1, 0, 0, 0, 4088, 4089, 7, 27, 0, 0, 4089, 4090,
-		7, 5, 0, 0, 4090, 4091, 7, 13, 0, 0, 4091, 4092, 7, 8, 0, 0, 4092, 4093,
-		7, 17, 0, 0, 4093, 4094, 7, 7, 0, 0, 4094, 4095, 7, 23, 0, 0, 4095, 746,
-		1, 0, 0, 0, 4096, 4097, 7, 27, 0, 0, 4097, 4098, 7, 10, 0, 0, 4098, 4099,
-		7, 13, 0, 0, 4099, 4100, 7, 9, 0, 0, 4100, 4101, 7, 17, 0, 0, 4101, 4102,
-		7, 19, 0, 0, 4102, 4103, 7, 7, 0, 0, 4103, 748, 1, 0, 0, 0, 4104, 4105,
-		7, 27, 0, 0, 4105, 4106, 7, 17, 0, 0, 4106, 4107, 7, 10, 0, 0, 4107, 4108,
-		7, 29, 0, 0, 4108, 750, 1, 0, 0, 0, 4109, 4110, 7, 27, 0, 0, 4110, 4111,
-		7, 19, 0, 0, 4111, 4112, 7, 6, 0, 0, 4112, 4113, 7, 5, 0, 0, 4113, 4114,
-		7, 16, 0, 0, 4114, 4115, 7, 17, 0, 0, 4115, 4116, 7, 6, 0, 0, 4116, 4117,
-		7, 10, 0, 0, 4117, 752, 1, 0, 0, 0, 4118, 4119, 7, 29, 0, 0, 4119, 4120,
-		7, 20, 0, 0, 4120, 4121, 7, 17, 0, 0, 4121, 4122, 7, 16, 0, 0, 4122, 4123,
-		7, 10, 0, 0, 4123, 4124, 7, 9, 0, 0, 4124, 4125, 7, 24, 0, 0, 4125, 4126,
-		7, 5, 0, 0, 4126, 4127, 7, 14, 0, 0, 4127, 4128, 7, 10, 0, 0, 4128, 754,
-		1, 0, 0, 0, 4129, 4130, 7, 29, 0, 0, 4130, 4131, 7, 17, 0, 0, 4131, 4132,
-		7, 16, 0, 0, 4132, 4133, 7, 20, 0, 0, 4133, 4134, 7, 19, 0, 0, 4134, 4135,
-		7, 22, 0, 0, 4135, 4136, 7, 16, 0, 0, 4136, 756, 1, 0, 0, 0, 4137, 4138,
-		7, 29, 0, 0, 4138, 4139, 7, 19, 0, 0, 4139, 4140, 7, 13, 0, 0, 4140, 4141,
-		7, 21, 0, 0, 4141, 758, 1, 0, 0, 0, 4142, 4143, 7, 29, 0, 0, 4143, 4144,
-		7, 13, 0, 0, 4144, 4145, 7, 5, 0, 0, 4145, 4146, 7, 24, 0, 0, 4146, 4147,
-		7, 24, 0, 0, 4147, 4148, 7, 10, 0, 0, 4148, 4149, 7, 13, 0, 0, 4149, 760,
-		1, 0, 0, 0, 4150, 4151, 7, 29, 0, 0, 4151, 4152, 7, 13, 0, 0, 4152, 4153,
-		7, 17, 0, 0, 4153, 4154, 7, 16, 0, 0, 4154, 4155, 7, 10, 0, 0, 4155, 762,
-		1, 0, 0, 0, 4156, 4157, 7, 26, 0, 0, 4157, 4158, 7, 15, 0, 0, 4158, 4159,
-		7, 6, 0, 0, 4159, 764, 1, 0, 0, 0, 4160, 4161, 7, 8, 0, 0, 4161, 4162,
-		7, 10, 0, 0, 4162, 4163, 7, 5, 0, 0, 4163, 4164, 7, 13, 0, 0, 4164, 766,
-		1, 0, 0, 0, 4165, 4166, 7, 8, 0, 0, 4166, 4167, 7, 10, 0, 0, 4167, 4168,
-		7, 9, 0, 0, 4168, 768, 1, 0, 0, 0, 4169, 4170, 7, 11, 0, 0, 4170, 4171,
-		7, 19, 0, 0, 4171, 4172, 7, 7, 0, 0, 4172, 4173, 7, 10, 0, 0, 4173, 770,
-		1, 0, 0, 0, 4174, 4175, 7, 5, 0, 0, 4175, 4176, 7, 16, 0, 0, 4176, 4177,
-		7, 19, 0, 0, 4177, 4178, 7, 15, 0, 0, 4178, 4179, 7, 17, 0, 0, 4179, 4180,
-		7, 14, 0, 0, 4180, 772, 1, 0, 0, 0, 4181, 4182, 7, 18, 0, 0, 4182, 4183,
-		7, 10, 0, 0, 4183, 4184, 7, 16, 0, 0, 4184, 4185, 7, 29, 0, 0, 4185, 4186,
-		7, 10, 0, 0, 4186, 4187, 7, 10, 0, 0, 4187, 4188, 7, 7, 0, 0, 4188, 774,
-		1, 0, 0, 0, 4189, 4190, 7, 18, 0, 0, 4190, 4191, 7, 17, 0, 0, 4191, 4192,
-		7, 23, 0, 0, 4192, 4193, 7, 17, 0, 0, 4193, 4194, 7, 7, 0, 0, 4194, 4195,
-		7, 16, 0, 0, 4195, 776, 1, 0, 0, 0, 4196, 4197, 7, 18, 0, 0, 4197, 4198,
-		7, 17, 0, 0, 4198, 4199, 7, 16, 0, 0, 4199, 778, 1, 0, 0, 0, 4200, 4201,
-		7, 18, 0, 0, 4201, 4202, 7, 19, 0, 0, 4202, 4203, 7, 19, 0, 0, 4203, 4204,
-		7, 6, 0, 0, 4204, 4205, 7, 10, 0, 0, 4205, 4206, 7, 5, 0, 0, 4206, 4207,
-		7, 7, 0, 0, 4207, 780, 1, 0, 0, 0, 4208, 4209, 7, 14, 0, 0, 4209, 4210,
-		7, 20, 0, 0, 4210, 4211, 7, 5, 0, 0, 4211, 4212, 7, 13, 0, 0, 4212, 782,
-		1, 0, 0, 0, 4213, 4214, 7, 14, 0, 0, 4214, 4215, 7, 20, 0, 0, 4215, 4216,
-		7, 5, 0, 0, 4216, 4217, 7, 13, 0, 0, 4217, 4218, 7, 5, 0, 0, 4218, 4219,
-		7, 14, 0, 0, 4219, 4220, 7, 16, 0, 0, 4220, 4221, 7, 10, 0, 0, 4221, 4222,
-		7, 13, 0, 0, 4222, 784, 1, 0, 0, 0, 4223, 4224, 7, 14, 0, 0, 4224, 4225,
-		7, 19, 0, 0, 4225, 4226, 7, 5, 0, 0, 4226, 4227, 7, 6, 0, 0, 4227, 4228,
-		7, 10, 0, 0, 4228, 4229, 7, 9, 0, 0, 4229, 4230, 7, 14, 0, 0, 4230, 4231,
-		7, 10, 0, 0, 4231, 786, 1, 0, 0, 0, 4232, 4233, 7, 12, 0, 0, 4233, 4234,
-		7, 10, 0, 0, 4234, 4235, 7, 14, 0, 0, 4235, 788, 1, 0, 0, 0, 4236, 4237,
-		7, 12, 0, 0, 4237, 4238, 7, 10, 0, 0, 4238, 4239, 7, 14, 0, 0, 4239, 4240,
-		7, 17, 0, 0, 4240, 4241, 7, 15, 0, 0, 4241, 4242, 7, 5, 0, 0, 4242, 4243,
-		7, 6, 0, 0, 4243, 790, 1, 0, 0, 0, 4244, 4245, 7, 10, 0, 0, 4245, 4246,
-		7, 26, 0, 0, 4246, 4247, 7, 17, 0, 0, 4247, 4248, 7, 9, 0, 0, 4248, 4249,
-		7, 16, 0, 0, 4249, 4250, 7, 9, 0, 0, 4250, 792, 1, 0, 0, 0, 4251, 4252,
-		7, 10, 0, 0, 4252, 4253, 7, 26, 0, 0, 4253, 4254, 7, 16, 0, 0, 4254, 4255,
-		7, 13, 0, 0, 4255, 4256, 7, 5, 0, 0, 4256, 4257, 7, 14, 0, 0, 4257, 4258,
-		7, 16, 0, 0, 4258, 794, 1, 0, 0, 0, 4259, 4260, 7, 25, 0, 0, 4260, 4261,
-		7, 6, 0, 0, 4261, 4262, 7, 19, 0, 0, 4262, 4263, 7, 5, 0, 0, 4263, 4264,
-		7, 16, 0, 0, 4264, 796, 1, 0, 0, 0, 4265, 4266, 7, 23, 0, 0, 4266, 4267,
-		7, 13, 0, 0, 4267, 4268, 7, 10, 0, 0, 4268, 4269, 7, 5, 0, 0, 4269, 4270,
-		7, 16, 0, 0, 4270, 4271, 7, 10, 0, 0, 4271, 4272, 7, 9, 0, 0, 4272, 4273,
-		7, 16, 0, 0, 4273, 798, 1, 0, 0, 0, 4274, 4275, 7, 17, 0, 0, 4275, 4276,
-		7, 7, 0, 0, 4276, 4277, 7, 19, 0, 0, 4277, 4278, 7, 22, 0, 0, 4278, 4279,
-		7, 16, 0, 0, 4279, 800, 1, 0, 0, 0, 4280, 4281, 7, 17, 0, 0, 4281, 4282,
-		7, 7, 0, 0, 4282, 4283, 7, 16, 0, 0, 4283, 802, 1, 0, 0, 0, 4284, 4285,
-		7, 17, 0, 0, 4285, 4286, 7, 7, 0, 0, 4286, 4287, 7, 16, 0, 0, 4287, 4288,
-		7, 10, 0, 0, 4288, 4289, 7, 23, 0, 0, 4289, 4290, 7, 10, 0, 0, 4290, 4291,
-		7, 13, 0, 0, 4291, 804, 1, 0, 0, 0, 4292, 4293, 7, 17, 0, 0, 4293, 4294,
-		7, 7, 0, 0, 4294, 4295, 7, 16, 0, 0, 4295, 4296, 7, 10, 0, 0, 4296, 4297,
-		7, 13, 0, 0, 4297, 4298, 7, 27, 0, 0, 4298, 4299, 7, 5, 0, 0, 4299, 4300,
-		7, 6, 0, 0, 4300, 806, 1, 0, 0, 0, 4301, 4302, 7, 6, 0, 0, 4302, 4303,
-		7, 10, 0, 0, 4303, 4304, 7, 5, 0, 0, 4304, 4305, 7, 9, 0, 0, 4305, 4306,
-		7, 16, 0, 0, 4306, 808, 1, 0, 0, 0, 4307, 4308, 7, 7, 0, 0, 4308, 4309,
-		7, 5, 0, 0, 4309, 4310, 7, 16, 0, 0, 4310, 4311, 7, 17, 0, 0, 4311, 4312,
-		7, 19, 0, 0, 4312, 4313, 7, 7, 0, 0, 4313, 4314, 7, 5, 0, 0, 4314, 4315,
-		7, 6, 0, 0, 4315, 810, 1, 0, 0, 0, 4316, 4317, 7, 7, 0, 0, 4317, 4318,
-		7, 14, 0, 0, 4318, 4319, 7, 20, 0, 0, 4319, 4320, 7, 5, 0, 0, 4320, 4321,
-		7, 13, 0, 0, 4321, 812, 1, 0, 0, 0, 4322, 4323, 7, 7, 0, 0, 4323, 4324,
-		7, 19, 0, 0, 4324, 4325, 7, 7, 0, 0, 4325, 4326, 7, 10, 0, 0, 4326, 814,
-		1, 0, 0, 0, 4327, 4328, 7, 7, 0, 0, 4328, 4329, 7, 22, 0, 0, 4329, 4330,
-		7, 6, 0, 0, 4330, 4331, 7, 6, 0, 0, 4331, 4332, 7, 17, 0, 0, 4332, 4333,
-		7, 25, 0, 0, 4333, 816, 1, 0, 0, 0, 4334, 4335, 7, 7, 0, 0, 4335, 4336,
-		7, 22, 0, 0, 4336, 4337, 7, 15, 0, 0, 4337, 4338, 7, 10, 0, 0, 4338, 4339,
-		7, 13, 0, 0, 4339, 4340, 7, 17, 0, 0, 4340, 4341, 7, 14, 0, 0, 4341, 818,
-		1, 0, 0, 0, 4342, 4343, 7, 19, 0, 0, 4343, 4344, 7, 27, 0, 0, 4344, 4345,
-		7, 10, 0, 0, 4345, 4346, 7, 13, 0, 0, 4346, 4347, 7, 6, 0, 0, 4347, 4348,
-		7, 5, 0, 0, 4348, 4349, 7, 8, 0, 0, 4349, 820, 1, 0, 0, 0, 4350, 4351,
-		7, 24, 0, 0, 4351, 4352, 7, 5, 0, 0, 4352, 4353, 7, 13, 0, 0, 4353, 4354,
-		7, 5, 0, 0, 4354, 4355, 7, 15, 0, 0, 4355, 4356, 7, 10, 0, 0, 4356, 4357,
-		7, 16, 0, 0, 4357, 4358, 7, 10, 0, 0, 4358, 4359, 7, 13, 0, 0, 4359, 822,
-		1, 0, 0, 0, 4360, 4361, 7, 24, 0, 0, 4361, 4362, 7, 19, 0, 0, 4362, 4363,
-		7, 9, 0, 0, 4363, 4364, 7, 17, 0, 0, 4364, 4365, 7, 16, 0, 0, 4365, 4366,
-		7, 17, 0, 0, 4366, 4367, 7, 19, 0, 0, 4367, 4368, 7, 7, 0, 0, 4368, 824,
-		1, 0, 0, 0, 4369, 4370, 7, 24, 0, 0, 4370, 4371, 7, 13, 0, 0, 4371, 4372,
-		7, 10, 0, 0, 4372, 4373, 7, 14, 0, 0, 4373, 4374, 7, 17, 0, 0, 4374, 4375,
-		7, 9, 0, 0, 4375, 4376, 7, 17, 0, 0, 4376, 4377, 7, 19, 0, 0, 4377, 4378,
-		7, 7, 0, 0, 4378, 826, 1, 0, 0, 0, 4379, 4380, 7, 13, 0, 0, 4380, 4381,
-		7, 10, 0, 0, 4381, 4382, 7, 5, 0, 0, 4382, 4383, 7, 6, 0, 0, 4383, 828,
-		1, 0, 0, 0, 4384, 4385, 7, 13, 0, 0, 4385, 4386, 7, 19, 0, 0, 4386, 4387,
-		7, 29, 0, 0, 4387, 830, 1, 0, 0, 0, 4388, 4389, 7, 9, 0, 0, 4389, 4390,
-		7, 10, 0, 0, 4390, 4391, 7, 16, 0, 0, 4391, 4392, 7, 19, 0, 0, 4392, 4393,
-		7, 25, 0, 0, 4393, 832, 1, 0, 0, 0, 4394, 4395, 7, 9, 0, 0, 4395, 4396,
-		7, 15, 0, 0, 4396, 4397, 7, 5, 0, 0, 4397, 4398, 7, 6, 0, 0, 4398, 4399,
-		7, 6, 0, 0, 4399, 4400, 7, 17, 0, 0, 4400, 4401, 7, 7, 0, 0, 4401, 4402,
-		7, 16, 0, 0, 4402, 834, 1, 0, 0, 0, 4403, 4404, 7, 9, 0, 0, 4404, 4405,
-		7, 22, 0, 0, 4405, 4406, 7, 18, 0, 0, 4406, 4407, 7, 9, 0, 0, 4407, 4408,
-		7, 16, 0, 0, 4408, 4409, 7, 13, 0, 0, 4409, 4410, 7, 17, 0, 0, 4410, 4411,
-		7, 7, 0, 0, 4411, 4412, 7, 23, 0, 0, 4412, 836, 1, 0, 0, 0, 4413, 4414,
-		7, 16, 0, 0, 4414, 4415, 7, 17, 0, 0, 4415, 4416, 7, 15, 0, 0, 4416, 4417,
-		7, 10, 0, 0, 4417, 838, 1, 0, 0, 0, 4418, 4419, 7, 16, 0, 0, 4419, 4420,
-		7, 17, 0, 0, 4420, 4421, 7, 15, 0, 0, 4421, 4422, 7, 10, 0, 0, 4422, 4423,
-		7, 9, 0, 0, 4423, 4424, 7, 16, 0, 0, 4424, 4425, 7, 5, 0, 0, 4425, 4426,
-		7, 15, 0, 0, 4426, 4427, 7, 24, 0, 0, 4427, 840, 1, 0, 0, 0, 4428, 4429,
-		7, 16, 0, 0, 4429, 4430, 7, 13, 0, 0, 4430, 4431, 7, 10, 0, 0, 4431, 4432,
-		7, 5, 0, 0, 4432, 4433, 7, 16, 0, 0, 4433, 842, 1, 0, 0, 0, 4434, 4435,
-		7, 16, 0, 0, 4435, 4436, 7, 13, 0, 0, 4436, 4437, 7, 17, 0, 0, 4437, 4438,
-		7, 15, 0, 0, 4438, 844, 1, 0, 0, 0, 4439, 4440, 7, 27, 0, 0, 4440, 4441,
-		7, 5, 0, 0, 4441, 4442, 7, 6, 0, 0, 4442, 4443, 7, 22, 0, 0, 4443, 4444,
-		7, 10, 0, 0, 4444, 4445, 7, 9, 0, 0, 4445, 846, 1, 0, 0, 0, 4446, 4447,
-		7, 27, 0, 0, 4447, 4448, 7, 5, 0, 0, 4448, 4449, 7, 13, 0, 0, 4449, 4450,
-		7, 14, 0, 0, 4450, 4451, 7, 20, 0, 0, 4451, 4452, 7, 5, 0, 0, 4452, 4453,
-		7, 13, 0, 0, 4453, 848, 1, 0, 0, 0, 4454, 4455, 7, 26, 0, 0, 4455, 4456,
-		7, 15, 0, 0, 4456, 4457, 7, 6, 0, 0, 4457, 4458, 7, 5, 0, 0, 4458, 4459,
-		7, 16, 0, 0, 4459, 4460, 7, 16, 0, 0, 4460, 4461, 7, 13, 0, 0, 4461, 4462,
-		7, 17, 0, 0, 4462, 4463, 7, 18, 0, 0, 4463, 4464, 7, 22, 0, 0, 4464, 4465,
-		7, 16, 0, 0, 4465, 4466, 7, 10, 0, 0, 4466, 4467, 7, 9, 0, 0, 4467, 850,
-		1, 0, 0, 0, 4468, 4469, 7, 26, 0, 0, 4469, 4470, 7, 15, 0, 0, 4470, 4471,
-		7, 6, 0, 0, 4471, 4472, 7, 14, 0, 0, 4472, 4473, 7, 19, 0, 0, 4473, 4474,
-		7, 15, 0, 0, 4474, 4475, 7, 15, 0, 0, 4475, 4476, 7, 10, 0, 0, 4476, 4477,
-		7, 7, 0, 0, 4477, 4478, 7, 16, 0, 0, 4478, 852, 1, 0, 0, 0, 4479, 4480,
-		7, 26, 0, 0, 4480, 4481, 7, 15, 0, 0, 4481, 4482, 7, 6, 0, 0, 4482, 4483,
-		7, 5, 0, 0, 4483, 4484, 7, 23, 0, 0, 4484, 4485, 7, 23, 0, 0, 4485, 854,
-		1, 0, 0, 0, 4486, 4487, 7, 26, 0, 0, 4487, 4488, 7, 15, 0, 0, 4488, 4489,
-		7, 6, 0, 0, 4489, 4490, 5, 95, 0, 0, 4490, 4491, 7, 17, 0, 0, 4491, 4492,
-		7, 9, 0, 0, 4492, 4493, 5, 95, 0, 0, 4493, 4494, 7, 29, 0, 0, 4494, 4495,
-		7, 10, 0, 0, 4495, 4496, 7, 6, 0, 0, 4496, 4497, 7, 6, 0, 0, 4497, 4498,
-		5, 95, 0, 0, 4498, 4499, 7, 25, 0, 0, 4499, 4500, 7, 19, 0, 0, 4500, 4501,
-		7, 13, 0, 0, 4501, 4502, 7, 15, 0, 0, 4502, 4503, 7, 10, 0, 0, 4503, 4504,
-		7, 12, 0, 0, 4504, 856, 1, 0, 0, 0, 4505, 4506, 7, 26, 0, 0, 4506, 4507,
-		7, 15, 0, 0, 4507, 4508, 7, 6, 0, 0, 4508, 4509, 5, 95, 0, 0, 4509, 4510,
-		7, 17, 0, 0, 4510, 4511, 7, 9, 0, 0, 4511, 4512, 5, 95, 0, 0, 4512, 4513,
-		7, 29, 0, 0, 4513, 4514, 7, 10, 0, 0, 4514, 4515, 7, 6, 0, 0, 4515, 4516,
-		7, 6, 0, 0, 4516, 4517, 5, 95, 0, 0, 4517, 4518, 7, 25, 0, 0, 4518, 4519,
-		7, 19, 0, 0, 4519, 4520, 7, 13, 0, 0, 4520, 4521, 7, 15, 0, 0, 4521, 4522,
-		7, 10, 0, 0, 4522, 4523, 7, 12, 0, 0, 4523, 4524, 5, 95, 0, 0, 4524, 4525,
-		7, 12, 0, 0, 4525, 4526, 7, 19, 0, 0, 4526, 4527, 7, 14, 0, 0, 4527, 4528,
-		7, 22, 0, 0, 4528, 4529, 7, 15, 0, 0, 4529, 4530, 7, 10, 0, 0, 4530, 4531,
-		7, 7, 0, 0, 4531, 4532, 7, 16, 0, 0, 4532, 858, 1, 0, 0, 0, 4533, 4534,
-		7, 26, 0, 0, 4534, 4535, 7, 15, 0, 0, 4535, 4536, 7, 6, 0, 0, 4536, 4537,
-		5, 95, 0, 0, 4537, 4538, 7, 17, 0, 0, 4538, 4539, 7, 9, 0, 0, 4539, 4540,
-		5, 95, 0, 0, 4540, 4541, 7, 29, 0, 0, 4541, 4542, 7, 10, 0, 0, 4542, 4543,
-		7, 6, 0, 0, 4543, 4544, 7, 6, 0, 0, 4544, 4545, 5, 95, 0, 0, 4545, 4546,
-		7, 25, 0, 0, 4546, 4547, 7, 19, 0, 0, 4547, 4548, 7, 13, 0, 0, 4548, 4549,
-		7, 15, 0, 0, 4549, 4550, 7, 10, 0, 0, 4550, 4551, 7, 12, 0, 0, 4551, 4552,
-		5, 95, 0, 0, 4552, 4553, 7, 14, 0, 0, 4553, 4554, 7, 19, 0, 0, 4554, 4555,
-		7, 7, 0, 0, 4555, 4556, 7, 16, 0, 0, 4556, 4557, 7, 10, 0, 0, 4557, 4558,
-		7, 7, 0, 0, 4558, 4559, 7, 16, 0, 0, 4559, 860, 1, 0, 0, 0, 4560, 4561,
-		7, 26, 0, 0, 4561, 4562, 7, 24, 0, 0, 4562, 4563, 7, 5, 0, 0, 4563, 4564,
-		7, 16, 0, 0, 4564, 4565, 7, 20, 0, 0, 4565, 862, 1, 0, 0, 0, 4566, 4567,
-		7, 26, 0, 0, 4567, 4568, 7, 24, 0, 0, 4568, 4569, 7, 5, 0, 0, 4569, 4570,
-		7, 16, 0, 0, 4570, 4571, 7, 20, 0, 0, 4571, 4572, 5, 95, 0, 0, 4572, 4573,
-		7, 10, 0, 0, 4573, 4574, 7, 26, 0, 0, 4574, 4575, 7, 17, 0, 0, 4575, 4576,
-		7, 9, 0, 0, 4576, 4577, 7, 16, 0, 0, 4577, 4578, 7, 9, 0, 0, 4578, 864,
-		1, 0, 0, 0, 4579, 4580, 7, 26, 0, 0, 4580, 4581, 7, 15, 0, 0, 4581, 4582,
-		7, 6, 0, 0, 4582, 4583, 7, 14, 0, 0, 4583, 4584, 7, 19, 0, 0, 4584, 4585,
-		7, 7, 0, 0, 4585, 4586, 7, 14, 0, 0, 4586, 4587, 7, 5, 0, 0, 4587, 4588,
-		7, 16, 0, 0, 4588, 866, 1, 0, 0, 0, 4589, 4590, 7, 26, 0, 0, 4590, 4591,
-		7, 15, 0, 0, 4591, 4592, 7, 6, 0, 0, 4592, 4593, 7, 10, 0, 0, 4593, 4594,
-		7, 6, 0, 0, 4594, 4595, 7, 10, 0, 0, 4595, 4596, 7, 15, 0, 0, 4596, 4597,
-		7, 10, 0, 0, 4597, 4598, 7, 7, 0, 0, 4598, 4599, 7, 16, 0, 0, 4599, 868,
-		1, 0, 0, 0, 4600, 4601, 7, 26, 0, 0, 4601, 4602, 7, 15, 0, 0, 4602, 4603,
-		7, 6, 0, 0, 4603, 4604, 7, 10, 0, 0, 4604, 4605, 7, 26, 0, 0, 4605, 4606,
-		7, 17, 0, 0, 4606, 4607, 7, 9, 0, 0, 4607, 4608, 7, 16, 0, 0, 4608, 4609,
-		7, 9, 0, 0, 4609, 870, 1, 0, 0, 0, 4610, 4611, 7, 26, 0, 0, 4611, 4612,
-		7, 15, 0, 0, 4612, 4613, 7, 6, 0, 0, 4613, 4614, 7, 25, 0, 0, 4614, 4615,
-		7, 19, 0, 0, 4615, 4616, 7, 13, 0, 0, 4616, 4617, 7, 10, 0, 0, 4617, 4618,
-		7, 9, 0, 0, 4618, 4619, 7, 16, 0, 0, 4619, 872, 1, 0, 0, 0, 4620, 4621,
-		7, 26, 0, 0, 4621, 4622, 7, 15, 0, 0, 4622, 4623, 7, 6, 0, 0, 4623, 4624,
-		7, 24, 0, 0, 4624, 4625, 7, 5, 0, 0, 4625, 4626, 7, 13, 0, 0, 4626, 4627,
-		7, 9, 0, 0, 4627, 4628, 7, 10, 0, 0, 4628, 874, 1, 0, 0, 0, 4629, 4630,
-		7, 26, 0, 0, 4630, 4631, 7, 15, 0, 0, 4631, 4632, 7, 6, 0, 0, 4632, 4633,
-		7, 24, 0, 0, 4633, 4634, 7, 17, 0, 0, 4634, 876, 1, 0, 0, 0, 4635, 4636,
-		7, 26, 0, 0, 4636, 4637, 7, 15, 0, 0, 4637, 4638, 7, 6, 0, 0, 4638, 4639,
-		7, 13, 0, 0, 4639, 4640, 7, 19, 0, 0, 4640, 4641, 7, 19, 0, 0, 4641, 4642,
-		7, 16, 0, 0, 4642, 878, 1, 0, 0, 0, 4643, 4644, 7, 26, 0, 0, 4644, 4645,
-		7, 15, 0, 0, 4645, 4646, 7, 6, 0, 0, 4646, 4647, 7, 9, 0, 0, 4647, 4648,
-		7, 10, 0, 0, 4648, 4649, 7, 13, 0, 0, 4649, 4650, 7, 17, 0, 0, 4650, 4651,
-		7, 5, 0, 0, 4651, 4652, 7, 6, 0, 0, 4652, 4653, 7, 17, 0, 0, 4653, 4654,
-		7, 11, 0, 0, 4654, 4655, 7, 10, 0, 0, 4655, 880, 1, 0, 0, 0, 4656, 4657,
-		7, 14, 0, 0, 4657, 4658, 7, 5, 0, 0, 4658, 4659, 7, 6, 0, 0, 4659, 4660,
-		7, 6, 0, 0, 4660, 882, 1, 0, 0, 0, 4661, 4662, 7, 14, 0, 0, 4662, 4663,
-		7, 22, 0, 0, 4663, 4664, 7, 13, 0, 0, 4664, 4665, 7, 13, 0, 0, 4665, 4666,
-		7, 10, 0, 0, 4666, 4667, 7, 7, 0, 0, 4667, 4668, 7, 16, 0, 0, 4668, 884,
-		1, 0, 0, 0, 4669, 4670, 7, 5, 0, 0, 4670, 4671, 7, 16, 0, 0, 4671, 4672,
-		7, 16, 0, 0, 4672, 4673, 7, 5, 0, 0, 4673, 4674, 7, 14, 0, 0, 4674, 4675,
-		7, 20, 0, 0, 4675, 886, 1, 0, 0, 0, 4676, 4677, 7, 12, 0, 0, 4677, 4678,
-		7, 10, 0, 0, 4678, 4679, 7, 16, 0, 0, 4679, 4680, 7, 5, 0, 0, 4680, 4681,
-		7, 14, 0, 0, 4681, 4682, 7, 20, 0, 0, 4682, 888, 1, 0, 0, 0, 4683, 4684,
-		7, 10, 0, 0, 4684, 4685, 7, 26, 0, 0, 4685, 4686, 7, 24, 0, 0, 4686, 4687,
-		7, 13, 0, 0, 4687, 4688, 7, 10, 0, 0, 4688, 4689, 7, 9, 0, 0, 4689, 4690,
-		7, 9, 0, 0, 4690, 4691, 7, 17, 0, 0, 4691, 4692, 7, 19, 0, 0, 4692, 4693,
-		7, 7, 0, 0, 4693, 890, 1, 0, 0, 0, 4694, 4695, 7, 23, 0, 0, 4695, 4696,
-		7, 10, 0, 0, 4696, 4697, 7, 7, 0, 0, 4697, 4698, 7, 10, 0, 0, 4698, 4699,
-		7, 13, 0, 0, 4699, 4700, 7, 5, 0, 0, 4700, 4701, 7, 16, 0, 0, 4701, 4702,
-		7, 10, 0, 0, 4702, 4703, 7, 12, 0, 0, 4703, 892, 1, 0, 0, 0, 4704, 4705,
-		7, 6, 0, 0, 4705, 4706, 7, 19, 0, 0, 4706, 4707, 7, 23, 0, 0, 4707, 4708,
-		7, 23, 0, 0, 4708, 4709, 7, 10, 0, 0, 4709, 4710, 7, 12, 0, 0, 4710, 894,
-		1, 0, 0, 0, 4711, 4712, 7, 9, 0, 0, 4712, 4713, 7, 16, 0, 0, 4713, 4714,
-		7, 19, 0, 0, 4714, 4715, 7, 13, 0, 0, 4715, 4716, 7, 10, 0, 0, 4716, 4717,
-		7, 12, 0, 0, 4717, 896, 1, 0, 0, 0, 4718, 4719, 7, 17, 0, 0, 4719, 4720,
-		7, 7, 0, 0, 4720, 4721, 7, 14, 0, 0, 4721, 4722, 7, 6, 0, 0, 4722, 4723,
-		7, 22, 0, 0, 4723, 4724, 7, 12, 0, 0, 4724, 4725, 7, 10, 0, 0, 4725, 898,
-		1, 0, 0, 0, 4726, 4727, 7, 13, 0, 0, 4727, 4728, 7, 19, 0, 0, 4728, 4729,
-		7, 22, 0, 0, 4729, 4730, 7, 16, 0, 0, 4730, 4731, 7, 17, 0, 0, 4731, 4732,
-		7, 7, 0, 0, 4732, 4733, 7, 10, 0, 0, 4733, 900, 1, 0, 0, 0, 4734, 4735,
-		7, 16, 0, 0, 4735, 4736, 7, 13, 0, 0, 4736, 4737, 7, 5, 0, 0, 4737, 4738,
-		7, 7, 0, 0, 4738, 4739, 7, 9, 0, 0, 4739, 4740, 7, 25, 0, 0, 4740, 4741,
-		7, 19, 0, 0, 4741, 4742, 7, 13, 0, 0, 4742, 4743, 7, 15, 0, 0, 4743, 902,
-		1, 0, 0, 0, 4744, 4745, 7, 17, 0, 0, 4745, 4746, 7, 15, 0, 0, 4746, 4747,
-		7, 24, 0, 0, 4747, 4748, 7, 19, 0, 0, 4748, 4749, 7, 13, 0, 0, 4749, 4750,
-		7, 16, 0, 0, 4750, 904, 1, 0, 0, 0, 4751, 4752, 7, 24, 0, 0, 4752, 4753,
-		7, 19, 0, 0, 4753, 4754, 7, 6, 0, 0, 4754, 4755, 7, 17, 0, 0, 4755, 4756,
-		7, 14, 0, 0, 4756, 4757, 7, 8, 0, 0, 4757, 906, 1, 0, 0, 0, 4758, 4759,
-		7, 15, 0, 0, 4759, 4760, 7, 10, 0, 0, 4760, 4761, 7, 16, 0, 0, 4761, 4762,
-		7, 20, 0, 0, 4762, 4763, 7, 19, 0, 0, 4763, 4764, 7, 12, 0, 0, 4764, 908,
-		1, 0, 0, 0, 4765, 4766, 7, 13, 0, 0, 4766, 4767, 7, 10, 0, 0, 4767, 4768,
-		7, 25, 0, 0, 4768, 4769, 7, 10, 0, 0, 4769, 4770, 7, 13, 0, 0, 4770, 4771,
-		7, 10, 0, 0, 4771, 4772, 7, 7, 0, 0, 4772, 4773, 7, 14, 0, 0, 4773, 4774,
-		7, 17, 0, 0, 4774, 4775, 7, 7, 0, 0, 4775, 4776, 7, 23, 0, 0, 4776, 910,
-		1, 0, 0, 0, 4777, 4778, 7, 7, 0, 0, 4778, 4779, 7, 10, 0, 0, 4779, 4780,
-		7, 29, 0, 0, 4780, 912, 1, 0, 0, 0, 4781, 4782, 7, 19, 0, 0, 4782, 4783,
-		7, 6, 0, 0, 4783, 4784, 7, 12, 0, 0, 4784, 914, 1, 0, 0, 0, 4785, 4786,
-		7, 27, 0, 0, 4786, 4787, 7, 5, 0, 0, 4787, 4788, 7, 6, 0, 0, 4788, 4789,
-		7, 22, 0, 0, 4789, 4790, 7, 10, 0, 0, 4790, 916, 1, 0, 0, 0, 4791, 4792,
-		7, 9, 0, 0, 4792, 4793, 7, 22, 0, 0, 4793, 4794, 7, 18, 0, 0, 4794, 4795,
-		7, 9, 0, 0, 4795, 4796, 7, 14, 0, 0, 4796, 4797, 7, 13, 0, 0, 4797, 4798,
-		7, 17, 0, 0, 4798, 4799, 7, 24, 0, 0, 4799, 4800, 7, 16, 0, 0, 4800, 4801,
-		7, 17, 0, 0, 4801, 4802, 7, 19, 0, 0, 4802, 4803, 7, 7, 0, 0, 4803, 918,
-		1, 0, 0, 0, 4804, 4805, 7, 24, 0, 0, 4805, 4806, 7, 22, 0, 0, 4806, 4807,
-		7, 18, 0, 0, 4807, 4808, 7, 6, 0, 0, 4808, 4809, 7, 17, 0, 0, 4809, 4810,
-		7, 14, 0, 0, 4810, 4811, 7, 5, 0, 0, 4811, 4812, 7, 16, 0, 0, 4812, 4813,
-		7, 17, 0, 0, 4813, 4814, 7, 19, 0, 0, 4814, 4815, 7, 7, 0, 0, 4815, 920,
-		1, 0, 0, 0, 4816, 4817, 7, 19, 0, 0, 4817, 4818, 7, 22, 0, 0, 4818, 4819,
-		7, 16, 0, 0, 4819, 922, 1, 0, 0, 0, 4820, 4821, 7, 10, 0, 0, 4821, 4822,
-		7, 7, 0, 0, 4822, 4823, 7, 12, 0, 0, 4823, 924, 1, 0, 0, 0, 4824, 4825,
-		7, 13, 0, 0, 4825, 4826, 7, 19, 0, 0, 4826, 4827, 7, 22, 0, 0, 4827, 4828,
-		7, 16, 0, 0, 4828, 4829, 7, 17, 0, 0, 4829, 4830, 7, 7, 0, 0, 4830, 4831,
-		7, 10, 0, 0, 4831, 4832, 7, 9, 0, 0, 4832, 926, 1, 0, 0, 0, 4833, 4834,
-		7, 9, 0, 0, 4834, 4835, 7, 14, 0, 0, 4835, 4836, 7, 20, 0, 0, 4836, 4837,
-		7, 10, 0, 0, 4837, 4838, 7, 15, 0, 0, 4838, 4839, 7, 5, 0, 0, 4839, 4840,
-		7, 9, 0, 0, 4840, 928, 1, 0, 0, 0, 4841, 4842, 7, 24, 0, 0, 4842, 4843,
-		7, 13, 0, 0, 4843, 4844, 7, 19, 0, 0, 4844, 4845, 7, 14, 0, 0, 4845, 4846,
-		7, 10, 0, 0, 4846, 4847, 7, 12, 0, 0, 4847, 4848, 7, 22, 0, 0, 4848, 4849,
-		7, 13, 0, 0, 4849, 4850, 7, 10, 0, 0, 4850, 4851, 7, 9, 0, 0, 4851, 930,
-		1, 0, 0, 0, 4852, 4853, 7, 17, 0, 0, 4853, 4854, 7, 7, 0, 0, 4854, 4855,
-		7, 24, 0, 0, 4855, 4856, 7, 22, 0, 0, 4856, 4857, 7, 16, 0, 0, 4857, 932,
-		1, 0, 0, 0, 4858, 4859, 7, 9, 0, 0, 4859, 4860, 7, 22, 0, 0, 4860, 4861,
-		7, 24, 0, 0, 4861, 4862, 7, 24, 0, 0, 4862, 4863, 7, 19, 0, 0, 4863, 4864,
-		7, 13, 0, 0, 4864, 4865, 7, 16, 0, 0, 4865, 934, 1, 0, 0, 0, 4866, 4867,
-		7, 24, 0, 0, 4867, 4868, 7, 5, 0, 0, 4868, 4869, 7, 13, 0, 0, 4869, 4870,
-		7, 5, 0, 0, 4870, 4871, 7, 6, 0, 0, 4871, 4872, 7, 6, 0, 0, 4872, 4873,
-		7, 10, 0, 0, 4873, 4874, 7, 6, 0, 0, 4874, 936, 1, 0, 0, 0, 4875, 4876,
-		7, 9, 0, 0, 4876, 4877, 7, 28, 0, 0, 4877, 4878, 7, 6, 0, 0, 4878, 938,
-		1, 0, 0, 0, 4879, 4880, 7, 12, 0, 0, 4880, 4881, 7, 10, 0, 0, 4881, 4882,
-		7, 24, 0, 0, 4882, 4883, 7, 10, 0, 0, 4883, 4884, 7, 7, 0, 0, 4884, 4885,
-		7, 12, 0, 0, 4885, 4886, 7, 9, 0, 0, 4886, 940, 1, 0, 0, 0, 4887, 4888,
-		7, 19, 0, 0, 4888, 4889, 7, 27, 0, 0, 4889, 4890, 7, 10, 0, 0, 4890, 4891,
-		7, 13, 0, 0, 4891, 4892, 7, 13, 0, 0, 4892, 4893, 7, 17, 0, 0, 4893, 4894,
-		7, 12, 0, 0, 4894, 4895, 7, 17, 0, 0, 4895, 4896, 7, 7, 0, 0, 4896, 4897,
-		7, 23, 0, 0, 4897, 942, 1, 0, 0, 0, 4898, 4899, 7, 14, 0, 0, 4899, 4900,
-		7, 19, 0, 0, 4900, 4901, 7, 7, 0, 0, 4901, 4902, 7, 25, 0, 0, 4902, 4903,
-		7, 6, 0, 0, 4903, 4904, 7, 17, 0, 0, 4904, 4905, 7, 14, 0, 0, 4905, 4906,
-		7, 16, 0, 0, 4906, 944, 1, 0, 0, 0, 4907, 4908, 7, 9, 0, 0, 4908, 4909,
-		7, 21, 0, 0, 4909, 4910, 7, 17, 0, 0, 4910, 4911, 7, 24, 0, 0, 4911, 946,
-		1, 0, 0, 0, 4912, 4913, 7, 6, 0, 0, 4913, 4914, 7, 19, 0, 0, 4914, 4915,
-		7, 14, 0, 0, 4915, 4916, 7, 21, 0, 0, 4916, 4917, 7, 10, 0, 0, 4917, 4918,
-		7, 12, 0, 0, 4918, 948, 1, 0, 0, 0, 4919, 4920, 7, 16, 0, 0, 4920, 4921,
-		7, 17, 0, 0, 4921, 4922, 7, 10, 0, 0, 4922, 4923, 7, 9, 0, 0, 4923, 950,
-		1, 0, 0, 0, 4924, 4925, 7, 13, 0, 0, 4925, 4926, 7, 19, 0, 0, 4926, 4927,
-		7, 6, 0, 0, 4927, 4928, 7, 6, 0, 0, 4928, 4929, 7, 22, 0, 0, 4929, 4930,
-		7, 24, 0, 0, 4930, 952, 1, 0, 0, 0, 4931, 4932, 7, 14, 0, 0, 4932, 4933,
-		7, 22, 0, 0, 4933, 4934, 7, 18, 0, 0, 4934, 4935, 7, 10, 0, 0, 4935, 954,
-		1, 0, 0, 0, 4936, 4937, 7, 23, 0, 0, 4937, 4938, 7, 13, 0, 0, 4938, 4939,
-		7, 19, 0, 0, 4939, 4940, 7, 22, 0, 0, 4940, 4941, 7, 24, 0, 0, 4941, 4942,
-		7, 17, 0, 0, 4942, 4943, 7, 7, 0, 0, 4943, 4944, 7, 23, 0, 0, 4944, 956,
-		1, 0, 0, 0, 4945, 4946, 7, 9, 0, 0, 4946, 4947, 7, 10, 0, 0, 4947, 4948,
-		7, 16, 0, 0, 4948, 4949, 7, 9, 0, 0, 4949, 958, 1, 0, 0, 0, 4950, 4951,
-		7, 16, 0, 0, 4951, 4952, 7, 5, 0, 0, 4952, 4953, 7, 18, 0, 0, 4953, 4954,
-		7, 6, 0, 0, 4954, 4955, 7, 10, 0, 0, 4955, 4956, 7, 9, 0, 0, 4956, 4957,
-		7, 5, 0, 0, 4957, 4958, 7, 15, 0, 0, 4958, 4959, 7, 24, 0, 0, 4959, 4960,
-		7, 6, 0, 0, 4960, 4961, 7, 10, 0, 0, 4961, 960, 1, 0, 0, 0, 4962, 4963,
-		7, 19, 0, 0, 4963, 4964, 7, 13, 0, 0, 4964, 4965, 7, 12, 0, 0, 4965, 4966,
-		7, 17, 0, 0, 4966, 4967, 7, 7, 0, 0, 4967, 4968, 7, 5, 0, 0, 4968, 4969,
-		7, 6, 0, 0, 4969, 4970, 7, 17, 0, 0, 4970, 4971, 7, 16, 0, 0, 4971, 4972,
-		7, 8, 0, 0, 4972, 962, 1, 0, 0, 0, 4973, 4974, 7, 26, 0, 0, 4974, 4975,
-		7, 15, 0, 0, 4975, 4976, 7, 6, 0, 0, 4976, 4977, 7, 16, 0, 0, 4977, 4978,
-		7, 5, 0, 0, 4978, 4979, 7, 18, 0, 0, 4979, 4980, 7, 6, 0, 0, 4980, 4981,
-		7, 10, 0, 0, 4981, 964, 1, 0, 0, 0, 4982, 4983, 7, 14, 0, 0, 4983, 4984,
-		7, 19, 0, 0, 4984, 4985, 7, 6, 0, 0, 4985, 4986, 7, 22, 0, 0, 4986, 4987,
-		7, 15, 0, 0, 4987, 4988, 7, 7, 0, 0, 4988, 4989, 7, 9, 0, 0, 4989, 966,
-		1, 0, 0, 0, 4990, 4991, 7, 26, 0, 0, 4991, 4992, 7, 15, 0, 0, 4992, 4993,
-		7, 6, 0, 0, 4993, 4994, 7, 7, 0, 0, 4994, 4995, 7, 5, 0, 0, 4995, 4996,
-		7, 15, 0, 0, 4996, 4997, 7, 10, 0, 0, 4997, 4998, 7, 9, 0, 0, 4998, 4999,
-		7, 24, 0, 0, 4999, 5000, 7, 5, 0, 0, 5000, 5001, 7, 14, 0, 0, 5001, 5002,
-		7, 10, 0, 0, 5002, 5003, 7, 9, 0, 0, 5003, 968, 1, 0, 0, 0, 5004, 5005,
-		7, 13, 0, 0, 5005, 5006, 7, 19, 0, 0, 5006, 5007, 7, 29, 0, 0, 5007, 5008,
-		7, 16, 0, 0, 5008, 5009, 7, 8, 0, 0, 5009, 5010, 7, 24, 0, 0, 5010, 5011,
-		7, 10, 0, 0, 5011, 970, 1, 0, 0, 0, 5012, 5013, 7, 7, 0, 0, 5013, 5014,
-		7, 19, 0, 0, 5014, 5015, 7, 13, 0, 0, 5015, 5016, 7, 15, 0, 0, 5016, 5017,
-		7, 5, 0, 0, 5017, 5018, 7, 6, 0, 0, 5018, 5019, 7, 17, 0, 0, 5019, 5020,
-		7, 11, 0, 0, 5020, 5021, 7, 10, 0, 0, 5021, 5022, 7, 12, 0, 0, 5022, 972,
-		1, 0, 0, 0, 5023, 5024, 7, 29, 0, 0, 5024, 5025, 7, 17, 0, 0, 5025, 5026,
-		7, 16, 0, 0, 5026, 5027, 7, 20, 0, 0, 5027, 5028, 7, 17, 0, 0, 5028, 5029,
-		7, 7, 0, 0, 5029, 974, 1, 0, 0, 0, 5030, 5031, 7, 25, 0, 0, 5031, 5032,
-		7, 17, 0, 0, 5032, 5033, 7, 6, 0, 0, 5033, 5034, 7, 16, 0, 0, 5034, 5035,
-		7, 10, 0, 0, 5035, 5036, 7, 13, 0, 0, 5036, 976, 1, 0, 0, 0, 5037, 5038,
-		7, 23, 0, 0, 5038, 5039, 7, 13, 0, 0, 5039, 5040, 7, 19, 0, 0, 5040, 5041,
-		7, 22, 0, 0, 5041, 5042, 7, 24, 0, 0, 5042, 5043, 7, 9, 0, 0, 5043, 978,
-		1, 0, 0, 0, 5044, 5045, 7, 19, 0, 0, 5045, 5046, 7, 16, 0, 0, 5046, 5047,
-		7, 20, 0, 0, 5047, 5048, 7, 10, 0, 0, 5048, 5049, 7, 13, 0, 0, 5049, 5050,
-		7, 9, 0, 0, 5050, 980, 1, 0, 0, 0, 5051, 5052, 7, 7, 0, 0, 5052, 5053,
-		7, 25, 0, 0, 5053, 5054, 7, 14, 0, 0, 5054, 982, 1, 0, 0, 0, 5055, 5056,
-		7, 7, 0, 0, 5056, 5057, 7, 25, 0, 0, 5057, 5058, 7, 12, 0, 0, 5058, 984,
-		1, 0, 0, 0, 5059, 5060, 7, 7, 0, 0, 5060, 5061, 7, 25, 0, 0, 5061, 5062,
-		7, 21, 0, 0, 5062, 5063, 7, 14, 0, 0, 5063, 986, 1, 0, 0, 0, 5064, 5065,
-		7, 7, 0, 0, 5065, 5066, 7, 25, 0, 0, 5066, 5067, 7, 21, 0, 0, 5067, 5068,
-		7, 12, 0, 0, 5068, 988, 1, 0, 0, 0, 5069, 5070, 7, 22, 0, 0, 5070, 5071,
-		7, 10, 0, 0, 5071, 5072, 7, 9, 0, 0, 5072, 5073, 7, 14, 0, 0, 5073, 5074,
-		7, 5, 0, 0, 5074, 5075, 7, 24, 0, 0, 5075, 5076, 7, 10, 0, 0, 5076, 990,
-		1, 0, 0, 0, 5077, 5078, 7, 27, 0, 0, 5078, 5079, 7, 17, 0, 0, 5079, 5080,
-		7, 10, 0, 0, 5080, 5081, 7, 29, 0, 0, 5081, 5082, 7, 9, 0, 0, 5082, 992,
-		1, 0, 0, 0, 5083, 5084, 7, 7, 0, 0, 5084, 5085, 7, 19, 0, 0, 5085, 5086,
-		7, 13, 0, 0, 5086, 5087, 7, 15, 0, 0, 5087, 5088, 7, 5, 0, 0, 5088, 5089,
-		7, 6, 0, 0, 5089, 5090, 7, 17, 0, 0, 5090, 5091, 7, 11, 0, 0, 5091, 5092,
-		7, 10, 0, 0, 5092, 994, 1, 0, 0, 0, 5093, 5094, 7, 12, 0, 0, 5094, 5095,
-		7, 22, 0, 0, 5095, 5096, 7, 15, 0, 0, 5096, 5097, 7, 24, 0, 0, 5097, 996,
-		1, 0, 0, 0, 5098, 5099, 7, 24, 0, 0, 5099, 5100, 7, 13, 0, 0, 5100, 5101,
-		7, 17, 0, 0, 5101, 5102, 7, 7, 0, 0, 5102, 5103, 7, 16, 0, 0, 5103, 5104,
-		5, 95, 0, 0, 5104, 5105, 7, 9, 0, 0, 5105, 5106, 7, 16, 0, 0, 5106, 5107,
-		7, 13, 0, 0, 5107, 5108, 7, 17, 0, 0, 5108, 5109, 7, 14, 0, 0, 5109, 5110,
-		7, 16, 0, 0, 5110, 5111, 5, 95, 0, 0, 5111, 5112, 7, 24, 0, 0, 5112, 5113,
-		7, 5, 0, 0, 5113, 5114, 7, 13, 0, 0, 5114, 5115, 7, 5, 0, 0, 5115, 5116,
-		7, 15, 0, 0, 5116, 5117, 7, 9, 0, 0, 5117, 998, 1, 0, 0, 0, 5118, 5119,
-		7, 27, 0, 0, 5119, 5120, 7, 5, 0, 0, 5120, 5121, 7, 13, 0, 0, 5121, 5122,
-		7, 17, 0, 0, 5122, 5123, 7, 5, 0, 0, 5123, 5124, 7, 18, 0, 0, 5124, 5125,
-		7, 6, 0, 0, 5125, 5126, 7, 10, 0, 0, 5126, 5127, 5, 95, 0, 0, 5127, 5128,
-		7, 14, 0, 0, 5128, 5129, 7, 19, 0, 0, 5129, 5130, 7, 7, 0, 0, 5130, 5131,
-		7, 25, 0, 0, 5131, 5132, 7, 6, 0, 0, 5132, 5133, 7, 17, 0, 0, 5133, 5134,
-		7, 14, 0, 0, 5134, 5135, 7, 16, 0, 0, 5135, 1000, 1, 0, 0, 0, 5136, 5137,
-		7, 10, 0, 0, 5137, 5138, 7, 13, 0, 0, 5138, 5139, 7, 13, 0, 0, 5139, 5140,
-		7, 19, 0, 0, 5140, 5141, 7, 13, 0, 0, 5141, 1002, 1, 0, 0, 0, 5142, 5143,
-		7, 22, 0, 0, 5143, 5144, 7, 9, 0, 0, 5144, 5145, 7, 10, 0, 0, 5145, 5146,
-		5, 95, 0, 0, 5146, 5147, 7, 27, 0, 0, 5147, 5148, 7, 5, 0, 0, 5148, 5149,
-		7, 13, 0, 0, 5149, 5150, 7, 17, 0, 0, 5150, 5151, 7, 5, 0, 0, 5151, 5152,
-		7, 18, 0, 0, 5152, 5153, 7, 6, 0, 0, 5153, 5154, 7, 10, 0, 0, 5154, 1004,
-		1, 0, 0, 0, 5155, 5156, 7, 22, 0, 0, 5156, 5157, 7, 9, 0, 0, 5157, 5158,
-		7, 10, 0, 0, 5158, 5159, 5, 95, 0, 0, 5159, 5160, 7, 14, 0, 0, 5160, 5161,
-		7, 19, 0, 0, 5161, 5162, 7, 6, 0, 0, 5162, 5163, 7, 22, 0, 0, 5163, 5164,
-		7, 15, 0, 0, 5164, 5165, 7, 7, 0, 0, 5165, 1006, 1, 0, 0, 0, 5166, 5167,
-		7, 5, 0, 0, 5167, 5168, 7, 6, 0, 0, 5168, 5169, 7, 17, 0, 0, 5169, 5170,
-		7, 5, 0, 0, 5170, 5171, 7, 9, 0, 0, 5171, 1008, 1, 0, 0, 0, 5172, 5173,
-		7, 14, 0, 0, 5173, 5174, 7, 19, 0, 0, 5174, 5175, 7, 7, 0, 0, 5175, 5176,
-		7, 9, 0, 0, 5176, 5177, 7, 16, 0, 0, 5177, 5178, 7, 5, 0, 0, 5178, 5179,
-		7, 7, 0, 0, 5179, 5180, 7, 16, 0, 0, 5180, 1010, 1, 0, 0, 0, 5181, 5182,
-		7, 24, 0, 0, 5182, 5183, 7, 10, 0, 0, 5183, 5184, 7, 13, 0, 0, 5184, 5185,
-		7, 25, 0, 0, 5185, 5186, 7, 19, 0, 0, 5186, 5187, 7, 13, 0, 0, 5187, 5188,
-		7, 15, 0, 0, 5188, 1012, 1, 0, 0, 0, 5189, 5190, 7, 23, 0, 0, 5190, 5191,
-		7, 10, 0, 0, 5191, 5192, 7, 16, 0, 0, 5192, 1014, 1, 0, 0, 0, 5193, 5194,
-		7, 12, 0, 0, 5194, 5195, 7, 17, 0, 0, 5195, 5196, 7, 5, 0, 0, 5196, 5197,
-		7, 23, 0, 0, 5197, 5198, 7, 7, 0, 0, 5198, 5199, 7, 19, 0, 0, 5199, 5200,
-		7, 9, 0, 0, 5200, 5201, 7, 16, 0, 0, 5201, 5202, 7, 17, 0, 0, 5202, 5203,
-		7, 14, 0, 0, 5203, 5204, 7, 9, 0, 0, 5204, 1016, 1, 0, 0, 0, 5205, 5206,
-		7, 9, 0, 0, 5206, 5207, 7, 16, 0, 0, 5207, 5208, 7, 5, 0, 0, 5208, 5209,
-		7, 14, 0, 0, 5209, 5210, 7, 21, 0, 0, 5210, 5211, 7, 10, 0, 0, 5211, 5212,
-		7, 12, 0, 0, 5212, 1018, 1, 0, 0, 0, 5213, 5214, 7, 10, 0, 0, 5214, 5215,
-		7, 6, 0, 0, 5215, 5216, 7, 9, 0, 0, 5216, 5217, 7, 17, 0, 0, 5217, 5218,
-		7, 25, 0, 0, 5218, 1020, 1, 0, 0, 0, 5219, 5220, 7, 29, 0, 0, 5220, 5221,
-		7, 20, 0, 0, 5221, 5222, 7, 17, 0, 0, 5222, 5223, 7, 6, 0, 0, 5223, 5224,
-		7, 10, 0, 0, 5224, 1022, 1, 0, 0, 0, 5225, 5226, 7, 13, 0, 0, 5226, 5227,
-		7, 10, 0, 0, 5227, 5228, 7, 27, 0, 0, 5228, 5229, 7, 10, 0, 0, 5229, 5230,
-		7, 13, 0, 0, 5230, 5231, 7, 9, 0, 0, 5231, 5232, 7, 10, 0, 0, 5232, 1024,
-		1, 0, 0, 0, 5233, 5234, 7, 25, 0, 0, 5234, 5235, 7, 19, 0, 0, 5235, 5236,
-		7, 13, 0, 0, 5236, 5237, 7, 10, 0, 0, 5237, 5238, 7, 5, 0, 0, 5238, 5239,
-		7, 14, 0, 0, 5239, 5240, 7, 20, 0, 0, 5240, 1026, 1, 0, 0, 0, 5241, 5242,
-		7, 9, 0, 0, 5242, 5243, 7, 6, 0, 0, 5243, 5244, 7, 17, 0, 0, 5244, 5245,
-		7, 14, 0, 0, 5245, 5246, 7, 10, 0, 0, 5246, 1028, 1, 0, 0, 0, 5247, 5248,
-		7, 10, 0, 0, 5248, 5249, 7, 26, 0, 0, 5249, 5250, 7, 17, 0, 0, 5250, 5251,
-		7, 16, 0, 0, 5251, 1030, 1, 0, 0, 0, 5252, 5253, 7, 13, 0, 0, 5253, 5254,
-		7, 10, 0, 0, 5254, 5255, 7, 16, 0, 0, 5255, 5256, 7, 22, 0, 0, 5256, 5257,
-		7, 13, 0, 0, 5257, 5258, 7, 7, 0, 0, 5258, 1032, 1, 0, 0, 0, 5259, 5260,
-		7, 28, 0, 0, 5260, 5261, 7, 22, 0, 0, 5261, 5262, 7, 10, 0, 0, 5262, 5263,
-		7, 13, 0, 0, 5263, 5264, 7, 8, 0, 0, 5264, 1034, 1, 0, 0, 0, 5265, 5266,
-		7, 13, 0, 0, 5266, 5267, 7, 5, 0, 0, 5267, 5268, 7, 17, 0, 0, 5268, 5269,
-		7, 9, 0, 0, 5269, 5270, 7, 10, 0, 0, 5270, 1036, 1, 0, 0, 0, 5271, 5272,
-		7, 9, 0, 0, 5272, 5273, 7, 28, 0, 0, 5273, 5274, 7, 6, 0, 0, 5274, 5275,
-		7, 9, 0, 0, 5275, 5276, 7, 16, 0, 0, 5276, 5277, 7, 5, 0, 0, 5277, 5278,
-		7, 16, 0, 0, 5278, 5279, 7, 10, 0, 0, 5279, 1038, 1, 0, 0, 0, 5280, 5281,
-		7, 12, 0, 0, 5281, 5282, 7, 10, 0, 0, 5282, 5283, 7, 18, 0, 0, 5283, 5284,
-		7, 22, 0, 0, 5284, 5285, 7, 23, 0, 0, 5285, 1040, 1, 0, 0, 0, 5286, 5287,
-		7, 6, 0, 0, 5287, 5288, 7, 19, 0, 0, 5288, 5289, 7, 23, 0, 0, 5289, 1042,
-		1, 0, 0, 0, 5290, 5291, 7, 17, 0, 0, 5291, 5292, 7, 7, 0, 0, 5292, 5293,
-		7, 25, 0, 0, 5293, 5294, 7, 19, 0, 0, 5294, 1044, 1, 0, 0, 0, 5295, 5296,
-		7, 7, 0, 0, 5296, 5297, 7, 19, 0, 0, 5297, 5298, 7, 16, 0, 0, 5298, 5299,
-		7, 17, 0, 0, 5299, 5300, 7, 14, 0, 0, 5300, 5301, 7, 10, 0, 0, 5301, 1046,
-		1, 0, 0, 0, 5302, 5303, 7, 29, 0, 0, 5303, 5304, 7, 5, 0, 0, 5304, 5305,
-		7, 13, 0, 0, 5305, 5306, 7, 7, 0, 0, 5306, 5307, 7, 17, 0, 0, 5307, 5308,
-		7, 7, 0, 0, 5308, 5309, 7, 23, 0, 0, 5309, 1048, 1, 0, 0, 0, 5310, 5311,
-		7, 10, 0, 0, 5311, 5312, 7, 26, 0, 0, 5312, 5313, 7, 14, 0, 0, 5313, 5314,
-		7, 10, 0, 0, 5314, 5315, 7, 24, 0, 0, 5315, 5316, 7, 16, 0, 0, 5316, 5317,
-		7, 17, 0, 0, 5317, 5318, 7, 19, 0, 0, 5318, 5319, 7, 7, 0, 0, 5319, 1050,
-		1, 0, 0, 0, 5320, 5321, 7, 5, 0, 0, 5321, 5322, 7, 9, 0, 0, 5322, 5323,
-		7, 9, 0, 0, 5323, 5324, 7, 10, 0, 0, 5324, 5325, 7, 13, 0, 0, 5325, 5326,
-		7, 16, 0, 0, 5326, 1052, 1, 0, 0, 0, 5327, 5328, 7, 6, 0, 0, 5328, 5329,
-		7, 19, 0, 0, 5329, 5330, 7, 19, 0, 0, 5330, 5331, 7, 24, 0, 0, 5331, 1054,
-		1, 0, 0, 0, 5332, 5333, 7, 19, 0, 0, 5333, 5334, 7, 24, 0, 0, 5334, 5335,
-		7, 10, 0, 0, 5335, 5336, 7, 7, 0, 0, 5336, 1056, 1, 0, 0, 0, 5337, 5338,
-		7, 5, 0, 0, 5338, 5339, 7, 18, 0, 0, 5339, 5340, 7, 9, 0, 0, 5340, 1058,
-		1, 0, 0, 0, 5341, 5342, 7, 14, 0, 0, 5342, 5343, 7, 18, 0, 0, 5343, 5344,
-		7, 13, 0, 0, 5344, 5345, 7, 16, 0, 0, 5345, 1060, 1, 0, 0, 0, 5346, 5347,
-		7, 14, 0, 0, 5347, 5348, 7, 10, 0, 0, 5348, 5349, 7, 17, 0, 0, 5349, 5350,
-		7, 6, 0, 0, 5350, 1062, 1, 0, 0, 0, 5351, 5352, 7, 14, 0, 0, 5352, 5353,
-		7, 10, 0, 0, 5353, 5354, 7, 17, 0, 0, 5354, 5355, 7, 6, 0, 0, 5355, 5356,
-		7, 17, 0, 0, 5356, 5357, 7, 7, 0, 0, 5357, 5358, 7, 23, 0, 0, 5358, 1064,
-		1, 0, 0, 0, 5359, 5360, 7, 12, 0, 0, 5360, 5361, 7, 10, 0, 0, 5361, 5362,
-		7, 23, 0, 0, 5362, 5363, 7, 13, 0, 0, 5363, 5364, 7, 10, 0, 0, 5364, 5365,
-		7, 10, 0, 0, 5365, 5366, 7, 9, 0, 0, 5366, 1066, 1, 0, 0, 0, 5367, 5368,
-		7, 12, 0, 0, 5368, 5369, 7, 17, 0, 0, 5369, 5370, 7, 27, 0, 0, 5370, 1068,
-		1, 0, 0, 0, 5371, 5372, 7, 10, 0, 0, 5372, 5373, 7, 26, 0, 0, 5373, 5374,
-		7, 24, 0, 0, 5374, 1070, 1, 0, 0, 0, 5375, 5376, 7, 25, 0, 0, 5376, 5377,
-		7, 5, 0, 0, 5377, 5378, 7, 14, 0, 0, 5378, 5379, 7, 16, 0, 0, 5379, 5380,
-		7, 19, 0, 0, 5380, 5381, 7, 13, 0, 0, 5381, 5382, 7, 17, 0, 0, 5382, 5383,
-		7, 5, 0, 0, 5383, 5384, 7, 6, 0, 0, 5384, 1072, 1, 0, 0, 0, 5385, 5386,
-		7, 25, 0, 0, 5386, 5387, 7, 6, 0, 0, 5387, 5388, 7, 19, 0, 0, 5388, 5389,
-		7, 19, 0, 0, 5389, 5390, 7, 13, 0, 0, 5390, 1074, 1, 0, 0, 0, 5391, 5392,
-		7, 23, 0, 0, 5392, 5393, 7, 14, 0, 0, 5393, 5394, 7, 12, 0, 0, 5394, 1076,
-		1, 0, 0, 0, 5395, 5396, 7, 6, 0, 0, 5396, 5397, 7, 14, 0, 0, 5397, 5398,
-		7, 15, 0, 0, 5398, 1078, 1, 0, 0, 0, 5399, 5400, 7, 6, 0, 0, 5400, 5401,
-		7, 7, 0, 0, 5401, 1080, 1, 0, 0, 0, 5402, 5403, 7, 6, 0, 0, 5403, 5404,
-		7, 19, 0, 0, 5404, 5405, 7, 23, 0, 0, 5405, 5406, 5, 49, 0, 0, 5406, 5407,
-		5, 48, 0, 0, 5407, 1082, 1, 0, 0, 0, 5408, 5409, 7, 15, 0, 0, 5409, 5410,
-		7, 17, 0, 0, 5410, 5411, 7, 7, 0, 0, 5411, 5412, 5, 95, 0, 0, 5412, 5413,
-		7, 9, 0, 0, 5413, 5414, 7, 14, 0, 0, 5414, 5415, 7, 5, 0, 0, 5415, 5416,
-		7, 6, 0, 0, 5416, 5417, 7, 10, 0, 0, 5417, 1084, 1, 0, 0, 0, 5418, 5419,
-		7, 15, 0, 0, 5419, 5420, 7, 19, 0, 0, 5420, 5421, 7, 12, 0, 0, 5421, 1086,
-		1, 0, 0, 0, 5422, 5423, 7, 24, 0, 0, 5423, 5424, 7, 17, 0, 0, 5424, 1088,
-		1, 0, 0, 0, 5425, 5426, 7, 24, 0, 0, 5426, 5427, 7, 19, 0, 0, 5427, 5428,
-		7, 29, 0, 0, 5428, 5429, 7, 10, 0, 0, 5429, 5430, 7, 13, 0, 0, 5430, 1090,
-		1, 0, 0, 0, 5431, 5432, 7, 13, 0, 0, 5432, 5433, 7, 5, 0, 0, 5433, 5434,
-		7, 12, 0, 0, 5434, 5435, 7, 17, 0, 0, 5435, 5436, 7, 5, 0, 0, 5436, 5437,
-		7, 7, 0, 0, 5437, 5438, 7, 9, 0, 0, 5438, 1092, 1, 0, 0, 0, 5439, 5440,
-		7, 13, 0, 0, 5440, 5441, 7, 19, 0, 0, 5441, 5442, 7, 22, 0, 0, 5442, 5443,
-		7, 7, 0, 0, 5443, 5444, 7, 12, 0, 0, 5444, 1094, 1, 0, 0, 0, 5445, 5446,
-		7, 9, 0, 0, 5446, 5447, 7, 14, 0, 0, 5447, 5448, 7, 5, 0, 0, 5448, 5449,
-		7, 6, 0, 0, 5449, 5450, 7, 10, 0, 0, 5450, 1096, 1, 0, 0, 0, 5451, 5452,
-		7, 9, 0, 0, 5452, 5453, 7, 17, 0, 0, 5453, 5454, 7, 23, 0, 0, 5454, 5455,
-		7, 7, 0, 0, 5455, 1098, 1, 0, 0, 0, 5456, 5457, 7, 9, 0, 0, 5457, 5458,
-		7, 28, 0, 0, 5458, 5459, 7, 13, 0, 0, 5459, 5460, 7, 16, 0, 0, 5460, 1100,
-		1, 0, 0, 0, 5461, 5462, 7, 16, 0, 0, 5462, 5463, 7, 13, 0, 0, 5463, 5464,
-		7, 17, 0, 0, 5464, 5465, 7, 15, 0, 0, 5465, 5466, 5, 95, 0, 0, 5466, 5467,
-		7, 9, 0, 0, 5467, 5468, 7, 14, 0, 0, 5468, 5469, 7, 5, 0, 0, 5469, 5470,
-		7, 6, 0, 0, 5470, 5471, 7, 10, 0, 0, 5471, 1102, 1, 0, 0, 0, 5472, 5473,
-		7, 16, 0, 0, 5473, 5474, 7, 13, 0, 0, 5474, 5475, 7, 22, 0, 0, 5475, 5476,
-		7, 7, 0, 0, 5476, 5477, 7, 14, 0, 0, 5477, 1104, 1, 0, 0, 0, 5478, 5479,
-		7, 29, 0, 0, 5479, 5480, 7, 17, 0, 0, 5480, 5481, 7, 12, 0, 0, 5481, 5482,
-		7, 16, 0, 0, 5482, 5483, 7, 20, 0, 0, 5483, 5484, 5, 95, 0, 0, 5484, 5485,
-		7, 18, 0, 0, 5485, 5486, 7, 22, 0, 0, 5486, 5487, 7, 14, 0, 0, 5487, 5488,
-		7, 21, 0, 0, 5488, 5489, 7, 10, 0, 0, 5489, 5490, 7, 16, 0, 0, 5490, 1106,
-		1, 0, 0, 0, 5491, 5492, 7, 13, 0, 0, 5492, 5493, 7, 5, 0, 0, 5493, 5494,
-		7, 7, 0, 0, 5494, 5495, 7, 12, 0, 0, 5495, 5496, 7, 19, 0, 0, 5496, 5497,
-		7, 15, 0, 0, 5497, 1108, 1, 0, 0, 0, 5498, 5499, 7, 9, 0, 0, 5499, 5500,
-		7, 10, 0, 0, 5500, 5501, 7, 16, 0, 0, 5501, 5502, 7, 9, 0, 0, 5502, 5503,
-		7, 10, 0, 0, 5503, 5504, 7, 10, 0, 0, 5504, 5505, 7, 12, 0, 0, 5505, 1110,
-		1, 0, 0, 0, 5506, 5507, 7, 5, 0, 0, 5507, 5508, 7, 14, 0, 0, 5508, 5509,
-		7, 19, 0, 0, 5509, 5510, 7, 9, 0, 0, 5510, 1112, 1, 0, 0, 0, 5511, 5512,
-		7, 5, 0, 0, 5512, 5513, 7, 14, 0, 0, 5513, 5514, 7, 19, 0, 0, 5514, 5515,
-		7, 9, 0, 0, 5515, 5516, 7, 12, 0, 0, 5516, 1114, 1, 0, 0, 0, 5517, 5518,
-		7, 5, 0, 0, 5518, 5519, 7, 9, 0, 0, 5519, 5520, 7, 17, 0, 0, 5520, 5521,
-		7, 7, 0, 0, 5521, 1116, 1, 0, 0, 0, 5522, 5523, 7, 5, 0, 0, 5523, 5524,
-		7, 9, 0, 0, 5524, 5525, 7, 17, 0, 0, 5525, 5526, 7, 7, 0, 0, 5526, 5527,
-		7, 12, 0, 0, 5527, 1118, 1, 0, 0, 0, 5528, 5529, 7, 5, 0, 0, 5529, 5530,
-		7, 16, 0, 0, 5530, 5531, 7, 5, 0, 0, 5531, 5532, 7, 7, 0, 0, 5532, 1120,
-		1, 0, 0, 0, 5533, 5534, 7, 5, 0, 0, 5534, 5535, 7, 16, 0, 0, 5535, 5536,
-		7, 5, 0, 0, 5536, 5537, 7, 7, 0, 0, 5537, 5538, 7, 12, 0, 0, 5538, 1122,
-		1, 0, 0, 0, 5539, 5540, 7, 5, 0, 0, 5540, 5541, 7, 16, 0, 0, 5541, 5542,
-		7, 5, 0, 0, 5542, 5543, 7, 7, 0, 0, 5543, 5544, 5, 50, 0, 0, 5544, 1124,
-		1, 0, 0, 0, 5545, 5546, 7, 5, 0, 0, 5546, 5547, 7, 16, 0, 0, 5547, 5548,
-		7, 5, 0, 0, 5548, 5549, 7, 7, 0, 0, 5549, 5550, 5, 50, 0, 0, 5550, 5551,
-		7, 12, 0, 0, 5551, 1126, 1, 0, 0, 0, 5552, 5553, 7, 14, 0, 0, 5553, 5554,
-		7, 19, 0, 0, 5554, 5555, 7, 9, 0, 0, 5555, 1128, 1, 0, 0, 0, 5556, 5557,
-		7, 14, 0, 0, 5557, 5558, 7, 19, 0, 0, 5558, 5559, 7, 9, 0, 0, 5559, 5560,
-		7, 12, 0, 0, 5560, 1130, 1, 0, 0, 0, 5561, 5562, 7, 14, 0, 0, 5562, 5563,
-		7, 19, 0, 0, 5563, 5564, 7, 16, 0, 0, 5564, 1132, 1, 0, 0, 0, 5565, 5566,
-		7, 14, 0, 0, 5566, 5567, 7, 19, 0, 0, 5567, 5568, 7, 16, 0, 0, 5568, 5569,
-		7, 12, 0, 0, 5569, 1134, 1, 0, 0, 0, 5570, 5571, 7, 9, 0, 0, 5571, 5572,
-		7, 17, 0, 0, 5572, 5573, 7, 7, 0, 0, 5573, 1136, 1, 0, 0, 0, 5574, 5575,
-		7, 9, 0, 0, 5575, 5576, 7, 17, 0, 0, 5576, 5577, 7, 7, 0, 0, 5577, 5578,
-		7, 12, 0, 0, 5578, 1138, 1, 0, 0, 0, 5579, 5580, 7, 16, 0, 0, 5580, 5581,
-		7, 5, 0, 0, 5581, 5582, 7, 7, 0, 0, 5582, 1140, 1, 0, 0, 0, 5583, 5584,
-		7, 16, 0, 0, 5584, 5585, 7, 5, 0, 0, 5585, 5586, 7, 7, 0, 0, 5586, 5587,
-		7, 12, 0, 0, 5587, 1142, 1, 0, 0, 0, 5588, 5589, 7, 9, 0, 0, 5589, 5590,
-		7, 17, 0, 0, 5590, 5591, 7, 7, 0, 0, 5591, 5592, 7, 20, 0, 0, 5592, 1144,
-		1, 0, 0, 0, 5593, 5594, 7, 14, 0, 0, 5594, 5595, 7, 19, 0, 0, 5595, 5596,
-		7, 9, 0, 0, 5596, 5597, 7, 20, 0, 0, 5597, 1146, 1, 0, 0, 0, 5598, 5599,
-		7, 16, 0, 0, 5599, 5600, 7, 5, 0, 0, 5600, 5601, 7, 7, 0, 0, 5601, 5602,
-		7, 20, 0, 0, 5602, 1148, 1, 0, 0, 0, 5603, 5604, 7, 5, 0, 0, 5604, 5605,
-		7, 9, 0, 0, 5605, 5606, 7, 17, 0, 0, 5606, 5607, 7, 7, 0, 0, 5607, 5608,
-		7, 20, 0, 0, 5608, 1150, 1, 0, 0, 0, 5609, 5610, 7, 5, 0, 0, 5610, 5611,
-		7, 14, 0, 0, 5611, 5612, 7, 19, 0, 0, 5612, 5613, 7, 9, 0, 0, 5613, 5614,
-		7, 20, 0, 0, 5614, 1152, 1, 0, 0, 0, 5615, 5616, 7, 5, 0, 0, 5616, 5617,
-		7, 16, 0, 0, 5617, 5618, 7, 5, 0, 0, 5618, 5619, 7, 7, 0, 0, 5619, 5620,
-		7, 20, 0, 0, 5620, 1154, 1, 0, 0, 0, 5621, 5622, 7, 18, 0, 0, 5622, 5623,
-		7, 17, 0, 0, 5623, 5624, 7, 16, 0, 0, 5624, 5625, 5, 95, 0, 0, 5625, 5626,
-		7, 6, 0, 0, 5626, 5627, 7, 10, 0, 0, 5627, 5628, 7, 7, 0, 0, 5628, 5629,
-		7, 23, 0, 0, 5629, 5630, 7, 16, 0, 0, 5630, 5631, 7, 20, 0, 0, 5631, 1156,
-		1, 0, 0, 0, 5632, 5633, 7, 14, 0, 0, 5633, 5634, 7, 20, 0, 0, 5634, 5635,
-		7, 5, 0, 0, 5635, 5636, 7, 13, 0, 0, 5636, 5637, 5, 95, 0, 0, 5637, 5638,
-		7, 6, 0, 0, 5638, 5639, 7, 10, 0, 0, 5639, 5640, 7, 7, 0, 0, 5640, 5641,
-		7, 23, 0, 0, 5641, 5642, 7, 16, 0, 0, 5642, 5643, 7, 20, 0, 0, 5643, 1158,
-		1, 0, 0, 0, 5644, 5645, 7, 14, 0, 0, 5645, 5646, 7, 20, 0, 0, 5646, 5647,
-		7, 5, 0, 0, 5647, 5648, 7, 13, 0, 0, 5648, 5649, 7, 5, 0, 0, 5649, 5650,
-		7, 14, 0, 0, 5650, 5651, 7, 16, 0, 0, 5651, 5652, 7, 10, 0, 0, 5652, 5653,
-		7, 13, 0, 0, 5653, 5654, 5, 95, 0, 0, 5654, 5655, 7, 6, 0, 0, 5655, 5656,
-		7, 10, 0, 0, 5656, 5657, 7, 7, 0, 0, 5657, 5658, 7, 23, 0, 0, 5658, 5659,
-		7, 16, 0, 0, 5659, 5660, 7, 20, 0, 0, 5660, 1160, 1, 0, 0, 0, 5661, 5662,
-		7, 6, 0, 0, 5662, 5663, 7, 19, 0, 0, 5663, 5664, 7, 29, 0, 0, 5664, 5665,
-		7, 10, 0, 0, 5665, 5666, 7, 13, 0, 0, 5666, 1162, 1, 0, 0, 0, 5667, 5668,
-		7, 19, 0, 0, 5668, 5669, 7, 14, 0, 0, 5669, 5670, 7, 16, 0, 0, 5670, 5671,
-		7, 10, 0, 0, 5671, 5672, 7, 16, 0, 0, 5672, 5673, 5, 95, 0, 0, 5673, 5674,
-		7, 6, 0, 0, 5674, 5675, 7, 10, 0, 0, 5675, 5676, 7, 7, 0, 0, 5676, 5677,
-		7, 23, 0, 0, 5677, 5678, 7, 16, 0, 0, 5678, 5679, 7, 20, 0, 0, 5679, 1164,
-		1, 0, 0, 0, 5680, 5681, 7, 22, 0, 0, 5681, 5682, 7, 24, 0, 0, 5682, 5683,
-		7, 24, 0, 0, 5683, 5684, 7, 10, 0, 0, 5684, 5685, 7, 13, 0, 0, 5685, 1166,
-		1, 0, 0, 0, 5686, 5687, 7, 5, 0, 0, 5687, 5688, 7, 9, 0, 0, 5688, 5689,
-		7, 14, 0, 0, 5689, 5690, 7, 17, 0, 0, 5690, 5691, 7, 17, 0, 0, 5691, 1168,
-		1, 0, 0, 0, 5692, 5693, 7, 18, 0, 0, 5693, 5694, 7, 16, 0, 0, 5694, 5695,
-		7, 13, 0, 0, 5695, 5696, 7, 17, 0, 0, 5696, 5697, 7, 15, 0, 0, 5697, 1170,
-		1, 0, 0, 0, 5698, 5699, 7, 14, 0, 0, 5699, 5700, 7, 20, 0, 0, 5700, 5701,
-		7, 13, 0, 0, 5701, 1172, 1, 0, 0, 0, 5702, 5703, 7, 14, 0, 0, 5703, 5704,
-		7, 19, 0, 0, 5704, 5705, 7, 7, 0, 0, 5705, 5706, 7, 14, 0, 0, 5706, 5707,
-		7, 5, 0, 0, 5707, 5708, 7, 16, 0, 0, 5708, 1174, 1, 0, 0, 0, 5709, 5710,
-		7, 14, 0, 0, 5710, 5711, 7, 19, 0, 0, 5711, 5712, 7, 7, 0, 0, 5712, 5713,
-		7, 14, 0, 0, 5713, 5714, 7, 5, 0, 0, 5714, 5715, 7, 16, 0, 0, 5715, 5716,
-		5, 95, 0, 0, 5716, 5717, 7, 29, 0, 0, 5717, 5718, 7, 9, 0, 0, 5718, 1176,
-		1, 0, 0, 0, 5719, 5720, 7, 25, 0, 0, 5720, 5721, 7, 19, 0, 0, 5721, 5722,
-		7, 13, 0, 0, 5722, 5723, 7, 15, 0, 0, 5723, 5724, 7, 5, 0, 0, 5724, 5725,
-		7, 16, 0, 0, 5725, 1178, 1, 0, 0, 0, 5726, 5727, 7, 17, 0, 0, 5727, 5728,
-		7, 7, 0, 0, 5728, 5729, 7, 17, 0, 0, 5729, 5730, 7, 16, 0, 0, 5730, 5731,
-		7, 14, 0, 0, 5731, 5732, 7, 5, 0, 0, 5732, 5733, 7, 24, 0, 0, 5733, 1180,
-		1, 0, 0, 0, 5734, 5735, 7, 6, 0, 0, 5735, 5736, 7, 10, 0, 0, 5736, 5737,
-		7, 7, 0, 0, 5737, 5738, 7, 23, 0, 0, 5738, 5739, 7, 16, 0, 0, 5739, 5740,
-		7, 20, 0, 0, 5740, 1182, 1, 0, 0, 0, 5741, 5742, 7, 6, 0, 0, 5742, 5743,
-		7, 24, 0, 0, 5743, 5744, 7, 5, 0, 0, 5744, 5745, 7, 12, 0, 0, 5745, 1184,
-		1, 0, 0, 0, 5746, 5747, 7, 6, 0, 0, 5747, 5748, 7, 16, 0, 0, 5748, 5749,
-		7, 13, 0, 0, 5749, 5750, 7, 17, 0, 0, 5750, 5751, 7, 15, 0, 0, 5751, 1186,
-		1, 0, 0, 0, 5752, 5753, 7, 15, 0, 0, 5753, 5754, 7, 12, 0, 0, 5754, 5755,
-		5, 53, 0, 0, 5755, 1188, 1, 0, 0, 0, 5756, 5757, 7, 24, 0, 0, 5757, 5758,
-		7, 5, 0, 0, 5758, 5759, 7, 13, 0, 0, 5759, 5760, 7, 9, 0, 0, 5760, 5761,
-		7, 10, 0, 0, 5761, 5762, 5, 95, 0, 0, 5762, 5763, 7, 17, 0, 0, 5763, 5764,
-		7, 12, 0, 0, 5764, 5765, 7, 10, 0, 0, 5765, 5766, 7, 7, 0, 0, 5766, 5767,
-		7, 16, 0, 0, 5767, 1190, 1, 0, 0, 0, 5768, 5769, 7, 24, 0, 0, 5769, 5770,
-		7, 23, 0, 0, 5770, 5771, 5, 95, 0, 0, 5771, 5772, 7, 14, 0, 0, 5772, 5773,
-		7, 6, 0, 0, 5773, 5774, 7, 17, 0, 0, 5774, 5775, 7, 10, 0, 0, 5775, 5776,
-		7, 7, 0, 0, 5776, 5777, 7, 16, 0, 0, 5777, 5778, 5, 95, 0, 0, 5778, 5779,
-		7, 10, 0, 0, 5779, 5780, 7, 7, 0, 0, 5780, 5781, 7, 14, 0, 0, 5781, 5782,
-		7, 19, 0, 0, 5782, 5783, 7, 12, 0, 0, 5783, 5784, 7, 17, 0, 0, 5784, 5785,
-		7, 7, 0, 0, 5785, 5786, 7, 23, 0, 0, 5786, 1192, 1, 0, 0, 0, 5787, 5788,
-		7, 28, 0, 0, 5788, 5789, 7, 22, 0, 0, 5789, 5790, 7, 19, 0, 0, 5790, 5791,
-		7, 16, 0, 0, 5791, 5792, 7, 10, 0, 0, 5792, 5793, 5, 95, 0, 0, 5793, 5794,
-		7, 17, 0, 0, 5794, 5795, 7, 12, 0, 0, 5795, 5796, 7, 10, 0, 0, 5796, 5797,
-		7, 7, 0, 0, 5797, 5798, 7, 16, 0, 0, 5798, 1194, 1, 0, 0, 0, 5799, 5800,
-		7, 28, 0, 0, 5800, 5801, 7, 22, 0, 0, 5801, 5802, 7, 19, 0, 0, 5802, 5803,
-		7, 16, 0, 0, 5803, 5804, 7, 10, 0, 0, 5804, 5805, 5, 95, 0, 0, 5805, 5806,
-		7, 6, 0, 0, 5806, 5807, 7, 17, 0, 0, 5807, 5808, 7, 16, 0, 0, 5808, 5809,
-		7, 10, 0, 0, 5809, 5810, 7, 13, 0, 0, 5810, 5811, 7, 5, 0, 0, 5811, 5812,
-		7, 6, 0, 0, 5812, 1196, 1, 0, 0, 0, 5813, 5814, 7, 28, 0, 0, 5814, 5815,
-		7, 22, 0, 0, 5815, 5816, 7, 19, 0, 0, 5816, 5817, 7, 16, 0, 0, 5817, 5818,
-		7, 10, 0, 0, 5818, 5819, 5, 95, 0, 0, 5819, 5820, 7, 7, 0, 0, 5820, 5821,
-		7, 22, 0, 0, 5821, 5822, 7, 6, 0, 0, 5822, 5823, 7, 6, 0, 0, 5823, 5824,
-		7, 5, 0, 0, 5824, 5825, 7, 18, 0, 0, 5825, 5826, 7, 6, 0, 0, 5826, 5827,
-		7, 10, 0, 0, 5827, 1198, 1, 0, 0, 0, 5828, 5829, 7, 13, 0, 0, 5829, 5830,
-		7, 10, 0, 0, 5830, 5831, 7, 23, 0, 0, 5831, 5832, 7, 10, 0, 0, 5832, 5833,
-		7, 26, 0, 0, 5833, 5834, 7, 24, 0, 0, 5834, 5835, 5, 95, 0, 0, 5835, 5836,
-		7, 14, 0, 0, 5836, 5837, 7, 19, 0, 0, 5837, 5838, 7, 22, 0, 0, 5838, 5839,
-		7, 7, 0, 0, 5839, 5840, 7, 16, 0, 0, 5840, 1200, 1, 0, 0, 0, 5841, 5842,
-		7, 13, 0, 0, 5842, 5843, 7, 10, 0, 0, 5843, 5844, 7, 23, 0, 0, 5844, 5845,
-		7, 10, 0, 0, 5845, 5846, 7, 26, 0, 0, 5846, 5847, 7, 24, 0, 0, 5847, 5848,
-		5, 95, 0, 0, 5848, 5849, 7, 17, 0, 0, 5849, 5850, 7, 7, 0, 0, 5850, 5851,
-		7, 9, 0, 0, 5851, 5852, 7, 16, 0, 0, 5852, 5853, 7, 13, 0, 0, 5853, 1202,
-		1, 0, 0, 0, 5854, 5855, 7, 13, 0, 0, 5855, 5856, 7, 10, 0, 0, 5856, 5857,
-		7, 23, 0, 0, 5857, 5858, 7, 10, 0, 0, 5858, 5859, 7, 26, 0, 0, 5859, 5860,
-		7, 24, 0, 0, 5860, 5861, 5, 95, 0, 0, 5861, 5862, 7, 6, 0, 0, 5862, 5863,
-		7, 17, 0, 0, 5863, 5864, 7, 21, 0, 0, 5864, 5865, 7, 10, 0, 0, 5865, 1204,
-		1, 0, 0, 0, 5866, 5867, 7, 13, 0, 0, 5867, 5868, 7, 10, 0, 0, 5868, 5869,
-		7, 23, 0, 0, 5869, 5870, 7, 10, 0, 0, 5870, 5871, 7, 26, 0, 0, 5871, 5872,
-		7, 24, 0, 0, 5872, 5873, 5, 95, 0, 0, 5873, 5874, 7, 15, 0, 0, 5874, 5875,
-		7, 5, 0, 0, 5875, 5876, 7, 16, 0, 0, 5876, 5877, 7, 14, 0, 0, 5877, 5878,
-		7, 20, 0, 0, 5878, 1206, 1, 0, 0, 0, 5879, 5880, 7, 13, 0, 0, 5880, 5881,
-		7, 10, 0, 0, 5881, 5882, 7, 23, 0, 0, 5882, 5883, 7, 10, 0, 0, 5883, 5884,
-		7, 26, 0, 0, 5884, 5885, 7, 24, 0, 0, 5885, 5886, 5, 95, 0, 0, 5886, 5887,
-		7, 15, 0, 0, 5887, 5888, 7, 5, 0, 0, 5888, 5889, 7, 16, 0, 0, 5889, 5890,
-		7, 14, 0, 0, 5890, 5891, 7, 20, 0, 0, 5891, 5892, 7, 10, 0, 0, 5892, 5893,
-		7, 9, 0, 0, 5893, 1208, 1, 0, 0, 0, 5894, 5895, 7, 13, 0, 0, 5895, 5896,
-		7, 10, 0, 0, 5896, 5897, 7, 23, 0, 0, 5897, 5898, 7, 10, 0, 0, 5898, 5899,
-		7, 26, 0, 0, 5899, 5900, 7, 24, 0, 0, 5900, 5901, 5, 95, 0, 0, 5901, 5902,
-		7, 13, 0, 0, 5902, 5903, 7, 10, 0, 0, 5903, 5904, 7, 24, 0, 0, 5904, 5905,
-		7, 6, 0, 0, 5905, 5906, 7, 5, 0, 0, 5906, 5907, 7, 14, 0, 0, 5907, 5908,
-		7, 10, 0, 0, 5908, 1210, 1, 0, 0, 0, 5909, 5910, 7, 13, 0, 0, 5910, 5911,
-		7, 10, 0, 0, 5911, 5912, 7, 23, 0, 0, 5912, 5913, 7, 10, 0, 0, 5913, 5914,
-		7, 26, 0, 0, 5914, 5915, 7, 24, 0, 0, 5915, 5916, 5, 95, 0, 0, 5916, 5917,
-		7, 9, 0, 0, 5917, 5918, 7, 24, 0, 0, 5918, 5919, 7, 6, 0, 0, 5919, 5920,
-		7, 17, 0, 0, 5920, 5921, 7, 16, 0, 0, 5921, 5922, 5, 95, 0, 0, 5922, 5923,
-		7, 16, 0, 0, 5923, 5924, 7, 19, 0, 0, 5924, 5925, 5, 95, 0, 0, 5925, 5926,
-		7, 5, 0, 0, 5926, 5927, 7, 13, 0, 0, 5927, 5928, 7, 13, 0, 0, 5928, 5929,
-		7, 5, 0, 0, 5929, 5930, 7, 8, 0, 0, 5930, 1212, 1, 0, 0, 0, 5931, 5932,
-		7, 13, 0, 0, 5932, 5933, 7, 10, 0, 0, 5933, 5934, 7, 23, 0, 0, 5934, 5935,
-		7, 10, 0, 0, 5935, 5936, 7, 26, 0, 0, 5936, 5937, 7, 24, 0, 0, 5937, 5938,
-		5, 95, 0, 0, 5938, 5939, 7, 9, 0, 0, 5939, 5940, 7, 24, 0, 0, 5940, 5941,
-		7, 6, 0, 0, 5941, 5942, 7, 17, 0, 0, 5942, 5943, 7, 16, 0, 0, 5943, 5944,
-		5, 95, 0, 0, 5944, 5945, 7, 16, 0, 0, 5945, 5946, 7, 19, 0, 0, 5946, 5947,
-		5, 95, 0, 0, 5947, 5948, 7, 16, 0, 0, 5948, 5949, 7, 5, 0, 0, 5949, 5950,
-		7, 18, 0, 0, 5950, 5951, 7, 6, 0, 0, 5951, 5952, 7, 10, 0, 0, 5952, 1214,
-		1, 0, 0, 0, 5953, 5954, 7, 13, 0, 0, 5954, 5955, 7, 10, 0, 0, 5955, 5956,
-		7, 23, 0, 0, 5956, 5957, 7, 10, 0, 0, 5957, 5958, 7, 26, 0, 0, 5958, 5959,
-		7, 24, 0, 0, 5959, 5960, 5, 95, 0, 0, 5960, 5961, 7, 9, 0, 0, 5961, 5962,
-		7, 22, 0, 0, 5962, 5963, 7, 18, 0, 0, 5963, 5964, 7, 9, 0, 0, 5964, 5965,
-		7, 16, 0, 0, 5965, 5966, 7, 13, 0, 0, 5966, 1216, 1, 0, 0, 0, 5967, 5968,
-		7, 13, 0, 0, 5968, 5969, 7, 10, 0, 0, 5969, 5970, 7, 24, 0, 0, 5970, 5971,
-		7, 10, 0, 0, 5971, 5972, 7, 5, 0, 0, 5972, 5973, 7, 16, 0, 0, 5973, 1218,
-		1, 0, 0, 0, 5974, 5975, 7, 13, 0, 0, 5975, 5976, 7, 24, 0, 0, 5976, 5977,
-		7, 5, 0, 0, 5977, 5978, 7, 12, 0, 0, 5978, 1220, 1, 0, 0, 0, 5979, 5980,
-		7, 13, 0, 0, 5980, 5981, 7, 16, 0, 0, 5981, 5982, 7, 13, 0, 0, 5982, 5983,
-		7, 17, 0, 0, 5983, 5984, 7, 15, 0, 0, 5984, 1222, 1, 0, 0, 0, 5985, 5986,
-		7, 9, 0, 0, 5986, 5987, 7, 24, 0, 0, 5987, 5988, 7, 6, 0, 0, 5988, 5989,
-		7, 17, 0, 0, 5989, 5990, 7, 16, 0, 0, 5990, 5991, 5, 95, 0, 0, 5991, 5992,
-		7, 24, 0, 0, 5992, 5993, 7, 5, 0, 0, 5993, 5994, 7, 13, 0, 0, 5994, 5995,
-		7, 16, 0, 0, 5995, 1224, 1, 0, 0, 0, 5996, 5997, 7, 9, 0, 0, 5997, 5998,
-		7, 16, 0, 0, 5998, 5999, 7, 5, 0, 0, 5999, 6000, 7, 13, 0, 0, 6000, 6001,
-		7, 16, 0, 0, 6001, 6002, 7, 9, 0, 0, 6002, 6003, 5, 95, 0, 0, 6003, 6004,
-		7, 29, 0, 0, 6004, 6005, 7, 17, 0, 0, 6005, 6006, 7, 16, 0, 0, 6006, 6007,
-		7, 20, 0, 0, 6007, 1226, 1, 0, 0, 0, 6008, 6009, 7, 9, 0, 0, 6009, 6010,
-		7, 16, 0, 0, 6010, 6011, 7, 13, 0, 0, 6011, 6012, 7, 17, 0, 0, 6012, 6013,
-		7, 7, 0, 0, 6013, 6014, 7, 23, 0, 0, 6014, 6015, 5, 95, 0, 0, 6015, 6016,
-		7, 16, 0, 0, 6016, 6017, 7, 19, 0, 0, 6017, 6018, 5, 95, 0, 0, 6018, 6019,
-		7, 5, 0, 0, 6019, 6020, 7, 13, 0, 0, 6020, 6021, 7, 13, 0, 0, 6021, 6022,
-		7, 5, 0, 0, 6022, 6023, 7, 8, 0, 0, 6023, 1228, 1, 0, 0, 0, 6024, 6025,
-		7, 9, 0, 0, 6025, 6026, 7, 16, 0, 0, 6026, 6027, 7, 13, 0, 0, 6027, 6028,
-		7, 17, 0, 0, 6028, 6029, 7, 7, 0, 0, 6029, 6030, 7, 23, 0, 0, 6030, 6031,
-		5, 95, 0, 0, 6031, 6032, 7, 16, 0, 0, 6032, 6033, 7, 19, 0, 0, 6033, 6034,
-		5, 95, 0, 0, 6034, 6035, 7, 16, 0, 0, 6035, 6036, 7, 5, 0, 0, 6036, 6037,
-		7, 18, 0, 0, 6037, 6038, 7, 6, 0, 0, 6038, 6039, 7, 10, 0, 0, 6039, 1230,
-		1, 0, 0, 0, 6040, 6041, 7, 9, 0, 0, 6041, 6042, 7, 16, 0, 0, 6042, 6043,
-		7, 13, 0, 0, 6043, 6044, 7, 24, 0, 0, 6044, 6045, 7, 19, 0, 0, 6045, 6046,
-		7, 9, 0, 0, 6046, 1232, 1, 0, 0, 0, 6047, 6048, 7, 9, 0, 0, 6048, 6049,
-		7, 22, 0, 0, 6049, 6050, 7, 18, 0, 0, 6050, 6051, 7, 9, 0, 0, 6051, 6052,
-		7, 16, 0, 0, 6052, 6053, 7, 13, 0, 0, 6053, 1234, 1, 0, 0, 0, 6054, 6055,
-		7, 16, 0, 0, 6055, 6056, 7, 19, 0, 0, 6056, 6057, 5, 95, 0, 0, 6057, 6058,
-		7, 5, 0, 0, 6058, 6059, 7, 9, 0, 0, 6059, 6060, 7, 14, 0, 0, 6060, 6061,
-		7, 17, 0, 0, 6061, 6062, 7, 17, 0, 0, 6062, 1236, 1, 0, 0, 0, 6063, 6064,
-		7, 16, 0, 0, 6064, 6065, 7, 19, 0, 0, 6065, 6066, 5, 95, 0, 0, 6066, 6067,
-		7, 20, 0, 0, 6067, 6068, 7, 10, 0, 0, 6068, 6069, 7, 26, 0, 0, 6069, 1238,
-		1, 0, 0, 0, 6070, 6071, 7, 16, 0, 0, 6071, 6072, 7, 13, 0, 0, 6072, 6073,
-		7, 5, 0, 0, 6073, 6074, 7, 7, 0, 0, 6074, 6075, 7, 9, 0, 0, 6075, 6076,
-		7, 6, 0, 0, 6076, 6077, 7, 5, 0, 0, 6077, 6078, 7, 16, 0, 0, 6078, 6079,
-		7, 10, 0, 0, 6079, 1240, 1, 0, 0, 0, 6080, 6081, 7, 22, 0, 0, 6081, 6082,
-		7, 7, 0, 0, 6082, 6083, 7, 17, 0, 0, 6083, 6084, 7, 9, 0, 0, 6084, 6085,
-		7, 16, 0, 0, 6085, 6086, 7, 13, 0, 0, 6086, 1242, 1, 0, 0, 0, 6087, 6088,
-		7, 5, 0, 0, 6088, 6089, 7, 23, 0, 0, 6089, 6090, 7, 10, 0, 0, 6090, 1244,
-		1, 0, 0, 0, 6091, 6092, 7, 14, 0, 0, 6092, 6093, 7, 6, 0, 0, 6093, 6094,
-		7, 19, 0, 0, 6094, 6095, 7, 14, 0, 0, 6095, 6096, 7, 21, 0, 0, 6096, 6097,
-		5, 95, 0, 0, 6097, 6098, 7, 16, 0, 0, 6098, 6099, 7, 17, 0, 0, 6099, 6100,
-		7, 15, 0, 0, 6100, 6101, 7, 10, 0, 0, 6101, 6102, 7, 9, 0, 0, 6102, 6103,
-		7, 16, 0, 0, 6103, 6104, 7, 5, 0, 0, 6104, 6105, 7, 15, 0, 0, 6105, 6106,
-		7, 24, 0, 0, 6106, 1246, 1, 0, 0, 0, 6107, 6108, 7, 12, 0, 0, 6108, 6109,
-		7, 5, 0, 0, 6109, 6110, 7, 16, 0, 0, 6110, 6111, 7, 10, 0, 0, 6111, 6112,
-		5, 95, 0, 0, 6112, 6113, 7, 18, 0, 0, 6113, 6114, 7, 17, 0, 0, 6114, 6115,
-		7, 7, 0, 0, 6115, 1248, 1, 0, 0, 0, 6116, 6117, 7, 12, 0, 0, 6117, 6118,
-		7, 5, 0, 0, 6118, 6119, 7, 16, 0, 0, 6119, 6120, 7, 10, 0, 0, 6120, 6121,
-		5, 95, 0, 0, 6121, 6122, 7, 24, 0, 0, 6122, 6123, 7, 5, 0, 0, 6123, 6124,
-		7, 13, 0, 0, 6124, 6125, 7, 16, 0, 0, 6125, 1250, 1, 0, 0, 0, 6126, 6127,
-		7, 12, 0, 0, 6127, 6128, 7, 5, 0, 0, 6128, 6129, 7, 16, 0, 0, 6129, 6130,
-		7, 10, 0, 0, 6130, 6131, 5, 95, 0, 0, 6131, 6132, 7, 16, 0, 0, 6132, 6133,
-		7, 13, 0, 0, 6133, 6134, 7, 22, 0, 0, 6134, 6135, 7, 7, 0, 0, 6135, 6136,
-		7, 14, 0, 0, 6136, 1252, 1, 0, 0, 0, 6137, 6138, 7, 17, 0, 0, 6138, 6139,
-		7, 9, 0, 0, 6139, 6140, 7, 25, 0, 0, 6140, 6141, 7, 17, 0, 0, 6141, 6142,
-		7, 7, 0, 0, 6142, 6143, 7, 17, 0, 0, 6143, 6144, 7, 16, 0, 0, 6144, 6145,
-		7, 10, 0, 0, 6145, 1254, 1, 0, 0, 0, 6146, 6147, 7, 30, 0, 0, 6147, 6148,
-		7, 22, 0, 0, 6148, 6149, 7, 9, 0, 0, 6149, 6150, 7, 16, 0, 0, 6150, 6151,
-		7, 17, 0, 0, 6151, 6152, 7, 25, 0, 0, 6152, 6153, 7, 8, 0, 0, 6153, 6154,
-		5, 95, 0, 0, 6154, 6155, 7, 12, 0, 0, 6155, 6156, 7, 5, 0, 0, 6156, 6157,
-		7, 8, 0, 0, 6157, 6158, 7, 9, 0, 0, 6158, 1256, 1, 0, 0, 0, 6159, 6160,
-		7, 30, 0, 0, 6160, 6161, 7, 22, 0, 0, 6161, 6162, 7, 9, 0, 0, 6162, 6163,
-		7, 16, 0, 0, 6163, 6164, 7, 17, 0, 0, 6164, 6165, 7, 25, 0, 0, 6165, 6166,
-		7, 8, 0, 0, 6166, 6167, 5, 95, 0, 0, 6167, 6168, 7, 20, 0, 0, 6168, 6169,
-		7, 19, 0, 0, 6169, 6170, 7, 22, 0, 0, 6170, 6171, 7, 13, 0, 0, 6171, 6172,
-		7, 9, 0, 0, 6172, 1258, 1, 0, 0, 0, 6173, 6174, 7, 30, 0, 0, 6174, 6175,
-		7, 22, 0, 0, 6175, 6176, 7, 9, 0, 0, 6176, 6177, 7, 16, 0, 0, 6177, 6178,
-		7, 17, 0, 0, 6178, 6179, 7, 25, 0, 0, 6179, 6180, 7, 8, 0, 0, 6180, 6181,
-		5, 95, 0, 0, 6181, 6182, 7, 17, 0, 0, 6182, 6183, 7, 7, 0, 0, 6183, 6184,
-		7, 16, 0, 0, 6184, 6185, 7, 10, 0, 0, 6185, 6186, 7, 13, 0, 0, 6186, 6187,
-		7, 27, 0, 0, 6187, 6188, 7, 5, 0, 0, 6188, 6189, 7, 6, 0, 0, 6189, 1260,
-		1, 0, 0, 0, 6190, 6191, 7, 15, 0, 0, 6191, 6192, 7, 5, 0, 0, 6192, 6193,
-		7, 21, 0, 0, 6193, 6194, 7, 10, 0, 0, 6194, 6195, 5, 95, 0, 0, 6195, 6196,
-		7, 12, 0, 0, 6196, 6197, 7, 5, 0, 0, 6197, 6198, 7, 16, 0, 0, 6198, 6199,
-		7, 10, 0, 0, 6199, 1262, 1, 0, 0, 0, 6200, 6201, 7, 15, 0, 0, 6201, 6202,
-		7, 5, 0, 0, 6202, 6203, 7, 21, 0, 0, 6203, 6204, 7, 10, 0, 0, 6204, 6205,
-		5, 95, 0, 0, 6205, 6206, 7, 17, 0, 0, 6206, 6207, 7, 7, 0, 0, 6207, 6208,
-		7, 16, 0, 0, 6208, 6209, 7, 10, 0, 0, 6209, 6210, 7, 13, 0, 0, 6210, 6211,
-		7, 27, 0, 0, 6211, 6212, 7, 5, 0, 0, 6212, 6213, 7, 6, 0, 0, 6213, 1264,
-		1, 0, 0, 0, 6214, 6215, 7, 15, 0, 0, 6215, 6216, 7, 5, 0, 0, 6216, 6217,
-		7, 21, 0, 0, 6217, 6218, 7, 10, 0, 0, 6218, 6219, 5, 95, 0, 0, 6219, 6220,
-		7, 16, 0, 0, 6220, 6221, 7, 17, 0, 0, 6221, 6222, 7, 15, 0, 0, 6222, 6223,
-		7, 10, 0, 0, 6223, 1266, 1, 0, 0, 0, 6224, 6225, 7, 15, 0, 0, 6225, 6226,
-		7, 5, 0, 0, 6226, 6227, 7, 21, 0, 0, 6227, 6228, 7, 10, 0, 0, 6228, 6229,
-		5, 95, 0, 0, 6229, 6230, 7, 16, 0, 0, 6230, 6231, 7, 17, 0, 0, 6231, 6232,
-		7, 15, 0, 0, 6232, 6233, 7, 10, 0, 0, 6233, 6234, 7, 9, 0, 0, 6234, 6235,
-		7, 16, 0, 0, 6235, 6236, 7, 5, 0, 0, 6236, 6237, 7, 15, 0, 0, 6237, 6238,
-		7, 24, 0, 0, 6238, 1268, 1, 0, 0, 0, 6239, 6240, 7, 15, 0, 0, 6240, 6241,
-		7, 5, 0, 0, 6241, 6242, 7, 21, 0, 0, 6242, 6243, 7, 10, 0, 0, 6243, 6244,
-		5, 95, 0, 0, 6244, 6245, 7, 16, 0, 0, 6245, 6246, 7, 17, 0, 0, 6246, 6247,
-		7, 15, 0, 0, 6247, 6248, 7, 10, 0, 0, 6248, 6249, 7, 9, 0, 0, 6249, 6250,
-		7, 16, 0, 0, 6250, 6251, 7, 5, 0, 0, 6251, 6252, 7, 15, 0, 0, 6252, 6253,
-		7, 24, 0, 0, 6253, 6254, 7, 16, 0, 0, 6254, 6255, 7, 11, 0, 0, 6255, 1270,
-		1, 0, 0, 0, 6256, 6257, 7, 7, 0, 0, 6257, 6258, 7, 19, 0, 0, 6258, 6259,
-		7, 29, 0, 0, 6259, 1272, 1, 0, 0, 0, 6260, 6261, 7, 9, 0, 0, 6261, 6262,
-		7, 16, 0, 0, 6262, 6263, 7, 5, 0, 0, 6263, 6264, 7, 16, 0, 0, 6264, 6265,
-		7, 10, 0, 0, 6265, 6266, 7, 15, 0, 0, 6266, 6267, 7, 10, 0, 0, 6267, 6268,
-		7, 7, 0, 0, 6268, 6269, 7, 16, 0, 0, 6269, 6270, 5, 95, 0, 0, 6270, 6271,
-		7, 16, 0, 0, 6271, 6272, 7, 17, 0, 0, 6272, 6273, 7, 15, 0, 0, 6273, 6274,
-		7, 10, 0, 0, 6274, 6275, 7, 9, 0, 0, 6275, 6276, 7, 16, 0, 0, 6276, 6277,
-		7, 5, 0, 0, 6277, 6278, 7, 15, 0, 0, 6278, 6279, 7, 24, 0, 0, 6279, 1274,
-		1, 0, 0, 0, 6280, 6281, 7, 16, 0, 0, 6281, 6282, 7, 17, 0, 0, 6282, 6283,
-		7, 15, 0, 0, 6283, 6284, 7, 10, 0, 0, 6284, 6285, 7, 19, 0, 0, 6285, 6286,
-		7, 25, 0, 0, 6286, 6287, 7, 12, 0, 0, 6287, 6288, 7, 5, 0, 0, 6288, 6289,
-		7, 8, 0, 0, 6289, 1276, 1, 0, 0, 0, 6290, 6291, 7, 16, 0, 0, 6291, 6292,
-		7, 13, 0, 0, 6292, 6293, 7, 5, 0, 0, 6293, 6294, 7, 7, 0, 0, 6294, 6295,
-		7, 9, 0, 0, 6295, 6296, 7, 5, 0, 0, 6296, 6297, 7, 14, 0, 0, 6297, 6298,
-		7, 16, 0, 0, 6298, 6299, 7, 17, 0, 0, 6299, 6300, 7, 19, 0, 0, 6300, 6301,
-		7, 7, 0, 0, 6301, 6302, 5, 95, 0, 0, 6302, 6303, 7, 16, 0, 0, 6303, 6304,
-		7, 17, 0, 0, 6304, 6305, 7, 15, 0, 0, 6305, 6306, 7, 10, 0, 0, 6306, 6307,
-		7, 9, 0, 0, 6307, 6308, 7, 16, 0, 0, 6308, 6309, 7, 5, 0, 0, 6309, 6310,
-		7, 15, 0, 0, 6310, 6311, 7, 24, 0, 0, 6311, 1278, 1, 0, 0, 0, 6312, 6313,
-		7, 16, 0, 0, 6313, 6314, 7, 19, 0, 0, 6314, 6315, 5, 95, 0, 0, 6315, 6316,
-		7, 16, 0, 0, 6316, 6317, 7, 17, 0, 0, 6317, 6318, 7, 15, 0, 0, 6318, 6319,
-		7, 10, 0, 0, 6319, 6320, 7, 9, 0, 0, 6320, 6321, 7, 16, 0, 0, 6321, 6322,
-		7, 5, 0, 0, 6322, 6323, 7, 15, 0, 0, 6323, 6324, 7, 24, 0, 0, 6324, 1280,
-		1, 0, 0, 0, 6325, 6326, 7, 16, 0, 0, 6326, 6327, 7, 19, 0, 0, 6327, 6328,
-		5, 95, 0, 0, 6328, 6329, 7, 14, 0, 0, 6329, 6330, 7, 20, 0, 0, 6330, 6331,
-		7, 5, 0, 0, 6331, 6332, 7, 13, 0, 0, 6332, 1282, 1, 0, 0, 0, 6333, 6334,
-		7, 16, 0, 0, 6334, 6335, 7, 19, 0, 0, 6335, 6336, 5, 95, 0, 0, 6336, 6337,
-		7, 12, 0, 0, 6337, 6338, 7, 5, 0, 0, 6338, 6339, 7, 16, 0, 0, 6339, 6340,
-		7, 10, 0, 0, 6340, 1284, 1, 0, 0, 0, 6341, 6342, 7, 16, 0, 0, 6342, 6343,
-		7, 19, 0, 0, 6343, 6344, 5, 95, 0, 0, 6344, 6345, 7, 7, 0, 0, 6345, 6346,
-		7, 22, 0, 0, 6346, 6347, 7, 15, 0, 0, 6347, 6348, 7, 18, 0, 0, 6348, 6349,
-		7, 10, 0, 0, 6349, 6350, 7, 13, 0, 0, 6350, 1286, 1, 0, 0, 0, 6351, 6352,
-		7, 10, 0, 0, 6352, 6353, 7, 7, 0, 0, 6353, 6354, 7, 14, 0, 0, 6354, 6355,
-		7, 19, 0, 0, 6355, 6356, 7, 12, 0, 0, 6356, 6357, 7, 10, 0, 0, 6357, 1288,
-		1, 0, 0, 0, 6358, 6359, 7, 12, 0, 0, 6359, 6360, 7, 17, 0, 0, 6360, 6361,
-		7, 9, 0, 0, 6361, 6362, 7, 16, 0, 0, 6362, 6363, 7, 21, 0, 0, 6363, 6364,
-		7, 10, 0, 0, 6364, 6365, 7, 8, 0, 0, 6365, 1290, 1, 0, 0, 0, 6366, 6367,
-		7, 9, 0, 0, 6367, 6368, 7, 19, 0, 0, 6368, 6369, 7, 13, 0, 0, 6369, 6370,
-		7, 16, 0, 0, 6370, 6371, 7, 21, 0, 0, 6371, 6372, 7, 10, 0, 0, 6372, 6373,
-		7, 8, 0, 0, 6373, 1292, 1, 0, 0, 0, 6374, 6375, 7, 14, 0, 0, 6375, 6376,
-		7, 5, 0, 0, 6376, 6377, 7, 9, 0, 0, 6377, 6378, 7, 10, 0, 0, 6378, 6379,
-		5, 95, 0, 0, 6379, 6380, 7, 9, 0, 0, 6380, 6381, 7, 10, 0, 0, 6381, 6382,
-		7, 7, 0, 0, 6382, 6383, 7, 9, 0, 0, 6383, 6384, 7, 17, 0, 0, 6384, 6385,
-		7, 16, 0, 0, 6385, 6386, 7, 17, 0, 0, 6386, 6387, 7, 27, 0, 0, 6387, 6388,
-		7, 10, 0, 0, 6388, 1294, 1, 0, 0, 0, 6389, 6390, 7, 14, 0, 0, 6390, 6391,
-		7, 5, 0, 0, 6391, 6392, 7, 9, 0, 0, 6392, 6393, 7, 10, 0, 0, 6393, 6394,
-		5, 95, 0, 0, 6394, 6395, 7, 17, 0, 0, 6395, 6396, 7, 7, 0, 0, 6396, 6397,
-		7, 9, 0, 0, 6397, 6398, 7, 10, 0, 0, 6398, 6399, 7, 7, 0, 0, 6399, 6400,
-		7, 9, 0, 0, 6400, 6401, 7, 17, 0, 0, 6401, 6402, 7, 16, 0, 0, 6402, 6403,
-		7, 17, 0, 0, 6403, 6404, 7, 27, 0, 0, 6404, 6405, 7, 10, 0, 0, 6405, 1296,
-		1, 0, 0, 0, 6406, 6410, 3, 1299, 647, 0, 6407, 6409, 3, 1301, 648, 0, 6408,
-		6407, 1, 0, 0, 0, 6409, 6412, 1, 0, 0, 0, 6410, 6408, 1, 0, 0, 0, 6410,
-		6411, 1, 0, 0, 0, 6411, 1298, 1, 0, 0, 0, 6412, 6410, 1, 0, 0, 0, 6413,
-		6420, 7, 31, 0, 0, 6414, 6415, 7, 32, 0, 0, 6415, 6420, 4, 647, 6, 0, 6416,
-		6417, 7, 33, 0, 0, 6417, 6418, 7, 34, 0, 0, 6418, 6420, 4, 647, 7, 0, 6419,
-		6413, 1, 0, 0, 0, 6419, 6414, 1, 0, 0, 0, 6419, 6416, 1, 0, 0, 0, 6420,
-		1300, 1, 0, 0, 0, 6421, 6424, 3, 1303, 649, 0, 6422, 6424, 5, 36, 0, 0,
-		6423, 6421, 1, 0, 0, 0, 6423, 6422, 1, 0, 0, 0, 6424, 1302, 1, 0, 0, 0,
-		6425, 6428, 3, 1299, 647, 0, 6426, 6428, 7, 0, 0, 0, 6427, 6425, 1, 0,
-		0, 0, 6427, 6426, 1, 0, 0, 0, 6428, 1304, 1, 0, 0, 0, 6429, 6430, 3, 1307,
-		651, 0, 6430, 6431, 5, 34, 0, 0, 6431, 1306, 1, 0, 0, 0, 6432, 6438, 5,
-		34, 0, 0, 6433, 6434, 5, 34, 0, 0, 6434, 6437, 5, 34, 0, 0, 6435, 6437,
-		8, 35, 0, 0, 6436, 6433, 1, 0, 0, 0, 6436, 6435, 1, 0, 0, 0, 6437, 6440,
-		1, 0, 0, 0, 6438, 6436, 1, 0, 0, 0, 6438, 6439, 1, 0, 0, 0, 6439, 1308,
-		1, 0, 0, 0, 6440, 6438, 1, 0, 0, 0, 6441, 6442, 3, 1311, 653, 0, 6442,
-		6443, 5, 34, 0, 0, 6443, 1310, 1, 0, 0, 0, 6444, 6450, 5, 34, 0, 0, 6445,
-		6446, 5, 34, 0, 0, 6446, 6449, 5, 34, 0, 0, 6447, 6449, 8, 36, 0, 0, 6448,
-		6445, 1, 0, 0, 0, 6448, 6447, 1, 0, 0, 0, 6449, 6452, 1, 0, 0, 0, 6450,
-		6448, 1, 0, 0, 0, 6450, 6451, 1, 0, 0, 0, 6451, 1312, 1, 0, 0, 0, 6452,
-		6450, 1, 0, 0, 0, 6453, 6454, 7, 22, 0, 0, 6454, 6455, 5, 38, 0, 0, 6455,
-		6456, 3, 1305, 650, 0, 6456, 1314, 1, 0, 0, 0, 6457, 6458, 7, 22, 0, 0,
-		6458, 6459, 5, 38, 0, 0, 6459, 6460, 3, 1307, 651, 0, 6460, 1316, 1, 0,
-		0, 0, 6461, 6462, 7, 22, 0, 0, 6462, 6463, 5, 38, 0, 0, 6463, 6464, 3,
-		1309, 652, 0, 6464, 1318, 1, 0, 0, 0, 6465, 6466, 7, 22, 0, 0, 6466, 6467,
-		5, 38, 0, 0, 6467, 6468, 3, 1311, 653, 0, 6468, 1320, 1, 0, 0, 0, 6469,
-		6470, 3, 1323, 659, 0, 6470, 6471, 5, 39, 0, 0, 6471, 1322, 1, 0, 0, 0,
-		6472, 6478, 5, 39, 0, 0, 6473, 6474, 5, 39, 0, 0, 6474, 6477, 5, 39, 0,
-		0, 6475, 6477, 8, 37, 0, 0, 6476, 6473, 1, 0, 0, 0, 6476, 6475, 1, 0, 0,
-		0, 6477, 6480, 1, 0, 0, 0, 6478, 6476, 1, 0, 0, 0, 6478, 6479, 1, 0, 0,
-		0, 6479, 1324, 1, 0, 0, 0, 6480, 6478, 1, 0, 0, 0, 6481, 6482, 7, 10, 0,
-		0, 6482, 6483, 5, 39, 0, 0, 6483, 6484, 1, 0, 0, 0, 6484, 6485, 6, 660,
-		2, 0, 6485, 6486, 6, 660, 3, 0, 6486, 1326, 1, 0, 0, 0, 6487, 6488, 3,
-		1329, 662, 0, 6488, 6489, 5, 39, 0, 0, 6489, 1328, 1, 0, 0, 0, 6490, 6491,
-		7, 22, 0, 0, 6491, 6492, 5, 38, 0, 0, 6492, 6493, 3, 1323, 659, 0, 6493,
-		1330, 1, 0, 0, 0, 6494, 6496, 5, 36, 0, 0, 6495, 6497, 3, 1333, 664, 0,
-		6496, 6495, 1, 0, 0, 0, 6496, 6497, 1, 0, 0, 0, 6497, 6498, 1, 0, 0, 0,
-		6498, 6499, 5, 36, 0, 0, 6499, 6500, 6, 663, 4, 0, 6500, 6501, 1, 0, 0,
-		0, 6501, 6502, 6, 663, 5, 0, 6502, 1332, 1, 0, 0, 0, 6503, 6507, 3, 1299,
-		647, 0, 6504, 6506, 3, 1303, 649, 0, 6505, 6504, 1, 0, 0, 0, 6506, 6509,
-		1, 0, 0, 0, 6507, 6505, 1, 0, 0, 0, 6507, 6508, 1, 0, 0, 0, 6508, 1334,
-		1, 0, 0, 0, 6509, 6507, 1, 0, 0, 0, 6510, 6511, 3, 1337, 666, 0, 6511,
-		6512, 5, 39, 0, 0, 6512, 1336, 1, 0, 0, 0, 6513, 6514, 7, 18, 0, 0, 6514,
-		6518, 5, 39, 0, 0, 6515, 6517, 7, 38, 0, 0, 6516, 6515, 1, 0, 0, 0, 6517,
-		6520, 1, 0, 0, 0, 6518, 6516, 1, 0, 0, 0, 6518, 6519, 1, 0, 0, 0, 6519,
-		1338, 1, 0, 0, 0, 6520, 6518, 1, 0, 0, 0, 6521, 6522, 3, 1341, 668, 0,
-		6522, 6523, 5, 39, 0, 0, 6523, 1340, 1, 0, 0, 0, 6524, 6525, 7, 18, 0,
-		0, 6525, 6526, 3, 1323, 659, 0, 6526, 1342, 1, 0, 0, 0, 6527, 6528, 3,
-		1345, 670, 0, 6528, 6529, 5, 39, 0, 0, 6529, 1344, 1, 0, 0, 0, 6530, 6531,
-		7, 26, 0, 0, 6531, 6535, 5, 39, 0, 0, 6532, 6534, 7, 39, 0, 0, 6533, 6532,
-		1, 0, 0, 0, 6534, 6537, 1, 0, 0, 0, 6535, 6533, 1, 0, 0, 0, 6535, 6536,
-		1, 0, 0, 0, 6536, 1346, 1, 0, 0, 0, 6537, 6535, 1, 0, 0, 0, 6538, 6539,
-		3, 1349, 672, 0, 6539, 6540, 5, 39, 0, 0, 6540, 1348, 1, 0, 0, 0, 6541,
-		6542, 7, 26, 0, 0, 6542, 6543, 3, 1323, 659, 0, 6543, 1350, 1, 0, 0, 0,
-		6544, 6545, 3, 1357, 676, 0, 6545, 1352, 1, 0, 0, 0, 6546, 6547, 3, 1357,
-		676, 0, 6547, 6548, 5, 46, 0, 0, 6548, 6549, 5, 46, 0, 0, 6549, 6550, 1,
-		0, 0, 0, 6550, 6551, 6, 674, 6, 0, 6551, 1354, 1, 0, 0, 0, 6552, 6553,
-		3, 1357, 676, 0, 6553, 6555, 5, 46, 0, 0, 6554, 6556, 3, 1357, 676, 0,
-		6555, 6554, 1, 0, 0, 0, 6555, 6556, 1, 0, 0, 0, 6556, 6562, 1, 0, 0, 0,
-		6557, 6559, 7, 10, 0, 0, 6558, 6560, 7, 1, 0, 0, 6559, 6558, 1, 0, 0, 0,
-		6559, 6560, 1, 0, 0, 0, 6560, 6561, 1, 0, 0, 0, 6561, 6563, 3, 1357, 676,
-		0, 6562, 6557, 1, 0, 0, 0, 6562, 6563, 1, 0, 0, 0, 6563, 6581, 1, 0, 0,
-		0, 6564, 6565, 5, 46, 0, 0, 6565, 6571, 3, 1357, 676, 0, 6566, 6568, 7,
-		10, 0, 0, 6567, 6569, 7, 1, 0, 0, 6568, 6567, 1, 0, 0, 0, 6568, 6569, 1,
-		0, 0, 0, 6569, 6570, 1, 0, 0, 0, 6570, 6572, 3, 1357, 676, 0, 6571, 6566,
-		1, 0, 0, 0, 6571, 6572, 1, 0, 0, 0, 6572, 6581, 1, 0, 0, 0, 6573, 6574,
-		3, 1357, 676, 0, 6574, 6576, 7, 10, 0, 0, 6575, 6577, 7, 1, 0, 0, 6576,
-		6575, 1, 0, 0, 0, 6576, 6577, 1, 0, 0, 0, 6577, 6578, 1, 0, 0, 0, 6578,
-		6579, 3, 1357, 676, 0, 6579, 6581, 1, 0, 0, 0, 6580, 6552, 1, 0, 0, 0,
-		6580, 6564, 1, 0, 0, 0, 6580, 6573, 1, 0, 0, 0, 6581, 1356, 1, 0, 0, 0,
-		6582, 6584, 7, 0, 0, 0, 6583, 6582, 1, 0, 0, 0, 6584, 6585, 1, 0, 0, 0,
-		6585, 6583, 1, 0, 0, 0, 6585, 6586, 1, 0, 0, 0, 6586, 1358, 1, 0, 0, 0,
-		6587, 6588, 5, 58, 0, 0, 6588, 6592, 7, 40, 0, 0, 6589, 6591, 7, 41, 0,
-		0, 6590, 6589, 1, 0, 0, 0, 6591, 6594, 1, 0, 0, 0, 6592, 6590, 1, 0, 0,
-		0, 6592, 6593, 1, 0, 0, 0, 6593, 1360, 1, 0, 0, 0, 6594, 6592, 1, 0, 0,
-		0, 6595, 6596, 5, 58, 0, 0, 6596, 6597, 5, 34, 0, 0, 6597, 6605, 1, 0,
-		0, 0, 6598, 6599, 5, 92, 0, 0, 6599, 6604, 9, 0, 0, 0, 6600, 6601, 5, 34,
-		0, 0, 6601, 6604, 5, 34, 0, 0, 6602, 6604, 8, 42, 0, 0, 6603, 6598, 1,
-		0, 0, 0, 6603, 6600, 1, 0, 0, 0, 6603, 6602, 1, 0, 0, 0, 6604, 6607, 1,
-		0, 0, 0, 6605, 6603, 1, 0, 0, 0, 6605, 6606, 1, 0, 0, 0, 6606, 6608, 1,
-		0, 0, 0, 6607, 6605, 1, 0, 0, 0, 6608, 6609, 5, 34, 0, 0, 6609, 1362, 1,
-		0, 0, 0, 6610, 6611, 7, 43, 0, 0, 6611, 6612, 1, 0, 0, 0, 6612, 6613, 6,
-		679, 7, 0, 6613, 1364, 1, 0, 0, 0, 6614, 6616, 5, 13, 0, 0, 6615, 6617,
-		5, 10, 0, 0, 6616, 6615, 1, 0, 0, 0, 6616, 6617, 1, 0, 0, 0, 6617, 6620,
-		1, 0, 0, 0, 6618, 6620, 5, 10, 0, 0, 6619, 6614, 1, 0, 0, 0, 6619, 6618,
-		1, 0, 0, 0, 6620, 6621, 1, 0, 0, 0, 6621, 6622, 6, 680, 7, 0, 6622, 1366,
-		1, 0, 0, 0, 6623, 6624, 5, 45, 0, 0, 6624, 6625, 5, 45, 0, 0, 6625, 6629,
-		1, 0, 0, 0, 6626, 6628, 8, 44, 0, 0, 6627, 6626, 1, 0, 0, 0, 6628, 6631,
-		1, 0, 0, 0, 6629, 6627, 1, 0, 0, 0, 6629, 6630, 1, 0, 0, 0, 6630, 6632,
-		1, 0, 0, 0, 6631, 6629, 1, 0, 0, 0, 6632, 6633, 6, 681, 7, 0, 6633, 1368,
-		1, 0, 0, 0, 6634, 6635, 5, 47, 0, 0, 6635, 6636, 5, 42, 0, 0, 6636, 6659,
-		1, 0, 0, 0, 6637, 6639, 5, 47, 0, 0, 6638, 6637, 1, 0, 0, 0, 6639, 6642,
-		1, 0, 0, 0, 6640, 6638, 1, 0, 0, 0, 6640, 6641, 1, 0, 0, 0, 6641, 6643,
-		1, 0, 0, 0, 6642, 6640, 1, 0, 0, 0, 6643, 6658, 3, 1369, 682, 0, 6644,
-		6658, 8, 45, 0, 0, 6645, 6647, 5, 47, 0, 0, 6646, 6645, 1, 0, 0, 0, 6647,
-		6648, 1, 0, 0, 0, 6648, 6646, 1, 0, 0, 0, 6648, 6649, 1, 0, 0, 0, 6649,
-		6650, 1, 0, 0, 0, 6650, 6658, 8, 45, 0, 0, 6651, 6653, 5, 42, 0, 0, 6652,
-		6651, 1, 0, 0, 0, 6653, 6654, 1, 0, 0, 0, 6654, 6652, 1, 0, 0, 0, 6654,
-		6655, 1, 0, 0, 0, 6655, 6656, 1, 0, 0, 0, 6656, 6658, 8, 45, 0, 0, 6657,
-		6640, 1, 0, 0, 0, 6657, 6644, 1, 0, 0, 0, 6657, 6646, 1, 0, 0, 0, 6657,
-		6652, 1, 0, 0, 0, 6658, 6661, 1, 0, 0, 0, 6659, 6657, 1, 0, 0, 0, 6659,
-		6660, 1, 0, 0, 0, 6660, 6665, 1, 0, 0, 0, 6661, 6659, 1, 0, 0, 0, 6662,
-		6664, 5, 42, 0, 0, 6663, 6662, 1, 0, 0, 0, 6664, 6667, 1, 0, 0, 0, 6665,
-		6663, 1, 0, 0, 0, 6665, 6666, 1, 0, 0, 0, 6666, 6668, 1, 0, 0, 0, 6667,
-		6665, 1, 0, 0, 0, 6668, 6669, 5, 42, 0, 0, 6669, 6670, 5, 47, 0, 0, 6670,
-		6671, 1, 0, 0, 0, 6671, 6672, 6, 682, 7, 0, 6672, 1370, 1, 0, 0, 0, 6673,
-		6674, 5, 47, 0, 0, 6674, 6675, 5, 42, 0, 0, 6675, 6700, 1, 0, 0, 0, 6676,
-		6678, 5, 47, 0, 0, 6677, 6676, 1, 0, 0, 0, 6678, 6681, 1, 0, 0, 0, 6679,
-		6677, 1, 0, 0, 0, 6679, 6680, 1, 0, 0, 0, 6680, 6682, 1, 0, 0, 0, 6681,
-		6679, 1, 0, 0, 0, 6682, 6699, 3, 1369, 682, 0, 6683, 6699, 8, 45, 0, 0,
-		6684, 6686, 5, 47, 0, 0, 6685, 6684, 1, 0, 0, 0, 6686, 6687, 1, 0, 0, 0,
-		6687, 6685, 1, 0, 0, 0, 6687, 6688, 1, 0, 0, 0, 6688, 6689, 1, 0, 0, 0,
-		6689, 6697, 8, 45, 0, 0, 6690, 6692, 5, 42, 0, 0, 6691, 6690, 1, 0, 0,
-		0, 6692, 6693, 1, 0, 0, 0, 6693, 6691, 1, 0, 0, 0, 6693, 6694, 1, 0, 0,
-		0, 6694, 6695, 1, 0, 0, 0, 6695, 6697, 8, 45, 0, 0, 6696, 6685, 1, 0, 0,
-		0, 6696, 6691, 1, 0, 0, 0, 6697, 6699, 1, 0, 0, 0, 6698, 6679, 1, 0, 0,
-		0, 6698, 6683, 1, 0, 0, 0, 6698, 6696, 1, 0, 0, 0, 6699, 6702, 1, 0, 0,
-		0, 6700, 6698, 1, 0, 0, 0, 6700, 6701, 1, 0, 0, 0, 6701, 6720, 1, 0, 0,
-		0, 6702, 6700, 1, 0, 0, 0, 6703, 6705, 5, 47, 0, 0, 6704, 6703, 1, 0, 0,
-		0, 6705, 6706, 1, 0, 0, 0, 6706, 6704, 1, 0, 0, 0, 6706, 6707, 1, 0, 0,
-		0, 6707, 6721, 1, 0, 0, 0, 6708, 6710, 5, 42, 0, 0, 6709, 6708, 1, 0, 0,
-		0, 6710, 6711, 1, 0, 0, 0, 6711, 6709, 1, 0, 0, 0, 6711, 6712, 1, 0, 0,
-		0, 6712, 6721, 1, 0, 0, 0, 6713, 6715, 5, 47, 0, 0, 6714, 6713, 1, 0, 0,
-		0, 6715, 6718, 1, 0, 0, 0, 6716, 6714, 1, 0, 0, 0, 6716, 6717, 1, 0, 0,
-		0, 6717, 6719, 1, 0, 0, 0, 6718, 6716, 1, 0, 0, 0, 6719, 6721, 3, 1371,
-		683, 0, 6720, 6704, 1, 0, 0, 0, 6720, 6709, 1, 0, 0, 0, 6720, 6716, 1,
-		0, 0, 0, 6720, 6721, 1, 0, 0, 0, 6721, 6722, 1, 0, 0, 0, 6722, 6723, 6,
-		683, 8, 0, 6723, 1372, 1, 0, 0, 0, 6724, 6736, 5, 92, 0, 0, 6725, 6735,
-		8, 46, 0, 0, 6726, 6730, 5, 34, 0, 0, 6727, 6729, 8, 47, 0, 0, 6728, 6727,
-		1, 0, 0, 0, 6729, 6732, 1, 0, 0, 0, 6730, 6728, 1, 0, 0, 0, 6730, 6731,
-		1, 0, 0, 0, 6731, 6733, 1, 0, 0, 0, 6732, 6730, 1, 0, 0, 0, 6733, 6735,
-		5, 34, 0, 0, 6734, 6725, 1, 0, 0, 0, 6734, 6726, 1, 0, 0, 0, 6735, 6738,
-		1, 0, 0, 0, 6736, 6734, 1, 0, 0, 0, 6736, 6737, 1, 0, 0, 0, 6737, 6746,
-		1, 0, 0, 0, 6738, 6736, 1, 0, 0, 0, 6739, 6743, 5, 34, 0, 0, 6740, 6742,
-		8, 47, 0, 0, 6741, 6740, 1, 0, 0, 0, 6742, 6745, 1, 0, 0, 0, 6743, 6741,
-		1, 0, 0, 0, 6743, 6744, 1, 0, 0, 0, 6744, 6747, 1, 0, 0, 0, 6745, 6743,
-		1, 0, 0, 0, 6746, 6739, 1, 0, 0, 0, 6746, 6747, 1, 0, 0, 0, 6747, 1374,
-		1, 0, 0, 0, 6748, 6749, 5, 92, 0, 0, 6749, 6750, 5, 92, 0, 0, 6750, 1376,
-		1, 0, 0, 0, 6751, 6752, 9, 0, 0, 0, 6752, 1378, 1, 0, 0, 0, 6753, 6754,
-		3, 1383, 689, 0, 6754, 6755, 5, 39, 0, 0, 6755, 6756, 1, 0, 0, 0, 6756,
-		6757, 6, 687, 9, 0, 6757, 1380, 1, 0, 0, 0, 6758, 6760, 3, 1383, 689, 0,
-		6759, 6761, 5, 92, 0, 0, 6760, 6759, 1, 0, 0, 0, 6760, 6761, 1, 0, 0, 0,
-		6761, 6762, 1, 0, 0, 0, 6762, 6763, 5, 0, 0, 1, 6763, 1382, 1, 0, 0, 0,
-		6764, 6765, 5, 39, 0, 0, 6765, 6788, 5, 39, 0, 0, 6766, 6784, 5, 92, 0,
-		0, 6767, 6768, 5, 120, 0, 0, 6768, 6785, 7, 39, 0, 0, 6769, 6770, 5, 117,
-		0, 0, 6770, 6771, 7, 39, 0, 0, 6771, 6772, 7, 39, 0, 0, 6772, 6773, 7,
-		39, 0, 0, 6773, 6785, 7, 39, 0, 0, 6774, 6775, 5, 85, 0, 0, 6775, 6776,
-		7, 39, 0, 0, 6776, 6777, 7, 39, 0, 0, 6777, 6778, 7, 39, 0, 0, 6778, 6779,
-		7, 39, 0, 0, 6779, 6780, 7, 39, 0, 0, 6780, 6781, 7, 39, 0, 0, 6781, 6782,
-		7, 39, 0, 0, 6782, 6785, 7, 39, 0, 0, 6783, 6785, 8, 48, 0, 0, 6784, 6767,
-		1, 0, 0, 0, 6784, 6769, 1, 0, 0, 0, 6784, 6774, 1, 0, 0, 0, 6784, 6783,
-		1, 0, 0, 0, 6785, 6788, 1, 0, 0, 0, 6786, 6788, 8, 49, 0, 0, 6787, 6764,
-		1, 0, 0, 0, 6787, 6766, 1, 0, 0, 0, 6787, 6786, 1, 0, 0, 0, 6788, 6791,
-		1, 0, 0, 0, 6789, 6787, 1, 0, 0, 0, 6789, 6790, 1, 0, 0, 0, 6790, 1384,
-		1, 0, 0, 0, 6791, 6789, 1, 0, 0, 0, 6792, 6793, 3, 1389, 692, 0, 6793,
-		6794, 5, 39, 0, 0, 6794, 6795, 1, 0, 0, 0, 6795, 6796, 6, 690, 9, 0, 6796,
-		1386, 1, 0, 0, 0, 6797, 6799, 3, 1389, 692, 0, 6798, 6800, 5, 92, 0, 0,
-		6799, 6798, 1, 0, 0, 0, 6799, 6800, 1, 0, 0, 0, 6800, 6801, 1, 0, 0, 0,
-		6801, 6802, 5, 0, 0, 1, 6802, 1388, 1, 0, 0, 0, 6803, 6804, 5, 39, 0, 0,
-		6804, 6809, 5, 39, 0, 0, 6805, 6806, 5, 92, 0, 0, 6806, 6809, 9, 0, 0,
-		0, 6807, 6809, 8, 49, 0, 0, 6808, 6803, 1, 0, 0, 0, 6808, 6805, 1, 0, 0,
-		0, 6808, 6807, 1, 0, 0, 0, 6809, 6812, 1, 0, 0, 0, 6810, 6808, 1, 0, 0,
-		0, 6810, 6811, 1, 0, 0, 0, 6811, 1390, 1, 0, 0, 0, 6812, 6810, 1, 0, 0,
-		0, 6813, 6814, 3, 1363, 679, 0, 6814, 6815, 1, 0, 0, 0, 6815, 6816, 6,
-		693, 10, 0, 6816, 6817, 6, 693, 7, 0, 6817, 1392, 1, 0, 0, 0, 6818, 6819,
-		3, 1365, 680, 0, 6819, 6820, 1, 0, 0, 0, 6820, 6821, 6, 694, 11, 0, 6821,
-		6822, 6, 694, 7, 0, 6822, 6823, 6, 694, 12, 0, 6823, 1394, 1, 0, 0, 0,
-		6824, 6825, 6, 695, 13, 0, 6825, 6826, 1, 0, 0, 0, 6826, 6827, 6, 695,
-		14, 0, 6827, 6828, 6, 695, 15, 0, 6828, 1396, 1, 0, 0, 0, 6829, 6830, 3,
-		1363, 679, 0, 6830, 6831, 1, 0, 0, 0, 6831, 6832, 6, 696, 10, 0, 6832,
-		6833, 6, 696, 7, 0, 6833, 1398, 1, 0, 0, 0, 6834, 6835, 3, 1365, 680, 0,
-		6835, 6836, 1, 0, 0, 0, 6836, 6837, 6, 697, 11, 0, 6837, 6838, 6, 697,
-		7, 0, 6838, 1400, 1, 0, 0, 0, 6839, 6840, 5, 39, 0, 0, 6840, 6841, 1, 0,
-		0, 0, 6841, 6842, 6, 698, 2, 0, 6842, 6843, 6, 698, 16, 0, 6843, 1402,
-		1, 0, 0, 0, 6844, 6845, 6, 699, 17, 0, 6845, 6846, 1, 0, 0, 0, 6846, 6847,
-		6, 699, 14, 0, 6847, 6848, 6, 699, 15, 0, 6848, 1404, 1, 0, 0, 0, 6849,
-		6851, 8, 50, 0, 0, 6850, 6849, 1, 0, 0, 0, 6851, 6852, 1, 0, 0, 0, 6852,
-		6850, 1, 0, 0, 0, 6852, 6853, 1, 0, 0, 0, 6853, 6862, 1, 0, 0, 0, 6854,
-		6858, 5, 36, 0, 0, 6855, 6857, 8, 50, 0, 0, 6856, 6855, 1, 0, 0, 0, 6857,
-		6860, 1, 0, 0, 0, 6858, 6856, 1, 0, 0, 0, 6858, 6859, 1, 0, 0, 0, 6859,
-		6862, 1, 0, 0, 0, 6860, 6858, 1, 0, 0, 0, 6861, 6850, 1, 0, 0, 0, 6861,
-		6854, 1, 0, 0, 0, 6862, 1406, 1, 0, 0, 0, 6863, 6865, 5, 36, 0, 0, 6864,
-		6866, 3, 1333, 664, 0, 6865, 6864, 1, 0, 0, 0, 6865, 6866, 1, 0, 0, 0,
-		6866, 6867, 1, 0, 0, 0, 6867, 6868, 5, 36, 0, 0, 6868, 6869, 1, 0, 0, 0,
-		6869, 6870, 4, 701, 8, 0, 6870, 6871, 6, 701, 18, 0, 6871, 6872, 1, 0,
-		0, 0, 6872, 6873, 6, 701, 15, 0, 6873, 1408, 1, 0, 0, 0, 77, 0, 1, 2, 3,
-		4, 1476, 1482, 1484, 1489, 1493, 1495, 1498, 1507, 1509, 1514, 1519, 1521,
-		6410, 6419, 6423, 6427, 6436, 6438, 6448, 6450, 6476, 6478, 6496, 6507,
-		6518, 6535, 6555, 6559, 6562, 6568, 6571, 6576, 6580, 6585, 6592, 6603,
-		6605, 6616, 6619, 6629, 6640, 6648, 6654, 6657, 6659, 6665, 6679, 6687,
-		6693, 6696, 6698, 6700, 6706, 6711, 6716, 6720, 6730, 6734, 6736, 6743,
-		6746, 6760, 6784, 6787, 6789, 6799, 6808, 6810, 6852, 6858, 6861, 6865,
-		19, 1, 28, 0, 7, 29, 0, 3, 0, 0, 5, 1, 0, 1, 663, 1, 5, 4, 0, 1, 674, 2,
-		0, 1, 0, 1, 683, 3, 2, 2, 0, 7, 670, 0, 7, 671, 0, 2, 3, 0, 1, 695, 4,
-		6, 0, 0, 4, 0, 0, 2, 1, 0, 1, 699, 5, 1, 701, 6,
+		55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57,
+		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58, 1,
+		58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
+		1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1,
+		62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1,
+		65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 67,
+		1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1,
+		69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70,
+		1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1,
+		72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73,
+		1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1,
+		75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 76,
+		1, 76, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 79,
+		1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1,
+		79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81,
+		1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 83, 1,
+		83, 1, 83, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 86,
+		1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1,
+		87, 1, 87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88,
+		1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1,
+		89, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90,
+		1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1,
+		92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 93,
+		1, 93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1,
+		94, 1, 94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 96,
+		1, 96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1,
+		98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99,
+		1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 101, 1, 101, 1, 101,
+		1, 101, 1, 101, 1, 101, 1, 101, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102,
+		1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 104, 1, 104, 1, 104,
+		1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105, 1, 105,
+		1, 105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 107,
+		1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 107, 1, 108, 1, 108, 1, 108,
+		1, 108, 1, 108, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109,
+		1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 110, 1, 110,
+		1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111, 1, 111, 1, 111,
+		1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 111, 1, 112, 1, 112, 1, 112,
+		1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112,
+		1, 112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 114, 1, 114,
+		1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114,
+		1, 114, 1, 114, 1, 114, 1, 114, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115,
+		1, 115, 1, 115, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 117, 1, 117,
+		1, 117, 1, 117, 1, 117, 1, 117, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118,
+		1, 118, 1, 119, 1, 119, 1, 119, 1, 120, 1, 120, 1, 120, 1, 120, 1, 120,
+		1, 120, 1, 120, 1, 121, 1, 121, 1, 121, 1, 121, 1, 121, 1, 122, 1, 122,
+		1, 122, 1, 122, 1, 122, 1, 123, 1, 123, 1, 123, 1, 123, 1, 123, 1, 124,
+		1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 124, 1, 125, 1, 125,
+		1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 125, 1, 126, 1, 126, 1, 126,
+		1, 126, 1, 126, 1, 126, 1, 127, 1, 127, 1, 127, 1, 127, 1, 127, 1, 128,
+		1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 128, 1, 129,
+		1, 129, 1, 129, 1, 129, 1, 129, 1, 129, 1, 130, 1, 130, 1, 130, 1, 130,
+		1, 130, 1, 130, 1, 130, 1, 130, 1, 131, 1, 131, 1, 131, 1, 131, 1, 131,
+		1, 131, 1, 131, 1, 131, 1, 132, 1, 132, 1, 132, 1, 132, 1, 132, 1, 132,
+		1, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1, 134, 1, 134,
+		1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 134, 1, 135, 1, 135,
+		1, 135, 1, 135, 1, 135, 1, 135, 1, 135, 1, 136, 1, 136, 1, 136, 1, 136,
+		1, 136, 1, 136, 1, 136, 1, 137, 1, 137, 1, 137, 1, 137, 1, 138, 1, 138,
+		1, 138, 1, 138, 1, 138, 1, 138, 1, 139, 1, 139, 1, 139, 1, 139, 1, 139,
+		1, 139, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140, 1, 140,
+		1, 140, 1, 140, 1, 141, 1, 141, 1, 141, 1, 141, 1, 141, 1, 142, 1, 142,
+		1, 142, 1, 142, 1, 142, 1, 142, 1, 143, 1, 143, 1, 143, 1, 143, 1, 143,
+		1, 143, 1, 143, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144, 1, 144,
+		1, 144, 1, 144, 1, 144, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 145,
+		1, 145, 1, 145, 1, 145, 1, 145, 1, 145, 1, 146, 1, 146, 1, 146, 1, 147,
+		1, 147, 1, 147, 1, 147, 1, 147, 1, 147, 1, 147, 1, 147, 1, 147, 1, 147,
+		1, 148, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148, 1, 148,
+		1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 149, 1, 150, 1, 150,
+		1, 150, 1, 150, 1, 150, 1, 150, 1, 151, 1, 151, 1, 151, 1, 152, 1, 152,
+		1, 152, 1, 152, 1, 152, 1, 152, 1, 153, 1, 153, 1, 153, 1, 153, 1, 153,
+		1, 153, 1, 153, 1, 154, 1, 154, 1, 154, 1, 154, 1, 154, 1, 154, 1, 154,
+		1, 154, 1, 155, 1, 155, 1, 155, 1, 155, 1, 155, 1, 155, 1, 155, 1, 155,
+		1, 155, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156, 1, 156,
+		1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 157, 1, 158, 1, 158, 1, 158,
+		1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158, 1, 158,
+		1, 158, 1, 158, 1, 158, 1, 158, 1, 159, 1, 159, 1, 159, 1, 159, 1, 159,
+		1, 159, 1, 159, 1, 159, 1, 159, 1, 159, 1, 159, 1, 160, 1, 160, 1, 160,
+		1, 160, 1, 160, 1, 160, 1, 161, 1, 161, 1, 161, 1, 161, 1, 161, 1, 161,
+		1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 162, 1, 163,
+		1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 1, 163, 1, 164, 1, 164,
+		1, 164, 1, 164, 1, 164, 1, 164, 1, 164, 1, 164, 1, 164, 1, 165, 1, 165,
+		1, 165, 1, 165, 1, 165, 1, 165, 1, 165, 1, 166, 1, 166, 1, 166, 1, 166,
+		1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 166, 1, 167, 1, 167, 1, 167,
+		1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167, 1, 167,
+		1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 168,
+		1, 168, 1, 168, 1, 168, 1, 168, 1, 168, 1, 169, 1, 169, 1, 169, 1, 169,
+		1, 169, 1, 169, 1, 169, 1, 169, 1, 169, 1, 169, 1, 169, 1, 170, 1, 170,
+		1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170, 1, 170,
+		1, 170, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171, 1, 171,
+		1, 172, 1, 172, 1, 172, 1, 172, 1, 172, 1, 172, 1, 172, 1, 172, 1, 172,
+		1, 173, 1, 173, 1, 173, 1, 173, 1, 173, 1, 173, 1, 173, 1, 173, 1, 173,
+		1, 173, 1, 173, 1, 174, 1, 174, 1, 174, 1, 174, 1, 174, 1, 175, 1, 175,
+		1, 175, 1, 175, 1, 175, 1, 176, 1, 176, 1, 176, 1, 176, 1, 177, 1, 177,
+		1, 177, 1, 177, 1, 177, 1, 177, 1, 177, 1, 178, 1, 178, 1, 178, 1, 178,
+		1, 178, 1, 178, 1, 179, 1, 179, 1, 179, 1, 179, 1, 179, 1, 180, 1, 180,
+		1, 180, 1, 180, 1, 180, 1, 180, 1, 180, 1, 180, 1, 180, 1, 181, 1, 181,
+		1, 181, 1, 181, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182, 1, 182,
+		1, 182, 1, 182, 1, 182, 1, 182, 1, 183, 1, 183, 1, 183, 1, 183, 1, 183,
+		1, 183, 1, 183, 1, 183, 1, 184, 1, 184, 1, 184, 1, 184, 1, 184, 1, 184,
+		1, 184, 1, 184, 1, 184, 1, 185, 1, 185, 1, 185, 1, 185, 1, 185, 1, 185,
+		1, 185, 1, 185, 1, 185, 1, 186, 1, 186, 1, 186, 1, 186, 1, 186, 1, 186,
+		1, 186, 1, 186, 1, 187, 1, 187, 1, 187, 1, 187, 1, 187, 1, 187, 1, 187,
+		1, 188, 1, 188, 1, 188, 1, 188, 1, 188, 1, 188, 1, 188, 1, 188, 1, 188,
+		1, 188, 1, 189, 1, 189, 1, 189, 1, 189, 1, 189, 1, 189, 1, 189, 1, 189,
+		1, 189, 1, 189, 1, 189, 1, 190, 1, 190, 1, 190, 1, 190, 1, 190, 1, 190,
+		1, 190, 1, 190, 1, 190, 1, 190, 1, 190, 1, 191, 1, 191, 1, 191, 1, 191,
+		1, 191, 1, 191, 1, 191, 1, 191, 1, 192, 1, 192, 1, 192, 1, 192, 1, 192,
+		1, 192, 1, 192, 1, 192, 1, 193, 1, 193, 1, 193, 1, 193, 1, 193, 1, 193,
+		1, 193, 1, 193, 1, 193, 1, 194, 1, 194, 1, 194, 1, 194, 1, 194, 1, 194,
+		1, 194, 1, 195, 1, 195, 1, 195, 1, 195, 1, 195, 1, 195, 1, 195, 1, 196,
+		1, 196, 1, 196, 1, 196, 1, 196, 1, 197, 1, 197, 1, 197, 1, 197, 1, 197,
+		1, 198, 1, 198, 1, 198, 1, 198, 1, 198, 1, 198, 1, 199, 1, 199, 1, 199,
+		1, 199, 1, 199, 1, 199, 1, 199, 1, 200, 1, 200, 1, 200, 1, 200, 1, 200,
+		1, 200, 1, 200, 1, 200, 1, 200, 1, 201, 1, 201, 1, 201, 1, 201, 1, 201,
+		1, 201, 1, 201, 1, 201, 1, 201, 1, 201, 1, 202, 1, 202, 1, 202, 1, 202,
+		1, 202, 1, 203, 1, 203, 1, 203, 1, 203, 1, 203, 1, 203, 1, 203, 1, 204,
+		1, 204, 1, 204, 1, 204, 1, 204, 1, 204, 1, 205, 1, 205, 1, 205, 1, 205,
+		1, 205, 1, 205, 1, 205, 1, 205, 1, 206, 1, 206, 1, 206, 1, 206, 1, 206,
+		1, 206, 1, 206, 1, 206, 1, 206, 1, 206, 1, 207, 1, 207, 1, 207, 1, 207,
+		1, 207, 1, 207, 1, 207, 1, 207, 1, 207, 1, 207, 1, 208, 1, 208, 1, 208,
+		1, 208, 1, 208, 1, 208, 1, 208, 1, 208, 1, 209, 1, 209, 1, 209, 1, 209,
+		1, 209, 1, 209, 1, 209, 1, 209, 1, 210, 1, 210, 1, 210, 1, 210, 1, 210,
+		1, 210, 1, 210, 1, 210, 1, 210, 1, 210, 1, 211, 1, 211, 1, 211, 1, 211,
+		1, 211, 1, 211, 1, 211, 1, 211, 1, 211, 1, 212, 1, 212, 1, 212, 1, 212,
+		1, 212, 1, 212, 1, 212, 1, 213, 1, 213, 1, 213, 1, 213, 1, 213, 1, 213,
+		1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214, 1, 214,
+		1, 214, 1, 215, 1, 215, 1, 215, 1, 215, 1, 215, 1, 215, 1, 216, 1, 216,
+		1, 216, 1, 216, 1, 216, 1, 216, 1, 216, 1, 216, 1, 217, 1, 217, 1, 217,
+		1, 217, 1, 217, 1, 217, 1, 217, 1, 217, 1, 217, 1, 218, 1, 218, 1, 218,
+		1, 218, 1, 218, 1, 218, 1, 218, 1, 218, 1, 218, 1, 218, 1, 219, 1, 219,
+		1, 219, 1, 219, 1, 219, 1, 219, 1, 219, 1, 220, 1, 220, 1, 220, 1, 220,
+		1, 220, 1, 220, 1, 220, 1, 220, 1, 221, 1, 221, 1, 221, 1, 221, 1, 221,
+		1, 221, 1, 221, 1, 221, 1, 222, 1, 222, 1, 222, 1, 222, 1, 222, 1, 222,
+		1, 222, 1, 223, 1, 223, 1, 223, 1, 223, 1, 223, 1, 224, 1, 224, 1, 224,
+		1, 224, 1, 224, 1, 225, 1, 225, 1, 225, 1, 225, 1, 225, 1, 225, 1, 225,
+		1, 225, 1, 225, 1, 226, 1, 226, 1, 226, 1, 227, 1, 227, 1, 227, 1, 227,
+		1, 227, 1, 227, 1, 227, 1, 227, 1, 227, 1, 227, 1, 228, 1, 228, 1, 228,
+		1, 228, 1, 228, 1, 228, 1, 228, 1, 228, 1, 228, 1, 228, 1, 229, 1, 229,
+		1, 229, 1, 229, 1, 229, 1, 229, 1, 229, 1, 229, 1, 229, 1, 230, 1, 230,
+		1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1, 230, 1, 231,
+		1, 231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 231, 1, 231,
+		1, 232, 1, 232, 1, 232, 1, 232, 1, 232, 1, 232, 1, 233, 1, 233, 1, 233,
+		1, 233, 1, 233, 1, 233, 1, 233, 1, 233, 1, 234, 1, 234, 1, 234, 1, 234,
+		1, 234, 1, 234, 1, 234, 1, 234, 1, 235, 1, 235, 1, 235, 1, 235, 1, 235,
+		1, 235, 1, 235, 1, 235, 1, 235, 1, 236, 1, 236, 1, 236, 1, 236, 1, 236,
+		1, 236, 1, 236, 1, 237, 1, 237, 1, 237, 1, 237, 1, 237, 1, 237, 1, 237,
+		1, 237, 1, 237, 1, 237, 1, 237, 1, 237, 1, 238, 1, 238, 1, 238, 1, 238,
+		1, 238, 1, 238, 1, 238, 1, 239, 1, 239, 1, 239, 1, 239, 1, 239, 1, 239,
+		1, 239, 1, 239, 1, 240, 1, 240, 1, 240, 1, 240, 1, 240, 1, 240, 1, 240,
+		1, 240, 1, 241, 1, 241, 1, 241, 1, 241, 1, 241, 1, 241, 1, 241, 1, 241,
+		1, 241, 1, 241, 1, 242, 1, 242, 1, 242, 1, 242, 1, 242, 1, 243, 1, 243,
+		1, 243, 1, 243, 1, 243, 1, 244, 1, 244, 1, 244, 1, 244, 1, 245, 1, 245,
+		1, 245, 1, 245, 1, 245, 1, 246, 1, 246, 1, 246, 1, 246, 1, 246, 1, 246,
+		1, 247, 1, 247, 1, 247, 1, 247, 1, 247, 1, 247, 1, 247, 1, 247, 1, 247,
+		1, 248, 1, 248, 1, 248, 1, 248, 1, 248, 1, 248, 1, 249, 1, 249, 1, 249,
+		1, 249, 1, 249, 1, 250, 1, 250, 1, 250, 1, 250, 1, 250, 1, 250, 1, 250,
+		1, 250, 1, 250, 1, 250, 1, 251, 1, 251, 1, 251, 1, 251, 1, 251, 1, 251,
+		1, 252, 1, 252, 1, 252, 1, 252, 1, 252, 1, 252, 1, 252, 1, 253, 1, 253,
+		1, 253, 1, 253, 1, 253, 1, 254, 1, 254, 1, 254, 1, 254, 1, 254, 1, 254,
+		1, 255, 1, 255, 1, 255, 1, 255, 1, 255, 1, 255, 1, 255, 1, 255, 1, 255,
+		1, 256, 1, 256, 1, 256, 1, 256, 1, 256, 1, 257, 1, 257, 1, 257, 1, 257,
+		1, 257, 1, 257, 1, 257, 1, 257, 1, 258, 1, 258, 1, 258, 1, 258, 1, 258,
+		1, 258, 1, 259, 1, 259, 1, 259, 1, 259, 1, 259, 1, 259, 1, 259, 1, 259,
+		1, 260, 1, 260, 1, 260, 1, 260, 1, 260, 1, 260, 1, 260, 1, 260, 1, 260,
+		1, 260, 1, 260, 1, 260, 1, 260, 1, 261, 1, 261, 1, 261, 1, 261, 1, 261,
+		1, 261, 1, 261, 1, 261, 1, 261, 1, 262, 1, 262, 1, 262, 1, 262, 1, 262,
+		1, 262, 1, 263, 1, 263, 1, 263, 1, 263, 1, 263, 1, 263, 1, 263, 1, 264,
+		1, 264, 1, 264, 1, 264, 1, 264, 1, 264, 1, 264, 1, 264, 1, 264, 1, 265,
+		1, 265, 1, 265, 1, 265, 1, 265, 1, 266, 1, 266, 1, 266, 1, 266, 1, 266,
+		1, 266, 1, 267, 1, 267, 1, 267, 1, 267, 1, 267, 1, 268, 1, 268, 1, 268,
+		1, 268, 1, 268, 1, 269, 1, 269, 1, 269, 1, 269, 1, 269, 1, 269, 1, 270,
+		1, 270, 1, 270, 1, 270, 1, 270, 1, 270, 1, 270, 1, 271, 1, 271, 1, 271,
+		1, 271, 1, 271, 1, 272, 1, 272, 1, 272, 1, 273, 1, 273, 1, 273, 1, 273,
+		1, 273, 1, 273, 1, 273, 1, 273, 1, 274, 1, 274, 1, 274, 1, 274, 1, 274,
+		1, 274, 1, 274, 1, 275, 1, 275, 1, 275, 1, 275, 1, 275, 1, 275, 1, 275,
+		1, 276, 1, 276, 1, 276, 1, 276, 1, 276, 1, 276, 1, 277, 1, 277, 1, 277,
+		1, 277, 1, 277, 1, 277, 1, 277, 1, 278, 1, 278, 1, 278, 1, 279, 1, 279,
+		1, 279, 1, 279, 1, 280, 1, 280, 1, 280, 1, 280, 1, 280, 1, 281, 1, 281,
+		1, 281, 1, 281, 1, 281, 1, 282, 1, 282, 1, 282, 1, 282, 1, 282, 1, 282,
+		1, 282, 1, 282, 1, 282, 1, 283, 1, 283, 1, 283, 1, 283, 1, 283, 1, 283,
+		1, 283, 1, 284, 1, 284, 1, 284, 1, 284, 1, 284, 1, 284, 1, 284, 1, 284,
+		1, 285, 1, 285, 1, 285, 1, 285, 1, 285, 1, 285, 1, 286, 1, 286, 1, 286,
+		1, 286, 1, 286, 1, 286, 1, 287, 1, 287, 1, 287, 1, 287, 1, 287, 1, 287,
+		1, 287, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288, 1, 288,
+		1, 289, 1, 289, 1, 289, 1, 289, 1, 289, 1, 289, 1, 289, 1, 289, 1, 289,
+		1, 289, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290, 1, 290,
+		1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291, 1, 291,
+		1, 292, 1, 292, 1, 292, 1, 292, 1, 292, 1, 293, 1, 293, 1, 293, 1, 293,
+		1, 293, 1, 293, 1, 294, 1, 294, 1, 294, 1, 294, 1, 294, 1, 294, 1, 294,
+		1, 294, 1, 294, 1, 294, 1, 295, 1, 295, 1, 295, 1, 295, 1, 295, 1, 295,
+		1, 295, 1, 295, 1, 296, 1, 296, 1, 296, 1, 296, 1, 296, 1, 296, 1, 296,
+		1, 296, 1, 296, 1, 297, 1, 297, 1, 297, 1, 297, 1, 297, 1, 297, 1, 297,
+		1, 297, 1, 297, 1, 298, 1, 298, 1, 298, 1, 298, 1, 298, 1, 298, 1, 299,
+		1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299, 1, 299,
+		1, 299, 1, 300, 1, 300, 1, 300, 1, 300, 1, 300, 1, 300, 1, 300, 1, 300,
+		1, 300, 1, 300, 1, 300, 1, 301, 1, 301, 1, 301, 1, 301, 1, 301, 1, 301,
+		1, 301, 1, 301, 1, 301, 1, 301, 1, 302, 1, 302, 1, 302, 1, 302, 1, 302,
+		1, 302, 1, 302, 1, 302, 1, 303, 1, 303, 1, 303, 1, 303, 1, 303, 1, 303,
+		1, 304, 1, 304, 1, 304, 1, 304, 1, 304, 1, 304, 1, 304, 1, 305, 1, 305,
+		1, 305, 1, 305, 1, 305, 1, 305, 1, 306, 1, 306, 1, 306, 1, 306, 1, 306,
+		1, 307, 1, 307, 1, 307, 1, 307, 1, 307, 1, 307, 1, 307, 1, 307, 1, 307,
+		1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1, 308, 1, 309,
+		1, 309, 1, 309, 1, 309, 1, 309, 1, 309, 1, 309, 1, 309, 1, 309, 1, 309,
+		1, 310, 1, 310, 1, 310, 1, 310, 1, 311, 1, 311, 1, 311, 1, 311, 1, 311,
+		1, 311, 1, 311, 1, 311, 1, 312, 1, 312, 1, 312, 1, 312, 1, 312, 1, 312,
+		1, 312, 1, 312, 1, 313, 1, 313, 1, 313, 1, 313, 1, 313, 1, 313, 1, 313,
+		1, 313, 1, 313, 1, 314, 1, 314, 1, 314, 1, 314, 1, 314, 1, 314, 1, 314,
+		1, 314, 1, 315, 1, 315, 1, 315, 1, 315, 1, 315, 1, 315, 1, 315, 1, 316,
+		1, 316, 1, 316, 1, 316, 1, 316, 1, 316, 1, 316, 1, 316, 1, 316, 1, 316,
+		1, 316, 1, 317, 1, 317, 1, 317, 1, 317, 1, 317, 1, 317, 1, 317, 1, 317,
+		1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 318, 1, 319,
+		1, 319, 1, 319, 1, 319, 1, 319, 1, 319, 1, 320, 1, 320, 1, 320, 1, 320,
+		1, 320, 1, 320, 1, 320, 1, 320, 1, 321, 1, 321, 1, 321, 1, 321, 1, 321,
+		1, 321, 1, 321, 1, 321, 1, 321, 1, 322, 1, 322, 1, 322, 1, 322, 1, 322,
+		1, 322, 1, 322, 1, 322, 1, 323, 1, 323, 1, 323, 1, 323, 1, 323, 1, 323,
+		1, 323, 1, 324, 1, 324, 1, 324, 1, 324, 1, 324, 1, 325, 1, 325, 1, 325,
+		1, 325, 1, 325, 1, 325, 1, 325, 1, 325, 1, 325, 1, 326, 1, 326, 1, 326,
+		1, 326, 1, 326, 1, 327, 1, 327, 1, 327, 1, 327, 1, 327, 1, 328, 1, 328,
+		1, 328, 1, 328, 1, 328, 1, 328, 1, 328, 1, 328, 1, 328, 1, 328, 1, 329,
+		1, 329, 1, 329, 1, 329, 1, 329, 1, 329, 1, 329, 1, 330, 1, 330, 1, 330,
+		1, 330, 1, 330, 1, 330, 1, 330, 1, 331, 1, 331, 1, 331, 1, 331, 1, 331,
+		1, 331, 1, 331, 1, 332, 1, 332, 1, 332, 1, 332, 1, 332, 1, 332, 1, 332,
+		1, 333, 1, 333, 1, 333, 1, 333, 1, 333, 1, 333, 1, 333, 1, 334, 1, 334,
+		1, 334, 1, 334, 1, 334, 1, 334, 1, 334, 1, 334, 1, 334, 1, 335, 1, 335,
+		1, 335, 1, 335, 1, 335, 1, 335, 1, 335, 1, 335, 1, 335, 1, 336, 1, 336,
+		1, 336, 1, 336, 1, 336, 1, 336, 1, 336, 1, 336, 1, 336, 1, 336, 1, 337,
+		1, 337, 1, 337, 1, 337, 1, 337, 1, 337, 1, 337, 1, 337, 1, 337, 1, 337,
+		1, 337, 1, 337, 1, 337, 1, 338, 1, 338, 1, 338, 1, 338, 1, 338, 1, 338,
+		1, 338, 1, 339, 1, 339, 1, 339, 1, 339, 1, 339, 1, 339, 1, 339, 1, 339,
+		1, 340, 1, 340, 1, 340, 1, 340, 1, 341, 1, 341, 1, 341, 1, 341, 1, 341,
+		1, 341, 1, 342, 1, 342, 1, 342, 1, 342, 1, 342, 1, 343, 1, 343, 1, 343,
+		1, 343, 1, 343, 1, 343, 1, 343, 1, 344, 1, 344, 1, 344, 1, 344, 1, 344,
+		1, 344, 1, 344, 1, 344, 1, 344, 1, 345, 1, 345, 1, 345, 1, 345, 1, 345,
+		1, 345, 1, 345, 1, 346, 1, 346, 1, 346, 1, 346, 1, 346, 1, 346, 1, 346,
+		1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347, 1, 347,
+		1, 347, 1, 347, 1, 348, 1, 348, 1, 348, 1, 348, 1, 348, 1, 348, 1, 349,
+		1, 349, 1, 349, 1, 349, 1, 349, 1, 349, 1, 349, 1, 349, 1, 349, 1, 349,
+		1, 350, 1, 350, 1, 350, 1, 350, 1, 350, 1, 350, 1, 350, 1, 350, 1, 350,
+		1, 350, 1, 350, 1, 351, 1, 351, 1, 351, 1, 351, 1, 351, 1, 351, 1, 352,
+		1, 352, 1, 352, 1, 352, 1, 352, 1, 352, 1, 352, 1, 353, 1, 353, 1, 353,
+		1, 353, 1, 353, 1, 353, 1, 353, 1, 353, 1, 354, 1, 354, 1, 354, 1, 354,
+		1, 354, 1, 354, 1, 354, 1, 355, 1, 355, 1, 355, 1, 355, 1, 355, 1, 355,
+		1, 355, 1, 356, 1, 356, 1, 356, 1, 356, 1, 356, 1, 356, 1, 357, 1, 357,
+		1, 357, 1, 357, 1, 357, 1, 357, 1, 358, 1, 358, 1, 358, 1, 358, 1, 358,
+		1, 358, 1, 358, 1, 359, 1, 359, 1, 359, 1, 359, 1, 359, 1, 359, 1, 359,
+		1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360, 1, 360,
+		1, 360, 1, 360, 1, 361, 1, 361, 1, 361, 1, 361, 1, 361, 1, 361, 1, 361,
+		1, 362, 1, 362, 1, 362, 1, 362, 1, 362, 1, 363, 1, 363, 1, 363, 1, 363,
+		1, 363, 1, 363, 1, 363, 1, 363, 1, 363, 1, 364, 1, 364, 1, 364, 1, 364,
+		1, 364, 1, 364, 1, 364, 1, 364, 1, 364, 1, 364, 1, 365, 1, 365, 1, 365,
+		1, 365, 1, 365, 1, 366, 1, 366, 1, 366, 1, 366, 1, 366, 1, 366, 1, 366,
+		1, 366, 1, 366, 1, 366, 1, 366, 1, 366, 1, 367, 1, 367, 1, 367, 1, 367,
+		1, 367, 1, 367, 1, 367, 1, 367, 1, 368, 1, 368, 1, 368, 1, 368, 1, 368,
+		1, 368, 1, 368, 1, 368, 1, 368, 1, 369, 1, 369, 1, 369, 1, 369, 1, 369,
+		1, 369, 1, 369, 1, 369, 1, 370, 1, 370, 1, 370, 1, 370, 1, 370, 1, 371,
+		1, 371, 1, 371, 1, 371, 1, 371, 1, 371, 1, 372, 1, 372, 1, 372, 1, 372,
+		1, 372, 1, 372, 1, 372, 1, 372, 1, 372, 1, 372, 1, 373, 1, 373, 1, 373,
+		1, 373, 1, 373, 1, 373, 1, 373, 1, 373, 1, 373, 1, 373, 1, 373, 1, 373,
+		1, 374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 374,
+		1, 374, 1, 374, 1, 374, 1, 374, 1, 374, 1, 375, 1, 375, 1, 375, 1, 375,
+		1, 375, 1, 375, 1, 375, 1, 375, 1, 375, 1, 375, 1, 375, 1, 375, 1, 376,
+		1, 376, 1, 376, 1, 376, 1, 376, 1, 376, 1, 376, 1, 376, 1, 377, 1, 377,
+		1, 377, 1, 377, 1, 377, 1, 377, 1, 377, 1, 377, 1, 377, 1, 378, 1, 378,
+		1, 378, 1, 378, 1, 378, 1, 378, 1, 378, 1, 378, 1, 378, 1, 379, 1, 379,
+		1, 379, 1, 379, 1, 379, 1, 379, 1, 380, 1, 380, 1, 380, 1, 380, 1, 380,
+		1, 380, 1, 380, 1, 381, 1, 381, 1, 381, 1, 381, 1, 381, 1, 381, 1, 381,
+		1, 382, 1, 382, 1, 382, 1, 382, 1, 382, 1, 382, 1, 383, 1, 383, 1, 383,
+		1, 383, 1, 383, 1, 383, 1, 383, 1, 383, 1, 383, 1, 384, 1, 384, 1, 384,
+		1, 384, 1, 384, 1, 384, 1, 384, 1, 384, 1, 384, 1, 384, 1, 385, 1, 385,
+		1, 385, 1, 385, 1, 385, 1, 385, 1, 385, 1, 385, 1, 386, 1, 386, 1, 386,
+		1, 386, 1, 386, 1, 386, 1, 386, 1, 386, 1, 387, 1, 387, 1, 387, 1, 387,
+		1, 387, 1, 388, 1, 388, 1, 388, 1, 388, 1, 388, 1, 388, 1, 388, 1, 388,
+		1, 388, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389, 1, 389,
+		1, 389, 1, 389, 1, 389, 1, 390, 1, 390, 1, 390, 1, 390, 1, 390, 1, 390,
+		1, 390, 1, 390, 1, 391, 1, 391, 1, 391, 1, 391, 1, 391, 1, 392, 1, 392,
+		1, 392, 1, 392, 1, 392, 1, 392, 1, 392, 1, 392, 1, 393, 1, 393, 1, 393,
+		1, 393, 1, 393, 1, 393, 1, 394, 1, 394, 1, 394, 1, 394, 1, 395, 1, 395,
+		1, 395, 1, 395, 1, 395, 1, 396, 1, 396, 1, 396, 1, 396, 1, 397, 1, 397,
+		1, 397, 1, 397, 1, 397, 1, 398, 1, 398, 1, 398, 1, 398, 1, 398, 1, 398,
+		1, 398, 1, 399, 1, 399, 1, 399, 1, 399, 1, 399, 1, 399, 1, 399, 1, 399,
+		1, 400, 1, 400, 1, 400, 1, 400, 1, 400, 1, 400, 1, 400, 1, 401, 1, 401,
+		1, 401, 1, 401, 1, 402, 1, 402, 1, 402, 1, 402, 1, 402, 1, 402, 1, 402,
+		1, 402, 1, 403, 1, 403, 1, 403, 1, 403, 1, 403, 1, 404, 1, 404, 1, 404,
+		1, 404, 1, 404, 1, 404, 1, 404, 1, 404, 1, 404, 1, 404, 1, 405, 1, 405,
+		1, 405, 1, 405, 1, 405, 1, 405, 1, 405, 1, 405, 1, 405, 1, 406, 1, 406,
+		1, 406, 1, 406, 1, 407, 1, 407, 1, 407, 1, 407, 1, 407, 1, 407, 1, 407,
+		1, 407, 1, 408, 1, 408, 1, 408, 1, 408, 1, 408, 1, 408, 1, 408, 1, 409,
+		1, 409, 1, 409, 1, 409, 1, 409, 1, 409, 1, 409, 1, 409, 1, 410, 1, 410,
+		1, 410, 1, 410, 1, 410, 1, 410, 1, 411, 1, 411, 1, 411, 1, 411, 1, 411,
+		1, 411, 1, 411, 1, 411, 1, 411, 1, 412, 1, 412, 1, 412, 1, 412, 1, 412,
+		1, 412, 1, 413, 1, 413, 1, 413, 1, 413, 1, 414, 1, 414, 1, 414, 1, 414,
+		1, 414, 1, 414, 1, 414, 1, 414, 1, 415, 1, 415, 1, 415, 1, 415, 1, 415,
+		1, 415, 1, 415, 1, 415, 1, 415, 1, 416, 1, 416, 1, 416, 1, 416, 1, 416,
+		1, 416, 1, 416, 1, 416, 1, 416, 1, 416, 1, 416, 1, 417, 1, 417, 1, 417,
+		1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417, 1, 417,
+		1, 417, 1, 417, 1, 418, 1, 418, 1, 418, 1, 418, 1, 418, 1, 418, 1, 418,
+		1, 418, 1, 418, 1, 418, 1, 418, 1, 418, 1, 419, 1, 419, 1, 419, 1, 419,
+		1, 419, 1, 419, 1, 419, 1, 419, 1, 419, 1, 419, 1, 419, 1, 419, 1, 420,
+		1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1, 420,
+		1, 420, 1, 420, 1, 420, 1, 420, 1, 420, 1, 421, 1, 421, 1, 421, 1, 421,
+		1, 421, 1, 421, 1, 421, 1, 421, 1, 421, 1, 421, 1, 421, 1, 422, 1, 422,
+		1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422, 1, 422,
+		1, 422, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423,
+		1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 423, 1, 424, 1, 424,
+		1, 424, 1, 424, 1, 424, 1, 424, 1, 424, 1, 424, 1, 424, 1, 424, 1, 424,
+		1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425, 1, 425,
+		1, 425, 1, 425, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 426, 1, 427,
+		1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 427, 1, 428,
+		1, 428, 1, 428, 1, 428, 1, 428, 1, 428, 1, 429, 1, 429, 1, 429, 1, 429,
+		1, 429, 1, 430, 1, 430, 1, 430, 1, 430, 1, 430, 1, 430, 1, 430, 1, 431,
+		1, 431, 1, 431, 1, 431, 1, 431, 1, 431, 1, 431, 1, 431, 1, 432, 1, 432,
+		1, 432, 1, 432, 1, 432, 1, 432, 1, 432, 1, 432, 1, 433, 1, 433, 1, 433,
+		1, 433, 1, 433, 1, 433, 1, 433, 1, 433, 1, 433, 1, 433, 1, 434, 1, 434,
+		1, 434, 1, 434, 1, 434, 1, 434, 1, 434, 1, 434, 1, 434, 1, 435, 1, 435,
+		1, 435, 1, 435, 1, 435, 1, 435, 1, 435, 1, 435, 1, 435, 1, 435, 1, 436,
+		1, 436, 1, 436, 1, 436, 1, 436, 1, 437, 1, 437, 1, 437, 1, 437, 1, 438,
+		1, 438, 1, 438, 1, 438, 1, 438, 1, 438, 1, 439, 1, 439, 1, 439, 1, 439,
+		1, 439, 1, 439, 1, 439, 1, 439, 1, 439, 1, 440, 1, 440, 1, 440, 1, 440,
+		1, 440, 1, 440, 1, 440, 1, 440, 1, 440, 1, 440, 1, 441, 1, 441, 1, 441,
+		1, 441, 1, 441, 1, 442, 1, 442, 1, 442, 1, 442, 1, 442, 1, 442, 1, 442,
+		1, 442, 1, 442, 1, 442, 1, 443, 1, 443, 1, 443, 1, 443, 1, 443, 1, 443,
+		1, 444, 1, 444, 1, 444, 1, 444, 1, 444, 1, 445, 1, 445, 1, 445, 1, 445,
+		1, 445, 1, 445, 1, 445, 1, 446, 1, 446, 1, 446, 1, 446, 1, 446, 1, 446,
+		1, 446, 1, 446, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447,
+		1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 447, 1, 448, 1, 448,
+		1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448, 1, 448,
+		1, 449, 1, 449, 1, 449, 1, 449, 1, 449, 1, 449, 1, 449, 1, 450, 1, 450,
+		1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450,
+		1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 450, 1, 451,
+		1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451,
+		1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451,
+		1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451, 1, 451,
+		1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452,
+		1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452,
+		1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452, 1, 452,
+		1, 453, 1, 453, 1, 453, 1, 453, 1, 453, 1, 453, 1, 454, 1, 454, 1, 454,
+		1, 454, 1, 454, 1, 454, 1, 454, 1, 454, 1, 454, 1, 454, 1, 454, 1, 454,
+		1, 454, 1, 455, 1, 455, 1, 455, 1, 455, 1, 455, 1, 455, 1, 455, 1, 455,
+		1, 455, 1, 455, 1, 456, 1, 456, 1, 456, 1, 456, 1, 456, 1, 456, 1, 456,
+		1, 456, 1, 456, 1, 456, 1, 456, 1, 457, 1, 457, 1, 457, 1, 457, 1, 457,
+		1, 457, 1, 457, 1, 457, 1, 457, 1, 457, 1, 458, 1, 458, 1, 458, 1, 458,
+		1, 458, 1, 458, 1, 458, 1, 458, 1, 458, 1, 458, 1, 459, 1, 459, 1, 459,
+		1, 459, 1, 459, 1, 459, 1, 459, 1, 459, 1, 459, 1, 460, 1, 460, 1, 460,
+		1, 460, 1, 460, 1, 460, 1, 461, 1, 461, 1, 461, 1, 461, 1, 461, 1, 461,
+		1, 461, 1, 461, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462,
+		1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 462, 1, 463, 1, 463, 1, 463,
+		1, 463, 1, 463, 1, 464, 1, 464, 1, 464, 1, 464, 1, 464, 1, 464, 1, 464,
+		1, 464, 1, 465, 1, 465, 1, 465, 1, 465, 1, 465, 1, 465, 1, 465, 1, 466,
+		1, 466, 1, 466, 1, 466, 1, 466, 1, 466, 1, 466, 1, 467, 1, 467, 1, 467,
+		1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1, 467, 1, 468,
+		1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468, 1, 468,
+		1, 469, 1, 469, 1, 469, 1, 469, 1, 469, 1, 469, 1, 469, 1, 470, 1, 470,
+		1, 470, 1, 470, 1, 470, 1, 470, 1, 470, 1, 471, 1, 471, 1, 471, 1, 471,
+		1, 471, 1, 471, 1, 471, 1, 471, 1, 472, 1, 472, 1, 472, 1, 472, 1, 472,
+		1, 472, 1, 472, 1, 472, 1, 473, 1, 473, 1, 473, 1, 473, 1, 473, 1, 473,
+		1, 473, 1, 473, 1, 473, 1, 473, 1, 474, 1, 474, 1, 474, 1, 474, 1, 474,
+		1, 474, 1, 474, 1, 475, 1, 475, 1, 475, 1, 475, 1, 475, 1, 475, 1, 475,
+		1, 476, 1, 476, 1, 476, 1, 476, 1, 476, 1, 476, 1, 476, 1, 477, 1, 477,
+		1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477, 1, 477,
+		1, 477, 1, 478, 1, 478, 1, 478, 1, 478, 1, 479, 1, 479, 1, 479, 1, 479,
+		1, 480, 1, 480, 1, 480, 1, 480, 1, 480, 1, 480, 1, 481, 1, 481, 1, 481,
+		1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481, 1, 481,
+		1, 481, 1, 482, 1, 482, 1, 482, 1, 482, 1, 482, 1, 482, 1, 482, 1, 482,
+		1, 482, 1, 482, 1, 482, 1, 482, 1, 483, 1, 483, 1, 483, 1, 483, 1, 484,
+		1, 484, 1, 484, 1, 484, 1, 485, 1, 485, 1, 485, 1, 485, 1, 485, 1, 485,
+		1, 485, 1, 485, 1, 485, 1, 486, 1, 486, 1, 486, 1, 486, 1, 486, 1, 486,
+		1, 486, 1, 486, 1, 487, 1, 487, 1, 487, 1, 487, 1, 487, 1, 487, 1, 487,
+		1, 487, 1, 487, 1, 487, 1, 487, 1, 488, 1, 488, 1, 488, 1, 488, 1, 488,
+		1, 488, 1, 489, 1, 489, 1, 489, 1, 489, 1, 489, 1, 489, 1, 489, 1, 489,
+		1, 490, 1, 490, 1, 490, 1, 490, 1, 490, 1, 490, 1, 490, 1, 490, 1, 490,
+		1, 491, 1, 491, 1, 491, 1, 491, 1, 492, 1, 492, 1, 492, 1, 492, 1, 492,
+		1, 492, 1, 492, 1, 492, 1, 493, 1, 493, 1, 493, 1, 493, 1, 493, 1, 493,
+		1, 493, 1, 493, 1, 493, 1, 493, 1, 493, 1, 494, 1, 494, 1, 494, 1, 494,
+		1, 494, 1, 494, 1, 494, 1, 494, 1, 494, 1, 495, 1, 495, 1, 495, 1, 495,
+		1, 495, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 496, 1, 497,
+		1, 497, 1, 497, 1, 497, 1, 497, 1, 498, 1, 498, 1, 498, 1, 498, 1, 498,
+		1, 498, 1, 498, 1, 499, 1, 499, 1, 499, 1, 499, 1, 499, 1, 500, 1, 500,
+		1, 500, 1, 500, 1, 500, 1, 500, 1, 500, 1, 500, 1, 500, 1, 501, 1, 501,
+		1, 501, 1, 501, 1, 501, 1, 502, 1, 502, 1, 502, 1, 502, 1, 502, 1, 502,
+		1, 502, 1, 502, 1, 502, 1, 502, 1, 502, 1, 502, 1, 503, 1, 503, 1, 503,
+		1, 503, 1, 503, 1, 503, 1, 503, 1, 503, 1, 503, 1, 503, 1, 503, 1, 504,
+		1, 504, 1, 504, 1, 504, 1, 504, 1, 504, 1, 504, 1, 504, 1, 504, 1, 505,
+		1, 505, 1, 505, 1, 505, 1, 505, 1, 505, 1, 505, 1, 505, 1, 506, 1, 506,
+		1, 506, 1, 506, 1, 506, 1, 506, 1, 506, 1, 506, 1, 506, 1, 506, 1, 506,
+		1, 506, 1, 506, 1, 506, 1, 507, 1, 507, 1, 507, 1, 507, 1, 507, 1, 507,
+		1, 507, 1, 507, 1, 508, 1, 508, 1, 508, 1, 508, 1, 508, 1, 508, 1, 508,
+		1, 508, 1, 508, 1, 508, 1, 508, 1, 509, 1, 509, 1, 509, 1, 509, 1, 509,
+		1, 509, 1, 509, 1, 510, 1, 510, 1, 510, 1, 510, 1, 510, 1, 510, 1, 510,
+		1, 511, 1, 511, 1, 511, 1, 511, 1, 511, 1, 511, 1, 511, 1, 512, 1, 512,
+		1, 512, 1, 512, 1, 512, 1, 512, 1, 512, 1, 513, 1, 513, 1, 513, 1, 513,
+		1, 514, 1, 514, 1, 514, 1, 514, 1, 515, 1, 515, 1, 515, 1, 515, 1, 515,
+		1, 516, 1, 516, 1, 516, 1, 516, 1, 516, 1, 517, 1, 517, 1, 517, 1, 517,
+		1, 517, 1, 517, 1, 517, 1, 517, 1, 518, 1, 518, 1, 518, 1, 518, 1, 518,
+		1, 518, 1, 519, 1, 519, 1, 519, 1, 519, 1, 519, 1, 519, 1, 519, 1, 519,
+		1, 519, 1, 519, 1, 520, 1, 520, 1, 520, 1, 520, 1, 520, 1, 521, 1, 521,
+		1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521,
+		1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521, 1, 521,
+		1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522,
+		1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522, 1, 522,
+		1, 523, 1, 523, 1, 523, 1, 523, 1, 523, 1, 523, 1, 524, 1, 524, 1, 524,
+		1, 524, 1, 524, 1, 524, 1, 524, 1, 524, 1, 524, 1, 524, 1, 524, 1, 524,
+		1, 524, 1, 525, 1, 525, 1, 525, 1, 525, 1, 525, 1, 525, 1, 525, 1, 525,
+		1, 525, 1, 525, 1, 525, 1, 526, 1, 526, 1, 526, 1, 526, 1, 526, 1, 526,
+		1, 527, 1, 527, 1, 527, 1, 527, 1, 527, 1, 527, 1, 527, 1, 527, 1, 527,
+		1, 528, 1, 528, 1, 528, 1, 528, 1, 528, 1, 528, 1, 528, 1, 528, 1, 529,
+		1, 529, 1, 529, 1, 529, 1, 530, 1, 530, 1, 530, 1, 530, 1, 530, 1, 530,
+		1, 530, 1, 530, 1, 530, 1, 530, 1, 530, 1, 530, 1, 531, 1, 531, 1, 531,
+		1, 531, 1, 531, 1, 531, 1, 531, 1, 531, 1, 532, 1, 532, 1, 532, 1, 532,
+		1, 532, 1, 532, 1, 533, 1, 533, 1, 533, 1, 533, 1, 533, 1, 533, 1, 534,
+		1, 534, 1, 534, 1, 534, 1, 534, 1, 534, 1, 534, 1, 534, 1, 535, 1, 535,
+		1, 535, 1, 535, 1, 535, 1, 535, 1, 535, 1, 535, 1, 536, 1, 536, 1, 536,
+		1, 536, 1, 536, 1, 536, 1, 537, 1, 537, 1, 537, 1, 537, 1, 537, 1, 538,
+		1, 538, 1, 538, 1, 538, 1, 538, 1, 538, 1, 538, 1, 539, 1, 539, 1, 539,
+		1, 539, 1, 539, 1, 539, 1, 540, 1, 540, 1, 540, 1, 540, 1, 540, 1, 540,
+		1, 541, 1, 541, 1, 541, 1, 541, 1, 541, 1, 541, 1, 541, 1, 541, 1, 541,
+		1, 542, 1, 542, 1, 542, 1, 542, 1, 542, 1, 542, 1, 543, 1, 543, 1, 543,
+		1, 543, 1, 544, 1, 544, 1, 544, 1, 544, 1, 544, 1, 545, 1, 545, 1, 545,
+		1, 545, 1, 545, 1, 545, 1, 545, 1, 546, 1, 546, 1, 546, 1, 546, 1, 546,
+		1, 546, 1, 546, 1, 546, 1, 547, 1, 547, 1, 547, 1, 547, 1, 547, 1, 547,
+		1, 547, 1, 547, 1, 547, 1, 547, 1, 548, 1, 548, 1, 548, 1, 548, 1, 548,
+		1, 548, 1, 548, 1, 549, 1, 549, 1, 549, 1, 549, 1, 549, 1, 550, 1, 550,
+		1, 550, 1, 550, 1, 550, 1, 551, 1, 551, 1, 551, 1, 551, 1, 552, 1, 552,
+		1, 552, 1, 552, 1, 552, 1, 553, 1, 553, 1, 553, 1, 553, 1, 553, 1, 554,
+		1, 554, 1, 554, 1, 554, 1, 554, 1, 554, 1, 554, 1, 554, 1, 555, 1, 555,
+		1, 555, 1, 555, 1, 555, 1, 555, 1, 555, 1, 555, 1, 556, 1, 556, 1, 556,
+		1, 556, 1, 557, 1, 557, 1, 557, 1, 557, 1, 558, 1, 558, 1, 558, 1, 558,
+		1, 558, 1, 558, 1, 558, 1, 558, 1, 558, 1, 558, 1, 559, 1, 559, 1, 559,
+		1, 559, 1, 559, 1, 559, 1, 560, 1, 560, 1, 560, 1, 560, 1, 561, 1, 561,
+		1, 561, 1, 561, 1, 562, 1, 562, 1, 562, 1, 563, 1, 563, 1, 563, 1, 563,
+		1, 563, 1, 563, 1, 564, 1, 564, 1, 564, 1, 564, 1, 564, 1, 564, 1, 564,
+		1, 564, 1, 564, 1, 564, 1, 565, 1, 565, 1, 565, 1, 565, 1, 566, 1, 566,
+		1, 566, 1, 567, 1, 567, 1, 567, 1, 567, 1, 567, 1, 567, 1, 568, 1, 568,
+		1, 568, 1, 568, 1, 568, 1, 568, 1, 568, 1, 568, 1, 569, 1, 569, 1, 569,
+		1, 569, 1, 569, 1, 569, 1, 570, 1, 570, 1, 570, 1, 570, 1, 570, 1, 570,
+		1, 571, 1, 571, 1, 571, 1, 571, 1, 571, 1, 572, 1, 572, 1, 572, 1, 572,
+		1, 572, 1, 573, 1, 573, 1, 573, 1, 573, 1, 573, 1, 573, 1, 573, 1, 573,
+		1, 573, 1, 573, 1, 573, 1, 574, 1, 574, 1, 574, 1, 574, 1, 574, 1, 574,
+		1, 575, 1, 575, 1, 575, 1, 575, 1, 575, 1, 575, 1, 575, 1, 575, 1, 575,
+		1, 575, 1, 575, 1, 575, 1, 575, 1, 576, 1, 576, 1, 576, 1, 576, 1, 576,
+		1, 576, 1, 576, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577, 1, 577,
+		1, 577, 1, 578, 1, 578, 1, 578, 1, 578, 1, 578, 1, 579, 1, 579, 1, 579,
+		1, 579, 1, 579, 1, 579, 1, 580, 1, 580, 1, 580, 1, 580, 1, 580, 1, 581,
+		1, 581, 1, 581, 1, 581, 1, 581, 1, 581, 1, 582, 1, 582, 1, 582, 1, 582,
+		1, 582, 1, 583, 1, 583, 1, 583, 1, 583, 1, 583, 1, 583, 1, 584, 1, 584,
+		1, 584, 1, 584, 1, 584, 1, 584, 1, 585, 1, 585, 1, 585, 1, 585, 1, 585,
+		1, 585, 1, 585, 1, 586, 1, 586, 1, 586, 1, 586, 1, 587, 1, 587, 1, 587,
+		1, 587, 1, 587, 1, 588, 1, 588, 1, 588, 1, 588, 1, 589, 1, 589, 1, 589,
+		1, 589, 1, 589, 1, 590, 1, 590, 1, 590, 1, 590, 1, 591, 1, 591, 1, 591,
+		1, 591, 1, 591, 1, 592, 1, 592, 1, 592, 1, 592, 1, 593, 1, 593, 1, 593,
+		1, 593, 1, 593, 1, 594, 1, 594, 1, 594, 1, 594, 1, 594, 1, 595, 1, 595,
+		1, 595, 1, 595, 1, 595, 1, 596, 1, 596, 1, 596, 1, 596, 1, 596, 1, 597,
+		1, 597, 1, 597, 1, 597, 1, 597, 1, 597, 1, 598, 1, 598, 1, 598, 1, 598,
+		1, 598, 1, 598, 1, 599, 1, 599, 1, 599, 1, 599, 1, 599, 1, 599, 1, 600,
+		1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600, 1, 600,
+		1, 600, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601, 1, 601,
+		1, 601, 1, 601, 1, 601, 1, 601, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602,
+		1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602, 1, 602,
+		1, 602, 1, 602, 1, 602, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603, 1, 603,
+		1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604, 1, 604,
+		1, 604, 1, 604, 1, 604, 1, 604, 1, 605, 1, 605, 1, 605, 1, 605, 1, 605,
+		1, 605, 1, 606, 1, 606, 1, 606, 1, 606, 1, 606, 1, 606, 1, 607, 1, 607,
+		1, 607, 1, 607, 1, 607, 1, 607, 1, 608, 1, 608, 1, 608, 1, 608, 1, 609,
+		1, 609, 1, 609, 1, 609, 1, 609, 1, 609, 1, 609, 1, 610, 1, 610, 1, 610,
+		1, 610, 1, 610, 1, 610, 1, 610, 1, 610, 1, 610, 1, 610, 1, 611, 1, 611,
+		1, 611, 1, 611, 1, 611, 1, 611, 1, 611, 1, 612, 1, 612, 1, 612, 1, 612,
+		1, 612, 1, 612, 1, 612, 1, 612, 1, 613, 1, 613, 1, 613, 1, 613, 1, 613,
+		1, 613, 1, 613, 1, 614, 1, 614, 1, 614, 1, 614, 1, 614, 1, 615, 1, 615,
+		1, 615, 1, 615, 1, 615, 1, 615, 1, 616, 1, 616, 1, 616, 1, 616, 1, 617,
+		1, 617, 1, 617, 1, 617, 1, 617, 1, 617, 1, 617, 1, 617, 1, 617, 1, 617,
+		1, 617, 1, 617, 1, 618, 1, 618, 1, 618, 1, 618, 1, 618, 1, 618, 1, 618,
+		1, 618, 1, 618, 1, 618, 1, 618, 1, 618, 1, 618, 1, 618, 1, 618, 1, 618,
+		1, 618, 1, 618, 1, 618, 1, 619, 1, 619, 1, 619, 1, 619, 1, 619, 1, 619,
+		1, 619, 1, 619, 1, 619, 1, 619, 1, 619, 1, 619, 1, 620, 1, 620, 1, 620,
+		1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620, 1, 620,
+		1, 620, 1, 620, 1, 621, 1, 621, 1, 621, 1, 621, 1, 621, 1, 621, 1, 621,
+		1, 621, 1, 621, 1, 621, 1, 621, 1, 621, 1, 621, 1, 621, 1, 621, 1, 622,
+		1, 622, 1, 622, 1, 622, 1, 622, 1, 622, 1, 622, 1, 622, 1, 622, 1, 622,
+		1, 622, 1, 622, 1, 622, 1, 623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 623,
+		1, 623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 623, 1, 624, 1, 624,
+		1, 624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 624, 1, 624,
+		1, 624, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 625,
+		1, 625, 1, 625, 1, 625, 1, 625, 1, 625, 1, 626, 1, 626, 1, 626, 1, 626,
+		1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626, 1, 626,
+		1, 626, 1, 626, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627,
+		1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 627, 1, 628,
+		1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628,
+		1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628, 1, 628,
+		1, 628, 1, 628, 1, 628, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629,
+		1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629,
+		1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 629, 1, 630, 1, 630,
+		1, 630, 1, 630, 1, 630, 1, 630, 1, 630, 1, 630, 1, 630, 1, 630, 1, 630,
+		1, 630, 1, 630, 1, 630, 1, 631, 1, 631, 1, 631, 1, 631, 1, 631, 1, 631,
+		1, 631, 1, 632, 1, 632, 1, 632, 1, 632, 1, 632, 1, 633, 1, 633, 1, 633,
+		1, 633, 1, 633, 1, 633, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 634,
+		1, 634, 1, 634, 1, 634, 1, 634, 1, 634, 1, 635, 1, 635, 1, 635, 1, 635,
+		1, 635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 635, 1, 636,
+		1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636,
+		1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 636, 1, 637, 1, 637, 1, 637,
+		1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637, 1, 637,
+		1, 637, 1, 637, 1, 637, 1, 637, 1, 638, 1, 638, 1, 638, 1, 638, 1, 638,
+		1, 638, 1, 638, 1, 639, 1, 639, 1, 639, 1, 639, 1, 639, 1, 639, 1, 639,
+		1, 640, 1, 640, 1, 640, 1, 640, 1, 640, 1, 640, 1, 640, 1, 640, 1, 640,
+		1, 641, 1, 641, 1, 641, 1, 641, 1, 641, 1, 641, 1, 641, 1, 642, 1, 642,
+		1, 642, 1, 642, 1, 642, 1, 642, 1, 642, 1, 642, 1, 642, 1, 642, 1, 643,
+		1, 643, 1, 643, 1, 643, 1, 643, 1, 643, 1, 643, 1, 644, 1, 644, 1, 644,
+		1, 644, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645,
+		1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 645, 1, 646,
+		1, 646, 1, 646, 1, 646, 1, 646, 1, 646, 1, 646, 1, 646, 1, 646, 1, 647,
+		1, 647, 1, 647, 1, 647, 1, 647, 1, 647, 1, 647, 1, 647, 1, 647, 1, 647,
+		1, 648, 1, 648, 1, 648, 1, 648, 1, 648, 1, 648, 1, 648, 1, 648, 1, 648,
+		1, 648, 1, 648, 1, 649, 1, 649, 1, 649, 1, 649, 1, 649, 1, 649, 1, 649,
+		1, 649, 1, 649, 1, 650, 1, 650, 1, 650, 1, 650, 1, 650, 1, 650, 1, 650,
+		1, 650, 1, 650, 1, 650, 1, 650, 1, 650, 1, 650, 1, 651, 1, 651, 1, 651,
+		1, 651, 1, 651, 1, 651, 1, 651, 1, 651, 1, 651, 1, 651, 1, 651, 1, 651,
+		1, 651, 1, 651, 1, 652, 1, 652, 1, 652, 1, 652, 1, 652, 1, 652, 1, 652,
+		1, 652, 1, 652, 1, 652, 1, 652, 1, 652, 1, 652, 1, 652, 1, 652, 1, 652,
+		1, 652, 1, 653, 1, 653, 1, 653, 1, 653, 1, 653, 1, 653, 1, 653, 1, 653,
+		1, 653, 1, 653, 1, 654, 1, 654, 1, 654, 1, 654, 1, 654, 1, 654, 1, 654,
+		1, 654, 1, 654, 1, 654, 1, 654, 1, 654, 1, 654, 1, 654, 1, 655, 1, 655,
+		1, 655, 1, 655, 1, 655, 1, 655, 1, 655, 1, 655, 1, 655, 1, 655, 1, 656,
+		1, 656, 1, 656, 1, 656, 1, 656, 1, 656, 1, 656, 1, 656, 1, 656, 1, 656,
+		1, 656, 1, 656, 1, 656, 1, 656, 1, 656, 1, 657, 1, 657, 1, 657, 1, 657,
+		1, 657, 1, 657, 1, 657, 1, 657, 1, 657, 1, 657, 1, 657, 1, 657, 1, 657,
+		1, 657, 1, 657, 1, 657, 1, 657, 1, 658, 1, 658, 1, 658, 1, 658, 1, 659,
+		1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659,
+		1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659, 1, 659,
+		1, 659, 1, 660, 1, 660, 1, 660, 1, 660, 1, 660, 1, 660, 1, 660, 1, 660,
+		1, 660, 1, 660, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661,
+		1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661,
+		1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 661, 1, 662, 1, 662, 1, 662,
+		1, 662, 1, 662, 1, 662, 1, 662, 1, 662, 1, 662, 1, 662, 1, 662, 1, 662,
+		1, 662, 1, 663, 1, 663, 1, 663, 1, 663, 1, 663, 1, 663, 1, 663, 1, 663,
+		1, 664, 1, 664, 1, 664, 1, 664, 1, 664, 1, 664, 1, 664, 1, 664, 1, 665,
+		1, 665, 1, 665, 1, 665, 1, 665, 1, 665, 1, 665, 1, 665, 1, 665, 1, 665,
+		1, 666, 1, 666, 1, 666, 1, 666, 1, 666, 1, 666, 1, 666, 1, 667, 1, 667,
+		1, 667, 1, 667, 1, 667, 1, 667, 1, 667, 1, 667, 1, 668, 1, 668, 1, 668,
+		1, 668, 1, 668, 1, 668, 1, 668, 1, 668, 1, 669, 1, 669, 1, 669, 1, 669,
+		1, 669, 1, 669, 1, 669, 1, 669, 1, 669, 1, 669, 1, 669, 1, 669, 1, 669,
+		1, 669, 1, 669, 1, 670, 1, 670, 1, 670, 1, 670, 1, 670, 1, 670, 1, 670,
+		1, 670, 1, 670, 1, 670, 1, 670, 1, 670, 1, 670, 1, 670, 1, 670, 1, 670,
+		1, 670, 1, 671, 1, 671, 5, 671, 6692, 8, 671, 10, 671, 12, 671, 6695, 9,
+		671, 1, 672, 1, 672, 1, 672, 1, 672, 1, 672, 1, 672, 3, 672, 6703, 8, 672,
+		1, 673, 1, 673, 3, 673, 6707, 8, 673, 1, 674, 1, 674, 3, 674, 6711, 8,
+		674, 1, 675, 1, 675, 1, 675, 1, 676, 1, 676, 1, 676, 1, 676, 5, 676, 6720,
+		8, 676, 10, 676, 12, 676, 6723, 9, 676, 1, 677, 1, 677, 1, 677, 1, 678,
+		1, 678, 1, 678, 1, 678, 5, 678, 6732, 8, 678, 10, 678, 12, 678, 6735, 9,
+		678, 1, 679, 1, 679, 1, 679, 1, 679, 1, 680, 1, 680, 1, 680, 1, 680, 1,
+		681, 1, 681, 1, 681, 1, 681, 1, 682, 1, 682, 1, 682, 1, 682, 1, 683, 1,
+		683, 1, 683, 1, 684, 1, 684, 1, 684, 1, 684, 5, 684, 6760, 8, 684, 10,
+		684, 12, 684, 6763, 9, 684, 1, 685, 1, 685, 1, 685, 1, 685, 1, 685, 1,
+		685, 1, 686, 1, 686, 1, 686, 1, 687, 1, 687, 1, 687, 1, 687, 1, 688, 1,
+		688, 3, 688, 6780, 8, 688, 1, 688, 1, 688, 1, 688, 1, 688, 1, 688, 1, 689,
+		1, 689, 5, 689, 6789, 8, 689, 10, 689, 12, 689, 6792, 9, 689, 1, 690, 1,
+		690, 1, 690, 1, 691, 1, 691, 1, 691, 5, 691, 6800, 8, 691, 10, 691, 12,
+		691, 6803, 9, 691, 1, 692, 1, 692, 1, 692, 1, 693, 1, 693, 1, 693, 1, 694,
+		1, 694, 1, 694, 1, 695, 1, 695, 1, 695, 5, 695, 6817, 8, 695, 10, 695,
+		12, 695, 6820, 9, 695, 1, 696, 1, 696, 1, 696, 1, 697, 1, 697, 1, 697,
+		1, 698, 1, 698, 1, 699, 1, 699, 1, 699, 1, 699, 1, 699, 1, 699, 1, 700,
+		1, 700, 1, 700, 3, 700, 6839, 8, 700, 1, 700, 1, 700, 3, 700, 6843, 8,
+		700, 1, 700, 3, 700, 6846, 8, 700, 1, 700, 1, 700, 1, 700, 1, 700, 3, 700,
+		6852, 8, 700, 1, 700, 3, 700, 6855, 8, 700, 1, 700, 1, 700, 1, 700, 3,
+		700, 6860, 8, 700, 1, 700, 1, 700, 3, 700, 6864, 8, 700, 1, 701, 4, 701,
+		6867, 8, 701, 11, 701, 12, 701, 6868, 1, 702, 1, 702, 1, 702, 5, 702, 6874,
+		8, 702, 10, 702, 12, 702, 6877, 9, 702, 1, 703, 1, 703, 1, 703, 1, 703,
+		1, 703, 1, 703, 1, 703, 1, 703, 5, 703, 6887, 8, 703, 10, 703, 12, 703,
+		6890, 9, 703, 1, 703, 1, 703, 1, 704, 1, 704, 1, 704, 1, 704, 1, 705, 1,
+		705, 3, 705, 6900, 8, 705, 1, 705, 3, 705, 6903, 8, 705, 1, 705, 1, 705,
+		1, 706, 1, 706, 1, 706, 1, 706, 5, 706, 6911, 8, 706, 10, 706, 12, 706,
+		6914, 9, 706, 1, 706, 1, 706, 1, 707, 1, 707, 1, 707, 1, 707, 5, 707, 6922,
+		8, 707, 10, 707, 12, 707, 6925, 9, 707, 1, 707, 1, 707, 1, 707, 4, 707,
+		6930, 8, 707, 11, 707, 12, 707, 6931, 1, 707, 1, 707, 4, 707, 6936, 8,
+		707, 11, 707, 12, 707, 6937, 1, 707, 5, 707, 6941, 8, 707, 10, 707, 12,
+		707, 6944, 9, 707, 1, 707, 5, 707, 6947, 8, 707, 10, 707, 12, 707, 6950,
+		9, 707, 1, 707, 1, 707, 1, 707, 1, 707, 1, 707, 1, 708, 1, 708, 1, 708,
+		1, 708, 5, 708, 6961, 8, 708, 10, 708, 12, 708, 6964, 9, 708, 1, 708, 1,
+		708, 1, 708, 4, 708, 6969, 8, 708, 11, 708, 12, 708, 6970, 1, 708, 1, 708,
+		4, 708, 6975, 8, 708, 11, 708, 12, 708, 6976, 1, 708, 3, 708, 6980, 8,
+		708, 5, 708, 6982, 8, 708, 10, 708, 12, 708, 6985, 9, 708, 1, 708, 4, 708,
+		6988, 8, 708, 11, 708, 12, 708, 6989, 1, 708, 4, 708, 6993, 8, 708, 11,
+		708, 12, 708, 6994, 1, 708, 5, 708, 6998, 8, 708, 10, 708, 12, 708, 7001,
+		9, 708, 1, 708, 3, 708, 7004, 8, 708, 1, 708, 1, 708, 1, 709, 1, 709, 1,
+		709, 1, 709, 5, 709, 7012, 8, 709, 10, 709, 12, 709, 7015, 9, 709, 1, 709,
+		5, 709, 7018, 8, 709, 10, 709, 12, 709, 7021, 9, 709, 1, 709, 1, 709, 5,
+		709, 7025, 8, 709, 10, 709, 12, 709, 7028, 9, 709, 3, 709, 7030, 8, 709,
+		1, 710, 1, 710, 1, 710, 1, 711, 1, 711, 1, 712, 1, 712, 1, 712, 1, 712,
+		1, 712, 1, 713, 1, 713, 3, 713, 7044, 8, 713, 1, 713, 1, 713, 1, 714, 1,
+		714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1,
+		714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1, 714, 1,
+		714, 3, 714, 7068, 8, 714, 1, 714, 5, 714, 7071, 8, 714, 10, 714, 12, 714,
+		7074, 9, 714, 1, 715, 1, 715, 1, 715, 1, 715, 1, 715, 1, 716, 1, 716, 3,
+		716, 7083, 8, 716, 1, 716, 1, 716, 1, 717, 1, 717, 1, 717, 1, 717, 1, 717,
+		5, 717, 7092, 8, 717, 10, 717, 12, 717, 7095, 9, 717, 1, 718, 1, 718, 1,
+		718, 1, 718, 1, 718, 1, 719, 1, 719, 1, 719, 1, 719, 1, 719, 1, 719, 1,
+		720, 1, 720, 1, 720, 1, 720, 1, 720, 1, 721, 1, 721, 1, 721, 1, 721, 1,
+		721, 1, 722, 1, 722, 1, 722, 1, 722, 1, 722, 1, 723, 1, 723, 1, 723, 1,
+		723, 1, 723, 1, 724, 1, 724, 1, 724, 1, 724, 1, 724, 1, 725, 4, 725, 7134,
+		8, 725, 11, 725, 12, 725, 7135, 1, 725, 1, 725, 5, 725, 7140, 8, 725, 10,
+		725, 12, 725, 7143, 9, 725, 3, 725, 7145, 8, 725, 1, 726, 1, 726, 3, 726,
+		7149, 8, 726, 1, 726, 1, 726, 1, 726, 1, 726, 1, 726, 1, 726, 1, 726, 1,
+		727, 5, 727, 7159, 8, 727, 10, 727, 12, 727, 7162, 9, 727, 1, 727, 1, 727,
+		3, 727, 7166, 8, 727, 1, 727, 3, 727, 7169, 8, 727, 5, 727, 7171, 8, 727,
+		10, 727, 12, 727, 7174, 9, 727, 1, 727, 1, 727, 1, 727, 1, 727, 1, 727,
+		1, 727, 1, 7172, 0, 728, 6, 1, 8, 2, 10, 3, 12, 4, 14, 5, 16, 6, 18, 7,
+		20, 8, 22, 9, 24, 10, 26, 11, 28, 12, 30, 13, 32, 14, 34, 15, 36, 16, 38,
+		17, 40, 18, 42, 19, 44, 20, 46, 21, 48, 22, 50, 23, 52, 24, 54, 25, 56,
+		26, 58, 27, 60, 28, 62, 29, 64, 0, 66, 0, 68, 0, 70, 0, 72, 30, 74, 31,
+		76, 32, 78, 33, 80, 34, 82, 35, 84, 36, 86, 37, 88, 38, 90, 39, 92, 40,
+		94, 41, 96, 42, 98, 43, 100, 44, 102, 45, 104, 46, 106, 47, 108, 48, 110,
+		49, 112, 50, 114, 51, 116, 52, 118, 53, 120, 54, 122, 55, 124, 56, 126,
+		57, 128, 58, 130, 59, 132, 60, 134, 61, 136, 62, 138, 63, 140, 64, 142,
+		65, 144, 66, 146, 67, 148, 68, 150, 69, 152, 70, 154, 71, 156, 72, 158,
+		73, 160, 74, 162, 75, 164, 76, 166, 77, 168, 78, 170, 79, 172, 80, 174,
+		81, 176, 82, 178, 83, 180, 84, 182, 85, 184, 86, 186, 87, 188, 88, 190,
+		89, 192, 90, 194, 91, 196, 92, 198, 93, 200, 94, 202, 95, 204, 96, 206,
+		97, 208, 98, 210, 99, 212, 100, 214, 101, 216, 102, 218, 103, 220, 104,
+		222, 105, 224, 106, 226, 107, 228, 108, 230, 109, 232, 110, 234, 111, 236,
+		112, 238, 113, 240, 114, 242, 115, 244, 116, 246, 117, 248, 118, 250, 119,
+		252, 120, 254, 121, 256, 122, 258, 123, 260, 124, 262, 125, 264, 126, 266,
+		127, 268, 128, 270, 129, 272, 130, 274, 131, 276, 132, 278, 133, 280, 134,
+		282, 135, 284, 136, 286, 137, 288, 138, 290, 139, 292, 140, 294, 141, 296,
+		142, 298, 143, 300, 144, 302, 145, 304, 146, 306, 147, 308, 148, 310, 149,
+		312, 150, 314, 151, 316, 152, 318, 153, 320, 154, 322, 155, 324, 156, 326,
+		157, 328, 158, 330, 159, 332, 160, 334, 161, 336, 162, 338, 163, 340, 164,
+		342, 165, 344, 166, 346, 167, 348, 168, 350, 169, 352, 170, 354, 171, 356,
+		172, 358, 173, 360, 174, 362, 175, 364, 176, 366, 177, 368, 178, 370, 179,
+		372, 180, 374, 181, 376, 182, 378, 183, 380, 184, 382, 185, 384, 186, 386,
+		187, 388, 188, 390, 189, 392, 190, 394, 191, 396, 192, 398, 193, 400, 194,
+		402, 195, 404, 196, 406, 197, 408, 198, 410, 199, 412, 200, 414, 201, 416,
+		202, 418, 203, 420, 204, 422, 205, 424, 206, 426, 207, 428, 208, 430, 209,
+		432, 210, 434, 211, 436, 212, 438, 213, 440, 214, 442, 215, 444, 216, 446,
+		217, 448, 218, 450, 219, 452, 220, 454, 221, 456, 222, 458, 223, 460, 224,
+		462, 225, 464, 226, 466, 227, 468, 228, 470, 229, 472, 230, 474, 231, 476,
+		232, 478, 233, 480, 234, 482, 235, 484, 236, 486, 237, 488, 238, 490, 239,
+		492, 240, 494, 241, 496, 242, 498, 243, 500, 244, 502, 245, 504, 246, 506,
+		247, 508, 248, 510, 249, 512, 250, 514, 251, 516, 252, 518, 253, 520, 254,
+		522, 255, 524, 256, 526, 257, 528, 258, 530, 259, 532, 260, 534, 261, 536,
+		262, 538, 263, 540, 264, 542, 265, 544, 266, 546, 267, 548, 268, 550, 269,
+		552, 270, 554, 271, 556, 272, 558, 273, 560, 274, 562, 275, 564, 276, 566,
+		277, 568, 278, 570, 279, 572, 280, 574, 281, 576, 282, 578, 283, 580, 284,
+		582, 285, 584, 286, 586, 287, 588, 288, 590, 289, 592, 290, 594, 291, 596,
+		292, 598, 293, 600, 294, 602, 295, 604, 296, 606, 297, 608, 298, 610, 299,
+		612, 300, 614, 301, 616, 302, 618, 303, 620, 304, 622, 305, 624, 306, 626,
+		307, 628, 308, 630, 309, 632, 310, 634, 311, 636, 312, 638, 313, 640, 314,
+		642, 315, 644, 316, 646, 317, 648, 318, 650, 319, 652, 320, 654, 321, 656,
+		322, 658, 323, 660, 324, 662, 325, 664, 326, 666, 327, 668, 328, 670, 329,
+		672, 330, 674, 331, 676, 332, 678, 333, 680, 334, 682, 335, 684, 336, 686,
+		337, 688, 338, 690, 339, 692, 340, 694, 341, 696, 342, 698, 343, 700, 344,
+		702, 345, 704, 346, 706, 347, 708, 348, 710, 349, 712, 350, 714, 351, 716,
+		352, 718, 353, 720, 354, 722, 355, 724, 356, 726, 357, 728, 358, 730, 359,
+		732, 360, 734, 361, 736, 362, 738, 363, 740, 364, 742, 365, 744, 366, 746,
+		367, 748, 368, 750, 369, 752, 370, 754, 371, 756, 372, 758, 373, 760, 374,
+		762, 375, 764, 376, 766, 377, 768, 378, 770, 379, 772, 380, 774, 381, 776,
+		382, 778, 383, 780, 384, 782, 385, 784, 386, 786, 387, 788, 388, 790, 389,
+		792, 390, 794, 391, 796, 392, 798, 393, 800, 394, 802, 395, 804, 396, 806,
+		397, 808, 398, 810, 399, 812, 400, 814, 401, 816, 402, 818, 403, 820, 404,
+		822, 405, 824, 406, 826, 407, 828, 408, 830, 409, 832, 410, 834, 411, 836,
+		412, 838, 413, 840, 414, 842, 415, 844, 416, 846, 417, 848, 418, 850, 419,
+		852, 420, 854, 421, 856, 422, 858, 423, 860, 424, 862, 425, 864, 426, 866,
+		427, 868, 428, 870, 429, 872, 430, 874, 431, 876, 432, 878, 433, 880, 434,
+		882, 435, 884, 436, 886, 437, 888, 438, 890, 439, 892, 440, 894, 441, 896,
+		442, 898, 443, 900, 444, 902, 445, 904, 446, 906, 447, 908, 448, 910, 449,
+		912, 450, 914, 451, 916, 452, 918, 453, 920, 454, 922, 455, 924, 456, 926,
+		457, 928, 458, 930, 459, 932, 460, 934, 461, 936, 462, 938, 463, 940, 464,
+		942, 465, 944, 466, 946, 467, 948, 468, 950, 469, 952, 470, 954, 471, 956,
+		472, 958, 473, 960, 474, 962, 475, 964, 476, 966, 477, 968, 478, 970, 479,
+		972, 480, 974, 481, 976, 482, 978, 483, 980, 484, 982, 485, 984, 486, 986,
+		487, 988, 488, 990, 489, 992, 490, 994, 491, 996, 492, 998, 493, 1000,
+		494, 1002, 495, 1004, 496, 1006, 497, 1008, 498, 1010, 499, 1012, 500,
+		1014, 501, 1016, 502, 1018, 503, 1020, 504, 1022, 505, 1024, 506, 1026,
+		507, 1028, 508, 1030, 509, 1032, 510, 1034, 511, 1036, 512, 1038, 513,
+		1040, 514, 1042, 515, 1044, 516, 1046, 517, 1048, 518, 1050, 519, 1052,
+		520, 1054, 521, 1056, 522, 1058, 523, 1060, 524, 1062, 525, 1064, 526,
+		1066, 527, 1068, 528, 1070, 529, 1072, 530, 1074, 531, 1076, 532, 1078,
+		533, 1080, 534, 1082, 535, 1084, 536, 1086, 537, 1088, 538, 1090, 539,
+		1092, 540, 1094, 541, 1096, 542, 1098, 543, 1100, 544, 1102, 545, 1104,
+		546, 1106, 547, 1108, 548, 1110, 549, 1112, 550, 1114, 551, 1116, 552,
+		1118, 553, 1120, 554, 1122, 555, 1124, 556, 1126, 557, 1128, 558, 1130,
+		559, 1132, 560, 1134, 561, 1136, 562, 1138, 563, 1140, 564, 1142, 565,
+		1144, 566, 1146, 567, 1148, 568, 1150, 569, 1152, 570, 1154, 571, 1156,
+		572, 1158, 573, 1160, 574, 1162, 575, 1164, 576, 1166, 577, 1168, 578,
+		1170, 579, 1172, 580, 1174, 581, 1176, 582, 1178, 583, 1180, 584, 1182,
+		585, 1184, 586, 1186, 587, 1188, 588, 1190, 589, 1192, 590, 1194, 591,
+		1196, 592, 1198, 593, 1200, 594, 1202, 595, 1204, 596, 1206, 597, 1208,
+		598, 1210, 599, 1212, 600, 1214, 601, 1216, 602, 1218, 603, 1220, 604,
+		1222, 605, 1224, 606, 1226, 607, 1228, 608, 1230, 609, 1232, 610, 1234,
+		611, 1236, 612, 1238, 613, 1240, 614, 1242, 615, 1244, 616, 1246, 617,
+		1248, 618, 1250, 619, 1252, 620, 1254, 621, 1256, 622, 1258, 623, 1260,
+		624, 1262, 625, 1264, 626, 1266, 627, 1268, 628, 1270, 629, 1272, 630,
+		1274, 631, 1276, 632, 1278, 633, 1280, 634, 1282, 635, 1284, 636, 1286,
+		637, 1288, 638, 1290, 639, 1292, 640, 1294, 641, 1296, 642, 1298, 643,
+		1300, 644, 1302, 645, 1304, 646, 1306, 647, 1308, 648, 1310, 649, 1312,
+		650, 1314, 651, 1316, 652, 1318, 653, 1320, 654, 1322, 655, 1324, 656,
+		1326, 657, 1328, 658, 1330, 659, 1332, 660, 1334, 661, 1336, 662, 1338,
+		663, 1340, 664, 1342, 665, 1344, 666, 1346, 667, 1348, 668, 1350, 0, 1352,
+		0, 1354, 0, 1356, 669, 1358, 670, 1360, 671, 1362, 672, 1364, 673, 1366,
+		674, 1368, 675, 1370, 676, 1372, 677, 1374, 678, 1376, 0, 1378, 679, 1380,
+		680, 1382, 681, 1384, 0, 1386, 682, 1388, 683, 1390, 684, 1392, 685, 1394,
+		686, 1396, 687, 1398, 688, 1400, 689, 1402, 690, 1404, 691, 1406, 692,
+		1408, 0, 1410, 693, 1412, 694, 1414, 695, 1416, 696, 1418, 697, 1420, 698,
+		1422, 699, 1424, 700, 1426, 701, 1428, 702, 1430, 703, 1432, 704, 1434,
+		0, 1436, 705, 1438, 706, 1440, 0, 1442, 0, 1444, 0, 1446, 707, 1448, 0,
+		1450, 0, 1452, 712, 1454, 708, 1456, 709, 1458, 710, 1460, 711, 6, 0, 1,
+		2, 3, 4, 5, 51, 1, 0, 48, 57, 2, 0, 43, 43, 45, 45, 9, 0, 33, 33, 35, 35,
+		37, 38, 42, 42, 60, 64, 94, 94, 96, 96, 124, 124, 126, 126, 2, 0, 42, 43,
+		60, 62, 8, 0, 33, 33, 35, 35, 37, 38, 63, 64, 94, 94, 96, 96, 124, 124,
+		126, 126, 2, 0, 65, 65, 97, 97, 2, 0, 76, 76, 108, 108, 2, 0, 78, 78, 110,
+		110, 2, 0, 89, 89, 121, 121, 2, 0, 83, 83, 115, 115, 2, 0, 69, 69, 101,
+		101, 2, 0, 90, 90, 122, 122, 2, 0, 68, 68, 100, 100, 2, 0, 82, 82, 114,
+		114, 2, 0, 67, 67, 99, 99, 2, 0, 77, 77, 109, 109, 2, 0, 84, 84, 116, 116,
+		2, 0, 73, 73, 105, 105, 2, 0, 66, 66, 98, 98, 2, 0, 79, 79, 111, 111, 2,
+		0, 72, 72, 104, 104, 2, 0, 75, 75, 107, 107, 2, 0, 85, 85, 117, 117, 2,
+		0, 71, 71, 103, 103, 2, 0, 80, 80, 112, 112, 2, 0, 70, 70, 102, 102, 2,
+		0, 88, 88, 120, 120, 2, 0, 86, 86, 118, 118, 2, 0, 81, 81, 113, 113, 2,
+		0, 87, 87, 119, 119, 2, 0, 74, 74, 106, 106, 9, 0, 65, 90, 95, 95, 97,
+		122, 170, 170, 181, 181, 186, 186, 192, 214, 216, 246, 248, 255, 2, 0,
+		256, 55295, 57344, 65535, 1, 0, 55296, 56319, 1, 0, 56320, 57343, 2, 0,
+		0, 0, 34, 34, 1, 0, 34, 34, 1, 0, 39, 39, 1, 0, 48, 49, 3, 0, 48, 57, 65,
+		70, 97, 102, 3, 0, 65, 90, 95, 95, 97, 122, 5, 0, 36, 36, 48, 57, 65, 90,
+		95, 95, 97, 122, 2, 0, 34, 34, 92, 92, 2, 0, 9, 9, 32, 32, 2, 0, 10, 10,
+		13, 13, 2, 0, 42, 42, 47, 47, 4, 0, 10, 10, 13, 13, 34, 34, 92, 92, 3,
+		0, 10, 10, 13, 13, 34, 34, 3, 0, 85, 85, 117, 117, 120, 120, 2, 0, 39,
+		39, 92, 92, 1, 0, 36, 36, 7255, 0, 6, 1, 0, 0, 0, 0, 8, 1, 0, 0, 0, 0,
+		10, 1, 0, 0, 0, 0, 12, 1, 0, 0, 0, 0, 14, 1, 0, 0, 0, 0, 16, 1, 0, 0, 0,
+		0, 18, 1, 0, 0, 0, 0, 20, 1, 0, 0, 0, 0, 22, 1, 0, 0, 0, 0, 24, 1, 0, 0,
+		0, 0, 26, 1, 0, 0, 0, 0, 28, 1, 0, 0, 0, 0, 30, 1, 0, 0, 0, 0, 32, 1, 0,
+		0, 0, 0, 34, 1, 0, 0, 0, 0, 36, 1, 0, 0, 0, 0, 38, 1, 0, 0, 0, 0, 40, 1,
+		0, 0, 0, 0, 42, 1, 0, 0, 0, 0, 44, 1, 0, 0, 0, 0, 46, 1, 0, 0, 0, 0, 48,
+		1, 0, 0, 0, 0, 50, 1, 0, 0, 0, 0, 52, 1, 0, 0, 0, 0, 54, 1, 0, 0, 0, 0,
+		56, 1, 0, 0, 0, 0, 58, 1, 0, 0, 0, 0, 60, 1, 0, 0, 0, 0, 62, 1, 0, 0, 0,
+		0, 64, 1, 0, 0, 0, 0, 72, 1, 0, 0, 0, 0, 74, 1, 0, 0, 0, 0, 76, 1, 0, 0,
+		0, 0, 78, 1, 0, 0, 0, 0, 80, 1, 0, 0, 0, 0, 82, 1, 0, 0, 0, 0, 84, 1, 0,
+		0, 0, 0, 86, 1, 0, 0, 0, 0, 88, 1, 0, 0, 0, 0, 90, 1, 0, 0, 0, 0, 92, 1,
+		0, 0, 0, 0, 94, 1, 0, 0, 0, 0, 96, 1, 0, 0, 0, 0, 98, 1, 0, 0, 0, 0, 100,
+		1, 0, 0, 0, 0, 102, 1, 0, 0, 0, 0, 104, 1, 0, 0, 0, 0, 106, 1, 0, 0, 0,
+		0, 108, 1, 0, 0, 0, 0, 110, 1, 0, 0, 0, 0, 112, 1, 0, 0, 0, 0, 114, 1,
+		0, 0, 0, 0, 116, 1, 0, 0, 0, 0, 118, 1, 0, 0, 0, 0, 120, 1, 0, 0, 0, 0,
+		122, 1, 0, 0, 0, 0, 124, 1, 0, 0, 0, 0, 126, 1, 0, 0, 0, 0, 128, 1, 0,
+		0, 0, 0, 130, 1, 0, 0, 0, 0, 132, 1, 0, 0, 0, 0, 134, 1, 0, 0, 0, 0, 136,
+		1, 0, 0, 0, 0, 138, 1, 0, 0, 0, 0, 140, 1, 0, 0, 0, 0, 142, 1, 0, 0, 0,
+		0, 144, 1, 0, 0, 0, 0, 146, 1, 0, 0, 0, 0, 148, 1, 0, 0, 0, 0, 150, 1,
+		0, 0, 0, 0, 152, 1, 0, 0, 0, 0, 154, 1, 0, 0, 0, 0, 156, 1, 0, 0, 0, 0,
+		158, 1, 0, 0, 0, 0, 160, 1, 0, 0, 0, 0, 162, 1, 0, 0, 0, 0, 164, 1, 0,
+		0, 0, 0, 166, 1, 0, 0, 0, 0, 168, 1, 0, 0, 0, 0, 170, 1, 0, 0, 0, 0, 172,
+		1, 0, 0, 0, 0, 174, 1, 0, 0, 0, 0, 176, 1, 0, 0, 0, 0, 178, 1, 0, 0, 0,
+		0, 180, 1, 0, 0, 0, 0, 182, 1, 0, 0, 0, 0, 184, 1, 0, 0, 0, 0, 186, 1,
+		0, 0, 0, 0, 188, 1, 0, 0, 0, 0, 190, 1, 0, 0, 0, 0, 192, 1, 0, 0, 0, 0,
+		194, 1, 0, 0, 0, 0, 196, 1, 0, 0, 0, 0, 198, 1, 0, 0, 0, 0, 200, 1, 0,
+		0, 0, 0, 202, 1, 0, 0, 0, 0, 204, 1, 0, 0, 0, 0, 206, 1, 0, 0, 0, 0, 208,
+		1, 0, 0, 0, 0, 210, 1, 0, 0, 0, 0, 212, 1, 0, 0, 0, 0, 214, 1, 0, 0, 0,
+		0, 216, 1, 0, 0, 0, 0, 218, 1, 0, 0, 0, 0, 220, 1, 0, 0, 0, 0, 222, 1,
+		0, 0, 0, 0, 224, 1, 0, 0, 0, 0, 226, 1, 0, 0, 0, 0, 228, 1, 0, 0, 0, 0,
+		230, 1, 0, 0, 0, 0, 232, 1, 0, 0, 0, 0, 234, 1, 0, 0, 0, 0, 236, 1, 0,
+		0, 0, 0, 238, 1, 0, 0, 0, 0, 240, 1, 0, 0, 0, 0, 242, 1, 0, 0, 0, 0, 244,
+		1, 0, 0, 0, 0, 246, 1, 0, 0, 0, 0, 248, 1, 0, 0, 0, 0, 250, 1, 0, 0, 0,
+		0, 252, 1, 0, 0, 0, 0, 254, 1, 0, 0, 0, 0, 256, 1, 0, 0, 0, 0, 258, 1,
+		0, 0, 0, 0, 260, 1, 0, 0, 0, 0, 262, 1, 0, 0, 0, 0, 264, 1, 0, 0, 0, 0,
+		266, 1, 0, 0, 0, 0, 268, 1, 0, 0, 0, 0, 270, 1, 0, 0, 0, 0, 272, 1, 0,
+		0, 0, 0, 274, 1, 0, 0, 0, 0, 276, 1, 0, 0, 0, 0, 278, 1, 0, 0, 0, 0, 280,
+		1, 0, 0, 0, 0, 282, 1, 0, 0, 0, 0, 284, 1, 0, 0, 0, 0, 286, 1, 0, 0, 0,
+		0, 288, 1, 0, 0, 0, 0, 290, 1, 0, 0, 0, 0, 292, 1, 0, 0, 0, 0, 294, 1,
+		0, 0, 0, 0, 296, 1, 0, 0, 0, 0, 298, 1, 0, 0, 0, 0, 300, 1, 0, 0, 0, 0,
+		302, 1, 0, 0, 0, 0, 304, 1, 0, 0, 0, 0, 306, 1, 0, 0, 0, 0, 308, 1, 0,
+		0, 0, 0, 310, 1, 0, 0, 0, 0, 312, 1, 0, 0, 0, 0, 314, 1, 0, 0, 0, 0, 316,
+		1, 0, 0, 0, 0, 318, 1, 0, 0, 0, 0, 320, 1, 0, 0, 0, 0, 322, 1, 0, 0, 0,
+		0, 324, 1, 0, 0, 0, 0, 326, 1, 0, 0, 0, 0, 328, 1, 0, 0, 0, 0, 330, 1,
+		0, 0, 0, 0, 332, 1, 0, 0, 0, 0, 334, 1, 0, 0, 0, 0, 336, 1, 0, 0, 0, 0,
+		338, 1, 0, 0, 0, 0, 340, 1, 0, 0, 0, 0, 342, 1, 0, 0, 0, 0, 344, 1, 0,
+		0, 0, 0, 346, 1, 0, 0, 0, 0, 348, 1, 0, 0, 0, 0, 350, 1, 0, 0, 0, 0, 352,
+		1, 0, 0, 0, 0, 354, 1, 0, 0, 0, 0, 356, 1, 0, 0, 0, 0, 358, 1, 0, 0, 0,
+		0, 360, 1, 0, 0, 0, 0, 362, 1, 0, 0, 0, 0, 364, 1, 0, 0, 0, 0, 366, 1,
+		0, 0, 0, 0, 368, 1, 0, 0, 0, 0, 370, 1, 0, 0, 0, 0, 372, 1, 0, 0, 0, 0,
+		374, 1, 0, 0, 0, 0, 376, 1, 0, 0, 0, 0, 378, 1, 0, 0, 0, 0, 380, 1, 0,
+		0, 0, 0, 382, 1, 0, 0, 0, 0, 384, 1, 0, 0, 0, 0, 386, 1, 0, 0, 0, 0, 388,
+		1, 0, 0, 0, 0, 390, 1, 0, 0, 0, 0, 392, 1, 0, 0, 0, 0, 394, 1, 0, 0, 0,
+		0, 396, 1, 0, 0, 0, 0, 398, 1, 0, 0, 0, 0, 400, 1, 0, 0, 0, 0, 402, 1,
+		0, 0, 0, 0, 404, 1, 0, 0, 0, 0, 406, 1, 0, 0, 0, 0, 408, 1, 0, 0, 0, 0,
+		410, 1, 0, 0, 0, 0, 412, 1, 0, 0, 0, 0, 414, 1, 0, 0, 0, 0, 416, 1, 0,
+		0, 0, 0, 418, 1, 0, 0, 0, 0, 420, 1, 0, 0, 0, 0, 422, 1, 0, 0, 0, 0, 424,
+		1, 0, 0, 0, 0, 426, 1, 0, 0, 0, 0, 428, 1, 0, 0, 0, 0, 430, 1, 0, 0, 0,
+		0, 432, 1, 0, 0, 0, 0, 434, 1, 0, 0, 0, 0, 436, 1, 0, 0, 0, 0, 438, 1,
+		0, 0, 0, 0, 440, 1, 0, 0, 0, 0, 442, 1, 0, 0, 0, 0, 444, 1, 0, 0, 0, 0,
+		446, 1, 0, 0, 0, 0, 448, 1, 0, 0, 0, 0, 450, 1, 0, 0, 0, 0, 452, 1, 0,
+		0, 0, 0, 454, 1, 0, 0, 0, 0, 456, 1, 0, 0, 0, 0, 458, 1, 0, 0, 0, 0, 460,
+		1, 0, 0, 0, 0, 462, 1, 0, 0, 0, 0, 464, 1, 0, 0, 0, 0, 466, 1, 0, 0, 0,
+		0, 468, 1, 0, 0, 0, 0, 470, 1, 0, 0, 0, 0, 472, 1, 0, 0, 0, 0, 474, 1,
+		0, 0, 0, 0, 476, 1, 0, 0, 0, 0, 478, 1, 0, 0, 0, 0, 480, 1, 0, 0, 0, 0,
+		482, 1, 0, 0, 0, 0, 484, 1, 0, 0, 0, 0, 486, 1, 0, 0, 0, 0, 488, 1, 0,
+		0, 0, 0, 490, 1, 0, 0, 0, 0, 492, 1, 0, 0, 0, 0, 494, 1, 0, 0, 0, 0, 496,
+		1, 0, 0, 0, 0, 498, 1, 0, 0, 0, 0, 500, 1, 0, 0, 0, 0, 502, 1, 0, 0, 0,
+		0, 504, 1, 0, 0, 0, 0, 506, 1, 0, 0, 0, 0, 508, 1, 0, 0, 0, 0, 510, 1,
+		0, 0, 0, 0, 512, 1, 0, 0, 0, 0, 514, 1, 0, 0, 0, 0, 516, 1, 0, 0, 0, 0,
+		518, 1, 0, 0, 0, 0, 520, 1, 0, 0, 0, 0, 522, 1, 0, 0, 0, 0, 524, 1, 0,
+		0, 0, 0, 526, 1, 0, 0, 0, 0, 528, 1, 0, 0, 0, 0, 530, 1, 0, 0, 0, 0, 532,
+		1, 0, 0, 0, 0, 534, 1, 0, 0, 0, 0, 536, 1, 0, 0, 0, 0, 538, 1, 0, 0, 0,
+		0, 540, 1, 0, 0, 0, 0, 542, 1, 0, 0, 0, 0, 544, 1, 0, 0, 0, 0, 546, 1,
+		0, 0, 0, 0, 548, 1, 0, 0, 0, 0, 550, 1, 0, 0, 0, 0, 552, 1, 0, 0, 0, 0,
+		554, 1, 0, 0, 0, 0, 556, 1, 0, 0, 0, 0, 558, 1, 0, 0, 0, 0, 560, 1, 0,
+		0, 0, 0, 562, 1, 0, 0, 0, 0, 564, 1, 0, 0, 0, 0, 566, 1, 0, 0, 0, 0, 568,
+		1, 0, 0, 0, 0, 570, 1, 0, 0, 0, 0, 572, 1, 0, 0, 0, 0, 574, 1, 0, 0, 0,
+		0, 576, 1, 0, 0, 0, 0, 578, 1, 0, 0, 0, 0, 580, 1, 0, 0, 0, 0, 582, 1,
+		0, 0, 0, 0, 584, 1, 0, 0, 0, 0, 586, 1, 0, 0, 0, 0, 588, 1, 0, 0, 0, 0,
+		590, 1, 0, 0, 0, 0, 592, 1, 0, 0, 0, 0, 594, 1, 0, 0, 0, 0, 596, 1, 0,
+		0, 0, 0, 598, 1, 0, 0, 0, 0, 600, 1, 0, 0, 0, 0, 602, 1, 0, 0, 0, 0, 604,
+		1, 0, 0, 0, 0, 606, 1, 0, 0, 0, 0, 608, 1, 0, 0, 0, 0, 610, 1, 0, 0, 0,
+		0, 612, 1, 0, 0, 0, 0, 614, 1, 0, 0, 0, 0, 616, 1, 0, 0, 0, 0, 618, 1,
+		0, 0, 0, 0, 620, 1, 0, 0, 0, 0, 622, 1, 0, 0, 0, 0, 624, 1, 0, 0, 0, 0,
+		626, 1, 0, 0, 0, 0, 628, 1, 0, 0, 0, 0, 630, 1, 0, 0, 0, 0, 632, 1, 0,
+		0, 0, 0, 634, 1, 0, 0, 0, 0, 636, 1, 0, 0, 0, 0, 638, 1, 0, 0, 0, 0, 640,
+		1, 0, 0, 0, 0, 642, 1, 0, 0, 0, 0, 644, 1, 0, 0, 0, 0, 646, 1, 0, 0, 0,
+		0, 648, 1, 0, 0, 0, 0, 650, 1, 0, 0, 0, 0, 652, 1, 0, 0, 0, 0, 654, 1,
+		0, 0, 0, 0, 656, 1, 0, 0, 0, 0, 658, 1, 0, 0, 0, 0, 660, 1, 0, 0, 0, 0,
+		662, 1, 0, 0, 0, 0, 664, 1, 0, 0, 0, 0, 666, 1, 0, 0, 0, 0, 668, 1, 0,
+		0, 0, 0, 670, 1, 0, 0, 0, 0, 672, 1, 0, 0, 0, 0, 674, 1, 0, 0, 0, 0, 676,
+		1, 0, 0, 0, 0, 678, 1, 0, 0, 0, 0, 680, 1, 0, 0, 0, 0, 682, 1, 0, 0, 0,
+		0, 684, 1, 0, 0, 0, 0, 686, 1, 0, 0, 0, 0, 688, 1, 0, 0, 0, 0, 690, 1,
+		0, 0, 0, 0, 692, 1, 0, 0, 0, 0, 694, 1, 0, 0, 0, 0, 696, 1, 0, 0, 0, 0,
+		698, 1, 0, 0, 0, 0, 700, 1, 0, 0, 0, 0, 702, 1, 0, 0, 0, 0, 704, 1, 0,
+		0, 0, 0, 706, 1, 0, 0, 0, 0, 708, 1, 0, 0, 0, 0, 710, 1, 0, 0, 0, 0, 712,
+		1, 0, 0, 0, 0, 714, 1, 0, 0, 0, 0, 716, 1, 0, 0, 0, 0, 718, 1, 0, 0, 0,
+		0, 720, 1, 0, 0, 0, 0, 722, 1, 0, 0, 0, 0, 724, 1, 0, 0, 0, 0, 726, 1,
+		0, 0, 0, 0, 728, 1, 0, 0, 0, 0, 730, 1, 0, 0, 0, 0, 732, 1, 0, 0, 0, 0,
+		734, 1, 0, 0, 0, 0, 736, 1, 0, 0, 0, 0, 738, 1, 0, 0, 0, 0, 740, 1, 0,
+		0, 0, 0, 742, 1, 0, 0, 0, 0, 744, 1, 0, 0, 0, 0, 746, 1, 0, 0, 0, 0, 748,
+		1, 0, 0, 0, 0, 750, 1, 0, 0, 0, 0, 752, 1, 0, 0, 0, 0, 754, 1, 0, 0, 0,
+		0, 756, 1, 0, 0, 0, 0, 758, 1, 0, 0, 0, 0, 760, 1, 0, 0, 0, 0, 762, 1,
+		0, 0, 0, 0, 764, 1, 0, 0, 0, 0, 766, 1, 0, 0, 0, 0, 768, 1, 0, 0, 0, 0,
+		770, 1, 0, 0, 0, 0, 772, 1, 0, 0, 0, 0, 774, 1, 0, 0, 0, 0, 776, 1, 0,
+		0, 0, 0, 778, 1, 0, 0, 0, 0, 780, 1, 0, 0, 0, 0, 782, 1, 0, 0, 0, 0, 784,
+		1, 0, 0, 0, 0, 786, 1, 0, 0, 0, 0, 788, 1, 0, 0, 0, 0, 790, 1, 0, 0, 0,
+		0, 792, 1, 0, 0, 0, 0, 794, 1, 0, 0, 0, 0, 796, 1, 0, 0, 0, 0, 798, 1,
+		0, 0, 0, 0, 800, 1, 0, 0, 0, 0, 802, 1, 0, 0, 0, 0, 804, 1, 0, 0, 0, 0,
+		806, 1, 0, 0, 0, 0, 808, 1, 0, 0, 0, 0, 810, 1, 0, 0, 0, 0, 812, 1, 0,
+		0, 0, 0, 814, 1, 0, 0, 0, 0, 816, 1, 0, 0, 0, 0, 818, 1, 0, 0, 0, 0, 820,
+		1, 0, 0, 0, 0, 822, 1, 0, 0, 0, 0, 824, 1, 0, 0, 0, 0, 826, 1, 0, 0, 0,
+		0, 828, 1, 0, 0, 0, 0, 830, 1, 0, 0, 0, 0, 832, 1, 0, 0, 0, 0, 834, 1,
+		0, 0, 0, 0, 836, 1, 0, 0, 0, 0, 838, 1, 0, 0, 0, 0, 840, 1, 0, 0, 0, 0,
+		842, 1, 0, 0, 0, 0, 844, 1, 0, 0, 0, 0, 846, 1, 0, 0, 0, 0, 848, 1, 0,
+		0, 0, 0, 850, 1, 0, 0, 0, 0, 852, 1, 0, 0, 0, 0, 854, 1, 0, 0, 0, 0, 856,
+		1, 0, 0, 0, 0, 858, 1, 0, 0, 0, 0, 860, 1, 0, 0, 0, 0, 862, 1, 0, 0, 0,
+		0, 864, 1, 0, 0, 0, 0, 866, 1, 0, 0, 0, 0, 868, 1, 0, 0, 0, 0, 870, 1,
+		0, 0, 0, 0, 872, 1, 0, 0, 0, 0, 874, 1, 0, 0, 0, 0, 876, 1, 0, 0, 0, 0,
+		878, 1, 0, 0, 0, 0, 880, 1, 0, 0, 0, 0, 882, 1, 0, 0, 0, 0, 884, 1, 0,
+		0, 0, 0, 886, 1, 0, 0, 0, 0, 888, 1, 0, 0, 0, 0, 890, 1, 0, 0, 0, 0, 892,
+		1, 0, 0, 0, 0, 894, 1, 0, 0, 0, 0, 896, 1, 0, 0, 0, 0, 898, 1, 0, 0, 0,
+		0, 900, 1, 0, 0, 0, 0, 902, 1, 0, 0, 0, 0, 904, 1, 0, 0, 0, 0, 906, 1,
+		0, 0, 0, 0, 908, 1, 0, 0, 0, 0, 910, 1, 0, 0, 0, 0, 912, 1, 0, 0, 0, 0,
+		914, 1, 0, 0, 0, 0, 916, 1, 0, 0, 0, 0, 918, 1, 0, 0, 0, 0, 920, 1, 0,
+		0, 0, 0, 922, 1, 0, 0, 0, 0, 924, 1, 0, 0, 0, 0, 926, 1, 0, 0, 0, 0, 928,
+		1, 0, 0, 0, 0, 930, 1, 0, 0, 0, 0, 932, 1, 0, 0, 0, 0, 934, 1, 0, 0, 0,
+		0, 936, 1, 0, 0, 0, 0, 938, 1, 0, 0, 0, 0, 940, 1, 0, 0, 0, 0, 942, 1,
+		0, 0, 0, 0, 944, 1, 0, 0, 0, 0, 946, 1, 0, 0, 0, 0, 948, 1, 0, 0, 0, 0,
+		950, 1, 0, 0, 0, 0, 952, 1, 0, 0, 0, 0, 954, 1, 0, 0, 0, 0, 956, 1, 0,
+		0, 0, 0, 958, 1, 0, 0, 0, 0, 960, 1, 0, 0, 0, 0, 962, 1, 0, 0, 0, 0, 964,
+		1, 0, 0, 0, 0, 966, 1, 0, 0, 0, 0, 968, 1, 0, 0, 0, 0, 970, 1, 0, 0, 0,
+		0, 972, 1, 0, 0, 0, 0, 974, 1, 0, 0, 0, 0, 976, 1, 0, 0, 0, 0, 978, 1,
+		0, 0, 0, 0, 980, 1, 0, 0, 0, 0, 982, 1, 0, 0, 0, 0, 984, 1, 0, 0, 0, 0,
+		986, 1, 0, 0, 0, 0, 988, 1, 0, 0, 0, 0, 990, 1, 0, 0, 0, 0, 992, 1, 0,
+		0, 0, 0, 994, 1, 0, 0, 0, 0, 996, 1, 0, 0, 0, 0, 998, 1, 0, 0, 0, 0, 1000,
+		1, 0, 0, 0, 0, 1002, 1, 0, 0, 0, 0, 1004, 1, 0, 0, 0, 0, 1006, 1, 0, 0,
+		0, 0, 1008, 1, 0, 0, 0, 0, 1010, 1, 0, 0, 0, 0, 1012, 1, 0, 0, 0, 0, 1014,
+		1, 0, 0, 0, 0, 1016, 1, 0, 0, 0, 0, 1018, 1, 0, 0, 0, 0, 1020, 1, 0, 0,
+		0, 0, 1022, 1, 0, 0, 0, 0, 1024, 1, 0, 0, 0, 0, 1026, 1, 0, 0, 0, 0, 1028,
+		1, 0, 0, 0, 0, 1030, 1, 0, 0, 0, 0, 1032, 1, 0, 0, 0, 0, 1034, 1, 0, 0,
+		0, 0, 1036, 1, 0, 0, 0, 0, 1038, 1, 0, 0, 0, 0, 1040, 1, 0, 0, 0, 0, 1042,
+		1, 0, 0, 0, 0, 1044, 1, 0, 0, 0, 0, 1046, 1, 0, 0, 0, 0, 1048, 1, 0, 0,
+		0, 0, 1050, 1, 0, 0, 0, 0, 1052, 1, 0, 0, 0, 0, 1054, 1, 0, 0, 0, 0, 1056,
+		1, 0, 0, 0, 0, 1058, 1, 0, 0, 0, 0, 1060, 1, 0, 0, 0, 0, 1062, 1, 0, 0,
+		0, 0, 1064, 1, 0, 0, 0, 0, 1066, 1, 0, 0, 0, 0, 1068, 1, 0, 0, 0, 0, 1070,
+		1, 0, 0, 0, 0, 1072, 1, 0, 0, 0, 0, 1074, 1, 0, 0, 0, 0, 1076, 1, 0, 0,
+		0, 0, 1078, 1, 0, 0, 0, 0, 1080, 1, 0, 0, 0, 0, 1082, 1, 0, 0, 0, 0, 1084,
+		1, 0, 0, 0, 0, 1086, 1, 0, 0, 0, 0, 1088, 1, 0, 0, 0, 0, 1090, 1, 0, 0,
+		0, 0, 1092, 1, 0, 0, 0, 0, 1094, 1, 0, 0, 0, 0, 1096, 1, 0, 0, 0, 0, 1098,
+		1, 0, 0, 0, 0, 1100, 1, 0, 0, 0, 0, 1102, 1, 0, 0, 0, 0, 1104, 1, 0, 0,
+		0, 0, 1106, 1, 0, 0, 0, 0, 1108, 1, 0, 0, 0, 0, 1110, 1, 0, 0, 0, 0, 1112,
+		1, 0, 0, 0, 0, 1114, 1, 0, 0, 0, 0, 1116, 1, 0, 0, 0, 0, 1118, 1, 0, 0,
+		0, 0, 1120, 1, 0, 0, 0, 0, 1122, 1, 0, 0, 0, 0, 1124, 1, 0, 0, 0, 0, 1126,
+		1, 0, 0, 0, 0, 1128, 1, 0, 0, 0, 0, 1130, 1, 0, 0, 0, 0, 1132, 1, 0, 0,
+		0, 0, 1134, 1, 0, 0, 0, 0, 1136, 1, 0, 0, 0, 0, 1138, 1, 0, 0, 0, 0, 1140,
+		1, 0, 0, 0, 0, 1142, 1, 0, 0, 0, 0, 1144, 1, 0, 0, 0, 0, 1146, 1, 0, 0,
+		0, 0, 1148, 1, 0, 0, 0, 0, 1150, 1, 0, 0, 0, 0, 1152, 1, 0, 0, 0, 0, 1154,
+		1, 0, 0, 0, 0, 1156, 1, 0, 0, 0, 0, 1158, 1, 0, 0, 0, 0, 1160, 1, 0, 0,
+		0, 0, 1162, 1, 0, 0, 0, 0, 1164, 1, 0, 0, 0, 0, 1166, 1, 0, 0, 0, 0, 1168,
+		1, 0, 0, 0, 0, 1170, 1, 0, 0, 0, 0, 1172, 1, 0, 0, 0, 0, 1174, 1, 0, 0,
+		0, 0, 1176, 1, 0, 0, 0, 0, 1178, 1, 0, 0, 0, 0, 1180, 1, 0, 0, 0, 0, 1182,
+		1, 0, 0, 0, 0, 1184, 1, 0, 0, 0, 0, 1186, 1, 0, 0, 0, 0, 1188, 1, 0, 0,
+		0, 0, 1190, 1, 0, 0, 0, 0, 1192, 1, 0, 0, 0, 0, 1194, 1, 0, 0, 0, 0, 1196,
+		1, 0, 0, 0, 0, 1198, 1, 0, 0, 0, 0, 1200, 1, 0, 0, 0, 0, 1202, 1, 0, 0,
+		0, 0, 1204, 1, 0, 0, 0, 0, 1206, 1, 0, 0, 0, 0, 1208, 1, 0, 0, 0, 0, 1210,
+		1, 0, 0, 0, 0, 1212, 1, 0, 0, 0, 0, 1214, 1, 0, 0, 0, 0, 1216, 1, 0, 0,
+		0, 0, 1218, 1, 0, 0, 0, 0, 1220, 1, 0, 0, 0, 0, 1222, 1, 0, 0, 0, 0, 1224,
+		1, 0, 0, 0, 0, 1226, 1, 0, 0, 0, 0, 1228, 1, 0, 0, 0, 0, 1230, 1, 0, 0,
+		0, 0, 1232, 1, 0, 0, 0, 0, 1234, 1, 0, 0, 0, 0, 1236, 1, 0, 0, 0, 0, 1238,
+		1, 0, 0, 0, 0, 1240, 1, 0, 0, 0, 0, 1242, 1, 0, 0, 0, 0, 1244, 1, 0, 0,
+		0, 0, 1246, 1, 0, 0, 0, 0, 1248, 1, 0, 0, 0, 0, 1250, 1, 0, 0, 0, 0, 1252,
+		1, 0, 0, 0, 0, 1254, 1, 0, 0, 0, 0, 1256, 1, 0, 0, 0, 0, 1258, 1, 0, 0,
+		0, 0, 1260, 1, 0, 0, 0, 0, 1262, 1, 0, 0, 0, 0, 1264, 1, 0, 0, 0, 0, 1266,
+		1, 0, 0, 0, 0, 1268, 1, 0, 0, 0, 0, 1270, 1, 0, 0, 0, 0, 1272, 1, 0, 0,
+		0, 0, 1274, 1, 0, 0, 0, 0, 1276, 1, 0, 0, 0, 0, 1278, 1, 0, 0, 0, 0, 1280,
+		1, 0, 0, 0, 0, 1282, 1, 0, 0, 0, 0, 1284, 1, 0, 0, 0, 0, 1286, 1, 0, 0,
+		0, 0, 1288, 1, 0, 0, 0, 0, 1290, 1, 0, 0, 0, 0, 1292, 1, 0, 0, 0, 0, 1294,
+		1, 0, 0, 0, 0, 1296, 1, 0, 0, 0, 0, 1298, 1, 0, 0, 0, 0, 1300, 1, 0, 0,
+		0, 0, 1302, 1, 0, 0, 0, 0, 1304, 1, 0, 0, 0, 0, 1306, 1, 0, 0, 0, 0, 1308,
+		1, 0, 0, 0, 0, 1310, 1, 0, 0, 0, 0, 1312, 1, 0, 0, 0, 0, 1314, 1, 0, 0,
+		0, 0, 1316, 1, 0, 0, 0, 0, 1318, 1, 0, 0, 0, 0, 1320, 1, 0, 0, 0, 0, 1322,
+		1, 0, 0, 0, 0, 1324, 1, 0, 0, 0, 0, 1326, 1, 0, 0, 0, 0, 1328, 1, 0, 0,
+		0, 0, 1330, 1, 0, 0, 0, 0, 1332, 1, 0, 0, 0, 0, 1334, 1, 0, 0, 0, 0, 1336,
+		1, 0, 0, 0, 0, 1338, 1, 0, 0, 0, 0, 1340, 1, 0, 0, 0, 0, 1342, 1, 0, 0,
+		0, 0, 1344, 1, 0, 0, 0, 0, 1346, 1, 0, 0, 0, 0, 1348, 1, 0, 0, 0, 0, 1356,
+		1, 0, 0, 0, 0, 1358, 1, 0, 0, 0, 0, 1360, 1, 0, 0, 0, 0, 1362, 1, 0, 0,
+		0, 0, 1364, 1, 0, 0, 0, 0, 1366, 1, 0, 0, 0, 0, 1368, 1, 0, 0, 0, 0, 1370,
+		1, 0, 0, 0, 0, 1372, 1, 0, 0, 0, 0, 1374, 1, 0, 0, 0, 0, 1376, 1, 0, 0,
+		0, 0, 1378, 1, 0, 0, 0, 0, 1380, 1, 0, 0, 0, 0, 1382, 1, 0, 0, 0, 0, 1386,
+		1, 0, 0, 0, 0, 1388, 1, 0, 0, 0, 0, 1390, 1, 0, 0, 0, 0, 1392, 1, 0, 0,
+		0, 0, 1394, 1, 0, 0, 0, 0, 1396, 1, 0, 0, 0, 0, 1398, 1, 0, 0, 0, 0, 1400,
+		1, 0, 0, 0, 0, 1402, 1, 0, 0, 0, 0, 1404, 1, 0, 0, 0, 0, 1406, 1, 0, 0,
+		0, 0, 1410, 1, 0, 0, 0, 0, 1412, 1, 0, 0, 0, 0, 1414, 1, 0, 0, 0, 0, 1416,
+		1, 0, 0, 0, 0, 1418, 1, 0, 0, 0, 0, 1420, 1, 0, 0, 0, 0, 1422, 1, 0, 0,
+		0, 0, 1424, 1, 0, 0, 0, 0, 1426, 1, 0, 0, 0, 0, 1428, 1, 0, 0, 0, 1, 1430,
+		1, 0, 0, 0, 1, 1432, 1, 0, 0, 0, 1, 1436, 1, 0, 0, 0, 1, 1438, 1, 0, 0,
+		0, 2, 1442, 1, 0, 0, 0, 2, 1444, 1, 0, 0, 0, 2, 1446, 1, 0, 0, 0, 3, 1448,
+		1, 0, 0, 0, 3, 1450, 1, 0, 0, 0, 3, 1452, 1, 0, 0, 0, 3, 1454, 1, 0, 0,
+		0, 4, 1456, 1, 0, 0, 0, 4, 1458, 1, 0, 0, 0, 5, 1460, 1, 0, 0, 0, 6, 1462,
+		1, 0, 0, 0, 8, 1464, 1, 0, 0, 0, 10, 1466, 1, 0, 0, 0, 12, 1468, 1, 0,
+		0, 0, 14, 1470, 1, 0, 0, 0, 16, 1472, 1, 0, 0, 0, 18, 1474, 1, 0, 0, 0,
+		20, 1476, 1, 0, 0, 0, 22, 1478, 1, 0, 0, 0, 24, 1480, 1, 0, 0, 0, 26, 1482,
+		1, 0, 0, 0, 28, 1484, 1, 0, 0, 0, 30, 1486, 1, 0, 0, 0, 32, 1488, 1, 0,
+		0, 0, 34, 1490, 1, 0, 0, 0, 36, 1492, 1, 0, 0, 0, 38, 1494, 1, 0, 0, 0,
+		40, 1496, 1, 0, 0, 0, 42, 1499, 1, 0, 0, 0, 44, 1502, 1, 0, 0, 0, 46, 1505,
+		1, 0, 0, 0, 48, 1508, 1, 0, 0, 0, 50, 1511, 1, 0, 0, 0, 52, 1514, 1, 0,
+		0, 0, 54, 1517, 1, 0, 0, 0, 56, 1520, 1, 0, 0, 0, 58, 1523, 1, 0, 0, 0,
+		60, 1525, 1, 0, 0, 0, 62, 1551, 1, 0, 0, 0, 64, 1562, 1, 0, 0, 0, 66, 1578,
+		1, 0, 0, 0, 68, 1580, 1, 0, 0, 0, 70, 1582, 1, 0, 0, 0, 72, 1584, 1, 0,
+		0, 0, 74, 1588, 1, 0, 0, 0, 76, 1596, 1, 0, 0, 0, 78, 1604, 1, 0, 0, 0,
+		80, 1608, 1, 0, 0, 0, 82, 1612, 1, 0, 0, 0, 84, 1618, 1, 0, 0, 0, 86, 1621,
+		1, 0, 0, 0, 88, 1625, 1, 0, 0, 0, 90, 1636, 1, 0, 0, 0, 92, 1641, 1, 0,
+		0, 0, 94, 1646, 1, 0, 0, 0, 96, 1651, 1, 0, 0, 0, 98, 1657, 1, 0, 0, 0,
+		100, 1665, 1, 0, 0, 0, 102, 1672, 1, 0, 0, 0, 104, 1683, 1, 0, 0, 0, 106,
+		1690, 1, 0, 0, 0, 108, 1706, 1, 0, 0, 0, 110, 1719, 1, 0, 0, 0, 112, 1732,
+		1, 0, 0, 0, 114, 1745, 1, 0, 0, 0, 116, 1763, 1, 0, 0, 0, 118, 1776, 1,
+		0, 0, 0, 120, 1784, 1, 0, 0, 0, 122, 1795, 1, 0, 0, 0, 124, 1800, 1, 0,
+		0, 0, 126, 1809, 1, 0, 0, 0, 128, 1812, 1, 0, 0, 0, 130, 1817, 1, 0, 0,
+		0, 132, 1824, 1, 0, 0, 0, 134, 1830, 1, 0, 0, 0, 136, 1836, 1, 0, 0, 0,
+		138, 1840, 1, 0, 0, 0, 140, 1848, 1, 0, 0, 0, 142, 1853, 1, 0, 0, 0, 144,
+		1859, 1, 0, 0, 0, 146, 1865, 1, 0, 0, 0, 148, 1872, 1, 0, 0, 0, 150, 1875,
+		1, 0, 0, 0, 152, 1885, 1, 0, 0, 0, 154, 1895, 1, 0, 0, 0, 156, 1900, 1,
+		0, 0, 0, 158, 1908, 1, 0, 0, 0, 160, 1916, 1, 0, 0, 0, 162, 1922, 1, 0,
+		0, 0, 164, 1932, 1, 0, 0, 0, 166, 1947, 1, 0, 0, 0, 168, 1951, 1, 0, 0,
+		0, 170, 1956, 1, 0, 0, 0, 172, 1963, 1, 0, 0, 0, 174, 1966, 1, 0, 0, 0,
+		176, 1971, 1, 0, 0, 0, 178, 1974, 1, 0, 0, 0, 180, 1980, 1, 0, 0, 0, 182,
+		1988, 1, 0, 0, 0, 184, 1996, 1, 0, 0, 0, 186, 2007, 1, 0, 0, 0, 188, 2017,
+		1, 0, 0, 0, 190, 2024, 1, 0, 0, 0, 192, 2037, 1, 0, 0, 0, 194, 2042, 1,
+		0, 0, 0, 196, 2052, 1, 0, 0, 0, 198, 2058, 1, 0, 0, 0, 200, 2063, 1, 0,
+		0, 0, 202, 2066, 1, 0, 0, 0, 204, 2075, 1, 0, 0, 0, 206, 2080, 1, 0, 0,
+		0, 208, 2086, 1, 0, 0, 0, 210, 2093, 1, 0, 0, 0, 212, 2098, 1, 0, 0, 0,
+		214, 2104, 1, 0, 0, 0, 216, 2113, 1, 0, 0, 0, 218, 2118, 1, 0, 0, 0, 220,
+		2124, 1, 0, 0, 0, 222, 2131, 1, 0, 0, 0, 224, 2136, 1, 0, 0, 0, 226, 2150,
+		1, 0, 0, 0, 228, 2157, 1, 0, 0, 0, 230, 2167, 1, 0, 0, 0, 232, 2180, 1,
+		0, 0, 0, 234, 2186, 1, 0, 0, 0, 236, 2201, 1, 0, 0, 0, 238, 2208, 1, 0,
+		0, 0, 240, 2213, 1, 0, 0, 0, 242, 2219, 1, 0, 0, 0, 244, 2225, 1, 0, 0,
+		0, 246, 2228, 1, 0, 0, 0, 248, 2235, 1, 0, 0, 0, 250, 2240, 1, 0, 0, 0,
+		252, 2245, 1, 0, 0, 0, 254, 2250, 1, 0, 0, 0, 256, 2258, 1, 0, 0, 0, 258,
+		2266, 1, 0, 0, 0, 260, 2272, 1, 0, 0, 0, 262, 2277, 1, 0, 0, 0, 264, 2286,
+		1, 0, 0, 0, 266, 2292, 1, 0, 0, 0, 268, 2300, 1, 0, 0, 0, 270, 2308, 1,
+		0, 0, 0, 272, 2314, 1, 0, 0, 0, 274, 2321, 1, 0, 0, 0, 276, 2330, 1, 0,
+		0, 0, 278, 2337, 1, 0, 0, 0, 280, 2344, 1, 0, 0, 0, 282, 2348, 1, 0, 0,
+		0, 284, 2354, 1, 0, 0, 0, 286, 2360, 1, 0, 0, 0, 288, 2370, 1, 0, 0, 0,
+		290, 2375, 1, 0, 0, 0, 292, 2381, 1, 0, 0, 0, 294, 2388, 1, 0, 0, 0, 296,
+		2398, 1, 0, 0, 0, 298, 2409, 1, 0, 0, 0, 300, 2412, 1, 0, 0, 0, 302, 2422,
+		1, 0, 0, 0, 304, 2431, 1, 0, 0, 0, 306, 2438, 1, 0, 0, 0, 308, 2444, 1,
+		0, 0, 0, 310, 2447, 1, 0, 0, 0, 312, 2453, 1, 0, 0, 0, 314, 2460, 1, 0,
+		0, 0, 316, 2468, 1, 0, 0, 0, 318, 2477, 1, 0, 0, 0, 320, 2485, 1, 0, 0,
+		0, 322, 2491, 1, 0, 0, 0, 324, 2507, 1, 0, 0, 0, 326, 2518, 1, 0, 0, 0,
+		328, 2524, 1, 0, 0, 0, 330, 2530, 1, 0, 0, 0, 332, 2538, 1, 0, 0, 0, 334,
+		2546, 1, 0, 0, 0, 336, 2555, 1, 0, 0, 0, 338, 2562, 1, 0, 0, 0, 340, 2572,
+		1, 0, 0, 0, 342, 2584, 1, 0, 0, 0, 344, 2598, 1, 0, 0, 0, 346, 2609, 1,
+		0, 0, 0, 348, 2621, 1, 0, 0, 0, 350, 2629, 1, 0, 0, 0, 352, 2638, 1, 0,
+		0, 0, 354, 2649, 1, 0, 0, 0, 356, 2654, 1, 0, 0, 0, 358, 2659, 1, 0, 0,
+		0, 360, 2663, 1, 0, 0, 0, 362, 2670, 1, 0, 0, 0, 364, 2676, 1, 0, 0, 0,
+		366, 2681, 1, 0, 0, 0, 368, 2690, 1, 0, 0, 0, 370, 2694, 1, 0, 0, 0, 372,
+		2705, 1, 0, 0, 0, 374, 2713, 1, 0, 0, 0, 376, 2722, 1, 0, 0, 0, 378, 2731,
+		1, 0, 0, 0, 380, 2739, 1, 0, 0, 0, 382, 2746, 1, 0, 0, 0, 384, 2756, 1,
+		0, 0, 0, 386, 2767, 1, 0, 0, 0, 388, 2778, 1, 0, 0, 0, 390, 2786, 1, 0,
+		0, 0, 392, 2794, 1, 0, 0, 0, 394, 2803, 1, 0, 0, 0, 396, 2810, 1, 0, 0,
+		0, 398, 2817, 1, 0, 0, 0, 400, 2822, 1, 0, 0, 0, 402, 2827, 1, 0, 0, 0,
+		404, 2833, 1, 0, 0, 0, 406, 2840, 1, 0, 0, 0, 408, 2849, 1, 0, 0, 0, 410,
+		2859, 1, 0, 0, 0, 412, 2864, 1, 0, 0, 0, 414, 2871, 1, 0, 0, 0, 416, 2877,
+		1, 0, 0, 0, 418, 2885, 1, 0, 0, 0, 420, 2895, 1, 0, 0, 0, 422, 2905, 1,
+		0, 0, 0, 424, 2913, 1, 0, 0, 0, 426, 2921, 1, 0, 0, 0, 428, 2931, 1, 0,
+		0, 0, 430, 2940, 1, 0, 0, 0, 432, 2947, 1, 0, 0, 0, 434, 2953, 1, 0, 0,
+		0, 436, 2963, 1, 0, 0, 0, 438, 2969, 1, 0, 0, 0, 440, 2977, 1, 0, 0, 0,
+		442, 2986, 1, 0, 0, 0, 444, 2996, 1, 0, 0, 0, 446, 3003, 1, 0, 0, 0, 448,
+		3011, 1, 0, 0, 0, 450, 3019, 1, 0, 0, 0, 452, 3026, 1, 0, 0, 0, 454, 3031,
+		1, 0, 0, 0, 456, 3036, 1, 0, 0, 0, 458, 3045, 1, 0, 0, 0, 460, 3048, 1,
+		0, 0, 0, 462, 3058, 1, 0, 0, 0, 464, 3068, 1, 0, 0, 0, 466, 3077, 1, 0,
+		0, 0, 468, 3087, 1, 0, 0, 0, 470, 3097, 1, 0, 0, 0, 472, 3103, 1, 0, 0,
+		0, 474, 3111, 1, 0, 0, 0, 476, 3119, 1, 0, 0, 0, 478, 3128, 1, 0, 0, 0,
+		480, 3135, 1, 0, 0, 0, 482, 3147, 1, 0, 0, 0, 484, 3154, 1, 0, 0, 0, 486,
+		3162, 1, 0, 0, 0, 488, 3170, 1, 0, 0, 0, 490, 3180, 1, 0, 0, 0, 492, 3185,
+		1, 0, 0, 0, 494, 3190, 1, 0, 0, 0, 496, 3194, 1, 0, 0, 0, 498, 3199, 1,
+		0, 0, 0, 500, 3205, 1, 0, 0, 0, 502, 3214, 1, 0, 0, 0, 504, 3220, 1, 0,
+		0, 0, 506, 3225, 1, 0, 0, 0, 508, 3235, 1, 0, 0, 0, 510, 3241, 1, 0, 0,
+		0, 512, 3248, 1, 0, 0, 0, 514, 3253, 1, 0, 0, 0, 516, 3259, 1, 0, 0, 0,
+		518, 3268, 1, 0, 0, 0, 520, 3273, 1, 0, 0, 0, 522, 3281, 1, 0, 0, 0, 524,
+		3287, 1, 0, 0, 0, 526, 3295, 1, 0, 0, 0, 528, 3308, 1, 0, 0, 0, 530, 3317,
+		1, 0, 0, 0, 532, 3323, 1, 0, 0, 0, 534, 3330, 1, 0, 0, 0, 536, 3339, 1,
+		0, 0, 0, 538, 3344, 1, 0, 0, 0, 540, 3350, 1, 0, 0, 0, 542, 3355, 1, 0,
+		0, 0, 544, 3360, 1, 0, 0, 0, 546, 3366, 1, 0, 0, 0, 548, 3373, 1, 0, 0,
+		0, 550, 3378, 1, 0, 0, 0, 552, 3381, 1, 0, 0, 0, 554, 3389, 1, 0, 0, 0,
+		556, 3396, 1, 0, 0, 0, 558, 3403, 1, 0, 0, 0, 560, 3409, 1, 0, 0, 0, 562,
+		3416, 1, 0, 0, 0, 564, 3419, 1, 0, 0, 0, 566, 3423, 1, 0, 0, 0, 568, 3428,
+		1, 0, 0, 0, 570, 3433, 1, 0, 0, 0, 572, 3442, 1, 0, 0, 0, 574, 3449, 1,
+		0, 0, 0, 576, 3457, 1, 0, 0, 0, 578, 3463, 1, 0, 0, 0, 580, 3469, 1, 0,
+		0, 0, 582, 3476, 1, 0, 0, 0, 584, 3484, 1, 0, 0, 0, 586, 3494, 1, 0, 0,
+		0, 588, 3502, 1, 0, 0, 0, 590, 3511, 1, 0, 0, 0, 592, 3516, 1, 0, 0, 0,
+		594, 3522, 1, 0, 0, 0, 596, 3532, 1, 0, 0, 0, 598, 3540, 1, 0, 0, 0, 600,
+		3549, 1, 0, 0, 0, 602, 3558, 1, 0, 0, 0, 604, 3564, 1, 0, 0, 0, 606, 3575,
+		1, 0, 0, 0, 608, 3586, 1, 0, 0, 0, 610, 3596, 1, 0, 0, 0, 612, 3604, 1,
+		0, 0, 0, 614, 3610, 1, 0, 0, 0, 616, 3617, 1, 0, 0, 0, 618, 3623, 1, 0,
+		0, 0, 620, 3628, 1, 0, 0, 0, 622, 3637, 1, 0, 0, 0, 624, 3645, 1, 0, 0,
+		0, 626, 3655, 1, 0, 0, 0, 628, 3659, 1, 0, 0, 0, 630, 3667, 1, 0, 0, 0,
+		632, 3675, 1, 0, 0, 0, 634, 3684, 1, 0, 0, 0, 636, 3692, 1, 0, 0, 0, 638,
+		3699, 1, 0, 0, 0, 640, 3710, 1, 0, 0, 0, 642, 3718, 1, 0, 0, 0, 644, 3726,
+		1, 0, 0, 0, 646, 3732, 1, 0, 0, 0, 648, 3740, 1, 0, 0, 0, 650, 3749, 1,
+		0, 0, 0, 652, 3757, 1, 0, 0, 0, 654, 3764, 1, 0, 0, 0, 656, 3769, 1, 0,
+		0, 0, 658, 3778, 1, 0, 0, 0, 660, 3783, 1, 0, 0, 0, 662, 3788, 1, 0, 0,
+		0, 664, 3798, 1, 0, 0, 0, 666, 3805, 1, 0, 0, 0, 668, 3812, 1, 0, 0, 0,
+		670, 3819, 1, 0, 0, 0, 672, 3826, 1, 0, 0, 0, 674, 3833, 1, 0, 0, 0, 676,
+		3842, 1, 0, 0, 0, 678, 3851, 1, 0, 0, 0, 680, 3861, 1, 0, 0, 0, 682, 3874,
+		1, 0, 0, 0, 684, 3881, 1, 0, 0, 0, 686, 3889, 1, 0, 0, 0, 688, 3893, 1,
+		0, 0, 0, 690, 3899, 1, 0, 0, 0, 692, 3904, 1, 0, 0, 0, 694, 3911, 1, 0,
+		0, 0, 696, 3920, 1, 0, 0, 0, 698, 3927, 1, 0, 0, 0, 700, 3934, 1, 0, 0,
+		0, 702, 3945, 1, 0, 0, 0, 704, 3951, 1, 0, 0, 0, 706, 3961, 1, 0, 0, 0,
+		708, 3972, 1, 0, 0, 0, 710, 3978, 1, 0, 0, 0, 712, 3985, 1, 0, 0, 0, 714,
+		3993, 1, 0, 0, 0, 716, 4000, 1, 0, 0, 0, 718, 4007, 1, 0, 0, 0, 720, 4013,
+		1, 0, 0, 0, 722, 4019, 1, 0, 0, 0, 724, 4026, 1, 0, 0, 0, 726, 4033, 1,
+		0, 0, 0, 728, 4044, 1, 0, 0, 0, 730, 4051, 1, 0, 0, 0, 732, 4056, 1, 0,
+		0, 0, 734, 4065, 1, 0, 0, 0, 736, 4075, 1, 0, 0, 0, 738, 4080, 1, 0, 0,
+		0, 740, 4092, 1, 0, 0, 0, 742, 4100, 1, 0, 0, 0, 744, 4109, 1, 0, 0, 0,
+		746, 4117, 1, 0, 0, 0, 748, 4122, 1, 0, 0, 0, 750, 4128, 1, 0, 0, 0, 752,
+		4138, 1, 0, 0, 0, 754, 4150, 1, 0, 0, 0, 756, 4164, 1, 0, 0, 0, 758, 4176,
+		1, 0, 0, 0, 760, 4184, 1, 0, 0, 0, 762, 4193, 1, 0, 0, 0, 764, 4202, 1,
+		0, 0, 0, 766, 4208, 1, 0, 0, 0, 768, 4215, 1, 0, 0, 0, 770, 4222, 1, 0,
+		0, 0, 772, 4228, 1, 0, 0, 0, 774, 4237, 1, 0, 0, 0, 776, 4247, 1, 0, 0,
+		0, 778, 4255, 1, 0, 0, 0, 780, 4263, 1, 0, 0, 0, 782, 4268, 1, 0, 0, 0,
+		784, 4277, 1, 0, 0, 0, 786, 4288, 1, 0, 0, 0, 788, 4296, 1, 0, 0, 0, 790,
+		4301, 1, 0, 0, 0, 792, 4309, 1, 0, 0, 0, 794, 4315, 1, 0, 0, 0, 796, 4319,
+		1, 0, 0, 0, 798, 4324, 1, 0, 0, 0, 800, 4328, 1, 0, 0, 0, 802, 4333, 1,
+		0, 0, 0, 804, 4340, 1, 0, 0, 0, 806, 4348, 1, 0, 0, 0, 808, 4355, 1, 0,
+		0, 0, 810, 4359, 1, 0, 0, 0, 812, 4367, 1, 0, 0, 0, 814, 4372, 1, 0, 0,
+		0, 816, 4382, 1, 0, 0, 0, 818, 4391, 1, 0, 0, 0, 820, 4395, 1, 0, 0, 0,
+		822, 4403, 1, 0, 0, 0, 824, 4410, 1, 0, 0, 0, 826, 4418, 1, 0, 0, 0, 828,
+		4424, 1, 0, 0, 0, 830, 4433, 1, 0, 0, 0, 832, 4439, 1, 0, 0, 0, 834, 4443,
+		1, 0, 0, 0, 836, 4451, 1, 0, 0, 0, 838, 4460, 1, 0, 0, 0, 840, 4471, 1,
+		0, 0, 0, 842, 4485, 1, 0, 0, 0, 844, 4497, 1, 0, 0, 0, 846, 4509, 1, 0,
+		0, 0, 848, 4524, 1, 0, 0, 0, 850, 4535, 1, 0, 0, 0, 852, 4547, 1, 0, 0,
+		0, 854, 4562, 1, 0, 0, 0, 856, 4573, 1, 0, 0, 0, 858, 4584, 1, 0, 0, 0,
+		860, 4590, 1, 0, 0, 0, 862, 4599, 1, 0, 0, 0, 864, 4605, 1, 0, 0, 0, 866,
+		4610, 1, 0, 0, 0, 868, 4617, 1, 0, 0, 0, 870, 4625, 1, 0, 0, 0, 872, 4633,
+		1, 0, 0, 0, 874, 4643, 1, 0, 0, 0, 876, 4652, 1, 0, 0, 0, 878, 4662, 1,
+		0, 0, 0, 880, 4667, 1, 0, 0, 0, 882, 4671, 1, 0, 0, 0, 884, 4677, 1, 0,
+		0, 0, 886, 4686, 1, 0, 0, 0, 888, 4696, 1, 0, 0, 0, 890, 4701, 1, 0, 0,
+		0, 892, 4711, 1, 0, 0, 0, 894, 4717, 1, 0, 0, 0, 896, 4722, 1, 0, 0, 0,
+		898, 4729, 1, 0, 0, 0, 900, 4737, 1, 0, 0, 0, 902, 4751, 1, 0, 0, 0, 904,
+		4762, 1, 0, 0, 0, 906, 4769, 1, 0, 0, 0, 908, 4788, 1, 0, 0, 0, 910, 4816,
+		1, 0, 0, 0, 912, 4843, 1, 0, 0, 0, 914, 4849, 1, 0, 0, 0, 916, 4862, 1,
+		0, 0, 0, 918, 4872, 1, 0, 0, 0, 920, 4883, 1, 0, 0, 0, 922, 4893, 1, 0,
+		0, 0, 924, 4903, 1, 0, 0, 0, 926, 4912, 1, 0, 0, 0, 928, 4918, 1, 0, 0,
+		0, 930, 4926, 1, 0, 0, 0, 932, 4939, 1, 0, 0, 0, 934, 4944, 1, 0, 0, 0,
+		936, 4952, 1, 0, 0, 0, 938, 4959, 1, 0, 0, 0, 940, 4966, 1, 0, 0, 0, 942,
+		4977, 1, 0, 0, 0, 944, 4987, 1, 0, 0, 0, 946, 4994, 1, 0, 0, 0, 948, 5001,
+		1, 0, 0, 0, 950, 5009, 1, 0, 0, 0, 952, 5017, 1, 0, 0, 0, 954, 5027, 1,
+		0, 0, 0, 956, 5034, 1, 0, 0, 0, 958, 5041, 1, 0, 0, 0, 960, 5048, 1, 0,
+		0, 0, 962, 5060, 1, 0, 0, 0, 964, 5064, 1, 0, 0, 0, 966, 5068, 1, 0, 0,
+		0, 968, 5074, 1, 0, 0, 0, 970, 5087, 1, 0, 0, 0, 972, 5099, 1, 0, 0, 0,
+		974, 5103, 1, 0, 0, 0, 976, 5107, 1, 0, 0, 0, 978, 5116, 1, 0, 0, 0, 980,
+		5124, 1, 0, 0, 0, 982, 5135, 1, 0, 0, 0, 984, 5141, 1, 0, 0, 0, 986, 5149,
+		1, 0, 0, 0, 988, 5158, 1, 0, 0, 0, 990, 5162, 1, 0, 0, 0, 992, 5170, 1,
+		0, 0, 0, 994, 5181, 1, 0, 0, 0, 996, 5190, 1, 0, 0, 0, 998, 5195, 1, 0,
+		0, 0, 1000, 5202, 1, 0, 0, 0, 1002, 5207, 1, 0, 0, 0, 1004, 5214, 1, 0,
+		0, 0, 1006, 5219, 1, 0, 0, 0, 1008, 5228, 1, 0, 0, 0, 1010, 5233, 1, 0,
+		0, 0, 1012, 5245, 1, 0, 0, 0, 1014, 5256, 1, 0, 0, 0, 1016, 5265, 1, 0,
+		0, 0, 1018, 5273, 1, 0, 0, 0, 1020, 5287, 1, 0, 0, 0, 1022, 5295, 1, 0,
+		0, 0, 1024, 5306, 1, 0, 0, 0, 1026, 5313, 1, 0, 0, 0, 1028, 5320, 1, 0,
+		0, 0, 1030, 5327, 1, 0, 0, 0, 1032, 5334, 1, 0, 0, 0, 1034, 5338, 1, 0,
+		0, 0, 1036, 5342, 1, 0, 0, 0, 1038, 5347, 1, 0, 0, 0, 1040, 5352, 1, 0,
+		0, 0, 1042, 5360, 1, 0, 0, 0, 1044, 5366, 1, 0, 0, 0, 1046, 5376, 1, 0,
+		0, 0, 1048, 5381, 1, 0, 0, 0, 1050, 5401, 1, 0, 0, 0, 1052, 5419, 1, 0,
+		0, 0, 1054, 5425, 1, 0, 0, 0, 1056, 5438, 1, 0, 0, 0, 1058, 5449, 1, 0,
+		0, 0, 1060, 5455, 1, 0, 0, 0, 1062, 5464, 1, 0, 0, 0, 1064, 5472, 1, 0,
+		0, 0, 1066, 5476, 1, 0, 0, 0, 1068, 5488, 1, 0, 0, 0, 1070, 5496, 1, 0,
+		0, 0, 1072, 5502, 1, 0, 0, 0, 1074, 5508, 1, 0, 0, 0, 1076, 5516, 1, 0,
+		0, 0, 1078, 5524, 1, 0, 0, 0, 1080, 5530, 1, 0, 0, 0, 1082, 5535, 1, 0,
+		0, 0, 1084, 5542, 1, 0, 0, 0, 1086, 5548, 1, 0, 0, 0, 1088, 5554, 1, 0,
+		0, 0, 1090, 5563, 1, 0, 0, 0, 1092, 5569, 1, 0, 0, 0, 1094, 5573, 1, 0,
+		0, 0, 1096, 5578, 1, 0, 0, 0, 1098, 5585, 1, 0, 0, 0, 1100, 5593, 1, 0,
+		0, 0, 1102, 5603, 1, 0, 0, 0, 1104, 5610, 1, 0, 0, 0, 1106, 5615, 1, 0,
+		0, 0, 1108, 5620, 1, 0, 0, 0, 1110, 5624, 1, 0, 0, 0, 1112, 5629, 1, 0,
+		0, 0, 1114, 5634, 1, 0, 0, 0, 1116, 5642, 1, 0, 0, 0, 1118, 5650, 1, 0,
+		0, 0, 1120, 5654, 1, 0, 0, 0, 1122, 5658, 1, 0, 0, 0, 1124, 5668, 1, 0,
+		0, 0, 1126, 5674, 1, 0, 0, 0, 1128, 5678, 1, 0, 0, 0, 1130, 5682, 1, 0,
+		0, 0, 1132, 5685, 1, 0, 0, 0, 1134, 5691, 1, 0, 0, 0, 1136, 5701, 1, 0,
+		0, 0, 1138, 5705, 1, 0, 0, 0, 1140, 5708, 1, 0, 0, 0, 1142, 5714, 1, 0,
+		0, 0, 1144, 5722, 1, 0, 0, 0, 1146, 5728, 1, 0, 0, 0, 1148, 5734, 1, 0,
+		0, 0, 1150, 5739, 1, 0, 0, 0, 1152, 5744, 1, 0, 0, 0, 1154, 5755, 1, 0,
+		0, 0, 1156, 5761, 1, 0, 0, 0, 1158, 5774, 1, 0, 0, 0, 1160, 5781, 1, 0,
+		0, 0, 1162, 5789, 1, 0, 0, 0, 1164, 5794, 1, 0, 0, 0, 1166, 5800, 1, 0,
+		0, 0, 1168, 5805, 1, 0, 0, 0, 1170, 5811, 1, 0, 0, 0, 1172, 5816, 1, 0,
+		0, 0, 1174, 5822, 1, 0, 0, 0, 1176, 5828, 1, 0, 0, 0, 1178, 5835, 1, 0,
+		0, 0, 1180, 5839, 1, 0, 0, 0, 1182, 5844, 1, 0, 0, 0, 1184, 5848, 1, 0,
+		0, 0, 1186, 5853, 1, 0, 0, 0, 1188, 5857, 1, 0, 0, 0, 1190, 5862, 1, 0,
+		0, 0, 1192, 5866, 1, 0, 0, 0, 1194, 5871, 1, 0, 0, 0, 1196, 5876, 1, 0,
+		0, 0, 1198, 5881, 1, 0, 0, 0, 1200, 5886, 1, 0, 0, 0, 1202, 5892, 1, 0,
+		0, 0, 1204, 5898, 1, 0, 0, 0, 1206, 5904, 1, 0, 0, 0, 1208, 5915, 1, 0,
+		0, 0, 1210, 5927, 1, 0, 0, 0, 1212, 5944, 1, 0, 0, 0, 1214, 5950, 1, 0,
+		0, 0, 1216, 5963, 1, 0, 0, 0, 1218, 5969, 1, 0, 0, 0, 1220, 5975, 1, 0,
+		0, 0, 1222, 5981, 1, 0, 0, 0, 1224, 5985, 1, 0, 0, 0, 1226, 5992, 1, 0,
+		0, 0, 1228, 6002, 1, 0, 0, 0, 1230, 6009, 1, 0, 0, 0, 1232, 6017, 1, 0,
+		0, 0, 1234, 6024, 1, 0, 0, 0, 1236, 6029, 1, 0, 0, 0, 1238, 6035, 1, 0,
+		0, 0, 1240, 6039, 1, 0, 0, 0, 1242, 6051, 1, 0, 0, 0, 1244, 6070, 1, 0,
+		0, 0, 1246, 6082, 1, 0, 0, 0, 1248, 6096, 1, 0, 0, 0, 1250, 6111, 1, 0,
+		0, 0, 1252, 6124, 1, 0, 0, 0, 1254, 6137, 1, 0, 0, 0, 1256, 6149, 1, 0,
+		0, 0, 1258, 6162, 1, 0, 0, 0, 1260, 6177, 1, 0, 0, 0, 1262, 6192, 1, 0,
+		0, 0, 1264, 6214, 1, 0, 0, 0, 1266, 6236, 1, 0, 0, 0, 1268, 6250, 1, 0,
+		0, 0, 1270, 6257, 1, 0, 0, 0, 1272, 6262, 1, 0, 0, 0, 1274, 6268, 1, 0,
+		0, 0, 1276, 6279, 1, 0, 0, 0, 1278, 6291, 1, 0, 0, 0, 1280, 6307, 1, 0,
+		0, 0, 1282, 6323, 1, 0, 0, 0, 1284, 6330, 1, 0, 0, 0, 1286, 6337, 1, 0,
+		0, 0, 1288, 6346, 1, 0, 0, 0, 1290, 6353, 1, 0, 0, 0, 1292, 6363, 1, 0,
+		0, 0, 1294, 6370, 1, 0, 0, 0, 1296, 6374, 1, 0, 0, 0, 1298, 6390, 1, 0,
+		0, 0, 1300, 6399, 1, 0, 0, 0, 1302, 6409, 1, 0, 0, 0, 1304, 6420, 1, 0,
+		0, 0, 1306, 6429, 1, 0, 0, 0, 1308, 6442, 1, 0, 0, 0, 1310, 6456, 1, 0,
+		0, 0, 1312, 6473, 1, 0, 0, 0, 1314, 6483, 1, 0, 0, 0, 1316, 6497, 1, 0,
+		0, 0, 1318, 6507, 1, 0, 0, 0, 1320, 6522, 1, 0, 0, 0, 1322, 6539, 1, 0,
+		0, 0, 1324, 6543, 1, 0, 0, 0, 1326, 6563, 1, 0, 0, 0, 1328, 6573, 1, 0,
+		0, 0, 1330, 6595, 1, 0, 0, 0, 1332, 6608, 1, 0, 0, 0, 1334, 6616, 1, 0,
+		0, 0, 1336, 6624, 1, 0, 0, 0, 1338, 6634, 1, 0, 0, 0, 1340, 6641, 1, 0,
+		0, 0, 1342, 6649, 1, 0, 0, 0, 1344, 6657, 1, 0, 0, 0, 1346, 6672, 1, 0,
+		0, 0, 1348, 6689, 1, 0, 0, 0, 1350, 6702, 1, 0, 0, 0, 1352, 6706, 1, 0,
+		0, 0, 1354, 6710, 1, 0, 0, 0, 1356, 6712, 1, 0, 0, 0, 1358, 6715, 1, 0,
+		0, 0, 1360, 6724, 1, 0, 0, 0, 1362, 6727, 1, 0, 0, 0, 1364, 6736, 1, 0,
+		0, 0, 1366, 6740, 1, 0, 0, 0, 1368, 6744, 1, 0, 0, 0, 1370, 6748, 1, 0,
+		0, 0, 1372, 6752, 1, 0, 0, 0, 1374, 6755, 1, 0, 0, 0, 1376, 6764, 1, 0,
+		0, 0, 1378, 6770, 1, 0, 0, 0, 1380, 6773, 1, 0, 0, 0, 1382, 6777, 1, 0,
+		0, 0, 1384, 6786, 1, 0, 0, 0, 1386, 6793, 1, 0, 0, 0, 1388, 6796, 1, 0,
+		0, 0, 1390, 6804, 1, 0, 0, 0, 1392, 6807, 1, 0, 0, 0, 1394, 6810, 1, 0,
+		0, 0, 1396, 6813, 1, 0, 0, 0, 1398, 6821, 1, 0, 0, 0, 1400, 6824, 1, 0,
+		0, 0, 1402, 6827, 1, 0, 0, 0, 1404, 6829, 1, 0, 0, 0, 1406, 6863, 1, 0,
+		0, 0, 1408, 6866, 1, 0, 0, 0, 1410, 6870, 1, 0, 0, 0, 1412, 6878, 1, 0,
+		0, 0, 1414, 6893, 1, 0, 0, 0, 1416, 6902, 1, 0, 0, 0, 1418, 6906, 1, 0,
+		0, 0, 1420, 6917, 1, 0, 0, 0, 1422, 6956, 1, 0, 0, 0, 1424, 7007, 1, 0,
+		0, 0, 1426, 7031, 1, 0, 0, 0, 1428, 7034, 1, 0, 0, 0, 1430, 7036, 1, 0,
+		0, 0, 1432, 7041, 1, 0, 0, 0, 1434, 7072, 1, 0, 0, 0, 1436, 7075, 1, 0,
+		0, 0, 1438, 7080, 1, 0, 0, 0, 1440, 7093, 1, 0, 0, 0, 1442, 7096, 1, 0,
+		0, 0, 1444, 7101, 1, 0, 0, 0, 1446, 7107, 1, 0, 0, 0, 1448, 7112, 1, 0,
+		0, 0, 1450, 7117, 1, 0, 0, 0, 1452, 7122, 1, 0, 0, 0, 1454, 7127, 1, 0,
+		0, 0, 1456, 7144, 1, 0, 0, 0, 1458, 7146, 1, 0, 0, 0, 1460, 7172, 1, 0,
+		0, 0, 1462, 1463, 5, 36, 0, 0, 1463, 7, 1, 0, 0, 0, 1464, 1465, 5, 40,
+		0, 0, 1465, 9, 1, 0, 0, 0, 1466, 1467, 5, 41, 0, 0, 1467, 11, 1, 0, 0,
+		0, 1468, 1469, 5, 91, 0, 0, 1469, 13, 1, 0, 0, 0, 1470, 1471, 5, 93, 0,
+		0, 1471, 15, 1, 0, 0, 0, 1472, 1473, 5, 44, 0, 0, 1473, 17, 1, 0, 0, 0,
+		1474, 1475, 5, 59, 0, 0, 1475, 19, 1, 0, 0, 0, 1476, 1477, 5, 58, 0, 0,
+		1477, 21, 1, 0, 0, 0, 1478, 1479, 5, 42, 0, 0, 1479, 23, 1, 0, 0, 0, 1480,
+		1481, 5, 61, 0, 0, 1481, 25, 1, 0, 0, 0, 1482, 1483, 5, 46, 0, 0, 1483,
+		27, 1, 0, 0, 0, 1484, 1485, 5, 43, 0, 0, 1485, 29, 1, 0, 0, 0, 1486, 1487,
+		5, 45, 0, 0, 1487, 31, 1, 0, 0, 0, 1488, 1489, 5, 47, 0, 0, 1489, 33, 1,
+		0, 0, 0, 1490, 1491, 5, 94, 0, 0, 1491, 35, 1, 0, 0, 0, 1492, 1493, 5,
+		60, 0, 0, 1493, 37, 1, 0, 0, 0, 1494, 1495, 5, 62, 0, 0, 1495, 39, 1, 0,
+		0, 0, 1496, 1497, 5, 60, 0, 0, 1497, 1498, 5, 60, 0, 0, 1498, 41, 1, 0,
+		0, 0, 1499, 1500, 5, 62, 0, 0, 1500, 1501, 5, 62, 0, 0, 1501, 43, 1, 0,
+		0, 0, 1502, 1503, 5, 58, 0, 0, 1503, 1504, 5, 61, 0, 0, 1504, 45, 1, 0,
+		0, 0, 1505, 1506, 5, 60, 0, 0, 1506, 1507, 5, 61, 0, 0, 1507, 47, 1, 0,
+		0, 0, 1508, 1509, 5, 61, 0, 0, 1509, 1510, 5, 62, 0, 0, 1510, 49, 1, 0,
+		0, 0, 1511, 1512, 5, 62, 0, 0, 1512, 1513, 5, 61, 0, 0, 1513, 51, 1, 0,
+		0, 0, 1514, 1515, 5, 46, 0, 0, 1515, 1516, 5, 46, 0, 0, 1516, 53, 1, 0,
+		0, 0, 1517, 1518, 5, 60, 0, 0, 1518, 1519, 5, 62, 0, 0, 1519, 55, 1, 0,
+		0, 0, 1520, 1521, 5, 58, 0, 0, 1521, 1522, 5, 58, 0, 0, 1522, 57, 1, 0,
+		0, 0, 1523, 1524, 5, 37, 0, 0, 1524, 59, 1, 0, 0, 0, 1525, 1527, 5, 36,
+		0, 0, 1526, 1528, 7, 0, 0, 0, 1527, 1526, 1, 0, 0, 0, 1528, 1529, 1, 0,
+		0, 0, 1529, 1527, 1, 0, 0, 0, 1529, 1530, 1, 0, 0, 0, 1530, 61, 1, 0, 0,
+		0, 1531, 1547, 3, 66, 30, 0, 1532, 1536, 5, 43, 0, 0, 1533, 1534, 5, 45,
+		0, 0, 1534, 1536, 4, 28, 0, 0, 1535, 1532, 1, 0, 0, 0, 1535, 1533, 1, 0,
+		0, 0, 1536, 1537, 1, 0, 0, 0, 1537, 1535, 1, 0, 0, 0, 1537, 1538, 1, 0,
+		0, 0, 1538, 1542, 1, 0, 0, 0, 1539, 1543, 3, 66, 30, 0, 1540, 1541, 5,
+		47, 0, 0, 1541, 1543, 4, 28, 1, 0, 1542, 1539, 1, 0, 0, 0, 1542, 1540,
+		1, 0, 0, 0, 1543, 1547, 1, 0, 0, 0, 1544, 1545, 5, 47, 0, 0, 1545, 1547,
+		4, 28, 2, 0, 1546, 1531, 1, 0, 0, 0, 1546, 1535, 1, 0, 0, 0, 1546, 1544,
+		1, 0, 0, 0, 1547, 1548, 1, 0, 0, 0, 1548, 1546, 1, 0, 0, 0, 1548, 1549,
+		1, 0, 0, 0, 1549, 1552, 1, 0, 0, 0, 1550, 1552, 7, 1, 0, 0, 1551, 1546,
+		1, 0, 0, 0, 1551, 1550, 1, 0, 0, 0, 1552, 1553, 1, 0, 0, 0, 1553, 1554,
+		6, 28, 0, 0, 1554, 63, 1, 0, 0, 0, 1555, 1561, 3, 68, 31, 0, 1556, 1557,
+		5, 45, 0, 0, 1557, 1561, 4, 29, 3, 0, 1558, 1559, 5, 47, 0, 0, 1559, 1561,
+		4, 29, 4, 0, 1560, 1555, 1, 0, 0, 0, 1560, 1556, 1, 0, 0, 0, 1560, 1558,
+		1, 0, 0, 0, 1561, 1564, 1, 0, 0, 0, 1562, 1560, 1, 0, 0, 0, 1562, 1563,
+		1, 0, 0, 0, 1563, 1565, 1, 0, 0, 0, 1564, 1562, 1, 0, 0, 0, 1565, 1567,
+		3, 70, 32, 0, 1566, 1568, 3, 62, 28, 0, 1567, 1566, 1, 0, 0, 0, 1567, 1568,
+		1, 0, 0, 0, 1568, 1572, 1, 0, 0, 0, 1569, 1573, 5, 43, 0, 0, 1570, 1571,
+		5, 45, 0, 0, 1571, 1573, 4, 29, 5, 0, 1572, 1569, 1, 0, 0, 0, 1572, 1570,
+		1, 0, 0, 0, 1573, 1574, 1, 0, 0, 0, 1574, 1572, 1, 0, 0, 0, 1574, 1575,
+		1, 0, 0, 0, 1575, 1576, 1, 0, 0, 0, 1576, 1577, 6, 29, 1, 0, 1577, 65,
+		1, 0, 0, 0, 1578, 1579, 7, 2, 0, 0, 1579, 67, 1, 0, 0, 0, 1580, 1581, 7,
+		3, 0, 0, 1581, 69, 1, 0, 0, 0, 1582, 1583, 7, 4, 0, 0, 1583, 71, 1, 0,
+		0, 0, 1584, 1585, 7, 5, 0, 0, 1585, 1586, 7, 6, 0, 0, 1586, 1587, 7, 6,
+		0, 0, 1587, 73, 1, 0, 0, 0, 1588, 1589, 7, 5, 0, 0, 1589, 1590, 7, 7, 0,
+		0, 1590, 1591, 7, 5, 0, 0, 1591, 1592, 7, 6, 0, 0, 1592, 1593, 7, 8, 0,
+		0, 1593, 1594, 7, 9, 0, 0, 1594, 1595, 7, 10, 0, 0, 1595, 75, 1, 0, 0,
+		0, 1596, 1597, 7, 5, 0, 0, 1597, 1598, 7, 7, 0, 0, 1598, 1599, 7, 5, 0,
+		0, 1599, 1600, 7, 6, 0, 0, 1600, 1601, 7, 8, 0, 0, 1601, 1602, 7, 11, 0,
+		0, 1602, 1603, 7, 10, 0, 0, 1603, 77, 1, 0, 0, 0, 1604, 1605, 7, 5, 0,
+		0, 1605, 1606, 7, 7, 0, 0, 1606, 1607, 7, 12, 0, 0, 1607, 79, 1, 0, 0,
+		0, 1608, 1609, 7, 5, 0, 0, 1609, 1610, 7, 7, 0, 0, 1610, 1611, 7, 8, 0,
+		0, 1611, 81, 1, 0, 0, 0, 1612, 1613, 7, 5, 0, 0, 1613, 1614, 7, 13, 0,
+		0, 1614, 1615, 7, 13, 0, 0, 1615, 1616, 7, 5, 0, 0, 1616, 1617, 7, 8, 0,
+		0, 1617, 83, 1, 0, 0, 0, 1618, 1619, 7, 5, 0, 0, 1619, 1620, 7, 9, 0, 0,
+		1620, 85, 1, 0, 0, 0, 1621, 1622, 7, 5, 0, 0, 1622, 1623, 7, 9, 0, 0, 1623,
+		1624, 7, 14, 0, 0, 1624, 87, 1, 0, 0, 0, 1625, 1626, 7, 5, 0, 0, 1626,
+		1627, 7, 9, 0, 0, 1627, 1628, 7, 8, 0, 0, 1628, 1629, 7, 15, 0, 0, 1629,
+		1630, 7, 15, 0, 0, 1630, 1631, 7, 10, 0, 0, 1631, 1632, 7, 16, 0, 0, 1632,
+		1633, 7, 13, 0, 0, 1633, 1634, 7, 17, 0, 0, 1634, 1635, 7, 14, 0, 0, 1635,
+		89, 1, 0, 0, 0, 1636, 1637, 7, 18, 0, 0, 1637, 1638, 7, 19, 0, 0, 1638,
+		1639, 7, 16, 0, 0, 1639, 1640, 7, 20, 0, 0, 1640, 91, 1, 0, 0, 0, 1641,
+		1642, 7, 14, 0, 0, 1642, 1643, 7, 5, 0, 0, 1643, 1644, 7, 9, 0, 0, 1644,
+		1645, 7, 10, 0, 0, 1645, 93, 1, 0, 0, 0, 1646, 1647, 7, 14, 0, 0, 1647,
+		1648, 7, 5, 0, 0, 1648, 1649, 7, 9, 0, 0, 1649, 1650, 7, 16, 0, 0, 1650,
+		95, 1, 0, 0, 0, 1651, 1652, 7, 14, 0, 0, 1652, 1653, 7, 20, 0, 0, 1653,
+		1654, 7, 10, 0, 0, 1654, 1655, 7, 14, 0, 0, 1655, 1656, 7, 21, 0, 0, 1656,
+		97, 1, 0, 0, 0, 1657, 1658, 7, 14, 0, 0, 1658, 1659, 7, 19, 0, 0, 1659,
+		1660, 7, 6, 0, 0, 1660, 1661, 7, 6, 0, 0, 1661, 1662, 7, 5, 0, 0, 1662,
+		1663, 7, 16, 0, 0, 1663, 1664, 7, 10, 0, 0, 1664, 99, 1, 0, 0, 0, 1665,
+		1666, 7, 14, 0, 0, 1666, 1667, 7, 19, 0, 0, 1667, 1668, 7, 6, 0, 0, 1668,
+		1669, 7, 22, 0, 0, 1669, 1670, 7, 15, 0, 0, 1670, 1671, 7, 7, 0, 0, 1671,
+		101, 1, 0, 0, 0, 1672, 1673, 7, 14, 0, 0, 1673, 1674, 7, 19, 0, 0, 1674,
+		1675, 7, 7, 0, 0, 1675, 1676, 7, 9, 0, 0, 1676, 1677, 7, 16, 0, 0, 1677,
+		1678, 7, 13, 0, 0, 1678, 1679, 7, 5, 0, 0, 1679, 1680, 7, 17, 0, 0, 1680,
+		1681, 7, 7, 0, 0, 1681, 1682, 7, 16, 0, 0, 1682, 103, 1, 0, 0, 0, 1683,
+		1684, 7, 14, 0, 0, 1684, 1685, 7, 13, 0, 0, 1685, 1686, 7, 10, 0, 0, 1686,
+		1687, 7, 5, 0, 0, 1687, 1688, 7, 16, 0, 0, 1688, 1689, 7, 10, 0, 0, 1689,
+		105, 1, 0, 0, 0, 1690, 1691, 7, 14, 0, 0, 1691, 1692, 7, 22, 0, 0, 1692,
+		1693, 7, 13, 0, 0, 1693, 1694, 7, 13, 0, 0, 1694, 1695, 7, 10, 0, 0, 1695,
+		1696, 7, 7, 0, 0, 1696, 1697, 7, 16, 0, 0, 1697, 1698, 5, 95, 0, 0, 1698,
+		1699, 7, 14, 0, 0, 1699, 1700, 7, 5, 0, 0, 1700, 1701, 7, 16, 0, 0, 1701,
+		1702, 7, 5, 0, 0, 1702, 1703, 7, 6, 0, 0, 1703, 1704, 7, 19, 0, 0, 1704,
+		1705, 7, 23, 0, 0, 1705, 107, 1, 0, 0, 0, 1706, 1707, 7, 14, 0, 0, 1707,
+		1708, 7, 22, 0, 0, 1708, 1709, 7, 13, 0, 0, 1709, 1710, 7, 13, 0, 0, 1710,
+		1711, 7, 10, 0, 0, 1711, 1712, 7, 7, 0, 0, 1712, 1713, 7, 16, 0, 0, 1713,
+		1714, 5, 95, 0, 0, 1714, 1715, 7, 12, 0, 0, 1715, 1716, 7, 5, 0, 0, 1716,
+		1717, 7, 16, 0, 0, 1717, 1718, 7, 10, 0, 0, 1718, 109, 1, 0, 0, 0, 1719,
+		1720, 7, 14, 0, 0, 1720, 1721, 7, 22, 0, 0, 1721, 1722, 7, 13, 0, 0, 1722,
+		1723, 7, 13, 0, 0, 1723, 1724, 7, 10, 0, 0, 1724, 1725, 7, 7, 0, 0, 1725,
+		1726, 7, 16, 0, 0, 1726, 1727, 5, 95, 0, 0, 1727, 1728, 7, 13, 0, 0, 1728,
+		1729, 7, 19, 0, 0, 1729, 1730, 7, 6, 0, 0, 1730, 1731, 7, 10, 0, 0, 1731,
+		111, 1, 0, 0, 0, 1732, 1733, 7, 14, 0, 0, 1733, 1734, 7, 22, 0, 0, 1734,
+		1735, 7, 13, 0, 0, 1735, 1736, 7, 13, 0, 0, 1736, 1737, 7, 10, 0, 0, 1737,
+		1738, 7, 7, 0, 0, 1738, 1739, 7, 16, 0, 0, 1739, 1740, 5, 95, 0, 0, 1740,
+		1741, 7, 16, 0, 0, 1741, 1742, 7, 17, 0, 0, 1742, 1743, 7, 15, 0, 0, 1743,
+		1744, 7, 10, 0, 0, 1744, 113, 1, 0, 0, 0, 1745, 1746, 7, 14, 0, 0, 1746,
+		1747, 7, 22, 0, 0, 1747, 1748, 7, 13, 0, 0, 1748, 1749, 7, 13, 0, 0, 1749,
+		1750, 7, 10, 0, 0, 1750, 1751, 7, 7, 0, 0, 1751, 1752, 7, 16, 0, 0, 1752,
+		1753, 5, 95, 0, 0, 1753, 1754, 7, 16, 0, 0, 1754, 1755, 7, 17, 0, 0, 1755,
+		1756, 7, 15, 0, 0, 1756, 1757, 7, 10, 0, 0, 1757, 1758, 7, 9, 0, 0, 1758,
+		1759, 7, 16, 0, 0, 1759, 1760, 7, 5, 0, 0, 1760, 1761, 7, 15, 0, 0, 1761,
+		1762, 7, 24, 0, 0, 1762, 115, 1, 0, 0, 0, 1763, 1764, 7, 14, 0, 0, 1764,
+		1765, 7, 22, 0, 0, 1765, 1766, 7, 13, 0, 0, 1766, 1767, 7, 13, 0, 0, 1767,
+		1768, 7, 10, 0, 0, 1768, 1769, 7, 7, 0, 0, 1769, 1770, 7, 16, 0, 0, 1770,
+		1771, 5, 95, 0, 0, 1771, 1772, 7, 22, 0, 0, 1772, 1773, 7, 9, 0, 0, 1773,
+		1774, 7, 10, 0, 0, 1774, 1775, 7, 13, 0, 0, 1775, 117, 1, 0, 0, 0, 1776,
+		1777, 7, 12, 0, 0, 1777, 1778, 7, 10, 0, 0, 1778, 1779, 7, 25, 0, 0, 1779,
+		1780, 7, 5, 0, 0, 1780, 1781, 7, 22, 0, 0, 1781, 1782, 7, 6, 0, 0, 1782,
+		1783, 7, 16, 0, 0, 1783, 119, 1, 0, 0, 0, 1784, 1785, 7, 12, 0, 0, 1785,
+		1786, 7, 10, 0, 0, 1786, 1787, 7, 25, 0, 0, 1787, 1788, 7, 10, 0, 0, 1788,
+		1789, 7, 13, 0, 0, 1789, 1790, 7, 13, 0, 0, 1790, 1791, 7, 5, 0, 0, 1791,
+		1792, 7, 18, 0, 0, 1792, 1793, 7, 6, 0, 0, 1793, 1794, 7, 10, 0, 0, 1794,
+		121, 1, 0, 0, 0, 1795, 1796, 7, 12, 0, 0, 1796, 1797, 7, 10, 0, 0, 1797,
+		1798, 7, 9, 0, 0, 1798, 1799, 7, 14, 0, 0, 1799, 123, 1, 0, 0, 0, 1800,
+		1801, 7, 12, 0, 0, 1801, 1802, 7, 17, 0, 0, 1802, 1803, 7, 9, 0, 0, 1803,
+		1804, 7, 16, 0, 0, 1804, 1805, 7, 17, 0, 0, 1805, 1806, 7, 7, 0, 0, 1806,
+		1807, 7, 14, 0, 0, 1807, 1808, 7, 16, 0, 0, 1808, 125, 1, 0, 0, 0, 1809,
+		1810, 7, 12, 0, 0, 1810, 1811, 7, 19, 0, 0, 1811, 127, 1, 0, 0, 0, 1812,
+		1813, 7, 10, 0, 0, 1813, 1814, 7, 6, 0, 0, 1814, 1815, 7, 9, 0, 0, 1815,
+		1816, 7, 10, 0, 0, 1816, 129, 1, 0, 0, 0, 1817, 1818, 7, 10, 0, 0, 1818,
+		1819, 7, 26, 0, 0, 1819, 1820, 7, 14, 0, 0, 1820, 1821, 7, 10, 0, 0, 1821,
+		1822, 7, 24, 0, 0, 1822, 1823, 7, 16, 0, 0, 1823, 131, 1, 0, 0, 0, 1824,
+		1825, 7, 25, 0, 0, 1825, 1826, 7, 5, 0, 0, 1826, 1827, 7, 6, 0, 0, 1827,
+		1828, 7, 9, 0, 0, 1828, 1829, 7, 10, 0, 0, 1829, 133, 1, 0, 0, 0, 1830,
+		1831, 7, 25, 0, 0, 1831, 1832, 7, 10, 0, 0, 1832, 1833, 7, 16, 0, 0, 1833,
+		1834, 7, 14, 0, 0, 1834, 1835, 7, 20, 0, 0, 1835, 135, 1, 0, 0, 0, 1836,
+		1837, 7, 25, 0, 0, 1837, 1838, 7, 19, 0, 0, 1838, 1839, 7, 13, 0, 0, 1839,
+		137, 1, 0, 0, 0, 1840, 1841, 7, 25, 0, 0, 1841, 1842, 7, 19, 0, 0, 1842,
+		1843, 7, 13, 0, 0, 1843, 1844, 7, 10, 0, 0, 1844, 1845, 7, 17, 0, 0, 1845,
+		1846, 7, 23, 0, 0, 1846, 1847, 7, 7, 0, 0, 1847, 139, 1, 0, 0, 0, 1848,
+		1849, 7, 25, 0, 0, 1849, 1850, 7, 13, 0, 0, 1850, 1851, 7, 19, 0, 0, 1851,
+		1852, 7, 15, 0, 0, 1852, 141, 1, 0, 0, 0, 1853, 1854, 7, 23, 0, 0, 1854,
+		1855, 7, 13, 0, 0, 1855, 1856, 7, 5, 0, 0, 1856, 1857, 7, 7, 0, 0, 1857,
+		1858, 7, 16, 0, 0, 1858, 143, 1, 0, 0, 0, 1859, 1860, 7, 23, 0, 0, 1860,
+		1861, 7, 13, 0, 0, 1861, 1862, 7, 19, 0, 0, 1862, 1863, 7, 22, 0, 0, 1863,
+		1864, 7, 24, 0, 0, 1864, 145, 1, 0, 0, 0, 1865, 1866, 7, 20, 0, 0, 1866,
+		1867, 7, 5, 0, 0, 1867, 1868, 7, 27, 0, 0, 1868, 1869, 7, 17, 0, 0, 1869,
+		1870, 7, 7, 0, 0, 1870, 1871, 7, 23, 0, 0, 1871, 147, 1, 0, 0, 0, 1872,
+		1873, 7, 17, 0, 0, 1873, 1874, 7, 7, 0, 0, 1874, 149, 1, 0, 0, 0, 1875,
+		1876, 7, 17, 0, 0, 1876, 1877, 7, 7, 0, 0, 1877, 1878, 7, 17, 0, 0, 1878,
+		1879, 7, 16, 0, 0, 1879, 1880, 7, 17, 0, 0, 1880, 1881, 7, 5, 0, 0, 1881,
+		1882, 7, 6, 0, 0, 1882, 1883, 7, 6, 0, 0, 1883, 1884, 7, 8, 0, 0, 1884,
+		151, 1, 0, 0, 0, 1885, 1886, 7, 17, 0, 0, 1886, 1887, 7, 7, 0, 0, 1887,
+		1888, 7, 16, 0, 0, 1888, 1889, 7, 10, 0, 0, 1889, 1890, 7, 13, 0, 0, 1890,
+		1891, 7, 9, 0, 0, 1891, 1892, 7, 10, 0, 0, 1892, 1893, 7, 14, 0, 0, 1893,
+		1894, 7, 16, 0, 0, 1894, 153, 1, 0, 0, 0, 1895, 1896, 7, 17, 0, 0, 1896,
+		1897, 7, 7, 0, 0, 1897, 1898, 7, 16, 0, 0, 1898, 1899, 7, 19, 0, 0, 1899,
+		155, 1, 0, 0, 0, 1900, 1901, 7, 6, 0, 0, 1901, 1902, 7, 5, 0, 0, 1902,
+		1903, 7, 16, 0, 0, 1903, 1904, 7, 10, 0, 0, 1904, 1905, 7, 13, 0, 0, 1905,
+		1906, 7, 5, 0, 0, 1906, 1907, 7, 6, 0, 0, 1907, 157, 1, 0, 0, 0, 1908,
+		1909, 7, 6, 0, 0, 1909, 1910, 7, 10, 0, 0, 1910, 1911, 7, 5, 0, 0, 1911,
+		1912, 7, 12, 0, 0, 1912, 1913, 7, 17, 0, 0, 1913, 1914, 7, 7, 0, 0, 1914,
+		1915, 7, 23, 0, 0, 1915, 159, 1, 0, 0, 0, 1916, 1917, 7, 6, 0, 0, 1917,
+		1918, 7, 17, 0, 0, 1918, 1919, 7, 15, 0, 0, 1919, 1920, 7, 17, 0, 0, 1920,
+		1921, 7, 16, 0, 0, 1921, 161, 1, 0, 0, 0, 1922, 1923, 7, 6, 0, 0, 1923,
+		1924, 7, 19, 0, 0, 1924, 1925, 7, 14, 0, 0, 1925, 1926, 7, 5, 0, 0, 1926,
+		1927, 7, 6, 0, 0, 1927, 1928, 7, 16, 0, 0, 1928, 1929, 7, 17, 0, 0, 1929,
+		1930, 7, 15, 0, 0, 1930, 1931, 7, 10, 0, 0, 1931, 163, 1, 0, 0, 0, 1932,
+		1933, 7, 6, 0, 0, 1933, 1934, 7, 19, 0, 0, 1934, 1935, 7, 14, 0, 0, 1935,
+		1936, 7, 5, 0, 0, 1936, 1937, 7, 6, 0, 0, 1937, 1938, 7, 16, 0, 0, 1938,
+		1939, 7, 17, 0, 0, 1939, 1940, 7, 15, 0, 0, 1940, 1941, 7, 10, 0, 0, 1941,
+		1942, 7, 9, 0, 0, 1942, 1943, 7, 16, 0, 0, 1943, 1944, 7, 5, 0, 0, 1944,
+		1945, 7, 15, 0, 0, 1945, 1946, 7, 24, 0, 0, 1946, 165, 1, 0, 0, 0, 1947,
+		1948, 7, 7, 0, 0, 1948, 1949, 7, 19, 0, 0, 1949, 1950, 7, 16, 0, 0, 1950,
+		167, 1, 0, 0, 0, 1951, 1952, 7, 7, 0, 0, 1952, 1953, 7, 22, 0, 0, 1953,
+		1954, 7, 6, 0, 0, 1954, 1955, 7, 6, 0, 0, 1955, 169, 1, 0, 0, 0, 1956,
+		1957, 7, 19, 0, 0, 1957, 1958, 7, 25, 0, 0, 1958, 1959, 7, 25, 0, 0, 1959,
+		1960, 7, 9, 0, 0, 1960, 1961, 7, 10, 0, 0, 1961, 1962, 7, 16, 0, 0, 1962,
+		171, 1, 0, 0, 0, 1963, 1964, 7, 19, 0, 0, 1964, 1965, 7, 7, 0, 0, 1965,
+		173, 1, 0, 0, 0, 1966, 1967, 7, 19, 0, 0, 1967, 1968, 7, 7, 0, 0, 1968,
+		1969, 7, 6, 0, 0, 1969, 1970, 7, 8, 0, 0, 1970, 175, 1, 0, 0, 0, 1971,
+		1972, 7, 19, 0, 0, 1972, 1973, 7, 13, 0, 0, 1973, 177, 1, 0, 0, 0, 1974,
+		1975, 7, 19, 0, 0, 1975, 1976, 7, 13, 0, 0, 1976, 1977, 7, 12, 0, 0, 1977,
+		1978, 7, 10, 0, 0, 1978, 1979, 7, 13, 0, 0, 1979, 179, 1, 0, 0, 0, 1980,
+		1981, 7, 24, 0, 0, 1981, 1982, 7, 6, 0, 0, 1982, 1983, 7, 5, 0, 0, 1983,
+		1984, 7, 14, 0, 0, 1984, 1985, 7, 17, 0, 0, 1985, 1986, 7, 7, 0, 0, 1986,
+		1987, 7, 23, 0, 0, 1987, 181, 1, 0, 0, 0, 1988, 1989, 7, 24, 0, 0, 1989,
+		1990, 7, 13, 0, 0, 1990, 1991, 7, 17, 0, 0, 1991, 1992, 7, 15, 0, 0, 1992,
+		1993, 7, 5, 0, 0, 1993, 1994, 7, 13, 0, 0, 1994, 1995, 7, 8, 0, 0, 1995,
+		183, 1, 0, 0, 0, 1996, 1997, 7, 13, 0, 0, 1997, 1998, 7, 10, 0, 0, 1998,
+		1999, 7, 25, 0, 0, 1999, 2000, 7, 10, 0, 0, 2000, 2001, 7, 13, 0, 0, 2001,
+		2002, 7, 10, 0, 0, 2002, 2003, 7, 7, 0, 0, 2003, 2004, 7, 14, 0, 0, 2004,
+		2005, 7, 10, 0, 0, 2005, 2006, 7, 9, 0, 0, 2006, 185, 1, 0, 0, 0, 2007,
+		2008, 7, 13, 0, 0, 2008, 2009, 7, 10, 0, 0, 2009, 2010, 7, 16, 0, 0, 2010,
+		2011, 7, 22, 0, 0, 2011, 2012, 7, 13, 0, 0, 2012, 2013, 7, 7, 0, 0, 2013,
+		2014, 7, 17, 0, 0, 2014, 2015, 7, 7, 0, 0, 2015, 2016, 7, 23, 0, 0, 2016,
+		187, 1, 0, 0, 0, 2017, 2018, 7, 9, 0, 0, 2018, 2019, 7, 10, 0, 0, 2019,
+		2020, 7, 6, 0, 0, 2020, 2021, 7, 10, 0, 0, 2021, 2022, 7, 14, 0, 0, 2022,
+		2023, 7, 16, 0, 0, 2023, 189, 1, 0, 0, 0, 2024, 2025, 7, 9, 0, 0, 2025,
+		2026, 7, 10, 0, 0, 2026, 2027, 7, 9, 0, 0, 2027, 2028, 7, 9, 0, 0, 2028,
+		2029, 7, 17, 0, 0, 2029, 2030, 7, 19, 0, 0, 2030, 2031, 7, 7, 0, 0, 2031,
+		2032, 5, 95, 0, 0, 2032, 2033, 7, 22, 0, 0, 2033, 2034, 7, 9, 0, 0, 2034,
+		2035, 7, 10, 0, 0, 2035, 2036, 7, 13, 0, 0, 2036, 191, 1, 0, 0, 0, 2037,
+		2038, 7, 9, 0, 0, 2038, 2039, 7, 19, 0, 0, 2039, 2040, 7, 15, 0, 0, 2040,
+		2041, 7, 10, 0, 0, 2041, 193, 1, 0, 0, 0, 2042, 2043, 7, 9, 0, 0, 2043,
+		2044, 7, 8, 0, 0, 2044, 2045, 7, 15, 0, 0, 2045, 2046, 7, 15, 0, 0, 2046,
+		2047, 7, 10, 0, 0, 2047, 2048, 7, 16, 0, 0, 2048, 2049, 7, 13, 0, 0, 2049,
+		2050, 7, 17, 0, 0, 2050, 2051, 7, 14, 0, 0, 2051, 195, 1, 0, 0, 0, 2052,
+		2053, 7, 16, 0, 0, 2053, 2054, 7, 5, 0, 0, 2054, 2055, 7, 18, 0, 0, 2055,
+		2056, 7, 6, 0, 0, 2056, 2057, 7, 10, 0, 0, 2057, 197, 1, 0, 0, 0, 2058,
+		2059, 7, 16, 0, 0, 2059, 2060, 7, 20, 0, 0, 2060, 2061, 7, 10, 0, 0, 2061,
+		2062, 7, 7, 0, 0, 2062, 199, 1, 0, 0, 0, 2063, 2064, 7, 16, 0, 0, 2064,
+		2065, 7, 19, 0, 0, 2065, 201, 1, 0, 0, 0, 2066, 2067, 7, 16, 0, 0, 2067,
+		2068, 7, 13, 0, 0, 2068, 2069, 7, 5, 0, 0, 2069, 2070, 7, 17, 0, 0, 2070,
+		2071, 7, 6, 0, 0, 2071, 2072, 7, 17, 0, 0, 2072, 2073, 7, 7, 0, 0, 2073,
+		2074, 7, 23, 0, 0, 2074, 203, 1, 0, 0, 0, 2075, 2076, 7, 16, 0, 0, 2076,
+		2077, 7, 13, 0, 0, 2077, 2078, 7, 22, 0, 0, 2078, 2079, 7, 10, 0, 0, 2079,
+		205, 1, 0, 0, 0, 2080, 2081, 7, 22, 0, 0, 2081, 2082, 7, 7, 0, 0, 2082,
+		2083, 7, 17, 0, 0, 2083, 2084, 7, 19, 0, 0, 2084, 2085, 7, 7, 0, 0, 2085,
+		207, 1, 0, 0, 0, 2086, 2087, 7, 22, 0, 0, 2087, 2088, 7, 7, 0, 0, 2088,
+		2089, 7, 17, 0, 0, 2089, 2090, 7, 28, 0, 0, 2090, 2091, 7, 22, 0, 0, 2091,
+		2092, 7, 10, 0, 0, 2092, 209, 1, 0, 0, 0, 2093, 2094, 7, 22, 0, 0, 2094,
+		2095, 7, 9, 0, 0, 2095, 2096, 7, 10, 0, 0, 2096, 2097, 7, 13, 0, 0, 2097,
+		211, 1, 0, 0, 0, 2098, 2099, 7, 22, 0, 0, 2099, 2100, 7, 9, 0, 0, 2100,
+		2101, 7, 17, 0, 0, 2101, 2102, 7, 7, 0, 0, 2102, 2103, 7, 23, 0, 0, 2103,
+		213, 1, 0, 0, 0, 2104, 2105, 7, 27, 0, 0, 2105, 2106, 7, 5, 0, 0, 2106,
+		2107, 7, 13, 0, 0, 2107, 2108, 7, 17, 0, 0, 2108, 2109, 7, 5, 0, 0, 2109,
+		2110, 7, 12, 0, 0, 2110, 2111, 7, 17, 0, 0, 2111, 2112, 7, 14, 0, 0, 2112,
+		215, 1, 0, 0, 0, 2113, 2114, 7, 29, 0, 0, 2114, 2115, 7, 20, 0, 0, 2115,
+		2116, 7, 10, 0, 0, 2116, 2117, 7, 7, 0, 0, 2117, 217, 1, 0, 0, 0, 2118,
+		2119, 7, 29, 0, 0, 2119, 2120, 7, 20, 0, 0, 2120, 2121, 7, 10, 0, 0, 2121,
+		2122, 7, 13, 0, 0, 2122, 2123, 7, 10, 0, 0, 2123, 219, 1, 0, 0, 0, 2124,
+		2125, 7, 29, 0, 0, 2125, 2126, 7, 17, 0, 0, 2126, 2127, 7, 7, 0, 0, 2127,
+		2128, 7, 12, 0, 0, 2128, 2129, 7, 19, 0, 0, 2129, 2130, 7, 29, 0, 0, 2130,
+		221, 1, 0, 0, 0, 2131, 2132, 7, 29, 0, 0, 2132, 2133, 7, 17, 0, 0, 2133,
+		2134, 7, 16, 0, 0, 2134, 2135, 7, 20, 0, 0, 2135, 223, 1, 0, 0, 0, 2136,
+		2137, 7, 5, 0, 0, 2137, 2138, 7, 22, 0, 0, 2138, 2139, 7, 16, 0, 0, 2139,
+		2140, 7, 20, 0, 0, 2140, 2141, 7, 19, 0, 0, 2141, 2142, 7, 13, 0, 0, 2142,
+		2143, 7, 17, 0, 0, 2143, 2144, 7, 11, 0, 0, 2144, 2145, 7, 5, 0, 0, 2145,
+		2146, 7, 16, 0, 0, 2146, 2147, 7, 17, 0, 0, 2147, 2148, 7, 19, 0, 0, 2148,
+		2149, 7, 7, 0, 0, 2149, 225, 1, 0, 0, 0, 2150, 2151, 7, 18, 0, 0, 2151,
+		2152, 7, 17, 0, 0, 2152, 2153, 7, 7, 0, 0, 2153, 2154, 7, 5, 0, 0, 2154,
+		2155, 7, 13, 0, 0, 2155, 2156, 7, 8, 0, 0, 2156, 227, 1, 0, 0, 0, 2157,
+		2158, 7, 14, 0, 0, 2158, 2159, 7, 19, 0, 0, 2159, 2160, 7, 6, 0, 0, 2160,
+		2161, 7, 6, 0, 0, 2161, 2162, 7, 5, 0, 0, 2162, 2163, 7, 16, 0, 0, 2163,
+		2164, 7, 17, 0, 0, 2164, 2165, 7, 19, 0, 0, 2165, 2166, 7, 7, 0, 0, 2166,
+		229, 1, 0, 0, 0, 2167, 2168, 7, 14, 0, 0, 2168, 2169, 7, 19, 0, 0, 2169,
+		2170, 7, 7, 0, 0, 2170, 2171, 7, 14, 0, 0, 2171, 2172, 7, 22, 0, 0, 2172,
+		2173, 7, 13, 0, 0, 2173, 2174, 7, 13, 0, 0, 2174, 2175, 7, 10, 0, 0, 2175,
+		2176, 7, 7, 0, 0, 2176, 2177, 7, 16, 0, 0, 2177, 2178, 7, 6, 0, 0, 2178,
+		2179, 7, 8, 0, 0, 2179, 231, 1, 0, 0, 0, 2180, 2181, 7, 14, 0, 0, 2181,
+		2182, 7, 13, 0, 0, 2182, 2183, 7, 19, 0, 0, 2183, 2184, 7, 9, 0, 0, 2184,
+		2185, 7, 9, 0, 0, 2185, 233, 1, 0, 0, 0, 2186, 2187, 7, 14, 0, 0, 2187,
+		2188, 7, 22, 0, 0, 2188, 2189, 7, 13, 0, 0, 2189, 2190, 7, 13, 0, 0, 2190,
+		2191, 7, 10, 0, 0, 2191, 2192, 7, 7, 0, 0, 2192, 2193, 7, 16, 0, 0, 2193,
+		2194, 5, 95, 0, 0, 2194, 2195, 7, 9, 0, 0, 2195, 2196, 7, 14, 0, 0, 2196,
+		2197, 7, 20, 0, 0, 2197, 2198, 7, 10, 0, 0, 2198, 2199, 7, 15, 0, 0, 2199,
+		2200, 7, 5, 0, 0, 2200, 235, 1, 0, 0, 0, 2201, 2202, 7, 25, 0, 0, 2202,
+		2203, 7, 13, 0, 0, 2203, 2204, 7, 10, 0, 0, 2204, 2205, 7, 10, 0, 0, 2205,
+		2206, 7, 11, 0, 0, 2206, 2207, 7, 10, 0, 0, 2207, 237, 1, 0, 0, 0, 2208,
+		2209, 7, 25, 0, 0, 2209, 2210, 7, 22, 0, 0, 2210, 2211, 7, 6, 0, 0, 2211,
+		2212, 7, 6, 0, 0, 2212, 239, 1, 0, 0, 0, 2213, 2214, 7, 17, 0, 0, 2214,
+		2215, 7, 6, 0, 0, 2215, 2216, 7, 17, 0, 0, 2216, 2217, 7, 21, 0, 0, 2217,
+		2218, 7, 10, 0, 0, 2218, 241, 1, 0, 0, 0, 2219, 2220, 7, 17, 0, 0, 2220,
+		2221, 7, 7, 0, 0, 2221, 2222, 7, 7, 0, 0, 2222, 2223, 7, 10, 0, 0, 2223,
+		2224, 7, 13, 0, 0, 2224, 243, 1, 0, 0, 0, 2225, 2226, 7, 17, 0, 0, 2226,
+		2227, 7, 9, 0, 0, 2227, 245, 1, 0, 0, 0, 2228, 2229, 7, 17, 0, 0, 2229,
+		2230, 7, 9, 0, 0, 2230, 2231, 7, 7, 0, 0, 2231, 2232, 7, 22, 0, 0, 2232,
+		2233, 7, 6, 0, 0, 2233, 2234, 7, 6, 0, 0, 2234, 247, 1, 0, 0, 0, 2235,
+		2236, 7, 30, 0, 0, 2236, 2237, 7, 19, 0, 0, 2237, 2238, 7, 17, 0, 0, 2238,
+		2239, 7, 7, 0, 0, 2239, 249, 1, 0, 0, 0, 2240, 2241, 7, 6, 0, 0, 2241,
+		2242, 7, 10, 0, 0, 2242, 2243, 7, 25, 0, 0, 2243, 2244, 7, 16, 0, 0, 2244,
+		251, 1, 0, 0, 0, 2245, 2246, 7, 6, 0, 0, 2246, 2247, 7, 17, 0, 0, 2247,
+		2248, 7, 21, 0, 0, 2248, 2249, 7, 10, 0, 0, 2249, 253, 1, 0, 0, 0, 2250,
+		2251, 7, 7, 0, 0, 2251, 2252, 7, 5, 0, 0, 2252, 2253, 7, 16, 0, 0, 2253,
+		2254, 7, 22, 0, 0, 2254, 2255, 7, 13, 0, 0, 2255, 2256, 7, 5, 0, 0, 2256,
+		2257, 7, 6, 0, 0, 2257, 255, 1, 0, 0, 0, 2258, 2259, 7, 7, 0, 0, 2259,
+		2260, 7, 19, 0, 0, 2260, 2261, 7, 16, 0, 0, 2261, 2262, 7, 7, 0, 0, 2262,
+		2263, 7, 22, 0, 0, 2263, 2264, 7, 6, 0, 0, 2264, 2265, 7, 6, 0, 0, 2265,
+		257, 1, 0, 0, 0, 2266, 2267, 7, 19, 0, 0, 2267, 2268, 7, 22, 0, 0, 2268,
+		2269, 7, 16, 0, 0, 2269, 2270, 7, 10, 0, 0, 2270, 2271, 7, 13, 0, 0, 2271,
+		259, 1, 0, 0, 0, 2272, 2273, 7, 19, 0, 0, 2273, 2274, 7, 27, 0, 0, 2274,
+		2275, 7, 10, 0, 0, 2275, 2276, 7, 13, 0, 0, 2276, 261, 1, 0, 0, 0, 2277,
+		2278, 7, 19, 0, 0, 2278, 2279, 7, 27, 0, 0, 2279, 2280, 7, 10, 0, 0, 2280,
+		2281, 7, 13, 0, 0, 2281, 2282, 7, 6, 0, 0, 2282, 2283, 7, 5, 0, 0, 2283,
+		2284, 7, 24, 0, 0, 2284, 2285, 7, 9, 0, 0, 2285, 263, 1, 0, 0, 0, 2286,
+		2287, 7, 13, 0, 0, 2287, 2288, 7, 17, 0, 0, 2288, 2289, 7, 23, 0, 0, 2289,
+		2290, 7, 20, 0, 0, 2290, 2291, 7, 16, 0, 0, 2291, 265, 1, 0, 0, 0, 2292,
+		2293, 7, 9, 0, 0, 2293, 2294, 7, 17, 0, 0, 2294, 2295, 7, 15, 0, 0, 2295,
+		2296, 7, 17, 0, 0, 2296, 2297, 7, 6, 0, 0, 2297, 2298, 7, 5, 0, 0, 2298,
+		2299, 7, 13, 0, 0, 2299, 267, 1, 0, 0, 0, 2300, 2301, 7, 27, 0, 0, 2301,
+		2302, 7, 10, 0, 0, 2302, 2303, 7, 13, 0, 0, 2303, 2304, 7, 18, 0, 0, 2304,
+		2305, 7, 19, 0, 0, 2305, 2306, 7, 9, 0, 0, 2306, 2307, 7, 10, 0, 0, 2307,
+		269, 1, 0, 0, 0, 2308, 2309, 7, 5, 0, 0, 2309, 2310, 7, 18, 0, 0, 2310,
+		2311, 7, 19, 0, 0, 2311, 2312, 7, 13, 0, 0, 2312, 2313, 7, 16, 0, 0, 2313,
+		271, 1, 0, 0, 0, 2314, 2315, 7, 5, 0, 0, 2315, 2316, 7, 18, 0, 0, 2316,
+		2317, 7, 9, 0, 0, 2317, 2318, 7, 10, 0, 0, 2318, 2319, 7, 7, 0, 0, 2319,
+		2320, 7, 16, 0, 0, 2320, 273, 1, 0, 0, 0, 2321, 2322, 7, 5, 0, 0, 2322,
+		2323, 7, 18, 0, 0, 2323, 2324, 7, 9, 0, 0, 2324, 2325, 7, 19, 0, 0, 2325,
+		2326, 7, 6, 0, 0, 2326, 2327, 7, 22, 0, 0, 2327, 2328, 7, 16, 0, 0, 2328,
+		2329, 7, 10, 0, 0, 2329, 275, 1, 0, 0, 0, 2330, 2331, 7, 5, 0, 0, 2331,
+		2332, 7, 14, 0, 0, 2332, 2333, 7, 14, 0, 0, 2333, 2334, 7, 10, 0, 0, 2334,
+		2335, 7, 9, 0, 0, 2335, 2336, 7, 9, 0, 0, 2336, 277, 1, 0, 0, 0, 2337,
+		2338, 7, 5, 0, 0, 2338, 2339, 7, 14, 0, 0, 2339, 2340, 7, 16, 0, 0, 2340,
+		2341, 7, 17, 0, 0, 2341, 2342, 7, 19, 0, 0, 2342, 2343, 7, 7, 0, 0, 2343,
+		279, 1, 0, 0, 0, 2344, 2345, 7, 5, 0, 0, 2345, 2346, 7, 12, 0, 0, 2346,
+		2347, 7, 12, 0, 0, 2347, 281, 1, 0, 0, 0, 2348, 2349, 7, 5, 0, 0, 2349,
+		2350, 7, 12, 0, 0, 2350, 2351, 7, 15, 0, 0, 2351, 2352, 7, 17, 0, 0, 2352,
+		2353, 7, 7, 0, 0, 2353, 283, 1, 0, 0, 0, 2354, 2355, 7, 5, 0, 0, 2355,
+		2356, 7, 25, 0, 0, 2356, 2357, 7, 16, 0, 0, 2357, 2358, 7, 10, 0, 0, 2358,
+		2359, 7, 13, 0, 0, 2359, 285, 1, 0, 0, 0, 2360, 2361, 7, 5, 0, 0, 2361,
+		2362, 7, 23, 0, 0, 2362, 2363, 7, 23, 0, 0, 2363, 2364, 7, 13, 0, 0, 2364,
+		2365, 7, 10, 0, 0, 2365, 2366, 7, 23, 0, 0, 2366, 2367, 7, 5, 0, 0, 2367,
+		2368, 7, 16, 0, 0, 2368, 2369, 7, 10, 0, 0, 2369, 287, 1, 0, 0, 0, 2370,
+		2371, 7, 5, 0, 0, 2371, 2372, 7, 6, 0, 0, 2372, 2373, 7, 9, 0, 0, 2373,
+		2374, 7, 19, 0, 0, 2374, 289, 1, 0, 0, 0, 2375, 2376, 7, 5, 0, 0, 2376,
+		2377, 7, 6, 0, 0, 2377, 2378, 7, 16, 0, 0, 2378, 2379, 7, 10, 0, 0, 2379,
+		2380, 7, 13, 0, 0, 2380, 291, 1, 0, 0, 0, 2381, 2382, 7, 5, 0, 0, 2382,
+		2383, 7, 6, 0, 0, 2383, 2384, 7, 29, 0, 0, 2384, 2385, 7, 5, 0, 0, 2385,
+		2386, 7, 8, 0, 0, 2386, 2387, 7, 9, 0, 0, 2387, 293, 1, 0, 0, 0, 2388,
+		2389, 7, 5, 0, 0, 2389, 2390, 7, 9, 0, 0, 2390, 2391, 7, 9, 0, 0, 2391,
+		2392, 7, 10, 0, 0, 2392, 2393, 7, 13, 0, 0, 2393, 2394, 7, 16, 0, 0, 2394,
+		2395, 7, 17, 0, 0, 2395, 2396, 7, 19, 0, 0, 2396, 2397, 7, 7, 0, 0, 2397,
+		295, 1, 0, 0, 0, 2398, 2399, 7, 5, 0, 0, 2399, 2400, 7, 9, 0, 0, 2400,
+		2401, 7, 9, 0, 0, 2401, 2402, 7, 17, 0, 0, 2402, 2403, 7, 23, 0, 0, 2403,
+		2404, 7, 7, 0, 0, 2404, 2405, 7, 15, 0, 0, 2405, 2406, 7, 10, 0, 0, 2406,
+		2407, 7, 7, 0, 0, 2407, 2408, 7, 16, 0, 0, 2408, 297, 1, 0, 0, 0, 2409,
+		2410, 7, 5, 0, 0, 2410, 2411, 7, 16, 0, 0, 2411, 299, 1, 0, 0, 0, 2412,
+		2413, 7, 5, 0, 0, 2413, 2414, 7, 16, 0, 0, 2414, 2415, 7, 16, 0, 0, 2415,
+		2416, 7, 13, 0, 0, 2416, 2417, 7, 17, 0, 0, 2417, 2418, 7, 18, 0, 0, 2418,
+		2419, 7, 22, 0, 0, 2419, 2420, 7, 16, 0, 0, 2420, 2421, 7, 10, 0, 0, 2421,
+		301, 1, 0, 0, 0, 2422, 2423, 7, 18, 0, 0, 2423, 2424, 7, 5, 0, 0, 2424,
+		2425, 7, 14, 0, 0, 2425, 2426, 7, 21, 0, 0, 2426, 2427, 7, 29, 0, 0, 2427,
+		2428, 7, 5, 0, 0, 2428, 2429, 7, 13, 0, 0, 2429, 2430, 7, 12, 0, 0, 2430,
+		303, 1, 0, 0, 0, 2431, 2432, 7, 18, 0, 0, 2432, 2433, 7, 10, 0, 0, 2433,
+		2434, 7, 25, 0, 0, 2434, 2435, 7, 19, 0, 0, 2435, 2436, 7, 13, 0, 0, 2436,
+		2437, 7, 10, 0, 0, 2437, 305, 1, 0, 0, 0, 2438, 2439, 7, 18, 0, 0, 2439,
+		2440, 7, 10, 0, 0, 2440, 2441, 7, 23, 0, 0, 2441, 2442, 7, 17, 0, 0, 2442,
+		2443, 7, 7, 0, 0, 2443, 307, 1, 0, 0, 0, 2444, 2445, 7, 18, 0, 0, 2445,
+		2446, 7, 8, 0, 0, 2446, 309, 1, 0, 0, 0, 2447, 2448, 7, 14, 0, 0, 2448,
+		2449, 7, 5, 0, 0, 2449, 2450, 7, 14, 0, 0, 2450, 2451, 7, 20, 0, 0, 2451,
+		2452, 7, 10, 0, 0, 2452, 311, 1, 0, 0, 0, 2453, 2454, 7, 14, 0, 0, 2454,
+		2455, 7, 5, 0, 0, 2455, 2456, 7, 6, 0, 0, 2456, 2457, 7, 6, 0, 0, 2457,
+		2458, 7, 10, 0, 0, 2458, 2459, 7, 12, 0, 0, 2459, 313, 1, 0, 0, 0, 2460,
+		2461, 7, 14, 0, 0, 2461, 2462, 7, 5, 0, 0, 2462, 2463, 7, 9, 0, 0, 2463,
+		2464, 7, 14, 0, 0, 2464, 2465, 7, 5, 0, 0, 2465, 2466, 7, 12, 0, 0, 2466,
+		2467, 7, 10, 0, 0, 2467, 315, 1, 0, 0, 0, 2468, 2469, 7, 14, 0, 0, 2469,
+		2470, 7, 5, 0, 0, 2470, 2471, 7, 9, 0, 0, 2471, 2472, 7, 14, 0, 0, 2472,
+		2473, 7, 5, 0, 0, 2473, 2474, 7, 12, 0, 0, 2474, 2475, 7, 10, 0, 0, 2475,
+		2476, 7, 12, 0, 0, 2476, 317, 1, 0, 0, 0, 2477, 2478, 7, 14, 0, 0, 2478,
+		2479, 7, 5, 0, 0, 2479, 2480, 7, 16, 0, 0, 2480, 2481, 7, 5, 0, 0, 2481,
+		2482, 7, 6, 0, 0, 2482, 2483, 7, 19, 0, 0, 2483, 2484, 7, 23, 0, 0, 2484,
+		319, 1, 0, 0, 0, 2485, 2486, 7, 14, 0, 0, 2486, 2487, 7, 20, 0, 0, 2487,
+		2488, 7, 5, 0, 0, 2488, 2489, 7, 17, 0, 0, 2489, 2490, 7, 7, 0, 0, 2490,
+		321, 1, 0, 0, 0, 2491, 2492, 7, 14, 0, 0, 2492, 2493, 7, 20, 0, 0, 2493,
+		2494, 7, 5, 0, 0, 2494, 2495, 7, 13, 0, 0, 2495, 2496, 7, 5, 0, 0, 2496,
+		2497, 7, 14, 0, 0, 2497, 2498, 7, 16, 0, 0, 2498, 2499, 7, 10, 0, 0, 2499,
+		2500, 7, 13, 0, 0, 2500, 2501, 7, 17, 0, 0, 2501, 2502, 7, 9, 0, 0, 2502,
+		2503, 7, 16, 0, 0, 2503, 2504, 7, 17, 0, 0, 2504, 2505, 7, 14, 0, 0, 2505,
+		2506, 7, 9, 0, 0, 2506, 323, 1, 0, 0, 0, 2507, 2508, 7, 14, 0, 0, 2508,
+		2509, 7, 20, 0, 0, 2509, 2510, 7, 10, 0, 0, 2510, 2511, 7, 14, 0, 0, 2511,
+		2512, 7, 21, 0, 0, 2512, 2513, 7, 24, 0, 0, 2513, 2514, 7, 19, 0, 0, 2514,
+		2515, 7, 17, 0, 0, 2515, 2516, 7, 7, 0, 0, 2516, 2517, 7, 16, 0, 0, 2517,
+		325, 1, 0, 0, 0, 2518, 2519, 7, 14, 0, 0, 2519, 2520, 7, 6, 0, 0, 2520,
+		2521, 7, 5, 0, 0, 2521, 2522, 7, 9, 0, 0, 2522, 2523, 7, 9, 0, 0, 2523,
+		327, 1, 0, 0, 0, 2524, 2525, 7, 14, 0, 0, 2525, 2526, 7, 6, 0, 0, 2526,
+		2527, 7, 19, 0, 0, 2527, 2528, 7, 9, 0, 0, 2528, 2529, 7, 10, 0, 0, 2529,
+		329, 1, 0, 0, 0, 2530, 2531, 7, 14, 0, 0, 2531, 2532, 7, 6, 0, 0, 2532,
+		2533, 7, 22, 0, 0, 2533, 2534, 7, 9, 0, 0, 2534, 2535, 7, 16, 0, 0, 2535,
+		2536, 7, 10, 0, 0, 2536, 2537, 7, 13, 0, 0, 2537, 331, 1, 0, 0, 0, 2538,
+		2539, 7, 14, 0, 0, 2539, 2540, 7, 19, 0, 0, 2540, 2541, 7, 15, 0, 0, 2541,
+		2542, 7, 15, 0, 0, 2542, 2543, 7, 10, 0, 0, 2543, 2544, 7, 7, 0, 0, 2544,
+		2545, 7, 16, 0, 0, 2545, 333, 1, 0, 0, 0, 2546, 2547, 7, 14, 0, 0, 2547,
+		2548, 7, 19, 0, 0, 2548, 2549, 7, 15, 0, 0, 2549, 2550, 7, 15, 0, 0, 2550,
+		2551, 7, 10, 0, 0, 2551, 2552, 7, 7, 0, 0, 2552, 2553, 7, 16, 0, 0, 2553,
+		2554, 7, 9, 0, 0, 2554, 335, 1, 0, 0, 0, 2555, 2556, 7, 14, 0, 0, 2556,
+		2557, 7, 19, 0, 0, 2557, 2558, 7, 15, 0, 0, 2558, 2559, 7, 15, 0, 0, 2559,
+		2560, 7, 17, 0, 0, 2560, 2561, 7, 16, 0, 0, 2561, 337, 1, 0, 0, 0, 2562,
+		2563, 7, 14, 0, 0, 2563, 2564, 7, 19, 0, 0, 2564, 2565, 7, 15, 0, 0, 2565,
+		2566, 7, 15, 0, 0, 2566, 2567, 7, 17, 0, 0, 2567, 2568, 7, 16, 0, 0, 2568,
+		2569, 7, 16, 0, 0, 2569, 2570, 7, 10, 0, 0, 2570, 2571, 7, 12, 0, 0, 2571,
+		339, 1, 0, 0, 0, 2572, 2573, 7, 14, 0, 0, 2573, 2574, 7, 19, 0, 0, 2574,
+		2575, 7, 7, 0, 0, 2575, 2576, 7, 12, 0, 0, 2576, 2577, 7, 17, 0, 0, 2577,
+		2578, 7, 16, 0, 0, 2578, 2579, 7, 17, 0, 0, 2579, 2580, 7, 19, 0, 0, 2580,
+		2581, 7, 7, 0, 0, 2581, 2582, 7, 5, 0, 0, 2582, 2583, 7, 6, 0, 0, 2583,
+		341, 1, 0, 0, 0, 2584, 2585, 7, 14, 0, 0, 2585, 2586, 7, 19, 0, 0, 2586,
+		2587, 7, 7, 0, 0, 2587, 2588, 7, 25, 0, 0, 2588, 2589, 7, 17, 0, 0, 2589,
+		2590, 7, 23, 0, 0, 2590, 2591, 7, 22, 0, 0, 2591, 2592, 7, 13, 0, 0, 2592,
+		2593, 7, 5, 0, 0, 2593, 2594, 7, 16, 0, 0, 2594, 2595, 7, 17, 0, 0, 2595,
+		2596, 7, 19, 0, 0, 2596, 2597, 7, 7, 0, 0, 2597, 343, 1, 0, 0, 0, 2598,
+		2599, 7, 14, 0, 0, 2599, 2600, 7, 19, 0, 0, 2600, 2601, 7, 7, 0, 0, 2601,
+		2602, 7, 7, 0, 0, 2602, 2603, 7, 10, 0, 0, 2603, 2604, 7, 14, 0, 0, 2604,
+		2605, 7, 16, 0, 0, 2605, 2606, 7, 17, 0, 0, 2606, 2607, 7, 19, 0, 0, 2607,
+		2608, 7, 7, 0, 0, 2608, 345, 1, 0, 0, 0, 2609, 2610, 7, 14, 0, 0, 2610,
+		2611, 7, 19, 0, 0, 2611, 2612, 7, 7, 0, 0, 2612, 2613, 7, 9, 0, 0, 2613,
+		2614, 7, 16, 0, 0, 2614, 2615, 7, 13, 0, 0, 2615, 2616, 7, 5, 0, 0, 2616,
+		2617, 7, 17, 0, 0, 2617, 2618, 7, 7, 0, 0, 2618, 2619, 7, 16, 0, 0, 2619,
+		2620, 7, 9, 0, 0, 2620, 347, 1, 0, 0, 0, 2621, 2622, 7, 14, 0, 0, 2622,
+		2623, 7, 19, 0, 0, 2623, 2624, 7, 7, 0, 0, 2624, 2625, 7, 16, 0, 0, 2625,
+		2626, 7, 10, 0, 0, 2626, 2627, 7, 7, 0, 0, 2627, 2628, 7, 16, 0, 0, 2628,
+		349, 1, 0, 0, 0, 2629, 2630, 7, 14, 0, 0, 2630, 2631, 7, 19, 0, 0, 2631,
+		2632, 7, 7, 0, 0, 2632, 2633, 7, 16, 0, 0, 2633, 2634, 7, 17, 0, 0, 2634,
+		2635, 7, 7, 0, 0, 2635, 2636, 7, 22, 0, 0, 2636, 2637, 7, 10, 0, 0, 2637,
+		351, 1, 0, 0, 0, 2638, 2639, 7, 14, 0, 0, 2639, 2640, 7, 19, 0, 0, 2640,
+		2641, 7, 7, 0, 0, 2641, 2642, 7, 27, 0, 0, 2642, 2643, 7, 10, 0, 0, 2643,
+		2644, 7, 13, 0, 0, 2644, 2645, 7, 9, 0, 0, 2645, 2646, 7, 17, 0, 0, 2646,
+		2647, 7, 19, 0, 0, 2647, 2648, 7, 7, 0, 0, 2648, 353, 1, 0, 0, 0, 2649,
+		2650, 7, 14, 0, 0, 2650, 2651, 7, 19, 0, 0, 2651, 2652, 7, 24, 0, 0, 2652,
+		2653, 7, 8, 0, 0, 2653, 355, 1, 0, 0, 0, 2654, 2655, 7, 14, 0, 0, 2655,
+		2656, 7, 19, 0, 0, 2656, 2657, 7, 9, 0, 0, 2657, 2658, 7, 16, 0, 0, 2658,
+		357, 1, 0, 0, 0, 2659, 2660, 7, 14, 0, 0, 2660, 2661, 7, 9, 0, 0, 2661,
+		2662, 7, 27, 0, 0, 2662, 359, 1, 0, 0, 0, 2663, 2664, 7, 14, 0, 0, 2664,
+		2665, 7, 22, 0, 0, 2665, 2666, 7, 13, 0, 0, 2666, 2667, 7, 9, 0, 0, 2667,
+		2668, 7, 19, 0, 0, 2668, 2669, 7, 13, 0, 0, 2669, 361, 1, 0, 0, 0, 2670,
+		2671, 7, 14, 0, 0, 2671, 2672, 7, 8, 0, 0, 2672, 2673, 7, 14, 0, 0, 2673,
+		2674, 7, 6, 0, 0, 2674, 2675, 7, 10, 0, 0, 2675, 363, 1, 0, 0, 0, 2676,
+		2677, 7, 12, 0, 0, 2677, 2678, 7, 5, 0, 0, 2678, 2679, 7, 16, 0, 0, 2679,
+		2680, 7, 5, 0, 0, 2680, 365, 1, 0, 0, 0, 2681, 2682, 7, 12, 0, 0, 2682,
+		2683, 7, 5, 0, 0, 2683, 2684, 7, 16, 0, 0, 2684, 2685, 7, 5, 0, 0, 2685,
+		2686, 7, 18, 0, 0, 2686, 2687, 7, 5, 0, 0, 2687, 2688, 7, 9, 0, 0, 2688,
+		2689, 7, 10, 0, 0, 2689, 367, 1, 0, 0, 0, 2690, 2691, 7, 12, 0, 0, 2691,
+		2692, 7, 5, 0, 0, 2692, 2693, 7, 8, 0, 0, 2693, 369, 1, 0, 0, 0, 2694,
+		2695, 7, 12, 0, 0, 2695, 2696, 7, 10, 0, 0, 2696, 2697, 7, 5, 0, 0, 2697,
+		2698, 7, 6, 0, 0, 2698, 2699, 7, 6, 0, 0, 2699, 2700, 7, 19, 0, 0, 2700,
+		2701, 7, 14, 0, 0, 2701, 2702, 7, 5, 0, 0, 2702, 2703, 7, 16, 0, 0, 2703,
+		2704, 7, 10, 0, 0, 2704, 371, 1, 0, 0, 0, 2705, 2706, 7, 12, 0, 0, 2706,
+		2707, 7, 10, 0, 0, 2707, 2708, 7, 14, 0, 0, 2708, 2709, 7, 6, 0, 0, 2709,
+		2710, 7, 5, 0, 0, 2710, 2711, 7, 13, 0, 0, 2711, 2712, 7, 10, 0, 0, 2712,
+		373, 1, 0, 0, 0, 2713, 2714, 7, 12, 0, 0, 2714, 2715, 7, 10, 0, 0, 2715,
+		2716, 7, 25, 0, 0, 2716, 2717, 7, 5, 0, 0, 2717, 2718, 7, 22, 0, 0, 2718,
+		2719, 7, 6, 0, 0, 2719, 2720, 7, 16, 0, 0, 2720, 2721, 7, 9, 0, 0, 2721,
+		375, 1, 0, 0, 0, 2722, 2723, 7, 12, 0, 0, 2723, 2724, 7, 10, 0, 0, 2724,
+		2725, 7, 25, 0, 0, 2725, 2726, 7, 10, 0, 0, 2726, 2727, 7, 13, 0, 0, 2727,
+		2728, 7, 13, 0, 0, 2728, 2729, 7, 10, 0, 0, 2729, 2730, 7, 12, 0, 0, 2730,
+		377, 1, 0, 0, 0, 2731, 2732, 7, 12, 0, 0, 2732, 2733, 7, 10, 0, 0, 2733,
+		2734, 7, 25, 0, 0, 2734, 2735, 7, 17, 0, 0, 2735, 2736, 7, 7, 0, 0, 2736,
+		2737, 7, 10, 0, 0, 2737, 2738, 7, 13, 0, 0, 2738, 379, 1, 0, 0, 0, 2739,
+		2740, 7, 12, 0, 0, 2740, 2741, 7, 10, 0, 0, 2741, 2742, 7, 6, 0, 0, 2742,
+		2743, 7, 10, 0, 0, 2743, 2744, 7, 16, 0, 0, 2744, 2745, 7, 10, 0, 0, 2745,
+		381, 1, 0, 0, 0, 2746, 2747, 7, 12, 0, 0, 2747, 2748, 7, 10, 0, 0, 2748,
+		2749, 7, 6, 0, 0, 2749, 2750, 7, 17, 0, 0, 2750, 2751, 7, 15, 0, 0, 2751,
+		2752, 7, 17, 0, 0, 2752, 2753, 7, 16, 0, 0, 2753, 2754, 7, 10, 0, 0, 2754,
+		2755, 7, 13, 0, 0, 2755, 383, 1, 0, 0, 0, 2756, 2757, 7, 12, 0, 0, 2757,
+		2758, 7, 10, 0, 0, 2758, 2759, 7, 6, 0, 0, 2759, 2760, 7, 17, 0, 0, 2760,
+		2761, 7, 15, 0, 0, 2761, 2762, 7, 17, 0, 0, 2762, 2763, 7, 16, 0, 0, 2763,
+		2764, 7, 10, 0, 0, 2764, 2765, 7, 13, 0, 0, 2765, 2766, 7, 9, 0, 0, 2766,
+		385, 1, 0, 0, 0, 2767, 2768, 7, 12, 0, 0, 2768, 2769, 7, 17, 0, 0, 2769,
+		2770, 7, 14, 0, 0, 2770, 2771, 7, 16, 0, 0, 2771, 2772, 7, 17, 0, 0, 2772,
+		2773, 7, 19, 0, 0, 2773, 2774, 7, 7, 0, 0, 2774, 2775, 7, 5, 0, 0, 2775,
+		2776, 7, 13, 0, 0, 2776, 2777, 7, 8, 0, 0, 2777, 387, 1, 0, 0, 0, 2778,
+		2779, 7, 12, 0, 0, 2779, 2780, 7, 17, 0, 0, 2780, 2781, 7, 9, 0, 0, 2781,
+		2782, 7, 5, 0, 0, 2782, 2783, 7, 18, 0, 0, 2783, 2784, 7, 6, 0, 0, 2784,
+		2785, 7, 10, 0, 0, 2785, 389, 1, 0, 0, 0, 2786, 2787, 7, 12, 0, 0, 2787,
+		2788, 7, 17, 0, 0, 2788, 2789, 7, 9, 0, 0, 2789, 2790, 7, 14, 0, 0, 2790,
+		2791, 7, 5, 0, 0, 2791, 2792, 7, 13, 0, 0, 2792, 2793, 7, 12, 0, 0, 2793,
+		391, 1, 0, 0, 0, 2794, 2795, 7, 12, 0, 0, 2795, 2796, 7, 19, 0, 0, 2796,
+		2797, 7, 14, 0, 0, 2797, 2798, 7, 22, 0, 0, 2798, 2799, 7, 15, 0, 0, 2799,
+		2800, 7, 10, 0, 0, 2800, 2801, 7, 7, 0, 0, 2801, 2802, 7, 16, 0, 0, 2802,
+		393, 1, 0, 0, 0, 2803, 2804, 7, 12, 0, 0, 2804, 2805, 7, 19, 0, 0, 2805,
+		2806, 7, 15, 0, 0, 2806, 2807, 7, 5, 0, 0, 2807, 2808, 7, 17, 0, 0, 2808,
+		2809, 7, 7, 0, 0, 2809, 395, 1, 0, 0, 0, 2810, 2811, 7, 12, 0, 0, 2811,
+		2812, 7, 19, 0, 0, 2812, 2813, 7, 22, 0, 0, 2813, 2814, 7, 18, 0, 0, 2814,
+		2815, 7, 6, 0, 0, 2815, 2816, 7, 10, 0, 0, 2816, 397, 1, 0, 0, 0, 2817,
+		2818, 7, 12, 0, 0, 2818, 2819, 7, 13, 0, 0, 2819, 2820, 7, 19, 0, 0, 2820,
+		2821, 7, 24, 0, 0, 2821, 399, 1, 0, 0, 0, 2822, 2823, 7, 10, 0, 0, 2823,
+		2824, 7, 5, 0, 0, 2824, 2825, 7, 14, 0, 0, 2825, 2826, 7, 20, 0, 0, 2826,
+		401, 1, 0, 0, 0, 2827, 2828, 7, 10, 0, 0, 2828, 2829, 7, 15, 0, 0, 2829,
+		2830, 7, 24, 0, 0, 2830, 2831, 7, 16, 0, 0, 2831, 2832, 7, 8, 0, 0, 2832,
+		403, 1, 0, 0, 0, 2833, 2834, 7, 10, 0, 0, 2834, 2835, 7, 7, 0, 0, 2835,
+		2836, 7, 5, 0, 0, 2836, 2837, 7, 18, 0, 0, 2837, 2838, 7, 6, 0, 0, 2838,
+		2839, 7, 10, 0, 0, 2839, 405, 1, 0, 0, 0, 2840, 2841, 7, 10, 0, 0, 2841,
+		2842, 7, 7, 0, 0, 2842, 2843, 7, 14, 0, 0, 2843, 2844, 7, 19, 0, 0, 2844,
+		2845, 7, 12, 0, 0, 2845, 2846, 7, 17, 0, 0, 2846, 2847, 7, 7, 0, 0, 2847,
+		2848, 7, 23, 0, 0, 2848, 407, 1, 0, 0, 0, 2849, 2850, 7, 10, 0, 0, 2850,
+		2851, 7, 7, 0, 0, 2851, 2852, 7, 14, 0, 0, 2852, 2853, 7, 13, 0, 0, 2853,
+		2854, 7, 8, 0, 0, 2854, 2855, 7, 24, 0, 0, 2855, 2856, 7, 16, 0, 0, 2856,
+		2857, 7, 10, 0, 0, 2857, 2858, 7, 12, 0, 0, 2858, 409, 1, 0, 0, 0, 2859,
+		2860, 7, 10, 0, 0, 2860, 2861, 7, 7, 0, 0, 2861, 2862, 7, 22, 0, 0, 2862,
+		2863, 7, 15, 0, 0, 2863, 411, 1, 0, 0, 0, 2864, 2865, 7, 10, 0, 0, 2865,
+		2866, 7, 9, 0, 0, 2866, 2867, 7, 14, 0, 0, 2867, 2868, 7, 5, 0, 0, 2868,
+		2869, 7, 24, 0, 0, 2869, 2870, 7, 10, 0, 0, 2870, 413, 1, 0, 0, 0, 2871,
+		2872, 7, 10, 0, 0, 2872, 2873, 7, 27, 0, 0, 2873, 2874, 7, 10, 0, 0, 2874,
+		2875, 7, 7, 0, 0, 2875, 2876, 7, 16, 0, 0, 2876, 415, 1, 0, 0, 0, 2877,
+		2878, 7, 10, 0, 0, 2878, 2879, 7, 26, 0, 0, 2879, 2880, 7, 14, 0, 0, 2880,
+		2881, 7, 6, 0, 0, 2881, 2882, 7, 22, 0, 0, 2882, 2883, 7, 12, 0, 0, 2883,
+		2884, 7, 10, 0, 0, 2884, 417, 1, 0, 0, 0, 2885, 2886, 7, 10, 0, 0, 2886,
+		2887, 7, 26, 0, 0, 2887, 2888, 7, 14, 0, 0, 2888, 2889, 7, 6, 0, 0, 2889,
+		2890, 7, 22, 0, 0, 2890, 2891, 7, 12, 0, 0, 2891, 2892, 7, 17, 0, 0, 2892,
+		2893, 7, 7, 0, 0, 2893, 2894, 7, 23, 0, 0, 2894, 419, 1, 0, 0, 0, 2895,
+		2896, 7, 10, 0, 0, 2896, 2897, 7, 26, 0, 0, 2897, 2898, 7, 14, 0, 0, 2898,
+		2899, 7, 6, 0, 0, 2899, 2900, 7, 22, 0, 0, 2900, 2901, 7, 9, 0, 0, 2901,
+		2902, 7, 17, 0, 0, 2902, 2903, 7, 27, 0, 0, 2903, 2904, 7, 10, 0, 0, 2904,
+		421, 1, 0, 0, 0, 2905, 2906, 7, 10, 0, 0, 2906, 2907, 7, 26, 0, 0, 2907,
+		2908, 7, 10, 0, 0, 2908, 2909, 7, 14, 0, 0, 2909, 2910, 7, 22, 0, 0, 2910,
+		2911, 7, 16, 0, 0, 2911, 2912, 7, 10, 0, 0, 2912, 423, 1, 0, 0, 0, 2913,
+		2914, 7, 10, 0, 0, 2914, 2915, 7, 26, 0, 0, 2915, 2916, 7, 24, 0, 0, 2916,
+		2917, 7, 6, 0, 0, 2917, 2918, 7, 5, 0, 0, 2918, 2919, 7, 17, 0, 0, 2919,
+		2920, 7, 7, 0, 0, 2920, 425, 1, 0, 0, 0, 2921, 2922, 7, 10, 0, 0, 2922,
+		2923, 7, 26, 0, 0, 2923, 2924, 7, 16, 0, 0, 2924, 2925, 7, 10, 0, 0, 2925,
+		2926, 7, 7, 0, 0, 2926, 2927, 7, 9, 0, 0, 2927, 2928, 7, 17, 0, 0, 2928,
+		2929, 7, 19, 0, 0, 2929, 2930, 7, 7, 0, 0, 2930, 427, 1, 0, 0, 0, 2931,
+		2932, 7, 10, 0, 0, 2932, 2933, 7, 26, 0, 0, 2933, 2934, 7, 16, 0, 0, 2934,
+		2935, 7, 10, 0, 0, 2935, 2936, 7, 13, 0, 0, 2936, 2937, 7, 7, 0, 0, 2937,
+		2938, 7, 5, 0, 0, 2938, 2939, 7, 6, 0, 0, 2939, 429, 1, 0, 0, 0, 2940,
+		2941, 7, 25, 0, 0, 2941, 2942, 7, 5, 0, 0, 2942, 2943, 7, 15, 0, 0, 2943,
+		2944, 7, 17, 0, 0, 2944, 2945, 7, 6, 0, 0, 2945, 2946, 7, 8, 0, 0, 2946,
+		431, 1, 0, 0, 0, 2947, 2948, 7, 25, 0, 0, 2948, 2949, 7, 17, 0, 0, 2949,
+		2950, 7, 13, 0, 0, 2950, 2951, 7, 9, 0, 0, 2951, 2952, 7, 16, 0, 0, 2952,
+		433, 1, 0, 0, 0, 2953, 2954, 7, 25, 0, 0, 2954, 2955, 7, 19, 0, 0, 2955,
+		2956, 7, 6, 0, 0, 2956, 2957, 7, 6, 0, 0, 2957, 2958, 7, 19, 0, 0, 2958,
+		2959, 7, 29, 0, 0, 2959, 2960, 7, 17, 0, 0, 2960, 2961, 7, 7, 0, 0, 2961,
+		2962, 7, 23, 0, 0, 2962, 435, 1, 0, 0, 0, 2963, 2964, 7, 25, 0, 0, 2964,
+		2965, 7, 19, 0, 0, 2965, 2966, 7, 13, 0, 0, 2966, 2967, 7, 14, 0, 0, 2967,
+		2968, 7, 10, 0, 0, 2968, 437, 1, 0, 0, 0, 2969, 2970, 7, 25, 0, 0, 2970,
+		2971, 7, 19, 0, 0, 2971, 2972, 7, 13, 0, 0, 2972, 2973, 7, 29, 0, 0, 2973,
+		2974, 7, 5, 0, 0, 2974, 2975, 7, 13, 0, 0, 2975, 2976, 7, 12, 0, 0, 2976,
+		439, 1, 0, 0, 0, 2977, 2978, 7, 25, 0, 0, 2978, 2979, 7, 22, 0, 0, 2979,
+		2980, 7, 7, 0, 0, 2980, 2981, 7, 14, 0, 0, 2981, 2982, 7, 16, 0, 0, 2982,
+		2983, 7, 17, 0, 0, 2983, 2984, 7, 19, 0, 0, 2984, 2985, 7, 7, 0, 0, 2985,
+		441, 1, 0, 0, 0, 2986, 2987, 7, 25, 0, 0, 2987, 2988, 7, 22, 0, 0, 2988,
+		2989, 7, 7, 0, 0, 2989, 2990, 7, 14, 0, 0, 2990, 2991, 7, 16, 0, 0, 2991,
+		2992, 7, 17, 0, 0, 2992, 2993, 7, 19, 0, 0, 2993, 2994, 7, 7, 0, 0, 2994,
+		2995, 7, 9, 0, 0, 2995, 443, 1, 0, 0, 0, 2996, 2997, 7, 23, 0, 0, 2997,
+		2998, 7, 6, 0, 0, 2998, 2999, 7, 19, 0, 0, 2999, 3000, 7, 18, 0, 0, 3000,
+		3001, 7, 5, 0, 0, 3001, 3002, 7, 6, 0, 0, 3002, 445, 1, 0, 0, 0, 3003,
+		3004, 7, 23, 0, 0, 3004, 3005, 7, 13, 0, 0, 3005, 3006, 7, 5, 0, 0, 3006,
+		3007, 7, 7, 0, 0, 3007, 3008, 7, 16, 0, 0, 3008, 3009, 7, 10, 0, 0, 3009,
+		3010, 7, 12, 0, 0, 3010, 447, 1, 0, 0, 0, 3011, 3012, 7, 20, 0, 0, 3012,
+		3013, 7, 5, 0, 0, 3013, 3014, 7, 7, 0, 0, 3014, 3015, 7, 12, 0, 0, 3015,
+		3016, 7, 6, 0, 0, 3016, 3017, 7, 10, 0, 0, 3017, 3018, 7, 13, 0, 0, 3018,
+		449, 1, 0, 0, 0, 3019, 3020, 7, 20, 0, 0, 3020, 3021, 7, 10, 0, 0, 3021,
+		3022, 7, 5, 0, 0, 3022, 3023, 7, 12, 0, 0, 3023, 3024, 7, 10, 0, 0, 3024,
+		3025, 7, 13, 0, 0, 3025, 451, 1, 0, 0, 0, 3026, 3027, 7, 20, 0, 0, 3027,
+		3028, 7, 19, 0, 0, 3028, 3029, 7, 6, 0, 0, 3029, 3030, 7, 12, 0, 0, 3030,
+		453, 1, 0, 0, 0, 3031, 3032, 7, 20, 0, 0, 3032, 3033, 7, 19, 0, 0, 3033,
+		3034, 7, 22, 0, 0, 3034, 3035, 7, 13, 0, 0, 3035, 455, 1, 0, 0, 0, 3036,
+		3037, 7, 17, 0, 0, 3037, 3038, 7, 12, 0, 0, 3038, 3039, 7, 10, 0, 0, 3039,
+		3040, 7, 7, 0, 0, 3040, 3041, 7, 16, 0, 0, 3041, 3042, 7, 17, 0, 0, 3042,
+		3043, 7, 16, 0, 0, 3043, 3044, 7, 8, 0, 0, 3044, 457, 1, 0, 0, 0, 3045,
+		3046, 7, 17, 0, 0, 3046, 3047, 7, 25, 0, 0, 3047, 459, 1, 0, 0, 0, 3048,
+		3049, 7, 17, 0, 0, 3049, 3050, 7, 15, 0, 0, 3050, 3051, 7, 15, 0, 0, 3051,
+		3052, 7, 10, 0, 0, 3052, 3053, 7, 12, 0, 0, 3053, 3054, 7, 17, 0, 0, 3054,
+		3055, 7, 5, 0, 0, 3055, 3056, 7, 16, 0, 0, 3056, 3057, 7, 10, 0, 0, 3057,
+		461, 1, 0, 0, 0, 3058, 3059, 7, 17, 0, 0, 3059, 3060, 7, 15, 0, 0, 3060,
+		3061, 7, 15, 0, 0, 3061, 3062, 7, 22, 0, 0, 3062, 3063, 7, 16, 0, 0, 3063,
+		3064, 7, 5, 0, 0, 3064, 3065, 7, 18, 0, 0, 3065, 3066, 7, 6, 0, 0, 3066,
+		3067, 7, 10, 0, 0, 3067, 463, 1, 0, 0, 0, 3068, 3069, 7, 17, 0, 0, 3069,
+		3070, 7, 15, 0, 0, 3070, 3071, 7, 24, 0, 0, 3071, 3072, 7, 6, 0, 0, 3072,
+		3073, 7, 17, 0, 0, 3073, 3074, 7, 14, 0, 0, 3074, 3075, 7, 17, 0, 0, 3075,
+		3076, 7, 16, 0, 0, 3076, 465, 1, 0, 0, 0, 3077, 3078, 7, 17, 0, 0, 3078,
+		3079, 7, 7, 0, 0, 3079, 3080, 7, 14, 0, 0, 3080, 3081, 7, 6, 0, 0, 3081,
+		3082, 7, 22, 0, 0, 3082, 3083, 7, 12, 0, 0, 3083, 3084, 7, 17, 0, 0, 3084,
+		3085, 7, 7, 0, 0, 3085, 3086, 7, 23, 0, 0, 3086, 467, 1, 0, 0, 0, 3087,
+		3088, 7, 17, 0, 0, 3088, 3089, 7, 7, 0, 0, 3089, 3090, 7, 14, 0, 0, 3090,
+		3091, 7, 13, 0, 0, 3091, 3092, 7, 10, 0, 0, 3092, 3093, 7, 15, 0, 0, 3093,
+		3094, 7, 10, 0, 0, 3094, 3095, 7, 7, 0, 0, 3095, 3096, 7, 16, 0, 0, 3096,
+		469, 1, 0, 0, 0, 3097, 3098, 7, 17, 0, 0, 3098, 3099, 7, 7, 0, 0, 3099,
+		3100, 7, 12, 0, 0, 3100, 3101, 7, 10, 0, 0, 3101, 3102, 7, 26, 0, 0, 3102,
+		471, 1, 0, 0, 0, 3103, 3104, 7, 17, 0, 0, 3104, 3105, 7, 7, 0, 0, 3105,
+		3106, 7, 12, 0, 0, 3106, 3107, 7, 10, 0, 0, 3107, 3108, 7, 26, 0, 0, 3108,
+		3109, 7, 10, 0, 0, 3109, 3110, 7, 9, 0, 0, 3110, 473, 1, 0, 0, 0, 3111,
+		3112, 7, 17, 0, 0, 3112, 3113, 7, 7, 0, 0, 3113, 3114, 7, 20, 0, 0, 3114,
+		3115, 7, 10, 0, 0, 3115, 3116, 7, 13, 0, 0, 3116, 3117, 7, 17, 0, 0, 3117,
+		3118, 7, 16, 0, 0, 3118, 475, 1, 0, 0, 0, 3119, 3120, 7, 17, 0, 0, 3120,
+		3121, 7, 7, 0, 0, 3121, 3122, 7, 20, 0, 0, 3122, 3123, 7, 10, 0, 0, 3123,
+		3124, 7, 13, 0, 0, 3124, 3125, 7, 17, 0, 0, 3125, 3126, 7, 16, 0, 0, 3126,
+		3127, 7, 9, 0, 0, 3127, 477, 1, 0, 0, 0, 3128, 3129, 7, 17, 0, 0, 3129,
+		3130, 7, 7, 0, 0, 3130, 3131, 7, 6, 0, 0, 3131, 3132, 7, 17, 0, 0, 3132,
+		3133, 7, 7, 0, 0, 3133, 3134, 7, 10, 0, 0, 3134, 479, 1, 0, 0, 0, 3135,
+		3136, 7, 17, 0, 0, 3136, 3137, 7, 7, 0, 0, 3137, 3138, 7, 9, 0, 0, 3138,
+		3139, 7, 10, 0, 0, 3139, 3140, 7, 7, 0, 0, 3140, 3141, 7, 9, 0, 0, 3141,
+		3142, 7, 17, 0, 0, 3142, 3143, 7, 16, 0, 0, 3143, 3144, 7, 17, 0, 0, 3144,
+		3145, 7, 27, 0, 0, 3145, 3146, 7, 10, 0, 0, 3146, 481, 1, 0, 0, 0, 3147,
+		3148, 7, 17, 0, 0, 3148, 3149, 7, 7, 0, 0, 3149, 3150, 7, 9, 0, 0, 3150,
+		3151, 7, 10, 0, 0, 3151, 3152, 7, 13, 0, 0, 3152, 3153, 7, 16, 0, 0, 3153,
+		483, 1, 0, 0, 0, 3154, 3155, 7, 17, 0, 0, 3155, 3156, 7, 7, 0, 0, 3156,
+		3157, 7, 9, 0, 0, 3157, 3158, 7, 16, 0, 0, 3158, 3159, 7, 10, 0, 0, 3159,
+		3160, 7, 5, 0, 0, 3160, 3161, 7, 12, 0, 0, 3161, 485, 1, 0, 0, 0, 3162,
+		3163, 7, 17, 0, 0, 3163, 3164, 7, 7, 0, 0, 3164, 3165, 7, 27, 0, 0, 3165,
+		3166, 7, 19, 0, 0, 3166, 3167, 7, 21, 0, 0, 3167, 3168, 7, 10, 0, 0, 3168,
+		3169, 7, 13, 0, 0, 3169, 487, 1, 0, 0, 0, 3170, 3171, 7, 17, 0, 0, 3171,
+		3172, 7, 9, 0, 0, 3172, 3173, 7, 19, 0, 0, 3173, 3174, 7, 6, 0, 0, 3174,
+		3175, 7, 5, 0, 0, 3175, 3176, 7, 16, 0, 0, 3176, 3177, 7, 17, 0, 0, 3177,
+		3178, 7, 19, 0, 0, 3178, 3179, 7, 7, 0, 0, 3179, 489, 1, 0, 0, 0, 3180,
+		3181, 7, 30, 0, 0, 3181, 3182, 7, 9, 0, 0, 3182, 3183, 7, 19, 0, 0, 3183,
+		3184, 7, 7, 0, 0, 3184, 491, 1, 0, 0, 0, 3185, 3186, 7, 21, 0, 0, 3186,
+		3187, 7, 10, 0, 0, 3187, 3188, 7, 10, 0, 0, 3188, 3189, 7, 24, 0, 0, 3189,
+		493, 1, 0, 0, 0, 3190, 3191, 7, 21, 0, 0, 3191, 3192, 7, 10, 0, 0, 3192,
+		3193, 7, 8, 0, 0, 3193, 495, 1, 0, 0, 0, 3194, 3195, 7, 21, 0, 0, 3195,
+		3196, 7, 10, 0, 0, 3196, 3197, 7, 8, 0, 0, 3197, 3198, 7, 9, 0, 0, 3198,
+		497, 1, 0, 0, 0, 3199, 3200, 7, 6, 0, 0, 3200, 3201, 7, 5, 0, 0, 3201,
+		3202, 7, 18, 0, 0, 3202, 3203, 7, 10, 0, 0, 3203, 3204, 7, 6, 0, 0, 3204,
+		499, 1, 0, 0, 0, 3205, 3206, 7, 6, 0, 0, 3206, 3207, 7, 5, 0, 0, 3207,
+		3208, 7, 7, 0, 0, 3208, 3209, 7, 23, 0, 0, 3209, 3210, 7, 22, 0, 0, 3210,
+		3211, 7, 5, 0, 0, 3211, 3212, 7, 23, 0, 0, 3212, 3213, 7, 10, 0, 0, 3213,
+		501, 1, 0, 0, 0, 3214, 3215, 7, 6, 0, 0, 3215, 3216, 7, 5, 0, 0, 3216,
+		3217, 7, 13, 0, 0, 3217, 3218, 7, 23, 0, 0, 3218, 3219, 7, 10, 0, 0, 3219,
+		503, 1, 0, 0, 0, 3220, 3221, 7, 6, 0, 0, 3221, 3222, 7, 5, 0, 0, 3222,
+		3223, 7, 9, 0, 0, 3223, 3224, 7, 16, 0, 0, 3224, 505, 1, 0, 0, 0, 3225,
+		3226, 7, 6, 0, 0, 3226, 3227, 7, 10, 0, 0, 3227, 3228, 7, 5, 0, 0, 3228,
+		3229, 7, 21, 0, 0, 3229, 3230, 7, 24, 0, 0, 3230, 3231, 7, 13, 0, 0, 3231,
+		3232, 7, 19, 0, 0, 3232, 3233, 7, 19, 0, 0, 3233, 3234, 7, 25, 0, 0, 3234,
+		507, 1, 0, 0, 0, 3235, 3236, 7, 6, 0, 0, 3236, 3237, 7, 10, 0, 0, 3237,
+		3238, 7, 27, 0, 0, 3238, 3239, 7, 10, 0, 0, 3239, 3240, 7, 6, 0, 0, 3240,
+		509, 1, 0, 0, 0, 3241, 3242, 7, 6, 0, 0, 3242, 3243, 7, 17, 0, 0, 3243,
+		3244, 7, 9, 0, 0, 3244, 3245, 7, 16, 0, 0, 3245, 3246, 7, 10, 0, 0, 3246,
+		3247, 7, 7, 0, 0, 3247, 511, 1, 0, 0, 0, 3248, 3249, 7, 6, 0, 0, 3249,
+		3250, 7, 19, 0, 0, 3250, 3251, 7, 5, 0, 0, 3251, 3252, 7, 12, 0, 0, 3252,
+		513, 1, 0, 0, 0, 3253, 3254, 7, 6, 0, 0, 3254, 3255, 7, 19, 0, 0, 3255,
+		3256, 7, 14, 0, 0, 3256, 3257, 7, 5, 0, 0, 3257, 3258, 7, 6, 0, 0, 3258,
+		515, 1, 0, 0, 0, 3259, 3260, 7, 6, 0, 0, 3260, 3261, 7, 19, 0, 0, 3261,
+		3262, 7, 14, 0, 0, 3262, 3263, 7, 5, 0, 0, 3263, 3264, 7, 16, 0, 0, 3264,
+		3265, 7, 17, 0, 0, 3265, 3266, 7, 19, 0, 0, 3266, 3267, 7, 7, 0, 0, 3267,
+		517, 1, 0, 0, 0, 3268, 3269, 7, 6, 0, 0, 3269, 3270, 7, 19, 0, 0, 3270,
+		3271, 7, 14, 0, 0, 3271, 3272, 7, 21, 0, 0, 3272, 519, 1, 0, 0, 0, 3273,
+		3274, 7, 15, 0, 0, 3274, 3275, 7, 5, 0, 0, 3275, 3276, 7, 24, 0, 0, 3276,
+		3277, 7, 24, 0, 0, 3277, 3278, 7, 17, 0, 0, 3278, 3279, 7, 7, 0, 0, 3279,
+		3280, 7, 23, 0, 0, 3280, 521, 1, 0, 0, 0, 3281, 3282, 7, 15, 0, 0, 3282,
+		3283, 7, 5, 0, 0, 3283, 3284, 7, 16, 0, 0, 3284, 3285, 7, 14, 0, 0, 3285,
+		3286, 7, 20, 0, 0, 3286, 523, 1, 0, 0, 0, 3287, 3288, 7, 15, 0, 0, 3288,
+		3289, 7, 5, 0, 0, 3289, 3290, 7, 16, 0, 0, 3290, 3291, 7, 14, 0, 0, 3291,
+		3292, 7, 20, 0, 0, 3292, 3293, 7, 10, 0, 0, 3293, 3294, 7, 12, 0, 0, 3294,
+		525, 1, 0, 0, 0, 3295, 3296, 7, 15, 0, 0, 3296, 3297, 7, 5, 0, 0, 3297,
+		3298, 7, 16, 0, 0, 3298, 3299, 7, 10, 0, 0, 3299, 3300, 7, 13, 0, 0, 3300,
+		3301, 7, 17, 0, 0, 3301, 3302, 7, 5, 0, 0, 3302, 3303, 7, 6, 0, 0, 3303,
+		3304, 7, 17, 0, 0, 3304, 3305, 7, 11, 0, 0, 3305, 3306, 7, 10, 0, 0, 3306,
+		3307, 7, 12, 0, 0, 3307, 527, 1, 0, 0, 0, 3308, 3309, 7, 15, 0, 0, 3309,
+		3310, 7, 5, 0, 0, 3310, 3311, 7, 26, 0, 0, 3311, 3312, 7, 27, 0, 0, 3312,
+		3313, 7, 5, 0, 0, 3313, 3314, 7, 6, 0, 0, 3314, 3315, 7, 22, 0, 0, 3315,
+		3316, 7, 10, 0, 0, 3316, 529, 1, 0, 0, 0, 3317, 3318, 7, 15, 0, 0, 3318,
+		3319, 7, 10, 0, 0, 3319, 3320, 7, 13, 0, 0, 3320, 3321, 7, 23, 0, 0, 3321,
+		3322, 7, 10, 0, 0, 3322, 531, 1, 0, 0, 0, 3323, 3324, 7, 15, 0, 0, 3324,
+		3325, 7, 17, 0, 0, 3325, 3326, 7, 7, 0, 0, 3326, 3327, 7, 22, 0, 0, 3327,
+		3328, 7, 16, 0, 0, 3328, 3329, 7, 10, 0, 0, 3329, 533, 1, 0, 0, 0, 3330,
+		3331, 7, 15, 0, 0, 3331, 3332, 7, 17, 0, 0, 3332, 3333, 7, 7, 0, 0, 3333,
+		3334, 7, 27, 0, 0, 3334, 3335, 7, 5, 0, 0, 3335, 3336, 7, 6, 0, 0, 3336,
+		3337, 7, 22, 0, 0, 3337, 3338, 7, 10, 0, 0, 3338, 535, 1, 0, 0, 0, 3339,
+		3340, 7, 15, 0, 0, 3340, 3341, 7, 19, 0, 0, 3341, 3342, 7, 12, 0, 0, 3342,
+		3343, 7, 10, 0, 0, 3343, 537, 1, 0, 0, 0, 3344, 3345, 7, 15, 0, 0, 3345,
+		3346, 7, 19, 0, 0, 3346, 3347, 7, 7, 0, 0, 3347, 3348, 7, 16, 0, 0, 3348,
+		3349, 7, 20, 0, 0, 3349, 539, 1, 0, 0, 0, 3350, 3351, 7, 15, 0, 0, 3351,
+		3352, 7, 19, 0, 0, 3352, 3353, 7, 27, 0, 0, 3353, 3354, 7, 10, 0, 0, 3354,
+		541, 1, 0, 0, 0, 3355, 3356, 7, 7, 0, 0, 3356, 3357, 7, 5, 0, 0, 3357,
+		3358, 7, 15, 0, 0, 3358, 3359, 7, 10, 0, 0, 3359, 543, 1, 0, 0, 0, 3360,
+		3361, 7, 7, 0, 0, 3361, 3362, 7, 5, 0, 0, 3362, 3363, 7, 15, 0, 0, 3363,
+		3364, 7, 10, 0, 0, 3364, 3365, 7, 9, 0, 0, 3365, 545, 1, 0, 0, 0, 3366,
+		3367, 7, 7, 0, 0, 3367, 3368, 7, 10, 0, 0, 3368, 3369, 7, 9, 0, 0, 3369,
+		3370, 7, 16, 0, 0, 3370, 3371, 7, 10, 0, 0, 3371, 3372, 7, 12, 0, 0, 3372,
+		547, 1, 0, 0, 0, 3373, 3374, 7, 7, 0, 0, 3374, 3375, 7, 10, 0, 0, 3375,
+		3376, 7, 26, 0, 0, 3376, 3377, 7, 16, 0, 0, 3377, 549, 1, 0, 0, 0, 3378,
+		3379, 7, 7, 0, 0, 3379, 3380, 7, 19, 0, 0, 3380, 551, 1, 0, 0, 0, 3381,
+		3382, 7, 7, 0, 0, 3382, 3383, 7, 19, 0, 0, 3383, 3384, 7, 16, 0, 0, 3384,
+		3385, 7, 20, 0, 0, 3385, 3386, 7, 17, 0, 0, 3386, 3387, 7, 7, 0, 0, 3387,
+		3388, 7, 23, 0, 0, 3388, 553, 1, 0, 0, 0, 3389, 3390, 7, 7, 0, 0, 3390,
+		3391, 7, 19, 0, 0, 3391, 3392, 7, 16, 0, 0, 3392, 3393, 7, 17, 0, 0, 3393,
+		3394, 7, 25, 0, 0, 3394, 3395, 7, 8, 0, 0, 3395, 555, 1, 0, 0, 0, 3396,
+		3397, 7, 7, 0, 0, 3397, 3398, 7, 19, 0, 0, 3398, 3399, 7, 29, 0, 0, 3399,
+		3400, 7, 5, 0, 0, 3400, 3401, 7, 17, 0, 0, 3401, 3402, 7, 16, 0, 0, 3402,
+		557, 1, 0, 0, 0, 3403, 3404, 7, 7, 0, 0, 3404, 3405, 7, 22, 0, 0, 3405,
+		3406, 7, 6, 0, 0, 3406, 3407, 7, 6, 0, 0, 3407, 3408, 7, 9, 0, 0, 3408,
+		559, 1, 0, 0, 0, 3409, 3410, 7, 19, 0, 0, 3410, 3411, 7, 18, 0, 0, 3411,
+		3412, 7, 30, 0, 0, 3412, 3413, 7, 10, 0, 0, 3413, 3414, 7, 14, 0, 0, 3414,
+		3415, 7, 16, 0, 0, 3415, 561, 1, 0, 0, 0, 3416, 3417, 7, 19, 0, 0, 3417,
+		3418, 7, 25, 0, 0, 3418, 563, 1, 0, 0, 0, 3419, 3420, 7, 19, 0, 0, 3420,
+		3421, 7, 25, 0, 0, 3421, 3422, 7, 25, 0, 0, 3422, 565, 1, 0, 0, 0, 3423,
+		3424, 7, 19, 0, 0, 3424, 3425, 7, 17, 0, 0, 3425, 3426, 7, 12, 0, 0, 3426,
+		3427, 7, 9, 0, 0, 3427, 567, 1, 0, 0, 0, 3428, 3429, 7, 19, 0, 0, 3429,
+		3430, 7, 15, 0, 0, 3430, 3431, 7, 17, 0, 0, 3431, 3432, 7, 16, 0, 0, 3432,
+		569, 1, 0, 0, 0, 3433, 3434, 7, 19, 0, 0, 3434, 3435, 7, 24, 0, 0, 3435,
+		3436, 7, 10, 0, 0, 3436, 3437, 7, 13, 0, 0, 3437, 3438, 7, 5, 0, 0, 3438,
+		3439, 7, 16, 0, 0, 3439, 3440, 7, 19, 0, 0, 3440, 3441, 7, 13, 0, 0, 3441,
+		571, 1, 0, 0, 0, 3442, 3443, 7, 19, 0, 0, 3443, 3444, 7, 24, 0, 0, 3444,
+		3445, 7, 16, 0, 0, 3445, 3446, 7, 17, 0, 0, 3446, 3447, 7, 19, 0, 0, 3447,
+		3448, 7, 7, 0, 0, 3448, 573, 1, 0, 0, 0, 3449, 3450, 7, 19, 0, 0, 3450,
+		3451, 7, 24, 0, 0, 3451, 3452, 7, 16, 0, 0, 3452, 3453, 7, 17, 0, 0, 3453,
+		3454, 7, 19, 0, 0, 3454, 3455, 7, 7, 0, 0, 3455, 3456, 7, 9, 0, 0, 3456,
+		575, 1, 0, 0, 0, 3457, 3458, 7, 19, 0, 0, 3458, 3459, 7, 29, 0, 0, 3459,
+		3460, 7, 7, 0, 0, 3460, 3461, 7, 10, 0, 0, 3461, 3462, 7, 12, 0, 0, 3462,
+		577, 1, 0, 0, 0, 3463, 3464, 7, 19, 0, 0, 3464, 3465, 7, 29, 0, 0, 3465,
+		3466, 7, 7, 0, 0, 3466, 3467, 7, 10, 0, 0, 3467, 3468, 7, 13, 0, 0, 3468,
+		579, 1, 0, 0, 0, 3469, 3470, 7, 24, 0, 0, 3470, 3471, 7, 5, 0, 0, 3471,
+		3472, 7, 13, 0, 0, 3472, 3473, 7, 9, 0, 0, 3473, 3474, 7, 10, 0, 0, 3474,
+		3475, 7, 13, 0, 0, 3475, 581, 1, 0, 0, 0, 3476, 3477, 7, 24, 0, 0, 3477,
+		3478, 7, 5, 0, 0, 3478, 3479, 7, 13, 0, 0, 3479, 3480, 7, 16, 0, 0, 3480,
+		3481, 7, 17, 0, 0, 3481, 3482, 7, 5, 0, 0, 3482, 3483, 7, 6, 0, 0, 3483,
+		583, 1, 0, 0, 0, 3484, 3485, 7, 24, 0, 0, 3485, 3486, 7, 5, 0, 0, 3486,
+		3487, 7, 13, 0, 0, 3487, 3488, 7, 16, 0, 0, 3488, 3489, 7, 17, 0, 0, 3489,
+		3490, 7, 16, 0, 0, 3490, 3491, 7, 17, 0, 0, 3491, 3492, 7, 19, 0, 0, 3492,
+		3493, 7, 7, 0, 0, 3493, 585, 1, 0, 0, 0, 3494, 3495, 7, 24, 0, 0, 3495,
+		3496, 7, 5, 0, 0, 3496, 3497, 7, 9, 0, 0, 3497, 3498, 7, 9, 0, 0, 3498,
+		3499, 7, 17, 0, 0, 3499, 3500, 7, 7, 0, 0, 3500, 3501, 7, 23, 0, 0, 3501,
+		587, 1, 0, 0, 0, 3502, 3503, 7, 24, 0, 0, 3503, 3504, 7, 5, 0, 0, 3504,
+		3505, 7, 9, 0, 0, 3505, 3506, 7, 9, 0, 0, 3506, 3507, 7, 29, 0, 0, 3507,
+		3508, 7, 19, 0, 0, 3508, 3509, 7, 13, 0, 0, 3509, 3510, 7, 12, 0, 0, 3510,
+		589, 1, 0, 0, 0, 3511, 3512, 7, 24, 0, 0, 3512, 3513, 7, 5, 0, 0, 3513,
+		3514, 7, 16, 0, 0, 3514, 3515, 7, 20, 0, 0, 3515, 591, 1, 0, 0, 0, 3516,
+		3517, 7, 24, 0, 0, 3517, 3518, 7, 6, 0, 0, 3518, 3519, 7, 5, 0, 0, 3519,
+		3520, 7, 7, 0, 0, 3520, 3521, 7, 9, 0, 0, 3521, 593, 1, 0, 0, 0, 3522,
+		3523, 7, 24, 0, 0, 3523, 3524, 7, 13, 0, 0, 3524, 3525, 7, 10, 0, 0, 3525,
+		3526, 7, 14, 0, 0, 3526, 3527, 7, 10, 0, 0, 3527, 3528, 7, 12, 0, 0, 3528,
+		3529, 7, 17, 0, 0, 3529, 3530, 7, 7, 0, 0, 3530, 3531, 7, 23, 0, 0, 3531,
+		595, 1, 0, 0, 0, 3532, 3533, 7, 24, 0, 0, 3533, 3534, 7, 13, 0, 0, 3534,
+		3535, 7, 10, 0, 0, 3535, 3536, 7, 24, 0, 0, 3536, 3537, 7, 5, 0, 0, 3537,
+		3538, 7, 13, 0, 0, 3538, 3539, 7, 10, 0, 0, 3539, 597, 1, 0, 0, 0, 3540,
+		3541, 7, 24, 0, 0, 3541, 3542, 7, 13, 0, 0, 3542, 3543, 7, 10, 0, 0, 3543,
+		3544, 7, 24, 0, 0, 3544, 3545, 7, 5, 0, 0, 3545, 3546, 7, 13, 0, 0, 3546,
+		3547, 7, 10, 0, 0, 3547, 3548, 7, 12, 0, 0, 3548, 599, 1, 0, 0, 0, 3549,
+		3550, 7, 24, 0, 0, 3550, 3551, 7, 13, 0, 0, 3551, 3552, 7, 10, 0, 0, 3552,
+		3553, 7, 9, 0, 0, 3553, 3554, 7, 10, 0, 0, 3554, 3555, 7, 13, 0, 0, 3555,
+		3556, 7, 27, 0, 0, 3556, 3557, 7, 10, 0, 0, 3557, 601, 1, 0, 0, 0, 3558,
+		3559, 7, 24, 0, 0, 3559, 3560, 7, 13, 0, 0, 3560, 3561, 7, 17, 0, 0, 3561,
+		3562, 7, 19, 0, 0, 3562, 3563, 7, 13, 0, 0, 3563, 603, 1, 0, 0, 0, 3564,
+		3565, 7, 24, 0, 0, 3565, 3566, 7, 13, 0, 0, 3566, 3567, 7, 17, 0, 0, 3567,
+		3568, 7, 27, 0, 0, 3568, 3569, 7, 17, 0, 0, 3569, 3570, 7, 6, 0, 0, 3570,
+		3571, 7, 10, 0, 0, 3571, 3572, 7, 23, 0, 0, 3572, 3573, 7, 10, 0, 0, 3573,
+		3574, 7, 9, 0, 0, 3574, 605, 1, 0, 0, 0, 3575, 3576, 7, 24, 0, 0, 3576,
+		3577, 7, 13, 0, 0, 3577, 3578, 7, 19, 0, 0, 3578, 3579, 7, 14, 0, 0, 3579,
+		3580, 7, 10, 0, 0, 3580, 3581, 7, 12, 0, 0, 3581, 3582, 7, 22, 0, 0, 3582,
+		3583, 7, 13, 0, 0, 3583, 3584, 7, 5, 0, 0, 3584, 3585, 7, 6, 0, 0, 3585,
+		607, 1, 0, 0, 0, 3586, 3587, 7, 24, 0, 0, 3587, 3588, 7, 13, 0, 0, 3588,
+		3589, 7, 19, 0, 0, 3589, 3590, 7, 14, 0, 0, 3590, 3591, 7, 10, 0, 0, 3591,
+		3592, 7, 12, 0, 0, 3592, 3593, 7, 22, 0, 0, 3593, 3594, 7, 13, 0, 0, 3594,
+		3595, 7, 10, 0, 0, 3595, 609, 1, 0, 0, 0, 3596, 3597, 7, 24, 0, 0, 3597,
+		3598, 7, 13, 0, 0, 3598, 3599, 7, 19, 0, 0, 3599, 3600, 7, 23, 0, 0, 3600,
+		3601, 7, 13, 0, 0, 3601, 3602, 7, 5, 0, 0, 3602, 3603, 7, 15, 0, 0, 3603,
+		611, 1, 0, 0, 0, 3604, 3605, 7, 28, 0, 0, 3605, 3606, 7, 22, 0, 0, 3606,
+		3607, 7, 19, 0, 0, 3607, 3608, 7, 16, 0, 0, 3608, 3609, 7, 10, 0, 0, 3609,
+		613, 1, 0, 0, 0, 3610, 3611, 7, 28, 0, 0, 3611, 3612, 7, 22, 0, 0, 3612,
+		3613, 7, 19, 0, 0, 3613, 3614, 7, 16, 0, 0, 3614, 3615, 7, 10, 0, 0, 3615,
+		3616, 7, 9, 0, 0, 3616, 615, 1, 0, 0, 0, 3617, 3618, 7, 13, 0, 0, 3618,
+		3619, 7, 5, 0, 0, 3619, 3620, 7, 7, 0, 0, 3620, 3621, 7, 23, 0, 0, 3621,
+		3622, 7, 10, 0, 0, 3622, 617, 1, 0, 0, 0, 3623, 3624, 7, 13, 0, 0, 3624,
+		3625, 7, 10, 0, 0, 3625, 3626, 7, 5, 0, 0, 3626, 3627, 7, 12, 0, 0, 3627,
+		619, 1, 0, 0, 0, 3628, 3629, 7, 13, 0, 0, 3629, 3630, 7, 10, 0, 0, 3630,
+		3631, 7, 5, 0, 0, 3631, 3632, 7, 9, 0, 0, 3632, 3633, 7, 9, 0, 0, 3633,
+		3634, 7, 17, 0, 0, 3634, 3635, 7, 23, 0, 0, 3635, 3636, 7, 7, 0, 0, 3636,
+		621, 1, 0, 0, 0, 3637, 3638, 7, 13, 0, 0, 3638, 3639, 7, 10, 0, 0, 3639,
+		3640, 7, 14, 0, 0, 3640, 3641, 7, 20, 0, 0, 3641, 3642, 7, 10, 0, 0, 3642,
+		3643, 7, 14, 0, 0, 3643, 3644, 7, 21, 0, 0, 3644, 623, 1, 0, 0, 0, 3645,
+		3646, 7, 13, 0, 0, 3646, 3647, 7, 10, 0, 0, 3647, 3648, 7, 14, 0, 0, 3648,
+		3649, 7, 22, 0, 0, 3649, 3650, 7, 13, 0, 0, 3650, 3651, 7, 9, 0, 0, 3651,
+		3652, 7, 17, 0, 0, 3652, 3653, 7, 27, 0, 0, 3653, 3654, 7, 10, 0, 0, 3654,
+		625, 1, 0, 0, 0, 3655, 3656, 7, 13, 0, 0, 3656, 3657, 7, 10, 0, 0, 3657,
+		3658, 7, 25, 0, 0, 3658, 627, 1, 0, 0, 0, 3659, 3660, 7, 13, 0, 0, 3660,
+		3661, 7, 10, 0, 0, 3661, 3662, 7, 25, 0, 0, 3662, 3663, 7, 13, 0, 0, 3663,
+		3664, 7, 10, 0, 0, 3664, 3665, 7, 9, 0, 0, 3665, 3666, 7, 20, 0, 0, 3666,
+		629, 1, 0, 0, 0, 3667, 3668, 7, 13, 0, 0, 3668, 3669, 7, 10, 0, 0, 3669,
+		3670, 7, 17, 0, 0, 3670, 3671, 7, 7, 0, 0, 3671, 3672, 7, 12, 0, 0, 3672,
+		3673, 7, 10, 0, 0, 3673, 3674, 7, 26, 0, 0, 3674, 631, 1, 0, 0, 0, 3675,
+		3676, 7, 13, 0, 0, 3676, 3677, 7, 10, 0, 0, 3677, 3678, 7, 6, 0, 0, 3678,
+		3679, 7, 5, 0, 0, 3679, 3680, 7, 16, 0, 0, 3680, 3681, 7, 17, 0, 0, 3681,
+		3682, 7, 27, 0, 0, 3682, 3683, 7, 10, 0, 0, 3683, 633, 1, 0, 0, 0, 3684,
+		3685, 7, 13, 0, 0, 3685, 3686, 7, 10, 0, 0, 3686, 3687, 7, 6, 0, 0, 3687,
+		3688, 7, 10, 0, 0, 3688, 3689, 7, 5, 0, 0, 3689, 3690, 7, 9, 0, 0, 3690,
+		3691, 7, 10, 0, 0, 3691, 635, 1, 0, 0, 0, 3692, 3693, 7, 13, 0, 0, 3693,
+		3694, 7, 10, 0, 0, 3694, 3695, 7, 7, 0, 0, 3695, 3696, 7, 5, 0, 0, 3696,
+		3697, 7, 15, 0, 0, 3697, 3698, 7, 10, 0, 0, 3698, 637, 1, 0, 0, 0, 3699,
+		3700, 7, 13, 0, 0, 3700, 3701, 7, 10, 0, 0, 3701, 3702, 7, 24, 0, 0, 3702,
+		3703, 7, 10, 0, 0, 3703, 3704, 7, 5, 0, 0, 3704, 3705, 7, 16, 0, 0, 3705,
+		3706, 7, 5, 0, 0, 3706, 3707, 7, 18, 0, 0, 3707, 3708, 7, 6, 0, 0, 3708,
+		3709, 7, 10, 0, 0, 3709, 639, 1, 0, 0, 0, 3710, 3711, 7, 13, 0, 0, 3711,
+		3712, 7, 10, 0, 0, 3712, 3713, 7, 24, 0, 0, 3713, 3714, 7, 6, 0, 0, 3714,
+		3715, 7, 5, 0, 0, 3715, 3716, 7, 14, 0, 0, 3716, 3717, 7, 10, 0, 0, 3717,
+		641, 1, 0, 0, 0, 3718, 3719, 7, 13, 0, 0, 3719, 3720, 7, 10, 0, 0, 3720,
+		3721, 7, 24, 0, 0, 3721, 3722, 7, 6, 0, 0, 3722, 3723, 7, 17, 0, 0, 3723,
+		3724, 7, 14, 0, 0, 3724, 3725, 7, 5, 0, 0, 3725, 643, 1, 0, 0, 0, 3726,
+		3727, 7, 13, 0, 0, 3727, 3728, 7, 10, 0, 0, 3728, 3729, 7, 9, 0, 0, 3729,
+		3730, 7, 10, 0, 0, 3730, 3731, 7, 16, 0, 0, 3731, 645, 1, 0, 0, 0, 3732,
+		3733, 7, 13, 0, 0, 3733, 3734, 7, 10, 0, 0, 3734, 3735, 7, 9, 0, 0, 3735,
+		3736, 7, 16, 0, 0, 3736, 3737, 7, 5, 0, 0, 3737, 3738, 7, 13, 0, 0, 3738,
+		3739, 7, 16, 0, 0, 3739, 647, 1, 0, 0, 0, 3740, 3741, 7, 13, 0, 0, 3741,
+		3742, 7, 10, 0, 0, 3742, 3743, 7, 9, 0, 0, 3743, 3744, 7, 16, 0, 0, 3744,
+		3745, 7, 13, 0, 0, 3745, 3746, 7, 17, 0, 0, 3746, 3747, 7, 14, 0, 0, 3747,
+		3748, 7, 16, 0, 0, 3748, 649, 1, 0, 0, 0, 3749, 3750, 7, 13, 0, 0, 3750,
+		3751, 7, 10, 0, 0, 3751, 3752, 7, 16, 0, 0, 3752, 3753, 7, 22, 0, 0, 3753,
+		3754, 7, 13, 0, 0, 3754, 3755, 7, 7, 0, 0, 3755, 3756, 7, 9, 0, 0, 3756,
+		651, 1, 0, 0, 0, 3757, 3758, 7, 13, 0, 0, 3758, 3759, 7, 10, 0, 0, 3759,
+		3760, 7, 27, 0, 0, 3760, 3761, 7, 19, 0, 0, 3761, 3762, 7, 21, 0, 0, 3762,
+		3763, 7, 10, 0, 0, 3763, 653, 1, 0, 0, 0, 3764, 3765, 7, 13, 0, 0, 3765,
+		3766, 7, 19, 0, 0, 3766, 3767, 7, 6, 0, 0, 3767, 3768, 7, 10, 0, 0, 3768,
+		655, 1, 0, 0, 0, 3769, 3770, 7, 13, 0, 0, 3770, 3771, 7, 19, 0, 0, 3771,
+		3772, 7, 6, 0, 0, 3772, 3773, 7, 6, 0, 0, 3773, 3774, 7, 18, 0, 0, 3774,
+		3775, 7, 5, 0, 0, 3775, 3776, 7, 14, 0, 0, 3776, 3777, 7, 21, 0, 0, 3777,
+		657, 1, 0, 0, 0, 3778, 3779, 7, 13, 0, 0, 3779, 3780, 7, 19, 0, 0, 3780,
+		3781, 7, 29, 0, 0, 3781, 3782, 7, 9, 0, 0, 3782, 659, 1, 0, 0, 0, 3783,
+		3784, 7, 13, 0, 0, 3784, 3785, 7, 22, 0, 0, 3785, 3786, 7, 6, 0, 0, 3786,
+		3787, 7, 10, 0, 0, 3787, 661, 1, 0, 0, 0, 3788, 3789, 7, 9, 0, 0, 3789,
+		3790, 7, 5, 0, 0, 3790, 3791, 7, 27, 0, 0, 3791, 3792, 7, 10, 0, 0, 3792,
+		3793, 7, 24, 0, 0, 3793, 3794, 7, 19, 0, 0, 3794, 3795, 7, 17, 0, 0, 3795,
+		3796, 7, 7, 0, 0, 3796, 3797, 7, 16, 0, 0, 3797, 663, 1, 0, 0, 0, 3798,
+		3799, 7, 9, 0, 0, 3799, 3800, 7, 14, 0, 0, 3800, 3801, 7, 5, 0, 0, 3801,
+		3802, 7, 6, 0, 0, 3802, 3803, 7, 5, 0, 0, 3803, 3804, 7, 13, 0, 0, 3804,
+		665, 1, 0, 0, 0, 3805, 3806, 7, 9, 0, 0, 3806, 3807, 7, 14, 0, 0, 3807,
+		3808, 7, 20, 0, 0, 3808, 3809, 7, 10, 0, 0, 3809, 3810, 7, 15, 0, 0, 3810,
+		3811, 7, 5, 0, 0, 3811, 667, 1, 0, 0, 0, 3812, 3813, 7, 9, 0, 0, 3813,
+		3814, 7, 14, 0, 0, 3814, 3815, 7, 13, 0, 0, 3815, 3816, 7, 19, 0, 0, 3816,
+		3817, 7, 6, 0, 0, 3817, 3818, 7, 6, 0, 0, 3818, 669, 1, 0, 0, 0, 3819,
+		3820, 7, 9, 0, 0, 3820, 3821, 7, 10, 0, 0, 3821, 3822, 7, 5, 0, 0, 3822,
+		3823, 7, 13, 0, 0, 3823, 3824, 7, 14, 0, 0, 3824, 3825, 7, 20, 0, 0, 3825,
+		671, 1, 0, 0, 0, 3826, 3827, 7, 9, 0, 0, 3827, 3828, 7, 10, 0, 0, 3828,
+		3829, 7, 14, 0, 0, 3829, 3830, 7, 19, 0, 0, 3830, 3831, 7, 7, 0, 0, 3831,
+		3832, 7, 12, 0, 0, 3832, 673, 1, 0, 0, 0, 3833, 3834, 7, 9, 0, 0, 3834,
+		3835, 7, 10, 0, 0, 3835, 3836, 7, 14, 0, 0, 3836, 3837, 7, 22, 0, 0, 3837,
+		3838, 7, 13, 0, 0, 3838, 3839, 7, 17, 0, 0, 3839, 3840, 7, 16, 0, 0, 3840,
+		3841, 7, 8, 0, 0, 3841, 675, 1, 0, 0, 0, 3842, 3843, 7, 9, 0, 0, 3843,
+		3844, 7, 10, 0, 0, 3844, 3845, 7, 28, 0, 0, 3845, 3846, 7, 22, 0, 0, 3846,
+		3847, 7, 10, 0, 0, 3847, 3848, 7, 7, 0, 0, 3848, 3849, 7, 14, 0, 0, 3849,
+		3850, 7, 10, 0, 0, 3850, 677, 1, 0, 0, 0, 3851, 3852, 7, 9, 0, 0, 3852,
+		3853, 7, 10, 0, 0, 3853, 3854, 7, 28, 0, 0, 3854, 3855, 7, 22, 0, 0, 3855,
+		3856, 7, 10, 0, 0, 3856, 3857, 7, 7, 0, 0, 3857, 3858, 7, 14, 0, 0, 3858,
+		3859, 7, 10, 0, 0, 3859, 3860, 7, 9, 0, 0, 3860, 679, 1, 0, 0, 0, 3861,
+		3862, 7, 9, 0, 0, 3862, 3863, 7, 10, 0, 0, 3863, 3864, 7, 13, 0, 0, 3864,
+		3865, 7, 17, 0, 0, 3865, 3866, 7, 5, 0, 0, 3866, 3867, 7, 6, 0, 0, 3867,
+		3868, 7, 17, 0, 0, 3868, 3869, 7, 11, 0, 0, 3869, 3870, 7, 5, 0, 0, 3870,
+		3871, 7, 18, 0, 0, 3871, 3872, 7, 6, 0, 0, 3872, 3873, 7, 10, 0, 0, 3873,
+		681, 1, 0, 0, 0, 3874, 3875, 7, 9, 0, 0, 3875, 3876, 7, 10, 0, 0, 3876,
+		3877, 7, 13, 0, 0, 3877, 3878, 7, 27, 0, 0, 3878, 3879, 7, 10, 0, 0, 3879,
+		3880, 7, 13, 0, 0, 3880, 683, 1, 0, 0, 0, 3881, 3882, 7, 9, 0, 0, 3882,
+		3883, 7, 10, 0, 0, 3883, 3884, 7, 9, 0, 0, 3884, 3885, 7, 9, 0, 0, 3885,
+		3886, 7, 17, 0, 0, 3886, 3887, 7, 19, 0, 0, 3887, 3888, 7, 7, 0, 0, 3888,
+		685, 1, 0, 0, 0, 3889, 3890, 7, 9, 0, 0, 3890, 3891, 7, 10, 0, 0, 3891,
+		3892, 7, 16, 0, 0, 3892, 687, 1, 0, 0, 0, 3893, 3894, 7, 9, 0, 0, 3894,
+		3895, 7, 20, 0, 0, 3895, 3896, 7, 5, 0, 0, 3896, 3897, 7, 13, 0, 0, 3897,
+		3898, 7, 10, 0, 0, 3898, 689, 1, 0, 0, 0, 3899, 3900, 7, 9, 0, 0, 3900,
+		3901, 7, 20, 0, 0, 3901, 3902, 7, 19, 0, 0, 3902, 3903, 7, 29, 0, 0, 3903,
+		691, 1, 0, 0, 0, 3904, 3905, 7, 9, 0, 0, 3905, 3906, 7, 17, 0, 0, 3906,
+		3907, 7, 15, 0, 0, 3907, 3908, 7, 24, 0, 0, 3908, 3909, 7, 6, 0, 0, 3909,
+		3910, 7, 10, 0, 0, 3910, 693, 1, 0, 0, 0, 3911, 3912, 7, 9, 0, 0, 3912,
+		3913, 7, 7, 0, 0, 3913, 3914, 7, 5, 0, 0, 3914, 3915, 7, 24, 0, 0, 3915,
+		3916, 7, 9, 0, 0, 3916, 3917, 7, 20, 0, 0, 3917, 3918, 7, 19, 0, 0, 3918,
+		3919, 7, 16, 0, 0, 3919, 695, 1, 0, 0, 0, 3920, 3921, 7, 9, 0, 0, 3921,
+		3922, 7, 19, 0, 0, 3922, 3923, 7, 22, 0, 0, 3923, 3924, 7, 13, 0, 0, 3924,
+		3925, 7, 14, 0, 0, 3925, 3926, 7, 10, 0, 0, 3926, 697, 1, 0, 0, 0, 3927,
+		3928, 7, 9, 0, 0, 3928, 3929, 7, 16, 0, 0, 3929, 3930, 7, 5, 0, 0, 3930,
+		3931, 7, 18, 0, 0, 3931, 3932, 7, 6, 0, 0, 3932, 3933, 7, 10, 0, 0, 3933,
+		699, 1, 0, 0, 0, 3934, 3935, 7, 9, 0, 0, 3935, 3936, 7, 16, 0, 0, 3936,
+		3937, 7, 5, 0, 0, 3937, 3938, 7, 7, 0, 0, 3938, 3939, 7, 12, 0, 0, 3939,
+		3940, 7, 5, 0, 0, 3940, 3941, 7, 6, 0, 0, 3941, 3942, 7, 19, 0, 0, 3942,
+		3943, 7, 7, 0, 0, 3943, 3944, 7, 10, 0, 0, 3944, 701, 1, 0, 0, 0, 3945,
+		3946, 7, 9, 0, 0, 3946, 3947, 7, 16, 0, 0, 3947, 3948, 7, 5, 0, 0, 3948,
+		3949, 7, 13, 0, 0, 3949, 3950, 7, 16, 0, 0, 3950, 703, 1, 0, 0, 0, 3951,
+		3952, 7, 9, 0, 0, 3952, 3953, 7, 16, 0, 0, 3953, 3954, 7, 5, 0, 0, 3954,
+		3955, 7, 16, 0, 0, 3955, 3956, 7, 10, 0, 0, 3956, 3957, 7, 15, 0, 0, 3957,
+		3958, 7, 10, 0, 0, 3958, 3959, 7, 7, 0, 0, 3959, 3960, 7, 16, 0, 0, 3960,
+		705, 1, 0, 0, 0, 3961, 3962, 7, 9, 0, 0, 3962, 3963, 7, 16, 0, 0, 3963,
+		3964, 7, 5, 0, 0, 3964, 3965, 7, 16, 0, 0, 3965, 3966, 7, 17, 0, 0, 3966,
+		3967, 7, 9, 0, 0, 3967, 3968, 7, 16, 0, 0, 3968, 3969, 7, 17, 0, 0, 3969,
+		3970, 7, 14, 0, 0, 3970, 3971, 7, 9, 0, 0, 3971, 707, 1, 0, 0, 0, 3972,
+		3973, 7, 9, 0, 0, 3973, 3974, 7, 16, 0, 0, 3974, 3975, 7, 12, 0, 0, 3975,
+		3976, 7, 17, 0, 0, 3976, 3977, 7, 7, 0, 0, 3977, 709, 1, 0, 0, 0, 3978,
+		3979, 7, 9, 0, 0, 3979, 3980, 7, 16, 0, 0, 3980, 3981, 7, 12, 0, 0, 3981,
+		3982, 7, 19, 0, 0, 3982, 3983, 7, 22, 0, 0, 3983, 3984, 7, 16, 0, 0, 3984,
+		711, 1, 0, 0, 0, 3985, 3986, 7, 9, 0, 0, 3986, 3987, 7, 16, 0, 0, 3987,
+		3988, 7, 19, 0, 0, 3988, 3989, 7, 13, 0, 0, 3989, 3990, 7, 5, 0, 0, 3990,
+		3991, 7, 23, 0, 0, 3991, 3992, 7, 10, 0, 0, 3992, 713, 1, 0, 0, 0, 3993,
+		3994, 7, 9, 0, 0, 3994, 3995, 7, 16, 0, 0, 3995, 3996, 7, 13, 0, 0, 3996,
+		3997, 7, 17, 0, 0, 3997, 3998, 7, 14, 0, 0, 3998, 3999, 7, 16, 0, 0, 3999,
+		715, 1, 0, 0, 0, 4000, 4001, 7, 9, 0, 0, 4001, 4002, 7, 16, 0, 0, 4002,
+		4003, 7, 13, 0, 0, 4003, 4004, 7, 17, 0, 0, 4004, 4005, 7, 7, 0, 0, 4005,
+		4006, 7, 23, 0, 0, 4006, 717, 1, 0, 0, 0, 4007, 4008, 7, 9, 0, 0, 4008,
+		4009, 7, 16, 0, 0, 4009, 4010, 7, 13, 0, 0, 4010, 4011, 7, 17, 0, 0, 4011,
+		4012, 7, 24, 0, 0, 4012, 719, 1, 0, 0, 0, 4013, 4014, 7, 9, 0, 0, 4014,
+		4015, 7, 8, 0, 0, 4015, 4016, 7, 9, 0, 0, 4016, 4017, 7, 17, 0, 0, 4017,
+		4018, 7, 12, 0, 0, 4018, 721, 1, 0, 0, 0, 4019, 4020, 7, 9, 0, 0, 4020,
+		4021, 7, 8, 0, 0, 4021, 4022, 7, 9, 0, 0, 4022, 4023, 7, 16, 0, 0, 4023,
+		4024, 7, 10, 0, 0, 4024, 4025, 7, 15, 0, 0, 4025, 723, 1, 0, 0, 0, 4026,
+		4027, 7, 16, 0, 0, 4027, 4028, 7, 5, 0, 0, 4028, 4029, 7, 18, 0, 0, 4029,
+		4030, 7, 6, 0, 0, 4030, 4031, 7, 10, 0, 0, 4031, 4032, 7, 9, 0, 0, 4032,
+		725, 1, 0, 0, 0, 4033, 4034, 7, 16, 0, 0, 4034, 4035, 7, 5, 0, 0, 4035,
+		4036, 7, 18, 0, 0, 4036, 4037, 7, 6, 0, 0, 4037, 4038, 7, 10, 0, 0, 4038,
+		4039, 7, 9, 0, 0, 4039, 4040, 7, 24, 0, 0, 4040, 4041, 7, 5, 0, 0, 4041,
+		4042, 7, 14, 0, 0, 4042, 4043, 7, 10, 0, 0, 4043, 727, 1, 0, 0, 0, 4044,
+		4045, 7, 16, 0, 0, 4045, 4046, 7, 5, 0, 0, 4046, 4047, 7, 13, 0, 0, 4047,
+		4048, 7, 23, 0, 0, 4048, 4049, 7, 10, 0, 0, 4049, 4050, 7, 16, 0, 0, 4050,
+		729, 1, 0, 0, 0, 4051, 4052, 7, 16, 0, 0, 4052, 4053, 7, 10, 0, 0, 4053,
+		4054, 7, 15, 0, 0, 4054, 4055, 7, 24, 0, 0, 4055, 731, 1, 0, 0, 0, 4056,
+		4057, 7, 16, 0, 0, 4057, 4058, 7, 10, 0, 0, 4058, 4059, 7, 15, 0, 0, 4059,
+		4060, 7, 24, 0, 0, 4060, 4061, 7, 6, 0, 0, 4061, 4062, 7, 5, 0, 0, 4062,
+		4063, 7, 16, 0, 0, 4063, 4064, 7, 10, 0, 0, 4064, 733, 1, 0, 0, 0, 4065,
+		4066, 7, 16, 0, 0, 4066, 4067, 7, 10, 0, 0, 4067, 4068, 7, 15, 0, 0, 4068,
+		4069, 7, 24, 0, 0, 4069, 4070, 7, 19, 0, 0, 4070, 4071, 7, 13, 0, 0, 4071,
+		4072, 7, 5, 0, 0, 4072, 4073, 7, 13, 0, 0, 4073, 4074, 7, 8, 0, 0, 4074,
+		735, 1, 0, 0, 0, 4075, 4076, 7, 16, 0, 0, 4076, 4077, 7, 10, 0, 0, 4077,
+		4078, 7, 26, 0, 0, 4078, 4079, 7, 16, 0, 0, 4079, 737, 1, 0, 0, 0, 4080,
+		4081, 7, 16, 0, 0, 4081, 4082, 7, 13, 0, 0, 4082, 4083, 7, 5, 0, 0, 4083,
+		4084, 7, 7, 0, 0, 4084, 4085, 7, 9, 0, 0, 4085, 4086, 7, 5, 0, 0, 4086,
+		4087, 7, 14, 0, 0, 4087, 4088, 7, 16, 0, 0, 4088, 4089, 7, 17, 0, 0, 4089,
+		4090, 7, 19, 0, 0, 4090, 4091, 7, 7, 0, 0, 4091, 739, 1, 0, 0, 0, 4092,
+		4093, 7, 16, 0, 0, 4093, 4094, 7, 13, 0, 0, 4094, 4095, 7, 17, 0, 0, 4095,
+		4096, 7, 23, 0, 0, 4096, 4097, 7, 23, 0, 0, 4097, 4098, 7, 10, 0, 0, 4098,
+		4099, 7, 13, 0, 0, 4099, 741, 1, 0, 0, 0, 4100, 4101, 7, 16, 0, 0, 4101,
+		4102, 7, 13, 0, 0, 4102, 4103, 7, 22, 0, 0, 4103, 4104, 7, 7, 0, 0, 4104,
+		4105, 7, 14, 0, 0, 4105, 4106, 7, 5, 0, 0, 4106, 4107, 7, 16, 0, 0, 4107,
+		4108, 7, 10, 0, 0, 4108, 743, 1, 0, 0, 0, 4109, 4110, 7, 16, 0, 0, 4110,
+		4111, 7, 13, 0, 0, 4111, 4112, 7, 22, 0, 0, 4112, 4113, 7, 9, 0, 0, 4113,
+		4114, 7, 16, 0, 0, 4114, 4115, 7, 10, 0, 0, 4115, 4116, 7, 12, 0, 0, 4116,
+		745, 1, 0, 0, 0, 4117, 4118, 7, 16, 0, 0, 4118, 4119, 7, 8, 0, 0, 4119,
+		4120, 7, 24, 0, 0, 4120, 4121, 7, 10, 0, 0, 4121, 747, 1, 0, 0, 0, 4122,
+		4123, 7, 16, 0, 0, 4123, 4124, 7, 8, 0, 0, 4124, 4125, 7, 24, 0, 0, 4125,
+		4126, 7, 10, 0, 0, 4126, 4127, 7, 9, 0, 0, 4127, 749, 1, 0, 0, 0, 4128,
+		4129, 7, 22, 0, 0, 4129, 4130, 7, 7, 0, 0, 4130, 4131, 7, 18, 0, 0, 4131,
+		4132, 7, 19, 0, 0, 4132, 4133, 7, 22, 0, 0, 4133, 4134, 7, 7, 0, 0, 4134,
+		4135, 7, 12, 0, 0, 4135, 4136, 7, 10, 0, 0, 4136, 4137, 7, 12, 0, 0, 4137,
+		751, 1, 0, 0, 0, 4138, 4139, 7, 22, 0, 0, 4139, 4140, 7, 7, 0, 0, 4140,
+		4141, 7, 14, 0, 0, 4141, 4142, 7, 19, 0, 0, 4142, 4143, 7, 15, 0, 0, 4143,
+		4144, 7, 15, 0, 0, 4144, 4145, 7, 17, 0, 0, 4145, 4146, 7, 16, 0, 0, 4146,
+		4147, 7, 16, 0, 0, 4147, 4148, 7, 10, 0, 0, 4148, 4149, 7, 12, 0, 0, 4149,
+		753, 1, 0, 0, 0, 4150, 4151, 7, 22, 0, 0, 4151, 4152, 7, 7, 0, 0, 4152,
+		4153, 7, 14, 0, 0, 4153, 4154, 7, 19, 0, 0, 4154, 4155, 7, 7, 0, 0, 4155,
+		4156, 7, 12, 0, 0, 4156, 4157, 7, 17, 0, 0, 4157, 4158, 7, 16, 0, 0, 4158,
+		4159, 7, 17, 0, 0, 4159, 4160, 7, 19, 0, 0, 4160, 4161, 7, 7, 0, 0, 4161,
+		4162, 7, 5, 0, 0, 4162, 4163, 7, 6, 0, 0, 4163, 755, 1, 0, 0, 0, 4164,
+		4165, 7, 22, 0, 0, 4165, 4166, 7, 7, 0, 0, 4166, 4167, 7, 10, 0, 0, 4167,
+		4168, 7, 7, 0, 0, 4168, 4169, 7, 14, 0, 0, 4169, 4170, 7, 13, 0, 0, 4170,
+		4171, 7, 8, 0, 0, 4171, 4172, 7, 24, 0, 0, 4172, 4173, 7, 16, 0, 0, 4173,
+		4174, 7, 10, 0, 0, 4174, 4175, 7, 12, 0, 0, 4175, 757, 1, 0, 0, 0, 4176,
+		4177, 7, 22, 0, 0, 4177, 4178, 7, 7, 0, 0, 4178, 4179, 7, 21, 0, 0, 4179,
+		4180, 7, 7, 0, 0, 4180, 4181, 7, 19, 0, 0, 4181, 4182, 7, 29, 0, 0, 4182,
+		4183, 7, 7, 0, 0, 4183, 759, 1, 0, 0, 0, 4184, 4185, 7, 22, 0, 0, 4185,
+		4186, 7, 7, 0, 0, 4186, 4187, 7, 6, 0, 0, 4187, 4188, 7, 17, 0, 0, 4188,
+		4189, 7, 9, 0, 0, 4189, 4190, 7, 16, 0, 0, 4190, 4191, 7, 10, 0, 0, 4191,
+		4192, 7, 7, 0, 0, 4192, 761, 1, 0, 0, 0, 4193, 4194, 7, 22, 0, 0, 4194,
+		4195, 7, 7, 0, 0, 4195, 4196, 7, 6, 0, 0, 4196, 4197, 7, 19, 0, 0, 4197,
+		4198, 7, 23, 0, 0, 4198, 4199, 7, 23, 0, 0, 4199, 4200, 7, 10, 0, 0, 4200,
+		4201, 7, 12, 0, 0, 4201, 763, 1, 0, 0, 0, 4202, 4203, 7, 22, 0, 0, 4203,
+		4204, 7, 7, 0, 0, 4204, 4205, 7, 16, 0, 0, 4205, 4206, 7, 17, 0, 0, 4206,
+		4207, 7, 6, 0, 0, 4207, 765, 1, 0, 0, 0, 4208, 4209, 7, 22, 0, 0, 4209,
+		4210, 7, 24, 0, 0, 4210, 4211, 7, 12, 0, 0, 4211, 4212, 7, 5, 0, 0, 4212,
+		4213, 7, 16, 0, 0, 4213, 4214, 7, 10, 0, 0, 4214, 767, 1, 0, 0, 0, 4215,
+		4216, 7, 27, 0, 0, 4216, 4217, 7, 5, 0, 0, 4217, 4218, 7, 14, 0, 0, 4218,
+		4219, 7, 22, 0, 0, 4219, 4220, 7, 22, 0, 0, 4220, 4221, 7, 15, 0, 0, 4221,
+		769, 1, 0, 0, 0, 4222, 4223, 7, 27, 0, 0, 4223, 4224, 7, 5, 0, 0, 4224,
+		4225, 7, 6, 0, 0, 4225, 4226, 7, 17, 0, 0, 4226, 4227, 7, 12, 0, 0, 4227,
+		771, 1, 0, 0, 0, 4228, 4229, 7, 27, 0, 0, 4229, 4230, 7, 5, 0, 0, 4230,
+		4231, 7, 6, 0, 0, 4231, 4232, 7, 17, 0, 0, 4232, 4233, 7, 12, 0, 0, 4233,
+		4234, 7, 5, 0, 0, 4234, 4235, 7, 16, 0, 0, 4235, 4236, 7, 10, 0, 0, 4236,
+		773, 1, 0, 0, 0, 4237, 4238, 7, 27, 0, 0, 4238, 4239, 7, 5, 0, 0, 4239,
+		4240, 7, 6, 0, 0, 4240, 4241, 7, 17, 0, 0, 4241, 4242, 7, 12, 0, 0, 4242,
+		4243, 7, 5, 0, 0, 4243, 4244, 7, 16, 0, 0, 4244, 4245, 7, 19, 0, 0, 4245,
+		4246, 7, 13, 0, 0, 4246, 775, 1, 0, 0, 0, 4247, 4248, 7, 27, 0, 0, 4248,
+		4249, 7, 5, 0, 0, 4249, 4250, 7, 13, 0, 0, 4250, 4251, 7, 8, 0, 0, 4251,
+		4252, 7, 17, 0, 0, 4252, 4253, 7, 7, 0, 0, 4253, 4254, 7, 23, 0, 0, 4254,
+		777, 1, 0, 0, 0, 4255, 4256, 7, 27, 0, 0, 4256, 4257, 7, 10, 0, 0, 4257,
+		4258, 7, 13, 0, 0, 4258, 4259, 7, 9, 0, 0, 4259, 4260, 7, 17, 0, 0, 4260,
+		4261, 7, 19, 0, 0, 4261, 4262, 7, 7, 0, 0, 4262, 779, 1, 0, 0, 0, 4263,
+		4264, 7, 27, 0, 0, 4264, 4265, 7, 17, 0, 0, 4265, 4266, 7, 10, 0, 0, 4266,
+		4267, 7, 29, 0, 0, 4267, 781, 1, 0, 0, 0, 4268, 4269, 7, 27, 0, 0, 4269,
+		4270, 7, 19, 0, 0, 4270, 4271, 7, 6, 0, 0, 4271, 4272, 7, 5, 0, 0, 4272,
+		4273, 7, 16, 0, 0, 4273, 4274, 7, 17, 0, 0, 4274, 4275, 7, 6, 0, 0, 4275,
+		4276, 7, 10, 0, 0, 4276, 783, 1, 0, 0, 0, 4277, 4278, 7, 29, 0, 0, 4278,
+		4279, 7, 20, 0, 0, 4279, 4280, 7, 17, 0, 0, 4280, 4281, 7, 16, 0, 0, 4281,
+		4282, 7, 10, 0, 0, 4282, 4283, 7, 9, 0, 0, 4283, 4284, 7, 24, 0, 0, 4284,
+		4285, 7, 5, 0, 0, 4285, 4286, 7, 14, 0, 0, 4286, 4287, 7, 10, 0, 0, 4287,
+		785, 1, 0, 0, 0, 4288, 4289, 7, 29, 0, 0, 4289, 4290, 7, 17, 0, 0, 4290,
+		4291, 7, 16, 0, 0, 4291, 4292, 7, 20, 0, 0, 4292, 4293, 7, 19, 0, 0, 4293,
+		4294, 7, 22, 0, 0, 4294, 4295, 7, 16, 0, 0, 4295, 787, 1, 0, 0, 0, 4296,
+		4297, 7, 29, 0, 0, 4297, 4298, 7, 19, 0, 0, 4298, 4299, 7, 13, 0, 0, 4299,
+		4300, 7, 21, 0, 0, 4300, 789, 1, 0, 0, 0, 4301, 4302, 7, 29, 0, 0, 4302,
+		4303, 7, 13, 0, 0, 4303, 4304, 7, 5, 0, 0, 4304, 4305, 7, 24, 0, 0, 4305,
+		4306, 7, 24, 0, 0, 4306, 4307, 7, 10, 0, 0, 4307, 4308, 7, 13, 0, 0, 4308,
+		791, 1, 0, 0, 0, 4309, 4310, 7, 29, 0, 0, 4310, 4311, 7, 13, 0, 0, 4311,
+		4312, 7, 17, 0, 0, 4312, 4313, 7, 16, 0, 0, 4313, 4314, 7, 10, 0, 0, 4314,
+		793, 1, 0, 0, 0, 4315, 4316, 7, 26, 0, 0, 4316, 4317, 7, 15, 0, 0, 4317,
+		4318, 7, 6, 0, 0, 4318, 795, 1, 0, 0, 0, 4319, 4320, 7, 8, 0, 0, 4320,
+		4321, 7, 10, 0, 0, 4321, 4322, 7, 5, 0, 0, 4322, 4323, 7, 13, 0, 0, 4323,
+		797, 1, 0, 0, 0, 4324, 4325, 7, 8, 0, 0, 4325, 4326, 7, 10, 0, 0, 4326,
+		4327, 7, 9, 0, 0, 4327, 799, 1, 0, 0, 0, 4328, 4329, 7, 11, 0, 0, 4329,
+		4330, 7, 19, 0, 0, 4330, 4331, 7, 7, 0, 0, 4331, 4332, 7, 10, 0, 0, 4332,
+		801, 1, 0, 0, 0, 4333, 4334, 7, 5, 0, 0, 4334, 4335, 7, 16, 0, 0, 4335,
+		4336, 7, 19, 0, 0, 4336, 4337, 7, 15, 0, 0, 4337, 4338, 7, 17, 0, 0, 4338,
+		4339, 7, 14, 0, 0, 4339, 803, 1, 0, 0, 0, 4340, 4341, 7, 18, 0, 0, 4341,
+		4342, 7, 10, 0, 0, 4342, 4343, 7, 16, 0, 0, 4343, 4344, 7, 29, 0, 0, 4344,
+		4345, 7, 10, 0, 0, 4345, 4346, 7, 10, 0, 0, 4346, 4347, 7, 7, 0, 0, 4347,
+		805, 1, 0, 0, 0, 4348, 4349, 7, 18, 0, 0, 4349, 4350, 7, 17, 0, 0, 4350,
+		4351, 7, 23, 0, 0, 4351, 4352, 7, 17, 0, 0, 4352, 4353, 7, 7, 0, 0, 4353,
+		4354, 7, 16, 0, 0, 4354, 807, 1, 0, 0, 0, 4355, 4356, 7, 18, 0, 0, 4356,
+		4357, 7, 17, 0, 0, 4357, 4358, 7, 16, 0, 0, 4358, 809, 1, 0, 0, 0, 4359,
+		4360, 7, 18, 0, 0, 4360, 4361, 7, 19, 0, 0, 4361, 4362, 7, 19, 0, 0, 4362,
+		4363, 7, 6, 0, 0, 4363, 4364, 7, 10, 0, 0, 4364, 4365, 7, 5, 0, 0, 4365,
+		4366, 7, 7, 0, 0, 4366, 811, 1, 0, 0, 0, 4367, 4368, 7, 14, 0, 0, 4368,
+		4369, 7, 20, 0, 0, 4369, 4370, 7, 5, 0, 0, 4370, 4371, 7, 13, 0, 0, 4371,
+		813, 1, 0, 0, 0, 4372, 4373, 7, 14, 0, 0, 4373, 4374, 7, 20, 0, 0, 4374,
+		4375, 7, 5, 0, 0, 4375, 4376, 7, 13, 0, 0, 4376, 4377, 7, 5, 0, 0, 4377,
+		4378, 7, 14, 0, 0, 4378, 4379, 7, 16, 0, 0, 4379, 4380, 7, 10, 0, 0, 4380,
+		4381, 7, 13, 0, 0, 4381, 815, 1, 0, 0, 0, 4382, 4383, 7, 14, 0, 0, 4383,
+		4384, 7, 19, 0, 0, 4384, 4385, 7, 5, 0, 0, 4385, 4386, 7, 6, 0, 0, 4386,
+		4387, 7, 10, 0, 0, 4387, 4388, 7, 9, 0, 0, 4388, 4389, 7, 14, 0, 0, 4389,
+		4390, 7, 10, 0, 0, 4390, 817, 1, 0, 0, 0, 4391, 4392, 7, 12, 0, 0, 4392,
+		4393, 7, 10, 0, 0, 4393, 4394, 7, 14, 0, 0, 4394, 819, 1, 0, 0, 0, 4395,
+		4396, 7, 12, 0, 0, 4396, 4397, 7, 10, 0, 0, 4397, 4398, 7, 14, 0, 0, 4398,
+		4399, 7, 17, 0, 0, 4399, 4400, 7, 15, 0, 0, 4400, 4401, 7, 5, 0, 0, 4401,
+		4402, 7, 6, 0, 0, 4402, 821, 1, 0, 0, 0, 4403, 4404, 7, 10, 0, 0, 4404,
+		4405, 7, 26, 0, 0, 4405, 4406, 7, 17, 0, 0, 4406, 4407, 7, 9, 0, 0, 4407,
+		4408, 7, 16, 0, 0, 4408, 4409, 7, 9, 0, 0, 4409, 823, 1, 0, 0, 0, 4410,
+		4411, 7, 10, 0, 0, 4411, 4412, 7, 26, 0, 0, 4412, 4413, 7, 16, 0, 0, 4413,
+		4414, 7, 13, 0, 0, 4414, 4415, 7, 5, 0, 0, 4415, 4416, 7, 14, 0, 0, 4416,
+		4417, 7, 16, 0, 0, 4417, 825, 1, 0, 0, 0, 4418, 4419, 7, 25, 0, 0, 4419,
+		4420, 7, 6, 0, 0, 4420, 4421, 7, 19, 0, 0, 4421, 4422, 7, 5, 0, 0, 4422,
+		4423, 7, 16, 0, 0, 4423, 827, 1, 0, 0, 0, 4424, 4425, 7, 23, 0, 0, 4425,
+		4426, 7, 13, 0, 0, 4426, 4427, 7, 10, 0, 0, 4427, 4428, 7, 5, 0, 0, 4428,
+		4429, 7, 16, 0, 0, 4429, 4430, 7, 10, 0, 0, 4430, 4431, 7, 9, 0, 0, 4431,
+		4432, 7, 16, 0, 0, 4432, 829, 1, 0, 0, 0, 4433, 4434, 7, 17, 0, 0, 4434,
+		4435, 7, 7, 0, 0, 4435, 4436, 7, 19, 0, 0, 4436, 4437, 7, 22, 0, 0, 4437,
+		4438, 7, 16, 0, 0, 4438, 831, 1, 0, 0, 0, 4439, 4440, 7, 17, 0, 0, 4440,
+		4441, 7, 7, 0, 0, 4441, 4442, 7, 16, 0, 0, 4442, 833, 1, 0, 0, 0, 4443,
+		4444, 7, 17, 0, 0, 4444, 4445, 7, 7, 0, 0, 4445, 4446, 7, 16, 0, 0, 4446,
+		4447, 7, 10, 0, 0, 4447, 4448, 7, 23, 0, 0, 4448, 4449, 7, 10, 0, 0, 4449,
+		4450, 7, 13, 0, 0, 4450, 835, 1, 0, 0, 0, 4451, 4452, 7, 17, 0, 0, 4452,
+		4453, 7, 7, 0, 0, 4453, 4454, 7, 16, 0, 0, 4454, 4455, 7, 10, 0, 0, 4455,
+		4456, 7, 13, 0, 0, 4456, 4457, 7, 27, 0, 0, 4457, 4458, 7, 5, 0, 0, 4458,
+		4459, 7, 6, 0, 0, 4459, 837, 1, 0, 0, 0, 4460, 4461, 7, 30, 0, 0, 4461,
+		4462, 7, 9, 0, 0, 4462, 4463, 7, 19, 0, 0, 4463, 4464, 7, 7, 0, 0, 4464,
+		4465, 5, 95, 0, 0, 4465, 4466, 7, 5, 0, 0, 4466, 4467, 7, 13, 0, 0, 4467,
+		4468, 7, 13, 0, 0, 4468, 4469, 7, 5, 0, 0, 4469, 4470, 7, 8, 0, 0, 4470,
+		839, 1, 0, 0, 0, 4471, 4472, 7, 30, 0, 0, 4472, 4473, 7, 9, 0, 0, 4473,
+		4474, 7, 19, 0, 0, 4474, 4475, 7, 7, 0, 0, 4475, 4476, 5, 95, 0, 0, 4476,
+		4477, 7, 5, 0, 0, 4477, 4478, 7, 13, 0, 0, 4478, 4479, 7, 13, 0, 0, 4479,
+		4480, 7, 5, 0, 0, 4480, 4481, 7, 8, 0, 0, 4481, 4482, 7, 5, 0, 0, 4482,
+		4483, 7, 23, 0, 0, 4483, 4484, 7, 23, 0, 0, 4484, 841, 1, 0, 0, 0, 4485,
+		4486, 7, 30, 0, 0, 4486, 4487, 7, 9, 0, 0, 4487, 4488, 7, 19, 0, 0, 4488,
+		4489, 7, 7, 0, 0, 4489, 4490, 5, 95, 0, 0, 4490, 4491, 7, 10, 0, 0, 4491,
+		4492, 7, 26, 0, 0, 4492, 4493, 7, 17, 0, 0, 4493, 4494, 7, 9, 0, 0, 4494,
+		4495, 7, 16, 0, 0, 4495, 4496, 7, 9, 0, 0, 4496, 843, 1, 0, 0, 0, 4497,
+		4498, 7, 30, 0, 0, 4498, 4499, 7, 9, 0, 0, 4499, 4500, 7, 19, 0, 0, 4500,
+		4501, 7, 7, 0, 0, 4501, 4502, 5, 95, 0, 0, 4502, 4503, 7, 19, 0, 0, 4503,
+		4504, 7, 18, 0, 0, 4504, 4505, 7, 30, 0, 0, 4505, 4506, 7, 10, 0, 0, 4506,
+		4507, 7, 14, 0, 0, 4507, 4508, 7, 16, 0, 0, 4508, 845, 1, 0, 0, 0, 4509,
+		4510, 7, 30, 0, 0, 4510, 4511, 7, 9, 0, 0, 4511, 4512, 7, 19, 0, 0, 4512,
+		4513, 7, 7, 0, 0, 4513, 4514, 5, 95, 0, 0, 4514, 4515, 7, 19, 0, 0, 4515,
+		4516, 7, 18, 0, 0, 4516, 4517, 7, 30, 0, 0, 4517, 4518, 7, 10, 0, 0, 4518,
+		4519, 7, 14, 0, 0, 4519, 4520, 7, 16, 0, 0, 4520, 4521, 7, 5, 0, 0, 4521,
+		4522, 7, 23, 0, 0, 4522, 4523, 7, 23, 0, 0, 4523, 847, 1, 0, 0, 0, 4524,
+		4525, 7, 30, 0, 0, 4525, 4526, 7, 9, 0, 0, 4526, 4527, 7, 19, 0, 0, 4527,
+		4528, 7, 7, 0, 0, 4528, 4529, 5, 95, 0, 0, 4529, 4530, 7, 28, 0, 0, 4530,
+		4531, 7, 22, 0, 0, 4531, 4532, 7, 10, 0, 0, 4532, 4533, 7, 13, 0, 0, 4533,
+		4534, 7, 8, 0, 0, 4534, 849, 1, 0, 0, 0, 4535, 4536, 7, 30, 0, 0, 4536,
+		4537, 7, 9, 0, 0, 4537, 4538, 7, 19, 0, 0, 4538, 4539, 7, 7, 0, 0, 4539,
+		4540, 5, 95, 0, 0, 4540, 4541, 7, 9, 0, 0, 4541, 4542, 7, 14, 0, 0, 4542,
+		4543, 7, 5, 0, 0, 4543, 4544, 7, 6, 0, 0, 4544, 4545, 7, 5, 0, 0, 4545,
+		4546, 7, 13, 0, 0, 4546, 851, 1, 0, 0, 0, 4547, 4548, 7, 30, 0, 0, 4548,
+		4549, 7, 9, 0, 0, 4549, 4550, 7, 19, 0, 0, 4550, 4551, 7, 7, 0, 0, 4551,
+		4552, 5, 95, 0, 0, 4552, 4553, 7, 9, 0, 0, 4553, 4554, 7, 10, 0, 0, 4554,
+		4555, 7, 13, 0, 0, 4555, 4556, 7, 17, 0, 0, 4556, 4557, 7, 5, 0, 0, 4557,
+		4558, 7, 6, 0, 0, 4558, 4559, 7, 17, 0, 0, 4559, 4560, 7, 11, 0, 0, 4560,
+		4561, 7, 10, 0, 0, 4561, 853, 1, 0, 0, 0, 4562, 4563, 7, 30, 0, 0, 4563,
+		4564, 7, 9, 0, 0, 4564, 4565, 7, 19, 0, 0, 4565, 4566, 7, 7, 0, 0, 4566,
+		4567, 5, 95, 0, 0, 4567, 4568, 7, 16, 0, 0, 4568, 4569, 7, 5, 0, 0, 4569,
+		4570, 7, 18, 0, 0, 4570, 4571, 7, 6, 0, 0, 4571, 4572, 7, 10, 0, 0, 4572,
+		855, 1, 0, 0, 0, 4573, 4574, 7, 30, 0, 0, 4574, 4575, 7, 9, 0, 0, 4575,
+		4576, 7, 19, 0, 0, 4576, 4577, 7, 7, 0, 0, 4577, 4578, 5, 95, 0, 0, 4578,
+		4579, 7, 27, 0, 0, 4579, 4580, 7, 5, 0, 0, 4580, 4581, 7, 6, 0, 0, 4581,
+		4582, 7, 22, 0, 0, 4582, 4583, 7, 10, 0, 0, 4583, 857, 1, 0, 0, 0, 4584,
+		4585, 7, 6, 0, 0, 4585, 4586, 7, 10, 0, 0, 4586, 4587, 7, 5, 0, 0, 4587,
+		4588, 7, 9, 0, 0, 4588, 4589, 7, 16, 0, 0, 4589, 859, 1, 0, 0, 0, 4590,
+		4591, 7, 7, 0, 0, 4591, 4592, 7, 5, 0, 0, 4592, 4593, 7, 16, 0, 0, 4593,
+		4594, 7, 17, 0, 0, 4594, 4595, 7, 19, 0, 0, 4595, 4596, 7, 7, 0, 0, 4596,
+		4597, 7, 5, 0, 0, 4597, 4598, 7, 6, 0, 0, 4598, 861, 1, 0, 0, 0, 4599,
+		4600, 7, 7, 0, 0, 4600, 4601, 7, 14, 0, 0, 4601, 4602, 7, 20, 0, 0, 4602,
+		4603, 7, 5, 0, 0, 4603, 4604, 7, 13, 0, 0, 4604, 863, 1, 0, 0, 0, 4605,
+		4606, 7, 7, 0, 0, 4606, 4607, 7, 19, 0, 0, 4607, 4608, 7, 7, 0, 0, 4608,
+		4609, 7, 10, 0, 0, 4609, 865, 1, 0, 0, 0, 4610, 4611, 7, 7, 0, 0, 4611,
+		4612, 7, 22, 0, 0, 4612, 4613, 7, 6, 0, 0, 4613, 4614, 7, 6, 0, 0, 4614,
+		4615, 7, 17, 0, 0, 4615, 4616, 7, 25, 0, 0, 4616, 867, 1, 0, 0, 0, 4617,
+		4618, 7, 7, 0, 0, 4618, 4619, 7, 22, 0, 0, 4619, 4620, 7, 15, 0, 0, 4620,
+		4621, 7, 10, 0, 0, 4621, 4622, 7, 13, 0, 0, 4622, 4623, 7, 17, 0, 0, 4623,
+		4624, 7, 14, 0, 0, 4624, 869, 1, 0, 0, 0, 4625, 4626, 7, 19, 0, 0, 4626,
+		4627, 7, 27, 0, 0, 4627, 4628, 7, 10, 0, 0, 4628, 4629, 7, 13, 0, 0, 4629,
+		4630, 7, 6, 0, 0, 4630, 4631, 7, 5, 0, 0, 4631, 4632, 7, 8, 0, 0, 4632,
+		871, 1, 0, 0, 0, 4633, 4634, 7, 24, 0, 0, 4634, 4635, 7, 5, 0, 0, 4635,
+		4636, 7, 13, 0, 0, 4636, 4637, 7, 5, 0, 0, 4637, 4638, 7, 15, 0, 0, 4638,
+		4639, 7, 10, 0, 0, 4639, 4640, 7, 16, 0, 0, 4640, 4641, 7, 10, 0, 0, 4641,
+		4642, 7, 13, 0, 0, 4642, 873, 1, 0, 0, 0, 4643, 4644, 7, 24, 0, 0, 4644,
+		4645, 7, 19, 0, 0, 4645, 4646, 7, 9, 0, 0, 4646, 4647, 7, 17, 0, 0, 4647,
+		4648, 7, 16, 0, 0, 4648, 4649, 7, 17, 0, 0, 4649, 4650, 7, 19, 0, 0, 4650,
+		4651, 7, 7, 0, 0, 4651, 875, 1, 0, 0, 0, 4652, 4653, 7, 24, 0, 0, 4653,
+		4654, 7, 13, 0, 0, 4654, 4655, 7, 10, 0, 0, 4655, 4656, 7, 14, 0, 0, 4656,
+		4657, 7, 17, 0, 0, 4657, 4658, 7, 9, 0, 0, 4658, 4659, 7, 17, 0, 0, 4659,
+		4660, 7, 19, 0, 0, 4660, 4661, 7, 7, 0, 0, 4661, 877, 1, 0, 0, 0, 4662,
+		4663, 7, 13, 0, 0, 4663, 4664, 7, 10, 0, 0, 4664, 4665, 7, 5, 0, 0, 4665,
+		4666, 7, 6, 0, 0, 4666, 879, 1, 0, 0, 0, 4667, 4668, 7, 13, 0, 0, 4668,
+		4669, 7, 19, 0, 0, 4669, 4670, 7, 29, 0, 0, 4670, 881, 1, 0, 0, 0, 4671,
+		4672, 7, 9, 0, 0, 4672, 4673, 7, 10, 0, 0, 4673, 4674, 7, 16, 0, 0, 4674,
+		4675, 7, 19, 0, 0, 4675, 4676, 7, 25, 0, 0, 4676, 883, 1, 0, 0, 0, 4677,
+		4678, 7, 9, 0, 0, 4678, 4679, 7, 15, 0, 0, 4679, 4680, 7, 5, 0, 0, 4680,
+		4681, 7, 6, 0, 0, 4681, 4682, 7, 6, 0, 0, 4682, 4683, 7, 17, 0, 0, 4683,
+		4684, 7, 7, 0, 0, 4684, 4685, 7, 16, 0, 0, 4685, 885, 1, 0, 0, 0, 4686,
+		4687, 7, 9, 0, 0, 4687, 4688, 7, 22, 0, 0, 4688, 4689, 7, 18, 0, 0, 4689,
+		4690, 7, 9, 0, 0, 4690, 4691, 7, 16, 0, 0, 4691, 4692, 7, 13, 0, 0, 4692,
+		4693, 7, 17, 0, 0, 4693, 4694, 7, 7, 0, 0, 4694, 4695, 7, 23, 0, 0, 4695,
+		887, 1, 0, 0, 0, 4696, 4697, 7, 16, 0, 0, 4697, 4698, 7, 17, 0, 0, 4698,
+		4699, 7, 15, 0, 0, 4699, 4700, 7, 10, 0, 0, 4700, 889, 1, 0, 0, 0, 4701,
+		4702, 7, 16, 0, 0, 4702, 4703, 7, 17, 0, 0, 4703, 4704, 7, 15, 0, 0, 4704,
+		4705, 7, 10, 0, 0, 4705, 4706, 7, 9, 0, 0, 4706, 4707, 7, 16, 0, 0, 4707,
+		4708, 7, 5, 0, 0, 4708, 4709, 7, 15, 0, 0, 4709, 4710, 7, 24, 0, 0, 4710,
+		891, 1, 0, 0, 0, 4711, 4712, 7, 16, 0, 0, 4712, 4713, 7, 13, 0, 0, 4713,
+		4714, 7, 10, 0, 0, 4714, 4715, 7, 5, 0, 0, 4715, 4716, 7, 16, 0, 0, 4716,
+		893, 1, 0, 0, 0, 4717, 4718, 7, 16, 0, 0, 4718, 4719, 7, 13, 0, 0, 4719,
+		4720, 7, 17, 0, 0, 4720, 4721, 7, 15, 0, 0, 4721, 895, 1, 0, 0, 0, 4722,
+		4723, 7, 27, 0, 0, 4723, 4724, 7, 5, 0, 0, 4724, 4725, 7, 6, 0, 0, 4725,
+		4726, 7, 22, 0, 0, 4726, 4727, 7, 10, 0, 0, 4727, 4728, 7, 9, 0, 0, 4728,
+		897, 1, 0, 0, 0, 4729, 4730, 7, 27, 0, 0, 4730, 4731, 7, 5, 0, 0, 4731,
+		4732, 7, 13, 0, 0, 4732, 4733, 7, 14, 0, 0, 4733, 4734, 7, 20, 0, 0, 4734,
+		4735, 7, 5, 0, 0, 4735, 4736, 7, 13, 0, 0, 4736, 899, 1, 0, 0, 0, 4737,
+		4738, 7, 26, 0, 0, 4738, 4739, 7, 15, 0, 0, 4739, 4740, 7, 6, 0, 0, 4740,
+		4741, 7, 5, 0, 0, 4741, 4742, 7, 16, 0, 0, 4742, 4743, 7, 16, 0, 0, 4743,
+		4744, 7, 13, 0, 0, 4744, 4745, 7, 17, 0, 0, 4745, 4746, 7, 18, 0, 0, 4746,
+		4747, 7, 22, 0, 0, 4747, 4748, 7, 16, 0, 0, 4748, 4749, 7, 10, 0, 0, 4749,
+		4750, 7, 9, 0, 0, 4750, 901, 1, 0, 0, 0, 4751, 4752, 7, 26, 0, 0, 4752,
+		4753, 7, 15, 0, 0, 4753, 4754, 7, 6, 0, 0, 4754, 4755, 7, 14, 0, 0, 4755,
+		4756, 7, 19, 0, 0, 4756, 4757, 7, 15, 0, 0, 4757, 4758, 7, 15, 0, 0, 4758,
+		4759, 7, 10, 0, 0, 4759, 4760, 7, 7, 0, 0, 4760, 4761, 7, 16, 0, 0, 4761,
+		903, 1, 0, 0, 0, 4762, 4763, 7, 26, 0, 0, 4763, 4764, 7, 15, 0, 0, 4764,
+		4765, 7, 6, 0, 0, 4765, 4766, 7, 5, 0, 0, 4766, 4767, 7, 23, 0, 0, 4767,
+		4768, 7, 23, 0, 0, 4768, 905, 1, 0, 0, 0, 4769, 4770, 7, 26, 0, 0, 4770,
+		4771, 7, 15, 0, 0, 4771, 4772, 7, 6, 0, 0, 4772, 4773, 5, 95, 0, 0, 4773,
+		4774, 7, 17, 0, 0, 4774, 4775, 7, 9, 0, 0, 4775, 4776, 5, 95, 0, 0, 4776,
+		4777, 7, 29, 0, 0, 4777, 4778, 7, 10, 0, 0, 4778, 4779, 7, 6, 0, 0, 4779,
+		4780, 7, 6, 0, 0, 4780, 4781, 5, 95, 0, 0, 4781, 4782, 7, 25, 0, 0, 4782,
+		4783, 7, 19, 0, 0, 4783, 4784, 7, 13, 0, 0, 4784, 4785, 7, 15, 0, 0, 4785,
+		4786, 7, 10, 0, 0, 4786, 4787, 7, 12, 0, 0, 4787, 907, 1, 0, 0, 0, 4788,
+		4789, 7, 26, 0, 0, 4789, 4790, 7, 15, 0, 0, 4790, 4791, 7, 6, 0, 0, 4791,
+		4792, 5, 95, 0, 0, 4792, 4793, 7, 17, 0, 0, 4793, 4794, 7, 9, 0, 0, 4794,
+		4795, 5, 95, 0, 0, 4795, 4796, 7, 29, 0, 0, 4796, 4797, 7, 10, 0, 0, 4797,
+		4798, 7, 6, 0, 0, 4798, 4799, 7, 6, 0, 0, 4799, 4800, 5, 95, 0, 0, 4800,
+		4801, 7, 25, 0, 0, 4801, 4802, 7, 19, 0, 0, 4802, 4803, 7, 13, 0, 0, 4803,
+		4804, 7, 15, 0, 0, 4804, 4805, 7, 10, 0, 0, 4805, 4806, 7, 12, 0, 0, 4806,
+		4807, 5, 95, 0, 0, 4807, 4808, 7, 12, 0, 0, 4808, 4809, 7, 19, 0, 0, 4809,
+		4810, 7, 14, 0, 0, 4810, 4811, 7, 22, 0, 0, 4811, 4812, 7, 15, 0, 0, 4812,
+		4813, 7, 10, 0, 0, 4813, 4814, 7, 7, 0, 0, 4814, 4815, 7, 16, 0, 0, 4815,
+		909, 1, 0, 0, 0, 4816, 4817, 7, 26, 0, 0, 4817, 4818, 7, 15, 0, 0, 4818,
+		4819, 7, 6, 0, 0, 4819, 4820, 5, 95, 0, 0, 4820, 4821, 7, 17, 0, 0, 4821,
+		4822, 7, 9, 0, 0, 4822, 4823, 5, 95, 0, 0, 4823, 4824, 7, 29, 0, 0, 4824,
+		4825, 7, 10, 0, 0, 4825, 4826, 7, 6, 0, 0, 4826, 4827, 7, 6, 0, 0, 4827,
+		4828, 5, 95, 0, 0, 4828, 4829, 7, 25, 0, 0, 4829, 4830, 7, 19, 0, 0, 4830,
+		4831, 7, 13, 0, 0, 4831, 4832, 7, 15, 0, 0, 4832, 4833, 7, 10, 0, 0, 4833,
+		4834, 7, 12, 0, 0, 4834, 4835, 5, 95, 0, 0, 4835, 4836, 7, 14, 0, 0, 4836,
+		4837, 7, 19, 0, 0, 4837, 4838, 7, 7, 0, 0, 4838, 4839, 7, 16, 0, 0, 4839,
+		4840, 7, 10, 0, 0, 4840, 4841, 7, 7, 0, 0, 4841, 4842, 7, 16, 0, 0, 4842,
+		911, 1, 0, 0, 0, 4843, 4844, 7, 26, 0, 0, 4844, 4845, 7, 24, 0, 0, 4845,
+		4846, 7, 5, 0, 0, 4846, 4847, 7, 16, 0, 0, 4847, 4848, 7, 20, 0, 0, 4848,
+		913, 1, 0, 0, 0, 4849, 4850, 7, 26, 0, 0, 4850, 4851, 7, 24, 0, 0, 4851,
+		4852, 7, 5, 0, 0, 4852, 4853, 7, 16, 0, 0, 4853, 4854, 7, 20, 0, 0, 4854,
+		4855, 5, 95, 0, 0, 4855, 4856, 7, 10, 0, 0, 4856, 4857, 7, 26, 0, 0, 4857,
+		4858, 7, 17, 0, 0, 4858, 4859, 7, 9, 0, 0, 4859, 4860, 7, 16, 0, 0, 4860,
+		4861, 7, 9, 0, 0, 4861, 915, 1, 0, 0, 0, 4862, 4863, 7, 26, 0, 0, 4863,
+		4864, 7, 15, 0, 0, 4864, 4865, 7, 6, 0, 0, 4865, 4866, 7, 14, 0, 0, 4866,
+		4867, 7, 19, 0, 0, 4867, 4868, 7, 7, 0, 0, 4868, 4869, 7, 14, 0, 0, 4869,
+		4870, 7, 5, 0, 0, 4870, 4871, 7, 16, 0, 0, 4871, 917, 1, 0, 0, 0, 4872,
+		4873, 7, 26, 0, 0, 4873, 4874, 7, 15, 0, 0, 4874, 4875, 7, 6, 0, 0, 4875,
+		4876, 7, 10, 0, 0, 4876, 4877, 7, 6, 0, 0, 4877, 4878, 7, 10, 0, 0, 4878,
+		4879, 7, 15, 0, 0, 4879, 4880, 7, 10, 0, 0, 4880, 4881, 7, 7, 0, 0, 4881,
+		4882, 7, 16, 0, 0, 4882, 919, 1, 0, 0, 0, 4883, 4884, 7, 26, 0, 0, 4884,
+		4885, 7, 15, 0, 0, 4885, 4886, 7, 6, 0, 0, 4886, 4887, 7, 10, 0, 0, 4887,
+		4888, 7, 26, 0, 0, 4888, 4889, 7, 17, 0, 0, 4889, 4890, 7, 9, 0, 0, 4890,
+		4891, 7, 16, 0, 0, 4891, 4892, 7, 9, 0, 0, 4892, 921, 1, 0, 0, 0, 4893,
+		4894, 7, 26, 0, 0, 4894, 4895, 7, 15, 0, 0, 4895, 4896, 7, 6, 0, 0, 4896,
+		4897, 7, 25, 0, 0, 4897, 4898, 7, 19, 0, 0, 4898, 4899, 7, 13, 0, 0, 4899,
+		4900, 7, 10, 0, 0, 4900, 4901, 7, 9, 0, 0, 4901, 4902, 7, 16, 0, 0, 4902,
+		923, 1, 0, 0, 0, 4903, 4904, 7, 26, 0, 0, 4904, 4905, 7, 15, 0, 0, 4905,
+		4906, 7, 6, 0, 0, 4906, 4907, 7, 24, 0, 0, 4907, 4908, 7, 5, 0, 0, 4908,
+		4909, 7, 13, 0, 0, 4909, 4910, 7, 9, 0, 0, 4910, 4911, 7, 10, 0, 0, 4911,
+		925, 1, 0, 0, 0, 4912, 4913, 7, 26, 0, 0, 4913, 4914, 7, 15, 0, 0, 4914,
+		4915, 7, 6, 0, 0, 4915, 4916, 7, 24, 0, 0, 4916, 4917, 7, 17, 0, 0, 4917,
+		927, 1, 0, 0, 0, 4918, 4919, 7, 26, 0, 0, 4919, 4920, 7, 15, 0, 0, 4920,
+		4921, 7, 6, 0, 0, 4921, 4922, 7, 13, 0, 0, 4922, 4923, 7, 19, 0, 0, 4923,
+		4924, 7, 19, 0, 0, 4924, 4925, 7, 16, 0, 0, 4925, 929, 1, 0, 0, 0, 4926,
+		4927, 7, 26, 0, 0, 4927, 4928, 7, 15, 0, 0, 4928, 4929, 7, 6, 0, 0, 4929,
+		4930, 7, 9, 0, 0, 4930, 4931, 7, 10, 0, 0, 4931, 4932, 7, 13, 0, 0, 4932,
+		4933, 7, 17, 0, 0, 4933, 4934, 7, 5, 0, 0, 4934, 4935, 7, 6, 0, 0, 4935,
+		4936, 7, 17, 0, 0, 4936, 4937, 7, 11, 0, 0, 4937, 4938, 7, 10, 0, 0, 4938,
+		931, 1, 0, 0, 0, 4939, 4940, 7, 14, 0, 0, 4940, 4941, 7, 5, 0, 0, 4941,
+		4942, 7, 6, 0, 0, 4942, 4943, 7, 6, 0, 0, 4943, 933, 1, 0, 0, 0, 4944,
+		4945, 7, 14, 0, 0, 4945, 4946, 7, 22, 0, 0, 4946, 4947, 7, 13, 0, 0, 4947,
+		4948, 7, 13, 0, 0, 4948, 4949, 7, 10, 0, 0, 4949, 4950, 7, 7, 0, 0, 4950,
+		4951, 7, 16, 0, 0, 4951, 935, 1, 0, 0, 0, 4952, 4953, 7, 5, 0, 0, 4953,
+		4954, 7, 16, 0, 0, 4954, 4955, 7, 16, 0, 0, 4955, 4956, 7, 5, 0, 0, 4956,
+		4957, 7, 14, 0, 0, 4957, 4958, 7, 20, 0, 0, 4958, 937, 1, 0, 0, 0, 4959,
+		4960, 7, 12, 0, 0, 4960, 4961, 7, 10, 0, 0, 4961, 4962, 7, 16, 0, 0, 4962,
+		4963, 7, 5, 0, 0, 4963, 4964, 7, 14, 0, 0, 4964, 4965, 7, 20, 0, 0, 4965,
+		939, 1, 0, 0, 0, 4966, 4967, 7, 10, 0, 0, 4967, 4968, 7, 26, 0, 0, 4968,
+		4969, 7, 24, 0, 0, 4969, 4970, 7, 13, 0, 0, 4970, 4971, 7, 10, 0, 0, 4971,
+		4972, 7, 9, 0, 0, 4972, 4973, 7, 9, 0, 0, 4973, 4974, 7, 17, 0, 0, 4974,
+		4975, 7, 19, 0, 0, 4975, 4976, 7, 7, 0, 0, 4976, 941, 1, 0, 0, 0, 4977,
+		4978, 7, 23, 0, 0, 4978, 4979, 7, 10, 0, 0, 4979, 4980, 7, 7, 0, 0, 4980,
+		4981, 7, 10, 0, 0, 4981, 4982, 7, 13, 0, 0, 4982, 4983, 7, 5, 0, 0, 4983,
+		4984, 7, 16, 0, 0, 4984, 4985, 7, 10, 0, 0, 4985, 4986, 7, 12, 0, 0, 4986,
+		943, 1, 0, 0, 0, 4987, 4988, 7, 6, 0, 0, 4988, 4989, 7, 19, 0, 0, 4989,
+		4990, 7, 23, 0, 0, 4990, 4991, 7, 23, 0, 0, 4991, 4992, 7, 10, 0, 0, 4992,
+		4993, 7, 12, 0, 0, 4993, 945, 1, 0, 0, 0, 4994, 4995, 7, 9, 0, 0, 4995,
+		4996, 7, 16, 0, 0, 4996, 4997, 7, 19, 0, 0, 4997, 4998, 7, 13, 0, 0, 4998,
+		4999, 7, 10, 0, 0, 4999, 5000, 7, 12, 0, 0, 5000, 947, 1, 0, 0, 0, 5001,
+		5002, 7, 17, 0, 0, 5002, 5003, 7, 7, 0, 0, 5003, 5004, 7, 14, 0, 0, 5004,
+		5005, 7, 6, 0, 0, 5005, 5006, 7, 22, 0, 0, 5006, 5007, 7, 12, 0, 0, 5007,
+		5008, 7, 10, 0, 0, 5008, 949, 1, 0, 0, 0, 5009, 5010, 7, 13, 0, 0, 5010,
+		5011, 7, 19, 0, 0, 5011, 5012, 7, 22, 0, 0, 5012, 5013, 7, 16, 0, 0, 5013,
+		5014, 7, 17, 0, 0, 5014, 5015, 7, 7, 0, 0, 5015, 5016, 7, 10, 0, 0, 5016,
+		951, 1, 0, 0, 0, 5017, 5018, 7, 16, 0, 0, 5018, 5019, 7, 13, 0, 0, 5019,
+		5020, 7, 5, 0, 0, 5020, 5021, 7, 7, 0, 0, 5021, 5022, 7, 9, 0, 0, 5022,
+		5023, 7, 25, 0, 0, 5023, 5024, 7, 19, 0, 0, 5024, 5025, 7, 13, 0, 0, 5025,
+		5026, 7, 15, 0, 0, 5026, 953, 1, 0, 0, 0, 5027, 5028, 7, 17, 0, 0, 5028,
+		5029, 7, 15, 0, 0, 5029, 5030, 7, 24, 0, 0, 5030, 5031, 7, 19, 0, 0, 5031,
+		5032, 7, 13, 0, 0, 5032, 5033, 7, 16, 0, 0, 5033, 955, 1, 0, 0, 0, 5034,
+		5035, 7, 24, 0, 0, 5035, 5036, 7, 19, 0, 0, 5036, 5037, 7, 6, 0, 0, 5037,
+		5038, 7, 17, 0, 0, 5038, 5039, 7, 14, 0, 0, 5039, 5040, 7, 8, 0, 0, 5040,
+		957, 1, 0, 0, 0, 5041, 5042, 7, 15, 0, 0, 5042, 5043, 7, 10, 0, 0, 5043,
+		5044, 7, 16, 0, 0, 5044, 5045, 7, 20, 0, 0, 5045, 5046, 7, 19, 0, 0, 5046,
+		5047, 7, 12, 0, 0, 5047, 959, 1, 0, 0, 0, 5048, 5049, 7, 13, 0, 0, 5049,
+		5050, 7, 10, 0, 0, 5050, 5051, 7, 25, 0, 0, 5051, 5052, 7, 10, 0, 0, 5052,
+		5053, 7, 13, 0, 0, 5053, 5054, 7, 10, 0, 0, 5054, 5055, 7, 7, 0, 0, 5055,
+		5056, 7, 14, 0, 0, 5056, 5057, 7, 17, 0, 0, 5057, 5058, 7, 7, 0, 0, 5058,
+		5059, 7, 23, 0, 0, 5059, 961, 1, 0, 0, 0, 5060, 5061, 7, 7, 0, 0, 5061,
+		5062, 7, 10, 0, 0, 5062, 5063, 7, 29, 0, 0, 5063, 963, 1, 0, 0, 0, 5064,
+		5065, 7, 19, 0, 0, 5065, 5066, 7, 6, 0, 0, 5066, 5067, 7, 12, 0, 0, 5067,
+		965, 1, 0, 0, 0, 5068, 5069, 7, 27, 0, 0, 5069, 5070, 7, 5, 0, 0, 5070,
+		5071, 7, 6, 0, 0, 5071, 5072, 7, 22, 0, 0, 5072, 5073, 7, 10, 0, 0, 5073,
+		967, 1, 0, 0, 0, 5074, 5075, 7, 9, 0, 0, 5075, 5076, 7, 22, 0, 0, 5076,
+		5077, 7, 18, 0, 0, 5077, 5078, 7, 9, 0, 0, 5078, 5079, 7, 14, 0, 0, 5079,
+		5080, 7, 13, 0, 0, 5080, 5081, 7, 17, 0, 0, 5081, 5082, 7, 24, 0, 0, 5082,
+		5083, 7, 16, 0, 0, 5083, 5084, 7, 17, 0, 0, 5084, 5085, 7, 19, 0, 0, 5085,
+		5086, 7, 7, 0, 0, 5086, 969, 1, 0, 0, 0, 5087, 5088, 7, 24, 0, 0, 5088,
+		5089, 7, 22, 0, 0, 5089, 5090, 7, 18, 0, 0, 5090, 5091, 7, 6, 0, 0, 5091,
+		5092, 7, 17, 0, 0, 5092, 5093, 7, 14, 0, 0, 5093, 5094, 7, 5, 0, 0, 5094,
+		5095, 7, 16, 0, 0, 5095, 5096, 7, 17, 0, 0, 5096, 5097, 7, 19, 0, 0, 5097,
+		5098, 7, 7, 0, 0, 5098, 971, 1, 0, 0, 0, 5099, 5100, 7, 19, 0, 0, 5100,
+		5101, 7, 22, 0, 0, 5101, 5102, 7, 16, 0, 0, 5102, 973, 1, 0, 0, 0, 5103,
+		5104, 7, 10, 0, 0, 5104, 5105, 7, 7, 0, 0, 5105, 5106, 7, 12, 0, 0, 5106,
+		975, 1, 0, 0, 0, 5107, 5108, 7, 13, 0, 0, 5108, 5109, 7, 19, 0, 0, 5109,
+		5110, 7, 22, 0, 0, 5110, 5111, 7, 16, 0, 0, 5111, 5112, 7, 17, 0, 0, 5112,
+		5113, 7, 7, 0, 0, 5113, 5114, 7, 10, 0, 0, 5114, 5115, 7, 9, 0, 0, 5115,
+		977, 1, 0, 0, 0, 5116, 5117, 7, 9, 0, 0, 5117, 5118, 7, 14, 0, 0, 5118,
+		5119, 7, 20, 0, 0, 5119, 5120, 7, 10, 0, 0, 5120, 5121, 7, 15, 0, 0, 5121,
+		5122, 7, 5, 0, 0, 5122, 5123, 7, 9, 0, 0, 5123, 979, 1, 0, 0, 0, 5124,
+		5125, 7, 24, 0, 0, 5125, 5126, 7, 13, 0, 0, 5126, 5127, 7, 19, 0, 0, 5127,
+		5128, 7, 14, 0, 0, 5128, 5129, 7, 10, 0, 0, 5129, 5130, 7, 12, 0, 0, 5130,
+		5131, 7, 22, 0, 0, 5131, 5132, 7, 13, 0, 0, 5132, 5133, 7, 10, 0, 0, 5133,
+		5134, 7, 9, 0, 0, 5134, 981, 1, 0, 0, 0, 5135, 5136, 7, 17, 0, 0, 5136,
+		5137, 7, 7, 0, 0, 5137, 5138, 7, 24, 0, 0, 5138, 5139, 7, 22, 0, 0, 5139,
+		5140, 7, 16, 0, 0, 5140, 983, 1, 0, 0, 0, 5141, 5142, 7, 9, 0, 0, 5142,
+		5143, 7, 22, 0, 0, 5143, 5144, 7, 24, 0, 0, 5144, 5145, 7, 24, 0, 0, 5145,
+		5146, 7, 19, 0, 0, 5146, 5147, 7, 13, 0, 0, 5147, 5148, 7, 16, 0, 0, 5148,
+		985, 1, 0, 0, 0, 5149, 5150, 7, 24, 0, 0, 5150, 5151, 7, 5, 0, 0, 5151,
+		5152, 7, 13, 0, 0, 5152, 5153, 7, 5, 0, 0, 5153, 5154, 7, 6, 0, 0, 5154,
+		5155, 7, 6, 0, 0, 5155, 5156, 7, 10, 0, 0, 5156, 5157, 7, 6, 0, 0, 5157,
+		987, 1, 0, 0, 0, 5158, 5159, 7, 9, 0, 0, 5159, 5160, 7, 28, 0, 0, 5160,
+		5161, 7, 6, 0, 0, 5161, 989, 1, 0, 0, 0, 5162, 5163, 7, 12, 0, 0, 5163,
+		5164, 7, 10, 0, 0, 5164, 5165, 7, 24, 0, 0, 5165, 5166, 7, 10, 0, 0, 5166,
+		5167, 7, 7, 0, 0, 5167, 5168, 7, 12, 0, 0, 5168, 5169, 7, 9, 0, 0, 5169,
+		991, 1, 0, 0, 0, 5170, 5171, 7, 19, 0, 0, 5171, 5172, 7, 27, 0, 0, 5172,
+		5173, 7, 10, 0, 0, 5173, 5174, 7, 13, 0, 0, 5174, 5175, 7, 13, 0, 0, 5175,
+		5176, 7, 17, 0, 0, 5176, 5177, 7, 12, 0, 0, 5177, 5178, 7, 17, 0, 0, 5178,
+		5179, 7, 7, 0, 0, 5179, 5180, 7, 23, 0, 0, 5180, 993, 1, 0, 0, 0, 5181,
+		5182, 7, 14, 0, 0, 5182, 5183, 7, 19, 0, 0, 5183, 5184, 7, 7, 0, 0, 5184,
+		5185, 7, 25, 0, 0, 5185, 5186, 7, 6, 0, 0, 5186, 5187, 7, 17, 0, 0, 5187,
+		5188, 7, 14, 0, 0, 5188, 5189, 7, 16, 0, 0, 5189, 995, 1, 0, 0, 0, 5190,
+		5191, 7, 9, 0, 0, 5191, 5192, 7, 21, 0, 0, 5192, 5193, 7, 17, 0, 0, 5193,
+		5194, 7, 24, 0, 0, 5194, 997, 1, 0, 0, 0, 5195, 5196, 7, 6, 0, 0, 5196,
+		5197, 7, 19, 0, 0, 5197, 5198, 7, 14, 0, 0, 5198, 5199, 7, 21, 0, 0, 5199,
+		5200, 7, 10, 0, 0, 5200, 5201, 7, 12, 0, 0, 5201, 999, 1, 0, 0, 0, 5202,
+		5203, 7, 16, 0, 0, 5203, 5204, 7, 17, 0, 0, 5204, 5205, 7, 10, 0, 0, 5205,
+		5206, 7, 9, 0, 0, 5206, 1001, 1, 0, 0, 0, 5207, 5208, 7, 13, 0, 0, 5208,
+		5209, 7, 19, 0, 0, 5209, 5210, 7, 6, 0, 0, 5210, 5211, 7, 6, 0, 0, 5211,
+		5212, 7, 22, 0, 0, 5212, 5213, 7, 24, 0, 0, 5213, 1003, 1, 0, 0, 0, 5214,
+		5215, 7, 14, 0, 0, 5215, 5216, 7, 22, 0, 0, 5216, 5217, 7, 18, 0, 0, 5217,
+		5218, 7, 10, 0, 0, 5218, 1005, 1, 0, 0, 0, 5219, 5220, 7, 23, 0, 0, 5220,
+		5221, 7, 13, 0, 0, 5221, 5222, 7, 19, 0, 0, 5222, 5223, 7, 22, 0, 0, 5223,
+		5224, 7, 24, 0, 0, 5224, 5225, 7, 17, 0, 0, 5225, 5226, 7, 7, 0, 0, 5226,
+		5227, 7, 23, 0, 0, 5227, 1007, 1, 0, 0, 0, 5228, 5229, 7, 9, 0, 0, 5229,
+		5230, 7, 10, 0, 0, 5230, 5231, 7, 16, 0, 0, 5231, 5232, 7, 9, 0, 0, 5232,
+		1009, 1, 0, 0, 0, 5233, 5234, 7, 16, 0, 0, 5234, 5235, 7, 5, 0, 0, 5235,
+		5236, 7, 18, 0, 0, 5236, 5237, 7, 6, 0, 0, 5237, 5238, 7, 10, 0, 0, 5238,
+		5239, 7, 9, 0, 0, 5239, 5240, 7, 5, 0, 0, 5240, 5241, 7, 15, 0, 0, 5241,
+		5242, 7, 24, 0, 0, 5242, 5243, 7, 6, 0, 0, 5243, 5244, 7, 10, 0, 0, 5244,
+		1011, 1, 0, 0, 0, 5245, 5246, 7, 19, 0, 0, 5246, 5247, 7, 13, 0, 0, 5247,
+		5248, 7, 12, 0, 0, 5248, 5249, 7, 17, 0, 0, 5249, 5250, 7, 7, 0, 0, 5250,
+		5251, 7, 5, 0, 0, 5251, 5252, 7, 6, 0, 0, 5252, 5253, 7, 17, 0, 0, 5253,
+		5254, 7, 16, 0, 0, 5254, 5255, 7, 8, 0, 0, 5255, 1013, 1, 0, 0, 0, 5256,
+		5257, 7, 26, 0, 0, 5257, 5258, 7, 15, 0, 0, 5258, 5259, 7, 6, 0, 0, 5259,
+		5260, 7, 16, 0, 0, 5260, 5261, 7, 5, 0, 0, 5261, 5262, 7, 18, 0, 0, 5262,
+		5263, 7, 6, 0, 0, 5263, 5264, 7, 10, 0, 0, 5264, 1015, 1, 0, 0, 0, 5265,
+		5266, 7, 14, 0, 0, 5266, 5267, 7, 19, 0, 0, 5267, 5268, 7, 6, 0, 0, 5268,
+		5269, 7, 22, 0, 0, 5269, 5270, 7, 15, 0, 0, 5270, 5271, 7, 7, 0, 0, 5271,
+		5272, 7, 9, 0, 0, 5272, 1017, 1, 0, 0, 0, 5273, 5274, 7, 26, 0, 0, 5274,
+		5275, 7, 15, 0, 0, 5275, 5276, 7, 6, 0, 0, 5276, 5277, 7, 7, 0, 0, 5277,
+		5278, 7, 5, 0, 0, 5278, 5279, 7, 15, 0, 0, 5279, 5280, 7, 10, 0, 0, 5280,
+		5281, 7, 9, 0, 0, 5281, 5282, 7, 24, 0, 0, 5282, 5283, 7, 5, 0, 0, 5283,
+		5284, 7, 14, 0, 0, 5284, 5285, 7, 10, 0, 0, 5285, 5286, 7, 9, 0, 0, 5286,
+		1019, 1, 0, 0, 0, 5287, 5288, 7, 13, 0, 0, 5288, 5289, 7, 19, 0, 0, 5289,
+		5290, 7, 29, 0, 0, 5290, 5291, 7, 16, 0, 0, 5291, 5292, 7, 8, 0, 0, 5292,
+		5293, 7, 24, 0, 0, 5293, 5294, 7, 10, 0, 0, 5294, 1021, 1, 0, 0, 0, 5295,
+		5296, 7, 7, 0, 0, 5296, 5297, 7, 19, 0, 0, 5297, 5298, 7, 13, 0, 0, 5298,
+		5299, 7, 15, 0, 0, 5299, 5300, 7, 5, 0, 0, 5300, 5301, 7, 6, 0, 0, 5301,
+		5302, 7, 17, 0, 0, 5302, 5303, 7, 11, 0, 0, 5303, 5304, 7, 10, 0, 0, 5304,
+		5305, 7, 12, 0, 0, 5305, 1023, 1, 0, 0, 0, 5306, 5307, 7, 29, 0, 0, 5307,
+		5308, 7, 17, 0, 0, 5308, 5309, 7, 16, 0, 0, 5309, 5310, 7, 20, 0, 0, 5310,
+		5311, 7, 17, 0, 0, 5311, 5312, 7, 7, 0, 0, 5312, 1025, 1, 0, 0, 0, 5313,
+		5314, 7, 25, 0, 0, 5314, 5315, 7, 17, 0, 0, 5315, 5316, 7, 6, 0, 0, 5316,
+		5317, 7, 16, 0, 0, 5317, 5318, 7, 10, 0, 0, 5318, 5319, 7, 13, 0, 0, 5319,
+		1027, 1, 0, 0, 0, 5320, 5321, 7, 23, 0, 0, 5321, 5322, 7, 13, 0, 0, 5322,
+		5323, 7, 19, 0, 0, 5323, 5324, 7, 22, 0, 0, 5324, 5325, 7, 24, 0, 0, 5325,
+		5326, 7, 9, 0, 0, 5326, 1029, 1, 0, 0, 0, 5327, 5328, 7, 19, 0, 0, 5328,
+		5329, 7, 16, 0, 0, 5329, 5330, 7, 20, 0, 0, 5330, 5331, 7, 10, 0, 0, 5331,
+		5332, 7, 13, 0, 0, 5332, 5333, 7, 9, 0, 0, 5333, 1031, 1, 0, 0, 0, 5334,
+		5335, 7, 7, 0, 0, 5335, 5336, 7, 25, 0, 0, 5336, 5337, 7, 14, 0, 0, 5337,
+		1033, 1, 0, 0, 0, 5338, 5339, 7, 7, 0, 0, 5339, 5340, 7, 25, 0, 0, 5340,
+		5341, 7, 12, 0, 0, 5341, 1035, 1, 0, 0, 0, 5342, 5343, 7, 7, 0, 0, 5343,
+		5344, 7, 25, 0, 0, 5344, 5345, 7, 21, 0, 0, 5345, 5346, 7, 14, 0, 0, 5346,
+		1037, 1, 0, 0, 0, 5347, 5348, 7, 7, 0, 0, 5348, 5349, 7, 25, 0, 0, 5349,
+		5350, 7, 21, 0, 0, 5350, 5351, 7, 12, 0, 0, 5351, 1039, 1, 0, 0, 0, 5352,
+		5353, 7, 22, 0, 0, 5353, 5354, 7, 10, 0, 0, 5354, 5355, 7, 9, 0, 0, 5355,
+		5356, 7, 14, 0, 0, 5356, 5357, 7, 5, 0, 0, 5357, 5358, 7, 24, 0, 0, 5358,
+		5359, 7, 10, 0, 0, 5359, 1041, 1, 0, 0, 0, 5360, 5361, 7, 27, 0, 0, 5361,
+		5362, 7, 17, 0, 0, 5362, 5363, 7, 10, 0, 0, 5363, 5364, 7, 29, 0, 0, 5364,
+		5365, 7, 9, 0, 0, 5365, 1043, 1, 0, 0, 0, 5366, 5367, 7, 7, 0, 0, 5367,
+		5368, 7, 19, 0, 0, 5368, 5369, 7, 13, 0, 0, 5369, 5370, 7, 15, 0, 0, 5370,
+		5371, 7, 5, 0, 0, 5371, 5372, 7, 6, 0, 0, 5372, 5373, 7, 17, 0, 0, 5373,
+		5374, 7, 11, 0, 0, 5374, 5375, 7, 10, 0, 0, 5375, 1045, 1, 0, 0, 0, 5376,
+		5377, 7, 12, 0, 0, 5377, 5378, 7, 22, 0, 0, 5378, 5379, 7, 15, 0, 0, 5379,
+		5380, 7, 24, 0, 0, 5380, 1047, 1, 0, 0, 0, 5381, 5382, 7, 24, 0, 0, 5382,
+		5383, 7, 13, 0, 0, 5383, 5384, 7, 17, 0, 0, 5384, 5385, 7, 7, 0, 0, 5385,
+		5386, 7, 16, 0, 0, 5386, 5387, 5, 95, 0, 0, 5387, 5388, 7, 9, 0, 0, 5388,
+		5389, 7, 16, 0, 0, 5389, 5390, 7, 13, 0, 0, 5390, 5391, 7, 17, 0, 0, 5391,
+		5392, 7, 14, 0, 0, 5392, 5393, 7, 16, 0, 0, 5393, 5394, 5, 95, 0, 0, 5394,
+		5395, 7, 24, 0, 0, 5395, 5396, 7, 5, 0, 0, 5396, 5397, 7, 13, 0, 0, 5397,
+		5398, 7, 5, 0, 0, 5398, 5399, 7, 15, 0, 0, 5399, 5400, 7, 9, 0, 0, 5400,
+		1049, 1, 0, 0, 0, 5401, 5402, 7, 27, 0, 0, 5402, 5403, 7, 5, 0, 0, 5403,
+		5404, 7, 13, 0, 0, 5404, 5405, 7, 17, 0, 0, 5405, 5406, 7, 5, 0, 0, 5406,
+		5407, 7, 18, 0, 0, 5407, 5408, 7, 6, 0, 0, 5408, 5409, 7, 10, 0, 0, 5409,
+		5410, 5, 95, 0, 0, 5410, 5411, 7, 14, 0, 0, 5411, 5412, 7, 19, 0, 0, 5412,
+		5413, 7, 7, 0, 0, 5413, 5414, 7, 25, 0, 0, 5414, 5415, 7, 6, 0, 0, 5415,
+		5416, 7, 17, 0, 0, 5416, 5417, 7, 14, 0, 0, 5417, 5418, 7, 16, 0, 0, 5418,
+		1051, 1, 0, 0, 0, 5419, 5420, 7, 10, 0, 0, 5420, 5421, 7, 13, 0, 0, 5421,
+		5422, 7, 13, 0, 0, 5422, 5423, 7, 19, 0, 0, 5423, 5424, 7, 13, 0, 0, 5424,
+		1053, 1, 0, 0, 0, 5425, 5426, 7, 22, 0, 0, 5426, 5427, 7, 9, 0, 0, 5427,
+		5428, 7, 10, 0, 0, 5428, 5429, 5, 95, 0, 0, 5429, 5430, 7, 27, 0, 0, 5430,
+		5431, 7, 5, 0, 0, 5431, 5432, 7, 13, 0, 0, 5432, 5433, 7, 17, 0, 0, 5433,
+		5434, 7, 5, 0, 0, 5434, 5435, 7, 18, 0, 0, 5435, 5436, 7, 6, 0, 0, 5436,
+		5437, 7, 10, 0, 0, 5437, 1055, 1, 0, 0, 0, 5438, 5439, 7, 22, 0, 0, 5439,
+		5440, 7, 9, 0, 0, 5440, 5441, 7, 10, 0, 0, 5441, 5442, 5, 95, 0, 0, 5442,
+		5443, 7, 14, 0, 0, 5443, 5444, 7, 19, 0, 0, 5444, 5445, 7, 6, 0, 0, 5445,
+		5446, 7, 22, 0, 0, 5446, 5447, 7, 15, 0, 0, 5447, 5448, 7, 7, 0, 0, 5448,
+		1057, 1, 0, 0, 0, 5449, 5450, 7, 5, 0, 0, 5450, 5451, 7, 6, 0, 0, 5451,
+		5452, 7, 17, 0, 0, 5452, 5453, 7, 5, 0, 0, 5453, 5454, 7, 9, 0, 0, 5454,
+		1059, 1, 0, 0, 0, 5455, 5456, 7, 14, 0, 0, 5456, 5457, 7, 19, 0, 0, 5457,
+		5458, 7, 7, 0, 0, 5458, 5459, 7, 9, 0, 0, 5459, 5460, 7, 16, 0, 0, 5460,
+		5461, 7, 5, 0, 0, 5461, 5462, 7, 7, 0, 0, 5462, 5463, 7, 16, 0, 0, 5463,
+		1061, 1, 0, 0, 0, 5464, 5465, 7, 24, 0, 0, 5465, 5466, 7, 10, 0, 0, 5466,
+		5467, 7, 13, 0, 0, 5467, 5468, 7, 25, 0, 0, 5468, 5469, 7, 19, 0, 0, 5469,
+		5470, 7, 13, 0, 0, 5470, 5471, 7, 15, 0, 0, 5471, 1063, 1, 0, 0, 0, 5472,
+		5473, 7, 23, 0, 0, 5473, 5474, 7, 10, 0, 0, 5474, 5475, 7, 16, 0, 0, 5475,
+		1065, 1, 0, 0, 0, 5476, 5477, 7, 12, 0, 0, 5477, 5478, 7, 17, 0, 0, 5478,
+		5479, 7, 5, 0, 0, 5479, 5480, 7, 23, 0, 0, 5480, 5481, 7, 7, 0, 0, 5481,
+		5482, 7, 19, 0, 0, 5482, 5483, 7, 9, 0, 0, 5483, 5484, 7, 16, 0, 0, 5484,
+		5485, 7, 17, 0, 0, 5485, 5486, 7, 14, 0, 0, 5486, 5487, 7, 9, 0, 0, 5487,
+		1067, 1, 0, 0, 0, 5488, 5489, 7, 9, 0, 0, 5489, 5490, 7, 16, 0, 0, 5490,
+		5491, 7, 5, 0, 0, 5491, 5492, 7, 14, 0, 0, 5492, 5493, 7, 21, 0, 0, 5493,
+		5494, 7, 10, 0, 0, 5494, 5495, 7, 12, 0, 0, 5495, 1069, 1, 0, 0, 0, 5496,
+		5497, 7, 10, 0, 0, 5497, 5498, 7, 6, 0, 0, 5498, 5499, 7, 9, 0, 0, 5499,
+		5500, 7, 17, 0, 0, 5500, 5501, 7, 25, 0, 0, 5501, 1071, 1, 0, 0, 0, 5502,
+		5503, 7, 29, 0, 0, 5503, 5504, 7, 20, 0, 0, 5504, 5505, 7, 17, 0, 0, 5505,
+		5506, 7, 6, 0, 0, 5506, 5507, 7, 10, 0, 0, 5507, 1073, 1, 0, 0, 0, 5508,
+		5509, 7, 13, 0, 0, 5509, 5510, 7, 10, 0, 0, 5510, 5511, 7, 27, 0, 0, 5511,
+		5512, 7, 10, 0, 0, 5512, 5513, 7, 13, 0, 0, 5513, 5514, 7, 9, 0, 0, 5514,
+		5515, 7, 10, 0, 0, 5515, 1075, 1, 0, 0, 0, 5516, 5517, 7, 25, 0, 0, 5517,
+		5518, 7, 19, 0, 0, 5518, 5519, 7, 13, 0, 0, 5519, 5520, 7, 10, 0, 0, 5520,
+		5521, 7, 5, 0, 0, 5521, 5522, 7, 14, 0, 0, 5522, 5523, 7, 20, 0, 0, 5523,
+		1077, 1, 0, 0, 0, 5524, 5525, 7, 9, 0, 0, 5525, 5526, 7, 6, 0, 0, 5526,
+		5527, 7, 17, 0, 0, 5527, 5528, 7, 14, 0, 0, 5528, 5529, 7, 10, 0, 0, 5529,
+		1079, 1, 0, 0, 0, 5530, 5531, 7, 10, 0, 0, 5531, 5532, 7, 26, 0, 0, 5532,
+		5533, 7, 17, 0, 0, 5533, 5534, 7, 16, 0, 0, 5534, 1081, 1, 0, 0, 0, 5535,
+		5536, 7, 13, 0, 0, 5536, 5537, 7, 10, 0, 0, 5537, 5538, 7, 16, 0, 0, 5538,
+		5539, 7, 22, 0, 0, 5539, 5540, 7, 13, 0, 0, 5540, 5541, 7, 7, 0, 0, 5541,
+		1083, 1, 0, 0, 0, 5542, 5543, 7, 28, 0, 0, 5543, 5544, 7, 22, 0, 0, 5544,
+		5545, 7, 10, 0, 0, 5545, 5546, 7, 13, 0, 0, 5546, 5547, 7, 8, 0, 0, 5547,
+		1085, 1, 0, 0, 0, 5548, 5549, 7, 13, 0, 0, 5549, 5550, 7, 5, 0, 0, 5550,
+		5551, 7, 17, 0, 0, 5551, 5552, 7, 9, 0, 0, 5552, 5553, 7, 10, 0, 0, 5553,
+		1087, 1, 0, 0, 0, 5554, 5555, 7, 9, 0, 0, 5555, 5556, 7, 28, 0, 0, 5556,
+		5557, 7, 6, 0, 0, 5557, 5558, 7, 9, 0, 0, 5558, 5559, 7, 16, 0, 0, 5559,
+		5560, 7, 5, 0, 0, 5560, 5561, 7, 16, 0, 0, 5561, 5562, 7, 10, 0, 0, 5562,
+		1089, 1, 0, 0, 0, 5563, 5564, 7, 12, 0, 0, 5564, 5565, 7, 10, 0, 0, 5565,
+		5566, 7, 18, 0, 0, 5566, 5567, 7, 22, 0, 0, 5567, 5568, 7, 23, 0, 0, 5568,
+		1091, 1, 0, 0, 0, 5569, 5570, 7, 6, 0, 0, 5570, 5571, 7, 19, 0, 0, 5571,
+		5572, 7, 23, 0, 0, 5572, 1093, 1, 0, 0, 0, 5573, 5574, 7, 17, 0, 0, 5574,
+		5575, 7, 7, 0, 0, 5575, 5576, 7, 25, 0, 0, 5576, 5577, 7, 19, 0, 0, 5577,
+		1095, 1, 0, 0, 0, 5578, 5579, 7, 7, 0, 0, 5579, 5580, 7, 19, 0, 0, 5580,
+		5581, 7, 16, 0, 0, 5581, 5582, 7, 17, 0, 0, 5582, 5583, 7, 14, 0, 0, 5583,
+		5584, 7, 10, 0, 0, 5584, 1097, 1, 0, 0, 0, 5585, 5586, 7, 29, 0, 0, 5586,
+		5587, 7, 5, 0, 0, 5587, 5588, 7, 13, 0, 0, 5588, 5589, 7, 7, 0, 0, 5589,
+		5590, 7, 17, 0, 0, 5590, 5591, 7, 7, 0, 0, 5591, 5592, 7, 23, 0, 0, 5592,
+		1099, 1, 0, 0, 0, 5593, 5594, 7, 10, 0, 0, 5594, 5595, 7, 26, 0, 0, 5595,
+		5596, 7, 14, 0, 0, 5596, 5597, 7, 10, 0, 0, 5597, 5598, 7, 24, 0, 0, 5598,
+		5599, 7, 16, 0, 0, 5599, 5600, 7, 17, 0, 0, 5600, 5601, 7, 19, 0, 0, 5601,
+		5602, 7, 7, 0, 0, 5602, 1101, 1, 0, 0, 0, 5603, 5604, 7, 5, 0, 0, 5604,
+		5605, 7, 9, 0, 0, 5605, 5606, 7, 9, 0, 0, 5606, 5607, 7, 10, 0, 0, 5607,
+		5608, 7, 13, 0, 0, 5608, 5609, 7, 16, 0, 0, 5609, 1103, 1, 0, 0, 0, 5610,
+		5611, 7, 6, 0, 0, 5611, 5612, 7, 19, 0, 0, 5612, 5613, 7, 19, 0, 0, 5613,
+		5614, 7, 24, 0, 0, 5614, 1105, 1, 0, 0, 0, 5615, 5616, 7, 19, 0, 0, 5616,
+		5617, 7, 24, 0, 0, 5617, 5618, 7, 10, 0, 0, 5618, 5619, 7, 7, 0, 0, 5619,
+		1107, 1, 0, 0, 0, 5620, 5621, 7, 5, 0, 0, 5621, 5622, 7, 18, 0, 0, 5622,
+		5623, 7, 9, 0, 0, 5623, 1109, 1, 0, 0, 0, 5624, 5625, 7, 14, 0, 0, 5625,
+		5626, 7, 18, 0, 0, 5626, 5627, 7, 13, 0, 0, 5627, 5628, 7, 16, 0, 0, 5628,
+		1111, 1, 0, 0, 0, 5629, 5630, 7, 14, 0, 0, 5630, 5631, 7, 10, 0, 0, 5631,
+		5632, 7, 17, 0, 0, 5632, 5633, 7, 6, 0, 0, 5633, 1113, 1, 0, 0, 0, 5634,
+		5635, 7, 14, 0, 0, 5635, 5636, 7, 10, 0, 0, 5636, 5637, 7, 17, 0, 0, 5637,
+		5638, 7, 6, 0, 0, 5638, 5639, 7, 17, 0, 0, 5639, 5640, 7, 7, 0, 0, 5640,
+		5641, 7, 23, 0, 0, 5641, 1115, 1, 0, 0, 0, 5642, 5643, 7, 12, 0, 0, 5643,
+		5644, 7, 10, 0, 0, 5644, 5645, 7, 23, 0, 0, 5645, 5646, 7, 13, 0, 0, 5646,
+		5647, 7, 10, 0, 0, 5647, 5648, 7, 10, 0, 0, 5648, 5649, 7, 9, 0, 0, 5649,
+		1117, 1, 0, 0, 0, 5650, 5651, 7, 12, 0, 0, 5651, 5652, 7, 17, 0, 0, 5652,
+		5653, 7, 27, 0, 0, 5653, 1119, 1, 0, 0, 0, 5654, 5655, 7, 10, 0, 0, 5655,
+		5656, 7, 26, 0, 0, 5656, 5657, 7, 24, 0, 0, 5657, 1121, 1, 0, 0, 0, 5658,
+		5659, 7, 25, 0, 0, 5659, 5660, 7, 5, 0, 0, 5660, 5661, 7, 14, 0, 0, 5661,
+		5662, 7, 16, 0, 0, 5662, 5663, 7, 19, 0, 0, 5663, 5664, 7, 13, 0, 0, 5664,
+		5665, 7, 17, 0, 0, 5665, 5666, 7, 5, 0, 0, 5666, 5667, 7, 6, 0, 0, 5667,
+		1123, 1, 0, 0, 0, 5668, 5669, 7, 25, 0, 0, 5669, 5670, 7, 6, 0, 0, 5670,
+		5671, 7, 19, 0, 0, 5671, 5672, 7, 19, 0, 0, 5672, 5673, 7, 13, 0, 0, 5673,
+		1125, 1, 0, 0, 0, 5674, 5675, 7, 23, 0, 0, 5675, 5676, 7, 14, 0, 0, 5676,
+		5677, 7, 12, 0, 0, 5677, 1127, 1, 0, 0, 0, 5678, 5679, 7, 6, 0, 0, 5679,
+		5680, 7, 14, 0, 0, 5680, 5681, 7, 15, 0, 0, 5681, 1129, 1, 0, 0, 0, 5682,
+		5683, 7, 6, 0, 0, 5683, 5684, 7, 7, 0, 0, 5684, 1131, 1, 0, 0, 0, 5685,
+		5686, 7, 6, 0, 0, 5686, 5687, 7, 19, 0, 0, 5687, 5688, 7, 23, 0, 0, 5688,
+		5689, 5, 49, 0, 0, 5689, 5690, 5, 48, 0, 0, 5690, 1133, 1, 0, 0, 0, 5691,
+		5692, 7, 15, 0, 0, 5692, 5693, 7, 17, 0, 0, 5693, 5694, 7, 7, 0, 0, 5694,
+		5695, 5, 95, 0, 0, 5695, 5696, 7, 9, 0, 0, 5696, 5697, 7, 14, 0, 0, 5697,
+		5698, 7, 5, 0, 0, 5698, 5699, 7, 6, 0, 0, 5699, 5700, 7, 10, 0, 0, 5700,
+		1135, 1, 0, 0, 0, 5701, 5702, 7, 15, 0, 0, 5702, 5703, 7, 19, 0, 0, 5703,
+		5704, 7, 12, 0, 0, 5704, 1137, 1, 0, 0, 0, 5705, 5706, 7, 24, 0, 0, 5706,
+		5707, 7, 17, 0, 0, 5707, 1139, 1, 0, 0, 0, 5708, 5709, 7, 24, 0, 0, 5709,
+		5710, 7, 19, 0, 0, 5710, 5711, 7, 29, 0, 0, 5711, 5712, 7, 10, 0, 0, 5712,
+		5713, 7, 13, 0, 0, 5713, 1141, 1, 0, 0, 0, 5714, 5715, 7, 13, 0, 0, 5715,
+		5716, 7, 5, 0, 0, 5716, 5717, 7, 12, 0, 0, 5717, 5718, 7, 17, 0, 0, 5718,
+		5719, 7, 5, 0, 0, 5719, 5720, 7, 7, 0, 0, 5720, 5721, 7, 9, 0, 0, 5721,
+		1143, 1, 0, 0, 0, 5722, 5723, 7, 13, 0, 0, 5723, 5724, 7, 19, 0, 0, 5724,
+		5725, 7, 22, 0, 0, 5725, 5726, 7, 7, 0, 0, 5726, 5727, 7, 12, 0, 0, 5727,
+		1145, 1, 0, 0, 0, 5728, 5729, 7, 9, 0, 0, 5729, 5730, 7, 14, 0, 0, 5730,
+		5731, 7, 5, 0, 0, 5731, 5732, 7, 6, 0, 0, 5732, 5733, 7, 10, 0, 0, 5733,
+		1147, 1, 0, 0, 0, 5734, 5735, 7, 9, 0, 0, 5735, 5736, 7, 17, 0, 0, 5736,
+		5737, 7, 23, 0, 0, 5737, 5738, 7, 7, 0, 0, 5738, 1149, 1, 0, 0, 0, 5739,
+		5740, 7, 9, 0, 0, 5740, 5741, 7, 28, 0, 0, 5741, 5742, 7, 13, 0, 0, 5742,
+		5743, 7, 16, 0, 0, 5743, 1151, 1, 0, 0, 0, 5744, 5745, 7, 16, 0, 0, 5745,
+		5746, 7, 13, 0, 0, 5746, 5747, 7, 17, 0, 0, 5747, 5748, 7, 15, 0, 0, 5748,
+		5749, 5, 95, 0, 0, 5749, 5750, 7, 9, 0, 0, 5750, 5751, 7, 14, 0, 0, 5751,
+		5752, 7, 5, 0, 0, 5752, 5753, 7, 6, 0, 0, 5753, 5754, 7, 10, 0, 0, 5754,
+		1153, 1, 0, 0, 0, 5755, 5756, 7, 16, 0, 0, 5756, 5757, 7, 13, 0, 0, 5757,
+		5758, 7, 22, 0, 0, 5758, 5759, 7, 7, 0, 0, 5759, 5760, 7, 14, 0, 0, 5760,
+		1155, 1, 0, 0, 0, 5761, 5762, 7, 29, 0, 0, 5762, 5763, 7, 17, 0, 0, 5763,
+		5764, 7, 12, 0, 0, 5764, 5765, 7, 16, 0, 0, 5765, 5766, 7, 20, 0, 0, 5766,
+		5767, 5, 95, 0, 0, 5767, 5768, 7, 18, 0, 0, 5768, 5769, 7, 22, 0, 0, 5769,
+		5770, 7, 14, 0, 0, 5770, 5771, 7, 21, 0, 0, 5771, 5772, 7, 10, 0, 0, 5772,
+		5773, 7, 16, 0, 0, 5773, 1157, 1, 0, 0, 0, 5774, 5775, 7, 13, 0, 0, 5775,
+		5776, 7, 5, 0, 0, 5776, 5777, 7, 7, 0, 0, 5777, 5778, 7, 12, 0, 0, 5778,
+		5779, 7, 19, 0, 0, 5779, 5780, 7, 15, 0, 0, 5780, 1159, 1, 0, 0, 0, 5781,
+		5782, 7, 9, 0, 0, 5782, 5783, 7, 10, 0, 0, 5783, 5784, 7, 16, 0, 0, 5784,
+		5785, 7, 9, 0, 0, 5785, 5786, 7, 10, 0, 0, 5786, 5787, 7, 10, 0, 0, 5787,
+		5788, 7, 12, 0, 0, 5788, 1161, 1, 0, 0, 0, 5789, 5790, 7, 5, 0, 0, 5790,
+		5791, 7, 14, 0, 0, 5791, 5792, 7, 19, 0, 0, 5792, 5793, 7, 9, 0, 0, 5793,
+		1163, 1, 0, 0, 0, 5794, 5795, 7, 5, 0, 0, 5795, 5796, 7, 14, 0, 0, 5796,
+		5797, 7, 19, 0, 0, 5797, 5798, 7, 9, 0, 0, 5798, 5799, 7, 12, 0, 0, 5799,
+		1165, 1, 0, 0, 0, 5800, 5801, 7, 5, 0, 0, 5801, 5802, 7, 9, 0, 0, 5802,
+		5803, 7, 17, 0, 0, 5803, 5804, 7, 7, 0, 0, 5804, 1167, 1, 0, 0, 0, 5805,
+		5806, 7, 5, 0, 0, 5806, 5807, 7, 9, 0, 0, 5807, 5808, 7, 17, 0, 0, 5808,
+		5809, 7, 7, 0, 0, 5809, 5810, 7, 12, 0, 0, 5810, 1169, 1, 0, 0, 0, 5811,
+		5812, 7, 5, 0, 0, 5812, 5813, 7, 16, 0, 0, 5813, 5814, 7, 5, 0, 0, 5814,
+		5815, 7, 7, 0, 0, 5815, 1171, 1, 0, 0, 0, 5816, 5817, 7, 5, 0, 0, 5817,
+		5818, 7, 16, 0, 0, 5818, 5819, 7, 5, 0, 0, 5819, 5820, 7, 7, 0, 0, 5820,
+		5821, 7, 12, 0, 0, 5821, 1173, 1, 0, 0, 0, 5822, 5823, 7, 5, 0, 0, 5823,
+		5824, 7, 16, 0, 0, 5824, 5825, 7, 5, 0, 0, 5825, 5826, 7, 7, 0, 0, 5826,
+		5827, 5, 50, 0, 0, 5827, 1175, 1, 0, 0, 0, 5828, 5829, 7, 5, 0, 0, 5829,
+		5830, 7, 16, 0, 0, 5830, 5831, 7, 5, 0, 0, 5831, 5832, 7, 7, 0, 0, 5832,
+		5833, 5, 50, 0, 0, 5833, 5834, 7, 12, 0, 0, 5834, 1177, 1, 0, 0, 0, 5835,
+		5836, 7, 14, 0, 0, 5836, 5837, 7, 19, 0, 0, 5837, 5838, 7, 9, 0, 0, 5838,
+		1179, 1, 0, 0, 0, 5839, 5840, 7, 14, 0, 0, 5840, 5841, 7, 19, 0, 0, 5841,
+		5842, 7, 9, 0, 0, 5842, 5843, 7, 12, 0, 0, 5843, 1181, 1, 0, 0, 0, 5844,
+		5845, 7, 14, 0, 0, 5845, 5846, 7, 19, 0, 0, 5846, 5847, 7, 16, 0, 0, 5847,
+		1183, 1, 0, 0, 0, 5848, 5849, 7, 14, 0, 0, 5849, 5850, 7, 19, 0, 0, 5850,
+		5851, 7, 16, 0, 0, 5851, 5852, 7, 12, 0, 0, 5852, 1185, 1, 0, 0, 0, 5853,
+		5854, 7, 9, 0, 0, 5854, 5855, 7, 17, 0, 0, 5855, 5856, 7, 7, 0, 0, 5856,
+		1187, 1, 0, 0, 0, 5857, 5858, 7, 9, 0, 0, 5858, 5859, 7, 17, 0, 0, 5859,
+		5860, 7, 7, 0, 0, 5860, 5861, 7, 12, 0, 0, 5861, 1189, 1, 0, 0, 0, 5862,
+		5863, 7, 16, 0, 0, 5863, 5864, 7, 5, 0, 0, 5864, 5865, 7, 7, 0, 0, 5865,
+		1191, 1, 0, 0, 0, 5866, 5867, 7, 16, 0, 0, 5867, 5868, 7, 5, 0, 0, 5868,
+		5869, 7, 7, 0, 0, 5869, 5870, 7, 12, 0, 0, 5870, 1193, 1, 0, 0, 0, 5871,
+		5872, 7, 9, 0, 0, 5872, 5873, 7, 17, 0, 0, 5873, 5874, 7, 7, 0, 0, 5874,
+		5875, 7, 20, 0, 0, 5875, 1195, 1, 0, 0, 0, 5876, 5877, 7, 14, 0, 0, 5877,
+		5878, 7, 19, 0, 0, 5878, 5879, 7, 9, 0, 0, 5879, 5880, 7, 20, 0, 0, 5880,
+		1197, 1, 0, 0, 0, 5881, 5882, 7, 16, 0, 0, 5882, 5883, 7, 5, 0, 0, 5883,
+		5884, 7, 7, 0, 0, 5884, 5885, 7, 20, 0, 0, 5885, 1199, 1, 0, 0, 0, 5886,
+		5887, 7, 5, 0, 0, 5887, 5888, 7, 9, 0, 0, 5888, 5889, 7, 17, 0, 0, 5889,
+		5890, 7, 7, 0, 0, 5890, 5891, 7, 20, 0, 0, 5891, 1201, 1, 0, 0, 0, 5892,
+		5893, 7, 5, 0, 0, 5893, 5894, 7, 14, 0, 0, 5894, 5895, 7, 19, 0, 0, 5895,
+		5896, 7, 9, 0, 0, 5896, 5897, 7, 20, 0, 0, 5897, 1203, 1, 0, 0, 0, 5898,
+		5899, 7, 5, 0, 0, 5899, 5900, 7, 16, 0, 0, 5900, 5901, 7, 5, 0, 0, 5901,
+		5902, 7, 7, 0, 0, 5902, 5903, 7, 20, 0, 0, 5903, 1205, 1, 0, 0, 0, 5904,
+		5905, 7, 18, 0, 0, 5905, 5906, 7, 17, 0, 0, 5906, 5907, 7, 16, 0, 0, 5907,
+		5908, 5, 95, 0, 0, 5908, 5909, 7, 6, 0, 0, 5909, 5910, 7, 10, 0, 0, 5910,
+		5911, 7, 7, 0, 0, 5911, 5912, 7, 23, 0, 0, 5912, 5913, 7, 16, 0, 0, 5913,
+		5914, 7, 20, 0, 0, 5914, 1207, 1, 0, 0, 0, 5915, 5916, 7, 14, 0, 0, 5916,
+		5917, 7, 20, 0, 0, 5917, 5918, 7, 5, 0, 0, 5918, 5919, 7, 13, 0, 0, 5919,
+		5920, 5, 95, 0, 0, 5920, 5921, 7, 6, 0, 0, 5921, 5922, 7, 10, 0, 0, 5922,
+		5923, 7, 7, 0, 0, 5923, 5924, 7, 23, 0, 0, 5924, 5925, 7, 16, 0, 0, 5925,
+		5926, 7, 20, 0, 0, 5926, 1209, 1, 0, 0, 0, 5927, 5928, 7, 14, 0, 0, 5928,
+		5929, 7, 20, 0, 0, 5929, 5930, 7, 5, 0, 0, 5930, 5931, 7, 13, 0, 0, 5931,
+		5932, 7, 5, 0, 0, 5932, 5933, 7, 14, 0, 0, 5933, 5934, 7, 16, 0, 0, 5934,
+		5935, 7, 10, 0, 0, 5935, 5936, 7, 13, 0, 0, 5936, 5937, 5, 95, 0, 0, 5937,
+		5938, 7, 6, 0, 0, 5938, 5939, 7, 10, 0, 0, 5939, 5940, 7, 7, 0, 0, 5940,
+		5941, 7, 23, 0, 0, 5941, 5942, 7, 16, 0, 0, 5942, 5943, 7, 20, 0, 0, 5943,
+		1211, 1, 0, 0, 0, 5944, 5945, 7, 6, 0, 0, 5945, 5946, 7, 19, 0, 0, 5946,
+		5947, 7, 29, 0, 0, 5947, 5948, 7, 10, 0, 0, 5948, 5949, 7, 13, 0, 0, 5949,
+		1213, 1, 0, 0, 0, 5950, 5951, 7, 19, 0, 0, 5951, 5952, 7, 14, 0, 0, 5952,
+		5953, 7, 16, 0, 0, 5953, 5954, 7, 10, 0, 0, 5954, 5955, 7, 16, 0, 0, 5955,
+		5956, 5, 95, 0, 0, 5956, 5957, 7, 6, 0, 0, 5957, 5958, 7, 10, 0, 0, 5958,
+		5959, 7, 7, 0, 0, 5959, 5960, 7, 23, 0, 0, 5960, 5961, 7, 16, 0, 0, 5961,
+		5962, 7, 20, 0, 0, 5962, 1215, 1, 0, 0, 0, 5963, 5964, 7, 22, 0, 0, 5964,
+		5965, 7, 24, 0, 0, 5965, 5966, 7, 24, 0, 0, 5966, 5967, 7, 10, 0, 0, 5967,
+		5968, 7, 13, 0, 0, 5968, 1217, 1, 0, 0, 0, 5969, 5970, 7, 5, 0, 0, 5970,
+		5971, 7, 9, 0, 0, 5971, 5972, 7, 14, 0, 0, 5972, 5973, 7, 17, 0, 0, 5973,
+		5974, 7, 17, 0, 0, 5974, 1219, 1, 0, 0, 0, 5975, 5976, 7, 18, 0, 0, 5976,
+		5977, 7, 16, 0, 0, 5977, 5978, 7, 13, 0, 0, 5978, 5979, 7, 17, 0, 0, 5979,
+		5980, 7, 15, 0, 0, 5980, 1221, 1, 0, 0, 0, 5981, 5982, 7, 14, 0, 0, 5982,
+		5983, 7, 20, 0, 0, 5983, 5984, 7, 13, 0, 0, 5984, 1223, 1, 0, 0, 0, 5985,
+		5986, 7, 14, 0, 0, 5986, 5987, 7, 19, 0, 0, 5987, 5988, 7, 7, 0, 0, 5988,
+		5989, 7, 14, 0, 0, 5989, 5990, 7, 5, 0, 0, 5990, 5991, 7, 16, 0, 0, 5991,
+		1225, 1, 0, 0, 0, 5992, 5993, 7, 14, 0, 0, 5993, 5994, 7, 19, 0, 0, 5994,
+		5995, 7, 7, 0, 0, 5995, 5996, 7, 14, 0, 0, 5996, 5997, 7, 5, 0, 0, 5997,
+		5998, 7, 16, 0, 0, 5998, 5999, 5, 95, 0, 0, 5999, 6000, 7, 29, 0, 0, 6000,
+		6001, 7, 9, 0, 0, 6001, 1227, 1, 0, 0, 0, 6002, 6003, 7, 25, 0, 0, 6003,
+		6004, 7, 19, 0, 0, 6004, 6005, 7, 13, 0, 0, 6005, 6006, 7, 15, 0, 0, 6006,
+		6007, 7, 5, 0, 0, 6007, 6008, 7, 16, 0, 0, 6008, 1229, 1, 0, 0, 0, 6009,
+		6010, 7, 17, 0, 0, 6010, 6011, 7, 7, 0, 0, 6011, 6012, 7, 17, 0, 0, 6012,
+		6013, 7, 16, 0, 0, 6013, 6014, 7, 14, 0, 0, 6014, 6015, 7, 5, 0, 0, 6015,
+		6016, 7, 24, 0, 0, 6016, 1231, 1, 0, 0, 0, 6017, 6018, 7, 6, 0, 0, 6018,
+		6019, 7, 10, 0, 0, 6019, 6020, 7, 7, 0, 0, 6020, 6021, 7, 23, 0, 0, 6021,
+		6022, 7, 16, 0, 0, 6022, 6023, 7, 20, 0, 0, 6023, 1233, 1, 0, 0, 0, 6024,
+		6025, 7, 6, 0, 0, 6025, 6026, 7, 24, 0, 0, 6026, 6027, 7, 5, 0, 0, 6027,
+		6028, 7, 12, 0, 0, 6028, 1235, 1, 0, 0, 0, 6029, 6030, 7, 6, 0, 0, 6030,
+		6031, 7, 16, 0, 0, 6031, 6032, 7, 13, 0, 0, 6032, 6033, 7, 17, 0, 0, 6033,
+		6034, 7, 15, 0, 0, 6034, 1237, 1, 0, 0, 0, 6035, 6036, 7, 15, 0, 0, 6036,
+		6037, 7, 12, 0, 0, 6037, 6038, 5, 53, 0, 0, 6038, 1239, 1, 0, 0, 0, 6039,
+		6040, 7, 24, 0, 0, 6040, 6041, 7, 5, 0, 0, 6041, 6042, 7, 13, 0, 0, 6042,
+		6043, 7, 9, 0, 0, 6043, 6044, 7, 10, 0, 0, 6044, 6045, 5, 95, 0, 0, 6045,
+		6046, 7, 17, 0, 0, 6046, 6047, 7, 12, 0, 0, 6047, 6048, 7, 10, 0, 0, 6048,
+		6049, 7, 7, 0, 0, 6049, 6050, 7, 16, 0, 0, 6050, 1241, 1, 0, 0, 0, 6051,
+		6052, 7, 24, 0, 0, 6052, 6053, 7, 23, 0, 0, 6053, 6054, 5, 95, 0, 0, 6054,
+		6055, 7, 14, 0, 0, 6055, 6056, 7, 6, 0, 0, 6056, 6057, 7, 17, 0, 0, 6057,
+		6058, 7, 10, 0, 0, 6058, 6059, 7, 7, 0, 0, 6059, 6060, 7, 16, 0, 0, 6060,
+		6061, 5, 95, 0, 0, 6061, 6062, 7, 10, 0, 0, 6062, 6063, 7, 7, 0, 0, 6063,
+		6064, 7, 14, 0, 0, 6064, 6065, 7, 19, 0, 0, 6065, 6066, 7, 12, 0, 0, 6066,
+		6067, 7, 17, 0, 0, 6067, 6068, 7, 7, 0, 0, 6068, 6069, 7, 23, 0, 0, 6069,
+		1243, 1, 0, 0, 0, 6070, 6071, 7, 28, 0, 0, 6071, 6072, 7, 22, 0, 0, 6072,
+		6073, 7, 19, 0, 0, 6073, 6074, 7, 16, 0, 0, 6074, 6075, 7, 10, 0, 0, 6075,
+		6076, 5, 95, 0, 0, 6076, 6077, 7, 17, 0, 0, 6077, 6078, 7, 12, 0, 0, 6078,
+		6079, 7, 10, 0, 0, 6079, 6080, 7, 7, 0, 0, 6080, 6081, 7, 16, 0, 0, 6081,
+		1245, 1, 0, 0, 0, 6082, 6083, 7, 28, 0, 0, 6083, 6084, 7, 22, 0, 0, 6084,
+		6085, 7, 19, 0, 0, 6085, 6086, 7, 16, 0, 0, 6086, 6087, 7, 10, 0, 0, 6087,
+		6088, 5, 95, 0, 0, 6088, 6089, 7, 6, 0, 0, 6089, 6090, 7, 17, 0, 0, 6090,
+		6091, 7, 16, 0, 0, 6091, 6092, 7, 10, 0, 0, 6092, 6093, 7, 13, 0, 0, 6093,
+		6094, 7, 5, 0, 0, 6094, 6095, 7, 6, 0, 0, 6095, 1247, 1, 0, 0, 0, 6096,
+		6097, 7, 28, 0, 0, 6097, 6098, 7, 22, 0, 0, 6098, 6099, 7, 19, 0, 0, 6099,
+		6100, 7, 16, 0, 0, 6100, 6101, 7, 10, 0, 0, 6101, 6102, 5, 95, 0, 0, 6102,
+		6103, 7, 7, 0, 0, 6103, 6104, 7, 22, 0, 0, 6104, 6105, 7, 6, 0, 0, 6105,
+		6106, 7, 6, 0, 0, 6106, 6107, 7, 5, 0, 0, 6107, 6108, 7, 18, 0, 0, 6108,
+		6109, 7, 6, 0, 0, 6109, 6110, 7, 10, 0, 0, 6110, 1249, 1, 0, 0, 0, 6111,
+		6112, 7, 13, 0, 0, 6112, 6113, 7, 10, 0, 0, 6113, 6114, 7, 23, 0, 0, 6114,
+		6115, 7, 10, 0, 0, 6115, 6116, 7, 26, 0, 0, 6116, 6117, 7, 24, 0, 0, 6117,
+		6118, 5, 95, 0, 0, 6118, 6119, 7, 14, 0, 0, 6119, 6120, 7, 19, 0, 0, 6120,
+		6121, 7, 22, 0, 0, 6121, 6122, 7, 7, 0, 0, 6122, 6123, 7, 16, 0, 0, 6123,
+		1251, 1, 0, 0, 0, 6124, 6125, 7, 13, 0, 0, 6125, 6126, 7, 10, 0, 0, 6126,
+		6127, 7, 23, 0, 0, 6127, 6128, 7, 10, 0, 0, 6128, 6129, 7, 26, 0, 0, 6129,
+		6130, 7, 24, 0, 0, 6130, 6131, 5, 95, 0, 0, 6131, 6132, 7, 17, 0, 0, 6132,
+		6133, 7, 7, 0, 0, 6133, 6134, 7, 9, 0, 0, 6134, 6135, 7, 16, 0, 0, 6135,
+		6136, 7, 13, 0, 0, 6136, 1253, 1, 0, 0, 0, 6137, 6138, 7, 13, 0, 0, 6138,
+		6139, 7, 10, 0, 0, 6139, 6140, 7, 23, 0, 0, 6140, 6141, 7, 10, 0, 0, 6141,
+		6142, 7, 26, 0, 0, 6142, 6143, 7, 24, 0, 0, 6143, 6144, 5, 95, 0, 0, 6144,
+		6145, 7, 6, 0, 0, 6145, 6146, 7, 17, 0, 0, 6146, 6147, 7, 21, 0, 0, 6147,
+		6148, 7, 10, 0, 0, 6148, 1255, 1, 0, 0, 0, 6149, 6150, 7, 13, 0, 0, 6150,
+		6151, 7, 10, 0, 0, 6151, 6152, 7, 23, 0, 0, 6152, 6153, 7, 10, 0, 0, 6153,
+		6154, 7, 26, 0, 0, 6154, 6155, 7, 24, 0, 0, 6155, 6156, 5, 95, 0, 0, 6156,
+		6157, 7, 15, 0, 0, 6157, 6158, 7, 5, 0, 0, 6158, 6159, 7, 16, 0, 0, 6159,
+		6160, 7, 14, 0, 0, 6160, 6161, 7, 20, 0, 0, 6161, 1257, 1, 0, 0, 0, 6162,
+		6163, 7, 13, 0, 0, 6163, 6164, 7, 10, 0, 0, 6164, 6165, 7, 23, 0, 0, 6165,
+		6166, 7, 10, 0, 0, 6166, 6167, 7, 26, 0, 0, 6167, 6168, 7, 24, 0, 0, 6168,
+		6169, 5, 95, 0, 0, 6169, 6170, 7, 15, 0, 0, 6170, 6171, 7, 5, 0, 0, 6171,
+		6172, 7, 16, 0, 0, 6172, 6173, 7, 14, 0, 0, 6173, 6174, 7, 20, 0, 0, 6174,
+		6175, 7, 10, 0, 0, 6175, 6176, 7, 9, 0, 0, 6176, 1259, 1, 0, 0, 0, 6177,
+		6178, 7, 13, 0, 0, 6178, 6179, 7, 10, 0, 0, 6179, 6180, 7, 23, 0, 0, 6180,
+		6181, 7, 10, 0, 0, 6181, 6182, 7, 26, 0, 0, 6182, 6183, 7, 24, 0, 0, 6183,
+		6184, 5, 95, 0, 0, 6184, 6185, 7, 13, 0, 0, 6185, 6186, 7, 10, 0, 0, 6186,
+		6187, 7, 24, 0, 0, 6187, 6188, 7, 6, 0, 0, 6188, 6189, 7, 5, 0, 0, 6189,
+		6190, 7, 14, 0, 0, 6190, 6191, 7, 10, 0, 0, 6191, 1261, 1, 0, 0, 0, 6192,
+		6193, 7, 13, 0, 0, 6193, 6194, 7, 10, 0, 0, 6194, 6195, 7, 23, 0, 0, 6195,
+		6196, 7, 10, 0, 0, 6196, 6197, 7, 26, 0, 0, 6197, 6198, 7, 24, 0, 0, 6198,
+		6199, 5, 95, 0, 0, 6199, 6200, 7, 9, 0, 0, 6200, 6201, 7, 24, 0, 0, 6201,
+		6202, 7, 6, 0, 0, 6202, 6203, 7, 17, 0, 0, 6203, 6204, 7, 16, 0, 0, 6204,
+		6205, 5, 95, 0, 0, 6205, 6206, 7, 16, 0, 0, 6206, 6207, 7, 19, 0, 0, 6207,
+		6208, 5, 95, 0, 0, 6208, 6209, 7, 5, 0, 0, 6209, 6210, 7, 13, 0, 0, 6210,
+		6211, 7, 13, 0, 0, 6211, 6212, 7, 5, 0, 0, 6212, 6213, 7, 8, 0, 0, 6213,
+		1263, 1, 0, 0, 0, 6214, 6215, 7, 13, 0, 0, 6215, 6216, 7, 10, 0, 0, 6216,
+		6217, 7, 23, 0, 0, 6217, 6218, 7, 10, 0, 0, 6218, 6219, 7, 26, 0, 0, 6219,
+		6220, 7, 24, 0, 0, 6220, 6221, 5, 95, 0, 0, 6221, 6222, 7, 9, 0, 0, 6222,
+		6223, 7, 24, 0, 0, 6223, 6224, 7, 6, 0, 0, 6224, 6225, 7, 17, 0, 0, 6225,
+		6226, 7, 16, 0, 0, 6226, 6227, 5, 95, 0, 0, 6227, 6228, 7, 16, 0, 0, 6228,
+		6229, 7, 19, 0, 0, 6229, 6230, 5, 95, 0, 0, 6230, 6231, 7, 16, 0, 0, 6231,
+		6232, 7, 5, 0, 0, 6232, 6233, 7, 18, 0, 0, 6233, 6234, 7, 6, 0, 0, 6234,
+		6235, 7, 10, 0, 0, 6235, 1265, 1, 0, 0, 0, 6236, 6237, 7, 13, 0, 0, 6237,
+		6238, 7, 10, 0, 0, 6238, 6239, 7, 23, 0, 0, 6239, 6240, 7, 10, 0, 0, 6240,
+		6241, 7, 26, 0, 0, 6241, 6242, 7, 24, 0, 0, 6242, 6243, 5, 95, 0, 0, 6243,
+		6244, 7, 9, 0, 0, 6244, 6245, 7, 22, 0, 0, 6245, 6246, 7, 18, 0, 0, 6246,
+		6247, 7, 9, 0, 0, 6247, 6248, 7, 16, 0, 0, 6248, 6249, 7, 13, 0, 0, 6249,
+		1267, 1, 0, 0, 0, 6250, 6251, 7, 13, 0, 0, 6251, 6252, 7, 10, 0, 0, 6252,
+		6253, 7, 24, 0, 0, 6253, 6254, 7, 10, 0, 0, 6254, 6255, 7, 5, 0, 0, 6255,
+		6256, 7, 16, 0, 0, 6256, 1269, 1, 0, 0, 0, 6257, 6258, 7, 13, 0, 0, 6258,
+		6259, 7, 24, 0, 0, 6259, 6260, 7, 5, 0, 0, 6260, 6261, 7, 12, 0, 0, 6261,
+		1271, 1, 0, 0, 0, 6262, 6263, 7, 13, 0, 0, 6263, 6264, 7, 16, 0, 0, 6264,
+		6265, 7, 13, 0, 0, 6265, 6266, 7, 17, 0, 0, 6266, 6267, 7, 15, 0, 0, 6267,
+		1273, 1, 0, 0, 0, 6268, 6269, 7, 9, 0, 0, 6269, 6270, 7, 24, 0, 0, 6270,
+		6271, 7, 6, 0, 0, 6271, 6272, 7, 17, 0, 0, 6272, 6273, 7, 16, 0, 0, 6273,
+		6274, 5, 95, 0, 0, 6274, 6275, 7, 24, 0, 0, 6275, 6276, 7, 5, 0, 0, 6276,
+		6277, 7, 13, 0, 0, 6277, 6278, 7, 16, 0, 0, 6278, 1275, 1, 0, 0, 0, 6279,
+		6280, 7, 9, 0, 0, 6280, 6281, 7, 16, 0, 0, 6281, 6282, 7, 5, 0, 0, 6282,
+		6283, 7, 13, 0, 0, 6283, 6284, 7, 16, 0, 0, 6284, 6285, 7, 9, 0, 0, 6285,
+		6286, 5, 95, 0, 0, 6286, 6287, 7, 29, 0, 0, 6287, 6288, 7, 17, 0, 0, 6288,
+		6289, 7, 16, 0, 0, 6289, 6290, 7, 20, 0, 0, 6290, 1277, 1, 0, 0, 0, 6291,
+		6292, 7, 9, 0, 0, 6292, 6293, 7, 16, 0, 0, 6293, 6294, 7, 13, 0, 0, 6294,
+		6295, 7, 17, 0, 0, 6295, 6296, 7, 7, 0, 0, 6296, 6297, 7, 23, 0, 0, 6297,
+		6298, 5, 95, 0, 0, 6298, 6299, 7, 16, 0, 0, 6299, 6300, 7, 19, 0, 0, 6300,
+		6301, 5, 95, 0, 0, 6301, 6302, 7, 5, 0, 0, 6302, 6303, 7, 13, 0, 0, 6303,
+		6304, 7, 13, 0, 0, 6304, 6305, 7, 5, 0, 0, 6305, 6306, 7, 8, 0, 0, 6306,
+		1279, 1, 0, 0, 0, 6307, 6308, 7, 9, 0, 0, 6308, 6309, 7, 16, 0, 0, 6309,
+		6310, 7, 13, 0, 0, 6310, 6311, 7, 17, 0, 0, 6311, 6312, 7, 7, 0, 0, 6312,
+		6313, 7, 23, 0, 0, 6313, 6314, 5, 95, 0, 0, 6314, 6315, 7, 16, 0, 0, 6315,
+		6316, 7, 19, 0, 0, 6316, 6317, 5, 95, 0, 0, 6317, 6318, 7, 16, 0, 0, 6318,
+		6319, 7, 5, 0, 0, 6319, 6320, 7, 18, 0, 0, 6320, 6321, 7, 6, 0, 0, 6321,
+		6322, 7, 10, 0, 0, 6322, 1281, 1, 0, 0, 0, 6323, 6324, 7, 9, 0, 0, 6324,
+		6325, 7, 16, 0, 0, 6325, 6326, 7, 13, 0, 0, 6326, 6327, 7, 24, 0, 0, 6327,
+		6328, 7, 19, 0, 0, 6328, 6329, 7, 9, 0, 0, 6329, 1283, 1, 0, 0, 0, 6330,
+		6331, 7, 9, 0, 0, 6331, 6332, 7, 22, 0, 0, 6332, 6333, 7, 18, 0, 0, 6333,
+		6334, 7, 9, 0, 0, 6334, 6335, 7, 16, 0, 0, 6335, 6336, 7, 13, 0, 0, 6336,
+		1285, 1, 0, 0, 0, 6337, 6338, 7, 16, 0, 0, 6338, 6339, 7, 19, 0, 0, 6339,
+		6340, 5, 95, 0, 0, 6340, 6341, 7, 5, 0, 0, 6341, 6342, 7, 9, 0, 0, 6342,
+		6343, 7, 14, 0, 0, 6343, 6344, 7, 17, 0, 0, 6344, 6345, 7, 17, 0, 0, 6345,
+		1287, 1, 0, 0, 0, 6346, 6347, 7, 16, 0, 0, 6347, 6348, 7, 19, 0, 0, 6348,
+		6349, 5, 95, 0, 0, 6349, 6350, 7, 20, 0, 0, 6350, 6351, 7, 10, 0, 0, 6351,
+		6352, 7, 26, 0, 0, 6352, 1289, 1, 0, 0, 0, 6353, 6354, 7, 16, 0, 0, 6354,
+		6355, 7, 13, 0, 0, 6355, 6356, 7, 5, 0, 0, 6356, 6357, 7, 7, 0, 0, 6357,
+		6358, 7, 9, 0, 0, 6358, 6359, 7, 6, 0, 0, 6359, 6360, 7, 5, 0, 0, 6360,
+		6361, 7, 16, 0, 0, 6361, 6362, 7, 10, 0, 0, 6362, 1291, 1, 0, 0, 0, 6363,
+		6364, 7, 22, 0, 0, 6364, 6365, 7, 7, 0, 0, 6365, 6366, 7, 17, 0, 0, 6366,
+		6367, 7, 9, 0, 0, 6367, 6368, 7, 16, 0, 0, 6368, 6369, 7, 13, 0, 0, 6369,
+		1293, 1, 0, 0, 0, 6370, 6371, 7, 5, 0, 0, 6371, 6372, 7, 23, 0, 0, 6372,
+		6373, 7, 10, 0, 0, 6373, 1295, 1, 0, 0, 0, 6374, 6375, 7, 14, 0, 0, 6375,
+		6376, 7, 6, 0, 0, 6376, 6377, 7, 19, 0, 0, 6377, 6378, 7, 14, 0, 0, 6378,
+		6379, 7, 21, 0, 0, 6379, 6380, 5, 95, 0, 0, 6380, 6381, 7, 16, 0, 0, 6381,
+		6382, 7, 17, 0, 0, 6382, 6383, 7, 15, 0, 0, 6383, 6384, 7, 10, 0, 0, 6384,
+		6385, 7, 9, 0, 0, 6385, 6386, 7, 16, 0, 0, 6386, 6387, 7, 5, 0, 0, 6387,
+		6388, 7, 15, 0, 0, 6388, 6389, 7, 24, 0, 0, 6389, 1297, 1, 0, 0, 0, 6390,
+		6391, 7, 12, 0, 0, 6391, 6392, 7, 5, 0, 0, 6392, 6393, 7, 16, 0, 0, 6393,
+		6394, 7, 10, 0, 0, 6394, 6395, 5, 95, 0, 0, 6395, 6396, 7, 18, 0, 0, 6396,
+		6397, 7, 17, 0, 0, 6397, 6398, 7, 7, 0, 0, 6398, 1299, 1, 0, 0, 0, 6399,
+		6400, 7, 12, 0, 0, 6400, 6401, 7, 5, 0, 0, 6401, 6402, 7, 16, 0, 0, 6402,
+		6403, 7, 10, 0, 0, 6403, 6404, 5, 95, 0, 0, 6404, 6405, 7, 24, 0, 0, 6405,
+		6406, 7, 5, 0, 0, 6406, 6407, 7, 13, 0, 0, 6407, 6408, 7, 16, 0, 0, 6408,
+		1301, 1, 0, 0, 0, 6409, 6410, 7, 12, 0, 0, 6410, 6411, 7, 5, 0, 0, 6411,
+		6412, 7, 16, 0, 0, 6412, 6413, 7, 10, 0, 0, 6413, 6414, 5, 95, 0, 0, 6414,
+		6415, 7, 16, 0, 0, 6415, 6416, 7, 13, 0, 0, 6416, 6417, 7, 22, 0, 0, 6417,
+		6418, 7, 7, 0, 0, 6418, 6419, 7, 14, 0, 0, 6419, 1303, 1, 0, 0, 0, 6420,
+		6421, 7, 17, 0, 0, 6421, 6422, 7, 9, 0, 0, 6422, 6423, 7, 25, 0, 0, 6423,
+		6424, 7, 17, 0, 0, 6424, 6425, 7, 7, 0, 0, 6425, 6426, 7, 17, 0, 0, 6426,
+		6427, 7, 16, 0, 0, 6427, 6428, 7, 10, 0, 0, 6428, 1305, 1, 0, 0, 0, 6429,
+		6430, 7, 30, 0, 0, 6430, 6431, 7, 22, 0, 0, 6431, 6432, 7, 9, 0, 0, 6432,
+		6433, 7, 16, 0, 0, 6433, 6434, 7, 17, 0, 0, 6434, 6435, 7, 25, 0, 0, 6435,
+		6436, 7, 8, 0, 0, 6436, 6437, 5, 95, 0, 0, 6437, 6438, 7, 12, 0, 0, 6438,
+		6439, 7, 5, 0, 0, 6439, 6440, 7, 8, 0, 0, 6440, 6441, 7, 9, 0, 0, 6441,
+		1307, 1, 0, 0, 0, 6442, 6443, 7, 30, 0, 0, 6443, 6444, 7, 22, 0, 0, 6444,
+		6445, 7, 9, 0, 0, 6445, 6446, 7, 16, 0, 0, 6446, 6447, 7, 17, 0, 0, 6447,
+		6448, 7, 25, 0, 0, 6448, 6449, 7, 8, 0, 0, 6449, 6450, 5, 95, 0, 0, 6450,
+		6451, 7, 20, 0, 0, 6451, 6452, 7, 19, 0, 0, 6452, 6453, 7, 22, 0, 0, 6453,
+		6454, 7, 13, 0, 0, 6454, 6455, 7, 9, 0, 0, 6455, 1309, 1, 0, 0, 0, 6456,
+		6457, 7, 30, 0, 0, 6457, 6458, 7, 22, 0, 0, 6458, 6459, 7, 9, 0, 0, 6459,
+		6460, 7, 16, 0, 0, 6460, 6461, 7, 17, 0, 0, 6461, 6462, 7, 25, 0, 0, 6462,
+		6463, 7, 8, 0, 0, 6463, 6464, 5, 95, 0, 0, 6464, 6465, 7, 17, 0, 0, 6465,
+		6466, 7, 7, 0, 0, 6466, 6467, 7, 16, 0, 0, 6467, 6468, 7, 10, 0, 0, 6468,
+		6469, 7, 13, 0, 0, 6469, 6470, 7, 27, 0, 0, 6470, 6471, 7, 5, 0, 0, 6471,
+		6472, 7, 6, 0, 0, 6472, 1311, 1, 0, 0, 0, 6473, 6474, 7, 15, 0, 0, 6474,
+		6475, 7, 5, 0, 0, 6475, 6476, 7, 21, 0, 0, 6476, 6477, 7, 10, 0, 0, 6477,
+		6478, 5, 95, 0, 0, 6478, 6479, 7, 12, 0, 0, 6479, 6480, 7, 5, 0, 0, 6480,
+		6481, 7, 16, 0, 0, 6481, 6482, 7, 10, 0, 0, 6482, 1313, 1, 0, 0, 0, 6483,
+		6484, 7, 15, 0, 0, 6484, 6485, 7, 5, 0, 0, 6485, 6486, 7, 21, 0, 0, 6486,
+		6487, 7, 10, 0, 0, 6487, 6488, 5, 95, 0, 0, 6488, 6489, 7, 17, 0, 0, 6489,
+		6490, 7, 7, 0, 0, 6490, 6491, 7, 16, 0, 0, 6491, 6492, 7, 10, 0, 0, 6492,
+		6493, 7, 13, 0, 0, 6493, 6494, 7, 27, 0, 0, 6494, 6495, 7, 5, 0, 0, 6495,
+		6496, 7, 6, 0, 0, 6496, 1315, 1, 0, 0, 0, 6497, 6498, 7, 15, 0, 0, 6498,
+		6499, 7, 5, 0, 0, 6499, 6500, 7, 21, 0, 0, 6500, 6501, 7, 10, 0, 0, 6501,
+		6502, 5, 95, 0, 0, 6502, 6503, 7, 16, 0, 0, 6503, 6504, 7, 17, 0, 0, 6504,
+		6505, 7, 15, 0, 0, 6505, 6506, 7, 10, 0, 0, 6506, 1317, 1, 0, 0, 0, 6507,
+		6508, 7, 15, 0, 0, 6508, 6509, 7, 5, 0, 0, 6509, 6510, 7, 21, 0, 0, 6510,
+		6511, 7, 10, 0, 0, 6511, 6512, 5, 95, 0, 0, 6512, 6513, 7, 16, 0, 0, 6513,
+		6514, 7, 17, 0, 0, 6514, 6515, 7, 15, 0, 0, 6515, 6516, 7, 10, 0, 0, 6516,
+		6517, 7, 9, 0, 0, 6517, 6518, 7, 16, 0, 0, 6518, 6519, 7, 5, 0, 0, 6519,
+		6520, 7, 15, 0, 0, 6520, 6521, 7, 24, 0, 0, 6521, 1319, 1, 0, 0, 0, 6522,
+		6523, 7, 15, 0, 0, 6523, 6524, 7, 5, 0, 0, 6524, 6525, 7, 21, 0, 0, 6525,
+		6526, 7, 10, 0, 0, 6526, 6527, 5, 95, 0, 0, 6527, 6528, 7, 16, 0, 0, 6528,
+		6529, 7, 17, 0, 0, 6529, 6530, 7, 15, 0, 0, 6530, 6531, 7, 10, 0, 0, 6531,
+		6532, 7, 9, 0, 0, 6532, 6533, 7, 16, 0, 0, 6533, 6534, 7, 5, 0, 0, 6534,
+		6535, 7, 15, 0, 0, 6535, 6536, 7, 24, 0, 0, 6536, 6537, 7, 16, 0, 0, 6537,
+		6538, 7, 11, 0, 0, 6538, 1321, 1, 0, 0, 0, 6539, 6540, 7, 7, 0, 0, 6540,
+		6541, 7, 19, 0, 0, 6541, 6542, 7, 29, 0, 0, 6542, 1323, 1, 0, 0, 0, 6543,
+		6544, 7, 9, 0, 0, 6544, 6545, 7, 16, 0, 0, 6545, 6546, 7, 5, 0, 0, 6546,
+		6547, 7, 16, 0, 0, 6547, 6548, 7, 10, 0, 0, 6548, 6549, 7, 15, 0, 0, 6549,
+		6550, 7, 10, 0, 0, 6550, 6551, 7, 7, 0, 0, 6551, 6552, 7, 16, 0, 0, 6552,
+		6553, 5, 95, 0, 0, 6553, 6554, 7, 16, 0, 0, 6554, 6555, 7, 17, 0, 0, 6555,
+		6556, 7, 15, 0, 0, 6556, 6557, 7, 10, 0, 0, 6557, 6558, 7, 9, 0, 0, 6558,
+		6559, 7, 16, 0, 0, 6559, 6560, 7, 5, 0, 0, 6560, 6561, 7, 15, 0, 0, 6561,
+		6562, 7, 24, 0, 0, 6562, 1325, 1, 0, 0, 0, 6563, 6564, 7, 16, 0, 0, 6564,
+		6565, 7, 17, 0, 0, 6565, 6566, 7, 15, 0, 0, 6566, 6567, 7, 10, 0, 0, 6567,
+		6568, 7, 19, 0, 0, 6568, 6569, 7, 25, 0, 0, 6569, 6570, 7, 12, 0, 0, 6570,
+		6571, 7, 5, 0, 0, 6571, 6572, 7, 8, 0, 0, 6572, 1327, 1, 0, 0, 0, 6573,
+		6574, 7, 16, 0, 0, 6574, 6575, 7, 13, 0, 0, 6575, 6576, 7, 5, 0, 0, 6576,
+		6577, 7, 7, 0, 0, 6577, 6578, 7, 9, 0, 0, 6578, 6579, 7, 5, 0, 0, 6579,
+		6580, 7, 14, 0, 0, 6580, 6581, 7, 16, 0, 0, 6581, 6582, 7, 17, 0, 0, 6582,
+		6583, 7, 19, 0, 0, 6583, 6584, 7, 7, 0, 0, 6584, 6585, 5, 95, 0, 0, 6585,
+		6586, 7, 16, 0, 0, 6586, 6587, 7, 17, 0, 0, 6587, 6588, 7, 15, 0, 0, 6588,
+		6589, 7, 10, 0, 0, 6589, 6590, 7, 9, 0, 0, 6590, 6591, 7, 16, 0, 0, 6591,
+		6592, 7, 5, 0, 0, 6592, 6593, 7, 15, 0, 0, 6593, 6594, 7, 24, 0, 0, 6594,
+		1329, 1, 0, 0, 0, 6595, 6596, 7, 16, 0, 0, 6596, 6597, 7, 19, 0, 0, 6597,
+		6598, 5, 95, 0, 0, 6598, 6599, 7, 16, 0, 0, 6599, 6600, 7, 17, 0, 0, 6600,
+		6601, 7, 15, 0, 0, 6601, 6602, 7, 10, 0, 0, 6602, 6603, 7, 9, 0, 0, 6603,
+		6604, 7, 16, 0, 0, 6604, 6605, 7, 5, 0, 0, 6605, 6606, 7, 15, 0, 0, 6606,
+		6607, 7, 24, 0, 0, 6607, 1331, 1, 0, 0, 0, 6608, 6609, 7, 16, 0, 0, 6609,
+		6610, 7, 19, 0, 0, 6610, 6611, 5, 95, 0, 0, 6611, 6612, 7, 14, 0, 0, 6612,
+		6613, 7, 20, 0, 0, 6613, 6614, 7, 5, 0, 0, 6614, 6615, 7, 13, 0, 0, 6615,
+		1333, 1, 0, 0, 0, 6616, 6617, 7, 16, 0, 0, 6617, 6618, 7, 19, 0, 0, 6618,
+		6619, 5, 95, 0, 0, 6619, 6620, 7, 12, 0, 0, 6620, 6621, 7, 5, 0, 0, 6621,
+		6622, 7, 16, 0, 0, 6622, 6623, 7, 10, 0, 0, 6623, 1335, 1, 0, 0, 0, 6624,
+		6625, 7, 16, 0, 0, 6625, 6626, 7, 19, 0, 0, 6626, 6627, 5, 95, 0, 0, 6627,
+		6628, 7, 7, 0, 0, 6628, 6629, 7, 22, 0, 0, 6629, 6630, 7, 15, 0, 0, 6630,
+		6631, 7, 18, 0, 0, 6631, 6632, 7, 10, 0, 0, 6632, 6633, 7, 13, 0, 0, 6633,
+		1337, 1, 0, 0, 0, 6634, 6635, 7, 10, 0, 0, 6635, 6636, 7, 7, 0, 0, 6636,
+		6637, 7, 14, 0, 0, 6637, 6638, 7, 19, 0, 0, 6638, 6639, 7, 12, 0, 0, 6639,
+		6640, 7, 10, 0, 0, 6640, 1339, 1, 0, 0, 0, 6641, 6642, 7, 12, 0, 0, 6642,
+		6643, 7, 17, 0, 0, 6643, 6644, 7, 9, 0, 0, 6644, 6645, 7, 16, 0, 0, 6645,
+		6646, 7, 21, 0, 0, 6646, 6647, 7, 10, 0, 0, 6647, 6648, 7, 8, 0, 0, 6648,
+		1341, 1, 0, 0, 0, 6649, 6650, 7, 9, 0, 0, 6650, 6651, 7, 19, 0, 0, 6651,
+		6652, 7, 13, 0, 0, 6652, 6653, 7, 16, 0, 0, 6653, 6654, 7, 21, 0, 0, 6654,
+		6655, 7, 10, 0, 0, 6655, 6656, 7, 8, 0, 0, 6656, 1343, 1, 0, 0, 0, 6657,
+		6658, 7, 14, 0, 0, 6658, 6659, 7, 5, 0, 0, 6659, 6660, 7, 9, 0, 0, 6660,
+		6661, 7, 10, 0, 0, 6661, 6662, 5, 95, 0, 0, 6662, 6663, 7, 9, 0, 0, 6663,
+		6664, 7, 10, 0, 0, 6664, 6665, 7, 7, 0, 0, 6665, 6666, 7, 9, 0, 0, 6666,
+		6667, 7, 17, 0, 0, 6667, 6668, 7, 16, 0, 0, 6668, 6669, 7, 17, 0, 0, 6669,
+		6670, 7, 27, 0, 0, 6670, 6671, 7, 10, 0, 0, 6671, 1345, 1, 0, 0, 0, 6672,
+		6673, 7, 14, 0, 0, 6673, 6674, 7, 5, 0, 0, 6674, 6675, 7, 9, 0, 0, 6675,
+		6676, 7, 10, 0, 0, 6676, 6677, 5, 95, 0, 0, 6677, 6678, 7, 17, 0, 0, 6678,
+		6679, 7, 7, 0, 0, 6679, 6680, 7, 9, 0, 0, 6680, 6681, 7, 10, 0, 0, 6681,
+		6682, 7, 7, 0, 0, 6682, 6683, 7, 9, 0, 0, 6683, 6684, 7, 17, 0, 0, 6684,
+		6685, 7, 16, 0, 0, 6685, 6686, 7, 17, 0, 0, 6686, 6687, 7, 27, 0, 0, 6687,
+		6688, 7, 10, 0, 0, 6688, 1347, 1, 0, 0, 0, 6689, 6693, 3, 1350, 672, 0,
+		6690, 6692, 3, 1352, 673, 0, 6691, 6690, 1, 0, 0, 0, 6692, 6695, 1, 0,
+		0, 0, 6693, 6691, 1, 0, 0, 0, 6693, 6694, 1, 0, 0, 0, 6694, 1349, 1, 0,
+		0, 0, 6695, 6693, 1, 0, 0, 0, 6696, 6703, 7, 31, 0, 0, 6697, 6698, 7, 32,
+		0, 0, 6698, 6703, 4, 672, 6, 0, 6699, 6700, 7, 33, 0, 0, 6700, 6701, 7,
+		34, 0, 0, 6701, 6703, 4, 672, 7, 0, 6702, 6696, 1, 0, 0, 0, 6702, 6697,
+		1, 0, 0, 0, 6702, 6699, 1, 0, 0, 0, 6703, 1351, 1, 0, 0, 0, 6704, 6707,
+		3, 1354, 674, 0, 6705, 6707, 5, 36, 0, 0, 6706, 6704, 1, 0, 0, 0, 6706,
+		6705, 1, 0, 0, 0, 6707, 1353, 1, 0, 0, 0, 6708, 6711, 3, 1350, 672, 0,
+		6709, 6711, 7, 0, 0, 0, 6710, 6708, 1, 0, 0, 0, 6710, 6709, 1, 0, 0, 0,
+		6711, 1355, 1, 0, 0, 0, 6712, 6713, 3, 1358, 676, 0, 6713, 6714, 5, 34,
+		0, 0, 6714, 1357, 1, 0, 0, 0, 6715, 6721, 5, 34, 0, 0, 6716, 6717, 5, 34,
+		0, 0, 6717, 6720, 5, 34, 0, 0, 6718, 6720, 8, 35, 0, 0, 6719, 6716, 1,
+		0, 0, 0, 6719, 6718, 1, 0, 0, 0, 6720, 6723, 1, 0, 0, 0, 6721, 6719, 1,
+		0, 0, 0, 6721, 6722, 1, 0, 0, 0, 6722, 1359, 1, 0, 0, 0, 6723, 6721, 1,
+		0, 0, 0, 6724, 6725, 3, 1362, 678, 0, 6725, 6726, 5, 34, 0, 0, 6726, 1361,
+		1, 0, 0, 0, 6727, 6733, 5, 34, 0, 0, 6728, 6729, 5, 34, 0, 0, 6729, 6732,
+		5, 34, 0, 0, 6730, 6732, 8, 36, 0, 0, 6731, 6728, 1, 0, 0, 0, 6731, 6730,
+		1, 0, 0, 0, 6732, 6735, 1, 0, 0, 0, 6733, 6731, 1, 0, 0, 0, 6733, 6734,
+		1, 0, 0, 0, 6734, 1363, 1, 0, 0, 0, 6735, 6733, 1, 0, 0, 0, 6736, 6737,
+		7, 22, 0, 0, 6737, 6738, 5, 38, 0, 0, 6738, 6739, 3, 1356, 675, 0, 6739,
+		1365, 1, 0, 0, 0, 6740, 6741, 7, 22, 0, 0, 6741, 6742, 5, 38, 0, 0, 6742,
+		6743, 3, 1358, 676, 0, 6743, 1367, 1, 0, 0, 0, 6744, 6745, 7, 22, 0, 0,
+		6745, 6746, 5, 38, 0, 0, 6746, 6747, 3, 1360, 677, 0, 6747, 1369, 1, 0,
+		0, 0, 6748, 6749, 7, 22, 0, 0, 6749, 6750, 5, 38, 0, 0, 6750, 6751, 3,
+		1362, 678, 0, 6751, 1371, 1, 0, 0, 0, 6752, 6753, 3, 1374, 684, 0, 6753,
+		6754, 5, 39, 0, 0, 6754, 1373, 1, 0, 0, 0, 6755, 6761, 5, 39, 0, 0, 6756,
+		6757, 5, 39, 0, 0, 6757, 6760, 5, 39, 0, 0, 6758, 6760, 8, 37, 0, 0, 6759,
+		6756, 1, 0, 0, 0, 6759, 6758, 1, 0, 0, 0, 6760, 6763, 1, 0, 0, 0, 6761,
+		6759, 1, 0, 0, 0, 6761, 6762, 1, 0, 0, 0, 6762, 1375, 1, 0, 0, 0, 6763,
+		6761, 1, 0, 0, 0, 6764, 6765, 7, 10, 0, 0, 6765, 6766, 5, 39, 0, 0, 6766,
+		6767, 1, 0, 0, 0, 6767, 6768, 6, 685, 2, 0, 6768, 6769, 6, 685, 3, 0, 6769,
+		1377, 1, 0, 0, 0, 6770, 6771, 3, 1380, 687, 0, 6771, 6772, 5, 39, 0, 0,
+		6772, 1379, 1, 0, 0, 0, 6773, 6774, 7, 22, 0, 0, 6774, 6775, 5, 38, 0,
+		0, 6775, 6776, 3, 1374, 684, 0, 6776, 1381, 1, 0, 0, 0, 6777, 6779, 5,
+		36, 0, 0, 6778, 6780, 3, 1384, 689, 0, 6779, 6778, 1, 0, 0, 0, 6779, 6780,
+		1, 0, 0, 0, 6780, 6781, 1, 0, 0, 0, 6781, 6782, 5, 36, 0, 0, 6782, 6783,
+		6, 688, 4, 0, 6783, 6784, 1, 0, 0, 0, 6784, 6785, 6, 688, 5, 0, 6785, 1383,
+		1, 0, 0, 0, 6786, 6790, 3, 1350, 672, 0, 6787, 6789, 3, 1354, 674, 0, 6788,
+		6787, 1, 0, 0, 0, 6789, 6792, 1, 0, 0, 0, 6790, 6788, 1, 0, 0, 0, 6790,
+		6791, 1, 0, 0, 0, 6791, 1385, 1, 0, 0, 0, 6792, 6790, 1, 0, 0, 0, 6793,
+		6794, 3, 1388, 691, 0, 6794, 6795, 5, 39, 0, 0, 6795, 1387, 1, 0, 0, 0,
+		6796, 6797, 7, 18, 0, 0, 6797, 6801, 5, 39, 0, 0, 6798, 6800, 7, 38, 0,
+		0, 6799, 6798, 1, 0, 0, 0, 6800, 6803, 1, 0, 0, 0, 6801, 6799, 1, 0, 0,
+		0, 6801, 6802, 1, 0, 0, 0, 6802, 1389, 1, 0, 0, 0, 6803, 6801, 1, 0, 0,
+		0, 6804, 6805, 3, 1392, 693, 0, 6805, 6806, 5, 39, 0, 0, 6806, 1391, 1,
+		0, 0, 0, 6807, 6808, 7, 18, 0, 0, 6808, 6809, 3, 1374, 684, 0, 6809, 1393,
+		1, 0, 0, 0, 6810, 6811, 3, 1396, 695, 0, 6811, 6812, 5, 39, 0, 0, 6812,
+		1395, 1, 0, 0, 0, 6813, 6814, 7, 26, 0, 0, 6814, 6818, 5, 39, 0, 0, 6815,
+		6817, 7, 39, 0, 0, 6816, 6815, 1, 0, 0, 0, 6817, 6820, 1, 0, 0, 0, 6818,
+		6816, 1, 0, 0, 0, 6818, 6819, 1, 0, 0, 0, 6819, 1397, 1, 0, 0, 0, 6820,
+		6818, 1, 0, 0, 0, 6821, 6822, 3, 1400, 697, 0, 6822, 6823, 5, 39, 0, 0,
+		6823, 1399, 1, 0, 0, 0, 6824, 6825, 7, 26, 0, 0, 6825, 6826, 3, 1374, 684,
+		0, 6826, 1401, 1, 0, 0, 0, 6827, 6828, 3, 1408, 701, 0, 6828, 1403, 1,
+		0, 0, 0, 6829, 6830, 3, 1408, 701, 0, 6830, 6831, 5, 46, 0, 0, 6831, 6832,
+		5, 46, 0, 0, 6832, 6833, 1, 0, 0, 0, 6833, 6834, 6, 699, 6, 0, 6834, 1405,
+		1, 0, 0, 0, 6835, 6836, 3, 1408, 701, 0, 6836, 6838, 5, 46, 0, 0, 6837,
+		6839, 3, 1408, 701, 0, 6838, 6837, 1, 0, 0, 0, 6838, 6839, 1, 0, 0, 0,
+		6839, 6845, 1, 0, 0, 0, 6840, 6842, 7, 10, 0, 0, 6841, 6843, 7, 1, 0, 0,
+		6842, 6841, 1, 0, 0, 0, 6842, 6843, 1, 0, 0, 0, 6843, 6844, 1, 0, 0, 0,
+		6844, 6846, 3, 1408, 701, 0, 6845, 6840, 1, 0, 0, 0, 6845, 6846, 1, 0,
+		0, 0, 6846, 6864, 1, 0, 0, 0, 6847, 6848, 5, 46, 0, 0, 6848, 6854, 3, 1408,
+		701, 0, 6849, 6851, 7, 10, 0, 0, 6850, 6852, 7, 1, 0, 0, 6851, 6850, 1,
+		0, 0, 0, 6851, 6852, 1, 0, 0, 0, 6852, 6853, 1, 0, 0, 0, 6853, 6855, 3,
+		1408, 701, 0, 6854, 6849, 1, 0, 0, 0, 6854, 6855, 1, 0, 0, 0, 6855, 6864,
+		1, 0, 0, 0, 6856, 6857, 3, 1408, 701, 0, 6857, 6859, 7, 10, 0, 0, 6858,
+		6860, 7, 1, 0, 0, 6859, 6858, 1, 0, 0, 0, 6859, 6860, 1, 0, 0, 0, 6860,
+		6861, 1, 0, 0, 0, 6861, 6862, 3, 1408, 701, 0, 6862, 6864, 1, 0, 0, 0,
+		6863, 6835, 1, 0, 0, 0, 6863, 6847, 1, 0, 0, 0, 6863, 6856, 1, 0, 0, 0,
+		6864, 1407, 1, 0, 0, 0, 6865, 6867, 7, 0, 0, 0, 6866, 6865, 1, 0, 0, 0,
+		6867, 6868, 1, 0, 0, 0, 6868, 6866, 1, 0, 0, 0, 6868, 6869, 1, 0, 0, 0,
+		6869, 1409, 1, 0, 0, 0, 6870, 6871, 5, 58, 0, 0, 6871, 6875, 7, 40, 0,
+		0, 6872, 6874, 7, 41, 0, 0, 6873, 6872, 1, 0, 0, 0, 6874, 6877, 1, 0, 0,
+		0, 6875, 6873, 1, 0, 0, 0, 6875, 6876, 1, 0, 0, 0, 6876, 1411, 1, 0, 0,
+		0, 6877, 6875, 1, 0, 0, 0, 6878, 6879, 5, 58, 0, 0, 6879, 6880, 5, 34,
+		0, 0, 6880, 6888, 1, 0, 0, 0, 6881, 6882, 5, 92, 0, 0, 6882, 6887, 9, 0,
+		0, 0, 6883, 6884, 5, 34, 0, 0, 6884, 6887, 5, 34, 0, 0, 6885, 6887, 8,
+		42, 0, 0, 6886, 6881, 1, 0, 0, 0, 6886, 6883, 1, 0, 0, 0, 6886, 6885, 1,
+		0, 0, 0, 6887, 6890, 1, 0, 0, 0, 6888, 6886, 1, 0, 0, 0, 6888, 6889, 1,
+		0, 0, 0, 6889, 6891, 1, 0, 0, 0, 6890, 6888, 1, 0, 0, 0, 6891, 6892, 5,
+		34, 0, 0, 6892, 1413, 1, 0, 0, 0, 6893, 6894, 7, 43, 0, 0, 6894, 6895,
+		1, 0, 0, 0, 6895, 6896, 6, 704, 7, 0, 6896, 1415, 1, 0, 0, 0, 6897, 6899,
+		5, 13, 0, 0, 6898, 6900, 5, 10, 0, 0, 6899, 6898, 1, 0, 0, 0, 6899, 6900,
+		1, 0, 0, 0, 6900, 6903, 1, 0, 0, 0, 6901, 6903, 5, 10, 0, 0, 6902, 6897,
+		1, 0, 0, 0, 6902, 6901, 1, 0, 0, 0, 6903, 6904, 1, 0, 0, 0, 6904, 6905,
+		6, 705, 7, 0, 6905, 1417, 1, 0, 0, 0, 6906, 6907, 5, 45, 0, 0, 6907, 6908,
+		5, 45, 0, 0, 6908, 6912, 1, 0, 0, 0, 6909, 6911, 8, 44, 0, 0, 6910, 6909,
+		1, 0, 0, 0, 6911, 6914, 1, 0, 0, 0, 6912, 6910, 1, 0, 0, 0, 6912, 6913,
+		1, 0, 0, 0, 6913, 6915, 1, 0, 0, 0, 6914, 6912, 1, 0, 0, 0, 6915, 6916,
+		6, 706, 7, 0, 6916, 1419, 1, 0, 0, 0, 6917, 6918, 5, 47, 0, 0, 6918, 6919,
+		5, 42, 0, 0, 6919, 6942, 1, 0, 0, 0, 6920, 6922, 5, 47, 0, 0, 6921, 6920,
+		1, 0, 0, 0, 6922, 6925, 1, 0, 0, 0, 6923, 6921, 1, 0, 0, 0, 6923, 6924,
+		1, 0, 0, 0, 6924, 6926, 1, 0, 0, 0, 6925, 6923, 1, 0, 0, 0, 6926, 6941,
+		3, 1420, 707, 0, 6927, 6941, 8, 45, 0, 0, 6928, 6930, 5, 47, 0, 0, 6929,
+		6928, 1, 0, 0, 0, 6930, 6931, 1, 0, 0, 0, 6931, 6929, 1, 0, 0, 0, 6931,
+		6932, 1, 0, 0, 0, 6932, 6933, 1, 0, 0, 0, 6933, 6941, 8, 45, 0, 0, 6934,
+		6936, 5, 42, 0, 0, 6935, 6934, 1, 0, 0, 0, 6936, 6937, 1, 0, 0, 0, 6937,
+		6935, 1, 0, 0, 0, 6937, 6938, 1, 0, 0, 0, 6938, 6939, 1, 0, 0, 0, 6939,
+		6941, 8, 45, 0, 0, 6940, 6923, 1, 0, 0, 0, 6940, 6927, 1, 0, 0, 0, 6940,
+		6929, 1, 0, 0, 0, 6940, 6935, 1, 0, 0, 0, 6941, 6944, 1, 0, 0, 0, 6942,
+		6940, 1, 0, 0, 0, 6942, 6943, 1, 0, 0, 0, 6943, 6948, 1, 0, 0, 0, 6944,
+		6942, 1, 0, 0, 0, 6945, 6947, 5, 42, 0, 0, 6946, 6945, 1, 0, 0, 0, 6947,
+		6950, 1, 0, 0, 0, 6948, 6946, 1, 0, 0, 0, 6948, 6949, 1, 0, 0, 0, 6949,
+		6951, 1, 0, 0, 0, 6950, 6948, 1, 0, 0, 0, 6951, 6952, 5, 42, 0, 0, 6952,
+		6953, 5, 47, 0, 0, 6953, 6954, 1, 0, 0, 0, 6954, 6955, 6, 707, 7, 0, 6955,
+		1421, 1, 0, 0, 0, 6956, 6957, 5, 47, 0, 0, 6957, 6958, 5, 42, 0, 0, 6958,
+		6983, 1, 0, 0, 0, 6959, 6961, 5, 47, 0, 0, 6960, 6959, 1, 0, 0, 0, 6961,
+		6964, 1, 0, 0, 0, 6962, 6960, 1, 0, 0, 0, 6962, 6963, 1, 0, 0, 0, 6963,
+		6965, 1, 0, 0, 0, 6964, 6962, 1, 0, 0, 0, 6965, 6982, 3, 1420, 707, 0,
+		6966, 6982, 8, 45, 0, 0, 6967, 6969, 5, 47, 0, 0, 6968, 6967, 1, 0, 0,
+		0, 6969, 6970, 1, 0, 0, 0, 6970, 6968, 1, 0, 0, 0, 6970, 6971, 1, 0, 0,
+		0, 6971, 6972, 1, 0, 0, 0, 6972, 6980, 8, 45, 0, 0, 6973, 6975, 5, 42,
+		0, 0, 6974, 6973, 1, 0, 0, 0, 6975, 6976, 1, 0, 0, 0, 6976, 6974, 1, 0,
+		0, 0, 6976, 6977, 1, 0, 0, 0, 6977, 6978, 1, 0, 0, 0, 6978, 6980, 8, 45,
+		0, 0, 6979, 6968, 1, 0, 0, 0, 6979, 6974, 1, 0, 0, 0, 6980, 6982, 1, 0,
+		0, 0, 6981, 6962, 1, 0, 0, 0, 6981, 6966, 1, 0, 0, 0, 6981, 6979, 1, 0,
+		0, 0, 6982, 6985, 1, 0, 0, 0, 6983, 6981, 1, 0, 0, 0, 6983, 6984, 1, 0,
+		0, 0, 6984, 7003, 1, 0, 0, 0, 6985, 6983, 1, 0, 0, 0, 6986, 6988, 5, 47,
+		0, 0, 6987, 6986, 1, 0, 0, 0, 6988, 6989, 1, 0, 0, 0, 6989, 6987, 1, 0,
+		0, 0, 6989, 6990, 1, 0, 0, 0, 6990, 7004, 1, 0, 0, 0, 6991, 6993, 5, 42,
+		0, 0, 6992, 6991, 1, 0, 0, 0, 6993, 6994, 1, 0, 0, 0, 6994, 6992, 1, 0,
+		0, 0, 6994, 6995, 1, 0, 0, 0, 6995, 7004, 1, 0, 0, 0, 6996, 6998, 5, 47,
+		0, 0, 6997, 6996, 1, 0, 0, 0, 6998, 7001, 1, 0, 0, 0, 6999, 6997, 1, 0,
+		0, 0, 6999, 7000, 1, 0, 0, 0, 7000, 7002, 1, 0, 0, 0, 7001, 6999, 1, 0,
+		0, 0, 7002, 7004, 3, 1422, 708, 0, 7003, 6987, 1, 0, 0, 0, 7003, 6992,
+		1, 0, 0, 0, 7003, 6999, 1, 0, 0, 0, 7003, 7004, 1, 0, 0, 0, 7004, 7005,
+		1, 0, 0, 0, 7005, 7006, 6, 708, 8, 0, 7006, 1423, 1, 0, 0, 0, 7007, 7019,
+		5, 92, 0, 0, 7008, 7018, 8, 46, 0, 0, 7009, 7013, 5, 34, 0, 0, 7010, 7012,
+		8, 47, 0, 0, 7011, 7010, 1, 0, 0, 0, 7012, 7015, 1, 0, 0, 0, 7013, 7011,
+		1, 0, 0, 0, 7013, 7014, 1, 0, 0, 0, 7014, 7016, 1, 0, 0, 0, 7015, 7013,
+		1, 0, 0, 0, 7016, 7018, 5, 34, 0, 0, 7017, 7008, 1, 0, 0, 0, 7017, 7009,
+		1, 0, 0, 0, 7018, 7021, 1, 0, 0, 0, 7019, 7017, 1, 0, 0, 0, 7019, 7020,
+		1, 0, 0, 0, 7020, 7029, 1, 0, 0, 0, 7021, 7019, 1, 0, 0, 0, 7022, 7026,
+		5, 34, 0, 0, 7023, 7025, 8, 47, 0, 0, 7024, 7023, 1, 0, 0, 0, 7025, 7028,
+		1, 0, 0, 0, 7026, 7024, 1, 0, 0, 0, 7026, 7027, 1, 0, 0, 0, 7027, 7030,
+		1, 0, 0, 0, 7028, 7026, 1, 0, 0, 0, 7029, 7022, 1, 0, 0, 0, 7029, 7030,
+		1, 0, 0, 0, 7030, 1425, 1, 0, 0, 0, 7031, 7032, 5, 92, 0, 0, 7032, 7033,
+		5, 92, 0, 0, 7033, 1427, 1, 0, 0, 0, 7034, 7035, 9, 0, 0, 0, 7035, 1429,
+		1, 0, 0, 0, 7036, 7037, 3, 1434, 714, 0, 7037, 7038, 5, 39, 0, 0, 7038,
+		7039, 1, 0, 0, 0, 7039, 7040, 6, 712, 9, 0, 7040, 1431, 1, 0, 0, 0, 7041,
+		7043, 3, 1434, 714, 0, 7042, 7044, 5, 92, 0, 0, 7043, 7042, 1, 0, 0, 0,
+		7043, 7044, 1, 0, 0, 0, 7044, 7045, 1, 0, 0, 0, 7045, 7046, 5, 0, 0, 1,
+		7046, 1433, 1, 0, 0, 0, 7047, 7048, 5, 39, 0, 0, 7048, 7071, 5, 39, 0,
+		0, 7049, 7067, 5, 92, 0, 0, 7050, 7051, 5, 120, 0, 0, 7051, 7068, 7, 39,
+		0, 0, 7052, 7053, 5, 117, 0, 0, 7053, 7054, 7, 39, 0, 0, 7054, 7055, 7,
+		39, 0, 0, 7055, 7056, 7, 39, 0, 0, 7056, 7068, 7, 39, 0, 0, 7057, 7058,
+		5, 85, 0, 0, 7058, 7059, 7, 39, 0, 0, 7059, 7060, 7, 39, 0, 0, 7060, 7061,
+		7, 39, 0, 0, 7061, 7062, 7, 39, 0, 0, 7062, 7063, 7, 39, 0, 0, 7063, 7064,
+		7, 39, 0, 0, 7064, 7065, 7, 39, 0, 0, 7065, 7068, 7, 39, 0, 0, 7066, 7068,
+		8, 48, 0, 0, 7067, 7050, 1, 0, 0, 0, 7067, 7052, 1, 0, 0, 0, 7067, 7057,
+		1, 0, 0, 0, 7067, 7066, 1, 0, 0, 0, 7068, 7071, 1, 0, 0, 0, 7069, 7071,
+		8, 49, 0, 0, 7070, 7047, 1, 0, 0, 0, 7070, 7049, 1, 0, 0, 0, 7070, 7069,
+		1, 0, 0, 0, 7071, 7074, 1, 0, 0, 0, 7072, 7070, 1, 0, 0, 0, 7072, 7073,
+		1, 0, 0, 0, 7073, 1435, 1, 0, 0, 0, 7074, 7072, 1, 0, 0, 0, 7075, 7076,
+		3, 1440, 717, 0, 7076, 7077, 5, 39, 0, 0, 7077, 7078, 1, 0, 0, 0, 7078,
+		7079, 6, 715, 9, 0, 7079, 1437, 1, 0, 0, 0, 7080, 7082, 3, 1440, 717, 0,
+		7081, 7083, 5, 92, 0, 0, 7082, 7081, 1, 0, 0, 0, 7082, 7083, 1, 0, 0, 0,
+		7083, 7084, 1, 0, 0, 0, 7084, 7085, 5, 0, 0, 1, 7085, 1439, 1, 0, 0, 0,
+		7086, 7087, 5, 39, 0, 0, 7087, 7092, 5, 39, 0, 0, 7088, 7089, 5, 92, 0,
+		0, 7089, 7092, 9, 0, 0, 0, 7090, 7092, 8, 49, 0, 0, 7091, 7086, 1, 0, 0,
+		0, 7091, 7088, 1, 0, 0, 0, 7091, 7090, 1, 0, 0, 0, 7092, 7095, 1, 0, 0,
+		0, 7093, 7091, 1, 0, 0, 0, 7093, 7094, 1, 0, 0, 0, 7094, 1441, 1, 0, 0,
+		0, 7095, 7093, 1, 0, 0, 0, 7096, 7097, 3, 1414, 704, 0, 7097, 7098, 1,
+		0, 0, 0, 7098, 7099, 6, 718, 10, 0, 7099, 7100, 6, 718, 7, 0, 7100, 1443,
+		1, 0, 0, 0, 7101, 7102, 3, 1416, 705, 0, 7102, 7103, 1, 0, 0, 0, 7103,
+		7104, 6, 719, 11, 0, 7104, 7105, 6, 719, 7, 0, 7105, 7106, 6, 719, 12,
+		0, 7106, 1445, 1, 0, 0, 0, 7107, 7108, 6, 720, 13, 0, 7108, 7109, 1, 0,
+		0, 0, 7109, 7110, 6, 720, 14, 0, 7110, 7111, 6, 720, 15, 0, 7111, 1447,
+		1, 0, 0, 0, 7112, 7113, 3, 1414, 704, 0, 7113, 7114, 1, 0, 0, 0, 7114,
+		7115, 6, 721, 10, 0, 7115, 7116, 6, 721, 7, 0, 7116, 1449, 1, 0, 0, 0,
+		7117, 7118, 3, 1416, 705, 0, 7118, 7119, 1, 0, 0, 0, 7119, 7120, 6, 722,
+		11, 0, 7120, 7121, 6, 722, 7, 0, 7121, 1451, 1, 0, 0, 0, 7122, 7123, 5,
+		39, 0, 0, 7123, 7124, 1, 0, 0, 0, 7124, 7125, 6, 723, 2, 0, 7125, 7126,
+		6, 723, 16, 0, 7126, 1453, 1, 0, 0, 0, 7127, 7128, 6, 724, 17, 0, 7128,
+		7129, 1, 0, 0, 0, 7129, 7130, 6, 724, 14, 0, 7130, 7131, 6, 724, 15, 0,
+		7131, 1455, 1, 0, 0, 0, 7132, 7134, 8, 50, 0, 0, 7133, 7132, 1, 0, 0, 0,
+		7134, 7135, 1, 0, 0, 0, 7135, 7133, 1, 0, 0, 0, 7135, 7136, 1, 0, 0, 0,
+		7136, 7145, 1, 0, 0, 0, 7137, 7141, 5, 36, 0, 0, 7138, 7140, 8, 50, 0,
+		0, 7139, 7138, 1, 0, 0, 0, 7140, 7143, 1, 0, 0, 0, 7141, 7139, 1, 0, 0,
+		0, 7141, 7142, 1, 0, 0, 0, 7142, 7145, 1, 0, 0, 0, 7143, 7141, 1, 0, 0,
+		0, 7144, 7133, 1, 0, 0, 0, 7144, 7137, 1, 0, 0, 0, 7145, 1457, 1, 0, 0,
+		0, 7146, 7148, 5, 36, 0, 0, 7147, 7149, 3, 1384, 689, 0, 7148, 7147, 1,
+		0, 0, 0, 7148, 7149, 1, 0, 0, 0, 7149, 7150, 1, 0, 0, 0, 7150, 7151, 5,
+		36, 0, 0, 7151, 7152, 1, 0, 0, 0, 7152, 7153, 4, 726, 8, 0, 7153, 7154,
+		6, 726, 18, 0, 7154, 7155, 1, 0, 0, 0, 7155, 7156, 6, 726, 15, 0, 7156,
+		1459, 1, 0, 0, 0, 7157, 7159, 8, 44, 0, 0, 7158, 7157, 1, 0, 0, 0, 7159,
+		7162, 1, 0, 0, 0, 7160, 7158, 1, 0, 0, 0, 7160, 7161, 1, 0, 0, 0, 7161,
+		7168, 1, 0, 0, 0, 7162, 7160, 1, 0, 0, 0, 7163, 7165, 5, 13, 0, 0, 7164,
+		7166, 5, 10, 0, 0, 7165, 7164, 1, 0, 0, 0, 7165, 7166, 1, 0, 0, 0, 7166,
+		7169, 1, 0, 0, 0, 7167, 7169, 5, 10, 0, 0, 7168, 7163, 1, 0, 0, 0, 7168,
+		7167, 1, 0, 0, 0, 7169, 7171, 1, 0, 0, 0, 7170, 7160, 1, 0, 0, 0, 7171,
+		7174, 1, 0, 0, 0, 7172, 7173, 1, 0, 0, 0, 7172, 7170, 1, 0, 0, 0, 7173,
+		7175, 1, 0, 0, 0, 7174, 7172, 1, 0, 0, 0, 7175, 7176, 5, 92, 0, 0, 7176,
+		7177, 5, 46, 0, 0, 7177, 7178, 1, 0, 0, 0, 7178, 7179, 6, 727, 7, 0, 7179,
+		7180, 6, 727, 15, 0, 7180, 1461, 1, 0, 0, 0, 82, 0, 1, 2, 3, 4, 5, 1529,
+		1535, 1537, 1542, 1546, 1548, 1551, 1560, 1562, 1567, 1572, 1574, 6693,
+		6702, 6706, 6710, 6719, 6721, 6731, 6733, 6759, 6761, 6779, 6790, 6801,
+		6818, 6838, 6842, 6845, 6851, 6854, 6859, 6863, 6868, 6875, 6886, 6888,
+		6899, 6902, 6912, 6923, 6931, 6937, 6940, 6942, 6948, 6962, 6970, 6976,
+		6979, 6981, 6983, 6989, 6994, 6999, 7003, 7013, 7017, 7019, 7026, 7029,
+		7043, 7067, 7070, 7072, 7082, 7091, 7093, 7135, 7141, 7144, 7148, 7160,
+		7165, 7168, 7172, 19, 1, 28, 0, 7, 29, 0, 3, 0, 0, 5, 1, 0, 1, 688, 1,
+		5, 4, 0, 1, 699, 2, 0, 1, 0, 1, 708, 3, 2, 2, 0, 7, 695, 0, 7, 696, 0,
+		2, 3, 0, 1, 720, 4, 6, 0, 0, 4, 0, 0, 2, 1, 0, 1, 724, 5, 1, 726, 6,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -3718,563 +3873,589 @@ const (
 	PostgreSQLLexerSIMILAR                                               = 127
 	PostgreSQLLexerVERBOSE                                               = 128
 	PostgreSQLLexerABORT_P                                               = 129
-	PostgreSQLLexerABSOLUTE_P                                            = 130
-	PostgreSQLLexerACCESS                                                = 131
-	PostgreSQLLexerACTION                                                = 132
-	PostgreSQLLexerADD_P                                                 = 133
-	PostgreSQLLexerADMIN                                                 = 134
-	PostgreSQLLexerAFTER                                                 = 135
-	PostgreSQLLexerAGGREGATE                                             = 136
-	PostgreSQLLexerALSO                                                  = 137
-	PostgreSQLLexerALTER                                                 = 138
-	PostgreSQLLexerALWAYS                                                = 139
-	PostgreSQLLexerASSERTION                                             = 140
-	PostgreSQLLexerASSIGNMENT                                            = 141
-	PostgreSQLLexerAT                                                    = 142
-	PostgreSQLLexerATTRIBUTE                                             = 143
-	PostgreSQLLexerBACKWARD                                              = 144
-	PostgreSQLLexerBEFORE                                                = 145
-	PostgreSQLLexerBEGIN_P                                               = 146
-	PostgreSQLLexerBY                                                    = 147
-	PostgreSQLLexerCACHE                                                 = 148
-	PostgreSQLLexerCALLED                                                = 149
-	PostgreSQLLexerCASCADE                                               = 150
-	PostgreSQLLexerCASCADED                                              = 151
-	PostgreSQLLexerCATALOG                                               = 152
-	PostgreSQLLexerCHAIN                                                 = 153
-	PostgreSQLLexerCHARACTERISTICS                                       = 154
-	PostgreSQLLexerCHECKPOINT                                            = 155
-	PostgreSQLLexerCLASS                                                 = 156
-	PostgreSQLLexerCLOSE                                                 = 157
-	PostgreSQLLexerCLUSTER                                               = 158
-	PostgreSQLLexerCOMMENT                                               = 159
-	PostgreSQLLexerCOMMENTS                                              = 160
-	PostgreSQLLexerCOMMIT                                                = 161
-	PostgreSQLLexerCOMMITTED                                             = 162
-	PostgreSQLLexerCONFIGURATION                                         = 163
-	PostgreSQLLexerCONNECTION                                            = 164
-	PostgreSQLLexerCONSTRAINTS                                           = 165
-	PostgreSQLLexerCONTENT_P                                             = 166
-	PostgreSQLLexerCONTINUE_P                                            = 167
-	PostgreSQLLexerCONVERSION_P                                          = 168
-	PostgreSQLLexerCOPY                                                  = 169
-	PostgreSQLLexerCOST                                                  = 170
-	PostgreSQLLexerCSV                                                   = 171
-	PostgreSQLLexerCURSOR                                                = 172
-	PostgreSQLLexerCYCLE                                                 = 173
-	PostgreSQLLexerDATA_P                                                = 174
-	PostgreSQLLexerDATABASE                                              = 175
-	PostgreSQLLexerDAY_P                                                 = 176
-	PostgreSQLLexerDEALLOCATE                                            = 177
-	PostgreSQLLexerDECLARE                                               = 178
-	PostgreSQLLexerDEFAULTS                                              = 179
-	PostgreSQLLexerDEFERRED                                              = 180
-	PostgreSQLLexerDEFINER                                               = 181
-	PostgreSQLLexerDELETE_P                                              = 182
-	PostgreSQLLexerDELIMITER                                             = 183
-	PostgreSQLLexerDELIMITERS                                            = 184
-	PostgreSQLLexerDICTIONARY                                            = 185
-	PostgreSQLLexerDISABLE_P                                             = 186
-	PostgreSQLLexerDISCARD                                               = 187
-	PostgreSQLLexerDOCUMENT_P                                            = 188
-	PostgreSQLLexerDOMAIN_P                                              = 189
-	PostgreSQLLexerDOUBLE_P                                              = 190
-	PostgreSQLLexerDROP                                                  = 191
-	PostgreSQLLexerEACH                                                  = 192
-	PostgreSQLLexerENABLE_P                                              = 193
-	PostgreSQLLexerENCODING                                              = 194
-	PostgreSQLLexerENCRYPTED                                             = 195
-	PostgreSQLLexerENUM_P                                                = 196
-	PostgreSQLLexerESCAPE                                                = 197
-	PostgreSQLLexerEVENT                                                 = 198
-	PostgreSQLLexerEXCLUDE                                               = 199
-	PostgreSQLLexerEXCLUDING                                             = 200
-	PostgreSQLLexerEXCLUSIVE                                             = 201
-	PostgreSQLLexerEXECUTE                                               = 202
-	PostgreSQLLexerEXPLAIN                                               = 203
-	PostgreSQLLexerEXTENSION                                             = 204
-	PostgreSQLLexerEXTERNAL                                              = 205
-	PostgreSQLLexerFAMILY                                                = 206
-	PostgreSQLLexerFIRST_P                                               = 207
-	PostgreSQLLexerFOLLOWING                                             = 208
-	PostgreSQLLexerFORCE                                                 = 209
-	PostgreSQLLexerFORWARD                                               = 210
-	PostgreSQLLexerFUNCTION                                              = 211
-	PostgreSQLLexerFUNCTIONS                                             = 212
-	PostgreSQLLexerGLOBAL                                                = 213
-	PostgreSQLLexerGRANTED                                               = 214
-	PostgreSQLLexerHANDLER                                               = 215
-	PostgreSQLLexerHEADER_P                                              = 216
-	PostgreSQLLexerHOLD                                                  = 217
-	PostgreSQLLexerHOUR_P                                                = 218
-	PostgreSQLLexerIDENTITY_P                                            = 219
-	PostgreSQLLexerIF_P                                                  = 220
-	PostgreSQLLexerIMMEDIATE                                             = 221
-	PostgreSQLLexerIMMUTABLE                                             = 222
-	PostgreSQLLexerIMPLICIT_P                                            = 223
-	PostgreSQLLexerINCLUDING                                             = 224
-	PostgreSQLLexerINCREMENT                                             = 225
-	PostgreSQLLexerINDEX                                                 = 226
-	PostgreSQLLexerINDEXES                                               = 227
-	PostgreSQLLexerINHERIT                                               = 228
-	PostgreSQLLexerINHERITS                                              = 229
-	PostgreSQLLexerINLINE_P                                              = 230
-	PostgreSQLLexerINSENSITIVE                                           = 231
-	PostgreSQLLexerINSERT                                                = 232
-	PostgreSQLLexerINSTEAD                                               = 233
-	PostgreSQLLexerINVOKER                                               = 234
-	PostgreSQLLexerISOLATION                                             = 235
-	PostgreSQLLexerKEY                                                   = 236
-	PostgreSQLLexerLABEL                                                 = 237
-	PostgreSQLLexerLANGUAGE                                              = 238
-	PostgreSQLLexerLARGE_P                                               = 239
-	PostgreSQLLexerLAST_P                                                = 240
-	PostgreSQLLexerLEAKPROOF                                             = 241
-	PostgreSQLLexerLEVEL                                                 = 242
-	PostgreSQLLexerLISTEN                                                = 243
-	PostgreSQLLexerLOAD                                                  = 244
-	PostgreSQLLexerLOCAL                                                 = 245
-	PostgreSQLLexerLOCATION                                              = 246
-	PostgreSQLLexerLOCK_P                                                = 247
-	PostgreSQLLexerMAPPING                                               = 248
-	PostgreSQLLexerMATCH                                                 = 249
-	PostgreSQLLexerMATCHED                                               = 250
-	PostgreSQLLexerMATERIALIZED                                          = 251
-	PostgreSQLLexerMAXVALUE                                              = 252
-	PostgreSQLLexerMERGE                                                 = 253
-	PostgreSQLLexerMINUTE_P                                              = 254
-	PostgreSQLLexerMINVALUE                                              = 255
-	PostgreSQLLexerMODE                                                  = 256
-	PostgreSQLLexerMONTH_P                                               = 257
-	PostgreSQLLexerMOVE                                                  = 258
-	PostgreSQLLexerNAME_P                                                = 259
-	PostgreSQLLexerNAMES                                                 = 260
-	PostgreSQLLexerNEXT                                                  = 261
-	PostgreSQLLexerNO                                                    = 262
-	PostgreSQLLexerNOTHING                                               = 263
-	PostgreSQLLexerNOTIFY                                                = 264
-	PostgreSQLLexerNOWAIT                                                = 265
-	PostgreSQLLexerNULLS_P                                               = 266
-	PostgreSQLLexerOBJECT_P                                              = 267
-	PostgreSQLLexerOF                                                    = 268
-	PostgreSQLLexerOFF                                                   = 269
-	PostgreSQLLexerOIDS                                                  = 270
-	PostgreSQLLexerOPERATOR                                              = 271
-	PostgreSQLLexerOPTION                                                = 272
-	PostgreSQLLexerOPTIONS                                               = 273
-	PostgreSQLLexerOWNED                                                 = 274
-	PostgreSQLLexerOWNER                                                 = 275
-	PostgreSQLLexerPARSER                                                = 276
-	PostgreSQLLexerPARTIAL                                               = 277
-	PostgreSQLLexerPARTITION                                             = 278
-	PostgreSQLLexerPASSING                                               = 279
-	PostgreSQLLexerPASSWORD                                              = 280
-	PostgreSQLLexerPLANS                                                 = 281
-	PostgreSQLLexerPRECEDING                                             = 282
-	PostgreSQLLexerPREPARE                                               = 283
-	PostgreSQLLexerPREPARED                                              = 284
-	PostgreSQLLexerPRESERVE                                              = 285
-	PostgreSQLLexerPRIOR                                                 = 286
-	PostgreSQLLexerPRIVILEGES                                            = 287
-	PostgreSQLLexerPROCEDURAL                                            = 288
-	PostgreSQLLexerPROCEDURE                                             = 289
-	PostgreSQLLexerPROGRAM                                               = 290
-	PostgreSQLLexerQUOTE                                                 = 291
-	PostgreSQLLexerRANGE                                                 = 292
-	PostgreSQLLexerREAD                                                  = 293
-	PostgreSQLLexerREASSIGN                                              = 294
-	PostgreSQLLexerRECHECK                                               = 295
-	PostgreSQLLexerRECURSIVE                                             = 296
-	PostgreSQLLexerREF                                                   = 297
-	PostgreSQLLexerREFRESH                                               = 298
-	PostgreSQLLexerREINDEX                                               = 299
-	PostgreSQLLexerRELATIVE_P                                            = 300
-	PostgreSQLLexerRELEASE                                               = 301
-	PostgreSQLLexerRENAME                                                = 302
-	PostgreSQLLexerREPEATABLE                                            = 303
-	PostgreSQLLexerREPLACE                                               = 304
-	PostgreSQLLexerREPLICA                                               = 305
-	PostgreSQLLexerRESET                                                 = 306
-	PostgreSQLLexerRESTART                                               = 307
-	PostgreSQLLexerRESTRICT                                              = 308
-	PostgreSQLLexerRETURNS                                               = 309
-	PostgreSQLLexerREVOKE                                                = 310
-	PostgreSQLLexerROLE                                                  = 311
-	PostgreSQLLexerROLLBACK                                              = 312
-	PostgreSQLLexerROWS                                                  = 313
-	PostgreSQLLexerRULE                                                  = 314
-	PostgreSQLLexerSAVEPOINT                                             = 315
-	PostgreSQLLexerSCHEMA                                                = 316
-	PostgreSQLLexerSCROLL                                                = 317
-	PostgreSQLLexerSEARCH                                                = 318
-	PostgreSQLLexerSECOND_P                                              = 319
-	PostgreSQLLexerSECURITY                                              = 320
-	PostgreSQLLexerSEQUENCE                                              = 321
-	PostgreSQLLexerSEQUENCES                                             = 322
-	PostgreSQLLexerSERIALIZABLE                                          = 323
-	PostgreSQLLexerSERVER                                                = 324
-	PostgreSQLLexerSESSION                                               = 325
-	PostgreSQLLexerSET                                                   = 326
-	PostgreSQLLexerSHARE                                                 = 327
-	PostgreSQLLexerSHOW                                                  = 328
-	PostgreSQLLexerSIMPLE                                                = 329
-	PostgreSQLLexerSNAPSHOT                                              = 330
-	PostgreSQLLexerSTABLE                                                = 331
-	PostgreSQLLexerSTANDALONE_P                                          = 332
-	PostgreSQLLexerSTART                                                 = 333
-	PostgreSQLLexerSTATEMENT                                             = 334
-	PostgreSQLLexerSTATISTICS                                            = 335
-	PostgreSQLLexerSTDIN                                                 = 336
-	PostgreSQLLexerSTDOUT                                                = 337
-	PostgreSQLLexerSTORAGE                                               = 338
-	PostgreSQLLexerSTRICT_P                                              = 339
-	PostgreSQLLexerSTRIP_P                                               = 340
-	PostgreSQLLexerSYSID                                                 = 341
-	PostgreSQLLexerSYSTEM_P                                              = 342
-	PostgreSQLLexerTABLES                                                = 343
-	PostgreSQLLexerTABLESPACE                                            = 344
-	PostgreSQLLexerTEMP                                                  = 345
-	PostgreSQLLexerTEMPLATE                                              = 346
-	PostgreSQLLexerTEMPORARY                                             = 347
-	PostgreSQLLexerTEXT_P                                                = 348
-	PostgreSQLLexerTRANSACTION                                           = 349
-	PostgreSQLLexerTRIGGER                                               = 350
-	PostgreSQLLexerTRUNCATE                                              = 351
-	PostgreSQLLexerTRUSTED                                               = 352
-	PostgreSQLLexerTYPE_P                                                = 353
-	PostgreSQLLexerTYPES_P                                               = 354
-	PostgreSQLLexerUNBOUNDED                                             = 355
-	PostgreSQLLexerUNCOMMITTED                                           = 356
-	PostgreSQLLexerUNENCRYPTED                                           = 357
-	PostgreSQLLexerUNKNOWN                                               = 358
-	PostgreSQLLexerUNLISTEN                                              = 359
-	PostgreSQLLexerUNLOGGED                                              = 360
-	PostgreSQLLexerUNTIL                                                 = 361
-	PostgreSQLLexerUPDATE                                                = 362
-	PostgreSQLLexerVACUUM                                                = 363
-	PostgreSQLLexerVALID                                                 = 364
-	PostgreSQLLexerVALIDATE                                              = 365
-	PostgreSQLLexerVALIDATOR                                             = 366
-	PostgreSQLLexerVARYING                                               = 367
-	PostgreSQLLexerVERSION_P                                             = 368
-	PostgreSQLLexerVIEW                                                  = 369
-	PostgreSQLLexerVOLATILE                                              = 370
-	PostgreSQLLexerWHITESPACE_P                                          = 371
-	PostgreSQLLexerWITHOUT                                               = 372
-	PostgreSQLLexerWORK                                                  = 373
-	PostgreSQLLexerWRAPPER                                               = 374
-	PostgreSQLLexerWRITE                                                 = 375
-	PostgreSQLLexerXML_P                                                 = 376
-	PostgreSQLLexerYEAR_P                                                = 377
-	PostgreSQLLexerYES_P                                                 = 378
-	PostgreSQLLexerZONE                                                  = 379
-	PostgreSQLLexerATOMIC_P                                              = 380
-	PostgreSQLLexerBETWEEN                                               = 381
-	PostgreSQLLexerBIGINT                                                = 382
-	PostgreSQLLexerBIT                                                   = 383
-	PostgreSQLLexerBOOLEAN_P                                             = 384
-	PostgreSQLLexerCHAR_P                                                = 385
-	PostgreSQLLexerCHARACTER                                             = 386
-	PostgreSQLLexerCOALESCE                                              = 387
-	PostgreSQLLexerDEC                                                   = 388
-	PostgreSQLLexerDECIMAL_P                                             = 389
-	PostgreSQLLexerEXISTS                                                = 390
-	PostgreSQLLexerEXTRACT                                               = 391
-	PostgreSQLLexerFLOAT_P                                               = 392
-	PostgreSQLLexerGREATEST                                              = 393
-	PostgreSQLLexerINOUT                                                 = 394
-	PostgreSQLLexerINT_P                                                 = 395
-	PostgreSQLLexerINTEGER                                               = 396
-	PostgreSQLLexerINTERVAL                                              = 397
-	PostgreSQLLexerLEAST                                                 = 398
-	PostgreSQLLexerNATIONAL                                              = 399
-	PostgreSQLLexerNCHAR                                                 = 400
-	PostgreSQLLexerNONE                                                  = 401
-	PostgreSQLLexerNULLIF                                                = 402
-	PostgreSQLLexerNUMERIC                                               = 403
-	PostgreSQLLexerOVERLAY                                               = 404
-	PostgreSQLLexerPARAMETER                                             = 405
-	PostgreSQLLexerPOSITION                                              = 406
-	PostgreSQLLexerPRECISION                                             = 407
-	PostgreSQLLexerREAL                                                  = 408
-	PostgreSQLLexerROW                                                   = 409
-	PostgreSQLLexerSETOF                                                 = 410
-	PostgreSQLLexerSMALLINT                                              = 411
-	PostgreSQLLexerSUBSTRING                                             = 412
-	PostgreSQLLexerTIME                                                  = 413
-	PostgreSQLLexerTIMESTAMP                                             = 414
-	PostgreSQLLexerTREAT                                                 = 415
-	PostgreSQLLexerTRIM                                                  = 416
-	PostgreSQLLexerVALUES                                                = 417
-	PostgreSQLLexerVARCHAR                                               = 418
-	PostgreSQLLexerXMLATTRIBUTES                                         = 419
-	PostgreSQLLexerXMLCOMMENT                                            = 420
-	PostgreSQLLexerXMLAGG                                                = 421
-	PostgreSQLLexerXML_IS_WELL_FORMED                                    = 422
-	PostgreSQLLexerXML_IS_WELL_FORMED_DOCUMENT                           = 423
-	PostgreSQLLexerXML_IS_WELL_FORMED_CONTENT                            = 424
-	PostgreSQLLexerXPATH                                                 = 425
-	PostgreSQLLexerXPATH_EXISTS                                          = 426
-	PostgreSQLLexerXMLCONCAT                                             = 427
-	PostgreSQLLexerXMLELEMENT                                            = 428
-	PostgreSQLLexerXMLEXISTS                                             = 429
-	PostgreSQLLexerXMLFOREST                                             = 430
-	PostgreSQLLexerXMLPARSE                                              = 431
-	PostgreSQLLexerXMLPI                                                 = 432
-	PostgreSQLLexerXMLROOT                                               = 433
-	PostgreSQLLexerXMLSERIALIZE                                          = 434
-	PostgreSQLLexerCALL                                                  = 435
-	PostgreSQLLexerCURRENT_P                                             = 436
-	PostgreSQLLexerATTACH                                                = 437
-	PostgreSQLLexerDETACH                                                = 438
-	PostgreSQLLexerEXPRESSION                                            = 439
-	PostgreSQLLexerGENERATED                                             = 440
-	PostgreSQLLexerLOGGED                                                = 441
-	PostgreSQLLexerSTORED                                                = 442
-	PostgreSQLLexerINCLUDE                                               = 443
-	PostgreSQLLexerROUTINE                                               = 444
-	PostgreSQLLexerTRANSFORM                                             = 445
-	PostgreSQLLexerIMPORT_P                                              = 446
-	PostgreSQLLexerPOLICY                                                = 447
-	PostgreSQLLexerMETHOD                                                = 448
-	PostgreSQLLexerREFERENCING                                           = 449
-	PostgreSQLLexerNEW                                                   = 450
-	PostgreSQLLexerOLD                                                   = 451
-	PostgreSQLLexerVALUE_P                                               = 452
-	PostgreSQLLexerSUBSCRIPTION                                          = 453
-	PostgreSQLLexerPUBLICATION                                           = 454
-	PostgreSQLLexerOUT_P                                                 = 455
-	PostgreSQLLexerEND_P                                                 = 456
-	PostgreSQLLexerROUTINES                                              = 457
-	PostgreSQLLexerSCHEMAS                                               = 458
-	PostgreSQLLexerPROCEDURES                                            = 459
-	PostgreSQLLexerINPUT_P                                               = 460
-	PostgreSQLLexerSUPPORT                                               = 461
-	PostgreSQLLexerPARALLEL                                              = 462
-	PostgreSQLLexerSQL_P                                                 = 463
-	PostgreSQLLexerDEPENDS                                               = 464
-	PostgreSQLLexerOVERRIDING                                            = 465
-	PostgreSQLLexerCONFLICT                                              = 466
-	PostgreSQLLexerSKIP_P                                                = 467
-	PostgreSQLLexerLOCKED                                                = 468
-	PostgreSQLLexerTIES                                                  = 469
-	PostgreSQLLexerROLLUP                                                = 470
-	PostgreSQLLexerCUBE                                                  = 471
-	PostgreSQLLexerGROUPING                                              = 472
-	PostgreSQLLexerSETS                                                  = 473
-	PostgreSQLLexerTABLESAMPLE                                           = 474
-	PostgreSQLLexerORDINALITY                                            = 475
-	PostgreSQLLexerXMLTABLE                                              = 476
-	PostgreSQLLexerCOLUMNS                                               = 477
-	PostgreSQLLexerXMLNAMESPACES                                         = 478
-	PostgreSQLLexerROWTYPE                                               = 479
-	PostgreSQLLexerNORMALIZED                                            = 480
-	PostgreSQLLexerWITHIN                                                = 481
-	PostgreSQLLexerFILTER                                                = 482
-	PostgreSQLLexerGROUPS                                                = 483
-	PostgreSQLLexerOTHERS                                                = 484
-	PostgreSQLLexerNFC                                                   = 485
-	PostgreSQLLexerNFD                                                   = 486
-	PostgreSQLLexerNFKC                                                  = 487
-	PostgreSQLLexerNFKD                                                  = 488
-	PostgreSQLLexerUESCAPE                                               = 489
-	PostgreSQLLexerVIEWS                                                 = 490
-	PostgreSQLLexerNORMALIZE                                             = 491
-	PostgreSQLLexerDUMP                                                  = 492
-	PostgreSQLLexerPRINT_STRICT_PARAMS                                   = 493
-	PostgreSQLLexerVARIABLE_CONFLICT                                     = 494
-	PostgreSQLLexerERROR                                                 = 495
-	PostgreSQLLexerUSE_VARIABLE                                          = 496
-	PostgreSQLLexerUSE_COLUMN                                            = 497
-	PostgreSQLLexerALIAS                                                 = 498
-	PostgreSQLLexerCONSTANT                                              = 499
-	PostgreSQLLexerPERFORM                                               = 500
-	PostgreSQLLexerGET                                                   = 501
-	PostgreSQLLexerDIAGNOSTICS                                           = 502
-	PostgreSQLLexerSTACKED                                               = 503
-	PostgreSQLLexerELSIF                                                 = 504
-	PostgreSQLLexerWHILE                                                 = 505
-	PostgreSQLLexerREVERSE                                               = 506
-	PostgreSQLLexerFOREACH                                               = 507
-	PostgreSQLLexerSLICE                                                 = 508
-	PostgreSQLLexerEXIT                                                  = 509
-	PostgreSQLLexerRETURN                                                = 510
-	PostgreSQLLexerQUERY                                                 = 511
-	PostgreSQLLexerRAISE                                                 = 512
-	PostgreSQLLexerSQLSTATE                                              = 513
-	PostgreSQLLexerDEBUG                                                 = 514
-	PostgreSQLLexerLOG                                                   = 515
-	PostgreSQLLexerINFO                                                  = 516
-	PostgreSQLLexerNOTICE                                                = 517
-	PostgreSQLLexerWARNING                                               = 518
-	PostgreSQLLexerEXCEPTION                                             = 519
-	PostgreSQLLexerASSERT                                                = 520
-	PostgreSQLLexerLOOP                                                  = 521
-	PostgreSQLLexerOPEN                                                  = 522
-	PostgreSQLLexerABS                                                   = 523
-	PostgreSQLLexerCBRT                                                  = 524
-	PostgreSQLLexerCEIL                                                  = 525
-	PostgreSQLLexerCEILING                                               = 526
-	PostgreSQLLexerDEGREES                                               = 527
-	PostgreSQLLexerDIV                                                   = 528
-	PostgreSQLLexerEXP                                                   = 529
-	PostgreSQLLexerFACTORIAL                                             = 530
-	PostgreSQLLexerFLOOR                                                 = 531
-	PostgreSQLLexerGCD                                                   = 532
-	PostgreSQLLexerLCM                                                   = 533
-	PostgreSQLLexerLN                                                    = 534
-	PostgreSQLLexerLOG10                                                 = 535
-	PostgreSQLLexerMIN_SCALE                                             = 536
-	PostgreSQLLexerMOD                                                   = 537
-	PostgreSQLLexerPI                                                    = 538
-	PostgreSQLLexerPOWER                                                 = 539
-	PostgreSQLLexerRADIANS                                               = 540
-	PostgreSQLLexerROUND                                                 = 541
-	PostgreSQLLexerSCALE                                                 = 542
-	PostgreSQLLexerSIGN                                                  = 543
-	PostgreSQLLexerSQRT                                                  = 544
-	PostgreSQLLexerTRIM_SCALE                                            = 545
-	PostgreSQLLexerTRUNC                                                 = 546
-	PostgreSQLLexerWIDTH_BUCKET                                          = 547
-	PostgreSQLLexerRANDOM                                                = 548
-	PostgreSQLLexerSETSEED                                               = 549
-	PostgreSQLLexerACOS                                                  = 550
-	PostgreSQLLexerACOSD                                                 = 551
-	PostgreSQLLexerASIN                                                  = 552
-	PostgreSQLLexerASIND                                                 = 553
-	PostgreSQLLexerATAN                                                  = 554
-	PostgreSQLLexerATAND                                                 = 555
-	PostgreSQLLexerATAN2                                                 = 556
-	PostgreSQLLexerATAN2D                                                = 557
-	PostgreSQLLexerCOS                                                   = 558
-	PostgreSQLLexerCOSD                                                  = 559
-	PostgreSQLLexerCOT                                                   = 560
-	PostgreSQLLexerCOTD                                                  = 561
-	PostgreSQLLexerSIN                                                   = 562
-	PostgreSQLLexerSIND                                                  = 563
-	PostgreSQLLexerTAN                                                   = 564
-	PostgreSQLLexerTAND                                                  = 565
-	PostgreSQLLexerSINH                                                  = 566
-	PostgreSQLLexerCOSH                                                  = 567
-	PostgreSQLLexerTANH                                                  = 568
-	PostgreSQLLexerASINH                                                 = 569
-	PostgreSQLLexerACOSH                                                 = 570
-	PostgreSQLLexerATANH                                                 = 571
-	PostgreSQLLexerBIT_LENGTH                                            = 572
-	PostgreSQLLexerCHAR_LENGTH                                           = 573
-	PostgreSQLLexerCHARACTER_LENGTH                                      = 574
-	PostgreSQLLexerLOWER                                                 = 575
-	PostgreSQLLexerOCTET_LENGTH                                          = 576
-	PostgreSQLLexerUPPER                                                 = 577
-	PostgreSQLLexerASCII                                                 = 578
-	PostgreSQLLexerBTRIM                                                 = 579
-	PostgreSQLLexerCHR                                                   = 580
-	PostgreSQLLexerCONCAT                                                = 581
-	PostgreSQLLexerCONCAT_WS                                             = 582
-	PostgreSQLLexerFORMAT                                                = 583
-	PostgreSQLLexerINITCAP                                               = 584
-	PostgreSQLLexerLENGTH                                                = 585
-	PostgreSQLLexerLPAD                                                  = 586
-	PostgreSQLLexerLTRIM                                                 = 587
-	PostgreSQLLexerMD5                                                   = 588
-	PostgreSQLLexerPARSE_IDENT                                           = 589
-	PostgreSQLLexerPG_CLIENT_ENCODING                                    = 590
-	PostgreSQLLexerQUOTE_IDENT                                           = 591
-	PostgreSQLLexerQUOTE_LITERAL                                         = 592
-	PostgreSQLLexerQUOTE_NULLABLE                                        = 593
-	PostgreSQLLexerREGEXP_COUNT                                          = 594
-	PostgreSQLLexerREGEXP_INSTR                                          = 595
-	PostgreSQLLexerREGEXP_LIKE                                           = 596
-	PostgreSQLLexerREGEXP_MATCH                                          = 597
-	PostgreSQLLexerREGEXP_MATCHES                                        = 598
-	PostgreSQLLexerREGEXP_REPLACE                                        = 599
-	PostgreSQLLexerREGEXP_SPLIT_TO_ARRAY                                 = 600
-	PostgreSQLLexerREGEXP_SPLIT_TO_TABLE                                 = 601
-	PostgreSQLLexerREGEXP_SUBSTR                                         = 602
-	PostgreSQLLexerREPEAT                                                = 603
-	PostgreSQLLexerRPAD                                                  = 604
-	PostgreSQLLexerRTRIM                                                 = 605
-	PostgreSQLLexerSPLIT_PART                                            = 606
-	PostgreSQLLexerSTARTS_WITH                                           = 607
-	PostgreSQLLexerSTRING_TO_ARRAY                                       = 608
-	PostgreSQLLexerSTRING_TO_TABLE                                       = 609
-	PostgreSQLLexerSTRPOS                                                = 610
-	PostgreSQLLexerSUBSTR                                                = 611
-	PostgreSQLLexerTO_ASCII                                              = 612
-	PostgreSQLLexerTO_HEX                                                = 613
-	PostgreSQLLexerTRANSLATE                                             = 614
-	PostgreSQLLexerUNISTR                                                = 615
-	PostgreSQLLexerAGE                                                   = 616
-	PostgreSQLLexerCLOCK_TIMESTAMP                                       = 617
-	PostgreSQLLexerDATE_BIN                                              = 618
-	PostgreSQLLexerDATE_PART                                             = 619
-	PostgreSQLLexerDATE_TRUNC                                            = 620
-	PostgreSQLLexerISFINITE                                              = 621
-	PostgreSQLLexerJUSTIFY_DAYS                                          = 622
-	PostgreSQLLexerJUSTIFY_HOURS                                         = 623
-	PostgreSQLLexerJUSTIFY_INTERVAL                                      = 624
-	PostgreSQLLexerMAKE_DATE                                             = 625
-	PostgreSQLLexerMAKE_INTERVAL                                         = 626
-	PostgreSQLLexerMAKE_TIME                                             = 627
-	PostgreSQLLexerMAKE_TIMESTAMP                                        = 628
-	PostgreSQLLexerMAKE_TIMESTAMPTZ                                      = 629
-	PostgreSQLLexerNOW                                                   = 630
-	PostgreSQLLexerSTATEMENT_TIMESTAMP                                   = 631
-	PostgreSQLLexerTIMEOFDAY                                             = 632
-	PostgreSQLLexerTRANSACTION_TIMESTAMP                                 = 633
-	PostgreSQLLexerTO_TIMESTAMP                                          = 634
-	PostgreSQLLexerTO_CHAR                                               = 635
-	PostgreSQLLexerTO_DATE                                               = 636
-	PostgreSQLLexerTO_NUMBER                                             = 637
-	PostgreSQLLexerENCODE                                                = 638
-	PostgreSQLLexerDISTKEY                                               = 639
-	PostgreSQLLexerSORTKEY                                               = 640
-	PostgreSQLLexerCASE_SENSITIVE                                        = 641
-	PostgreSQLLexerCASE_INSENSITIVE                                      = 642
-	PostgreSQLLexerIdentifier                                            = 643
-	PostgreSQLLexerQuotedIdentifier                                      = 644
-	PostgreSQLLexerUnterminatedQuotedIdentifier                          = 645
-	PostgreSQLLexerInvalidQuotedIdentifier                               = 646
-	PostgreSQLLexerInvalidUnterminatedQuotedIdentifier                   = 647
-	PostgreSQLLexerUnicodeQuotedIdentifier                               = 648
-	PostgreSQLLexerUnterminatedUnicodeQuotedIdentifier                   = 649
-	PostgreSQLLexerInvalidUnicodeQuotedIdentifier                        = 650
-	PostgreSQLLexerInvalidUnterminatedUnicodeQuotedIdentifier            = 651
-	PostgreSQLLexerStringConstant                                        = 652
-	PostgreSQLLexerUnterminatedStringConstant                            = 653
-	PostgreSQLLexerUnicodeEscapeStringConstant                           = 654
-	PostgreSQLLexerUnterminatedUnicodeEscapeStringConstant               = 655
-	PostgreSQLLexerBeginDollarStringConstant                             = 656
-	PostgreSQLLexerBinaryStringConstant                                  = 657
-	PostgreSQLLexerUnterminatedBinaryStringConstant                      = 658
-	PostgreSQLLexerInvalidBinaryStringConstant                           = 659
-	PostgreSQLLexerInvalidUnterminatedBinaryStringConstant               = 660
-	PostgreSQLLexerHexadecimalStringConstant                             = 661
-	PostgreSQLLexerUnterminatedHexadecimalStringConstant                 = 662
-	PostgreSQLLexerInvalidHexadecimalStringConstant                      = 663
-	PostgreSQLLexerInvalidUnterminatedHexadecimalStringConstant          = 664
-	PostgreSQLLexerIntegral                                              = 665
-	PostgreSQLLexerNumericFail                                           = 666
-	PostgreSQLLexerNumeric                                               = 667
-	PostgreSQLLexerPLSQLVARIABLENAME                                     = 668
-	PostgreSQLLexerPLSQLIDENTIFIER                                       = 669
-	PostgreSQLLexerWhitespace                                            = 670
-	PostgreSQLLexerNewline                                               = 671
-	PostgreSQLLexerLineComment                                           = 672
-	PostgreSQLLexerBlockComment                                          = 673
-	PostgreSQLLexerUnterminatedBlockComment                              = 674
-	PostgreSQLLexerMetaCommand                                           = 675
-	PostgreSQLLexerEndMetaCommand                                        = 676
-	PostgreSQLLexerErrorCharacter                                        = 677
-	PostgreSQLLexerEscapeStringConstant                                  = 678
-	PostgreSQLLexerUnterminatedEscapeStringConstant                      = 679
-	PostgreSQLLexerInvalidEscapeStringConstant                           = 680
-	PostgreSQLLexerInvalidUnterminatedEscapeStringConstant               = 681
-	PostgreSQLLexerAfterEscapeStringConstantMode_NotContinued            = 682
-	PostgreSQLLexerAfterEscapeStringConstantWithNewlineMode_NotContinued = 683
-	PostgreSQLLexerDollarText                                            = 684
-	PostgreSQLLexerEndDollarStringConstant                               = 685
-	PostgreSQLLexerAfterEscapeStringConstantWithNewlineMode_Continued    = 686
+	PostgreSQLLexerABSENT                                                = 130
+	PostgreSQLLexerABSOLUTE_P                                            = 131
+	PostgreSQLLexerACCESS                                                = 132
+	PostgreSQLLexerACTION                                                = 133
+	PostgreSQLLexerADD_P                                                 = 134
+	PostgreSQLLexerADMIN                                                 = 135
+	PostgreSQLLexerAFTER                                                 = 136
+	PostgreSQLLexerAGGREGATE                                             = 137
+	PostgreSQLLexerALSO                                                  = 138
+	PostgreSQLLexerALTER                                                 = 139
+	PostgreSQLLexerALWAYS                                                = 140
+	PostgreSQLLexerASSERTION                                             = 141
+	PostgreSQLLexerASSIGNMENT                                            = 142
+	PostgreSQLLexerAT                                                    = 143
+	PostgreSQLLexerATTRIBUTE                                             = 144
+	PostgreSQLLexerBACKWARD                                              = 145
+	PostgreSQLLexerBEFORE                                                = 146
+	PostgreSQLLexerBEGIN_P                                               = 147
+	PostgreSQLLexerBY                                                    = 148
+	PostgreSQLLexerCACHE                                                 = 149
+	PostgreSQLLexerCALLED                                                = 150
+	PostgreSQLLexerCASCADE                                               = 151
+	PostgreSQLLexerCASCADED                                              = 152
+	PostgreSQLLexerCATALOG                                               = 153
+	PostgreSQLLexerCHAIN                                                 = 154
+	PostgreSQLLexerCHARACTERISTICS                                       = 155
+	PostgreSQLLexerCHECKPOINT                                            = 156
+	PostgreSQLLexerCLASS                                                 = 157
+	PostgreSQLLexerCLOSE                                                 = 158
+	PostgreSQLLexerCLUSTER                                               = 159
+	PostgreSQLLexerCOMMENT                                               = 160
+	PostgreSQLLexerCOMMENTS                                              = 161
+	PostgreSQLLexerCOMMIT                                                = 162
+	PostgreSQLLexerCOMMITTED                                             = 163
+	PostgreSQLLexerCONDITIONAL                                           = 164
+	PostgreSQLLexerCONFIGURATION                                         = 165
+	PostgreSQLLexerCONNECTION                                            = 166
+	PostgreSQLLexerCONSTRAINTS                                           = 167
+	PostgreSQLLexerCONTENT_P                                             = 168
+	PostgreSQLLexerCONTINUE_P                                            = 169
+	PostgreSQLLexerCONVERSION_P                                          = 170
+	PostgreSQLLexerCOPY                                                  = 171
+	PostgreSQLLexerCOST                                                  = 172
+	PostgreSQLLexerCSV                                                   = 173
+	PostgreSQLLexerCURSOR                                                = 174
+	PostgreSQLLexerCYCLE                                                 = 175
+	PostgreSQLLexerDATA_P                                                = 176
+	PostgreSQLLexerDATABASE                                              = 177
+	PostgreSQLLexerDAY_P                                                 = 178
+	PostgreSQLLexerDEALLOCATE                                            = 179
+	PostgreSQLLexerDECLARE                                               = 180
+	PostgreSQLLexerDEFAULTS                                              = 181
+	PostgreSQLLexerDEFERRED                                              = 182
+	PostgreSQLLexerDEFINER                                               = 183
+	PostgreSQLLexerDELETE_P                                              = 184
+	PostgreSQLLexerDELIMITER                                             = 185
+	PostgreSQLLexerDELIMITERS                                            = 186
+	PostgreSQLLexerDICTIONARY                                            = 187
+	PostgreSQLLexerDISABLE_P                                             = 188
+	PostgreSQLLexerDISCARD                                               = 189
+	PostgreSQLLexerDOCUMENT_P                                            = 190
+	PostgreSQLLexerDOMAIN_P                                              = 191
+	PostgreSQLLexerDOUBLE_P                                              = 192
+	PostgreSQLLexerDROP                                                  = 193
+	PostgreSQLLexerEACH                                                  = 194
+	PostgreSQLLexerEMPTY_P                                               = 195
+	PostgreSQLLexerENABLE_P                                              = 196
+	PostgreSQLLexerENCODING                                              = 197
+	PostgreSQLLexerENCRYPTED                                             = 198
+	PostgreSQLLexerENUM_P                                                = 199
+	PostgreSQLLexerESCAPE                                                = 200
+	PostgreSQLLexerEVENT                                                 = 201
+	PostgreSQLLexerEXCLUDE                                               = 202
+	PostgreSQLLexerEXCLUDING                                             = 203
+	PostgreSQLLexerEXCLUSIVE                                             = 204
+	PostgreSQLLexerEXECUTE                                               = 205
+	PostgreSQLLexerEXPLAIN                                               = 206
+	PostgreSQLLexerEXTENSION                                             = 207
+	PostgreSQLLexerEXTERNAL                                              = 208
+	PostgreSQLLexerFAMILY                                                = 209
+	PostgreSQLLexerFIRST_P                                               = 210
+	PostgreSQLLexerFOLLOWING                                             = 211
+	PostgreSQLLexerFORCE                                                 = 212
+	PostgreSQLLexerFORWARD                                               = 213
+	PostgreSQLLexerFUNCTION                                              = 214
+	PostgreSQLLexerFUNCTIONS                                             = 215
+	PostgreSQLLexerGLOBAL                                                = 216
+	PostgreSQLLexerGRANTED                                               = 217
+	PostgreSQLLexerHANDLER                                               = 218
+	PostgreSQLLexerHEADER_P                                              = 219
+	PostgreSQLLexerHOLD                                                  = 220
+	PostgreSQLLexerHOUR_P                                                = 221
+	PostgreSQLLexerIDENTITY_P                                            = 222
+	PostgreSQLLexerIF_P                                                  = 223
+	PostgreSQLLexerIMMEDIATE                                             = 224
+	PostgreSQLLexerIMMUTABLE                                             = 225
+	PostgreSQLLexerIMPLICIT_P                                            = 226
+	PostgreSQLLexerINCLUDING                                             = 227
+	PostgreSQLLexerINCREMENT                                             = 228
+	PostgreSQLLexerINDEX                                                 = 229
+	PostgreSQLLexerINDEXES                                               = 230
+	PostgreSQLLexerINHERIT                                               = 231
+	PostgreSQLLexerINHERITS                                              = 232
+	PostgreSQLLexerINLINE_P                                              = 233
+	PostgreSQLLexerINSENSITIVE                                           = 234
+	PostgreSQLLexerINSERT                                                = 235
+	PostgreSQLLexerINSTEAD                                               = 236
+	PostgreSQLLexerINVOKER                                               = 237
+	PostgreSQLLexerISOLATION                                             = 238
+	PostgreSQLLexerJSON                                                  = 239
+	PostgreSQLLexerKEEP                                                  = 240
+	PostgreSQLLexerKEY                                                   = 241
+	PostgreSQLLexerKEYS                                                  = 242
+	PostgreSQLLexerLABEL                                                 = 243
+	PostgreSQLLexerLANGUAGE                                              = 244
+	PostgreSQLLexerLARGE_P                                               = 245
+	PostgreSQLLexerLAST_P                                                = 246
+	PostgreSQLLexerLEAKPROOF                                             = 247
+	PostgreSQLLexerLEVEL                                                 = 248
+	PostgreSQLLexerLISTEN                                                = 249
+	PostgreSQLLexerLOAD                                                  = 250
+	PostgreSQLLexerLOCAL                                                 = 251
+	PostgreSQLLexerLOCATION                                              = 252
+	PostgreSQLLexerLOCK_P                                                = 253
+	PostgreSQLLexerMAPPING                                               = 254
+	PostgreSQLLexerMATCH                                                 = 255
+	PostgreSQLLexerMATCHED                                               = 256
+	PostgreSQLLexerMATERIALIZED                                          = 257
+	PostgreSQLLexerMAXVALUE                                              = 258
+	PostgreSQLLexerMERGE                                                 = 259
+	PostgreSQLLexerMINUTE_P                                              = 260
+	PostgreSQLLexerMINVALUE                                              = 261
+	PostgreSQLLexerMODE                                                  = 262
+	PostgreSQLLexerMONTH_P                                               = 263
+	PostgreSQLLexerMOVE                                                  = 264
+	PostgreSQLLexerNAME_P                                                = 265
+	PostgreSQLLexerNAMES                                                 = 266
+	PostgreSQLLexerNESTED                                                = 267
+	PostgreSQLLexerNEXT                                                  = 268
+	PostgreSQLLexerNO                                                    = 269
+	PostgreSQLLexerNOTHING                                               = 270
+	PostgreSQLLexerNOTIFY                                                = 271
+	PostgreSQLLexerNOWAIT                                                = 272
+	PostgreSQLLexerNULLS_P                                               = 273
+	PostgreSQLLexerOBJECT_P                                              = 274
+	PostgreSQLLexerOF                                                    = 275
+	PostgreSQLLexerOFF                                                   = 276
+	PostgreSQLLexerOIDS                                                  = 277
+	PostgreSQLLexerOMIT                                                  = 278
+	PostgreSQLLexerOPERATOR                                              = 279
+	PostgreSQLLexerOPTION                                                = 280
+	PostgreSQLLexerOPTIONS                                               = 281
+	PostgreSQLLexerOWNED                                                 = 282
+	PostgreSQLLexerOWNER                                                 = 283
+	PostgreSQLLexerPARSER                                                = 284
+	PostgreSQLLexerPARTIAL                                               = 285
+	PostgreSQLLexerPARTITION                                             = 286
+	PostgreSQLLexerPASSING                                               = 287
+	PostgreSQLLexerPASSWORD                                              = 288
+	PostgreSQLLexerPATH                                                  = 289
+	PostgreSQLLexerPLANS                                                 = 290
+	PostgreSQLLexerPRECEDING                                             = 291
+	PostgreSQLLexerPREPARE                                               = 292
+	PostgreSQLLexerPREPARED                                              = 293
+	PostgreSQLLexerPRESERVE                                              = 294
+	PostgreSQLLexerPRIOR                                                 = 295
+	PostgreSQLLexerPRIVILEGES                                            = 296
+	PostgreSQLLexerPROCEDURAL                                            = 297
+	PostgreSQLLexerPROCEDURE                                             = 298
+	PostgreSQLLexerPROGRAM                                               = 299
+	PostgreSQLLexerQUOTE                                                 = 300
+	PostgreSQLLexerQUOTES                                                = 301
+	PostgreSQLLexerRANGE                                                 = 302
+	PostgreSQLLexerREAD                                                  = 303
+	PostgreSQLLexerREASSIGN                                              = 304
+	PostgreSQLLexerRECHECK                                               = 305
+	PostgreSQLLexerRECURSIVE                                             = 306
+	PostgreSQLLexerREF                                                   = 307
+	PostgreSQLLexerREFRESH                                               = 308
+	PostgreSQLLexerREINDEX                                               = 309
+	PostgreSQLLexerRELATIVE_P                                            = 310
+	PostgreSQLLexerRELEASE                                               = 311
+	PostgreSQLLexerRENAME                                                = 312
+	PostgreSQLLexerREPEATABLE                                            = 313
+	PostgreSQLLexerREPLACE                                               = 314
+	PostgreSQLLexerREPLICA                                               = 315
+	PostgreSQLLexerRESET                                                 = 316
+	PostgreSQLLexerRESTART                                               = 317
+	PostgreSQLLexerRESTRICT                                              = 318
+	PostgreSQLLexerRETURNS                                               = 319
+	PostgreSQLLexerREVOKE                                                = 320
+	PostgreSQLLexerROLE                                                  = 321
+	PostgreSQLLexerROLLBACK                                              = 322
+	PostgreSQLLexerROWS                                                  = 323
+	PostgreSQLLexerRULE                                                  = 324
+	PostgreSQLLexerSAVEPOINT                                             = 325
+	PostgreSQLLexerSCALAR                                                = 326
+	PostgreSQLLexerSCHEMA                                                = 327
+	PostgreSQLLexerSCROLL                                                = 328
+	PostgreSQLLexerSEARCH                                                = 329
+	PostgreSQLLexerSECOND_P                                              = 330
+	PostgreSQLLexerSECURITY                                              = 331
+	PostgreSQLLexerSEQUENCE                                              = 332
+	PostgreSQLLexerSEQUENCES                                             = 333
+	PostgreSQLLexerSERIALIZABLE                                          = 334
+	PostgreSQLLexerSERVER                                                = 335
+	PostgreSQLLexerSESSION                                               = 336
+	PostgreSQLLexerSET                                                   = 337
+	PostgreSQLLexerSHARE                                                 = 338
+	PostgreSQLLexerSHOW                                                  = 339
+	PostgreSQLLexerSIMPLE                                                = 340
+	PostgreSQLLexerSNAPSHOT                                              = 341
+	PostgreSQLLexerSOURCE                                                = 342
+	PostgreSQLLexerSTABLE                                                = 343
+	PostgreSQLLexerSTANDALONE_P                                          = 344
+	PostgreSQLLexerSTART                                                 = 345
+	PostgreSQLLexerSTATEMENT                                             = 346
+	PostgreSQLLexerSTATISTICS                                            = 347
+	PostgreSQLLexerSTDIN                                                 = 348
+	PostgreSQLLexerSTDOUT                                                = 349
+	PostgreSQLLexerSTORAGE                                               = 350
+	PostgreSQLLexerSTRICT_P                                              = 351
+	PostgreSQLLexerSTRING_P                                              = 352
+	PostgreSQLLexerSTRIP_P                                               = 353
+	PostgreSQLLexerSYSID                                                 = 354
+	PostgreSQLLexerSYSTEM_P                                              = 355
+	PostgreSQLLexerTABLES                                                = 356
+	PostgreSQLLexerTABLESPACE                                            = 357
+	PostgreSQLLexerTARGET                                                = 358
+	PostgreSQLLexerTEMP                                                  = 359
+	PostgreSQLLexerTEMPLATE                                              = 360
+	PostgreSQLLexerTEMPORARY                                             = 361
+	PostgreSQLLexerTEXT_P                                                = 362
+	PostgreSQLLexerTRANSACTION                                           = 363
+	PostgreSQLLexerTRIGGER                                               = 364
+	PostgreSQLLexerTRUNCATE                                              = 365
+	PostgreSQLLexerTRUSTED                                               = 366
+	PostgreSQLLexerTYPE_P                                                = 367
+	PostgreSQLLexerTYPES_P                                               = 368
+	PostgreSQLLexerUNBOUNDED                                             = 369
+	PostgreSQLLexerUNCOMMITTED                                           = 370
+	PostgreSQLLexerUNCONDITIONAL                                         = 371
+	PostgreSQLLexerUNENCRYPTED                                           = 372
+	PostgreSQLLexerUNKNOWN                                               = 373
+	PostgreSQLLexerUNLISTEN                                              = 374
+	PostgreSQLLexerUNLOGGED                                              = 375
+	PostgreSQLLexerUNTIL                                                 = 376
+	PostgreSQLLexerUPDATE                                                = 377
+	PostgreSQLLexerVACUUM                                                = 378
+	PostgreSQLLexerVALID                                                 = 379
+	PostgreSQLLexerVALIDATE                                              = 380
+	PostgreSQLLexerVALIDATOR                                             = 381
+	PostgreSQLLexerVARYING                                               = 382
+	PostgreSQLLexerVERSION_P                                             = 383
+	PostgreSQLLexerVIEW                                                  = 384
+	PostgreSQLLexerVOLATILE                                              = 385
+	PostgreSQLLexerWHITESPACE_P                                          = 386
+	PostgreSQLLexerWITHOUT                                               = 387
+	PostgreSQLLexerWORK                                                  = 388
+	PostgreSQLLexerWRAPPER                                               = 389
+	PostgreSQLLexerWRITE                                                 = 390
+	PostgreSQLLexerXML_P                                                 = 391
+	PostgreSQLLexerYEAR_P                                                = 392
+	PostgreSQLLexerYES_P                                                 = 393
+	PostgreSQLLexerZONE                                                  = 394
+	PostgreSQLLexerATOMIC_P                                              = 395
+	PostgreSQLLexerBETWEEN                                               = 396
+	PostgreSQLLexerBIGINT                                                = 397
+	PostgreSQLLexerBIT                                                   = 398
+	PostgreSQLLexerBOOLEAN_P                                             = 399
+	PostgreSQLLexerCHAR_P                                                = 400
+	PostgreSQLLexerCHARACTER                                             = 401
+	PostgreSQLLexerCOALESCE                                              = 402
+	PostgreSQLLexerDEC                                                   = 403
+	PostgreSQLLexerDECIMAL_P                                             = 404
+	PostgreSQLLexerEXISTS                                                = 405
+	PostgreSQLLexerEXTRACT                                               = 406
+	PostgreSQLLexerFLOAT_P                                               = 407
+	PostgreSQLLexerGREATEST                                              = 408
+	PostgreSQLLexerINOUT                                                 = 409
+	PostgreSQLLexerINT_P                                                 = 410
+	PostgreSQLLexerINTEGER                                               = 411
+	PostgreSQLLexerINTERVAL                                              = 412
+	PostgreSQLLexerJSON_ARRAY                                            = 413
+	PostgreSQLLexerJSON_ARRAYAGG                                         = 414
+	PostgreSQLLexerJSON_EXISTS                                           = 415
+	PostgreSQLLexerJSON_OBJECT                                           = 416
+	PostgreSQLLexerJSON_OBJECTAGG                                        = 417
+	PostgreSQLLexerJSON_QUERY                                            = 418
+	PostgreSQLLexerJSON_SCALAR                                           = 419
+	PostgreSQLLexerJSON_SERIALIZE                                        = 420
+	PostgreSQLLexerJSON_TABLE                                            = 421
+	PostgreSQLLexerJSON_VALUE                                            = 422
+	PostgreSQLLexerLEAST                                                 = 423
+	PostgreSQLLexerNATIONAL                                              = 424
+	PostgreSQLLexerNCHAR                                                 = 425
+	PostgreSQLLexerNONE                                                  = 426
+	PostgreSQLLexerNULLIF                                                = 427
+	PostgreSQLLexerNUMERIC                                               = 428
+	PostgreSQLLexerOVERLAY                                               = 429
+	PostgreSQLLexerPARAMETER                                             = 430
+	PostgreSQLLexerPOSITION                                              = 431
+	PostgreSQLLexerPRECISION                                             = 432
+	PostgreSQLLexerREAL                                                  = 433
+	PostgreSQLLexerROW                                                   = 434
+	PostgreSQLLexerSETOF                                                 = 435
+	PostgreSQLLexerSMALLINT                                              = 436
+	PostgreSQLLexerSUBSTRING                                             = 437
+	PostgreSQLLexerTIME                                                  = 438
+	PostgreSQLLexerTIMESTAMP                                             = 439
+	PostgreSQLLexerTREAT                                                 = 440
+	PostgreSQLLexerTRIM                                                  = 441
+	PostgreSQLLexerVALUES                                                = 442
+	PostgreSQLLexerVARCHAR                                               = 443
+	PostgreSQLLexerXMLATTRIBUTES                                         = 444
+	PostgreSQLLexerXMLCOMMENT                                            = 445
+	PostgreSQLLexerXMLAGG                                                = 446
+	PostgreSQLLexerXML_IS_WELL_FORMED                                    = 447
+	PostgreSQLLexerXML_IS_WELL_FORMED_DOCUMENT                           = 448
+	PostgreSQLLexerXML_IS_WELL_FORMED_CONTENT                            = 449
+	PostgreSQLLexerXPATH                                                 = 450
+	PostgreSQLLexerXPATH_EXISTS                                          = 451
+	PostgreSQLLexerXMLCONCAT                                             = 452
+	PostgreSQLLexerXMLELEMENT                                            = 453
+	PostgreSQLLexerXMLEXISTS                                             = 454
+	PostgreSQLLexerXMLFOREST                                             = 455
+	PostgreSQLLexerXMLPARSE                                              = 456
+	PostgreSQLLexerXMLPI                                                 = 457
+	PostgreSQLLexerXMLROOT                                               = 458
+	PostgreSQLLexerXMLSERIALIZE                                          = 459
+	PostgreSQLLexerCALL                                                  = 460
+	PostgreSQLLexerCURRENT_P                                             = 461
+	PostgreSQLLexerATTACH                                                = 462
+	PostgreSQLLexerDETACH                                                = 463
+	PostgreSQLLexerEXPRESSION                                            = 464
+	PostgreSQLLexerGENERATED                                             = 465
+	PostgreSQLLexerLOGGED                                                = 466
+	PostgreSQLLexerSTORED                                                = 467
+	PostgreSQLLexerINCLUDE                                               = 468
+	PostgreSQLLexerROUTINE                                               = 469
+	PostgreSQLLexerTRANSFORM                                             = 470
+	PostgreSQLLexerIMPORT_P                                              = 471
+	PostgreSQLLexerPOLICY                                                = 472
+	PostgreSQLLexerMETHOD                                                = 473
+	PostgreSQLLexerREFERENCING                                           = 474
+	PostgreSQLLexerNEW                                                   = 475
+	PostgreSQLLexerOLD                                                   = 476
+	PostgreSQLLexerVALUE_P                                               = 477
+	PostgreSQLLexerSUBSCRIPTION                                          = 478
+	PostgreSQLLexerPUBLICATION                                           = 479
+	PostgreSQLLexerOUT_P                                                 = 480
+	PostgreSQLLexerEND_P                                                 = 481
+	PostgreSQLLexerROUTINES                                              = 482
+	PostgreSQLLexerSCHEMAS                                               = 483
+	PostgreSQLLexerPROCEDURES                                            = 484
+	PostgreSQLLexerINPUT_P                                               = 485
+	PostgreSQLLexerSUPPORT                                               = 486
+	PostgreSQLLexerPARALLEL                                              = 487
+	PostgreSQLLexerSQL_P                                                 = 488
+	PostgreSQLLexerDEPENDS                                               = 489
+	PostgreSQLLexerOVERRIDING                                            = 490
+	PostgreSQLLexerCONFLICT                                              = 491
+	PostgreSQLLexerSKIP_P                                                = 492
+	PostgreSQLLexerLOCKED                                                = 493
+	PostgreSQLLexerTIES                                                  = 494
+	PostgreSQLLexerROLLUP                                                = 495
+	PostgreSQLLexerCUBE                                                  = 496
+	PostgreSQLLexerGROUPING                                              = 497
+	PostgreSQLLexerSETS                                                  = 498
+	PostgreSQLLexerTABLESAMPLE                                           = 499
+	PostgreSQLLexerORDINALITY                                            = 500
+	PostgreSQLLexerXMLTABLE                                              = 501
+	PostgreSQLLexerCOLUMNS                                               = 502
+	PostgreSQLLexerXMLNAMESPACES                                         = 503
+	PostgreSQLLexerROWTYPE                                               = 504
+	PostgreSQLLexerNORMALIZED                                            = 505
+	PostgreSQLLexerWITHIN                                                = 506
+	PostgreSQLLexerFILTER                                                = 507
+	PostgreSQLLexerGROUPS                                                = 508
+	PostgreSQLLexerOTHERS                                                = 509
+	PostgreSQLLexerNFC                                                   = 510
+	PostgreSQLLexerNFD                                                   = 511
+	PostgreSQLLexerNFKC                                                  = 512
+	PostgreSQLLexerNFKD                                                  = 513
+	PostgreSQLLexerUESCAPE                                               = 514
+	PostgreSQLLexerVIEWS                                                 = 515
+	PostgreSQLLexerNORMALIZE                                             = 516
+	PostgreSQLLexerDUMP                                                  = 517
+	PostgreSQLLexerPRINT_STRICT_PARAMS                                   = 518
+	PostgreSQLLexerVARIABLE_CONFLICT                                     = 519
+	PostgreSQLLexerERROR                                                 = 520
+	PostgreSQLLexerUSE_VARIABLE                                          = 521
+	PostgreSQLLexerUSE_COLUMN                                            = 522
+	PostgreSQLLexerALIAS                                                 = 523
+	PostgreSQLLexerCONSTANT                                              = 524
+	PostgreSQLLexerPERFORM                                               = 525
+	PostgreSQLLexerGET                                                   = 526
+	PostgreSQLLexerDIAGNOSTICS                                           = 527
+	PostgreSQLLexerSTACKED                                               = 528
+	PostgreSQLLexerELSIF                                                 = 529
+	PostgreSQLLexerWHILE                                                 = 530
+	PostgreSQLLexerREVERSE                                               = 531
+	PostgreSQLLexerFOREACH                                               = 532
+	PostgreSQLLexerSLICE                                                 = 533
+	PostgreSQLLexerEXIT                                                  = 534
+	PostgreSQLLexerRETURN                                                = 535
+	PostgreSQLLexerQUERY                                                 = 536
+	PostgreSQLLexerRAISE                                                 = 537
+	PostgreSQLLexerSQLSTATE                                              = 538
+	PostgreSQLLexerDEBUG                                                 = 539
+	PostgreSQLLexerLOG                                                   = 540
+	PostgreSQLLexerINFO                                                  = 541
+	PostgreSQLLexerNOTICE                                                = 542
+	PostgreSQLLexerWARNING                                               = 543
+	PostgreSQLLexerEXCEPTION                                             = 544
+	PostgreSQLLexerASSERT                                                = 545
+	PostgreSQLLexerLOOP                                                  = 546
+	PostgreSQLLexerOPEN                                                  = 547
+	PostgreSQLLexerABS                                                   = 548
+	PostgreSQLLexerCBRT                                                  = 549
+	PostgreSQLLexerCEIL                                                  = 550
+	PostgreSQLLexerCEILING                                               = 551
+	PostgreSQLLexerDEGREES                                               = 552
+	PostgreSQLLexerDIV                                                   = 553
+	PostgreSQLLexerEXP                                                   = 554
+	PostgreSQLLexerFACTORIAL                                             = 555
+	PostgreSQLLexerFLOOR                                                 = 556
+	PostgreSQLLexerGCD                                                   = 557
+	PostgreSQLLexerLCM                                                   = 558
+	PostgreSQLLexerLN                                                    = 559
+	PostgreSQLLexerLOG10                                                 = 560
+	PostgreSQLLexerMIN_SCALE                                             = 561
+	PostgreSQLLexerMOD                                                   = 562
+	PostgreSQLLexerPI                                                    = 563
+	PostgreSQLLexerPOWER                                                 = 564
+	PostgreSQLLexerRADIANS                                               = 565
+	PostgreSQLLexerROUND                                                 = 566
+	PostgreSQLLexerSCALE                                                 = 567
+	PostgreSQLLexerSIGN                                                  = 568
+	PostgreSQLLexerSQRT                                                  = 569
+	PostgreSQLLexerTRIM_SCALE                                            = 570
+	PostgreSQLLexerTRUNC                                                 = 571
+	PostgreSQLLexerWIDTH_BUCKET                                          = 572
+	PostgreSQLLexerRANDOM                                                = 573
+	PostgreSQLLexerSETSEED                                               = 574
+	PostgreSQLLexerACOS                                                  = 575
+	PostgreSQLLexerACOSD                                                 = 576
+	PostgreSQLLexerASIN                                                  = 577
+	PostgreSQLLexerASIND                                                 = 578
+	PostgreSQLLexerATAN                                                  = 579
+	PostgreSQLLexerATAND                                                 = 580
+	PostgreSQLLexerATAN2                                                 = 581
+	PostgreSQLLexerATAN2D                                                = 582
+	PostgreSQLLexerCOS                                                   = 583
+	PostgreSQLLexerCOSD                                                  = 584
+	PostgreSQLLexerCOT                                                   = 585
+	PostgreSQLLexerCOTD                                                  = 586
+	PostgreSQLLexerSIN                                                   = 587
+	PostgreSQLLexerSIND                                                  = 588
+	PostgreSQLLexerTAN                                                   = 589
+	PostgreSQLLexerTAND                                                  = 590
+	PostgreSQLLexerSINH                                                  = 591
+	PostgreSQLLexerCOSH                                                  = 592
+	PostgreSQLLexerTANH                                                  = 593
+	PostgreSQLLexerASINH                                                 = 594
+	PostgreSQLLexerACOSH                                                 = 595
+	PostgreSQLLexerATANH                                                 = 596
+	PostgreSQLLexerBIT_LENGTH                                            = 597
+	PostgreSQLLexerCHAR_LENGTH                                           = 598
+	PostgreSQLLexerCHARACTER_LENGTH                                      = 599
+	PostgreSQLLexerLOWER                                                 = 600
+	PostgreSQLLexerOCTET_LENGTH                                          = 601
+	PostgreSQLLexerUPPER                                                 = 602
+	PostgreSQLLexerASCII                                                 = 603
+	PostgreSQLLexerBTRIM                                                 = 604
+	PostgreSQLLexerCHR                                                   = 605
+	PostgreSQLLexerCONCAT                                                = 606
+	PostgreSQLLexerCONCAT_WS                                             = 607
+	PostgreSQLLexerFORMAT                                                = 608
+	PostgreSQLLexerINITCAP                                               = 609
+	PostgreSQLLexerLENGTH                                                = 610
+	PostgreSQLLexerLPAD                                                  = 611
+	PostgreSQLLexerLTRIM                                                 = 612
+	PostgreSQLLexerMD5                                                   = 613
+	PostgreSQLLexerPARSE_IDENT                                           = 614
+	PostgreSQLLexerPG_CLIENT_ENCODING                                    = 615
+	PostgreSQLLexerQUOTE_IDENT                                           = 616
+	PostgreSQLLexerQUOTE_LITERAL                                         = 617
+	PostgreSQLLexerQUOTE_NULLABLE                                        = 618
+	PostgreSQLLexerREGEXP_COUNT                                          = 619
+	PostgreSQLLexerREGEXP_INSTR                                          = 620
+	PostgreSQLLexerREGEXP_LIKE                                           = 621
+	PostgreSQLLexerREGEXP_MATCH                                          = 622
+	PostgreSQLLexerREGEXP_MATCHES                                        = 623
+	PostgreSQLLexerREGEXP_REPLACE                                        = 624
+	PostgreSQLLexerREGEXP_SPLIT_TO_ARRAY                                 = 625
+	PostgreSQLLexerREGEXP_SPLIT_TO_TABLE                                 = 626
+	PostgreSQLLexerREGEXP_SUBSTR                                         = 627
+	PostgreSQLLexerREPEAT                                                = 628
+	PostgreSQLLexerRPAD                                                  = 629
+	PostgreSQLLexerRTRIM                                                 = 630
+	PostgreSQLLexerSPLIT_PART                                            = 631
+	PostgreSQLLexerSTARTS_WITH                                           = 632
+	PostgreSQLLexerSTRING_TO_ARRAY                                       = 633
+	PostgreSQLLexerSTRING_TO_TABLE                                       = 634
+	PostgreSQLLexerSTRPOS                                                = 635
+	PostgreSQLLexerSUBSTR                                                = 636
+	PostgreSQLLexerTO_ASCII                                              = 637
+	PostgreSQLLexerTO_HEX                                                = 638
+	PostgreSQLLexerTRANSLATE                                             = 639
+	PostgreSQLLexerUNISTR                                                = 640
+	PostgreSQLLexerAGE                                                   = 641
+	PostgreSQLLexerCLOCK_TIMESTAMP                                       = 642
+	PostgreSQLLexerDATE_BIN                                              = 643
+	PostgreSQLLexerDATE_PART                                             = 644
+	PostgreSQLLexerDATE_TRUNC                                            = 645
+	PostgreSQLLexerISFINITE                                              = 646
+	PostgreSQLLexerJUSTIFY_DAYS                                          = 647
+	PostgreSQLLexerJUSTIFY_HOURS                                         = 648
+	PostgreSQLLexerJUSTIFY_INTERVAL                                      = 649
+	PostgreSQLLexerMAKE_DATE                                             = 650
+	PostgreSQLLexerMAKE_INTERVAL                                         = 651
+	PostgreSQLLexerMAKE_TIME                                             = 652
+	PostgreSQLLexerMAKE_TIMESTAMP                                        = 653
+	PostgreSQLLexerMAKE_TIMESTAMPTZ                                      = 654
+	PostgreSQLLexerNOW                                                   = 655
+	PostgreSQLLexerSTATEMENT_TIMESTAMP                                   = 656
+	PostgreSQLLexerTIMEOFDAY                                             = 657
+	PostgreSQLLexerTRANSACTION_TIMESTAMP                                 = 658
+	PostgreSQLLexerTO_TIMESTAMP                                          = 659
+	PostgreSQLLexerTO_CHAR                                               = 660
+	PostgreSQLLexerTO_DATE                                               = 661
+	PostgreSQLLexerTO_NUMBER                                             = 662
+	PostgreSQLLexerENCODE                                                = 663
+	PostgreSQLLexerDISTKEY                                               = 664
+	PostgreSQLLexerSORTKEY                                               = 665
+	PostgreSQLLexerCASE_SENSITIVE                                        = 666
+	PostgreSQLLexerCASE_INSENSITIVE                                      = 667
+	PostgreSQLLexerIdentifier                                            = 668
+	PostgreSQLLexerQuotedIdentifier                                      = 669
+	PostgreSQLLexerUnterminatedQuotedIdentifier                          = 670
+	PostgreSQLLexerInvalidQuotedIdentifier                               = 671
+	PostgreSQLLexerInvalidUnterminatedQuotedIdentifier                   = 672
+	PostgreSQLLexerUnicodeQuotedIdentifier                               = 673
+	PostgreSQLLexerUnterminatedUnicodeQuotedIdentifier                   = 674
+	PostgreSQLLexerInvalidUnicodeQuotedIdentifier                        = 675
+	PostgreSQLLexerInvalidUnterminatedUnicodeQuotedIdentifier            = 676
+	PostgreSQLLexerStringConstant                                        = 677
+	PostgreSQLLexerUnterminatedStringConstant                            = 678
+	PostgreSQLLexerUnicodeEscapeStringConstant                           = 679
+	PostgreSQLLexerUnterminatedUnicodeEscapeStringConstant               = 680
+	PostgreSQLLexerBeginDollarStringConstant                             = 681
+	PostgreSQLLexerBinaryStringConstant                                  = 682
+	PostgreSQLLexerUnterminatedBinaryStringConstant                      = 683
+	PostgreSQLLexerInvalidBinaryStringConstant                           = 684
+	PostgreSQLLexerInvalidUnterminatedBinaryStringConstant               = 685
+	PostgreSQLLexerHexadecimalStringConstant                             = 686
+	PostgreSQLLexerUnterminatedHexadecimalStringConstant                 = 687
+	PostgreSQLLexerInvalidHexadecimalStringConstant                      = 688
+	PostgreSQLLexerInvalidUnterminatedHexadecimalStringConstant          = 689
+	PostgreSQLLexerIntegral                                              = 690
+	PostgreSQLLexerNumericFail                                           = 691
+	PostgreSQLLexerNumeric                                               = 692
+	PostgreSQLLexerPLSQLVARIABLENAME                                     = 693
+	PostgreSQLLexerPLSQLIDENTIFIER                                       = 694
+	PostgreSQLLexerWhitespace                                            = 695
+	PostgreSQLLexerNewline                                               = 696
+	PostgreSQLLexerLineComment                                           = 697
+	PostgreSQLLexerBlockComment                                          = 698
+	PostgreSQLLexerUnterminatedBlockComment                              = 699
+	PostgreSQLLexerMetaCommand                                           = 700
+	PostgreSQLLexerEndMetaCommand                                        = 701
+	PostgreSQLLexerErrorCharacter                                        = 702
+	PostgreSQLLexerEscapeStringConstant                                  = 703
+	PostgreSQLLexerUnterminatedEscapeStringConstant                      = 704
+	PostgreSQLLexerInvalidEscapeStringConstant                           = 705
+	PostgreSQLLexerInvalidUnterminatedEscapeStringConstant               = 706
+	PostgreSQLLexerAfterEscapeStringConstantMode_NotContinued            = 707
+	PostgreSQLLexerAfterEscapeStringConstantWithNewlineMode_NotContinued = 708
+	PostgreSQLLexerDollarText                                            = 709
+	PostgreSQLLexerEndDollarStringConstant                               = 710
+	PostgreSQLLexerCopyData                                              = 711
+	PostgreSQLLexerAfterEscapeStringConstantWithNewlineMode_Continued    = 712
 )
 
 // PostgreSQLLexer modes.
@@ -4283,6 +4464,7 @@ const (
 	PostgreSQLLexerAfterEscapeStringConstantMode
 	PostgreSQLLexerAfterEscapeStringConstantWithNewlineMode
 	PostgreSQLLexerDollarQuotedStringMode
+	PostgreSQLLexerCopyDataMode
 )
 
 /* This field stores the tags which are used to detect the end of a dollar-quoted string literal.
@@ -4293,22 +4475,22 @@ func (l *PostgreSQLLexer) Action(localctx antlr.RuleContext, ruleIndex, actionIn
 	case 28:
 		l.Operator_Action(localctx, actionIndex)
 
-	case 663:
+	case 688:
 		l.BeginDollarStringConstant_Action(localctx, actionIndex)
 
-	case 674:
+	case 699:
 		l.NumericFail_Action(localctx, actionIndex)
 
-	case 683:
+	case 708:
 		l.UnterminatedBlockComment_Action(localctx, actionIndex)
 
-	case 695:
+	case 720:
 		l.AfterEscapeStringConstantMode_NotContinued_Action(localctx, actionIndex)
 
-	case 699:
+	case 724:
 		l.AfterEscapeStringConstantWithNewlineMode_NotContinued_Action(localctx, actionIndex)
 
-	case 701:
+	case 726:
 		l.EndDollarStringConstant_Action(localctx, actionIndex)
 
 	default:
@@ -4388,10 +4570,10 @@ func (l *PostgreSQLLexer) Sempred(localctx antlr.RuleContext, ruleIndex, predInd
 	case 29:
 		return l.OperatorEndingWithPlusMinus_Sempred(localctx, predIndex)
 
-	case 647:
+	case 672:
 		return l.IdentifierStartChar_Sempred(localctx, predIndex)
 
-	case 701:
+	case 726:
 		return l.EndDollarStringConstant_Sempred(localctx, predIndex)
 
 	default:
@@ -12,6 +12,12 @@ type PostgreSQLLexerBase struct {
 
 	stack              StringStack
 	reservedKeywordMap map[string]bool
+
+	// CopyRowHandler, if set, is called with every row of the data that
+	// follows a COPY ... FROM STDIN statement, and the data is left out of the
+	// token stream. copy is the COPY token of the statement.
+	CopyRowHandler func(copy antlr.Token, row string)
+	copyStatement  copyStatement
 }
 
 func (receiver *PostgreSQLLexerBase) pushTag() {
@@ -16,8 +16,17 @@ const (
 type PostgreSQLParserBase struct {
 	*antlr.BaseParser
 
-	Engine      Engine
-	parseErrors []*PostgreSQLParseError
+	// Engine is the database the script is written for. Syntax that a
+	// Redshift engine does not support is reported as a syntax error.
+	Engine Engine
+	// TargetVersion is the major version of the PostgreSQL server the script
+	// is written for, such as 15. Syntax the target does not support is
+	// reported as a syntax error. 0 accepts the syntax of every version.
+	TargetVersion int
+	parseErrors   []*PostgreSQLParseError
+	// sourceMap maps the parsed text to the script it was extracted from. It
+	// is nil when the script itself is parsed.
+	sourceMap *sourceMap
 }
 
 func NewPostgreSQLParserBase(input antlr.TokenStream) *PostgreSQLParserBase {
@@ -27,21 +36,49 @@ func NewPostgreSQLParserBase(input antlr.TokenStream) *PostgreSQLParserBase {
 	}
 }
 
+// GetParsedSqlTree parses script, which starts after line lines of the file
+// being parsed. Its tokens and syntax errors are positioned in that file.
 func (receiver *PostgreSQLParserBase) GetParsedSqlTree(script string, line int) antlr.ParserRuleContext {
-	parser := getPostgreSQLParser(script)
+	parser := receiver.getPostgreSQLParser(script, scriptSourceMap(script, line))
 	result := parser.Root()
-	for _, err := range parser.parseErrors {
-		receiver.parseErrors = append(receiver.parseErrors, &PostgreSQLParseError{
-			Number:  err.Number,
-			Offset:  err.Offset,
-			Line:    err.Line + line,
-			Column:  err.Column,
-			Message: err.Message,
-		})
-	}
+	receiver.parseErrors = append(receiver.parseErrors, parser.parseErrors...)
 	return result
 }
 
+// ParseErrors returns the syntax errors found in the routine and DO bodies
+// parsed along with the script, positioned in the script.
+func (receiver *PostgreSQLParserBase) ParseErrors() []*PostgreSQLParseError {
+	return receiver.parseErrors
+}
+
+// ValidateTargetVersion reports the syntax in tree that TargetVersion does not
+// support as syntax errors. Redshift scripts are not checked.
+func (receiver *PostgreSQLParserBase) ValidateTargetVersion(tree antlr.ParserRuleContext) {
+	if receiver.TargetVersion == 0 || receiver.Engine != EnginePostgreSQL {
+		return
+	}
+	for _, use := range findVersionedSyntax(tree) {
+		if use.since > receiver.TargetVersion {
+			receiver.NotifyErrorListeners(use.message(), use.token, nil)
+		}
+	}
+}
+
+// ValidateEngine reports the syntax in tree that Engine does not support as
+// syntax errors. The grammar is PostgreSQL's, so only Redshift scripts are
+// checked.
+func (receiver *PostgreSQLParserBase) ValidateEngine(tree antlr.ParserRuleContext) {
+	if receiver.Engine != EngineRedshift {
+		return
+	}
+	for _, use := range findRedshiftUnsupportedSyntax(tree) {
+		receiver.NotifyErrorListeners(use.message(), use.token, nil)
+	}
+}
+
+// ParseRoutineBody parses the AS body of a LANGUAGE plpgsql or sql function or
+// procedure into Func_asContext.Definition. SQL-standard BEGIN ATOMIC bodies
+// are part of the grammar and need no second parse.
 func (receiver *PostgreSQLParserBase) ParseRoutineBody(localContextInterface ICreatefunc_opt_listContext) {
 	localContext, ok := localContextInterface.(*Createfunc_opt_listContext)
 	if !ok {
@@ -49,153 +86,171 @@ func (receiver *PostgreSQLParserBase) ParseRoutineBody(localContextInterface ICr
 	}
 
 	var lang string
-	for _, coi := range localContext.AllCreatefunc_opt_item() {
-		createFuncOptItemContext, ok := coi.(*Createfunc_opt_itemContext)
-		if !ok || createFuncOptItemContext.LANGUAGE() == nil {
-			continue
+	var funcAs *Func_asContext
+	for _, item := range localContext.AllCreatefunc_opt_item() {
+		if item.LANGUAGE() != nil && item.Nonreservedword_or_sconst() != nil {
+			lang = routineLanguage(item.Nonreservedword_or_sconst())
 		}
-		nonReservedWordOrSConstContextInterface := createFuncOptItemContext.Nonreservedword_or_sconst()
-		if nonReservedWordOrSConstContextInterface == nil {
-			continue
-		}
-		nonReservedWordOrSConstContext, ok := nonReservedWordOrSConstContextInterface.(*Nonreservedword_or_sconstContext)
-		if !ok {
-			continue
-		}
-		nonReservedWordContextInterface := nonReservedWordOrSConstContext.Nonreservedword()
-		if nonReservedWordContextInterface == nil {
-			continue
-		}
-		nonReservedWordContext, ok := nonReservedWordContextInterface.(*NonreservedwordContext)
-		if !ok {
-			continue
-		}
-		identifierInterface := nonReservedWordContext.Identifier()
-		if identifierInterface == nil {
-			continue
-		}
-		identifier, ok := identifierInterface.(*IdentifierContext)
-		if !ok {
-			continue
-		}
-		node := identifier.Identifier()
-		if node == nil {
-			continue
+		if as, ok := item.Func_as().(*Func_asContext); ok && item.AS() != nil {
+			funcAs = as
 		}
-		lang = node.GetText()
-		break
 	}
-	if lang == "" {
+	if funcAs == nil {
+		return
+	}
+	funcAs.Definition = receiver.parseBody(lang, funcAs.Sconst(0))
+}
+
+// ParseDoBody parses the body of a DO statement into
+// Dostmt_opt_itemContext.Definition. The language defaults to plpgsql.
+func (receiver *PostgreSQLParserBase) ParseDoBody(localContextInterface IDostmt_opt_listContext) {
+	localContext, ok := localContextInterface.(*Dostmt_opt_listContext)
+	if !ok {
 		return
 	}
 
-	var funcAs *Createfunc_opt_itemContext
-	for _, coi := range localContext.AllCreatefunc_opt_item() {
-		ctx, ok := coi.(*Createfunc_opt_itemContext)
-		if !ok || ctx.AS() == nil {
+	lang := "plpgsql"
+	var body *Dostmt_opt_itemContext
+	for _, itemInterface := range localContext.AllDostmt_opt_item() {
+		item, ok := itemInterface.(*Dostmt_opt_itemContext)
+		if !ok {
 			continue
 		}
-		as := ctx.Func_as()
-		if as != nil {
-			funcAs = ctx
-			break
+		if item.LANGUAGE() != nil {
+			lang = routineLanguage(item.Nonreservedword_or_sconst())
+		} else if item.Sconst() != nil {
+			body = item
 		}
 	}
-	if funcAs == nil {
+	if body == nil {
 		return
 	}
+	body.Definition = receiver.parseBody(lang, body.Sconst())
+}
 
-	funcAsContextInterface := funcAs.Func_as()
-	if funcAsContextInterface == nil {
+// ParseDynamicSQL parses the command string of a PL/pgSQL EXECUTE into
+// Stmt_dynexecuteContext.Definition. The parts of the command that are only
+// known at run time are replaced with DynamicSQLPlaceholder, so the tree is a
+// best guess; if it does not parse, its syntax errors are recorded in
+// DefinitionErrors instead of the errors of the script. Syntax that
+// TargetVersion does not support is recorded in DefinitionVersionErrors and
+// does not keep the tree from being set.
+func (receiver *PostgreSQLParserBase) ParseDynamicSQL(localContextInterface IStmt_dynexecuteContext) {
+	localContext, ok := localContextInterface.(*Stmt_dynexecuteContext)
+	if !ok || localContext.A_expr() == nil {
 		return
 	}
-	funcAsContext, ok := funcAsContextInterface.(*Func_asContext)
+	text, sourceMap, ok := dynamicSQLSource(localContext.A_expr(), receiver.sourceMap)
 	if !ok {
 		return
 	}
-	sConstContextInterface := funcAsContext.Sconst(0)
-	if sConstContextInterface == nil {
+	parser := receiver.getPostgreSQLParser(text, sourceMap)
+	parser.TargetVersion = 0
+	result := parser.Root()
+	if len(parser.parseErrors) > 0 {
+		localContext.DefinitionErrors = parser.parseErrors
 		return
 	}
-	sConstContext, ok := sConstContextInterface.(*SconstContext)
-	if !ok {
-		return
+	localContext.Definition = result
+	if receiver.TargetVersion != 0 && receiver.Engine == EnginePostgreSQL {
+		localContext.DefinitionVersionErrors = CheckTargetVersion(result, receiver.TargetVersion)
 	}
-	text := GetRoutineBodyString(sConstContext)
-	line := sConstContext.GetStart().GetLine()
-	parser := getPostgreSQLParser(text)
-	switch lang {
-	case "plpgsql":
-		funcAs.Func_as().(*Func_asContext).Definition = parser.Plsqlroot()
-	case "sql":
-		funcAs.Func_as().(*Func_asContext).Definition = parser.Root()
+}
+
+// parseBody parses a routine body written in lang, which is plpgsql or sql.
+// The tokens and syntax errors of the body are positioned in the script.
+func (receiver *PostgreSQLParserBase) parseBody(lang string, sConstContextInterface ISconstContext) antlr.ParserRuleContext {
+	sConstContext, ok := sConstContextInterface.(*SconstContext)
+	if !ok || lang != "plpgsql" && lang != "sql" {
+		return nil
 	}
-	for _, err := range parser.parseErrors {
-		receiver.parseErrors = append(receiver.parseErrors, &PostgreSQLParseError{
-			Number:  err.Number,
-			Offset:  err.Offset,
-			Line:    err.Line + line,
-			Column:  err.Column,
-			Message: err.Message,
-		})
+	parser := receiver.getPostgreSQLParser(routineBodySource(sConstContext, receiver.sourceMap))
+	var result antlr.ParserRuleContext
+	if lang == "plpgsql" {
+		result = parser.Plsqlroot()
+	} else {
+		result = parser.Root()
 	}
+	receiver.parseErrors = append(receiver.parseErrors, parser.parseErrors...)
+	return result
 }
 
-func TrimQuotes(s string) string {
-	if s == "" {
-		return s
+// routineLanguage returns the lower-case language name of a LANGUAGE clause,
+// which may be an identifier or a string.
+func routineLanguage(ctx INonreservedword_or_sconstContext) string {
+	if sConstContext, ok := ctx.Sconst().(*SconstContext); ok {
+		return strings.ToLower(GetRoutineBodyString(sConstContext))
 	}
-	return s[1 : len(s)-2]
+	return identifierName(ctx.GetText())
 }
 
-func unquote(s string) string {
-	result := strings.Builder{}
-	length := len(s)
-	index := 0
-	for index < length {
-		c := s[index]
-		result.WriteByte(c)
-		if c == '\'' && index < length-1 && (s[index+1] == '\'') {
-			index++
+// GetRoutineBody returns the body of a function or procedure: the tree parsed
+// from its AS string, or the statements of a BEGIN ATOMIC body. It returns nil
+// if the body was not parsed, e.g. because the language is not plpgsql or sql.
+func GetRoutineBody(ctx ICreatefunctionstmtContext) antlr.ParserRuleContext {
+	for _, item := range ctx.Createfunc_opt_list().AllCreatefunc_opt_item() {
+		if atomic := item.Stmtmulti(); atomic != nil {
+			return atomic
+		}
+		if as, ok := item.Func_as().(*Func_asContext); ok && as.Definition != nil {
+			return as.Definition
 		}
-		index++
 	}
-	return result.String()
+	return nil
 }
 
-func GetRoutineBodyString(rule *SconstContext) string {
-	if rule.Anysconst() == nil {
-		return ""
+// GetDoBody returns the tree parsed from the body of a DO statement, or nil if
+// the body was not parsed.
+func GetDoBody(ctx IDostmtContext) antlr.ParserRuleContext {
+	for _, item := range ctx.Dostmt_opt_list().AllDostmt_opt_item() {
+		if body, ok := item.(*Dostmt_opt_itemContext); ok && body.Definition != nil {
+			return body.Definition
+		}
 	}
-	anySConstContext := rule.Anysconst().(*AnysconstContext)
+	return nil
+}
 
-	stringConstant := anySConstContext.StringConstant()
-	if stringConstant != nil {
-		return unquote(TrimQuotes(stringConstant.GetText()))
+// GetDynamicSQL returns the tree parsed from the command string of a PL/pgSQL
+// EXECUTE, or the syntax errors that kept it from being parsed. Both are nil
+// if the command has no constant part.
+func GetDynamicSQL(ctx IStmt_dynexecuteContext) (antlr.ParserRuleContext, []*PostgreSQLParseError) {
+	if execute, ok := ctx.(*Stmt_dynexecuteContext); ok {
+		return execute.Definition, execute.DefinitionErrors
 	}
+	return nil, nil
+}
 
-	unicodeEscapeStringConstant := anySConstContext.UnicodeEscapeStringConstant()
-	if unicodeEscapeStringConstant != nil {
-		return TrimQuotes(unicodeEscapeStringConstant.GetText())
+// GetDynamicSQLVersionErrors returns the syntax in the command string of a
+// PL/pgSQL EXECUTE that the parser's TargetVersion does not support.
+func GetDynamicSQLVersionErrors(ctx IStmt_dynexecuteContext) []*VersionError {
+	if execute, ok := ctx.(*Stmt_dynexecuteContext); ok {
+		return execute.DefinitionVersionErrors
 	}
+	return nil
+}
 
-	escapeStringConstant := anySConstContext.EscapeStringConstant()
-	if escapeStringConstant != nil {
-		return TrimQuotes(escapeStringConstant.GetText())
+func TrimQuotes(s string) string {
+	if len(s) < 2 {
+		return s
 	}
+	return s[1 : len(s)-1]
+}
 
-	result := strings.Builder{}
-	for _, node := range anySConstContext.AllDollarText() {
-		result.WriteString(node.GetText())
-	}
-	return result.String()
+func GetRoutineBodyString(rule *SconstContext) string {
+	text, _ := routineBodySource(rule, nil)
+	return text
 }
 
-func getPostgreSQLParser(script string) *PostgreSQLParser {
+// getPostgreSQLParser returns a parser for a script nested in the one being
+// parsed, with the same engine and target version.
+func (receiver *PostgreSQLParserBase) getPostgreSQLParser(script string, sourceMap *sourceMap) *PostgreSQLParser {
 	stream := antlr.NewInputStream(script)
 	lexer := NewPostgreSQLLexer(stream)
-	tokenStream := antlr.NewCommonTokenStream(lexer, 0)
+	tokenStream := antlr.NewCommonTokenStream(&sourceMapLexer{PostgreSQLLexer: lexer, sourceMap: sourceMap}, 0)
 	parser := NewPostgreSQLParser(tokenStream)
+	parser.Engine = receiver.Engine
+	parser.TargetVersion = receiver.TargetVersion
+	parser.sourceMap = sourceMap
 	errorListener := new(PostgreSQLParserErrorListener)
 	errorListener.grammar = parser
 	parser.AddErrorListener(errorListener)
@@ -0,0 +1,87 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func parseStatement(statement string) (*pgparser.PostgreSQLParser, pgparser.IRootContext) {
+	lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	return parser, parser.Root()
+}
+
+func firstStmt(tree pgparser.IRootContext) pgparser.IStmtContext {
+	return tree.Stmtblock().Stmtmulti().Stmt(0)
+}
+
+func TestParseRoutineBody(t *testing.T) {
+	_, tree := parseStatement("CREATE PROCEDURE p() LANGUAGE 'plpgsql' AS 'BEGIN PERFORM 1; END';")
+	body := pgparser.GetRoutineBody(firstStmt(tree).Createfunctionstmt())
+	require.IsType(t, &pgparser.PlsqlrootContext{}, body)
+
+	_, tree = parseStatement("CREATE FUNCTION f(a int) RETURNS int LANGUAGE sql AS $fn$ SELECT a + 1 $fn$;")
+	body = pgparser.GetRoutineBody(firstStmt(tree).Createfunctionstmt())
+	require.IsType(t, &pgparser.RootContext{}, body)
+
+	_, tree = parseStatement("CREATE FUNCTION f(a int) RETURNS int LANGUAGE sql BEGIN ATOMIC SELECT a + 1; END;")
+	body = pgparser.GetRoutineBody(firstStmt(tree).Createfunctionstmt())
+	require.IsType(t, &pgparser.StmtmultiContext{}, body)
+
+	_, tree = parseStatement("CREATE FUNCTION f() RETURNS int LANGUAGE plpython3u AS 'return 1';")
+	require.Nil(t, pgparser.GetRoutineBody(firstStmt(tree).Createfunctionstmt()))
+
+	_, tree = parseStatement("DO $$BEGIN PERFORM 1; END$$;")
+	require.IsType(t, &pgparser.PlsqlrootContext{}, pgparser.GetDoBody(firstStmt(tree).Dostmt()))
+}
+
+func TestRoutineBodyErrorPositions(t *testing.T) {
+	parser, _ := parseStatement("CREATE FUNCTION f() RETURNS int AS $$\nBEGIN\n  RETURN (1;\nEND $$ LANGUAGE plpgsql;")
+	require.NotEmpty(t, parser.ParseErrors())
+	require.Equal(t, 3, parser.ParseErrors()[0].Line)
+	require.Equal(t, 11, parser.ParseErrors()[0].Column)
+
+	// Errors on the first line of a body are shifted by the column the body starts at.
+	parser, _ = parseStatement("DO $$BEGIN PERFORM (1; END$$;")
+	require.NotEmpty(t, parser.ParseErrors())
+	require.Equal(t, 1, parser.ParseErrors()[0].Line)
+	require.Equal(t, 21, parser.ParseErrors()[0].Column)
+}
+
+func TestRoutineBodySourcePositions(t *testing.T) {
+	// Unescaping '' inside a single-quoted body does not shift positions.
+	parser, _ := parseStatement("CREATE FUNCTION f() RETURNS text AS 'BEGIN\n  RETURN ''a'' || (1;\nEND' LANGUAGE plpgsql;")
+	require.NotEmpty(t, parser.ParseErrors())
+	require.Equal(t, 2, parser.ParseErrors()[0].Line)
+	require.Equal(t, 18, parser.ParseErrors()[0].Column)
+	require.Equal(t, 61, parser.ParseErrors()[0].Offset)
+
+	// Escapes in an E'' body are decoded, and each decoded character keeps
+	// the position of its escape.
+	parser, _ = parseStatement(`CREATE FUNCTION f() RETURNS text AS E'BEGIN RETURN \'it\'\'s\'; END' LANGUAGE plpgsql;`)
+	require.Empty(t, parser.ParseErrors())
+	parser, _ = parseStatement("CREATE FUNCTION f() RETURNS text AS E'BEGIN\n  RETURN \\'a\\\\b\\n\\' || ''c'' || (1;\nEND' LANGUAGE plpgsql;")
+	require.NotEmpty(t, parser.ParseErrors())
+	require.Equal(t, 2, parser.ParseErrors()[0].Line)
+	require.Equal(t, 32, parser.ParseErrors()[0].Column)
+	require.Equal(t, 76, parser.ParseErrors()[0].Offset)
+
+	// A DO body nested in a function body is mapped through both literals.
+	parser, _ = parseStatement("CREATE FUNCTION f() RETURNS void LANGUAGE sql AS\n'SELECT ''x''; DO $$BEGIN PERFORM (1; END$$';")
+	require.NotEmpty(t, parser.ParseErrors())
+	require.Equal(t, 2, parser.ParseErrors()[0].Line)
+	require.Equal(t, 36, parser.ParseErrors()[0].Column)
+	require.Equal(t, 85, parser.ParseErrors()[0].Offset)
+
+	// Tokens of a parsed body are positioned in the script too.
+	_, tree := parseStatement("CREATE FUNCTION f() RETURNS int LANGUAGE sql AS\n  'SELECT 1';")
+	body := pgparser.GetRoutineBody(firstStmt(tree).Createfunctionstmt())
+	require.NotNil(t, body)
+	require.Equal(t, 2, body.GetStart().GetLine())
+	require.Equal(t, 3, body.GetStart().GetColumn())
+	require.Equal(t, "SELECT", body.GetStart().GetText())
+}
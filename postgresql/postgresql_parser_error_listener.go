@@ -9,9 +9,13 @@ type PostgreSQLParserErrorListener struct {
 var _ antlr.ErrorListener = &PostgreSQLParserErrorListener{}
 
 func (receiver PostgreSQLParserErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	offset := 0
+	if token, ok := offendingSymbol.(antlr.Token); ok {
+		offset = sourcePositionOf(token, receiver.grammar.sourceMap).offset
+	}
 	receiver.grammar.parseErrors = append(receiver.grammar.parseErrors, &PostgreSQLParseError{
 		Number:  0,
-		Offset:  0,
+		Offset:  offset,
 		Line:    line,
 		Column:  column,
 		Message: msg,
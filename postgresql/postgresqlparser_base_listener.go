@@ -1855,13 +1855,23 @@ func (s *BasePostgreSQLParserListener) EnterRevokerolestmt(ctx *RevokerolestmtCo
 // ExitRevokerolestmt is called when production revokerolestmt is exited.
 func (s *BasePostgreSQLParserListener) ExitRevokerolestmt(ctx *RevokerolestmtContext) {}
 
-// EnterOpt_grant_admin_option is called when production opt_grant_admin_option is entered.
-func (s *BasePostgreSQLParserListener) EnterOpt_grant_admin_option(ctx *Opt_grant_admin_optionContext) {
-}
+// EnterGrant_role_opt_list is called when production grant_role_opt_list is entered.
+func (s *BasePostgreSQLParserListener) EnterGrant_role_opt_list(ctx *Grant_role_opt_listContext) {}
 
-// ExitOpt_grant_admin_option is called when production opt_grant_admin_option is exited.
-func (s *BasePostgreSQLParserListener) ExitOpt_grant_admin_option(ctx *Opt_grant_admin_optionContext) {
-}
+// ExitGrant_role_opt_list is called when production grant_role_opt_list is exited.
+func (s *BasePostgreSQLParserListener) ExitGrant_role_opt_list(ctx *Grant_role_opt_listContext) {}
+
+// EnterGrant_role_opt is called when production grant_role_opt is entered.
+func (s *BasePostgreSQLParserListener) EnterGrant_role_opt(ctx *Grant_role_optContext) {}
+
+// ExitGrant_role_opt is called when production grant_role_opt is exited.
+func (s *BasePostgreSQLParserListener) ExitGrant_role_opt(ctx *Grant_role_optContext) {}
+
+// EnterGrant_role_opt_value is called when production grant_role_opt_value is entered.
+func (s *BasePostgreSQLParserListener) EnterGrant_role_opt_value(ctx *Grant_role_opt_valueContext) {}
+
+// ExitGrant_role_opt_value is called when production grant_role_opt_value is exited.
+func (s *BasePostgreSQLParserListener) ExitGrant_role_opt_value(ctx *Grant_role_opt_valueContext) {}
 
 // EnterOpt_granted_by is called when production opt_granted_by is entered.
 func (s *BasePostgreSQLParserListener) EnterOpt_granted_by(ctx *Opt_granted_byContext) {}
@@ -2966,23 +2976,65 @@ func (s *BasePostgreSQLParserListener) EnterMergestmt(ctx *MergestmtContext) {}
 // ExitMergestmt is called when production mergestmt is exited.
 func (s *BasePostgreSQLParserListener) ExitMergestmt(ctx *MergestmtContext) {}
 
-// EnterMerge_insert_clause is called when production merge_insert_clause is entered.
-func (s *BasePostgreSQLParserListener) EnterMerge_insert_clause(ctx *Merge_insert_clauseContext) {}
+// EnterMerge_when_list is called when production merge_when_list is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_when_list(ctx *Merge_when_listContext) {}
+
+// ExitMerge_when_list is called when production merge_when_list is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_when_list(ctx *Merge_when_listContext) {}
+
+// EnterMerge_when_clause is called when production merge_when_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_when_clause(ctx *Merge_when_clauseContext) {}
+
+// ExitMerge_when_clause is called when production merge_when_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_when_clause(ctx *Merge_when_clauseContext) {}
+
+// EnterMerge_when_tgt_matched is called when production merge_when_tgt_matched is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_when_tgt_matched(ctx *Merge_when_tgt_matchedContext) {
+}
+
+// ExitMerge_when_tgt_matched is called when production merge_when_tgt_matched is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_when_tgt_matched(ctx *Merge_when_tgt_matchedContext) {
+}
+
+// EnterMerge_when_tgt_not_matched is called when production merge_when_tgt_not_matched is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_when_tgt_not_matched(ctx *Merge_when_tgt_not_matchedContext) {
+}
+
+// ExitMerge_when_tgt_not_matched is called when production merge_when_tgt_not_matched is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_when_tgt_not_matched(ctx *Merge_when_tgt_not_matchedContext) {
+}
+
+// EnterOpt_merge_when_condition is called when production opt_merge_when_condition is entered.
+func (s *BasePostgreSQLParserListener) EnterOpt_merge_when_condition(ctx *Opt_merge_when_conditionContext) {
+}
+
+// ExitOpt_merge_when_condition is called when production opt_merge_when_condition is exited.
+func (s *BasePostgreSQLParserListener) ExitOpt_merge_when_condition(ctx *Opt_merge_when_conditionContext) {
+}
 
-// ExitMerge_insert_clause is called when production merge_insert_clause is exited.
-func (s *BasePostgreSQLParserListener) ExitMerge_insert_clause(ctx *Merge_insert_clauseContext) {}
+// EnterMerge_update is called when production merge_update is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_update(ctx *Merge_updateContext) {}
 
-// EnterMerge_update_clause is called when production merge_update_clause is entered.
-func (s *BasePostgreSQLParserListener) EnterMerge_update_clause(ctx *Merge_update_clauseContext) {}
+// ExitMerge_update is called when production merge_update is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_update(ctx *Merge_updateContext) {}
 
-// ExitMerge_update_clause is called when production merge_update_clause is exited.
-func (s *BasePostgreSQLParserListener) ExitMerge_update_clause(ctx *Merge_update_clauseContext) {}
+// EnterMerge_delete is called when production merge_delete is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_delete(ctx *Merge_deleteContext) {}
 
-// EnterMerge_delete_clause is called when production merge_delete_clause is entered.
-func (s *BasePostgreSQLParserListener) EnterMerge_delete_clause(ctx *Merge_delete_clauseContext) {}
+// ExitMerge_delete is called when production merge_delete is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_delete(ctx *Merge_deleteContext) {}
 
-// ExitMerge_delete_clause is called when production merge_delete_clause is exited.
-func (s *BasePostgreSQLParserListener) ExitMerge_delete_clause(ctx *Merge_delete_clauseContext) {}
+// EnterMerge_insert is called when production merge_insert is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_insert(ctx *Merge_insertContext) {}
+
+// ExitMerge_insert is called when production merge_insert is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_insert(ctx *Merge_insertContext) {}
+
+// EnterMerge_values_clause is called when production merge_values_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterMerge_values_clause(ctx *Merge_values_clauseContext) {}
+
+// ExitMerge_values_clause is called when production merge_values_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitMerge_values_clause(ctx *Merge_values_clauseContext) {}
 
 // EnterDeletestmt is called when production deletestmt is entered.
 func (s *BasePostgreSQLParserListener) EnterDeletestmt(ctx *DeletestmtContext) {}
@@ -3560,6 +3612,36 @@ func (s *BasePostgreSQLParserListener) EnterXmltable_column_option_el(ctx *Xmlta
 func (s *BasePostgreSQLParserListener) ExitXmltable_column_option_el(ctx *Xmltable_column_option_elContext) {
 }
 
+// EnterJson_table is called when production json_table is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_table(ctx *Json_tableContext) {}
+
+// ExitJson_table is called when production json_table is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_table(ctx *Json_tableContext) {}
+
+// EnterJson_table_column_definition_list is called when production json_table_column_definition_list is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_table_column_definition_list(ctx *Json_table_column_definition_listContext) {
+}
+
+// ExitJson_table_column_definition_list is called when production json_table_column_definition_list is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_table_column_definition_list(ctx *Json_table_column_definition_listContext) {
+}
+
+// EnterJson_table_column_definition is called when production json_table_column_definition is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_table_column_definition(ctx *Json_table_column_definitionContext) {
+}
+
+// ExitJson_table_column_definition is called when production json_table_column_definition is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_table_column_definition(ctx *Json_table_column_definitionContext) {
+}
+
+// EnterJson_table_column_path_clause is called when production json_table_column_path_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_table_column_path_clause(ctx *Json_table_column_path_clauseContext) {
+}
+
+// ExitJson_table_column_path_clause is called when production json_table_column_path_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_table_column_path_clause(ctx *Json_table_column_path_clauseContext) {
+}
+
 // EnterXml_namespace_list is called when production xml_namespace_list is entered.
 func (s *BasePostgreSQLParserListener) EnterXml_namespace_list(ctx *Xml_namespace_listContext) {}
 
@@ -3900,6 +3982,118 @@ func (s *BasePostgreSQLParserListener) EnterOpt_xml_root_standalone(ctx *Opt_xml
 func (s *BasePostgreSQLParserListener) ExitOpt_xml_root_standalone(ctx *Opt_xml_root_standaloneContext) {
 }
 
+// EnterJson_value_expr is called when production json_value_expr is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_value_expr(ctx *Json_value_exprContext) {}
+
+// ExitJson_value_expr is called when production json_value_expr is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_value_expr(ctx *Json_value_exprContext) {}
+
+// EnterJson_value_expr_list is called when production json_value_expr_list is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_value_expr_list(ctx *Json_value_expr_listContext) {}
+
+// ExitJson_value_expr_list is called when production json_value_expr_list is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_value_expr_list(ctx *Json_value_expr_listContext) {}
+
+// EnterJson_format_clause is called when production json_format_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_format_clause(ctx *Json_format_clauseContext) {}
+
+// ExitJson_format_clause is called when production json_format_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_format_clause(ctx *Json_format_clauseContext) {}
+
+// EnterJson_returning_clause is called when production json_returning_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_returning_clause(ctx *Json_returning_clauseContext) {
+}
+
+// ExitJson_returning_clause is called when production json_returning_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_returning_clause(ctx *Json_returning_clauseContext) {}
+
+// EnterJson_name_and_value_list is called when production json_name_and_value_list is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_name_and_value_list(ctx *Json_name_and_value_listContext) {
+}
+
+// ExitJson_name_and_value_list is called when production json_name_and_value_list is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_name_and_value_list(ctx *Json_name_and_value_listContext) {
+}
+
+// EnterJson_name_and_value is called when production json_name_and_value is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_name_and_value(ctx *Json_name_and_valueContext) {}
+
+// ExitJson_name_and_value is called when production json_name_and_value is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_name_and_value(ctx *Json_name_and_valueContext) {}
+
+// EnterJson_constructor_null_clause is called when production json_constructor_null_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_constructor_null_clause(ctx *Json_constructor_null_clauseContext) {
+}
+
+// ExitJson_constructor_null_clause is called when production json_constructor_null_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_constructor_null_clause(ctx *Json_constructor_null_clauseContext) {
+}
+
+// EnterJson_key_uniqueness_constraint is called when production json_key_uniqueness_constraint is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_key_uniqueness_constraint(ctx *Json_key_uniqueness_constraintContext) {
+}
+
+// ExitJson_key_uniqueness_constraint is called when production json_key_uniqueness_constraint is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_key_uniqueness_constraint(ctx *Json_key_uniqueness_constraintContext) {
+}
+
+// EnterJson_predicate_type_constraint is called when production json_predicate_type_constraint is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_predicate_type_constraint(ctx *Json_predicate_type_constraintContext) {
+}
+
+// ExitJson_predicate_type_constraint is called when production json_predicate_type_constraint is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_predicate_type_constraint(ctx *Json_predicate_type_constraintContext) {
+}
+
+// EnterJson_passing_clause is called when production json_passing_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_passing_clause(ctx *Json_passing_clauseContext) {}
+
+// ExitJson_passing_clause is called when production json_passing_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_passing_clause(ctx *Json_passing_clauseContext) {}
+
+// EnterJson_argument is called when production json_argument is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_argument(ctx *Json_argumentContext) {}
+
+// ExitJson_argument is called when production json_argument is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_argument(ctx *Json_argumentContext) {}
+
+// EnterJson_wrapper_behavior is called when production json_wrapper_behavior is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_wrapper_behavior(ctx *Json_wrapper_behaviorContext) {
+}
+
+// ExitJson_wrapper_behavior is called when production json_wrapper_behavior is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_wrapper_behavior(ctx *Json_wrapper_behaviorContext) {}
+
+// EnterJson_quotes_clause is called when production json_quotes_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_quotes_clause(ctx *Json_quotes_clauseContext) {}
+
+// ExitJson_quotes_clause is called when production json_quotes_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_quotes_clause(ctx *Json_quotes_clauseContext) {}
+
+// EnterJson_behavior is called when production json_behavior is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_behavior(ctx *Json_behaviorContext) {}
+
+// ExitJson_behavior is called when production json_behavior is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_behavior(ctx *Json_behaviorContext) {}
+
+// EnterJson_behavior_clause is called when production json_behavior_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_behavior_clause(ctx *Json_behavior_clauseContext) {}
+
+// ExitJson_behavior_clause is called when production json_behavior_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_behavior_clause(ctx *Json_behavior_clauseContext) {}
+
+// EnterJson_on_error_clause is called when production json_on_error_clause is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_on_error_clause(ctx *Json_on_error_clauseContext) {}
+
+// ExitJson_on_error_clause is called when production json_on_error_clause is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_on_error_clause(ctx *Json_on_error_clauseContext) {}
+
+// EnterJson_aggregate_func is called when production json_aggregate_func is entered.
+func (s *BasePostgreSQLParserListener) EnterJson_aggregate_func(ctx *Json_aggregate_funcContext) {}
+
+// ExitJson_aggregate_func is called when production json_aggregate_func is exited.
+func (s *BasePostgreSQLParserListener) ExitJson_aggregate_func(ctx *Json_aggregate_funcContext) {}
+
 // EnterXml_attributes is called when production xml_attributes is entered.
 func (s *BasePostgreSQLParserListener) EnterXml_attributes(ctx *Xml_attributesContext) {}
 
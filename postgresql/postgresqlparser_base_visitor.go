@@ -1171,7 +1171,15 @@ func (v *BasePostgreSQLParserVisitor) VisitRevokerolestmt(ctx *RevokerolestmtCon
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePostgreSQLParserVisitor) VisitOpt_grant_admin_option(ctx *Opt_grant_admin_optionContext) interface{} {
+func (v *BasePostgreSQLParserVisitor) VisitGrant_role_opt_list(ctx *Grant_role_opt_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitGrant_role_opt(ctx *Grant_role_optContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitGrant_role_opt_value(ctx *Grant_role_opt_valueContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -1871,15 +1879,39 @@ func (v *BasePostgreSQLParserVisitor) VisitMergestmt(ctx *MergestmtContext) inte
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePostgreSQLParserVisitor) VisitMerge_insert_clause(ctx *Merge_insert_clauseContext) interface{} {
+func (v *BasePostgreSQLParserVisitor) VisitMerge_when_list(ctx *Merge_when_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitMerge_when_clause(ctx *Merge_when_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitMerge_when_tgt_matched(ctx *Merge_when_tgt_matchedContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitMerge_when_tgt_not_matched(ctx *Merge_when_tgt_not_matchedContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitOpt_merge_when_condition(ctx *Opt_merge_when_conditionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitMerge_update(ctx *Merge_updateContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitMerge_delete(ctx *Merge_deleteContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePostgreSQLParserVisitor) VisitMerge_update_clause(ctx *Merge_update_clauseContext) interface{} {
+func (v *BasePostgreSQLParserVisitor) VisitMerge_insert(ctx *Merge_insertContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePostgreSQLParserVisitor) VisitMerge_delete_clause(ctx *Merge_delete_clauseContext) interface{} {
+func (v *BasePostgreSQLParserVisitor) VisitMerge_values_clause(ctx *Merge_values_clauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -2255,6 +2287,22 @@ func (v *BasePostgreSQLParserVisitor) VisitXmltable_column_option_el(ctx *Xmltab
 	return v.VisitChildren(ctx)
 }
 
+func (v *BasePostgreSQLParserVisitor) VisitJson_table(ctx *Json_tableContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_table_column_definition_list(ctx *Json_table_column_definition_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_table_column_definition(ctx *Json_table_column_definitionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_table_column_path_clause(ctx *Json_table_column_path_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BasePostgreSQLParserVisitor) VisitXml_namespace_list(ctx *Xml_namespace_listContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -2479,6 +2527,74 @@ func (v *BasePostgreSQLParserVisitor) VisitOpt_xml_root_standalone(ctx *Opt_xml_
 	return v.VisitChildren(ctx)
 }
 
+func (v *BasePostgreSQLParserVisitor) VisitJson_value_expr(ctx *Json_value_exprContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_value_expr_list(ctx *Json_value_expr_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_format_clause(ctx *Json_format_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_returning_clause(ctx *Json_returning_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_name_and_value_list(ctx *Json_name_and_value_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_name_and_value(ctx *Json_name_and_valueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_constructor_null_clause(ctx *Json_constructor_null_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_key_uniqueness_constraint(ctx *Json_key_uniqueness_constraintContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_predicate_type_constraint(ctx *Json_predicate_type_constraintContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_passing_clause(ctx *Json_passing_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_argument(ctx *Json_argumentContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_wrapper_behavior(ctx *Json_wrapper_behaviorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_quotes_clause(ctx *Json_quotes_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_behavior(ctx *Json_behaviorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_behavior_clause(ctx *Json_behavior_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_on_error_clause(ctx *Json_on_error_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePostgreSQLParserVisitor) VisitJson_aggregate_func(ctx *Json_aggregate_funcContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BasePostgreSQLParserVisitor) VisitXml_attributes(ctx *Xml_attributesContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -880,8 +880,14 @@ type PostgreSQLParserListener interface {
 	// EnterRevokerolestmt is called when entering the revokerolestmt production.
 	EnterRevokerolestmt(c *RevokerolestmtContext)
 
-	// EnterOpt_grant_admin_option is called when entering the opt_grant_admin_option production.
-	EnterOpt_grant_admin_option(c *Opt_grant_admin_optionContext)
+	// EnterGrant_role_opt_list is called when entering the grant_role_opt_list production.
+	EnterGrant_role_opt_list(c *Grant_role_opt_listContext)
+
+	// EnterGrant_role_opt is called when entering the grant_role_opt production.
+	EnterGrant_role_opt(c *Grant_role_optContext)
+
+	// EnterGrant_role_opt_value is called when entering the grant_role_opt_value production.
+	EnterGrant_role_opt_value(c *Grant_role_opt_valueContext)
 
 	// EnterOpt_granted_by is called when entering the opt_granted_by production.
 	EnterOpt_granted_by(c *Opt_granted_byContext)
@@ -1405,14 +1411,32 @@ type PostgreSQLParserListener interface {
 	// EnterMergestmt is called when entering the mergestmt production.
 	EnterMergestmt(c *MergestmtContext)
 
-	// EnterMerge_insert_clause is called when entering the merge_insert_clause production.
-	EnterMerge_insert_clause(c *Merge_insert_clauseContext)
+	// EnterMerge_when_list is called when entering the merge_when_list production.
+	EnterMerge_when_list(c *Merge_when_listContext)
+
+	// EnterMerge_when_clause is called when entering the merge_when_clause production.
+	EnterMerge_when_clause(c *Merge_when_clauseContext)
+
+	// EnterMerge_when_tgt_matched is called when entering the merge_when_tgt_matched production.
+	EnterMerge_when_tgt_matched(c *Merge_when_tgt_matchedContext)
+
+	// EnterMerge_when_tgt_not_matched is called when entering the merge_when_tgt_not_matched production.
+	EnterMerge_when_tgt_not_matched(c *Merge_when_tgt_not_matchedContext)
+
+	// EnterOpt_merge_when_condition is called when entering the opt_merge_when_condition production.
+	EnterOpt_merge_when_condition(c *Opt_merge_when_conditionContext)
+
+	// EnterMerge_update is called when entering the merge_update production.
+	EnterMerge_update(c *Merge_updateContext)
+
+	// EnterMerge_delete is called when entering the merge_delete production.
+	EnterMerge_delete(c *Merge_deleteContext)
 
-	// EnterMerge_update_clause is called when entering the merge_update_clause production.
-	EnterMerge_update_clause(c *Merge_update_clauseContext)
+	// EnterMerge_insert is called when entering the merge_insert production.
+	EnterMerge_insert(c *Merge_insertContext)
 
-	// EnterMerge_delete_clause is called when entering the merge_delete_clause production.
-	EnterMerge_delete_clause(c *Merge_delete_clauseContext)
+	// EnterMerge_values_clause is called when entering the merge_values_clause production.
+	EnterMerge_values_clause(c *Merge_values_clauseContext)
 
 	// EnterDeletestmt is called when entering the deletestmt production.
 	EnterDeletestmt(c *DeletestmtContext)
@@ -1693,6 +1717,18 @@ type PostgreSQLParserListener interface {
 	// EnterXmltable_column_option_el is called when entering the xmltable_column_option_el production.
 	EnterXmltable_column_option_el(c *Xmltable_column_option_elContext)
 
+	// EnterJson_table is called when entering the json_table production.
+	EnterJson_table(c *Json_tableContext)
+
+	// EnterJson_table_column_definition_list is called when entering the json_table_column_definition_list production.
+	EnterJson_table_column_definition_list(c *Json_table_column_definition_listContext)
+
+	// EnterJson_table_column_definition is called when entering the json_table_column_definition production.
+	EnterJson_table_column_definition(c *Json_table_column_definitionContext)
+
+	// EnterJson_table_column_path_clause is called when entering the json_table_column_path_clause production.
+	EnterJson_table_column_path_clause(c *Json_table_column_path_clauseContext)
+
 	// EnterXml_namespace_list is called when entering the xml_namespace_list production.
 	EnterXml_namespace_list(c *Xml_namespace_listContext)
 
@@ -1861,6 +1897,57 @@ type PostgreSQLParserListener interface {
 	// EnterOpt_xml_root_standalone is called when entering the opt_xml_root_standalone production.
 	EnterOpt_xml_root_standalone(c *Opt_xml_root_standaloneContext)
 
+	// EnterJson_value_expr is called when entering the json_value_expr production.
+	EnterJson_value_expr(c *Json_value_exprContext)
+
+	// EnterJson_value_expr_list is called when entering the json_value_expr_list production.
+	EnterJson_value_expr_list(c *Json_value_expr_listContext)
+
+	// EnterJson_format_clause is called when entering the json_format_clause production.
+	EnterJson_format_clause(c *Json_format_clauseContext)
+
+	// EnterJson_returning_clause is called when entering the json_returning_clause production.
+	EnterJson_returning_clause(c *Json_returning_clauseContext)
+
+	// EnterJson_name_and_value_list is called when entering the json_name_and_value_list production.
+	EnterJson_name_and_value_list(c *Json_name_and_value_listContext)
+
+	// EnterJson_name_and_value is called when entering the json_name_and_value production.
+	EnterJson_name_and_value(c *Json_name_and_valueContext)
+
+	// EnterJson_constructor_null_clause is called when entering the json_constructor_null_clause production.
+	EnterJson_constructor_null_clause(c *Json_constructor_null_clauseContext)
+
+	// EnterJson_key_uniqueness_constraint is called when entering the json_key_uniqueness_constraint production.
+	EnterJson_key_uniqueness_constraint(c *Json_key_uniqueness_constraintContext)
+
+	// EnterJson_predicate_type_constraint is called when entering the json_predicate_type_constraint production.
+	EnterJson_predicate_type_constraint(c *Json_predicate_type_constraintContext)
+
+	// EnterJson_passing_clause is called when entering the json_passing_clause production.
+	EnterJson_passing_clause(c *Json_passing_clauseContext)
+
+	// EnterJson_argument is called when entering the json_argument production.
+	EnterJson_argument(c *Json_argumentContext)
+
+	// EnterJson_wrapper_behavior is called when entering the json_wrapper_behavior production.
+	EnterJson_wrapper_behavior(c *Json_wrapper_behaviorContext)
+
+	// EnterJson_quotes_clause is called when entering the json_quotes_clause production.
+	EnterJson_quotes_clause(c *Json_quotes_clauseContext)
+
+	// EnterJson_behavior is called when entering the json_behavior production.
+	EnterJson_behavior(c *Json_behaviorContext)
+
+	// EnterJson_behavior_clause is called when entering the json_behavior_clause production.
+	EnterJson_behavior_clause(c *Json_behavior_clauseContext)
+
+	// EnterJson_on_error_clause is called when entering the json_on_error_clause production.
+	EnterJson_on_error_clause(c *Json_on_error_clauseContext)
+
+	// EnterJson_aggregate_func is called when entering the json_aggregate_func production.
+	EnterJson_aggregate_func(c *Json_aggregate_funcContext)
+
 	// EnterXml_attributes is called when entering the xml_attributes production.
 	EnterXml_attributes(c *Xml_attributesContext)
 
@@ -3346,8 +3433,14 @@ type PostgreSQLParserListener interface {
 	// ExitRevokerolestmt is called when exiting the revokerolestmt production.
 	ExitRevokerolestmt(c *RevokerolestmtContext)
 
-	// ExitOpt_grant_admin_option is called when exiting the opt_grant_admin_option production.
-	ExitOpt_grant_admin_option(c *Opt_grant_admin_optionContext)
+	// ExitGrant_role_opt_list is called when exiting the grant_role_opt_list production.
+	ExitGrant_role_opt_list(c *Grant_role_opt_listContext)
+
+	// ExitGrant_role_opt is called when exiting the grant_role_opt production.
+	ExitGrant_role_opt(c *Grant_role_optContext)
+
+	// ExitGrant_role_opt_value is called when exiting the grant_role_opt_value production.
+	ExitGrant_role_opt_value(c *Grant_role_opt_valueContext)
 
 	// ExitOpt_granted_by is called when exiting the opt_granted_by production.
 	ExitOpt_granted_by(c *Opt_granted_byContext)
@@ -3871,14 +3964,32 @@ type PostgreSQLParserListener interface {
 	// ExitMergestmt is called when exiting the mergestmt production.
 	ExitMergestmt(c *MergestmtContext)
 
-	// ExitMerge_insert_clause is called when exiting the merge_insert_clause production.
-	ExitMerge_insert_clause(c *Merge_insert_clauseContext)
+	// ExitMerge_when_list is called when exiting the merge_when_list production.
+	ExitMerge_when_list(c *Merge_when_listContext)
+
+	// ExitMerge_when_clause is called when exiting the merge_when_clause production.
+	ExitMerge_when_clause(c *Merge_when_clauseContext)
+
+	// ExitMerge_when_tgt_matched is called when exiting the merge_when_tgt_matched production.
+	ExitMerge_when_tgt_matched(c *Merge_when_tgt_matchedContext)
+
+	// ExitMerge_when_tgt_not_matched is called when exiting the merge_when_tgt_not_matched production.
+	ExitMerge_when_tgt_not_matched(c *Merge_when_tgt_not_matchedContext)
+
+	// ExitOpt_merge_when_condition is called when exiting the opt_merge_when_condition production.
+	ExitOpt_merge_when_condition(c *Opt_merge_when_conditionContext)
+
+	// ExitMerge_update is called when exiting the merge_update production.
+	ExitMerge_update(c *Merge_updateContext)
+
+	// ExitMerge_delete is called when exiting the merge_delete production.
+	ExitMerge_delete(c *Merge_deleteContext)
 
-	// ExitMerge_update_clause is called when exiting the merge_update_clause production.
-	ExitMerge_update_clause(c *Merge_update_clauseContext)
+	// ExitMerge_insert is called when exiting the merge_insert production.
+	ExitMerge_insert(c *Merge_insertContext)
 
-	// ExitMerge_delete_clause is called when exiting the merge_delete_clause production.
-	ExitMerge_delete_clause(c *Merge_delete_clauseContext)
+	// ExitMerge_values_clause is called when exiting the merge_values_clause production.
+	ExitMerge_values_clause(c *Merge_values_clauseContext)
 
 	// ExitDeletestmt is called when exiting the deletestmt production.
 	ExitDeletestmt(c *DeletestmtContext)
@@ -4159,6 +4270,18 @@ type PostgreSQLParserListener interface {
 	// ExitXmltable_column_option_el is called when exiting the xmltable_column_option_el production.
 	ExitXmltable_column_option_el(c *Xmltable_column_option_elContext)
 
+	// ExitJson_table is called when exiting the json_table production.
+	ExitJson_table(c *Json_tableContext)
+
+	// ExitJson_table_column_definition_list is called when exiting the json_table_column_definition_list production.
+	ExitJson_table_column_definition_list(c *Json_table_column_definition_listContext)
+
+	// ExitJson_table_column_definition is called when exiting the json_table_column_definition production.
+	ExitJson_table_column_definition(c *Json_table_column_definitionContext)
+
+	// ExitJson_table_column_path_clause is called when exiting the json_table_column_path_clause production.
+	ExitJson_table_column_path_clause(c *Json_table_column_path_clauseContext)
+
 	// ExitXml_namespace_list is called when exiting the xml_namespace_list production.
 	ExitXml_namespace_list(c *Xml_namespace_listContext)
 
@@ -4327,6 +4450,57 @@ type PostgreSQLParserListener interface {
 	// ExitOpt_xml_root_standalone is called when exiting the opt_xml_root_standalone production.
 	ExitOpt_xml_root_standalone(c *Opt_xml_root_standaloneContext)
 
+	// ExitJson_value_expr is called when exiting the json_value_expr production.
+	ExitJson_value_expr(c *Json_value_exprContext)
+
+	// ExitJson_value_expr_list is called when exiting the json_value_expr_list production.
+	ExitJson_value_expr_list(c *Json_value_expr_listContext)
+
+	// ExitJson_format_clause is called when exiting the json_format_clause production.
+	ExitJson_format_clause(c *Json_format_clauseContext)
+
+	// ExitJson_returning_clause is called when exiting the json_returning_clause production.
+	ExitJson_returning_clause(c *Json_returning_clauseContext)
+
+	// ExitJson_name_and_value_list is called when exiting the json_name_and_value_list production.
+	ExitJson_name_and_value_list(c *Json_name_and_value_listContext)
+
+	// ExitJson_name_and_value is called when exiting the json_name_and_value production.
+	ExitJson_name_and_value(c *Json_name_and_valueContext)
+
+	// ExitJson_constructor_null_clause is called when exiting the json_constructor_null_clause production.
+	ExitJson_constructor_null_clause(c *Json_constructor_null_clauseContext)
+
+	// ExitJson_key_uniqueness_constraint is called when exiting the json_key_uniqueness_constraint production.
+	ExitJson_key_uniqueness_constraint(c *Json_key_uniqueness_constraintContext)
+
+	// ExitJson_predicate_type_constraint is called when exiting the json_predicate_type_constraint production.
+	ExitJson_predicate_type_constraint(c *Json_predicate_type_constraintContext)
+
+	// ExitJson_passing_clause is called when exiting the json_passing_clause production.
+	ExitJson_passing_clause(c *Json_passing_clauseContext)
+
+	// ExitJson_argument is called when exiting the json_argument production.
+	ExitJson_argument(c *Json_argumentContext)
+
+	// ExitJson_wrapper_behavior is called when exiting the json_wrapper_behavior production.
+	ExitJson_wrapper_behavior(c *Json_wrapper_behaviorContext)
+
+	// ExitJson_quotes_clause is called when exiting the json_quotes_clause production.
+	ExitJson_quotes_clause(c *Json_quotes_clauseContext)
+
+	// ExitJson_behavior is called when exiting the json_behavior production.
+	ExitJson_behavior(c *Json_behaviorContext)
+
+	// ExitJson_behavior_clause is called when exiting the json_behavior_clause production.
+	ExitJson_behavior_clause(c *Json_behavior_clauseContext)
+
+	// ExitJson_on_error_clause is called when exiting the json_on_error_clause production.
+	ExitJson_on_error_clause(c *Json_on_error_clauseContext)
+
+	// ExitJson_aggregate_func is called when exiting the json_aggregate_func production.
+	ExitJson_aggregate_func(c *Json_aggregate_funcContext)
+
 	// ExitXml_attributes is called when exiting the xml_attributes production.
 	ExitXml_attributes(c *Xml_attributesContext)
 
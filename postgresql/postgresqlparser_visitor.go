@@ -880,8 +880,14 @@ type PostgreSQLParserVisitor interface {
 	// Visit a parse tree produced by PostgreSQLParser#revokerolestmt.
 	VisitRevokerolestmt(ctx *RevokerolestmtContext) interface{}
 
-	// Visit a parse tree produced by PostgreSQLParser#opt_grant_admin_option.
-	VisitOpt_grant_admin_option(ctx *Opt_grant_admin_optionContext) interface{}
+	// Visit a parse tree produced by PostgreSQLParser#grant_role_opt_list.
+	VisitGrant_role_opt_list(ctx *Grant_role_opt_listContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#grant_role_opt.
+	VisitGrant_role_opt(ctx *Grant_role_optContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#grant_role_opt_value.
+	VisitGrant_role_opt_value(ctx *Grant_role_opt_valueContext) interface{}
 
 	// Visit a parse tree produced by PostgreSQLParser#opt_granted_by.
 	VisitOpt_granted_by(ctx *Opt_granted_byContext) interface{}
@@ -1405,14 +1411,32 @@ type PostgreSQLParserVisitor interface {
 	// Visit a parse tree produced by PostgreSQLParser#mergestmt.
 	VisitMergestmt(ctx *MergestmtContext) interface{}
 
-	// Visit a parse tree produced by PostgreSQLParser#merge_insert_clause.
-	VisitMerge_insert_clause(ctx *Merge_insert_clauseContext) interface{}
+	// Visit a parse tree produced by PostgreSQLParser#merge_when_list.
+	VisitMerge_when_list(ctx *Merge_when_listContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#merge_when_clause.
+	VisitMerge_when_clause(ctx *Merge_when_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#merge_when_tgt_matched.
+	VisitMerge_when_tgt_matched(ctx *Merge_when_tgt_matchedContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#merge_when_tgt_not_matched.
+	VisitMerge_when_tgt_not_matched(ctx *Merge_when_tgt_not_matchedContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#opt_merge_when_condition.
+	VisitOpt_merge_when_condition(ctx *Opt_merge_when_conditionContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#merge_update.
+	VisitMerge_update(ctx *Merge_updateContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#merge_delete.
+	VisitMerge_delete(ctx *Merge_deleteContext) interface{}
 
-	// Visit a parse tree produced by PostgreSQLParser#merge_update_clause.
-	VisitMerge_update_clause(ctx *Merge_update_clauseContext) interface{}
+	// Visit a parse tree produced by PostgreSQLParser#merge_insert.
+	VisitMerge_insert(ctx *Merge_insertContext) interface{}
 
-	// Visit a parse tree produced by PostgreSQLParser#merge_delete_clause.
-	VisitMerge_delete_clause(ctx *Merge_delete_clauseContext) interface{}
+	// Visit a parse tree produced by PostgreSQLParser#merge_values_clause.
+	VisitMerge_values_clause(ctx *Merge_values_clauseContext) interface{}
 
 	// Visit a parse tree produced by PostgreSQLParser#deletestmt.
 	VisitDeletestmt(ctx *DeletestmtContext) interface{}
@@ -1693,6 +1717,18 @@ type PostgreSQLParserVisitor interface {
 	// Visit a parse tree produced by PostgreSQLParser#xmltable_column_option_el.
 	VisitXmltable_column_option_el(ctx *Xmltable_column_option_elContext) interface{}
 
+	// Visit a parse tree produced by PostgreSQLParser#json_table.
+	VisitJson_table(ctx *Json_tableContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_table_column_definition_list.
+	VisitJson_table_column_definition_list(ctx *Json_table_column_definition_listContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_table_column_definition.
+	VisitJson_table_column_definition(ctx *Json_table_column_definitionContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_table_column_path_clause.
+	VisitJson_table_column_path_clause(ctx *Json_table_column_path_clauseContext) interface{}
+
 	// Visit a parse tree produced by PostgreSQLParser#xml_namespace_list.
 	VisitXml_namespace_list(ctx *Xml_namespace_listContext) interface{}
 
@@ -1861,6 +1897,57 @@ type PostgreSQLParserVisitor interface {
 	// Visit a parse tree produced by PostgreSQLParser#opt_xml_root_standalone.
 	VisitOpt_xml_root_standalone(ctx *Opt_xml_root_standaloneContext) interface{}
 
+	// Visit a parse tree produced by PostgreSQLParser#json_value_expr.
+	VisitJson_value_expr(ctx *Json_value_exprContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_value_expr_list.
+	VisitJson_value_expr_list(ctx *Json_value_expr_listContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_format_clause.
+	VisitJson_format_clause(ctx *Json_format_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_returning_clause.
+	VisitJson_returning_clause(ctx *Json_returning_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_name_and_value_list.
+	VisitJson_name_and_value_list(ctx *Json_name_and_value_listContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_name_and_value.
+	VisitJson_name_and_value(ctx *Json_name_and_valueContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_constructor_null_clause.
+	VisitJson_constructor_null_clause(ctx *Json_constructor_null_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_key_uniqueness_constraint.
+	VisitJson_key_uniqueness_constraint(ctx *Json_key_uniqueness_constraintContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_predicate_type_constraint.
+	VisitJson_predicate_type_constraint(ctx *Json_predicate_type_constraintContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_passing_clause.
+	VisitJson_passing_clause(ctx *Json_passing_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_argument.
+	VisitJson_argument(ctx *Json_argumentContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_wrapper_behavior.
+	VisitJson_wrapper_behavior(ctx *Json_wrapper_behaviorContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_quotes_clause.
+	VisitJson_quotes_clause(ctx *Json_quotes_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_behavior.
+	VisitJson_behavior(ctx *Json_behaviorContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_behavior_clause.
+	VisitJson_behavior_clause(ctx *Json_behavior_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_on_error_clause.
+	VisitJson_on_error_clause(ctx *Json_on_error_clauseContext) interface{}
+
+	// Visit a parse tree produced by PostgreSQLParser#json_aggregate_func.
+	VisitJson_aggregate_func(ctx *Json_aggregate_funcContext) interface{}
+
 	// Visit a parse tree produced by PostgreSQLParser#xml_attributes.
 	VisitXml_attributes(ctx *Xml_attributesContext) interface{}
 
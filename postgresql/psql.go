@@ -0,0 +1,568 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// MetaCommandKind is the psql backslash command a MetaCommand runs.
+type MetaCommandKind int
+
+const (
+	// OtherMetaCommand is any command without a kind of its own, such as \d.
+	OtherMetaCommand MetaCommandKind = iota
+	// SetMetaCommand is \set name [value ...].
+	SetMetaCommand
+	// UnsetMetaCommand is \unset name.
+	UnsetMetaCommand
+	// ConnectMetaCommand is \connect or \c.
+	ConnectMetaCommand
+	// IncludeMetaCommand is \i, \include, \ir or \include_relative.
+	IncludeMetaCommand
+	// CopyMetaCommand is \copy.
+	CopyMetaCommand
+	// GexecMetaCommand is \gexec.
+	GexecMetaCommand
+	// IfMetaCommand is \if expression.
+	IfMetaCommand
+	// ElifMetaCommand is \elif expression.
+	ElifMetaCommand
+	// ElseMetaCommand is \else.
+	ElseMetaCommand
+	// EndifMetaCommand is \endif.
+	EndifMetaCommand
+)
+
+var metaCommandKinds = map[string]MetaCommandKind{
+	"set":              SetMetaCommand,
+	"unset":            UnsetMetaCommand,
+	"connect":          ConnectMetaCommand,
+	"c":                ConnectMetaCommand,
+	"i":                IncludeMetaCommand,
+	"include":          IncludeMetaCommand,
+	"ir":               IncludeMetaCommand,
+	"include_relative": IncludeMetaCommand,
+	"copy":             CopyMetaCommand,
+	"gexec":            GexecMetaCommand,
+	"if":               IfMetaCommand,
+	"elif":             ElifMetaCommand,
+	"else":             ElseMetaCommand,
+	"endif":            EndifMetaCommand,
+}
+
+// MetaCommand is a psql backslash command.
+type MetaCommand struct {
+	Line   int
+	Column int
+	Kind   MetaCommandKind
+	// Name is the command name without the backslash, e.g. "set" or "i".
+	Name string
+	// Args are the arguments as psql reads them: single-quoted arguments are
+	// unquoted and unescaped, double-quoted ones keep their quotes, as psql
+	// passes them on as identifiers. They are nil for \copy, whose argument
+	// is the whole of Text.
+	Args []string
+	// Text is the text after the command name, e.g. the COPY options of \copy.
+	Text string
+}
+
+// GetMetaCommand returns the psql backslash command of ctx.
+func GetMetaCommand(ctx IPlsqlconsolecommandContext) *MetaCommand {
+	token := ctx.MetaCommand().GetSymbol()
+	command := ParseMetaCommand(token.GetText())
+	command.Line, command.Column = token.GetLine(), token.GetColumn()
+	return command
+}
+
+// ParseMetaCommand parses text, a psql backslash command such as
+// `\set name 'value'`. Its Line and Column are 0.
+func ParseMetaCommand(text string) *MetaCommand {
+	text = strings.TrimPrefix(text, `\`)
+	name, rest := text, ""
+	if i := strings.IndexFunc(text, unicode.IsSpace); i >= 0 {
+		name, rest = text[:i], text[i:]
+	}
+	command := &MetaCommand{
+		Kind: metaCommandKinds[name],
+		Name: name,
+		Text: strings.TrimSpace(rest),
+	}
+	if command.Kind != CopyMetaCommand {
+		command.Args = metaCommandArgs(command.Text)
+	}
+	return command
+}
+
+// metaCommandArgs splits the arguments of a backslash command the way psql
+// does. Adjacent quoted and unquoted parts form a single argument.
+func metaCommandArgs(text string) []string {
+	var args []string
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+		var arg strings.Builder
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			switch runes[i] {
+			case '\'':
+				i = metaCommandLiteral(runes, i+1, &arg)
+			case '"', '`':
+				end := closingQuote(runes, i+1, runes[i])
+				arg.WriteString(string(runes[i:end]))
+				i = end
+			default:
+				arg.WriteRune(runes[i])
+				i++
+			}
+		}
+		args = append(args, arg.String())
+	}
+	return args
+}
+
+// metaCommandLiteral writes the value of the single-quoted argument that
+// starts at i, after the opening quote, and returns the index after it.
+func metaCommandLiteral(runes []rune, i int, arg *strings.Builder) int {
+	for i < len(runes) {
+		switch {
+		case runes[i] == '\'' && i+1 < len(runes) && runes[i+1] == '\'':
+			arg.WriteRune('\'')
+			i += 2
+		case runes[i] == '\'':
+			return i + 1
+		case runes[i] == '\\' && i+1 < len(runes):
+			switch runes[i+1] {
+			case 'n':
+				arg.WriteRune('\n')
+			case 't':
+				arg.WriteRune('\t')
+			case 'r':
+				arg.WriteRune('\r')
+			case 'b':
+				arg.WriteRune('\b')
+			case 'f':
+				arg.WriteRune('\f')
+			default:
+				arg.WriteRune(runes[i+1])
+			}
+			i += 2
+		default:
+			arg.WriteRune(runes[i])
+			i++
+		}
+	}
+	return i
+}
+
+// closingQuote returns the index after the quote that closes the quoted text
+// starting at i, or the end of runes. A doubled quote does not close it.
+func closingQuote(runes []rune, i int, quote rune) int {
+	for i < len(runes) {
+		if runes[i] == quote {
+			if i+1 < len(runes) && runes[i+1] == quote {
+				i += 2
+				continue
+			}
+			return i + 1
+		}
+		i++
+	}
+	return i
+}
+
+// MetaCommandError is a misplaced psql conditional command, such as an \else
+// without \if.
+type MetaCommandError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *MetaCommandError) Error() string {
+	return fmt.Sprintf("line %d:%d %s", e.Line, e.Column, e.Message)
+}
+
+// CheckMetaCommands reports the psql conditional commands in parseTree that
+// psql would reject: \elif, \else and \endif without a matching \if, \elif
+// after \else, and \if without \endif.
+func CheckMetaCommands(parseTree antlr.Tree) []*MetaCommandError {
+	collector := &metaCommandCollector{BasePostgreSQLParserListener: &BasePostgreSQLParserListener{}}
+	antlr.ParseTreeWalkerDefault.Walk(collector, parseTree)
+
+	type block struct {
+		command *MetaCommand
+		hasElse bool
+	}
+	var result []*MetaCommandError
+	var blocks []*block
+	report := func(command *MetaCommand, message string) {
+		result = append(result, &MetaCommandError{Line: command.Line, Column: command.Column, Message: message})
+	}
+	for _, command := range collector.commands {
+		switch command.Kind {
+		case IfMetaCommand:
+			blocks = append(blocks, &block{command: command})
+		case ElifMetaCommand, ElseMetaCommand:
+			if len(blocks) == 0 {
+				report(command, fmt.Sprintf(`\%s without \if`, command.Name))
+				continue
+			}
+			current := blocks[len(blocks)-1]
+			if current.hasElse {
+				report(command, fmt.Sprintf(`\%s after \else`, command.Name))
+			}
+			if command.Kind == ElseMetaCommand {
+				current.hasElse = true
+			}
+		case EndifMetaCommand:
+			if len(blocks) == 0 {
+				report(command, `\endif without \if`)
+				continue
+			}
+			blocks = blocks[:len(blocks)-1]
+		}
+	}
+	for _, b := range blocks {
+		report(b.command, `\if without \endif`)
+	}
+	return result
+}
+
+type metaCommandCollector struct {
+	*BasePostgreSQLParserListener
+
+	commands []*MetaCommand
+}
+
+func (c *metaCommandCollector) EnterPlsqlconsolecommand(ctx *PlsqlconsolecommandContext) {
+	c.commands = append(c.commands, GetMetaCommand(ctx))
+}
+
+// InterpolatePsqlVariables replaces the psql variable references in script
+// the way psql does before it sends a statement to the server: :name with the
+// value of the variable, :'name' with the value as a string literal and
+// :"name" with the value as an identifier. variables are the variables set
+// before the script runs; \set and \unset in the script change them from
+// there on, except in \if branches whose condition is a constant that psql
+// reads as false. References to unset variables, and those in strings, quoted
+// identifiers and comments, are kept.
+func InterpolatePsqlVariables(script string, variables map[string]string) string {
+	text, _ := interpolatePsqlVariables(script, variables)
+	return text
+}
+
+// NewPsqlParser returns a parser for a psql script with its variables
+// interpolated as InterpolatePsqlVariables does. Its tokens and syntax errors
+// are positioned in script.
+func NewPsqlParser(script string, variables map[string]string) *PostgreSQLParser {
+	text, sourceMap := interpolatePsqlVariables(script, variables)
+	lexer := NewPostgreSQLLexer(antlr.NewInputStream(text))
+	parser := NewPostgreSQLParser(antlr.NewCommonTokenStream(&sourceMapLexer{PostgreSQLLexer: lexer, sourceMap: sourceMap}, antlr.TokenDefaultChannel))
+	parser.sourceMap = sourceMap
+	return parser
+}
+
+func interpolatePsqlVariables(script string, variables map[string]string) (string, *sourceMap) {
+	p := &psqlInterpolator{
+		input:     []rune(script),
+		variables: make(map[string]string, len(variables)),
+		script:    scriptSourceMap(script, 0),
+	}
+	for name, value := range variables {
+		p.variables[name] = value
+	}
+	p.run()
+	result := &sourceMap{}
+	for _, offset := range p.offsets {
+		result.positions = append(result.positions, p.script.position(offset))
+	}
+	result.positions = append(result.positions, p.script.position(len(p.input)))
+	return string(p.text), result
+}
+
+type psqlInterpolator struct {
+	input     []rune
+	variables map[string]string
+	script    *sourceMap
+
+	text []rune
+	// offsets holds the offset in input of every character of text.
+	offsets []int
+	// branches holds the \if blocks the interpolator is in, innermost last.
+	branches []*psqlBranch
+}
+
+// psqlBranch is an \if block. Only branches that are clearly skipped, after a
+// constant false condition or a branch that is clearly taken, are skipped.
+type psqlBranch struct {
+	// outerSkipped is whether the block is in a skipped branch.
+	outerSkipped bool
+	// taken is whether an earlier branch of the block is clearly taken.
+	taken bool
+	// skipped is whether the current branch is skipped.
+	skipped bool
+}
+
+func (p *psqlInterpolator) skipping() bool {
+	return len(p.branches) > 0 && p.branches[len(p.branches)-1].skipped
+}
+
+// branch applies the \if, \elif, \else or \endif command.
+func (p *psqlInterpolator) branch(command *MetaCommand) {
+	if command.Kind == IfMetaCommand {
+		p.branches = append(p.branches, &psqlBranch{outerSkipped: p.skipping()})
+	}
+	if len(p.branches) == 0 {
+		return
+	}
+	b := p.branches[len(p.branches)-1]
+	switch command.Kind {
+	case IfMetaCommand, ElifMetaCommand:
+		value, known := psqlBool(command.Args)
+		b.skipped = b.outerSkipped || b.taken || known && !value
+		b.taken = b.taken || known && value
+	case ElseMetaCommand:
+		b.skipped = b.outerSkipped || b.taken
+	case EndifMetaCommand:
+		p.branches = p.branches[:len(p.branches)-1]
+	}
+}
+
+// psqlBool reads an \if condition the way psql does: a unique prefix of
+// true, false, yes, no, on or off, in any case, or 1 or 0. known is false if
+// the condition is not one of them.
+func psqlBool(args []string) (value bool, known bool) {
+	if len(args) != 1 || args[0] == "" {
+		return false, false
+	}
+	arg := strings.ToLower(args[0])
+	switch {
+	case strings.HasPrefix("true", arg), strings.HasPrefix("yes", arg), arg == "1":
+		return true, true
+	case strings.HasPrefix("false", arg), strings.HasPrefix("no", arg), arg == "0":
+		return false, true
+	case len(arg) >= 2 && strings.HasPrefix("on", arg):
+		return true, true
+	case len(arg) >= 2 && strings.HasPrefix("off", arg):
+		return false, true
+	}
+	return false, false
+}
+
+func (p *psqlInterpolator) copy(from, to int) {
+	for i := from; i < to && i < len(p.input); i++ {
+		p.text = append(p.text, p.input[i])
+		p.offsets = append(p.offsets, i)
+	}
+}
+
+func (p *psqlInterpolator) run() {
+	for i := 0; i < len(p.input); {
+		r := p.input[i]
+		switch {
+		case r == '-' && p.at(i+1, '-'):
+			end := i
+			for end < len(p.input) && p.input[end] != '\n' {
+				end++
+			}
+			p.copy(i, end)
+			i = end
+		case r == '/' && p.at(i+1, '*'):
+			end := p.blockCommentEnd(i)
+			p.copy(i, end)
+			i = end
+		case r == '\'':
+			// E'...' strings allow backslash escapes.
+			escapes := i > 0 && (p.input[i-1] == 'E' || p.input[i-1] == 'e') && (i < 2 || !isPsqlVariableChar(p.input[i-2]))
+			end := p.literalEnd(i+1, escapes)
+			p.copy(i, end)
+			i = end
+		case r == '"':
+			end := closingQuote(p.input, i+1, '"')
+			p.copy(i, end)
+			i = end
+		case r == '$' && (i == 0 || !isPsqlVariableChar(p.input[i-1])):
+			end := p.dollarQuoteEnd(i)
+			p.copy(i, end)
+			i = end
+		case r == ':':
+			i = p.variable(i)
+		case r == '\\':
+			i = p.metaCommand(i)
+		default:
+			p.copy(i, i+1)
+			i++
+		}
+	}
+}
+
+func (p *psqlInterpolator) at(i int, r rune) bool {
+	return i < len(p.input) && p.input[i] == r
+}
+
+// variable interpolates the variable reference at i, if there is one, and
+// returns the index after it.
+func (p *psqlInterpolator) variable(i int) int {
+	// :: is a cast.
+	if p.at(i+1, ':') {
+		p.copy(i, i+2)
+		return i + 2
+	}
+	quote := rune(0)
+	start := i + 1
+	if p.at(start, '\'') || p.at(start, '"') {
+		quote = p.input[start]
+		start++
+	}
+	end := start
+	for end < len(p.input) && isPsqlVariableChar(p.input[end]) {
+		end++
+	}
+	if end == start || quote != 0 && !p.at(end, quote) {
+		p.copy(i, i+1)
+		return i + 1
+	}
+	value, ok := p.variables[string(p.input[start:end])]
+	if quote != 0 {
+		end++
+	}
+	if !ok {
+		p.copy(i, end)
+		return end
+	}
+	switch quote {
+	case '\'':
+		value = quoteLiteral(value)
+	case '"':
+		value = `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+	}
+	for _, r := range value {
+		p.text = append(p.text, r)
+		p.offsets = append(p.offsets, i)
+	}
+	return end
+}
+
+// quoteLiteral quotes value as a string literal the way psql does.
+func quoteLiteral(value string) string {
+	quoted := "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	if strings.Contains(value, `\`) {
+		quoted = "E" + strings.ReplaceAll(quoted, `\`, `\\`)
+	}
+	return quoted
+}
+
+// metaCommand interpolates the variables in the backslash command at i,
+// applies it if it is \set, \unset or a conditional, and returns the index
+// after it. A command ends at the end of the line or at the next backslash
+// outside quotes; \\ separates a command from a statement on the same line.
+func (p *psqlInterpolator) metaCommand(i int) int {
+	if p.at(i+1, '\\') {
+		p.copy(i, i+2)
+		return i + 2
+	}
+	start := len(p.text)
+	p.copy(i, i+1)
+	i++
+	for i < len(p.input) && p.input[i] != '\n' && p.input[i] != '\\' {
+		switch p.input[i] {
+		case '\'':
+			end := p.literalEnd(i+1, true)
+			p.copy(i, end)
+			i = end
+		case '"', '`':
+			end := closingQuote(p.input, i+1, p.input[i])
+			p.copy(i, end)
+			i = end
+		case ':':
+			i = p.variable(i)
+		default:
+			p.copy(i, i+1)
+			i++
+		}
+	}
+
+	command := ParseMetaCommand(string(p.text[start:]))
+	switch command.Kind {
+	case IfMetaCommand, ElifMetaCommand, ElseMetaCommand, EndifMetaCommand:
+		p.branch(command)
+	case SetMetaCommand:
+		if len(command.Args) > 0 && !p.skipping() {
+			p.variables[command.Args[0]] = strings.Join(command.Args[1:], "")
+		}
+	case UnsetMetaCommand:
+		if len(command.Args) > 0 && !p.skipping() {
+			delete(p.variables, command.Args[0])
+		}
+	}
+	return i
+}
+
+// literalEnd returns the index after the string literal whose text starts
+// at i, after the opening quote.
+func (p *psqlInterpolator) literalEnd(i int, escapes bool) int {
+	for i < len(p.input) {
+		switch {
+		case escapes && p.input[i] == '\\':
+			i += 2
+		case p.input[i] == '\'' && p.at(i+1, '\''):
+			i += 2
+		case p.input[i] == '\'':
+			return i + 1
+		default:
+			i++
+		}
+	}
+	return len(p.input)
+}
+
+// blockCommentEnd returns the index after the, possibly nested, block comment
+// at i.
+func (p *psqlInterpolator) blockCommentEnd(i int) int {
+	depth := 0
+	for i < len(p.input) {
+		switch {
+		case p.input[i] == '/' && p.at(i+1, '*'):
+			depth++
+			i += 2
+		case p.input[i] == '*' && p.at(i+1, '/'):
+			depth--
+			i += 2
+			if depth == 0 {
+				return i
+			}
+		default:
+			i++
+		}
+	}
+	return len(p.input)
+}
+
+// dollarQuoteEnd returns the index after the dollar-quoted string at i, or
+// i+1 if the $ at i does not start one, as in a parameter such as $1.
+func (p *psqlInterpolator) dollarQuoteEnd(i int) int {
+	end := i + 1
+	for end < len(p.input) && (unicode.IsLetter(p.input[end]) || p.input[end] == '_' || end > i+1 && unicode.IsDigit(p.input[end])) {
+		end++
+	}
+	if !p.at(end, '$') {
+		return i + 1
+	}
+	tag := string(p.input[i : end+1])
+	size := end + 1 - i
+	for j := end + 1; j+size <= len(p.input); j++ {
+		if string(p.input[j:j+size]) == tag {
+			return j + size
+		}
+	}
+	return len(p.input)
+}
+
+func isPsqlVariableChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,122 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetaCommand(t *testing.T) {
+	command := pgparser.ParseMetaCommand(`\set greeting 'it''s\n' here`)
+	require.Equal(t, pgparser.SetMetaCommand, command.Kind)
+	require.Equal(t, []string{"greeting", "it's\n", "here"}, command.Args)
+
+	command = pgparser.ParseMetaCommand(`\c mydb "Admin"`)
+	require.Equal(t, pgparser.ConnectMetaCommand, command.Kind)
+	require.Equal(t, []string{"mydb", `"Admin"`}, command.Args)
+
+	command = pgparser.ParseMetaCommand(`\copy t FROM 'data.csv' WITH (FORMAT csv)`)
+	require.Equal(t, pgparser.CopyMetaCommand, command.Kind)
+	require.Nil(t, command.Args)
+	require.Equal(t, `t FROM 'data.csv' WITH (FORMAT csv)`, command.Text)
+
+	command = pgparser.ParseMetaCommand(`\ir ../schema.sql`)
+	require.Equal(t, pgparser.IncludeMetaCommand, command.Kind)
+	require.Equal(t, []string{"../schema.sql"}, command.Args)
+
+	command = pgparser.ParseMetaCommand(`\dt+ public.*`)
+	require.Equal(t, pgparser.OtherMetaCommand, command.Kind)
+	require.Equal(t, "dt+", command.Name)
+}
+
+func TestCheckMetaCommands(t *testing.T) {
+	_, tree := parseStatement(`\set ON_ERROR_STOP on
+\if :is_prod
+SELECT 1;
+\else
+SELECT 2;
+\elif true
+\endif
+\endif
+SELECT format('GRANT SELECT ON %I TO reader', tablename) FROM pg_tables \gexec`)
+
+	var kinds []pgparser.MetaCommandKind
+	for _, stmt := range tree.Stmtblock().Stmtmulti().AllStmt() {
+		if stmt.Plsqlconsolecommand() != nil {
+			kinds = append(kinds, pgparser.GetMetaCommand(stmt.Plsqlconsolecommand()).Kind)
+		}
+	}
+	require.Equal(t, []pgparser.MetaCommandKind{
+		pgparser.SetMetaCommand,
+		pgparser.IfMetaCommand,
+		pgparser.ElseMetaCommand,
+		pgparser.ElifMetaCommand,
+		pgparser.EndifMetaCommand,
+		pgparser.EndifMetaCommand,
+		pgparser.GexecMetaCommand,
+	}, kinds)
+
+	var got []string
+	for _, err := range pgparser.CheckMetaCommands(tree) {
+		got = append(got, err.Error())
+	}
+	require.Equal(t, []string{
+		`line 6:0 \elif after \else`,
+		`line 8:0 \endif without \if`,
+	}, got)
+}
+
+func TestInterpolatePsqlVariables(t *testing.T) {
+	script := `\set tbl users
+\set who 'O''Brien'
+\set target :tbl
+SELECT * FROM :"target" WHERE name = :'who' AND id = :id AND x::int = :missing -- :tbl
+AND y = ':tbl' AND z = $$:tbl$$;
+\unset tbl
+SELECT :tbl;`
+	want := `\set tbl users
+\set who 'O''Brien'
+\set target users
+SELECT * FROM "users" WHERE name = 'O''Brien' AND id = 42 AND x::int = :missing -- :tbl
+AND y = ':tbl' AND z = $$:tbl$$;
+\unset tbl
+SELECT :tbl;`
+	require.Equal(t, want, pgparser.InterpolatePsqlVariables(script, map[string]string{"id": "42"}))
+
+	// \set in a branch psql skips has no effect. A condition that is not a
+	// constant skips neither its branch nor the ones after it.
+	script = `\set mode a
+\if false
+\set mode b
+\elif :flag
+\set mode c
+\else
+\set mode d
+\endif
+\if 0
+\if true
+\unset mode
+\endif
+\endif
+SELECT :'mode';`
+	require.Contains(t, pgparser.InterpolatePsqlVariables(script, nil), "SELECT 'd';")
+	require.Contains(t, pgparser.InterpolatePsqlVariables(script, map[string]string{"flag": "off"}), "SELECT 'd';")
+	require.Contains(t, pgparser.InterpolatePsqlVariables(script, map[string]string{"flag": "yes"}), "SELECT 'c';")
+	require.Equal(t, `SELECT E'C:\\temp';`, pgparser.InterpolatePsqlVariables(`SELECT :'dir';`, map[string]string{"dir": `C:\temp`}))
+
+	// Tokens are positioned in the script, not in the interpolated text.
+	parser := pgparser.NewPsqlParser("\\set n 10\nSELECT * FROM t LIMIT :n;", nil)
+	parser.RemoveErrorListeners()
+	parser.Root()
+	tokens := parser.GetTokenStream().(*antlr.CommonTokenStream)
+	var limit antlr.Token
+	for _, token := range tokens.GetAllTokens() {
+		if token.GetText() == "10" && token.GetLine() == 2 {
+			limit = token
+		}
+	}
+	require.NotNil(t, limit)
+	require.Equal(t, 22, limit.GetColumn())
+}
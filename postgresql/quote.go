@@ -0,0 +1,50 @@
+package postgresql
+
+import "strings"
+
+// NeedsQuoting reports whether name must be double-quoted to be used as an
+// identifier and keep its exact spelling. It follows PostgreSQL's
+// quote_identifier:
+//   - names with upper-case letters are quoted, because PostgreSQL folds
+//     unquoted names to lower case;
+//   - names with any character outside [a-z0-9_], or starting with a digit,
+//     are quoted. This includes embedded double quotes and non-ASCII letters,
+//     which are valid unquoted but whose case folding depends on the server
+//     encoding;
+//   - keywords are quoted unless they are unreserved. Column name keywords
+//     such as "between" and function or type name keywords such as "left"
+//     are quoted too, since they are only accepted as some kinds of names.
+//
+// Keywords of all versions are considered; use NeedsQuotingForVersion to
+// target a specific server.
+func NeedsQuoting(name string) bool {
+	return NeedsQuotingForVersion(name, 0)
+}
+
+// NeedsQuotingForVersion is NeedsQuoting for the given PostgreSQL major
+// version, 0 meaning the latest. A name such as "json_table" is a plain
+// identifier on PostgreSQL 16 but a keyword from 17 on.
+func NeedsQuotingForVersion(name string, version int) bool {
+	if name == "" {
+		return true
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r == '_':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return true
+		}
+	}
+	keyword, ok := LookupKeyword(name, version)
+	return ok && keyword.Category != UnreservedKeyword
+}
+
+// QuoteIdentifierIfNeeded returns name as an identifier, double-quoted with
+// embedded quotes doubled if NeedsQuoting reports that it must be quoted.
+func QuoteIdentifierIfNeeded(name string) string {
+	if !NeedsQuoting(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,41 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteIdentifierIfNeeded(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "users", want: "users"},
+		{name: "user_2", want: "user_2"},
+		{name: "_tmp", want: "_tmp"},
+		{name: "Users", want: `"Users"`},
+		{name: "2fa", want: `"2fa"`},
+		{name: "my table", want: `"my table"`},
+		{name: `say "hi"`, want: `"say ""hi"""`},
+		{name: "café", want: `"café"`},
+		{name: "", want: `""`},
+		// Unreserved keywords and words PostgreSQL does not reserve are fine.
+		{name: "value", want: "value"},
+		{name: "year", want: "year"},
+		{name: "abs", want: "abs"},
+		// Reserved, column name and function or type name keywords are not.
+		{name: "select", want: `"select"`},
+		{name: "between", want: `"between"`},
+		{name: "left", want: `"left"`},
+		{name: "user", want: `"user"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, pgparser.QuoteIdentifierIfNeeded(tt.name))
+			require.Equal(t, tt.want != tt.name, pgparser.NeedsQuoting(tt.name))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// RedshiftError is PostgreSQL syntax that Amazon Redshift does not support.
+type RedshiftError struct {
+	Line   int
+	Column int
+	// Syntax names the unsupported syntax, e.g. "LISTEN" or "array types".
+	Syntax string
+}
+
+func (e *RedshiftError) Error() string {
+	return fmt.Sprintf("line %d:%d %s is not supported by Redshift", e.Line, e.Column, e.Syntax)
+}
+
+// CheckRedshiftCompatibility reports the syntax in a tree returned by the
+// PostgreSQL parser that Redshift does not support, such as array types,
+// INSERT ... ON CONFLICT, generated columns and LISTEN/NOTIFY. It helps port a
+// PostgreSQL script to Redshift; the Redshift parser should be used for
+// scripts written for Redshift.
+func CheckRedshiftCompatibility(parseTree antlr.Tree) []*RedshiftError {
+	var result []*RedshiftError
+	for _, use := range findRedshiftUnsupportedSyntax(parseTree) {
+		result = append(result, &RedshiftError{
+			Line:   use.token.GetLine(),
+			Column: use.token.GetColumn(),
+			Syntax: use.syntax,
+		})
+	}
+	return result
+}
+
+// redshiftUnsupportedSyntax is a use of syntax that Redshift does not support.
+type redshiftUnsupportedSyntax struct {
+	token  antlr.Token
+	syntax string
+}
+
+func (s *redshiftUnsupportedSyntax) message() string {
+	return fmt.Sprintf("%s is not supported by Redshift", s.syntax)
+}
+
+func findRedshiftUnsupportedSyntax(parseTree antlr.Tree) []*redshiftUnsupportedSyntax {
+	finder := &redshiftUnsupportedSyntaxFinder{BasePostgreSQLParserListener: &BasePostgreSQLParserListener{}}
+	antlr.ParseTreeWalkerDefault.Walk(finder, parseTree)
+	return finder.uses
+}
+
+type redshiftUnsupportedSyntaxFinder struct {
+	*BasePostgreSQLParserListener
+
+	uses []*redshiftUnsupportedSyntax
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) add(token antlr.Token, syntax string) {
+	f.uses = append(f.uses, &redshiftUnsupportedSyntax{token: token, syntax: syntax})
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterTypename(ctx *TypenameContext) {
+	if ctx.ARRAY() != nil || ctx.Opt_array_bounds() != nil && ctx.Opt_array_bounds().GetChildCount() > 0 {
+		f.add(ctx.GetStart(), "array types")
+	}
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterOpt_on_conflict(ctx *Opt_on_conflictContext) {
+	f.add(ctx.GetStart(), "INSERT ... ON CONFLICT")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterReturning_clause(ctx *Returning_clauseContext) {
+	f.add(ctx.GetStart(), "RETURNING")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterColconstraintelem(ctx *ColconstraintelemContext) {
+	if ctx.STORED() != nil {
+		f.add(ctx.GetStart(), "generated columns")
+	}
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterConstraintelem(ctx *ConstraintelemContext) {
+	if ctx.EXCLUDE() != nil {
+		f.add(ctx.GetStart(), "exclusion constraints")
+	}
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterOptinherit(ctx *OptinheritContext) {
+	f.add(ctx.GetStart(), "table inheritance")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterPartitionspec(ctx *PartitionspecContext) {
+	f.add(ctx.GetStart(), "declarative partitioning")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterListenstmt(ctx *ListenstmtContext) {
+	f.add(ctx.GetStart(), "LISTEN")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterNotifystmt(ctx *NotifystmtContext) {
+	f.add(ctx.GetStart(), "NOTIFY")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterUnlistenstmt(ctx *UnlistenstmtContext) {
+	f.add(ctx.GetStart(), "UNLISTEN")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterCreatetrigstmt(ctx *CreatetrigstmtContext) {
+	f.add(ctx.GetStart(), "CREATE TRIGGER")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterCreateeventtrigstmt(ctx *CreateeventtrigstmtContext) {
+	f.add(ctx.GetStart(), "CREATE EVENT TRIGGER")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterCreatedomainstmt(ctx *CreatedomainstmtContext) {
+	f.add(ctx.GetStart(), "CREATE DOMAIN")
+}
+
+func (f *redshiftUnsupportedSyntaxFinder) EnterCreateextensionstmt(ctx *CreateextensionstmtContext) {
+	f.add(ctx.GetStart(), "CREATE EXTENSION")
+}
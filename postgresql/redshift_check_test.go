@@ -0,0 +1,74 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRedshiftCompatibility(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{
+			statement: "CREATE TABLE t (id int PRIMARY KEY, tags text[], total int GENERATED ALWAYS AS (id * 2) STORED);",
+			want: []string{
+				"line 1:41 array types is not supported by Redshift",
+				"line 1:59 generated columns is not supported by Redshift",
+			},
+		},
+		{
+			statement: "INSERT INTO t VALUES (1) ON CONFLICT (id) DO NOTHING RETURNING id;",
+			want: []string{
+				"line 1:25 INSERT ... ON CONFLICT is not supported by Redshift",
+				"line 1:53 RETURNING is not supported by Redshift",
+			},
+		},
+		{
+			statement: "LISTEN jobs;\nNOTIFY jobs, 'ready';",
+			want: []string{
+				"line 1:0 LISTEN is not supported by Redshift",
+				"line 2:0 NOTIFY is not supported by Redshift",
+			},
+		},
+		{
+			statement: "CREATE TABLE m (ts timestamp) PARTITION BY RANGE (ts);",
+			want:      []string{"line 1:30 declarative partitioning is not supported by Redshift"},
+		},
+		{
+			statement: "CREATE TABLE t (id int GENERATED BY DEFAULT AS IDENTITY, name varchar(32));",
+		},
+		{
+			statement: "SELECT id, count(*) FROM t GROUP BY id;",
+		},
+	}
+
+	for _, test := range tests {
+		_, tree := parseStatement(test.statement)
+		var got []string
+		for _, err := range pgparser.CheckRedshiftCompatibility(tree) {
+			got = append(got, err.Error())
+		}
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
+
+func TestRedshiftEngine(t *testing.T) {
+	parse := func(statement string, engine pgparser.Engine) []string {
+		lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+		parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		parser.Engine = engine
+		collector := &syntaxErrorCollector{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+		parser.RemoveErrorListeners()
+		parser.AddErrorListener(collector)
+		parser.Root()
+		return collector.messages
+	}
+
+	statement := "NOTIFY jobs;"
+	require.Equal(t, []string{"NOTIFY is not supported by Redshift"}, parse(statement, pgparser.EngineRedshift))
+	require.Empty(t, parse(statement, pgparser.EnginePostgreSQL))
+}
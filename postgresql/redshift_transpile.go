@@ -0,0 +1,358 @@
+package postgresql
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// TranspileError is a construct of a PostgreSQL script that cannot be
+// translated to Redshift. The construct is copied to the output unchanged.
+type TranspileError struct {
+	Line   int
+	Column int
+	// Construct describes the construct, e.g. "LISTEN" or "generate_series
+	// outside FROM".
+	Construct string
+}
+
+func (e *TranspileError) Error() string {
+	return fmt.Sprintf("line %d:%d cannot translate %s to Redshift", e.Line, e.Column, e.Construct)
+}
+
+// TranspileToRedshift parses a PostgreSQL script and returns it rewritten as
+// Redshift SQL, with its whitespace and comments kept. It rewrites:
+//
+//   - x::type casts as CAST(x AS type), which Redshift accepts anywhere;
+//   - x [NOT] [I]LIKE ANY|ALL (ARRAY[...]) and other comparisons with an
+//     array constructor as a chain of OR or AND;
+//   - generate_series(start, stop [, step]) in FROM as a recursive WITH query;
+//   - string_agg(x, delimiter ORDER BY ...) as LISTAGG ... WITHIN GROUP;
+//   - SELECT DISTINCT ON (...) as a QUALIFY ROW_NUMBER() filter;
+//   - LIMIT ALL by removing it;
+//   - serial, bigserial and smallserial columns as IDENTITY(1, 1) columns.
+//
+// Constructs it cannot translate, and syntax Redshift does not support, are
+// returned as TranspileErrors. The error is the first syntax error of script.
+func TranspileToRedshift(script string) (string, []*TranspileError, error) {
+	lexer := NewPostgreSQLLexer(antlr.NewInputStream(script))
+	lexer.RemoveErrorListeners()
+	tokens := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := NewPostgreSQLParser(tokens)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(&PostgreSQLParserErrorListener{grammar: parser})
+	tree := parser.Root()
+	if len(parser.parseErrors) > 0 {
+		err := parser.parseErrors[0]
+		return "", nil, fmt.Errorf("line %d:%d %s", err.Line, err.Column, err.Message)
+	}
+
+	t := &redshiftTranspiler{tokens: tokens}
+	result := t.text(tree)
+	for _, use := range findRedshiftUnsupportedSyntax(tree) {
+		t.report(use.token, use.syntax)
+	}
+	sort.SliceStable(t.errs, func(i, j int) bool {
+		if t.errs[i].Line != t.errs[j].Line {
+			return t.errs[i].Line < t.errs[j].Line
+		}
+		return t.errs[i].Column < t.errs[j].Column
+	})
+	return result, t.errs, nil
+}
+
+type redshiftTranspiler struct {
+	tokens *antlr.CommonTokenStream
+	errs   []*TranspileError
+}
+
+func (t *redshiftTranspiler) report(token antlr.Token, construct string) {
+	t.errs = append(t.errs, &TranspileError{Line: token.GetLine(), Column: token.GetColumn(), Construct: construct})
+}
+
+// text returns the Redshift text of node, starting with the whitespace and
+// comments before it.
+func (t *redshiftTranspiler) text(node antlr.Tree) string {
+	switch n := node.(type) {
+	case antlr.TerminalNode:
+		token := n.GetSymbol()
+		if token.GetTokenType() == antlr.TokenEOF {
+			return t.leading(token)
+		}
+		return t.leading(token) + token.GetText()
+	case *A_expr_typecastContext:
+		if len(n.AllTypename()) > 0 {
+			text := t.trimmed(n.C_expr())
+			for _, typename := range n.AllTypename() {
+				text = "CAST(" + text + " AS " + t.trimmed(typename) + ")"
+			}
+			return t.leading(n.GetStart()) + text
+		}
+	case *B_exprContext:
+		if n.TYPECAST() != nil {
+			return t.leading(n.GetStart()) + "CAST(" + t.trimmed(n.B_expr(0)) + " AS " + t.trimmed(n.Typename()) + ")"
+		}
+	case *A_expr_compareContext:
+		if text, ok := t.arrayComparison(n); ok {
+			return text
+		}
+	case *Func_applicationContext:
+		if text, ok := t.funcApplication(n); ok {
+			return text
+		}
+	case *Func_tableContext:
+		if text, ok := t.generateSeries(n); ok {
+			return text
+		}
+	case *Simple_select_pramaryContext:
+		if text, ok := t.distinctOn(n); ok {
+			return text
+		}
+	case *Limit_clauseContext:
+		// LIMIT ALL is the same as no LIMIT. Comments before it are kept.
+		if value := n.Select_limit_value(); value != nil && value.ALL() != nil && n.Select_offset_value() == nil {
+			if leading := t.leading(n.GetStart()); strings.TrimSpace(leading) != "" {
+				return leading
+			}
+			return ""
+		}
+	case *TypenameContext:
+		if text, ok := t.serial(n); ok {
+			return text
+		}
+	}
+
+	var b strings.Builder
+	for _, child := range node.GetChildren() {
+		b.WriteString(t.text(child))
+	}
+	return b.String()
+}
+
+// leading returns the whitespace and comments between token and the token on
+// the default channel before it.
+func (t *redshiftTranspiler) leading(token antlr.Token) string {
+	start := 0
+	for i := token.GetTokenIndex() - 1; i >= 0; i-- {
+		if previous := t.tokens.Get(i); previous.GetChannel() == antlr.TokenDefaultChannel {
+			start = previous.GetStop() + 1
+			break
+		}
+	}
+	if start >= token.GetStart() {
+		return ""
+	}
+	return token.GetInputStream().GetTextFromInterval(antlr.NewInterval(start, token.GetStart()-1))
+}
+
+// trimmed returns the Redshift text of node without the whitespace and
+// comments before it.
+func (t *redshiftTranspiler) trimmed(node antlr.ParserRuleContext) string {
+	return strings.TrimPrefix(t.text(node), t.leading(node.GetStart()))
+}
+
+// arrayComparison rewrites x op ANY|ALL (ARRAY[a, b]) as (x op a OR x op b),
+// or with AND for ALL.
+func (t *redshiftTranspiler) arrayComparison(n *A_expr_compareContext) (string, bool) {
+	op, subType, operand := n.Subquery_Op(), n.Sub_type(), n.A_expr()
+	if op == nil || subType == nil || operand == nil {
+		return "", false
+	}
+	construct := strings.ToUpper(t.trimmed(op) + " " + subType.GetText())
+	array, ok := innermostRule(operand).(*C_expr_exprContext)
+	if !ok || array.ARRAY() == nil || array.Array_expr() == nil || array.Array_expr().Array_expr_list() != nil {
+		t.report(op.GetStart(), construct+" of an array that is not an ARRAY[...] constructor")
+		return "", false
+	}
+
+	left, operator := t.trimmed(n.A_expr_like(0)), t.trimmed(op)
+	var terms []string
+	if list := array.Array_expr().Expr_list(); list != nil {
+		for _, element := range list.AllA_expr() {
+			terms = append(terms, left+" "+operator+" "+t.trimmed(element))
+		}
+	}
+	all := subType.ALL() != nil
+	switch {
+	case len(terms) == 0 && all:
+		return t.leading(n.GetStart()) + "TRUE", true
+	case len(terms) == 0:
+		return t.leading(n.GetStart()) + "FALSE", true
+	case all:
+		return t.leading(n.GetStart()) + "(" + strings.Join(terms, " AND ") + ")", true
+	default:
+		return t.leading(n.GetStart()) + "(" + strings.Join(terms, " OR ") + ")", true
+	}
+}
+
+// funcApplication rewrites string_agg as LISTAGG, and reports
+// generate_series outside FROM.
+func (t *redshiftTranspiler) funcApplication(n *Func_applicationContext) (string, bool) {
+	switch {
+	case isBuiltinFunctionCall(n, "generate_series"):
+		if _, ok := n.GetParent().GetParent().(*Func_tableContext); !ok {
+			t.report(n.GetStart(), "generate_series outside FROM")
+		}
+	case isBuiltinFunctionCall(n, "string_agg"):
+		list := n.Func_arg_list()
+		if list == nil || len(list.AllFunc_arg_expr()) != 2 || n.VARIADIC() != nil {
+			return "", false
+		}
+		var b strings.Builder
+		b.WriteString(t.leading(n.GetStart()))
+		b.WriteString("LISTAGG(")
+		if n.DISTINCT() != nil {
+			b.WriteString("DISTINCT ")
+		}
+		b.WriteString(t.trimmed(list.Func_arg_expr(0)))
+		b.WriteString(", ")
+		b.WriteString(t.trimmed(list.Func_arg_expr(1)))
+		b.WriteString(")")
+		if order := n.Opt_sort_clause(); order != nil {
+			b.WriteString(" WITHIN GROUP (" + t.trimmed(order) + ")")
+		}
+		return b.String(), true
+	}
+	return "", false
+}
+
+// generateSeries rewrites generate_series in FROM as a recursive WITH query
+// that returns the same column.
+func (t *redshiftTranspiler) generateSeries(n *Func_tableContext) (string, bool) {
+	function := n.Func_expr_windowless()
+	if function == nil || function.Func_application() == nil || n.Opt_ordinality() != nil {
+		return "", false
+	}
+	call := function.Func_application().(*Func_applicationContext)
+	if !isBuiltinFunctionCall(call, "generate_series") || call.Func_arg_list() == nil {
+		return "", false
+	}
+	args := call.Func_arg_list().AllFunc_arg_expr()
+	if len(args) < 2 || len(args) > 3 || call.VARIADIC() != nil {
+		return "", false
+	}
+	step := "1"
+	if len(args) == 3 {
+		// A number constant is an iconst or fconst under a single-child aexprconst.
+		constant := innermostRule(args[2])
+		switch constant.(type) {
+		case *IconstContext, *FconstContext:
+		default:
+			t.report(args[2].GetStart(), "generate_series with a step that is not a positive number")
+			return "", false
+		}
+		if value, err := strconv.ParseFloat(constant.GetText(), 64); err != nil || value <= 0 {
+			t.report(args[2].GetStart(), "generate_series with a step that is not a positive number")
+			return "", false
+		}
+		step = t.trimmed(args[2])
+	}
+
+	text := fmt.Sprintf("(WITH RECURSIVE generate_series(generate_series) AS (SELECT %s UNION ALL SELECT generate_series + %s FROM generate_series WHERE generate_series + %s <= %s) SELECT generate_series FROM generate_series)",
+		t.trimmed(args[0]), step, step, t.trimmed(args[1]))
+	// Redshift requires an alias for a subquery in FROM.
+	if ref, ok := n.GetParent().(*Table_refContext); ok && ref.Func_alias_clause() == nil {
+		text += " AS generate_series"
+	}
+	return t.leading(n.GetStart()) + text, true
+}
+
+// distinctOn rewrites SELECT DISTINCT ON (keys) as a QUALIFY clause that keeps
+// the first row of each key in the order of the ORDER BY of the SELECT.
+// Redshift expects QUALIFY before WINDOW.
+func (t *redshiftTranspiler) distinctOn(n *Simple_select_pramaryContext) (string, bool) {
+	distinct := n.Distinct_clause()
+	if distinct == nil || distinct.ON() == nil {
+		return "", false
+	}
+	qualify := " QUALIFY ROW_NUMBER() OVER (PARTITION BY " + t.trimmed(distinct.Expr_list())
+	if order := selectSortClause(n); order != nil {
+		qualify += " " + t.trimmed(order)
+	}
+	qualify += ") = 1"
+
+	var b strings.Builder
+	for _, child := range n.GetChildren() {
+		switch child {
+		case distinct:
+			continue
+		case n.Window_clause():
+			b.WriteString(qualify)
+			qualify = ""
+		}
+		b.WriteString(t.text(child))
+	}
+	b.WriteString(qualify)
+	return b.String(), true
+}
+
+// serialTypes maps the serial types to the integer type of their column.
+var serialTypes = map[string]string{
+	"smallserial": "SMALLINT",
+	"serial2":     "SMALLINT",
+	"serial":      "INTEGER",
+	"serial4":     "INTEGER",
+	"bigserial":   "BIGINT",
+	"serial8":     "BIGINT",
+}
+
+// serial rewrites the serial type of a CREATE TABLE column as an IDENTITY
+// column of its integer type.
+func (t *redshiftTranspiler) serial(n *TypenameContext) (string, bool) {
+	columnType, ok := serialTypes[strings.ToLower(n.GetText())]
+	if !ok {
+		return "", false
+	}
+	if _, ok := n.GetParent().(*ColumnDefContext); !ok {
+		return "", false
+	}
+	for parent := n.GetParent(); parent != nil; parent = parent.GetParent() {
+		if _, ok := parent.(*CreatestmtContext); ok {
+			return t.leading(n.GetStart()) + columnType + " IDENTITY(1, 1)", true
+		}
+	}
+	t.report(n.GetStart(), "serial column outside CREATE TABLE")
+	return "", false
+}
+
+// isBuiltinFunctionCall reports whether n calls the built-in function name.
+func isBuiltinFunctionCall(n *Func_applicationContext, name string) bool {
+	parts := splitQualifiedName(n.Func_name().GetText())
+	if parts[len(parts)-1] != name {
+		return false
+	}
+	return len(parts) == 1 || len(parts) == 2 && parts[0] == "pg_catalog"
+}
+
+// selectSortClause returns the ORDER BY of the SELECT that n is the whole
+// of, or nil.
+func selectSortClause(n *Simple_select_pramaryContext) ISort_clauseContext {
+	intersect, ok := n.GetParent().(*Simple_select_intersectContext)
+	if !ok || intersect.GetChildCount() != 1 {
+		return nil
+	}
+	clause, ok := intersect.GetParent().(*Select_clauseContext)
+	if !ok || clause.GetChildCount() != 1 {
+		return nil
+	}
+	statement, ok := clause.GetParent().(*Select_no_parensContext)
+	if !ok || statement.Opt_sort_clause() == nil {
+		return nil
+	}
+	return statement.Opt_sort_clause().Sort_clause()
+}
+
+// innermostRule follows node down while it has a single child rule.
+func innermostRule(node antlr.ParserRuleContext) antlr.ParserRuleContext {
+	for node.GetChildCount() == 1 {
+		child, ok := node.GetChild(0).(antlr.ParserRuleContext)
+		if !ok {
+			break
+		}
+		node = child
+	}
+	return node
+}
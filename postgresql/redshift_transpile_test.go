@@ -0,0 +1,82 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/bytebase/parser/redshift"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranspileToRedshift(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+		errs      []string
+	}{
+		{
+			statement: "SELECT id::text, (price * 2)::numeric(10, 2) FROM t;",
+			want:      "SELECT CAST(id AS text), CAST((price * 2) AS numeric(10, 2)) FROM t;",
+		},
+		{
+			statement: "SELECT name FROM t WHERE name ILIKE ANY (ARRAY['a%', 'b%']) AND code NOT LIKE ALL (ARRAY['x%']);",
+			want:      "SELECT name FROM t WHERE (name ILIKE 'a%' OR name ILIKE 'b%') AND (code NOT LIKE 'x%');",
+		},
+		{
+			statement: "SELECT d FROM generate_series(1, 10, 2) AS g(d);",
+			want:      "SELECT d FROM (WITH RECURSIVE generate_series(generate_series) AS (SELECT 1 UNION ALL SELECT generate_series + 2 FROM generate_series WHERE generate_series + 2 <= 10) SELECT generate_series FROM generate_series) AS g(d);",
+		},
+		{
+			statement: "SELECT dept, string_agg(name, ', ' ORDER BY name) FROM emp GROUP BY dept;",
+			want:      "SELECT dept, LISTAGG(name, ', ') WITHIN GROUP (ORDER BY name) FROM emp GROUP BY dept;",
+		},
+		{
+			statement: "SELECT DISTINCT ON (customer_id) customer_id, total FROM orders ORDER BY customer_id, created_at DESC;",
+			want:      "SELECT customer_id, total FROM orders QUALIFY ROW_NUMBER() OVER (PARTITION BY customer_id ORDER BY customer_id, created_at DESC) = 1 ORDER BY customer_id, created_at DESC;",
+		},
+		{
+			statement: "SELECT * FROM t -- all rows\nLIMIT ALL OFFSET 10;",
+			want:      "SELECT * FROM t -- all rows\n OFFSET 10;",
+		},
+		{
+			statement: "CREATE TABLE t (id bigserial PRIMARY KEY, name varchar(32));",
+			want:      "CREATE TABLE t (id BIGINT IDENTITY(1, 1) PRIMARY KEY, name varchar(32));",
+		},
+		{
+			statement: "SELECT generate_series(1, 3), tags::text[] FROM t;\nLISTEN jobs;",
+			want:      "SELECT generate_series(1, 3), CAST(tags AS text[]) FROM t;\nLISTEN jobs;",
+			errs: []string{
+				"line 1:7 cannot translate generate_series outside FROM to Redshift",
+				"line 1:36 cannot translate array types to Redshift",
+				"line 2:0 cannot translate LISTEN to Redshift",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, errs, err := pgparser.TranspileToRedshift(test.statement)
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+		var messages []string
+		for _, e := range errs {
+			messages = append(messages, e.Error())
+		}
+		require.Equal(t, test.errs, messages, test.statement)
+		if len(errs) > 0 {
+			continue
+		}
+
+		// The output is valid Redshift.
+		lexer := redshift.NewRedshiftLexer(antlr.NewInputStream(got))
+		parser := redshift.NewRedshiftParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		collector := &syntaxErrorCollector{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+		parser.RemoveErrorListeners()
+		parser.AddErrorListener(collector)
+		parser.Root()
+		require.Empty(t, collector.messages, got)
+	}
+
+	_, _, err := pgparser.TranspileToRedshift("SELECT FROM WHERE;")
+	require.Error(t, err)
+}
@@ -0,0 +1,194 @@
+package postgresql
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// sourcePosition is a position in the script: the offset of a character
+// counted in characters, and its line and column as ANTLR reports them.
+type sourcePosition struct {
+	offset int
+	line   int
+	column int
+}
+
+// sourceMap maps the characters of a text extracted from the script, such as
+// a routine body with its quotes unescaped, to their positions in the script.
+type sourceMap struct {
+	// positions holds one position per character of the text and one for
+	// the end of the text.
+	positions []sourcePosition
+}
+
+// position returns the script position of the character at offset in the text.
+func (m *sourceMap) position(offset int) sourcePosition {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(m.positions) {
+		offset = len(m.positions) - 1
+	}
+	return m.positions[offset]
+}
+
+// sourceMapLexer gives the tokens of a text the line and column of their first
+// character in the script. Their start and stop stay offsets in the text, so
+// the text of a tree can still be read from its input stream.
+type sourceMapLexer struct {
+	*PostgreSQLLexer
+
+	sourceMap *sourceMap
+}
+
+func (l *sourceMapLexer) NextToken() antlr.Token {
+	token := l.PostgreSQLLexer.NextToken()
+	if l.sourceMap == nil {
+		return token
+	}
+	position := l.sourceMap.position(token.GetStart())
+	return antlr.CommonTokenFactoryDEFAULT.Create(token.GetSource(), token.GetTokenType(), token.GetText(), token.GetChannel(), token.GetStart(), token.GetStop(), position.line, position.column)
+}
+
+// routineBodySource returns the text of a routine body and the script
+// positions of its characters. parent maps the text sconst was parsed from to
+// the script; it is nil if sconst was parsed from the script itself.
+func routineBodySource(rule *SconstContext, parent *sourceMap) (string, *sourceMap) {
+	if rule.Anysconst() == nil {
+		return "", &sourceMap{positions: []sourcePosition{sourcePositionOf(rule.GetStart(), parent)}}
+	}
+	anySConstContext := rule.Anysconst().(*AnysconstContext)
+	start := anySConstContext.GetStart()
+	raw := []rune(anySConstContext.GetText())
+
+	// Find where every character of the literal is in the script.
+	positions := make([]sourcePosition, len(raw)+1)
+	if parent != nil {
+		for i := range positions {
+			positions[i] = parent.position(start.GetStart() + i)
+		}
+	} else {
+		line, column := start.GetLine(), start.GetColumn()
+		for i := range positions {
+			positions[i] = sourcePosition{offset: start.GetStart() + i, line: line, column: column}
+			if i < len(raw) && raw[i] == '\n' {
+				line, column = line+1, 0
+			} else {
+				column++
+			}
+		}
+	}
+
+	// Strip the delimiters and decode the escapes of standard and E'...' strings.
+	prefix, suffix, quoted, escaped := 1, 1, false, false
+	switch {
+	case anySConstContext.StringConstant() != nil:
+		quoted = true
+	case anySConstContext.UnicodeEscapeStringConstant() != nil:
+		prefix = 3
+	case anySConstContext.EscapeStringConstant() != nil:
+		prefix, quoted, escaped = 2, true, true
+	default:
+		prefix = len([]rune(start.GetText()))
+		suffix = len([]rune(anySConstContext.GetStop().GetText()))
+	}
+	end := len(raw) - suffix
+	if end < prefix {
+		end = prefix
+	}
+
+	var text strings.Builder
+	result := &sourceMap{}
+	for i := prefix; i < end; {
+		r, n := raw[i], 1
+		switch {
+		case quoted && raw[i] == '\'' && i+1 < end && raw[i+1] == '\'':
+			n = 2
+		case escaped && raw[i] == '\\' && i+1 < end:
+			r, n = decodeEscape(raw[i+1 : end])
+			n++
+		}
+		// A decoded character is positioned at the start of its escape.
+		result.positions = append(result.positions, positions[i])
+		text.WriteRune(r)
+		i += n
+	}
+	result.positions = append(result.positions, positions[end])
+	return text.String(), result
+}
+
+// decodeEscape decodes the backslash escape whose text, after the backslash,
+// starts s, as in an E'...' string. It returns the character and the number of
+// runes of s the escape uses.
+func decodeEscape(s []rune) (rune, int) {
+	switch c := s[0]; c {
+	case 'b':
+		return '\b', 1
+	case 'f':
+		return '\f', 1
+	case 'n':
+		return '\n', 1
+	case 'r':
+		return '\r', 1
+	case 't':
+		return '\t', 1
+	case 'x':
+		if r, n := escapeDigits(s[1:], 16, 2); n > 0 {
+			return r, n + 1
+		}
+	case 'u':
+		if r, n := escapeDigits(s[1:], 16, 4); n == 4 {
+			return r, n + 1
+		}
+	case 'U':
+		if r, n := escapeDigits(s[1:], 16, 8); n == 8 {
+			return r, n + 1
+		}
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		return escapeDigits(s, 8, 3)
+	}
+	// Any other character, including ' and \, stands for itself.
+	return s[0], 1
+}
+
+// escapeDigits reads up to max digits in base from the start of s.
+func escapeDigits(s []rune, base, max int) (rune, int) {
+	var r rune
+	n := 0
+	for ; n < max && n < len(s); n++ {
+		digit := strings.IndexRune("0123456789abcdef"[:base], unicode.ToLower(s[n]))
+		if digit < 0 {
+			break
+		}
+		r = r*rune(base) + rune(digit)
+	}
+	return r, n
+}
+
+// sourcePositionOf returns the script position of a token parsed from a text
+// that parent maps to the script, or from the script itself if parent is nil.
+func sourcePositionOf(token antlr.Token, parent *sourceMap) sourcePosition {
+	if parent != nil {
+		return parent.position(token.GetStart())
+	}
+	return sourcePosition{offset: token.GetStart(), line: token.GetLine(), column: token.GetColumn()}
+}
+
+// scriptSourceMap maps a script that starts at the beginning of a line of an
+// enclosing file, after lines lines.
+func scriptSourceMap(script string, lines int) *sourceMap {
+	result := &sourceMap{}
+	line, column := lines+1, 0
+	for offset, r := range []rune(script) {
+		result.positions = append(result.positions, sourcePosition{offset: offset, line: line, column: column})
+		if r == '\n' {
+			line, column = line+1, 0
+		} else {
+			column++
+		}
+	}
+	result.positions = append(result.positions, sourcePosition{offset: len(result.positions), line: line, column: column})
+	return result
+}
@@ -0,0 +1,362 @@
+package postgresql
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Statement is a statement read by a StatementScanner.
+type Statement struct {
+	// Text is the statement from its first token to its terminating
+	// semicolon, without the comments before it.
+	Text string
+	// Line and Column are the position of the first token of the statement
+	// in the script.
+	Line   int
+	Column int
+	Tree   IRootContext
+	// Errors are the syntax errors in the statement, including those in its
+	// routine bodies, positioned in the script.
+	Errors []*PostgreSQLParseError
+}
+
+// StatementScanner parses a script read from an io.Reader one statement at a
+// time, so that the memory it uses depends on the size of the largest
+// statement rather than the size of the script. The data that follows COPY
+// ... FROM STDIN is read a row at a time and is not part of any statement.
+//
+// Scan reads the next statement, which Statement then returns:
+//
+//	scanner := NewStatementScanner(r)
+//	for scanner.Scan() {
+//		statement := scanner.Statement()
+//		...
+//	}
+//	if err := scanner.Err(); err != nil {
+//		...
+//	}
+type StatementScanner struct {
+	// Engine and TargetVersion are set on the parser of every statement.
+	Engine        Engine
+	TargetVersion int
+	// CopyRowHandler, if set, is called with every row of the data that
+	// follows a COPY ... FROM STDIN statement. The rows are read when Scan is
+	// called after the statement is returned.
+	CopyRowHandler func(statement *Statement, row string)
+
+	input     *readerCharStream
+	lexer     *PostgreSQLLexer
+	statement *Statement
+	// copyData is the COPY ... FROM STDIN statement whose data is next.
+	copyData *Statement
+	done     bool
+}
+
+// NewStatementScanner returns a scanner that reads a PostgreSQL script from r.
+func NewStatementScanner(r io.Reader) *StatementScanner {
+	input := &readerCharStream{reader: bufio.NewReader(r)}
+	lexer := NewPostgreSQLLexer(input)
+	lexer.RemoveErrorListeners()
+	return &StatementScanner{
+		Engine: EnginePostgreSQL,
+		input:  input,
+		lexer:  lexer,
+	}
+}
+
+// Scan parses the next statement. It returns false at the end of the script
+// or if reading fails, when Err returns the error.
+func (s *StatementScanner) Scan() bool {
+	s.statement = nil
+	if s.copyData != nil {
+		s.readCopyData(s.copyData)
+		s.copyData = nil
+	}
+	if s.done {
+		return false
+	}
+	s.input.discard()
+
+	var tokens []antlr.Token
+	var boundary statementBoundary
+	first := -1
+	for !boundary.end {
+		token := s.lexer.NextToken()
+		if token.GetTokenType() == antlr.TokenEOF {
+			s.done = true
+			break
+		}
+		// Copy the text: the input it points into is discarded.
+		tokens = append(tokens, antlr.CommonTokenFactoryDEFAULT.Create(s.lexer.GetTokenSourceCharStreamPair(), token.GetTokenType(), token.GetText(), token.GetChannel(), token.GetStart(), token.GetStop(), token.GetLine(), token.GetColumn()))
+		if token.GetChannel() == antlr.TokenDefaultChannel {
+			if first < 0 {
+				first = len(tokens) - 1
+			}
+			boundary.track(token)
+		}
+	}
+	if first < 0 {
+		return false
+	}
+
+	s.statement = s.parse(tokens, first)
+	if s.lexer.copyStatement.dataPending {
+		// The scanner reads the data itself, a row at a time.
+		s.lexer.copyStatement.dataPending = false
+		s.copyData = s.statement
+	}
+	return true
+}
+
+// Statement returns the statement parsed by the last call to Scan.
+func (s *StatementScanner) Statement() *Statement {
+	return s.statement
+}
+
+// Err returns the error that stopped reading the script, or nil at the end of
+// the script.
+func (s *StatementScanner) Err() error {
+	return s.input.err
+}
+
+func (s *StatementScanner) parse(tokens []antlr.Token, first int) *Statement {
+	var text strings.Builder
+	for _, token := range tokens[first:] {
+		text.WriteString(token.GetText())
+	}
+	last := tokens[len(tokens)-1]
+	eof := antlr.CommonTokenFactoryDEFAULT.Create(s.lexer.GetTokenSourceCharStreamPair(), antlr.TokenEOF, "", antlr.TokenDefaultChannel, last.GetStop()+1, last.GetStop(), last.GetLine(), last.GetColumn()+len([]rune(last.GetText())))
+
+	parser := NewPostgreSQLParser(antlr.NewCommonTokenStream(&tokenListSource{PostgreSQLLexer: s.lexer, tokens: tokens, eof: eof}, antlr.TokenDefaultChannel))
+	parser.Engine = s.Engine
+	parser.TargetVersion = s.TargetVersion
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(&PostgreSQLParserErrorListener{grammar: parser})
+	tree := parser.Root()
+	return &Statement{
+		Text:   text.String(),
+		Line:   tokens[first].GetLine(),
+		Column: tokens[first].GetColumn(),
+		Tree:   tree,
+		Errors: parser.parseErrors,
+	}
+}
+
+// readCopyData reads the data that follows statement up to the \. line,
+// passing each row to CopyRowHandler. It finds the data the way the lexer
+// does: if there is none, the rest of the script is read as SQL.
+func (s *StatementScanner) readCopyData(statement *Statement) {
+	simulator := s.lexer.Interpreter.(*antlr.LexerATNSimulator)
+	// The data starts on the line after the statement.
+	newline := 1
+	for c := s.input.LA(newline); c != '\n'; c = s.input.LA(newline) {
+		if c == antlr.TokenEOF {
+			return
+		}
+		newline++
+	}
+	if !copyDataFollows(s.input, newline+1) {
+		return
+	}
+	for i := 1; i < newline; i++ {
+		s.input.Consume()
+	}
+	var row []rune
+	for s.input.LA(1) != antlr.TokenEOF {
+		s.input.Consume()
+		simulator.Line++
+		simulator.CharPositionInLine = 0
+		row = row[:0]
+		for c := s.input.LA(1); c != antlr.TokenEOF && c != '\n'; c = s.input.LA(1) {
+			row = append(row, rune(c))
+			s.input.Consume()
+		}
+		s.input.discard()
+		line := strings.TrimSuffix(string(row), "\r")
+		if line == `\.` {
+			// The lexer continues after the \. line.
+			simulator.CharPositionInLine = len(row)
+			return
+		}
+		if s.CopyRowHandler != nil {
+			s.CopyRowHandler(statement, line)
+		}
+	}
+}
+
+// statementBoundary finds the semicolon that ends a statement. Semicolons in
+// parentheses, as in CREATE RULE, and in BEGIN ATOMIC routine bodies do not.
+// A psql backslash command ends the statement as well.
+type statementBoundary struct {
+	parentheses int
+	// atomic is the number of BEGIN ATOMIC bodies the statement is in, and
+	// cases the number of CASE expressions in them.
+	atomic            int
+	cases             int
+	previousTokenType int
+	end               bool
+}
+
+func (b *statementBoundary) track(token antlr.Token) {
+	tokenType := token.GetTokenType()
+	switch tokenType {
+	case PostgreSQLLexerOPEN_PAREN:
+		b.parentheses++
+	case PostgreSQLLexerCLOSE_PAREN:
+		b.parentheses--
+	case PostgreSQLLexerATOMIC_P:
+		if b.previousTokenType == PostgreSQLLexerBEGIN_P {
+			b.atomic++
+		}
+	case PostgreSQLLexerCASE:
+		if b.atomic > 0 {
+			b.cases++
+		}
+	case PostgreSQLLexerEND_P:
+		if b.cases > 0 {
+			b.cases--
+		} else if b.atomic > 0 {
+			b.atomic--
+		}
+	case PostgreSQLLexerSEMI:
+		b.end = b.parentheses <= 0 && b.atomic == 0
+	case PostgreSQLLexerMetaCommand:
+		b.end = b.parentheses <= 0 && b.atomic == 0
+	}
+	b.previousTokenType = tokenType
+}
+
+// tokenListSource is a token source for the tokens of a single statement.
+type tokenListSource struct {
+	*PostgreSQLLexer
+
+	tokens []antlr.Token
+	eof    antlr.Token
+	next   int
+}
+
+func (s *tokenListSource) NextToken() antlr.Token {
+	if s.next == len(s.tokens) {
+		return s.eof
+	}
+	s.next++
+	return s.tokens[s.next-1]
+}
+
+// readerCharStream is a CharStream that reads its characters from a reader as
+// the lexer needs them. Its indexes count from the start of the script, but it
+// only keeps the characters read since the last call to discard.
+type readerCharStream struct {
+	reader *bufio.Reader
+	// data holds the characters from offset on.
+	data   []rune
+	offset int
+	index  int
+	eof    bool
+	err    error
+}
+
+// fill reads until the character at index i is in data, and returns whether
+// it is.
+func (c *readerCharStream) fill(i int) bool {
+	for i-c.offset >= len(c.data) && !c.eof {
+		r, _, err := c.reader.ReadRune()
+		if err != nil {
+			c.eof = true
+			if err != io.EOF {
+				c.err = err
+			}
+			break
+		}
+		c.data = append(c.data, r)
+	}
+	return i-c.offset < len(c.data)
+}
+
+// discard drops the characters before the current index.
+func (c *readerCharStream) discard() {
+	if c.index <= c.offset {
+		return
+	}
+	c.data = append([]rune(nil), c.data[c.index-c.offset:]...)
+	c.offset = c.index
+}
+
+func (c *readerCharStream) Consume() {
+	if !c.fill(c.index) {
+		panic("cannot consume EOF")
+	}
+	c.index++
+}
+
+func (c *readerCharStream) LA(offset int) int {
+	if offset == 0 {
+		return 0
+	}
+	if offset < 0 {
+		offset++
+	}
+	i := c.index + offset - 1
+	if i < c.offset || !c.fill(i) {
+		return antlr.TokenEOF
+	}
+	return int(c.data[i-c.offset])
+}
+
+func (c *readerCharStream) Mark() int {
+	return -1
+}
+
+func (c *readerCharStream) Release(int) {}
+
+func (c *readerCharStream) Index() int {
+	return c.index
+}
+
+func (c *readerCharStream) Seek(index int) {
+	if index < c.offset {
+		index = c.offset
+	}
+	c.fill(index - 1)
+	if index > c.offset+len(c.data) {
+		index = c.offset + len(c.data)
+	}
+	c.index = index
+}
+
+// Size returns the number of characters read so far; the size of the script
+// is not known until it is read.
+func (c *readerCharStream) Size() int {
+	return c.offset + len(c.data)
+}
+
+func (c *readerCharStream) GetSourceName() string {
+	return "<stream>"
+}
+
+func (c *readerCharStream) GetText(start, stop int) string {
+	if start < c.offset {
+		start = c.offset
+	}
+	if stop >= c.offset+len(c.data) {
+		stop = c.offset + len(c.data) - 1
+	}
+	if start > stop {
+		return ""
+	}
+	return string(c.data[start-c.offset : stop-c.offset+1])
+}
+
+func (c *readerCharStream) GetTextFromTokens(start, end antlr.Token) string {
+	if start == nil || end == nil {
+		return ""
+	}
+	return c.GetText(start.GetStart(), end.GetStop())
+}
+
+func (c *readerCharStream) GetTextFromInterval(interval antlr.Interval) string {
+	return c.GetText(interval.Start, interval.Stop)
+}
@@ -0,0 +1,67 @@
+package postgresql_test
+
+import (
+	"strings"
+	"testing"
+
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementScanner(t *testing.T) {
+	script := `-- pg_dump
+CREATE FUNCTION one() RETURNS int LANGUAGE sql
+BEGIN ATOMIC
+  SELECT CASE WHEN true THEN 1 END;
+END;
+
+` + pgDump + `
+SELECT 'a;b' FROM;
+`
+	scanner := pgparser.NewStatementScanner(strings.NewReader(script))
+	var rows []string
+	scanner.CopyRowHandler = func(statement *pgparser.Statement, row string) {
+		require.Equal(t, 11, statement.Line)
+		rows = append(rows, row)
+	}
+	var statements []*pgparser.Statement
+	for scanner.Scan() {
+		statements = append(statements, scanner.Statement())
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, statements, 4)
+
+	require.Equal(t, 2, statements[0].Line)
+	require.Empty(t, statements[0].Errors)
+	require.NotNil(t, statements[0].Tree.Stmtblock().Stmtmulti().Stmt(0).Createfunctionstmt())
+
+	require.Equal(t, "COPY public.users (id, name, note) FROM stdin;", statements[1].Text)
+	require.Len(t, rows, 3)
+
+	// Positions after the data are those in the script.
+	require.Equal(t, 18, statements[2].Line)
+	require.Empty(t, statements[2].Errors)
+
+	require.Equal(t, "SELECT 'a;b' FROM;", statements[3].Text)
+	require.NotEmpty(t, statements[3].Errors)
+	require.Equal(t, 20, statements[3].Errors[0].Line)
+}
+
+func TestStatementScannerCopyWithoutData(t *testing.T) {
+	scanner := pgparser.NewStatementScanner(strings.NewReader("COPY a FROM stdin;\nSELECT 1;\nCOPY b FROM stdin;\n1\n\\.\nSELECT 2;\nCOPY c FROM stdin;\n"))
+	rows := map[string][]string{}
+	scanner.CopyRowHandler = func(statement *pgparser.Statement, row string) {
+		rows[statement.Text] = append(rows[statement.Text], row)
+	}
+	var texts []string
+	var lines []int
+	for scanner.Scan() {
+		texts = append(texts, scanner.Statement().Text)
+		lines = append(lines, scanner.Statement().Line)
+	}
+	require.NoError(t, scanner.Err())
+	// The lexer and the scanner agree on which COPY statements have data.
+	require.Equal(t, []string{"COPY a FROM stdin;", "SELECT 1;", "COPY b FROM stdin;", "SELECT 2;", "COPY c FROM stdin;"}, texts)
+	require.Equal(t, []int{1, 2, 3, 6, 7}, lines)
+	require.Equal(t, map[string][]string{"COPY b FROM stdin;": {"1"}}, rows)
+}
@@ -0,0 +1,40 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/bytebase/parser/treequery"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeQuery(t *testing.T) {
+	lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream("SELECT a FROM t WHERE b = NULL;\nUPDATE t SET a = 1 WHERE c = 2;\nDELETE FROM u WHERE D = null;"))
+	parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	tree := parser.Root()
+
+	wheres, err := treequery.FindAll(tree, "//selectstmt//where_clause/a_expr", parser)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b=NULL"}, texts(wheres))
+
+	matcher := treequery.NewPatternMatcher(pgparser.NewPostgreSQLLexer(nil), parser)
+	pattern, err := matcher.Compile("<col:columnref> = NULL", "a_expr")
+	require.NoError(t, err)
+	matches, err := pattern.FindAll(tree, "//a_expr")
+	require.NoError(t, err)
+	var columns []string
+	for _, match := range matches {
+		columns = append(columns, match.Get("col").GetText())
+	}
+	require.Equal(t, []string{"b", "D"}, columns)
+}
+
+func texts(nodes []antlr.ParseTree) []string {
+	var result []string
+	for _, node := range nodes {
+		result = append(result, node.GetText())
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// VersionError is syntax that a PostgreSQL server older than Since does not
+// support.
+type VersionError struct {
+	Line   int
+	Column int
+	// Syntax names the unsupported syntax, e.g. "MERGE" or "JSON_TABLE".
+	Syntax string
+	// Since is the major version that introduced the syntax.
+	Since int
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("line %d:%d %s requires PostgreSQL %d or later", e.Line, e.Column, e.Syntax, e.Since)
+}
+
+// CheckTargetVersion reports the syntax in parseTree that PostgreSQL version,
+// a major version such as 15, does not support. Routine bodies and dynamic
+// SQL are parsed with their own trees and are not checked.
+func CheckTargetVersion(parseTree antlr.Tree, version int) []*VersionError {
+	var result []*VersionError
+	for _, use := range findVersionedSyntax(parseTree) {
+		if use.since > version {
+			result = append(result, &VersionError{
+				Line:   use.token.GetLine(),
+				Column: use.token.GetColumn(),
+				Syntax: use.syntax,
+				Since:  use.since,
+			})
+		}
+	}
+	return result
+}
+
+// versionedSyntax is a use of syntax that only recent PostgreSQL versions
+// support.
+type versionedSyntax struct {
+	token  antlr.Token
+	syntax string
+	since  int
+}
+
+func (s *versionedSyntax) message() string {
+	return fmt.Sprintf("%s requires PostgreSQL %d or later", s.syntax, s.since)
+}
+
+func findVersionedSyntax(parseTree antlr.Tree) []*versionedSyntax {
+	finder := &versionedSyntaxFinder{BasePostgreSQLParserListener: &BasePostgreSQLParserListener{}}
+	antlr.ParseTreeWalkerDefault.Walk(finder, parseTree)
+	return finder.uses
+}
+
+// sqlJSONSince is the version that added each SQL/JSON function, by the token
+// that starts it.
+var sqlJSONSince = map[int]struct {
+	syntax string
+	since  int
+}{
+	PostgreSQLParserJSON_OBJECT:    {"JSON_OBJECT", 16},
+	PostgreSQLParserJSON_ARRAY:     {"JSON_ARRAY", 16},
+	PostgreSQLParserJSON_OBJECTAGG: {"JSON_OBJECTAGG", 16},
+	PostgreSQLParserJSON_ARRAYAGG:  {"JSON_ARRAYAGG", 16},
+	PostgreSQLParserJSON:           {"JSON", 17},
+	PostgreSQLParserJSON_SCALAR:    {"JSON_SCALAR", 17},
+	PostgreSQLParserJSON_SERIALIZE: {"JSON_SERIALIZE", 17},
+	PostgreSQLParserJSON_QUERY:     {"JSON_QUERY", 17},
+	PostgreSQLParserJSON_EXISTS:    {"JSON_EXISTS", 17},
+	PostgreSQLParserJSON_VALUE:     {"JSON_VALUE", 17},
+}
+
+// copyOptionSince is the version that added each COPY option, by its lower
+// case name.
+var copyOptionSince = map[string]int{
+	"default":       16,
+	"on_error":      17,
+	"log_verbosity": 17,
+}
+
+type versionedSyntaxFinder struct {
+	*BasePostgreSQLParserListener
+
+	uses []*versionedSyntax
+}
+
+func (f *versionedSyntaxFinder) add(token antlr.Token, syntax string, since int) {
+	f.uses = append(f.uses, &versionedSyntax{token: token, syntax: syntax, since: since})
+}
+
+func (f *versionedSyntaxFinder) EnterCreatefunc_opt_item(ctx *Createfunc_opt_itemContext) {
+	if ctx.ATOMIC_P() != nil {
+		f.add(ctx.GetStart(), "BEGIN ATOMIC", 14)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterMergestmt(ctx *MergestmtContext) {
+	f.add(ctx.MERGE().GetSymbol(), "MERGE", 15)
+}
+
+func (f *versionedSyntaxFinder) EnterReturning_clause(ctx *Returning_clauseContext) {
+	if _, ok := ctx.GetParent().(*MergestmtContext); ok {
+		f.add(ctx.GetStart(), "MERGE ... RETURNING", 17)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterMerge_when_tgt_matched(ctx *Merge_when_tgt_matchedContext) {
+	if ctx.SOURCE() != nil {
+		f.add(ctx.GetStart(), "WHEN NOT MATCHED BY SOURCE", 17)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterMerge_when_tgt_not_matched(ctx *Merge_when_tgt_not_matchedContext) {
+	if ctx.TARGET() != nil {
+		f.add(ctx.GetStart(), "WHEN NOT MATCHED BY TARGET", 17)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterFunc_application(ctx *Func_applicationContext) {
+	parts := splitQualifiedName(ctx.Func_name().GetText())
+	if parts[len(parts)-1] == "merge_action" {
+		f.add(ctx.GetStart(), "merge_action()", 17)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterOpt_unique_null_treatment(ctx *Opt_unique_null_treatmentContext) {
+	f.add(ctx.GetStart(), "NULLS [NOT] DISTINCT", 15)
+}
+
+func (f *versionedSyntaxFinder) EnterFunc_expr_common_subexpr(ctx *Func_expr_common_subexprContext) {
+	// JSON_OBJECT with a plain argument list is the json_object function.
+	if ctx.JSON_OBJECT() != nil && ctx.Func_arg_list() != nil {
+		return
+	}
+	if s, ok := sqlJSONSince[ctx.GetStart().GetTokenType()]; ok {
+		f.add(ctx.GetStart(), s.syntax, s.since)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterJson_aggregate_func(ctx *Json_aggregate_funcContext) {
+	if s, ok := sqlJSONSince[ctx.GetStart().GetTokenType()]; ok {
+		f.add(ctx.GetStart(), s.syntax, s.since)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterJson_predicate_type_constraint(ctx *Json_predicate_type_constraintContext) {
+	f.add(ctx.GetStart(), "IS JSON", 16)
+}
+
+func (f *versionedSyntaxFinder) EnterJson_table(ctx *Json_tableContext) {
+	f.add(ctx.GetStart(), "JSON_TABLE", 17)
+}
+
+func (f *versionedSyntaxFinder) EnterGrant_role_opt(ctx *Grant_role_optContext) {
+	name := strings.ToLower(ctx.Collabel().GetText())
+	if name != "admin" || ctx.Grant_role_opt_value().OPTION() == nil {
+		f.add(ctx.GetStart(), "GRANT ... WITH "+strings.ToUpper(name)+" "+strings.ToUpper(ctx.Grant_role_opt_value().GetText()), 16)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterRevokerolestmt(ctx *RevokerolestmtContext) {
+	if option := ctx.Colid(); option != nil && strings.ToLower(option.GetText()) != "admin" {
+		f.add(option.GetStart(), "REVOKE "+strings.ToUpper(option.GetText())+" OPTION FOR", 16)
+	}
+}
+
+func (f *versionedSyntaxFinder) EnterCopy_generic_opt_elem(ctx *Copy_generic_opt_elemContext) {
+	name := strings.ToLower(ctx.Collabel().GetText())
+	if since, ok := copyOptionSince[name]; ok {
+		f.add(ctx.GetStart(), "COPY option "+strings.ToUpper(name), since)
+	}
+}
@@ -0,0 +1,119 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	pgparser "github.com/bytebase/parser/postgresql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTargetVersion(t *testing.T) {
+	tests := []struct {
+		statement string
+		version   int
+		want      []string
+	}{
+		{
+			statement: "MERGE INTO t USING s ON t.id = s.id WHEN MATCHED THEN DELETE;",
+			version:   14,
+			want:      []string{"line 1:0 MERGE requires PostgreSQL 15 or later"},
+		},
+		{
+			statement: "MERGE INTO t USING s ON t.id = s.id WHEN MATCHED THEN DELETE;",
+			version:   15,
+		},
+		{
+			statement: `MERGE INTO t USING s ON t.id = s.id
+WHEN NOT MATCHED BY SOURCE THEN DELETE
+RETURNING merge_action(), t.*;`,
+			version: 16,
+			want: []string{
+				"line 2:0 WHEN NOT MATCHED BY SOURCE requires PostgreSQL 17 or later",
+				"line 3:0 MERGE ... RETURNING requires PostgreSQL 17 or later",
+				"line 3:10 merge_action() requires PostgreSQL 17 or later",
+			},
+		},
+		{
+			statement: "CREATE UNIQUE INDEX i ON t (a) NULLS NOT DISTINCT;",
+			version:   14,
+			want:      []string{"line 1:31 NULLS [NOT] DISTINCT requires PostgreSQL 15 or later"},
+		},
+		{
+			statement: "SELECT JSON_OBJECT('a' VALUE 1), json_object('{a,1}'), x IS JSON FROM t;",
+			version:   15,
+			want: []string{
+				"line 1:7 JSON_OBJECT requires PostgreSQL 16 or later",
+				"line 1:60 IS JSON requires PostgreSQL 16 or later",
+			},
+		},
+		{
+			statement: "SELECT * FROM JSON_TABLE(j, '$[*]' COLUMNS (a int PATH '$.a')) jt;",
+			version:   16,
+			want:      []string{"line 1:14 JSON_TABLE requires PostgreSQL 17 or later"},
+		},
+		{
+			statement: "COPY t FROM '/tmp/t.csv' WITH (FORMAT csv, ON_ERROR ignore);",
+			version:   16,
+			want:      []string{"line 1:43 COPY option ON_ERROR requires PostgreSQL 17 or later"},
+		},
+		{
+			statement: "GRANT r TO u WITH ADMIN OPTION;",
+			version:   12,
+		},
+		{
+			statement: "GRANT r TO u WITH SET FALSE, INHERIT TRUE;",
+			version:   15,
+			want: []string{
+				"line 1:18 GRANT ... WITH SET FALSE requires PostgreSQL 16 or later",
+				"line 1:29 GRANT ... WITH INHERIT TRUE requires PostgreSQL 16 or later",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		_, tree := parseStatement(test.statement)
+		var got []string
+		for _, err := range pgparser.CheckTargetVersion(tree, test.version) {
+			got = append(got, err.Error())
+		}
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
+
+type syntaxErrorCollector struct {
+	*antlr.DefaultErrorListener
+
+	messages []string
+}
+
+func (c *syntaxErrorCollector) SyntaxError(_ antlr.Recognizer, _ interface{}, _, _ int, msg string, _ antlr.RecognitionException) {
+	c.messages = append(c.messages, msg)
+}
+
+func TestTargetVersion(t *testing.T) {
+	parse := func(statement string, version int) []string {
+		lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+		parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		parser.TargetVersion = version
+		collector := &syntaxErrorCollector{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+		parser.RemoveErrorListeners()
+		parser.AddErrorListener(collector)
+		parser.Root()
+		return collector.messages
+	}
+
+	statement := "MERGE INTO t USING s ON t.id = s.id WHEN MATCHED THEN DELETE;"
+	require.Equal(t, []string{"MERGE requires PostgreSQL 15 or later"}, parse(statement, 14))
+	require.Empty(t, parse(statement, 15))
+	require.Empty(t, parse(statement, 0))
+
+	// Routine bodies are checked as they are parsed.
+	lexer := pgparser.NewPostgreSQLLexer(antlr.NewInputStream("DO $$BEGIN MERGE INTO t USING s ON true WHEN MATCHED THEN DELETE; END$$;"))
+	parser := pgparser.NewPostgreSQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.TargetVersion = 14
+	parser.RemoveErrorListeners()
+	parser.Root()
+	require.Len(t, parser.ParseErrors(), 1)
+	require.Equal(t, 11, parser.ParseErrors()[0].Column)
+}
@@ -1,835 +1,919 @@
 package redshift
 
-import "sort"
-
-var (
-	comparisonFunctions = []string{
-		"num_nonnulls",
-		"num_nulls",
-	}
-
-	mathematicalFunctions = []string{
-		"abs",
-		"cbrt",
-		"ceil",
-		"ceiling",
-		"degrees",
-		"div",
-		"erf",
-		"erfc",
-		"exp",
-		"factorial",
-		"floor",
-		"gcd",
-		"lcm",
-		"ln",
-		"log",
-		"log10",
-		"min_scale",
-		"mod",
-		"pi",
-		"power",
-		"radians",
-		"round",
-		"scale",
-		"sign",
-		"sqrt",
-		"trim_scale",
-		"trunc",
-		"width_bucket",
-		"random",
-		"random_normal",
-		"setseed",
-		"acos",
-		"acosd",
-		"asin",
-		"asind",
-		"atan",
-		"atand",
-		"atan2",
-		"atan2d",
-		"cos",
-		"cosd",
-		"cot",
-		"cotd",
-		"sin",
-		"sind",
-		"tan",
-		"tand",
-		"sinh",
-		"cosh",
-		"tanh",
-		"asinh",
-		"acosh",
-		"atanh",
-	}
-
-	stringFunctions = []string{
-		"btrim",
-		"bit_length",
-		"char_length",
-		"character_length",
-		"lower",
-		"lpad",
-		"ltrim",
-		"normalize",
-		"octet_length",
-		"overlay",
-		"position",
-		"rpad",
-		"rtrim",
-		"substring",
-		"trim",
-		"upper",
-		"ascii",
-		"chr",
-		"concat",
-		"concat_ws",
-		"format",
-		"initcap",
-		"left",
-		"length",
-		"md5",
-		"parse_ident",
-		"pg_client_encoding",
-		"quote_ident",
-		"quote_literal",
-		"quote_nullable",
-		"regexp_count",
-		"regexp_instr",
-		"regexp_like",
-		"regexp_match",
-		"regexp_matches",
-		"regexp_replace",
-		"regexp_split_to_array",
-		"regexp_split_to_table",
-		"regexp_substr",
-		"repeat",
-		"replace",
-		"reverse",
-		"right",
-		"split_part",
-		"starts_with",
-		"string_to_array",
-		"string_to_table",
-		"strpos",
-		"substr",
-		"to_ascii",
-		"to_hex",
-		"translate",
-		"unistr",
-	}
-
-	binaryStringFunctions = []string{
-		"bit_length",
-		"btrim",
-		"ltrim",
-		"octet_length",
-		"overlay",
-		"position",
-		"rtrim",
-		"substring",
-		"trim",
-		"bit_count",
-		"get_bit",
-		"get_byte",
-		"length",
-		"md5",
-		"set_bit",
-		"set_byte",
-		"sha224",
-		"sha256",
-		"sha384",
-		"sha512",
-		"substr",
-		"convert",
-		"convert_from",
-		"convert_to",
-		"encode",
-		"decode",
-	}
-
-	bitStringFunctions = []string{
-		"bit_count",
-		"bit_length",
-		"length",
-		"octet_length",
-		"overlay",
-		"position",
-		"substring",
-		"get_bit",
-		"set_bit",
-	}
-
-	dataTypeFormattingFunctions = []string{
-		"to_char",
-		"to_date",
-		"to_number",
-		"to_timestamp",
-	}
-
-	dateTimeFunctions = []string{
-		"age",
-		"clock_timestamp",
-		"current_time",
-		"current_timestamp",
-		"date_add",
-		"date_bin",
-		"date_part",
-		"date_subtract",
-		"date_trunc",
-		"extract",
-		"isfinite",
-		"justify_days",
-		"justify_hours",
-		"justify_interval",
-		"localtime",
-		"localtimestamp",
-		"make_date",
-		"make_interval",
-		"make_time",
-		"make_timestamp",
-		"make_timestamptz",
-		"now",
-		"statement_timestamp",
-		"timeofday",
-		"transaction_timestamp",
-		"to_timestamp",
-	}
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
-	enumSupportFunctions = []string{
-		"enum_first",
-		"enum_last",
-		"enum_range",
-	}
+// FunctionCategory is the section of the Amazon Redshift SQL function
+// reference that describes a built-in function.
+type FunctionCategory int
+
+const (
+	AggregateFunction FunctionCategory = iota
+	ArrayFunction
+	BitwiseAggregateFunction
+	ConditionalFunction
+	DataTypeFormattingFunction
+	DateTimeFunction
+	HashFunction
+	HyperLogLogFunction
+	JSONFunction
+	MathematicalFunction
+	SetReturningFunction
+	SpatialFunction
+	StringFunction
+	SuperTypeInformationFunction
+	VarbyteFunction
+	WindowFunction
+	SystemAdministrationFunction
+	SystemInformationFunction
+)
 
-	geometricFunctions = []string{
-		"area",
-		"center",
-		"diagonal",
-		"diameter",
-		"height",
-		"isclosed",
-		"isopen",
-		"length",
-		"npoints",
-		"pclose",
-		"popen",
-		"radius",
-		"slope",
-		"width",
-		"box",
-		"bound_box",
-		"circle",
-		"line",
-		"lseg",
-		"path",
-		"point",
-		"polygon",
-	}
+var functionCategoryNames = []string{
+	"aggregate",
+	"array",
+	"bit-wise aggregate",
+	"conditional",
+	"data type formatting",
+	"date and time",
+	"hash",
+	"hyperloglog",
+	"json",
+	"mathematical",
+	"set returning",
+	"spatial",
+	"string",
+	"super type information",
+	"varbyte",
+	"window",
+	"system administration",
+	"system information",
+}
 
-	networkAddressFunctions = []string{
-		"abbrev",
-		"broadcast",
-		"family",
-		"host",
-		"hostmask",
-		"inet_merge",
-		"inet_same_family",
-		"masklen",
-		"netmask",
-		"network",
-		"set_masklen",
-		"text",
-		"trunc",
-		"macaddr8_set7bit",
+func (c FunctionCategory) String() string {
+	if c < 0 || int(c) >= len(functionCategoryNames) {
+		return fmt.Sprintf("FunctionCategory(%d)", int(c))
 	}
+	return functionCategoryNames[c]
+}
 
-	textSearchFunctions = []string{
-		"array_to_tsvector",
-		"get_current_ts_config",
-		"length",
-		"numnode",
-		"plainto_tsquery",
-		"phraseto_tsquery",
-		"websearch_to_tsquery",
-		"querytree",
-		"setweight",
-		"strip",
-		"to_tsquery",
-		"to_tsvector",
-		"json_to_tsvector",
-		"jsonb_to_tsvector",
-		"ts_delete",
-		"ts_filter",
-		"ts_headline",
-		"ts_rank",
-		"ts_rank_cd",
-		"ts_rewrite",
-		"tsquery_phrase",
-		"tsvector_to_array",
-		"unnest",
-		"ts_debug",
-		"ts_lexize",
-		"ts_parse",
-		"ts_token_type",
-		"ts_stat",
-	}
+// FunctionKind tells plain functions from aggregate and window functions.
+type FunctionKind int
 
-	uuidFunctions = []string{
-		"gen_random_uuid",
-	}
+const (
+	FunctionKindNormal FunctionKind = iota
+	FunctionKindAggregate
+	FunctionKindWindow
+)
 
-	xmlFunctions = []string{
-		"xmlcomment",
-		"xmlconcat",
-		"xmlelement",
-		"xmlforest",
-		"xmlpi",
-		"xmlroot",
-		"xmlagg",
-		"XMLEXISTS",
-		"xml_is_well_formed",
-		"xml_is_well_formed_document",
-		"xml_is_well_formed_content",
-		"xpath",
-		"xpath_exists",
-		"table_to_xml",
-		"query_to_xml",
-		"cursor_to_xml",
-		"table_to_xmlschema",
-		"query_to_xmlschema",
-		"cursor_to_xmlschema",
-		"table_to_xml_and_xmlschema",
-		"query_to_xml_and_xmlschema",
-		"schema_to_xml",
-		"schema_to_xmlschema",
-		"schema_to_xml_and_xmlschema",
-		"database_to_xml",
-		"database_to_xmlschema",
-		"database_to_xml_and_xmlschema",
+func (k FunctionKind) String() string {
+	switch k {
+	case FunctionKindAggregate:
+		return "aggregate"
+	case FunctionKindWindow:
+		return "window"
+	default:
+		return "normal"
 	}
+}
 
-	jsonFunctions = []string{
-		"to_json",
-		"to_jsonb",
-		"array_to_json",
-		"json_array",
-		"row_to_json",
-		"json_build_array",
-		"jsonb_build_array",
-		"json_build_object",
-		"jsonb_build_object",
-		"json_object",
-		"jsonb_object",
-		"json_array_elements",
-		"jsonb_array_elements",
-		"json_array_elements_text",
-		"jsonb_array_elements_text",
-		"json_array_length",
-		"jsonb_array_length",
-		"json_each",
-		"jsonb_each",
-		"json_each_text",
-		"jsonb_each_text",
-		"json_extract_path",
-		"jsonb_extract_path",
-		"json_extract_path_text",
-		"jsonb_extract_path_text",
-		"json_object_keys",
-		"jsonb_object_keys",
-		"json_populate_record",
-		"jsonb_populate_record",
-		"json_populate_recordset",
-		"jsonb_populate_recordset",
-		"json_to_record",
-		"jsonb_to_record",
-		"json_to_recordset",
-		"jsonb_to_recordset",
-		"jsonb_set",
-		"jsonb_set_lax",
-		"jsonb_insert",
-		"json_strip_nulls",
-		"jsonb_strip_nulls",
-		"jsonb_path_exists",
-		"jsonb_path_match",
-		"jsonb_path_query",
-		"jsonb_path_query_array",
-		"jsonb_path_query_first",
-		"jsonb_path_exists_tz",
-		"jsonb_path_match_tz",
-		"jsonb_path_query_tz",
-		"jsonb_path_query_array_tz",
-		"jsonb_path_query_first_tz",
-		"jsonb_pretty",
-		"json_typeof",
-		"jsonb_typeof",
-		"exists",
-	}
+// ExecutionNode tells where in a cluster a function can run.
+type ExecutionNode int
+
+const (
+	// AnyNode functions can be used in any query.
+	AnyNode ExecutionNode = iota
+	// LeaderNodeOnly functions fail in a query that references a user-defined
+	// table or a Redshift system table, because only the compute nodes can
+	// read those.
+	LeaderNodeOnly
+	// ComputeNodeOnly functions fail in a query that does not reference a
+	// user-defined table or a Redshift system table.
+	ComputeNodeOnly
+)
 
-	sequenceManipulationFunctions = []string{
-		"nextval",
-		"setval",
-		"currval",
-		"lastval",
+func (n ExecutionNode) String() string {
+	switch n {
+	case LeaderNodeOnly:
+		return "leader node only"
+	case ComputeNodeOnly:
+		return "compute node only"
+	default:
+		return "any node"
 	}
+}
 
-	conditionalFunctions = []string{
-		"COALESCE",
-		"NULLIF",
-		"GREATEST",
-		"LEAST",
-	}
+// FunctionSignature is one overload of a built-in function.
+type FunctionSignature struct {
+	// Arguments are the argument types, e.g. "varchar" or "geometry". "any"
+	// stands for an argument of any type.
+	Arguments []string
+	// Variadic is true if the last argument may be repeated.
+	Variadic bool
+	// ReturnType is the type of the result, or of each row for a set-returning function.
+	ReturnType string
+	// ReturnsSet is true for set-returning functions.
+	ReturnsSet bool
+}
 
-	arrayFunctions = []string{
-		"array_append",
-		"array_cat",
-		"array_dims",
-		"array_fill",
-		"array_length",
-		"array_lower",
-		"array_ndims",
-		"array_position",
-		"array_positions",
-		"array_prepend",
-		"array_remove",
-		"array_replace",
-		"array_sample",
-		"array_shuffle",
-		"array_to_string",
-		"array_upper",
-		"cardinality",
-		"trim_array",
-		"unnest",
+func (s *FunctionSignature) String() string {
+	args := make([]string, len(s.Arguments))
+	copy(args, s.Arguments)
+	if s.Variadic && len(args) > 0 {
+		args[len(args)-1] = "VARIADIC " + args[len(args)-1]
 	}
-
-	rangeFunctions = []string{
-		"lower",
-		"upper",
-		"isempty",
-		"lower_inc",
-		"upper_inc",
-		"lower_inf",
-		"upper_inf",
-		"range_merge",
-		"multirange",
-		"unnest",
+	result := s.ReturnType
+	if s.ReturnsSet {
+		result = "SETOF " + result
 	}
+	return "(" + strings.Join(args, ", ") + ") RETURNS " + result
+}
 
-	aggregateFunctions = []string{
-		"any_value",
-		"array_agg",
-		"avg",
-		"bit_and",
-		"bit_or",
-		"bit_xor",
-		"bool_and",
-		"bool_or",
-		"count",
-		"every",
-		"json_agg",
-		"jsonb_agg",
-		"json_objectagg",
-		"json_object_agg",
-		"jsonb_object_agg",
-		"json_object_agg_strict",
-		"jsonb_object_agg_strict",
-		"json_object_agg_unique",
-		"jsonb_object_agg_unique",
-		"json_arrayagg",
-		"json_object_agg_unique_strict",
-		"jsonb_object_agg_unique_strict",
-		"max",
-		"min",
-		"range_agg",
-		"range_intersect_agg",
-		"json_agg_strict",
-		"jsonb_agg_strict",
-		"string_agg",
-		"sum",
-		"xmlagg",
-		"corr",
-		"covar_pop",
-		"covar_samp",
-		"regr_avgx",
-		"regr_avgy",
-		"regr_count",
-		"regr_intercept",
-		"regr_r2",
-		"regr_slope",
-		"regr_sxx",
-		"regr_sxy",
-		"regr_syy",
-		"stddev",
-		"stddev_pop",
-		"stddev_samp",
-		"variance",
-		"var_pop",
-		"var_samp",
-		"mode",
-		"percentile_cont",
-		"percentile_disc",
-		"rank",
-		"dense_rank",
-		"percent_rank",
-		"cume_dist",
-		"GROUPING",
+// Accepts reports whether the signature can be called with n arguments. A
+// variadic argument must be given at least once.
+func (s *FunctionSignature) Accepts(n int) bool {
+	if s.Variadic {
+		return n >= len(s.Arguments)
 	}
+	return n == len(s.Arguments)
+}
 
-	windowFunctions = []string{
-		"row_number",
-		"rank",
-		"dense_rank",
-		"percent_rank",
-		"cume_dist",
-		"ntile",
-		"lag",
-		"lead",
-		"first_value",
-		"last_value",
-		"nth_value",
-	}
+// BuiltinFunction describes a built-in function of one category. A name that
+// is documented in several categories, such as listagg, has one
+// BuiltinFunction per category.
+type BuiltinFunction struct {
+	// Name is the lower-case function name. Functions with special syntax
+	// keep their SQL spelling, e.g. "approximate percentile_disc".
+	Name       string
+	Category   FunctionCategory
+	Kind       FunctionKind
+	Node       ExecutionNode
+	Signatures []*FunctionSignature
+}
 
-	setReturningFunctions = []string{
-		"generate_series",
-		"generate_subscripts",
-	}
+var (
+	builtinFunctionCatalog []*BuiltinFunction
+	builtinFunctionsByName = make(map[string][]*BuiltinFunction)
+	builtinFunctions       []string
+	unsupportedFunctions   = make(map[string]string)
+)
 
-	systemInformationFunctions = []string{
-		"current_database",
-		"current_query",
-		"current_schema",
-		"current_schemas",
-		"inet_client_addr",
-		"inet_client_port",
-		"inet_server_addr",
-		"inet_server_port",
-		"pg_backend_pid",
-		"pg_blocking_pids",
-		"pg_conf_load_time",
-		"pg_current_logfile",
-		"pg_my_temp_schema",
-		"pg_is_other_temp_schema",
-		"pg_jit_available",
-		"pg_listening_channels",
-		"pg_notification_queue_usage",
-		"pg_postmaster_start_time",
-		"pg_safe_snapshot_blocking_pids",
-		"pg_trigger_depth",
-		"version",
-		"has_any_column_privilege",
-		"has_column_privilege",
-		"has_database_privilege",
-		"has_foreign_data_wrapper_privilege",
-		"has_function_privilege",
-		"has_language_privilege",
-		"has_parameter_privilege",
-		"has_schema_privilege",
-		"has_sequence_privilege",
-		"has_server_privilege",
-		"has_table_privilege",
-		"has_tablespace_privilege",
-		"has_type_privilege",
-		"pg_has_role",
-		"row_security_active",
-		"acldefault",
-		"aclexplode",
-		"makeaclitem",
-		"pg_collation_is_visible",
-		"pg_conversion_is_visible",
-		"pg_function_is_visible",
-		"pg_opclass_is_visible",
-		"pg_operator_is_visible",
-		"pg_opfamily_is_visible",
-		"pg_statistics_obj_is_visible",
-		"pg_table_is_visible",
-		"pg_ts_config_is_visible",
-		"pg_ts_dict_is_visible",
-		"pg_ts_parser_is_visible",
-		"pg_ts_template_is_visible",
-		"pg_type_is_visible",
-		"format_type",
-		"pg_char_to_encoding",
-		"pg_encoding_to_char",
-		"pg_get_catalog_foreign_keys",
-		"pg_get_constraintdef",
-		"pg_get_expr",
-		"pg_get_functiondef",
-		"pg_get_function_arguments",
-		"pg_get_function_identity_arguments",
-		"pg_get_function_result",
-		"pg_get_indexdef",
-		"pg_get_keywords",
-		"pg_get_partkeydef",
-		"pg_get_ruledef",
-		"pg_get_serial_sequence",
-		"pg_get_statisticsobjdef",
-		"pg_get_triggerdef",
-		"pg_get_userbyid",
-		"pg_get_viewdef",
-		"pg_index_column_has_property",
-		"pg_index_has_property",
-		"pg_indexam_has_property",
-		"pg_options_to_table",
-		"pg_settings_get_flags",
-		"pg_tablespace_databases",
-		"pg_tablespace_location",
-		"pg_typeof",
-		"COLLATION FOR",
-		"to_regclass",
-		"to_regcollation",
-		"to_regnamespace",
-		"to_regoper",
-		"to_regoperator",
-		"to_regproc",
-		"to_regprocedure",
-		"to_regrole",
-		"to_regtype",
-		"pg_describe_object",
-		"pg_identify_object",
-		"pg_identify_object_as_address",
-		"pg_get_object_address",
-		"col_description",
-		"obj_description",
-		"shobj_description",
-		"pg_input_is_valid",
-		"pg_input_error_info",
-		"pg_current_xact_id",
-		"pg_current_xact_id_if_assigned",
-		"pg_xact_status",
-		"pg_current_snapshot",
-		"pg_snapshot_xip",
-		"pg_snapshot_xmax",
-		"pg_snapshot_xmin",
-		"pg_visible_in_snapshot",
-		"txid_current",
-		"txid_current_if_assigned",
-		"txid_current_snapshot",
-		"txid_snapshot_xip",
-		"txid_snapshot_xmax",
-		"txid_snapshot_xmin",
-		"txid_visible_in_snapshot",
-		"txid_status",
-		"pg_xact_commit_timestamp",
-		"pg_xact_commit_timestamp_origin",
-		"pg_last_committed_xact",
-		"pg_control_checkpoint",
-		"pg_control_system",
-		"pg_control_init",
-		"pg_control_recovery",
+func init() {
+	for _, section := range builtinFunctionSections {
+		for _, f := range parseBuiltinFunctionSection(section) {
+			builtinFunctionCatalog = append(builtinFunctionCatalog, f)
+			if len(builtinFunctionsByName[f.Name]) == 0 {
+				builtinFunctions = append(builtinFunctions, f.Name)
+			}
+			builtinFunctionsByName[f.Name] = append(builtinFunctionsByName[f.Name], f)
+		}
 	}
+	sort.Strings(builtinFunctions)
 
-	systemAdministrationFunctions = []string{
-		"current_setting",
-		"set_config",
-		"pg_cancel_backend",
-		"pg_log_backend_memory_contexts",
-		"pg_reload_conf",
-		"pg_rotate_logfile",
-		"pg_terminate_backend",
-		"pg_create_restore_point",
-		"pg_current_wal_flush_lsn",
-		"pg_current_wal_insert_lsn",
-		"pg_current_wal_lsn",
-		"pg_backup_start",
-		"pg_backup_stop",
-		"pg_switch_wal",
-		"pg_walfile_name",
-		"pg_walfile_name_offset",
-		"pg_split_walfile_name",
-		"pg_wal_lsn_diff",
-		"pg_is_in_recovery",
-		"pg_last_wal_receive_lsn",
-		"pg_last_wal_replay_lsn",
-		"pg_last_xact_replay_timestamp",
-		"pg_get_wal_resource_managers",
-		"pg_is_wal_replay_paused",
-		"pg_get_wal_replay_pause_state",
-		"pg_promote",
-		"pg_wal_replay_pause",
-		"pg_wal_replay_resume",
-		"pg_export_snapshot",
-		"pg_log_standby_snapshot",
-		"pg_create_physical_replication_slot",
-		"pg_drop_replication_slot",
-		"pg_create_logical_replication_slot",
-		"pg_copy_physical_replication_slot",
-		"pg_copy_logical_replication_slot",
-		"pg_logical_slot_get_changes",
-		"pg_logical_slot_peek_changes",
-		"pg_logical_slot_get_binary_changes",
-		"pg_logical_slot_peek_binary_changes",
-		"pg_replication_slot_advance",
-		"pg_replication_origin_create",
-		"pg_replication_origin_drop",
-		"pg_replication_origin_oid",
-		"pg_replication_origin_session_setup",
-		"pg_replication_origin_session_reset",
-		"pg_replication_origin_session_is_setup",
-		"pg_replication_origin_session_progress",
-		"pg_replication_origin_xact_setup",
-		"pg_replication_origin_xact_reset",
-		"pg_replication_origin_advance",
-		"pg_replication_origin_progress",
-		"pg_logical_emit_message",
-		"pg_column_size",
-		"pg_column_compression",
-		"pg_database_size",
-		"pg_indexes_size",
-		"pg_relation_size",
-		"pg_size_bytes",
-		"pg_size_pretty",
-		"pg_table_size",
-		"pg_tablespace_size",
-		"pg_total_relation_size",
-		"pg_relation_filenode",
-		"pg_relation_filepath",
-		"pg_filenode_relation",
-		"pg_collation_actual_version",
-		"pg_database_collation_actual_version",
-		"pg_import_system_collations",
-		"pg_partition_tree",
-		"pg_partition_ancestors",
-		"pg_partition_root",
-		"brin_summarize_new_values",
-		"brin_summarize_range",
-		"brin_desummarize_range",
-		"gin_clean_pending_list",
-		"pg_ls_dir",
-		"pg_ls_logdir",
-		"pg_ls_waldir",
-		"pg_ls_logicalmapdir",
-		"pg_ls_logicalsnapdir",
-		"pg_ls_replslotdir",
-		"pg_ls_archive_statusdir",
-		"pg_ls_tmpdir",
-		"pg_read_file",
-		"pg_read_binary_file",
-		"pg_stat_file",
-		"pg_advisory_lock",
-		"pg_advisory_lock_shared",
-		"pg_advisory_unlock",
-		"pg_advisory_unlock_all",
-		"pg_advisory_unlock_shared",
-		"pg_advisory_xact_lock",
-		"pg_advisory_xact_lock_shared",
-		"pg_try_advisory_lock",
-		"pg_try_advisory_lock_shared",
-		"pg_try_advisory_xact_lock",
-		"pg_try_advisory_xact_lock_shared",
+	for group, names := range unsupportedPostgreSQLFunctions {
+		for _, name := range names {
+			unsupportedFunctions[name] = group
+		}
 	}
+}
 
-	triggerFunctions = []string{
-		"suppress_redundant_updates_trigger",
-		"tsvector_update_trigger",
-		"tsvector_update_trigger_column",
-	}
+// GetBuiltinFunctions returns the names of the built-in functions, sorted.
+func GetBuiltinFunctions() []string {
+	var result []string
+	result = append(result, builtinFunctions...)
+	return result
+}
 
-	eventTriggerFunctions = []string{
-		"pg_event_trigger_ddl_commands",
-		"pg_event_trigger_dropped_objects",
-		"pg_event_trigger_table_rewrite_oid",
-		"pg_event_trigger_table_rewrite_reason",
-	}
+// GetBuiltinFunctionCatalog returns every built-in function, ordered by category.
+func GetBuiltinFunctionCatalog() []*BuiltinFunction {
+	var result []*BuiltinFunction
+	result = append(result, builtinFunctionCatalog...)
+	return result
+}
 
-	statisticsFunctions = []string{
-		"pg_event_trigger_table_rewrite_reason",
-	}
+// LookupBuiltinFunction returns the built-in functions called name, one per
+// category that documents it. Names are matched case-insensitively.
+func LookupBuiltinFunction(name string) []*BuiltinFunction {
+	var result []*BuiltinFunction
+	result = append(result, builtinFunctionsByName[strings.ToLower(name)]...)
+	return result
+}
 
-	builtinFunctions = []string{}
-)
+// LookupUnsupportedFunction reports whether name is a PostgreSQL function that
+// Redshift does not support, and if so the group of PostgreSQL functions it
+// belongs to, e.g. "sequence manipulation functions".
+func LookupUnsupportedFunction(name string) (string, bool) {
+	group, ok := unsupportedFunctions[strings.ToLower(name)]
+	return group, ok
+}
 
-func init() {
-	funcMap := make(map[string]bool)
+// builtinFunctionSection lists the signatures of one category, one per line:
+//
+//	name(argtype, ..., VARIADIC argtype) [SETOF] rettype [flags]
+//
+// where the optional flags are "leader" or "compute" for functions restricted
+// to one kind of node, and "aggregate" or "window" for aggregate and window
+// functions listed outside their category.
+type builtinFunctionSection struct {
+	category   FunctionCategory
+	signatures string
+}
 
-	for _, f := range comparisonFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range mathematicalFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range stringFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range binaryStringFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range bitStringFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range dataTypeFormattingFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range dateTimeFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range enumSupportFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range geometricFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range networkAddressFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range textSearchFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range uuidFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range xmlFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range jsonFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range sequenceManipulationFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range conditionalFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range arrayFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range rangeFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range aggregateFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range windowFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range setReturningFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range systemInformationFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range systemAdministrationFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range triggerFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range eventTriggerFunctions {
-		funcMap[f] = true
-	}
-	for _, f := range statisticsFunctions {
-		funcMap[f] = true
+func parseBuiltinFunctionSection(section builtinFunctionSection) []*BuiltinFunction {
+	var result []*BuiltinFunction
+	byName := make(map[string]*BuiltinFunction)
+	for _, line := range strings.Split(section.signatures, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, signature, kind, node := parseFunctionSignature(line)
+		f, ok := byName[name]
+		if !ok {
+			f = &BuiltinFunction{Name: name, Category: section.category}
+			switch section.category {
+			case AggregateFunction, BitwiseAggregateFunction:
+				f.Kind = FunctionKindAggregate
+			case WindowFunction:
+				f.Kind = FunctionKindWindow
+			}
+			byName[name] = f
+			result = append(result, f)
+		}
+		if kind != FunctionKindNormal {
+			f.Kind = kind
+		}
+		if node != AnyNode {
+			f.Node = node
+		}
+		f.Signatures = append(f.Signatures, signature)
 	}
+	return result
+}
 
-	for f := range funcMap {
-		builtinFunctions = append(builtinFunctions, f)
-	}
+func parseFunctionSignature(line string) (string, *FunctionSignature, FunctionKind, ExecutionNode) {
+	open, end := strings.Index(line, "("), strings.Index(line, ")")
+	if open <= 0 || end < open {
+		panic(fmt.Sprintf("malformed built-in function signature %q", line))
+	}
+	name := strings.TrimSpace(line[:open])
+	signature := &FunctionSignature{}
+	if args := strings.TrimSpace(line[open+1 : end]); args != "" {
+		for _, arg := range strings.Split(args, ",") {
+			arg = strings.TrimSpace(arg)
+			if rest, ok := strings.CutPrefix(arg, "VARIADIC "); ok {
+				signature.Variadic = true
+				arg = rest
+			}
+			signature.Arguments = append(signature.Arguments, arg)
+		}
+	}
+
+	kind, node := FunctionKindNormal, AnyNode
+	words := strings.Fields(line[end+1:])
+flags:
+	for len(words) > 1 {
+		switch words[len(words)-1] {
+		case "leader":
+			node = LeaderNodeOnly
+		case "compute":
+			node = ComputeNodeOnly
+		case "aggregate":
+			kind = FunctionKindAggregate
+		case "window":
+			kind = FunctionKindWindow
+		default:
+			break flags
+		}
+		words = words[:len(words)-1]
+	}
+	if len(words) > 1 && words[0] == "SETOF" {
+		signature.ReturnsSet = true
+		words = words[1:]
+	}
+	if len(words) == 0 {
+		panic(fmt.Sprintf("built-in function signature %q has no return type", line))
+	}
+	signature.ReturnType = strings.Join(words, " ")
+	return name, signature, kind, node
+}
 
-	sort.Strings(builtinFunctions)
+var builtinFunctionSections = []builtinFunctionSection{
+	{AggregateFunction, `
+		any_value(any) any
+		approximate percentile_disc(double precision) any compute
+		avg(smallint) bigint
+		avg(integer) bigint
+		avg(bigint) bigint
+		avg(decimal) decimal
+		avg(real) double precision
+		avg(double precision) double precision
+		count() bigint
+		count(any) bigint
+		listagg(varchar) varchar compute
+		listagg(varchar, varchar) varchar compute
+		max(any) any
+		median(any) any compute
+		min(any) any
+		percentile_cont(double precision) any compute
+		percentile_disc(double precision) any compute
+		stddev(decimal) decimal
+		stddev(double precision) double precision
+		stddev_samp(decimal) decimal
+		stddev_samp(double precision) double precision
+		stddev_pop(decimal) decimal
+		stddev_pop(double precision) double precision
+		sum(smallint) bigint
+		sum(integer) bigint
+		sum(bigint) bigint
+		sum(decimal) decimal
+		sum(real) double precision
+		sum(double precision) double precision
+		variance(decimal) decimal
+		variance(double precision) double precision
+		var_samp(decimal) decimal
+		var_samp(double precision) double precision
+		var_pop(decimal) decimal
+		var_pop(double precision) double precision
+	`},
+	{ArrayFunction, `
+		array(VARIADIC any) super
+		array_concat(super, super) super
+		array_flatten(super) super
+		get_array_length(super) integer
+		split_to_array(varchar) super
+		split_to_array(varchar, varchar) super
+		subarray(super, integer, integer) super
+	`},
+	{BitwiseAggregateFunction, `
+		bit_and(smallint) smallint
+		bit_and(integer) integer
+		bit_and(bigint) bigint
+		bit_or(smallint) smallint
+		bit_or(integer) integer
+		bit_or(bigint) bigint
+		bool_and(boolean) boolean
+		bool_or(boolean) boolean
+	`},
+	{ConditionalFunction, `
+		coalesce(VARIADIC any) any
+		decode(any, VARIADIC any) any
+		greatest(VARIADIC any) any
+		least(VARIADIC any) any
+		nvl(VARIADIC any) any
+		nvl2(any, any, any) any
+		nullif(any, any) any
+	`},
+	{DataTypeFormattingFunction, `
+		convert(any, any) any
+		to_char(timestamp, varchar) varchar
+		to_char(timestamptz, varchar) varchar
+		to_char(smallint, varchar) varchar
+		to_char(integer, varchar) varchar
+		to_char(bigint, varchar) varchar
+		to_char(decimal, varchar) varchar
+		to_char(real, varchar) varchar
+		to_char(double precision, varchar) varchar
+		to_date(varchar, varchar) date
+		to_date(varchar, varchar, boolean) date
+		to_number(varchar, varchar) decimal
+		text_to_int_alt(varchar) integer
+		text_to_int_alt(varchar, varchar) integer
+		text_to_numeric_alt(varchar) decimal
+		text_to_numeric_alt(varchar, varchar) decimal
+		text_to_numeric_alt(varchar, varchar, integer, integer) decimal
+	`},
+	{DateTimeFunction, `
+		add_months(date, bigint) timestamp
+		add_months(timestamp, bigint) timestamp
+		add_months(timestamptz, bigint) timestamptz
+		age(timestamp) interval leader
+		age(timestamp, timestamp) interval leader
+		convert_timezone(varchar, timestamp) timestamp
+		convert_timezone(varchar, varchar, timestamp) timestamp
+		current_date() date
+		current_time() timetz leader
+		current_timestamp() timestamptz leader
+		date_cmp(date, date) integer
+		date_cmp_timestamp(date, timestamp) integer
+		date_cmp_timestamptz(date, timestamptz) integer
+		dateadd(varchar, bigint, date) timestamp
+		dateadd(varchar, bigint, timestamp) timestamp
+		dateadd(varchar, bigint, timestamptz) timestamptz
+		dateadd(varchar, bigint, time) time
+		dateadd(varchar, bigint, timetz) timetz
+		datediff(varchar, date, date) bigint
+		datediff(varchar, timestamp, timestamp) bigint
+		datediff(varchar, timestamptz, timestamptz) bigint
+		datediff(varchar, time, time) bigint
+		datediff(varchar, timetz, timetz) bigint
+		date_part(varchar, date) double precision
+		date_part(varchar, timestamp) double precision
+		date_part(varchar, timestamptz) double precision
+		date_part(varchar, time) double precision
+		date_part(varchar, timetz) double precision
+		date_part_year(date) integer
+		date_trunc(varchar, timestamp) timestamp
+		date_trunc(varchar, timestamptz) timestamptz
+		extract(varchar, date) integer
+		extract(varchar, timestamp) integer
+		extract(varchar, timestamptz) integer
+		extract(varchar, time) integer
+		extract(varchar, timetz) integer
+		getdate() timestamp
+		interval_cmp(interval, interval) integer
+		isfinite(timestamp) boolean leader
+		last_day(date) date
+		last_day(timestamp) date
+		localtime() time leader
+		months_between(date, date) double precision
+		months_between(timestamp, timestamp) double precision
+		next_day(date, varchar) date
+		next_day(timestamp, varchar) date
+		now() timestamptz leader
+		sysdate() timestamp
+		timeofday() varchar
+		timestamp_cmp(timestamp, timestamp) integer
+		timestamp_cmp_date(timestamp, date) integer
+		timestamp_cmp_timestamptz(timestamp, timestamptz) integer
+		timestamptz_cmp(timestamptz, timestamptz) integer
+		timestamptz_cmp_date(timestamptz, date) integer
+		timestamptz_cmp_timestamp(timestamptz, timestamp) integer
+		timezone(varchar, timestamp) timestamptz
+		timezone(varchar, timestamptz) timestamp
+		to_timestamp(varchar, varchar) timestamptz
+		to_timestamp(varchar, varchar, boolean) timestamptz
+		trunc(timestamp) date
+	`},
+	{HashFunction, `
+		checksum(any) integer
+		farmfingerprint64(any) bigint
+		fnv_hash(any) bigint
+		fnv_hash(any, bigint) bigint
+		func_sha1(varchar) varchar
+		md5(varchar) varchar
+		murmur3_32_hash(any) integer
+		murmur3_32_hash(any, integer) integer
+		sha(varchar) varchar
+		sha1(varchar) varchar
+		sha2(varchar, integer) varchar
+	`},
+	{HyperLogLogFunction, `
+		hll(any) bigint aggregate
+		hll_create_sketch(any) hllsketch aggregate
+		hll_combine(hllsketch) hllsketch aggregate
+		hll_cardinality(hllsketch) bigint
+		hll_combine_sketches(hllsketch, hllsketch) hllsketch
+	`},
+	{JSONFunction, `
+		can_json_parse(varchar) boolean
+		is_valid_json(varchar) boolean
+		is_valid_json_array(varchar) boolean
+		json_array_length(varchar) integer
+		json_array_length(varchar, boolean) integer
+		json_extract_array_element_text(varchar, integer) varchar
+		json_extract_array_element_text(varchar, integer, boolean) varchar
+		json_extract_path_text(varchar, VARIADIC varchar) varchar
+		json_parse(varchar) super
+		json_serialize(super) varchar
+		json_serialize_to_varbyte(super) varbyte
+	`},
+	{MathematicalFunction, `
+		abs(smallint) smallint
+		abs(integer) integer
+		abs(bigint) bigint
+		abs(decimal) decimal
+		abs(real) real
+		abs(double precision) double precision
+		acos(double precision) double precision
+		asin(double precision) double precision
+		atan(double precision) double precision
+		atan2(double precision, double precision) double precision
+		cbrt(double precision) double precision
+		ceil(decimal) decimal
+		ceil(double precision) double precision
+		ceiling(decimal) decimal
+		ceiling(double precision) double precision
+		cos(double precision) double precision
+		cot(double precision) double precision
+		degrees(double precision) double precision
+		dexp(double precision) double precision
+		dlog1(double precision) double precision
+		dlog10(double precision) double precision
+		exp(double precision) double precision
+		floor(decimal) decimal
+		floor(double precision) double precision
+		ln(double precision) double precision
+		log(double precision) double precision
+		mod(smallint, smallint) smallint
+		mod(integer, integer) integer
+		mod(bigint, bigint) bigint
+		mod(decimal, decimal) decimal
+		pi() double precision
+		pow(double precision, double precision) double precision
+		power(double precision, double precision) double precision
+		radians(double precision) double precision
+		random() double precision
+		round(decimal) decimal
+		round(decimal, integer) decimal
+		round(double precision) double precision
+		round(double precision, integer) double precision
+		sign(decimal) decimal
+		sign(double precision) double precision
+		sin(double precision) double precision
+		sqrt(double precision) double precision
+		tan(double precision) double precision
+		trunc(decimal) decimal
+		trunc(decimal, integer) decimal
+		trunc(double precision) double precision
+	`},
+	{SetReturningFunction, `
+		generate_series(integer, integer) SETOF integer leader
+		generate_series(integer, integer, integer) SETOF integer leader
+		generate_series(timestamp, timestamp, interval) SETOF timestamp leader
+	`},
+	{SpatialFunction, `
+		addbbox(geometry) geometry
+		dropbbox(geometry) geometry
+		geometrytype(geometry) varchar
+		h3_fromlonglat(double precision, double precision, integer) bigint
+		h3_frompoint(geometry, integer) bigint
+		h3_isvalid(bigint) boolean
+		h3_polyfill(geometry, integer) super
+		h3_resolution(bigint) integer
+		h3_tochildren(bigint, integer) super
+		h3_toparent(bigint, integer) bigint
+		st_addpoint(geometry, geometry) geometry
+		st_addpoint(geometry, geometry, integer) geometry
+		st_angle(geometry, geometry, geometry) double precision
+		st_angle(geometry, geometry, geometry, geometry) double precision
+		st_area(geometry) double precision
+		st_area(geography) double precision
+		st_asbinary(geometry) varbyte
+		st_asewkb(geometry) varbyte
+		st_asewkt(geometry) varchar
+		st_asewkt(geometry, integer) varchar
+		st_asgeojson(geometry) varchar
+		st_asgeojson(geometry, integer) varchar
+		st_ashexewkb(geometry) varchar
+		st_ashexwkb(geometry) varchar
+		st_astext(geometry) varchar
+		st_astext(geometry, integer) varchar
+		st_azimuth(geometry, geometry) double precision
+		st_boundary(geometry) geometry
+		st_buffer(geometry, double precision) geometry
+		st_buffer(geometry, double precision, integer) geometry
+		st_centroid(geometry) geometry
+		st_collect(geometry, geometry) geometry
+		st_contains(geometry, geometry) boolean
+		st_containsproperly(geometry, geometry) boolean
+		st_convexhull(geometry) geometry
+		st_coveredby(geometry, geometry) boolean
+		st_covers(geometry, geometry) boolean
+		st_crosses(geometry, geometry) boolean
+		st_dimension(geometry) integer
+		st_disjoint(geometry, geometry) boolean
+		st_distance(geometry, geometry) double precision
+		st_distance(geography, geography) double precision
+		st_distancesphere(geometry, geometry) double precision
+		st_distancesphere(geometry, geometry, double precision) double precision
+		st_dwithin(geometry, geometry, double precision) boolean
+		st_endpoint(geometry) geometry
+		st_envelope(geometry) geometry
+		st_equals(geometry, geometry) boolean
+		st_exteriorring(geometry) geometry
+		st_force2d(geometry) geometry
+		st_force3d(geometry) geometry
+		st_geogfromtext(varchar) geography
+		st_geogfromwkb(varbyte) geography
+		st_geohash(geometry) varchar
+		st_geohash(geometry, integer) varchar
+		st_geometryn(geometry, integer) geometry
+		st_geometrytype(geometry) varchar
+		st_geomfromewkb(varbyte) geometry
+		st_geomfromewkt(varchar) geometry
+		st_geomfromgeohash(varchar) geometry
+		st_geomfromgeohash(varchar, integer) geometry
+		st_geomfromgeojson(varchar) geometry
+		st_geomfromtext(varchar) geometry
+		st_geomfromtext(varchar, integer) geometry
+		st_geomfromwkb(varbyte) geometry
+		st_geomfromwkb(varbyte, integer) geometry
+		st_interiorringn(geometry, integer) geometry
+		st_intersection(geometry, geometry) geometry
+		st_intersects(geometry, geometry) boolean
+		st_isclosed(geometry) boolean
+		st_iscollection(geometry) boolean
+		st_isempty(geometry) boolean
+		st_ispolygonccw(geometry) boolean
+		st_ispolygoncw(geometry) boolean
+		st_isring(geometry) boolean
+		st_issimple(geometry) boolean
+		st_isvalid(geometry) boolean
+		st_length(geometry) double precision
+		st_length(geography) double precision
+		st_length2d(geometry) double precision
+		st_lengthsphere(geometry) double precision
+		st_linefrommultipoint(geometry) geometry
+		st_lineinterpolatepoint(geometry, double precision) geometry
+		st_m(geometry) double precision
+		st_makeenvelope(double precision, double precision, double precision, double precision) geometry
+		st_makeenvelope(double precision, double precision, double precision, double precision, integer) geometry
+		st_makeline(geometry, geometry) geometry
+		st_makepoint(double precision, double precision) geometry
+		st_makepoint(double precision, double precision, double precision) geometry
+		st_makepoint(double precision, double precision, double precision, double precision) geometry
+		st_makepolygon(geometry) geometry
+		st_memsize(geometry) integer
+		st_mmax(geometry) double precision
+		st_mmin(geometry) double precision
+		st_multi(geometry) geometry
+		st_ndims(geometry) integer
+		st_npoints(geometry) integer
+		st_nrings(geometry) integer
+		st_numgeometries(geometry) integer
+		st_numinteriorrings(geometry) integer
+		st_numpoints(geometry) integer
+		st_perimeter(geometry) double precision
+		st_perimeter(geography) double precision
+		st_perimeter2d(geometry) double precision
+		st_point(double precision, double precision) geometry
+		st_pointn(geometry, integer) geometry
+		st_points(geometry) geometry
+		st_polygon(geometry, integer) geometry
+		st_removepoint(geometry, integer) geometry
+		st_reverse(geometry) geometry
+		st_setpoint(geometry, integer, geometry) geometry
+		st_setsrid(geometry, integer) geometry
+		st_simplify(geometry, double precision) geometry
+		st_srid(geometry) integer
+		st_startpoint(geometry) geometry
+		st_touches(geometry, geometry) boolean
+		st_transform(geometry, integer) geometry
+		st_union(geometry, geometry) geometry
+		st_within(geometry, geometry) boolean
+		st_x(geometry) double precision
+		st_xmax(geometry) double precision
+		st_xmin(geometry) double precision
+		st_y(geometry) double precision
+		st_ymax(geometry) double precision
+		st_ymin(geometry) double precision
+		st_z(geometry) double precision
+		st_zmax(geometry) double precision
+		st_zmin(geometry) double precision
+		supportsbbox(geometry) boolean
+	`},
+	{StringFunction, `
+		ascii(varchar) integer leader
+		bpcharcmp(char, char) integer
+		btrim(varchar) varchar
+		btrim(varchar, varchar) varchar
+		bttext_pattern_cmp(varchar, varchar) integer
+		char_length(varchar) integer
+		character_length(varchar) integer
+		charindex(varchar, varchar) integer
+		chr(integer) char
+		concat(varchar, varchar) varchar
+		crc32(varchar) bigint
+		difference(varchar, varchar) integer
+		get_bit(varbyte, integer) integer leader
+		get_byte(varbyte, integer) integer leader
+		initcap(varchar) varchar
+		left(varchar, integer) varchar
+		len(varchar) integer
+		length(varchar) integer
+		lower(varchar) varchar
+		lpad(varchar, integer) varchar
+		lpad(varchar, integer, varchar) varchar
+		ltrim(varchar) varchar
+		ltrim(varchar, varchar) varchar
+		octetindex(varchar, varchar) integer
+		octet_length(varchar) integer
+		position(varchar, varchar) integer
+		quote_ident(varchar) varchar
+		quote_literal(varchar) varchar
+		regexp_count(varchar, varchar) integer
+		regexp_count(varchar, varchar, integer) integer
+		regexp_count(varchar, varchar, integer, varchar) integer
+		regexp_instr(varchar, varchar) integer
+		regexp_instr(varchar, varchar, integer) integer
+		regexp_instr(varchar, varchar, integer, integer) integer
+		regexp_instr(varchar, varchar, integer, integer, integer) integer
+		regexp_instr(varchar, varchar, integer, integer, integer, varchar) integer
+		regexp_replace(varchar, varchar) varchar
+		regexp_replace(varchar, varchar, varchar) varchar
+		regexp_replace(varchar, varchar, varchar, integer) varchar
+		regexp_replace(varchar, varchar, varchar, integer, varchar) varchar
+		regexp_substr(varchar, varchar) varchar
+		regexp_substr(varchar, varchar, integer) varchar
+		regexp_substr(varchar, varchar, integer, integer) varchar
+		regexp_substr(varchar, varchar, integer, integer, varchar) varchar
+		repeat(varchar, integer) varchar
+		replace(varchar, varchar, varchar) varchar
+		replicate(varchar, integer) varchar
+		reverse(varchar) varchar
+		right(varchar, integer) varchar
+		rpad(varchar, integer) varchar
+		rpad(varchar, integer, varchar) varchar
+		rtrim(varchar) varchar
+		rtrim(varchar, varchar) varchar
+		set_bit(varbyte, integer, integer) varbyte leader
+		set_byte(varbyte, integer, integer) varbyte leader
+		soundex(varchar) varchar
+		split_part(varchar, varchar, integer) varchar
+		strpos(varchar, varchar) integer
+		strtol(varchar, integer) bigint
+		substr(varchar, integer) varchar
+		substr(varchar, integer, integer) varchar
+		substring(varchar, integer) varchar
+		substring(varchar, integer, integer) varchar
+		textlen(varchar) integer
+		to_ascii(varchar) varchar leader
+		to_hex(bigint) varchar
+		translate(varchar, varchar, varchar) varchar
+		trim(varchar) varchar
+		trim(varchar, varchar) varchar
+		upper(varchar) varchar
+	`},
+	{SuperTypeInformationFunction, `
+		decimal_precision(super) integer
+		decimal_scale(super) integer
+		is_array(super) boolean
+		is_bigint(super) boolean
+		is_boolean(super) boolean
+		is_char(super) boolean
+		is_decimal(super) boolean
+		is_float(super) boolean
+		is_integer(super) boolean
+		is_object(super) boolean
+		is_scalar(super) boolean
+		is_smallint(super) boolean
+		is_varchar(super) boolean
+		json_typeof(super) varchar
+		object(VARIADIC any) super
+	`},
+	{VarbyteFunction, `
+		from_hex(varchar) varbyte
+		from_varbyte(varbyte, varchar) varchar
+		getbit(varbyte, integer) integer
+		to_hex(varbyte) varchar
+		to_varbyte(varchar, varchar) varbyte
+	`},
+	{WindowFunction, `
+		cume_dist() double precision
+		dense_rank() bigint
+		first_value(any) any
+		lag(any) any
+		lag(any, integer) any
+		last_value(any) any
+		lead(any) any
+		lead(any, integer) any
+		listagg(varchar) varchar compute
+		listagg(varchar, varchar) varchar compute
+		median(any) any compute
+		nth_value(any, integer) any
+		ntile(integer) bigint
+		percent_rank() double precision
+		percentile_cont(double precision) any compute
+		percentile_disc(double precision) any compute
+		rank() bigint
+		ratio_to_report(any) decimal
+		row_number() bigint
+	`},
+	{SystemAdministrationFunction, `
+		change_query_priority(integer, varchar) varchar
+		change_session_priority(integer, varchar) varchar
+		change_user_priority(varchar, varchar) varchar
+		current_setting(varchar) varchar
+		pg_cancel_backend(integer) integer
+		pg_terminate_backend(integer) integer
+		set_config(varchar, varchar, boolean) varchar
+	`},
+	{SystemInformationFunction, `
+		current_aws_account() bigint
+		current_database() name
+		current_namespace() varchar
+		current_schema() name leader
+		current_schemas(boolean) name[] leader
+		current_session_arn() varchar
+		current_user_id() integer
+		default_iam_role() varchar
+		format_type(oid, integer) varchar leader
+		has_assumerole_privilege(varchar, varchar, varchar) boolean
+		has_database_privilege(varchar, varchar) boolean leader
+		has_database_privilege(varchar, varchar, varchar) boolean leader
+		has_schema_privilege(varchar, varchar) boolean leader
+		has_schema_privilege(varchar, varchar, varchar) boolean leader
+		has_table_privilege(varchar, varchar) boolean leader
+		has_table_privilege(varchar, varchar, varchar) boolean leader
+		pg_backend_pid() integer
+		pg_get_cols(varchar) SETOF record
+		pg_get_grantee_by_iam_role(varchar) SETOF record
+		pg_get_iam_role_by_user(varchar) SETOF record
+		pg_get_late_binding_view_cols() SETOF record
+		pg_get_userbyid(oid) name leader
+		pg_get_viewdef(oid) varchar leader
+		pg_get_viewdef(varchar) varchar leader
+		pg_get_viewdef(oid, boolean) varchar leader
+		pg_last_copy_count() integer
+		pg_last_copy_id() integer
+		pg_last_query_id() integer
+		pg_last_unload_count() integer
+		pg_last_unload_id() integer
+		pg_table_is_visible(oid) boolean leader
+		slice_num() integer
+		version() varchar
+	`},
 }
 
-func GetBuiltinFunctions() []string {
-	var result []string
-	result = append(result, builtinFunctions...)
-	return result
+// unsupportedPostgreSQLFunctions are PostgreSQL functions that Redshift does
+// not support, grouped as in "Unsupported PostgreSQL functions" in the
+// Redshift documentation.
+var unsupportedPostgreSQLFunctions = map[string][]string{
+	"access privilege inquiry functions": {
+		"has_any_column_privilege", "has_column_privilege", "has_foreign_data_wrapper_privilege",
+		"has_function_privilege", "has_language_privilege", "has_sequence_privilege",
+		"has_server_privilege", "has_tablespace_privilege", "has_type_privilege", "pg_has_role",
+	},
+	"advisory lock functions": {
+		"pg_advisory_lock", "pg_advisory_lock_shared", "pg_advisory_unlock", "pg_advisory_unlock_all",
+		"pg_advisory_unlock_shared", "pg_advisory_xact_lock", "pg_advisory_xact_lock_shared",
+		"pg_try_advisory_lock", "pg_try_advisory_lock_shared", "pg_try_advisory_xact_lock",
+		"pg_try_advisory_xact_lock_shared",
+	},
+	"aggregate functions": {
+		"array_agg", "corr", "covar_pop", "covar_samp", "every", "regr_avgx", "regr_avgy", "regr_count",
+		"regr_intercept", "regr_r2", "regr_slope", "regr_sxx", "regr_sxy", "regr_syy", "string_agg", "xmlagg",
+	},
+	"array functions": {
+		"array_append", "array_cat", "array_dims", "array_fill", "array_length", "array_lower",
+		"array_ndims", "array_position", "array_positions", "array_prepend", "array_remove",
+		"array_replace", "array_to_string", "array_upper", "cardinality", "string_to_array",
+		"trim_array", "unnest",
+	},
+	"backup and recovery control functions": {
+		"pg_backup_start", "pg_backup_stop", "pg_create_restore_point", "pg_is_in_recovery",
+		"pg_is_wal_replay_paused", "pg_last_wal_receive_lsn", "pg_last_wal_replay_lsn",
+		"pg_last_xact_replay_timestamp", "pg_start_backup", "pg_stop_backup", "pg_switch_wal",
+		"pg_wal_replay_pause", "pg_wal_replay_resume",
+	},
+	"comment information functions": {"col_description", "obj_description", "shobj_description"},
+	"database object size and location functions": {
+		"pg_column_size", "pg_database_size", "pg_indexes_size", "pg_relation_filenode",
+		"pg_relation_filepath", "pg_relation_size", "pg_size_pretty", "pg_table_size",
+		"pg_tablespace_size", "pg_total_relation_size",
+	},
+	"date/time functions": {
+		"clock_timestamp", "date_bin", "justify_days", "justify_hours", "justify_interval",
+		"make_date", "make_interval", "make_time", "make_timestamp", "make_timestamptz",
+		"pg_sleep", "transaction_timestamp",
+	},
+	"enum support functions":        {"enum_first", "enum_last", "enum_range"},
+	"generic file access functions": {"pg_ls_dir", "pg_read_binary_file", "pg_read_file", "pg_stat_file"},
+	"geometric functions": {
+		"area", "box", "center", "circle", "diameter", "height", "isclosed", "isopen", "lseg",
+		"npoints", "path", "pclose", "point", "polygon", "popen", "radius", "width",
+	},
+	"json functions": {
+		"json_agg", "json_array_elements", "json_build_array", "json_build_object", "json_each",
+		"json_object_agg", "jsonb_agg", "jsonb_build_object", "jsonb_set", "row_to_json",
+		"to_json", "to_jsonb",
+	},
+	"mathematical functions": {"div", "setseed", "width_bucket"},
+	"network address functions": {
+		"abbrev", "broadcast", "family", "host", "hostmask", "inet_merge", "inet_same_family",
+		"masklen", "netmask", "network", "set_masklen",
+	},
+	"range functions":                    {"isempty", "lower_inc", "lower_inf", "range_merge", "upper_inc", "upper_inf"},
+	"sequence manipulation functions":    {"currval", "lastval", "nextval", "setval"},
+	"server signaling functions":         {"pg_reload_conf", "pg_rotate_logfile"},
+	"set returning functions":            {"generate_subscripts"},
+	"snapshot synchronization functions": {"pg_export_snapshot"},
+	"string functions": {
+		"bit_length", "convert_from", "convert_to", "encode", "format", "overlay", "quote_nullable",
+		"regexp_matches", "regexp_split_to_array", "regexp_split_to_table",
+	},
+	"system information functions": {
+		"current_query", "inet_client_addr", "inet_client_port", "inet_server_addr", "inet_server_port",
+		"pg_conf_load_time", "pg_is_other_temp_schema", "pg_listening_channels", "pg_my_temp_schema",
+		"pg_postmaster_start_time", "pg_trigger_depth",
+	},
+	"text search functions": {
+		"array_to_tsvector", "get_current_ts_config", "numnode", "phraseto_tsquery", "plainto_tsquery",
+		"querytree", "setweight", "strip", "to_tsquery", "to_tsvector", "ts_debug", "ts_headline",
+		"ts_lexize", "ts_parse", "ts_rank", "ts_rank_cd", "ts_rewrite", "ts_stat", "ts_token_type",
+		"tsvector_to_array", "websearch_to_tsquery",
+	},
+	"transaction ID and snapshot functions": {
+		"pg_current_xact_id", "txid_current", "txid_current_snapshot", "txid_snapshot_xip",
+		"txid_snapshot_xmax", "txid_snapshot_xmin", "txid_visible_in_snapshot",
+	},
+	"trigger functions": {"suppress_redundant_updates_trigger", "tsvector_update_trigger", "tsvector_update_trigger_column"},
+	"uuid functions":    {"gen_random_uuid"},
+	"xml functions": {
+		"query_to_xml", "table_to_xml", "xml_is_well_formed", "xmlcomment", "xmlconcat", "xmlelement",
+		"xmlforest", "xmlpi", "xmlroot", "xpath", "xpath_exists",
+	},
 }
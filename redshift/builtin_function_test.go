@@ -0,0 +1,62 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/bytebase/parser/redshift"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupBuiltinFunction(t *testing.T) {
+	listagg := redshift.LookupBuiltinFunction("LISTAGG")
+	require.Len(t, listagg, 2)
+	require.Equal(t, redshift.FunctionKindAggregate, listagg[0].Kind)
+	require.Equal(t, redshift.FunctionKindWindow, listagg[1].Kind)
+	require.Equal(t, redshift.ComputeNodeOnly, listagg[0].Node)
+
+	dateadd := redshift.LookupBuiltinFunction("dateadd")[0]
+	require.Equal(t, redshift.DateTimeFunction, dateadd.Category)
+	require.Equal(t, "(varchar, bigint, timestamp) RETURNS timestamp", dateadd.Signatures[1].String())
+
+	require.Equal(t, redshift.LeaderNodeOnly, redshift.LookupBuiltinFunction("now")[0].Node)
+	require.Equal(t, redshift.AnyNode, redshift.LookupBuiltinFunction("getdate")[0].Node)
+	require.Equal(t, "geometry", redshift.LookupBuiltinFunction("st_makepoint")[0].Signatures[0].ReturnType)
+
+	path := redshift.LookupBuiltinFunction("json_extract_path_text")[0].Signatures[0]
+	require.True(t, path.Variadic)
+	require.True(t, path.Accepts(3))
+	require.False(t, path.Accepts(0))
+
+	// PostgreSQL functions that Redshift lacks are not in the catalog.
+	require.Empty(t, redshift.LookupBuiltinFunction("string_agg"))
+	group, ok := redshift.LookupUnsupportedFunction("NEXTVAL")
+	require.True(t, ok)
+	require.Equal(t, "sequence manipulation functions", group)
+	_, ok = redshift.LookupUnsupportedFunction("getdate")
+	require.False(t, ok)
+}
+
+func TestBuiltinFunctionCatalog(t *testing.T) {
+	names := make(map[string]bool)
+	for _, f := range redshift.GetBuiltinFunctionCatalog() {
+		require.NotEmpty(t, f.Signatures, f.Name)
+		_, unsupported := redshift.LookupUnsupportedFunction(f.Name)
+		require.False(t, unsupported, f.Name)
+		names[f.Name] = true
+	}
+	require.Len(t, redshift.GetBuiltinFunctions(), len(names))
+	require.Contains(t, names, "approximate percentile_disc")
+}
+
+func TestFunctionSignatureAccepts(t *testing.T) {
+	path := redshift.LookupBuiltinFunction("json_extract_path_text")[0].Signatures[0]
+	require.Equal(t, "(varchar, VARIADIC varchar) RETURNS varchar", path.String())
+	require.False(t, path.Accepts(1))
+	require.True(t, path.Accepts(2))
+	require.True(t, path.Accepts(5))
+
+	dateadd := redshift.LookupBuiltinFunction("dateadd")[0].Signatures[1]
+	require.False(t, dateadd.Accepts(2))
+	require.True(t, dateadd.Accepts(3))
+	require.False(t, dateadd.Accepts(4))
+}
@@ -0,0 +1,51 @@
+package redshift
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/completion"
+)
+
+// completionRules are reported instead of their tokens, so that callers can
+// offer table, column and function names from a catalog. The identifier
+// rules are included because they accept most unreserved keywords, which
+// would otherwise be listed wherever a name may appear.
+var completionRules = []int{
+	RedshiftParserRULE_qualified_name,
+	RedshiftParserRULE_columnref,
+	RedshiftParserRULE_func_name,
+	RedshiftParserRULE_type_function_name,
+	RedshiftParserRULE_colid,
+	RedshiftParserRULE_collabel,
+	RedshiftParserRULE_nonreservedword,
+	RedshiftParserRULE_identifier,
+}
+
+// GetCompletionCandidates returns the keywords, built-in functions and name
+// rules valid at caretOffset, a rune offset into statement. The statement
+// does not need to be complete or valid after the caret.
+func GetCompletionCandidates(statement string, caretOffset int) *completion.Candidates {
+	lexer := NewRedshiftLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := NewRedshiftParser(stream)
+
+	return completion.Collect(parser, stream, caretOffset, completion.Options{
+		PreferredRules: completionRules,
+		IsKeyword:      isKeywordText,
+		FunctionRules:  []int{RedshiftParserRULE_func_name},
+		Functions:      GetBuiltinFunctions(),
+	})
+}
+
+// isKeywordText reports whether a literal token text is a keyword. Keywords
+// only lists the words Redshift reserves, so every literal token made of
+// letters, digits and underscores counts, as the lexer defines no other such
+// tokens.
+func isKeywordText(text string) bool {
+	for i, r := range text {
+		if (r < 'A' || r > 'Z') && r != '_' && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+package redshift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// FunctionProblem is why a function call fails on Redshift.
+type FunctionProblem int
+
+const (
+	// FunctionUnsupported is a PostgreSQL function that Redshift does not support.
+	FunctionUnsupported FunctionProblem = iota
+	// FunctionLeaderNodeOnly is a leader node function in a query that references a table.
+	FunctionLeaderNodeOnly
+	// FunctionComputeNodeOnly is a compute node function in a query that references no table.
+	FunctionComputeNodeOnly
+)
+
+// FunctionLintError reports a function call that fails on Redshift.
+type FunctionLintError struct {
+	Line     int
+	Column   int
+	Function string
+	Problem  FunctionProblem
+	// Group is the group of PostgreSQL functions an unsupported function
+	// belongs to, e.g. "sequence manipulation functions".
+	Group string
+}
+
+func (e *FunctionLintError) Error() string {
+	switch e.Problem {
+	case FunctionLeaderNodeOnly:
+		return fmt.Sprintf("line %d:%d function %s runs only on the leader node and cannot be used in a query that references a table", e.Line, e.Column, e.Function)
+	case FunctionComputeNodeOnly:
+		return fmt.Sprintf("line %d:%d function %s runs only on the compute nodes and must be used in a query that references a table", e.Line, e.Column, e.Function)
+	default:
+		return fmt.Sprintf("line %d:%d function %s not supported by Redshift (%s)", e.Line, e.Column, e.Function, e.Group)
+	}
+}
+
+// LintFunctions walks a tree returned by the Redshift parser and reports every
+// call to a PostgreSQL function that Redshift does not support, and every call
+// to a leader node or compute node function in a statement where it cannot run.
+// A statement references a table if it reads a user-defined or system table;
+// catalog tables such as pg_class live on the leader node and do not count.
+func LintFunctions(tree antlr.Tree) []*FunctionLintError {
+	parseTree, ok := tree.(antlr.ParseTree)
+	if !ok {
+		return nil
+	}
+	linter := &functionLinter{BaseRedshiftParserListener: &BaseRedshiftParserListener{}}
+	antlr.ParseTreeWalkerDefault.Walk(linter, parseTree)
+	return linter.errors
+}
+
+type functionLinter struct {
+	*BaseRedshiftParserListener
+
+	errors []*FunctionLintError
+
+	// depth is the nesting of stmt rules; the state below belongs to the
+	// outermost statement.
+	depth     int
+	calls     []*FunctionLintError
+	cteNames  map[string]bool
+	relations []string
+}
+
+func (l *functionLinter) EnterStmt(ctx *StmtContext) {
+	if l.depth == 0 {
+		l.calls, l.relations = nil, nil
+		l.cteNames = make(map[string]bool)
+	}
+	l.depth++
+}
+
+func (l *functionLinter) ExitStmt(ctx *StmtContext) {
+	l.depth--
+	if l.depth > 0 {
+		return
+	}
+	referencesTable := false
+	for _, relation := range l.relations {
+		isCTE := !strings.Contains(relation, ".") && l.cteNames[normalizeName(relation)]
+		if !isCTE && !isCatalogRelation(relation) {
+			referencesTable = true
+			break
+		}
+	}
+	for _, call := range l.calls {
+		if call.Problem == FunctionLeaderNodeOnly && !referencesTable || call.Problem == FunctionComputeNodeOnly && referencesTable {
+			continue
+		}
+		l.errors = append(l.errors, call)
+	}
+}
+
+func (l *functionLinter) EnterCommon_table_expr(ctx *Common_table_exprContext) {
+	l.cteNames[normalizeName(ctx.Name().GetText())] = true
+}
+
+func (l *functionLinter) EnterRelation_expr(ctx *Relation_exprContext) {
+	if ctx.Qualified_name() != nil {
+		l.relations = append(l.relations, strings.ToLower(ctx.Qualified_name().GetText()))
+	}
+}
+
+func (l *functionLinter) EnterFunc_application(ctx *Func_applicationContext) {
+	l.check(ctx.Func_name().GetText(), ctx.GetStart())
+}
+
+// EnterFunc_expr_common_subexpr checks the functions with special syntax, such
+// as CURRENT_TIME or XMLELEMENT(...), which are named by their first token.
+func (l *functionLinter) EnterFunc_expr_common_subexpr(ctx *Func_expr_common_subexprContext) {
+	l.check(ctx.GetStart().GetText(), ctx.GetStart())
+}
+
+func (l *functionLinter) check(name string, token antlr.Token) {
+	if l.depth == 0 {
+		return
+	}
+	name = normalizeName(name)
+	call := &FunctionLintError{
+		Line:     token.GetLine(),
+		Column:   token.GetColumn(),
+		Function: name,
+	}
+	functions := LookupBuiltinFunction(name)
+	if len(functions) == 0 {
+		group, ok := LookupUnsupportedFunction(name)
+		if !ok {
+			return
+		}
+		call.Problem, call.Group = FunctionUnsupported, group
+		l.calls = append(l.calls, call)
+		return
+	}
+	for _, f := range functions {
+		switch f.Node {
+		case LeaderNodeOnly:
+			call.Problem = FunctionLeaderNodeOnly
+		case ComputeNodeOnly:
+			call.Problem = FunctionComputeNodeOnly
+		default:
+			continue
+		}
+		l.calls = append(l.calls, call)
+		return
+	}
+}
+
+// normalizeName drops the schema of a qualified name, unquotes it and folds
+// it to lower case.
+func normalizeName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ToLower(strings.Trim(name, `"`))
+}
+
+// isCatalogRelation reports whether a lower-case relation name refers to a
+// catalog table, which the leader node reads without the compute nodes.
+func isCatalogRelation(relation string) bool {
+	schema, name := "", relation
+	if i := strings.LastIndex(relation, "."); i >= 0 {
+		schema, name = relation[:i], relation[i+1:]
+	}
+	schema, name = strings.Trim(schema, `"`), strings.Trim(name, `"`)
+	return schema == "pg_catalog" || schema == "information_schema" || strings.HasPrefix(name, "pg_")
+}
@@ -0,0 +1,57 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/redshift"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintFunctions(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{
+			statement: "SELECT getdate(), dateadd(day, 1, created_at) FROM users;",
+		},
+		{
+			statement: "SELECT now(), current_schemas(false);",
+		},
+		{
+			statement: "SELECT relname, now() FROM pg_catalog.pg_class;",
+		},
+		{
+			statement: "SELECT id, now() FROM users;",
+			want:      []string{"line 1:11 function now runs only on the leader node and cannot be used in a query that references a table"},
+		},
+		{
+			statement: "WITH t AS (SELECT 1 AS x) SELECT generate_series(1, 3), x FROM t;",
+		},
+		{
+			statement: "SELECT listagg(name, ',') WITHIN GROUP (ORDER BY name) FROM users;",
+		},
+		{
+			statement: "SELECT median(1);",
+			want:      []string{"line 1:7 function median runs only on the compute nodes and must be used in a query that references a table"},
+		},
+		{
+			statement: "SELECT nextval('seq'), string_agg(name, ',') FROM users;",
+			want: []string{
+				"line 1:7 function nextval not supported by Redshift (sequence manipulation functions)",
+				"line 1:23 function string_agg not supported by Redshift (aggregate functions)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		lexer := redshift.NewRedshiftLexer(antlr.NewInputStream(tt.statement))
+		parser := redshift.NewRedshiftParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		var got []string
+		for _, err := range redshift.LintFunctions(parser.Root()) {
+			got = append(got, err.Error())
+		}
+		require.Equal(t, tt.want, got, tt.statement)
+	}
+}
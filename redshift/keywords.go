@@ -6,850 +6,168 @@ type Keyword struct {
 	Reserved bool
 }
 
-// Retrieved from https://www.postgresql.org/docs/current/sql-keywords-appendix.html#KEYWORDS-TABLE
-// Reserved is true if it's label is in ["reserved", "reserved, requires as", "reserved (can be function or type)"]
+// Keywords are the words Amazon Redshift reserves. The list differs from
+// PostgreSQL's: it includes Redshift-only words such as the column
+// compression encodings (BYTEDICT, LZO, MOSTLY8, ...), while words like VALUE
+// or YEAR, which PostgreSQL treats as keywords, are ordinary identifiers on a
+// Redshift cluster and are not listed.
+// Retrieved from https://docs.aws.amazon.com/redshift/latest/dg/r_pg_keywords.html
 var Keywords = map[string]Keyword{
-	"A":                                {Keyword: "A", Reserved: false},
-	"ABORT":                            {Keyword: "ABORT", Reserved: false},
-	"ABS":                              {Keyword: "ABS", Reserved: false},
-	"ABSENT":                           {Keyword: "ABSENT", Reserved: false},
-	"ABSOLUTE":                         {Keyword: "ABSOLUTE", Reserved: false},
-	"ACCESS":                           {Keyword: "ACCESS", Reserved: false},
-	"ACCORDING":                        {Keyword: "ACCORDING", Reserved: false},
-	"ACOS":                             {Keyword: "ACOS", Reserved: false},
-	"ACTION":                           {Keyword: "ACTION", Reserved: false},
-	"ADA":                              {Keyword: "ADA", Reserved: false},
-	"ADD":                              {Keyword: "ADD", Reserved: false},
-	"ADMIN":                            {Keyword: "ADMIN", Reserved: false},
-	"AFTER":                            {Keyword: "AFTER", Reserved: false},
-	"AGGREGATE":                        {Keyword: "AGGREGATE", Reserved: false},
-	"ALL":                              {Keyword: "ALL", Reserved: true},
-	"ALLOCATE":                         {Keyword: "ALLOCATE", Reserved: false},
-	"ALSO":                             {Keyword: "ALSO", Reserved: false},
-	"ALTER":                            {Keyword: "ALTER", Reserved: false},
-	"ALWAYS":                           {Keyword: "ALWAYS", Reserved: false},
-	"ANALYSE":                          {Keyword: "ANALYSE", Reserved: true},
-	"ANALYZE":                          {Keyword: "ANALYZE", Reserved: true},
-	"AND":                              {Keyword: "AND", Reserved: true},
-	"ANY":                              {Keyword: "ANY", Reserved: true},
-	"ANY_VALUE":                        {Keyword: "ANY_VALUE", Reserved: false},
-	"ARE":                              {Keyword: "ARE", Reserved: false},
-	"ARRAY":                            {Keyword: "ARRAY", Reserved: true},
-	"ARRAY_AGG":                        {Keyword: "ARRAY_AGG", Reserved: false},
-	"ARRAY_MAX_CARDINALITY":            {Keyword: "ARRAY_MAX_CARDINALITY", Reserved: false},
-	"AS":                               {Keyword: "AS", Reserved: true},
-	"ASC":                              {Keyword: "ASC", Reserved: true},
-	"ASENSITIVE":                       {Keyword: "ASENSITIVE", Reserved: false},
-	"ASIN":                             {Keyword: "ASIN", Reserved: false},
-	"ASSERTION":                        {Keyword: "ASSERTION", Reserved: false},
-	"ASSIGNMENT":                       {Keyword: "ASSIGNMENT", Reserved: false},
-	"ASYMMETRIC":                       {Keyword: "ASYMMETRIC", Reserved: true},
-	"AT":                               {Keyword: "AT", Reserved: false},
-	"ATAN":                             {Keyword: "ATAN", Reserved: false},
-	"ATOMIC":                           {Keyword: "ATOMIC", Reserved: false},
-	"ATTACH":                           {Keyword: "ATTACH", Reserved: false},
-	"ATTRIBUTE":                        {Keyword: "ATTRIBUTE", Reserved: false},
-	"ATTRIBUTES":                       {Keyword: "ATTRIBUTES", Reserved: false},
-	"AUTHORIZATION":                    {Keyword: "AUTHORIZATION", Reserved: true},
-	"AVG":                              {Keyword: "AVG", Reserved: false},
-	"BACKWARD":                         {Keyword: "BACKWARD", Reserved: false},
-	"BASE64":                           {Keyword: "BASE64", Reserved: false},
-	"BEFORE":                           {Keyword: "BEFORE", Reserved: false},
-	"BEGIN":                            {Keyword: "BEGIN", Reserved: false},
-	"BEGIN_FRAME":                      {Keyword: "BEGIN_FRAME", Reserved: false},
-	"BEGIN_PARTITION":                  {Keyword: "BEGIN_PARTITION", Reserved: false},
-	"BERNOULLI":                        {Keyword: "BERNOULLI", Reserved: false},
-	"BETWEEN":                          {Keyword: "BETWEEN", Reserved: false},
-	"BIGINT":                           {Keyword: "BIGINT", Reserved: false},
-	"BINARY":                           {Keyword: "BINARY", Reserved: true},
-	"BIT":                              {Keyword: "BIT", Reserved: false},
-	"BIT_LENGTH":                       {Keyword: "BIT_LENGTH", Reserved: false},
-	"BLOB":                             {Keyword: "BLOB", Reserved: false},
-	"BLOCKED":                          {Keyword: "BLOCKED", Reserved: false},
-	"BOM":                              {Keyword: "BOM", Reserved: false},
-	"BOOLEAN":                          {Keyword: "BOOLEAN", Reserved: false},
-	"BOTH":                             {Keyword: "BOTH", Reserved: true},
-	"BREADTH":                          {Keyword: "BREADTH", Reserved: false},
-	"BTRIM":                            {Keyword: "BTRIM", Reserved: false},
-	"BY":                               {Keyword: "BY", Reserved: false},
-	"C":                                {Keyword: "C", Reserved: false},
-	"CACHE":                            {Keyword: "CACHE", Reserved: false},
-	"CALL":                             {Keyword: "CALL", Reserved: false},
-	"CALLED":                           {Keyword: "CALLED", Reserved: false},
-	"CARDINALITY":                      {Keyword: "CARDINALITY", Reserved: false},
-	"CASCADE":                          {Keyword: "CASCADE", Reserved: false},
-	"CASCADED":                         {Keyword: "CASCADED", Reserved: false},
-	"CASE":                             {Keyword: "CASE", Reserved: true},
-	"CAST":                             {Keyword: "CAST", Reserved: true},
-	"CATALOG":                          {Keyword: "CATALOG", Reserved: false},
-	"CATALOG_NAME":                     {Keyword: "CATALOG_NAME", Reserved: false},
-	"CEIL":                             {Keyword: "CEIL", Reserved: false},
-	"CEILING":                          {Keyword: "CEILING", Reserved: false},
-	"CHAIN":                            {Keyword: "CHAIN", Reserved: false},
-	"CHAINING":                         {Keyword: "CHAINING", Reserved: false},
-	"CHAR":                             {Keyword: "CHAR", Reserved: false},
-	"CHARACTER":                        {Keyword: "CHARACTER", Reserved: false},
-	"CHARACTERISTICS":                  {Keyword: "CHARACTERISTICS", Reserved: false},
-	"CHARACTERS":                       {Keyword: "CHARACTERS", Reserved: false},
-	"CHARACTER_LENGTH":                 {Keyword: "CHARACTER_LENGTH", Reserved: false},
-	"CHARACTER_SET_CATALOG":            {Keyword: "CHARACTER_SET_CATALOG", Reserved: false},
-	"CHARACTER_SET_NAME":               {Keyword: "CHARACTER_SET_NAME", Reserved: false},
-	"CHARACTER_SET_SCHEMA":             {Keyword: "CHARACTER_SET_SCHEMA", Reserved: false},
-	"CHAR_LENGTH":                      {Keyword: "CHAR_LENGTH", Reserved: false},
-	"CHECK":                            {Keyword: "CHECK", Reserved: true},
-	"CHECKPOINT":                       {Keyword: "CHECKPOINT", Reserved: false},
-	"CLASS":                            {Keyword: "CLASS", Reserved: false},
-	"CLASSIFIER":                       {Keyword: "CLASSIFIER", Reserved: false},
-	"CLASS_ORIGIN":                     {Keyword: "CLASS_ORIGIN", Reserved: false},
-	"CLOB":                             {Keyword: "CLOB", Reserved: false},
-	"CLOSE":                            {Keyword: "CLOSE", Reserved: false},
-	"CLUSTER":                          {Keyword: "CLUSTER", Reserved: false},
-	"COALESCE":                         {Keyword: "COALESCE", Reserved: false},
-	"COBOL":                            {Keyword: "COBOL", Reserved: false},
-	"COLLATE":                          {Keyword: "COLLATE", Reserved: true},
-	"COLLATION":                        {Keyword: "COLLATION", Reserved: true},
-	"COLLATION_CATALOG":                {Keyword: "COLLATION_CATALOG", Reserved: false},
-	"COLLATION_NAME":                   {Keyword: "COLLATION_NAME", Reserved: false},
-	"COLLATION_SCHEMA":                 {Keyword: "COLLATION_SCHEMA", Reserved: false},
-	"COLLECT":                          {Keyword: "COLLECT", Reserved: false},
-	"COLUMN":                           {Keyword: "COLUMN", Reserved: true},
-	"COLUMNS":                          {Keyword: "COLUMNS", Reserved: false},
-	"COLUMN_NAME":                      {Keyword: "COLUMN_NAME", Reserved: false},
-	"COMMAND_FUNCTION":                 {Keyword: "COMMAND_FUNCTION", Reserved: false},
-	"COMMAND_FUNCTION_CODE":            {Keyword: "COMMAND_FUNCTION_CODE", Reserved: false},
-	"COMMENT":                          {Keyword: "COMMENT", Reserved: false},
-	"COMMENTS":                         {Keyword: "COMMENTS", Reserved: false},
-	"COMMIT":                           {Keyword: "COMMIT", Reserved: false},
-	"COMMITTED":                        {Keyword: "COMMITTED", Reserved: false},
-	"COMPRESSION":                      {Keyword: "COMPRESSION", Reserved: false},
-	"CONCURRENTLY":                     {Keyword: "CONCURRENTLY", Reserved: true},
-	"CONDITION":                        {Keyword: "CONDITION", Reserved: false},
-	"CONDITIONAL":                      {Keyword: "CONDITIONAL", Reserved: false},
-	"CONDITION_NUMBER":                 {Keyword: "CONDITION_NUMBER", Reserved: false},
-	"CONFIGURATION":                    {Keyword: "CONFIGURATION", Reserved: false},
-	"CONFLICT":                         {Keyword: "CONFLICT", Reserved: false},
-	"CONNECT":                          {Keyword: "CONNECT", Reserved: false},
-	"CONNECTION":                       {Keyword: "CONNECTION", Reserved: false},
-	"CONNECTION_NAME":                  {Keyword: "CONNECTION_NAME", Reserved: false},
-	"CONSTRAINT":                       {Keyword: "CONSTRAINT", Reserved: true},
-	"CONSTRAINTS":                      {Keyword: "CONSTRAINTS", Reserved: false},
-	"CONSTRAINT_CATALOG":               {Keyword: "CONSTRAINT_CATALOG", Reserved: false},
-	"CONSTRAINT_NAME":                  {Keyword: "CONSTRAINT_NAME", Reserved: false},
-	"CONSTRAINT_SCHEMA":                {Keyword: "CONSTRAINT_SCHEMA", Reserved: false},
-	"CONSTRUCTOR":                      {Keyword: "CONSTRUCTOR", Reserved: false},
-	"CONTAINS":                         {Keyword: "CONTAINS", Reserved: false},
-	"CONTENT":                          {Keyword: "CONTENT", Reserved: false},
-	"CONTINUE":                         {Keyword: "CONTINUE", Reserved: false},
-	"CONTROL":                          {Keyword: "CONTROL", Reserved: false},
-	"CONVERSION":                       {Keyword: "CONVERSION", Reserved: false},
-	"CONVERT":                          {Keyword: "CONVERT", Reserved: false},
-	"COPARTITION":                      {Keyword: "COPARTITION", Reserved: false},
-	"COPY":                             {Keyword: "COPY", Reserved: false},
-	"CORR":                             {Keyword: "CORR", Reserved: false},
-	"CORRESPONDING":                    {Keyword: "CORRESPONDING", Reserved: false},
-	"COS":                              {Keyword: "COS", Reserved: false},
-	"COSH":                             {Keyword: "COSH", Reserved: false},
-	"COST":                             {Keyword: "COST", Reserved: false},
-	"COUNT":                            {Keyword: "COUNT", Reserved: false},
-	"COVAR_POP":                        {Keyword: "COVAR_POP", Reserved: false},
-	"COVAR_SAMP":                       {Keyword: "COVAR_SAMP", Reserved: false},
-	"CREATE":                           {Keyword: "CREATE", Reserved: true},
-	"CROSS":                            {Keyword: "CROSS", Reserved: true},
-	"CSV":                              {Keyword: "CSV", Reserved: false},
-	"CUBE":                             {Keyword: "CUBE", Reserved: false},
-	"CUME_DIST":                        {Keyword: "CUME_DIST", Reserved: false},
-	"CURRENT":                          {Keyword: "CURRENT", Reserved: false},
-	"CURRENT_CATALOG":                  {Keyword: "CURRENT_CATALOG", Reserved: true},
-	"CURRENT_DATE":                     {Keyword: "CURRENT_DATE", Reserved: true},
-	"CURRENT_DEFAULT_TRANSFORM_GROUP":  {Keyword: "CURRENT_DEFAULT_TRANSFORM_GROUP", Reserved: false},
-	"CURRENT_PATH":                     {Keyword: "CURRENT_PATH", Reserved: false},
-	"CURRENT_ROLE":                     {Keyword: "CURRENT_ROLE", Reserved: true},
-	"CURRENT_ROW":                      {Keyword: "CURRENT_ROW", Reserved: false},
-	"CURRENT_SCHEMA":                   {Keyword: "CURRENT_SCHEMA", Reserved: true},
-	"CURRENT_TIME":                     {Keyword: "CURRENT_TIME", Reserved: true},
-	"CURRENT_TIMESTAMP":                {Keyword: "CURRENT_TIMESTAMP", Reserved: true},
-	"CURRENT_TRANSFORM_GROUP_FOR_TYPE": {Keyword: "CURRENT_TRANSFORM_GROUP_FOR_TYPE", Reserved: false},
-	"CURRENT_USER":                     {Keyword: "CURRENT_USER", Reserved: true},
-	"CURSOR":                           {Keyword: "CURSOR", Reserved: false},
-	"CURSOR_NAME":                      {Keyword: "CURSOR_NAME", Reserved: false},
-	"CYCLE":                            {Keyword: "CYCLE", Reserved: false},
-	"DATA":                             {Keyword: "DATA", Reserved: false},
-	"DATABASE":                         {Keyword: "DATABASE", Reserved: false},
-	"DATALINK":                         {Keyword: "DATALINK", Reserved: false},
-	"DATE":                             {Keyword: "DATE", Reserved: false},
-	"DATETIME_INTERVAL_CODE":           {Keyword: "DATETIME_INTERVAL_CODE", Reserved: false},
-	"DATETIME_INTERVAL_PRECISION":      {Keyword: "DATETIME_INTERVAL_PRECISION", Reserved: false},
-	"DAY":                              {Keyword: "DAY", Reserved: false},
-	"DB":                               {Keyword: "DB", Reserved: false},
-	"DEALLOCATE":                       {Keyword: "DEALLOCATE", Reserved: false},
-	"DEC":                              {Keyword: "DEC", Reserved: false},
-	"DECFLOAT":                         {Keyword: "DECFLOAT", Reserved: false},
-	"DECIMAL":                          {Keyword: "DECIMAL", Reserved: false},
-	"DECLARE":                          {Keyword: "DECLARE", Reserved: false},
-	"DEFAULT":                          {Keyword: "DEFAULT", Reserved: true},
-	"DEFAULTS":                         {Keyword: "DEFAULTS", Reserved: false},
-	"DEFERRABLE":                       {Keyword: "DEFERRABLE", Reserved: true},
-	"DEFERRED":                         {Keyword: "DEFERRED", Reserved: false},
-	"DEFINE":                           {Keyword: "DEFINE", Reserved: false},
-	"DEFINED":                          {Keyword: "DEFINED", Reserved: false},
-	"DEFINER":                          {Keyword: "DEFINER", Reserved: false},
-	"DEGREE":                           {Keyword: "DEGREE", Reserved: false},
-	"DELETE":                           {Keyword: "DELETE", Reserved: false},
-	"DELIMITER":                        {Keyword: "DELIMITER", Reserved: false},
-	"DELIMITERS":                       {Keyword: "DELIMITERS", Reserved: false},
-	"DENSE_RANK":                       {Keyword: "DENSE_RANK", Reserved: false},
-	"DEPENDS":                          {Keyword: "DEPENDS", Reserved: false},
-	"DEPTH":                            {Keyword: "DEPTH", Reserved: false},
-	"DEREF":                            {Keyword: "DEREF", Reserved: false},
-	"DERIVED":                          {Keyword: "DERIVED", Reserved: false},
-	"DESC":                             {Keyword: "DESC", Reserved: true},
-	"DESCRIBE":                         {Keyword: "DESCRIBE", Reserved: false},
-	"DESCRIPTOR":                       {Keyword: "DESCRIPTOR", Reserved: false},
-	"DETACH":                           {Keyword: "DETACH", Reserved: false},
-	"DETERMINISTIC":                    {Keyword: "DETERMINISTIC", Reserved: false},
-	"DIAGNOSTICS":                      {Keyword: "DIAGNOSTICS", Reserved: false},
-	"DICTIONARY":                       {Keyword: "DICTIONARY", Reserved: false},
-	"DISABLE":                          {Keyword: "DISABLE", Reserved: false},
-	"DISCARD":                          {Keyword: "DISCARD", Reserved: false},
-	"DISCONNECT":                       {Keyword: "DISCONNECT", Reserved: false},
-	"DISPATCH":                         {Keyword: "DISPATCH", Reserved: false},
-	"DISTINCT":                         {Keyword: "DISTINCT", Reserved: true},
-	"DLNEWCOPY":                        {Keyword: "DLNEWCOPY", Reserved: false},
-	"DLPREVIOUSCOPY":                   {Keyword: "DLPREVIOUSCOPY", Reserved: false},
-	"DLURLCOMPLETE":                    {Keyword: "DLURLCOMPLETE", Reserved: false},
-	"DLURLCOMPLETEONLY":                {Keyword: "DLURLCOMPLETEONLY", Reserved: false},
-	"DLURLCOMPLETEWRITE":               {Keyword: "DLURLCOMPLETEWRITE", Reserved: false},
-	"DLURLPATH":                        {Keyword: "DLURLPATH", Reserved: false},
-	"DLURLPATHONLY":                    {Keyword: "DLURLPATHONLY", Reserved: false},
-	"DLURLPATHWRITE":                   {Keyword: "DLURLPATHWRITE", Reserved: false},
-	"DLURLSCHEME":                      {Keyword: "DLURLSCHEME", Reserved: false},
-	"DLURLSERVER":                      {Keyword: "DLURLSERVER", Reserved: false},
-	"DLVALUE":                          {Keyword: "DLVALUE", Reserved: false},
-	"DO":                               {Keyword: "DO", Reserved: true},
-	"DOCUMENT":                         {Keyword: "DOCUMENT", Reserved: false},
-	"DOMAIN":                           {Keyword: "DOMAIN", Reserved: false},
-	"DOUBLE":                           {Keyword: "DOUBLE", Reserved: false},
-	"DROP":                             {Keyword: "DROP", Reserved: false},
-	"DYNAMIC":                          {Keyword: "DYNAMIC", Reserved: false},
-	"DYNAMIC_FUNCTION":                 {Keyword: "DYNAMIC_FUNCTION", Reserved: false},
-	"DYNAMIC_FUNCTION_CODE":            {Keyword: "DYNAMIC_FUNCTION_CODE", Reserved: false},
-	"EACH":                             {Keyword: "EACH", Reserved: false},
-	"ELEMENT":                          {Keyword: "ELEMENT", Reserved: false},
-	"ELSE":                             {Keyword: "ELSE", Reserved: true},
-	"EMPTY":                            {Keyword: "EMPTY", Reserved: false},
-	"ENABLE":                           {Keyword: "ENABLE", Reserved: false},
-	"ENCODING":                         {Keyword: "ENCODING", Reserved: false},
-	"ENCRYPTED":                        {Keyword: "ENCRYPTED", Reserved: false},
-	"END":                              {Keyword: "END", Reserved: true},
-	"END-EXEC":                         {Keyword: "END-EXEC", Reserved: false},
-	"END_FRAME":                        {Keyword: "END_FRAME", Reserved: false},
-	"END_PARTITION":                    {Keyword: "END_PARTITION", Reserved: false},
-	"ENFORCED":                         {Keyword: "ENFORCED", Reserved: false},
-	"ENUM":                             {Keyword: "ENUM", Reserved: false},
-	"EQUALS":                           {Keyword: "EQUALS", Reserved: false},
-	"ERROR":                            {Keyword: "ERROR", Reserved: false},
-	"ESCAPE":                           {Keyword: "ESCAPE", Reserved: false},
-	"EVENT":                            {Keyword: "EVENT", Reserved: false},
-	"EVERY":                            {Keyword: "EVERY", Reserved: false},
-	"EXCEPT":                           {Keyword: "EXCEPT", Reserved: true},
-	"EXCEPTION":                        {Keyword: "EXCEPTION", Reserved: false},
-	"EXCLUDE":                          {Keyword: "EXCLUDE", Reserved: false},
-	"EXCLUDING":                        {Keyword: "EXCLUDING", Reserved: false},
-	"EXCLUSIVE":                        {Keyword: "EXCLUSIVE", Reserved: false},
-	"EXEC":                             {Keyword: "EXEC", Reserved: false},
-	"EXECUTE":                          {Keyword: "EXECUTE", Reserved: false},
-	"EXISTS":                           {Keyword: "EXISTS", Reserved: false},
-	"EXP":                              {Keyword: "EXP", Reserved: false},
-	"EXPLAIN":                          {Keyword: "EXPLAIN", Reserved: false},
-	"EXPRESSION":                       {Keyword: "EXPRESSION", Reserved: false},
-	"EXTENSION":                        {Keyword: "EXTENSION", Reserved: false},
-	"EXTERNAL":                         {Keyword: "EXTERNAL", Reserved: false},
-	"EXTRACT":                          {Keyword: "EXTRACT", Reserved: false},
-	"FALSE":                            {Keyword: "FALSE", Reserved: true},
-	"FAMILY":                           {Keyword: "FAMILY", Reserved: false},
-	"FETCH":                            {Keyword: "FETCH", Reserved: true},
-	"FILE":                             {Keyword: "FILE", Reserved: false},
-	"FILTER":                           {Keyword: "FILTER", Reserved: false},
-	"FINAL":                            {Keyword: "FINAL", Reserved: false},
-	"FINALIZE":                         {Keyword: "FINALIZE", Reserved: false},
-	"FINISH":                           {Keyword: "FINISH", Reserved: false},
-	"FIRST":                            {Keyword: "FIRST", Reserved: false},
-	"FIRST_VALUE":                      {Keyword: "FIRST_VALUE", Reserved: false},
-	"FLAG":                             {Keyword: "FLAG", Reserved: false},
-	"FLOAT":                            {Keyword: "FLOAT", Reserved: false},
-	"FLOOR":                            {Keyword: "FLOOR", Reserved: false},
-	"FOLLOWING":                        {Keyword: "FOLLOWING", Reserved: false},
-	"FOR":                              {Keyword: "FOR", Reserved: true},
-	"FORCE":                            {Keyword: "FORCE", Reserved: false},
-	"FOREIGN":                          {Keyword: "FOREIGN", Reserved: true},
-	"FORMAT":                           {Keyword: "FORMAT", Reserved: false},
-	"FORTRAN":                          {Keyword: "FORTRAN", Reserved: false},
-	"FORWARD":                          {Keyword: "FORWARD", Reserved: false},
-	"FOUND":                            {Keyword: "FOUND", Reserved: false},
-	"FRAME_ROW":                        {Keyword: "FRAME_ROW", Reserved: false},
-	"FREE":                             {Keyword: "FREE", Reserved: false},
-	"FREEZE":                           {Keyword: "FREEZE", Reserved: true},
-	"FROM":                             {Keyword: "FROM", Reserved: true},
-	"FS":                               {Keyword: "FS", Reserved: false},
-	"FULFILL":                          {Keyword: "FULFILL", Reserved: false},
-	"FULL":                             {Keyword: "FULL", Reserved: true},
-	"FUNCTION":                         {Keyword: "FUNCTION", Reserved: false},
-	"FUNCTIONS":                        {Keyword: "FUNCTIONS", Reserved: false},
-	"FUSION":                           {Keyword: "FUSION", Reserved: false},
-	"G":                                {Keyword: "G", Reserved: false},
-	"GENERAL":                          {Keyword: "GENERAL", Reserved: false},
-	"GENERATED":                        {Keyword: "GENERATED", Reserved: false},
-	"GET":                              {Keyword: "GET", Reserved: false},
-	"GLOBAL":                           {Keyword: "GLOBAL", Reserved: false},
-	"GO":                               {Keyword: "GO", Reserved: false},
-	"GOTO":                             {Keyword: "GOTO", Reserved: false},
-	"GRANT":                            {Keyword: "GRANT", Reserved: true},
-	"GRANTED":                          {Keyword: "GRANTED", Reserved: false},
-	"GREATEST":                         {Keyword: "GREATEST", Reserved: false},
-	"GROUP":                            {Keyword: "GROUP", Reserved: true},
-	"GROUPING":                         {Keyword: "GROUPING", Reserved: false},
-	"GROUPS":                           {Keyword: "GROUPS", Reserved: false},
-	"HANDLER":                          {Keyword: "HANDLER", Reserved: false},
-	"HAVING":                           {Keyword: "HAVING", Reserved: true},
-	"HEADER":                           {Keyword: "HEADER", Reserved: false},
-	"HEX":                              {Keyword: "HEX", Reserved: false},
-	"HIERARCHY":                        {Keyword: "HIERARCHY", Reserved: false},
-	"HOLD":                             {Keyword: "HOLD", Reserved: false},
-	"HOUR":                             {Keyword: "HOUR", Reserved: false},
-	"ID":                               {Keyword: "ID", Reserved: false},
-	"IDENTITY":                         {Keyword: "IDENTITY", Reserved: false},
-	"IF":                               {Keyword: "IF", Reserved: false},
-	"IGNORE":                           {Keyword: "IGNORE", Reserved: false},
-	"ILIKE":                            {Keyword: "ILIKE", Reserved: true},
-	"IMMEDIATE":                        {Keyword: "IMMEDIATE", Reserved: false},
-	"IMMEDIATELY":                      {Keyword: "IMMEDIATELY", Reserved: false},
-	"IMMUTABLE":                        {Keyword: "IMMUTABLE", Reserved: false},
-	"IMPLEMENTATION":                   {Keyword: "IMPLEMENTATION", Reserved: false},
-	"IMPLICIT":                         {Keyword: "IMPLICIT", Reserved: false},
-	"IMPORT":                           {Keyword: "IMPORT", Reserved: false},
-	"IN":                               {Keyword: "IN", Reserved: true},
-	"INCLUDE":                          {Keyword: "INCLUDE", Reserved: false},
-	"INCLUDING":                        {Keyword: "INCLUDING", Reserved: false},
-	"INCREMENT":                        {Keyword: "INCREMENT", Reserved: false},
-	"INDENT":                           {Keyword: "INDENT", Reserved: false},
-	"INDEX":                            {Keyword: "INDEX", Reserved: false},
-	"INDEXES":                          {Keyword: "INDEXES", Reserved: false},
-	"INDICATOR":                        {Keyword: "INDICATOR", Reserved: false},
-	"INHERIT":                          {Keyword: "INHERIT", Reserved: false},
-	"INHERITS":                         {Keyword: "INHERITS", Reserved: false},
-	"INITIAL":                          {Keyword: "INITIAL", Reserved: false},
-	"INITIALLY":                        {Keyword: "INITIALLY", Reserved: true},
-	"INLINE":                           {Keyword: "INLINE", Reserved: false},
-	"INNER":                            {Keyword: "INNER", Reserved: true},
-	"INOUT":                            {Keyword: "INOUT", Reserved: false},
-	"INPUT":                            {Keyword: "INPUT", Reserved: false},
-	"INSENSITIVE":                      {Keyword: "INSENSITIVE", Reserved: false},
-	"INSERT":                           {Keyword: "INSERT", Reserved: false},
-	"INSTANCE":                         {Keyword: "INSTANCE", Reserved: false},
-	"INSTANTIABLE":                     {Keyword: "INSTANTIABLE", Reserved: false},
-	"INSTEAD":                          {Keyword: "INSTEAD", Reserved: false},
-	"INT":                              {Keyword: "INT", Reserved: false},
-	"INTEGER":                          {Keyword: "INTEGER", Reserved: false},
-	"INTEGRITY":                        {Keyword: "INTEGRITY", Reserved: false},
-	"INTERSECT":                        {Keyword: "INTERSECT", Reserved: true},
-	"INTERSECTION":                     {Keyword: "INTERSECTION", Reserved: false},
-	"INTERVAL":                         {Keyword: "INTERVAL", Reserved: false},
-	"INTO":                             {Keyword: "INTO", Reserved: true},
-	"INVOKER":                          {Keyword: "INVOKER", Reserved: false},
-	"IS":                               {Keyword: "IS", Reserved: true},
-	"ISNULL":                           {Keyword: "ISNULL", Reserved: false},
-	"ISOLATION":                        {Keyword: "ISOLATION", Reserved: false},
-	"JOIN":                             {Keyword: "JOIN", Reserved: true},
-	"JSON":                             {Keyword: "JSON", Reserved: false},
-	"JSON_ARRAY":                       {Keyword: "JSON_ARRAY", Reserved: false},
-	"JSON_ARRAYAGG":                    {Keyword: "JSON_ARRAYAGG", Reserved: false},
-	"JSON_EXISTS":                      {Keyword: "JSON_EXISTS", Reserved: false},
-	"JSON_OBJECT":                      {Keyword: "JSON_OBJECT", Reserved: false},
-	"JSON_OBJECTAGG":                   {Keyword: "JSON_OBJECTAGG", Reserved: false},
-	"JSON_QUERY":                       {Keyword: "JSON_QUERY", Reserved: false},
-	"JSON_SCALAR":                      {Keyword: "JSON_SCALAR", Reserved: false},
-	"JSON_SERIALIZE":                   {Keyword: "JSON_SERIALIZE", Reserved: false},
-	"JSON_TABLE":                       {Keyword: "JSON_TABLE", Reserved: false},
-	"JSON_TABLE_PRIMITIVE":             {Keyword: "JSON_TABLE_PRIMITIVE", Reserved: false},
-	"JSON_VALUE":                       {Keyword: "JSON_VALUE", Reserved: false},
-	"K":                                {Keyword: "K", Reserved: false},
-	"KEEP":                             {Keyword: "KEEP", Reserved: false},
-	"KEY":                              {Keyword: "KEY", Reserved: false},
-	"KEYS":                             {Keyword: "KEYS", Reserved: false},
-	"KEY_MEMBER":                       {Keyword: "KEY_MEMBER", Reserved: false},
-	"KEY_TYPE":                         {Keyword: "KEY_TYPE", Reserved: false},
-	"LABEL":                            {Keyword: "LABEL", Reserved: false},
-	"LAG":                              {Keyword: "LAG", Reserved: false},
-	"LANGUAGE":                         {Keyword: "LANGUAGE", Reserved: false},
-	"LARGE":                            {Keyword: "LARGE", Reserved: false},
-	"LAST":                             {Keyword: "LAST", Reserved: false},
-	"LAST_VALUE":                       {Keyword: "LAST_VALUE", Reserved: false},
-	"LATERAL":                          {Keyword: "LATERAL", Reserved: true},
-	"LEAD":                             {Keyword: "LEAD", Reserved: false},
-	"LEADING":                          {Keyword: "LEADING", Reserved: true},
-	"LEAKPROOF":                        {Keyword: "LEAKPROOF", Reserved: false},
-	"LEAST":                            {Keyword: "LEAST", Reserved: false},
-	"LEFT":                             {Keyword: "LEFT", Reserved: true},
-	"LENGTH":                           {Keyword: "LENGTH", Reserved: false},
-	"LEVEL":                            {Keyword: "LEVEL", Reserved: false},
-	"LIBRARY":                          {Keyword: "LIBRARY", Reserved: false},
-	"LIKE":                             {Keyword: "LIKE", Reserved: true},
-	"LIKE_REGEX":                       {Keyword: "LIKE_REGEX", Reserved: false},
-	"LIMIT":                            {Keyword: "LIMIT", Reserved: true},
-	"LINK":                             {Keyword: "LINK", Reserved: false},
-	"LISTAGG":                          {Keyword: "LISTAGG", Reserved: false},
-	"LISTEN":                           {Keyword: "LISTEN", Reserved: false},
-	"LN":                               {Keyword: "LN", Reserved: false},
-	"LOAD":                             {Keyword: "LOAD", Reserved: false},
-	"LOCAL":                            {Keyword: "LOCAL", Reserved: false},
-	"LOCALTIME":                        {Keyword: "LOCALTIME", Reserved: true},
-	"LOCALTIMESTAMP":                   {Keyword: "LOCALTIMESTAMP", Reserved: true},
-	"LOCATION":                         {Keyword: "LOCATION", Reserved: false},
-	"LOCATOR":                          {Keyword: "LOCATOR", Reserved: false},
-	"LOCK":                             {Keyword: "LOCK", Reserved: false},
-	"LOCKED":                           {Keyword: "LOCKED", Reserved: false},
-	"LOG":                              {Keyword: "LOG", Reserved: false},
-	"LOG10":                            {Keyword: "LOG10", Reserved: false},
-	"LOGGED":                           {Keyword: "LOGGED", Reserved: false},
-	"LOWER":                            {Keyword: "LOWER", Reserved: false},
-	"LPAD":                             {Keyword: "LPAD", Reserved: false},
-	"LTRIM":                            {Keyword: "LTRIM", Reserved: false},
-	"M":                                {Keyword: "M", Reserved: false},
-	"MAP":                              {Keyword: "MAP", Reserved: false},
-	"MAPPING":                          {Keyword: "MAPPING", Reserved: false},
-	"MATCH":                            {Keyword: "MATCH", Reserved: false},
-	"MATCHED":                          {Keyword: "MATCHED", Reserved: false},
-	"MATCHES":                          {Keyword: "MATCHES", Reserved: false},
-	"MATCH_NUMBER":                     {Keyword: "MATCH_NUMBER", Reserved: false},
-	"MATCH_RECOGNIZE":                  {Keyword: "MATCH_RECOGNIZE", Reserved: false},
-	"MATERIALIZED":                     {Keyword: "MATERIALIZED", Reserved: false},
-	"MAX":                              {Keyword: "MAX", Reserved: false},
-	"MAXVALUE":                         {Keyword: "MAXVALUE", Reserved: false},
-	"MEASURES":                         {Keyword: "MEASURES", Reserved: false},
-	"MEMBER":                           {Keyword: "MEMBER", Reserved: false},
-	"MERGE":                            {Keyword: "MERGE", Reserved: false},
-	"MERGE_ACTION":                     {Keyword: "MERGE_ACTION", Reserved: false},
-	"MESSAGE_LENGTH":                   {Keyword: "MESSAGE_LENGTH", Reserved: false},
-	"MESSAGE_OCTET_LENGTH":             {Keyword: "MESSAGE_OCTET_LENGTH", Reserved: false},
-	"MESSAGE_TEXT":                     {Keyword: "MESSAGE_TEXT", Reserved: false},
-	"METHOD":                           {Keyword: "METHOD", Reserved: false},
-	"MIN":                              {Keyword: "MIN", Reserved: false},
-	"MINUTE":                           {Keyword: "MINUTE", Reserved: false},
-	"MINVALUE":                         {Keyword: "MINVALUE", Reserved: false},
-	"MOD":                              {Keyword: "MOD", Reserved: false},
-	"MODE":                             {Keyword: "MODE", Reserved: false},
-	"MODIFIES":                         {Keyword: "MODIFIES", Reserved: false},
-	"MODULE":                           {Keyword: "MODULE", Reserved: false},
-	"MONTH":                            {Keyword: "MONTH", Reserved: false},
-	"MORE":                             {Keyword: "MORE", Reserved: false},
-	"MOVE":                             {Keyword: "MOVE", Reserved: false},
-	"MULTISET":                         {Keyword: "MULTISET", Reserved: false},
-	"MUMPS":                            {Keyword: "MUMPS", Reserved: false},
-	"NAME":                             {Keyword: "NAME", Reserved: false},
-	"NAMES":                            {Keyword: "NAMES", Reserved: false},
-	"NAMESPACE":                        {Keyword: "NAMESPACE", Reserved: false},
-	"NATIONAL":                         {Keyword: "NATIONAL", Reserved: false},
-	"NATURAL":                          {Keyword: "NATURAL", Reserved: true},
-	"NCHAR":                            {Keyword: "NCHAR", Reserved: false},
-	"NCLOB":                            {Keyword: "NCLOB", Reserved: false},
-	"NESTED":                           {Keyword: "NESTED", Reserved: false},
-	"NESTING":                          {Keyword: "NESTING", Reserved: false},
-	"NEW":                              {Keyword: "NEW", Reserved: false},
-	"NEXT":                             {Keyword: "NEXT", Reserved: false},
-	"NFC":                              {Keyword: "NFC", Reserved: false},
-	"NFD":                              {Keyword: "NFD", Reserved: false},
-	"NFKC":                             {Keyword: "NFKC", Reserved: false},
-	"NFKD":                             {Keyword: "NFKD", Reserved: false},
-	"NIL":                              {Keyword: "NIL", Reserved: false},
-	"NO":                               {Keyword: "NO", Reserved: false},
-	"NONE":                             {Keyword: "NONE", Reserved: false},
-	"NORMALIZE":                        {Keyword: "NORMALIZE", Reserved: false},
-	"NORMALIZED":                       {Keyword: "NORMALIZED", Reserved: false},
-	"NOT":                              {Keyword: "NOT", Reserved: true},
-	"NOTHING":                          {Keyword: "NOTHING", Reserved: false},
-	"NOTIFY":                           {Keyword: "NOTIFY", Reserved: false},
-	"NOTNULL":                          {Keyword: "NOTNULL", Reserved: false},
-	"NOWAIT":                           {Keyword: "NOWAIT", Reserved: false},
-	"NTH_VALUE":                        {Keyword: "NTH_VALUE", Reserved: false},
-	"NTILE":                            {Keyword: "NTILE", Reserved: false},
-	"NULL":                             {Keyword: "NULL", Reserved: true},
-	"NULLABLE":                         {Keyword: "NULLABLE", Reserved: false},
-	"NULLIF":                           {Keyword: "NULLIF", Reserved: false},
-	"NULLS":                            {Keyword: "NULLS", Reserved: false},
-	"NULL_ORDERING":                    {Keyword: "NULL_ORDERING", Reserved: false},
-	"NUMBER":                           {Keyword: "NUMBER", Reserved: false},
-	"NUMERIC":                          {Keyword: "NUMERIC", Reserved: false},
-	"OBJECT":                           {Keyword: "OBJECT", Reserved: false},
-	"OCCURRENCE":                       {Keyword: "OCCURRENCE", Reserved: false},
-	"OCCURRENCES_REGEX":                {Keyword: "OCCURRENCES_REGEX", Reserved: false},
-	"OCTETS":                           {Keyword: "OCTETS", Reserved: false},
-	"OCTET_LENGTH":                     {Keyword: "OCTET_LENGTH", Reserved: false},
-	"OF":                               {Keyword: "OF", Reserved: false},
-	"OFF":                              {Keyword: "OFF", Reserved: false},
-	"OFFSET":                           {Keyword: "OFFSET", Reserved: true},
-	"OIDS":                             {Keyword: "OIDS", Reserved: false},
-	"OLD":                              {Keyword: "OLD", Reserved: false},
-	"OMIT":                             {Keyword: "OMIT", Reserved: false},
-	"ON":                               {Keyword: "ON", Reserved: true},
-	"ONE":                              {Keyword: "ONE", Reserved: false},
-	"ONLY":                             {Keyword: "ONLY", Reserved: true},
-	"OPEN":                             {Keyword: "OPEN", Reserved: false},
-	"OPERATOR":                         {Keyword: "OPERATOR", Reserved: false},
-	"OPTION":                           {Keyword: "OPTION", Reserved: false},
-	"OPTIONS":                          {Keyword: "OPTIONS", Reserved: false},
-	"OR":                               {Keyword: "OR", Reserved: true},
-	"ORDER":                            {Keyword: "ORDER", Reserved: true},
-	"ORDERING":                         {Keyword: "ORDERING", Reserved: false},
-	"ORDINALITY":                       {Keyword: "ORDINALITY", Reserved: false},
-	"OTHERS":                           {Keyword: "OTHERS", Reserved: false},
-	"OUT":                              {Keyword: "OUT", Reserved: false},
-	"OUTER":                            {Keyword: "OUTER", Reserved: true},
-	"OUTPUT":                           {Keyword: "OUTPUT", Reserved: false},
-	"OVER":                             {Keyword: "OVER", Reserved: false},
-	"OVERFLOW":                         {Keyword: "OVERFLOW", Reserved: false},
-	"OVERLAPS":                         {Keyword: "OVERLAPS", Reserved: false},
-	"OVERLAY":                          {Keyword: "OVERLAY", Reserved: false},
-	"OVERRIDING":                       {Keyword: "OVERRIDING", Reserved: false},
-	"OWNED":                            {Keyword: "OWNED", Reserved: false},
-	"OWNER":                            {Keyword: "OWNER", Reserved: false},
-	"P":                                {Keyword: "P", Reserved: false},
-	"PAD":                              {Keyword: "PAD", Reserved: false},
-	"PARALLEL":                         {Keyword: "PARALLEL", Reserved: false},
-	"PARAMETER":                        {Keyword: "PARAMETER", Reserved: false},
-	"PARAMETER_MODE":                   {Keyword: "PARAMETER_MODE", Reserved: false},
-	"PARAMETER_NAME":                   {Keyword: "PARAMETER_NAME", Reserved: false},
-	"PARAMETER_ORDINAL_POSITION":       {Keyword: "PARAMETER_ORDINAL_POSITION", Reserved: false},
-	"PARAMETER_SPECIFIC_CATALOG":       {Keyword: "PARAMETER_SPECIFIC_CATALOG", Reserved: false},
-	"PARAMETER_SPECIFIC_NAME":          {Keyword: "PARAMETER_SPECIFIC_NAME", Reserved: false},
-	"PARAMETER_SPECIFIC_SCHEMA":        {Keyword: "PARAMETER_SPECIFIC_SCHEMA", Reserved: false},
-	"PARSER":                           {Keyword: "PARSER", Reserved: false},
-	"PARTIAL":                          {Keyword: "PARTIAL", Reserved: false},
-	"PARTITION":                        {Keyword: "PARTITION", Reserved: false},
-	"PASCAL":                           {Keyword: "PASCAL", Reserved: false},
-	"PASS":                             {Keyword: "PASS", Reserved: false},
-	"PASSING":                          {Keyword: "PASSING", Reserved: false},
-	"PASSTHROUGH":                      {Keyword: "PASSTHROUGH", Reserved: false},
-	"PASSWORD":                         {Keyword: "PASSWORD", Reserved: false},
-	"PAST":                             {Keyword: "PAST", Reserved: false},
-	"PATH":                             {Keyword: "PATH", Reserved: false},
-	"PATTERN":                          {Keyword: "PATTERN", Reserved: false},
-	"PER":                              {Keyword: "PER", Reserved: false},
-	"PERCENT":                          {Keyword: "PERCENT", Reserved: false},
-	"PERCENTILE_CONT":                  {Keyword: "PERCENTILE_CONT", Reserved: false},
-	"PERCENTILE_DISC":                  {Keyword: "PERCENTILE_DISC", Reserved: false},
-	"PERCENT_RANK":                     {Keyword: "PERCENT_RANK", Reserved: false},
-	"PERIOD":                           {Keyword: "PERIOD", Reserved: false},
-	"PERMISSION":                       {Keyword: "PERMISSION", Reserved: false},
-	"PERMUTE":                          {Keyword: "PERMUTE", Reserved: false},
-	"PIPE":                             {Keyword: "PIPE", Reserved: false},
-	"PLACING":                          {Keyword: "PLACING", Reserved: true},
-	"PLAN":                             {Keyword: "PLAN", Reserved: false},
-	"PLANS":                            {Keyword: "PLANS", Reserved: false},
-	"PLI":                              {Keyword: "PLI", Reserved: false},
-	"POLICY":                           {Keyword: "POLICY", Reserved: false},
-	"PORTION":                          {Keyword: "PORTION", Reserved: false},
-	"POSITION":                         {Keyword: "POSITION", Reserved: false},
-	"POSITION_REGEX":                   {Keyword: "POSITION_REGEX", Reserved: false},
-	"POWER":                            {Keyword: "POWER", Reserved: false},
-	"PRECEDES":                         {Keyword: "PRECEDES", Reserved: false},
-	"PRECEDING":                        {Keyword: "PRECEDING", Reserved: false},
-	"PRECISION":                        {Keyword: "PRECISION", Reserved: false},
-	"PREPARE":                          {Keyword: "PREPARE", Reserved: false},
-	"PREPARED":                         {Keyword: "PREPARED", Reserved: false},
-	"PRESERVE":                         {Keyword: "PRESERVE", Reserved: false},
-	"PREV":                             {Keyword: "PREV", Reserved: false},
-	"PRIMARY":                          {Keyword: "PRIMARY", Reserved: true},
-	"PRIOR":                            {Keyword: "PRIOR", Reserved: false},
-	"PRIVATE":                          {Keyword: "PRIVATE", Reserved: false},
-	"PRIVILEGES":                       {Keyword: "PRIVILEGES", Reserved: false},
-	"PROCEDURAL":                       {Keyword: "PROCEDURAL", Reserved: false},
-	"PROCEDURE":                        {Keyword: "PROCEDURE", Reserved: false},
-	"PROCEDURES":                       {Keyword: "PROCEDURES", Reserved: false},
-	"PROGRAM":                          {Keyword: "PROGRAM", Reserved: false},
-	"PRUNE":                            {Keyword: "PRUNE", Reserved: false},
-	"PTF":                              {Keyword: "PTF", Reserved: false},
-	"PUBLIC":                           {Keyword: "PUBLIC", Reserved: false},
-	"PUBLICATION":                      {Keyword: "PUBLICATION", Reserved: false},
-	"QUOTE":                            {Keyword: "QUOTE", Reserved: false},
-	"QUOTES":                           {Keyword: "QUOTES", Reserved: false},
-	"RANGE":                            {Keyword: "RANGE", Reserved: false},
-	"RANK":                             {Keyword: "RANK", Reserved: false},
-	"READ":                             {Keyword: "READ", Reserved: false},
-	"READS":                            {Keyword: "READS", Reserved: false},
-	"REAL":                             {Keyword: "REAL", Reserved: false},
-	"REASSIGN":                         {Keyword: "REASSIGN", Reserved: false},
-	"RECHECK":                          {Keyword: "RECHECK", Reserved: false},
-	"RECOVERY":                         {Keyword: "RECOVERY", Reserved: false},
-	"RECURSIVE":                        {Keyword: "RECURSIVE", Reserved: false},
-	"REF":                              {Keyword: "REF", Reserved: false},
-	"REFERENCES":                       {Keyword: "REFERENCES", Reserved: true},
-	"REFERENCING":                      {Keyword: "REFERENCING", Reserved: false},
-	"REFRESH":                          {Keyword: "REFRESH", Reserved: false},
-	"REGR_AVGX":                        {Keyword: "REGR_AVGX", Reserved: false},
-	"REGR_AVGY":                        {Keyword: "REGR_AVGY", Reserved: false},
-	"REGR_COUNT":                       {Keyword: "REGR_COUNT", Reserved: false},
-	"REGR_INTERCEPT":                   {Keyword: "REGR_INTERCEPT", Reserved: false},
-	"REGR_R2":                          {Keyword: "REGR_R2", Reserved: false},
-	"REGR_SLOPE":                       {Keyword: "REGR_SLOPE", Reserved: false},
-	"REGR_SXX":                         {Keyword: "REGR_SXX", Reserved: false},
-	"REGR_SXY":                         {Keyword: "REGR_SXY", Reserved: false},
-	"REGR_SYY":                         {Keyword: "REGR_SYY", Reserved: false},
-	"REINDEX":                          {Keyword: "REINDEX", Reserved: false},
-	"RELATIVE":                         {Keyword: "RELATIVE", Reserved: false},
-	"RELEASE":                          {Keyword: "RELEASE", Reserved: false},
-	"RENAME":                           {Keyword: "RENAME", Reserved: false},
-	"REPEATABLE":                       {Keyword: "REPEATABLE", Reserved: false},
-	"REPLACE":                          {Keyword: "REPLACE", Reserved: false},
-	"REPLICA":                          {Keyword: "REPLICA", Reserved: false},
-	"REQUIRING":                        {Keyword: "REQUIRING", Reserved: false},
-	"RESET":                            {Keyword: "RESET", Reserved: false},
-	"RESPECT":                          {Keyword: "RESPECT", Reserved: false},
-	"RESTART":                          {Keyword: "RESTART", Reserved: false},
-	"RESTORE":                          {Keyword: "RESTORE", Reserved: false},
-	"RESTRICT":                         {Keyword: "RESTRICT", Reserved: false},
-	"RESULT":                           {Keyword: "RESULT", Reserved: false},
-	"RETURN":                           {Keyword: "RETURN", Reserved: false},
-	"RETURNED_CARDINALITY":             {Keyword: "RETURNED_CARDINALITY", Reserved: false},
-	"RETURNED_LENGTH":                  {Keyword: "RETURNED_LENGTH", Reserved: false},
-	"RETURNED_OCTET_LENGTH":            {Keyword: "RETURNED_OCTET_LENGTH", Reserved: false},
-	"RETURNED_SQLSTATE":                {Keyword: "RETURNED_SQLSTATE", Reserved: false},
-	"RETURNING":                        {Keyword: "RETURNING", Reserved: true},
-	"RETURNS":                          {Keyword: "RETURNS", Reserved: false},
-	"REVOKE":                           {Keyword: "REVOKE", Reserved: false},
-	"RIGHT":                            {Keyword: "RIGHT", Reserved: true},
-	"ROLE":                             {Keyword: "ROLE", Reserved: false},
-	"ROLLBACK":                         {Keyword: "ROLLBACK", Reserved: false},
-	"ROLLUP":                           {Keyword: "ROLLUP", Reserved: false},
-	"ROUTINE":                          {Keyword: "ROUTINE", Reserved: false},
-	"ROUTINES":                         {Keyword: "ROUTINES", Reserved: false},
-	"ROUTINE_CATALOG":                  {Keyword: "ROUTINE_CATALOG", Reserved: false},
-	"ROUTINE_NAME":                     {Keyword: "ROUTINE_NAME", Reserved: false},
-	"ROUTINE_SCHEMA":                   {Keyword: "ROUTINE_SCHEMA", Reserved: false},
-	"ROW":                              {Keyword: "ROW", Reserved: false},
-	"ROWS":                             {Keyword: "ROWS", Reserved: false},
-	"ROW_COUNT":                        {Keyword: "ROW_COUNT", Reserved: false},
-	"ROW_NUMBER":                       {Keyword: "ROW_NUMBER", Reserved: false},
-	"RPAD":                             {Keyword: "RPAD", Reserved: false},
-	"RTRIM":                            {Keyword: "RTRIM", Reserved: false},
-	"RULE":                             {Keyword: "RULE", Reserved: false},
-	"RUNNING":                          {Keyword: "RUNNING", Reserved: false},
-	"SAVEPOINT":                        {Keyword: "SAVEPOINT", Reserved: false},
-	"SCALAR":                           {Keyword: "SCALAR", Reserved: false},
-	"SCALE":                            {Keyword: "SCALE", Reserved: false},
-	"SCHEMA":                           {Keyword: "SCHEMA", Reserved: false},
-	"SCHEMAS":                          {Keyword: "SCHEMAS", Reserved: false},
-	"SCHEMA_NAME":                      {Keyword: "SCHEMA_NAME", Reserved: false},
-	"SCOPE":                            {Keyword: "SCOPE", Reserved: false},
-	"SCOPE_CATALOG":                    {Keyword: "SCOPE_CATALOG", Reserved: false},
-	"SCOPE_NAME":                       {Keyword: "SCOPE_NAME", Reserved: false},
-	"SCOPE_SCHEMA":                     {Keyword: "SCOPE_SCHEMA", Reserved: false},
-	"SCROLL":                           {Keyword: "SCROLL", Reserved: false},
-	"SEARCH":                           {Keyword: "SEARCH", Reserved: false},
-	"SECOND":                           {Keyword: "SECOND", Reserved: false},
-	"SECTION":                          {Keyword: "SECTION", Reserved: false},
-	"SECURITY":                         {Keyword: "SECURITY", Reserved: false},
-	"SEEK":                             {Keyword: "SEEK", Reserved: false},
-	"SELECT":                           {Keyword: "SELECT", Reserved: true},
-	"SELECTIVE":                        {Keyword: "SELECTIVE", Reserved: false},
-	"SELF":                             {Keyword: "SELF", Reserved: false},
-	"SEMANTICS":                        {Keyword: "SEMANTICS", Reserved: false},
-	"SENSITIVE":                        {Keyword: "SENSITIVE", Reserved: false},
-	"SEQUENCE":                         {Keyword: "SEQUENCE", Reserved: false},
-	"SEQUENCES":                        {Keyword: "SEQUENCES", Reserved: false},
-	"SERIALIZABLE":                     {Keyword: "SERIALIZABLE", Reserved: false},
-	"SERVER":                           {Keyword: "SERVER", Reserved: false},
-	"SERVER_NAME":                      {Keyword: "SERVER_NAME", Reserved: false},
-	"SESSION":                          {Keyword: "SESSION", Reserved: false},
-	"SESSION_USER":                     {Keyword: "SESSION_USER", Reserved: true},
-	"SET":                              {Keyword: "SET", Reserved: false},
-	"SETOF":                            {Keyword: "SETOF", Reserved: false},
-	"SETS":                             {Keyword: "SETS", Reserved: false},
-	"SHARE":                            {Keyword: "SHARE", Reserved: false},
-	"SHOW":                             {Keyword: "SHOW", Reserved: false},
-	"SIMILAR":                          {Keyword: "SIMILAR", Reserved: true},
-	"SIMPLE":                           {Keyword: "SIMPLE", Reserved: false},
-	"SIN":                              {Keyword: "SIN", Reserved: false},
-	"SINH":                             {Keyword: "SINH", Reserved: false},
-	"SIZE":                             {Keyword: "SIZE", Reserved: false},
-	"SKIP":                             {Keyword: "SKIP", Reserved: false},
-	"SMALLINT":                         {Keyword: "SMALLINT", Reserved: false},
-	"SNAPSHOT":                         {Keyword: "SNAPSHOT", Reserved: false},
-	"SOME":                             {Keyword: "SOME", Reserved: true},
-	"SORT_DIRECTION":                   {Keyword: "SORT_DIRECTION", Reserved: false},
-	"SOURCE":                           {Keyword: "SOURCE", Reserved: false},
-	"SPACE":                            {Keyword: "SPACE", Reserved: false},
-	"SPECIFIC":                         {Keyword: "SPECIFIC", Reserved: false},
-	"SPECIFICTYPE":                     {Keyword: "SPECIFICTYPE", Reserved: false},
-	"SPECIFIC_NAME":                    {Keyword: "SPECIFIC_NAME", Reserved: false},
-	"SQL":                              {Keyword: "SQL", Reserved: false},
-	"SQLCODE":                          {Keyword: "SQLCODE", Reserved: false},
-	"SQLERROR":                         {Keyword: "SQLERROR", Reserved: false},
-	"SQLEXCEPTION":                     {Keyword: "SQLEXCEPTION", Reserved: false},
-	"SQLSTATE":                         {Keyword: "SQLSTATE", Reserved: false},
-	"SQLWARNING":                       {Keyword: "SQLWARNING", Reserved: false},
-	"SQRT":                             {Keyword: "SQRT", Reserved: false},
-	"STABLE":                           {Keyword: "STABLE", Reserved: false},
-	"STANDALONE":                       {Keyword: "STANDALONE", Reserved: false},
-	"START":                            {Keyword: "START", Reserved: false},
-	"STATE":                            {Keyword: "STATE", Reserved: false},
-	"STATEMENT":                        {Keyword: "STATEMENT", Reserved: false},
-	"STATIC":                           {Keyword: "STATIC", Reserved: false},
-	"STATISTICS":                       {Keyword: "STATISTICS", Reserved: false},
-	"STDDEV_POP":                       {Keyword: "STDDEV_POP", Reserved: false},
-	"STDDEV_SAMP":                      {Keyword: "STDDEV_SAMP", Reserved: false},
-	"STDIN":                            {Keyword: "STDIN", Reserved: false},
-	"STDOUT":                           {Keyword: "STDOUT", Reserved: false},
-	"STORAGE":                          {Keyword: "STORAGE", Reserved: false},
-	"STORED":                           {Keyword: "STORED", Reserved: false},
-	"STRICT":                           {Keyword: "STRICT", Reserved: false},
-	"STRING":                           {Keyword: "STRING", Reserved: false},
-	"STRIP":                            {Keyword: "STRIP", Reserved: false},
-	"STRUCTURE":                        {Keyword: "STRUCTURE", Reserved: false},
-	"STYLE":                            {Keyword: "STYLE", Reserved: false},
-	"SUBCLASS_ORIGIN":                  {Keyword: "SUBCLASS_ORIGIN", Reserved: false},
-	"SUBMULTISET":                      {Keyword: "SUBMULTISET", Reserved: false},
-	"SUBSCRIPTION":                     {Keyword: "SUBSCRIPTION", Reserved: false},
-	"SUBSET":                           {Keyword: "SUBSET", Reserved: false},
-	"SUBSTRING":                        {Keyword: "SUBSTRING", Reserved: false},
-	"SUBSTRING_REGEX":                  {Keyword: "SUBSTRING_REGEX", Reserved: false},
-	"SUCCEEDS":                         {Keyword: "SUCCEEDS", Reserved: false},
-	"SUM":                              {Keyword: "SUM", Reserved: false},
-	"SUPPORT":                          {Keyword: "SUPPORT", Reserved: false},
-	"SYMMETRIC":                        {Keyword: "SYMMETRIC", Reserved: true},
-	"SYSID":                            {Keyword: "SYSID", Reserved: false},
-	"SYSTEM":                           {Keyword: "SYSTEM", Reserved: false},
-	"SYSTEM_TIME":                      {Keyword: "SYSTEM_TIME", Reserved: false},
-	"SYSTEM_USER":                      {Keyword: "SYSTEM_USER", Reserved: true},
-	"T":                                {Keyword: "T", Reserved: false},
-	"TABLE":                            {Keyword: "TABLE", Reserved: true},
-	"TABLES":                           {Keyword: "TABLES", Reserved: false},
-	"TABLESAMPLE":                      {Keyword: "TABLESAMPLE", Reserved: true},
-	"TABLESPACE":                       {Keyword: "TABLESPACE", Reserved: false},
-	"TABLE_NAME":                       {Keyword: "TABLE_NAME", Reserved: false},
-	"TAN":                              {Keyword: "TAN", Reserved: false},
-	"TANH":                             {Keyword: "TANH", Reserved: false},
-	"TARGET":                           {Keyword: "TARGET", Reserved: false},
-	"TEMP":                             {Keyword: "TEMP", Reserved: false},
-	"TEMPLATE":                         {Keyword: "TEMPLATE", Reserved: false},
-	"TEMPORARY":                        {Keyword: "TEMPORARY", Reserved: false},
-	"TEXT":                             {Keyword: "TEXT", Reserved: false},
-	"THEN":                             {Keyword: "THEN", Reserved: true},
-	"THROUGH":                          {Keyword: "THROUGH", Reserved: false},
-	"TIES":                             {Keyword: "TIES", Reserved: false},
-	"TIME":                             {Keyword: "TIME", Reserved: false},
-	"TIMESTAMP":                        {Keyword: "TIMESTAMP", Reserved: false},
-	"TIMEZONE_HOUR":                    {Keyword: "TIMEZONE_HOUR", Reserved: false},
-	"TIMEZONE_MINUTE":                  {Keyword: "TIMEZONE_MINUTE", Reserved: false},
-	"TO":                               {Keyword: "TO", Reserved: true},
-	"TOKEN":                            {Keyword: "TOKEN", Reserved: false},
-	"TOP_LEVEL_COUNT":                  {Keyword: "TOP_LEVEL_COUNT", Reserved: false},
-	"TRAILING":                         {Keyword: "TRAILING", Reserved: true},
-	"TRANSACTION":                      {Keyword: "TRANSACTION", Reserved: false},
-	"TRANSACTIONS_COMMITTED":           {Keyword: "TRANSACTIONS_COMMITTED", Reserved: false},
-	"TRANSACTIONS_ROLLED_BACK":         {Keyword: "TRANSACTIONS_ROLLED_BACK", Reserved: false},
-	"TRANSACTION_ACTIVE":               {Keyword: "TRANSACTION_ACTIVE", Reserved: false},
-	"TRANSFORM":                        {Keyword: "TRANSFORM", Reserved: false},
-	"TRANSFORMS":                       {Keyword: "TRANSFORMS", Reserved: false},
-	"TRANSLATE":                        {Keyword: "TRANSLATE", Reserved: false},
-	"TRANSLATE_REGEX":                  {Keyword: "TRANSLATE_REGEX", Reserved: false},
-	"TRANSLATION":                      {Keyword: "TRANSLATION", Reserved: false},
-	"TREAT":                            {Keyword: "TREAT", Reserved: false},
-	"TRIGGER":                          {Keyword: "TRIGGER", Reserved: false},
-	"TRIGGER_CATALOG":                  {Keyword: "TRIGGER_CATALOG", Reserved: false},
-	"TRIGGER_NAME":                     {Keyword: "TRIGGER_NAME", Reserved: false},
-	"TRIGGER_SCHEMA":                   {Keyword: "TRIGGER_SCHEMA", Reserved: false},
-	"TRIM":                             {Keyword: "TRIM", Reserved: false},
-	"TRIM_ARRAY":                       {Keyword: "TRIM_ARRAY", Reserved: false},
-	"TRUE":                             {Keyword: "TRUE", Reserved: true},
-	"TRUNCATE":                         {Keyword: "TRUNCATE", Reserved: false},
-	"TRUSTED":                          {Keyword: "TRUSTED", Reserved: false},
-	"TYPE":                             {Keyword: "TYPE", Reserved: false},
-	"TYPES":                            {Keyword: "TYPES", Reserved: false},
-	"UESCAPE":                          {Keyword: "UESCAPE", Reserved: false},
-	"UNBOUNDED":                        {Keyword: "UNBOUNDED", Reserved: false},
-	"UNCOMMITTED":                      {Keyword: "UNCOMMITTED", Reserved: false},
-	"UNCONDITIONAL":                    {Keyword: "UNCONDITIONAL", Reserved: false},
-	"UNDER":                            {Keyword: "UNDER", Reserved: false},
-	"UNENCRYPTED":                      {Keyword: "UNENCRYPTED", Reserved: false},
-	"UNION":                            {Keyword: "UNION", Reserved: true},
-	"UNIQUE":                           {Keyword: "UNIQUE", Reserved: true},
-	"UNKNOWN":                          {Keyword: "UNKNOWN", Reserved: false},
-	"UNLINK":                           {Keyword: "UNLINK", Reserved: false},
-	"UNLISTEN":                         {Keyword: "UNLISTEN", Reserved: false},
-	"UNLOGGED":                         {Keyword: "UNLOGGED", Reserved: false},
-	"UNMATCHED":                        {Keyword: "UNMATCHED", Reserved: false},
-	"UNNAMED":                          {Keyword: "UNNAMED", Reserved: false},
-	"UNNEST":                           {Keyword: "UNNEST", Reserved: false},
-	"UNTIL":                            {Keyword: "UNTIL", Reserved: false},
-	"UNTYPED":                          {Keyword: "UNTYPED", Reserved: false},
-	"UPDATE":                           {Keyword: "UPDATE", Reserved: false},
-	"UPPER":                            {Keyword: "UPPER", Reserved: false},
-	"URI":                              {Keyword: "URI", Reserved: false},
-	"USAGE":                            {Keyword: "USAGE", Reserved: false},
-	"USER":                             {Keyword: "USER", Reserved: true},
-	"USER_DEFINED_TYPE_CATALOG":        {Keyword: "USER_DEFINED_TYPE_CATALOG", Reserved: false},
-	"USER_DEFINED_TYPE_CODE":           {Keyword: "USER_DEFINED_TYPE_CODE", Reserved: false},
-	"USER_DEFINED_TYPE_NAME":           {Keyword: "USER_DEFINED_TYPE_NAME", Reserved: false},
-	"USER_DEFINED_TYPE_SCHEMA":         {Keyword: "USER_DEFINED_TYPE_SCHEMA", Reserved: false},
-	"USING":                            {Keyword: "USING", Reserved: true},
-	"UTF16":                            {Keyword: "UTF16", Reserved: false},
-	"UTF32":                            {Keyword: "UTF32", Reserved: false},
-	"UTF8":                             {Keyword: "UTF8", Reserved: false},
-	"VACUUM":                           {Keyword: "VACUUM", Reserved: false},
-	"VALID":                            {Keyword: "VALID", Reserved: false},
-	"VALIDATE":                         {Keyword: "VALIDATE", Reserved: false},
-	"VALIDATOR":                        {Keyword: "VALIDATOR", Reserved: false},
-	"VALUE":                            {Keyword: "VALUE", Reserved: false},
-	"VALUES":                           {Keyword: "VALUES", Reserved: false},
-	"VALUE_OF":                         {Keyword: "VALUE_OF", Reserved: false},
-	"VARBINARY":                        {Keyword: "VARBINARY", Reserved: false},
-	"VARCHAR":                          {Keyword: "VARCHAR", Reserved: false},
-	"VARIADIC":                         {Keyword: "VARIADIC", Reserved: true},
-	"VARYING":                          {Keyword: "VARYING", Reserved: false},
-	"VAR_POP":                          {Keyword: "VAR_POP", Reserved: false},
-	"VAR_SAMP":                         {Keyword: "VAR_SAMP", Reserved: false},
-	"VERBOSE":                          {Keyword: "VERBOSE", Reserved: true},
-	"VERSION":                          {Keyword: "VERSION", Reserved: false},
-	"VERSIONING":                       {Keyword: "VERSIONING", Reserved: false},
-	"VIEW":                             {Keyword: "VIEW", Reserved: false},
-	"VIEWS":                            {Keyword: "VIEWS", Reserved: false},
-	"VOLATILE":                         {Keyword: "VOLATILE", Reserved: false},
-	"WHEN":                             {Keyword: "WHEN", Reserved: true},
-	"WHENEVER":                         {Keyword: "WHENEVER", Reserved: false},
-	"WHERE":                            {Keyword: "WHERE", Reserved: true},
-	"WHITESPACE":                       {Keyword: "WHITESPACE", Reserved: false},
-	"WIDTH_BUCKET":                     {Keyword: "WIDTH_BUCKET", Reserved: false},
-	"WINDOW":                           {Keyword: "WINDOW", Reserved: true},
-	"WITH":                             {Keyword: "WITH", Reserved: true},
-	"WITHIN":                           {Keyword: "WITHIN", Reserved: false},
-	"WITHOUT":                          {Keyword: "WITHOUT", Reserved: false},
-	"WORK":                             {Keyword: "WORK", Reserved: false},
-	"WRAPPER":                          {Keyword: "WRAPPER", Reserved: false},
-	"WRITE":                            {Keyword: "WRITE", Reserved: false},
-	"XML":                              {Keyword: "XML", Reserved: false},
-	"XMLAGG":                           {Keyword: "XMLAGG", Reserved: false},
-	"XMLATTRIBUTES":                    {Keyword: "XMLATTRIBUTES", Reserved: false},
-	"XMLBINARY":                        {Keyword: "XMLBINARY", Reserved: false},
-	"XMLCAST":                          {Keyword: "XMLCAST", Reserved: false},
-	"XMLCOMMENT":                       {Keyword: "XMLCOMMENT", Reserved: false},
-	"XMLCONCAT":                        {Keyword: "XMLCONCAT", Reserved: false},
-	"XMLDECLARATION":                   {Keyword: "XMLDECLARATION", Reserved: false},
-	"XMLDOCUMENT":                      {Keyword: "XMLDOCUMENT", Reserved: false},
-	"XMLELEMENT":                       {Keyword: "XMLELEMENT", Reserved: false},
-	"XMLEXISTS":                        {Keyword: "XMLEXISTS", Reserved: false},
-	"XMLFOREST":                        {Keyword: "XMLFOREST", Reserved: false},
-	"XMLITERATE":                       {Keyword: "XMLITERATE", Reserved: false},
-	"XMLNAMESPACES":                    {Keyword: "XMLNAMESPACES", Reserved: false},
-	"XMLPARSE":                         {Keyword: "XMLPARSE", Reserved: false},
-	"XMLPI":                            {Keyword: "XMLPI", Reserved: false},
-	"XMLQUERY":                         {Keyword: "XMLQUERY", Reserved: false},
-	"XMLROOT":                          {Keyword: "XMLROOT", Reserved: false},
-	"XMLSCHEMA":                        {Keyword: "XMLSCHEMA", Reserved: false},
-	"XMLSERIALIZE":                     {Keyword: "XMLSERIALIZE", Reserved: false},
-	"XMLTABLE":                         {Keyword: "XMLTABLE", Reserved: false},
-	"XMLTEXT":                          {Keyword: "XMLTEXT", Reserved: false},
-	"XMLVALIDATE":                      {Keyword: "XMLVALIDATE", Reserved: false},
-	"YEAR":                             {Keyword: "YEAR", Reserved: false},
-	"YES":                              {Keyword: "YES", Reserved: false},
-	"ZONE":                             {Keyword: "ZONE", Reserved: false},
+	"AES128":            {Keyword: "AES128", Reserved: true},
+	"AES256":            {Keyword: "AES256", Reserved: true},
+	"ALL":               {Keyword: "ALL", Reserved: true},
+	"ALLOWOVERWRITE":    {Keyword: "ALLOWOVERWRITE", Reserved: true},
+	"ANALYSE":           {Keyword: "ANALYSE", Reserved: true},
+	"ANALYZE":           {Keyword: "ANALYZE", Reserved: true},
+	"AND":               {Keyword: "AND", Reserved: true},
+	"ANY":               {Keyword: "ANY", Reserved: true},
+	"ARRAY":             {Keyword: "ARRAY", Reserved: true},
+	"AS":                {Keyword: "AS", Reserved: true},
+	"ASC":               {Keyword: "ASC", Reserved: true},
+	"AUTHORIZATION":     {Keyword: "AUTHORIZATION", Reserved: true},
+	"AZ64":              {Keyword: "AZ64", Reserved: true},
+	"BACKUP":            {Keyword: "BACKUP", Reserved: true},
+	"BETWEEN":           {Keyword: "BETWEEN", Reserved: true},
+	"BINARY":            {Keyword: "BINARY", Reserved: true},
+	"BLANKSASNULL":      {Keyword: "BLANKSASNULL", Reserved: true},
+	"BOTH":              {Keyword: "BOTH", Reserved: true},
+	"BYTEDICT":          {Keyword: "BYTEDICT", Reserved: true},
+	"BZIP2":             {Keyword: "BZIP2", Reserved: true},
+	"CASE":              {Keyword: "CASE", Reserved: true},
+	"CAST":              {Keyword: "CAST", Reserved: true},
+	"CHECK":             {Keyword: "CHECK", Reserved: true},
+	"COLLATE":           {Keyword: "COLLATE", Reserved: true},
+	"COLUMN":            {Keyword: "COLUMN", Reserved: true},
+	"CONSTRAINT":        {Keyword: "CONSTRAINT", Reserved: true},
+	"CREATE":            {Keyword: "CREATE", Reserved: true},
+	"CREDENTIALS":       {Keyword: "CREDENTIALS", Reserved: true},
+	"CROSS":             {Keyword: "CROSS", Reserved: true},
+	"CURRENT_DATE":      {Keyword: "CURRENT_DATE", Reserved: true},
+	"CURRENT_TIME":      {Keyword: "CURRENT_TIME", Reserved: true},
+	"CURRENT_TIMESTAMP": {Keyword: "CURRENT_TIMESTAMP", Reserved: true},
+	"CURRENT_USER":      {Keyword: "CURRENT_USER", Reserved: true},
+	"CURRENT_USER_ID":   {Keyword: "CURRENT_USER_ID", Reserved: true},
+	"DEFAULT":           {Keyword: "DEFAULT", Reserved: true},
+	"DEFERRABLE":        {Keyword: "DEFERRABLE", Reserved: true},
+	"DEFLATE":           {Keyword: "DEFLATE", Reserved: true},
+	"DEFRAG":            {Keyword: "DEFRAG", Reserved: true},
+	"DELTA":             {Keyword: "DELTA", Reserved: true},
+	"DELTA32K":          {Keyword: "DELTA32K", Reserved: true},
+	"DESC":              {Keyword: "DESC", Reserved: true},
+	"DISABLE":           {Keyword: "DISABLE", Reserved: true},
+	"DISTINCT":          {Keyword: "DISTINCT", Reserved: true},
+	"DO":                {Keyword: "DO", Reserved: true},
+	"ELSE":              {Keyword: "ELSE", Reserved: true},
+	"EMPTYASNULL":       {Keyword: "EMPTYASNULL", Reserved: true},
+	"ENABLE":            {Keyword: "ENABLE", Reserved: true},
+	"ENCODE":            {Keyword: "ENCODE", Reserved: true},
+	"ENCRYPT":           {Keyword: "ENCRYPT", Reserved: true},
+	"ENCRYPTION":        {Keyword: "ENCRYPTION", Reserved: true},
+	"END":               {Keyword: "END", Reserved: true},
+	"EXCEPT":            {Keyword: "EXCEPT", Reserved: true},
+	"EXPLICIT":          {Keyword: "EXPLICIT", Reserved: true},
+	"FALSE":             {Keyword: "FALSE", Reserved: true},
+	"FOR":               {Keyword: "FOR", Reserved: true},
+	"FOREIGN":           {Keyword: "FOREIGN", Reserved: true},
+	"FREEZE":            {Keyword: "FREEZE", Reserved: true},
+	"FROM":              {Keyword: "FROM", Reserved: true},
+	"FULL":              {Keyword: "FULL", Reserved: true},
+	"GLOBALDICT256":     {Keyword: "GLOBALDICT256", Reserved: true},
+	"GLOBALDICT64K":     {Keyword: "GLOBALDICT64K", Reserved: true},
+	"GRANT":             {Keyword: "GRANT", Reserved: true},
+	"GROUP":             {Keyword: "GROUP", Reserved: true},
+	"GZIP":              {Keyword: "GZIP", Reserved: true},
+	"HAVING":            {Keyword: "HAVING", Reserved: true},
+	"IDENTITY":          {Keyword: "IDENTITY", Reserved: true},
+	"IGNORE":            {Keyword: "IGNORE", Reserved: true},
+	"ILIKE":             {Keyword: "ILIKE", Reserved: true},
+	"IN":                {Keyword: "IN", Reserved: true},
+	"INITIALLY":         {Keyword: "INITIALLY", Reserved: true},
+	"INNER":             {Keyword: "INNER", Reserved: true},
+	"INTERSECT":         {Keyword: "INTERSECT", Reserved: true},
+	"INTERVAL":          {Keyword: "INTERVAL", Reserved: true},
+	"INTO":              {Keyword: "INTO", Reserved: true},
+	"IS":                {Keyword: "IS", Reserved: true},
+	"ISNULL":            {Keyword: "ISNULL", Reserved: true},
+	"JOIN":              {Keyword: "JOIN", Reserved: true},
+	"LANGUAGE":          {Keyword: "LANGUAGE", Reserved: true},
+	"LEADING":           {Keyword: "LEADING", Reserved: true},
+	"LEFT":              {Keyword: "LEFT", Reserved: true},
+	"LIKE":              {Keyword: "LIKE", Reserved: true},
+	"LIMIT":             {Keyword: "LIMIT", Reserved: true},
+	"LOCALTIME":         {Keyword: "LOCALTIME", Reserved: true},
+	"LOCALTIMESTAMP":    {Keyword: "LOCALTIMESTAMP", Reserved: true},
+	"LUN":               {Keyword: "LUN", Reserved: true},
+	"LUNS":              {Keyword: "LUNS", Reserved: true},
+	"LZO":               {Keyword: "LZO", Reserved: true},
+	"LZOP":              {Keyword: "LZOP", Reserved: true},
+	"MINUS":             {Keyword: "MINUS", Reserved: true},
+	"MOSTLY16":          {Keyword: "MOSTLY16", Reserved: true},
+	"MOSTLY32":          {Keyword: "MOSTLY32", Reserved: true},
+	"MOSTLY8":           {Keyword: "MOSTLY8", Reserved: true},
+	"NATURAL":           {Keyword: "NATURAL", Reserved: true},
+	"NEW":               {Keyword: "NEW", Reserved: true},
+	"NOT":               {Keyword: "NOT", Reserved: true},
+	"NOTNULL":           {Keyword: "NOTNULL", Reserved: true},
+	"NULL":              {Keyword: "NULL", Reserved: true},
+	"NULLS":             {Keyword: "NULLS", Reserved: true},
+	"OFF":               {Keyword: "OFF", Reserved: true},
+	"OFFLINE":           {Keyword: "OFFLINE", Reserved: true},
+	"OFFSET":            {Keyword: "OFFSET", Reserved: true},
+	"OID":               {Keyword: "OID", Reserved: true},
+	"OLD":               {Keyword: "OLD", Reserved: true},
+	"ON":                {Keyword: "ON", Reserved: true},
+	"ONLY":              {Keyword: "ONLY", Reserved: true},
+	"OPEN":              {Keyword: "OPEN", Reserved: true},
+	"OR":                {Keyword: "OR", Reserved: true},
+	"ORDER":             {Keyword: "ORDER", Reserved: true},
+	"OUTER":             {Keyword: "OUTER", Reserved: true},
+	"OVERLAPS":          {Keyword: "OVERLAPS", Reserved: true},
+	"PARALLEL":          {Keyword: "PARALLEL", Reserved: true},
+	"PARTITION":         {Keyword: "PARTITION", Reserved: true},
+	"PERCENT":           {Keyword: "PERCENT", Reserved: true},
+	"PERMISSIONS":       {Keyword: "PERMISSIONS", Reserved: true},
+	"PIVOT":             {Keyword: "PIVOT", Reserved: true},
+	"PLACING":           {Keyword: "PLACING", Reserved: true},
+	"PRIMARY":           {Keyword: "PRIMARY", Reserved: true},
+	"RAW":               {Keyword: "RAW", Reserved: true},
+	"READRATIO":         {Keyword: "READRATIO", Reserved: true},
+	"RECOVER":           {Keyword: "RECOVER", Reserved: true},
+	"REFERENCES":        {Keyword: "REFERENCES", Reserved: true},
+	"REJECTLOG":         {Keyword: "REJECTLOG", Reserved: true},
+	"RESORT":            {Keyword: "RESORT", Reserved: true},
+	"RESPECT":           {Keyword: "RESPECT", Reserved: true},
+	"RESTORE":           {Keyword: "RESTORE", Reserved: true},
+	"RIGHT":             {Keyword: "RIGHT", Reserved: true},
+	"SELECT":            {Keyword: "SELECT", Reserved: true},
+	"SESSION_USER":      {Keyword: "SESSION_USER", Reserved: true},
+	"SIMILAR":           {Keyword: "SIMILAR", Reserved: true},
+	"SNAPSHOT":          {Keyword: "SNAPSHOT", Reserved: true},
+	"SOME":              {Keyword: "SOME", Reserved: true},
+	"SYSDATE":           {Keyword: "SYSDATE", Reserved: true},
+	"SYSTEM":            {Keyword: "SYSTEM", Reserved: true},
+	"TABLE":             {Keyword: "TABLE", Reserved: true},
+	"TAG":               {Keyword: "TAG", Reserved: true},
+	"TDES":              {Keyword: "TDES", Reserved: true},
+	"TEXT255":           {Keyword: "TEXT255", Reserved: true},
+	"TEXT32K":           {Keyword: "TEXT32K", Reserved: true},
+	"THEN":              {Keyword: "THEN", Reserved: true},
+	"TIMESTAMP":         {Keyword: "TIMESTAMP", Reserved: true},
+	"TO":                {Keyword: "TO", Reserved: true},
+	"TOP":               {Keyword: "TOP", Reserved: true},
+	"TRAILING":          {Keyword: "TRAILING", Reserved: true},
+	"TRUE":              {Keyword: "TRUE", Reserved: true},
+	"TRUNCATECOLUMNS":   {Keyword: "TRUNCATECOLUMNS", Reserved: true},
+	"UNION":             {Keyword: "UNION", Reserved: true},
+	"UNIQUE":            {Keyword: "UNIQUE", Reserved: true},
+	"UNNEST":            {Keyword: "UNNEST", Reserved: true},
+	"UNPIVOT":           {Keyword: "UNPIVOT", Reserved: true},
+	"USER":              {Keyword: "USER", Reserved: true},
+	"USING":             {Keyword: "USING", Reserved: true},
+	"VERBOSE":           {Keyword: "VERBOSE", Reserved: true},
+	"WALLET":            {Keyword: "WALLET", Reserved: true},
+	"WHEN":              {Keyword: "WHEN", Reserved: true},
+	"WHERE":             {Keyword: "WHERE", Reserved: true},
+	"WITH":              {Keyword: "WITH", Reserved: true},
+	"WITHOUT":           {Keyword: "WITHOUT", Reserved: true},
 }
@@ -0,0 +1,47 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/redshift"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsIdentifier(t *testing.T) {
+	tests := []struct {
+		word string
+		want bool
+	}{
+		// Keywords in PostgreSQL but plain identifiers on a Redshift cluster.
+		{word: "value", want: true},
+		{word: "year", want: true},
+		{word: "fetch", want: true},
+		// Reserved by Redshift but not by PostgreSQL.
+		{word: "encode", want: false},
+		{word: "open", want: false},
+		{word: "partition", want: false},
+		// Reserved by both.
+		{word: "select", want: false},
+		{word: "null", want: false},
+		{word: "users", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			lexer := redshift.NewRedshiftLexer(antlr.NewInputStream(tt.word))
+			token := lexer.NextToken()
+			require.Equal(t, tt.want, lexer.IsIdentifier(token.GetTokenType()))
+		})
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	for _, word := range []string{"ENCODE", "DELTA", "BYTEDICT", "MOSTLY8", "LZO", "RAW", "GLOBALDICT256", "TAG"} {
+		require.True(t, redshift.Keywords[word].Reserved, word)
+	}
+	for _, word := range []string{"VALUE", "YEAR", "FETCH"} {
+		_, ok := redshift.Keywords[word]
+		require.False(t, ok, word)
+	}
+}
@@ -0,0 +1,43 @@
+package redshift
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NeedsQuoting reports whether name must be double-quoted to be used as an
+// identifier and keep its exact spelling. Following the Redshift rules for
+// standard identifiers:
+//   - names with upper-case letters are quoted, because Redshift folds
+//     unquoted names to lower case;
+//   - a name must start with a letter or an underscore and continue with
+//     letters, digits, underscores or dollar signs. Non-ASCII letters are
+//     allowed unquoted; anything else, including embedded double quotes, is
+//     quoted;
+//   - Redshift reserved words are quoted. Redshift's reserved words differ
+//     from PostgreSQL's: "encode" and "tag" must be quoted, "fetch" or
+//     "value" need not.
+func NeedsQuoting(name string) bool {
+	if name == "" {
+		return true
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r == '_':
+		case (r >= '0' && r <= '9' || r == '$') && i > 0:
+		case r > unicode.MaxASCII && unicode.IsLetter(r) && !unicode.IsUpper(r):
+		default:
+			return true
+		}
+	}
+	return Keywords[strings.ToUpper(name)].Reserved
+}
+
+// QuoteIdentifierIfNeeded returns name as an identifier, double-quoted with
+// embedded quotes doubled if NeedsQuoting reports that it must be quoted.
+func QuoteIdentifierIfNeeded(name string) string {
+	if !NeedsQuoting(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,41 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/bytebase/parser/redshift"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteIdentifierIfNeeded(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "sales", want: "sales"},
+		{name: "price$usd", want: "price$usd"},
+		{name: "café", want: "café"},
+		{name: "Sales", want: `"Sales"`},
+		{name: "Été", want: `"Été"`},
+		{name: "$price", want: `"$price"`},
+		{name: "1st", want: `"1st"`},
+		{name: `say "hi"`, want: `"say ""hi"""`},
+		{name: "", want: `""`},
+		// Redshift-only reserved words.
+		{name: "encode", want: `"encode"`},
+		{name: "tag", want: `"tag"`},
+		{name: "mostly8", want: `"mostly8"`},
+		// Reserved in PostgreSQL only.
+		{name: "fetch", want: "fetch"},
+		{name: "value", want: "value"},
+		{name: "year", want: "year"},
+		{name: "select", want: `"select"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, redshift.QuoteIdentifierIfNeeded(tt.name))
+			require.Equal(t, tt.want != tt.name, redshift.NeedsQuoting(tt.name))
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/redshift"
+	"github.com/bytebase/parser/treequery"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeQuery(t *testing.T) {
+	lexer := redshift.NewRedshiftLexer(antlr.NewInputStream("SELECT a FROM t WHERE b = NULL;\nUNLOAD ('SELECT 1') TO 's3://bucket/' IAM_ROLE default;\nDELETE FROM u WHERE D = null;"))
+	parser := redshift.NewRedshiftParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	tree := parser.Root()
+
+	wheres, err := treequery.FindAll(tree, "//selectstmt//where_clause/a_expr", parser)
+	require.NoError(t, err)
+	require.Len(t, wheres, 1)
+	require.Equal(t, "b=NULL", wheres[0].GetText())
+
+	matcher := treequery.NewPatternMatcher(redshift.NewRedshiftLexer(nil), parser)
+	pattern, err := matcher.Compile("<col:columnref> = NULL", "a_expr")
+	require.NoError(t, err)
+	matches, err := pattern.FindAll(tree, "//a_expr")
+	require.NoError(t, err)
+	var columns []string
+	for _, match := range matches {
+		columns = append(columns, match.Get("col").GetText())
+	}
+	require.Equal(t, []string{"b", "D"}, columns)
+}
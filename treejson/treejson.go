@@ -0,0 +1,167 @@
+// Package treejson converts the parse trees of the generated ANTLR parsers,
+// such as those of the postgresql, redshift and cql packages, to JSON and
+// back. A Node keeps everything needed to read a tree without the parser: rule
+// names, token types and symbolic names, text, positions and the comments on
+// hidden channels.
+package treejson
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Node is a rule or token of a parse tree.
+type Node struct {
+	// Rule is the rule name of a rule node, e.g. "selectstmt". It is empty
+	// for tokens.
+	Rule string `json:"rule,omitempty"`
+	// Type is the token type of a token node, and Symbol its symbolic name,
+	// e.g. "SELECT". Tokens without a symbolic name, such as implicit tokens
+	// of a combined grammar, have their literal name, e.g. "';'".
+	Type   int    `json:"type,omitempty"`
+	Symbol string `json:"symbol,omitempty"`
+	// Text is the text of a token node.
+	Text string `json:"text,omitempty"`
+	// Error marks a token that the parser consumed while recovering from a
+	// syntax error.
+	Error bool `json:"error,omitempty"`
+	// Line and Column are the position of the first token of the node, and
+	// Start and Stop the offsets of its first and last character.
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Start  int `json:"start"`
+	Stop   int `json:"stop"`
+	// Comments are the hidden-channel tokens before a token node that are not
+	// whitespace.
+	Comments []*Node `json:"comments,omitempty"`
+	// Children are the children of a rule node.
+	Children []*Node `json:"children,omitempty"`
+}
+
+// FromTree converts tree, parsed by parser, to a Node. tokens is the token
+// stream tree was parsed from, for its comments; it may be nil.
+func FromTree(tree antlr.Tree, parser antlr.Parser, tokens antlr.TokenStream) *Node {
+	c := &converter{
+		ruleNames:    parser.GetRuleNames(),
+		symbolicName: parser.GetSymbolicNames(),
+		literalNames: parser.GetLiteralNames(),
+		tokens:       tokens,
+	}
+	return c.node(tree)
+}
+
+// Marshal returns the indented JSON of tree, parsed by parser from tokens.
+// tokens may be nil.
+func Marshal(tree antlr.Tree, parser antlr.Parser, tokens antlr.TokenStream) ([]byte, error) {
+	return json.MarshalIndent(FromTree(tree, parser, tokens), "", "  ")
+}
+
+// Unmarshal parses the JSON of a tree returned by Marshal.
+func Unmarshal(data []byte) (*Node, error) {
+	node := &Node{}
+	if err := json.Unmarshal(data, node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// IsToken reports whether n is a token node.
+func (n *Node) IsToken() bool {
+	return n.Rule == ""
+}
+
+// GetText returns the text of the tokens of n, without the hidden tokens
+// between them, as the GetText method of a parse tree does.
+func (n *Node) GetText() string {
+	if n.IsToken() {
+		return n.Text
+	}
+	var b strings.Builder
+	for _, child := range n.Children {
+		b.WriteString(child.GetText())
+	}
+	return b.String()
+}
+
+type converter struct {
+	ruleNames    []string
+	symbolicName []string
+	literalNames []string
+	tokens       antlr.TokenStream
+}
+
+func (c *converter) node(tree antlr.Tree) *Node {
+	switch t := tree.(type) {
+	case antlr.TerminalNode:
+		node := c.token(t.GetSymbol())
+		_, node.Error = t.(antlr.ErrorNode)
+		node.Comments = c.comments(t.GetSymbol())
+		return node
+	case antlr.ParserRuleContext:
+		node := &Node{Rule: c.ruleName(t.GetRuleIndex())}
+		if start := t.GetStart(); start != nil {
+			node.Line, node.Column, node.Start = start.GetLine(), start.GetColumn(), start.GetStart()
+			node.Stop = start.GetStart() - 1
+		}
+		if stop := t.GetStop(); stop != nil && t.GetStart() != nil && stop.GetTokenIndex() >= t.GetStart().GetTokenIndex() {
+			node.Stop = stop.GetStop()
+		}
+		for _, child := range t.GetChildren() {
+			node.Children = append(node.Children, c.node(child))
+		}
+		return node
+	}
+	return &Node{}
+}
+
+func (c *converter) token(token antlr.Token) *Node {
+	return &Node{
+		Type:   token.GetTokenType(),
+		Symbol: c.symbol(token.GetTokenType()),
+		Text:   token.GetText(),
+		Line:   token.GetLine(),
+		Column: token.GetColumn(),
+		Start:  token.GetStart(),
+		Stop:   token.GetStop(),
+	}
+}
+
+// comments returns the hidden-channel tokens between token and the token on
+// the default channel before it that are not whitespace.
+func (c *converter) comments(token antlr.Token) []*Node {
+	if c.tokens == nil || token.GetTokenIndex() < 0 {
+		return nil
+	}
+	var result []*Node
+	for i := token.GetTokenIndex() - 1; i >= 0; i-- {
+		hidden := c.tokens.Get(i)
+		if hidden.GetChannel() == antlr.TokenDefaultChannel {
+			break
+		}
+		if strings.TrimSpace(hidden.GetText()) != "" {
+			result = append([]*Node{c.token(hidden)}, result...)
+		}
+	}
+	return result
+}
+
+func (c *converter) ruleName(index int) string {
+	if index >= 0 && index < len(c.ruleNames) {
+		return c.ruleNames[index]
+	}
+	return "<unknown>"
+}
+
+func (c *converter) symbol(tokenType int) string {
+	switch {
+	case tokenType == antlr.TokenEOF:
+		return "EOF"
+	case tokenType > 0 && tokenType < len(c.symbolicName) && c.symbolicName[tokenType] != "":
+		return c.symbolicName[tokenType]
+	case tokenType > 0 && tokenType < len(c.literalNames):
+		return c.literalNames[tokenType]
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package treejson_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	cqlparser "github.com/bytebase/parser/cql"
+	"github.com/bytebase/parser/treejson"
+	"github.com/stretchr/testify/require"
+)
+
+func parseCQL(statement string) (antlr.Tree, antlr.Parser, antlr.TokenStream) {
+	lexer := cqlparser.NewCqlLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := cqlparser.NewCqlParser(stream)
+	parser.RemoveErrorListeners()
+	return parser.Root(), parser, stream
+}
+
+func findRule(node *treejson.Node, rule string) *treejson.Node {
+	if node.Rule == rule {
+		return node
+	}
+	for _, child := range node.Children {
+		if found := findRule(child, rule); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func TestMarshal(t *testing.T) {
+	statement := "/* users */ SELECT a\nFROM t; -- done\n"
+	tree, parser, tokens := parseCQL(statement)
+	data, err := treejson.Marshal(tree, parser, tokens)
+	require.NoError(t, err)
+
+	node, err := treejson.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, treejson.FromTree(tree, parser, tokens), node)
+
+	require.Equal(t, "root", node.Rule)
+	require.Equal(t, tree.(antlr.ParseTree).GetText(), node.GetText())
+	require.Equal(t, 1, node.Line)
+	require.Equal(t, 12, node.Column)
+	require.Equal(t, 12, node.Start)
+
+	selectStatement := findRule(node, "select_")
+	require.NotNil(t, selectStatement)
+	require.Equal(t, "SELECTaFROMt", selectStatement.GetText())
+	require.Equal(t, "SELECT a\nFROM t", statement[selectStatement.Start:selectStatement.Stop+1])
+
+	keyword := selectStatement.Children[0].Children[0]
+	require.True(t, keyword.IsToken())
+	require.Equal(t, "K_SELECT", keyword.Symbol)
+	require.Equal(t, cqlparser.CqlLexerK_SELECT, keyword.Type)
+	require.Equal(t, "SELECT", keyword.Text)
+	require.Len(t, keyword.Comments, 1)
+	require.Equal(t, "/* users */", keyword.Comments[0].Text)
+	require.Equal(t, "COMMENT_INPUT", keyword.Comments[0].Symbol)
+
+	eof := node.Children[len(node.Children)-1]
+	require.Equal(t, "EOF", eof.Symbol)
+	require.Len(t, eof.Comments, 1)
+	require.Equal(t, "-- done\n", eof.Comments[0].Text)
+}
+
+func TestMarshalSyntaxError(t *testing.T) {
+	tree, parser, _ := parseCQL("SELECT FROM t")
+	node := treejson.FromTree(tree, parser, nil)
+
+	var hasError func(*treejson.Node) bool
+	hasError = func(node *treejson.Node) bool {
+		if node.Error {
+			return true
+		}
+		for _, child := range node.Children {
+			if hasError(child) {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(t, hasError(node))
+}
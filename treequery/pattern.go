@@ -0,0 +1,332 @@
+package treequery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Lexer is a generated lexer, whose input can be replaced.
+type Lexer interface {
+	antlr.Lexer
+	SetInputStream(input antlr.CharStream)
+}
+
+// PatternMatcher compiles tree patterns for the trees of a parser.
+//
+// A pattern is a piece of the language with tags in angle brackets for the
+// parts that may vary. <columnref> matches any columnref subtree and <Integral>
+// any Integral token; <col:columnref> labels the subtree col as well. The
+// other tokens of the pattern match tokens of the same type and text. Keywords
+// and unquoted identifiers match ignoring case; other tokens, such as string
+// constants and quoted identifiers, must have the same spelling. A < that does
+// not start a tag, or that is preceded by a backslash, is part of the pattern
+// text, so a comparison can be written as <a_expr> \< 5.
+type PatternMatcher struct {
+	lexer     Lexer
+	parser    antlr.Parser
+	ruleIndex map[string]int
+}
+
+// NewPatternMatcher returns a matcher that lexes patterns with lexer and
+// looks up their tags in parser.
+func NewPatternMatcher(lexer Lexer, parser antlr.Parser) *PatternMatcher {
+	ruleIndex := make(map[string]int)
+	for i, name := range parser.GetRuleNames() {
+		ruleIndex[name] = i
+	}
+	return &PatternMatcher{lexer: lexer, parser: parser, ruleIndex: ruleIndex}
+}
+
+// Pattern is a compiled tree pattern.
+type Pattern struct {
+	pattern string
+	rule    int
+	items   []*patternItem
+	parser  antlr.Parser
+}
+
+// patternItem is a token of a pattern or a tag.
+type patternItem struct {
+	// token is the token of a text item, and nil for a tag.
+	token antlr.Token
+	// bare is whether token is a keyword or an unquoted identifier, whose
+	// text is matched ignoring case.
+	bare bool
+	// tag is the rule or token name of a tag, and label its label.
+	tag   string
+	label string
+	// rule is the rule index of a rule tag, and -1 for the others.
+	rule      int
+	tokenType int
+}
+
+var tagPattern = regexp.MustCompile(`^<(?:([\p{L}_][\p{L}\p{N}_]*):)?([\p{L}_][\p{L}\p{N}_]*)>`)
+
+// Compile compiles pattern, which matches the subtrees of rule.
+func (m *PatternMatcher) Compile(pattern string, rule string) (*Pattern, error) {
+	ruleIndex, ok := m.ruleIndex[rule]
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a valid rule name", rule)
+	}
+	p := &Pattern{pattern: pattern, rule: ruleIndex, parser: m.parser}
+
+	var text strings.Builder
+	for i := 0; i < len(pattern); {
+		if strings.HasPrefix(pattern[i:], `\<`) {
+			text.WriteByte('<')
+			i += 2
+			continue
+		}
+		tag := tagPattern.FindStringSubmatch(pattern[i:])
+		if tag == nil {
+			text.WriteByte(pattern[i])
+			i++
+			continue
+		}
+		if err := m.appendText(p, text.String()); err != nil {
+			return nil, err
+		}
+		text.Reset()
+		item, err := m.tagItem(tag[1], tag[2])
+		if err != nil {
+			return nil, err
+		}
+		p.items = append(p.items, item)
+		i += len(tag[0])
+	}
+	if err := m.appendText(p, text.String()); err != nil {
+		return nil, err
+	}
+	if len(p.items) == 0 {
+		return nil, fmt.Errorf("empty pattern")
+	}
+	return p, nil
+}
+
+func (m *PatternMatcher) tagItem(label, tag string) (*patternItem, error) {
+	item := &patternItem{tag: tag, label: label, rule: -1}
+	if unicode.IsUpper([]rune(tag)[0]) {
+		tokenType, ok := tokenTypeOf(m.parser, tag)
+		if !ok {
+			return nil, fmt.Errorf("<%s> isn't a valid token name", tag)
+		}
+		item.tokenType = tokenType
+		return item, nil
+	}
+	rule, ok := m.ruleIndex[tag]
+	if !ok {
+		return nil, fmt.Errorf("<%s> isn't a valid rule name", tag)
+	}
+	item.rule = rule
+	return item, nil
+}
+
+// appendText lexes text and appends its default channel tokens to p.
+func (m *PatternMatcher) appendText(p *Pattern, text string) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	tokens, err := m.lex(text)
+	if err != nil {
+		return fmt.Errorf("pattern %q: %v", text, err)
+	}
+	for _, token := range tokens {
+		p.items = append(p.items, &patternItem{token: token, bare: isBareWord(token.GetText()), rule: -1})
+	}
+	return nil
+}
+
+// lex returns the default channel tokens of text.
+func (m *PatternMatcher) lex(text string) ([]antlr.Token, error) {
+	errors := &patternErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	m.lexer.SetInputStream(antlr.NewInputStream(text))
+	m.lexer.RemoveErrorListeners()
+	m.lexer.AddErrorListener(errors)
+	var result []antlr.Token
+	for {
+		token := m.lexer.NextToken()
+		if token.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		if token.GetChannel() == antlr.TokenDefaultChannel {
+			result = append(result, token)
+		}
+	}
+	return result, errors.err
+}
+
+// isBareWord reports whether text is a keyword or an unquoted identifier.
+// String constants, quoted identifiers and numbers are not.
+func isBareWord(text string) bool {
+	for i, r := range text {
+		if !(unicode.IsLetter(r) || r == '_' || i > 0 && (unicode.IsDigit(r) || r == '$')) {
+			return false
+		}
+	}
+	return text != ""
+}
+
+type patternErrorListener struct {
+	*antlr.DefaultErrorListener
+
+	err error
+}
+
+func (l *patternErrorListener) SyntaxError(_ antlr.Recognizer, _ any, line, column int, msg string, _ antlr.RecognitionException) {
+	if l.err == nil {
+		l.err = fmt.Errorf("line %d:%d %s", line, column, msg)
+	}
+}
+
+// String returns the text p was compiled from.
+func (p *Pattern) String() string {
+	return p.pattern
+}
+
+// PatternMatch is a subtree that a pattern matches.
+type PatternMatch struct {
+	Tree antlr.ParseTree
+	// labels are the subtrees and tokens matched by the tags, by tag name
+	// and by label.
+	labels map[string][]antlr.ParseTree
+}
+
+// Get returns the node matched by the tag with label or name, or nil. If
+// several tags have it, it returns the last one.
+func (m *PatternMatch) Get(label string) antlr.ParseTree {
+	nodes := m.labels[label]
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[len(nodes)-1]
+}
+
+// GetAll returns the nodes matched by the tags with label or name, in pattern
+// order.
+func (m *PatternMatch) GetAll(label string) []antlr.ParseTree {
+	return m.labels[label]
+}
+
+// Matches reports whether p matches tree.
+func (p *Pattern) Matches(tree antlr.ParseTree) bool {
+	return p.Match(tree) != nil
+}
+
+// Match matches p against tree, which must be a subtree of the pattern rule
+// whose tokens all match the pattern, and returns nil if it does not match.
+func (p *Pattern) Match(tree antlr.ParseTree) *PatternMatch {
+	ctx, ok := tree.(antlr.ParserRuleContext)
+	if !ok || ctx.GetRuleIndex() != p.rule {
+		return nil
+	}
+	m := &patternMatcher{pattern: p, subtrees: make(map[subtreeKey][]subtree)}
+	m.index(tree)
+	bindings, ok := m.match(0, 0, nil)
+	if !ok {
+		return nil
+	}
+	match := &PatternMatch{Tree: tree, labels: make(map[string][]antlr.ParseTree)}
+	for _, b := range bindings {
+		match.labels[b.item.tag] = append(match.labels[b.item.tag], b.node)
+		if b.item.label != "" {
+			match.labels[b.item.label] = append(match.labels[b.item.label], b.node)
+		}
+	}
+	return match
+}
+
+// FindAll returns the matches of p among the nodes of tree that path selects.
+func (p *Pattern) FindAll(tree antlr.ParseTree, path string) ([]*PatternMatch, error) {
+	nodes, err := FindAll(tree, path, p.parser)
+	if err != nil {
+		return nil, err
+	}
+	var result []*PatternMatch
+	for _, node := range nodes {
+		if match := p.Match(node); match != nil {
+			result = append(result, match)
+		}
+	}
+	return result, nil
+}
+
+// patternMatcher matches the items of a pattern against the tokens of a tree.
+// A rule tag matches the tokens of a subtree of its rule.
+type patternMatcher struct {
+	pattern *Pattern
+	tokens  []antlr.TerminalNode
+	// subtrees are the rule subtrees by rule and first token, outermost
+	// first.
+	subtrees map[subtreeKey][]subtree
+}
+
+type subtreeKey struct {
+	rule  int
+	start int
+}
+
+type subtree struct {
+	node antlr.ParseTree
+	// stop is the index after the last token of the subtree.
+	stop int
+}
+
+type binding struct {
+	item *patternItem
+	node antlr.ParseTree
+}
+
+func (m *patternMatcher) index(node antlr.ParseTree) {
+	switch n := node.(type) {
+	case antlr.TerminalNode:
+		m.tokens = append(m.tokens, n)
+	case antlr.RuleContext:
+		start := len(m.tokens)
+		key := subtreeKey{rule: n.GetRuleIndex(), start: start}
+		i := len(m.subtrees[key])
+		m.subtrees[key] = append(m.subtrees[key], subtree{node: node})
+		for _, child := range children(node) {
+			m.index(child)
+		}
+		m.subtrees[key][i].stop = len(m.tokens)
+	}
+}
+
+// match matches the items from i on against the tokens from j on, and returns
+// the bindings of the tags.
+func (m *patternMatcher) match(i, j int, bindings []binding) ([]binding, bool) {
+	items := m.pattern.items
+	if i == len(items) {
+		return bindings, j == len(m.tokens)
+	}
+	item := items[i]
+	if item.rule >= 0 {
+		for _, s := range m.subtrees[subtreeKey{rule: item.rule, start: j}] {
+			if result, ok := m.match(i+1, s.stop, append(bindings[:len(bindings):len(bindings)], binding{item: item, node: s.node})); ok {
+				return result, true
+			}
+		}
+		return nil, false
+	}
+	if j == len(m.tokens) {
+		return nil, false
+	}
+	token := m.tokens[j].GetSymbol()
+	if item.token == nil {
+		if token.GetTokenType() != item.tokenType {
+			return nil, false
+		}
+		return m.match(i+1, j+1, append(bindings[:len(bindings):len(bindings)], binding{item: item, node: m.tokens[j]}))
+	}
+	if token.GetTokenType() != item.token.GetTokenType() {
+		return nil, false
+	}
+	if item.bare && !strings.EqualFold(token.GetText(), item.token.GetText()) || !item.bare && token.GetText() != item.token.GetText() {
+		return nil, false
+	}
+	return m.match(i+1, j+1, bindings)
+}
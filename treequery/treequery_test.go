@@ -0,0 +1,146 @@
+package treequery_test
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	cqlparser "github.com/bytebase/parser/cql"
+	"github.com/bytebase/parser/treequery"
+	"github.com/stretchr/testify/require"
+)
+
+const statements = "SELECT a FROM t WHERE b = 1 AND c < 2 AND d = 'x';\nSELECT * FROM u;"
+
+func parseCQL(statement string) (antlr.ParseTree, *cqlparser.CqlParser) {
+	lexer := cqlparser.NewCqlLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	parser := cqlparser.NewCqlParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	return parser.Root(), parser
+}
+
+func texts(nodes []antlr.ParseTree) []string {
+	var result []string
+	for _, node := range nodes {
+		result = append(result, node.GetText())
+	}
+	return result
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{
+			path: "//whereSpec//relationElement",
+			want: []string{"b=1", "c<2", "d='x'"},
+		},
+		{
+			path: "//relationElement/'='",
+			want: []string{"=", "="},
+		},
+		{
+			path: "//relationElement/!column",
+			want: []string{"=", "1", "<", "2", "=", "'x'"},
+		},
+		{
+			path: "//fromSpecElement/*",
+			want: []string{"t", "u"},
+		},
+		{
+			path: "/root/cqls/cql/select_/fromSpec",
+			want: []string{"FROMt", "FROMu"},
+		},
+		{
+			path: "/select_",
+		},
+		{
+			path: "root//constant//decimalLiteral",
+			want: []string{"1", "2"},
+		},
+	}
+
+	tree, parser := parseCQL(statements)
+	for _, test := range tests {
+		nodes, err := treequery.FindAll(tree, test.path, parser)
+		require.NoError(t, err, test.path)
+		require.Equal(t, test.want, texts(nodes), test.path)
+	}
+}
+
+func TestCompileXPathError(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "//selectstmt", want: "selectstmt at index 2 isn't a valid rule name"},
+		{path: "//SELECT", want: "SELECT at index 2 isn't a valid token name"},
+		{path: "//'=", want: "unterminated token literal at index 2 in \"//'=\""},
+		{path: "//select_ fromSpec", want: "missing separator at index 9 in \"//select_ fromSpec\""},
+		{path: "", want: "empty path"},
+	}
+
+	_, parser := parseCQL(statements)
+	for _, test := range tests {
+		_, err := treequery.CompileXPath(test.path, parser)
+		require.EqualError(t, err, test.want, test.path)
+	}
+}
+
+func TestPattern(t *testing.T) {
+	tree, parser := parseCQL(statements)
+	matcher := treequery.NewPatternMatcher(cqlparser.NewCqlLexer(nil), parser)
+
+	pattern, err := matcher.Compile("<OBJECT_NAME> = <value:constant>", "relationElement")
+	require.NoError(t, err)
+	matches, err := pattern.FindAll(tree, "//relationElement")
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.Equal(t, "b", matches[0].Get("OBJECT_NAME").GetText())
+	require.Equal(t, "1", matches[0].Get("value").GetText())
+	require.Equal(t, "'x'", matches[1].Get("constant").GetText())
+	require.Nil(t, matches[1].Get("column"))
+
+	pattern, err = matcher.Compile(`<OBJECT_NAME> \< <constant>`, "relationElement")
+	require.NoError(t, err)
+	matches, err = pattern.FindAll(tree, "//relationElement")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, "c<2", matches[0].Tree.GetText())
+
+	pattern, err = matcher.Compile("select * from <fromSpecElement>", "select_")
+	require.NoError(t, err)
+	matches, err = pattern.FindAll(tree, "//select_")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, "u", matches[0].Get("fromSpecElement").GetText())
+
+	pattern, err = matcher.Compile("<x:relationElement> AND <y:relationElement>", "relationElements")
+	require.NoError(t, err)
+	relations, err := treequery.FindAll(tree, "//relationElements", parser)
+	require.NoError(t, err)
+	require.False(t, pattern.Matches(relations[0]))
+
+	// Keywords and unquoted names match ignoring case, string constants do not.
+	pattern, err = matcher.Compile("<OBJECT_NAME> = 'x'", "relationElement")
+	require.NoError(t, err)
+	matches, err = pattern.FindAll(tree, "//relationElement")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	pattern, err = matcher.Compile("<OBJECT_NAME> = 'X'", "relationElement")
+	require.NoError(t, err)
+	matches, err = pattern.FindAll(tree, "//relationElement")
+	require.NoError(t, err)
+	require.Empty(t, matches)
+	pattern, err = matcher.Compile("select * from U", "select_")
+	require.NoError(t, err)
+	matches, err = pattern.FindAll(tree, "//select_")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+
+	_, err = matcher.Compile("<OBJECT_NAME> = <value>", "relationElement")
+	require.EqualError(t, err, "<value> isn't a valid rule name")
+	_, err = matcher.Compile("<OBJECT_NAME> = 1", "selectstmt")
+	require.EqualError(t, err, "selectstmt isn't a valid rule name")
+}
@@ -0,0 +1,241 @@
+// Package treequery finds nodes in the parse trees of the generated ANTLR
+// parsers, such as those of the postgresql, redshift and cql packages, with
+// the XPath-like paths and tree patterns of the ANTLR Java runtime:
+//
+//	// Every WHERE clause expression.
+//	exprs, err := treequery.FindAll(tree, "//where_clause/a_expr", parser)
+//
+//	// Every comparison of a column to NULL.
+//	pattern, err := treequery.NewPatternMatcher(lexer, parser).Compile("<columnref> = NULL", "a_expr")
+//	matches, err := pattern.FindAll(tree, "//a_expr")
+//
+// Unlike in the Java runtime, a pattern is not parsed into a tree of its own.
+// It is matched against the flattened run of tokens of a subtree: a tag
+// matches the tokens of any subtree of its rule at that position, and the other
+// items match one token each. A pattern can therefore match a subtree whose
+// nesting differs from the tree the parser would build for the pattern text.
+package treequery
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// XPath is a compiled path. A path is a sequence of elements, each of which
+// is a separator followed by a rule name, a token name, a quoted token
+// literal such as '=', or * for any node:
+//
+//	/name   the children of the current nodes named name; the root of the
+//	        tree if it is the first element
+//	//name  the descendants of the current nodes named name; the root of the
+//	        tree and its descendants if it is the first element
+//	/!name  the children of the current nodes not named name
+//
+// Names that start with an upper case letter are tokens and the others are
+// rules, as in the grammar.
+type XPath struct {
+	path     string
+	elements []*xpathElement
+}
+
+type xpathElement struct {
+	// anywhere is set for the // separator.
+	anywhere bool
+	invert   bool
+	wildcard bool
+	// rule is the rule index of a rule element, and -1 for the others.
+	rule      int
+	tokenType int
+}
+
+// CompileXPath compiles path for the trees of parser.
+func CompileXPath(path string, parser antlr.Parser) (*XPath, error) {
+	x := &XPath{path: path}
+	ruleIndex := make(map[string]int)
+	for i, name := range parser.GetRuleNames() {
+		ruleIndex[name] = i
+	}
+
+	runes := []rune(path)
+	i := 0
+	for i < len(runes) {
+		element := &xpathElement{rule: -1}
+		// A path that does not start with a separator starts at the root.
+		if runes[i] == '/' {
+			i++
+			if i < len(runes) && runes[i] == '/' {
+				element.anywhere = true
+				i++
+			}
+		} else if len(x.elements) > 0 {
+			return nil, fmt.Errorf("missing separator at index %d in %q", i, path)
+		}
+		if i < len(runes) && runes[i] == '!' {
+			element.invert = true
+			i++
+		}
+
+		start := i
+		switch {
+		case i < len(runes) && runes[i] == '*':
+			element.wildcard = true
+			i++
+		case i < len(runes) && runes[i] == '\'':
+			i++
+			for i < len(runes) && runes[i] != '\'' {
+				i++
+			}
+			if i == len(runes) {
+				return nil, fmt.Errorf("unterminated token literal at index %d in %q", start, path)
+			}
+			i++
+			tokenType, ok := tokenTypeOf(parser, string(runes[start:i]))
+			if !ok {
+				return nil, fmt.Errorf("%s at index %d isn't a valid token literal", string(runes[start:i]), start)
+			}
+			element.tokenType = tokenType
+		default:
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("missing name at index %d in %q", start, path)
+			}
+			name := string(runes[start:i])
+			if unicode.IsUpper(runes[start]) {
+				tokenType, ok := tokenTypeOf(parser, name)
+				if !ok {
+					return nil, fmt.Errorf("%s at index %d isn't a valid token name", name, start)
+				}
+				element.tokenType = tokenType
+			} else {
+				rule, ok := ruleIndex[name]
+				if !ok {
+					return nil, fmt.Errorf("%s at index %d isn't a valid rule name", name, start)
+				}
+				element.rule = rule
+			}
+		}
+		x.elements = append(x.elements, element)
+	}
+	if len(x.elements) == 0 {
+		return nil, fmt.Errorf("empty path")
+	}
+	return x, nil
+}
+
+// FindAll returns the nodes of tree, parsed by parser, that path selects, in
+// the order they are first found.
+func FindAll(tree antlr.ParseTree, path string, parser antlr.Parser) ([]antlr.ParseTree, error) {
+	x, err := CompileXPath(path, parser)
+	if err != nil {
+		return nil, err
+	}
+	return x.Evaluate(tree), nil
+}
+
+// String returns the path x was compiled from.
+func (x *XPath) String() string {
+	return x.path
+}
+
+// Evaluate returns the nodes of tree that x selects, in the order they are
+// first found.
+func (x *XPath) Evaluate(tree antlr.ParseTree) []antlr.ParseTree {
+	// The first element selects from a parent whose only child is the root.
+	var work []antlr.ParseTree
+	first := x.elements[0]
+	if first.anywhere {
+		work = descendants(tree, true)
+	} else {
+		work = []antlr.ParseTree{tree}
+	}
+	work = first.filter(work)
+
+	for _, element := range x.elements[1:] {
+		var next []antlr.ParseTree
+		seen := make(map[antlr.ParseTree]bool)
+		for _, node := range work {
+			var candidates []antlr.ParseTree
+			if element.anywhere {
+				candidates = descendants(node, false)
+			} else {
+				candidates = children(node)
+			}
+			for _, match := range element.filter(candidates) {
+				if !seen[match] {
+					seen[match] = true
+					next = append(next, match)
+				}
+			}
+		}
+		work = next
+	}
+	return work
+}
+
+func (e *xpathElement) filter(nodes []antlr.ParseTree) []antlr.ParseTree {
+	var result []antlr.ParseTree
+	for _, node := range nodes {
+		if e.matches(node) != e.invert {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+func (e *xpathElement) matches(node antlr.ParseTree) bool {
+	if e.wildcard {
+		return true
+	}
+	switch n := node.(type) {
+	case antlr.TerminalNode:
+		return e.rule < 0 && n.GetSymbol().GetTokenType() == e.tokenType
+	case antlr.RuleContext:
+		return e.rule >= 0 && n.GetRuleIndex() == e.rule
+	}
+	return false
+}
+
+func children(node antlr.ParseTree) []antlr.ParseTree {
+	var result []antlr.ParseTree
+	for i := 0; i < node.GetChildCount(); i++ {
+		if child, ok := node.GetChild(i).(antlr.ParseTree); ok {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+// descendants returns the descendants of node in pre-order, after node itself
+// if self is set.
+func descendants(node antlr.ParseTree, self bool) []antlr.ParseTree {
+	var result []antlr.ParseTree
+	if self {
+		result = append(result, node)
+	}
+	for _, child := range children(node) {
+		result = append(result, descendants(child, true)...)
+	}
+	return result
+}
+
+// tokenTypeOf returns the token type with the symbolic name or the quoted
+// literal name.
+func tokenTypeOf(recognizer antlr.Recognizer, name string) (int, bool) {
+	if name == "EOF" {
+		return antlr.TokenEOF, true
+	}
+	names := recognizer.GetSymbolicNames()
+	if len(name) > 0 && name[0] == '\'' {
+		names = recognizer.GetLiteralNames()
+	}
+	for tokenType, n := range names {
+		if tokenType > 0 && n == name {
+			return tokenType, true
+		}
+	}
+	return 0, false
+}